@@ -114,7 +114,7 @@ func main() {
 	}
 
 	if *freeze {
-		err = client.FreezeVirtualMachine(vmi, *unfreezeTimeoutSeconds)
+		err = client.FreezeVirtualMachine(vmi, *unfreezeTimeoutSeconds, nil)
 		if err != nil {
 			if strings.Contains(err.Error(), gaNotAvailableError) {
 				// make best effort of make sure fsstatus is not stuck on frozen
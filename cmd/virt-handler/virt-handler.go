@@ -70,6 +70,7 @@ import (
 	metrics "kubevirt.io/kubevirt/pkg/monitoring/metrics/virt-handler"
 	metricshandler "kubevirt.io/kubevirt/pkg/monitoring/metrics/virt-handler/handler"
 	"kubevirt.io/kubevirt/pkg/monitoring/profiler"
+	"kubevirt.io/kubevirt/pkg/network/arpsnoop"
 	"kubevirt.io/kubevirt/pkg/network/netbinding"
 	netsetup "kubevirt.io/kubevirt/pkg/network/setup"
 	"kubevirt.io/kubevirt/pkg/service"
@@ -78,12 +79,20 @@ import (
 	virthandler "kubevirt.io/kubevirt/pkg/virt-handler"
 	virtcache "kubevirt.io/kubevirt/pkg/virt-handler/cache"
 	cmdclient "kubevirt.io/kubevirt/pkg/virt-handler/cmd-client"
+	cpufairshare "kubevirt.io/kubevirt/pkg/virt-handler/cpu-fair-share"
 	dmetricsmanager "kubevirt.io/kubevirt/pkg/virt-handler/dmetrics-manager"
+	emergencyapi "kubevirt.io/kubevirt/pkg/virt-handler/emergency-api"
+	"kubevirt.io/kubevirt/pkg/virt-handler/hugepages"
 	"kubevirt.io/kubevirt/pkg/virt-handler/isolation"
+	memoryoverhead "kubevirt.io/kubevirt/pkg/virt-handler/memory-overhead"
+	migrationnetwork "kubevirt.io/kubevirt/pkg/virt-handler/migration-network"
 	migrationproxy "kubevirt.io/kubevirt/pkg/virt-handler/migration-proxy"
+	nodeapi "kubevirt.io/kubevirt/pkg/virt-handler/node-api"
 	nodelabeller "kubevirt.io/kubevirt/pkg/virt-handler/node-labeller"
+	nodemaintenance "kubevirt.io/kubevirt/pkg/virt-handler/node-maintenance"
 	"kubevirt.io/kubevirt/pkg/virt-handler/rest"
 	"kubevirt.io/kubevirt/pkg/virt-handler/selinux"
+	"kubevirt.io/kubevirt/pkg/virt-handler/swap"
 )
 
 const (
@@ -115,6 +124,12 @@ const (
 	// Default ConfigMap name of CA
 	defaultCAConfigMapName = "kubevirt-ca"
 
+	// Default socket of the read-only node API for host tooling
+	defaultNodeAPISocket = util.VirtPrivateDir + "/node-api.sock"
+
+	// Default token file of the emergency API for break-glass VMI operations
+	defaultEmergencyAPITokenFile = util.VirtPrivateDir + "/emergency-api.token"
+
 	// Default certificate and key paths
 	defaultClientCertFilePath = "/etc/virt-handler/clientcertificates/tls.crt"
 	defaultClientKeyFilePath  = "/etc/virt-handler/clientcertificates/tls.key"
@@ -135,6 +150,9 @@ type virtHandlerApp struct {
 	MaxRequestsInFlight       int
 	domainResyncPeriodSeconds int
 	gracefulShutdownSeconds   int
+	nodeAPISocket             string
+	emergencyAPISocket        string
+	emergencyAPITokenFile     string
 
 	caConfigMapName    string
 	clientCertFilePath string
@@ -288,6 +306,17 @@ func (app *virtHandlerApp) Run() {
 
 	stop := make(chan struct{})
 	defer close(stop)
+
+	// The emergency API resolves the VMIs from the ghost records on the node, so that it serves even when the
+	// cluster API is unreachable and the informers never sync
+	if app.emergencyAPISocket != "" {
+		emergencyAPIServer := emergencyapi.NewServer(&virtcache.GhostRecordGlobalStore)
+		go func() {
+			if err := emergencyAPIServer.Run(app.emergencyAPISocket, app.emergencyAPITokenFile, stop); err != nil {
+				log.Log.Reason(err).Error("failed to serve the emergency API")
+			}
+		}()
+	}
 	var capabilities libvirtxml.Caps
 	var hostCpuModel string
 
@@ -307,6 +336,7 @@ func (app *virtHandlerApp) Run() {
 		nodeLabellerrecorder,
 		capabilities.Host.CPU.Counter,
 		capabilities.Guests,
+		hostNUMACells(capabilities),
 	)
 	if err != nil {
 		panic(err)
@@ -325,6 +355,7 @@ func (app *virtHandlerApp) Run() {
 	}
 
 	downwardMetricsManager := dmetricsmanager.NewDownwardMetricsManager(app.HostOverride)
+	arpSnooper := arpsnoop.New()
 
 	vmController, err := virthandler.NewController(
 		recorder,
@@ -344,8 +375,8 @@ func (app *virtHandlerApp) Run() {
 		downwardMetricsManager,
 		&capabilities,
 		hostCpuModel,
-		netsetup.NewNetConf(app.clusterConfig),
-		netsetup.NewNetStat(),
+		netsetup.NewNetConf(app.clusterConfig, arpSnooper),
+		netsetup.NewNetStat(arpSnooper),
 		netbinding.MemoryCalculator{},
 	)
 	if err != nil {
@@ -402,6 +433,36 @@ func (app *virtHandlerApp) Run() {
 
 	go vmController.Run(10, stop)
 
+	if app.nodeAPISocket != "" {
+		nodeAPIServer := nodeapi.NewServer(vmiSourceInformer.GetStore(), podIsolationDetector)
+		go func() {
+			if err := nodeAPIServer.Run(app.nodeAPISocket, stop); err != nil {
+				log.Log.Reason(err).Error("failed to serve the node API")
+			}
+		}()
+	}
+
+	memoryOverheadCalibrator := memoryoverhead.NewCalibrator(app.virtCli.CoreV1().Nodes(), vmiSourceInformer.GetStore(), app.clusterConfig, netbinding.MemoryCalculator{}, app.HostOverride)
+	go memoryOverheadCalibrator.Run(memoryoverhead.CalibrationInterval, stop)
+
+	cpuFairShareWeigher := cpufairshare.NewWeigher(vmiSourceInformer.GetStore(), app.clusterConfig, app.HostOverride)
+	go cpuFairShareWeigher.Run(cpufairshare.Interval, stop)
+
+	swapManager := swap.NewManager(app.virtCli.CoreV1().Nodes(), app.virtCli.GeneratedKubeVirtClient().KubevirtV1(),
+		vmiSourceInformer.GetStore(), app.clusterConfig, app.HostOverride)
+	go swapManager.Run(swap.Interval, stop)
+
+	nodeMaintenanceEvacuator := nodemaintenance.NewEvacuator(app.virtCli.CoreV1().Nodes(),
+		app.virtCli.GeneratedKubeVirtClient().KubevirtV1(), vmiSourceInformer.GetStore(), app.clusterConfig, app.HostOverride)
+	go nodeMaintenanceEvacuator.Run(nodemaintenance.Interval, stop)
+
+	hugepagesProvisioner := hugepages.NewProvisioner(app.virtCli.CoreV1().Nodes(), app.clusterConfig, app.HostOverride)
+	go hugepagesProvisioner.Run(hugepages.Interval, stop)
+
+	migrationNetworkMonitor := migrationnetwork.NewMonitor(app.virtCli.CoreV1().Nodes(), app.clusterConfig,
+		app.HostOverride, migrationIpAddress)
+	go migrationNetworkMonitor.Run(migrationnetwork.Interval, stop)
+
 	doneCh := make(chan string)
 	defer close(doneCh)
 
@@ -530,6 +591,7 @@ func (app *virtHandlerApp) runServer(errCh chan error, consoleHandler *rest.Cons
 	ws.Route(ws.GET("/v1/namespaces/{namespace}/virtualmachineinstances/{name}/userlist").To(lifecycleHandler.GetUsers).Produces(restful.MIME_JSON).Consumes(restful.MIME_JSON).Returns(http.StatusOK, "OK", v1.VirtualMachineInstanceGuestOSUserList{}))
 	ws.Route(ws.GET("/v1/namespaces/{namespace}/virtualmachineinstances/{name}/filesystemlist").To(lifecycleHandler.GetFilesystems).Produces(restful.MIME_JSON).Consumes(restful.MIME_JSON).Returns(http.StatusOK, "OK", v1.VirtualMachineInstanceFileSystemList{}))
 	ws.Route(ws.GET("/v1/namespaces/{namespace}/virtualmachineinstances/{name}/vsock").Param(restful.QueryParameter("port", "Target VSOCK port")).To(consoleHandler.VSOCKHandler))
+	ws.Route(ws.GET("/v1/namespaces/{namespace}/virtualmachineinstances/{name}/pcap").Param(restful.QueryParameter("interface", "Captured VMI interface")).Param(restful.QueryParameter("duration", "Capture duration")).To(consoleHandler.PacketCaptureHandler))
 	ws.Route(ws.GET("/v1/namespaces/{namespace}/virtualmachineinstances/{name}/sev/fetchcertchain").To(lifecycleHandler.SEVFetchCertChainHandler).Produces(restful.MIME_JSON).Consumes(restful.MIME_JSON).Returns(http.StatusOK, "OK", v1.SEVPlatformInfo{}))
 	ws.Route(ws.GET("/v1/namespaces/{namespace}/virtualmachineinstances/{name}/sev/querylaunchmeasurement").To(lifecycleHandler.SEVQueryLaunchMeasurementHandler).Produces(restful.MIME_JSON).Consumes(restful.MIME_JSON).Returns(http.StatusOK, "OK", v1.SEVMeasurementInfo{}))
 	ws.Route(ws.PUT("/v1/namespaces/{namespace}/virtualmachineinstances/{name}/sev/injectlaunchsecret").To(lifecycleHandler.SEVInjectLaunchSecretHandler))
@@ -608,6 +670,15 @@ func (app *virtHandlerApp) AddFlags() {
 
 	flag.IntVar(&app.gracefulShutdownSeconds, "graceful-shutdown-seconds", defaultGracefulShutdownSeconds,
 		"The number of seconds to wait for existing migration connections to close before shutting down virt-handler.")
+
+	flag.StringVar(&app.nodeAPISocket, "node-api-socket", defaultNodeAPISocket,
+		"The UNIX socket of the read-only node API describing the VMIs on the node to host tooling. Empty disables the API.")
+
+	flag.StringVar(&app.emergencyAPISocket, "emergency-api-socket", "",
+		"The UNIX socket of the emergency API to pause, unpause or force-stop the VMIs on the node without the cluster API. The API is disabled unless a socket is set.")
+
+	flag.StringVar(&app.emergencyAPITokenFile, "emergency-api-token-file", defaultEmergencyAPITokenFile,
+		"The file the bearer token of the emergency API is written to at startup.")
 }
 
 func (app *virtHandlerApp) setupTLS(factory controller.KubeInformerFactory) error {
@@ -631,3 +702,10 @@ func main() {
 	log.InitializeLogging("virt-handler")
 	app.Run()
 }
+
+func hostNUMACells(capabilities libvirtxml.Caps) []libvirtxml.CapsHostNUMACell {
+	if capabilities.Host.NUMA == nil || capabilities.Host.NUMA.Cells == nil {
+		return nil
+	}
+	return capabilities.Host.NUMA.Cells.Cells
+}
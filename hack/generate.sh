@@ -22,6 +22,7 @@ swagger-doc -in ${KUBEVIRT_DIR}/staging/src/kubevirt.io/api/export/v1alpha1/type
 swagger-doc -in ${KUBEVIRT_DIR}/staging/src/kubevirt.io/api/export/v1beta1/types.go
 swagger-doc -in ${KUBEVIRT_DIR}/staging/src/kubevirt.io/api/clone/v1alpha1/types.go
 swagger-doc -in ${KUBEVIRT_DIR}/staging/src/kubevirt.io/api/clone/v1beta1/types.go
+swagger-doc -in ${KUBEVIRT_DIR}/staging/src/kubevirt.io/api/prefetch/v1alpha1/types.go
 
 deepcopy-gen \
     --bounding-dirs kubevirt.io/api \
@@ -38,6 +39,7 @@ deepcopy-gen \
     kubevirt.io/api/migrations/v1alpha1 \
     kubevirt.io/api/clone/v1alpha1 \
     kubevirt.io/api/clone/v1beta1 \
+    kubevirt.io/api/prefetch/v1alpha1 \
     kubevirt.io/api/core/v1
 
 defaulter-gen \
@@ -66,6 +68,7 @@ openapi-gen \
     kubevirt.io/api/instancetype/v1beta1 \
     kubevirt.io/api/migrations/v1alpha1 \
     kubevirt.io/api/pool/v1alpha1 \
+    kubevirt.io/api/prefetch/v1alpha1 \
     kubevirt.io/api/snapshot/v1alpha1 \
     kubevirt.io/api/snapshot/v1beta1 \
     kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1
@@ -88,7 +91,7 @@ fi
 
 client-gen --clientset-name kubevirt \
     --input-base kubevirt.io/api \
-    --input core/v1,export/v1alpha1,export/v1beta1,snapshot/v1alpha1,snapshot/v1beta1,instancetype/v1alpha1,instancetype/v1alpha2,instancetype/v1beta1,pool/v1alpha1,migrations/v1alpha1,clone/v1alpha1,clone/v1beta1 \
+    --input core/v1,export/v1alpha1,export/v1beta1,snapshot/v1alpha1,snapshot/v1beta1,instancetype/v1alpha1,instancetype/v1alpha2,instancetype/v1beta1,pool/v1alpha1,migrations/v1alpha1,clone/v1alpha1,clone/v1beta1,prefetch/v1alpha1 \
     --output-dir ${KUBEVIRT_DIR}/staging/src/kubevirt.io/client-go \
     --output-pkg ${CLIENT_GEN_BASE} \
     --go-header-file ${KUBEVIRT_DIR}/hack/boilerplate/boilerplate.go.txt
@@ -159,6 +162,9 @@ deepcopy-gen \
     GOFLAGS= controller-gen crd paths=../api/clone/v1alpha1/
     GOFLAGS= controller-gen crd paths=../api/clone/v1beta1/
 
+    #include prefetch
+    GOFLAGS= controller-gen crd paths=../api/prefetch/v1alpha1/
+
     #remove some weird stuff from controller-gen
     cd config/crd
     for file in *; do
@@ -270,6 +270,16 @@ func (d *VirtualMachineInstanceMigrationConditionManager) HasCondition(migration
 	return false
 }
 
+func (d *VirtualMachineInstanceMigrationConditionManager) GetCondition(migration *v1.VirtualMachineInstanceMigration,
+	cond v1.VirtualMachineInstanceMigrationConditionType) *v1.VirtualMachineInstanceMigrationCondition {
+	for _, c := range migration.Status.Conditions {
+		if c.Type == cond {
+			return &c
+		}
+	}
+	return nil
+}
+
 func (d *VirtualMachineInstanceMigrationConditionManager) HasConditionWithStatus(migration *v1.VirtualMachineInstanceMigration, cond v1.VirtualMachineInstanceMigrationConditionType, status k8sv1.ConditionStatus) bool {
 	for _, c := range migration.Status.Conditions {
 		if c.Type == cond {
@@ -44,6 +44,8 @@ const (
 	// BurstReplicas is the maximum amount of requests in a row for CRUD operations on resources by controllers,
 	// to avoid unintentional DoS
 	BurstReplicas uint = 250
+
+	istioProxyContainerName = "istio-proxy"
 )
 
 // Reasons for vmi events
@@ -80,6 +82,8 @@ const (
 	FailedGuaranteePodResourcesReason = "FailedGuaranteeResources"
 	// FailedGatherhingClusterTopologyHints is added if the cluster topology hints can't be collected for a VMI by virt-controller
 	FailedGatherhingClusterTopologyHints = "FailedGatherhingClusterTopologyHints"
+	// FailedPlacementHintsReason is added when the external placement service can't be consulted and its failure policy is Fail
+	FailedPlacementHintsReason = "FailedPlacementHints"
 	// FailedPvcNotFoundReason is added in an event
 	// when a PVC for a volume was not found.
 	FailedPvcNotFoundReason = "FailedPvcNotFound"
@@ -89,6 +93,8 @@ const (
 	FailedMigrationReason = "FailedMigration"
 	// SuccessfulAbortMigrationReason is added when an attempt to abort migration completes successfully
 	SuccessfulAbortMigrationReason = "SuccessfulAbortMigration"
+	// CancelledMigrationReason is added when a migration is cancelled through the cancel subresource
+	CancelledMigrationReason = "CancelledMigration"
 	// MigrationTargetPodUnschedulable is added a migration target pod enters Unschedulable phase
 	MigrationTargetPodUnschedulable = "migrationTargetPodUnschedulable"
 	// FailedAbortMigrationReason is added when an attempt to abort migration fails
@@ -201,6 +207,33 @@ func DataVolumeKey(dataVolume *cdiv1.DataVolume) string {
 	return fmt.Sprintf("%v/%v", dataVolume.Namespace, dataVolume.Name)
 }
 
+// IsVolumePreparationInFlight returns true while the DataVolume is cloned or imported
+func IsVolumePreparationInFlight(dataVolume *cdiv1.DataVolume) bool {
+	if dataVolume.DeletionTimestamp != nil {
+		return false
+	}
+	switch dataVolume.Status.Phase {
+	case cdiv1.Succeeded, cdiv1.Failed, cdiv1.Unknown, cdiv1.Paused,
+		cdiv1.WaitForFirstConsumer, cdiv1.PendingPopulation,
+		cdiv1.UploadScheduled, cdiv1.UploadReady:
+		return false
+	}
+	return true
+}
+
+// IsPersistentVolumeClaimResizing returns true while the PVC requests more storage than its capacity
+func IsPersistentVolumeClaimResizing(pvc *k8sv1.PersistentVolumeClaim) bool {
+	if pvc.DeletionTimestamp != nil {
+		return false
+	}
+	capacity, exists := pvc.Status.Capacity[k8sv1.ResourceStorage]
+	if !exists {
+		return false
+	}
+	request, exists := pvc.Spec.Resources.Requests[k8sv1.ResourceStorage]
+	return exists && request.Cmp(capacity) > 0
+}
+
 func VirtualMachineInstanceKeys(vmis []*v1.VirtualMachineInstance) []string {
 	keys := []string{}
 	for _, vmi := range vmis {
@@ -505,13 +538,16 @@ func IsPodReady(pod *k8sv1.Pod) bool {
 			if containerStatus.State.Running == nil {
 				return false
 			}
-		} else if containerStatus.Name == "istio-proxy" {
+		} else if containerStatus.Name == istioProxyContainerName {
 			// When using istio the istio-proxy container will not be ready
 			// until there is a service pointing to this pod.
-			// We need to start the VM anyway
+			// We need to start the VM anyway, unless asked to wait for the proxy
 			if containerStatus.State.Running == nil {
 				return false
 			}
+			if WaitsForMeshProxy(pod) && !containerStatus.Ready {
+				return false
+			}
 
 		} else if containerStatus.Ready == false {
 			return false
@@ -521,6 +557,24 @@ func IsPodReady(pod *k8sv1.Pod) bool {
 	return pod.Status.Phase == k8sv1.PodRunning
 }
 
+// WaitsForMeshProxy returns true if the guest of the pod should only run along with a ready service mesh proxy
+func WaitsForMeshProxy(pod *k8sv1.Pod) bool {
+	return strings.EqualFold(pod.Annotations[v1.WaitForMeshProxyAnnotation], "true")
+}
+
+// IsMeshProxyNotReady returns true if the pod waits for its service mesh proxy and the proxy is not ready
+func IsMeshProxyNotReady(pod *k8sv1.Pod) bool {
+	if !WaitsForMeshProxy(pod) {
+		return false
+	}
+	for _, containerStatus := range pod.Status.ContainerStatuses {
+		if containerStatus.Name == istioProxyContainerName {
+			return !containerStatus.Ready
+		}
+	}
+	return false
+}
+
 func IsPodDownOrGoingDown(pod *k8sv1.Pod) bool {
 	return PodIsDown(pod) || isComputeContainerDown(pod) || pod.DeletionTimestamp != nil
 }
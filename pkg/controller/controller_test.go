@@ -104,6 +104,38 @@ var _ = Describe("Controller", func() {
 				Expect(controller.IsPodReady(pod)).To(BeFalse())
 			})
 
+			DescribeTable("with a running but not ready istio-proxy container should return", func(annotations map[string]string, matcher gomegaTypes.GomegaMatcher) {
+				pod := &k8sv1.Pod{
+					ObjectMeta: metav1.ObjectMeta{
+						Annotations: annotations,
+					},
+					Status: k8sv1.PodStatus{
+						Phase: k8sv1.PodRunning,
+						ContainerStatuses: []k8sv1.ContainerStatus{
+							{
+								Name: "compute",
+								State: k8sv1.ContainerState{
+									Running: &k8sv1.ContainerStateRunning{},
+								},
+							},
+							{
+								Name: "istio-proxy",
+								State: k8sv1.ContainerState{
+									Running: &k8sv1.ContainerStateRunning{},
+								},
+								Ready: false,
+							},
+						},
+					},
+				}
+				Expect(controller.IsPodReady(pod)).To(matcher)
+				Expect(controller.IsMeshProxyNotReady(pod)).ToNot(matcher)
+			},
+				Entry("true without the wait for mesh proxy annotation", nil, BeTrue()),
+				Entry("false with the wait for mesh proxy annotation",
+					map[string]string{v1.WaitForMeshProxyAnnotation: "true"}, BeFalse()),
+			)
+
 			It("should return false if the a container reports that it is not ready", func() {
 				pod := &k8sv1.Pod{
 					Status: k8sv1.PodStatus{
@@ -23,6 +23,7 @@ import (
 	"errors"
 	"fmt"
 	"math/rand"
+	"net"
 	"sync"
 	"time"
 
@@ -39,6 +40,7 @@ import (
 	appsv1 "k8s.io/api/apps/v1"
 	batchv1 "k8s.io/api/batch/v1"
 	k8sv1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
 	networkingv1 "k8s.io/api/networking/v1"
 	policyv1 "k8s.io/api/policy/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
@@ -62,6 +64,7 @@ import (
 	"kubevirt.io/api/migrations"
 	migrationsv1 "kubevirt.io/api/migrations/v1alpha1"
 	poolv1 "kubevirt.io/api/pool/v1alpha1"
+	prefetchv1 "kubevirt.io/api/prefetch/v1alpha1"
 	snapshotv1 "kubevirt.io/api/snapshot/v1beta1"
 	"kubevirt.io/client-go/kubecli"
 	"kubevirt.io/client-go/log"
@@ -135,12 +138,18 @@ type KubeInformerFactory interface {
 	// Watches VirtualMachineRestore objects
 	VirtualMachineRestore() cache.SharedIndexInformer
 
+	// Watches VirtualMachineSnapshotSchedule objects
+	VirtualMachineSnapshotSchedule() cache.SharedIndexInformer
+
 	// Watches MigrationPolicy objects
 	MigrationPolicy() cache.SharedIndexInformer
 
 	// Watches VirtualMachineClone objects
 	VirtualMachineClone() cache.SharedIndexInformer
 
+	// Watches ImagePrefetch objects
+	ImagePrefetch() cache.SharedIndexInformer
+
 	// Watches VirtualMachineInstancetype objects
 	VirtualMachineInstancetype() cache.SharedIndexInformer
 
@@ -168,6 +177,15 @@ type KubeInformerFactory interface {
 	// Watches for the kubevirt export service
 	ExportService() cache.SharedIndexInformer
 
+	// Watches for the services exposing metrics exporters in guests
+	GuestMetricsService() cache.SharedIndexInformer
+
+	// Watches for the endpoint slices of the services exposing metrics exporters in guests
+	GuestMetricsEndpointSlice() cache.SharedIndexInformer
+
+	// Watches for the network policies enforcing the firewall of VMIs
+	FirewallNetworkPolicy() cache.SharedIndexInformer
+
 	// ConfigMaps which are managed by the operator
 	OperatorConfigMap() cache.SharedIndexInformer
 
@@ -413,6 +431,10 @@ func (f *kubeInformerFactory) Namespace() cache.SharedIndexInformer {
 	})
 }
 
+// StartingVMIIndex indexes the VMIs whose launcher pod is being scheduled or started by their namespace.
+// VMIs whose pod the scheduler reported as unschedulable are left out, they could hold a start slot forever.
+const StartingVMIIndex = "starting"
+
 func GetVMIInformerIndexers() cache.Indexers {
 	return cache.Indexers{
 		cache.NamespaceIndex: cache.MetaNamespaceIndexFunc,
@@ -445,6 +467,20 @@ func GetVMIInformerIndexers() cache.Indexers {
 			}
 			return pvcs, nil
 		},
+		StartingVMIIndex: func(obj interface{}) ([]string, error) {
+			vmi, ok := obj.(*kubev1.VirtualMachineInstance)
+			if !ok {
+				return nil, unexpectedObjectError
+			}
+			if vmi.DeletionTimestamp != nil || !(vmi.IsScheduling() || vmi.IsScheduled()) {
+				return nil, nil
+			}
+			if NewVirtualMachineInstanceConditionManager().HasConditionWithStatusAndReason(vmi,
+				kubev1.VirtualMachineInstanceConditionType(k8sv1.PodScheduled), k8sv1.ConditionFalse, k8sv1.PodReasonUnschedulable) {
+				return nil, nil
+			}
+			return []string{vmi.Namespace}, nil
+		},
 	}
 }
 
@@ -566,6 +602,22 @@ func GetVirtualMachineInformerIndexers() cache.Indexers {
 			}
 			return pvcs, nil
 		},
+		"macAddress": func(obj interface{}) ([]string, error) {
+			vm, ok := obj.(*kubev1.VirtualMachine)
+			if !ok {
+				return nil, unexpectedObjectError
+			}
+			if vm.Spec.Template == nil {
+				return nil, nil
+			}
+			var macAddresses []string
+			for _, iface := range vm.Spec.Template.Spec.Domain.Devices.Interfaces {
+				if mac, err := net.ParseMAC(iface.MacAddress); err == nil {
+					macAddresses = append(macAddresses, mac.String())
+				}
+			}
+			return macAddresses, nil
+		},
 	}
 }
 
@@ -644,6 +696,18 @@ func GetVirtualMachineSnapshotInformerIndexers() cache.Indexers {
 				return []string{fmt.Sprintf("%s/%s", vms.Namespace, vms.Spec.Source.Name)}, nil
 			}
 
+			return nil, nil
+		},
+		"schedule": func(obj interface{}) ([]string, error) {
+			vms, ok := obj.(*snapshotv1.VirtualMachineSnapshot)
+			if !ok {
+				return nil, unexpectedObjectError
+			}
+
+			if schedule, ok := vms.Labels[snapshotv1.SnapshotScheduleLabel]; ok {
+				return []string{fmt.Sprintf("%s/%s", vms.Namespace, schedule)}, nil
+			}
+
 			return nil, nil
 		},
 	}
@@ -709,6 +773,13 @@ func (f *kubeInformerFactory) VirtualMachineRestore() cache.SharedIndexInformer
 	})
 }
 
+func (f *kubeInformerFactory) VirtualMachineSnapshotSchedule() cache.SharedIndexInformer {
+	return f.getInformer("vmSnapshotScheduleInformer", func() cache.SharedIndexInformer {
+		lw := cache.NewListWatchFromClient(f.clientSet.GeneratedKubeVirtClient().SnapshotV1beta1().RESTClient(), "virtualmachinesnapshotschedules", k8sv1.NamespaceAll, fields.Everything())
+		return cache.NewSharedIndexInformer(lw, &snapshotv1.VirtualMachineSnapshotSchedule{}, f.defaultResync, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+	})
+}
+
 func (f *kubeInformerFactory) MigrationPolicy() cache.SharedIndexInformer {
 	return f.getInformer("migrationPolicyInformer", func() cache.SharedIndexInformer {
 		lw := cache.NewListWatchFromClient(f.clientSet.GeneratedKubeVirtClient().MigrationsV1alpha1().RESTClient(), migrations.ResourceMigrationPolicies, k8sv1.NamespaceAll, fields.Everything())
@@ -813,6 +884,13 @@ func (f *kubeInformerFactory) VirtualMachineClone() cache.SharedIndexInformer {
 	})
 }
 
+func (f *kubeInformerFactory) ImagePrefetch() cache.SharedIndexInformer {
+	return f.getInformer("imagePrefetchInformer", func() cache.SharedIndexInformer {
+		lw := cache.NewListWatchFromClient(f.clientSet.GeneratedKubeVirtClient().PrefetchV1alpha1().RESTClient(), "imageprefetches", k8sv1.NamespaceAll, fields.Everything())
+		return cache.NewSharedIndexInformer(lw, &prefetchv1.ImagePrefetch{}, f.defaultResync, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+	})
+}
+
 func (f *kubeInformerFactory) VirtualMachineInstancetype() cache.SharedIndexInformer {
 	return f.getInformer("vmInstancetypeInformer", func() cache.SharedIndexInformer {
 		lw := cache.NewListWatchFromClient(f.clientSet.GeneratedKubeVirtClient().InstancetypeV1beta1().RESTClient(), instancetypeapi.PluralResourceName, k8sv1.NamespaceAll, fields.Everything())
@@ -844,10 +922,35 @@ func (f *kubeInformerFactory) VirtualMachineClusterPreference() cache.SharedInde
 func (f *kubeInformerFactory) DataVolume() cache.SharedIndexInformer {
 	return f.getInformer("dataVolumeInformer", func() cache.SharedIndexInformer {
 		lw := cache.NewListWatchFromClient(f.clientSet.CdiClient().CdiV1beta1().RESTClient(), "datavolumes", k8sv1.NamespaceAll, fields.Everything())
-		return cache.NewSharedIndexInformer(lw, &cdiv1.DataVolume{}, f.defaultResync, cache.Indexers{})
+		return cache.NewSharedIndexInformer(lw, &cdiv1.DataVolume{}, f.defaultResync, GetDataVolumeInformerIndexers())
 	})
 }
 
+// VolumePreparationIndex indexes the DataVolumes which are cloned or imported, and the PVCs which
+// are resized, by the storage class in their spec
+const VolumePreparationIndex = "volumePreparation"
+
+func GetDataVolumeInformerIndexers() cache.Indexers {
+	return cache.Indexers{
+		VolumePreparationIndex: func(obj interface{}) ([]string, error) {
+			dataVolume, ok := obj.(*cdiv1.DataVolume)
+			if !ok {
+				return nil, unexpectedObjectError
+			}
+			if !IsVolumePreparationInFlight(dataVolume) {
+				return nil, nil
+			}
+			if dataVolume.Spec.PVC != nil && dataVolume.Spec.PVC.StorageClassName != nil {
+				return []string{*dataVolume.Spec.PVC.StorageClassName}, nil
+			}
+			if dataVolume.Spec.Storage != nil && dataVolume.Spec.Storage.StorageClassName != nil {
+				return []string{*dataVolume.Spec.Storage.StorageClassName}, nil
+			}
+			return []string{""}, nil
+		},
+	}
+}
+
 func (f *kubeInformerFactory) DummyDataVolume() cache.SharedIndexInformer {
 	return f.getInformer("fakeDataVolumeInformer", func() cache.SharedIndexInformer {
 		informer, _ := testutils.NewFakeInformerFor(&cdiv1.DataVolume{})
@@ -964,14 +1067,75 @@ func (f *kubeInformerFactory) ExportService() cache.SharedIndexInformer {
 	})
 }
 
+func (f *kubeInformerFactory) GuestMetricsService() cache.SharedIndexInformer {
+	return f.getInformer("guestMetricsService", func() cache.SharedIndexInformer {
+		labelSelector, err := labels.Parse(fmt.Sprintf("%s=%s", kubev1.AppLabel, kubev1.GuestMetricsAppLabelValue))
+		if err != nil {
+			panic(err)
+		}
+
+		lw := NewListWatchFromClient(f.clientSet.CoreV1().RESTClient(), "services",
+			k8sv1.NamespaceAll, fields.Everything(), labelSelector)
+		return cache.NewSharedIndexInformer(lw, &k8sv1.Service{}, f.defaultResync,
+			cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+	})
+}
+
+func (f *kubeInformerFactory) GuestMetricsEndpointSlice() cache.SharedIndexInformer {
+	return f.getInformer("guestMetricsEndpointSlice", func() cache.SharedIndexInformer {
+		labelSelector, err := labels.Parse(fmt.Sprintf("%s=%s", kubev1.AppLabel, kubev1.GuestMetricsAppLabelValue))
+		if err != nil {
+			panic(err)
+		}
+
+		lw := NewListWatchFromClient(f.clientSet.DiscoveryV1().RESTClient(), "endpointslices",
+			k8sv1.NamespaceAll, fields.Everything(), labelSelector)
+		return cache.NewSharedIndexInformer(lw, &discoveryv1.EndpointSlice{}, f.defaultResync,
+			cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+	})
+}
+
+func (f *kubeInformerFactory) FirewallNetworkPolicy() cache.SharedIndexInformer {
+	return f.getInformer("firewallNetworkPolicy", func() cache.SharedIndexInformer {
+		labelSelector, err := labels.Parse(fmt.Sprintf("%s=%s", kubev1.AppLabel, kubev1.FirewallAppLabelValue))
+		if err != nil {
+			panic(err)
+		}
+
+		lw := NewListWatchFromClient(f.clientSet.NetworkingV1().RESTClient(), "networkpolicies",
+			k8sv1.NamespaceAll, fields.Everything(), labelSelector)
+		return cache.NewSharedIndexInformer(lw, &networkingv1.NetworkPolicy{}, f.defaultResync,
+			cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+	})
+}
+
 func (f *kubeInformerFactory) PersistentVolumeClaim() cache.SharedIndexInformer {
 	return f.getInformer("persistentVolumeClaimInformer", func() cache.SharedIndexInformer {
 		restClient := f.clientSet.CoreV1().RESTClient()
 		lw := cache.NewListWatchFromClient(restClient, "persistentvolumeclaims", k8sv1.NamespaceAll, fields.Everything())
-		return cache.NewSharedIndexInformer(lw, &k8sv1.PersistentVolumeClaim{}, f.defaultResync, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+		return cache.NewSharedIndexInformer(lw, &k8sv1.PersistentVolumeClaim{}, f.defaultResync, GetPersistentVolumeClaimInformerIndexers())
 	})
 }
 
+func GetPersistentVolumeClaimInformerIndexers() cache.Indexers {
+	return cache.Indexers{
+		cache.NamespaceIndex: cache.MetaNamespaceIndexFunc,
+		VolumePreparationIndex: func(obj interface{}) ([]string, error) {
+			pvc, ok := obj.(*k8sv1.PersistentVolumeClaim)
+			if !ok {
+				return nil, unexpectedObjectError
+			}
+			if !IsPersistentVolumeClaimResizing(pvc) {
+				return nil, nil
+			}
+			if pvc.Spec.StorageClassName != nil {
+				return []string{*pvc.Spec.StorageClassName}, nil
+			}
+			return []string{""}, nil
+		},
+	}
+}
+
 func GetControllerRevisionInformerIndexers() cache.Indexers {
 	return cache.Indexers{
 		"vm": func(obj interface{}) ([]string, error) {
@@ -37,6 +37,10 @@ const (
 	ephemeralDiskPVCBaseDir         = "/var/run/kubevirt-private/vmi-disks"
 	ephemeralDiskBlockDeviceBaseDir = "/dev"
 	ephemeralDiskFormat             = "raw"
+
+	// DiskDataDir is the directory below the ephemeral disk directory holding
+	// the overlays, one subdirectory per volume.
+	DiskDataDir = "disk-data"
 )
 
 type EphemeralDiskCreatorInterface interface {
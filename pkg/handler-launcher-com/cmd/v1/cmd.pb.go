@@ -364,10 +364,11 @@ func (m *DiskInfo) GetVirtualSize() uint64 {
 }
 
 type ClusterConfig struct {
-	ExpandDisksEnabled        bool `protobuf:"varint,1,opt,name=ExpandDisksEnabled" json:"ExpandDisksEnabled,omitempty"`
-	FreePageReportingDisabled bool `protobuf:"varint,2,opt,name=FreePageReportingDisabled" json:"FreePageReportingDisabled,omitempty"`
-	BochsDisplayForEFIGuests  bool `protobuf:"varint,3,opt,name=BochsDisplayForEFIGuests" json:"BochsDisplayForEFIGuests,omitempty"`
-	SerialConsoleLogDisabled  bool `protobuf:"varint,4,opt,name=SerialConsoleLogDisabled" json:"SerialConsoleLogDisabled,omitempty"`
+	ExpandDisksEnabled            bool `protobuf:"varint,1,opt,name=ExpandDisksEnabled" json:"ExpandDisksEnabled,omitempty"`
+	FreePageReportingDisabled     bool `protobuf:"varint,2,opt,name=FreePageReportingDisabled" json:"FreePageReportingDisabled,omitempty"`
+	BochsDisplayForEFIGuests      bool `protobuf:"varint,3,opt,name=BochsDisplayForEFIGuests" json:"BochsDisplayForEFIGuests,omitempty"`
+	SerialConsoleLogDisabled      bool `protobuf:"varint,4,opt,name=SerialConsoleLogDisabled" json:"SerialConsoleLogDisabled,omitempty"`
+	BlockLatencyHistogramsEnabled bool `protobuf:"varint,5,opt,name=BlockLatencyHistogramsEnabled" json:"BlockLatencyHistogramsEnabled,omitempty"`
 }
 
 func (m *ClusterConfig) Reset()                    { *m = ClusterConfig{} }
@@ -403,6 +404,13 @@ func (m *ClusterConfig) GetSerialConsoleLogDisabled() bool {
 	return false
 }
 
+func (m *ClusterConfig) GetBlockLatencyHistogramsEnabled() bool {
+	if m != nil {
+		return m.BlockLatencyHistogramsEnabled
+	}
+	return false
+}
+
 type InterfaceBindingMigration struct {
 	Method string `protobuf:"bytes,1,opt,name=Method" json:"Method,omitempty"`
 }
@@ -813,8 +821,9 @@ func (m *GuestPingResponse) GetResponse() *Response {
 }
 
 type FreezeRequest struct {
-	Vmi                    *VMI  `protobuf:"bytes,1,opt,name=vmi" json:"vmi,omitempty"`
-	UnfreezeTimeoutSeconds int32 `protobuf:"varint,2,opt,name=unfreezeTimeoutSeconds" json:"unfreezeTimeoutSeconds,omitempty"`
+	Vmi                    *VMI     `protobuf:"bytes,1,opt,name=vmi" json:"vmi,omitempty"`
+	UnfreezeTimeoutSeconds int32    `protobuf:"varint,2,opt,name=unfreezeTimeoutSeconds" json:"unfreezeTimeoutSeconds,omitempty"`
+	Volumes                []string `protobuf:"bytes,3,rep,name=volumes" json:"volumes,omitempty"`
 }
 
 func (m *FreezeRequest) Reset()                    { *m = FreezeRequest{} }
@@ -836,6 +845,13 @@ func (m *FreezeRequest) GetUnfreezeTimeoutSeconds() int32 {
 	return 0
 }
 
+func (m *FreezeRequest) GetVolumes() []string {
+	if m != nil {
+		return m.Volumes
+	}
+	return nil
+}
+
 type MemoryDumpRequest struct {
 	Vmi      *VMI   `protobuf:"bytes,1,opt,name=vmi" json:"vmi,omitempty"`
 	DumpPath string `protobuf:"bytes,2,opt,name=dumpPath" json:"dumpPath,omitempty"`
@@ -2031,119 +2047,120 @@ var _Cmd_serviceDesc = grpc.ServiceDesc{
 func init() { proto.RegisterFile("pkg/handler-launcher-com/cmd/v1/cmd.proto", fileDescriptor0) }
 
 var fileDescriptor0 = []byte{
-	// 1809 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x59, 0x5f, 0x6f, 0x1b, 0xc7,
+	// 1835 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x59, 0xef, 0x6e, 0x1b, 0xc7,
 	0x11, 0x17, 0x45, 0x4a, 0x22, 0x47, 0x7f, 0x62, 0xaf, 0x25, 0xe5, 0xa4, 0xd6, 0xb6, 0xba, 0x28,
-	0x0c, 0xa5, 0x48, 0xa4, 0xda, 0x71, 0x82, 0xc2, 0x28, 0x02, 0x47, 0x14, 0xa5, 0x28, 0xb1, 0x6c,
-	0xe6, 0x28, 0xc9, 0x68, 0xda, 0x20, 0x58, 0xdd, 0xad, 0xa8, 0xad, 0xee, 0x76, 0x99, 0xdb, 0x3d,
-	0xd6, 0xcc, 0x53, 0x81, 0x14, 0x7d, 0x28, 0xd0, 0x0f, 0xd6, 0x4f, 0xd0, 0xb7, 0x7e, 0x8b, 0xbe,
-	0x07, 0xbb, 0xb7, 0x47, 0x1d, 0x79, 0x77, 0x92, 0x05, 0xf2, 0x89, 0xb7, 0x3b, 0x33, 0xbf, 0x99,
-	0xdd, 0x9d, 0x99, 0xfd, 0xdd, 0x11, 0x3e, 0xea, 0x5d, 0x75, 0x77, 0x2f, 0x09, 0xf7, 0x03, 0x1a,
-	0x7d, 0x12, 0x90, 0x98, 0x7b, 0x97, 0x34, 0xfa, 0xc4, 0x13, 0xe1, 0xae, 0x17, 0xfa, 0xbb, 0xfd,
-	0xa7, 0xfa, 0x67, 0xa7, 0x17, 0x09, 0x25, 0xd0, 0x07, 0x57, 0xf1, 0x39, 0xed, 0xb3, 0x48, 0xed,
-	0xe8, 0xb9, 0xfe, 0x53, 0x7c, 0x01, 0x0f, 0xbe, 0xa5, 0x61, 0x7c, 0x46, 0x23, 0xc9, 0x04, 0x77,
-	0xa9, 0xec, 0x09, 0x2e, 0x29, 0xfa, 0x0c, 0xea, 0x91, 0x7d, 0x76, 0x2a, 0x5b, 0x95, 0xed, 0xc5,
-	0x67, 0x1b, 0x3b, 0x63, 0xa6, 0x3b, 0xa9, 0xb2, 0x3b, 0x54, 0x45, 0x0e, 0x2c, 0xf4, 0x13, 0x24,
-	0x67, 0x76, 0xab, 0xb2, 0xdd, 0x70, 0xd3, 0x21, 0x7e, 0x0c, 0xd5, 0xb3, 0xe3, 0x23, 0xa3, 0x10,
-	0xb2, 0xaf, 0xa5, 0xe0, 0x06, 0x76, 0xc9, 0x4d, 0x87, 0xf8, 0x29, 0x54, 0x9b, 0xed, 0x53, 0xb4,
-	0x02, 0xb3, 0xcc, 0x37, 0xb2, 0x65, 0x77, 0x96, 0xf9, 0x68, 0x13, 0xea, 0x92, 0x9d, 0x07, 0x8c,
-	0x77, 0xa5, 0x33, 0xbb, 0x55, 0xdd, 0x5e, 0x76, 0x87, 0x63, 0xbc, 0x0b, 0x0b, 0x9d, 0xe4, 0x39,
-	0x67, 0xb6, 0x0a, 0x73, 0x7d, 0x12, 0xc4, 0xd4, 0x84, 0x51, 0x73, 0x93, 0x01, 0x6e, 0xc1, 0x5c,
-	0x9b, 0x74, 0xa9, 0xd4, 0x62, 0x4f, 0xc4, 0x5c, 0x19, 0x8b, 0x9a, 0x9b, 0x0c, 0x10, 0x82, 0x5a,
-	0xcc, 0x99, 0xb2, 0xa1, 0x9b, 0x67, 0x3d, 0x27, 0xd9, 0x4f, 0xd4, 0xa9, 0x1a, 0x68, 0xf3, 0x8c,
-	0x9f, 0xc3, 0xfc, 0x31, 0x0d, 0x45, 0x34, 0x40, 0xeb, 0x30, 0x4f, 0xc2, 0x0c, 0x90, 0x1d, 0x15,
-	0x21, 0xe1, 0xff, 0x56, 0xa0, 0xd6, 0xa4, 0x41, 0x90, 0x8b, 0x75, 0x17, 0xe6, 0x43, 0x03, 0x67,
-	0xd4, 0x17, 0x9f, 0x7d, 0x98, 0xdb, 0xe9, 0xc4, 0x9b, 0x6b, 0xd5, 0xd0, 0xc7, 0x30, 0xd7, 0xd3,
-	0xcb, 0x70, 0xaa, 0x5b, 0xd5, 0xed, 0xc5, 0x67, 0xeb, 0x39, 0x7d, 0xb3, 0x48, 0x37, 0x51, 0x42,
-	0x9f, 0x43, 0xc3, 0x67, 0x52, 0x11, 0xee, 0x51, 0xe9, 0xd4, 0x8c, 0x85, 0x93, 0xb3, 0xb0, 0xfb,
-	0xe8, 0x5e, 0xab, 0xa2, 0x6d, 0xa8, 0x79, 0xbd, 0x58, 0x3a, 0x73, 0xc6, 0x64, 0x35, 0x67, 0xd2,
-	0x6c, 0x9f, 0xba, 0x46, 0x03, 0xbf, 0x84, 0xfa, 0x89, 0xe8, 0x89, 0x40, 0x74, 0x07, 0xe8, 0x39,
-	0x00, 0x8f, 0x43, 0xf2, 0x83, 0x47, 0x83, 0x40, 0x3a, 0x15, 0x63, 0xbb, 0x96, 0xb7, 0xa5, 0x41,
-	0xe0, 0x36, 0xb4, 0xa2, 0x7e, 0x92, 0xf8, 0x5f, 0x15, 0x98, 0xef, 0x1c, 0xef, 0x31, 0x21, 0x11,
-	0x86, 0xa5, 0x90, 0xf0, 0xf8, 0x82, 0x78, 0x2a, 0x8e, 0x68, 0x64, 0xf6, 0xa9, 0xe1, 0x8e, 0xcc,
-	0xe9, 0x2c, 0xea, 0x45, 0xc2, 0x8f, 0xbd, 0x74, 0x87, 0xd3, 0x61, 0x36, 0x01, 0xab, 0x23, 0x09,
-	0x88, 0xee, 0x41, 0x55, 0x5e, 0xc5, 0x4e, 0xcd, 0xcc, 0xea, 0x47, 0x7d, 0x78, 0x17, 0x24, 0x64,
-	0xc1, 0xc0, 0x99, 0x33, 0x93, 0x76, 0x84, 0xff, 0x59, 0x81, 0xfa, 0x3e, 0x93, 0x57, 0x47, 0xfc,
-	0x42, 0x18, 0x25, 0x11, 0x85, 0x44, 0xd9, 0x40, 0xec, 0x08, 0x6d, 0xc1, 0xe2, 0x39, 0xf1, 0xae,
-	0x18, 0xef, 0x1e, 0xb0, 0x80, 0xda, 0x30, 0xb2, 0x53, 0xe8, 0x11, 0x80, 0x8e, 0x97, 0x04, 0x9d,
-	0x34, 0x7f, 0x6a, 0x6e, 0x66, 0x46, 0x23, 0xe8, 0x2d, 0x49, 0x15, 0x6a, 0x46, 0x21, 0x3b, 0x85,
-	0xff, 0x5f, 0x81, 0xe5, 0x66, 0x10, 0x4b, 0x45, 0xa3, 0xa6, 0xe0, 0x17, 0xac, 0x8b, 0x76, 0x00,
-	0xb5, 0xde, 0xf5, 0x08, 0xf7, 0x75, 0x7c, 0xb2, 0xc5, 0xc9, 0x79, 0x40, 0x93, 0x54, 0xaa, 0xbb,
-	0x05, 0x12, 0xf4, 0x47, 0xd8, 0x38, 0x88, 0x28, 0xd5, 0xf9, 0xe0, 0xd2, 0x9e, 0x88, 0x14, 0xe3,
-	0xdd, 0x7d, 0x26, 0x13, 0xb3, 0x59, 0x63, 0x56, 0xae, 0x80, 0x5e, 0x80, 0xb3, 0x27, 0xbc, 0x4b,
-	0xb9, 0xcf, 0x64, 0x2f, 0x20, 0x83, 0x03, 0x11, 0xb5, 0x0e, 0x8e, 0x0e, 0x63, 0x2a, 0x95, 0x34,
-	0xeb, 0xa9, 0xbb, 0xa5, 0x72, 0x6d, 0xdb, 0xa1, 0x11, 0x23, 0x41, 0x53, 0x70, 0x29, 0x02, 0xfa,
-	0x4a, 0x5c, 0x3b, 0xae, 0x25, 0xb6, 0x65, 0x72, 0xfc, 0x29, 0x6c, 0x1c, 0x71, 0x45, 0xa3, 0x0b,
-	0xe2, 0xd1, 0x3d, 0xc6, 0x7d, 0xc6, 0xbb, 0xc7, 0xac, 0x1b, 0x11, 0xa5, 0xcf, 0x71, 0x5d, 0x17,
-	0x9f, 0xba, 0x14, 0x7e, 0x7a, 0x20, 0xc9, 0x08, 0xff, 0x6f, 0x01, 0xd6, 0xce, 0x92, 0xcd, 0x3b,
-	0x26, 0xde, 0x25, 0xe3, 0xf4, 0x4d, 0x4f, 0x1b, 0x48, 0xf4, 0x0d, 0xac, 0x8e, 0x0a, 0x92, 0x4c,
-	0xb3, 0x7d, 0x2d, 0x5f, 0x6d, 0x89, 0xd8, 0x2d, 0x34, 0x42, 0xcf, 0x61, 0xed, 0x98, 0x86, 0x7b,
-	0x24, 0x08, 0x84, 0xe0, 0x1d, 0x45, 0x94, 0x6c, 0xd3, 0x88, 0x89, 0x64, 0x37, 0x97, 0xdd, 0x62,
-	0x21, 0xfa, 0x3d, 0x3c, 0x68, 0x47, 0x54, 0xcf, 0x7b, 0x44, 0x51, 0xff, 0x4c, 0x04, 0x71, 0x68,
-	0xeb, 0xb7, 0xe1, 0x16, 0x89, 0x74, 0x03, 0x56, 0xb6, 0xa6, 0xcc, 0x7e, 0x15, 0x35, 0xe0, 0xb4,
-	0xe8, 0xdc, 0xa1, 0x2a, 0xea, 0x40, 0xc3, 0x24, 0x80, 0xce, 0x5d, 0x5b, 0xb9, 0x9f, 0xe5, 0xec,
-	0x0a, 0xb7, 0x69, 0x67, 0x68, 0xd7, 0xe2, 0x2a, 0x1a, 0xb8, 0xd7, 0x38, 0x25, 0x59, 0x37, 0x5f,
-	0x9a, 0x75, 0xfb, 0xb0, 0xec, 0x65, 0xd3, 0xd6, 0x59, 0x30, 0x0b, 0x78, 0x94, 0x6f, 0x03, 0x59,
-	0x2d, 0x77, 0xd4, 0x08, 0xfd, 0x5c, 0x81, 0x0d, 0x96, 0xa6, 0xc1, 0xbe, 0x08, 0x09, 0xe3, 0x5f,
-	0x2a, 0x45, 0xbc, 0xcb, 0x90, 0x72, 0xe5, 0xd4, 0xcd, 0xda, 0x5a, 0xef, 0xb9, 0xb6, 0xa3, 0x32,
-	0x9c, 0x64, 0xad, 0xe5, 0x7e, 0x10, 0x07, 0x34, 0x14, 0x0e, 0x93, 0xd0, 0x69, 0x18, 0xef, 0x5f,
-	0xdc, 0xd5, 0xfb, 0x10, 0x20, 0x71, 0x5b, 0x80, 0xbc, 0xf9, 0x16, 0x56, 0x46, 0x0f, 0x42, 0x37,
-	0xae, 0x2b, 0x3a, 0xb0, 0xd9, 0xae, 0x1f, 0xd1, 0x6e, 0xf6, 0x72, 0x2b, 0x4a, 0x8c, 0xb4, 0x7b,
-	0xd9, 0x7b, 0xef, 0xc5, 0xec, 0x1f, 0x2a, 0x9b, 0xaf, 0xe0, 0xd1, 0xcd, 0xbb, 0x50, 0xe0, 0x68,
-	0xe4, 0x16, 0x6d, 0x64, 0xd1, 0x7e, 0x84, 0x0f, 0x4b, 0x56, 0x55, 0x00, 0xf3, 0x72, 0x34, 0xde,
-	0xdf, 0xe5, 0xe2, 0x2d, 0xad, 0xf6, 0x8c, 0x4b, 0xdc, 0x07, 0x38, 0x3b, 0x3e, 0x72, 0xe9, 0x8f,
-	0xba, 0xc1, 0xa0, 0x27, 0x50, 0xed, 0x87, 0xcc, 0xd6, 0x70, 0xfe, 0x72, 0xd2, 0x9a, 0x5a, 0x01,
-	0xbd, 0x84, 0x05, 0x91, 0x1c, 0x83, 0xf5, 0xfe, 0xe4, 0xfd, 0x0e, 0xcd, 0x4d, 0xcd, 0xf0, 0x09,
-	0xdc, 0xbb, 0x8e, 0xe7, 0x8e, 0xde, 0x9d, 0x51, 0xef, 0x4b, 0xd7, 0xa8, 0x3f, 0x57, 0x60, 0xb1,
-	0xf5, 0x8e, 0x7a, 0x29, 0xe2, 0x23, 0x00, 0xdf, 0x9c, 0xca, 0x6b, 0x12, 0x52, 0xbb, 0x79, 0x99,
-	0x19, 0x8d, 0xd4, 0x14, 0x61, 0x48, 0xb8, 0x9f, 0x5e, 0x79, 0x76, 0xa8, 0xb9, 0xc6, 0x97, 0x51,
-	0x37, 0x6d, 0x26, 0xe6, 0x19, 0x3d, 0x81, 0x15, 0xc5, 0x42, 0x2a, 0x62, 0xd5, 0xa1, 0x9e, 0xe0,
-	0xbe, 0x34, 0x3d, 0x64, 0xce, 0x1d, 0x9b, 0xc5, 0x2b, 0xb0, 0xd4, 0x0a, 0x7b, 0x6a, 0x60, 0xa3,
-	0xc0, 0x5f, 0x40, 0xdd, 0xcd, 0x70, 0x39, 0x19, 0x7b, 0x1e, 0x95, 0xd2, 0x5e, 0x30, 0xe9, 0x50,
-	0x4b, 0x42, 0x2a, 0x25, 0xe9, 0xa6, 0x89, 0x91, 0x0e, 0xf1, 0x0f, 0xb0, 0x92, 0xe4, 0xd6, 0xa4,
-	0x44, 0x72, 0x1d, 0xe6, 0x93, 0xc5, 0x5b, 0x0f, 0x76, 0x84, 0x39, 0x3c, 0x48, 0x1c, 0x98, 0xee,
-	0x3a, 0xa9, 0x97, 0x2d, 0x58, 0xf4, 0xaf, 0xd1, 0xd2, 0x4b, 0x3c, 0x33, 0x85, 0xdf, 0xc1, 0x7d,
-	0x73, 0xa1, 0x99, 0x6a, 0x9a, 0xd0, 0xdb, 0xc7, 0x70, 0xbf, 0x3b, 0x8e, 0x65, 0x7d, 0xe6, 0x05,
-	0xf8, 0x1f, 0x15, 0x58, 0x33, 0xae, 0x4f, 0x25, 0x8d, 0x5e, 0x31, 0xa9, 0x26, 0x75, 0xff, 0x1c,
-	0xd6, 0xba, 0x45, 0x78, 0x36, 0x84, 0x62, 0x21, 0xfe, 0x77, 0x05, 0x1c, 0x13, 0x86, 0xe6, 0x34,
-	0x72, 0x20, 0x15, 0x0d, 0x27, 0xde, 0xf6, 0x17, 0xe0, 0x74, 0x4b, 0x20, 0x6d, 0x30, 0xa5, 0x72,
-	0x3c, 0x80, 0xa5, 0xa4, 0x6c, 0x26, 0x0b, 0x61, 0x13, 0xea, 0xf4, 0x1d, 0x53, 0x4d, 0xe1, 0x27,
-	0x2e, 0xe7, 0xdc, 0xe1, 0x58, 0xe7, 0x9e, 0x54, 0xfe, 0x9b, 0x58, 0x59, 0x0a, 0x69, 0x47, 0xf8,
-	0x3b, 0xb8, 0x67, 0x76, 0xa2, 0xad, 0x89, 0xf2, 0x7b, 0x96, 0x6d, 0xbe, 0x10, 0x67, 0x0b, 0x0b,
-	0xf1, 0x6b, 0x9b, 0x67, 0x09, 0xf6, 0x44, 0x6b, 0xc3, 0x02, 0x96, 0x35, 0xa7, 0xfb, 0x89, 0xde,
-	0xb5, 0x5b, 0x7d, 0x0e, 0xeb, 0x31, 0xbf, 0x30, 0xa6, 0x27, 0x45, 0x41, 0x97, 0x48, 0xf1, 0x5b,
-	0xb8, 0x9f, 0xbc, 0xa1, 0xec, 0xc7, 0x61, 0xef, 0xae, 0x4e, 0x37, 0xa1, 0xee, 0xc7, 0x61, 0xaf,
-	0x4d, 0xd4, 0xa5, 0x3d, 0xfc, 0xe1, 0x18, 0x9f, 0xc3, 0x07, 0x9d, 0xd6, 0xd9, 0x34, 0x6a, 0x4f,
-	0x37, 0x33, 0xda, 0x37, 0xac, 0xc8, 0x36, 0x62, 0x3b, 0xc4, 0x7f, 0xaf, 0xc0, 0xc6, 0x2b, 0xf3,
-	0xce, 0x7c, 0x4c, 0x89, 0x8c, 0x23, 0xaa, 0x2f, 0xc4, 0x29, 0x94, 0x7a, 0x30, 0x8e, 0x69, 0x1d,
-	0xe7, 0x05, 0xf8, 0x7b, 0xcd, 0x77, 0xff, 0x4a, 0x3d, 0x95, 0xc4, 0xd1, 0xa1, 0x5e, 0x44, 0xd5,
-	0xd4, 0xae, 0x9a, 0x67, 0xff, 0x59, 0x85, 0x6a, 0x33, 0xf4, 0xd1, 0x6b, 0x40, 0x9d, 0x01, 0xf7,
-	0x46, 0xaf, 0x3b, 0xf4, 0xab, 0x42, 0xc8, 0xc4, 0xf9, 0x66, 0xf9, 0x62, 0xf1, 0x0c, 0x7a, 0x03,
-	0x0f, 0xda, 0x24, 0x96, 0x74, 0x6a, 0x80, 0xdf, 0xc2, 0xda, 0x29, 0xef, 0x4d, 0x15, 0xb2, 0x03,
-	0xab, 0x49, 0x2d, 0x8c, 0x21, 0xe6, 0xb9, 0xe8, 0x48, 0xc9, 0xdc, 0x0c, 0xea, 0xc2, 0xfa, 0xa9,
-	0xad, 0x84, 0x69, 0x6e, 0xa6, 0x4b, 0x25, 0x55, 0x53, 0x03, 0x3c, 0x01, 0xa7, 0x23, 0x2e, 0x94,
-	0x4b, 0xcf, 0x85, 0x98, 0x1e, 0xaa, 0x0b, 0xeb, 0x9d, 0xcb, 0x58, 0xf9, 0xe2, 0x6f, 0x7c, 0x6a,
-	0x98, 0xaf, 0x01, 0x7d, 0xc3, 0x82, 0x60, 0x6a, 0x78, 0x6d, 0x58, 0xdd, 0xa7, 0x01, 0x55, 0xd3,
-	0x3b, 0x9c, 0xb7, 0xb0, 0x96, 0x50, 0xc0, 0x71, 0xc8, 0xdf, 0xe4, 0x3f, 0xd5, 0x8c, 0x51, 0xc5,
-	0x5b, 0x4f, 0x5d, 0x97, 0xe4, 0xd0, 0xe8, 0x84, 0x44, 0x5d, 0xaa, 0x26, 0x88, 0xf4, 0x4f, 0xf0,
-	0xb0, 0x49, 0xb8, 0x47, 0xc7, 0x76, 0xf3, 0xfa, 0xf5, 0x79, 0xb2, 0xa3, 0x67, 0x5d, 0x4e, 0x82,
-	0x24, 0xc8, 0xb6, 0xf0, 0x9b, 0x01, 0x25, 0x3c, 0xee, 0x4d, 0x80, 0xf9, 0x67, 0x78, 0x7c, 0xc0,
-	0x38, 0x09, 0xd8, 0x78, 0x25, 0x4d, 0x23, 0xe0, 0xd7, 0x80, 0xbe, 0x12, 0xaa, 0x17, 0xc4, 0xdd,
-	0xaf, 0x84, 0x54, 0xfb, 0xb4, 0xcf, 0x3c, 0x2a, 0x27, 0xc0, 0x3b, 0x86, 0xc6, 0x21, 0x55, 0x09,
-	0xfd, 0x44, 0x0f, 0x73, 0x9a, 0x59, 0x22, 0xbd, 0xf9, 0x38, 0xff, 0x4e, 0x36, 0xc2, 0x8b, 0x4d,
-	0x52, 0xad, 0x0c, 0xe1, 0x0c, 0xd9, 0xbc, 0x0d, 0xf3, 0xb7, 0x25, 0x98, 0x23, 0x54, 0xd8, 0xf4,
-	0xbc, 0xa5, 0x43, 0xaa, 0x86, 0xb4, 0xf5, 0x36, 0x58, 0x9c, 0x13, 0xe7, 0x18, 0xaf, 0x01, 0xad,
-	0x1f, 0x52, 0x43, 0x0f, 0x6f, 0x8d, 0xf3, 0x49, 0x31, 0x60, 0x8e, 0x5a, 0xce, 0xa0, 0xbf, 0x98,
-	0x2d, 0xc8, 0xd0, 0xbc, 0xdb, 0xa0, 0x3f, 0x2a, 0x86, 0x2e, 0x22, 0x8a, 0x33, 0x68, 0x0f, 0x6a,
-	0x9a, 0x4e, 0xdd, 0x86, 0x79, 0xe3, 0x99, 0xb7, 0xa0, 0xa6, 0xe9, 0x26, 0xfa, 0x75, 0x1e, 0xe3,
-	0xfa, 0xe5, 0x6d, 0xf3, 0x61, 0x89, 0x34, 0xd3, 0x8c, 0x1b, 0x43, 0x7a, 0x57, 0xd0, 0x34, 0xc6,
-	0x69, 0x65, 0xd9, 0x99, 0x64, 0xd9, 0xa1, 0xa9, 0x1e, 0x67, 0xac, 0x6a, 0x86, 0x2c, 0x0c, 0xe1,
-	0x92, 0x8f, 0xc8, 0x19, 0x8a, 0x76, 0x5b, 0xcf, 0xd3, 0x67, 0x93, 0xf9, 0x6f, 0xe0, 0xee, 0xe9,
-	0x59, 0xf0, 0xc7, 0x82, 0xed, 0x23, 0x39, 0x1a, 0xd2, 0x6c, 0x9f, 0xca, 0x09, 0x2f, 0xbb, 0x1c,
-	0xa6, 0xfd, 0x46, 0x3f, 0xc9, 0x9d, 0x0c, 0x87, 0x54, 0x59, 0x06, 0x7a, 0xdb, 0xf2, 0xb7, 0xf2,
-	0xdf, 0x11, 0x47, 0xa9, 0x2b, 0x9e, 0x41, 0x04, 0x56, 0x0f, 0xa9, 0xca, 0xb1, 0xcd, 0x9b, 0x43,
-	0xcc, 0x7f, 0x2e, 0x29, 0xa5, 0xab, 0x78, 0x06, 0x7d, 0x0f, 0x28, 0xcf, 0x25, 0x51, 0xd1, 0x27,
-	0x97, 0x12, 0xc2, 0x79, 0xe3, 0x96, 0xec, 0xd5, 0xbe, 0x9b, 0xed, 0x3f, 0x3d, 0x9f, 0x37, 0x7f,
-	0x26, 0x7d, 0xfa, 0x4b, 0x00, 0x00, 0x00, 0xff, 0xff, 0x0b, 0xcc, 0xab, 0xa3, 0x79, 0x1a, 0x00,
-	0x00,
+	0x0c, 0xa5, 0x48, 0xa4, 0xda, 0x71, 0x82, 0xc2, 0x28, 0x02, 0x47, 0x14, 0x25, 0x2b, 0x91, 0x6c,
+	0xe6, 0x28, 0xc9, 0x68, 0xda, 0x20, 0x58, 0xdd, 0x2d, 0x4f, 0x5b, 0xdd, 0xed, 0x32, 0xb7, 0x7b,
+	0xac, 0xe9, 0x4f, 0x05, 0x52, 0x14, 0x68, 0x81, 0x3e, 0x51, 0x9f, 0xa0, 0x4f, 0xd0, 0x6f, 0x7d,
+	0x96, 0x62, 0xf7, 0xee, 0xa8, 0x23, 0xef, 0x4e, 0xb2, 0x40, 0x7e, 0xd2, 0xee, 0xce, 0xcc, 0x6f,
+	0x66, 0x77, 0x67, 0x66, 0x7f, 0x3c, 0xc1, 0x27, 0xbd, 0x2b, 0x6f, 0xf7, 0x92, 0x70, 0xd7, 0xa7,
+	0xe1, 0x67, 0x3e, 0x89, 0xb8, 0x73, 0x49, 0xc3, 0xcf, 0x1c, 0x11, 0xec, 0x3a, 0x81, 0xbb, 0xdb,
+	0x7f, 0xaa, 0xff, 0xec, 0xf4, 0x42, 0xa1, 0x04, 0xfa, 0xe8, 0x2a, 0xba, 0xa0, 0x7d, 0x16, 0xaa,
+	0x1d, 0xbd, 0xd6, 0x7f, 0x8a, 0xbb, 0xf0, 0xe0, 0x3b, 0x1a, 0x44, 0xe7, 0x34, 0x94, 0x4c, 0x70,
+	0x9b, 0xca, 0x9e, 0xe0, 0x92, 0xa2, 0x2f, 0xa0, 0x1e, 0x26, 0x63, 0xab, 0xb2, 0x55, 0xd9, 0x5e,
+	0x7c, 0xb6, 0xb1, 0x33, 0x66, 0xba, 0x93, 0x2a, 0xdb, 0x43, 0x55, 0x64, 0xc1, 0x42, 0x3f, 0x46,
+	0xb2, 0x66, 0xb7, 0x2a, 0xdb, 0x0d, 0x3b, 0x9d, 0xe2, 0xc7, 0x50, 0x3d, 0x3f, 0x39, 0x32, 0x0a,
+	0x01, 0xfb, 0x46, 0x0a, 0x6e, 0x60, 0x97, 0xec, 0x74, 0x8a, 0x9f, 0x42, 0xb5, 0xd9, 0x3e, 0x43,
+	0x2b, 0x30, 0xcb, 0x5c, 0x23, 0x5b, 0xb6, 0x67, 0x99, 0x8b, 0x36, 0xa1, 0x2e, 0xd9, 0x85, 0xcf,
+	0xb8, 0x27, 0xad, 0xd9, 0xad, 0xea, 0xf6, 0xb2, 0x3d, 0x9c, 0xe3, 0x5d, 0x58, 0xe8, 0xc4, 0xe3,
+	0x9c, 0xd9, 0x2a, 0xcc, 0xf5, 0x89, 0x1f, 0x51, 0x13, 0x46, 0xcd, 0x8e, 0x27, 0xb8, 0x05, 0x73,
+	0x6d, 0xe2, 0x51, 0xa9, 0xc5, 0x8e, 0x88, 0xb8, 0x32, 0x16, 0x35, 0x3b, 0x9e, 0x20, 0x04, 0xb5,
+	0x88, 0x33, 0x95, 0x84, 0x6e, 0xc6, 0x7a, 0x4d, 0xb2, 0xf7, 0xd4, 0xaa, 0x1a, 0x68, 0x33, 0xc6,
+	0xcf, 0x61, 0xfe, 0x84, 0x06, 0x22, 0x1c, 0xa0, 0x75, 0x98, 0x27, 0x41, 0x06, 0x28, 0x99, 0x15,
+	0x21, 0xe1, 0xff, 0x56, 0xa0, 0xd6, 0xa4, 0xbe, 0x9f, 0x8b, 0x75, 0x17, 0xe6, 0x03, 0x03, 0x67,
+	0xd4, 0x17, 0x9f, 0x7d, 0x9c, 0x3b, 0xe9, 0xd8, 0x9b, 0x9d, 0xa8, 0xa1, 0x4f, 0x61, 0xae, 0xa7,
+	0xb7, 0x61, 0x55, 0xb7, 0xaa, 0xdb, 0x8b, 0xcf, 0xd6, 0x73, 0xfa, 0x66, 0x93, 0x76, 0xac, 0x84,
+	0xbe, 0x84, 0x86, 0xcb, 0xa4, 0x22, 0xdc, 0xa1, 0xd2, 0xaa, 0x19, 0x0b, 0x2b, 0x67, 0x91, 0x9c,
+	0xa3, 0x7d, 0xad, 0x8a, 0xb6, 0xa1, 0xe6, 0xf4, 0x22, 0x69, 0xcd, 0x19, 0x93, 0xd5, 0x9c, 0x49,
+	0xb3, 0x7d, 0x66, 0x1b, 0x0d, 0xfc, 0x12, 0xea, 0xa7, 0xa2, 0x27, 0x7c, 0xe1, 0x0d, 0xd0, 0x73,
+	0x00, 0x1e, 0x05, 0xe4, 0x47, 0x87, 0xfa, 0xbe, 0xb4, 0x2a, 0xc6, 0x76, 0x2d, 0x6f, 0x4b, 0x7d,
+	0xdf, 0x6e, 0x68, 0x45, 0x3d, 0x92, 0xf8, 0x9f, 0x15, 0x98, 0xef, 0x9c, 0xec, 0x31, 0x21, 0x11,
+	0x86, 0xa5, 0x80, 0xf0, 0xa8, 0x4b, 0x1c, 0x15, 0x85, 0x34, 0x34, 0xe7, 0xd4, 0xb0, 0x47, 0xd6,
+	0x74, 0x16, 0xf5, 0x42, 0xe1, 0x46, 0x4e, 0x7a, 0xc2, 0xe9, 0x34, 0x9b, 0x80, 0xd5, 0x91, 0x04,
+	0x44, 0xf7, 0xa0, 0x2a, 0xaf, 0x22, 0xab, 0x66, 0x56, 0xf5, 0x50, 0x5f, 0x5e, 0x97, 0x04, 0xcc,
+	0x1f, 0x58, 0x73, 0x66, 0x31, 0x99, 0xe1, 0xbf, 0x57, 0xa0, 0xbe, 0xcf, 0xe4, 0xd5, 0x11, 0xef,
+	0x0a, 0xa3, 0x24, 0xc2, 0x80, 0xa8, 0x24, 0x90, 0x64, 0x86, 0xb6, 0x60, 0xf1, 0x82, 0x38, 0x57,
+	0x8c, 0x7b, 0x07, 0xcc, 0xa7, 0x49, 0x18, 0xd9, 0x25, 0xf4, 0x08, 0x40, 0xc7, 0x4b, 0xfc, 0x4e,
+	0x9a, 0x3f, 0x35, 0x3b, 0xb3, 0xa2, 0x11, 0xf4, 0x91, 0xa4, 0x0a, 0x35, 0xa3, 0x90, 0x5d, 0xc2,
+	0xff, 0x9e, 0x85, 0xe5, 0xa6, 0x1f, 0x49, 0x45, 0xc3, 0xa6, 0xe0, 0x5d, 0xe6, 0xa1, 0x1d, 0x40,
+	0xad, 0x77, 0x3d, 0xc2, 0x5d, 0x1d, 0x9f, 0x6c, 0x71, 0x72, 0xe1, 0xd3, 0x38, 0x95, 0xea, 0x76,
+	0x81, 0x04, 0xfd, 0x1e, 0x36, 0x0e, 0x42, 0x4a, 0x75, 0x3e, 0xd8, 0xb4, 0x27, 0x42, 0xc5, 0xb8,
+	0xb7, 0xcf, 0x64, 0x6c, 0x36, 0x6b, 0xcc, 0xca, 0x15, 0xd0, 0x0b, 0xb0, 0xf6, 0x84, 0x73, 0x29,
+	0xf7, 0x99, 0xec, 0xf9, 0x64, 0x70, 0x20, 0xc2, 0xd6, 0xc1, 0xd1, 0x61, 0x44, 0xa5, 0x92, 0x66,
+	0x3f, 0x75, 0xbb, 0x54, 0xae, 0x6d, 0x3b, 0x34, 0x64, 0xc4, 0x6f, 0x0a, 0x2e, 0x85, 0x4f, 0x8f,
+	0xc5, 0xb5, 0xe3, 0x5a, 0x6c, 0x5b, 0x26, 0x47, 0xfb, 0xf0, 0x70, 0xcf, 0x17, 0xce, 0xd5, 0x31,
+	0x51, 0x94, 0x3b, 0x83, 0x57, 0x4c, 0x2a, 0xe1, 0x85, 0x24, 0x18, 0x6e, 0x78, 0xce, 0x00, 0xdc,
+	0xac, 0x84, 0x3f, 0x87, 0x8d, 0x23, 0xae, 0x68, 0xd8, 0x25, 0x0e, 0xdd, 0x63, 0xdc, 0x65, 0xdc,
+	0x3b, 0x61, 0x5e, 0x48, 0x94, 0xce, 0x86, 0x75, 0x5d, 0xc2, 0xea, 0x52, 0xb8, 0xe9, 0xb5, 0xc6,
+	0x33, 0xfc, 0xbf, 0x05, 0x58, 0x3b, 0x8f, 0xaf, 0xe0, 0x84, 0x38, 0x97, 0x8c, 0xd3, 0x37, 0x3d,
+	0x6d, 0x20, 0xd1, 0xb7, 0xb0, 0x3a, 0x2a, 0x88, 0xf3, 0xd5, 0xaa, 0x94, 0xd4, 0x6c, 0x2c, 0xb6,
+	0x0b, 0x8d, 0xd0, 0x73, 0x58, 0x3b, 0xa1, 0xc1, 0x1e, 0xf1, 0x7d, 0x21, 0x78, 0x47, 0x11, 0x25,
+	0xdb, 0x34, 0x64, 0x22, 0xbe, 0x93, 0x65, 0xbb, 0x58, 0x88, 0x7e, 0x0b, 0x0f, 0xda, 0x21, 0xd5,
+	0xeb, 0x0e, 0x51, 0xd4, 0x3d, 0x17, 0x7e, 0x14, 0x24, 0x5d, 0xa0, 0x61, 0x17, 0x89, 0x74, 0x1b,
+	0x57, 0x49, 0x65, 0x5a, 0xb5, 0x92, 0x36, 0x9e, 0x96, 0xae, 0x3d, 0x54, 0x45, 0x1d, 0x68, 0x98,
+	0x34, 0xd2, 0x15, 0x90, 0xd4, 0xff, 0x17, 0x39, 0xbb, 0xc2, 0x63, 0xda, 0x19, 0xda, 0xb5, 0xb8,
+	0x0a, 0x07, 0xf6, 0x35, 0x4e, 0x49, 0xee, 0xce, 0x97, 0xe6, 0xee, 0x3e, 0x2c, 0x3b, 0xd9, 0xe4,
+	0xb7, 0x16, 0xcc, 0x06, 0x1e, 0xe5, 0x9b, 0x49, 0x56, 0xcb, 0x1e, 0x35, 0x42, 0x3f, 0x57, 0x60,
+	0x83, 0xa5, 0x69, 0xb0, 0x2f, 0x02, 0xc2, 0xf8, 0xd7, 0x4a, 0x11, 0xe7, 0x32, 0xa0, 0x5c, 0x59,
+	0x75, 0xb3, 0xb7, 0xd6, 0x07, 0xee, 0xed, 0xa8, 0x0c, 0x27, 0xde, 0x6b, 0xb9, 0x1f, 0xc4, 0x01,
+	0x0d, 0x85, 0xc3, 0x24, 0xb4, 0x1a, 0xc6, 0xfb, 0x57, 0x77, 0xf5, 0x3e, 0x04, 0x88, 0xdd, 0x16,
+	0x20, 0x6f, 0xbe, 0x85, 0x95, 0xd1, 0x8b, 0xd0, 0xed, 0xef, 0x8a, 0x0e, 0x92, 0x6c, 0xd7, 0x43,
+	0xb4, 0x9b, 0x7d, 0x22, 0x8b, 0x12, 0x23, 0xed, 0x81, 0xc9, 0xeb, 0xf9, 0x62, 0xf6, 0x77, 0x95,
+	0xcd, 0x63, 0x78, 0x74, 0xf3, 0x29, 0x14, 0x38, 0x1a, 0x79, 0x8b, 0x1b, 0x59, 0xb4, 0x9f, 0xe0,
+	0xe3, 0x92, 0x5d, 0x15, 0xc0, 0xbc, 0x1c, 0x8d, 0xf7, 0x37, 0xb9, 0x78, 0x4b, 0xab, 0x3d, 0xe3,
+	0x12, 0xf7, 0x01, 0xce, 0x4f, 0x8e, 0x6c, 0xfa, 0x93, 0x6e, 0x53, 0xe8, 0x09, 0x54, 0xfb, 0x01,
+	0x4b, 0x6a, 0x38, 0xff, 0xc4, 0x69, 0x4d, 0xad, 0x80, 0x5e, 0xc2, 0x82, 0x88, 0xaf, 0x21, 0xf1,
+	0xfe, 0xe4, 0xc3, 0x2e, 0xcd, 0x4e, 0xcd, 0xf0, 0x29, 0xdc, 0xbb, 0x8e, 0xe7, 0x8e, 0xde, 0xad,
+	0x51, 0xef, 0x4b, 0xd7, 0xa8, 0x3f, 0x57, 0x60, 0xb1, 0xf5, 0x8e, 0x3a, 0x29, 0xe2, 0x23, 0x00,
+	0xd7, 0xdc, 0xca, 0x6b, 0x12, 0xd0, 0xe4, 0xf0, 0x32, 0x2b, 0x1a, 0xa9, 0x29, 0x82, 0x80, 0x70,
+	0x37, 0x7d, 0x38, 0x93, 0xa9, 0x66, 0x2c, 0x5f, 0x87, 0x5e, 0xda, 0x4c, 0xcc, 0x18, 0x3d, 0x81,
+	0x15, 0xc5, 0x02, 0x2a, 0x22, 0xd5, 0xa1, 0x8e, 0xe0, 0xae, 0x34, 0x3d, 0x64, 0xce, 0x1e, 0x5b,
+	0xc5, 0x2b, 0xb0, 0xd4, 0x0a, 0x7a, 0x6a, 0x90, 0x44, 0x81, 0xbf, 0x82, 0xba, 0x9d, 0x61, 0x84,
+	0x32, 0x72, 0x1c, 0x2a, 0x65, 0xf2, 0x4c, 0xa5, 0x53, 0x2d, 0x09, 0xa8, 0x94, 0xc4, 0x4b, 0x13,
+	0x23, 0x9d, 0xe2, 0x1f, 0x61, 0x25, 0xce, 0xad, 0x49, 0xe9, 0xe8, 0x3a, 0xcc, 0xc7, 0x9b, 0x4f,
+	0x3c, 0x24, 0x33, 0xcc, 0xe1, 0x41, 0xec, 0xc0, 0x74, 0xd7, 0x49, 0xbd, 0x6c, 0xc1, 0xa2, 0x7b,
+	0x8d, 0x96, 0x52, 0x81, 0xcc, 0x12, 0x7e, 0x07, 0xf7, 0xcd, 0xb3, 0x68, 0xaa, 0x69, 0x42, 0x6f,
+	0x9f, 0xc2, 0x7d, 0x6f, 0x1c, 0x2b, 0xf1, 0x99, 0x17, 0xe0, 0xbf, 0x55, 0x60, 0xcd, 0xb8, 0x3e,
+	0x93, 0x34, 0x3c, 0x66, 0x52, 0x4d, 0xea, 0xfe, 0x39, 0xac, 0x79, 0x45, 0x78, 0x49, 0x08, 0xc5,
+	0x42, 0xfc, 0xaf, 0x0a, 0x58, 0x26, 0x0c, 0xcd, 0x8c, 0xe4, 0x40, 0x2a, 0x1a, 0x4c, 0x7c, 0xec,
+	0x2f, 0xc0, 0xf2, 0x4a, 0x20, 0x93, 0x60, 0x4a, 0xe5, 0x78, 0x00, 0x4b, 0x71, 0xd9, 0x4c, 0x16,
+	0xc2, 0x26, 0xd4, 0xe9, 0x3b, 0xa6, 0x9a, 0xc2, 0x8d, 0x5d, 0xce, 0xd9, 0xc3, 0xb9, 0xce, 0x3d,
+	0xa9, 0xdc, 0x37, 0x91, 0x4a, 0x88, 0x68, 0x32, 0xc3, 0xdf, 0xc3, 0x3d, 0x73, 0x12, 0x6d, 0x4d,
+	0xb7, 0x3f, 0xb0, 0x6c, 0xf3, 0x85, 0x38, 0x5b, 0x58, 0x88, 0xdf, 0xc0, 0xfd, 0x0c, 0xf6, 0x44,
+	0x7b, 0xc3, 0xff, 0xa8, 0xc0, 0xb2, 0xa6, 0x86, 0xef, 0xe9, 0x5d, 0xdb, 0xd5, 0x97, 0xb0, 0x1e,
+	0xf1, 0xae, 0x31, 0x3d, 0x2d, 0x8a, 0xba, 0x44, 0x6a, 0xb8, 0xfb, 0x08, 0xa5, 0x49, 0xa7, 0xf8,
+	0x2d, 0xdc, 0x8f, 0x7f, 0x02, 0xed, 0x47, 0x41, 0xef, 0xae, 0xe1, 0x6c, 0x42, 0xdd, 0x8d, 0x82,
+	0x5e, 0x9b, 0xa8, 0xcb, 0x24, 0x2f, 0x86, 0x73, 0x7c, 0x01, 0x1f, 0x75, 0x5a, 0xe7, 0xd3, 0x28,
+	0x4b, 0xdd, 0xe7, 0x68, 0xdf, 0x10, 0xa6, 0xa4, 0x47, 0x27, 0x53, 0xfc, 0xd7, 0x0a, 0x6c, 0x1c,
+	0x9b, 0x1f, 0xe5, 0x27, 0x94, 0xc8, 0x28, 0xa4, 0xfa, 0xad, 0x9c, 0x42, 0x17, 0xf0, 0xc7, 0x31,
+	0x13, 0xc7, 0x79, 0x01, 0xfe, 0x41, 0x53, 0xe1, 0x3f, 0x53, 0x47, 0xc5, 0x71, 0x74, 0xa8, 0x13,
+	0x52, 0x35, 0xb5, 0x57, 0xe8, 0xd9, 0x7f, 0x56, 0xa1, 0xda, 0x0c, 0x5c, 0xf4, 0x1a, 0x50, 0x67,
+	0xc0, 0x9d, 0xd1, 0x97, 0x10, 0xfd, 0xa2, 0x10, 0x32, 0x76, 0xbe, 0x59, 0xbe, 0x59, 0x3c, 0x83,
+	0xde, 0xc0, 0x83, 0x36, 0x89, 0x24, 0x9d, 0x1a, 0xe0, 0x77, 0xb0, 0x76, 0xc6, 0x7b, 0x53, 0x85,
+	0xec, 0xc0, 0x6a, 0x5c, 0x25, 0x63, 0x88, 0x79, 0x9a, 0x3a, 0x52, 0x4c, 0x37, 0x83, 0xda, 0xb0,
+	0x7e, 0xc6, 0xbb, 0x45, 0xb0, 0x13, 0x1d, 0xa6, 0x4d, 0x25, 0x55, 0x53, 0x03, 0x3c, 0x05, 0xab,
+	0x23, 0xba, 0xca, 0xa6, 0x17, 0x42, 0x4c, 0x0f, 0xd5, 0x86, 0xf5, 0xce, 0x65, 0xa4, 0x5c, 0xf1,
+	0x17, 0x3e, 0x35, 0xcc, 0xd7, 0x80, 0xbe, 0x65, 0xbe, 0x3f, 0x35, 0xbc, 0x36, 0xac, 0xee, 0x53,
+	0x9f, 0xaa, 0xe9, 0x5d, 0xce, 0x5b, 0x58, 0x8b, 0xd9, 0xe1, 0x38, 0xe4, 0xaf, 0x72, 0x56, 0xe3,
+	0x2c, 0xf2, 0xd6, 0x5b, 0xd7, 0x25, 0x39, 0x34, 0x3a, 0x25, 0xa1, 0x47, 0xd5, 0x04, 0x91, 0xfe,
+	0x01, 0x1e, 0x36, 0xf5, 0xf7, 0xa1, 0xb1, 0xd3, 0x1c, 0x3a, 0x98, 0xf0, 0xea, 0x99, 0xc7, 0x89,
+	0x1f, 0x07, 0xd9, 0x16, 0x6e, 0xd3, 0xa7, 0x84, 0x47, 0xbd, 0x09, 0x30, 0xff, 0x08, 0x8f, 0x0f,
+	0x18, 0x27, 0x3e, 0x7b, 0x4f, 0xa7, 0x1f, 0xf0, 0x6b, 0x40, 0xaf, 0x84, 0xea, 0xf9, 0x91, 0xf7,
+	0x4a, 0x48, 0xb5, 0x4f, 0xfb, 0xcc, 0xa1, 0x72, 0x02, 0xbc, 0x13, 0x68, 0x1c, 0x52, 0x15, 0x33,
+	0x53, 0xf4, 0x30, 0xa7, 0x99, 0xe5, 0xd8, 0x9b, 0x8f, 0xf3, 0x3f, 0xd7, 0x46, 0x28, 0xb3, 0x49,
+	0xaa, 0x95, 0x21, 0x9c, 0xe1, 0xa1, 0xb7, 0x61, 0xfe, 0xba, 0x04, 0x73, 0x84, 0x25, 0x9b, 0x9e,
+	0xb7, 0x74, 0x48, 0xd5, 0x90, 0xd1, 0xde, 0x06, 0x8b, 0x73, 0xe2, 0x1c, 0x19, 0x36, 0xa0, 0xf5,
+	0x43, 0x6a, 0x98, 0xe3, 0xad, 0x71, 0x3e, 0x29, 0x06, 0xcc, 0xb1, 0xce, 0x19, 0xf4, 0x27, 0x73,
+	0x04, 0x19, 0x06, 0x78, 0x1b, 0xf4, 0x27, 0xc5, 0xd0, 0x45, 0x1c, 0x72, 0x06, 0xed, 0x41, 0x4d,
+	0x33, 0xad, 0xdb, 0x30, 0x6f, 0xbc, 0xf3, 0x16, 0xd4, 0x34, 0x13, 0x45, 0xbf, 0xcc, 0x63, 0x5c,
+	0xff, 0xae, 0xdb, 0x7c, 0x58, 0x22, 0xcd, 0x34, 0xe3, 0xc6, 0x90, 0xf9, 0x15, 0x34, 0x8d, 0x71,
+	0xc6, 0xb9, 0x89, 0x6f, 0x52, 0xc9, 0x54, 0x8f, 0x35, 0x56, 0x35, 0x43, 0x16, 0x86, 0x70, 0xc9,
+	0x57, 0xea, 0x0c, 0x45, 0xbb, 0xad, 0xe7, 0xe9, 0xbb, 0xc9, 0xfc, 0xf3, 0xe1, 0xee, 0xe9, 0x59,
+	0xf0, 0x9f, 0x8b, 0xa4, 0x8f, 0xe4, 0x68, 0x48, 0xb3, 0x7d, 0x26, 0x27, 0x7c, 0xec, 0x72, 0x98,
+	0xf1, 0x86, 0x27, 0x7a, 0x93, 0xe1, 0x90, 0xaa, 0x84, 0x81, 0xde, 0xb6, 0xfd, 0xad, 0x9c, 0x78,
+	0x8c, 0xba, 0xe2, 0x19, 0x44, 0x60, 0xf5, 0x90, 0xaa, 0x1c, 0xdb, 0xbc, 0x39, 0xc4, 0xfc, 0x97,
+	0x94, 0x52, 0xba, 0x8a, 0x67, 0xd0, 0x0f, 0x80, 0xf2, 0x5c, 0x12, 0x15, 0x7d, 0x8d, 0x29, 0x21,
+	0x9c, 0x37, 0x1e, 0xc9, 0x5e, 0xed, 0xfb, 0xd9, 0xfe, 0xd3, 0x8b, 0x79, 0xf3, 0xdf, 0xaa, 0xcf,
+	0xff, 0x3f, 0x00, 0x69, 0xca, 0x55, 0x70, 0xda, 0x1a, 0x00, 0x00,
 }
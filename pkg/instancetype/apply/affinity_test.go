@@ -0,0 +1,74 @@
+package apply_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	k8sv1 "k8s.io/api/core/v1"
+	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
+
+	virtv1 "kubevirt.io/api/core/v1"
+	v1beta1 "kubevirt.io/api/instancetype/v1beta1"
+
+	"kubevirt.io/kubevirt/pkg/instancetype/apply"
+	"kubevirt.io/kubevirt/pkg/libvmi"
+)
+
+var _ = Describe("instancetype.spec.Affinity", func() {
+	var (
+		vmi              *virtv1.VirtualMachineInstance
+		instancetypeSpec *v1beta1.VirtualMachineInstancetypeSpec
+		preferenceSpec   *v1beta1.VirtualMachinePreferenceSpec
+
+		vmiApplier = apply.NewVMIApplier()
+		field      = k8sfield.NewPath("spec", "template", "spec")
+
+		affinity = &k8sv1.Affinity{
+			NodeAffinity: &k8sv1.NodeAffinity{
+				RequiredDuringSchedulingIgnoredDuringExecution: &k8sv1.NodeSelector{
+					NodeSelectorTerms: []k8sv1.NodeSelectorTerm{{
+						MatchExpressions: []k8sv1.NodeSelectorRequirement{{
+							Key:      "node-role.kubernetes.io/worker",
+							Operator: k8sv1.NodeSelectorOpExists,
+						}},
+					}},
+				},
+			},
+		}
+	)
+
+	BeforeEach(func() {
+		vmi = libvmi.New()
+	})
+
+	It("should apply to VMI", func() {
+		instancetypeSpec = &v1beta1.VirtualMachineInstancetypeSpec{
+			Affinity: affinity,
+		}
+
+		Expect(vmiApplier.ApplyToVMI(field, instancetypeSpec, preferenceSpec, &vmi.Spec, &vmi.ObjectMeta)).To(Succeed())
+
+		Expect(vmi.Spec.Affinity).To(Equal(instancetypeSpec.Affinity))
+		Expect(vmi.Spec.Affinity).ToNot(BeIdenticalTo(instancetypeSpec.Affinity))
+	})
+
+	It("should be no-op if vmi.Spec.Affinity is already set but instancetype.Affinity is empty", func() {
+		instancetypeSpec = &v1beta1.VirtualMachineInstancetypeSpec{}
+		vmi.Spec.Affinity = affinity.DeepCopy()
+
+		Expect(vmiApplier.ApplyToVMI(field, instancetypeSpec, preferenceSpec, &vmi.Spec, &vmi.ObjectMeta)).To(Succeed())
+
+		Expect(vmi.Spec.Affinity).To(Equal(affinity))
+	})
+
+	It("should return a conflict if vmi.Spec.Affinity is already set and instancetype.Affinity is defined", func() {
+		instancetypeSpec = &v1beta1.VirtualMachineInstancetypeSpec{
+			Affinity: affinity,
+		}
+		vmi.Spec.Affinity = &k8sv1.Affinity{}
+
+		conflicts := vmiApplier.ApplyToVMI(field, instancetypeSpec, preferenceSpec, &vmi.Spec, &vmi.ObjectMeta)
+		Expect(conflicts).To(HaveLen(1))
+		Expect(conflicts[0].String()).To(Equal("spec.template.spec.affinity"))
+	})
+})
@@ -68,6 +68,10 @@ func applyCPU(
 		vmiSpec.Domain.CPU.MaxSockets = *instancetypeSpec.CPU.MaxSockets
 	}
 
+	if len(vmiSpec.Domain.CPU.MitigatedVulnerabilities) == 0 && len(instancetypeSpec.CPU.MitigatedVulnerabilities) > 0 {
+		vmiSpec.Domain.CPU.MitigatedVulnerabilities = append([]string(nil), instancetypeSpec.CPU.MitigatedVulnerabilities...)
+	}
+
 	applyGuestCPUTopology(instancetypeSpec.CPU.Guest, preferenceSpec, vmiSpec)
 
 	return nil
@@ -153,5 +157,9 @@ func validateCPU(
 		conflicts = append(conflicts, baseConflict.NewChild("domain", "cpu", "realtime"))
 	}
 
+	if len(vmiSpec.Domain.CPU.MitigatedVulnerabilities) > 0 && len(instancetypeSpec.CPU.MitigatedVulnerabilities) > 0 {
+		conflicts = append(conflicts, baseConflict.NewChild("domain", "cpu", "mitigatedVulnerabilities"))
+	}
+
 	return conflicts
 }
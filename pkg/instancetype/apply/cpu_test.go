@@ -42,7 +42,8 @@ var _ = Describe("instancetype.spec.CPU and preference.spec.CPU", func() {
 				Realtime: &virtv1.Realtime{
 					Mask: "0-3,^1",
 				},
-				MaxSockets: pointer.P(uint32(6)),
+				MaxSockets:               pointer.P(uint32(6)),
+				MitigatedVulnerabilities: []string{"retbleed", "mds"},
 			},
 		}
 
@@ -63,6 +64,7 @@ var _ = Describe("instancetype.spec.CPU and preference.spec.CPU", func() {
 		Expect(vmi.Spec.Domain.CPU.NUMA).To(HaveValue(Equal(*instancetypeSpec.CPU.NUMA)))
 		Expect(vmi.Spec.Domain.CPU.Realtime).To(HaveValue(Equal(*instancetypeSpec.CPU.Realtime)))
 		Expect(vmi.Spec.Domain.CPU.MaxSockets).To(Equal(*instancetypeSpec.CPU.MaxSockets))
+		Expect(vmi.Spec.Domain.CPU.MitigatedVulnerabilities).To(Equal(instancetypeSpec.CPU.MitigatedVulnerabilities))
 	})
 
 	It("should default to Sockets, when instancetype is used with PreferAny", func() {
@@ -491,6 +493,16 @@ var _ = Describe("instancetype.spec.CPU and preference.spec.CPU", func() {
 		Expect(conflicts[0].String()).To(Equal("spec.template.spec.domain.resources.limits.cpu"))
 	})
 
+	It("should return a conflict if vmi.Spec.Domain.CPU.MitigatedVulnerabilities already defined", func() {
+		vmi.Spec.Domain.CPU = &virtv1.CPU{
+			MitigatedVulnerabilities: []string{"retbleed"},
+		}
+
+		conflicts := vmiApplier.ApplyToVMI(field, instancetypeSpec, preferenceSpec, &vmi.Spec, &vmi.ObjectMeta)
+		Expect(conflicts).To(HaveLen(1))
+		Expect(conflicts[0].String()).To(Equal("spec.template.spec.domain.cpu.mitigatedVulnerabilities"))
+	})
+
 	It("should apply PreferredCPUFeatures", func() {
 		preferenceSpec = &v1beta1.VirtualMachinePreferenceSpec{
 			CPU: &v1beta1.CPUPreferences{
@@ -0,0 +1,75 @@
+package apply_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	k8sv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
+
+	virtv1 "kubevirt.io/api/core/v1"
+	v1beta1 "kubevirt.io/api/instancetype/v1beta1"
+
+	"kubevirt.io/kubevirt/pkg/instancetype/apply"
+	"kubevirt.io/kubevirt/pkg/libvmi"
+)
+
+var _ = Describe("instancetype.spec.TopologySpreadConstraints", func() {
+	var (
+		vmi              *virtv1.VirtualMachineInstance
+		instancetypeSpec *v1beta1.VirtualMachineInstancetypeSpec
+		preferenceSpec   *v1beta1.VirtualMachinePreferenceSpec
+
+		vmiApplier = apply.NewVMIApplier()
+		field      = k8sfield.NewPath("spec", "template", "spec")
+
+		constraints = []k8sv1.TopologySpreadConstraint{{
+			MaxSkew:           1,
+			TopologyKey:       k8sv1.LabelTopologyZone,
+			WhenUnsatisfiable: k8sv1.ScheduleAnyway,
+			LabelSelector: &metav1.LabelSelector{
+				MatchLabels: map[string]string{"app": "database"},
+			},
+		}}
+	)
+
+	BeforeEach(func() {
+		vmi = libvmi.New()
+	})
+
+	It("should apply to VMI", func() {
+		instancetypeSpec = &v1beta1.VirtualMachineInstancetypeSpec{
+			TopologySpreadConstraints: constraints,
+		}
+
+		Expect(vmiApplier.ApplyToVMI(field, instancetypeSpec, preferenceSpec, &vmi.Spec, &vmi.ObjectMeta)).To(Succeed())
+
+		Expect(vmi.Spec.TopologySpreadConstraints).To(Equal(instancetypeSpec.TopologySpreadConstraints))
+		Expect(vmi.Spec.TopologySpreadConstraints[0].LabelSelector).ToNot(BeIdenticalTo(instancetypeSpec.TopologySpreadConstraints[0].LabelSelector))
+	})
+
+	It("should be no-op if vmi.Spec.TopologySpreadConstraints is already set but instancetype.TopologySpreadConstraints is empty", func() {
+		instancetypeSpec = &v1beta1.VirtualMachineInstancetypeSpec{}
+		vmi.Spec.TopologySpreadConstraints = constraints
+
+		Expect(vmiApplier.ApplyToVMI(field, instancetypeSpec, preferenceSpec, &vmi.Spec, &vmi.ObjectMeta)).To(Succeed())
+
+		Expect(vmi.Spec.TopologySpreadConstraints).To(Equal(constraints))
+	})
+
+	It("should return a conflict if vmi.Spec.TopologySpreadConstraints is already set and instancetype.TopologySpreadConstraints is defined", func() {
+		instancetypeSpec = &v1beta1.VirtualMachineInstancetypeSpec{
+			TopologySpreadConstraints: constraints,
+		}
+		vmi.Spec.TopologySpreadConstraints = []k8sv1.TopologySpreadConstraint{{
+			MaxSkew:           2,
+			TopologyKey:       k8sv1.LabelHostname,
+			WhenUnsatisfiable: k8sv1.DoNotSchedule,
+		}}
+
+		conflicts := vmiApplier.ApplyToVMI(field, instancetypeSpec, preferenceSpec, &vmi.Spec, &vmi.ObjectMeta)
+		Expect(conflicts).To(HaveLen(1))
+		Expect(conflicts[0].String()).To(Equal("spec.template.spec.topologySpreadConstraints"))
+	})
+})
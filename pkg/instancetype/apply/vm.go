@@ -19,8 +19,6 @@
 package apply
 
 import (
-	"fmt"
-
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
 
@@ -62,6 +60,8 @@ func NewVMApplier(instancetypeFinder specFinder, preferenceFinder preferenceSpec
 	}
 }
 
+// ApplyToVM applies the instance type and preference to the template of the VirtualMachine. Conflicts with the
+// template are returned as conflict.Conflicts.
 func (a *vmApplier) ApplyToVM(vm *virtv1.VirtualMachine) error {
 	if vm.Spec.Instancetype == nil && vm.Spec.Preference == nil {
 		return nil
@@ -75,13 +75,13 @@ func (a *vmApplier) ApplyToVM(vm *virtv1.VirtualMachine) error {
 		return err
 	}
 	if conflicts := a.ApplyToVMI(
-		k8sfield.NewPath("spec"),
+		k8sfield.NewPath("spec", "template", "spec"),
 		instancetypeSpec,
 		preferenceSpec,
 		&vm.Spec.Template.Spec,
 		&vm.Spec.Template.ObjectMeta,
 	); len(conflicts) > 0 {
-		return fmt.Errorf("VM conflicts with instancetype spec in fields: [%s]", conflicts.String())
+		return conflicts
 	}
 	return nil
 }
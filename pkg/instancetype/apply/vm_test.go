@@ -0,0 +1,56 @@
+package apply_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	virtv1 "kubevirt.io/api/core/v1"
+	v1beta1 "kubevirt.io/api/instancetype/v1beta1"
+
+	"kubevirt.io/kubevirt/pkg/instancetype/apply"
+	"kubevirt.io/kubevirt/pkg/instancetype/conflict"
+	"kubevirt.io/kubevirt/pkg/libvmi"
+)
+
+type fakeFinder struct {
+	instancetypeSpec *v1beta1.VirtualMachineInstancetypeSpec
+	preferenceSpec   *v1beta1.VirtualMachinePreferenceSpec
+}
+
+func (f fakeFinder) Find(*virtv1.VirtualMachine) (*v1beta1.VirtualMachineInstancetypeSpec, error) {
+	return f.instancetypeSpec, nil
+}
+
+func (f fakeFinder) FindPreference(*virtv1.VirtualMachine) (*v1beta1.VirtualMachinePreferenceSpec, error) {
+	return f.preferenceSpec, nil
+}
+
+var _ = Describe("VM applier", func() {
+	var (
+		vm     *virtv1.VirtualMachine
+		finder fakeFinder
+	)
+
+	BeforeEach(func() {
+		vm = libvmi.NewVirtualMachine(libvmi.New())
+		vm.Spec.Instancetype = &virtv1.InstancetypeMatcher{Name: "instancetype"}
+		finder = fakeFinder{
+			instancetypeSpec: &v1beta1.VirtualMachineInstancetypeSpec{
+				NodeSelector: map[string]string{"key": "value"},
+			},
+		}
+	})
+
+	It("should apply the instance type to the template of the VM", func() {
+		Expect(apply.NewVMApplier(finder, finder).ApplyToVM(vm)).To(Succeed())
+		Expect(vm.Spec.Template.Spec.NodeSelector).To(Equal(finder.instancetypeSpec.NodeSelector))
+	})
+
+	It("should return the conflicts with the template of the VM", func() {
+		vm.Spec.Template.Spec.NodeSelector = map[string]string{"key": "value"}
+
+		err := apply.NewVMApplier(finder, finder).ApplyToVM(vm)
+		Expect(err).To(BeAssignableToTypeOf(conflict.Conflicts{}))
+		Expect(err).To(MatchError("VM field(s) spec.template.spec.nodeSelector conflicts with selected instance type"))
+	})
+})
@@ -59,6 +59,8 @@ func (a *vmiApplier) ApplyToVMI(
 		conflicts := conflict.Conflicts{}
 		conflicts = append(conflicts, applyNodeSelector(baseConflict, instancetypeSpec, vmiSpec)...)
 		conflicts = append(conflicts, applySchedulerName(baseConflict, instancetypeSpec, vmiSpec)...)
+		conflicts = append(conflicts, applyAffinity(baseConflict, instancetypeSpec, vmiSpec)...)
+		conflicts = append(conflicts, applyTopologySpreadConstraints(baseConflict, instancetypeSpec, vmiSpec)...)
 		conflicts = append(conflicts, applyCPU(baseConflict, instancetypeSpec, preferenceSpec, vmiSpec)...)
 		conflicts = append(conflicts, applyMemory(baseConflict, instancetypeSpec, vmiSpec)...)
 		conflicts = append(conflicts, applyIOThreadPolicy(baseConflict, instancetypeSpec, vmiSpec)...)
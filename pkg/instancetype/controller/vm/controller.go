@@ -20,6 +20,7 @@ package vm
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	corev1 "k8s.io/api/core/v1"
@@ -38,6 +39,7 @@ import (
 
 	"kubevirt.io/kubevirt/pkg/instancetype/annotations"
 	"kubevirt.io/kubevirt/pkg/instancetype/apply"
+	"kubevirt.io/kubevirt/pkg/instancetype/conflict"
 	"kubevirt.io/kubevirt/pkg/instancetype/expand"
 	"kubevirt.io/kubevirt/pkg/instancetype/find"
 	preferenceannotations "kubevirt.io/kubevirt/pkg/instancetype/preference/annotations"
@@ -73,11 +75,16 @@ type upgradeHandler interface {
 	Upgrade(*virtv1.VirtualMachine) error
 }
 
+type outdatedRevisionHandler interface {
+	UpgradeOutdated(*virtv1.VirtualMachine, *virtv1.VirtualMachineInstance) (bool, error)
+}
+
 type controller struct {
 	applyVMHandler
 	storeHandler
 	expandHandler
 	upgradeHandler
+	outdatedRevisionHandler
 	instancetypeFindHandler
 	preferenceFindHandler
 
@@ -99,6 +106,7 @@ func New(
 		storeHandler:            revision.New(instancetypeStore, clusterInstancetypeStore, preferenceStore, clusterPreferenceStore, virtClient),
 		expandHandler:           expand.New(clusterConfig, finder, prefFinder),
 		upgradeHandler:          upgrade.New(revisionStore, virtClient),
+		outdatedRevisionHandler: upgrade.NewOutdatedRevisionHandler(instancetypeStore, clusterInstancetypeStore, revisionStore, virtClient, clusterConfig),
 		clientset:               virtClient,
 		clusterConfig:           clusterConfig,
 		recorder:                recorder,
@@ -109,6 +117,12 @@ const (
 	storeControllerRevisionErrFmt   = "error encountered while storing instancetype.kubevirt.io controllerRevisions: %v"
 	upgradeControllerRevisionErrFmt = "error encountered while upgrading instancetype.kubevirt.io controllerRevisions: %v"
 	cleanControllerRevisionErrFmt   = "error encountered cleaning controllerRevision %s after successfully expanding VirtualMachine %s: %v"
+	upgradeOutdatedRevisionErrFmt   = "error encountered while upgrading outdated instancetype.kubevirt.io controllerRevision: %v"
+
+	upgradedOutdatedRevisionReason       = "UpgradedInstancetypeRevision"
+	failedUpgradeOutdatedRevisionReason  = "FailedUpgradeInstancetypeRevision"
+	upgradeOutdatedRevisionConflictFmt   = "not upgrading to the latest version of instance type %s: %v"
+	upgradedOutdatedRevisionEventMessage = "Upgraded to the latest version of instance type %s"
 )
 
 func (c *controller) Sync(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance) (*virtv1.VirtualMachine, error) {
@@ -119,6 +133,10 @@ func (c *controller) Sync(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineI
 	referencePolicy := c.clusterConfig.GetInstancetypeReferencePolicy()
 	switch referencePolicy {
 	case virtv1.Reference:
+		// Drop an outdated instancetype controllerRevision of a stopped VM first so that Store replaces it with the latest version
+		if err := c.upgradeOutdatedRevision(vm, vmi); err != nil {
+			return vm, err
+		}
 		// Ensure we have controllerRevisions of any instancetype or preferences referenced by the VM
 		if err := c.Store(vm); err != nil {
 			log.Log.Object(vm).Errorf(storeControllerRevisionErrFmt, err)
@@ -138,6 +156,27 @@ func (c *controller) Sync(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineI
 	return vm, nil
 }
 
+func (c *controller) upgradeOutdatedRevision(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance) error {
+	upgraded, err := c.UpgradeOutdated(vm, vmi)
+	var conflicts conflict.Conflicts
+	if errors.As(err, &conflicts) {
+		// The VM can still be started with its current controllerRevision
+		log.Log.Object(vm).Warningf(upgradeOutdatedRevisionConflictFmt, vm.Spec.Instancetype.Name, conflicts)
+		c.recorder.Eventf(vm, corev1.EventTypeWarning, failedUpgradeOutdatedRevisionReason,
+			upgradeOutdatedRevisionConflictFmt, vm.Spec.Instancetype.Name, conflicts)
+		return nil
+	}
+	if err != nil {
+		log.Log.Object(vm).Reason(err).Errorf(upgradeOutdatedRevisionErrFmt, err)
+		c.recorder.Eventf(vm, corev1.EventTypeWarning, failedUpgradeOutdatedRevisionReason, upgradeOutdatedRevisionErrFmt, err)
+		return common.NewSyncError(fmt.Errorf(upgradeOutdatedRevisionErrFmt, err), failedUpgradeOutdatedRevisionReason)
+	}
+	if upgraded {
+		c.recorder.Eventf(vm, corev1.EventTypeNormal, upgradedOutdatedRevisionReason, upgradedOutdatedRevisionEventMessage, vm.Spec.Instancetype.Name)
+	}
+	return nil
+}
+
 func (c *controller) handleExpand(
 	vm *virtv1.VirtualMachine,
 	referencePolicy virtv1.InstancetypeReferencePolicy,
@@ -1073,4 +1073,117 @@ var _ = Describe("Instance type and Preference VirtualMachine Controller", func(
 				kvWithFGEnabledReferencePolicyExpandAll, addRevisionsToVMFunc),
 		)
 	})
+
+	Context("RevisionUpgradePolicy", func() {
+		var outdatedRevision *appsv1.ControllerRevision
+
+		BeforeEach(func() {
+			outdatedInstancetype := instancetypeObj.DeepCopy()
+			outdatedInstancetype.Generation = resourceGeneration - 1
+			outdatedInstancetype.Spec.CPU.Guest = 1
+
+			var err error
+			outdatedRevision, err = instancetype.CreateControllerRevision(vm, outdatedInstancetype)
+			Expect(err).ToNot(HaveOccurred())
+			outdatedRevision, err = virtClient.AppsV1().ControllerRevisions(vm.Namespace).Create(
+				context.Background(), outdatedRevision, metav1.CreateOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(controllerrevisionInformerStore.Add(outdatedRevision)).To(Succeed())
+
+			vm.Spec.Instancetype = &virtv1.InstancetypeMatcher{
+				Name: instancetypeObj.Name,
+				Kind: instancetypeapi.SingularResourceName,
+			}
+			vm.Status.InstancetypeRef = &virtv1.InstancetypeStatusRef{
+				Name: instancetypeObj.Name,
+				Kind: instancetypeapi.SingularResourceName,
+				ControllerRevisionRef: &virtv1.ControllerRevisionRef{
+					Name: outdatedRevision.Name,
+				},
+			}
+		})
+
+		createAndSync := func(vmi *virtv1.VirtualMachineInstance) *virtv1.VirtualMachine {
+			var err error
+			vm, err = virtClient.VirtualMachine(vm.Namespace).Create(context.Background(), vm, metav1.CreateOptions{})
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = instancetypeController.Sync(vm, vmi)
+			Expect(err).ToNot(HaveOccurred())
+
+			vm, err = virtClient.VirtualMachine(vm.Namespace).Get(context.Background(), vm.Name, metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			return vm
+		}
+
+		expectUpgraded := func(vm *virtv1.VirtualMachine) {
+			expectedRevision, err := instancetype.CreateControllerRevision(vm, instancetypeObj)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(vm.Status.InstancetypeRef.ControllerRevisionRef.Name).To(Equal(expectedRevision.Name))
+
+			_, err = virtClient.AppsV1().ControllerRevisions(vm.Namespace).Get(
+				context.Background(), outdatedRevision.Name, metav1.GetOptions{})
+			Expect(err).To(MatchError(k8serrors.IsNotFound, "IsNotFound"))
+			testutils.ExpectEvent(recorder, "UpgradedInstancetypeRevision")
+		}
+
+		It("should upgrade a stopped VM opted in with the annotation", func() {
+			vm.Annotations = map[string]string{instancetypeapi.RevisionUpgradePolicyAnnotation: string(virtv1.RevisionUpgradeOnRestart)}
+			expectUpgraded(createAndSync(nil))
+		})
+
+		It("should upgrade a VM with a finished VMI", func() {
+			vm.Annotations = map[string]string{instancetypeapi.RevisionUpgradePolicyAnnotation: string(virtv1.RevisionUpgradeOnRestart)}
+			vmi.Status.Phase = virtv1.Succeeded
+			expectUpgraded(createAndSync(vmi))
+		})
+
+		It("should upgrade a stopped VM with the onRestart cluster policy", func() {
+			testutils.UpdateFakeKubeVirtClusterConfig(kvStore, &virtv1.KubeVirt{
+				Spec: virtv1.KubeVirtSpec{
+					Configuration: virtv1.KubeVirtConfiguration{
+						Instancetype: &virtv1.InstancetypeConfiguration{
+							RevisionUpgradePolicy: pointer.P(virtv1.RevisionUpgradeOnRestart),
+						},
+					},
+				},
+			})
+			expectUpgraded(createAndSync(nil))
+		})
+
+		DescribeTable("should not upgrade", func(annotations map[string]string, running bool) {
+			vm.Annotations = annotations
+			var currentVMI *virtv1.VirtualMachineInstance
+			if running {
+				currentVMI = vmi
+			}
+			vm = createAndSync(currentVMI)
+			Expect(vm.Status.InstancetypeRef.ControllerRevisionRef.Name).To(Equal(outdatedRevision.Name))
+			Expect(recorder.Events).To(BeEmpty())
+		},
+			Entry("a stopped VM without opting in", nil, false),
+			Entry("a stopped VM opted out with the annotation",
+				map[string]string{instancetypeapi.RevisionUpgradePolicyAnnotation: string(virtv1.RevisionUpgradeManual)}, false),
+			Entry("a running VM",
+				map[string]string{instancetypeapi.RevisionUpgradePolicyAnnotation: string(virtv1.RevisionUpgradeOnRestart)}, true),
+		)
+
+		It("should not upgrade a VM asking for a specific revisionName", func() {
+			vm.Annotations = map[string]string{instancetypeapi.RevisionUpgradePolicyAnnotation: string(virtv1.RevisionUpgradeOnRestart)}
+			vm.Spec.Instancetype.RevisionName = outdatedRevision.Name
+			vm = createAndSync(nil)
+			Expect(vm.Status.InstancetypeRef.ControllerRevisionRef.Name).To(Equal(outdatedRevision.Name))
+		})
+
+		It("should keep the outdated revision if the latest version conflicts with the VM", func() {
+			instancetypeObj.Spec.NodeSelector = map[string]string{"key": "value"}
+			Expect(instancetypeInformerStore.Update(instancetypeObj)).To(Succeed())
+
+			vm.Annotations = map[string]string{instancetypeapi.RevisionUpgradePolicyAnnotation: string(virtv1.RevisionUpgradeOnRestart)}
+			vm.Spec.Template.Spec.NodeSelector = map[string]string{"key": "other"}
+			vm = createAndSync(nil)
+			Expect(vm.Status.InstancetypeRef.ControllerRevisionRef.Name).To(Equal(outdatedRevision.Name))
+			testutils.ExpectEvent(recorder, "FailedUpgradeInstancetypeRevision")
+		})
+	})
 })
@@ -19,28 +19,18 @@
 package expand
 
 import (
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
-
 	virtv1 "kubevirt.io/api/core/v1"
 	"kubevirt.io/api/instancetype/v1beta1"
 
 	"kubevirt.io/kubevirt/pkg/defaults"
 	"kubevirt.io/kubevirt/pkg/instancetype/apply"
-	"kubevirt.io/kubevirt/pkg/instancetype/conflict"
 	"kubevirt.io/kubevirt/pkg/network/vmispec"
 	utils "kubevirt.io/kubevirt/pkg/util"
 	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
 )
 
-type vmiApplier interface {
-	ApplyToVMI(
-		field *k8sfield.Path,
-		instancetypeSpec *v1beta1.VirtualMachineInstancetypeSpec,
-		preferenceSpec *v1beta1.VirtualMachinePreferenceSpec,
-		vmiSpec *virtv1.VirtualMachineInstanceSpec,
-		vmiMetadata *metav1.ObjectMeta,
-	) (conflicts conflict.Conflicts)
+type vmApplier interface {
+	ApplyToVM(vm *virtv1.VirtualMachine) error
 }
 
 type specFinder interface {
@@ -52,9 +42,7 @@ type preferenceSpecFinder interface {
 }
 
 type expander struct {
-	vmiApplier
-	specFinder
-	preferenceSpecFinder
+	vmApplier
 
 	clusterConfig *virtconfig.ClusterConfig
 }
@@ -65,10 +53,8 @@ func New(
 	preferenceFinder preferenceSpecFinder,
 ) *expander {
 	return &expander{
-		clusterConfig:        clusterConfig,
-		vmiApplier:           apply.NewVMIApplier(),
-		specFinder:           instancetypeFinder,
-		preferenceSpecFinder: preferenceFinder,
+		clusterConfig: clusterConfig,
+		vmApplier:     apply.NewVMApplier(instancetypeFinder, preferenceFinder),
 	}
 }
 
@@ -77,16 +63,6 @@ func (e *expander) Expand(vm *virtv1.VirtualMachine) (*virtv1.VirtualMachine, er
 		return vm, nil
 	}
 
-	instancetypeSpec, err := e.Find(vm)
-	if err != nil {
-		return nil, err
-	}
-
-	preferenceSpec, err := e.FindPreference(vm)
-	if err != nil {
-		return nil, err
-	}
-
 	expandedVM := vm.DeepCopy()
 
 	utils.SetDefaultVolumeDisk(&expandedVM.Spec.Template.Spec)
@@ -95,15 +71,8 @@ func (e *expander) Expand(vm *virtv1.VirtualMachine) (*virtv1.VirtualMachine, er
 		return nil, err
 	}
 
-	// Replace with VMApplier.ApplyToVM once conflict errors are aligned
-	conflicts := e.ApplyToVMI(
-		k8sfield.NewPath("spec", "template", "spec"),
-		instancetypeSpec, preferenceSpec,
-		&expandedVM.Spec.Template.Spec,
-		&expandedVM.Spec.Template.ObjectMeta,
-	)
-	if len(conflicts) > 0 {
-		return nil, conflicts
+	if err := e.ApplyToVM(expandedVM); err != nil {
+		return nil, err
 	}
 
 	// Apply defaults to VM.Spec.Template.Spec after applying instance types to ensure we don't conflict
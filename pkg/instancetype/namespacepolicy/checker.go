@@ -0,0 +1,137 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+package namespacepolicy
+
+import (
+	"fmt"
+	"strings"
+
+	k8sv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/sets"
+	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/client-go/tools/cache"
+
+	virtv1 "kubevirt.io/api/core/v1"
+	api "kubevirt.io/api/instancetype"
+)
+
+type checker struct {
+	namespaceStore cache.Store
+}
+
+func New(namespaceStore cache.Store) *checker {
+	return &checker{
+		namespaceStore: namespaceStore,
+	}
+}
+
+// Check returns a cause for the cluster instance type and the cluster preference referenced by the VirtualMachine
+// if the policies selecting its namespace do not allow them.
+func (c *checker) Check(policies []virtv1.InstancetypeNamespacePolicy, vm *virtv1.VirtualMachine) ([]metav1.StatusCause, error) {
+	if len(policies) == 0 {
+		return nil, nil
+	}
+	allowedInstancetypes, allowedPreferences, err := c.allowed(policies, vm.Namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	var causes []metav1.StatusCause
+	if matcher := vm.Spec.Instancetype; matcher != nil && allowedInstancetypes != nil && isClusterInstancetype(matcher.Kind) &&
+		!allowedInstancetypes.Has(matcher.Name) {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueNotSupported,
+			Message: fmt.Sprintf("cluster instance type %s is not allowed in namespace %s", matcher.Name, vm.Namespace),
+			Field:   k8sfield.NewPath("spec", "instancetype", "name").String(),
+		})
+	}
+	if matcher := vm.Spec.Preference; matcher != nil && allowedPreferences != nil && isClusterPreference(matcher.Kind) &&
+		!allowedPreferences.Has(matcher.Name) {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueNotSupported,
+			Message: fmt.Sprintf("cluster preference %s is not allowed in namespace %s", matcher.Name, vm.Namespace),
+			Field:   k8sfield.NewPath("spec", "preference", "name").String(),
+		})
+	}
+	return causes, nil
+}
+
+// CheckClusterPreference returns an error if the policies selecting the namespace do not allow the cluster preference
+func (c *checker) CheckClusterPreference(policies []virtv1.InstancetypeNamespacePolicy, namespace, name string) error {
+	if len(policies) == 0 {
+		return nil
+	}
+	_, allowedPreferences, err := c.allowed(policies, namespace)
+	if err != nil {
+		return err
+	}
+	if allowedPreferences != nil && !allowedPreferences.Has(name) {
+		return fmt.Errorf("cluster preference %s is not allowed in namespace %s", name, namespace)
+	}
+	return nil
+}
+
+// allowed returns the names allowed by the policies selecting the namespace, nil if no policy restricts the kind
+func (c *checker) allowed(
+	policies []virtv1.InstancetypeNamespacePolicy, namespace string,
+) (allowedInstancetypes, allowedPreferences sets.Set[string], err error) {
+	namespaceLabels := labels.Set{}
+	obj, exists, err := c.namespaceStore.GetByKey(namespace)
+	if err != nil {
+		return nil, nil, err
+	}
+	if exists {
+		namespaceLabels = obj.(*k8sv1.Namespace).Labels
+	}
+
+	for i := range policies {
+		selector, err := metav1.LabelSelectorAsSelector(&policies[i].NamespaceSelector)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid namespaceSelector of instance type namespace policy %d: %v", i, err)
+		}
+		if !selector.Matches(namespaceLabels) {
+			continue
+		}
+		if len(policies[i].ClusterInstancetypes) > 0 {
+			allowedInstancetypes = sets.New(policies[i].ClusterInstancetypes...).Union(allowedInstancetypes)
+		}
+		if len(policies[i].ClusterPreferences) > 0 {
+			allowedPreferences = sets.New(policies[i].ClusterPreferences...).Union(allowedPreferences)
+		}
+	}
+	return allowedInstancetypes, allowedPreferences, nil
+}
+
+func isClusterInstancetype(kind string) bool {
+	switch strings.ToLower(kind) {
+	case api.ClusterSingularResourceName, api.ClusterPluralResourceName, "":
+		return true
+	}
+	return false
+}
+
+func isClusterPreference(kind string) bool {
+	switch strings.ToLower(kind) {
+	case api.ClusterSingularPreferenceResourceName, api.ClusterPluralPreferenceResourceName, "":
+		return true
+	}
+	return false
+}
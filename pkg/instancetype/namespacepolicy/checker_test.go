@@ -0,0 +1,113 @@
+package namespacepolicy_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	k8sv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/instancetype/namespacepolicy"
+	"kubevirt.io/kubevirt/pkg/libvmi"
+)
+
+var _ = Describe("Instancetype - Namespace policies", func() {
+	const (
+		dataNamespace   = "data"
+		tenantNamespace = "tenant"
+	)
+
+	var (
+		namespaceStore cache.Store
+		policies       []v1.InstancetypeNamespacePolicy
+	)
+
+	BeforeEach(func() {
+		namespaceStore = cache.NewStore(cache.MetaNamespaceKeyFunc)
+		for name, team := range map[string]string{dataNamespace: "data", tenantNamespace: "tenant"} {
+			Expect(namespaceStore.Add(&k8sv1.Namespace{
+				ObjectMeta: metav1.ObjectMeta{Name: name, Labels: map[string]string{"team": team}},
+			})).To(Succeed())
+		}
+
+		policies = []v1.InstancetypeNamespacePolicy{
+			{
+				ClusterInstancetypes: []string{"small", "medium"},
+			},
+			{
+				NamespaceSelector:    metav1.LabelSelector{MatchLabels: map[string]string{"team": "data"}},
+				ClusterInstancetypes: []string{"large"},
+			},
+			{
+				NamespaceSelector:  metav1.LabelSelector{MatchLabels: map[string]string{"team": "tenant"}},
+				ClusterPreferences: []string{"linux"},
+			},
+		}
+	})
+
+	newVM := func(namespace string, opts ...libvmi.VMOption) *v1.VirtualMachine {
+		return libvmi.NewVirtualMachine(libvmi.New(libvmi.WithNamespace(namespace)), opts...)
+	}
+
+	DescribeTable("should allow", func(namespace string, opts ...libvmi.VMOption) {
+		causes, err := namespacepolicy.New(namespaceStore).Check(policies, newVM(namespace, opts...))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(causes).To(BeEmpty())
+	},
+		Entry("a cluster instance type allowed by a policy selecting all namespaces",
+			tenantNamespace, libvmi.WithClusterInstancetype("small")),
+		Entry("a cluster instance type allowed by a policy selecting the namespace",
+			dataNamespace, libvmi.WithClusterInstancetype("large")),
+		Entry("a namespaced instance type", tenantNamespace, libvmi.WithInstancetype("large")),
+		Entry("any cluster preference if no policy selecting the namespace lists preferences",
+			dataNamespace, libvmi.WithClusterPreference("windows")),
+		Entry("a cluster preference allowed by a policy selecting the namespace",
+			tenantNamespace, libvmi.WithClusterPreference("linux")),
+		Entry("a VM without references", tenantNamespace),
+	)
+
+	It("should reject a cluster instance type reserved for another namespace", func() {
+		causes, err := namespacepolicy.New(namespaceStore).Check(policies, newVM(tenantNamespace, libvmi.WithClusterInstancetype("large")))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(causes).To(ConsistOf(metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueNotSupported,
+			Message: "cluster instance type large is not allowed in namespace tenant",
+			Field:   "spec.instancetype.name",
+		}))
+	})
+
+	It("should reject a cluster preference not allowed in the namespace", func() {
+		causes, err := namespacepolicy.New(namespaceStore).Check(policies, newVM(tenantNamespace, libvmi.WithClusterPreference("windows")))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(causes).To(ConsistOf(metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueNotSupported,
+			Message: "cluster preference windows is not allowed in namespace tenant",
+			Field:   "spec.preference.name",
+		}))
+	})
+
+	DescribeTable("should check cluster preferences inherited in a namespace", func(namespace, name, expectedErr string) {
+		err := namespacepolicy.New(namespaceStore).CheckClusterPreference(policies, namespace, name)
+		if expectedErr == "" {
+			Expect(err).ToNot(HaveOccurred())
+		} else {
+			Expect(err).To(MatchError(expectedErr))
+		}
+	},
+		Entry("allowing a cluster preference allowed in the namespace", tenantNamespace, "linux", ""),
+		Entry("allowing any cluster preference if no policy selecting the namespace lists preferences", dataNamespace, "windows", ""),
+		Entry("rejecting a cluster preference not allowed in the namespace", tenantNamespace, "windows",
+			"cluster preference windows is not allowed in namespace tenant"),
+	)
+
+	It("should fail with an invalid namespace selector", func() {
+		policies[0].NamespaceSelector = metav1.LabelSelector{
+			MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "team", Operator: "Unknown"}},
+		}
+		_, err := namespacepolicy.New(namespaceStore).Check(policies, newVM(tenantNamespace, libvmi.WithClusterInstancetype("small")))
+		Expect(err).To(MatchError(ContainSubstring("invalid namespaceSelector of instance type namespace policy 0")))
+	})
+})
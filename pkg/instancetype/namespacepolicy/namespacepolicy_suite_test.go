@@ -0,0 +1,13 @@
+package namespacepolicy_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestNamespacePolicy(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "NamespacePolicy Suite")
+}
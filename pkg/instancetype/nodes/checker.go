@@ -0,0 +1,137 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+package nodes
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+
+	virtv1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/api/instancetype/v1beta1"
+)
+
+type checker struct {
+	nodeStore cache.Store
+}
+
+func New(nodeStore cache.Store) *checker {
+	return &checker{
+		nodeStore: nodeStore,
+	}
+}
+
+type requirements struct {
+	dedicatedCPUs bool
+	resources     k8sv1.ResourceList
+}
+
+// Check returns an error if no schedulable node matching the node selector of the VirtualMachineInstance advertises
+// all of the hugepages, dedicated CPUs, GPUs and host devices requested by the instance type.
+func (c *checker) Check(instancetypeSpec *v1beta1.VirtualMachineInstancetypeSpec, vmiSpec *virtv1.VirtualMachineInstanceSpec) error {
+	if instancetypeSpec == nil {
+		return nil
+	}
+	required := requirementsOf(instancetypeSpec)
+	if !required.dedicatedCPUs && len(required.resources) == 0 {
+		return nil
+	}
+
+	selector := labels.SelectorFromSet(vmiSpec.NodeSelector)
+	for _, obj := range c.nodeStore.List() {
+		node, ok := obj.(*k8sv1.Node)
+		if !ok {
+			continue
+		}
+		if isSchedulable(node) && selector.Matches(labels.Set(node.Labels)) && provides(node, required) {
+			return nil
+		}
+	}
+	return fmt.Errorf("no schedulable node provides %s", required)
+}
+
+func requirementsOf(instancetypeSpec *v1beta1.VirtualMachineInstancetypeSpec) requirements {
+	required := requirements{
+		resources: k8sv1.ResourceList{},
+	}
+
+	if instancetypeSpec.CPU.DedicatedCPUPlacement != nil && *instancetypeSpec.CPU.DedicatedCPUPlacement {
+		required.dedicatedCPUs = true
+		required.resources[k8sv1.ResourceCPU] = *resource.NewQuantity(int64(instancetypeSpec.CPU.Guest), resource.DecimalSI)
+	}
+	if instancetypeSpec.Memory.Hugepages != nil && instancetypeSpec.Memory.Hugepages.PageSize != "" {
+		name := k8sv1.ResourceName(k8sv1.ResourceHugePagesPrefix + instancetypeSpec.Memory.Hugepages.PageSize)
+		required.resources[name] = instancetypeSpec.Memory.Guest.DeepCopy()
+	}
+	for _, gpu := range instancetypeSpec.GPUs {
+		addDevice(required.resources, gpu.DeviceName)
+	}
+	for _, hostDevice := range instancetypeSpec.HostDevices {
+		addDevice(required.resources, hostDevice.DeviceName)
+	}
+	return required
+}
+
+func addDevice(resources k8sv1.ResourceList, deviceName string) {
+	name := k8sv1.ResourceName(deviceName)
+	quantity := resources[name]
+	quantity.Add(*resource.NewQuantity(1, resource.DecimalSI))
+	resources[name] = quantity
+}
+
+// isSchedulable returns true for nodes accepting new pods with a ready virt-handler
+func isSchedulable(node *k8sv1.Node) bool {
+	return !node.Spec.Unschedulable && node.Labels[virtv1.NodeSchedulable] == "true"
+}
+
+func provides(node *k8sv1.Node, required requirements) bool {
+	if required.dedicatedCPUs && node.Labels[virtv1.CPUManager] != "true" {
+		return false
+	}
+	for name, quantity := range required.resources {
+		allocatable, exists := node.Status.Allocatable[name]
+		if !exists || allocatable.Cmp(quantity) < 0 {
+			return false
+		}
+	}
+	return true
+}
+
+func (r requirements) String() string {
+	names := make([]string, 0, len(r.resources))
+	for name := range r.resources {
+		names = append(names, string(name))
+	}
+	sort.Strings(names)
+
+	parts := make([]string, 0, len(names)+1)
+	if r.dedicatedCPUs {
+		parts = append(parts, "dedicated CPUs")
+	}
+	for _, name := range names {
+		quantity := r.resources[k8sv1.ResourceName(name)]
+		parts = append(parts, fmt.Sprintf("%s=%s", name, quantity.String()))
+	}
+	return strings.Join(parts, ", ")
+}
@@ -0,0 +1,133 @@
+package nodes_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/api/instancetype/v1beta1"
+
+	"kubevirt.io/kubevirt/pkg/instancetype/nodes"
+	"kubevirt.io/kubevirt/pkg/pointer"
+)
+
+var _ = Describe("Instancetype - Nodes", func() {
+	var nodeStore cache.Store
+
+	BeforeEach(func() {
+		nodeStore = cache.NewStore(cache.MetaNamespaceKeyFunc)
+	})
+
+	addNode := func(name string, nodeLabels map[string]string, allocatable k8sv1.ResourceList) *k8sv1.Node {
+		node := &k8sv1.Node{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   name,
+				Labels: map[string]string{v1.NodeSchedulable: "true"},
+			},
+			Status: k8sv1.NodeStatus{
+				Allocatable: allocatable,
+			},
+		}
+		for key, value := range nodeLabels {
+			node.Labels[key] = value
+		}
+		Expect(nodeStore.Add(node)).To(Succeed())
+		return node
+	}
+
+	hugepagesInstancetype := func() *v1beta1.VirtualMachineInstancetypeSpec {
+		return &v1beta1.VirtualMachineInstancetypeSpec{
+			CPU: v1beta1.CPUInstancetype{Guest: 4},
+			Memory: v1beta1.MemoryInstancetype{
+				Guest:     resource.MustParse("4Gi"),
+				Hugepages: &v1.Hugepages{PageSize: "1Gi"},
+			},
+		}
+	}
+
+	It("should pass when the instance type does not need special resources", func() {
+		instancetypeSpec := &v1beta1.VirtualMachineInstancetypeSpec{
+			CPU:    v1beta1.CPUInstancetype{Guest: 4},
+			Memory: v1beta1.MemoryInstancetype{Guest: resource.MustParse("4Gi")},
+		}
+		Expect(nodes.New(nodeStore).Check(instancetypeSpec, &v1.VirtualMachineInstanceSpec{})).To(Succeed())
+	})
+
+	It("should pass when a node provides all resources", func() {
+		addNode("node01", map[string]string{v1.CPUManager: "true"}, k8sv1.ResourceList{
+			k8sv1.ResourceCPU:  resource.MustParse("8"),
+			"hugepages-1Gi":    resource.MustParse("8Gi"),
+			"nvidia.com/A100":  resource.MustParse("2"),
+			"intel.com/qat_vf": resource.MustParse("1"),
+		})
+
+		instancetypeSpec := hugepagesInstancetype()
+		instancetypeSpec.CPU.DedicatedCPUPlacement = pointer.P(true)
+		instancetypeSpec.GPUs = []v1.GPU{
+			{Name: "gpu1", DeviceName: "nvidia.com/A100"},
+			{Name: "gpu2", DeviceName: "nvidia.com/A100"},
+		}
+		instancetypeSpec.HostDevices = []v1.HostDevice{{Name: "qat", DeviceName: "intel.com/qat_vf"}}
+
+		Expect(nodes.New(nodeStore).Check(instancetypeSpec, &v1.VirtualMachineInstanceSpec{})).To(Succeed())
+	})
+
+	It("should fail when the resources are only provided by different nodes", func() {
+		addNode("node01", nil, k8sv1.ResourceList{"hugepages-1Gi": resource.MustParse("8Gi")})
+		addNode("node02", nil, k8sv1.ResourceList{"nvidia.com/A100": resource.MustParse("1")})
+
+		instancetypeSpec := hugepagesInstancetype()
+		instancetypeSpec.GPUs = []v1.GPU{{Name: "gpu1", DeviceName: "nvidia.com/A100"}}
+
+		Expect(nodes.New(nodeStore).Check(instancetypeSpec, &v1.VirtualMachineInstanceSpec{})).To(
+			MatchError("no schedulable node provides hugepages-1Gi=4Gi, nvidia.com/A100=1"))
+	})
+
+	It("should fail when no node provides the GPUs requested more than once", func() {
+		addNode("node01", nil, k8sv1.ResourceList{"nvidia.com/A100": resource.MustParse("1")})
+
+		instancetypeSpec := &v1beta1.VirtualMachineInstancetypeSpec{
+			GPUs: []v1.GPU{
+				{Name: "gpu1", DeviceName: "nvidia.com/A100"},
+				{Name: "gpu2", DeviceName: "nvidia.com/A100"},
+			},
+		}
+		Expect(nodes.New(nodeStore).Check(instancetypeSpec, &v1.VirtualMachineInstanceSpec{})).To(
+			MatchError("no schedulable node provides nvidia.com/A100=2"))
+	})
+
+	It("should fail when no node has the CPU manager enabled", func() {
+		addNode("node01", nil, k8sv1.ResourceList{k8sv1.ResourceCPU: resource.MustParse("8")})
+
+		instancetypeSpec := &v1beta1.VirtualMachineInstancetypeSpec{
+			CPU: v1beta1.CPUInstancetype{Guest: 4, DedicatedCPUPlacement: pointer.P(true)},
+		}
+		Expect(nodes.New(nodeStore).Check(instancetypeSpec, &v1.VirtualMachineInstanceSpec{})).To(
+			MatchError("no schedulable node provides dedicated CPUs, cpu=4"))
+	})
+
+	It("should ignore unschedulable nodes", func() {
+		node := addNode("node01", nil, k8sv1.ResourceList{"hugepages-1Gi": resource.MustParse("8Gi")})
+		node.Spec.Unschedulable = true
+		addNode("node02", map[string]string{v1.NodeSchedulable: "false"}, k8sv1.ResourceList{"hugepages-1Gi": resource.MustParse("8Gi")})
+
+		Expect(nodes.New(nodeStore).Check(hugepagesInstancetype(), &v1.VirtualMachineInstanceSpec{})).ToNot(Succeed())
+	})
+
+	It("should only consider nodes matching the node selector", func() {
+		addNode("node01", map[string]string{"zone": "a"}, k8sv1.ResourceList{"hugepages-1Gi": resource.MustParse("8Gi")})
+
+		checker := nodes.New(nodeStore)
+		Expect(checker.Check(hugepagesInstancetype(), &v1.VirtualMachineInstanceSpec{
+			NodeSelector: map[string]string{"zone": "a"},
+		})).To(Succeed())
+		Expect(checker.Check(hugepagesInstancetype(), &v1.VirtualMachineInstanceSpec{
+			NodeSelector: map[string]string{"zone": "b"},
+		})).ToNot(Succeed())
+	})
+})
@@ -0,0 +1,167 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+package find
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	jsonpatch "github.com/evanphx/json-patch"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/cache"
+
+	api "kubevirt.io/api/instancetype"
+	"kubevirt.io/api/instancetype/v1beta1"
+	"kubevirt.io/client-go/kubecli"
+)
+
+const maxInheritanceDepth = 8
+
+// ClusterPreferencePolicy returns an error if the preferences of the namespace may not use the cluster preference
+type ClusterPreferencePolicy func(namespace, name string) error
+
+type inheritanceResolver struct {
+	preferenceFinder        *preferenceFinder
+	clusterPreferenceFinder *clusterPreferenceFinder
+	clusterPreferencePolicy ClusterPreferencePolicy
+}
+
+func NewInheritanceResolver(store, clusterStore cache.Store, virtClient kubecli.KubevirtClient) *inheritanceResolver {
+	return &inheritanceResolver{
+		preferenceFinder:        NewPreferenceFinder(store, virtClient),
+		clusterPreferenceFinder: NewClusterPreferenceFinder(clusterStore, virtClient),
+	}
+}
+
+// WithClusterPreferencePolicy makes the resolver refuse namespaced preferences inheriting from cluster preferences
+// which the policy does not allow in their namespace, as a VirtualMachine could not reference those directly either
+func (r *inheritanceResolver) WithClusterPreferencePolicy(policy ClusterPreferencePolicy) *inheritanceResolver {
+	r.clusterPreferencePolicy = policy
+	return r
+}
+
+// ResolvePreference returns the spec of the preference merged with the specs of the preferences it inherits from
+func (r *inheritanceResolver) ResolvePreference(
+	preference *v1beta1.VirtualMachinePreference,
+) (*v1beta1.VirtualMachinePreferenceSpec, error) {
+	return r.resolve(preference.Namespace, &preference.Spec, []string{preferenceKey(preference.Namespace, preference.Name)})
+}
+
+// ResolveClusterPreference returns the spec of the cluster preference merged with the specs of the cluster preferences it inherits from
+func (r *inheritanceResolver) ResolveClusterPreference(
+	clusterPreference *v1beta1.VirtualMachineClusterPreference,
+) (*v1beta1.VirtualMachinePreferenceSpec, error) {
+	return r.resolve("", &clusterPreference.Spec, []string{clusterPreferenceKey(clusterPreference.Name)})
+}
+
+// resolve merges the specs of the base preferences and then the spec itself, namespace is empty for cluster preferences
+func (r *inheritanceResolver) resolve(
+	namespace string, spec *v1beta1.VirtualMachinePreferenceSpec, chain []string,
+) (*v1beta1.VirtualMachinePreferenceSpec, error) {
+	if len(spec.InheritFrom) == 0 {
+		return spec, nil
+	}
+	if len(chain) > maxInheritanceDepth {
+		return nil, fmt.Errorf("preference inheritance is nested deeper than %d levels: %s", maxInheritanceDepth, strings.Join(chain, " -> "))
+	}
+
+	merged := &v1beta1.VirtualMachinePreferenceSpec{}
+	for _, ref := range spec.InheritFrom {
+		baseNamespace, key, baseSpec, err := r.findBase(namespace, ref)
+		if err != nil {
+			return nil, err
+		}
+		if slices.Contains(chain, key) {
+			return nil, fmt.Errorf("preference inheritance cycle: %s", strings.Join(append(chain, key), " -> "))
+		}
+		resolvedBase, err := r.resolve(baseNamespace, baseSpec, append(slices.Clone(chain), key))
+		if err != nil {
+			return nil, err
+		}
+		if merged, err = mergePreferenceSpecs(merged, resolvedBase); err != nil {
+			return nil, err
+		}
+	}
+
+	own := spec.DeepCopy()
+	own.InheritFrom = nil
+	return mergePreferenceSpecs(merged, own)
+}
+
+func (r *inheritanceResolver) findBase(
+	namespace string, ref v1beta1.PreferenceReference,
+) (baseNamespace, key string, spec *v1beta1.VirtualMachinePreferenceSpec, err error) {
+	switch strings.ToLower(ref.Kind) {
+	case api.SingularPreferenceResourceName, api.PluralPreferenceResourceName:
+		if namespace == "" {
+			return "", "", nil, fmt.Errorf("VirtualMachineClusterPreferences cannot inherit from VirtualMachinePreference %s", ref.Name)
+		}
+		preference, err := r.preferenceFinder.find(types.NamespacedName{Namespace: namespace, Name: ref.Name})
+		if err != nil {
+			return "", "", nil, err
+		}
+		return namespace, preferenceKey(namespace, ref.Name), &preference.Spec, nil
+	case api.ClusterSingularPreferenceResourceName, api.ClusterPluralPreferenceResourceName, "":
+		// The bases of cluster preferences are chosen by the cluster admin, only those of namespaced preferences are checked
+		if namespace != "" && r.clusterPreferencePolicy != nil {
+			if err := r.clusterPreferencePolicy(namespace, ref.Name); err != nil {
+				return "", "", nil, err
+			}
+		}
+		clusterPreference, err := r.clusterPreferenceFinder.find(ref.Name)
+		if err != nil {
+			return "", "", nil, err
+		}
+		return "", clusterPreferenceKey(ref.Name), &clusterPreference.Spec, nil
+	default:
+		return "", "", nil, fmt.Errorf("got unexpected kind in PreferenceReference: %s", ref.Kind)
+	}
+}
+
+// mergePreferenceSpecs applies the attributes set in override on top of base as a JSON merge patch
+func mergePreferenceSpecs(base, override *v1beta1.VirtualMachinePreferenceSpec) (*v1beta1.VirtualMachinePreferenceSpec, error) {
+	baseJSON, err := json.Marshal(base)
+	if err != nil {
+		return nil, err
+	}
+	overrideJSON, err := json.Marshal(override)
+	if err != nil {
+		return nil, err
+	}
+	mergedJSON, err := jsonpatch.MergePatch(baseJSON, overrideJSON)
+	if err != nil {
+		return nil, err
+	}
+
+	merged := &v1beta1.VirtualMachinePreferenceSpec{}
+	if err := json.Unmarshal(mergedJSON, merged); err != nil {
+		return nil, err
+	}
+	return merged, nil
+}
+
+func preferenceKey(namespace, name string) string {
+	return fmt.Sprintf("VirtualMachinePreference %s/%s", namespace, name)
+}
+
+func clusterPreferenceKey(name string) string {
+	return fmt.Sprintf("VirtualMachineClusterPreference %s", name)
+}
@@ -0,0 +1,236 @@
+package find_test
+
+import (
+	"fmt"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/api/instancetype/v1beta1"
+	"kubevirt.io/client-go/kubecli"
+	"kubevirt.io/client-go/kubevirt/fake"
+
+	"kubevirt.io/kubevirt/pkg/instancetype/preference/find"
+	"kubevirt.io/kubevirt/pkg/libvmi"
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/testutils"
+)
+
+var _ = Describe("Preference inheritance", func() {
+	type preferenceSpecFinder interface {
+		FindPreference(vm *v1.VirtualMachine) (*v1beta1.VirtualMachinePreferenceSpec, error)
+	}
+
+	var (
+		finder                         preferenceSpecFinder
+		preferenceInformerStore        cache.Store
+		clusterPreferenceInformerStore cache.Store
+	)
+
+	BeforeEach(func() {
+		virtClient := kubecli.NewMockKubevirtClient(gomock.NewController(GinkgoT()))
+		fakeClientset := fake.NewSimpleClientset()
+		virtClient.EXPECT().VirtualMachineClusterPreference().Return(
+			fakeClientset.InstancetypeV1beta1().VirtualMachineClusterPreferences()).AnyTimes()
+		virtClient.EXPECT().VirtualMachinePreference(metav1.NamespaceDefault).Return(
+			fakeClientset.InstancetypeV1beta1().VirtualMachinePreferences(metav1.NamespaceDefault)).AnyTimes()
+
+		preferenceInformer, _ := testutils.NewFakeInformerFor(&v1beta1.VirtualMachinePreference{})
+		preferenceInformerStore = preferenceInformer.GetStore()
+		clusterPreferenceInformer, _ := testutils.NewFakeInformerFor(&v1beta1.VirtualMachineClusterPreference{})
+		clusterPreferenceInformerStore = clusterPreferenceInformer.GetStore()
+
+		finder = find.NewSpecFinder(preferenceInformerStore, clusterPreferenceInformerStore, nil, virtClient)
+	})
+
+	addClusterPreference := func(name string, spec v1beta1.VirtualMachinePreferenceSpec) {
+		Expect(clusterPreferenceInformerStore.Add(&v1beta1.VirtualMachineClusterPreference{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec:       spec,
+		})).To(Succeed())
+	}
+
+	addPreference := func(name string, spec v1beta1.VirtualMachinePreferenceSpec) {
+		Expect(preferenceInformerStore.Add(&v1beta1.VirtualMachinePreference{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: metav1.NamespaceDefault},
+			Spec:       spec,
+		})).To(Succeed())
+	}
+
+	newVM := func(opts ...libvmi.VMOption) *v1.VirtualMachine {
+		return libvmi.NewVirtualMachine(libvmi.New(libvmi.WithNamespace(metav1.NamespaceDefault)), opts...)
+	}
+
+	clusterBase := func(name string) v1beta1.PreferenceReference {
+		return v1beta1.PreferenceReference{Name: name, Kind: "VirtualMachineClusterPreference"}
+	}
+
+	It("should merge the base preferences in order and apply the attributes of the preference last", func() {
+		addClusterPreference("firmware", v1beta1.VirtualMachinePreferenceSpec{
+			Firmware: &v1beta1.FirmwarePreferences{
+				PreferredEfi: &v1.EFI{SecureBoot: pointer.P(true)},
+			},
+			Annotations:                   map[string]string{"base": "firmware", "team": "platform"},
+			PreferredSubdomain:            pointer.P("firmware"),
+			PreferSpreadSocketToCoreRatio: 4,
+		})
+		addClusterPreference("devices", v1beta1.VirtualMachinePreferenceSpec{
+			Devices: &v1beta1.DevicePreferences{
+				PreferredDiskBus:        v1.DiskBusVirtio,
+				PreferredInterfaceModel: v1.VirtIO,
+			},
+			Annotations:        map[string]string{"base": "devices"},
+			PreferredSubdomain: pointer.P("devices"),
+		})
+		addPreference("derived", v1beta1.VirtualMachinePreferenceSpec{
+			InheritFrom: []v1beta1.PreferenceReference{clusterBase("firmware"), {Name: "devices"}},
+			Devices: &v1beta1.DevicePreferences{
+				PreferredDiskBus: v1.DiskBusSATA,
+			},
+			Annotations: map[string]string{"team": "vms"},
+		})
+
+		spec, err := finder.FindPreference(newVM(libvmi.WithPreference("derived")))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(spec).To(HaveValue(Equal(v1beta1.VirtualMachinePreferenceSpec{
+			Firmware: &v1beta1.FirmwarePreferences{
+				PreferredEfi: &v1.EFI{SecureBoot: pointer.P(true)},
+			},
+			Devices: &v1beta1.DevicePreferences{
+				PreferredDiskBus:        v1.DiskBusSATA,
+				PreferredInterfaceModel: v1.VirtIO,
+			},
+			Annotations:                   map[string]string{"base": "devices", "team": "vms"},
+			PreferredSubdomain:            pointer.P("devices"),
+			PreferSpreadSocketToCoreRatio: 4,
+		})))
+	})
+
+	It("should resolve nested inheritance between namespaced and cluster preferences", func() {
+		addClusterPreference("root", v1beta1.VirtualMachinePreferenceSpec{
+			PreferredTerminationGracePeriodSeconds: pointer.P(int64(60)),
+		})
+		addPreference("middle", v1beta1.VirtualMachinePreferenceSpec{
+			InheritFrom:        []v1beta1.PreferenceReference{clusterBase("root")},
+			PreferredSubdomain: pointer.P("middle"),
+		})
+		addPreference("leaf", v1beta1.VirtualMachinePreferenceSpec{
+			InheritFrom: []v1beta1.PreferenceReference{{Name: "middle", Kind: "VirtualMachinePreference"}},
+		})
+
+		spec, err := finder.FindPreference(newVM(libvmi.WithPreference("leaf")))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(spec).To(HaveValue(Equal(v1beta1.VirtualMachinePreferenceSpec{
+			PreferredSubdomain:                     pointer.P("middle"),
+			PreferredTerminationGracePeriodSeconds: pointer.P(int64(60)),
+		})))
+	})
+
+	It("should not modify the cached preferences", func() {
+		addClusterPreference("base", v1beta1.VirtualMachinePreferenceSpec{PreferredSubdomain: pointer.P("base")})
+		addClusterPreference("derived", v1beta1.VirtualMachinePreferenceSpec{
+			InheritFrom: []v1beta1.PreferenceReference{clusterBase("base")},
+		})
+
+		_, err := finder.FindPreference(newVM(libvmi.WithClusterPreference("derived")))
+		Expect(err).ToNot(HaveOccurred())
+
+		obj, exists, err := clusterPreferenceInformerStore.GetByKey("derived")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(exists).To(BeTrue())
+		Expect(obj.(*v1beta1.VirtualMachineClusterPreference).Spec).To(Equal(v1beta1.VirtualMachinePreferenceSpec{
+			InheritFrom: []v1beta1.PreferenceReference{clusterBase("base")},
+		}))
+	})
+
+	It("should fail on inheritance cycles", func() {
+		addClusterPreference("a", v1beta1.VirtualMachinePreferenceSpec{InheritFrom: []v1beta1.PreferenceReference{clusterBase("b")}})
+		addClusterPreference("b", v1beta1.VirtualMachinePreferenceSpec{InheritFrom: []v1beta1.PreferenceReference{clusterBase("a")}})
+
+		_, err := finder.FindPreference(newVM(libvmi.WithClusterPreference("a")))
+		Expect(err).To(MatchError(
+			"preference inheritance cycle: VirtualMachineClusterPreference a -> VirtualMachineClusterPreference b -> " +
+				"VirtualMachineClusterPreference a"))
+	})
+
+	It("should fail when a cluster preference inherits from a namespaced preference", func() {
+		addPreference("namespaced", v1beta1.VirtualMachinePreferenceSpec{})
+		addClusterPreference("cluster", v1beta1.VirtualMachinePreferenceSpec{
+			InheritFrom: []v1beta1.PreferenceReference{{Name: "namespaced", Kind: "VirtualMachinePreference"}},
+		})
+
+		_, err := finder.FindPreference(newVM(libvmi.WithClusterPreference("cluster")))
+		Expect(err).To(MatchError("VirtualMachineClusterPreferences cannot inherit from VirtualMachinePreference namespaced"))
+	})
+
+	It("should fail when a base preference does not exist", func() {
+		addClusterPreference("derived", v1beta1.VirtualMachinePreferenceSpec{
+			InheritFrom: []v1beta1.PreferenceReference{clusterBase("missing")},
+		})
+
+		_, err := finder.FindPreference(newVM(libvmi.WithClusterPreference("derived")))
+		Expect(errors.IsNotFound(err)).To(BeTrue())
+	})
+
+	It("should fail with an unknown kind of base preference", func() {
+		addClusterPreference("derived", v1beta1.VirtualMachinePreferenceSpec{
+			InheritFrom: []v1beta1.PreferenceReference{{Name: "base", Kind: "foo"}},
+		})
+
+		_, err := finder.FindPreference(newVM(libvmi.WithClusterPreference("derived")))
+		Expect(err).To(MatchError("got unexpected kind in PreferenceReference: foo"))
+	})
+
+	Context("with a cluster preference policy", func() {
+		var policyFinder preferenceSpecFinder
+
+		BeforeEach(func() {
+			virtClient := kubecli.NewMockKubevirtClient(gomock.NewController(GinkgoT()))
+			policyFinder = find.NewSpecFinder(preferenceInformerStore, clusterPreferenceInformerStore, nil, virtClient).
+				WithClusterPreferencePolicy(func(namespace, name string) error {
+					if name == "restricted" {
+						return fmt.Errorf("cluster preference %s is not allowed in namespace %s", name, namespace)
+					}
+					return nil
+				})
+			addClusterPreference("restricted", v1beta1.VirtualMachinePreferenceSpec{PreferredSubdomain: pointer.P("restricted")})
+		})
+
+		It("should fail when a namespaced preference inherits from a cluster preference the policy does not allow", func() {
+			addPreference("derived", v1beta1.VirtualMachinePreferenceSpec{
+				InheritFrom: []v1beta1.PreferenceReference{clusterBase("restricted")},
+			})
+
+			_, err := policyFinder.FindPreference(newVM(libvmi.WithPreference("derived")))
+			Expect(err).To(MatchError("cluster preference restricted is not allowed in namespace default"))
+		})
+
+		It("should fail when a preference inherits from a cluster preference the policy does not allow through a namespaced base", func() {
+			addPreference("base", v1beta1.VirtualMachinePreferenceSpec{
+				InheritFrom: []v1beta1.PreferenceReference{{Name: "restricted"}},
+			})
+			addPreference("derived", v1beta1.VirtualMachinePreferenceSpec{
+				InheritFrom: []v1beta1.PreferenceReference{{Name: "base", Kind: "VirtualMachinePreference"}},
+			})
+
+			_, err := policyFinder.FindPreference(newVM(libvmi.WithPreference("derived")))
+			Expect(err).To(MatchError("cluster preference restricted is not allowed in namespace default"))
+		})
+
+		It("should resolve the bases of cluster preferences", func() {
+			addClusterPreference("derived", v1beta1.VirtualMachinePreferenceSpec{
+				InheritFrom: []v1beta1.PreferenceReference{clusterBase("restricted")},
+			})
+
+			spec, err := policyFinder.FindPreference(newVM(libvmi.WithClusterPreference("derived")))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(spec.PreferredSubdomain).To(HaveValue(Equal("restricted")))
+		})
+	})
+})
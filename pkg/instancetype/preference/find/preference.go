@@ -46,10 +46,13 @@ func (f *preferenceFinder) FindPreference(vm *virtv1.VirtualMachine) (*v1beta1.V
 	if vm.Spec.Preference == nil {
 		return nil, nil
 	}
-	namespacedName := types.NamespacedName{
+	return f.find(types.NamespacedName{
 		Namespace: vm.Namespace,
 		Name:      vm.Spec.Preference.Name,
-	}
+	})
+}
+
+func (f *preferenceFinder) find(namespacedName types.NamespacedName) (*v1beta1.VirtualMachinePreference, error) {
 	if f.store == nil {
 		return f.virtClient.VirtualMachinePreference(namespacedName.Namespace).Get(
 			context.Background(), namespacedName.Name, metav1.GetOptions{})
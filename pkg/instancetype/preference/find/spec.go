@@ -36,6 +36,7 @@ type specFinder struct {
 	preferenceFinder        *preferenceFinder
 	clusterPreferenceFinder *clusterPreferenceFinder
 	revisionFinder          *revisionFinder
+	inheritanceResolver     *inheritanceResolver
 }
 
 func NewSpecFinder(store, clusterStore, revisionStore cache.Store, virtClient kubecli.KubevirtClient) *specFinder {
@@ -43,9 +44,16 @@ func NewSpecFinder(store, clusterStore, revisionStore cache.Store, virtClient ku
 		preferenceFinder:        NewPreferenceFinder(store, virtClient),
 		clusterPreferenceFinder: NewClusterPreferenceFinder(clusterStore, virtClient),
 		revisionFinder:          NewRevisionFinder(revisionStore, virtClient),
+		inheritanceResolver:     NewInheritanceResolver(store, clusterStore, virtClient),
 	}
 }
 
+// WithClusterPreferencePolicy applies the policy to the cluster preferences inherited by namespaced preferences
+func (f *specFinder) WithClusterPreferencePolicy(policy ClusterPreferencePolicy) *specFinder {
+	f.inheritanceResolver.WithClusterPreferencePolicy(policy)
+	return f
+}
+
 const unexpectedKindFmt = "got unexpected kind in PreferenceMatcher: %s"
 
 func (f *specFinder) FindPreference(vm *virtv1.VirtualMachine) (*v1beta1.VirtualMachinePreferenceSpec, error) {
@@ -67,13 +75,13 @@ func (f *specFinder) FindPreference(vm *virtv1.VirtualMachine) (*v1beta1.Virtual
 		if err != nil {
 			return nil, err
 		}
-		return &preference.Spec, nil
+		return f.inheritanceResolver.ResolvePreference(preference)
 	case api.ClusterSingularPreferenceResourceName, api.ClusterPluralPreferenceResourceName, "":
 		clusterPreference, err := f.clusterPreferenceFinder.FindPreference(vm)
 		if err != nil {
 			return nil, err
 		}
-		return &clusterPreference.Spec, nil
+		return f.inheritanceResolver.ResolveClusterPreference(clusterPreference)
 	default:
 		return nil, fmt.Errorf(unexpectedKindFmt, vm.Spec.Preference.Kind)
 	}
@@ -228,12 +228,31 @@ func (h *revisionHandler) createPreferenceRevision(vm *virtv1.VirtualMachine) (*
 		if err != nil {
 			return nil, err
 		}
+		// Store the resolved spec so that later changes to the base preferences do not change the VirtualMachine
+		if len(preference.Spec.InheritFrom) > 0 {
+			resolver := preferenceFind.NewInheritanceResolver(h.preferenceStore, h.clusterPreferenceStore, h.virtClient)
+			resolvedSpec, err := resolver.ResolvePreference(preference)
+			if err != nil {
+				return nil, err
+			}
+			preference = preference.DeepCopy()
+			preference.Spec = *resolvedSpec
+		}
 		return h.storeControllerRevision(vm, preference)
 	case api.ClusterSingularPreferenceResourceName, api.ClusterPluralPreferenceResourceName:
 		clusterPreference, err := preferenceFind.NewClusterPreferenceFinder(h.clusterPreferenceStore, h.virtClient).FindPreference(vm)
 		if err != nil {
 			return nil, err
 		}
+		if len(clusterPreference.Spec.InheritFrom) > 0 {
+			resolver := preferenceFind.NewInheritanceResolver(h.preferenceStore, h.clusterPreferenceStore, h.virtClient)
+			resolvedSpec, err := resolver.ResolveClusterPreference(clusterPreference)
+			if err != nil {
+				return nil, err
+			}
+			clusterPreference = clusterPreference.DeepCopy()
+			clusterPreference.Spec = *resolvedSpec
+		}
 		return h.storeControllerRevision(vm, clusterPreference)
 	default:
 		return nil, fmt.Errorf("got unexpected kind in PreferenceMatcher: %s", vm.Spec.Preference.Kind)
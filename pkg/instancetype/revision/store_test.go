@@ -519,6 +519,35 @@ var _ = Describe("Instancetype and Preferences revision handler", func() {
 				Expect(storeHandler.Store(vm)).To(Succeed())
 				Expect(vm.Status.PreferenceRef.InferFromVolumeFailurePolicy).To(HaveValue(Equal(virtv1.IgnoreInferFromVolumeFailure)))
 			})
+
+			It("store VirtualMachineClusterPreference ControllerRevision with the spec of the base preferences", func() {
+				basePreference := &instancetypev1beta1.VirtualMachineClusterPreference{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "base-cluster-preference",
+					},
+					Spec: instancetypev1beta1.VirtualMachinePreferenceSpec{
+						PreferredSubdomain: pointer.P("base"),
+					},
+				}
+				_, err := virtClient.VirtualMachineClusterPreference().Create(context.Background(), basePreference, metav1.CreateOptions{})
+				Expect(err).ToNot(HaveOccurred())
+
+				clusterPreference.Spec.InheritFrom = []instancetypev1beta1.PreferenceReference{{Name: basePreference.Name}}
+				_, err = virtClient.VirtualMachineClusterPreference().Update(context.Background(), clusterPreference, metav1.UpdateOptions{})
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(storeHandler.Store(vm)).To(Succeed())
+
+				createdCR, err := virtClient.AppsV1().ControllerRevisions(vm.Namespace).Get(
+					context.Background(), vm.Status.PreferenceRef.ControllerRevisionRef.Name, metav1.GetOptions{})
+				Expect(err).ToNot(HaveOccurred())
+
+				storedPreference, ok := createdCR.Data.Object.(*instancetypev1beta1.VirtualMachineClusterPreference)
+				Expect(ok).To(BeTrue())
+				Expect(storedPreference.Spec.InheritFrom).To(BeEmpty())
+				Expect(storedPreference.Spec.PreferredSubdomain).To(HaveValue(Equal("base")))
+				Expect(storedPreference.Spec.CPU).To(Equal(clusterPreference.Spec.CPU))
+			})
 		})
 
 		Context("using namespaced Preference", func() {
@@ -0,0 +1,95 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package rightsizing
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/prometheus/client_golang/api"
+	prometheusv1 "github.com/prometheus/client_golang/api/prometheus/v1"
+	"github.com/prometheus/common/model"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+const (
+	// The 95th percentile ignores short bursts, e.g. while booting, which the headroom is meant to absorb
+	cpuUsageQuery = `quantile_over_time(0.95, sum by (namespace, name) ` +
+		`(rate(kubevirt_vmi_vcpu_seconds_total{state="running"}[5m]))[%s:5m])`
+	memoryUsageQuery = `quantile_over_time(0.95, max by (namespace, name) (kubevirt_vmi_memory_used_bytes)[%s:5m])`
+)
+
+type usageSource interface {
+	Usage(ctx context.Context, window time.Duration) (map[types.NamespacedName]Usage, error)
+}
+
+type prometheusSource struct {
+	api prometheusv1.API
+}
+
+func newPrometheusSource(address string) (usageSource, error) {
+	client, err := api.NewClient(api.Config{Address: address})
+	if err != nil {
+		return nil, err
+	}
+	return &prometheusSource{api: prometheusv1.NewAPI(client)}, nil
+}
+
+// Usage returns the usage of the VirtualMachineInstances which reported both their CPU and memory usage
+func (s *prometheusSource) Usage(ctx context.Context, window time.Duration) (map[types.NamespacedName]Usage, error) {
+	cpu, err := s.query(ctx, fmt.Sprintf(cpuUsageQuery, model.Duration(window)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to query the CPU usage of the VMIs: %v", err)
+	}
+	memory, err := s.query(ctx, fmt.Sprintf(memoryUsageQuery, model.Duration(window)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to query the memory usage of the VMIs: %v", err)
+	}
+
+	usages := map[types.NamespacedName]Usage{}
+	for key, cores := range cpu {
+		if bytes, exists := memory[key]; exists {
+			usages[key] = Usage{CPUCores: cores, MemoryBytes: bytes}
+		}
+	}
+	return usages, nil
+}
+
+func (s *prometheusSource) query(ctx context.Context, query string) (map[types.NamespacedName]float64, error) {
+	value, _, err := s.api.Query(ctx, query, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	vector, ok := value.(model.Vector)
+	if !ok {
+		return nil, fmt.Errorf("unexpected result type %s", value.Type())
+	}
+
+	samples := map[types.NamespacedName]float64{}
+	for _, sample := range vector {
+		key := types.NamespacedName{
+			Namespace: string(sample.Metric["namespace"]),
+			Name:      string(sample.Metric["name"]),
+		}
+		samples[key] = float64(sample.Value)
+	}
+	return samples, nil
+}
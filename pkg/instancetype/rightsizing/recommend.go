@@ -0,0 +1,79 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package rightsizing
+
+import (
+	"math"
+	"sort"
+
+	"kubevirt.io/api/instancetype/v1beta1"
+)
+
+// headroom is added on top of the observed usage so the recommended instance type absorbs short peaks
+const headroom = 1.2
+
+// Usage is the sustained usage of a VirtualMachineInstance over the observed window
+type Usage struct {
+	// CPUCores is the number of vCPUs kept busy
+	CPUCores float64
+	// MemoryBytes is the memory used by the guest
+	MemoryBytes float64
+}
+
+// Recommend returns the name of the smallest instance type providing the usage plus headroom, or an empty string if
+// none of them does. Instance types providing dedicated resources are never recommended.
+func Recommend(instancetypes []*v1beta1.VirtualMachineClusterInstancetype, usage Usage) string {
+	requiredCPUs := uint32(math.Max(1, math.Ceil(usage.CPUCores*headroom)))
+	requiredMemory := int64(math.Ceil(usage.MemoryBytes * headroom))
+
+	var candidates []*v1beta1.VirtualMachineClusterInstancetype
+	for _, instancetype := range instancetypes {
+		if hasDedicatedResources(&instancetype.Spec) {
+			continue
+		}
+		if instancetype.Spec.CPU.Guest >= requiredCPUs && instancetype.Spec.Memory.Guest.Value() >= requiredMemory {
+			candidates = append(candidates, instancetype)
+		}
+	}
+	if len(candidates) == 0 {
+		return ""
+	}
+
+	sort.Slice(candidates, func(i, j int) bool {
+		a, b := candidates[i].Spec, candidates[j].Spec
+		if a.CPU.Guest != b.CPU.Guest {
+			return a.CPU.Guest < b.CPU.Guest
+		}
+		if cmp := a.Memory.Guest.Cmp(b.Memory.Guest); cmp != 0 {
+			return cmp < 0
+		}
+		return candidates[i].Name < candidates[j].Name
+	})
+	return candidates[0].Name
+}
+
+// hasDedicatedResources returns true for instance types which are picked for the devices or the placement they
+// provide rather than for their size
+func hasDedicatedResources(spec *v1beta1.VirtualMachineInstancetypeSpec) bool {
+	return len(spec.GPUs) > 0 ||
+		len(spec.HostDevices) > 0 ||
+		spec.Memory.Hugepages != nil ||
+		(spec.CPU.DedicatedCPUPlacement != nil && *spec.CPU.DedicatedCPUPlacement)
+}
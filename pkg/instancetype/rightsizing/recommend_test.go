@@ -0,0 +1,61 @@
+package rightsizing
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	virtv1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/api/instancetype/v1beta1"
+
+	"kubevirt.io/kubevirt/pkg/pointer"
+)
+
+func newClusterInstancetype(name string, cpus uint32, memory string) *v1beta1.VirtualMachineClusterInstancetype {
+	return &v1beta1.VirtualMachineClusterInstancetype{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+		},
+		Spec: v1beta1.VirtualMachineInstancetypeSpec{
+			CPU:    v1beta1.CPUInstancetype{Guest: cpus},
+			Memory: v1beta1.MemoryInstancetype{Guest: resource.MustParse(memory)},
+		},
+	}
+}
+
+var _ = Describe("Instancetype - Rightsizing recommendation", func() {
+	const gib = 1024 * 1024 * 1024
+
+	var instancetypes []*v1beta1.VirtualMachineClusterInstancetype
+
+	BeforeEach(func() {
+		instancetypes = []*v1beta1.VirtualMachineClusterInstancetype{
+			newClusterInstancetype("u1.large", 2, "8Gi"),
+			newClusterInstancetype("u1.small", 1, "2Gi"),
+			newClusterInstancetype("u1.xlarge", 4, "16Gi"),
+			newClusterInstancetype("u1.medium", 1, "4Gi"),
+		}
+	})
+
+	DescribeTable("should recommend the smallest instance type providing the usage plus headroom", func(usage Usage, expected string) {
+		Expect(Recommend(instancetypes, usage)).To(Equal(expected))
+	},
+		Entry("for an idle VM", Usage{}, "u1.small"),
+		Entry("with the memory used close to the size of an instance type", Usage{CPUCores: 0.5, MemoryBytes: 1.8 * gib}, "u1.medium"),
+		Entry("with the CPU usage driving the size", Usage{CPUCores: 1.5, MemoryBytes: 1 * gib}, "u1.large"),
+		Entry("when no instance type is large enough", Usage{CPUCores: 8, MemoryBytes: 1 * gib}, ""),
+	)
+
+	It("should never recommend instance types providing dedicated resources", func() {
+		dedicated := newClusterInstancetype("cx1.small", 1, "2Gi")
+		dedicated.Spec.CPU.DedicatedCPUPlacement = pointer.P(true)
+		gpu := newClusterInstancetype("gn1.small", 1, "2Gi")
+		gpu.Spec.GPUs = []virtv1.GPU{{Name: "gpu1", DeviceName: "nvidia.com/A100"}}
+		hugepages := newClusterInstancetype("m1.small", 1, "2Gi")
+		hugepages.Spec.Memory.Hugepages = &virtv1.Hugepages{PageSize: "2Mi"}
+
+		Expect(Recommend([]*v1beta1.VirtualMachineClusterInstancetype{dedicated, gpu, hugepages}, Usage{})).To(BeEmpty())
+	})
+})
@@ -0,0 +1,189 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package rightsizing
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+
+	virtv1 "kubevirt.io/api/core/v1"
+	instancetypeapi "kubevirt.io/api/instancetype"
+	"kubevirt.io/api/instancetype/v1beta1"
+	"kubevirt.io/client-go/kubecli"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
+)
+
+const (
+	DefaultWindow = 24 * time.Hour
+
+	annotationsPath = "/metadata/annotations"
+)
+
+// Recommender periodically annotates the VirtualMachines with the cluster instance type fitting their usage, if
+// rightsizing is configured in the KubeVirt CR
+type Recommender struct {
+	clientset                   kubecli.KubevirtClient
+	vmInformer                  cache.SharedIndexInformer
+	clusterInstancetypeInformer cache.SharedIndexInformer
+	clusterConfig               *virtconfig.ClusterConfig
+
+	newUsageSource func(address string) (usageSource, error)
+	sourceAddress  string
+	source         usageSource
+}
+
+func NewRecommender(
+	clientset kubecli.KubevirtClient,
+	vmInformer, clusterInstancetypeInformer cache.SharedIndexInformer,
+	clusterConfig *virtconfig.ClusterConfig,
+) *Recommender {
+	return &Recommender{
+		clientset:                   clientset,
+		vmInformer:                  vmInformer,
+		clusterInstancetypeInformer: clusterInstancetypeInformer,
+		clusterConfig:               clusterConfig,
+		newUsageSource:              newPrometheusSource,
+	}
+}
+
+func (r *Recommender) Run(period time.Duration, stopCh <-chan struct{}) {
+	cache.WaitForCacheSync(stopCh, r.vmInformer.HasSynced, r.clusterInstancetypeInformer.HasSynced)
+	wait.Until(func() {
+		if err := r.Sync(); err != nil {
+			log.Log.Reason(err).Warning("failed to recommend instance types for the VirtualMachines")
+		}
+	}, period, stopCh)
+}
+
+func (r *Recommender) Sync() error {
+	config := r.clusterConfig.GetInstancetypeRightsizing()
+	if config == nil {
+		return nil
+	}
+	source, err := r.usageSource(config.PrometheusURL)
+	if err != nil {
+		return err
+	}
+	window := DefaultWindow
+	if config.Window != nil {
+		window = config.Window.Duration
+	}
+	usages, err := source.Usage(context.Background(), window)
+	if err != nil {
+		return err
+	}
+
+	var instancetypes []*v1beta1.VirtualMachineClusterInstancetype
+	for _, obj := range r.clusterInstancetypeInformer.GetStore().List() {
+		instancetypes = append(instancetypes, obj.(*v1beta1.VirtualMachineClusterInstancetype))
+	}
+
+	var errs []error
+	for _, obj := range r.vmInformer.GetStore().List() {
+		vm := obj.(*virtv1.VirtualMachine)
+		usage, exists := usages[types.NamespacedName{Namespace: vm.Namespace, Name: vm.Name}]
+		if !exists {
+			continue
+		}
+		recommended := ""
+		if !r.needsDedicatedResources(vm) {
+			recommended = Recommend(instancetypes, usage)
+		}
+		if err := r.annotate(vm, recommended); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (r *Recommender) usageSource(address string) (usageSource, error) {
+	if r.source != nil && r.sourceAddress == address {
+		return r.source, nil
+	}
+	source, err := r.newUsageSource(address)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create a client for %s: %v", address, err)
+	}
+	r.source, r.sourceAddress = source, address
+	return source, nil
+}
+
+// needsDedicatedResources returns true for VirtualMachines which would lose devices or placement guarantees if they
+// were moved to another instance type
+func (r *Recommender) needsDedicatedResources(vm *virtv1.VirtualMachine) bool {
+	if vm.Spec.Template != nil {
+		devices := vm.Spec.Template.Spec.Domain.Devices
+		if len(devices.GPUs) > 0 || len(devices.HostDevices) > 0 {
+			return true
+		}
+	}
+	if vm.Spec.Instancetype == nil {
+		return false
+	}
+	switch strings.ToLower(vm.Spec.Instancetype.Kind) {
+	case instancetypeapi.ClusterSingularResourceName, instancetypeapi.ClusterPluralResourceName, "":
+		obj, exists, err := r.clusterInstancetypeInformer.GetStore().GetByKey(vm.Spec.Instancetype.Name)
+		if err != nil || !exists {
+			return false
+		}
+		return hasDedicatedResources(&obj.(*v1beta1.VirtualMachineClusterInstancetype).Spec)
+	}
+	return false
+}
+
+func (r *Recommender) annotate(vm *virtv1.VirtualMachine, recommended string) error {
+	current, annotated := vm.Annotations[instancetypeapi.RecommendedInstancetypeAnnotation]
+	if (annotated && current == recommended) || (!annotated && recommended == "") {
+		return nil
+	}
+
+	annotationPath := fmt.Sprintf("%s/%s", annotationsPath, patch.EscapeJSONPointer(instancetypeapi.RecommendedInstancetypeAnnotation))
+	patchSet := patch.New()
+	switch {
+	case recommended == "":
+		patchSet.AddOption(patch.WithRemove(annotationPath))
+	case vm.Annotations == nil:
+		patchSet.AddOption(patch.WithAdd(annotationsPath, map[string]string{
+			instancetypeapi.RecommendedInstancetypeAnnotation: recommended,
+		}))
+	default:
+		patchSet.AddOption(patch.WithAdd(annotationPath, recommended))
+	}
+	patchBytes, err := patchSet.GeneratePayload()
+	if err != nil {
+		return err
+	}
+	if _, err := r.clientset.VirtualMachine(vm.Namespace).Patch(
+		context.Background(), vm.Name, types.JSONPatchType, patchBytes, metav1.PatchOptions{}); err != nil {
+		return fmt.Errorf("unable to annotate VirtualMachine %s/%s with the recommended instance type: %v", vm.Namespace, vm.Name, err)
+	}
+	return nil
+}
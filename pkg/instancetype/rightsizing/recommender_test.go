@@ -0,0 +1,144 @@
+package rightsizing
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	k8sv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/cache"
+
+	virtv1 "kubevirt.io/api/core/v1"
+	instancetypeapi "kubevirt.io/api/instancetype"
+	"kubevirt.io/api/instancetype/v1beta1"
+	"kubevirt.io/client-go/kubecli"
+	kubevirtfake "kubevirt.io/client-go/kubevirt/fake"
+
+	"kubevirt.io/kubevirt/pkg/libvmi"
+	"kubevirt.io/kubevirt/pkg/testutils"
+)
+
+type fakeUsageSource struct {
+	usages map[types.NamespacedName]Usage
+	window time.Duration
+}
+
+func (s *fakeUsageSource) Usage(_ context.Context, window time.Duration) (map[types.NamespacedName]Usage, error) {
+	s.window = window
+	return s.usages, nil
+}
+
+var _ = Describe("Instancetype - Rightsizing recommender", func() {
+	const gib = 1024 * 1024 * 1024
+
+	var (
+		vmInformer                  cache.SharedIndexInformer
+		clusterInstancetypeInformer cache.SharedIndexInformer
+		fakeVirtClient              *kubevirtfake.Clientset
+		virtClient                  *kubecli.MockKubevirtClient
+		source                      *fakeUsageSource
+	)
+
+	BeforeEach(func() {
+		vmInformer, _ = testutils.NewFakeInformerFor(&virtv1.VirtualMachine{})
+		clusterInstancetypeInformer, _ = testutils.NewFakeInformerFor(&v1beta1.VirtualMachineClusterInstancetype{})
+		Expect(clusterInstancetypeInformer.GetStore().Add(newClusterInstancetype("u1.small", 1, "2Gi"))).To(Succeed())
+		Expect(clusterInstancetypeInformer.GetStore().Add(newClusterInstancetype("u1.large", 2, "8Gi"))).To(Succeed())
+
+		fakeVirtClient = kubevirtfake.NewSimpleClientset()
+		virtClient = kubecli.NewMockKubevirtClient(gomock.NewController(GinkgoT()))
+		virtClient.EXPECT().VirtualMachine(k8sv1.NamespaceDefault).Return(
+			fakeVirtClient.KubevirtV1().VirtualMachines(k8sv1.NamespaceDefault)).AnyTimes()
+
+		source = &fakeUsageSource{usages: map[types.NamespacedName]Usage{}}
+	})
+
+	newRecommender := func(rightsizing *virtv1.InstancetypeRightsizing) *Recommender {
+		config, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&virtv1.KubeVirtConfiguration{
+			Instancetype: &virtv1.InstancetypeConfiguration{
+				Rightsizing: rightsizing,
+			},
+		})
+		recommender := NewRecommender(virtClient, vmInformer, clusterInstancetypeInformer, config)
+		recommender.newUsageSource = func(address string) (usageSource, error) {
+			if address != "http://prometheus:9090" {
+				return nil, fmt.Errorf("unexpected address %s", address)
+			}
+			return source, nil
+		}
+		return recommender
+	}
+
+	addVM := func(usage Usage, annotations map[string]string) *virtv1.VirtualMachine {
+		vm := libvmi.NewVirtualMachine(libvmi.New(libvmi.WithNamespace(k8sv1.NamespaceDefault)))
+		vm.Annotations = annotations
+		_, err := fakeVirtClient.KubevirtV1().VirtualMachines(vm.Namespace).Create(context.Background(), vm, metav1.CreateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(vmInformer.GetStore().Add(vm)).To(Succeed())
+		source.usages[types.NamespacedName{Namespace: vm.Namespace, Name: vm.Name}] = usage
+		return vm
+	}
+
+	annotationsOf := func(vm *virtv1.VirtualMachine) map[string]string {
+		updatedVM, err := fakeVirtClient.KubevirtV1().VirtualMachines(vm.Namespace).Get(context.Background(), vm.Name, metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		return updatedVM.Annotations
+	}
+
+	It("should do nothing when rightsizing is not configured", func() {
+		vm := addVM(Usage{CPUCores: 1.5, MemoryBytes: 1 * gib}, nil)
+
+		Expect(newRecommender(nil).Sync()).To(Succeed())
+		Expect(fakeVirtClient.Actions()).To(HaveLen(1))
+		Expect(annotationsOf(vm)).ToNot(HaveKey(instancetypeapi.RecommendedInstancetypeAnnotation))
+	})
+
+	It("should annotate the VMs with the recommended instance type", func() {
+		vm := addVM(Usage{CPUCores: 1.5, MemoryBytes: 1 * gib}, nil)
+		otherVM := addVM(Usage{CPUCores: 0.1, MemoryBytes: 1 * gib}, map[string]string{"other": "annotation"})
+
+		Expect(newRecommender(&virtv1.InstancetypeRightsizing{PrometheusURL: "http://prometheus:9090"}).Sync()).To(Succeed())
+		Expect(annotationsOf(vm)).To(HaveKeyWithValue(instancetypeapi.RecommendedInstancetypeAnnotation, "u1.large"))
+		Expect(annotationsOf(otherVM)).To(HaveKeyWithValue(instancetypeapi.RecommendedInstancetypeAnnotation, "u1.small"))
+		Expect(source.window).To(Equal(DefaultWindow))
+	})
+
+	It("should observe the usage over the configured window", func() {
+		addVM(Usage{}, nil)
+
+		Expect(newRecommender(&virtv1.InstancetypeRightsizing{
+			PrometheusURL: "http://prometheus:9090",
+			Window:        &metav1.Duration{Duration: time.Hour},
+		}).Sync()).To(Succeed())
+		Expect(source.window).To(Equal(time.Hour))
+	})
+
+	It("should not patch VMs already annotated with the recommended instance type", func() {
+		vm := addVM(Usage{}, map[string]string{instancetypeapi.RecommendedInstancetypeAnnotation: "u1.small"})
+
+		Expect(newRecommender(&virtv1.InstancetypeRightsizing{PrometheusURL: "http://prometheus:9090"}).Sync()).To(Succeed())
+		Expect(fakeVirtClient.Actions()).To(HaveLen(1))
+		Expect(annotationsOf(vm)).To(HaveKeyWithValue(instancetypeapi.RecommendedInstancetypeAnnotation, "u1.small"))
+	})
+
+	It("should remove the recommendation when no instance type is large enough", func() {
+		vm := addVM(Usage{CPUCores: 8}, map[string]string{instancetypeapi.RecommendedInstancetypeAnnotation: "u1.large"})
+
+		Expect(newRecommender(&virtv1.InstancetypeRightsizing{PrometheusURL: "http://prometheus:9090"}).Sync()).To(Succeed())
+		Expect(annotationsOf(vm)).ToNot(HaveKey(instancetypeapi.RecommendedInstancetypeAnnotation))
+	})
+
+	It("should not recommend instance types for VMs with host devices", func() {
+		vm := addVM(Usage{}, nil)
+		vm.Spec.Template.Spec.Domain.Devices.GPUs = []virtv1.GPU{{Name: "gpu1", DeviceName: "nvidia.com/A100"}}
+
+		Expect(newRecommender(&virtv1.InstancetypeRightsizing{PrometheusURL: "http://prometheus:9090"}).Sync()).To(Succeed())
+		Expect(annotationsOf(vm)).ToNot(HaveKey(instancetypeapi.RecommendedInstancetypeAnnotation))
+	})
+})
@@ -0,0 +1,13 @@
+package rightsizing
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestRightsizing(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Rightsizing Suite")
+}
@@ -0,0 +1,205 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package upgrade
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/client-go/tools/cache"
+
+	virtv1 "kubevirt.io/api/core/v1"
+	instancetypeapi "kubevirt.io/api/instancetype"
+	"kubevirt.io/api/instancetype/v1beta1"
+	"kubevirt.io/client-go/kubecli"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
+	"kubevirt.io/kubevirt/pkg/instancetype/apply"
+	"kubevirt.io/kubevirt/pkg/instancetype/compatibility"
+	"kubevirt.io/kubevirt/pkg/instancetype/find"
+	"kubevirt.io/kubevirt/pkg/instancetype/revision"
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
+)
+
+const instancetypeControllerRevisionRefPath = "/status/instancetypeRef/controllerRevisionRef"
+
+type instancetypeFinder interface {
+	Find(*virtv1.VirtualMachine) (*v1beta1.VirtualMachineInstancetype, error)
+}
+
+type clusterInstancetypeFinder interface {
+	Find(*virtv1.VirtualMachine) (*v1beta1.VirtualMachineClusterInstancetype, error)
+}
+
+// outdatedRevisionHandler moves VirtualMachines referencing a ControllerRevision of an older version of their instance type
+// to the latest version, following the RevisionUpgradePolicy of the cluster or of the VirtualMachine.
+type outdatedRevisionHandler struct {
+	instancetypeFinder        instancetypeFinder
+	clusterInstancetypeFinder clusterInstancetypeFinder
+	controllerRevisionFinder  controllerRevisionFinder
+	virtClient                kubecli.KubevirtClient
+	clusterConfig             *virtconfig.ClusterConfig
+}
+
+func NewOutdatedRevisionHandler(
+	instancetypeStore, clusterInstancetypeStore, revisionStore cache.Store,
+	virtClient kubecli.KubevirtClient,
+	clusterConfig *virtconfig.ClusterConfig,
+) *outdatedRevisionHandler {
+	return &outdatedRevisionHandler{
+		instancetypeFinder:        find.NewInstancetypeFinder(instancetypeStore, virtClient),
+		clusterInstancetypeFinder: find.NewClusterInstancetypeFinder(clusterInstancetypeStore, virtClient),
+		controllerRevisionFinder:  find.NewControllerRevisionFinder(revisionStore, virtClient),
+		virtClient:                virtClient,
+		clusterConfig:             clusterConfig,
+	}
+}
+
+// Policy returns the RevisionUpgradePolicy of the VirtualMachine, unknown annotation values fall back to the cluster wide policy
+func (h *outdatedRevisionHandler) Policy(vm *virtv1.VirtualMachine) virtv1.InstancetypeRevisionUpgradePolicy {
+	switch policy := virtv1.InstancetypeRevisionUpgradePolicy(vm.Annotations[instancetypeapi.RevisionUpgradePolicyAnnotation]); policy {
+	case virtv1.RevisionUpgradeManual, virtv1.RevisionUpgradeOnRestart:
+		return policy
+	}
+	return h.clusterConfig.GetInstancetypeRevisionUpgradePolicy()
+}
+
+// IsUpgradePending returns true if the VirtualMachine references an outdated ControllerRevision which is going to be
+// replaced while the VirtualMachine is not running.
+func (h *outdatedRevisionHandler) IsUpgradePending(vm *virtv1.VirtualMachine) (bool, error) {
+	if h.Policy(vm) != virtv1.RevisionUpgradeOnRestart {
+		return false, nil
+	}
+	latestSpec, err := h.findLatestSpecIfOutdated(vm)
+	return latestSpec != nil, err
+}
+
+// UpgradeOutdated removes an outdated ControllerRevision from the status of a VirtualMachine which is not running so that
+// a ControllerRevision of the latest version of the instance type is stored in its place. It returns false if nothing
+// was upgraded and the conflict.Conflicts as error if the latest version cannot be applied to the VirtualMachine.
+func (h *outdatedRevisionHandler) UpgradeOutdated(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance) (bool, error) {
+	if vmi != nil && !vmi.IsFinal() {
+		return false, nil
+	}
+	if h.Policy(vm) != virtv1.RevisionUpgradeOnRestart {
+		return false, nil
+	}
+
+	latestSpec, err := h.findLatestSpecIfOutdated(vm)
+	if err != nil || latestSpec == nil {
+		return false, err
+	}
+
+	// Apply the latest version to copies as the VirtualMachine is only changed through the ControllerRevision
+	if conflicts := apply.NewVMIApplier().ApplyToVMI(
+		field.NewPath("spec", "template", "spec"),
+		latestSpec,
+		nil,
+		vm.Spec.Template.Spec.DeepCopy(),
+		vm.Spec.Template.ObjectMeta.DeepCopy(),
+	); len(conflicts) > 0 {
+		return false, conflicts
+	}
+
+	crName := vm.Status.InstancetypeRef.ControllerRevisionRef.Name
+	patchPayload, err := patch.New(
+		patch.WithTest(instancetypeControllerRevisionRefPath+"/name", crName),
+		patch.WithRemove(instancetypeControllerRevisionRefPath),
+	).GeneratePayload()
+	if err != nil {
+		return false, err
+	}
+	if _, err := h.virtClient.VirtualMachine(vm.Namespace).PatchStatus(
+		context.Background(), vm.Name, types.JSONPatchType, patchPayload, metav1.PatchOptions{}); err != nil {
+		return false, err
+	}
+
+	if err := h.virtClient.AppsV1().ControllerRevisions(vm.Namespace).Delete(
+		context.Background(), crName, metav1.DeleteOptions{}); err != nil && !errors.IsNotFound(err) {
+		log.Log.Object(vm).Reason(err).Error("ignoring failure to delete outdated instance type ControllerRevision")
+	}
+	vm.Status.InstancetypeRef.ControllerRevisionRef = nil
+
+	log.Log.Object(vm).Infof("removed outdated instance type ControllerRevision %s", crName)
+	return true, nil
+}
+
+func (h *outdatedRevisionHandler) findLatestSpecIfOutdated(vm *virtv1.VirtualMachine) (*v1beta1.VirtualMachineInstancetypeSpec, error) {
+	// VirtualMachines asking for a specific revision are never moved away from it
+	if vm.Spec.Instancetype == nil || vm.Spec.Instancetype.RevisionName != "" {
+		return nil, nil
+	}
+	statusRef := vm.Status.InstancetypeRef
+	if !revision.HasControllerRevisionRef(statusRef) || statusRef.Name != vm.Spec.Instancetype.Name {
+		return nil, nil
+	}
+
+	cr, err := h.controllerRevisionFinder.Find(types.NamespacedName{
+		Namespace: vm.Namespace,
+		Name:      statusRef.ControllerRevisionRef.Name,
+	})
+	if err != nil {
+		return nil, err
+	}
+	storedSpec, err := compatibility.GetInstancetypeSpec(cr)
+	if err != nil {
+		return nil, err
+	}
+
+	latestSpec, err := h.findLatestSpec(vm)
+	if errors.IsNotFound(err) {
+		// The VirtualMachine keeps using the ControllerRevision of a removed instance type
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if equality.Semantic.DeepEqual(storedSpec, latestSpec) {
+		return nil, nil
+	}
+	return latestSpec, nil
+}
+
+func (h *outdatedRevisionHandler) findLatestSpec(vm *virtv1.VirtualMachine) (*v1beta1.VirtualMachineInstancetypeSpec, error) {
+	switch strings.ToLower(vm.Spec.Instancetype.Kind) {
+	case instancetypeapi.SingularResourceName, instancetypeapi.PluralResourceName:
+		instancetype, err := h.instancetypeFinder.Find(vm)
+		if err != nil {
+			return nil, err
+		}
+		return &instancetype.Spec, nil
+	case instancetypeapi.ClusterSingularResourceName, instancetypeapi.ClusterPluralResourceName, "":
+		clusterInstancetype, err := h.clusterInstancetypeFinder.Find(vm)
+		if err != nil {
+			return nil, err
+		}
+		return &clusterInstancetype.Spec, nil
+	default:
+		return nil, fmt.Errorf("got unexpected kind in InstancetypeMatcher: %s", vm.Spec.Instancetype.Kind)
+	}
+}
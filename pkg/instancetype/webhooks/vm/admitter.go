@@ -68,10 +68,11 @@ type admitter struct {
 	requirementsChecker
 }
 
-func NewAdmitter(virtClient kubecli.KubevirtClient) *admitter {
+func NewAdmitter(virtClient kubecli.KubevirtClient, clusterPreferencePolicy preferenceFind.ClusterPreferencePolicy) *admitter {
 	return &admitter{
-		instancetypeFinder:  find.NewSpecFinder(nil, nil, nil, virtClient),
-		preferenceFinder:    preferenceFind.NewSpecFinder(nil, nil, nil, virtClient),
+		instancetypeFinder: find.NewSpecFinder(nil, nil, nil, virtClient),
+		preferenceFinder: preferenceFind.NewSpecFinder(nil, nil, nil, virtClient).
+			WithClusterPreferencePolicy(clusterPreferencePolicy),
 		requirementsChecker: requirements.New(),
 		applyVMIHandler:     apply.NewVMIApplier(),
 	}
@@ -0,0 +1,42 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package virt_api
+
+import (
+	"github.com/machadovilaca/operator-observability/pkg/operatormetrics"
+)
+
+var (
+	admissionMetrics = []operatormetrics.Metric{
+		admissionWarningsCounter,
+	}
+
+	admissionWarningsCounter = operatormetrics.NewCounterVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_api_admission_warnings_total",
+			Help: "The total number of admission warnings returned for discouraged VM configurations, broken down by warning type.",
+		},
+		[]string{"type"},
+	)
+)
+
+func NewAdmissionWarning(warningType string) {
+	admissionWarningsCounter.WithLabelValues(warningType).Inc()
+}
@@ -38,6 +38,7 @@ func SetupMetrics() error {
 	return operatormetrics.RegisterMetrics(
 		connectionMetrics,
 		vmMetrics,
+		admissionMetrics,
 	)
 }
 
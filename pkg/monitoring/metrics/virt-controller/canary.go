@@ -0,0 +1,103 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright the KubeVirt Authors.
+ *
+ */
+
+package virt_controller
+
+import (
+	"time"
+
+	"github.com/machadovilaca/operator-observability/pkg/operatormetrics"
+	"github.com/prometheus/client_golang/prometheus"
+	io_prometheus_client "github.com/prometheus/client_model/go"
+)
+
+const (
+	CanaryResultSucceeded = "succeeded"
+	CanaryResultFailed    = "failed"
+)
+
+var (
+	canaryMetrics = []operatormetrics.Metric{
+		canaryPhaseDuration,
+		canaryPhasesTotal,
+		canaryLastRunSucceeded,
+	}
+
+	canaryPhaseDuration = operatormetrics.NewHistogramVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_canary_phase_duration_seconds",
+			Help: "Histogram of the duration of the successful phases of canary VirtualMachineInstance runs in seconds.",
+		},
+		prometheus.HistogramOpts{
+			Buckets: PhaseTransitionTimeBuckets(),
+		},
+		[]string{"phase"},
+	)
+
+	canaryPhasesTotal = operatormetrics.NewCounterVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_canary_phases_total",
+			Help: "The total number of phases of canary VirtualMachineInstance runs, broken down by phase and result.",
+		},
+		[]string{"phase", "result"},
+	)
+
+	canaryLastRunSucceeded = operatormetrics.NewGauge(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_canary_last_run_succeeded",
+			Help: "Indication whether all phases of the last finished canary VirtualMachineInstance run succeeded (1) or not (0).",
+		},
+	)
+)
+
+// CanaryPhaseSucceeded records a phase of a canary run which succeeded after the given duration
+func CanaryPhaseSucceeded(phase string, duration time.Duration) {
+	canaryPhaseDuration.WithLabelValues(phase).Observe(duration.Seconds())
+	canaryPhasesTotal.WithLabelValues(phase, CanaryResultSucceeded).Inc()
+}
+
+// CanaryPhaseFailed records a phase of a canary run which failed
+func CanaryPhaseFailed(phase string) {
+	canaryPhasesTotal.WithLabelValues(phase, CanaryResultFailed).Inc()
+}
+
+// SetCanaryLastRunSucceeded records the result of the last finished canary run
+func SetCanaryLastRunSucceeded(succeeded bool) {
+	if succeeded {
+		canaryLastRunSucceeded.Set(1)
+	} else {
+		canaryLastRunSucceeded.Set(0)
+	}
+}
+
+func GetCanaryPhasesTotal(phase, result string) (float64, error) {
+	dto := &io_prometheus_client.Metric{}
+	if err := canaryPhasesTotal.WithLabelValues(phase, result).Write(dto); err != nil {
+		return 0, err
+	}
+	return *dto.Counter.Value, nil
+}
+
+func GetCanaryLastRunSucceeded() (float64, error) {
+	dto := &io_prometheus_client.Metric{}
+	if err := canaryLastRunSucceeded.Write(dto); err != nil {
+		return 0, err
+	}
+	return *dto.Gauge.Value, nil
+}
@@ -0,0 +1,73 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package virt_controller
+
+import (
+	"github.com/machadovilaca/operator-observability/pkg/operatormetrics"
+)
+
+var (
+	circuitBreakerMetrics = []operatormetrics.Metric{
+		reconcileErrors,
+		errorBudgetBurnRatio,
+		circuitBreakerOpen,
+	}
+
+	reconcileErrors = operatormetrics.NewCounterVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_virt_controller_reconcile_errors_total",
+			Help: "Total number of failed reconciles of a controller counting against its error budget.",
+		},
+		[]string{"controller"},
+	)
+
+	errorBudgetBurnRatio = operatormetrics.NewGaugeVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_virt_controller_error_budget_burn_ratio",
+			Help: "Failed reconciles of a controller within the error budget window divided by the error budget. " +
+				"The circuit breaker of the controller opens at 1.",
+		},
+		[]string{"controller"},
+	)
+
+	circuitBreakerOpen = operatormetrics.NewGaugeVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_virt_controller_circuit_breaker_open",
+			Help: "Indication whether the circuit breaker of a controller is open and its reconciles are paused (1) or not (0).",
+		},
+		[]string{"controller"},
+	)
+)
+
+func IncReconcileErrors(controller string) {
+	reconcileErrors.WithLabelValues(controller).Inc()
+}
+
+func SetErrorBudgetBurnRatio(controller string, ratio float64) {
+	errorBudgetBurnRatio.WithLabelValues(controller).Set(ratio)
+}
+
+func SetCircuitBreakerOpen(controller string, open bool) {
+	value := 0.0
+	if open {
+		value = 1.0
+	}
+	circuitBreakerOpen.WithLabelValues(controller).Set(value)
+}
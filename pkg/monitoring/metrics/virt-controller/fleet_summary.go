@@ -0,0 +1,91 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+package virt_controller
+
+import (
+	"github.com/machadovilaca/operator-observability/pkg/operatormetrics"
+
+	virtv1 "kubevirt.io/api/core/v1"
+)
+
+var (
+	fleetSummaryMetrics = []operatormetrics.Metric{
+		fleetVirtualMachines,
+		fleetVirtualMachineInstances,
+		fleetMigrationsInFlight,
+		fleetSchedulableNodes,
+		fleetPendingActions,
+	}
+
+	fleetVirtualMachines = operatormetrics.NewGaugeVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_fleet_summary_vms",
+			Help: "The number of VirtualMachines in the cluster by run strategy.",
+		},
+		[]string{"run_strategy"},
+	)
+
+	fleetVirtualMachineInstances = operatormetrics.NewGaugeVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_fleet_summary_vmis",
+			Help: "The number of VirtualMachineInstances in the cluster by phase.",
+		},
+		[]string{"phase"},
+	)
+
+	fleetMigrationsInFlight = operatormetrics.NewGauge(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_fleet_summary_migrations_in_flight",
+			Help: "The number of VirtualMachineInstanceMigrations which did not finish yet.",
+		},
+	)
+
+	fleetSchedulableNodes = operatormetrics.NewGauge(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_fleet_summary_schedulable_nodes",
+			Help: "The number of nodes VirtualMachineInstances can be scheduled on.",
+		},
+	)
+
+	fleetPendingActions = operatormetrics.NewGaugeVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_fleet_summary_pending_actions",
+			Help: "The number of workloads waiting for an action before the fleet is up to date, by reason.",
+		},
+		[]string{"reason"},
+	)
+)
+
+// SetFleetSummary exposes the fleet summary of the KubeVirt CR
+func SetFleetSummary(summary *virtv1.KubeVirtFleetSummary) {
+	fleetVirtualMachines.Reset()
+	for runStrategy, count := range summary.VirtualMachines {
+		fleetVirtualMachines.WithLabelValues(runStrategy).Set(float64(count))
+	}
+	fleetVirtualMachineInstances.Reset()
+	for phase, count := range summary.VirtualMachineInstances {
+		fleetVirtualMachineInstances.WithLabelValues(phase).Set(float64(count))
+	}
+	fleetMigrationsInFlight.Set(float64(summary.MigrationsInFlight))
+	fleetSchedulableNodes.Set(float64(summary.SchedulableNodes))
+	fleetPendingActions.Reset()
+	for _, action := range summary.PendingActions {
+		fleetPendingActions.WithLabelValues(string(action.Reason)).Set(float64(action.Count))
+	}
+}
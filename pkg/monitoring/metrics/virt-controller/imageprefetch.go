@@ -0,0 +1,51 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright the KubeVirt Authors.
+ *
+ */
+
+package virt_controller
+
+import (
+	"github.com/machadovilaca/operator-observability/pkg/operatormetrics"
+
+	prefetchv1 "kubevirt.io/api/prefetch/v1alpha1"
+)
+
+var (
+	imagePrefetchMetrics = []operatormetrics.Metric{
+		imagePrefetchReadinessPercent,
+	}
+
+	imagePrefetchReadinessPercent = operatormetrics.NewGaugeVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_imageprefetch_readiness_percent",
+			Help: "Percentage of the images and DataSources of an image prefetch which are staged.",
+		},
+		[]string{"name", "namespace"},
+	)
+)
+
+func SetImagePrefetchReadiness(prefetch *prefetchv1.ImagePrefetch) {
+	if prefetch.Status == nil {
+		return
+	}
+	imagePrefetchReadinessPercent.WithLabelValues(prefetch.Name, prefetch.Namespace).Set(float64(prefetch.Status.ReadinessPercentage))
+}
+
+func DeleteImagePrefetchReadiness(name, namespace string) {
+	imagePrefetchReadinessPercent.DeleteLabelValues(name, namespace)
+}
@@ -33,6 +33,7 @@ import (
 	"kubevirt.io/kubevirt/pkg/instancetype/apply"
 	"kubevirt.io/kubevirt/pkg/instancetype/find"
 	preferencefind "kubevirt.io/kubevirt/pkg/instancetype/preference/find"
+	"kubevirt.io/kubevirt/pkg/instancetype/upgrade"
 	"kubevirt.io/kubevirt/pkg/monitoring/metrics/common/client"
 	"kubevirt.io/kubevirt/pkg/monitoring/metrics/common/workqueue"
 	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
@@ -42,6 +43,10 @@ type vmApplyHandler interface {
 	ApplyToVM(vm *virtv1.VirtualMachine) error
 }
 
+type revisionUpgradeHandler interface {
+	IsUpgradePending(vm *virtv1.VirtualMachine) (bool, error)
+}
+
 type Informers struct {
 	VM                    cache.SharedIndexInformer
 	VMI                   cache.SharedIndexInformer
@@ -60,17 +65,26 @@ type Stores struct {
 
 var (
 	metrics = [][]operatormetrics.Metric{
+		canaryMetrics,
+		circuitBreakerMetrics,
 		componentMetrics,
+		fleetSummaryMetrics,
+		imagePrefetchMetrics,
 		migrationMetrics,
+		nodeEvacuationMetrics,
 		perfscaleMetrics,
+		placementHintsMetrics,
 		vmiMetrics,
+		vmiStartQueueMetrics,
 		vmSnapshotMetrics,
+		volumePreparationMetrics,
 	}
 
 	informers     *Informers
 	stores        *Stores
 	clusterConfig *virtconfig.ClusterConfig
 	vmApplier     vmApplyHandler
+	vmUpgrader    revisionUpgradeHandler
 )
 
 func SetupMetrics(
@@ -105,6 +119,14 @@ func SetupMetrics(
 		),
 	)
 
+	vmUpgrader = upgrade.NewOutdatedRevisionHandler(
+		stores.Instancetype,
+		stores.ClusterInstancetype,
+		stores.ControllerRevision,
+		clientset,
+		clusterConfig,
+	)
+
 	if err := client.SetupMetrics(); err != nil {
 		return err
 	}
@@ -21,6 +21,8 @@ package virt_controller
 
 import (
 	"github.com/machadovilaca/operator-observability/pkg/operatormetrics"
+	k8sv1 "k8s.io/api/core/v1"
+
 	k6tv1 "kubevirt.io/api/core/v1"
 )
 
@@ -28,10 +30,14 @@ var (
 	migrationStatsCollector = operatormetrics.Collector{
 		Metrics: []operatormetrics.Metric{
 			pendingMigrations,
+			quotaDelayedMigrations,
+			maintenanceWindowQueuedMigrations,
 			schedulingMigrations,
 			runningMigrations,
 			succeededMigration,
 			failedMigration,
+			cancelledMigration,
+			doubleCountedResourceRequests,
 		},
 		CollectCallback: migrationStatsCollectorCallback,
 	}
@@ -43,6 +49,20 @@ var (
 		},
 	)
 
+	quotaDelayedMigrations = operatormetrics.NewGauge(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_vmi_migrations_delayed_by_resource_quota",
+			Help: "Number of current pending migrations waiting for the namespace ResourceQuota to fit their target pod.",
+		},
+	)
+
+	maintenanceWindowQueuedMigrations = operatormetrics.NewGauge(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_vmi_migrations_queued_for_maintenance_window",
+			Help: "Number of current pending automated migrations waiting for a maintenance window of their migration policy to open.",
+		},
+	)
+
 	schedulingMigrations = operatormetrics.NewGauge(
 		operatormetrics.MetricOpts{
 			Name: "kubevirt_vmi_migrations_in_scheduling_phase",
@@ -72,6 +92,23 @@ var (
 		},
 		[]string{"vmi", "vmim", "namespace"},
 	)
+
+	cancelledMigration = operatormetrics.NewGaugeVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_vmi_migration_cancelled",
+			Help: "Indicates if the VMI migration was cancelled.",
+		},
+		[]string{"vmi", "vmim", "namespace"},
+	)
+
+	doubleCountedResourceRequests = operatormetrics.NewGaugeVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_vmi_migrations_double_counted_resource_requests",
+			Help: "Resource requests of the target pods of current migrations which are counted a second time against the quota of " +
+				"the namespace while the source pods are still running. CPU is reported in cores and other resources in their base unit.",
+		},
+		[]string{"namespace", "resource"},
+	)
 )
 
 func migrationStatsCollectorCallback() []operatormetrics.CollectorResult {
@@ -81,13 +118,19 @@ func migrationStatsCollectorCallback() []operatormetrics.CollectorResult {
 		vmims[i] = obj.(*k6tv1.VirtualMachineInstanceMigration)
 	}
 
-	return reportMigrationStats(vmims)
+	cr := reportMigrationStats(vmims)
+	if informers.KVPod != nil {
+		cr = append(cr, reportDoubleCountedResources(vmims, informers.KVPod.GetIndexer().List())...)
+	}
+	return cr
 }
 
 func reportMigrationStats(vmims []*k6tv1.VirtualMachineInstanceMigration) []operatormetrics.CollectorResult {
 	var cr []operatormetrics.CollectorResult
 
 	pendingCount := 0
+	quotaDelayedCount := 0
+	maintenanceWindowQueuedCount := 0
 	schedulingCount := 0
 	runningCount := 0
 
@@ -95,12 +138,20 @@ func reportMigrationStats(vmims []*k6tv1.VirtualMachineInstanceMigration) []oper
 		switch vmim.Status.Phase {
 		case k6tv1.MigrationPending:
 			pendingCount++
+			if hasTrueCondition(vmim, k6tv1.VirtualMachineInstanceMigrationRejectedByResourceQuota) {
+				quotaDelayedCount++
+			}
+			if hasTrueCondition(vmim, k6tv1.VirtualMachineInstanceMigrationQueuedForMaintenanceWindow) {
+				maintenanceWindowQueuedCount++
+			}
 		case k6tv1.MigrationScheduling:
 			schedulingCount++
 		case k6tv1.MigrationRunning, k6tv1.MigrationScheduled, k6tv1.MigrationPreparingTarget, k6tv1.MigrationTargetReady:
 			runningCount++
 		case k6tv1.MigrationSucceeded:
 			cr = append(cr, operatormetrics.CollectorResult{Metric: succeededMigration, Value: 1, Labels: []string{vmim.Spec.VMIName, vmim.Name, vmim.Namespace}})
+		case k6tv1.MigrationCancelled:
+			cr = append(cr, operatormetrics.CollectorResult{Metric: cancelledMigration, Value: 1, Labels: []string{vmim.Spec.VMIName, vmim.Name, vmim.Namespace}})
 		default:
 			cr = append(cr, operatormetrics.CollectorResult{Metric: failedMigration, Value: 1, Labels: []string{vmim.Spec.VMIName, vmim.Name, vmim.Namespace}})
 		}
@@ -108,7 +159,73 @@ func reportMigrationStats(vmims []*k6tv1.VirtualMachineInstanceMigration) []oper
 
 	return append(cr,
 		operatormetrics.CollectorResult{Metric: pendingMigrations, Value: float64(pendingCount)},
+		operatormetrics.CollectorResult{Metric: quotaDelayedMigrations, Value: float64(quotaDelayedCount)},
+		operatormetrics.CollectorResult{Metric: maintenanceWindowQueuedMigrations, Value: float64(maintenanceWindowQueuedCount)},
 		operatormetrics.CollectorResult{Metric: schedulingMigrations, Value: float64(schedulingCount)},
 		operatormetrics.CollectorResult{Metric: runningMigrations, Value: float64(runningCount)},
 	)
 }
+
+func hasTrueCondition(vmim *k6tv1.VirtualMachineInstanceMigration, conditionType k6tv1.VirtualMachineInstanceMigrationConditionType) bool {
+	for _, condition := range vmim.Status.Conditions {
+		if condition.Type == conditionType {
+			return condition.Status == k8sv1.ConditionTrue
+		}
+	}
+	return false
+}
+
+// reportDoubleCountedResources sums the resource requests of the target pods of unfinished migrations per namespace.
+// Target pods excluded from the quota of their namespace are not counted.
+func reportDoubleCountedResources(vmims []*k6tv1.VirtualMachineInstanceMigration, pods []interface{}) []operatormetrics.CollectorResult {
+	inProgress := map[string]struct{}{}
+	for _, vmim := range vmims {
+		if !vmim.IsFinal() {
+			inProgress[string(vmim.UID)] = struct{}{}
+		}
+	}
+
+	requestsPerNamespace := map[string]k8sv1.ResourceList{}
+	for _, obj := range pods {
+		pod, ok := obj.(*k8sv1.Pod)
+		if !ok || pod.Status.Phase == k8sv1.PodSucceeded || pod.Status.Phase == k8sv1.PodFailed {
+			continue
+		}
+		if _, exists := inProgress[pod.Labels[k6tv1.MigrationJobLabel]]; !exists {
+			continue
+		}
+		if pod.Labels[k6tv1.MigrationQuotaExcludedLabel] == "true" {
+			continue
+		}
+
+		requests, exists := requestsPerNamespace[pod.Namespace]
+		if !exists {
+			requests = k8sv1.ResourceList{}
+			requestsPerNamespace[pod.Namespace] = requests
+		}
+		for _, container := range pod.Spec.Containers {
+			addResourceList(requests, container.Resources.Requests)
+		}
+		addResourceList(requests, pod.Spec.Overhead)
+	}
+
+	var cr []operatormetrics.CollectorResult
+	for namespace, requests := range requestsPerNamespace {
+		for name, quantity := range requests {
+			cr = append(cr, operatormetrics.CollectorResult{
+				Metric: doubleCountedResourceRequests,
+				Value:  quantity.AsApproximateFloat64(),
+				Labels: []string{namespace, string(name)},
+			})
+		}
+	}
+	return cr
+}
+
+func addResourceList(list, other k8sv1.ResourceList) {
+	for name, quantity := range other {
+		current := list[name]
+		current.Add(quantity)
+		list[name] = current
+	}
+}
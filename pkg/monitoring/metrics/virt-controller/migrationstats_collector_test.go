@@ -26,6 +26,10 @@ import (
 	. "github.com/onsi/gomega"
 
 	"github.com/machadovilaca/operator-observability/pkg/operatormetrics"
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 
 	k6tv1 "kubevirt.io/api/core/v1"
 )
@@ -69,6 +73,7 @@ var _ = Describe("Migration Stats Collector", func() {
 
 		Expect(containsMetric).To(BeTrue())
 	},
+		Entry("Cancelled migration", k6tv1.MigrationCancelled, cancelledMigration),
 		Entry("Failed migration", k6tv1.MigrationFailed, failedMigration),
 		Entry("Pending migration", k6tv1.MigrationPending, pendingMigrations),
 		Entry("Running migration", k6tv1.MigrationRunning, runningMigrations),
@@ -94,4 +99,108 @@ var _ = Describe("Migration Stats Collector", func() {
 			}
 		}
 	})
+
+	It("should count pending migrations delayed by a resource quota", func() {
+		delayed := getVMIM(k6tv1.MigrationPending)
+		delayed.Status.Conditions = []k6tv1.VirtualMachineInstanceMigrationCondition{{
+			Type:   k6tv1.VirtualMachineInstanceMigrationRejectedByResourceQuota,
+			Status: k8sv1.ConditionTrue,
+		}}
+		vmims := []*k6tv1.VirtualMachineInstanceMigration{
+			delayed,
+			getVMIM(k6tv1.MigrationPending),
+		}
+
+		cr := reportMigrationStats(vmims)
+
+		Expect(cr).To(ContainElement(operatormetrics.CollectorResult{Metric: quotaDelayedMigrations, Value: 1.0}))
+		Expect(cr).To(ContainElement(operatormetrics.CollectorResult{Metric: pendingMigrations, Value: 2.0}))
+	})
+
+	It("should count pending migrations queued for a maintenance window", func() {
+		queued := getVMIM(k6tv1.MigrationPending)
+		queued.Status.Conditions = []k6tv1.VirtualMachineInstanceMigrationCondition{{
+			Type:   k6tv1.VirtualMachineInstanceMigrationQueuedForMaintenanceWindow,
+			Status: k8sv1.ConditionTrue,
+		}}
+		vmims := []*k6tv1.VirtualMachineInstanceMigration{
+			queued,
+			getVMIM(k6tv1.MigrationPending),
+		}
+
+		cr := reportMigrationStats(vmims)
+
+		Expect(cr).To(ContainElement(operatormetrics.CollectorResult{Metric: maintenanceWindowQueuedMigrations, Value: 1.0}))
+		Expect(cr).To(ContainElement(operatormetrics.CollectorResult{Metric: quotaDelayedMigrations, Value: 0.0}))
+		Expect(cr).To(ContainElement(operatormetrics.CollectorResult{Metric: pendingMigrations, Value: 2.0}))
+	})
+
+	Context("double counted resource requests", func() {
+		newTargetPod := func(namespace string, vmim *k6tv1.VirtualMachineInstanceMigration, cpu, memory string) *k8sv1.Pod {
+			return &k8sv1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: namespace,
+					Labels:    map[string]string{k6tv1.MigrationJobLabel: string(vmim.UID)},
+				},
+				Spec: k8sv1.PodSpec{
+					Containers: []k8sv1.Container{{
+						Resources: k8sv1.ResourceRequirements{
+							Requests: k8sv1.ResourceList{
+								k8sv1.ResourceCPU:    resource.MustParse(cpu),
+								k8sv1.ResourceMemory: resource.MustParse(memory),
+							},
+						},
+					}},
+				},
+				Status: k8sv1.PodStatus{Phase: k8sv1.PodRunning},
+			}
+		}
+
+		newVMIM := func(uid string, phase k6tv1.VirtualMachineInstanceMigrationPhase) *k6tv1.VirtualMachineInstanceMigration {
+			vmim := getVMIM(phase)
+			vmim.UID = types.UID(uid)
+			return vmim
+		}
+
+		It("should sum the requests of the target pods of running migrations per namespace", func() {
+			running1 := newVMIM("running1", k6tv1.MigrationRunning)
+			running2 := newVMIM("running2", k6tv1.MigrationScheduled)
+			running3 := newVMIM("running3", k6tv1.MigrationRunning)
+			vmims := []*k6tv1.VirtualMachineInstanceMigration{running1, running2, running3}
+			pods := []interface{}{
+				newTargetPod("ns1", running1, "500m", "1Gi"),
+				newTargetPod("ns1", running2, "1", "1Gi"),
+				newTargetPod("ns2", running3, "2", "512Mi"),
+			}
+
+			cr := reportDoubleCountedResources(vmims, pods)
+
+			Expect(cr).To(ConsistOf(
+				operatormetrics.CollectorResult{Metric: doubleCountedResourceRequests, Value: 1.5, Labels: []string{"ns1", "cpu"}},
+				operatormetrics.CollectorResult{Metric: doubleCountedResourceRequests, Value: 2147483648, Labels: []string{"ns1", "memory"}},
+				operatormetrics.CollectorResult{Metric: doubleCountedResourceRequests, Value: 2, Labels: []string{"ns2", "cpu"}},
+				operatormetrics.CollectorResult{Metric: doubleCountedResourceRequests, Value: 536870912, Labels: []string{"ns2", "memory"}},
+			))
+		})
+
+		It("should not count finished migrations, finished pods and pods excluded from the quota", func() {
+			succeeded := newVMIM("succeeded", k6tv1.MigrationSucceeded)
+			running := newVMIM("running", k6tv1.MigrationRunning)
+			excluded := newVMIM("excluded", k6tv1.MigrationRunning)
+
+			failedPod := newTargetPod("ns1", running, "1", "1Gi")
+			failedPod.Status.Phase = k8sv1.PodFailed
+			excludedPod := newTargetPod("ns1", excluded, "1", "1Gi")
+			excludedPod.Labels[k6tv1.MigrationQuotaExcludedLabel] = "true"
+			sourcePod := newTargetPod("ns1", running, "1", "1Gi")
+			delete(sourcePod.Labels, k6tv1.MigrationJobLabel)
+
+			cr := reportDoubleCountedResources(
+				[]*k6tv1.VirtualMachineInstanceMigration{succeeded, running, excluded},
+				[]interface{}{newTargetPod("ns1", succeeded, "1", "1Gi"), failedPod, excludedPod, sourcePod},
+			)
+
+			Expect(cr).To(BeEmpty())
+		})
+	})
 })
@@ -0,0 +1,70 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+package virt_controller
+
+import (
+	"github.com/machadovilaca/operator-observability/pkg/operatormetrics"
+
+	virtv1 "kubevirt.io/api/core/v1"
+)
+
+const (
+	NodeEvacuationStatePending       = "pending"
+	NodeEvacuationStateMigrating     = "migrating"
+	NodeEvacuationStateNotMigratable = "not_migratable"
+)
+
+var (
+	nodeEvacuationMetrics = []operatormetrics.Metric{
+		nodeEvacuationVMIs,
+		nodeEvacuationRemainingMemory,
+	}
+
+	nodeEvacuationVMIs = operatormetrics.NewGaugeVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_node_evacuation_vmis",
+			Help: "The number of VirtualMachineInstances which still have to leave a node that is being evacuated, by state.",
+		},
+		[]string{"node", "state"},
+	)
+
+	nodeEvacuationRemainingMemory = operatormetrics.NewGaugeVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_node_evacuation_remaining_memory_bytes",
+			Help: "The guest memory of the VirtualMachineInstances which still have to leave a node that is being evacuated.",
+		},
+		[]string{"node"},
+	)
+)
+
+// SetNodeEvacuations exposes the evacuation progress of the KubeVirt CR, nodes which are not evacuated are dropped
+func SetNodeEvacuations(evacuations []virtv1.NodeEvacuationStatus) {
+	nodeEvacuationVMIs.Reset()
+	nodeEvacuationRemainingMemory.Reset()
+	for _, evacuation := range evacuations {
+		nodeEvacuationVMIs.WithLabelValues(evacuation.Node, NodeEvacuationStatePending).Set(float64(evacuation.Pending))
+		nodeEvacuationVMIs.WithLabelValues(evacuation.Node, NodeEvacuationStateMigrating).Set(float64(evacuation.Migrating))
+		nodeEvacuationVMIs.WithLabelValues(evacuation.Node, NodeEvacuationStateNotMigratable).Set(float64(evacuation.NotMigratable))
+		var remainingMemory float64
+		if evacuation.RemainingMemory != nil {
+			remainingMemory = float64(evacuation.RemainingMemory.Value())
+		}
+		nodeEvacuationRemainingMemory.WithLabelValues(evacuation.Node).Set(remainingMemory)
+	}
+}
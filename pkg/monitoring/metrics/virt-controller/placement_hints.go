@@ -0,0 +1,61 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright the KubeVirt Authors.
+ *
+ */
+
+package virt_controller
+
+import (
+	"github.com/machadovilaca/operator-observability/pkg/operatormetrics"
+	io_prometheus_client "github.com/prometheus/client_model/go"
+)
+
+const (
+	PlacementHintsResultSucceeded = "succeeded"
+	PlacementHintsResultFailed    = "failed"
+)
+
+var (
+	placementHintsMetrics = []operatormetrics.Metric{
+		placementHintsRequestsTotal,
+	}
+
+	placementHintsRequestsTotal = operatormetrics.NewCounterVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_placement_hints_requests_total",
+			Help: "The total number of requests to the external placement service, broken down by operation and result.",
+		},
+		[]string{"operation", "result"},
+	)
+)
+
+// PlacementHintsRequested records a request to the external placement service
+func PlacementHintsRequested(operation string, succeeded bool) {
+	result := PlacementHintsResultSucceeded
+	if !succeeded {
+		result = PlacementHintsResultFailed
+	}
+	placementHintsRequestsTotal.WithLabelValues(operation, result).Inc()
+}
+
+func GetPlacementHintsRequestsTotal(operation, result string) (float64, error) {
+	dto := &io_prometheus_client.Metric{}
+	if err := placementHintsRequestsTotal.WithLabelValues(operation, result).Write(dto); err != nil {
+		return 0, err
+	}
+	return *dto.Counter.Value, nil
+}
@@ -0,0 +1,58 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package virt_controller
+
+import (
+	"github.com/machadovilaca/operator-observability/pkg/operatormetrics"
+	k8sv1 "k8s.io/api/core/v1"
+
+	k6tv1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/controller"
+)
+
+var (
+	vmDataVolumeFailure = operatormetrics.NewGaugeVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_vm_datavolume_failure",
+			Help: "Indicates that a DataVolume of the Virtual Machine can't be populated, broken down by reason " +
+				"(QuotaExceeded, SourceNotFound, StorageClassNotFound, ImportError or DataVolumeFailed).",
+		},
+		[]string{"name", "namespace", "reason"},
+	)
+)
+
+func CollectDataVolumeFailures(vms []*k6tv1.VirtualMachine) []operatormetrics.CollectorResult {
+	var cr []operatormetrics.CollectorResult
+	cm := controller.NewVirtualMachineConditionManager()
+	for _, vm := range vms {
+		cond := cm.GetCondition(vm, k6tv1.VirtualMachineDataVolumeFailure)
+		if cond == nil || cond.Status != k8sv1.ConditionTrue {
+			continue
+		}
+		cr = append(cr, operatormetrics.CollectorResult{
+			Metric: vmDataVolumeFailure,
+			Labels: []string{vm.Name, vm.Namespace, cond.Reason},
+			Value:  1,
+		})
+	}
+
+	return cr
+}
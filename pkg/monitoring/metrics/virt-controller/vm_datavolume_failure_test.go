@@ -0,0 +1,64 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package virt_controller
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	k8sv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	k6tv1 "kubevirt.io/api/core/v1"
+)
+
+var _ = Describe("VM DataVolume failures", func() {
+	newVM := func(name string, conditions ...k6tv1.VirtualMachineCondition) *k6tv1.VirtualMachine {
+		return &k6tv1.VirtualMachine{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "test-ns", Name: name},
+			Status:     k6tv1.VirtualMachineStatus{Conditions: conditions},
+		}
+	}
+
+	It("should report the reason of the DataVolumeFailure condition", func() {
+		vms := []*k6tv1.VirtualMachine{
+			newVM("failing", k6tv1.VirtualMachineCondition{
+				Type:   k6tv1.VirtualMachineDataVolumeFailure,
+				Status: k8sv1.ConditionTrue,
+				Reason: "QuotaExceeded",
+			}),
+			newVM("healthy", k6tv1.VirtualMachineCondition{
+				Type:   k6tv1.VirtualMachineReady,
+				Status: k8sv1.ConditionTrue,
+			}),
+			newVM("recovered", k6tv1.VirtualMachineCondition{
+				Type:   k6tv1.VirtualMachineDataVolumeFailure,
+				Status: k8sv1.ConditionFalse,
+				Reason: "SourceNotFound",
+			}),
+		}
+
+		crs := CollectDataVolumeFailures(vms)
+		Expect(crs).To(HaveLen(1))
+		Expect(crs[0].Metric.GetOpts().Name).To(Equal("kubevirt_vm_datavolume_failure"))
+		Expect(crs[0].Labels).To(Equal([]string{"failing", "test-ns", "QuotaExceeded"}))
+		Expect(crs[0].Value).To(BeEquivalentTo(1))
+	})
+})
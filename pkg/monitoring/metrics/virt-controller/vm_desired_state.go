@@ -0,0 +1,172 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package virt_controller
+
+import (
+	"sync"
+	"time"
+
+	"github.com/machadovilaca/operator-observability/pkg/operatormetrics"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/clock"
+
+	k6tv1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/controller"
+)
+
+const (
+	divergenceReasonVMIMissing    = "vmi_missing"
+	divergenceReasonVMINotRunning = "vmi_not_running"
+	divergenceReasonVMINotStopped = "vmi_not_stopped"
+)
+
+var (
+	vmDesiredStateDivergence = operatormetrics.NewGaugeVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_vm_desired_state_divergence_seconds",
+			Help: "The time in seconds a Virtual Machine has not been in the state desired by its run strategy, " +
+				"broken down by reason (vmi_missing, vmi_not_running or vmi_not_stopped).",
+		},
+		[]string{"name", "namespace", "reason"},
+	)
+
+	divergences = newDivergenceTracker(clock.RealClock{})
+)
+
+type divergenceKey struct {
+	uid       types.UID
+	namespace string
+	name      string
+	reason    string
+}
+
+// divergenceTracker remembers when a divergence was first collected, for the
+// divergences which cannot be dated from the VirtualMachineInstance.
+type divergenceTracker struct {
+	lock      sync.Mutex
+	clock     clock.Clock
+	firstSeen map[divergenceKey]time.Time
+}
+
+func newDivergenceTracker(clock clock.Clock) *divergenceTracker {
+	return &divergenceTracker{
+		clock:     clock,
+		firstSeen: map[divergenceKey]time.Time{},
+	}
+}
+
+// ages returns the age of all current divergences and forgets the resolved ones
+func (t *divergenceTracker) ages(current map[divergenceKey]time.Time) map[divergenceKey]time.Duration {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	now := t.clock.Now()
+	firstSeen := make(map[divergenceKey]time.Time, len(current))
+	ages := make(map[divergenceKey]time.Duration, len(current))
+	for key, since := range current {
+		if since.IsZero() {
+			var exists bool
+			if since, exists = t.firstSeen[key]; !exists {
+				since = now
+			}
+		}
+		firstSeen[key] = since
+		ages[key] = max(now.Sub(since), 0)
+	}
+	t.firstSeen = firstSeen
+
+	return ages
+}
+
+func CollectDesiredStateDivergence(vms []*k6tv1.VirtualMachine) []operatormetrics.CollectorResult {
+	current := map[divergenceKey]time.Time{}
+	for _, vm := range vms {
+		var vmi *k6tv1.VirtualMachineInstance
+		if informers.VMI != nil {
+			obj, exists, err := informers.VMI.GetStore().GetByKey(controller.NamespacedKey(vm.Namespace, vm.Name))
+			if err != nil {
+				log.Log.Object(vm).Reason(err).Error("Failed to get the VirtualMachineInstance of the VirtualMachine")
+				continue
+			}
+			if exists {
+				vmi = obj.(*k6tv1.VirtualMachineInstance)
+			}
+		}
+
+		reason, since := getDesiredStateDivergence(vm, vmi)
+		if reason == "" {
+			continue
+		}
+		current[divergenceKey{uid: vm.UID, namespace: vm.Namespace, name: vm.Name, reason: reason}] = since
+	}
+
+	var cr []operatormetrics.CollectorResult
+	for key, age := range divergences.ages(current) {
+		cr = append(cr, operatormetrics.CollectorResult{
+			Metric: vmDesiredStateDivergence,
+			Labels: []string{key.name, key.namespace, key.reason},
+			Value:  age.Seconds(),
+		})
+	}
+
+	return cr
+}
+
+// getDesiredStateDivergence returns why the VirtualMachine is not in the state desired by its run strategy
+// and since when, if this can be told from the VirtualMachineInstance. Run strategies which do not
+// unambiguously desire a state, like Manual, never diverge.
+func getDesiredStateDivergence(vm *k6tv1.VirtualMachine, vmi *k6tv1.VirtualMachineInstance) (string, time.Time) {
+	runStrategy, err := vm.RunStrategy()
+	if err != nil {
+		return "", time.Time{}
+	}
+
+	switch runStrategy {
+	case k6tv1.RunStrategyAlways, k6tv1.RunStrategyRerunOnFailure:
+		switch {
+		case vmi == nil:
+			return divergenceReasonVMIMissing, time.Time{}
+		case vmi.DeletionTimestamp != nil:
+			return divergenceReasonVMINotRunning, vmi.DeletionTimestamp.Time
+		case vmi.IsRunning():
+			return "", time.Time{}
+		case vmi.IsFinal():
+			if runStrategy == k6tv1.RunStrategyRerunOnFailure && vmi.Status.Phase == k6tv1.Succeeded {
+				return "", time.Time{}
+			}
+			return divergenceReasonVMINotRunning, time.Time{}
+		default:
+			return divergenceReasonVMINotRunning, vmi.CreationTimestamp.Time
+		}
+	case k6tv1.RunStrategyHalted:
+		switch {
+		case vmi == nil:
+			return "", time.Time{}
+		case vmi.DeletionTimestamp != nil:
+			return divergenceReasonVMINotStopped, vmi.DeletionTimestamp.Time
+		default:
+			return divergenceReasonVMINotStopped, time.Time{}
+		}
+	}
+
+	return "", time.Time{}
+}
@@ -0,0 +1,137 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package virt_controller
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+	clocktesting "k8s.io/utils/clock/testing"
+
+	k6tv1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/testutils"
+)
+
+var _ = Describe("VM desired state divergence", func() {
+	var (
+		fakeClock *clocktesting.FakeClock
+		vmiStore  cache.Store
+	)
+
+	newVM := func(runStrategy k6tv1.VirtualMachineRunStrategy) *k6tv1.VirtualMachine {
+		return &k6tv1.VirtualMachine{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "test-ns", Name: "testvm", UID: "vm-uid"},
+			Spec:       k6tv1.VirtualMachineSpec{RunStrategy: pointer.P(runStrategy)},
+		}
+	}
+
+	newVMI := func(phase k6tv1.VirtualMachineInstancePhase, age time.Duration) *k6tv1.VirtualMachineInstance {
+		return &k6tv1.VirtualMachineInstance{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:         "test-ns",
+				Name:              "testvm",
+				CreationTimestamp: metav1.NewTime(fakeClock.Now().Add(-age)),
+			},
+			Status: k6tv1.VirtualMachineInstanceStatus{Phase: phase},
+		}
+	}
+
+	BeforeEach(func() {
+		fakeClock = clocktesting.NewFakeClock(time.Now().Truncate(time.Second))
+		divergences = newDivergenceTracker(fakeClock)
+		informers.VMI, _ = testutils.NewFakeInformerFor(&k6tv1.VirtualMachineInstance{})
+		vmiStore = informers.VMI.GetStore()
+	})
+
+	It("should report the age of a missing VMI since it was first collected", func() {
+		vms := []*k6tv1.VirtualMachine{newVM(k6tv1.RunStrategyAlways)}
+
+		crs := CollectDesiredStateDivergence(vms)
+		Expect(crs).To(HaveLen(1))
+		Expect(crs[0].Metric.GetOpts().Name).To(Equal("kubevirt_vm_desired_state_divergence_seconds"))
+		Expect(crs[0].Labels).To(Equal([]string{"testvm", "test-ns", divergenceReasonVMIMissing}))
+		Expect(crs[0].Value).To(BeEquivalentTo(0))
+
+		fakeClock.Step(10 * time.Minute)
+		crs = CollectDesiredStateDivergence(vms)
+		Expect(crs).To(HaveLen(1))
+		Expect(crs[0].Value).To(BeEquivalentTo(600))
+	})
+
+	It("should forget a divergence once it is resolved", func() {
+		vms := []*k6tv1.VirtualMachine{newVM(k6tv1.RunStrategyAlways)}
+		Expect(CollectDesiredStateDivergence(vms)).To(HaveLen(1))
+
+		Expect(vmiStore.Add(newVMI(k6tv1.Running, time.Minute))).To(Succeed())
+		fakeClock.Step(10 * time.Minute)
+		Expect(CollectDesiredStateDivergence(vms)).To(BeEmpty())
+
+		Expect(vmiStore.Delete(newVMI(k6tv1.Running, time.Minute))).To(Succeed())
+		crs := CollectDesiredStateDivergence(vms)
+		Expect(crs).To(HaveLen(1))
+		Expect(crs[0].Value).To(BeEquivalentTo(0))
+	})
+
+	It("should report the age of a VMI which does not start since its creation", func() {
+		Expect(vmiStore.Add(newVMI(k6tv1.Scheduling, 5*time.Minute))).To(Succeed())
+
+		crs := CollectDesiredStateDivergence([]*k6tv1.VirtualMachine{newVM(k6tv1.RunStrategyRerunOnFailure)})
+		Expect(crs).To(HaveLen(1))
+		Expect(crs[0].Labels).To(Equal([]string{"testvm", "test-ns", divergenceReasonVMINotRunning}))
+		Expect(crs[0].Value).To(BeEquivalentTo(300))
+	})
+
+	It("should report the age of a VMI which does not stop since its deletion", func() {
+		vmi := newVMI(k6tv1.Running, time.Hour)
+		vmi.DeletionTimestamp = pointer.P(metav1.NewTime(fakeClock.Now().Add(-2 * time.Minute)))
+		Expect(vmiStore.Add(vmi)).To(Succeed())
+
+		crs := CollectDesiredStateDivergence([]*k6tv1.VirtualMachine{newVM(k6tv1.RunStrategyHalted)})
+		Expect(crs).To(HaveLen(1))
+		Expect(crs[0].Labels).To(Equal([]string{"testvm", "test-ns", divergenceReasonVMINotStopped}))
+		Expect(crs[0].Value).To(BeEquivalentTo(120))
+	})
+
+	DescribeTable("should not report a VM in its desired state", func(
+		runStrategy k6tv1.VirtualMachineRunStrategy, vmi *k6tv1.VirtualMachineInstance,
+	) {
+		if vmi != nil {
+			Expect(vmiStore.Add(vmi)).To(Succeed())
+		}
+		Expect(CollectDesiredStateDivergence([]*k6tv1.VirtualMachine{newVM(runStrategy)})).To(BeEmpty())
+	},
+		Entry("running with Always", k6tv1.RunStrategyAlways, &k6tv1.VirtualMachineInstance{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "test-ns", Name: "testvm"},
+			Status:     k6tv1.VirtualMachineInstanceStatus{Phase: k6tv1.Running},
+		}),
+		Entry("succeeded with RerunOnFailure", k6tv1.RunStrategyRerunOnFailure, &k6tv1.VirtualMachineInstance{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "test-ns", Name: "testvm"},
+			Status:     k6tv1.VirtualMachineInstanceStatus{Phase: k6tv1.Succeeded},
+		}),
+		Entry("stopped with Halted", k6tv1.RunStrategyHalted, nil),
+		Entry("stopped with Manual", k6tv1.RunStrategyManual, nil),
+	)
+})
@@ -0,0 +1,59 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright the KubeVirt Authors.
+ *
+ */
+
+package virt_controller
+
+import (
+	"time"
+
+	"github.com/machadovilaca/operator-observability/pkg/operatormetrics"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	vmiStartQueueMetrics = []operatormetrics.Metric{
+		vmiStartQueueLength,
+		vmiStartQueueWait,
+	}
+
+	vmiStartQueueLength = operatormetrics.NewGauge(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_vmi_start_queue_length",
+			Help: "Number of VirtualMachineInstances waiting to start because of the configured start limits.",
+		},
+	)
+
+	vmiStartQueueWait = operatormetrics.NewHistogram(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_vmi_start_queue_wait_seconds",
+			Help: "Histogram of the time VirtualMachineInstances waited in the start queue in seconds.",
+		},
+		prometheus.HistogramOpts{
+			Buckets: PhaseTransitionTimeBuckets(),
+		},
+	)
+)
+
+func SetVMIStartQueueLength(length int) {
+	vmiStartQueueLength.Set(float64(length))
+}
+
+func ObserveVMIStartQueueWait(wait time.Duration) {
+	vmiStartQueueWait.Observe(wait.Seconds())
+}
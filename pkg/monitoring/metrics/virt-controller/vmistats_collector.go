@@ -22,6 +22,7 @@ package virt_controller
 import (
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/machadovilaca/operator-observability/pkg/operatormetrics"
 	k8sv1 "k8s.io/api/core/v1"
@@ -41,6 +42,9 @@ const (
 	none  = "<none>"
 	other = "<other>"
 
+	osFamilySourceSpec       = "spec"
+	osFamilySourceGuestAgent = "guest_agent"
+
 	annotationPrefix        = "vm.kubevirt.io/"
 	instancetypeVendorLabel = "instancetype.kubevirt.io/vendor"
 )
@@ -54,7 +58,13 @@ var (
 	vmiStatsCollector = operatormetrics.Collector{
 		Metrics: []operatormetrics.Metric{
 			vmiInfo,
+			vmiGuestOSInfo,
+			vmiGuestLicenseInfo,
+			vmiPausedSeconds,
+			vmiGuestMemoryRequested,
+			vmiGuestMemoryUsable,
 			vmiEvictionBlocker,
+			vmiNonMigratableReason,
 			vmiAddresses,
 			vmiMigrationStartTime,
 			vmiMigrationEndTime,
@@ -84,6 +94,53 @@ var (
 		},
 	)
 
+	vmiGuestOSInfo = operatormetrics.NewGaugeVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_vmi_guest_os_info",
+			Help: "The guest OS name, version, kernel release and hostname of VirtualMachineInstances as reported by the guest agent.",
+		},
+		// The kernel version is left out, it is a free form build string which would only add series
+		[]string{
+			"node", "namespace", "name",
+			"hostname", "guest_os_id", "guest_os_name", "guest_os_version", "guest_os_version_id",
+			"guest_os_kernel_release", "guest_os_machine",
+		},
+	)
+
+	vmiGuestLicenseInfo = operatormetrics.NewGaugeVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_vmi_guest_license_info",
+			Help: "The licensing attributes of the guest OS of VirtualMachineInstances which did not finish. " +
+				"The OS family is taken from the spec or detected from the OS reported by the guest agent, as told by 'os_family_source'.",
+		},
+		[]string{"node", "namespace", "name", "os_family", "os_family_source", "license_model", "byol"},
+	)
+
+	vmiPausedSeconds = operatormetrics.NewCounterVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_vmi_paused_seconds_total",
+			Help: "Total time in seconds the VirtualMachineInstance was paused, including the ongoing pause.",
+		},
+		[]string{"node", "namespace", "name"},
+	)
+
+	vmiGuestMemoryRequested = operatormetrics.NewGaugeVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_vmi_guest_memory_requested_bytes",
+			Help: "The guest memory requested for a VirtualMachineInstance with memory hotplug, in bytes.",
+		},
+		[]string{"node", "namespace", "name"},
+	)
+
+	vmiGuestMemoryUsable = operatormetrics.NewGaugeVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_vmi_guest_memory_usable_bytes",
+			Help: "The guest memory actually usable by a VirtualMachineInstance with memory hotplug, in bytes. " +
+				"It stays above the requested memory while the guest did not release the memory being unplugged.",
+		},
+		[]string{"node", "namespace", "name"},
+	)
+
 	vmiEvictionBlocker = operatormetrics.NewGaugeVec(
 		operatormetrics.MetricOpts{
 			Name: "kubevirt_vmi_non_evictable",
@@ -92,6 +149,14 @@ var (
 		[]string{"node", "namespace", "name"},
 	)
 
+	vmiNonMigratableReason = operatormetrics.NewGaugeVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_vmi_non_migratable_reason",
+			Help: "Indication for a VirtualMachineInstance that it can not be live migrated, with the reason of its LiveMigratable condition.",
+		},
+		[]string{"node", "namespace", "name", "reason"},
+	)
+
 	vmiAddresses = operatormetrics.NewGaugeVec(
 		operatormetrics.MetricOpts{
 			Name: "kubevirt_vmi_status_addresses",
@@ -149,7 +214,12 @@ func reportVmisStats(vmis []*k6tv1.VirtualMachineInstance) []operatormetrics.Col
 
 	for _, vmi := range vmis {
 		crs = append(crs, collectVMIInfo(vmi))
+		crs = append(crs, collectVMIGuestOSInfo(vmi)...)
+		crs = append(crs, collectVMIGuestLicenseInfo(vmi)...)
+		crs = append(crs, collectVMIPausedSeconds(vmi)...)
+		crs = append(crs, collectVMIGuestMemory(vmi)...)
 		crs = append(crs, getEvictionBlocker(vmi))
+		crs = append(crs, collectVMINonMigratableReason(vmi)...)
 		crs = append(crs, collectVMIInterfacesInfo(vmi)...)
 		crs = append(crs, collectVMIMigrationTime(vmi)...)
 		crs = append(crs, CollectVmisVnicInfo(vmi)...)
@@ -180,6 +250,102 @@ func collectVMIInfo(vmi *k6tv1.VirtualMachineInstance) operatormetrics.Collector
 	}
 }
 
+func collectVMIGuestOSInfo(vmi *k6tv1.VirtualMachineInstance) []operatormetrics.CollectorResult {
+	osInfo := vmi.Status.GuestOSInfo
+	if osInfo == (k6tv1.VirtualMachineInstanceGuestOSInfo{}) {
+		return nil
+	}
+
+	return []operatormetrics.CollectorResult{{
+		Metric: vmiGuestOSInfo,
+		Labels: []string{
+			vmi.Status.NodeName, vmi.Namespace, vmi.Name,
+			osInfo.Hostname, osInfo.ID, osInfo.Name, osInfo.Version, osInfo.VersionID,
+			osInfo.KernelRelease, osInfo.Machine,
+		},
+		Value: 1.0,
+	}}
+}
+
+func collectVMIGuestLicenseInfo(vmi *k6tv1.VirtualMachineInstance) []operatormetrics.CollectorResult {
+	if vmi.IsFinal() {
+		return nil
+	}
+
+	osFamily, osFamilySource := getGuestOSFamily(vmi)
+	licenseModel, byol := none, none
+	if vmi.Spec.GuestLicense != nil {
+		if vmi.Spec.GuestLicense.LicenseModel != "" {
+			licenseModel = vmi.Spec.GuestLicense.LicenseModel
+		}
+		if vmi.Spec.GuestLicense.BYOL != nil {
+			byol = strconv.FormatBool(*vmi.Spec.GuestLicense.BYOL)
+		}
+	}
+
+	return []operatormetrics.CollectorResult{{
+		Metric: vmiGuestLicenseInfo,
+		Labels: []string{
+			vmi.Status.NodeName, vmi.Namespace, vmi.Name,
+			osFamily, osFamilySource, licenseModel, byol,
+		},
+		Value: 1.0,
+	}}
+}
+
+// getGuestOSFamily returns the OS family of the spec, or else the one of the OS ID reported by the guest agent
+func getGuestOSFamily(vmi *k6tv1.VirtualMachineInstance) (osFamily, source string) {
+	if vmi.Spec.GuestLicense != nil && vmi.Spec.GuestLicense.OSFamily != "" {
+		return string(vmi.Spec.GuestLicense.OSFamily), osFamilySourceSpec
+	}
+
+	switch vmi.Status.GuestOSInfo.ID {
+	case "":
+		return none, none
+	case "mswindows":
+		return string(k6tv1.GuestOSFamilyWindows), osFamilySourceGuestAgent
+	case "freebsd", "netbsd", "openbsd":
+		return string(k6tv1.GuestOSFamilyOther), osFamilySourceGuestAgent
+	default:
+		// Linux distributions report the ID of their os-release
+		return string(k6tv1.GuestOSFamilyLinux), osFamilySourceGuestAgent
+	}
+}
+
+func collectVMIPausedSeconds(vmi *k6tv1.VirtualMachineInstance) []operatormetrics.CollectorResult {
+	var pausedSeconds float64
+	if vmi.Status.PauseStatus != nil {
+		pausedSeconds = float64(vmi.Status.PauseStatus.TotalPauseDurationSeconds)
+	}
+	for _, condition := range vmi.Status.Conditions {
+		if condition.Type == k6tv1.VirtualMachineInstancePaused && condition.Status == k8sv1.ConditionTrue {
+			pausedSeconds += time.Since(condition.LastTransitionTime.Time).Truncate(time.Second).Seconds()
+		}
+	}
+	if pausedSeconds <= 0 {
+		return nil
+	}
+
+	return []operatormetrics.CollectorResult{{
+		Metric: vmiPausedSeconds,
+		Labels: []string{vmi.Status.NodeName, vmi.Namespace, vmi.Name},
+		Value:  pausedSeconds,
+	}}
+}
+
+func collectVMIGuestMemory(vmi *k6tv1.VirtualMachineInstance) []operatormetrics.CollectorResult {
+	memory := vmi.Status.Memory
+	if memory == nil || memory.GuestRequested == nil || memory.GuestUsable == nil {
+		return nil
+	}
+
+	labels := []string{vmi.Status.NodeName, vmi.Namespace, vmi.Name}
+	return []operatormetrics.CollectorResult{
+		{Metric: vmiGuestMemoryRequested, Labels: labels, Value: float64(memory.GuestRequested.Value())},
+		{Metric: vmiGuestMemoryUsable, Labels: labels, Value: float64(memory.GuestUsable.Value())},
+	}
+}
+
 func getVMIPhase(vmi *k6tv1.VirtualMachineInstance) string {
 	return strings.ToLower(string(vmi.Status.Phase))
 }
@@ -323,6 +489,20 @@ func getEvictionBlocker(vmi *k6tv1.VirtualMachineInstance) operatormetrics.Colle
 	}
 }
 
+func collectVMINonMigratableReason(vmi *k6tv1.VirtualMachineInstance) []operatormetrics.CollectorResult {
+	condition := controller.NewVirtualMachineInstanceConditionManager().
+		GetCondition(vmi, k6tv1.VirtualMachineInstanceIsMigratable)
+	if condition == nil || condition.Status != k8sv1.ConditionFalse {
+		return nil
+	}
+
+	return []operatormetrics.CollectorResult{{
+		Metric: vmiNonMigratableReason,
+		Labels: []string{vmi.Status.NodeName, vmi.Namespace, vmi.Name, condition.Reason},
+		Value:  1,
+	}}
+}
+
 func isVMEvictable(vmi *k6tv1.VirtualMachineInstance) bool {
 	if migrations.VMIMigratableOnEviction(clusterConfig, vmi) {
 		vmiIsMigratableCond := controller.NewVirtualMachineInstanceConditionManager().
@@ -20,6 +20,8 @@
 package virt_controller
 
 import (
+	"time"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
@@ -36,6 +38,7 @@ import (
 	"kubevirt.io/kubevirt/pkg/instancetype/apply"
 	"kubevirt.io/kubevirt/pkg/instancetype/find"
 	preferencefind "kubevirt.io/kubevirt/pkg/instancetype/preference/find"
+	"kubevirt.io/kubevirt/pkg/pointer"
 	"kubevirt.io/kubevirt/pkg/testutils"
 )
 
@@ -350,6 +353,185 @@ var _ = Describe("VMI Stats Collector", func() {
 		)
 	})
 
+	Context("VMI non migratable reason", func() {
+		newVMI := func(conditions ...k6tv1.VirtualMachineInstanceCondition) *k6tv1.VirtualMachineInstance {
+			return &k6tv1.VirtualMachineInstance{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "test-ns", Name: "testvmi"},
+				Status: k6tv1.VirtualMachineInstanceStatus{
+					NodeName:   "testNode",
+					Conditions: conditions,
+				},
+			}
+		}
+
+		It("should not create a metric for migratable VMIs", func() {
+			Expect(collectVMINonMigratableReason(newVMI())).To(BeEmpty())
+			Expect(collectVMINonMigratableReason(newVMI(k6tv1.VirtualMachineInstanceCondition{
+				Type:   k6tv1.VirtualMachineInstanceIsMigratable,
+				Status: k8sv1.ConditionTrue,
+			}))).To(BeEmpty())
+		})
+
+		It("should report the reason of the LiveMigratable condition", func() {
+			metrics := collectVMINonMigratableReason(newVMI(k6tv1.VirtualMachineInstanceCondition{
+				Type:   k6tv1.VirtualMachineInstanceIsMigratable,
+				Status: k8sv1.ConditionFalse,
+				Reason: k6tv1.VirtualMachineInstanceReasonHostDeviceNotMigratable,
+			}))
+			Expect(metrics).To(HaveLen(1))
+			Expect(metrics[0].Metric.GetOpts().Name).To(Equal("kubevirt_vmi_non_migratable_reason"))
+			Expect(metrics[0].Labels).To(Equal([]string{
+				"testNode", "test-ns", "testvmi", k6tv1.VirtualMachineInstanceReasonHostDeviceNotMigratable,
+			}))
+			Expect(metrics[0].Value).To(BeEquivalentTo(1))
+		})
+	})
+
+	Context("VMI guest OS info", func() {
+		It("should not create a metric before the guest agent reported the guest OS", func() {
+			vmi := &k6tv1.VirtualMachineInstance{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "test-ns", Name: "testvmi"},
+			}
+
+			Expect(collectVMIGuestOSInfo(vmi)).To(BeEmpty())
+		})
+
+		It("should report the guest OS inventory", func() {
+			vmi := &k6tv1.VirtualMachineInstance{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "test-ns", Name: "testvmi"},
+				Status: k6tv1.VirtualMachineInstanceStatus{
+					NodeName: "testNode",
+					GuestOSInfo: k6tv1.VirtualMachineInstanceGuestOSInfo{
+						Hostname:      "testvmi.example.com",
+						ID:            "fedora",
+						Name:          "Fedora Linux",
+						Version:       "39 (Cloud Edition)",
+						VersionID:     "39",
+						KernelRelease: "6.5.6-300.fc39.x86_64",
+						KernelVersion: "#1 SMP PREEMPT_DYNAMIC Fri Oct  6 19:57:21 UTC 2023",
+						Machine:       "x86_64",
+					},
+				},
+			}
+
+			metrics := collectVMIGuestOSInfo(vmi)
+			Expect(metrics).To(HaveLen(1))
+			Expect(metrics[0].Metric.GetOpts().Name).To(Equal("kubevirt_vmi_guest_os_info"))
+			Expect(metrics[0].Value).To(BeEquivalentTo(1))
+			Expect(metrics[0].Labels).To(Equal([]string{
+				"testNode", "test-ns", "testvmi",
+				"testvmi.example.com", "fedora", "Fedora Linux", "39 (Cloud Edition)", "39",
+				"6.5.6-300.fc39.x86_64", "x86_64",
+			}))
+		})
+	})
+
+	Context("VMI guest license info", func() {
+		newVMI := func(license *k6tv1.GuestLicense, osID string) *k6tv1.VirtualMachineInstance {
+			return &k6tv1.VirtualMachineInstance{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "test-ns", Name: "testvmi"},
+				Spec:       k6tv1.VirtualMachineInstanceSpec{GuestLicense: license},
+				Status: k6tv1.VirtualMachineInstanceStatus{
+					NodeName:    "testNode",
+					Phase:       k6tv1.Running,
+					GuestOSInfo: k6tv1.VirtualMachineInstanceGuestOSInfo{ID: osID},
+				},
+			}
+		}
+
+		DescribeTable("should report the licensing attributes", func(vmi *k6tv1.VirtualMachineInstance, expectedLabels []string) {
+			metrics := collectVMIGuestLicenseInfo(vmi)
+			Expect(metrics).To(HaveLen(1))
+			Expect(metrics[0].Metric.GetOpts().Name).To(Equal("kubevirt_vmi_guest_license_info"))
+			Expect(metrics[0].Value).To(BeEquivalentTo(1))
+			Expect(metrics[0].Labels).To(Equal(append([]string{"testNode", "test-ns", "testvmi"}, expectedLabels...)))
+		},
+			Entry("supplied in the spec", newVMI(&k6tv1.GuestLicense{
+				OSFamily:     k6tv1.GuestOSFamilyWindows,
+				LicenseModel: "perCore",
+				BYOL:         pointer.P(true),
+			}, "fedora"), []string{"windows", "spec", "perCore", "true"}),
+			Entry("with the OS family detected from a Windows guest", newVMI(&k6tv1.GuestLicense{BYOL: pointer.P(false)}, "mswindows"),
+				[]string{"windows", "guest_agent", "<none>", "false"}),
+			Entry("with the OS family detected from a Linux guest", newVMI(nil, "rhel"),
+				[]string{"linux", "guest_agent", "<none>", "<none>"}),
+			Entry("without any attribute", newVMI(nil, ""),
+				[]string{"<none>", "<none>", "<none>", "<none>"}),
+		)
+
+		It("should not report finished VMIs", func() {
+			vmi := newVMI(nil, "rhel")
+			vmi.Status.Phase = k6tv1.Succeeded
+
+			Expect(collectVMIGuestLicenseInfo(vmi)).To(BeEmpty())
+		})
+	})
+
+	Context("VMI paused seconds", func() {
+		It("should not create a metric for VMIs which were never paused", func() {
+			vmi := &k6tv1.VirtualMachineInstance{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "test-ns", Name: "testvmi"},
+			}
+
+			Expect(collectVMIPausedSeconds(vmi)).To(BeEmpty())
+		})
+
+		It("should add the ongoing pause to the completed pauses", func() {
+			vmi := &k6tv1.VirtualMachineInstance{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "test-ns", Name: "testvmi"},
+				Status: k6tv1.VirtualMachineInstanceStatus{
+					NodeName:    "testNode",
+					PauseStatus: &k6tv1.PauseStatus{LastPauseDurationSeconds: 20, TotalPauseDurationSeconds: 30},
+					Conditions: []k6tv1.VirtualMachineInstanceCondition{{
+						Type:               k6tv1.VirtualMachineInstancePaused,
+						Status:             k8sv1.ConditionTrue,
+						LastTransitionTime: metav1.NewTime(time.Now().Add(-time.Minute)),
+					}},
+				},
+			}
+
+			metrics := collectVMIPausedSeconds(vmi)
+			Expect(metrics).To(HaveLen(1))
+			Expect(metrics[0].Metric.GetOpts().Name).To(Equal("kubevirt_vmi_paused_seconds_total"))
+			Expect(metrics[0].Labels).To(Equal([]string{"testNode", "test-ns", "testvmi"}))
+			Expect(metrics[0].Value).To(BeNumerically("~", 90, 2))
+		})
+	})
+
+	Context("VMI guest memory", func() {
+		It("should not create metrics for VMIs without reported usable memory", func() {
+			vmi := &k6tv1.VirtualMachineInstance{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "test-ns", Name: "testvmi"},
+				Status: k6tv1.VirtualMachineInstanceStatus{
+					Memory: &k6tv1.MemoryStatus{GuestRequested: pointer.P(resource.MustParse("2Gi"))},
+				},
+			}
+
+			Expect(collectVMIGuestMemory(vmi)).To(BeEmpty())
+		})
+
+		It("should report the requested and the usable guest memory", func() {
+			vmi := &k6tv1.VirtualMachineInstance{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "test-ns", Name: "testvmi"},
+				Status: k6tv1.VirtualMachineInstanceStatus{
+					NodeName: "testNode",
+					Memory: &k6tv1.MemoryStatus{
+						GuestRequested: pointer.P(resource.MustParse("2Gi")),
+						GuestUsable:    pointer.P(resource.MustParse("3Gi")),
+					},
+				},
+			}
+
+			metrics := collectVMIGuestMemory(vmi)
+			Expect(metrics).To(HaveLen(2))
+			Expect(metrics[0].Metric.GetOpts().Name).To(Equal("kubevirt_vmi_guest_memory_requested_bytes"))
+			Expect(metrics[0].Labels).To(Equal([]string{"testNode", "test-ns", "testvmi"}))
+			Expect(metrics[0].Value).To(BeEquivalentTo(2 * 1024 * 1024 * 1024))
+			Expect(metrics[1].Metric.GetOpts().Name).To(Equal("kubevirt_vmi_guest_memory_usable_bytes"))
+			Expect(metrics[1].Value).To(BeEquivalentTo(3 * 1024 * 1024 * 1024))
+		})
+	})
+
 	Context("VMI Interfaces info", func() {
 		DescribeTable("kubevirt_vmi_status_addresses metrics", func(ifaceValues [][]string) {
 			vmi := &k6tv1.VirtualMachineInstance{
@@ -20,6 +20,8 @@
 package virt_controller
 
 import (
+	"time"
+
 	"github.com/machadovilaca/operator-observability/pkg/operatormetrics"
 
 	io_prometheus_client "github.com/prometheus/client_model/go"
@@ -29,6 +31,7 @@ import (
 var (
 	vmSnapshotMetrics = []operatormetrics.Metric{
 		VmSnapshotSucceededTimestamp,
+		VmSnapshotScheduleLastSuccessTimestamp,
 	}
 
 	VmSnapshotSucceededTimestamp = operatormetrics.NewGaugeVec(
@@ -38,6 +41,14 @@ var (
 		},
 		[]string{"name", "snapshot_name", "namespace"},
 	)
+
+	VmSnapshotScheduleLastSuccessTimestamp = operatormetrics.NewGaugeVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_vmsnapshot_schedule_last_success_timestamp_seconds",
+			Help: "Returns the timestamp of the last run of a virtual machine snapshot schedule whose snapshots all succeeded.",
+		},
+		[]string{"name", "namespace"},
+	)
 )
 
 func HandleSucceededVMSnapshot(snapshot *snapshotv1.VirtualMachineSnapshot) {
@@ -50,6 +61,14 @@ func HandleSucceededVMSnapshot(snapshot *snapshotv1.VirtualMachineSnapshot) {
 	}
 }
 
+func SetVmSnapshotScheduleLastSuccess(schedule *snapshotv1.VirtualMachineSnapshotSchedule, lastSuccess time.Time) {
+	VmSnapshotScheduleLastSuccessTimestamp.WithLabelValues(schedule.Name, schedule.Namespace).Set(float64(lastSuccess.Unix()))
+}
+
+func DeleteVmSnapshotScheduleLastSuccess(name, namespace string) {
+	VmSnapshotScheduleLastSuccessTimestamp.DeleteLabelValues(name, namespace)
+}
+
 func GetVmSnapshotSucceededTimestamp(vm, snapshot, namespace string) (float64, error) {
 	dto := &io_prometheus_client.Metric{}
 	if err := VmSnapshotSucceededTimestamp.WithLabelValues(vm, snapshot, namespace).Write(dto); err != nil {
@@ -57,3 +76,11 @@ func GetVmSnapshotSucceededTimestamp(vm, snapshot, namespace string) (float64, e
 	}
 	return *dto.Gauge.Value, nil
 }
+
+func GetVmSnapshotScheduleLastSuccessTimestamp(name, namespace string) (float64, error) {
+	dto := &io_prometheus_client.Metric{}
+	if err := VmSnapshotScheduleLastSuccessTimestamp.WithLabelValues(name, namespace).Write(dto); err != nil {
+		return 0, err
+	}
+	return *dto.Gauge.Value, nil
+}
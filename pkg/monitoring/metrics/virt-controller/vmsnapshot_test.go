@@ -66,4 +66,25 @@ var _ = Describe("VMSnapshot Metrics Collector", func() {
 			Expect(metricTime).To(Equal(float64(vmSnapshot.Status.CreationTime.Unix())))
 		})
 	})
+
+	Context("VMSnapshotSchedule", func() {
+		It("should set and delete the last success time of a schedule", func() {
+			schedule := &snapshotv1.VirtualMachineSnapshotSchedule{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "nightly",
+					Namespace: "namespace",
+				},
+			}
+			lastSuccess := time.Date(2024, time.June, 1, 2, 3, 0, 0, time.UTC)
+
+			metrics.SetVmSnapshotScheduleLastSuccess(schedule, lastSuccess)
+
+			value, err := metrics.GetVmSnapshotScheduleLastSuccessTimestamp("nightly", "namespace")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(value).To(Equal(float64(lastSuccess.Unix())))
+
+			metrics.DeleteVmSnapshotScheduleLastSuccess("nightly", "namespace")
+			Expect(metrics.VmSnapshotScheduleLastSuccessTimestamp.DeleteLabelValues("nightly", "namespace")).To(BeFalse())
+		})
+	})
 })
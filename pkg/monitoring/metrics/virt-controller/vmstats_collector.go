@@ -28,6 +28,7 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 
 	k6tv1 "kubevirt.io/api/core/v1"
+	instancetypeapi "kubevirt.io/api/instancetype"
 	"kubevirt.io/client-go/log"
 
 	"kubevirt.io/kubevirt/pkg/controller"
@@ -35,7 +36,8 @@ import (
 
 var (
 	vmStatsCollector = operatormetrics.Collector{
-		Metrics:         append(timestampMetrics, vmResourceRequests, vmResourceLimits, vmInfo, vmDiskAllocatedSize, vmCreationTimestamp, vmVnicInfo),
+		Metrics: append(timestampMetrics, vmResourceRequests, vmResourceLimits, vmInfo, vmDiskAllocatedSize, vmCreationTimestamp, vmVnicInfo,
+			vmInstancetypeRevisionUpgradePending, vmRightsizingRecommendation, vmDesiredStateDivergence, vmDataVolumeFailure),
 		CollectCallback: vmStatsCollectorCallback,
 	}
 
@@ -185,6 +187,24 @@ var (
 		},
 		[]string{"name", "namespace", "vnic_name", "binding_type", "network", "binding_name"},
 	)
+
+	vmInstancetypeRevisionUpgradePending = operatormetrics.NewGaugeVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_vm_instancetype_revision_upgrade_pending",
+			Help: "Indicates a Virtual Machine referencing an outdated ControllerRevision of its instance type, " +
+				"which is upgraded to the latest version while the Virtual Machine is not running.",
+		},
+		[]string{"name", "namespace"},
+	)
+
+	vmRightsizingRecommendation = operatormetrics.NewGaugeVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_vm_rightsizing_recommendation",
+			Help: "The cluster instance type recommended for a Virtual Machine from its observed CPU and memory usage, " +
+				"next to the instance type it currently references.",
+		},
+		[]string{"name", "namespace", "instance_type", "recommended_instance_type"},
+	)
 )
 
 func vmStatsCollectorCallback() []operatormetrics.CollectorResult {
@@ -207,6 +227,10 @@ func vmStatsCollectorCallback() []operatormetrics.CollectorResult {
 	results = append(results, reportVmsStats(vms)...)
 	results = append(results, collectVMCreationTimestamp(vms)...)
 	results = append(results, CollectVmsVnicInfo(vms)...)
+	results = append(results, CollectInstancetypeRevisionUpgradePending(vms)...)
+	results = append(results, CollectRightsizingRecommendations(vms)...)
+	results = append(results, CollectDesiredStateDivergence(vms)...)
+	results = append(results, CollectDataVolumeFailures(vms)...)
 	return results
 }
 
@@ -751,3 +775,45 @@ func LookupNetworkByName(networks []k6tv1.Network, name string) *k6tv1.Network {
 	}
 	return nil
 }
+
+func CollectInstancetypeRevisionUpgradePending(vms []*k6tv1.VirtualMachine) []operatormetrics.CollectorResult {
+	var cr []operatormetrics.CollectorResult
+
+	for _, vm := range vms {
+		pending, err := vmUpgrader.IsUpgradePending(vm)
+		if err != nil {
+			log.Log.Object(vm).Reason(err).V(4).Info("Failed to check for outdated instance type ControllerRevision")
+			continue
+		}
+		if !pending {
+			continue
+		}
+
+		cr = append(cr, operatormetrics.CollectorResult{
+			Metric: vmInstancetypeRevisionUpgradePending,
+			Labels: []string{vm.Name, vm.Namespace},
+			Value:  1.0,
+		})
+	}
+
+	return cr
+}
+
+func CollectRightsizingRecommendations(vms []*k6tv1.VirtualMachine) []operatormetrics.CollectorResult {
+	var cr []operatormetrics.CollectorResult
+
+	for _, vm := range vms {
+		recommended, exists := vm.Annotations[instancetypeapi.RecommendedInstancetypeAnnotation]
+		if !exists || recommended == "" {
+			continue
+		}
+
+		cr = append(cr, operatormetrics.CollectorResult{
+			Metric: vmRightsizingRecommendation,
+			Labels: []string{vm.Name, vm.Namespace, getVMInstancetype(vm), recommended},
+			Value:  1.0,
+		})
+	}
+
+	return cr
+}
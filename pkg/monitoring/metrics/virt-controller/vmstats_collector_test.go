@@ -27,13 +27,19 @@ import (
 	. "github.com/onsi/gomega"
 
 	"github.com/machadovilaca/operator-observability/pkg/operatormetrics"
+	appsv1 "k8s.io/api/apps/v1"
 	k8sv1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
 
 	k6tv1 "kubevirt.io/api/core/v1"
+	instancetypeapi "kubevirt.io/api/instancetype"
+	instancetypev1beta1 "kubevirt.io/api/instancetype/v1beta1"
 	cdiv1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
 
+	"kubevirt.io/kubevirt/pkg/instancetype/revision"
+	"kubevirt.io/kubevirt/pkg/instancetype/upgrade"
 	"kubevirt.io/kubevirt/pkg/pointer"
 	"kubevirt.io/kubevirt/pkg/testutils"
 )
@@ -773,6 +779,130 @@ var _ = Describe("VM Stats Collector", func() {
 			Expect(metrics).To(BeEmpty())
 		})
 	})
+
+	Context("Instance type revision upgrade pending", func() {
+		var (
+			instancetypeObj         *instancetypev1beta1.VirtualMachineInstancetype
+			outdatedRevision        *appsv1.ControllerRevision
+			controllerRevisionStore cache.Store
+		)
+
+		newVM := func(name, policy string) *k6tv1.VirtualMachine {
+			return &k6tv1.VirtualMachine{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:   "test-ns",
+					Name:        name,
+					Annotations: map[string]string{instancetypeapi.RevisionUpgradePolicyAnnotation: policy},
+				},
+				Spec: k6tv1.VirtualMachineSpec{
+					Instancetype: &k6tv1.InstancetypeMatcher{
+						Kind: instancetypeapi.SingularResourceName,
+						Name: instancetypeObj.Name,
+					},
+				},
+				Status: k6tv1.VirtualMachineStatus{
+					InstancetypeRef: &k6tv1.InstancetypeStatusRef{
+						Kind: instancetypeapi.SingularResourceName,
+						Name: instancetypeObj.Name,
+						ControllerRevisionRef: &k6tv1.ControllerRevisionRef{
+							Name: outdatedRevision.Name,
+						},
+					},
+				},
+			}
+		}
+
+		BeforeEach(func() {
+			instancetypeObj = &instancetypev1beta1.VirtualMachineInstancetype{
+				TypeMeta: metav1.TypeMeta{
+					APIVersion: instancetypev1beta1.SchemeGroupVersion.String(),
+					Kind:       "VirtualMachineInstancetype",
+				},
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:  "test-ns",
+					Name:       "instancetype",
+					Generation: 2,
+				},
+				Spec: instancetypev1beta1.VirtualMachineInstancetypeSpec{
+					CPU:    instancetypev1beta1.CPUInstancetype{Guest: 2},
+					Memory: instancetypev1beta1.MemoryInstancetype{Guest: resource.MustParse("1Gi")},
+				},
+			}
+			instancetypeInformer, _ := testutils.NewFakeInformerFor(&instancetypev1beta1.VirtualMachineInstancetype{})
+			Expect(instancetypeInformer.GetStore().Add(instancetypeObj)).To(Succeed())
+
+			outdatedInstancetype := instancetypeObj.DeepCopy()
+			outdatedInstancetype.Generation = 1
+			outdatedInstancetype.Spec.CPU.Guest = 1
+
+			var err error
+			outdatedRevision, err = revision.CreateControllerRevision(&k6tv1.VirtualMachine{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "test-ns", Name: "vm"},
+			}, outdatedInstancetype)
+			Expect(err).ToNot(HaveOccurred())
+			controllerRevisionInformer, _ := testutils.NewFakeInformerFor(&appsv1.ControllerRevision{})
+			controllerRevisionStore = controllerRevisionInformer.GetStore()
+			Expect(controllerRevisionStore.Add(outdatedRevision)).To(Succeed())
+
+			clusterInstancetypeInformer, _ := testutils.NewFakeInformerFor(&instancetypev1beta1.VirtualMachineClusterInstancetype{})
+			config, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&k6tv1.KubeVirtConfiguration{})
+			vmUpgrader = upgrade.NewOutdatedRevisionHandler(
+				instancetypeInformer.GetStore(),
+				clusterInstancetypeInformer.GetStore(),
+				controllerRevisionStore,
+				nil,
+				config,
+			)
+		})
+
+		It("should report opted in VMs with an outdated revision", func() {
+			vms := []*k6tv1.VirtualMachine{
+				newVM("upgrade-pending", string(k6tv1.RevisionUpgradeOnRestart)),
+				newVM("manual", string(k6tv1.RevisionUpgradeManual)),
+			}
+
+			crs := CollectInstancetypeRevisionUpgradePending(vms)
+			Expect(crs).To(HaveLen(1))
+			Expect(crs[0].Metric.GetOpts().Name).To(Equal("kubevirt_vm_instancetype_revision_upgrade_pending"))
+			Expect(crs[0].Labels).To(Equal([]string{"upgrade-pending", "test-ns"}))
+			Expect(crs[0].Value).To(BeEquivalentTo(1))
+		})
+
+		It("should not report VMs with the latest revision", func() {
+			latestRevision, err := revision.CreateControllerRevision(&k6tv1.VirtualMachine{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "test-ns", Name: "vm"},
+			}, instancetypeObj)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(controllerRevisionStore.Add(latestRevision)).To(Succeed())
+
+			vm := newVM("up-to-date", string(k6tv1.RevisionUpgradeOnRestart))
+			vm.Status.InstancetypeRef.ControllerRevisionRef.Name = latestRevision.Name
+			Expect(CollectInstancetypeRevisionUpgradePending([]*k6tv1.VirtualMachine{vm})).To(BeEmpty())
+		})
+	})
+
+	Context("Rightsizing recommendation", func() {
+		It("should report the instance type recommended for the VMs", func() {
+			vms := []*k6tv1.VirtualMachine{
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace:   "test-ns",
+						Name:        "recommended",
+						Annotations: map[string]string{instancetypeapi.RecommendedInstancetypeAnnotation: "u1.small"},
+					},
+				},
+				{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "test-ns", Name: "not-recommended"},
+				},
+			}
+
+			crs := CollectRightsizingRecommendations(vms)
+			Expect(crs).To(HaveLen(1))
+			Expect(crs[0].Metric.GetOpts().Name).To(Equal("kubevirt_vm_rightsizing_recommendation"))
+			Expect(crs[0].Labels).To(Equal([]string{"recommended", "test-ns", none, "u1.small"}))
+			Expect(crs[0].Value).To(BeEquivalentTo(1))
+		})
+	})
 })
 
 func expectDefaultCPUResourceRequests(crs []operatormetrics.CollectorResult) {
@@ -0,0 +1,46 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright the KubeVirt Authors.
+ *
+ */
+
+package virt_controller
+
+import (
+	"github.com/machadovilaca/operator-observability/pkg/operatormetrics"
+)
+
+var (
+	volumePreparationMetrics = []operatormetrics.Metric{
+		volumePreparationsInFlight,
+	}
+
+	volumePreparationsInFlight = operatormetrics.NewGaugeVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_storageclass_volume_preparations_in_flight",
+			Help: "Number of volumes of a storage class which are cloned, imported or resized at the moment.",
+		},
+		[]string{"storage_class"},
+	)
+)
+
+// SetVolumePreparationsInFlight replaces the reported in-flight volume preparations by the given counts per storage class
+func SetVolumePreparationsInFlight(inFlight map[string]int) {
+	volumePreparationsInFlight.Reset()
+	for storageClass, count := range inFlight {
+		volumePreparationsInFlight.WithLabelValues(storageClass).Set(float64(count))
+	}
+}
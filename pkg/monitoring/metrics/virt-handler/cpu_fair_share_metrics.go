@@ -0,0 +1,78 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright the KubeVirt Authors.
+ *
+ */
+
+package virt_handler
+
+import (
+	"github.com/machadovilaca/operator-observability/pkg/operatormetrics"
+	io_prometheus_client "github.com/prometheus/client_model/go"
+)
+
+var (
+	cpuFairShareMetrics = []operatormetrics.Metric{
+		nodeCPUFairShareVMIs,
+		nodeCPUFairSharePressureRatio,
+	}
+
+	nodeCPUFairShareVMIs = operatormetrics.NewGaugeVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_node_cpu_fair_share_vmis",
+			Help: "The number of running VMIs of a CPU fair share tier on the node.",
+		},
+		[]string{"node", "tier"},
+	)
+
+	nodeCPUFairSharePressureRatio = operatormetrics.NewGaugeVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_node_cpu_fair_share_pressure_ratio",
+			Help: "The average share of the last 10 seconds in which the virt-launcher pods of a CPU fair share tier on the node waited for CPU.",
+		},
+		[]string{"node", "tier"},
+	)
+)
+
+// ResetCPUFairShare removes the tiers which were removed from the configuration or left the node
+func ResetCPUFairShare() {
+	nodeCPUFairShareVMIs.Reset()
+	nodeCPUFairSharePressureRatio.Reset()
+}
+
+func SetCPUFairShareVMIs(node, tier string, vmis int) {
+	nodeCPUFairShareVMIs.WithLabelValues(node, tier).Set(float64(vmis))
+}
+
+func SetCPUFairSharePressureRatio(node, tier string, ratio float64) {
+	nodeCPUFairSharePressureRatio.WithLabelValues(node, tier).Set(ratio)
+}
+
+func GetCPUFairShareVMIs(node, tier string) (float64, error) {
+	dto := &io_prometheus_client.Metric{}
+	if err := nodeCPUFairShareVMIs.WithLabelValues(node, tier).Write(dto); err != nil {
+		return 0, err
+	}
+	return *dto.Gauge.Value, nil
+}
+
+func GetCPUFairSharePressureRatio(node, tier string) (float64, error) {
+	dto := &io_prometheus_client.Metric{}
+	if err := nodeCPUFairSharePressureRatio.WithLabelValues(node, tier).Write(dto); err != nil {
+		return 0, err
+	}
+	return *dto.Gauge.Value, nil
+}
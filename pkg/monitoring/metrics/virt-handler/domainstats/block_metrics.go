@@ -20,8 +20,12 @@
 package domainstats
 
 import (
+	"strconv"
+
 	"github.com/machadovilaca/operator-observability/pkg/operatormetrics"
 	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/stats"
 )
 
 var (
@@ -80,6 +84,27 @@ var (
 			Help: "Total time spent on cache flushing.",
 		},
 	)
+
+	storageReadLatencySecondsBucket = operatormetrics.NewCounter(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_vmi_storage_read_latency_seconds_bucket_total",
+			Help: "Cumulative number of read operations whose average latency between two stats samples is within the le label.",
+		},
+	)
+
+	storageWriteLatencySecondsBucket = operatormetrics.NewCounter(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_vmi_storage_write_latency_seconds_bucket_total",
+			Help: "Cumulative number of write operations whose average latency between two stats samples is within the le label.",
+		},
+	)
+
+	storageFlushLatencySecondsBucket = operatormetrics.NewCounter(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_vmi_storage_flush_latency_seconds_bucket_total",
+			Help: "Cumulative number of flush operations whose average latency between two stats samples is within the le label.",
+		},
+	)
 )
 
 type blockMetrics struct{}
@@ -94,6 +119,9 @@ func (blockMetrics) Describe() []operatormetrics.Metric {
 		storageWriteTimesSeconds,
 		storageFlushRequests,
 		storageFlushTimesSeconds,
+		storageReadLatencySecondsBucket,
+		storageWriteLatencySecondsBucket,
+		storageFlushLatencySecondsBucket,
 	}
 }
 
@@ -146,6 +174,38 @@ func (blockMetrics) Collect(vmiReport *VirtualMachineInstanceReport) []operatorm
 		if block.FlTimesSet {
 			crs = append(crs, vmiReport.newCollectorResultWithLabels(storageFlushTimesSeconds, nanosecondsToSeconds(block.FlTimes), blkLabels))
 		}
+
+		crs = append(crs, collectLatencyHistogram(vmiReport, storageReadLatencySecondsBucket, block.RdLatencyHistogram, blkLabels)...)
+		crs = append(crs, collectLatencyHistogram(vmiReport, storageWriteLatencySecondsBucket, block.WrLatencyHistogram, blkLabels)...)
+		crs = append(crs, collectLatencyHistogram(vmiReport, storageFlushLatencySecondsBucket, block.FlLatencyHistogram, blkLabels)...)
+	}
+
+	return crs
+}
+
+// collectLatencyHistogram reports the histogram as cumulative buckets labeled
+// with their upper bound, the layout histogram_quantile() expects.
+func collectLatencyHistogram(vmiReport *VirtualMachineInstanceReport, metric operatormetrics.Metric,
+	histogram *stats.DomainStatsLatencyHistogram, blkLabels map[string]string) []operatormetrics.CollectorResult {
+	if histogram == nil || len(histogram.Bins) != len(histogram.Boundaries)+1 {
+		return nil
+	}
+
+	var crs []operatormetrics.CollectorResult
+	var cumulative uint64
+	for i, count := range histogram.Bins {
+		cumulative += count
+
+		le := "+Inf"
+		if i < len(histogram.Boundaries) {
+			le = strconv.FormatFloat(nanosecondsToSeconds(histogram.Boundaries[i]), 'g', -1, 64)
+		}
+
+		bucketLabels := map[string]string{"le": le}
+		for k, v := range blkLabels {
+			bucketLabels[k] = v
+		}
+		crs = append(crs, vmiReport.newCollectorResultWithLabels(metric, float64(cumulative), bucketLabels))
 	}
 
 	return crs
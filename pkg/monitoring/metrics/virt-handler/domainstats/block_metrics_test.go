@@ -83,6 +83,30 @@ var _ = Describe("block metrics", func() {
 			Entry("kubevirt_vmi_storage_flush_times_seconds_total", storageFlushTimesSeconds, nanosecondsToSeconds(8)),
 		)
 
+		It("should collect the latency histograms as cumulative buckets", func() {
+			vmiStats.DomainStats.Block[0].RdLatencyHistogram = &stats.DomainStatsLatencyHistogram{
+				Boundaries: []uint64{1_000_000, 10_000_000},
+				Bins:       []uint64{5, 3, 1},
+			}
+			defer func() { vmiStats.DomainStats.Block[0].RdLatencyHistogram = nil }()
+
+			buckets := map[string]float64{}
+			for _, cr := range (blockMetrics{}).Collect(vmiReport) {
+				if cr.Metric == storageReadLatencySecondsBucket {
+					Expect(cr.ConstLabels).To(HaveKeyWithValue("drive", "vda"))
+					buckets[cr.ConstLabels["le"]] = cr.Value
+				}
+			}
+			Expect(buckets).To(Equal(map[string]float64{"0.001": 5, "0.01": 8, "+Inf": 9}))
+		})
+
+		It("should not collect latency histograms which are not reported", func() {
+			for _, cr := range (blockMetrics{}).Collect(vmiReport) {
+				Expect(cr.Metric).ToNot(BeElementOf(
+					storageReadLatencySecondsBucket, storageWriteLatencySecondsBucket, storageFlushLatencySecondsBucket))
+			}
+		})
+
 		It("result should be empty if stat not populated or set is false", func() {
 			vmiStats.DomainStats.Block[0].NameSet = false
 			crs := blockMetrics{}.Collect(vmiReport)
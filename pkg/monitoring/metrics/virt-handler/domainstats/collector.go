@@ -40,6 +40,7 @@ var (
 		networkMetrics{},
 		cpuAffinityMetrics{},
 		filesystemMetrics{},
+		guestClockMetrics{},
 	}
 
 	Collector = operatormetrics.Collector{
@@ -0,0 +1,47 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright the KubeVirt Authors.
+ *
+ */
+
+package domainstats
+
+import "github.com/machadovilaca/operator-observability/pkg/operatormetrics"
+
+var (
+	guestClockDrift = operatormetrics.NewGauge(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_vmi_guest_clock_drift_seconds",
+			Help: "Offset of the guest clock from the host clock, measured through the guest agent. Positive when the guest clock is ahead.",
+		},
+	)
+)
+
+type guestClockMetrics struct{}
+
+func (guestClockMetrics) Describe() []operatormetrics.Metric {
+	return []operatormetrics.Metric{guestClockDrift}
+}
+
+func (guestClockMetrics) Collect(vmiReport *VirtualMachineInstanceReport) []operatormetrics.CollectorResult {
+	if vmiReport.vmiStats.DomainStats == nil || !vmiReport.vmiStats.DomainStats.GuestClockDriftSet {
+		return []operatormetrics.CollectorResult{}
+	}
+
+	return []operatormetrics.CollectorResult{
+		vmiReport.newCollectorResult(guestClockDrift, vmiReport.vmiStats.DomainStats.GuestClockDrift),
+	}
+}
@@ -19,7 +19,14 @@
 
 package domainstats
 
-import "github.com/machadovilaca/operator-observability/pkg/operatormetrics"
+import (
+	"github.com/machadovilaca/operator-observability/pkg/operatormetrics"
+
+	k6tv1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/network/vmispec"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/stats"
+)
 
 var (
 	networkTrafficBytesDeprecated = operatormetrics.NewCounter(
@@ -84,6 +91,34 @@ var (
 			Help: "The total number of tx packets dropped on vNIC interfaces.",
 		},
 	)
+
+	networkReceiveBandwidthLimit = operatormetrics.NewGauge(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_vmi_network_receive_bandwidth_limit_bytes_per_second",
+			Help: "The rate the traffic received by a vNIC interface is limited to. The limit label is either average or peak.",
+		},
+	)
+
+	networkTransmitBandwidthLimit = operatormetrics.NewGauge(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_vmi_network_transmit_bandwidth_limit_bytes_per_second",
+			Help: "The rate the traffic transmitted by a vNIC interface is limited to. The limit label is either average or peak.",
+		},
+	)
+
+	networkReceiveBurstLimit = operatormetrics.NewGauge(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_vmi_network_receive_burst_limit_bytes",
+			Help: "The amount of bytes a vNIC interface may receive at the peak rate.",
+		},
+	)
+
+	networkTransmitBurstLimit = operatormetrics.NewGauge(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_vmi_network_transmit_burst_limit_bytes",
+			Help: "The amount of bytes a vNIC interface may transmit at the peak rate.",
+		},
+	)
 )
 
 type networkMetrics struct{}
@@ -99,6 +134,10 @@ func (networkMetrics) Describe() []operatormetrics.Metric {
 		networkTransmitErrors,
 		networkReceivePacketsDropped,
 		networkTransmitPacketsDropped,
+		networkReceiveBandwidthLimit,
+		networkTransmitBandwidthLimit,
+		networkReceiveBurstLimit,
+		networkTransmitBurstLimit,
 	}
 }
 
@@ -155,6 +194,53 @@ func (networkMetrics) Collect(vmiReport *VirtualMachineInstanceReport) []operato
 		if net.TxDropSet {
 			crs = append(crs, vmiReport.newCollectorResultWithLabels(networkTransmitPacketsDropped, float64(net.TxDrop), netLabels))
 		}
+
+		if bandwidth := interfaceBandwidth(vmiReport.vmi, net); bandwidth != nil {
+			crs = append(crs, collectBandwidthLimits(vmiReport, bandwidth, iface)...)
+		}
+	}
+
+	return crs
+}
+
+// interfaceBandwidth returns the bandwidth limits of the VMI interface the stats belong to.
+// The limits are applied by virt-handler on the tap device and are not known to libvirt.
+func interfaceBandwidth(vmi *k6tv1.VirtualMachineInstance, net stats.DomainStatsNet) *k6tv1.InterfaceBandwidth {
+	if vmi == nil || !net.AliasSet {
+		return nil
+	}
+	iface := vmispec.LookupInterfaceByName(vmi.Spec.Domain.Devices.Interfaces, net.Alias)
+	if iface == nil {
+		return nil
+	}
+	return iface.Bandwidth
+}
+
+func collectBandwidthLimits(vmiReport *VirtualMachineInstanceReport, bandwidth *k6tv1.InterfaceBandwidth, iface string) []operatormetrics.CollectorResult {
+	var crs []operatormetrics.CollectorResult
+	if bandwidth.Inbound != nil {
+		crs = append(crs, collectBandwidthLimit(vmiReport, bandwidth.Inbound, networkReceiveBandwidthLimit, networkReceiveBurstLimit, iface)...)
+	}
+	if bandwidth.Outbound != nil {
+		crs = append(crs, collectBandwidthLimit(vmiReport, bandwidth.Outbound, networkTransmitBandwidthLimit, networkTransmitBurstLimit, iface)...)
+	}
+	return crs
+}
+
+func collectBandwidthLimit(vmiReport *VirtualMachineInstanceReport, limit *k6tv1.InterfaceBandwidthLimit, rateMetric, burstMetric operatormetrics.Metric, iface string) []operatormetrics.CollectorResult {
+	averageLabels := map[string]string{"interface": iface, "limit": "average"}
+	crs := []operatormetrics.CollectorResult{
+		vmiReport.newCollectorResultWithLabels(rateMetric, float64(limit.Average.Value()), averageLabels),
+	}
+
+	if limit.Peak != nil {
+		peakLabels := map[string]string{"interface": iface, "limit": "peak"}
+		crs = append(crs, vmiReport.newCollectorResultWithLabels(rateMetric, float64(limit.Peak.Value()), peakLabels))
+	}
+
+	if limit.Burst != nil {
+		netLabels := map[string]string{"interface": iface}
+		crs = append(crs, vmiReport.newCollectorResultWithLabels(burstMetric, float64(limit.Burst.Value()), netLabels))
 	}
 
 	return crs
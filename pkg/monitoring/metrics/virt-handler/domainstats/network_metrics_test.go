@@ -24,10 +24,12 @@ import (
 	. "github.com/onsi/gomega"
 
 	"github.com/machadovilaca/operator-observability/pkg/operatormetrics"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	k6tv1 "kubevirt.io/api/core/v1"
 
 	"kubevirt.io/kubevirt/pkg/monitoring/metrics/testing"
+	"kubevirt.io/kubevirt/pkg/pointer"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/stats"
 )
 
@@ -83,6 +85,52 @@ var _ = Describe("network metrics", func() {
 			Entry("kubevirt_vmi_network_transmit_packets_dropped_total", networkTransmitPacketsDropped, 8.0),
 		)
 
+		It("should collect the bandwidth limits", func() {
+			vmi.Spec.Domain.Devices.Interfaces = []k6tv1.Interface{{
+				Name: "default",
+				Bandwidth: &k6tv1.InterfaceBandwidth{
+					Inbound: &k6tv1.InterfaceBandwidthLimit{
+						Average: resource.MustParse("1Mi"),
+						Peak:    pointer.P(resource.MustParse("2Mi")),
+					},
+					Outbound: &k6tv1.InterfaceBandwidthLimit{
+						Average: resource.MustParse("512Ki"),
+						Burst:   pointer.P(resource.MustParse("64Ki")),
+					},
+				},
+			}}
+			vmiStats.DomainStats.Net[0].AliasSet = true
+			vmiStats.DomainStats.Net[0].Alias = "default"
+			defer func() {
+				vmi.Spec.Domain.Devices.Interfaces = nil
+				vmiStats.DomainStats.Net[0].AliasSet = false
+				vmiStats.DomainStats.Net[0].Alias = ""
+			}()
+
+			limits := map[string]float64{}
+			for _, cr := range (networkMetrics{}).Collect(vmiReport) {
+				switch cr.Metric {
+				case networkReceiveBandwidthLimit, networkTransmitBandwidthLimit:
+					limits[cr.Metric.GetOpts().Name+"/"+cr.ConstLabels["limit"]] = cr.Value
+				case networkReceiveBurstLimit, networkTransmitBurstLimit:
+					limits[cr.Metric.GetOpts().Name] = cr.Value
+				}
+			}
+			Expect(limits).To(Equal(map[string]float64{
+				"kubevirt_vmi_network_receive_bandwidth_limit_bytes_per_second/average":  1024 * 1024,
+				"kubevirt_vmi_network_receive_bandwidth_limit_bytes_per_second/peak":     2 * 1024 * 1024,
+				"kubevirt_vmi_network_transmit_bandwidth_limit_bytes_per_second/average": 512 * 1024,
+				"kubevirt_vmi_network_transmit_burst_limit_bytes":                        64 * 1024,
+			}))
+		})
+
+		It("should not collect bandwidth limits of an interface which is not limited", func() {
+			for _, cr := range (networkMetrics{}).Collect(vmiReport) {
+				Expect(cr.Metric).ToNot(BeElementOf(
+					networkReceiveBandwidthLimit, networkTransmitBandwidthLimit, networkReceiveBurstLimit, networkTransmitBurstLimit))
+			}
+		})
+
 		It("result should be empty if stat not populated or set is false", func() {
 			vmiStats.DomainStats.Net[0].NameSet = false
 			crs := networkMetrics{}.Collect(vmiReport)
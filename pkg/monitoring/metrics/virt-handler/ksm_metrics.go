@@ -0,0 +1,51 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright the KubeVirt Authors.
+ *
+ */
+
+package virt_handler
+
+import (
+	"github.com/machadovilaca/operator-observability/pkg/operatormetrics"
+	io_prometheus_client "github.com/prometheus/client_model/go"
+)
+
+var (
+	ksmMetrics = []operatormetrics.Metric{
+		nodeKSMSavedBytes,
+	}
+
+	nodeKSMSavedBytes = operatormetrics.NewGaugeVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_node_ksm_saved_bytes",
+			Help: "The memory saved on the node by KSM merging identical pages.",
+		},
+		[]string{"node"},
+	)
+)
+
+func SetKSMSavedBytes(node string, bytes float64) {
+	nodeKSMSavedBytes.WithLabelValues(node).Set(bytes)
+}
+
+func GetKSMSavedBytes(node string) (float64, error) {
+	dto := &io_prometheus_client.Metric{}
+	if err := nodeKSMSavedBytes.WithLabelValues(node).Write(dto); err != nil {
+		return 0, err
+	}
+	return *dto.Gauge.Value, nil
+}
@@ -0,0 +1,60 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright the KubeVirt Authors.
+ *
+ */
+
+package virt_handler
+
+import (
+	"github.com/machadovilaca/operator-observability/pkg/operatormetrics"
+)
+
+var (
+	memoryOverheadMetrics = []operatormetrics.Metric{
+		vmiLauncherMemoryOverheadMeasured,
+		nodeMemoryOverheadRatio,
+	}
+
+	vmiLauncherMemoryOverheadMeasured = operatormetrics.NewGaugeVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_vmi_launcher_memory_overhead_measured_bytes",
+			Help: "The peak memory usage of the virt-launcher compute container minus the memory of the guest.",
+		},
+		[]string{"namespace", "name", "node"},
+	)
+
+	nodeMemoryOverheadRatio = operatormetrics.NewGaugeVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_node_memory_overhead_ratio",
+			Help: "The highest ratio between the measured and the estimated memory overhead of the virt-launcher pods on the node.",
+		},
+		[]string{"node"},
+	)
+)
+
+// ResetLauncherMemoryOverheadMeasured removes the measurements of VMIs which left the node
+func ResetLauncherMemoryOverheadMeasured() {
+	vmiLauncherMemoryOverheadMeasured.Reset()
+}
+
+func SetLauncherMemoryOverheadMeasured(namespace, name, node string, overhead uint64) {
+	vmiLauncherMemoryOverheadMeasured.WithLabelValues(namespace, name, node).Set(float64(overhead))
+}
+
+func SetNodeMemoryOverheadRatio(node string, ratio float64) {
+	nodeMemoryOverheadRatio.WithLabelValues(node).Set(ratio)
+}
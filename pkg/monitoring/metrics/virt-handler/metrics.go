@@ -37,7 +37,15 @@ func SetupMetrics(virtShareDir, nodeName string, MaxRequestsInFlight int, vmiInf
 		return err
 	}
 
-	if err := operatormetrics.RegisterMetrics(versionMetrics); err != nil {
+	if err := operatormetrics.RegisterMetrics(
+		versionMetrics,
+		shutdownMetrics,
+		memoryOverheadMetrics,
+		cpuFairShareMetrics,
+		ksmMetrics,
+		swapMetrics,
+		postCopyRecoveryMetrics,
+	); err != nil {
 		return err
 	}
 	SetVersionInfo()
@@ -0,0 +1,56 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright the KubeVirt Authors.
+ *
+ */
+
+package virt_handler
+
+import (
+	"github.com/machadovilaca/operator-observability/pkg/operatormetrics"
+	io_prometheus_client "github.com/prometheus/client_model/go"
+)
+
+const (
+	PostCopyRecoveryResultSucceeded = "succeeded"
+	PostCopyRecoveryResultFailed    = "failed"
+)
+
+var (
+	postCopyRecoveryMetrics = []operatormetrics.Metric{
+		vmiMigrationPostCopyRecoveries,
+	}
+
+	vmiMigrationPostCopyRecoveries = operatormetrics.NewCounterVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_vmi_migration_postcopy_recoveries_total",
+			Help: "The number of post copy migrations which lost the connection to the target, by the result of their recovery.",
+		},
+		[]string{"result"},
+	)
+)
+
+func IncPostCopyRecoveries(result string) {
+	vmiMigrationPostCopyRecoveries.WithLabelValues(result).Inc()
+}
+
+func GetPostCopyRecoveries(result string) (float64, error) {
+	dto := &io_prometheus_client.Metric{}
+	if err := vmiMigrationPostCopyRecoveries.WithLabelValues(result).Write(dto); err != nil {
+		return 0, err
+	}
+	return *dto.Counter.Value, nil
+}
@@ -0,0 +1,42 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright the KubeVirt Authors.
+ *
+ */
+
+package virt_handler
+
+import (
+	"github.com/machadovilaca/operator-observability/pkg/operatormetrics"
+)
+
+var (
+	shutdownMetrics = []operatormetrics.Metric{
+		vmiShutdownStage,
+	}
+
+	vmiShutdownStage = operatormetrics.NewCounterVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_vmi_shutdown_stage_total",
+			Help: "The number of VMIs with a shutdown policy which stopped in the given stage of the shutdown.",
+		},
+		[]string{"stage"},
+	)
+)
+
+func IncShutdownStage(stage string) {
+	vmiShutdownStage.WithLabelValues(stage).Inc()
+}
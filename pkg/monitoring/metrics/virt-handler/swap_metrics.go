@@ -0,0 +1,82 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package virt_handler
+
+import (
+	"github.com/machadovilaca/operator-observability/pkg/operatormetrics"
+	io_prometheus_client "github.com/prometheus/client_model/go"
+)
+
+var (
+	swapMetrics = []operatormetrics.Metric{
+		vmiLauncherSwapUsedBytes,
+		nodeSwapUsedBytes,
+		nodeMemoryPressureRatio,
+	}
+
+	vmiLauncherSwapUsedBytes = operatormetrics.NewGaugeVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_vmi_launcher_swap_used_bytes",
+			Help: "The swap used by the virt-launcher compute container of an overcommitted VMI.",
+		},
+		[]string{"namespace", "name", "node"},
+	)
+
+	nodeSwapUsedBytes = operatormetrics.NewGaugeVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_node_swap_used_bytes",
+			Help: "The swap in use on the node.",
+		},
+		[]string{"node"},
+	)
+
+	nodeMemoryPressureRatio = operatormetrics.NewGaugeVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_node_memory_pressure_ratio",
+			Help: "The share of the last 10 seconds in which all the tasks of the node were stalled on memory.",
+		},
+		[]string{"node"},
+	)
+)
+
+// ResetLauncherSwapUsed removes the swap usage of VMIs which left the node or are no longer overcommitted
+func ResetLauncherSwapUsed() {
+	vmiLauncherSwapUsedBytes.Reset()
+}
+
+func SetLauncherSwapUsed(namespace, name, node string, bytes uint64) {
+	vmiLauncherSwapUsedBytes.WithLabelValues(namespace, name, node).Set(float64(bytes))
+}
+
+func SetNodeSwapUsed(node string, bytes uint64) {
+	nodeSwapUsedBytes.WithLabelValues(node).Set(float64(bytes))
+}
+
+func SetNodeMemoryPressure(node string, ratio float64) {
+	nodeMemoryPressureRatio.WithLabelValues(node).Set(ratio)
+}
+
+func GetLauncherSwapUsed(namespace, name, node string) (float64, error) {
+	dto := &io_prometheus_client.Metric{}
+	if err := vmiLauncherSwapUsedBytes.WithLabelValues(namespace, name, node).Write(dto); err != nil {
+		return 0, err
+	}
+	return *dto.Gauge.Value, nil
+}
@@ -64,6 +64,18 @@ var (
 				operatorHealthImpactLabelKey: "none",
 			},
 		},
+		{
+			Alert: "VMDesiredStateDivergence",
+			Expr:  intstr.FromString("kubevirt_vm_desired_state_divergence_seconds >= 600"),
+			Annotations: map[string]string{
+				"description": "VirtualMachine {{ $labels.name }} in namespace {{ $labels.namespace }} has not been in the state desired by its run strategy for more than 10 minutes ({{ $labels.reason }})",
+				"summary":     "A VirtualMachine has not reached the state desired by its run strategy for more than 10 minutes.",
+			},
+			Labels: map[string]string{
+				severityAlertLabelKey:        "warning",
+				operatorHealthImpactLabelKey: "none",
+			},
+		},
 		{
 			Alert: "OutdatedVirtualMachineInstanceWorkloads",
 			Expr:  intstr.FromString("kubevirt_vmi_number_of_outdated != 0"),
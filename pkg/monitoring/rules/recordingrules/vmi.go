@@ -39,4 +39,20 @@ var vmiRecordingRules = []operatorrules.RecordingRule{
 		MetricType: operatormetrics.GaugeType,
 		Expr:       intstr.FromString("kubevirt_vmi_memory_available_bytes-kubevirt_vmi_memory_usable_bytes"),
 	},
+	{
+		MetricsOpts: operatormetrics.MetricOpts{
+			Name: "kubevirt_vmi_guest_license_node_count",
+			Help: "The number of VMIs per node by guest OS family, license model and BYOL flag.",
+		},
+		MetricType: operatormetrics.GaugeType,
+		Expr:       intstr.FromString("sum by (node, os_family, license_model, byol) (kubevirt_vmi_guest_license_info{node!=''})"),
+	},
+	{
+		MetricsOpts: operatormetrics.MetricOpts{
+			Name: "kubevirt_vmi_guest_license_count",
+			Help: "The number of VMIs in the cluster by guest OS family, license model and BYOL flag.",
+		},
+		MetricType: operatormetrics.GaugeType,
+		Expr:       intstr.FromString("sum by (os_family, license_model, byol) (kubevirt_vmi_guest_license_info)"),
+	},
 }
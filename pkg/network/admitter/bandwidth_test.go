@@ -0,0 +1,116 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package admitter_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/network/admitter"
+	"kubevirt.io/kubevirt/pkg/pointer"
+)
+
+var _ = Describe("Validating interface bandwidth", func() {
+	newSpec := func(iface v1.Interface) *v1.VirtualMachineInstanceSpec {
+		spec := &v1.VirtualMachineInstanceSpec{}
+		spec.Domain.Devices.Interfaces = []v1.Interface{iface}
+		spec.Networks = []v1.Network{{
+			Name:          iface.Name,
+			NetworkSource: v1.NetworkSource{Multus: &v1.MultusNetwork{NetworkName: "test"}},
+		}}
+		return spec
+	}
+
+	It("should accept valid limits", func() {
+		spec := newSpec(v1.Interface{
+			Name:                   "blue",
+			InterfaceBindingMethod: v1.InterfaceBindingMethod{Bridge: &v1.InterfaceBridge{}},
+			Bandwidth: &v1.InterfaceBandwidth{
+				Inbound: &v1.InterfaceBandwidthLimit{
+					Average: resource.MustParse("10Mi"),
+					Peak:    pointer.P(resource.MustParse("20Mi")),
+					Burst:   pointer.P(resource.MustParse("1Mi")),
+				},
+				Outbound: &v1.InterfaceBandwidthLimit{Average: resource.MustParse("5Mi")},
+			},
+		})
+
+		validator := admitter.NewValidator(k8sfield.NewPath("fake"), spec, stubClusterConfigChecker{})
+		Expect(validator.Validate()).To(BeEmpty())
+	})
+
+	DescribeTable("should reject limits on an interface with", func(binding v1.InterfaceBindingMethod, pluginBinding *v1.PluginBinding) {
+		spec := newSpec(v1.Interface{
+			Name:                   "red",
+			InterfaceBindingMethod: binding,
+			Binding:                pluginBinding,
+			Bandwidth: &v1.InterfaceBandwidth{
+				Outbound: &v1.InterfaceBandwidthLimit{Average: resource.MustParse("5Mi")},
+			},
+		})
+
+		validator := admitter.NewValidator(k8sfield.NewPath("fake"), spec, stubClusterConfigChecker{})
+		Expect(validator.Validate()).To(ContainElement(metav1.StatusCause{
+			Type:    "FieldValueInvalid",
+			Message: "\"red\" interface's bandwidth is only supported for bridge and masquerade bindings",
+			Field:   "fake.domain.devices.interfaces[0].bandwidth",
+		}))
+	},
+		Entry("SR-IOV binding", v1.InterfaceBindingMethod{SRIOV: &v1.InterfaceSRIOV{}}, nil),
+		Entry("a binding plugin", v1.InterfaceBindingMethod{}, &v1.PluginBinding{Name: "passt"}),
+	)
+
+	DescribeTable("should reject", func(limit *v1.InterfaceBandwidthLimit, field, message string) {
+		spec := newSpec(v1.Interface{
+			Name:                   "blue",
+			InterfaceBindingMethod: v1.InterfaceBindingMethod{Bridge: &v1.InterfaceBridge{}},
+			Bandwidth:              &v1.InterfaceBandwidth{Inbound: limit},
+		})
+
+		validator := admitter.NewValidator(k8sfield.NewPath("fake"), spec, stubClusterConfigChecker{})
+		Expect(validator.Validate()).To(ConsistOf(metav1.StatusCause{
+			Type:    "FieldValueInvalid",
+			Message: message,
+			Field:   field,
+		}))
+	},
+		Entry("a zero average",
+			&v1.InterfaceBandwidthLimit{Average: resource.MustParse("0")},
+			"fake.domain.devices.interfaces[0].bandwidth.inbound.average",
+			"fake.domain.devices.interfaces[0].bandwidth.inbound.average must be greater than zero",
+		),
+		Entry("a peak lower than the average",
+			&v1.InterfaceBandwidthLimit{Average: resource.MustParse("10Mi"), Peak: pointer.P(resource.MustParse("1Mi"))},
+			"fake.domain.devices.interfaces[0].bandwidth.inbound.peak",
+			"fake.domain.devices.interfaces[0].bandwidth.inbound.peak must not be lower than the average",
+		),
+		Entry("a negative burst",
+			&v1.InterfaceBandwidthLimit{Average: resource.MustParse("10Mi"), Burst: pointer.P(resource.MustParse("-1Mi"))},
+			"fake.domain.devices.interfaces[0].bandwidth.inbound.burst",
+			"fake.domain.devices.interfaces[0].bandwidth.inbound.burst must be greater than zero",
+		),
+	)
+})
@@ -270,6 +270,8 @@ func validateDHCPOptions(field *k8sfield.Path, idx int, iface v1.Interface) []me
 	if iface.DHCPOptions != nil {
 		causes = append(causes, validateDHCPExtraOptions(field, iface)...)
 		causes = append(causes, validateDHCPNTPServersAreValidIPv4Addresses(field, iface, idx)...)
+		causes = append(causes, validateDHCPMTU(field, iface, idx)...)
+		causes = append(causes, validateDHCPRoutes(field, iface, idx)...)
 	}
 	return causes
 }
@@ -306,6 +308,39 @@ func validateDHCPNTPServersAreValidIPv4Addresses(field *k8sfield.Path, iface v1.
 	return causes
 }
 
+func validateDHCPMTU(field *k8sfield.Path, iface v1.Interface, idx int) (causes []metav1.StatusCause) {
+	const minIPv4MTU, maxMTU = 68, 65535
+	if mtu := iface.DHCPOptions.MTU; mtu != nil && (*mtu < minIPv4MTU || *mtu > maxMTU) {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("DHCP MTU must be in range %d to %d", minIPv4MTU, maxMTU),
+			Field:   field.Child("domain", "devices", "interfaces").Index(idx).Child("dhcpOptions", "mtu").String(),
+		})
+	}
+	return causes
+}
+
+func validateDHCPRoutes(field *k8sfield.Path, iface v1.Interface, idx int) (causes []metav1.StatusCause) {
+	for index, route := range iface.DHCPOptions.Routes {
+		routeField := field.Child("domain", "devices", "interfaces").Index(idx).Child("dhcpOptions", "routes").Index(index)
+		if dst, _, err := net.ParseCIDR(route.Destination); err != nil || dst.To4() == nil {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: "DHCP route destination must be a valid IPv4 CIDR.",
+				Field:   routeField.Child("destination").String(),
+			})
+		}
+		if route.Gateway != "" && net.ParseIP(route.Gateway).To4() == nil {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: "DHCP route gateway must be a valid IPv4 address.",
+				Field:   routeField.Child("gateway").String(),
+			})
+		}
+	}
+	return causes
+}
+
 func validateDHCPPrivateOptionsWithinRange(field *k8sfield.Path, dhcpPrivateOption v1.DHCPPrivateOptions) (causes []metav1.StatusCause) {
 	if !(dhcpPrivateOption.Option >= 224 && dhcpPrivateOption.Option <= 254) {
 		causes = append(causes, metav1.StatusCause{
@@ -31,6 +31,7 @@ import (
 	v1 "kubevirt.io/api/core/v1"
 
 	"kubevirt.io/kubevirt/pkg/network/admitter"
+	"kubevirt.io/kubevirt/pkg/pointer"
 )
 
 var _ = Describe("Validating VMI network spec", func() {
@@ -362,6 +363,31 @@ var _ = Describe("Validating VMI network spec", func() {
 					Field:   "fake.domain.devices.interfaces[0].dhcpOptions.ntpServers[1]",
 				}},
 			),
+			Entry(
+				"out of range MTU",
+				v1.DHCPOptions{MTU: pointer.P(uint32(67))},
+				[]metav1.StatusCause{{
+					Type:    "FieldValueInvalid",
+					Message: "DHCP MTU must be in range 68 to 65535",
+					Field:   "fake.domain.devices.interfaces[0].dhcpOptions.mtu",
+				}},
+			),
+			Entry(
+				"non-IPv4 routes",
+				v1.DHCPOptions{Routes: []v1.DHCPRoute{
+					{Destination: "fd10::/64", Gateway: "10.0.0.1"},
+					{Destination: "10.10.0.0/16", Gateway: "fd10::1"},
+				}},
+				[]metav1.StatusCause{{
+					Type:    "FieldValueInvalid",
+					Message: "DHCP route destination must be a valid IPv4 CIDR.",
+					Field:   "fake.domain.devices.interfaces[0].dhcpOptions.routes[0].destination",
+				}, {
+					Type:    "FieldValueInvalid",
+					Message: "DHCP route gateway must be a valid IPv4 address.",
+					Field:   "fake.domain.devices.interfaces[0].dhcpOptions.routes[1].gateway",
+				}},
+			),
 		)
 
 		DescribeTable("should accept interface DHCP options with", func(dhcpOpts v1.DHCPOptions) {
@@ -380,6 +406,11 @@ var _ = Describe("Validating VMI network spec", func() {
 				PrivateOptions: []v1.DHCPPrivateOptions{{Option: 240, Value: "extra.options.kubevirt.io"}},
 			}),
 			Entry(" valid NTP servers", v1.DHCPOptions{NTPServers: []string{"127.0.0.1", "127.0.0.2"}}),
+			Entry("valid MTU", v1.DHCPOptions{MTU: pointer.P(uint32(1400))}),
+			Entry("valid routes", v1.DHCPOptions{Routes: []v1.DHCPRoute{
+				{Destination: "10.10.0.0/16", Gateway: "10.0.0.1"},
+				{Destination: "192.168.1.0/24"},
+			}}),
 			Entry(
 				"unique DHCPPrivateOptions",
 				v1.DHCPOptions{
@@ -0,0 +1,100 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package arpsnoop
+
+import (
+	"encoding/binary"
+	"errors"
+	"net"
+	"time"
+
+	"golang.org/x/sys/unix"
+)
+
+const (
+	arpHardwareEthernet = 1
+	arpProtocolIPv4     = 0x0800
+	arpIPv4PacketLen    = 28
+
+	readTimeout = time.Second
+)
+
+// arpSocket receives the ARP payload of the frames seen by a link.
+type arpSocket struct {
+	fd int
+}
+
+func openARPSocket(ifIndex int) (*arpSocket, error) {
+	protocol := htons(unix.ETH_P_ARP)
+	fd, err := unix.Socket(unix.AF_PACKET, unix.SOCK_DGRAM|unix.SOCK_CLOEXEC, int(protocol))
+	if err != nil {
+		return nil, err
+	}
+
+	// A read timeout lets the snooper notice it was stopped
+	timeout := unix.NsecToTimeval(readTimeout.Nanoseconds())
+	if err := unix.SetsockoptTimeval(fd, unix.SOL_SOCKET, unix.SO_RCVTIMEO, &timeout); err != nil {
+		_ = unix.Close(fd)
+		return nil, err
+	}
+	if err := unix.Bind(fd, &unix.SockaddrLinklayer{Protocol: protocol, Ifindex: ifIndex}); err != nil {
+		_ = unix.Close(fd)
+		return nil, err
+	}
+	return &arpSocket{fd: fd}, nil
+}
+
+func (s *arpSocket) Read(buf []byte) (int, error) {
+	n, _, err := unix.Recvfrom(s.fd, buf, 0)
+	return n, err
+}
+
+func (s *arpSocket) Close() error {
+	return unix.Close(s.fd)
+}
+
+func isTimeout(err error) bool {
+	return errors.Is(err, unix.EAGAIN) || errors.Is(err, unix.EINTR)
+}
+
+// parseARPSender returns the sender addresses of an Ethernet/IPv4 ARP packet (RFC 826).
+// ARP probes, which have no sender IP yet, are ignored.
+func parseARPSender(packet []byte) (net.HardwareAddr, net.IP, bool) {
+	if len(packet) < arpIPv4PacketLen ||
+		binary.BigEndian.Uint16(packet[0:2]) != arpHardwareEthernet ||
+		binary.BigEndian.Uint16(packet[2:4]) != arpProtocolIPv4 ||
+		packet[4] != 6 || packet[5] != 4 {
+		return nil, nil, false
+	}
+
+	senderMAC := net.HardwareAddr(append([]byte(nil), packet[8:14]...))
+	senderIP := net.IP(append([]byte(nil), packet[14:18]...))
+	if senderIP.IsUnspecified() {
+		return nil, nil, false
+	}
+	return senderMAC, senderIP, true
+}
+
+// htons converts a short from host to network byte order.
+func htons(v uint16) uint16 {
+	b := make([]byte, 2)
+	binary.BigEndian.PutUint16(b, v)
+	return binary.NativeEndian.Uint16(b)
+}
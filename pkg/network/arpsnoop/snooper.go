@@ -0,0 +1,204 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package arpsnoop
+
+import (
+	"fmt"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/vishvananda/netlink"
+
+	"kubevirt.io/client-go/log"
+)
+
+const (
+	// maxIPsPerMAC bounds the addresses kept for a guest NIC, the most recently seen ones are kept.
+	maxIPsPerMAC = 8
+	// learnedIPTTL is how long an address is reported after the guest last announced it.
+	learnedIPTTL = 20 * time.Minute
+)
+
+type NSExecutor interface {
+	Do(func() error) error
+}
+
+// Snooper learns the IPv4 addresses of guests from the ARP packets they send on the
+// in-pod bridges of their interfaces. Snooped links are identified by a key, see Key.
+type Snooper struct {
+	mu         sync.RWMutex
+	linksByKey map[string]*snoopedLink
+	now        func() time.Time
+}
+
+type snoopedLink struct {
+	stop     chan struct{}
+	guestMAC string
+	ips      []learnedIP
+}
+
+type learnedIP struct {
+	ip       string
+	lastSeen time.Time
+}
+
+func New() *Snooper {
+	return &Snooper{
+		linksByKey: map[string]*snoopedLink{},
+		now:        time.Now,
+	}
+}
+
+// Key identifies the snooped link of a VMI network.
+func Key(vmiUID, networkName string) string {
+	return fmt.Sprintf("%s/%s", vmiUID, networkName)
+}
+
+// Start snoops the ARP packets the guest NIC with guestMAC sends on the first existing link out of linkNames
+// in the network namespace. If the key is already snooped, only the guest MAC is updated.
+func (s *Snooper) Start(key string, ns NSExecutor, guestMAC string, linkNames ...string) error {
+	hwAddr, err := net.ParseMAC(guestMAC)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if link, exists := s.linksByKey[key]; exists {
+		if link.guestMAC != hwAddr.String() {
+			link.guestMAC = hwAddr.String()
+			link.ips = nil
+		}
+		return nil
+	}
+
+	var sock *arpSocket
+	err = ns.Do(func() error {
+		arpLink, err := lookupFirstLink(linkNames)
+		if err != nil {
+			return err
+		}
+		// The socket stays in the namespace it was created in
+		sock, err = openARPSocket(arpLink.Attrs().Index)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	link := &snoopedLink{stop: make(chan struct{}), guestMAC: hwAddr.String()}
+	s.linksByKey[key] = link
+	go s.snoop(key, sock, link.stop)
+	return nil
+}
+
+// Stop stops snooping the keys starting with the prefix and forgets what was learned on them.
+func (s *Snooper) Stop(keyPrefix string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for key, link := range s.linksByKey {
+		if strings.HasPrefix(key, keyPrefix) {
+			close(link.stop)
+			delete(s.linksByKey, key)
+		}
+	}
+}
+
+// LearnedIPs returns the addresses the guest NIC with the MAC recently announced on the link of the key,
+// the most recent first.
+func (s *Snooper) LearnedIPs(key, mac string) []string {
+	hwAddr, err := net.ParseMAC(mac)
+	if err != nil {
+		return nil
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	link, exists := s.linksByKey[key]
+	if !exists || link.guestMAC != hwAddr.String() {
+		return nil
+	}
+
+	var ips []string
+	for _, learned := range link.ips {
+		if !s.isExpired(learned) {
+			ips = append(ips, learned.ip)
+		}
+	}
+	return ips
+}
+
+// learn records the sender of an ARP packet, the packets of other hosts on the segment are ignored.
+func (s *Snooper) learn(key string, mac net.HardwareAddr, ip net.IP) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	link, exists := s.linksByKey[key]
+	if !exists || link.guestMAC != mac.String() {
+		return
+	}
+
+	ips := []learnedIP{{ip: ip.String(), lastSeen: s.now()}}
+	for _, known := range link.ips {
+		if known.ip != ips[0].ip && !s.isExpired(known) && len(ips) < maxIPsPerMAC {
+			ips = append(ips, known)
+		}
+	}
+	link.ips = ips
+}
+
+func (s *Snooper) isExpired(learned learnedIP) bool {
+	return s.now().Sub(learned.lastSeen) > learnedIPTTL
+}
+
+func (s *Snooper) snoop(key string, sock *arpSocket, stop <-chan struct{}) {
+	defer sock.Close()
+
+	buf := make([]byte, 1500)
+	for {
+		select {
+		case <-stop:
+			return
+		default:
+		}
+
+		n, err := sock.Read(buf)
+		if err != nil {
+			if !isTimeout(err) {
+				log.Log.Reason(err).Warningf("failed to read ARP packets of %s", key)
+				return
+			}
+			continue
+		}
+		if mac, ip, ok := parseARPSender(buf[:n]); ok {
+			s.learn(key, mac, ip)
+		}
+	}
+}
+
+func lookupFirstLink(linkNames []string) (netlink.Link, error) {
+	for _, linkName := range linkNames {
+		if arpLink, err := netlink.LinkByName(linkName); err == nil {
+			return arpLink, nil
+		}
+	}
+	return nil, fmt.Errorf("none of the links %v exists", linkNames)
+}
@@ -0,0 +1,143 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package arpsnoop
+
+import (
+	"fmt"
+	"net"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ARP snooper", func() {
+	const (
+		key      = "vmi-uid/net1"
+		guestMAC = "02:aa:bb:cc:dd:01"
+	)
+
+	arpPacket := func(senderMAC string, senderIP net.IP) []byte {
+		mac, err := net.ParseMAC(senderMAC)
+		Expect(err).ToNot(HaveOccurred())
+		packet := []byte{0, 1, 8, 0, 6, 4, 0, 1}
+		packet = append(packet, mac...)
+		packet = append(packet, senderIP.To4()...)
+		packet = append(packet, make([]byte, 6)...)
+		return append(packet, 10, 0, 0, 1)
+	}
+
+	Context("parseARPSender", func() {
+		It("should return the sender of an ARP packet", func() {
+			mac, ip, ok := parseARPSender(arpPacket(guestMAC, net.ParseIP("10.0.0.2")))
+			Expect(ok).To(BeTrue())
+			Expect(mac.String()).To(Equal(guestMAC))
+			Expect(ip.String()).To(Equal("10.0.0.2"))
+		})
+
+		It("should ignore ARP probes", func() {
+			_, _, ok := parseARPSender(arpPacket(guestMAC, net.IPv4zero))
+			Expect(ok).To(BeFalse())
+		})
+
+		It("should ignore truncated packets", func() {
+			_, _, ok := parseARPSender(arpPacket(guestMAC, net.ParseIP("10.0.0.2"))[:20])
+			Expect(ok).To(BeFalse())
+		})
+	})
+
+	Context("learned IPs", func() {
+		var (
+			snooper *Snooper
+			now     time.Time
+		)
+
+		BeforeEach(func() {
+			now = time.Now()
+			snooper = New()
+			snooper.now = func() time.Time { return now }
+			snooper.linksByKey[key] = &snoopedLink{stop: make(chan struct{}), guestMAC: guestMAC}
+		})
+
+		learnFrom := func(senderMAC, ip string) {
+			mac, _ := net.ParseMAC(senderMAC)
+			snooper.learn(key, mac, net.ParseIP(ip))
+		}
+
+		learn := func(ip string) {
+			learnFrom(guestMAC, ip)
+		}
+
+		It("should return the most recently announced IP first", func() {
+			learn("10.0.0.2")
+			learn("10.0.0.3")
+			learn("10.0.0.2")
+			Expect(snooper.LearnedIPs(key, guestMAC)).To(Equal([]string{"10.0.0.2", "10.0.0.3"}))
+		})
+
+		It("should match the MAC regardless of its case", func() {
+			learn("10.0.0.2")
+			Expect(snooper.LearnedIPs(key, "02:AA:BB:CC:DD:01")).To(Equal([]string{"10.0.0.2"}))
+		})
+
+		It("should keep a bounded number of IPs", func() {
+			for i := 0; i < 2*maxIPsPerMAC; i++ {
+				learn(fmt.Sprintf("10.0.0.%d", i+2))
+			}
+			Expect(snooper.LearnedIPs(key, guestMAC)).To(HaveLen(maxIPsPerMAC))
+			Expect(snooper.LearnedIPs(key, guestMAC)[0]).To(Equal(fmt.Sprintf("10.0.0.%d", 2*maxIPsPerMAC+1)))
+		})
+
+		It("should ignore the ARP packets of other hosts on the segment", func() {
+			learnFrom("02:aa:bb:cc:dd:02", "10.0.0.4")
+			Expect(snooper.LearnedIPs(key, "02:aa:bb:cc:dd:02")).To(BeEmpty())
+			Expect(snooper.linksByKey[key].ips).To(BeEmpty())
+		})
+
+		It("should expire the IPs the guest stopped announcing", func() {
+			learn("10.0.0.2")
+			now = now.Add(learnedIPTTL / 2)
+			learn("10.0.0.3")
+			now = now.Add(learnedIPTTL/2 + time.Second)
+			Expect(snooper.LearnedIPs(key, guestMAC)).To(Equal([]string{"10.0.0.3"}))
+
+			learn("10.0.0.4")
+			Expect(snooper.linksByKey[key].ips).To(HaveLen(2))
+		})
+
+		It("should forget the IPs once the guest MAC changes", func() {
+			learn("10.0.0.2")
+			Expect(snooper.Start(key, nil, "02:aa:bb:cc:dd:03")).To(Succeed())
+			Expect(snooper.LearnedIPs(key, guestMAC)).To(BeEmpty())
+
+			learnFrom("02:aa:bb:cc:dd:03", "10.0.0.3")
+			Expect(snooper.LearnedIPs(key, "02:aa:bb:cc:dd:03")).To(Equal([]string{"10.0.0.3"}))
+		})
+
+		It("should forget the IPs once stopped", func() {
+			learn("10.0.0.2")
+			snooper.Stop("vmi-uid")
+			Expect(snooper.LearnedIPs(key, guestMAC)).To(BeEmpty())
+
+			learn("10.0.0.3")
+			Expect(snooper.LearnedIPs(key, guestMAC)).To(BeEmpty())
+		})
+	})
+})
@@ -0,0 +1,44 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package deviceinfo
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"kubevirt.io/kubevirt/pkg/network/downwardapi"
+)
+
+// VDPADevicePathByNetworkName returns the vhost-vdpa character device of the networks in the network-info,
+// as reported by the device plugin which allocated the vDPA device.
+func VDPADevicePathByNetworkName(networkInfoBytes []byte) (map[string]string, error) {
+	var networkInfo downwardapi.NetworkInfo
+	if err := json.Unmarshal(networkInfoBytes, &networkInfo); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal network-info: %w", err)
+	}
+
+	devicePathByNetworkName := map[string]string{}
+	for _, iface := range networkInfo.Interfaces {
+		if iface.DeviceInfo != nil && iface.DeviceInfo.Vdpa != nil && iface.DeviceInfo.Vdpa.Path != "" {
+			devicePathByNetworkName[iface.Network] = iface.DeviceInfo.Vdpa.Path
+		}
+	}
+	return devicePathByNetworkName, nil
+}
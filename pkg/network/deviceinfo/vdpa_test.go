@@ -0,0 +1,45 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package deviceinfo_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"kubevirt.io/kubevirt/pkg/network/deviceinfo"
+)
+
+var _ = Describe("vDPA device info", func() {
+	It("should map the networks to their vhost-vdpa device", func() {
+		networkInfo := `{"interfaces":[` +
+			`{"network":"sriov","deviceInfo":{"type":"pci","version":"1.0.0","pci":{"pci-address":"0000:65:00.2"}}},` +
+			`{"network":"vdpa","deviceInfo":{"type":"vdpa","version":"1.1.0",` +
+			`"vdpa":{"parent-device":"vdpa:0000:65:00.3","driver":"vhost","path":"/dev/vhost-vdpa-1"}}}]}`
+
+		Expect(deviceinfo.VDPADevicePathByNetworkName([]byte(networkInfo))).To(Equal(map[string]string{
+			"vdpa": "/dev/vhost-vdpa-1",
+		}))
+	})
+
+	It("should fail on a malformed network-info", func() {
+		_, err := deviceinfo.VDPADevicePathByNetworkName([]byte("{"))
+		Expect(err).To(HaveOccurred())
+	})
+})
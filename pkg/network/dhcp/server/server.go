@@ -43,6 +43,8 @@ const (
 	errorSearchDomainNotValid = "Search domain is not valid"
 	errorSearchDomainTooLong  = "Search domains length exceeded allowable size"
 	errorNTPConfiguration     = "Could not parse NTP server as IPv4 address: %s"
+	errorRouteDestination     = "Could not parse route destination as IPv4 CIDR: %s"
+	errorRouteGateway         = "Could not parse route gateway as IPv4 address: %s"
 )
 
 // simple domain validation regex. Put it here to avoid compiling each time.
@@ -110,6 +112,10 @@ func prepareDHCPOptions(
 	hostname string,
 	customDHCPOptions *v1.DHCPOptions) (dhcp.Options, error) {
 
+	if customDHCPOptions != nil && customDHCPOptions.MTU != nil {
+		log.Log.Infof("Setting dhcp option MTU to %d", *customDHCPOptions.MTU)
+		mtu = uint16(*customDHCPOptions.MTU)
+	}
 	mtuArray := make([]byte, 2)
 	binary.BigEndian.PutUint16(mtuArray, mtu)
 
@@ -125,6 +131,17 @@ func prepareDHCPOptions(
 		dhcpOptions[dhcp.OptionRouter] = routerIP.To4()
 	}
 
+	if customDHCPOptions != nil && len(customDHCPOptions.Routes) > 0 {
+		customRoutes, err := convertCustomRoutes(customDHCPOptions.Routes)
+		if err != nil {
+			return nil, err
+		}
+		if routes != nil {
+			customRoutes = append(append([]netlink.Route{}, *routes...), customRoutes...)
+		}
+		routes = &customRoutes
+	}
+
 	netRoutes := formClasslessRoutes(routes)
 
 	if len(netRoutes) != 0 {
@@ -277,6 +294,24 @@ func formClasslessRoutes(routes *[]netlink.Route) (formattedRoutes []byte) {
 	return
 }
 
+func convertCustomRoutes(customRoutes []v1.DHCPRoute) ([]netlink.Route, error) {
+	var routes []netlink.Route
+	for _, customRoute := range customRoutes {
+		_, dst, err := net.ParseCIDR(customRoute.Destination)
+		if err != nil || dst.IP.To4() == nil {
+			return nil, fmt.Errorf(errorRouteDestination, customRoute.Destination)
+		}
+		route := netlink.Route{Dst: dst}
+		if customRoute.Gateway != "" {
+			if route.Gw = net.ParseIP(customRoute.Gateway).To4(); route.Gw == nil {
+				return nil, fmt.Errorf(errorRouteGateway, customRoute.Gateway)
+			}
+		}
+		routes = append(routes, route)
+	}
+	return routes, nil
+}
+
 func convertSearchDomainsToBytes(searchDomainStrings []string) ([]byte, error) {
 	/*
 	   https://tools.ietf.org/html/rfc3397
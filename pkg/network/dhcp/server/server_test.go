@@ -29,6 +29,8 @@ import (
 	. "github.com/onsi/gomega"
 
 	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/pointer"
 )
 
 var _ = Describe("DHCP Server", func() {
@@ -212,6 +214,32 @@ var _ = Describe("DHCP Server", func() {
 			Expect(options[240]).To(Equal([]byte("private.options.kubevirt.io")))
 		})
 
+		It("should override the MTU and add the custom routes", func() {
+			gw := net.ParseIP("192.168.2.1")
+			podRoutes := []netlink.Route{{Gw: gw}}
+			dhcpOptions := &v1.DHCPOptions{
+				MTU:    pointer.P(uint32(1400)),
+				Routes: []v1.DHCPRoute{{Destination: "10.10.0.0/16", Gateway: "192.168.2.254"}},
+			}
+
+			options, err := prepareDHCPOptions(gw.DefaultMask(), gw, nil, &podRoutes, nil, 1500, "myhost", dhcpOptions)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(options[dhcp4.OptionInterfaceMTU]).To(Equal([]byte{0x05, 0x78}))
+			Expect(options[dhcp4.OptionClasslessRouteFormat]).To(Equal([]byte{
+				16, 10, 10, 192, 168, 2, 254, 0, 192, 168, 2, 1,
+			}))
+		})
+
+		It("should reject non-IPv4 custom routes", func() {
+			ip := net.ParseIP("192.168.2.1")
+			dhcpOptions := &v1.DHCPOptions{Routes: []v1.DHCPRoute{{Destination: "fd10::/64"}}}
+
+			_, err := prepareDHCPOptions(ip.DefaultMask(), ip, nil, nil, nil, 1500, "myhost", dhcpOptions)
+
+			Expect(err).To(HaveOccurred())
+		})
+
 		It("expects the gateway as an IPv4 addresses", func() {
 			gw := net.ParseIP("192.168.2.1")
 			options, err := prepareDHCPOptions(gw.DefaultMask(), gw, nil, nil, nil, 1500, "myhost", nil)
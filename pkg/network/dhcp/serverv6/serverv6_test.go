@@ -34,7 +34,7 @@ var _ = Describe("DHCPv6", func() {
 		It("should contain ianaAdrress and duid", func() {
 			clientIP := net.ParseIP("fd10:0:2::2")
 			serverInterfaceMac, _ := net.ParseMAC("12:34:56:78:9A:BC")
-			modifiers := prepareDHCPv6Modifiers(clientIP, serverInterfaceMac)
+			modifiers := prepareDHCPv6Modifiers(clientIP, serverInterfaceMac, nil, nil)
 			Expect(modifiers).To(HaveLen(2))
 
 			msg := &dhcpv6.Message{
@@ -53,13 +53,32 @@ var _ = Describe("DHCPv6", func() {
 			Expect(msg.GetOneOption(dhcpv6.OptionServerID).String()).To(Equal(expectedServerId.String()))
 		})
 	})
+	Context("prepareDHCPv6Modifiers with DNS details", func() {
+		It("should contain the nameservers and the domain search list", func() {
+			clientIP := net.ParseIP("fd10:0:2::2")
+			serverInterfaceMac, _ := net.ParseMAC("12:34:56:78:9A:BC")
+			nameservers := []net.IP{net.ParseIP("fd00:10:96::a")}
+			searchDomains := []string{"default.svc.cluster.local", "cluster.local"}
+			modifiers := prepareDHCPv6Modifiers(clientIP, serverInterfaceMac, nameservers, searchDomains)
+			Expect(modifiers).To(HaveLen(4))
+
+			msg := &dhcpv6.Message{
+				MessageType: dhcpv6.MessageTypeAdvertise,
+			}
+			for _, modifier := range modifiers {
+				modifier(msg)
+			}
+			Expect(msg.Options.DNS()).To(Equal(nameservers))
+			Expect(msg.Options.DomainSearchList().Labels).To(Equal(searchDomains))
+		})
+	})
 	Context("buildResponse should build a response with", func() {
 		var handler *DHCPv6Handler
 
 		BeforeEach(func() {
 			clientIP := net.ParseIP("fd10:0:2::2")
 			serverInterfaceMac, _ := net.ParseMAC("12:34:56:78:9A:BC")
-			modifiers := prepareDHCPv6Modifiers(clientIP, serverInterfaceMac)
+			modifiers := prepareDHCPv6Modifiers(clientIP, serverInterfaceMac, nil, nil)
 
 			handler = &DHCPv6Handler{
 				clientIP:  clientIP,
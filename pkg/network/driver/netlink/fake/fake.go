@@ -31,6 +31,9 @@ type NetLink struct {
 	ip6AddressesByLinkName map[string][]vishnetlink.Addr
 	routes4                []vishnetlink.Route
 	routes6                []vishnetlink.Route
+	qdiscs                 []vishnetlink.Qdisc
+	classes                []vishnetlink.Class
+	filters                []vishnetlink.Filter
 }
 
 func New() *NetLink {
@@ -232,6 +235,70 @@ func (n *NetLink) RouteAdd(route *vishnetlink.Route) error {
 	return nil
 }
 
+func (n *NetLink) QdiscReplace(qdisc vishnetlink.Qdisc) error {
+	for i, q := range n.qdiscs {
+		if q.Attrs().LinkIndex == qdisc.Attrs().LinkIndex && q.Attrs().Parent == qdisc.Attrs().Parent {
+			n.qdiscs[i] = qdisc
+			return nil
+		}
+	}
+	n.qdiscs = append(n.qdiscs, qdisc)
+	return nil
+}
+
+func (n *NetLink) QdiscList(link vishnetlink.Link) ([]vishnetlink.Qdisc, error) {
+	var qdiscs []vishnetlink.Qdisc
+	for _, q := range n.qdiscs {
+		if q.Attrs().LinkIndex == link.Attrs().Index {
+			qdiscs = append(qdiscs, q)
+		}
+	}
+	return qdiscs, nil
+}
+
+func (n *NetLink) ClassReplace(class vishnetlink.Class) error {
+	for i, c := range n.classes {
+		if c.Attrs().LinkIndex == class.Attrs().LinkIndex && c.Attrs().Handle == class.Attrs().Handle {
+			n.classes[i] = class
+			return nil
+		}
+	}
+	n.classes = append(n.classes, class)
+	return nil
+}
+
+func (n *NetLink) ClassList(link vishnetlink.Link, parent uint32) ([]vishnetlink.Class, error) {
+	var classes []vishnetlink.Class
+	for _, c := range n.classes {
+		if c.Attrs().LinkIndex == link.Attrs().Index && c.Attrs().Parent == parent {
+			classes = append(classes, c)
+		}
+	}
+	return classes, nil
+}
+
+func (n *NetLink) FilterReplace(filter vishnetlink.Filter) error {
+	for i, f := range n.filters {
+		if f.Attrs().LinkIndex == filter.Attrs().LinkIndex && f.Attrs().Parent == filter.Attrs().Parent &&
+			f.Attrs().Priority == filter.Attrs().Priority {
+			n.filters[i] = filter
+			return nil
+		}
+	}
+	n.filters = append(n.filters, filter)
+	return nil
+}
+
+func (n *NetLink) FilterList(link vishnetlink.Link, parent uint32) ([]vishnetlink.Filter, error) {
+	var filters []vishnetlink.Filter
+	for _, f := range n.filters {
+		if f.Attrs().LinkIndex == link.Attrs().Index && f.Attrs().Parent == parent {
+			filters = append(filters, f)
+		}
+	}
+	return filters, nil
+}
+
 func (n *NetLink) lookupLinkByName(name string) vishnetlink.Link {
 	for i, l := range n.links {
 		if l.Attrs().Name == name {
@@ -84,3 +84,15 @@ func withErrDescr(err error, description string) error {
 	}
 	return nil
 }
+
+func (n NetLink) QdiscReplace(qdisc netlink.Qdisc) error {
+	return withErrDescr(netlink.QdiscReplace(qdisc), "QdiscReplace")
+}
+
+func (n NetLink) ClassReplace(class netlink.Class) error {
+	return withErrDescr(netlink.ClassReplace(class), "ClassReplace")
+}
+
+func (n NetLink) FilterReplace(filter netlink.Filter) error {
+	return withErrDescr(netlink.FilterReplace(filter), "FilterReplace")
+}
@@ -23,6 +23,7 @@ import (
 	"errors"
 	"fmt"
 	"io/fs"
+	"math"
 	"net"
 	"os"
 	"strconv"
@@ -229,9 +230,119 @@ func (n NMState) setupInterface(iface Interface, link vishnetlink.Link) error {
 			return err
 		}
 	}
+
+	if iface.Bandwidth != nil {
+		if err := n.setupBandwidth(iface, link); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// setupBandwidth shapes the traffic of the link the same way libvirt does:
+// The egress traffic is shaped by an HTB class which all the traffic is classified to,
+// the ingress traffic is policed and the packets exceeding the limit are dropped.
+func (n NMState) setupBandwidth(iface Interface, link vishnetlink.Link) error {
+	linkIndex := link.Attrs().Index
+	// Links created through the backend, like the tap devices, are not re-read after their creation.
+	if linkIndex == 0 {
+		createdLink, err := n.adapter.LinkByName(iface.Name)
+		if err != nil {
+			return err
+		}
+		linkIndex = createdLink.Attrs().Index
+	}
+
+	if limit := iface.Bandwidth.Egress; limit != nil {
+		if err := n.setupEgressBandwidth(linkIndex, limit); err != nil {
+			return err
+		}
+	}
+	if limit := iface.Bandwidth.Ingress; limit != nil {
+		if err := n.setupIngressBandwidth(linkIndex, limit); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
+const (
+	bitsPerByte = 8
+	// policeMTU is the largest packet accepted by the ingress policer, it matches the one libvirt uses.
+	policeMTU = 64 * 1024
+)
+
+func (n NMState) setupEgressBandwidth(linkIndex int, limit *BandwidthLimit) error {
+	rootHandle := vishnetlink.MakeHandle(1, 0)
+	htb := vishnetlink.NewHtb(vishnetlink.QdiscAttrs{
+		LinkIndex: linkIndex,
+		Handle:    rootHandle,
+		Parent:    vishnetlink.HANDLE_ROOT,
+	})
+	htb.Defcls = 1
+	if err := n.adapter.QdiscReplace(htb); err != nil {
+		return err
+	}
+
+	class := vishnetlink.NewHtbClass(
+		vishnetlink.ClassAttrs{
+			LinkIndex: linkIndex,
+			Parent:    rootHandle,
+			Handle:    vishnetlink.MakeHandle(1, 1),
+		},
+		vishnetlink.HtbClassAttrs{
+			Rate:    limit.Average * bitsPerByte,
+			Ceil:    limit.Peak * bitsPerByte,
+			Buffer:  clampUint32(limit.Burst),
+			Cbuffer: clampUint32(limit.Burst),
+		},
+	)
+	return n.adapter.ClassReplace(class)
+}
+
+func (n NMState) setupIngressBandwidth(linkIndex int, limit *BandwidthLimit) error {
+	ingressHandle := vishnetlink.MakeHandle(0xffff, 0)
+	ingress := &vishnetlink.Ingress{
+		QdiscAttrs: vishnetlink.QdiscAttrs{
+			LinkIndex: linkIndex,
+			Handle:    ingressHandle,
+			Parent:    vishnetlink.HANDLE_INGRESS,
+		},
+	}
+	if err := n.adapter.QdiscReplace(ingress); err != nil {
+		return err
+	}
+
+	police := vishnetlink.NewPoliceAction()
+	police.Rate = clampUint32(limit.Average)
+	police.PeakRate = clampUint32(limit.Peak)
+	// Like libvirt, allow a burst of a second worth of traffic when none is specified.
+	police.Burst = clampUint32(limit.Burst)
+	if police.Burst == 0 {
+		police.Burst = police.Rate
+	}
+	police.Mtu = policeMTU
+	police.ExceedAction = vishnetlink.TC_POLICE_SHOT
+
+	filter := &vishnetlink.MatchAll{
+		FilterAttrs: vishnetlink.FilterAttrs{
+			LinkIndex: linkIndex,
+			Parent:    ingressHandle,
+			Priority:  1,
+			Protocol:  unix.ETH_P_ALL,
+		},
+		Actions: []vishnetlink.Action{police},
+	}
+	return n.adapter.FilterReplace(filter)
+}
+
+func clampUint32(value uint64) uint32 {
+	if value > math.MaxUint32 {
+		return math.MaxUint32
+	}
+	return uint32(value)
+}
+
 func (n NMState) setupInterfaceIP(iface Interface, link vishnetlink.Link) error {
 	if err := n.deleteIPAddresses(link); err != nil {
 		return err
@@ -23,6 +23,8 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
+	vishnetlink "github.com/vishvananda/netlink"
+
 	"kubevirt.io/kubevirt/pkg/network/driver/procsys"
 
 	"kubevirt.io/kubevirt/pkg/network/driver/nmstate"
@@ -46,10 +48,14 @@ const (
 // the nmstate spec unit tests do not act on the drivers directly.
 
 var _ = Describe("NMState Spec interfaces", func() {
-	var nmState nmstate.NMState
+	var (
+		adapter *testAdapter
+		nmState nmstate.NMState
+	)
 
 	BeforeEach(func() {
-		nmState = nmstate.New(nmstate.WithAdapter(newTestAdapter()))
+		adapter = newTestAdapter()
+		nmState = nmstate.New(nmstate.WithAdapter(adapter))
 	})
 
 	DescribeTable("setup a new interfaces with", func(ipv4, ipv6 nmstate.IP) {
@@ -480,6 +486,52 @@ var _ = Describe("NMState Spec interfaces", func() {
 				},
 			}))
 		})
+
+		It("shapes the egress and polices the ingress traffic", func() {
+			Expect(nmState.Apply(&nmstate.Spec{Interfaces: []nmstate.Interface{
+				{
+					Name: dummyName,
+					Bandwidth: &nmstate.Bandwidth{
+						Egress:  &nmstate.BandwidthLimit{Average: 1000, Peak: 2000, Burst: 4000},
+						Ingress: &nmstate.BandwidthLimit{Average: 3000},
+					},
+				},
+			}})).To(Succeed())
+
+			link, err := adapter.LinkByName(dummyName)
+			Expect(err).NotTo(HaveOccurred())
+
+			qdiscs, err := adapter.QdiscList(link)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(qdiscs).To(ConsistOf(
+				And(
+					BeAssignableToTypeOf(&vishnetlink.Htb{}),
+					HaveField("Defcls", uint32(1)),
+					HaveField("QdiscAttrs.Parent", uint32(vishnetlink.HANDLE_ROOT)),
+				),
+				And(
+					BeAssignableToTypeOf(&vishnetlink.Ingress{}),
+					HaveField("QdiscAttrs.Parent", uint32(vishnetlink.HANDLE_INGRESS)),
+				),
+			))
+
+			classes, err := adapter.ClassList(link, vishnetlink.MakeHandle(1, 0))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(classes).To(ConsistOf(And(
+				HaveField("ClassAttrs.Handle", vishnetlink.MakeHandle(1, 1)),
+				HaveField("Rate", uint64(1000)),
+				HaveField("Ceil", uint64(2000)),
+			)))
+
+			filters, err := adapter.FilterList(link, vishnetlink.MakeHandle(0xffff, 0))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(filters).To(HaveLen(1))
+			Expect(filters[0].(*vishnetlink.MatchAll).Actions).To(ConsistOf(And(
+				HaveField("Rate", uint32(3000)),
+				HaveField("Burst", uint32(3000)),
+				HaveField("ExceedAction", vishnetlink.TC_POLICE_SHOT),
+			)))
+		})
 	})
 })
 
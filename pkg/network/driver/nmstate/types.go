@@ -54,6 +54,8 @@ type Interface struct {
 
 	Tap *TapDevice `json:"tap,omitempty"`
 
+	Bandwidth *Bandwidth `json:"bandwidth,omitempty"`
+
 	IPv4 IP `json:"IPv4,omitempty"`
 	IPv6 IP `json:"IPv6,omitempty"`
 
@@ -97,6 +99,20 @@ type TapDevice struct {
 	GID    int `json:"GID,omitempty"`
 }
 
+// Bandwidth shapes the traffic of a link.
+// Egress is the traffic transmitted by the link and ingress the traffic received by it.
+type Bandwidth struct {
+	Egress  *BandwidthLimit `json:"egress,omitempty"`
+	Ingress *BandwidthLimit `json:"ingress,omitempty"`
+}
+
+// BandwidthLimit holds the rates in bytes per second and the burst in bytes.
+type BandwidthLimit struct {
+	Average uint64 `json:"average"`
+	Peak    uint64 `json:"peak,omitempty"`
+	Burst   uint64 `json:"burst,omitempty"`
+}
+
 type LinuxIfaceStack struct {
 	IP4RouteLocalNet *bool `json:"ip4-route-local-net,omitempty"`
 	PortLearning     *bool `json:"port-learning,omitempty"`
@@ -164,6 +180,10 @@ type adapter interface {
 	IPv4EnableRouteLocalNet(string) error
 	LinkGetProtinfo(vishnetlink.Link) (vishnetlink.Protinfo, error)
 
+	QdiscReplace(vishnetlink.Qdisc) error
+	ClassReplace(vishnetlink.Class) error
+	FilterReplace(vishnetlink.Filter) error
+
 	AddTapDeviceWithSELinuxLabel(name string, mtu int, queueCount int, ownerID int, pid int) error
 }
 
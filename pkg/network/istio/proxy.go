@@ -35,3 +35,7 @@ func ProxyInjectionEnabled(vmi *v1.VirtualMachineInstance) bool {
 func GetLoopbackAddress() string {
 	return "127.0.0.6"
 }
+
+func GetLoopbackAddressIPv6() string {
+	return "::6"
+}
@@ -0,0 +1,188 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package macpool
+
+import (
+	"fmt"
+	"net"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	v1 "kubevirt.io/api/core/v1"
+)
+
+const macAddressLength = 6
+
+type macRange struct {
+	start uint64
+	end   uint64
+}
+
+func (r macRange) size() uint64 {
+	return r.end - r.start + 1
+}
+
+// ValidatePoolConfiguration validates the ranges of the MAC address pool configuration
+func ValidatePoolConfiguration(field *field.Path, config *v1.MacAddressPoolConfiguration) []metav1.StatusCause {
+	if config == nil {
+		return nil
+	}
+
+	causes := validateRanges(field.Child("ranges"), config.Ranges)
+
+	namespaces := map[string]struct{}{}
+	for idx, namespaceRanges := range config.Namespaces {
+		namespaceField := field.Child("namespaces").Index(idx)
+		if namespaceRanges.Namespace == "" {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueRequired,
+				Message: fmt.Sprintf("%s must not be empty", namespaceField.Child("namespace").String()),
+				Field:   namespaceField.Child("namespace").String(),
+			})
+		} else if _, exists := namespaces[namespaceRanges.Namespace]; exists {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueDuplicate,
+				Message: fmt.Sprintf("%s has duplicate ranges for namespace %s", field.Child("namespaces").String(), namespaceRanges.Namespace),
+				Field:   namespaceField.Child("namespace").String(),
+			})
+		}
+		namespaces[namespaceRanges.Namespace] = struct{}{}
+
+		causes = append(causes, validateRanges(namespaceField.Child("ranges"), namespaceRanges.Ranges)...)
+	}
+
+	if len(causes) == 0 {
+		causes = validateDisjointPools(field, config)
+	}
+	return causes
+}
+
+// validateDisjointPools rejects ranges of a namespace overlapping the shared ranges or the ranges of
+// another namespace. The reservations of each of them are held separately, a MAC address in both could
+// be allocated twice.
+func validateDisjointPools(field *field.Path, config *v1.MacAddressPoolConfiguration) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	sharedRanges, _ := parseRanges(config.Ranges)
+	var previousRanges [][]macRange
+	for idx, namespaceRanges := range config.Namespaces {
+		ranges, _ := parseRanges(namespaceRanges.Ranges)
+		overlapping := overlaps(ranges, sharedRanges)
+		for _, other := range previousRanges {
+			overlapping = overlapping || overlaps(ranges, other)
+		}
+		if overlapping {
+			rangesField := field.Child("namespaces").Index(idx).Child("ranges")
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s must not overlap the shared ranges or the ranges of another namespace", rangesField.String()),
+				Field:   rangesField.String(),
+			})
+		}
+		previousRanges = append(previousRanges, ranges)
+	}
+	return causes
+}
+
+func overlaps(ranges, others []macRange) bool {
+	for _, r := range ranges {
+		for _, other := range others {
+			if r.start <= other.end && other.start <= r.end {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func validateRanges(field *field.Path, ranges []v1.MacAddressRange) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	for idx, macAddressRange := range ranges {
+		if _, err := parseRange(macAddressRange); err != nil {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s is invalid: %v", field.Index(idx).String(), err),
+				Field:   field.Index(idx).String(),
+			})
+		}
+	}
+	return causes
+}
+
+func parseRanges(ranges []v1.MacAddressRange) ([]macRange, error) {
+	var parsedRanges []macRange
+	for _, macAddressRange := range ranges {
+		parsedRange, err := parseRange(macAddressRange)
+		if err != nil {
+			return nil, err
+		}
+		parsedRanges = append(parsedRanges, parsedRange)
+	}
+	return parsedRanges, nil
+}
+
+func parseRange(macAddressRange v1.MacAddressRange) (macRange, error) {
+	start, err := parseMacAddress(macAddressRange.Start)
+	if err != nil {
+		return macRange{}, err
+	}
+	end, err := parseMacAddress(macAddressRange.End)
+	if err != nil {
+		return macRange{}, err
+	}
+	if start > end {
+		return macRange{}, fmt.Errorf("start %s is after end %s", macAddressRange.Start, macAddressRange.End)
+	}
+	// The multicast bit is the least significant bit of the first octet,
+	// a range only holds unicast addresses if both its ends share an even first octet.
+	if firstOctet(start) != firstOctet(end) || firstOctet(start)&1 == 1 {
+		return macRange{}, fmt.Errorf("range %s-%s includes multicast addresses", macAddressRange.Start, macAddressRange.End)
+	}
+	return macRange{start: start, end: end}, nil
+}
+
+func parseMacAddress(macAddress string) (uint64, error) {
+	hwAddr, err := net.ParseMAC(macAddress)
+	if err != nil {
+		return 0, err
+	}
+	if len(hwAddr) != macAddressLength {
+		return 0, fmt.Errorf("%s is not an EUI-48 MAC address", macAddress)
+	}
+
+	var value uint64
+	for _, octet := range hwAddr {
+		value = value<<8 | uint64(octet)
+	}
+	return value, nil
+}
+
+func formatMacAddress(value uint64) string {
+	hwAddr := make(net.HardwareAddr, macAddressLength)
+	for idx := macAddressLength - 1; idx >= 0; idx-- {
+		hwAddr[idx] = byte(value)
+		value >>= 8
+	}
+	return hwAddr.String()
+}
+
+func firstOctet(value uint64) uint64 {
+	return value >> ((macAddressLength - 1) * 8)
+}
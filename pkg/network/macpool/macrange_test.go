@@ -0,0 +1,92 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+package macpool
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	v1 "kubevirt.io/api/core/v1"
+)
+
+var _ = Describe("MAC address pool configuration", func() {
+	It("should accept valid ranges", func() {
+		config := &v1.MacAddressPoolConfiguration{
+			Ranges: []v1.MacAddressRange{{Start: "02:00:00:00:00:00", End: "02:ff:ff:ff:ff:ff"}},
+			Namespaces: []v1.NamespaceMacAddressRanges{{
+				Namespace: "ns",
+				Ranges:    []v1.MacAddressRange{{Start: "0A:00:00:00:00:00", End: "0A:00:00:00:FF:FF"}},
+			}},
+		}
+		Expect(ValidatePoolConfiguration(field.NewPath("macAddressPool"), config)).To(BeEmpty())
+	})
+
+	DescribeTable("should reject", func(config *v1.MacAddressPoolConfiguration, expectedField string) {
+		causes := ValidatePoolConfiguration(field.NewPath("macAddressPool"), config)
+		Expect(causes).To(HaveLen(1))
+		Expect(causes[0].Field).To(Equal(expectedField))
+	},
+		Entry("an invalid MAC address",
+			&v1.MacAddressPoolConfiguration{Ranges: []v1.MacAddressRange{{Start: "02:00:00:00:00", End: "02:00:00:00:00:ff"}}},
+			"macAddressPool.ranges[0]"),
+		Entry("an EUI-64 MAC address",
+			&v1.MacAddressPoolConfiguration{Ranges: []v1.MacAddressRange{{Start: "02:00:00:00:00:00:00:00", End: "02:00:00:00:00:00:00:ff"}}},
+			"macAddressPool.ranges[0]"),
+		Entry("a start after the end",
+			&v1.MacAddressPoolConfiguration{Ranges: []v1.MacAddressRange{{Start: "02:00:00:00:00:ff", End: "02:00:00:00:00:00"}}},
+			"macAddressPool.ranges[0]"),
+		Entry("a multicast range",
+			&v1.MacAddressPoolConfiguration{Ranges: []v1.MacAddressRange{{Start: "03:00:00:00:00:00", End: "03:00:00:00:00:ff"}}},
+			"macAddressPool.ranges[0]"),
+		Entry("a range across multicast addresses",
+			&v1.MacAddressPoolConfiguration{Ranges: []v1.MacAddressRange{{Start: "02:00:00:00:00:00", End: "04:00:00:00:00:00"}}},
+			"macAddressPool.ranges[0]"),
+		Entry("a namespace without a name",
+			&v1.MacAddressPoolConfiguration{Namespaces: []v1.NamespaceMacAddressRanges{{
+				Ranges: []v1.MacAddressRange{{Start: "02:00:00:00:00:00", End: "02:00:00:00:00:ff"}},
+			}}},
+			"macAddressPool.namespaces[0].namespace"),
+		Entry("a namespace with duplicate ranges",
+			&v1.MacAddressPoolConfiguration{Namespaces: []v1.NamespaceMacAddressRanges{
+				{Namespace: "ns", Ranges: []v1.MacAddressRange{{Start: "02:00:00:00:00:00", End: "02:00:00:00:00:ff"}}},
+				{Namespace: "ns", Ranges: []v1.MacAddressRange{{Start: "02:00:00:00:01:00", End: "02:00:00:00:01:ff"}}},
+			}},
+			"macAddressPool.namespaces[1].namespace"),
+		Entry("an invalid namespace range",
+			&v1.MacAddressPoolConfiguration{Namespaces: []v1.NamespaceMacAddressRanges{{
+				Namespace: "ns", Ranges: []v1.MacAddressRange{{Start: "02:00:00:00:00:00", End: "zz"}},
+			}}},
+			"macAddressPool.namespaces[0].ranges[0]"),
+		Entry("a namespace range overlapping the shared ranges",
+			&v1.MacAddressPoolConfiguration{
+				Ranges: []v1.MacAddressRange{{Start: "02:00:00:00:00:00", End: "02:00:00:00:00:ff"}},
+				Namespaces: []v1.NamespaceMacAddressRanges{{
+					Namespace: "ns", Ranges: []v1.MacAddressRange{{Start: "02:00:00:00:00:ff", End: "02:00:00:00:01:ff"}},
+				}},
+			},
+			"macAddressPool.namespaces[0].ranges"),
+		Entry("namespace ranges overlapping each other",
+			&v1.MacAddressPoolConfiguration{Namespaces: []v1.NamespaceMacAddressRanges{
+				{Namespace: "ns1", Ranges: []v1.MacAddressRange{{Start: "02:00:00:00:00:00", End: "02:00:00:00:00:ff"}}},
+				{Namespace: "ns2", Ranges: []v1.MacAddressRange{{Start: "02:00:00:00:00:80", End: "02:00:00:00:00:8f"}}},
+			}},
+			"macAddressPool.namespaces[1].ranges"),
+	)
+})
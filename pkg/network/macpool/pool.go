@@ -0,0 +1,347 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package macpool
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/rand/v2"
+	"strings"
+	"time"
+
+	k8sv1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/retry"
+
+	v1 "kubevirt.io/api/core/v1"
+)
+
+const (
+	macAddressIndex = "macAddress"
+
+	// reservationsConfigMapName is the name of the ConfigMap holding the reservations of the shared ranges.
+	// The reservations of the ranges dedicated to a namespace are held by a ConfigMap suffixed with the namespace.
+	reservationsConfigMapName = "kubevirt-mac-address-pool"
+
+	// reservationTTL is how long an allocated MAC address is kept from being allocated again,
+	// until the VirtualMachine it was allocated to shows up in the informer.
+	reservationTTL = 2 * time.Minute
+)
+
+// reservation records the VirtualMachine a MAC address was allocated to.
+type reservation struct {
+	VM         string      `json:"vm"`
+	Expiration metav1.Time `json:"expiration"`
+}
+
+// UsageFinder looks up the VirtualMachines using a MAC address through the macAddress index of the
+// VirtualMachine informer.
+type UsageFinder struct {
+	vmIndexer cache.Indexer
+}
+
+func NewUsageFinder(vmIndexer cache.Indexer) *UsageFinder {
+	return &UsageFinder{vmIndexer: vmIndexer}
+}
+
+// Pool allocates the MAC addresses of VirtualMachine interfaces.
+// The allocations are persisted in the VirtualMachines themselves. Until a VirtualMachine shows up in the
+// informer, the MAC addresses allocated to it are reserved in a ConfigMap of the KubeVirt namespace.
+// The ConfigMap is written with optimistic concurrency, so that the virt-api replicas never hand out the
+// same MAC address.
+type Pool struct {
+	*UsageFinder
+
+	configMaps corev1client.ConfigMapInterface
+
+	now   func() time.Time
+	randN func(n uint64) uint64
+}
+
+func New(vmIndexer cache.Indexer, configMaps corev1client.ConfigMapInterface) *Pool {
+	return &Pool{
+		UsageFinder: NewUsageFinder(vmIndexer),
+		configMaps:  configMaps,
+		now:         time.Now,
+		randN:       rand.Uint64N,
+	}
+}
+
+// AllocateMacAddresses sets a MAC address from the ranges of the VirtualMachine namespace on the
+// interfaces without one. On updates, only the interfaces which were not part of the old VirtualMachine
+// get a MAC address, so that the interfaces of running guests keep theirs.
+// The MAC addresses set by the user within the ranges are reserved as well. It fails if one of them
+// is reserved for another VirtualMachine.
+func (p *Pool) AllocateMacAddresses(config *v1.MacAddressPoolConfiguration, vm, oldVM *v1.VirtualMachine) error {
+	if vm.Spec.Template == nil {
+		return nil
+	}
+	ranges, poolName, err := rangesOfNamespace(config, vm.Namespace)
+	if err != nil {
+		return err
+	}
+	if len(ranges) == 0 {
+		return nil
+	}
+
+	interfaces := vm.Spec.Template.Spec.Domain.Devices.Interfaces
+	var interfacesToAllocate []int
+	var macAddressesToReserve []uint64
+	vmMacAddresses := map[uint64]struct{}{}
+	for idx, iface := range interfaces {
+		if iface.MacAddress == "" {
+			if !hasInterface(oldVM, iface.Name) {
+				interfacesToAllocate = append(interfacesToAllocate, idx)
+			}
+		} else if macAddress, err := parseMacAddress(iface.MacAddress); err == nil {
+			vmMacAddresses[macAddress] = struct{}{}
+			if inRanges(ranges, macAddress) && !hasMacAddress(oldVM, macAddress) {
+				macAddressesToReserve = append(macAddressesToReserve, macAddress)
+			}
+		}
+	}
+	if len(interfacesToAllocate) == 0 && len(macAddressesToReserve) == 0 {
+		return nil
+	}
+
+	vmKey, err := cache.MetaNamespaceKeyFunc(vm)
+	if err != nil {
+		return err
+	}
+
+	var allocated []uint64
+	isConflict := func(err error) bool { return k8serrors.IsConflict(err) || k8serrors.IsAlreadyExists(err) }
+	err = retry.OnError(retry.DefaultRetry, isConflict, func() error {
+		configMap, exists, err := p.reservationsConfigMap(poolName)
+		if err != nil {
+			return err
+		}
+		reservations := p.unexpiredReservations(configMap)
+
+		for _, macAddress := range macAddressesToReserve {
+			if reserved, exists := reservations[macAddress]; exists && reserved.VM != vmKey {
+				return fmt.Errorf("MAC address %s is already allocated to VirtualMachine %s", formatMacAddress(macAddress), reserved.VM)
+			}
+			reservations[macAddress] = p.newReservation(vmKey)
+		}
+
+		allocated = nil
+		for _, idx := range interfacesToAllocate {
+			macAddress, err := p.allocate(ranges, vmMacAddresses, reservations)
+			if err != nil {
+				return fmt.Errorf("failed to allocate a MAC address to interface %s: %v", interfaces[idx].Name, err)
+			}
+			reservations[macAddress] = p.newReservation(vmKey)
+			allocated = append(allocated, macAddress)
+		}
+
+		return p.storeReservations(configMap, exists, reservations)
+	})
+	if err != nil {
+		return err
+	}
+
+	for i, idx := range interfacesToAllocate {
+		interfaces[idx].MacAddress = formatMacAddress(allocated[i])
+	}
+	return nil
+}
+
+// FindOtherVMWithMacAddress returns the key of a VirtualMachine, other than vm, with an interface using macAddress.
+// An empty key is returned if there is none.
+func (f *UsageFinder) FindOtherVMWithMacAddress(vm *v1.VirtualMachine, macAddress string) (string, error) {
+	value, err := parseMacAddress(macAddress)
+	if err != nil {
+		return "", err
+	}
+
+	vmKey, err := cache.MetaNamespaceKeyFunc(vm)
+	if err != nil {
+		return "", err
+	}
+
+	keys, err := f.vmIndexer.IndexKeys(macAddressIndex, formatMacAddress(value))
+	if err != nil {
+		return "", err
+	}
+	for _, key := range keys {
+		if key != vmKey {
+			return key, nil
+		}
+	}
+	return "", nil
+}
+
+func (f *UsageFinder) isUsed(macAddress uint64) (bool, error) {
+	keys, err := f.vmIndexer.IndexKeys(macAddressIndex, formatMacAddress(macAddress))
+	if err != nil {
+		return false, err
+	}
+	return len(keys) > 0, nil
+}
+
+func (p *Pool) allocate(ranges []macRange, vmMacAddresses map[uint64]struct{}, reservations map[uint64]reservation) (uint64, error) {
+	var total uint64
+	for _, r := range ranges {
+		total += r.size()
+	}
+
+	// Start at a random offset, to lower the odds of concurrent allocations by several virt-api replicas
+	// conflicting on the reservations
+	offset := p.randN(total)
+	for i := uint64(0); i < total; i++ {
+		candidate := addressAt(ranges, (offset+i)%total)
+		if _, exists := vmMacAddresses[candidate]; exists {
+			continue
+		}
+		if _, reserved := reservations[candidate]; reserved {
+			continue
+		}
+		used, err := p.isUsed(candidate)
+		if err != nil {
+			return 0, err
+		}
+		if !used {
+			return candidate, nil
+		}
+	}
+	return 0, fmt.Errorf("the MAC address pool is exhausted")
+}
+
+func (p *Pool) newReservation(vmKey string) reservation {
+	return reservation{VM: vmKey, Expiration: metav1.NewTime(p.now().Add(reservationTTL))}
+}
+
+// reservationsConfigMap returns the ConfigMap holding the reservations of the pool and whether it exists.
+func (p *Pool) reservationsConfigMap(poolName string) (*k8sv1.ConfigMap, bool, error) {
+	configMap, err := p.configMaps.Get(context.Background(), poolName, metav1.GetOptions{})
+	if k8serrors.IsNotFound(err) {
+		return &k8sv1.ConfigMap{ObjectMeta: metav1.ObjectMeta{
+			Name:   poolName,
+			Labels: map[string]string{v1.AppLabel: reservationsConfigMapName},
+		}}, false, nil
+	}
+	return configMap, err == nil, err
+}
+
+// unexpiredReservations decodes the reservations of the ConfigMap, dropping the expired ones.
+// Once expired, the VirtualMachines using the MAC addresses are found through the informer.
+func (p *Pool) unexpiredReservations(configMap *k8sv1.ConfigMap) map[uint64]reservation {
+	now := p.now()
+	reservations := map[uint64]reservation{}
+	for key, value := range configMap.Data {
+		macAddress, err := parseMacAddress(strings.ReplaceAll(key, "-", ":"))
+		if err != nil {
+			continue
+		}
+		var r reservation
+		if err := json.Unmarshal([]byte(value), &r); err != nil || now.After(r.Expiration.Time) {
+			continue
+		}
+		reservations[macAddress] = r
+	}
+	return reservations
+}
+
+// storeReservations writes the reservations to the ConfigMap, failing with a conflict if it was
+// changed or created since it was read.
+func (p *Pool) storeReservations(configMap *k8sv1.ConfigMap, exists bool, reservations map[uint64]reservation) error {
+	configMap = configMap.DeepCopy()
+	configMap.Data = map[string]string{}
+	for macAddress, r := range reservations {
+		value, err := json.Marshal(r)
+		if err != nil {
+			return err
+		}
+		// Colons are not allowed in ConfigMap keys
+		configMap.Data[strings.ReplaceAll(formatMacAddress(macAddress), ":", "-")] = string(value)
+	}
+
+	var err error
+	if !exists {
+		_, err = p.configMaps.Create(context.Background(), configMap, metav1.CreateOptions{})
+	} else {
+		_, err = p.configMaps.Update(context.Background(), configMap, metav1.UpdateOptions{})
+	}
+	return err
+}
+
+func addressAt(ranges []macRange, offset uint64) uint64 {
+	for _, r := range ranges {
+		if offset < r.size() {
+			return r.start + offset
+		}
+		offset -= r.size()
+	}
+	return 0
+}
+
+// rangesOfNamespace returns the ranges the MAC addresses of the namespace are allocated from,
+// along with the name of the ConfigMap holding their reservations.
+func rangesOfNamespace(config *v1.MacAddressPoolConfiguration, namespace string) ([]macRange, string, error) {
+	if config == nil {
+		return nil, "", nil
+	}
+	for _, namespaceRanges := range config.Namespaces {
+		if namespaceRanges.Namespace == namespace {
+			ranges, err := parseRanges(namespaceRanges.Ranges)
+			return ranges, reservationsConfigMapName + "-" + namespace, err
+		}
+	}
+	ranges, err := parseRanges(config.Ranges)
+	return ranges, reservationsConfigMapName, err
+}
+
+func inRanges(ranges []macRange, macAddress uint64) bool {
+	for _, r := range ranges {
+		if r.start <= macAddress && macAddress <= r.end {
+			return true
+		}
+	}
+	return false
+}
+
+func hasMacAddress(vm *v1.VirtualMachine, macAddress uint64) bool {
+	if vm == nil || vm.Spec.Template == nil {
+		return false
+	}
+	for _, iface := range vm.Spec.Template.Spec.Domain.Devices.Interfaces {
+		if value, err := parseMacAddress(iface.MacAddress); err == nil && value == macAddress {
+			return true
+		}
+	}
+	return false
+}
+
+func hasInterface(vm *v1.VirtualMachine, name string) bool {
+	if vm == nil || vm.Spec.Template == nil {
+		return false
+	}
+	for _, iface := range vm.Spec.Template.Spec.Domain.Devices.Interfaces {
+		if iface.Name == name {
+			return true
+		}
+	}
+	return false
+}
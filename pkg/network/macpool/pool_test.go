@@ -0,0 +1,232 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+package macpool
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	k8sv1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+	"k8s.io/client-go/tools/cache"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/controller"
+	"kubevirt.io/kubevirt/pkg/libvmi"
+)
+
+var _ = Describe("MAC address pool", func() {
+	const (
+		namespace         = "default"
+		kubevirtNamespace = "kubevirt"
+	)
+
+	var (
+		vmIndexer  cache.Indexer
+		kubeClient *k8sfake.Clientset
+		pool       *Pool
+		// otherReplicaPool shares the reservations of pool, like the pool of another virt-api replica
+		otherReplicaPool *Pool
+		now              time.Time
+		config           *v1.MacAddressPoolConfiguration
+	)
+
+	newVM := func(name string, ifaces ...v1.Interface) *v1.VirtualMachine {
+		opts := []libvmi.Option{libvmi.WithNamespace(namespace), libvmi.WithName(name)}
+		for _, iface := range ifaces {
+			opts = append(opts, libvmi.WithInterface(iface), libvmi.WithNetwork(libvmi.MultusNetwork(iface.Name, "nad")))
+		}
+		return libvmi.NewVirtualMachine(libvmi.New(opts...))
+	}
+
+	withMac := func(iface v1.Interface, mac string) v1.Interface {
+		iface.MacAddress = mac
+		return iface
+	}
+
+	macAddresses := func(vm *v1.VirtualMachine) []string {
+		var macs []string
+		for _, iface := range vm.Spec.Template.Spec.Domain.Devices.Interfaces {
+			macs = append(macs, iface.MacAddress)
+		}
+		return macs
+	}
+
+	BeforeEach(func() {
+		vmIndexer = cache.NewIndexer(cache.MetaNamespaceKeyFunc, controller.GetVirtualMachineInformerIndexers())
+		kubeClient = k8sfake.NewSimpleClientset()
+		now = time.Now()
+		newPool := func() *Pool {
+			p := New(vmIndexer, kubeClient.CoreV1().ConfigMaps(kubevirtNamespace))
+			p.now = func() time.Time { return now }
+			p.randN = func(uint64) uint64 { return 0 }
+			return p
+		}
+		pool = newPool()
+		otherReplicaPool = newPool()
+		config = &v1.MacAddressPoolConfiguration{
+			Ranges: []v1.MacAddressRange{{Start: "02:00:00:00:00:00", End: "02:00:00:00:00:03"}},
+		}
+	})
+
+	It("should allocate MAC addresses to the interfaces without one", func() {
+		vm := newVM("vm",
+			libvmi.InterfaceDeviceWithBridgeBinding("red"),
+			withMac(libvmi.InterfaceDeviceWithBridgeBinding("blue"), "02:00:00:00:00:01"),
+			libvmi.InterfaceDeviceWithBridgeBinding("green"),
+		)
+		Expect(pool.AllocateMacAddresses(config, vm, nil)).To(Succeed())
+		Expect(macAddresses(vm)).To(Equal([]string{"02:00:00:00:00:00", "02:00:00:00:00:01", "02:00:00:00:00:02"}))
+	})
+
+	It("should skip the MAC addresses used by other VMs and recently allocated by any replica", func() {
+		Expect(vmIndexer.Add(newVM("other", withMac(libvmi.InterfaceDeviceWithBridgeBinding("red"), "02:00:00:00:00:00")))).To(Succeed())
+		first := newVM("first", libvmi.InterfaceDeviceWithBridgeBinding("red"))
+		Expect(pool.AllocateMacAddresses(config, first, nil)).To(Succeed())
+		second := newVM("second", libvmi.InterfaceDeviceWithBridgeBinding("red"))
+		Expect(otherReplicaPool.AllocateMacAddresses(config, second, nil)).To(Succeed())
+
+		Expect(macAddresses(first)).To(Equal([]string{"02:00:00:00:00:01"}))
+		Expect(macAddresses(second)).To(Equal([]string{"02:00:00:00:00:02"}))
+	})
+
+	It("should release reservations once they expire", func() {
+		first := newVM("first", libvmi.InterfaceDeviceWithBridgeBinding("red"))
+		Expect(pool.AllocateMacAddresses(config, first, nil)).To(Succeed())
+
+		now = now.Add(reservationTTL + time.Second)
+		second := newVM("second", libvmi.InterfaceDeviceWithBridgeBinding("red"))
+		Expect(pool.AllocateMacAddresses(config, second, nil)).To(Succeed())
+		Expect(macAddresses(second)).To(Equal(macAddresses(first)))
+	})
+
+	It("should persist the reservations in a ConfigMap", func() {
+		vm := newVM("vm", libvmi.InterfaceDeviceWithBridgeBinding("red"))
+		Expect(pool.AllocateMacAddresses(config, vm, nil)).To(Succeed())
+
+		configMap, err := kubeClient.CoreV1().ConfigMaps(kubevirtNamespace).Get(context.Background(), reservationsConfigMapName, metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(configMap.Data).To(HaveKeyWithValue("02-00-00-00-00-00", ContainSubstring(`"vm":"default/vm"`)))
+	})
+
+	It("should retry when another replica reserved a MAC address concurrently", func() {
+		concurrentReservation := &k8sv1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: reservationsConfigMapName, Namespace: kubevirtNamespace},
+			Data: map[string]string{
+				"02-00-00-00-00-00": fmt.Sprintf(`{"vm":"default/other","expiration":%q}`, now.Add(time.Minute).UTC().Format(time.RFC3339)),
+			},
+		}
+		kubeClient.PrependReactor("create", "configmaps", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			if err := kubeClient.Tracker().Add(concurrentReservation); err != nil {
+				return false, nil, nil
+			}
+			return true, nil, k8serrors.NewAlreadyExists(schema.GroupResource{Resource: "configmaps"}, reservationsConfigMapName)
+		})
+
+		vm := newVM("vm", libvmi.InterfaceDeviceWithBridgeBinding("red"))
+		Expect(pool.AllocateMacAddresses(config, vm, nil)).To(Succeed())
+		Expect(macAddresses(vm)).To(Equal([]string{"02:00:00:00:00:01"}))
+	})
+
+	It("should reject a MAC address of the ranges set by the user and reserved for another VM", func() {
+		first := newVM("first", libvmi.InterfaceDeviceWithBridgeBinding("red"))
+		Expect(pool.AllocateMacAddresses(config, first, nil)).To(Succeed())
+
+		second := newVM("second", withMac(libvmi.InterfaceDeviceWithBridgeBinding("red"), "02:00:00:00:00:00"))
+		Expect(otherReplicaPool.AllocateMacAddresses(config, second, nil)).To(
+			MatchError("MAC address 02:00:00:00:00:00 is already allocated to VirtualMachine default/first"))
+	})
+
+	It("should allocate from the ranges dedicated to the namespace", func() {
+		config.Namespaces = []v1.NamespaceMacAddressRanges{{
+			Namespace: namespace,
+			Ranges:    []v1.MacAddressRange{{Start: "0a:00:00:00:00:10", End: "0a:00:00:00:00:1f"}},
+		}}
+		vm := newVM("vm", libvmi.InterfaceDeviceWithBridgeBinding("red"))
+		Expect(pool.AllocateMacAddresses(config, vm, nil)).To(Succeed())
+		Expect(macAddresses(vm)).To(Equal([]string{"0a:00:00:00:00:10"}))
+
+		_, err := kubeClient.CoreV1().ConfigMaps(kubevirtNamespace).Get(context.Background(), reservationsConfigMapName+"-"+namespace, metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("should continue into the next range from the random offset", func() {
+		config.Ranges = append(config.Ranges, v1.MacAddressRange{Start: "02:00:00:00:01:00", End: "02:00:00:00:01:03"})
+		pool.randN = func(n uint64) uint64 {
+			Expect(n).To(Equal(uint64(8)))
+			return 6
+		}
+		vm := newVM("vm", libvmi.InterfaceDeviceWithBridgeBinding("red"))
+		Expect(pool.AllocateMacAddresses(config, vm, nil)).To(Succeed())
+		Expect(macAddresses(vm)).To(Equal([]string{"02:00:00:00:01:02"}))
+	})
+
+	It("should only allocate MAC addresses to new interfaces on update", func() {
+		oldVM := newVM("vm", libvmi.InterfaceDeviceWithBridgeBinding("red"))
+		vm := newVM("vm", libvmi.InterfaceDeviceWithBridgeBinding("red"), libvmi.InterfaceDeviceWithBridgeBinding("blue"))
+		Expect(pool.AllocateMacAddresses(config, vm, oldVM)).To(Succeed())
+		Expect(macAddresses(vm)).To(Equal([]string{"", "02:00:00:00:00:00"}))
+	})
+
+	It("should not allocate MAC addresses without ranges", func() {
+		vm := newVM("vm", libvmi.InterfaceDeviceWithBridgeBinding("red"))
+		Expect(pool.AllocateMacAddresses(&v1.MacAddressPoolConfiguration{}, vm, nil)).To(Succeed())
+		Expect(macAddresses(vm)).To(Equal([]string{""}))
+	})
+
+	It("should fail when the pool is exhausted", func() {
+		vm := newVM("vm",
+			libvmi.InterfaceDeviceWithBridgeBinding("a"),
+			libvmi.InterfaceDeviceWithBridgeBinding("b"),
+			libvmi.InterfaceDeviceWithBridgeBinding("c"),
+			libvmi.InterfaceDeviceWithBridgeBinding("d"),
+			libvmi.InterfaceDeviceWithBridgeBinding("e"),
+		)
+		Expect(pool.AllocateMacAddresses(config, vm, nil)).To(MatchError(ContainSubstring("interface e: the MAC address pool is exhausted")))
+	})
+
+	Context("FindOtherVMWithMacAddress", func() {
+		BeforeEach(func() {
+			Expect(vmIndexer.Add(newVM("other", withMac(libvmi.InterfaceDeviceWithBridgeBinding("red"), "02:00:00:00:00:0A")))).To(Succeed())
+		})
+
+		It("should find another VM using the MAC address regardless of its case", func() {
+			Expect(pool.FindOtherVMWithMacAddress(newVM("vm"), "02:00:00:00:00:0a")).To(Equal(namespace + "/other"))
+		})
+
+		It("should ignore the VM itself", func() {
+			vm := newVM("other")
+			vm.ObjectMeta = metav1.ObjectMeta{Namespace: namespace, Name: "other"}
+			Expect(pool.FindOtherVMWithMacAddress(vm, "02:00:00:00:00:0a")).To(BeEmpty())
+		})
+
+		It("should not find unused MAC addresses", func() {
+			Expect(pool.FindOtherVMWithMacAddress(newVM("vm"), "02:00:00:00:00:0b")).To(BeEmpty())
+		})
+	})
+})
@@ -0,0 +1,127 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package pcap
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/vishvananda/netlink"
+	"golang.org/x/sys/unix"
+)
+
+// readTimeout lets the capture notice it was stopped while the link is idle
+const readTimeout = 500 * time.Millisecond
+
+type NSExecutor interface {
+	Do(func() error) error
+}
+
+// Capture writes the frames sent and received by the link in the network namespace to w, in the pcap format.
+// It returns once stop is closed or w can not be written to anymore.
+func Capture(ns NSExecutor, linkName string, w io.Writer, stop <-chan struct{}) error {
+	var sock *packetSocket
+	err := ns.Do(func() error {
+		capturedLink, err := netlink.LinkByName(linkName)
+		if err != nil {
+			return err
+		}
+		// The socket stays in the namespace it was created in
+		sock, err = openPacketSocket(capturedLink.Attrs().Index)
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("failed to capture the traffic of %s: %v", linkName, err)
+	}
+	defer sock.Close()
+
+	writer, err := NewWriter(w)
+	if err != nil {
+		return err
+	}
+
+	buf := make([]byte, SnapLen)
+	for {
+		select {
+		case <-stop:
+			return nil
+		default:
+		}
+
+		n, err := sock.Read(buf)
+		if err != nil {
+			if isTimeout(err) {
+				continue
+			}
+			return fmt.Errorf("failed to capture the traffic of %s: %v", linkName, err)
+		}
+		// The frame length is reported even when it was truncated to the buffer
+		if err := writer.WritePacket(time.Now(), buf[:min(n, len(buf))], n); err != nil {
+			return err
+		}
+	}
+}
+
+// packetSocket receives the frames seen by a link, in both directions.
+type packetSocket struct {
+	fd int
+}
+
+func openPacketSocket(ifIndex int) (*packetSocket, error) {
+	protocol := htons(unix.ETH_P_ALL)
+	fd, err := unix.Socket(unix.AF_PACKET, unix.SOCK_RAW|unix.SOCK_CLOEXEC, int(protocol))
+	if err != nil {
+		return nil, err
+	}
+
+	timeout := unix.NsecToTimeval(readTimeout.Nanoseconds())
+	if err := unix.SetsockoptTimeval(fd, unix.SOL_SOCKET, unix.SO_RCVTIMEO, &timeout); err != nil {
+		_ = unix.Close(fd)
+		return nil, err
+	}
+	if err := unix.Bind(fd, &unix.SockaddrLinklayer{Protocol: protocol, Ifindex: ifIndex}); err != nil {
+		_ = unix.Close(fd)
+		return nil, err
+	}
+	return &packetSocket{fd: fd}, nil
+}
+
+func (s *packetSocket) Read(buf []byte) (int, error) {
+	n, _, err := unix.Recvfrom(s.fd, buf, unix.MSG_TRUNC)
+	return n, err
+}
+
+func (s *packetSocket) Close() error {
+	return unix.Close(s.fd)
+}
+
+func isTimeout(err error) bool {
+	return errors.Is(err, unix.EAGAIN) || errors.Is(err, unix.EINTR)
+}
+
+// htons converts a short from host to network byte order.
+func htons(v uint16) uint16 {
+	b := make([]byte, 2)
+	binary.BigEndian.PutUint16(b, v)
+	return binary.NativeEndian.Uint16(b)
+}
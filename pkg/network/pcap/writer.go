@@ -0,0 +1,74 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package pcap
+
+import (
+	"encoding/binary"
+	"io"
+	"time"
+)
+
+const (
+	magicMicroseconds = 0xa1b2c3d4
+	versionMajor      = 2
+	versionMinor      = 4
+	linkTypeEthernet  = 1
+
+	// SnapLen is the maximum number of bytes kept of a packet
+	SnapLen = 65535
+
+	fileHeaderLen   = 24
+	recordHeaderLen = 16
+)
+
+// Writer writes Ethernet frames in the libpcap file format, which is read by tcpdump and wireshark.
+// https://wiki.wireshark.org/Development/LibpcapFileFormat
+type Writer struct {
+	w io.Writer
+}
+
+// NewWriter writes the file header to w.
+func NewWriter(w io.Writer) (*Writer, error) {
+	header := make([]byte, fileHeaderLen)
+	binary.LittleEndian.PutUint32(header[0:4], magicMicroseconds)
+	binary.LittleEndian.PutUint16(header[4:6], versionMajor)
+	binary.LittleEndian.PutUint16(header[6:8], versionMinor)
+	// The timezone offset and timestamp accuracy are always zero
+	binary.LittleEndian.PutUint32(header[16:20], SnapLen)
+	binary.LittleEndian.PutUint32(header[20:24], linkTypeEthernet)
+	if _, err := w.Write(header); err != nil {
+		return nil, err
+	}
+	return &Writer{w: w}, nil
+}
+
+// WritePacket writes a frame captured at the timestamp. The frame may be truncated, origLen is its length on the wire.
+func (w *Writer) WritePacket(timestamp time.Time, frame []byte, origLen int) error {
+	if len(frame) > SnapLen {
+		frame = frame[:SnapLen]
+	}
+	record := make([]byte, recordHeaderLen, recordHeaderLen+len(frame))
+	binary.LittleEndian.PutUint32(record[0:4], uint32(timestamp.Unix()))
+	binary.LittleEndian.PutUint32(record[4:8], uint32(timestamp.Nanosecond()/int(time.Microsecond)))
+	binary.LittleEndian.PutUint32(record[8:12], uint32(len(frame)))
+	binary.LittleEndian.PutUint32(record[12:16], uint32(origLen))
+	_, err := w.w.Write(append(record, frame...))
+	return err
+}
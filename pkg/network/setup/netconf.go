@@ -33,9 +33,11 @@ import (
 	"kubevirt.io/kubevirt/pkg/util"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/converter"
 
+	"kubevirt.io/kubevirt/pkg/network/arpsnoop"
 	"kubevirt.io/kubevirt/pkg/network/cache"
 	netdriver "kubevirt.io/kubevirt/pkg/network/driver"
 	"kubevirt.io/kubevirt/pkg/network/istio"
+	"kubevirt.io/kubevirt/pkg/network/link"
 	"kubevirt.io/kubevirt/pkg/network/netns"
 	"kubevirt.io/kubevirt/pkg/network/setup/netpod"
 	"kubevirt.io/kubevirt/pkg/network/setup/netpod/masquerade"
@@ -50,6 +52,11 @@ type clusterConfigurer interface {
 	GetNetworkBindings() map[string]v1.InterfaceBindingPlugin
 }
 
+type arpSnooper interface {
+	Start(key string, ns arpsnoop.NSExecutor, guestMAC string, linkNames ...string) error
+	Stop(keyPrefix string)
+}
+
 type NetConf struct {
 	cacheCreator     cacheCreator
 	nsFactory        nsFactory
@@ -57,6 +64,7 @@ type NetConf struct {
 	configStateMutex *sync.RWMutex
 
 	clusterConfigurer clusterConfigurer
+	arpSnooper        arpSnooper
 }
 
 type nsFactory func(int) NSExecutor
@@ -65,11 +73,13 @@ type NSExecutor interface {
 	Do(func() error) error
 }
 
-func NewNetConf(clusterConfigurer clusterConfigurer) *NetConf {
+func NewNetConf(clusterConfigurer clusterConfigurer, arpSnooper *arpsnoop.Snooper) *NetConf {
 	var cacheFactory cache.CacheCreator
-	return NewNetConfWithCustomFactoryAndConfigState(func(pid int) NSExecutor {
+	netConf := NewNetConfWithCustomFactoryAndConfigState(func(pid int) NSExecutor {
 		return netns.New(pid)
 	}, cacheFactory, map[string]*netpod.State{}, clusterConfigurer)
+	netConf.arpSnooper = arpSnooper
+	return netConf
 }
 
 func NewNetConfWithCustomFactoryAndConfigState(nsFactory nsFactory, cacheCreator cacheCreator, state map[string]*netpod.State, clusterConfigurer clusterConfigurer) *NetConf {
@@ -123,9 +133,47 @@ func (c *NetConf) Setup(vmi *v1.VirtualMachineInstance, networks []v1.Network, l
 	if err := netpod.Setup(); err != nil {
 		return fmt.Errorf("setup failed, err: %w", err)
 	}
+
+	if c.arpSnooper != nil {
+		c.snoopSecondaryBridgeNetworks(vmi, networks, launcherPid)
+	}
 	return nil
 }
 
+// snoopSecondaryBridgeNetworks learns the IPv4 addresses of the guest on the secondary networks it is bridged to,
+// which are otherwise only known when the guest agent runs.
+func (c *NetConf) snoopSecondaryBridgeNetworks(vmi *v1.VirtualMachineInstance, networks []v1.Network, launcherPid int) {
+	ns := c.nsFactory(launcherPid)
+	for _, network := range networks {
+		if network.Multus == nil || network.Multus.Default {
+			continue
+		}
+		iface := vmispec.LookupInterfaceByName(vmi.Spec.Domain.Devices.Interfaces, network.Name)
+		if iface == nil || iface.Bridge == nil || iface.State == v1.InterfaceStateAbsent {
+			continue
+		}
+		// Without a MAC in the spec, the guest MAC is known once reported in the status
+		guestMAC := iface.MacAddress
+		if guestMAC == "" {
+			if ifaceStatus := vmispec.LookupInterfaceStatusByName(vmi.Status.Interfaces, network.Name); ifaceStatus != nil {
+				guestMAC = ifaceStatus.MAC
+			}
+		}
+		if guestMAC == "" {
+			continue
+		}
+
+		// The pod interface is named after the ordinal scheme on pods created by older versions
+		bridgeNames := []string{
+			link.GenerateBridgeName(namescheme.GenerateHashedInterfaceName(network.Name)),
+			link.GenerateBridgeName(namescheme.OrdinalPodInterfaceName(network.Name, vmi.Spec.Networks)),
+		}
+		if err := c.arpSnooper.Start(arpsnoop.Key(string(vmi.UID), network.Name), ns, guestMAC, bridgeNames...); err != nil {
+			log.Log.Object(vmi).Reason(err).Warningf("failed to snoop the ARP traffic of network %s", network.Name)
+		}
+	}
+}
+
 func upgradeConfigStateCache(stateCache *ConfigStateCache, networks []v1.Network, cacheCreator cacheCreator, vmiUID string) (*ConfigStateCache, error) {
 	for networkName, podIfaceName := range namescheme.CreateOrdinalNetworkNameScheme(networks) {
 		exists, err := stateCache.Exists(podIfaceName)
@@ -155,6 +203,9 @@ func (c *NetConf) Teardown(vmi *v1.VirtualMachineInstance) error {
 	c.configStateMutex.Lock()
 	delete(c.state, string(vmi.UID))
 	c.configStateMutex.Unlock()
+	if c.arpSnooper != nil {
+		c.arpSnooper.Stop(string(vmi.UID) + "/")
+	}
 	podCache := cache.NewPodInterfaceCache(c.cacheCreator, string(vmi.UID))
 	if err := podCache.Remove(); err != nil {
 		return fmt.Errorf("teardown failed, err: %w", err)
@@ -145,8 +145,8 @@ func (m MasqPod) setupNATByFamily(family nft.IPFamily, podIfaceSpec, bridgeIface
 	}
 
 	addressesToDnat := []string{ipLoopback(family)}
-	if m.istioEnabled && family == nft.IPv4 {
-		addressesToDnat = append(addressesToDnat, podIfaceSpec.IPv4.Address[0].IP)
+	if m.istioEnabled {
+		addressesToDnat = append(addressesToDnat, podIPByFamily(family, *podIfaceSpec))
 	}
 	addressesToDnatSpec := fmt.Sprintf("{ %s }", strings.Join(addressesToDnat, ", "))
 
@@ -168,9 +168,7 @@ func (m MasqPod) setupNATByFamily(family nft.IPFamily, podIfaceSpec, bridgeIface
 				return err
 			}
 
-			if family == nft.IPv4 {
-				addressesToSnat = append(addressesToSnat, istio.GetLoopbackAddress())
-			}
+			addressesToSnat = append(addressesToSnat, istioLoopback(family))
 		} else {
 			if err := m.forwardPorts(family, guestIP, protocol, int(port.Port)); err != nil {
 				return err
@@ -198,9 +196,7 @@ func (m MasqPod) setupNATByFamily(family nft.IPFamily, podIfaceSpec, bridgeIface
 			if err := m.forwardPorts(family, guestIP, "tcp", istio.NonProxiedPorts()...); err != nil {
 				return err
 			}
-			if family == nft.IPv4 {
-				addressesToSnat = append(addressesToSnat, istio.GetLoopbackAddress())
-			}
+			addressesToSnat = append(addressesToSnat, istioLoopback(family))
 		} else {
 			if err := m.nftable.AddRule(family, natTable, kubevirtPreInboundChain, "counter", "dnat", "to", guestIP); err != nil {
 				return err
@@ -256,6 +252,20 @@ func ipLoopback(family nft.IPFamily) string {
 	return net.IPv6loopback.String()
 }
 
+func istioLoopback(family nft.IPFamily) string {
+	if family == nft.IPv4 {
+		return istio.GetLoopbackAddress()
+	}
+	return istio.GetLoopbackAddressIPv6()
+}
+
+func podIPByFamily(family nft.IPFamily, podIface nmstate.Interface) string {
+	if family == nft.IPv4 {
+		return podIface.IPv4.Address[0].IP
+	}
+	return podIface.IPv6.Address[0].IP
+}
+
 // guestIPByGatewayInterface calculates and returns the expected guest IP.
 // The bridge IP is the guest default gateway and the next address is the one expected on the guest interface.
 func guestIPByGatewayInterface(family nft.IPFamily, bridgeIface nmstate.Interface) string {
@@ -421,8 +421,8 @@ family ip6 table nat chain postrouting rulespec [oifname k6t-eth0 counter jump K
 family ip6 table nat chain output rulespec [tcp dport { 15000, 15001, 15004, 15006, 15008, 15009, 15020, 15021, 15053, 15090 } ip6 saddr ::1 counter return]
 family ip6 table nat chain KUBEVIRT_POSTINBOUND rulespec [tcp dport { 15000, 15001, 15004, 15006, 15008, 15009, 15020, 15021, 15053, 15090 } ip6 saddr ::1 counter return]
 family ip6 table nat chain KUBEVIRT_PREINBOUND rulespec [tcp dport { 22 } counter dnat to fd10:0:2::2]
-family ip6 table nat chain KUBEVIRT_POSTINBOUND rulespec [ip6 saddr { ::1 } counter snat to fd10:0:2::1]
-family ip6 table nat chain output rulespec [ip6 daddr { ::1 } counter dnat to fd10:0:2::2]
+family ip6 table nat chain KUBEVIRT_POSTINBOUND rulespec [ip6 saddr { ::1, ::6 } counter snat to fd10:0:2::1]
+family ip6 table nat chain output rulespec [ip6 daddr { ::1, 2001::1 } counter dnat to fd10:0:2::2]
 `
 			Expect(nftStub.String()).To(Equal(expectedConfig), fmt.Sprintf("actual:\n%s\n\nexpected:\n%s", nftStub.String(), expectedConfig))
 		})
@@ -516,10 +516,10 @@ family ip6 table nat chain prerouting rulespec [iifname eth0 counter jump KUBEVI
 family ip6 table nat chain postrouting rulespec [oifname k6t-eth0 counter jump KUBEVIRT_POSTINBOUND]
 family ip6 table nat chain output rulespec [tcp dport { 49152, 49153 } ip6 saddr ::1 counter return]
 family ip6 table nat chain KUBEVIRT_POSTINBOUND rulespec [tcp dport { 49152, 49153 } ip6 saddr ::1 counter return]
-family ip6 table nat chain KUBEVIRT_POSTINBOUND rulespec [tcp dport 80 ip6 saddr { ::1 } counter snat to fd10:0:2::1]
-family ip6 table nat chain output rulespec [ip6 daddr { ::1 } tcp dport 80 counter dnat to fd10:0:2::2]
-family ip6 table nat chain KUBEVIRT_POSTINBOUND rulespec [tcp dport 8080 ip6 saddr { ::1 } counter snat to fd10:0:2::1]
-family ip6 table nat chain output rulespec [ip6 daddr { ::1 } tcp dport 8080 counter dnat to fd10:0:2::2]
+family ip6 table nat chain KUBEVIRT_POSTINBOUND rulespec [tcp dport 80 ip6 saddr { ::1, ::6 } counter snat to fd10:0:2::1]
+family ip6 table nat chain output rulespec [ip6 daddr { ::1, 2001::1 } tcp dport 80 counter dnat to fd10:0:2::2]
+family ip6 table nat chain KUBEVIRT_POSTINBOUND rulespec [tcp dport 8080 ip6 saddr { ::1, ::6 } counter snat to fd10:0:2::1]
+family ip6 table nat chain output rulespec [ip6 daddr { ::1, 2001::1 } tcp dport 8080 counter dnat to fd10:0:2::2]
 `
 			Expect(nftStub.String()).To(Equal(expectedConfig), fmt.Sprintf("actual:\n%s\n\nexpected:\n%s", nftStub.String(), expectedConfig))
 		})
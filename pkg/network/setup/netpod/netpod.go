@@ -391,7 +391,8 @@ func (n NetPod) bridgeBindingSpec(podIfaceName string, vmiIfaceIndex int, ifaceS
 			UID:    n.ownerID,
 			GID:    n.ownerID,
 		},
-		Metadata: &nmstate.IfaceMetadata{Pid: n.podPID, NetworkName: vmiNetworkName},
+		Bandwidth: n.networkBandwidth(vmiIfaceIndex),
+		Metadata:  &nmstate.IfaceMetadata{Pid: n.podPID, NetworkName: vmiNetworkName},
 	}
 
 	dummyIface := nmstate.Interface{
@@ -414,6 +415,33 @@ func (n NetPod) networkQueues(vmiIfaceIndex int) int {
 	return 0
 }
 
+// networkBandwidth returns the shaping of the tap device.
+// The guest receives the traffic the tap device transmits and transmits the traffic the tap device receives.
+func (n NetPod) networkBandwidth(vmiIfaceIndex int) *nmstate.Bandwidth {
+	bandwidth := n.vmiSpecIfaces[vmiIfaceIndex].Bandwidth
+	if bandwidth == nil || (bandwidth.Inbound == nil && bandwidth.Outbound == nil) {
+		return nil
+	}
+	return &nmstate.Bandwidth{
+		Egress:  toBandwidthLimit(bandwidth.Inbound),
+		Ingress: toBandwidthLimit(bandwidth.Outbound),
+	}
+}
+
+func toBandwidthLimit(limit *v1.InterfaceBandwidthLimit) *nmstate.BandwidthLimit {
+	if limit == nil {
+		return nil
+	}
+	bandwidthLimit := &nmstate.BandwidthLimit{Average: uint64(limit.Average.Value())}
+	if limit.Peak != nil {
+		bandwidthLimit.Peak = uint64(limit.Peak.Value())
+	}
+	if limit.Burst != nil {
+		bandwidthLimit.Burst = uint64(limit.Burst.Value())
+	}
+	return bandwidthLimit
+}
+
 func (n NetPod) masqueradeBindingSpec(podIfaceName string, vmiIfaceIndex int, ifaceStatusByName map[string]nmstate.Interface) ([]nmstate.Interface, error) {
 	podIface := ifaceStatusByName[podIfaceName]
 
@@ -466,7 +494,8 @@ func (n NetPod) masqueradeBindingSpec(podIfaceName string, vmiIfaceIndex int, if
 			UID:    n.ownerID,
 			GID:    n.ownerID,
 		},
-		Metadata: &nmstate.IfaceMetadata{Pid: n.podPID, NetworkName: vmiNetwork.Name},
+		Bandwidth: n.networkBandwidth(vmiIfaceIndex),
+		Metadata:  &nmstate.IfaceMetadata{Pid: n.podPID, NetworkName: vmiNetwork.Name},
 	}
 
 	return []nmstate.Interface{bridgeIface, tapIface}, nil
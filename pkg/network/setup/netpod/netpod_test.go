@@ -27,6 +27,8 @@ import (
 
 	vishnetlink "github.com/vishvananda/netlink"
 
+	"k8s.io/apimachinery/pkg/api/resource"
+
 	dutils "kubevirt.io/kubevirt/pkg/ephemeral-disk-utils"
 	kfs "kubevirt.io/kubevirt/pkg/os/fs"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
@@ -279,6 +281,56 @@ var _ = Describe("netpod", func() {
 		}))
 	})
 
+	It("setup masquerade binding with bandwidth limits on the tap device", func() {
+		nmstatestub := nmstateStub{status: nmstate.Status{
+			Interfaces: []nmstate.Interface{{
+				Name:       "eth0",
+				Index:      0,
+				TypeName:   nmstate.TypeVETH,
+				State:      nmstate.IfaceStateUp,
+				MacAddress: "12:34:56:78:90:ab",
+				MTU:        1500,
+				IPv4: nmstate.IP{
+					Enabled: pointer.P(true),
+					Address: []nmstate.IPAddress{{
+						IP:        primaryIPv4Address,
+						PrefixLen: 30,
+					}},
+				},
+			}},
+		}}
+		masqstub := masqueradeStub{}
+
+		vmiIface := v1.Interface{
+			Name:                   defaultPodNetworkName,
+			InterfaceBindingMethod: v1.InterfaceBindingMethod{Masquerade: &v1.InterfaceMasquerade{}},
+			Bandwidth: &v1.InterfaceBandwidth{
+				Inbound: &v1.InterfaceBandwidthLimit{
+					Average: resource.MustParse("10Mi"),
+					Peak:    pointer.P(resource.MustParse("20Mi")),
+					Burst:   pointer.P(resource.MustParse("1Mi")),
+				},
+				Outbound: &v1.InterfaceBandwidthLimit{Average: resource.MustParse("5Mi")},
+			},
+		}
+		netPod := netpod.NewNetPod(
+			[]v1.Network{*v1.DefaultPodNetwork()},
+			[]v1.Interface{vmiIface},
+			vmiUID, 0, 0, 0, state,
+			netpod.WithNMStateAdapter(&nmstatestub),
+			netpod.WithMasqueradeAdapter(&masqstub),
+			netpod.WithCacheCreator(&baseCacheCreator),
+		)
+		Expect(netPod.Setup()).To(Succeed())
+		Expect(nmstatestub.spec.Interfaces).To(ContainElement(And(
+			HaveField("Name", "tap0"),
+			HaveField("Bandwidth", Equal(&nmstate.Bandwidth{
+				Egress:  &nmstate.BandwidthLimit{Average: 10 * 1024 * 1024, Peak: 20 * 1024 * 1024, Burst: 1024 * 1024},
+				Ingress: &nmstate.BandwidthLimit{Average: 5 * 1024 * 1024},
+			})),
+		)))
+	})
+
 	It("setup bridge binding with IP and a static route", func() {
 		const (
 			defaultGatewayIP4Address = "10.222.222.254"
@@ -29,6 +29,7 @@ import (
 
 	v1 "kubevirt.io/api/core/v1"
 
+	"kubevirt.io/kubevirt/pkg/network/arpsnoop"
 	"kubevirt.io/kubevirt/pkg/network/cache"
 	"kubevirt.io/kubevirt/pkg/network/deviceinfo"
 	netvmispec "kubevirt.io/kubevirt/pkg/network/vmispec"
@@ -40,8 +41,13 @@ const (
 	UnknownInterfaceQueueCount = 0
 )
 
+type learnedIPsReader interface {
+	LearnedIPs(key, mac string) []string
+}
+
 type NetStat struct {
 	cacheCreator cacheCreator
+	arpSnooper   learnedIPsReader
 
 	// In memory cache, storing pod interface information.
 	// key is the file path, value is the contents.
@@ -49,13 +55,14 @@ type NetStat struct {
 	podInterfaceVolatileCache sync.Map
 }
 
-func NewNetStat() *NetStat {
-	return NewNetStateWithCustomFactory(cache.CacheCreator{})
+func NewNetStat(arpSnooper *arpsnoop.Snooper) *NetStat {
+	return NewNetStateWithCustomFactory(cache.CacheCreator{}, arpSnooper)
 }
 
-func NewNetStateWithCustomFactory(cacheCreator cacheCreator) *NetStat {
+func NewNetStateWithCustomFactory(cacheCreator cacheCreator, arpSnooper learnedIPsReader) *NetStat {
 	return &NetStat{
 		cacheCreator:              cacheCreator,
+		arpSnooper:                arpSnooper,
 		podInterfaceVolatileCache: sync.Map{},
 	}
 }
@@ -82,6 +89,7 @@ func (c *NetStat) CachePodInterfaceVolatileData(vmi *v1.VirtualMachineInstance,
 //   - Pod interface cache: interfaces data (IP/s) collected from the cache (which was populated during the network setup).
 //   - domain.Spec: interfaces configuration as seen by the (libvirt) domain.
 //   - domain.Status.Interfaces: interfaces reported by the guest agent (empty if Qemu agent not running).
+//   - ARP snooping: IPv4 addresses the guest announced on secondary networks, used when no other source reports IPs.
 //   - Multus status: Interfaces reported by multus on the pod annotation.
 //     The virt-controller updates the VMI interfaces status my setting the infoSource field.
 //
@@ -113,6 +121,10 @@ func (c *NetStat) UpdateStatus(vmi *v1.VirtualMachineInstance, domain *api.Domai
 	// Guest Agent information will add and conditionally override data gathered from the cache.
 	interfacesStatus = ifacesStatusFromGuestAgent(interfacesStatus, domain.Status.Interfaces)
 
+	if c.arpSnooper != nil {
+		c.updateIfacesStatusFromARPSnooping(interfacesStatus, vmi.UID)
+	}
+
 	if primaryNetwork := netvmispec.LookupPodNetwork(vmi.Spec.Networks); primaryNetwork != nil {
 		interfacesStatus = restorePrimaryIfaceStatus(interfacesStatus, vmi.Status.Interfaces, primaryNetwork.Name)
 		interfacesStatus = movePrimaryIfaceStatusToFront(interfacesStatus, primaryNetwork.Name)
@@ -225,6 +237,25 @@ func (c *NetStat) updateIfacesStatusFromPodCache(ifacesStatus []v1.VirtualMachin
 	return ifacesStatus, nil
 }
 
+// updateIfacesStatusFromARPSnooping updates the provided interfaces statuses which have no IPs with the IPs learned
+// from the ARP traffic of the guest.
+func (c *NetStat) updateIfacesStatusFromARPSnooping(ifacesStatus []v1.VirtualMachineInstanceNetworkInterface, vmiUID types.UID) {
+	for i := range ifacesStatus {
+		ifaceStatus := &ifacesStatus[i]
+		if ifaceStatus.Name == "" || ifaceStatus.IP != "" {
+			continue
+		}
+
+		learnedIPs := c.arpSnooper.LearnedIPs(arpsnoop.Key(string(vmiUID), ifaceStatus.Name), ifaceStatus.MAC)
+		if len(learnedIPs) == 0 {
+			continue
+		}
+		ifaceStatus.IP = learnedIPs[0]
+		ifaceStatus.IPs = learnedIPs
+		ifaceStatus.InfoSource = netvmispec.AddInfoSource(ifaceStatus.InfoSource, netvmispec.InfoSourceARPSnooping)
+	}
+}
+
 func (c *NetStat) getPodInterfacefromFileCache(vmi *v1.VirtualMachineInstance, ifaceName string) (*cache.PodIfaceCacheData, error) {
 	// Once the Interface files are set on the handler, they don't change
 	// If already present in the map, don't read again
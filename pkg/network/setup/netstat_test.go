@@ -26,6 +26,7 @@ import (
 	v1 "kubevirt.io/api/core/v1"
 
 	dutils "kubevirt.io/kubevirt/pkg/ephemeral-disk-utils"
+	"kubevirt.io/kubevirt/pkg/network/arpsnoop"
 	"kubevirt.io/kubevirt/pkg/network/cache"
 	netsriov "kubevirt.io/kubevirt/pkg/network/deviceinfo"
 	netsetup "kubevirt.io/kubevirt/pkg/network/setup"
@@ -458,6 +459,37 @@ var _ = Describe("netstat", func() {
 		}), "the pod IP/s should be reported in the status")
 	})
 
+	It("should report the IPs learned from ARP snooping on an interface with no other IP source", func() {
+		const (
+			secondaryNetworkName = "secondary"
+			secondaryMAC         = "1c:ce:c0:01:be:e9"
+			learnedIPv4          = "10.10.0.5"
+		)
+
+		Expect(
+			setup.addNetworkInterface(
+				newVMISpecIfaceWithBridgeBinding(secondaryNetworkName),
+				newVMISpecMultusNetwork(secondaryNetworkName),
+				newDomainSpecIface(secondaryNetworkName, secondaryMAC),
+			),
+		).To(Succeed())
+		setup.arpSnooper[arpsnoop.Key(string(setup.Vmi.UID), secondaryNetworkName)] = []string{learnedIPv4}
+
+		Expect(setup.NetStat.UpdateStatus(setup.Vmi, setup.Domain)).To(Succeed())
+
+		Expect(setup.Vmi.Status.Interfaces).To(Equal([]v1.VirtualMachineInstanceNetworkInterface{
+			{
+				Name:       secondaryNetworkName,
+				MAC:        secondaryMAC,
+				IP:         learnedIPv4,
+				IPs:        []string{learnedIPv4},
+				InfoSource: netvmispec.NewInfoSource(netvmispec.InfoSourceDomain, netvmispec.InfoSourceARPSnooping),
+				QueueCount: netsetup.DefaultInterfaceQueueCount,
+				LinkState:  linkStateUp,
+			},
+		}))
+	})
+
 	It("should report SR-IOV interface when guest-agent is inactive and no other interface exists", func() {
 		const (
 			networkName = "sriov-network"
@@ -1155,6 +1187,7 @@ type testSetup struct {
 
 	cacheCreator  *tempCacheCreator
 	podIfaceCache cache.PodInterfaceCache
+	arpSnooper    stubARPSnooper
 
 	// There are two types of caches used: virt-launcher/pod filesystem & virt-handler in-memory (volatile).
 	// volatileCache flag marks that the setup should also populate the volatile cache when a network interface is added.
@@ -1174,15 +1207,25 @@ func newTestSetup() testSetup {
 	vmi.UID = uid
 	dutils.MockDefaultOwnershipManager()
 
+	arpSnooper := stubARPSnooper{}
+
 	return testSetup{
 		Vmi:           vmi,
 		Domain:        &api.Domain{},
-		NetStat:       netsetup.NewNetStateWithCustomFactory(&cacheCreator),
+		NetStat:       netsetup.NewNetStateWithCustomFactory(&cacheCreator, arpSnooper),
 		cacheCreator:  &cacheCreator,
 		podIfaceCache: cache.NewPodInterfaceCache(&cacheCreator, uid),
+		arpSnooper:    arpSnooper,
 	}
 }
 
+// stubARPSnooper holds the learned IPs by snooping key, regardless of the MAC
+type stubARPSnooper map[string][]string
+
+func (s stubARPSnooper) LearnedIPs(key, _ string) []string {
+	return s[key]
+}
+
 // addNetworkInterface is adding a regular[*] network configuration which adds a vNIC.
 // This consist of 4 entities and an optional pod volatile cache:
 // - vmi spec interface
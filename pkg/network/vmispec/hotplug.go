@@ -43,3 +43,13 @@ func NetworksToHotplugWhosePodIfacesAreReady(vmi *v1.VirtualMachineInstance) []v
 
 	return networksToHotplug
 }
+
+// SRIOVInterfacesToHotplug returns the SR-IOV interfaces which are requested in the spec
+// but are not reported in the status, meaning the pod was created without their VFs.
+func SRIOVInterfacesToHotplug(vmi *v1.VirtualMachineInstance) []v1.Interface {
+	ifacesStatusByName := IndexInterfaceStatusByName(vmi.Status.Interfaces, nil)
+	return FilterInterfacesSpec(vmi.Spec.Domain.Devices.Interfaces, func(iface v1.Interface) bool {
+		_, inStatus := ifacesStatusByName[iface.Name]
+		return iface.SRIOV != nil && iface.State != v1.InterfaceStateAbsent && !inStatus
+	})
+}
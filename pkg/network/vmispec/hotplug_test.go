@@ -81,4 +81,49 @@ var _ = Describe("utilitary funcs to identify attachments to hotplug", func() {
 			),
 		)
 	})
+
+	Context("SRIOVInterfacesToHotplug", func() {
+		const (
+			nadName     = "sriov-nad"
+			networkName = "sriov"
+		)
+
+		DescribeTable("SRIOVInterfacesToHotplug", func(vmi *v1.VirtualMachineInstance, expectedIfaces ...v1.Interface) {
+			Expect(vmispec.SRIOVInterfacesToHotplug(vmi)).To(ConsistOf(expectedIfaces))
+		},
+			Entry("VMI without interfaces does not have anything to hotplug", libvmi.New()),
+			Entry("SR-IOV interface which is not reported in the status *is* subject to hotplug",
+				libvmi.New(
+					libvmi.WithInterface(libvmi.InterfaceDeviceWithSRIOVBinding(networkName)),
+					libvmi.WithNetwork(libvmi.MultusNetwork(networkName, nadName)),
+				),
+				libvmi.InterfaceDeviceWithSRIOVBinding(networkName),
+			),
+			Entry("SR-IOV interface which is reported in the status is *not* subject to hotplug",
+				libvmi.New(
+					libvmi.WithInterface(libvmi.InterfaceDeviceWithSRIOVBinding(networkName)),
+					libvmi.WithNetwork(libvmi.MultusNetwork(networkName, nadName)),
+					libvmistatus.WithStatus(libvmistatus.New(libvmistatus.WithInterfaceStatus(v1.VirtualMachineInstanceNetworkInterface{
+						Name: networkName, InfoSource: vmispec.InfoSourceMultusStatus,
+					}))),
+				),
+			),
+			Entry("SR-IOV interface which is being unplugged is *not* subject to hotplug",
+				libvmi.New(
+					libvmi.WithInterface(v1.Interface{
+						Name:                   networkName,
+						State:                  v1.InterfaceStateAbsent,
+						InterfaceBindingMethod: v1.InterfaceBindingMethod{SRIOV: &v1.InterfaceSRIOV{}},
+					}),
+					libvmi.WithNetwork(libvmi.MultusNetwork(networkName, nadName)),
+				),
+			),
+			Entry("bridge interface which is not reported in the status is *not* subject to SR-IOV hotplug",
+				libvmi.New(
+					libvmi.WithInterface(libvmi.InterfaceDeviceWithBridgeBinding(networkName)),
+					libvmi.WithNetwork(libvmi.MultusNetwork(networkName, nadName)),
+				),
+			),
+		)
+	})
 })
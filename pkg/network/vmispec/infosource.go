@@ -6,6 +6,7 @@ const (
 	InfoSourceDomain       string = "domain"
 	InfoSourceGuestAgent   string = "guest-agent"
 	InfoSourceMultusStatus string = "multus-status"
+	InfoSourceARPSnooping  string = "arp-snooping"
 	InfoSourceDomainAndGA  string = InfoSourceDomain + ", " + InfoSourceGuestAgent
 
 	separator = ", "
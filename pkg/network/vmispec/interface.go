@@ -60,6 +60,13 @@ func VerifyVMIMigratable(vmi *v1.VirtualMachineInstance, bindingPlugins map[stri
 		return nil
 	}
 
+	// The state of a vDPA device is kept by the hardware, it can only be migrated if the plugin says so
+	for _, iface := range ifaces {
+		if IsVDPAInterface(iface, bindingPlugins) && bindingPlugins[iface.Binding.Name].Migration == nil {
+			return fmt.Errorf("cannot migrate VMI with vDPA interface %s", iface.Name)
+		}
+	}
+
 	_, allowPodBridgeNetworkLiveMigration := vmi.Annotations[v1.AllowPodBridgeNetworkLiveMigrationAnnotation]
 	if allowPodBridgeNetworkLiveMigration && IsPodNetworkWithBridgeBindingInterface(vmi.Spec.Networks, ifaces) {
 		return nil
@@ -186,7 +193,16 @@ func BindingPluginNetworkWithDeviceInfoExist(ifaces []v1.Interface, bindingPlugi
 func HasBindingPluginDeviceInfo(iface v1.Interface, bindingPlugins map[string]v1.InterfaceBindingPlugin) bool {
 	if iface.Binding != nil {
 		binding, exist := bindingPlugins[iface.Binding.Name]
-		return exist && binding.DownwardAPI == v1.DeviceInfo
+		// The vDPA domain attachment consumes the device info of the network
+		return exist && (binding.DownwardAPI == v1.DeviceInfo || binding.DomainAttachmentType == v1.Vdpa)
+	}
+	return false
+}
+
+func IsVDPAInterface(iface v1.Interface, bindingPlugins map[string]v1.InterfaceBindingPlugin) bool {
+	if iface.Binding != nil {
+		binding, exist := bindingPlugins[iface.Binding.Name]
+		return exist && binding.DomainAttachmentType == v1.Vdpa
 	}
 	return false
 }
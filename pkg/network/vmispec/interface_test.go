@@ -22,6 +22,8 @@ package vmispec_test
 import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/types"
+
 	v1 "kubevirt.io/api/core/v1"
 
 	"kubevirt.io/kubevirt/pkg/libvmi"
@@ -93,14 +95,19 @@ var _ = Describe("VMI network spec", func() {
 
 	Context("migratable", func() {
 		const (
-			migratablePlugin    = "mig"
-			nonMigratablePlugin = "non_mig"
-			podNet0             = "default"
+			migratablePlugin     = "mig"
+			nonMigratablePlugin  = "non_mig"
+			vdpaPlugin           = "vdpa"
+			migratableVDPAPlugin = "mig_vdpa"
+			podNet0              = "default"
+			secondaryNet         = "secondary"
 		)
 
 		bindingPlugins := map[string]v1.InterfaceBindingPlugin{
-			migratablePlugin:    {Migration: &v1.InterfaceBindingMigration{}},
-			nonMigratablePlugin: {},
+			migratablePlugin:     {Migration: &v1.InterfaceBindingMigration{}},
+			nonMigratablePlugin:  {},
+			vdpaPlugin:           {DomainAttachmentType: v1.Vdpa},
+			migratableVDPAPlugin: {DomainAttachmentType: v1.Vdpa, Migration: &v1.InterfaceBindingMigration{}},
 		}
 
 		Context("pod network with migratable binding plugin", func() {
@@ -190,17 +197,32 @@ var _ = Describe("VMI network spec", func() {
 				)
 				Expect(netvmispec.VerifyVMIMigratable(vmi, bindingPlugins)).To(Succeed())
 			})
+			DescribeTable("should verify the migration of a VMI with a vDPA interface", func(plugin string, matcher types.GomegaMatcher) {
+				network := podNetwork(podNet0)
+				vmi := libvmi.New(
+					libvmi.WithInterface(*v1.DefaultMasqueradeNetworkInterface()),
+					libvmi.WithNetwork(&network),
+					libvmi.WithInterface(interfaceWithBindingPlugin(secondaryNet, plugin)),
+					libvmi.WithNetwork(libvmi.MultusNetwork(secondaryNet, "vdpa-nad")),
+				)
+				Expect(netvmispec.VerifyVMIMigratable(vmi, bindingPlugins)).To(matcher)
+			},
+				Entry("not allowed by default", vdpaPlugin, Not(Succeed())),
+				Entry("allowed when the plugin is migratable", migratableVDPAPlugin, Succeed()),
+			)
 		})
 	})
 
 	const (
 		deviceInfoPlugin    = "deviceinfo"
 		nonDeviceInfoPlugin = "non_deviceinfo"
+		vdpaPlugin          = "vdpa"
 	)
 
 	bindingPlugins := map[string]v1.InterfaceBindingPlugin{
 		deviceInfoPlugin:    {DownwardAPI: v1.DeviceInfo},
 		nonDeviceInfoPlugin: {},
+		vdpaPlugin:          {DomainAttachmentType: v1.Vdpa},
 	}
 	Context("binding plugin network with device info", func() {
 		It("returns false given non binding-plugin interface", func() {
@@ -221,6 +243,12 @@ var _ = Describe("VMI network spec", func() {
 				bindingPlugins,
 			)).To(BeTrue())
 		})
+		It("returns true when interface binding is plugin with vDPA domain attachment", func() {
+			Expect(netvmispec.HasBindingPluginDeviceInfo(
+				interfaceWithBindingPlugin("net3", vdpaPlugin),
+				bindingPlugins,
+			)).To(BeTrue())
+		})
 	})
 	Context("binding plugin network with device info exist", func() {
 		It("returns false when there is no network with device info plugin", func() {
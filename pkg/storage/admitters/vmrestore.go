@@ -23,6 +23,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"net"
 	"strings"
 
 	admissionv1 "k8s.io/api/admission/v1"
@@ -33,6 +34,7 @@ import (
 	"k8s.io/client-go/tools/cache"
 
 	"kubevirt.io/api/core"
+	v1 "kubevirt.io/api/core/v1"
 
 	snapshotv1 "kubevirt.io/api/snapshot/v1beta1"
 	"kubevirt.io/client-go/kubecli"
@@ -172,6 +174,7 @@ func (admitter *VMRestoreAdmitter) validateTargetVM(ctx context.Context, field *
 	namespace := vmRestore.Namespace
 
 	causes = admitter.validatePatches(vmRestore.Spec.Patches, field.Child("patches"))
+	causes = append(causes, validateTargetIdentity(&vmRestore.Spec, field)...)
 
 	vmSnapshot, err := admitter.Client.VirtualMachineSnapshot(namespace).Get(ctx, vmRestore.Spec.VirtualMachineSnapshotName, metav1.GetOptions{})
 	if err != nil {
@@ -186,6 +189,15 @@ func (admitter *VMRestoreAdmitter) validateTargetVM(ctx context.Context, field *
 		return nil, err
 	}
 
+	targetExists := err == nil
+	if targetExists && (vmRestore.Spec.TargetIdentityPolicy != nil || len(vmRestore.Spec.NewMacAddresses) > 0) {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "targetIdentityPolicy and newMacAddresses are only allowed when restoring to a new VM",
+			Field:   field.Child("targetIdentityPolicy").String(),
+		})
+	}
+
 	sourceTargetVmsAreDifferent := errors.IsNotFound(err) || (vmSnapshot.Status.SourceUID != nil && target.UID != *vmSnapshot.Status.SourceUID)
 	if sourceTargetVmsAreDifferent {
 		contentName := vmSnapshot.Status.VirtualMachineSnapshotContentName
@@ -203,6 +215,16 @@ func (admitter *VMRestoreAdmitter) validateTargetVM(ctx context.Context, field *
 			return nil, fmt.Errorf("unexpected snapshot source")
 		}
 
+		for ifaceName := range vmRestore.Spec.NewMacAddresses {
+			if !hasInterface(snapshotVM.Spec.Template.Spec.Domain.Devices.Interfaces, ifaceName) {
+				causes = append(causes, metav1.StatusCause{
+					Type:    metav1.CauseTypeFieldValueInvalid,
+					Message: fmt.Sprintf("interface %q not found in the snapshot VM", ifaceName),
+					Field:   field.Child("newMacAddresses").Key(ifaceName).String(),
+				})
+			}
+		}
+
 		if backendstorage.IsBackendStorageNeededForVMI(&snapshotVM.Spec.Template.Spec) {
 			causes = append(causes, metav1.StatusCause{
 				Type:    metav1.CauseTypeFieldValueInvalid,
@@ -215,6 +237,47 @@ func (admitter *VMRestoreAdmitter) validateTargetVM(ctx context.Context, field *
 	return causes, nil
 }
 
+func validateTargetIdentity(spec *snapshotv1.VirtualMachineRestoreSpec, field *k8sfield.Path) (causes []metav1.StatusCause) {
+	policy := spec.TargetIdentityPolicy
+	if policy != nil && *policy != snapshotv1.TargetIdentityPolicyKeep && *policy != snapshotv1.TargetIdentityPolicyRegenerate {
+		causes = append(causes, metav1.StatusCause{
+			Type: metav1.CauseTypeFieldValueNotSupported,
+			Message: fmt.Sprintf("targetIdentityPolicy must be %s or %s",
+				snapshotv1.TargetIdentityPolicyKeep, snapshotv1.TargetIdentityPolicyRegenerate),
+			Field: field.Child("targetIdentityPolicy").String(),
+		})
+	}
+
+	if len(spec.NewMacAddresses) > 0 && (policy == nil || *policy != snapshotv1.TargetIdentityPolicyRegenerate) {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("newMacAddresses requires targetIdentityPolicy %s", snapshotv1.TargetIdentityPolicyRegenerate),
+			Field:   field.Child("newMacAddresses").String(),
+		})
+	}
+
+	for ifaceName, macAddress := range spec.NewMacAddresses {
+		if _, err := net.ParseMAC(macAddress); err != nil {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("invalid MAC address %q: %v", macAddress, err),
+				Field:   field.Child("newMacAddresses").Key(ifaceName).String(),
+			})
+		}
+	}
+
+	return causes
+}
+
+func hasInterface(interfaces []v1.Interface, name string) bool {
+	for _, iface := range interfaces {
+		if iface.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
 func (admitter *VMRestoreAdmitter) validatePatches(patches []string, field *k8sfield.Path) (causes []metav1.StatusCause) {
 	// Validate patches are either on labels/annotations or on elements under "/spec/" path only
 	for _, patch := range patches {
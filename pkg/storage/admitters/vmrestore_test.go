@@ -561,6 +561,94 @@ var _ = Describe("Validating VirtualMachineRestore Admitter", func() {
 				})
 			})
 
+			Context("when regenerating the target identity", func() {
+				const targetVMName = "new-test-vm"
+
+				var (
+					restore           *snapshotv1.VirtualMachineRestore
+					vmSnapshotContent *snapshotv1.VirtualMachineSnapshotContent
+				)
+
+				BeforeEach(func() {
+					vm.Spec.Template = &v1.VirtualMachineInstanceTemplateSpec{
+						Spec: v1.VirtualMachineInstanceSpec{
+							Domain: v1.DomainSpec{
+								Devices: v1.Devices{
+									Interfaces: []v1.Interface{{Name: "default"}},
+								},
+							},
+						},
+					}
+
+					vmSnapshotContent = &snapshotv1.VirtualMachineSnapshotContent{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "snapshot-content",
+							Namespace: "default",
+						},
+						Spec: snapshotv1.VirtualMachineSnapshotContentSpec{
+							Source: snapshotv1.SourceSpec{
+								VirtualMachine: &snapshotv1.VirtualMachine{
+									ObjectMeta: vm.ObjectMeta,
+									Spec:       vm.Spec,
+								},
+							},
+						},
+					}
+					snapshot.Status.VirtualMachineSnapshotContentName = pointer.P(vmSnapshotContent.Name)
+
+					restore = &snapshotv1.VirtualMachineRestore{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "restore",
+							Namespace: "default",
+						},
+						Spec: snapshotv1.VirtualMachineRestoreSpec{
+							Target: corev1.TypedLocalObjectReference{
+								APIGroup: &apiGroup,
+								Kind:     "VirtualMachine",
+								Name:     targetVMName,
+							},
+							VirtualMachineSnapshotName: vmSnapshotName,
+							TargetIdentityPolicy:       pointer.P(snapshotv1.TargetIdentityPolicyRegenerate),
+						},
+					}
+				})
+
+				It("should allow new MAC addresses for the interfaces of a new VM", func() {
+					restore.Spec.NewMacAddresses = map[string]string{"default": "00:00:5e:00:53:01"}
+
+					ar := createRestoreAdmissionReview(restore)
+					resp := createTestVMRestoreAdmitter(config, vm, snapshot, vmSnapshotContent).Admit(context.Background(), ar)
+					Expect(resp.Allowed).To(BeTrue())
+				})
+
+				DescribeTable("should reject", func(mutate func(*snapshotv1.VirtualMachineRestore), field string) {
+					mutate(restore)
+
+					ar := createRestoreAdmissionReview(restore)
+					resp := createTestVMRestoreAdmitter(config, vm, snapshot, vmSnapshotContent).Admit(context.Background(), ar)
+					Expect(resp.Allowed).To(BeFalse())
+					Expect(resp.Result.Details.Causes).To(HaveLen(1))
+					Expect(resp.Result.Details.Causes[0].Field).To(Equal(field))
+				},
+					Entry("an unknown policy", func(r *snapshotv1.VirtualMachineRestore) {
+						r.Spec.TargetIdentityPolicy = pointer.P(snapshotv1.TargetIdentityPolicy("Random"))
+					}, "spec.targetIdentityPolicy"),
+					Entry("new MAC addresses without regenerating the identity", func(r *snapshotv1.VirtualMachineRestore) {
+						r.Spec.TargetIdentityPolicy = nil
+						r.Spec.NewMacAddresses = map[string]string{"default": "00:00:5e:00:53:01"}
+					}, "spec.newMacAddresses"),
+					Entry("an invalid MAC address", func(r *snapshotv1.VirtualMachineRestore) {
+						r.Spec.NewMacAddresses = map[string]string{"default": "not-a-mac"}
+					}, "spec.newMacAddresses[default]"),
+					Entry("a MAC address for an unknown interface", func(r *snapshotv1.VirtualMachineRestore) {
+						r.Spec.NewMacAddresses = map[string]string{"missing": "00:00:5e:00:53:01"}
+					}, "spec.newMacAddresses[missing]"),
+					Entry("regenerating the identity of an existing VM", func(r *snapshotv1.VirtualMachineRestore) {
+						r.Spec.Target.Name = vmName
+					}, "spec.targetIdentityPolicy"),
+				)
+			})
+
 		})
 	})
 })
@@ -0,0 +1,134 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package admitters
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
+
+	snapshotv1 "kubevirt.io/api/snapshot/v1beta1"
+
+	"kubevirt.io/kubevirt/pkg/util/cron"
+	webhookutils "kubevirt.io/kubevirt/pkg/util/webhooks"
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
+)
+
+// VMSnapshotScheduleAdmitter validates VirtualMachineSnapshotSchedules
+type VMSnapshotScheduleAdmitter struct {
+	Config *virtconfig.ClusterConfig
+}
+
+// NewVMSnapshotScheduleAdmitter creates a VMSnapshotScheduleAdmitter
+func NewVMSnapshotScheduleAdmitter(config *virtconfig.ClusterConfig) *VMSnapshotScheduleAdmitter {
+	return &VMSnapshotScheduleAdmitter{
+		Config: config,
+	}
+}
+
+// Admit validates an AdmissionReview
+func (admitter *VMSnapshotScheduleAdmitter) Admit(_ context.Context, ar *admissionv1.AdmissionReview) *admissionv1.AdmissionResponse {
+	if ar.Request.Resource.Group != snapshotv1.SchemeGroupVersion.Group ||
+		ar.Request.Resource.Resource != "virtualmachinesnapshotschedules" {
+		return webhookutils.ToAdmissionResponseError(fmt.Errorf("unexpected resource %+v", ar.Request.Resource))
+	}
+
+	if ar.Request.Operation == admissionv1.Create && !admitter.Config.SnapshotEnabled() {
+		return webhookutils.ToAdmissionResponseError(fmt.Errorf("snapshot feature gate not enabled"))
+	}
+
+	schedule := &snapshotv1.VirtualMachineSnapshotSchedule{}
+	if err := json.Unmarshal(ar.Request.Object.Raw, schedule); err != nil {
+		return webhookutils.ToAdmissionResponseError(err)
+	}
+
+	var causes []metav1.StatusCause
+	switch ar.Request.Operation {
+	case admissionv1.Create, admissionv1.Update:
+		causes = validateSnapshotSchedule(schedule)
+	default:
+		return webhookutils.ToAdmissionResponseError(fmt.Errorf("unexpected operation %s", ar.Request.Operation))
+	}
+
+	if len(causes) > 0 {
+		return webhookutils.ToAdmissionResponse(causes)
+	}
+
+	reviewResponse := admissionv1.AdmissionResponse{
+		Allowed: true,
+	}
+	return &reviewResponse
+}
+
+func validateSnapshotSchedule(schedule *snapshotv1.VirtualMachineSnapshotSchedule) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	specField := k8sfield.NewPath("spec")
+
+	// The name labels the created snapshots
+	if errs := validation.IsValidLabelValue(schedule.Name); len(errs) > 0 {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("name must be a valid label value: %s", strings.Join(errs, ", ")),
+			Field:   k8sfield.NewPath("metadata", "name").String(),
+		})
+	}
+
+	if _, err := cron.ParseSchedule(schedule.Spec.Schedule); err != nil {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("invalid cron schedule %q: %v", schedule.Spec.Schedule, err),
+			Field:   specField.Child("schedule").String(),
+		})
+	}
+
+	if _, err := metav1.LabelSelectorAsSelector(&schedule.Spec.Selector); err != nil {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("invalid selector: %v", err),
+			Field:   specField.Child("selector").String(),
+		})
+	}
+
+	if schedule.Spec.RetentionCount != nil && *schedule.Spec.RetentionCount < 1 {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "retentionCount must be at least 1",
+			Field:   specField.Child("retentionCount").String(),
+		})
+	}
+
+	if policy := schedule.Spec.QuiescePolicy; policy != nil &&
+		*policy != snapshotv1.QuiescePolicyBestEffort && *policy != snapshotv1.QuiescePolicyRequired {
+		causes = append(causes, metav1.StatusCause{
+			Type: metav1.CauseTypeFieldValueNotSupported,
+			Message: fmt.Sprintf("quiescePolicy must be %s or %s",
+				snapshotv1.QuiescePolicyBestEffort, snapshotv1.QuiescePolicyRequired),
+			Field: specField.Child("quiescePolicy").String(),
+		})
+	}
+
+	return causes
+}
@@ -0,0 +1,160 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package admitters
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	v1 "kubevirt.io/api/core/v1"
+	snapshotv1 "kubevirt.io/api/snapshot/v1beta1"
+
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/testutils"
+	"kubevirt.io/kubevirt/pkg/virt-api/webhooks"
+)
+
+var _ = Describe("Validating VirtualMachineSnapshotSchedule Admitter", func() {
+	config, _, kvStore := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{})
+
+	newSchedule := func() *snapshotv1.VirtualMachineSnapshotSchedule {
+		return &snapshotv1.VirtualMachineSnapshotSchedule{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "nightly",
+				Namespace: "foo",
+			},
+			Spec: snapshotv1.VirtualMachineSnapshotScheduleSpec{
+				Schedule: "0 2 * * *",
+				Selector: metav1.LabelSelector{
+					MatchLabels: map[string]string{"backup": "nightly"},
+				},
+			},
+		}
+	}
+
+	Context("Without feature gate enabled", func() {
+		It("should reject the creation", func() {
+			ar := createSnapshotScheduleAdmissionReview(admissionv1.Create, newSchedule())
+			resp := NewVMSnapshotScheduleAdmitter(config).Admit(context.Background(), ar)
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(resp.Result.Message).Should(Equal("snapshot feature gate not enabled"))
+		})
+	})
+
+	Context("With feature gate enabled", func() {
+		BeforeEach(func() {
+			testutils.UpdateFakeKubeVirtClusterConfig(kvStore, &v1.KubeVirt{
+				Spec: v1.KubeVirtSpec{
+					Configuration: v1.KubeVirtConfiguration{
+						DeveloperConfiguration: &v1.DeveloperConfiguration{
+							FeatureGates: []string{"Snapshot"},
+						},
+					},
+				},
+			})
+		})
+
+		AfterEach(func() {
+			testutils.UpdateFakeKubeVirtClusterConfig(kvStore, &v1.KubeVirt{})
+		})
+
+		It("should reject invalid request resource", func() {
+			ar := &admissionv1.AdmissionReview{
+				Request: &admissionv1.AdmissionRequest{
+					Resource: webhooks.VirtualMachineGroupVersionResource,
+				},
+			}
+
+			resp := NewVMSnapshotScheduleAdmitter(config).Admit(context.Background(), ar)
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(resp.Result.Message).Should(ContainSubstring("unexpected resource"))
+		})
+
+		DescribeTable("should allow a valid schedule", func(operation admissionv1.Operation) {
+			schedule := newSchedule()
+			schedule.Spec.RetentionCount = pointer.P(int32(3))
+			schedule.Spec.QuiescePolicy = pointer.P(snapshotv1.QuiescePolicyRequired)
+
+			ar := createSnapshotScheduleAdmissionReview(operation, schedule)
+			resp := NewVMSnapshotScheduleAdmitter(config).Admit(context.Background(), ar)
+			Expect(resp.Allowed).To(BeTrue())
+		},
+			Entry("on creation", admissionv1.Create),
+			Entry("on update", admissionv1.Update),
+		)
+
+		DescribeTable("should reject", func(mutate func(*snapshotv1.VirtualMachineSnapshotSchedule), field string) {
+			schedule := newSchedule()
+			mutate(schedule)
+
+			ar := createSnapshotScheduleAdmissionReview(admissionv1.Create, schedule)
+			resp := NewVMSnapshotScheduleAdmitter(config).Admit(context.Background(), ar)
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(resp.Result.Details.Causes).To(HaveLen(1))
+			Expect(resp.Result.Details.Causes[0].Field).To(Equal(field))
+		},
+			Entry("a name which is not a label value", func(s *snapshotv1.VirtualMachineSnapshotSchedule) {
+				s.Name = strings.Repeat("a", 64)
+			}, "metadata.name"),
+			Entry("an invalid cron schedule", func(s *snapshotv1.VirtualMachineSnapshotSchedule) {
+				s.Spec.Schedule = "every night"
+			}, "spec.schedule"),
+			Entry("an invalid selector", func(s *snapshotv1.VirtualMachineSnapshotSchedule) {
+				s.Spec.Selector.MatchExpressions = []metav1.LabelSelectorRequirement{
+					{Key: "backup", Operator: metav1.LabelSelectorOpIn},
+				}
+			}, "spec.selector"),
+			Entry("a retention count of zero", func(s *snapshotv1.VirtualMachineSnapshotSchedule) {
+				s.Spec.RetentionCount = pointer.P(int32(0))
+			}, "spec.retentionCount"),
+			Entry("an unknown quiesce policy", func(s *snapshotv1.VirtualMachineSnapshotSchedule) {
+				s.Spec.QuiescePolicy = pointer.P(snapshotv1.QuiescePolicy("Never"))
+			}, "spec.quiescePolicy"),
+		)
+	})
+})
+
+func createSnapshotScheduleAdmissionReview(
+	operation admissionv1.Operation, schedule *snapshotv1.VirtualMachineSnapshotSchedule,
+) *admissionv1.AdmissionReview {
+	bytes, _ := json.Marshal(schedule)
+
+	return &admissionv1.AdmissionReview{
+		Request: &admissionv1.AdmissionRequest{
+			Operation: operation,
+			Namespace: "foo",
+			Resource: metav1.GroupVersionResource{
+				Group:    "snapshot.kubevirt.io",
+				Resource: "virtualmachinesnapshotschedules",
+			},
+			Object: runtime.RawExtension{
+				Raw: bytes,
+			},
+		},
+	}
+}
@@ -62,6 +62,7 @@ import (
 	storageutils "kubevirt.io/kubevirt/pkg/storage/utils"
 	kutil "kubevirt.io/kubevirt/pkg/util"
 	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
+	"kubevirt.io/kubevirt/pkg/virt-controller/watch/circuitbreaker"
 	watchutil "kubevirt.io/kubevirt/pkg/virt-controller/watch/util"
 	"kubevirt.io/kubevirt/pkg/virt-operator/resource/apply"
 	"kubevirt.io/kubevirt/pkg/virt-operator/resource/generate/components"
@@ -122,6 +123,7 @@ const (
 	manifestData           = "manifest-data"
 	manifestsPath          = "/manifests/all"
 	secretManifestPath     = "/manifests/secret"
+	ovaPath                = "/ova"
 	externalHostKey        = "external_host"
 	internalHostKey        = "internal_host"
 	externalCaConfigMapKey = "external_ca_cm"
@@ -215,6 +217,8 @@ type VMExportController struct {
 
 	Recorder record.EventRecorder
 
+	CircuitBreaker *circuitbreaker.CircuitBreaker
+
 	KubevirtNamespace string
 
 	vmExportQueue workqueue.TypedRateLimitingInterface[string]
@@ -421,7 +425,7 @@ func (ctrl *VMExportController) vmExportWorker() {
 }
 
 func (ctrl *VMExportController) processVMExportWorkItem() bool {
-	return watchutil.ProcessWorkItem(ctrl.vmExportQueue, func(key string) (time.Duration, error) {
+	return watchutil.ProcessWorkItemWithBreaker(ctrl.vmExportQueue, ctrl.CircuitBreaker, func(key string) (time.Duration, error) {
 		log.Log.V(3).Infof("vmExport worker processing key [%s]", key)
 
 		storeObj, exists, err := ctrl.VMExportInformer.GetStore().GetByKey(key)
@@ -1065,6 +1069,11 @@ func (ctrl *VMExportController) createDataManifestAndAddToPod(vmExport *exportv1
 			},
 		},
 	})
+	// The OVA is generated from the VM manifest, so it is only offered along with it
+	podManifest.Spec.Containers[0].Env = append(podManifest.Spec.Containers[0].Env, corev1.EnvVar{
+		Name:  "EXPORT_OVA_URI",
+		Value: ovaPath,
+	})
 	return nil
 }
 
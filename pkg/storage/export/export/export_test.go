@@ -1263,6 +1263,7 @@ var _ = Describe("Export controller", func() {
 		err = controller.createDataManifestAndAddToPod(testVMExport, vm, testPod, service)
 		Expect(err).ToNot(HaveOccurred())
 		Expect(testVMExport.Status).ToNot(BeNil())
+		Expect(testPod.Spec.Containers[0].Env).To(ContainElement(k8sv1.EnvVar{Name: "EXPORT_OVA_URI", Value: ovaPath}))
 	})
 
 	createVM := func() *virtv1.VirtualMachine {
@@ -91,6 +91,12 @@ func (ctrl *VMExportController) getLinks(pvcs []*corev1.PersistentVolumeClaim, e
 			Url:  scheme + path.Join(hostAndBase, linkType, paths.VMURI),
 		})
 	}
+	if paths.OVAURI != "" {
+		exportLink.Manifests = append(exportLink.Manifests, exportv1.VirtualMachineExportManifest{
+			Type: exportv1.OVA,
+			Url:  scheme + path.Join(hostAndBase, linkType, paths.OVAURI),
+		})
+	}
 	if paths.SecretURI != "" {
 		exportLink.Manifests = append(exportLink.Manifests, exportv1.VirtualMachineExportManifest{
 			Type: exportv1.AuthHeader,
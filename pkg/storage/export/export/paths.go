@@ -21,6 +21,7 @@ type VolumeInfo struct {
 type ServerPaths struct {
 	VMURI     string
 	SecretURI string
+	OVAURI    string
 	Volumes   []VolumeInfo
 }
 
@@ -48,6 +49,7 @@ func CreateServerPaths(env map[string]string) *ServerPaths {
 	result := &ServerPaths{
 		VMURI:     env["EXPORT_VM_DEF_URI"],
 		SecretURI: env["EXPORT_SECRET_DEF_URI"],
+		OVAURI:    env["EXPORT_OVA_URI"],
 	}
 	for k, v := range env {
 		if strings.HasSuffix(k, "_EXPORT_PATH") {
@@ -87,6 +87,7 @@ type ExportServerConfig struct {
 	FileHandler        func(string) http.Handler
 	GzipHandler        func(string) http.Handler
 	VmHandler          func([]export.VolumeInfo, func() (string, error), func() (*corev1.ConfigMap, error)) http.Handler
+	OVAHandler         func([]export.VolumeInfo) http.Handler
 	TokenSecretHandler func(TokenGetterFunc) http.Handler
 
 	PermissionChecker func(string) bool
@@ -136,6 +137,10 @@ func (s *exportServer) initHandler() {
 		mux.Handle(filepath.Join(internal, s.Paths.VMURI), tokenChecker(s.TokenGetter, s.VmHandler(s.Paths.Volumes, getInternalBasePath, getInternalCAConfigMap)))
 		mux.Handle(filepath.Join(external, s.Paths.VMURI), tokenChecker(s.TokenGetter, s.VmHandler(s.Paths.Volumes, getExternalBasePath, getExternalCAConfigMap)))
 	}
+	if s.Paths.OVAURI != "" {
+		mux.Handle(filepath.Join(internal, s.Paths.OVAURI), tokenChecker(s.TokenGetter, s.OVAHandler(s.Paths.Volumes)))
+		mux.Handle(filepath.Join(external, s.Paths.OVAURI), tokenChecker(s.TokenGetter, s.OVAHandler(s.Paths.Volumes)))
+	}
 	if s.Paths.SecretURI != "" {
 		mux.Handle(filepath.Join(internal, s.Paths.SecretURI), tokenChecker(s.TokenGetter, s.TokenSecretHandler(s.TokenGetter)))
 		mux.Handle(filepath.Join(external, s.Paths.SecretURI), tokenChecker(s.TokenGetter, s.TokenSecretHandler(s.TokenGetter)))
@@ -247,6 +252,10 @@ func NewExportServer(config ExportServerConfig) service.Service {
 		es.VmHandler = vmHandler
 	}
 
+	if es.OVAHandler == nil {
+		es.OVAHandler = ovaHandler
+	}
+
 	if es.TokenSecretHandler == nil {
 		es.TokenSecretHandler = secretHandler
 	}
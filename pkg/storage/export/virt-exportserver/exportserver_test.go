@@ -66,6 +66,9 @@ func newTestServer(token string) *exportServer {
 		VmHandler: func([]export.VolumeInfo, func() (string, error), func() (*v1.ConfigMap, error)) http.Handler {
 			return http.HandlerFunc(successHandler)
 		},
+		OVAHandler: func([]export.VolumeInfo) http.Handler {
+			return http.HandlerFunc(successHandler)
+		},
 		TokenSecretHandler: func(tgf TokenGetterFunc) http.Handler {
 			return http.HandlerFunc(successHandler)
 		},
@@ -0,0 +1,455 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package virtexportserver
+
+import (
+	"archive/tar"
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path"
+	"time"
+
+	virtv1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/storage/export/export"
+	"kubevirt.io/kubevirt/pkg/util/hardware"
+)
+
+const (
+	ovfEnvelopeNamespace = "http://schemas.dmtf.org/ovf/envelope/1"
+	ovfRasdNamespace     = "http://schemas.dmtf.org/wbem/wscim/1/cim-schema/2/CIM_ResourceAllocationSettingData"
+	ovfVssdNamespace     = "http://schemas.dmtf.org/wbem/wscim/1/cim-schema/2/CIM_VirtualSystemSettingData"
+	ovfVMDKFormat        = "http://www.vmware.com/interfaces/specifications/vmdk.html#streamOptimized"
+	ovfVirtualSystemType = "vmx-07"
+
+	// CIM resource types of the virtual hardware items
+	ovfResourceCPU            = 3
+	ovfResourceMemory         = 4
+	ovfResourceSCSIController = 6
+	ovfResourceEthernet       = 10
+	ovfResourceDisk           = 17
+
+	// SCSI target 7 is reserved for the controller
+	ovfDisksPerSCSIController = 15
+)
+
+type ovfEnvelope struct {
+	XMLName        xml.Name           `xml:"Envelope"`
+	Xmlns          string             `xml:"xmlns,attr"`
+	XmlnsOvf       string             `xml:"xmlns:ovf,attr"`
+	XmlnsRasd      string             `xml:"xmlns:rasd,attr"`
+	XmlnsVssd      string             `xml:"xmlns:vssd,attr"`
+	References     []ovfFile          `xml:"References>File"`
+	DiskSection    ovfDiskSection     `xml:"DiskSection"`
+	NetworkSection *ovfNetworkSection `xml:"NetworkSection,omitempty"`
+	VirtualSystem  ovfVirtualSystem   `xml:"VirtualSystem"`
+}
+
+type ovfFile struct {
+	ID   string `xml:"ovf:id,attr"`
+	Href string `xml:"ovf:href,attr"`
+	Size int64  `xml:"ovf:size,attr"`
+}
+
+type ovfDiskSection struct {
+	Info  string    `xml:"Info"`
+	Disks []ovfDisk `xml:"Disk"`
+}
+
+type ovfDisk struct {
+	DiskID                  string `xml:"ovf:diskId,attr"`
+	FileRef                 string `xml:"ovf:fileRef,attr"`
+	Capacity                int64  `xml:"ovf:capacity,attr"`
+	CapacityAllocationUnits string `xml:"ovf:capacityAllocationUnits,attr"`
+	Format                  string `xml:"ovf:format,attr"`
+}
+
+type ovfNetworkSection struct {
+	Info     string       `xml:"Info"`
+	Networks []ovfNetwork `xml:"Network"`
+}
+
+type ovfNetwork struct {
+	Name        string `xml:"ovf:name,attr"`
+	Description string `xml:"Description"`
+}
+
+type ovfVirtualSystem struct {
+	ID                     string                    `xml:"ovf:id,attr"`
+	Info                   string                    `xml:"Info"`
+	Name                   string                    `xml:"Name"`
+	OperatingSystemSection ovfOperatingSystemSection `xml:"OperatingSystemSection"`
+	VirtualHardwareSection ovfVirtualHardwareSection `xml:"VirtualHardwareSection"`
+}
+
+type ovfOperatingSystemSection struct {
+	// 1 is the CIM operating system "Other"
+	ID   int    `xml:"ovf:id,attr"`
+	Info string `xml:"Info"`
+}
+
+type ovfVirtualHardwareSection struct {
+	Info   string    `xml:"Info"`
+	System ovfSystem `xml:"System"`
+	Items  []ovfItem `xml:"Item"`
+}
+
+type ovfSystem struct {
+	ElementName       string `xml:"vssd:ElementName"`
+	InstanceID        int    `xml:"vssd:InstanceID"`
+	VirtualSystemType string `xml:"vssd:VirtualSystemType"`
+}
+
+// ovfItem is a CIM_ResourceAllocationSettingData, its elements are in the order of the schema
+type ovfItem struct {
+	Address             string `xml:"rasd:Address,omitempty"`
+	AddressOnParent     string `xml:"rasd:AddressOnParent,omitempty"`
+	AllocationUnits     string `xml:"rasd:AllocationUnits,omitempty"`
+	AutomaticAllocation *bool  `xml:"rasd:AutomaticAllocation,omitempty"`
+	Connection          string `xml:"rasd:Connection,omitempty"`
+	ElementName         string `xml:"rasd:ElementName"`
+	HostResource        string `xml:"rasd:HostResource,omitempty"`
+	InstanceID          int    `xml:"rasd:InstanceID"`
+	Parent              int    `xml:"rasd:Parent,omitempty"`
+	ResourceSubType     string `xml:"rasd:ResourceSubType,omitempty"`
+	ResourceType        int    `xml:"rasd:ResourceType"`
+	VirtualQuantity     int64  `xml:"rasd:VirtualQuantity,omitempty"`
+}
+
+// ovaDisk is a disk of the VM which is added to the OVA
+type ovaDisk struct {
+	name     string
+	fileName string
+	capacity int64
+	image    *vmdkImage
+	file     *os.File
+}
+
+func (d *ovaDisk) Close() error {
+	return d.file.Close()
+}
+
+// getOVADisks returns the disks of the VM which are exported in raw format, in the order of the VM devices.
+// Other disks, like CD-ROMs or container disks, are not part of the OVA.
+func getOVADisks(vm *virtv1.VirtualMachine, volumes []export.VolumeInfo) ([]*ovaDisk, error) {
+	var disks []*ovaDisk
+	closeDisks := func() {
+		for _, d := range disks {
+			d.Close()
+		}
+	}
+
+	for _, disk := range vm.Spec.Template.Spec.Domain.Devices.Disks {
+		if disk.CDRom != nil {
+			continue
+		}
+		vi := findRawVolume(volumes, vm.Spec.Template.Spec.Volumes, disk.Name)
+		if vi == nil {
+			continue
+		}
+
+		d, err := newOVADisk(disk.Name, fmt.Sprintf("disk%d.vmdk", len(disks)+1), vi)
+		if err != nil {
+			closeDisks()
+			return nil, err
+		}
+		disks = append(disks, d)
+	}
+	return disks, nil
+}
+
+func findRawVolume(volumes []export.VolumeInfo, vmVolumes []virtv1.Volume, name string) *export.VolumeInfo {
+	claimName := ""
+	for _, volume := range vmVolumes {
+		if volume.Name != name {
+			continue
+		}
+		if volume.DataVolume != nil {
+			claimName = volume.DataVolume.Name
+		} else if volume.PersistentVolumeClaim != nil {
+			claimName = volume.PersistentVolumeClaim.ClaimName
+		}
+	}
+	if claimName == "" {
+		return nil
+	}
+
+	exportName, err := getExportName()
+	if err != nil {
+		log.Log.Reason(err).Info("unable to read export name")
+	}
+	for i, vi := range volumes {
+		if vi.RawURI == "" {
+			continue
+		}
+		// The PVCs of snapshot exports are prefixed with the export name
+		pvcName := path.Base(path.Dir(vi.RawURI))
+		if pvcName == claimName || (exportName != "" && pvcName == fmt.Sprintf("%s-%s", exportName, claimName)) {
+			return &volumes[i]
+		}
+	}
+	return nil
+}
+
+func newOVADisk(name, fileName string, vi *export.VolumeInfo) (*ovaDisk, error) {
+	p := vi.Path
+	fi, err := os.Stat(p)
+	if err != nil {
+		return nil, err
+	}
+	if fi.IsDir() {
+		p = path.Join(p, "disk.img")
+	}
+
+	f, err := os.Open(p)
+	if err != nil {
+		return nil, err
+	}
+	// Block devices report a size of 0 when statted
+	size, err := f.Seek(0, io.SeekEnd)
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+
+	image, err := newVMDKImage(fileName, f, size)
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+	return &ovaDisk{name: name, fileName: fileName, capacity: size, image: image, file: f}, nil
+}
+
+func vmMemoryMiB(vm *virtv1.VirtualMachine) int64 {
+	domain := vm.Spec.Template.Spec.Domain
+	if domain.Memory != nil && domain.Memory.Guest != nil {
+		return domain.Memory.Guest.Value() / (1024 * 1024)
+	}
+	if memory, ok := domain.Resources.Requests["memory"]; ok {
+		return memory.Value() / (1024 * 1024)
+	}
+	if memory, ok := domain.Resources.Limits["memory"]; ok {
+		return memory.Value() / (1024 * 1024)
+	}
+	return 0
+}
+
+func vmCPUs(vm *virtv1.VirtualMachine) int64 {
+	if cpu := vm.Spec.Template.Spec.Domain.CPU; cpu != nil {
+		if vcpus := hardware.GetNumberOfVCPUs(cpu); vcpus > 0 {
+			return vcpus
+		}
+	}
+	return 1
+}
+
+// generateOVF describes the VM and its disks in an OVF descriptor
+func generateOVF(vm *virtv1.VirtualMachine, disks []*ovaDisk) ([]byte, error) {
+	envelope := ovfEnvelope{
+		Xmlns:     ovfEnvelopeNamespace,
+		XmlnsOvf:  ovfEnvelopeNamespace,
+		XmlnsRasd: ovfRasdNamespace,
+		XmlnsVssd: ovfVssdNamespace,
+		DiskSection: ovfDiskSection{
+			Info: "Virtual disk information",
+		},
+		VirtualSystem: ovfVirtualSystem{
+			ID:   vm.Name,
+			Info: "A virtual machine exported from KubeVirt",
+			Name: vm.Name,
+			OperatingSystemSection: ovfOperatingSystemSection{
+				ID:   1,
+				Info: "The kind of installed guest operating system",
+			},
+			VirtualHardwareSection: ovfVirtualHardwareSection{
+				Info: "Virtual hardware requirements",
+				System: ovfSystem{
+					ElementName:       "Virtual Hardware Family",
+					VirtualSystemType: ovfVirtualSystemType,
+				},
+			},
+		},
+	}
+
+	hw := &envelope.VirtualSystem.VirtualHardwareSection
+	addItem := func(item ovfItem) int {
+		item.InstanceID = len(hw.Items) + 1
+		hw.Items = append(hw.Items, item)
+		return item.InstanceID
+	}
+
+	cpus := vmCPUs(vm)
+	addItem(ovfItem{
+		AllocationUnits: "hertz * 10^6",
+		ElementName:     fmt.Sprintf("%d virtual CPU(s)", cpus),
+		ResourceType:    ovfResourceCPU,
+		VirtualQuantity: cpus,
+	})
+	memory := vmMemoryMiB(vm)
+	addItem(ovfItem{
+		AllocationUnits: "byte * 2^20",
+		ElementName:     fmt.Sprintf("%dMB of memory", memory),
+		ResourceType:    ovfResourceMemory,
+		VirtualQuantity: memory,
+	})
+
+	controller := 0
+	for i, disk := range disks {
+		fileID := fmt.Sprintf("file%d", i+1)
+		diskID := fmt.Sprintf("vmdisk%d", i+1)
+		envelope.References = append(envelope.References, ovfFile{
+			ID:   fileID,
+			Href: disk.fileName,
+			Size: disk.image.Size(),
+		})
+		envelope.DiskSection.Disks = append(envelope.DiskSection.Disks, ovfDisk{
+			DiskID:                  diskID,
+			FileRef:                 fileID,
+			Capacity:                disk.capacity,
+			CapacityAllocationUnits: "byte",
+			Format:                  ovfVMDKFormat,
+		})
+
+		address := i % ovfDisksPerSCSIController
+		if address == 0 {
+			controller = addItem(ovfItem{
+				ElementName:     fmt.Sprintf("SCSI Controller %d", i/ovfDisksPerSCSIController),
+				ResourceSubType: "lsilogic",
+				ResourceType:    ovfResourceSCSIController,
+			})
+		}
+		// Skip the target of the controller
+		if address >= 7 {
+			address++
+		}
+		addItem(ovfItem{
+			AddressOnParent: fmt.Sprintf("%d", address),
+			ElementName:     disk.name,
+			HostResource:    "ovf:/disk/" + diskID,
+			Parent:          controller,
+			ResourceType:    ovfResourceDisk,
+		})
+	}
+
+	networks := vm.Spec.Template.Spec.Networks
+	if len(networks) > 0 {
+		envelope.NetworkSection = &ovfNetworkSection{
+			Info: "The list of logical networks",
+		}
+		for _, network := range networks {
+			envelope.NetworkSection.Networks = append(envelope.NetworkSection.Networks, ovfNetwork{
+				Name:        network.Name,
+				Description: fmt.Sprintf("The %s network", network.Name),
+			})
+		}
+	}
+	for _, iface := range vm.Spec.Template.Spec.Domain.Devices.Interfaces {
+		addItem(ovfItem{
+			Address:             iface.MacAddress,
+			AutomaticAllocation: pointer.P(true),
+			Connection:          iface.Name,
+			ElementName:         iface.Name,
+			ResourceSubType:     "E1000",
+			ResourceType:        ovfResourceEthernet,
+		})
+	}
+
+	data, err := xml.MarshalIndent(envelope, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte(xml.Header), data...), nil
+}
+
+func writeTarFile(tw *tar.Writer, name string, size int64, content io.WriterTo) error {
+	header := &tar.Header{
+		Typeflag: tar.TypeReg,
+		Name:     name,
+		Mode:     0644,
+		Size:     size,
+		ModTime:  time.Now(),
+		Format:   tar.FormatUSTAR,
+	}
+	if err := tw.WriteHeader(header); err != nil {
+		return err
+	}
+	_, err := content.WriteTo(tw)
+	return err
+}
+
+// ovaHandler streams the VM as an OVA, a tar archive of an OVF descriptor followed by the disks of the VM
+// as stream-optimized VMDKs. This is the format VMware and VirtualBox import.
+func ovaHandler(vi []export.VolumeInfo) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodGet {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		vm := getExpandedVM()
+		if vm == nil {
+			log.Log.Error("error getting VM definition")
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		disks, err := getOVADisks(vm, vi)
+		if err != nil {
+			log.Log.Reason(err).Error("error reading the VM disks")
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		defer func() {
+			for _, d := range disks {
+				d.Close()
+			}
+		}()
+		descriptor, err := generateOVF(vm, disks)
+		if err != nil {
+			log.Log.Reason(err).Error("error generating OVF descriptor")
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/x-tar")
+		w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", vm.Name+".ova"))
+		tw := tar.NewWriter(w)
+		// The OVF descriptor has to be the first file of the archive
+		if err := writeTarFile(tw, vm.Name+".ovf", int64(len(descriptor)), bytes.NewReader(descriptor)); err != nil {
+			log.Log.Reason(err).Error("error writing OVF descriptor")
+			return
+		}
+		for _, d := range disks {
+			if err := writeTarFile(tw, d.fileName, d.image.Size(), d.image); err != nil {
+				log.Log.Reason(err).Errorf("error writing disk %s", d.name)
+				return
+			}
+		}
+		if err := tw.Close(); err != nil {
+			log.Log.Reason(err).Error("error closing OVA")
+			return
+		}
+		log.Log.Infof("Wrote OVA of VM %s with %d disks\n", vm.Name, len(disks))
+	})
+}
@@ -0,0 +1,411 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package virtexportserver
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/zlib"
+	"encoding/binary"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	virtv1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/storage/export/export"
+)
+
+// readVMDK reassembles the raw disk of a stream-optimized VMDK from its footer, grain directory and grain tables
+func readVMDK(data []byte) []byte {
+	header := &vmdkSparseExtentHeader{}
+	Expect(binary.Read(bytes.NewReader(data), binary.LittleEndian, header)).To(Succeed())
+	Expect(header.MagicNumber).To(Equal(uint32(vmdkMagic)))
+	Expect(header.GdOffset).To(Equal(vmdkGDAtEnd))
+
+	footer := &vmdkSparseExtentHeader{}
+	Expect(binary.Read(bytes.NewReader(data[len(data)-2*sectorSize:]), binary.LittleEndian, footer)).To(Succeed())
+	Expect(footer.MagicNumber).To(Equal(uint32(vmdkMagic)))
+	Expect(data[len(data)-sectorSize:]).To(Equal(make([]byte, sectorSize)), "end of stream marker")
+
+	raw := make([]byte, footer.Capacity*sectorSize)
+	numGrains := (footer.Capacity + vmdkGrainSectors - 1) / vmdkGrainSectors
+	numGTs := (numGrains + vmdkGTEsPerGT - 1) / vmdkGTEsPerGT
+	gd := make([]uint32, numGTs)
+	Expect(binary.Read(bytes.NewReader(data[footer.GdOffset*sectorSize:]), binary.LittleEndian, gd)).To(Succeed())
+	for gtIndex, gtOffset := range gd {
+		gt := make([]uint32, vmdkGTEsPerGT)
+		Expect(binary.Read(bytes.NewReader(data[uint64(gtOffset)*sectorSize:]), binary.LittleEndian, gt)).To(Succeed())
+		for i, grainOffset := range gt {
+			if grainOffset == 0 {
+				continue
+			}
+			grain := data[uint64(grainOffset)*sectorSize:]
+			lba := binary.LittleEndian.Uint64(grain)
+			size := binary.LittleEndian.Uint32(grain[8:])
+			Expect(lba).To(Equal(uint64(gtIndex*vmdkGTEsPerGT+i) * vmdkGrainSectors))
+
+			zr, err := zlib.NewReader(bytes.NewReader(grain[vmdkGrainMarkerSize : vmdkGrainMarkerSize+size]))
+			Expect(err).ToNot(HaveOccurred())
+			inflated, err := io.ReadAll(zr)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(inflated).To(HaveLen(vmdkGrainSize))
+			copy(raw[lba*sectorSize:], inflated)
+		}
+	}
+	return raw
+}
+
+// xmlNode decodes any XML element, keeping the namespaces of its name and attributes
+type xmlNode struct {
+	XMLName xml.Name
+	Attrs   []xml.Attr `xml:",any,attr"`
+	Nodes   []xmlNode  `xml:",any"`
+	Content string     `xml:",chardata"`
+}
+
+func (n xmlNode) children(space, local string) []xmlNode {
+	var result []xmlNode
+	for _, child := range n.Nodes {
+		if child.XMLName.Space == space && child.XMLName.Local == local {
+			result = append(result, child)
+		}
+	}
+	return result
+}
+
+func (n xmlNode) child(space, local string) xmlNode {
+	children := n.children(space, local)
+	ExpectWithOffset(1, children).To(HaveLen(1), "expected one %s in %s", local, n.XMLName.Local)
+	return children[0]
+}
+
+func (n xmlNode) ovfAttr(local string) string {
+	for _, attr := range n.Attrs {
+		if attr.Name.Space == ovfEnvelopeNamespace && attr.Name.Local == local {
+			return attr.Value
+		}
+	}
+	return ""
+}
+
+// hardwareItems returns the resource allocation settings of the virtual hardware items, in order
+func hardwareItems(envelope xmlNode) []map[string]string {
+	var items []map[string]string
+	hardware := envelope.child(ovfEnvelopeNamespace, "VirtualSystem").child(ovfEnvelopeNamespace, "VirtualHardwareSection")
+	for _, item := range hardware.children(ovfEnvelopeNamespace, "Item") {
+		settings := map[string]string{}
+		for _, setting := range item.Nodes {
+			Expect(setting.XMLName.Space).To(Equal(ovfRasdNamespace))
+			settings[setting.XMLName.Local] = setting.Content
+		}
+		items = append(items, settings)
+	}
+	return items
+}
+
+func itemsOfType(items []map[string]string, resourceType int) []map[string]string {
+	var result []map[string]string
+	for _, item := range items {
+		if item["ResourceType"] == fmt.Sprint(resourceType) {
+			result = append(result, item)
+		}
+	}
+	return result
+}
+
+func newRawDisk(size int) []byte {
+	disk := make([]byte, size)
+	// Some data in the first grain and at the very end of the disk
+	copy(disk, "bootloader")
+	copy(disk[size-4:], "last")
+	return disk
+}
+
+var _ = Describe("OVA", func() {
+	Context("VMDK", func() {
+		DescribeTable("should convert a raw disk", func(size int, allocatedGrains int) {
+			disk := newRawDisk(size)
+
+			img, err := newVMDKImage("disk1.vmdk", bytes.NewReader(disk), int64(size))
+			Expect(err).ToNot(HaveOccurred())
+
+			var out bytes.Buffer
+			n, err := img.WriteTo(&out)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(n).To(Equal(img.Size()))
+			Expect(out.Len()).To(BeEquivalentTo(img.Size()))
+			Expect(img.Size() % sectorSize).To(BeZero())
+
+			allocated := 0
+			for _, a := range img.allocated {
+				if a {
+					allocated++
+				}
+			}
+			Expect(allocated).To(Equal(allocatedGrains))
+
+			Expect(bytes.Contains(out.Bytes(), []byte(`createType="streamOptimized"`))).To(BeTrue())
+			raw := readVMDK(out.Bytes())
+			Expect(raw[:size]).To(Equal(disk))
+			Expect(raw[size:]).To(Equal(make([]byte, len(raw)-size)))
+		},
+			Entry("of a single grain", vmdkGrainSize, 1),
+			Entry("spanning several grain tables", 3*vmdkGTEsPerGT*vmdkGrainSize, 2),
+			Entry("which does not end at a grain boundary", 10*vmdkGrainSize+3*sectorSize, 2),
+		)
+
+		It("should only store the grains containing data", func() {
+			const size = 100 * vmdkGrainSize
+			sparse, err := newVMDKImage("sparse.vmdk", bytes.NewReader(make([]byte, size)), size)
+			Expect(err).ToNot(HaveOccurred())
+			full, err := newVMDKImage("full.vmdk", bytes.NewReader(bytes.Repeat([]byte{1}, size)), size)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(full.Size() - sparse.Size()).To(BeEquivalentTo(100 * grainSectors() * sectorSize))
+		})
+	})
+
+	Context("OVF descriptor", func() {
+		It("should describe the VM hardware and disks", func() {
+			vm := newOVAVM()
+			disks := []*ovaDisk{{
+				name:     "rootdisk",
+				fileName: "disk1.vmdk",
+				capacity: vmdkGrainSize,
+				image:    &vmdkImage{capacity: vmdkGrainSectors, allocated: []bool{true}},
+			}}
+
+			data, err := generateOVF(vm, disks)
+			Expect(err).ToNot(HaveOccurred())
+
+			envelope := xmlNode{}
+			Expect(xml.Unmarshal(data, &envelope)).To(Succeed())
+			Expect(envelope.XMLName.Space).To(Equal(ovfEnvelopeNamespace))
+
+			file := envelope.child(ovfEnvelopeNamespace, "References").child(ovfEnvelopeNamespace, "File")
+			Expect(file.ovfAttr("id")).To(Equal("file1"))
+			Expect(file.ovfAttr("href")).To(Equal("disk1.vmdk"))
+			Expect(file.ovfAttr("size")).To(Equal(fmt.Sprint(disks[0].image.Size())))
+			disk := envelope.child(ovfEnvelopeNamespace, "DiskSection").child(ovfEnvelopeNamespace, "Disk")
+			Expect(disk.ovfAttr("diskId")).To(Equal("vmdisk1"))
+			Expect(disk.ovfAttr("fileRef")).To(Equal("file1"))
+			Expect(disk.ovfAttr("capacity")).To(Equal(fmt.Sprint(vmdkGrainSize)))
+			Expect(disk.ovfAttr("format")).To(Equal(ovfVMDKFormat))
+			network := envelope.child(ovfEnvelopeNamespace, "NetworkSection").child(ovfEnvelopeNamespace, "Network")
+			Expect(network.ovfAttr("name")).To(Equal("default"))
+			Expect(envelope.child(ovfEnvelopeNamespace, "VirtualSystem").child(ovfEnvelopeNamespace, "Name").Content).To(Equal("testvm"))
+
+			items := hardwareItems(envelope)
+			Expect(itemsOfType(items, ovfResourceCPU)).To(ConsistOf(HaveKeyWithValue("VirtualQuantity", "4")))
+			Expect(itemsOfType(items, ovfResourceMemory)).To(ConsistOf(HaveKeyWithValue("VirtualQuantity", "2048")))
+			controllers := itemsOfType(items, ovfResourceSCSIController)
+			Expect(controllers).To(HaveLen(1))
+			Expect(itemsOfType(items, ovfResourceDisk)).To(ConsistOf(And(
+				HaveKeyWithValue("HostResource", "ovf:/disk/vmdisk1"),
+				HaveKeyWithValue("Parent", controllers[0]["InstanceID"]),
+			)))
+			Expect(itemsOfType(items, ovfResourceEthernet)).To(ConsistOf(And(
+				HaveKeyWithValue("Address", "02:00:00:00:00:01"),
+				HaveKeyWithValue("Connection", "default"),
+			)))
+		})
+
+		It("should add a SCSI controller for every 15 disks", func() {
+			var disks []*ovaDisk
+			for range 16 {
+				disks = append(disks, &ovaDisk{image: &vmdkImage{capacity: vmdkGrainSectors, allocated: []bool{false}}})
+			}
+
+			data, err := generateOVF(newOVAVM(), disks)
+			Expect(err).ToNot(HaveOccurred())
+
+			envelope := xmlNode{}
+			Expect(xml.Unmarshal(data, &envelope)).To(Succeed())
+			items := hardwareItems(envelope)
+			var addresses []string
+			for _, item := range itemsOfType(items, ovfResourceDisk) {
+				addresses = append(addresses, item["AddressOnParent"])
+			}
+			controllers := itemsOfType(items, ovfResourceSCSIController)
+			Expect(addresses).To(HaveLen(16))
+			Expect(controllers).To(HaveLen(2))
+			Expect(addresses).ToNot(ContainElement("7"))
+			Expect(addresses[14]).To(Equal("15"))
+			Expect(addresses[15]).To(Equal("0"))
+		})
+	})
+
+	Context("handler", func() {
+		var (
+			orgGetExpandedVM  = getExpandedVM
+			orgGetExportName  = getExportName
+			volumes           []export.VolumeInfo
+			disk              []byte
+			expectedDiskBytes int64
+		)
+
+		BeforeEach(func() {
+			dir := GinkgoT().TempDir()
+			disk = newRawDisk(4 * vmdkGrainSize)
+			Expect(os.WriteFile(filepath.Join(dir, "disk.img"), disk, 0644)).To(Succeed())
+			img, err := newVMDKImage("disk1.vmdk", bytes.NewReader(disk), int64(len(disk)))
+			Expect(err).ToNot(HaveOccurred())
+			expectedDiskBytes = img.Size()
+
+			volumes = []export.VolumeInfo{{Path: dir, RawURI: "/volumes/test-vm-export-rootdisk-pvc/disk.img"}}
+			getExpandedVM = newOVAVM
+			getExportName = func() (string, error) {
+				return "test-vm-export", nil
+			}
+		})
+
+		AfterEach(func() {
+			getExpandedVM = orgGetExpandedVM
+			getExportName = orgGetExportName
+		})
+
+		It("should stream the OVF descriptor followed by the disks", func() {
+			rr := httptest.NewRecorder()
+			req, err := http.NewRequest(http.MethodGet, "https://test.blah.invalid/ova", nil)
+			Expect(err).ToNot(HaveOccurred())
+			ovaHandler(volumes).ServeHTTP(rr, req)
+			Expect(rr.Code).To(Equal(http.StatusOK))
+			Expect(rr.Header().Get("Content-Disposition")).To(Equal(`attachment; filename="testvm.ova"`))
+
+			tr := tar.NewReader(rr.Body)
+			hdr, err := tr.Next()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(hdr.Name).To(Equal("testvm.ovf"))
+			descriptor, err := io.ReadAll(tr)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(descriptor)).To(ContainSubstring(`ovf:href="disk1.vmdk"`))
+
+			hdr, err = tr.Next()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(hdr.Name).To(Equal("disk1.vmdk"))
+			Expect(hdr.Size).To(Equal(expectedDiskBytes))
+			vmdk, err := io.ReadAll(tr)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(readVMDK(vmdk)).To(Equal(disk))
+
+			_, err = tr.Next()
+			Expect(err).To(MatchError(io.EOF))
+		})
+
+		It("should return 500 if getExpandedVM returns nil", func() {
+			getExpandedVM = func() *virtv1.VirtualMachine {
+				return nil
+			}
+			rr := httptest.NewRecorder()
+			req, err := http.NewRequest(http.MethodGet, "https://test.blah.invalid/ova", nil)
+			Expect(err).ToNot(HaveOccurred())
+			ovaHandler(volumes).ServeHTTP(rr, req)
+			Expect(rr.Code).To(Equal(http.StatusInternalServerError))
+		})
+
+		DescribeTable("should return error on non GET", func(verb string) {
+			rr := httptest.NewRecorder()
+			req, err := http.NewRequest(verb, "https://test.blah.invalid/ova", nil)
+			Expect(err).ToNot(HaveOccurred())
+			ovaHandler(volumes).ServeHTTP(rr, req)
+			Expect(rr.Code).To(Equal(http.StatusBadRequest))
+		},
+			Entry("POST", http.MethodPost),
+			Entry("PUT", http.MethodPut),
+			Entry("DELETE", http.MethodDelete),
+		)
+
+		It("should be served on the internal and external paths", func() {
+			token := "foo"
+			es := newTestServer(token)
+			es.Paths = &export.ServerPaths{OVAURI: "/ova"}
+			es.initHandler()
+
+			httpServer := httptest.NewServer(es.handler)
+			defer httpServer.Close()
+
+			for _, uri := range []string{"/internal/ova", "/external/ova"} {
+				req, err := http.NewRequest(http.MethodGet, httpServer.URL+uri, nil)
+				Expect(err).ToNot(HaveOccurred())
+				req.Header.Set("x-kubevirt-export-token", token)
+				res, err := http.DefaultClient.Do(req)
+				Expect(err).ToNot(HaveOccurred())
+				res.Body.Close()
+				Expect(res.StatusCode).To(Equal(http.StatusOK))
+			}
+		})
+	})
+})
+
+func newOVAVM() *virtv1.VirtualMachine {
+	return &virtv1.VirtualMachine{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "testvm",
+			Namespace: testNamespace,
+		},
+		Spec: virtv1.VirtualMachineSpec{
+			Template: &virtv1.VirtualMachineInstanceTemplateSpec{
+				Spec: virtv1.VirtualMachineInstanceSpec{
+					Domain: virtv1.DomainSpec{
+						CPU: &virtv1.CPU{Sockets: 2, Cores: 2},
+						Memory: &virtv1.Memory{
+							Guest: pointer.P(resource.MustParse("2Gi")),
+						},
+						Devices: virtv1.Devices{
+							Disks: []virtv1.Disk{
+								{Name: "rootdisk"},
+								{Name: "cloudinit"},
+							},
+							Interfaces: []virtv1.Interface{
+								{Name: "default", MacAddress: "02:00:00:00:00:01"},
+							},
+						},
+					},
+					Networks: []virtv1.Network{*virtv1.DefaultPodNetwork()},
+					Volumes: []virtv1.Volume{
+						{
+							Name: "rootdisk",
+							VolumeSource: virtv1.VolumeSource{
+								DataVolume: &virtv1.DataVolumeSource{Name: "rootdisk-pvc"},
+							},
+						},
+						{
+							Name: "cloudinit",
+							VolumeSource: virtv1.VolumeSource{
+								CloudInitNoCloud: &virtv1.CloudInitNoCloudSource{UserData: "#cloud-config"},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
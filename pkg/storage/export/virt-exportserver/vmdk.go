@@ -0,0 +1,359 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package virtexportserver
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/adler32"
+	"hash/crc32"
+	"io"
+)
+
+// The stream-optimized VMDK layout is described in the VMware Virtual Disk Format 5.0 specification.
+const (
+	sectorSize = 512
+
+	vmdkMagic          = 0x564d444b // "KDMV"
+	vmdkVersion        = 3
+	vmdkGrainSectors   = 128
+	vmdkGrainSize      = vmdkGrainSectors * sectorSize
+	vmdkGTEsPerGT      = 512
+	vmdkGTSectors      = vmdkGTEsPerGT * 4 / sectorSize
+	vmdkGDAtEnd        = ^uint64(0)
+	vmdkDeflate        = 1
+	vmdkMaxCylinders   = 65535
+	vmdkHeads          = 255
+	vmdkSectorsPerHead = 63
+
+	vmdkFlagValidNewLineDetection = 1 << 0
+	vmdkFlagCompressed            = 1 << 16
+	vmdkFlagMarkers               = 1 << 17
+
+	vmdkMarkerEOS    = 0
+	vmdkMarkerGT     = 1
+	vmdkMarkerGD     = 2
+	vmdkMarkerFooter = 3
+
+	// Grain markers hold the LBA and the size of the compressed grain
+	vmdkGrainMarkerSize = 12
+
+	// Stored deflate blocks hold at most 64KiB-1 bytes, each block has a 5 bytes header
+	deflateMaxStoredBlock   = 65535
+	deflateStoredBlockExtra = 5
+	zlibHeaderSize          = 2
+	zlibTrailerSize         = 4
+)
+
+type vmdkSparseExtentHeader struct {
+	MagicNumber        uint32
+	Version            uint32
+	Flags              uint32
+	Capacity           uint64
+	GrainSize          uint64
+	DescriptorOffset   uint64
+	DescriptorSize     uint64
+	NumGTEsPerGT       uint32
+	RgdOffset          uint64
+	GdOffset           uint64
+	OverHead           uint64
+	UncleanShutdown    uint8
+	SingleEndLineChar  byte
+	NonEndLineChar     byte
+	DoubleEndLineChar1 byte
+	DoubleEndLineChar2 byte
+	CompressAlgorithm  uint16
+	Pad                [433]byte
+}
+
+type vmdkMarker struct {
+	Value uint64
+	Size  uint32
+	Type  uint32
+	Pad   [496]byte
+}
+
+// vmdkImage converts a raw disk into a stream-optimized VMDK. The size of the image has to be known before it is
+// written, because it is streamed into a tar archive. Grains are therefore stored in uncompressed deflate blocks,
+// and only the grains which are not zero are written.
+type vmdkImage struct {
+	src        io.ReaderAt
+	capacity   uint64 // in sectors
+	allocated  []bool
+	descriptor []byte
+}
+
+func newVMDKImage(fileName string, src io.ReaderAt, size int64) (*vmdkImage, error) {
+	img := &vmdkImage{
+		src:      src,
+		capacity: uint64((size + sectorSize - 1) / sectorSize),
+	}
+	img.descriptor = vmdkDescriptor(fileName, img.capacity)
+
+	buf := make([]byte, vmdkGrainSize)
+	zero := make([]byte, vmdkGrainSize)
+	img.allocated = make([]bool, img.numGrains())
+	for i := range img.allocated {
+		if err := img.readGrain(i, buf); err != nil {
+			return nil, err
+		}
+		img.allocated[i] = !bytes.Equal(buf, zero)
+	}
+	return img, nil
+}
+
+func vmdkDescriptor(fileName string, capacity uint64) []byte {
+	cylinders := capacity / (vmdkHeads * vmdkSectorsPerHead)
+	if cylinders > vmdkMaxCylinders {
+		cylinders = vmdkMaxCylinders
+	}
+
+	var descriptor bytes.Buffer
+	fmt.Fprintf(&descriptor, "# Disk DescriptorFile\n")
+	fmt.Fprintf(&descriptor, "version=1\n")
+	fmt.Fprintf(&descriptor, "CID=%08x\n", crc32.ChecksumIEEE([]byte(fileName)))
+	fmt.Fprintf(&descriptor, "parentCID=ffffffff\n")
+	fmt.Fprintf(&descriptor, "createType=\"streamOptimized\"\n\n")
+	fmt.Fprintf(&descriptor, "# Extent description\n")
+	fmt.Fprintf(&descriptor, "RW %d SPARSE %q\n\n", capacity, fileName)
+	fmt.Fprintf(&descriptor, "# The Disk Data Base\n")
+	fmt.Fprintf(&descriptor, "#DDB\n\n")
+	fmt.Fprintf(&descriptor, "ddb.virtualHWVersion = \"4\"\n")
+	fmt.Fprintf(&descriptor, "ddb.geometry.cylinders = \"%d\"\n", cylinders)
+	fmt.Fprintf(&descriptor, "ddb.geometry.heads = \"%d\"\n", vmdkHeads)
+	fmt.Fprintf(&descriptor, "ddb.geometry.sectors = \"%d\"\n", vmdkSectorsPerHead)
+	fmt.Fprintf(&descriptor, "ddb.adapterType = \"lsilogic\"\n")
+	return descriptor.Bytes()
+}
+
+func sectorsFor(size uint64) uint64 {
+	return (size + sectorSize - 1) / sectorSize
+}
+
+func (img *vmdkImage) numGrains() int {
+	return int((img.capacity + vmdkGrainSectors - 1) / vmdkGrainSectors)
+}
+
+func (img *vmdkImage) numGTs() int {
+	return (img.numGrains() + vmdkGTEsPerGT - 1) / vmdkGTEsPerGT
+}
+
+func (img *vmdkImage) descriptorSectors() uint64 {
+	return sectorsFor(uint64(len(img.descriptor)))
+}
+
+func (img *vmdkImage) gdSectors() uint64 {
+	return sectorsFor(uint64(img.numGTs() * 4))
+}
+
+func storedDeflateSize(size int) int {
+	blocks := (size + deflateMaxStoredBlock - 1) / deflateMaxStoredBlock
+	return zlibHeaderSize + blocks*deflateStoredBlockExtra + size + zlibTrailerSize
+}
+
+func grainSectors() uint64 {
+	return sectorsFor(vmdkGrainMarkerSize + uint64(storedDeflateSize(vmdkGrainSize)))
+}
+
+// Size returns the size of the VMDK in bytes
+func (img *vmdkImage) Size() int64 {
+	allocated := uint64(0)
+	for _, a := range img.allocated {
+		if a {
+			allocated++
+		}
+	}
+
+	sectors := 1 + img.descriptorSectors()
+	sectors += allocated * grainSectors()
+	sectors += uint64(img.numGTs()) * (1 + vmdkGTSectors)
+	sectors += 1 + img.gdSectors()
+	// footer marker, footer and end of stream marker
+	sectors += 3
+	return int64(sectors * sectorSize)
+}
+
+func (img *vmdkImage) readGrain(index int, buf []byte) error {
+	n, err := img.src.ReadAt(buf, int64(index)*vmdkGrainSize)
+	if err != nil && !errors.Is(err, io.EOF) {
+		return err
+	}
+	// The last grain of a disk may be partial
+	clear(buf[n:])
+	return nil
+}
+
+func (img *vmdkImage) header(gdOffset uint64) *vmdkSparseExtentHeader {
+	return &vmdkSparseExtentHeader{
+		MagicNumber:        vmdkMagic,
+		Version:            vmdkVersion,
+		Flags:              vmdkFlagValidNewLineDetection | vmdkFlagCompressed | vmdkFlagMarkers,
+		Capacity:           img.capacity,
+		GrainSize:          vmdkGrainSectors,
+		DescriptorOffset:   1,
+		DescriptorSize:     img.descriptorSectors(),
+		NumGTEsPerGT:       vmdkGTEsPerGT,
+		GdOffset:           gdOffset,
+		OverHead:           1 + img.descriptorSectors(),
+		SingleEndLineChar:  '\n',
+		NonEndLineChar:     ' ',
+		DoubleEndLineChar1: '\r',
+		DoubleEndLineChar2: '\n',
+		CompressAlgorithm:  vmdkDeflate,
+	}
+}
+
+// vmdkWriter keeps track of the sector the VMDK is written at
+type vmdkWriter struct {
+	w       *bufio.Writer
+	written int64
+}
+
+func (vw *vmdkWriter) Write(p []byte) (int, error) {
+	n, err := vw.w.Write(p)
+	vw.written += int64(n)
+	return n, err
+}
+
+func (vw *vmdkWriter) sector() uint64 {
+	return uint64(vw.written / sectorSize)
+}
+
+func (vw *vmdkWriter) padToSector() error {
+	if rest := vw.written % sectorSize; rest != 0 {
+		_, err := vw.Write(make([]byte, sectorSize-rest))
+		return err
+	}
+	return nil
+}
+
+func (vw *vmdkWriter) writeStruct(data interface{}) error {
+	return binary.Write(vw, binary.LittleEndian, data)
+}
+
+func (vw *vmdkWriter) writeMarker(value uint64, markerType uint32) error {
+	return vw.writeStruct(&vmdkMarker{Value: value, Type: markerType})
+}
+
+// writeGrain writes the grain marker followed by the grain wrapped into a zlib stream of stored deflate blocks
+func (vw *vmdkWriter) writeGrain(lba uint64, grain []byte) error {
+	var marker [vmdkGrainMarkerSize]byte
+	binary.LittleEndian.PutUint64(marker[0:], lba)
+	binary.LittleEndian.PutUint32(marker[8:], uint32(storedDeflateSize(len(grain))))
+	if _, err := vw.Write(marker[:]); err != nil {
+		return err
+	}
+
+	// CMF for deflate with a 32KiB window and FLG for the fastest compression level
+	if _, err := vw.Write([]byte{0x78, 0x01}); err != nil {
+		return err
+	}
+	for offset := 0; offset < len(grain); offset += deflateMaxStoredBlock {
+		end := min(offset+deflateMaxStoredBlock, len(grain))
+		var blockHeader [deflateStoredBlockExtra]byte
+		if end == len(grain) {
+			blockHeader[0] = 1
+		}
+		binary.LittleEndian.PutUint16(blockHeader[1:], uint16(end-offset))
+		binary.LittleEndian.PutUint16(blockHeader[3:], ^uint16(end-offset))
+		if _, err := vw.Write(blockHeader[:]); err != nil {
+			return err
+		}
+		if _, err := vw.Write(grain[offset:end]); err != nil {
+			return err
+		}
+	}
+	var trailer [zlibTrailerSize]byte
+	binary.BigEndian.PutUint32(trailer[:], adler32.Checksum(grain))
+	if _, err := vw.Write(trailer[:]); err != nil {
+		return err
+	}
+	return vw.padToSector()
+}
+
+// WriteTo writes the VMDK, it has exactly the length returned by Size
+func (img *vmdkImage) WriteTo(w io.Writer) (int64, error) {
+	vw := &vmdkWriter{w: bufio.NewWriterSize(w, vmdkGrainSize*2)}
+	if err := img.write(vw); err != nil {
+		return vw.written, err
+	}
+	return vw.written, vw.w.Flush()
+}
+
+func (img *vmdkImage) write(vw *vmdkWriter) error {
+	if err := vw.writeStruct(img.header(vmdkGDAtEnd)); err != nil {
+		return err
+	}
+	if _, err := vw.Write(img.descriptor); err != nil {
+		return err
+	}
+	if err := vw.padToSector(); err != nil {
+		return err
+	}
+
+	grain := make([]byte, vmdkGrainSize)
+	gd := make([]uint32, img.numGTs())
+	for gtIndex := range gd {
+		gt := make([]uint32, vmdkGTEsPerGT)
+		for i := range gt {
+			grainIndex := gtIndex*vmdkGTEsPerGT + i
+			if grainIndex >= len(img.allocated) || !img.allocated[grainIndex] {
+				continue
+			}
+			if err := img.readGrain(grainIndex, grain); err != nil {
+				return err
+			}
+			gt[i] = uint32(vw.sector())
+			if err := vw.writeGrain(uint64(grainIndex)*vmdkGrainSectors, grain); err != nil {
+				return err
+			}
+		}
+
+		if err := vw.writeMarker(vmdkGTSectors, vmdkMarkerGT); err != nil {
+			return err
+		}
+		gd[gtIndex] = uint32(vw.sector())
+		if err := vw.writeStruct(gt); err != nil {
+			return err
+		}
+	}
+
+	if err := vw.writeMarker(img.gdSectors(), vmdkMarkerGD); err != nil {
+		return err
+	}
+	gdOffset := vw.sector()
+	if err := vw.writeStruct(gd); err != nil {
+		return err
+	}
+	if err := vw.padToSector(); err != nil {
+		return err
+	}
+
+	if err := vw.writeMarker(1, vmdkMarkerFooter); err != nil {
+		return err
+	}
+	if err := vw.writeStruct(img.header(gdOffset)); err != nil {
+		return err
+	}
+	return vw.writeMarker(0, vmdkMarkerEOS)
+}
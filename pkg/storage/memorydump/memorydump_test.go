@@ -26,6 +26,7 @@ import (
 	. "github.com/onsi/gomega"
 
 	k8score "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	k8sfake "k8s.io/client-go/kubernetes/fake"
 	"k8s.io/client-go/tools/cache"
@@ -165,6 +166,18 @@ var _ = Describe("MemoryDump", func() {
 		})
 	})
 
+	It("should pass the compression and the chunk size of the request to the memory dump volume", func() {
+		spec := applyMemoryDumpVolumeRequestOnVMISpec(&v1.VirtualMachineInstanceSpec{}, &v1.VirtualMachineMemoryDumpRequest{
+			ClaimName:   testPVCName,
+			Compression: v1.MemoryDumpCompressionZstd,
+			ChunkSize:   pointer.P(resource.MustParse("16Mi")),
+		})
+		Expect(spec.Volumes).To(HaveLen(1))
+		Expect(spec.Volumes[0].MemoryDump.ClaimName).To(Equal(testPVCName))
+		Expect(spec.Volumes[0].MemoryDump.Compression).To(Equal(v1.MemoryDumpCompressionZstd))
+		Expect(spec.Volumes[0].MemoryDump.ChunkSize.String()).To(Equal("16Mi"))
+	})
+
 	DescribeTable("should remove memory dump volume from vmi volumes and update pvc annotation", func(phase v1.MemoryDumpPhase, expectedAnnotation string) {
 		vm, vmi := createVirtualMachineWithMemoryDump(phase)
 
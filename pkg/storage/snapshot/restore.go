@@ -860,6 +860,9 @@ func (t *vmRestoreTarget) reconcileSpec(restoredVM *kubevirtv1.VirtualMachine) (
 	}
 
 	if !t.Exists() {
+		if policy := t.vmRestore.Spec.TargetIdentityPolicy; policy != nil && *policy == snapshotv1.TargetIdentityPolicyRegenerate {
+			regenerateVMIdentity(restoredVM, t.vmRestore.Spec.NewMacAddresses)
+		}
 		restoredVM, err = patchVM(restoredVM, t.vmRestore.Spec.Patches)
 		if err != nil {
 			return false, fmt.Errorf("error patching VM %s: %v", restoredVM.Name, err)
@@ -1244,6 +1247,20 @@ func (ctrl *VMRestoreController) getVM(namespace, name string) (vm *kubevirtv1.V
 	return obj.(*kubevirtv1.VirtualMachine).DeepCopy(), nil
 }
 
+// regenerateVMIdentity clears the MAC addresses, the firmware UUID and the SMBios serial of a VM created from a snapshot,
+// so that it does not conflict with the snapshotted VM. Interfaces listed in newMacAddresses get the given address.
+func regenerateVMIdentity(vm *kubevirtv1.VirtualMachine, newMacAddresses map[string]string) {
+	interfaces := vm.Spec.Template.Spec.Domain.Devices.Interfaces
+	for i := range interfaces {
+		interfaces[i].MacAddress = newMacAddresses[interfaces[i].Name]
+	}
+
+	if firmware := vm.Spec.Template.Spec.Domain.Firmware; firmware != nil {
+		firmware.UUID = ""
+		firmware.Serial = ""
+	}
+}
+
 func patchVM(vm *kubevirtv1.VirtualMachine, patches []string) (*kubevirtv1.VirtualMachine, error) {
 	if len(patches) == 0 {
 		return vm, nil
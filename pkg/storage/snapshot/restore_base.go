@@ -38,6 +38,7 @@ import (
 	cdiv1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
 
 	"kubevirt.io/kubevirt/pkg/storage/status"
+	"kubevirt.io/kubevirt/pkg/virt-controller/watch/circuitbreaker"
 	watchutil "kubevirt.io/kubevirt/pkg/virt-controller/watch/util"
 )
 
@@ -59,6 +60,8 @@ type VMRestoreController struct {
 
 	Recorder record.EventRecorder
 
+	CircuitBreaker *circuitbreaker.CircuitBreaker
+
 	vmRestoreQueue workqueue.TypedRateLimitingInterface[string]
 
 	VMRestoreStatusUpdater *status.VMRestoreStatusUpdater
@@ -153,7 +156,7 @@ func (ctrl *VMRestoreController) vmRestoreWorker() {
 }
 
 func (ctrl *VMRestoreController) processVMRestoreWorkItem() bool {
-	return watchutil.ProcessWorkItem(ctrl.vmRestoreQueue, func(key string) (time.Duration, error) {
+	return watchutil.ProcessWorkItemWithBreaker(ctrl.vmRestoreQueue, ctrl.CircuitBreaker, func(key string) (time.Duration, error) {
 		log.Log.V(3).Infof("vmRestore worker processing key [%s]", key)
 
 		storeObj, exists, err := ctrl.VMRestoreInformer.GetStore().GetByKey(key)
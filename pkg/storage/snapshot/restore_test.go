@@ -1407,6 +1407,27 @@ var _ = Describe("Restore controller", func() {
 						Expect(err).ShouldNot(HaveOccurred())
 					})
 
+					It("with changed name and regenerated identity", func() {
+						r.Spec.Patches = []string{changeNamePatch}
+						r.Spec.TargetIdentityPolicy = pointer.P(snapshotv1.TargetIdentityPolicyRegenerate)
+						r.Spec.NewMacAddresses = map[string]string{"fake-interface": newMacAddress}
+
+						newVM := createVirtualMachine(testNamespace, newVmName)
+						newVM.UID = ""
+						newVM.Spec.DataVolumeTemplates[0].Name = restoreDVName(r, r.Status.Restores[0].VolumeName)
+						newVM.Spec.Template.Spec.Volumes[0].DataVolume.Name = restoreDVName(r, r.Status.Restores[0].VolumeName)
+						newVM.Annotations = map[string]string{lastRestoreAnnotation: "restore-uid"}
+						newVM.Spec.Template.Spec.Domain.Devices.Interfaces[0].MacAddress = newMacAddress
+
+						vmInterface.EXPECT().Create(context.Background(), newVM, metav1.CreateOptions{}).Return(newVM, nil).Times(1)
+
+						targetVM, err := controller.getTarget(r)
+						Expect(err).ShouldNot(HaveOccurred())
+						success, err := targetVM.Reconcile()
+						Expect(success).To(BeTrue())
+						Expect(err).ShouldNot(HaveOccurred())
+					})
+
 				})
 
 				It("should update condition if deleted and failed to restore", func() {
@@ -2026,6 +2047,28 @@ var _ = Describe("Restore controller", func() {
 			})
 		})
 	})
+
+	Context("regenerateVMIdentity", func() {
+		It("should clear the MAC addresses and the firmware identifiers", func() {
+			vm := createVirtualMachine(testNamespace, vmName)
+			vm.Spec.Template.Spec.Domain.Devices.Interfaces = []kubevirtv1.Interface{
+				{Name: "default", MacAddress: "00:00:5e:00:53:01"},
+				{Name: "secondary", MacAddress: "00:00:5e:00:53:02"},
+			}
+			vm.Spec.Template.Spec.Domain.Firmware = &kubevirtv1.Firmware{
+				UUID:   "2f0a5c2e-7d56-4a5e-a0a0-5c6b3b6d8a4f",
+				Serial: "serial",
+			}
+
+			regenerateVMIdentity(vm, map[string]string{"secondary": "00:00:5e:00:53:03"})
+
+			interfaces := vm.Spec.Template.Spec.Domain.Devices.Interfaces
+			Expect(interfaces[0].MacAddress).To(BeEmpty())
+			Expect(interfaces[1].MacAddress).To(Equal("00:00:5e:00:53:03"))
+			Expect(vm.Spec.Template.Spec.Domain.Firmware.UUID).To(BeEmpty())
+			Expect(vm.Spec.Template.Spec.Domain.Firmware.Serial).To(BeEmpty())
+		})
+	})
 })
 
 func expectPVCCreates(client *k8sfake.Clientset, vmRestore *snapshotv1.VirtualMachineRestore, expectedSize resource.Quantity) *int {
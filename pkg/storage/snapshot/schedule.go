@@ -0,0 +1,518 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package snapshot
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/openshift/library-go/pkg/build/naming"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
+
+	"kubevirt.io/api/core"
+	kubevirtv1 "kubevirt.io/api/core/v1"
+	snapshotv1 "kubevirt.io/api/snapshot/v1beta1"
+	"kubevirt.io/client-go/kubecli"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/controller"
+	metrics "kubevirt.io/kubevirt/pkg/monitoring/metrics/virt-controller"
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/util/cron"
+	watchutil "kubevirt.io/kubevirt/pkg/virt-controller/watch/util"
+)
+
+const (
+	// scheduleTimeAnnotation records the due time of the run which created a scheduled snapshot
+	scheduleTimeAnnotation = "snapshot.kubevirt.io/schedule-time"
+
+	scheduledSnapshotCreatedEvent = "ScheduledSnapshotCreated"
+	scheduledSnapshotDeletedEvent = "ScheduledSnapshotDeleted"
+	scheduledSnapshotSkippedEvent = "ScheduledSnapshotSkipped"
+)
+
+// VMSnapshotScheduleController creates VirtualMachineSnapshots on the schedule of
+// VirtualMachineSnapshotSchedules and deletes the snapshots exceeding their retention count
+type VMSnapshotScheduleController struct {
+	Client kubecli.KubevirtClient
+
+	VMSnapshotScheduleInformer cache.SharedIndexInformer
+	VMSnapshotInformer         cache.SharedIndexInformer
+	VMInformer                 cache.SharedIndexInformer
+	VMIInformer                cache.SharedIndexInformer
+
+	Recorder record.EventRecorder
+
+	vmSnapshotScheduleQueue workqueue.TypedRateLimitingInterface[string]
+}
+
+// Init initializes the snapshot schedule controller
+func (ctrl *VMSnapshotScheduleController) Init() error {
+	ctrl.vmSnapshotScheduleQueue = workqueue.NewTypedRateLimitingQueueWithConfig[string](
+		workqueue.DefaultTypedControllerRateLimiter[string](),
+		workqueue.TypedRateLimitingQueueConfig[string]{Name: "virt-controller-snapshot-schedule"},
+	)
+
+	_, err := ctrl.VMSnapshotScheduleInformer.AddEventHandler(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc:    ctrl.handleVMSnapshotSchedule,
+			UpdateFunc: func(oldObj, newObj interface{}) { ctrl.handleVMSnapshotSchedule(newObj) },
+			DeleteFunc: ctrl.handleVMSnapshotSchedule,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	_, err = ctrl.VMSnapshotInformer.AddEventHandler(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc:    ctrl.handleVMSnapshot,
+			UpdateFunc: func(oldObj, newObj interface{}) { ctrl.handleVMSnapshot(newObj) },
+			DeleteFunc: ctrl.handleVMSnapshot,
+		},
+	)
+	return err
+}
+
+// Run the controller
+func (ctrl *VMSnapshotScheduleController) Run(threadiness int, stopCh <-chan struct{}) error {
+	defer utilruntime.HandleCrash()
+	defer ctrl.vmSnapshotScheduleQueue.ShutDown()
+
+	log.Log.Info("Starting snapshot schedule controller.")
+	defer log.Log.Info("Shutting down snapshot schedule controller.")
+
+	if !cache.WaitForCacheSync(
+		stopCh,
+		ctrl.VMSnapshotScheduleInformer.HasSynced,
+		ctrl.VMSnapshotInformer.HasSynced,
+		ctrl.VMInformer.HasSynced,
+		ctrl.VMIInformer.HasSynced,
+	) {
+		return fmt.Errorf("failed to wait for caches to sync")
+	}
+
+	for i := 0; i < threadiness; i++ {
+		go wait.Until(ctrl.vmSnapshotScheduleWorker, time.Second, stopCh)
+	}
+
+	<-stopCh
+
+	return nil
+}
+
+func (ctrl *VMSnapshotScheduleController) vmSnapshotScheduleWorker() {
+	for ctrl.processVMSnapshotScheduleWorkItem() {
+	}
+}
+
+func (ctrl *VMSnapshotScheduleController) processVMSnapshotScheduleWorkItem() bool {
+	return watchutil.ProcessWorkItemWithBreaker(ctrl.vmSnapshotScheduleQueue, nil, func(key string) (time.Duration, error) {
+		log.Log.V(3).Infof("vmSnapshotSchedule worker processing key [%s]", key)
+
+		storeObj, exists, err := ctrl.VMSnapshotScheduleInformer.GetStore().GetByKey(key)
+		if err != nil {
+			return 0, err
+		}
+		if !exists {
+			if namespace, name, err := cache.SplitMetaNamespaceKey(key); err == nil {
+				metrics.DeleteVmSnapshotScheduleLastSuccess(name, namespace)
+			}
+			return 0, nil
+		}
+
+		schedule, ok := storeObj.(*snapshotv1.VirtualMachineSnapshotSchedule)
+		if !ok {
+			return 0, fmt.Errorf("unexpected resource %+v", storeObj)
+		}
+
+		return ctrl.updateVMSnapshotSchedule(schedule.DeepCopy())
+	})
+}
+
+func (ctrl *VMSnapshotScheduleController) handleVMSnapshotSchedule(obj interface{}) {
+	if unknown, ok := obj.(cache.DeletedFinalStateUnknown); ok && unknown.Obj != nil {
+		obj = unknown.Obj
+	}
+
+	if schedule, ok := obj.(*snapshotv1.VirtualMachineSnapshotSchedule); ok {
+		objName, err := cache.DeletionHandlingMetaNamespaceKeyFunc(schedule)
+		if err != nil {
+			log.Log.Errorf("failed to get key from object: %v, %v", err, schedule)
+			return
+		}
+
+		log.Log.V(3).Infof("enqueued %q for sync", objName)
+		ctrl.vmSnapshotScheduleQueue.Add(objName)
+	}
+}
+
+func (ctrl *VMSnapshotScheduleController) handleVMSnapshot(obj interface{}) {
+	if unknown, ok := obj.(cache.DeletedFinalStateUnknown); ok && unknown.Obj != nil {
+		obj = unknown.Obj
+	}
+
+	if vmSnapshot, ok := obj.(*snapshotv1.VirtualMachineSnapshot); ok {
+		if scheduleName, ok := vmSnapshot.Labels[snapshotv1.SnapshotScheduleLabel]; ok {
+			ctrl.vmSnapshotScheduleQueue.Add(cacheKeyFunc(vmSnapshot.Namespace, scheduleName))
+		}
+	}
+}
+
+func (ctrl *VMSnapshotScheduleController) updateVMSnapshotSchedule(
+	schedule *snapshotv1.VirtualMachineSnapshotSchedule,
+) (time.Duration, error) {
+	logger := log.Log.Object(schedule)
+	logger.V(1).Infof("Updating VirtualMachineSnapshotSchedule")
+
+	if schedule.DeletionTimestamp != nil {
+		return 0, nil
+	}
+
+	status := &snapshotv1.VirtualMachineSnapshotScheduleStatus{}
+	if schedule.Status != nil {
+		status = schedule.Status.DeepCopy()
+	}
+
+	cronSchedule, err := cron.ParseSchedule(schedule.Spec.Schedule)
+	if err != nil {
+		status.NextScheduleTime = nil
+		status.Error = newScheduleError(fmt.Sprintf("invalid schedule: %v", err))
+		return 0, ctrl.updateVMSnapshotScheduleStatus(schedule, status)
+	}
+
+	snapshots, err := ctrl.scheduledSnapshots(schedule)
+	if err != nil {
+		return 0, err
+	}
+
+	var requeueAfter time.Duration
+	if schedule.Spec.Suspend == nil || !*schedule.Spec.Suspend {
+		now := currentTime().Time
+		if due := lastDueTime(cronSchedule, schedule, status, now); !due.IsZero() {
+			messages, err := ctrl.createScheduledSnapshots(schedule, snapshots, due)
+			if err != nil {
+				return 0, err
+			}
+			status.LastScheduleTime = &metav1.Time{Time: due}
+			status.Error = nil
+			if len(messages) > 0 {
+				status.Error = newScheduleError(strings.Join(messages, ", "))
+			}
+			if snapshots, err = ctrl.scheduledSnapshots(schedule); err != nil {
+				return 0, err
+			}
+		}
+
+		next := cronSchedule.Next(now)
+		status.NextScheduleTime = nil
+		if !next.IsZero() {
+			status.NextScheduleTime = &metav1.Time{Time: next}
+			requeueAfter = next.Sub(now)
+		}
+
+		if err := ctrl.pruneScheduledSnapshots(schedule, snapshots); err != nil {
+			return 0, err
+		}
+	} else {
+		status.NextScheduleTime = nil
+	}
+
+	updateLastSuccess(status, snapshots)
+	if status.LastSuccessTime != nil {
+		metrics.SetVmSnapshotScheduleLastSuccess(schedule, status.LastSuccessTime.Time)
+	}
+
+	return requeueAfter, ctrl.updateVMSnapshotScheduleStatus(schedule, status)
+}
+
+// lastDueTime returns the most recent time the schedule was due since its last run, missed runs are not caught up
+func lastDueTime(
+	cronSchedule *cron.Schedule, schedule *snapshotv1.VirtualMachineSnapshotSchedule,
+	status *snapshotv1.VirtualMachineSnapshotScheduleStatus, now time.Time,
+) time.Time {
+	last := schedule.CreationTimestamp.Time
+	if status.LastScheduleTime != nil {
+		last = status.LastScheduleTime.Time
+	}
+	if last.IsZero() {
+		return time.Time{}
+	}
+
+	var due time.Time
+	for t := cronSchedule.Next(last); !t.IsZero() && !t.After(now); t = cronSchedule.Next(t) {
+		due = t
+	}
+	return due
+}
+
+func (ctrl *VMSnapshotScheduleController) scheduledSnapshots(
+	schedule *snapshotv1.VirtualMachineSnapshotSchedule,
+) ([]*snapshotv1.VirtualMachineSnapshot, error) {
+	objs, err := ctrl.VMSnapshotInformer.GetIndexer().ByIndex("schedule", cacheKeyFunc(schedule.Namespace, schedule.Name))
+	if err != nil {
+		return nil, err
+	}
+
+	var snapshots []*snapshotv1.VirtualMachineSnapshot
+	for _, obj := range objs {
+		snapshots = append(snapshots, obj.(*snapshotv1.VirtualMachineSnapshot))
+	}
+	return snapshots, nil
+}
+
+// createScheduledSnapshots creates a snapshot of every selected VM and returns why VMs were skipped
+func (ctrl *VMSnapshotScheduleController) createScheduledSnapshots(
+	schedule *snapshotv1.VirtualMachineSnapshotSchedule, snapshots []*snapshotv1.VirtualMachineSnapshot, due time.Time,
+) ([]string, error) {
+	selector, err := metav1.LabelSelectorAsSelector(&schedule.Spec.Selector)
+	if err != nil {
+		return []string{fmt.Sprintf("invalid selector: %v", err)}, nil
+	}
+
+	objs, err := ctrl.VMInformer.GetIndexer().ByIndex(cache.NamespaceIndex, schedule.Namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	var messages []string
+	for _, obj := range objs {
+		vm := obj.(*kubevirtv1.VirtualMachine)
+		if vm.DeletionTimestamp != nil || !selector.Matches(labels.Set(vm.Labels)) {
+			continue
+		}
+
+		vmSnapshot := newScheduledSnapshot(schedule, vm, due)
+		if scheduledSnapshotExists(snapshots, vmSnapshot.Name) {
+			continue
+		}
+
+		if reason := ctrl.skipReason(schedule, snapshots, vm); reason != "" {
+			message := fmt.Sprintf("skipped VirtualMachine %s: %s", vm.Name, reason)
+			ctrl.Recorder.Event(schedule, corev1.EventTypeWarning, scheduledSnapshotSkippedEvent, message)
+			messages = append(messages, message)
+			continue
+		}
+
+		_, err := ctrl.Client.VirtualMachineSnapshot(schedule.Namespace).Create(context.Background(), vmSnapshot, metav1.CreateOptions{})
+		if errors.IsAlreadyExists(err) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		ctrl.Recorder.Eventf(schedule, corev1.EventTypeNormal, scheduledSnapshotCreatedEvent,
+			"Created VirtualMachineSnapshot %s", vmSnapshot.Name)
+	}
+
+	return messages, nil
+}
+
+func scheduledSnapshotExists(snapshots []*snapshotv1.VirtualMachineSnapshot, name string) bool {
+	for _, vmSnapshot := range snapshots {
+		if vmSnapshot.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+func (ctrl *VMSnapshotScheduleController) skipReason(
+	schedule *snapshotv1.VirtualMachineSnapshotSchedule, snapshots []*snapshotv1.VirtualMachineSnapshot, vm *kubevirtv1.VirtualMachine,
+) string {
+	for _, vmSnapshot := range snapshots {
+		if vmSnapshot.Spec.Source.Name == vm.Name && !snapshotDone(vmSnapshot) {
+			return fmt.Sprintf("VirtualMachineSnapshot %s is in progress", vmSnapshot.Name)
+		}
+	}
+
+	if schedule.Spec.QuiescePolicy == nil || *schedule.Spec.QuiescePolicy != snapshotv1.QuiescePolicyRequired {
+		return ""
+	}
+	obj, exists, err := ctrl.VMIInformer.GetStore().GetByKey(cacheKeyFunc(vm.Namespace, vm.Name))
+	if err != nil || !exists {
+		return ""
+	}
+	vmi := obj.(*kubevirtv1.VirtualMachineInstance)
+	condManager := controller.NewVirtualMachineInstanceConditionManager()
+	if vmi.IsRunning() && !condManager.HasCondition(vmi, kubevirtv1.VirtualMachineInstanceAgentConnected) {
+		return "the guest agent is not connected"
+	}
+	return ""
+}
+
+func newScheduledSnapshot(
+	schedule *snapshotv1.VirtualMachineSnapshotSchedule, vm *kubevirtv1.VirtualMachine, due time.Time,
+) *snapshotv1.VirtualMachineSnapshot {
+	return &snapshotv1.VirtualMachineSnapshot{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      naming.GetName(vm.Name+"-"+schedule.Name, due.UTC().Format("200601021504"), validation.DNS1123SubdomainMaxLength),
+			Namespace: schedule.Namespace,
+			Labels: map[string]string{
+				snapshotv1.SnapshotScheduleLabel: schedule.Name,
+			},
+			Annotations: map[string]string{
+				scheduleTimeAnnotation: due.UTC().Format(time.RFC3339),
+			},
+		},
+		Spec: snapshotv1.VirtualMachineSnapshotSpec{
+			Source: corev1.TypedLocalObjectReference{
+				APIGroup: pointer.P(core.GroupName),
+				Kind:     "VirtualMachine",
+				Name:     vm.Name,
+			},
+			FailureDeadline: schedule.Spec.FailureDeadline,
+		},
+	}
+}
+
+// pruneScheduledSnapshots deletes the succeeded snapshots of a VM exceeding the retention count
+// and the failed snapshots older than the newest succeeded one
+func (ctrl *VMSnapshotScheduleController) pruneScheduledSnapshots(
+	schedule *snapshotv1.VirtualMachineSnapshotSchedule, snapshots []*snapshotv1.VirtualMachineSnapshot,
+) error {
+	retentionCount := snapshotv1.DefaultSnapshotScheduleRetentionCount
+	if schedule.Spec.RetentionCount != nil {
+		retentionCount = int(*schedule.Spec.RetentionCount)
+	}
+
+	snapshotsPerVM := map[string][]*snapshotv1.VirtualMachineSnapshot{}
+	for _, vmSnapshot := range snapshots {
+		if vmSnapshot.DeletionTimestamp == nil {
+			snapshotsPerVM[vmSnapshot.Spec.Source.Name] = append(snapshotsPerVM[vmSnapshot.Spec.Source.Name], vmSnapshot)
+		}
+	}
+
+	for _, vmSnapshots := range snapshotsPerVM {
+		// Newest first
+		sort.Slice(vmSnapshots, func(i, j int) bool {
+			return vmSnapshots[j].CreationTimestamp.Before(&vmSnapshots[i].CreationTimestamp)
+		})
+
+		succeeded := 0
+		for _, vmSnapshot := range vmSnapshots {
+			if vmSnapshot.Status == nil {
+				continue
+			}
+			switch vmSnapshot.Status.Phase {
+			case snapshotv1.Succeeded:
+				succeeded++
+				if succeeded <= retentionCount {
+					continue
+				}
+			case snapshotv1.Failed:
+				if succeeded == 0 {
+					continue
+				}
+			default:
+				continue
+			}
+
+			err := ctrl.Client.VirtualMachineSnapshot(vmSnapshot.Namespace).Delete(context.Background(), vmSnapshot.Name, metav1.DeleteOptions{})
+			if err != nil && !errors.IsNotFound(err) {
+				return err
+			}
+			ctrl.Recorder.Eventf(schedule, corev1.EventTypeNormal, scheduledSnapshotDeletedEvent,
+				"Deleted VirtualMachineSnapshot %s", vmSnapshot.Name)
+		}
+	}
+
+	return nil
+}
+
+// updateLastSuccess sets the last success time once all the snapshots of the last run succeeded
+func updateLastSuccess(status *snapshotv1.VirtualMachineSnapshotScheduleStatus, snapshots []*snapshotv1.VirtualMachineSnapshot) {
+	if status.LastScheduleTime == nil {
+		return
+	}
+	runTime := status.LastScheduleTime.UTC().Format(time.RFC3339)
+
+	var lastSuccess *metav1.Time
+	var run int
+	for _, vmSnapshot := range snapshots {
+		if vmSnapshot.Annotations[scheduleTimeAnnotation] != runTime {
+			continue
+		}
+		run++
+		if !snapshotDone(vmSnapshot) {
+			return
+		}
+		if vmSnapshot.Status.Phase == snapshotv1.Failed {
+			status.Error = newScheduleError(fmt.Sprintf("VirtualMachineSnapshot %s failed", vmSnapshot.Name))
+			return
+		}
+		if creationTime := vmSnapshot.Status.CreationTime; creationTime != nil &&
+			(lastSuccess == nil || lastSuccess.Before(creationTime)) {
+			lastSuccess = creationTime
+		}
+	}
+
+	if run > 0 && lastSuccess != nil {
+		status.LastSuccessTime = lastSuccess.DeepCopy()
+	}
+}
+
+func snapshotDone(vmSnapshot *snapshotv1.VirtualMachineSnapshot) bool {
+	return vmSnapshot.Status != nil &&
+		(vmSnapshot.Status.Phase == snapshotv1.Succeeded || vmSnapshot.Status.Phase == snapshotv1.Failed)
+}
+
+func newScheduleError(message string) *snapshotv1.Error {
+	return &snapshotv1.Error{
+		Time:    currentTime(),
+		Message: &message,
+	}
+}
+
+func (ctrl *VMSnapshotScheduleController) updateVMSnapshotScheduleStatus(
+	schedule *snapshotv1.VirtualMachineSnapshotSchedule, status *snapshotv1.VirtualMachineSnapshotScheduleStatus,
+) error {
+	if schedule.Status != nil && equalScheduleStatus(schedule.Status, status) {
+		return nil
+	}
+
+	scheduleCopy := schedule.DeepCopy()
+	scheduleCopy.Status = status
+	_, err := ctrl.Client.GeneratedKubeVirtClient().SnapshotV1beta1().VirtualMachineSnapshotSchedules(schedule.Namespace).
+		UpdateStatus(context.Background(), scheduleCopy, metav1.UpdateOptions{})
+	return err
+}
+
+// equalScheduleStatus ignores the time of an unchanged error
+func equalScheduleStatus(old, updated *snapshotv1.VirtualMachineSnapshotScheduleStatus) bool {
+	old, updated = old.DeepCopy(), updated.DeepCopy()
+	if old.Error != nil && updated.Error != nil && equality.Semantic.DeepEqual(old.Error.Message, updated.Error.Message) {
+		updated.Error.Time = old.Error.Time
+	}
+	return equality.Semantic.DeepEqual(old, updated)
+}
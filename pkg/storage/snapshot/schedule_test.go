@@ -0,0 +1,319 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package snapshot
+
+import (
+	"context"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/testing"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+
+	v1 "kubevirt.io/api/core/v1"
+	snapshotv1 "kubevirt.io/api/snapshot/v1beta1"
+	"kubevirt.io/client-go/kubecli"
+	kubevirtfake "kubevirt.io/client-go/kubevirt/fake"
+
+	virtcontroller "kubevirt.io/kubevirt/pkg/controller"
+	metrics "kubevirt.io/kubevirt/pkg/monitoring/metrics/virt-controller"
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/testutils"
+)
+
+var _ = Describe("Snapshot schedule controller", func() {
+	const scheduleName = "nightly"
+
+	created := time.Date(2024, time.June, 1, 0, 0, 0, 0, time.UTC)
+	due := time.Date(2024, time.June, 1, 2, 0, 0, 0, time.UTC)
+	next := time.Date(2024, time.June, 2, 2, 0, 0, 0, time.UTC)
+
+	var (
+		controller *VMSnapshotScheduleController
+		client     *kubevirtfake.Clientset
+		recorder   *record.FakeRecorder
+		schedule   *snapshotv1.VirtualMachineSnapshotSchedule
+		now        time.Time
+	)
+
+	newVM := func(name string, labels map[string]string) *v1.VirtualMachine {
+		return &v1.VirtualMachine{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: testNamespace,
+				Labels:    labels,
+			},
+			Spec: v1.VirtualMachineSpec{
+				Template: &v1.VirtualMachineInstanceTemplateSpec{},
+			},
+		}
+	}
+
+	newSnapshot := func(
+		vmName, name string, phase snapshotv1.VirtualMachineSnapshotPhase, createdAt time.Time,
+	) *snapshotv1.VirtualMachineSnapshot {
+		vmSnapshot := newScheduledSnapshot(schedule, newVM(vmName, nil), createdAt)
+		vmSnapshot.Name = name
+		vmSnapshot.CreationTimestamp = metav1.NewTime(createdAt)
+		vmSnapshot.Status = &snapshotv1.VirtualMachineSnapshotStatus{Phase: phase}
+		if phase == snapshotv1.Succeeded {
+			vmSnapshot.Status.CreationTime = &metav1.Time{Time: createdAt.Add(time.Minute)}
+		}
+		return vmSnapshot
+	}
+
+	addToStore := func(informer cache.SharedIndexInformer, objs ...interface{}) {
+		for _, obj := range objs {
+			Expect(informer.GetIndexer().Add(obj)).To(Succeed())
+		}
+	}
+
+	createdSnapshots := func() []*snapshotv1.VirtualMachineSnapshot {
+		var snapshots []*snapshotv1.VirtualMachineSnapshot
+		for _, action := range client.Actions() {
+			if create, ok := action.(testing.CreateAction); ok && action.GetResource().Resource == "virtualmachinesnapshots" {
+				snapshots = append(snapshots, create.GetObject().(*snapshotv1.VirtualMachineSnapshot))
+			}
+		}
+		return snapshots
+	}
+
+	deletedSnapshots := func() []string {
+		var names []string
+		for _, action := range client.Actions() {
+			if deletion, ok := action.(testing.DeleteAction); ok && action.GetResource().Resource == "virtualmachinesnapshots" {
+				names = append(names, deletion.GetName())
+			}
+		}
+		return names
+	}
+
+	sync := func() (time.Duration, *snapshotv1.VirtualMachineSnapshotScheduleStatus) {
+		requeueAfter, err := controller.updateVMSnapshotSchedule(schedule.DeepCopy())
+		Expect(err).ToNot(HaveOccurred())
+		updated, err := client.SnapshotV1beta1().VirtualMachineSnapshotSchedules(testNamespace).
+			Get(context.Background(), scheduleName, metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		return requeueAfter, updated.Status
+	}
+
+	BeforeEach(func() {
+		schedule = &snapshotv1.VirtualMachineSnapshotSchedule{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              scheduleName,
+				Namespace:         testNamespace,
+				CreationTimestamp: metav1.NewTime(created),
+			},
+			Spec: snapshotv1.VirtualMachineSnapshotScheduleSpec{
+				Schedule: "0 2 * * *",
+				Selector: metav1.LabelSelector{
+					MatchLabels: map[string]string{"backup": "nightly"},
+				},
+			},
+		}
+
+		now = due.Add(5 * time.Minute)
+		currentTime = func() *metav1.Time {
+			return &metav1.Time{Time: now}
+		}
+
+		client = kubevirtfake.NewSimpleClientset(schedule)
+		virtClient := kubecli.NewMockKubevirtClient(gomock.NewController(GinkgoT()))
+		virtClient.EXPECT().VirtualMachineSnapshot(testNamespace).
+			Return(client.SnapshotV1beta1().VirtualMachineSnapshots(testNamespace)).AnyTimes()
+		virtClient.EXPECT().GeneratedKubeVirtClient().Return(client).AnyTimes()
+
+		scheduleInformer, _ := testutils.NewFakeInformerFor(&snapshotv1.VirtualMachineSnapshotSchedule{})
+		vmSnapshotInformer, _ := testutils.NewFakeInformerWithIndexersFor(
+			&snapshotv1.VirtualMachineSnapshot{}, virtcontroller.GetVirtualMachineSnapshotInformerIndexers())
+		vmInformer, _ := testutils.NewFakeInformerWithIndexersFor(&v1.VirtualMachine{}, virtcontroller.GetVirtualMachineInformerIndexers())
+		vmiInformer, _ := testutils.NewFakeInformerFor(&v1.VirtualMachineInstance{})
+		recorder = record.NewFakeRecorder(100)
+
+		controller = &VMSnapshotScheduleController{
+			Client:                     virtClient,
+			VMSnapshotScheduleInformer: scheduleInformer,
+			VMSnapshotInformer:         vmSnapshotInformer,
+			VMInformer:                 vmInformer,
+			VMIInformer:                vmiInformer,
+			Recorder:                   recorder,
+		}
+		Expect(controller.Init()).To(Succeed())
+
+		addToStore(vmInformer,
+			newVM("selected", map[string]string{"backup": "nightly"}),
+			newVM("other", nil),
+		)
+	})
+
+	AfterEach(func() {
+		currentTime = func() *metav1.Time {
+			t := metav1.Now()
+			return &t
+		}
+	})
+
+	It("should snapshot the selected VirtualMachines when the schedule is due", func() {
+		requeueAfter, status := sync()
+
+		snapshots := createdSnapshots()
+		Expect(snapshots).To(HaveLen(1))
+		Expect(snapshots[0].Name).To(Equal("selected-nightly-202406010200"))
+		Expect(snapshots[0].Spec.Source.Name).To(Equal("selected"))
+		Expect(snapshots[0].Labels).To(HaveKeyWithValue(snapshotv1.SnapshotScheduleLabel, scheduleName))
+		Expect(snapshots[0].Annotations).To(HaveKeyWithValue(scheduleTimeAnnotation, "2024-06-01T02:00:00Z"))
+		Expect(recorder.Events).To(Receive(ContainSubstring(scheduledSnapshotCreatedEvent)))
+
+		Expect(status.LastScheduleTime.Time).To(Equal(due))
+		Expect(status.NextScheduleTime.Time).To(Equal(next))
+		Expect(status.Error).To(BeNil())
+		Expect(requeueAfter).To(Equal(next.Sub(now)))
+	})
+
+	It("should only snapshot once for missed runs", func() {
+		schedule.Status = &snapshotv1.VirtualMachineSnapshotScheduleStatus{
+			LastScheduleTime: &metav1.Time{Time: due.AddDate(0, 0, -5)},
+		}
+		now = due.AddDate(0, 0, 2).Add(time.Hour)
+
+		_, status := sync()
+
+		Expect(createdSnapshots()).To(HaveLen(1))
+		Expect(status.LastScheduleTime.Time).To(Equal(due.AddDate(0, 0, 2)))
+	})
+
+	It("should wait until the schedule is due", func() {
+		now = due.Add(-time.Minute)
+
+		requeueAfter, status := sync()
+
+		Expect(createdSnapshots()).To(BeEmpty())
+		Expect(status.LastScheduleTime).To(BeNil())
+		Expect(status.NextScheduleTime.Time).To(Equal(due))
+		Expect(requeueAfter).To(Equal(time.Minute))
+	})
+
+	It("should neither create nor delete snapshots while suspended", func() {
+		schedule.Spec.Suspend = pointer.P(true)
+		schedule.Spec.RetentionCount = pointer.P(int32(1))
+		addToStore(controller.VMSnapshotInformer,
+			newSnapshot("selected", "old", snapshotv1.Succeeded, due.AddDate(0, 0, -2)),
+			newSnapshot("selected", "new", snapshotv1.Succeeded, due.AddDate(0, 0, -1)),
+		)
+
+		requeueAfter, status := sync()
+
+		Expect(createdSnapshots()).To(BeEmpty())
+		Expect(deletedSnapshots()).To(BeEmpty())
+		Expect(status.NextScheduleTime).To(BeNil())
+		Expect(requeueAfter).To(BeZero())
+	})
+
+	It("should skip VirtualMachines with a snapshot in progress", func() {
+		addToStore(controller.VMSnapshotInformer,
+			newSnapshot("selected", "running", snapshotv1.InProgress, due.AddDate(0, 0, -1)),
+		)
+
+		_, status := sync()
+
+		Expect(createdSnapshots()).To(BeEmpty())
+		Expect(status.Error).ToNot(BeNil())
+		Expect(*status.Error.Message).To(ContainSubstring("VirtualMachineSnapshot running is in progress"))
+		Expect(recorder.Events).To(Receive(ContainSubstring(scheduledSnapshotSkippedEvent)))
+	})
+
+	DescribeTable("with the Required quiesce policy", func(conditions []v1.VirtualMachineInstanceCondition, expectedSnapshots int) {
+		schedule.Spec.QuiescePolicy = pointer.P(snapshotv1.QuiescePolicyRequired)
+		addToStore(controller.VMIInformer, &v1.VirtualMachineInstance{
+			ObjectMeta: metav1.ObjectMeta{Name: "selected", Namespace: testNamespace},
+			Status: v1.VirtualMachineInstanceStatus{
+				Phase:      v1.Running,
+				Conditions: conditions,
+			},
+		})
+
+		_, status := sync()
+
+		Expect(createdSnapshots()).To(HaveLen(expectedSnapshots))
+		Expect(status.Error == nil).To(Equal(expectedSnapshots == 1))
+	},
+		Entry("should snapshot a running VirtualMachine with a connected guest agent", []v1.VirtualMachineInstanceCondition{
+			{Type: v1.VirtualMachineInstanceAgentConnected, Status: corev1.ConditionTrue},
+		}, 1),
+		Entry("should skip a running VirtualMachine without a connected guest agent", nil, 0),
+	)
+
+	It("should delete the snapshots exceeding the retention count", func() {
+		schedule.Spec.RetentionCount = pointer.P(int32(2))
+		schedule.Status = &snapshotv1.VirtualMachineSnapshotScheduleStatus{
+			LastScheduleTime: &metav1.Time{Time: due},
+		}
+		addToStore(controller.VMSnapshotInformer,
+			newSnapshot("selected", "oldest", snapshotv1.Succeeded, due.AddDate(0, 0, -4)),
+			newSnapshot("selected", "failed", snapshotv1.Failed, due.AddDate(0, 0, -3)),
+			newSnapshot("selected", "older", snapshotv1.Succeeded, due.AddDate(0, 0, -2)),
+			newSnapshot("selected", "newer", snapshotv1.Succeeded, due.AddDate(0, 0, -1)),
+			newSnapshot("selected", "latest-failed", snapshotv1.Failed, due.Add(-time.Hour)),
+			newSnapshot("selected", "in-progress", snapshotv1.InProgress, due),
+		)
+
+		sync()
+
+		Expect(deletedSnapshots()).To(ConsistOf("oldest", "failed"))
+		Expect(recorder.Events).To(Receive(ContainSubstring(scheduledSnapshotDeletedEvent)))
+	})
+
+	It("should record the last success once all snapshots of the last run succeeded", func() {
+		schedule.Status = &snapshotv1.VirtualMachineSnapshotScheduleStatus{
+			LastScheduleTime: &metav1.Time{Time: due},
+		}
+		addToStore(controller.VMSnapshotInformer,
+			newSnapshot("selected", "selected-nightly-202406010200", snapshotv1.Succeeded, due),
+		)
+
+		_, status := sync()
+
+		Expect(status.LastSuccessTime.Time).To(Equal(due.Add(time.Minute)))
+		value, err := metrics.GetVmSnapshotScheduleLastSuccessTimestamp(scheduleName, testNamespace)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(value).To(Equal(float64(due.Add(time.Minute).Unix())))
+	})
+
+	It("should report a failed snapshot of the last run", func() {
+		schedule.Status = &snapshotv1.VirtualMachineSnapshotScheduleStatus{
+			LastScheduleTime: &metav1.Time{Time: due},
+		}
+		addToStore(controller.VMSnapshotInformer,
+			newSnapshot("selected", "selected-nightly-202406010200", snapshotv1.Failed, due),
+		)
+
+		_, status := sync()
+
+		Expect(status.LastSuccessTime).To(BeNil())
+		Expect(status.Error).ToNot(BeNil())
+		Expect(*status.Error.Message).To(Equal("VirtualMachineSnapshot selected-nightly-202406010200 failed"))
+	})
+})
@@ -41,6 +41,7 @@ import (
 
 	"kubevirt.io/kubevirt/pkg/controller"
 	"kubevirt.io/kubevirt/pkg/storage/status"
+	"kubevirt.io/kubevirt/pkg/virt-controller/watch/circuitbreaker"
 	watchutil "kubevirt.io/kubevirt/pkg/virt-controller/watch/util"
 )
 
@@ -83,6 +84,9 @@ type VMSnapshotController struct {
 
 	Recorder record.EventRecorder
 
+	// CircuitBreaker pauses all queues but the one of the CRDs
+	CircuitBreaker *circuitbreaker.CircuitBreaker
+
 	ResyncPeriod time.Duration
 
 	vmSnapshotQueue        workqueue.TypedRateLimitingInterface[string]
@@ -312,7 +316,7 @@ func (ctrl *VMSnapshotController) vmWorker() {
 }
 
 func (ctrl *VMSnapshotController) processVMSnapshotWorkItem() bool {
-	return watchutil.ProcessWorkItem(ctrl.vmSnapshotQueue, func(key string) (time.Duration, error) {
+	return watchutil.ProcessWorkItemWithBreaker(ctrl.vmSnapshotQueue, ctrl.CircuitBreaker, func(key string) (time.Duration, error) {
 		log.Log.V(3).Infof("vmSnapshot worker processing key [%s]", key)
 
 		storeObj, exists, err := ctrl.VMSnapshotInformer.GetStore().GetByKey(key)
@@ -330,7 +334,7 @@ func (ctrl *VMSnapshotController) processVMSnapshotWorkItem() bool {
 }
 
 func (ctrl *VMSnapshotController) processVMSnapshotContentWorkItem() bool {
-	return watchutil.ProcessWorkItem(ctrl.vmSnapshotContentQueue, func(key string) (time.Duration, error) {
+	return watchutil.ProcessWorkItemWithBreaker(ctrl.vmSnapshotContentQueue, ctrl.CircuitBreaker, func(key string) (time.Duration, error) {
 		log.Log.V(3).Infof("vmSnapshotContent worker processing key [%s]", key)
 
 		storeObj, exists, err := ctrl.VMSnapshotContentInformer.GetStore().GetByKey(key)
@@ -379,7 +383,7 @@ func (ctrl *VMSnapshotController) processCRDWorkItem() bool {
 }
 
 func (ctrl *VMSnapshotController) processVMSnapshotStatusWorkItem() bool {
-	return watchutil.ProcessWorkItem(ctrl.vmSnapshotStatusQueue, func(key string) (time.Duration, error) {
+	return watchutil.ProcessWorkItemWithBreaker(ctrl.vmSnapshotStatusQueue, ctrl.CircuitBreaker, func(key string) (time.Duration, error) {
 		log.Log.V(3).Infof("vmSnapshotStatus worker processing VM [%s]", key)
 
 		storeObj, exists, err := ctrl.VMInformer.GetStore().GetByKey(key)
@@ -403,7 +407,7 @@ func (ctrl *VMSnapshotController) processVMSnapshotStatusWorkItem() bool {
 }
 
 func (ctrl *VMSnapshotController) processVMWorkItem() bool {
-	return watchutil.ProcessWorkItem(ctrl.vmQueue, func(key string) (time.Duration, error) {
+	return watchutil.ProcessWorkItemWithBreaker(ctrl.vmQueue, ctrl.CircuitBreaker, func(key string) (time.Duration, error) {
 		log.Log.V(3).Infof("vm worker processing VM [%s]", key)
 
 		storeObj, exists, err := ctrl.VMInformer.GetStore().GetByKey(key)
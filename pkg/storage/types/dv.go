@@ -23,6 +23,7 @@ import (
 	"context"
 	"fmt"
 	"maps"
+	"strings"
 
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -203,6 +204,88 @@ func HasDataVolumeExceededQuotaError(dv *cdiv1.DataVolume) error {
 	return nil
 }
 
+const (
+	// DataVolumeFailedReason is reported for a DataVolume in the Failed phase
+	DataVolumeFailedReason = "DataVolumeFailed"
+	// DataVolumeImportErrorReason is reported when the population of a DataVolume keeps failing
+	DataVolumeImportErrorReason = "ImportError"
+	// DataVolumeQuotaExceededReason is reported when the PVC of a DataVolume exceeds the quota of the namespace
+	DataVolumeQuotaExceededReason = "QuotaExceeded"
+	// DataVolumeSourceNotFoundReason is reported when the image or the PVC to populate a DataVolume from does not exist
+	DataVolumeSourceNotFoundReason = "SourceNotFound"
+	// DataVolumeStorageClassNotFoundReason is reported when the storage class requested by a DataVolume does not exist
+	DataVolumeStorageClassNotFoundReason = "StorageClassNotFound"
+)
+
+// DataVolumeFailure explains why a DataVolume cannot be populated
+type DataVolumeFailure struct {
+	Reason  string
+	Message string
+}
+
+// GetDataVolumeFailure classifies the errors reported by a DataVolume which
+// prevent it from ever being populated without an intervention. It returns nil
+// if the DataVolume is populated or still making progress.
+func GetDataVolumeFailure(dv *cdiv1.DataVolume, storageClassStore cache.Store) *DataVolumeFailure {
+	if dv.Status.Phase == cdiv1.Succeeded {
+		return nil
+	}
+
+	if err := HasDataVolumeExceededQuotaError(dv); err != nil {
+		return &DataVolumeFailure{Reason: DataVolumeQuotaExceededReason, Message: err.Error()}
+	}
+
+	dvConditions := NewDataVolumeConditionManager()
+	if boundCond := dvConditions.GetCondition(dv, cdiv1.DataVolumeBound); boundCond != nil &&
+		boundCond.Status != v1.ConditionTrue && boundCond.Reason == "CloneWithoutSource" {
+		return &DataVolumeFailure{
+			Reason:  DataVolumeSourceNotFoundReason,
+			Message: fmt.Sprintf("DataVolume %s clone source does not exist: %s", dv.Name, boundCond.Message),
+		}
+	}
+
+	if storageClassName := dataVolumeStorageClassName(dv); storageClassName != "" && storageClassStore != nil {
+		if _, exists, err := storageClassStore.GetByKey(storageClassName); err == nil && !exists {
+			return &DataVolumeFailure{
+				Reason:  DataVolumeStorageClassNotFoundReason,
+				Message: fmt.Sprintf("DataVolume %s requests storage class %s which does not exist", dv.Name, storageClassName),
+			}
+		}
+	}
+
+	if runningCond := dvConditions.GetCondition(dv, cdiv1.DataVolumeRunning); runningCond != nil &&
+		runningCond.Status == v1.ConditionFalse &&
+		(runningCond.Reason == "Error" || runningCond.Reason == "ImagePullFailed") {
+		message := strings.ToLower(runningCond.Message)
+		if strings.Contains(message, "404") || strings.Contains(message, "not found") {
+			return &DataVolumeFailure{
+				Reason:  DataVolumeSourceNotFoundReason,
+				Message: fmt.Sprintf("DataVolume %s import source does not exist: %s", dv.Name, runningCond.Message),
+			}
+		}
+		return &DataVolumeFailure{
+			Reason:  DataVolumeImportErrorReason,
+			Message: fmt.Sprintf("DataVolume %s importer has stopped running due to an error: %s", dv.Name, runningCond.Message),
+		}
+	}
+
+	if DataVolumeFailed(dv) {
+		return &DataVolumeFailure{Reason: DataVolumeFailedReason, Message: fmt.Sprintf("DataVolume %s is in Failed phase", dv.Name)}
+	}
+
+	return nil
+}
+
+func dataVolumeStorageClassName(dv *cdiv1.DataVolume) string {
+	switch {
+	case dv.Spec.PVC != nil && dv.Spec.PVC.StorageClassName != nil:
+		return *dv.Spec.PVC.StorageClassName
+	case dv.Spec.Storage != nil && dv.Spec.Storage.StorageClassName != nil:
+		return *dv.Spec.Storage.StorageClassName
+	}
+	return ""
+}
+
 func HasDataVolumeProvisioning(namespace string, volumes []virtv1.Volume, dataVolumeStore cache.Store) bool {
 	for _, volume := range volumes {
 		if volume.DataVolume == nil {
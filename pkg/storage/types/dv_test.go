@@ -26,13 +26,18 @@ import (
 	. "github.com/onsi/gomega"
 
 	"github.com/golang/mock/gomock"
+	k8sv1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/cache"
 
 	virtv1 "kubevirt.io/api/core/v1"
 	cdifake "kubevirt.io/client-go/containerizeddataimporter/fake"
 	"kubevirt.io/client-go/kubecli"
 	cdiv1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
+
+	"kubevirt.io/kubevirt/pkg/pointer"
 )
 
 var _ = Describe("DataVolume utils test", func() {
@@ -143,4 +148,73 @@ var _ = Describe("DataVolume utils test", func() {
 			Entry("everything specified", "foo", "bar", "bar"),
 		)
 	})
+
+	Context("GetDataVolumeFailure", func() {
+		const storageClassName = "local"
+
+		newDataVolume := func(phase cdiv1.DataVolumePhase, conditions ...cdiv1.DataVolumeCondition) *cdiv1.DataVolume {
+			return &cdiv1.DataVolume{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "vmnamespace", Name: "dv"},
+				Spec: cdiv1.DataVolumeSpec{
+					Storage: &cdiv1.StorageSpec{StorageClassName: pointer.P(storageClassName)},
+				},
+				Status: cdiv1.DataVolumeStatus{Phase: phase, Conditions: conditions},
+			}
+		}
+
+		newStorageClassStore := func(names ...string) cache.Store {
+			store := cache.NewStore(cache.MetaNamespaceKeyFunc)
+			for _, name := range names {
+				Expect(store.Add(&storagev1.StorageClass{ObjectMeta: metav1.ObjectMeta{Name: name}})).To(Succeed())
+			}
+			return store
+		}
+
+		boundCondition := func(reason string) cdiv1.DataVolumeCondition {
+			return cdiv1.DataVolumeCondition{Type: cdiv1.DataVolumeBound, Status: k8sv1.ConditionFalse, Reason: reason}
+		}
+
+		runningCondition := func(reason, message string) cdiv1.DataVolumeCondition {
+			return cdiv1.DataVolumeCondition{Type: cdiv1.DataVolumeRunning, Status: k8sv1.ConditionFalse, Reason: reason, Message: message}
+		}
+
+		It("should not report a failure for a populated DataVolume", func() {
+			dv := newDataVolume(cdiv1.Succeeded, boundCondition("ErrExceededQuota"))
+			Expect(GetDataVolumeFailure(dv, newStorageClassStore())).To(BeNil())
+		})
+
+		It("should not report a failure for a DataVolume making progress", func() {
+			dv := newDataVolume(cdiv1.ImportInProgress, runningCondition("Pending", ""))
+			Expect(GetDataVolumeFailure(dv, newStorageClassStore(storageClassName))).To(BeNil())
+		})
+
+		DescribeTable("should classify the failure", func(dv *cdiv1.DataVolume, expectedReason string) {
+			failure := GetDataVolumeFailure(dv, newStorageClassStore(storageClassName))
+			Expect(failure).ToNot(BeNil())
+			Expect(failure.Reason).To(Equal(expectedReason))
+			Expect(failure.Message).To(ContainSubstring("DataVolume dv"))
+		},
+			Entry("exceeded quota", newDataVolume(cdiv1.Pending, boundCondition("ErrExceededQuota")), DataVolumeQuotaExceededReason),
+			Entry("missing clone source", newDataVolume(cdiv1.CloneScheduled, boundCondition("CloneWithoutSource")), DataVolumeSourceNotFoundReason),
+			Entry("missing import source",
+				newDataVolume(cdiv1.ImportInProgress,
+					runningCondition("Error", "Unable to connect to http data source: expected status code 200, got 404")),
+				DataVolumeSourceNotFoundReason),
+			Entry("missing registry image",
+				newDataVolume(cdiv1.ImportInProgress, runningCondition("ImagePullFailed", "manifest unknown: image not found")),
+				DataVolumeSourceNotFoundReason),
+			Entry("failing import",
+				newDataVolume(cdiv1.ImportInProgress, runningCondition("Error", "Unable to process data")),
+				DataVolumeImportErrorReason),
+			Entry("failed phase", newDataVolume(cdiv1.Failed), DataVolumeFailedReason),
+		)
+
+		It("should report a missing storage class", func() {
+			dv := newDataVolume(cdiv1.Pending)
+			failure := GetDataVolumeFailure(dv, newStorageClassStore("other"))
+			Expect(failure).ToNot(BeNil())
+			Expect(failure.Reason).To(Equal(DataVolumeStorageClassNotFoundReason))
+			Expect(failure.Message).To(ContainSubstring(storageClassName))
+		})
+	})
 })
@@ -0,0 +1,161 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package cron
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Every schedule matches at least once within a leap year cycle, February 29 included
+const searchLimit = 8 * 366 * 24 * time.Hour
+
+// Schedule is a parsed five field cron expression, every field holds the set of its matching values
+type Schedule struct {
+	minutes     uint64
+	hours       uint64
+	daysOfMonth uint64
+	months      uint64
+	daysOfWeek  uint64
+	// Like cron, a time matches if either of the days matches when both of them are restricted
+	daysRestricted bool
+}
+
+// ParseSchedule parses a five field cron expression like "0 2 * * 6,0"
+func ParseSchedule(schedule string) (*Schedule, error) {
+	fields := strings.Fields(schedule)
+	if len(fields) != 5 {
+		return nil, fmt.Errorf("expected 5 fields, found %d", len(fields))
+	}
+
+	var err error
+	s := &Schedule{}
+	if s.minutes, err = parseField(fields[0], 0, 59); err != nil {
+		return nil, fmt.Errorf("invalid minute: %v", err)
+	}
+	if s.hours, err = parseField(fields[1], 0, 23); err != nil {
+		return nil, fmt.Errorf("invalid hour: %v", err)
+	}
+	if s.daysOfMonth, err = parseField(fields[2], 1, 31); err != nil {
+		return nil, fmt.Errorf("invalid day of month: %v", err)
+	}
+	if s.months, err = parseField(fields[3], 1, 12); err != nil {
+		return nil, fmt.Errorf("invalid month: %v", err)
+	}
+	if s.daysOfWeek, err = parseField(fields[4], 0, 7); err != nil {
+		return nil, fmt.Errorf("invalid day of week: %v", err)
+	}
+	// Sunday is both 0 and 7
+	if s.daysOfWeek&(1<<7) != 0 {
+		s.daysOfWeek |= 1
+	}
+	s.daysRestricted = !strings.HasPrefix(fields[2], "*") && !strings.HasPrefix(fields[4], "*")
+
+	return s, nil
+}
+
+// parseField parses a comma separated list of values, ranges and steps like "1,5-10,*/15"
+func parseField(field string, lowest, highest int) (uint64, error) {
+	var values uint64
+	for _, part := range strings.Split(field, ",") {
+		valueRange, step := part, 1
+		if i := strings.Index(part, "/"); i >= 0 {
+			var err error
+			valueRange = part[:i]
+			if step, err = strconv.Atoi(part[i+1:]); err != nil || step < 1 {
+				return 0, fmt.Errorf("invalid step in %q", part)
+			}
+		}
+
+		var first, last int
+		var err error
+		switch {
+		case valueRange == "*":
+			first, last = lowest, highest
+		case strings.Contains(valueRange, "-"):
+			bounds := strings.SplitN(valueRange, "-", 2)
+			if first, err = strconv.Atoi(bounds[0]); err != nil {
+				return 0, fmt.Errorf("invalid range %q", part)
+			}
+			if last, err = strconv.Atoi(bounds[1]); err != nil {
+				return 0, fmt.Errorf("invalid range %q", part)
+			}
+		default:
+			if first, err = strconv.Atoi(valueRange); err != nil {
+				return 0, fmt.Errorf("invalid value %q", part)
+			}
+			last = first
+			if step > 1 {
+				last = highest
+			}
+		}
+		if first < lowest || last > highest || first > last {
+			return 0, fmt.Errorf("%q is out of the range %d-%d", part, lowest, highest)
+		}
+
+		for value := first; value <= last; value += step {
+			values |= 1 << uint(value)
+		}
+	}
+
+	return values, nil
+}
+
+// Matches tells if the schedule matches the minute of the given time
+func (s *Schedule) Matches(t time.Time) bool {
+	if s.minutes&(1<<uint(t.Minute())) == 0 || s.hours&(1<<uint(t.Hour())) == 0 {
+		return false
+	}
+	return s.matchesDay(t)
+}
+
+func (s *Schedule) matchesDay(t time.Time) bool {
+	if s.months&(1<<uint(t.Month())) == 0 {
+		return false
+	}
+	dayOfMonth := s.daysOfMonth&(1<<uint(t.Day())) != 0
+	dayOfWeek := s.daysOfWeek&(1<<uint(t.Weekday())) != 0
+	if s.daysRestricted {
+		return dayOfMonth || dayOfWeek
+	}
+	return dayOfMonth && dayOfWeek
+}
+
+// Next returns the first time after the given time the schedule matches, in UTC.
+// The zero time is returned if the schedule never matches, like on February 30.
+func (s *Schedule) Next(after time.Time) time.Time {
+	t := after.UTC().Truncate(time.Minute).Add(time.Minute)
+	limit := t.Add(searchLimit)
+	for t.Before(limit) {
+		switch {
+		case !s.matchesDay(t):
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, time.UTC)
+		case s.hours&(1<<uint(t.Hour())) == 0:
+			t = t.Truncate(time.Hour).Add(time.Hour)
+		case s.minutes&(1<<uint(t.Minute())) == 0:
+			t = t.Add(time.Minute)
+		default:
+			return t
+		}
+	}
+	return time.Time{}
+}
@@ -0,0 +1,73 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package cron
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Cron schedules", func() {
+	// The 1st of June 2024 is a Saturday
+	at := func(month time.Month, day, hour, minute int) time.Time {
+		return time.Date(2024, month, day, hour, minute, 0, 0, time.UTC)
+	}
+
+	DescribeTable("should find the next time", func(schedule string, after, next time.Time) {
+		s, err := ParseSchedule(schedule)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(s.Next(after)).To(Equal(next))
+	},
+		Entry("later on the same day", "0 2 * * *", at(time.June, 1, 1, 30), at(time.June, 1, 2, 0)),
+		Entry("on the next day", "0 2 * * *", at(time.June, 1, 2, 0), at(time.June, 2, 2, 0)),
+		Entry("within the same hour", "*/15 * * * *", at(time.June, 1, 1, 31), at(time.June, 1, 1, 45)),
+		Entry("in the next hour", "*/15 * * * *", at(time.June, 1, 1, 45), at(time.June, 1, 2, 0)),
+		Entry("on a day of the week", "30 3 * * 1", at(time.June, 1, 12, 0), at(time.June, 3, 3, 30)),
+		Entry("on either restricted day", "0 0 15 * 1", at(time.June, 4, 0, 0), at(time.June, 10, 0, 0)),
+		Entry("in the next year", "0 0 1 1 *", at(time.June, 1, 0, 0), time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC)),
+		Entry("on a leap day", "0 0 29 2 *", at(time.March, 1, 0, 0), time.Date(2028, time.February, 29, 0, 0, 0, 0, time.UTC)),
+		Entry("never", "0 0 30 2 *", at(time.June, 1, 0, 0), time.Time{}),
+	)
+
+	It("should find the next time in UTC", func() {
+		s, err := ParseSchedule("0 2 * * *")
+		Expect(err).ToNot(HaveOccurred())
+		zone := time.FixedZone("UTC+2", 2*60*60)
+		Expect(s.Next(at(time.June, 1, 1, 59).In(zone))).To(Equal(at(time.June, 1, 2, 0)))
+	})
+
+	It("should skip the seconds of the given time", func() {
+		s, err := ParseSchedule("* * * * *")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(s.Next(at(time.June, 1, 1, 0).Add(59 * time.Second))).To(Equal(at(time.June, 1, 1, 1)))
+	})
+
+	DescribeTable("should reject", func(schedule string) {
+		_, err := ParseSchedule(schedule)
+		Expect(err).To(HaveOccurred())
+	},
+		Entry("too many fields", "0 0 2 * * *"),
+		Entry("a value out of range", "60 * * * *"),
+		Entry("a day of month of zero", "0 0 0 * *"),
+		Entry("an empty step", "*/ * * * *"),
+	)
+})
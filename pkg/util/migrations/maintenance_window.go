@@ -0,0 +1,64 @@
+package migrations
+
+import (
+	"fmt"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
+
+	"kubevirt.io/api/migrations/v1alpha1"
+
+	"kubevirt.io/kubevirt/pkg/util/cron"
+)
+
+const maxMaintenanceWindowDuration = 7 * 24 * time.Hour
+
+// isOpen tells if the window opened by the schedule within the duration before the given time
+func isOpen(schedule *cron.Schedule, duration time.Duration, now time.Time) bool {
+	now = now.UTC()
+	for start := now.Truncate(time.Minute); now.Sub(start) < duration; start = start.Add(-time.Minute) {
+		if schedule.Matches(start) {
+			return true
+		}
+	}
+	return false
+}
+
+// IsInMaintenanceWindow tells if one of the windows is open at the given time, invalid windows never open
+func IsInMaintenanceWindow(windows []v1alpha1.MaintenanceWindow, now time.Time) bool {
+	for _, window := range windows {
+		schedule, err := cron.ParseSchedule(window.Schedule)
+		if err != nil {
+			continue
+		}
+		if isOpen(schedule, window.Duration.Duration, now) {
+			return true
+		}
+	}
+	return false
+}
+
+// ValidateMaintenanceWindows validates the schedules and the durations of the maintenance windows of a migration policy
+func ValidateMaintenanceWindows(field *k8sfield.Path, windows []v1alpha1.MaintenanceWindow) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+
+	for i, window := range windows {
+		if _, err := cron.ParseSchedule(window.Schedule); err != nil {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("invalid cron schedule %q: %v", window.Schedule, err),
+				Field:   field.Index(i).Child("schedule").String(),
+			})
+		}
+		if window.Duration.Duration < time.Minute || window.Duration.Duration > maxMaintenanceWindowDuration {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("must be between 1m and %s", maxMaintenanceWindowDuration),
+				Field:   field.Index(i).Child("duration").String(),
+			})
+		}
+	}
+
+	return causes
+}
@@ -0,0 +1,91 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package migrations
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
+
+	"kubevirt.io/api/migrations/v1alpha1"
+)
+
+var _ = Describe("Maintenance windows", func() {
+	window := func(schedule string, duration time.Duration) v1alpha1.MaintenanceWindow {
+		return v1alpha1.MaintenanceWindow{Schedule: schedule, Duration: metav1.Duration{Duration: duration}}
+	}
+
+	// The 1st of June 2024 is a Saturday
+	at := func(day, hour, minute int) time.Time {
+		return time.Date(2024, time.June, day, hour, minute, 30, 0, time.UTC)
+	}
+
+	DescribeTable("should tell if a window is open", func(windows []v1alpha1.MaintenanceWindow, now time.Time, open bool) {
+		Expect(IsInMaintenanceWindow(windows, now)).To(Equal(open))
+	},
+		Entry("at the start of a daily window", []v1alpha1.MaintenanceWindow{window("0 2 * * *", 2*time.Hour)}, at(1, 2, 0), true),
+		Entry("within a daily window", []v1alpha1.MaintenanceWindow{window("0 2 * * *", 2*time.Hour)}, at(1, 3, 59), true),
+		Entry("after a daily window", []v1alpha1.MaintenanceWindow{window("0 2 * * *", 2*time.Hour)}, at(1, 4, 0), false),
+		Entry("before a daily window", []v1alpha1.MaintenanceWindow{window("0 2 * * *", 2*time.Hour)}, at(1, 1, 59), false),
+		Entry("within a window crossing midnight", []v1alpha1.MaintenanceWindow{window("0 22 * * *", 4*time.Hour)}, at(2, 1, 0), true),
+		Entry("on a weekend day", []v1alpha1.MaintenanceWindow{window("0 0 * * 6,0", 24*time.Hour)}, at(2, 12, 0), true),
+		Entry("on a weekday", []v1alpha1.MaintenanceWindow{window("0 0 * * 6,0", 24*time.Hour)}, at(3, 12, 0), false),
+		Entry("on Sunday written as 7", []v1alpha1.MaintenanceWindow{window("0 0 * * 7", 24*time.Hour)}, at(2, 12, 0), true),
+		Entry("in a range with a step", []v1alpha1.MaintenanceWindow{window("*/20 8-10 * * *", 5*time.Minute)}, at(1, 9, 42), true),
+		Entry("between the steps", []v1alpha1.MaintenanceWindow{window("*/20 8-10 * * *", 5*time.Minute)}, at(1, 9, 50), false),
+		Entry("on either restricted day", []v1alpha1.MaintenanceWindow{window("0 0 15 * 1", 24*time.Hour)}, at(3, 12, 0), true),
+		Entry("in one of several windows", []v1alpha1.MaintenanceWindow{
+			window("0 2 * * *", time.Hour),
+			window("0 14 * * *", time.Hour),
+		}, at(1, 14, 30), true),
+		Entry("with an invalid schedule", []v1alpha1.MaintenanceWindow{window("every night", 24*time.Hour)}, at(1, 2, 0), false),
+		Entry("without windows", nil, at(1, 2, 0), false),
+	)
+
+	It("should evaluate the windows in UTC", func() {
+		zone := time.FixedZone("UTC+2", 2*60*60)
+		Expect(IsInMaintenanceWindow([]v1alpha1.MaintenanceWindow{window("0 2 * * *", time.Hour)}, at(1, 2, 30).In(zone))).To(BeTrue())
+	})
+
+	DescribeTable("should reject", func(window v1alpha1.MaintenanceWindow, field string) {
+		causes := ValidateMaintenanceWindows(k8sfield.NewPath("spec", "maintenanceWindows"), []v1alpha1.MaintenanceWindow{window})
+		Expect(causes).To(HaveLen(1))
+		Expect(causes[0].Field).To(Equal(field))
+	},
+		Entry("a schedule with too few fields", window("0 2 * *", time.Hour), "spec.maintenanceWindows[0].schedule"),
+		Entry("a value out of range", window("0 24 * * *", time.Hour), "spec.maintenanceWindows[0].schedule"),
+		Entry("an inverted range", window("0 4-2 * * *", time.Hour), "spec.maintenanceWindows[0].schedule"),
+		Entry("an invalid step", window("*/0 2 * * *", time.Hour), "spec.maintenanceWindows[0].schedule"),
+		Entry("names", window("0 2 * * SAT", time.Hour), "spec.maintenanceWindows[0].schedule"),
+		Entry("a too short duration", window("0 2 * * *", time.Second), "spec.maintenanceWindows[0].duration"),
+		Entry("a too long duration", window("0 2 * * *", 8*24*time.Hour), "spec.maintenanceWindows[0].duration"),
+	)
+
+	It("should accept valid windows", func() {
+		Expect(ValidateMaintenanceWindows(k8sfield.NewPath("spec", "maintenanceWindows"), []v1alpha1.MaintenanceWindow{
+			window("30 1 1,15 * *", 2*time.Hour),
+			window("0 0 * * 6", 7*24*time.Hour),
+		})).To(BeEmpty())
+	})
+})
@@ -1,8 +1,11 @@
 package migrations
 
 import (
+	"fmt"
+
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	v12 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/client-go/tools/cache"
 	v1 "kubevirt.io/api/core/v1"
 	"kubevirt.io/client-go/log"
@@ -12,6 +15,13 @@ import (
 
 const CancelMigrationFailedVmiNotMigratingErr = "failed to cancel migration - vmi is not migrating"
 
+const maxParallelMigrationChannels = 255
+
+var maxCompressionLevels = map[v1.MigrationCompressionMethod]int32{
+	v1.MigrationCompressionZlib: 9,
+	v1.MigrationCompressionZstd: 20,
+}
+
 func ListUnfinishedMigrations(store cache.Store) []*v1.VirtualMachineInstanceMigration {
 	objs := store.List()
 	migrations := []*v1.VirtualMachineInstanceMigration{}
@@ -126,3 +136,37 @@ func ActiveMigrationExistsForVMI(migrationIndexer cache.Indexer, vmi *v1.Virtual
 
 	return false, nil
 }
+
+// ValidateParallelMigration validates the multifd channels and the compression of a migration configuration or policy
+func ValidateParallelMigration(field *k8sfield.Path, channels *uint32, compression *v1.MigrationCompression) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+
+	if channels != nil && (*channels < 1 || *channels > maxParallelMigrationChannels) {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("must be between 1 and %d", maxParallelMigrationChannels),
+			Field:   field.Child("parallelMigrationChannels").String(),
+		})
+	}
+
+	if compression == nil {
+		return causes
+	}
+
+	maxLevel, supported := maxCompressionLevels[compression.Method]
+	if !supported {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueNotSupported,
+			Message: fmt.Sprintf("unsupported compression method %q", compression.Method),
+			Field:   field.Child("compression", "method").String(),
+		})
+	} else if compression.Level != nil && (*compression.Level < 1 || *compression.Level > maxLevel) {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("must be between 1 and %d for %s", maxLevel, compression.Method),
+			Field:   field.Child("compression", "level").String(),
+		})
+	}
+
+	return causes
+}
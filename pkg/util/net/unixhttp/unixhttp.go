@@ -0,0 +1,103 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package unixhttp
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"time"
+
+	"kubevirt.io/kubevirt/pkg/util"
+)
+
+// Serve serves the handler on the UNIX socket until the stop channel is closed. The socket is created with the
+// given mode in a private directory and only then moved to its path, so that it is never accessible with the
+// permissions of the umask.
+func Serve(socketPath string, mode os.FileMode, handler http.Handler, stop <-chan struct{}) error {
+	listener, err := listen(socketPath, mode)
+	if err != nil {
+		return err
+	}
+	defer os.Remove(socketPath)
+
+	server := &http.Server{
+		Handler:           handler,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	go func() {
+		<-stop
+		server.Close()
+	}()
+
+	if err := server.Serve(listener); err != nil && err != http.ErrServerClosed {
+		return err
+	}
+	return nil
+}
+
+func listen(socketPath string, mode os.FileMode) (net.Listener, error) {
+	dir := filepath.Dir(socketPath)
+	if err := util.MkdirAllWithNosec(dir); err != nil {
+		return nil, fmt.Errorf("unable to create directory for unix socket %s: %v", socketPath, err)
+	}
+	// MkdirTemp creates the directory with the mode 0700
+	privateDir, err := os.MkdirTemp(dir, "."+filepath.Base(socketPath)+"-")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(privateDir)
+
+	privatePath := filepath.Join(privateDir, "socket")
+	listener, err := net.ListenUnix("unix", &net.UnixAddr{Name: privatePath, Net: "unix"})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create unix socket %s: %v", socketPath, err)
+	}
+	// the socket is moved away from the path it was created on
+	listener.SetUnlinkOnClose(false)
+
+	if err := os.Chmod(privatePath, mode); err != nil {
+		listener.Close()
+		return nil, err
+	}
+	if err := os.Remove(socketPath); err != nil && !os.IsNotExist(err) {
+		listener.Close()
+		return nil, err
+	}
+	if err := os.Rename(privatePath, socketPath); err != nil {
+		listener.Close()
+		return nil, err
+	}
+	return listener, nil
+}
+
+// Client returns an HTTP client talking to a server on the UNIX socket, the host of the URLs is ignored
+func Client(socketPath string) *http.Client {
+	return &http.Client{
+		Transport: &http.Transport{
+			DialContext: func(ctx context.Context, _, _ string) (net.Conn, error) {
+				return (&net.Dialer{}).DialContext(ctx, "unix", socketPath)
+			},
+		},
+	}
+}
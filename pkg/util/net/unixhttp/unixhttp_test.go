@@ -0,0 +1,71 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package unixhttp
+
+import (
+	"net/http"
+	"os"
+	"path/filepath"
+	"syscall"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("HTTP on a UNIX socket", func() {
+	It("should serve on a socket which never had the permissions of the umask", func() {
+		dir := GinkgoT().TempDir()
+		socketPath := filepath.Join(dir, "api.sock")
+		Expect(os.WriteFile(socketPath, []byte("stale"), 0644)).To(Succeed())
+
+		oldUmask := syscall.Umask(0)
+		defer syscall.Umask(oldUmask)
+
+		stop := make(chan struct{})
+		done := make(chan error)
+		go func() {
+			done <- Serve(socketPath, 0600, http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(http.StatusNoContent)
+			}), stop)
+		}()
+
+		var response *http.Response
+		Eventually(func() error {
+			var err error
+			response, err = Client(socketPath).Get("http://localhost/")
+			return err
+		}).Should(Succeed())
+		defer response.Body.Close()
+		Expect(response.StatusCode).To(Equal(http.StatusNoContent))
+
+		info, err := os.Stat(socketPath)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(info.Mode().Type()).To(Equal(os.ModeSocket))
+		Expect(info.Mode().Perm()).To(Equal(os.FileMode(0600)))
+		By("removing the private directory the socket was created in")
+		entries, err := os.ReadDir(dir)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(entries).To(HaveLen(1))
+
+		close(stop)
+		Eventually(done).Should(Receive(BeNil()))
+		Expect(socketPath).ToNot(BeAnExistingFile())
+	})
+})
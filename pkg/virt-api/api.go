@@ -65,6 +65,7 @@ import (
 	metrics "kubevirt.io/kubevirt/pkg/monitoring/metrics/virt-api"
 	"kubevirt.io/kubevirt/pkg/monitoring/profiler"
 	netadmitter "kubevirt.io/kubevirt/pkg/network/admitter"
+	"kubevirt.io/kubevirt/pkg/network/macpool"
 	mime "kubevirt.io/kubevirt/pkg/rest"
 	"kubevirt.io/kubevirt/pkg/rest/filter"
 	"kubevirt.io/kubevirt/pkg/service"
@@ -234,6 +235,9 @@ func (app *virtAPIApp) composeSubresources() {
 		subresourcesvmGVR := schema.GroupVersionResource{Group: version.Group, Version: version.Version, Resource: "virtualmachines"}
 		subresourcesvmiGVR := schema.GroupVersionResource{Group: version.Group, Version: version.Version, Resource: "virtualmachineinstances"}
 		expandvmspecGVR := schema.GroupVersionResource{Group: version.Group, Version: version.Version, Resource: "expand-vm-spec"}
+		subresourcesvmimGVR := schema.GroupVersionResource{
+			Group: version.Group, Version: version.Version, Resource: "virtualmachineinstancemigrations",
+		}
 
 		subws := new(restful.WebService)
 		subws.Doc(fmt.Sprintf("KubeVirt \"%s\" Subresource API.", version.Version))
@@ -397,6 +401,12 @@ func (app *virtAPIApp) composeSubresources() {
 			Param(definitions.NamespaceParam(subws)).Param(definitions.NameParam(subws)).Param(definitions.VSOCKPortParameter(subws)).Param(definitions.VSOCKTLSParameter(subws)).
 			Operation(version.Version + "VSOCK").
 			Doc("Open a websocket connection forwarding traffic to the specified VirtualMachineInstance and port via VSOCK."))
+		subws.Route(subws.GET(definitions.NamespacedResourcePath(subresourcesvmiGVR) + definitions.SubResourcePath("pcap")).
+			To(subresourceApp.PacketCaptureRequestHandler).
+			Param(definitions.NamespaceParam(subws)).Param(definitions.NameParam(subws)).
+			Param(definitions.PacketCaptureInterfaceParameter(subws)).Param(definitions.PacketCaptureDurationParameter(subws)).
+			Operation(version.Version + "PacketCapture").
+			Doc("Open a websocket connection streaming the traffic of an interface of the specified VirtualMachineInstance in the pcap format."))
 
 		// VM endpoint
 		subws.Route(subws.GET(definitions.NamespacedResourcePath(subresourcesvmGVR) + definitions.SubResourcePath("portforward") + definitions.PortPath).
@@ -484,6 +494,17 @@ func (app *virtAPIApp) composeSubresources() {
 			Writes(v1.VirtualMachineInstanceFileSystemList{}).
 			Returns(http.StatusOK, "OK", v1.VirtualMachineInstanceFileSystemList{}))
 
+		subws.Route(subws.GET(definitions.NamespacedResourcePath(subresourcesvmiGVR)+definitions.SubResourcePath("migrationfeasibility")).
+			To(subresourceApp.MigrationFeasibilityRequestHandler).
+			Produces(restful.MIME_JSON).
+			Param(definitions.NamespaceParam(subws)).Param(definitions.NameParam(subws)).
+			Operation(version.Version+"MigrationFeasibility").
+			Doc("Check whether a VirtualMachineInstance can be live migrated and report what blocks it").
+			Writes(v1.VirtualMachineInstanceMigrationFeasibility{}).
+			Returns(http.StatusOK, "OK", v1.VirtualMachineInstanceMigrationFeasibility{}).
+			Returns(http.StatusNotFound, httpStatusNotFoundMessage, "").
+			Returns(http.StatusInternalServerError, httpStatusInternalServerError, ""))
+
 		subws.Route(subws.PUT(definitions.NamespacedResourcePath(subresourcesvmiGVR)+definitions.SubResourcePath("addvolume")).
 			To(subresourceApp.VMIAddVolumeRequestHandler).
 			Consumes(mime.MIME_ANY).
@@ -504,6 +525,19 @@ func (app *virtAPIApp) composeSubresources() {
 			Returns(http.StatusOK, "OK", "").
 			Returns(http.StatusBadRequest, httpStatusBadRequestMessage, ""))
 
+		subws.Route(subws.PUT(definitions.NamespacedResourcePath(subresourcesvmiGVR)+definitions.SubResourcePath("setlinkstate")).
+			To(subresourceApp.SetLinkStateRequestHandler).
+			Consumes(mime.MIME_ANY).
+			Reads(v1.SetLinkStateOptions{}).
+			Param(definitions.NamespaceParam(subws)).Param(definitions.NameParam(subws)).
+			Operation(version.Version+"SetLinkState").
+			Doc("Set the link state of an interface of a running Virtual Machine Instance").
+			Returns(http.StatusAccepted, "Accepted", "").
+			Returns(http.StatusBadRequest, httpStatusBadRequestMessage, "").
+			Returns(http.StatusNotFound, httpStatusNotFoundMessage, "").
+			Returns(http.StatusConflict, "Conflict", "").
+			Returns(http.StatusInternalServerError, httpStatusInternalServerError, ""))
+
 		subws.Route(subws.PUT(definitions.NamespacedResourcePath(subresourcesvmGVR)+definitions.SubResourcePath("addvolume")).
 			To(subresourceApp.VMAddVolumeRequestHandler).
 			Consumes(mime.MIME_ANY).
@@ -583,6 +617,20 @@ func (app *virtAPIApp) composeSubresources() {
 			Returns(http.StatusOK, "OK", "").
 			Returns(http.StatusBadRequest, httpStatusBadRequestMessage, ""))
 
+		cancelMigrationRouteBuilder := subws.PUT(definitions.NamespacedResourcePath(subresourcesvmimGVR)+definitions.SubResourcePath("cancel")).
+			To(subresourceApp.CancelMigrationRequestHandler).
+			Consumes(mime.MIME_ANY).
+			Reads(v1.MigrationCancelOptions{}).
+			Param(definitions.NamespaceParam(subws)).Param(definitions.NameParam(subws)).
+			Operation(version.Version+"Cancel").
+			Doc("Cancel a VirtualMachineInstanceMigration object.").
+			Returns(http.StatusAccepted, "Accepted", "").
+			Returns(http.StatusNotFound, httpStatusNotFoundMessage, "").
+			Returns(http.StatusConflict, "Conflict", "").
+			Returns(http.StatusInternalServerError, httpStatusInternalServerError, "")
+		cancelMigrationRouteBuilder.ParameterNamed("body").Required(false)
+		subws.Route(cancelMigrationRouteBuilder)
+
 		// Return empty api resource list.
 		// K8s expects to be able to retrieve a resource list for each aggregated
 		// app in order to discover what resources it provides. Without returning
@@ -613,6 +661,10 @@ func (app *virtAPIApp) composeSubresources() {
 						Name:       "virtualmachineinstances/portforward",
 						Namespaced: true,
 					},
+					{
+						Name:       "virtualmachineinstances/pcap",
+						Namespaced: true,
+					},
 					{
 						Name:       "virtualmachineinstances/pause",
 						Namespaced: true,
@@ -669,6 +721,10 @@ func (app *virtAPIApp) composeSubresources() {
 						Name:       "virtualmachineinstances/filesystemlist",
 						Namespaced: true,
 					},
+					{
+						Name:       "virtualmachineinstances/migrationfeasibility",
+						Namespaced: true,
+					},
 					{
 						Name:       "virtualmachineinstances/addvolume",
 						Namespaced: true,
@@ -677,6 +733,10 @@ func (app *virtAPIApp) composeSubresources() {
 						Name:       "virtualmachineinstances/removevolume",
 						Namespaced: true,
 					},
+					{
+						Name:       "virtualmachineinstances/setlinkstate",
+						Namespaced: true,
+					},
 					{
 						Name:       "virtualmachineinstances/sev/fetchcertchain",
 						Namespaced: true,
@@ -693,6 +753,10 @@ func (app *virtAPIApp) composeSubresources() {
 						Name:       "virtualmachineinstances/sev/injectlaunchsecret",
 						Namespaced: true,
 					},
+					{
+						Name:       "virtualmachineinstancemigrations/cancel",
+						Namespaced: true,
+					},
 				}
 
 				response.WriteAsJson(list)
@@ -912,6 +976,9 @@ func (app *virtAPIApp) registerValidatingWebhooks(informers *webhooks.Informers)
 	http.HandleFunc(components.VMSnapshotValidatePath, func(w http.ResponseWriter, r *http.Request) {
 		validating_webhook.ServeVMSnapshots(w, r, app.clusterConfig, app.virtCli)
 	})
+	http.HandleFunc(components.VMSnapshotScheduleValidatePath, func(w http.ResponseWriter, r *http.Request) {
+		validating_webhook.ServeVMSnapshotSchedules(w, r, app.clusterConfig)
+	})
 	http.HandleFunc(components.VMRestoreValidatePath, func(w http.ResponseWriter, r *http.Request) {
 		validating_webhook.ServeVMRestores(w, r, app.clusterConfig, app.virtCli, informers)
 	})
@@ -945,9 +1012,10 @@ func (app *virtAPIApp) registerValidatingWebhooks(informers *webhooks.Informers)
 }
 
 func (app *virtAPIApp) registerMutatingWebhook(informers *webhooks.Informers) {
+	macPool := macpool.New(informers.VMInformer.GetIndexer(), app.virtCli.CoreV1().ConfigMaps(app.namespace))
 
 	http.HandleFunc(components.VMMutatePath, func(w http.ResponseWriter, r *http.Request) {
-		mutating_webhook.ServeVMs(w, r, app.clusterConfig, app.virtCli)
+		mutating_webhook.ServeVMs(w, r, app.clusterConfig, app.virtCli, macPool)
 	})
 	http.HandleFunc(components.VMIMutatePath, func(w http.ResponseWriter, r *http.Request) {
 		mutating_webhook.ServeVMIs(w, r, app.clusterConfig, informers, app.kubeVirtServiceAccounts)
@@ -1090,6 +1158,8 @@ func (app *virtAPIApp) Run() {
 	vmiPresetInformer := kubeInformerFactory.VirtualMachinePreset()
 	vmRestoreInformer := kubeInformerFactory.VirtualMachineRestore()
 	namespaceInformer := kubeInformerFactory.Namespace()
+	nodeInformer := kubeInformerFactory.KubeVirtNode()
+	vmInformer := kubeInformerFactory.VirtualMachine()
 
 	stopChan := make(chan struct{}, 1)
 	defer close(stopChan)
@@ -1128,6 +1198,8 @@ func (app *virtAPIApp) Run() {
 		VMRestoreInformer:  vmRestoreInformer,
 		DataSourceInformer: dataSourceInformer,
 		NamespaceInformer:  namespaceInformer,
+		NodeInformer:       nodeInformer,
+		VMInformer:         vmInformer,
 	}
 
 	// Build webhook subresources
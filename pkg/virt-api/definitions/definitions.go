@@ -43,6 +43,7 @@ import (
 	exportv1 "kubevirt.io/api/export/v1beta1"
 	instancetypev1beta1 "kubevirt.io/api/instancetype/v1beta1"
 	poolv1alpha1 "kubevirt.io/api/pool/v1alpha1"
+	prefetchv1alpha1 "kubevirt.io/api/prefetch/v1alpha1"
 	snapshotv1 "kubevirt.io/api/snapshot/v1beta1"
 
 	mime "kubevirt.io/kubevirt/pkg/rest"
@@ -64,6 +65,7 @@ func ComposeAPIDefinitions() []*restful.WebService {
 		migrationPoliciesApiServiceDefinitions,
 		poolApiServiceDefinitions,
 		vmCloneDefinitions,
+		prefetchApiServiceDefinitions,
 	} {
 		result = append(result, f()...)
 	}
@@ -125,6 +127,7 @@ func snapshotApiServiceDefinitions() []*restful.WebService {
 	vmsGVR := snapshotv1.SchemeGroupVersion.WithResource("virtualmachinesnapshots")
 	vmscGVR := snapshotv1.SchemeGroupVersion.WithResource("virtualmachinesnapshotcontents")
 	vmrGVR := snapshotv1.SchemeGroupVersion.WithResource("virtualmachinerestores")
+	vmssGVR := snapshotv1.SchemeGroupVersion.WithResource("virtualmachinesnapshotschedules")
 
 	ws, err := groupVersionProxyBase(schema.GroupVersion{Group: snapshotv1.SchemeGroupVersion.Group, Version: snapshotv1.SchemeGroupVersion.Version})
 	if err != nil {
@@ -146,6 +149,12 @@ func snapshotApiServiceDefinitions() []*restful.WebService {
 		panic(err)
 	}
 
+	ws, err = genericNamespacedResourceProxy(ws, vmssGVR, &snapshotv1.VirtualMachineSnapshotSchedule{}, "VirtualMachineSnapshotSchedule",
+		&snapshotv1.VirtualMachineSnapshotScheduleList{})
+	if err != nil {
+		panic(err)
+	}
+
 	ws2, err := resourceProxyAutodiscovery(vmsGVR)
 	if err != nil {
 		panic(err)
@@ -253,6 +262,27 @@ func poolApiServiceDefinitions() []*restful.WebService {
 	return []*restful.WebService{ws, ws2}
 }
 
+func prefetchApiServiceDefinitions() []*restful.WebService {
+	prefetchGVR := prefetchv1alpha1.SchemeGroupVersion.WithResource("imageprefetches")
+
+	ws, err := groupVersionProxyBase(prefetchv1alpha1.SchemeGroupVersion)
+	if err != nil {
+		panic(err)
+	}
+
+	ws, err = genericNamespacedResourceProxy(ws, prefetchGVR, &prefetchv1alpha1.ImagePrefetch{}, prefetchv1alpha1.ImagePrefetchKind, &prefetchv1alpha1.ImagePrefetchList{})
+	if err != nil {
+		panic(err)
+	}
+
+	ws2, err := resourceProxyAutodiscovery(prefetchGVR)
+	if err != nil {
+		panic(err)
+	}
+
+	return []*restful.WebService{ws, ws2}
+}
+
 func vmCloneDefinitions() []*restful.WebService {
 	mpGVR := clone.SchemeGroupVersion.WithResource(clonebase.ResourceVMClonePlural)
 
@@ -663,11 +693,13 @@ func SubResourcePath(subResource string) string {
 }
 
 const (
-	PortParamName     = "port"
-	TLSParamName      = "tls"
-	PortPath          = "/{port}"
-	ProtocolParamName = "protocol"
-	ProtocolPath      = "/{protocol}"
+	PortParamName      = "port"
+	TLSParamName       = "tls"
+	PortPath           = "/{port}"
+	ProtocolParamName  = "protocol"
+	ProtocolPath       = "/{protocol}"
+	InterfaceParamName = "interface"
+	DurationParamName  = "duration"
 )
 
 func PortForwardPortParameter(ws *restful.WebService) *restful.Parameter {
@@ -687,3 +719,11 @@ func VSOCKPortParameter(ws *restful.WebService) *restful.Parameter {
 func VSOCKTLSParameter(ws *restful.WebService) *restful.Parameter {
 	return ws.QueryParameter(TLSParamName, "Weather to request a TLS encrypted session from the VSOCK application.").DataType("boolean").Required(false)
 }
+
+func PacketCaptureInterfaceParameter(ws *restful.WebService) *restful.Parameter {
+	return ws.QueryParameter(InterfaceParamName, "The name of the VirtualMachineInstance interface to capture the traffic of.").DataType("string").Required(true)
+}
+
+func PacketCaptureDurationParameter(ws *restful.WebService) *restful.Parameter {
+	return ws.QueryParameter(DurationParamName, "The duration of the capture, e.g. 60s. The capture lasts until the connection is closed if omitted.").DataType("string").Required(false)
+}
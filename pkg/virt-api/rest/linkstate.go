@@ -0,0 +1,182 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package rest
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/emicklei/go-restful/v3"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
+	"kubevirt.io/kubevirt/pkg/network/vmispec"
+)
+
+const interfacesPathFmt = "%s/domain/devices/interfaces/%d"
+
+// SetLinkStateRequestHandler sets the link state of an interface of a running VMI. virt-handler applies the
+// new state to the domain, and it is reported in the linkState of the interface status.
+// When the VMI is controlled by a VM, the state is set on the VM template, which is propagated to the VMI.
+func (app *SubresourceAPIApp) SetLinkStateRequestHandler(request *restful.Request, response *restful.Response) {
+	name := request.PathParameter("name")
+	namespace := request.PathParameter("namespace")
+
+	if request.Request.Body == nil {
+		writeError(errors.NewBadRequest("Request with no body, SetLinkStateOptions are expected as the request body"), response)
+		return
+	}
+
+	opts := &v1.SetLinkStateOptions{}
+	defer request.Request.Body.Close()
+	if err := decodeBody(request, opts); err != nil {
+		writeError(err, response)
+		return
+	}
+
+	if opts.Name == "" {
+		writeError(errors.NewBadRequest("SetLinkStateOptions requires name to be set"), response)
+		return
+	}
+	if opts.State != v1.InterfaceStateLinkUp && opts.State != v1.InterfaceStateLinkDown {
+		writeError(errors.NewBadRequest(fmt.Sprintf("SetLinkStateOptions requires state to be %q or %q",
+			v1.InterfaceStateLinkUp, v1.InterfaceStateLinkDown)), response)
+		return
+	}
+
+	vmi, statErr := app.FetchVirtualMachineInstance(namespace, name)
+	if statErr != nil {
+		writeError(statErr, response)
+		return
+	}
+
+	if !vmi.IsRunning() {
+		writeError(errors.NewConflict(v1.Resource("virtualmachineinstance"), name, fmt.Errorf(vmiNotRunning)), response)
+		return
+	}
+
+	iface := vmispec.LookupInterfaceByName(vmi.Spec.Domain.Devices.Interfaces, opts.Name)
+	if err := verifyLinkStateChange(iface, opts.Name); err != nil {
+		writeError(errors.NewConflict(v1.Resource("virtualmachineinstance"), name, err), response)
+		return
+	}
+	if linkStateOf(iface.State) == opts.State {
+		response.WriteHeader(http.StatusAccepted)
+		return
+	}
+
+	if owner := metav1.GetControllerOf(vmi); owner != nil && owner.Kind == v1.VirtualMachineGroupVersionKind.Kind {
+		statErr = app.vmLinkStatePatch(owner.Name, namespace, opts)
+	} else {
+		statErr = app.vmiLinkStatePatch(vmi, opts)
+	}
+	if statErr != nil {
+		writeError(statErr, response)
+		return
+	}
+
+	response.WriteHeader(http.StatusAccepted)
+}
+
+func (app *SubresourceAPIApp) vmiLinkStatePatch(vmi *v1.VirtualMachineInstance, opts *v1.SetLinkStateOptions) *errors.StatusError {
+	patchBytes, err := generateLinkStatePatch("/spec", vmi.Spec.Domain.Devices.Interfaces, opts)
+	if err != nil {
+		return errors.NewConflict(v1.Resource("virtualmachineinstance"), vmi.Name, err)
+	}
+
+	log.Log.Object(vmi).V(4).Infof("Patching VMI: %s", string(patchBytes))
+	if _, err := app.virtCli.VirtualMachineInstance(vmi.Namespace).Patch(
+		context.Background(), vmi.Name, types.JSONPatchType, patchBytes, metav1.PatchOptions{DryRun: opts.DryRun},
+	); err != nil {
+		return linkStatePatchError("virtualmachineinstance", vmi.Name, err)
+	}
+	return nil
+}
+
+func (app *SubresourceAPIApp) vmLinkStatePatch(name, namespace string, opts *v1.SetLinkStateOptions) *errors.StatusError {
+	vm, statErr := app.fetchVirtualMachine(name, namespace)
+	if statErr != nil {
+		return statErr
+	}
+
+	patchBytes, err := generateLinkStatePatch("/spec/template/spec", vm.Spec.Template.Spec.Domain.Devices.Interfaces, opts)
+	if err != nil {
+		return errors.NewConflict(v1.Resource("virtualmachine"), name, err)
+	}
+
+	log.Log.Object(vm).V(4).Infof("Patching VM: %s", string(patchBytes))
+	if _, err := app.virtCli.VirtualMachine(namespace).Patch(
+		context.Background(), name, types.JSONPatchType, patchBytes, metav1.PatchOptions{DryRun: opts.DryRun},
+	); err != nil {
+		return linkStatePatchError("virtualmachine", name, err)
+	}
+	return nil
+}
+
+func generateLinkStatePatch(specPath string, ifaces []v1.Interface, opts *v1.SetLinkStateOptions) ([]byte, error) {
+	for i, iface := range ifaces {
+		if iface.Name != opts.Name {
+			continue
+		}
+		ifacePath := fmt.Sprintf(interfacesPathFmt, specPath, i)
+		return patch.New(
+			patch.WithTest(ifacePath+"/name", iface.Name),
+			patch.WithAdd(ifacePath+"/state", opts.State),
+		).GeneratePayload()
+	}
+	return nil, fmt.Errorf("interface %s does not exist", opts.Name)
+}
+
+func linkStatePatchError(resource, name string, err error) *errors.StatusError {
+	if strings.Contains(err.Error(), jsonpatchTestErr) {
+		return errors.NewConflict(v1.Resource(resource), name, err)
+	}
+	if statErr, ok := err.(*errors.StatusError); ok && errors.IsInvalid(err) {
+		return statErr
+	}
+	return errors.NewInternalError(fmt.Errorf("unable to patch %s: %v", resource, err))
+}
+
+func verifyLinkStateChange(iface *v1.Interface, name string) error {
+	switch {
+	case iface == nil:
+		return fmt.Errorf("interface %s does not exist", name)
+	case iface.State == v1.InterfaceStateAbsent:
+		return fmt.Errorf("interface %s is being unplugged", name)
+	case iface.SRIOV != nil:
+		return fmt.Errorf("the link state of the SR-IOV interface %s cannot be set", name)
+	}
+	return nil
+}
+
+// linkStateOf returns the link state of an interface, which is up unless it is set to down.
+func linkStateOf(state v1.InterfaceState) v1.InterfaceState {
+	if state == v1.InterfaceStateLinkDown {
+		return v1.InterfaceStateLinkDown
+	}
+	return v1.InterfaceStateLinkUp
+}
@@ -0,0 +1,185 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package rest
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/emicklei/go-restful/v3"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+	kubevirtfake "kubevirt.io/client-go/kubevirt/fake"
+
+	"kubevirt.io/kubevirt/pkg/libvmi"
+	libvmistatus "kubevirt.io/kubevirt/pkg/libvmi/status"
+)
+
+var _ = Describe("Set link state subresource", func() {
+	const (
+		bridgeNetName = "blue"
+		sriovNetName  = "red"
+	)
+
+	var (
+		request    *restful.Request
+		response   *restful.Response
+		recorder   *httptest.ResponseRecorder
+		app        *SubresourceAPIApp
+		virtClient *kubevirtfake.Clientset
+	)
+
+	newVMI := func(phase v1.VirtualMachineInstancePhase) *v1.VirtualMachineInstance {
+		return libvmi.New(
+			libvmi.WithName(testVMIName),
+			libvmi.WithNamespace(metav1.NamespaceDefault),
+			libvmi.WithInterface(libvmi.InterfaceDeviceWithBridgeBinding(bridgeNetName)),
+			libvmi.WithNetwork(libvmi.MultusNetwork(bridgeNetName, "bridge-nad")),
+			libvmi.WithInterface(libvmi.InterfaceDeviceWithSRIOVBinding(sriovNetName)),
+			libvmi.WithNetwork(libvmi.MultusNetwork(sriovNetName, "sriov-nad")),
+			libvmistatus.WithStatus(libvmistatus.New(libvmistatus.WithPhase(phase))),
+		)
+	}
+
+	setup := func(objects ...runtime.Object) {
+		virtClient = kubevirtfake.NewSimpleClientset(objects...)
+		mockVirtClient := kubecli.NewMockKubevirtClient(gomock.NewController(GinkgoT()))
+		mockVirtClient.EXPECT().VirtualMachineInstance(metav1.NamespaceDefault).
+			Return(virtClient.KubevirtV1().VirtualMachineInstances(metav1.NamespaceDefault)).AnyTimes()
+		mockVirtClient.EXPECT().VirtualMachine(metav1.NamespaceDefault).
+			Return(virtClient.KubevirtV1().VirtualMachines(metav1.NamespaceDefault)).AnyTimes()
+		app = &SubresourceAPIApp{virtCli: mockVirtClient}
+	}
+
+	setBody := func(opts *v1.SetLinkStateOptions) {
+		body, err := json.Marshal(opts)
+		Expect(err).ToNot(HaveOccurred())
+		request.Request.Body = io.NopCloser(bytes.NewBuffer(body))
+	}
+
+	interfaceState := func(ifaces []v1.Interface, name string) v1.InterfaceState {
+		for _, iface := range ifaces {
+			if iface.Name == name {
+				return iface.State
+			}
+		}
+		Fail("interface " + name + " not found")
+		return ""
+	}
+
+	getVMI := func() *v1.VirtualMachineInstance {
+		vmi, err := virtClient.KubevirtV1().VirtualMachineInstances(metav1.NamespaceDefault).
+			Get(context.Background(), testVMIName, metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		return vmi
+	}
+
+	BeforeEach(func() {
+		request = restful.NewRequest(&http.Request{})
+		request.PathParameters()["name"] = testVMIName
+		request.PathParameters()["namespace"] = metav1.NamespaceDefault
+		recorder = httptest.NewRecorder()
+		response = restful.NewResponse(recorder)
+		response.SetRequestAccepts(restful.MIME_JSON)
+	})
+
+	It("should set the link state on the VMI spec", func() {
+		setup(newVMI(v1.Running))
+		setBody(&v1.SetLinkStateOptions{Name: bridgeNetName, State: v1.InterfaceStateLinkDown})
+
+		app.SetLinkStateRequestHandler(request, response)
+		Expect(recorder.Code).To(Equal(http.StatusAccepted))
+		Expect(interfaceState(getVMI().Spec.Domain.Devices.Interfaces, bridgeNetName)).To(Equal(v1.InterfaceStateLinkDown))
+	})
+
+	It("should set the link state on the template of the controlling VM", func() {
+		vmi := newVMI(v1.Running)
+		vm := libvmi.NewVirtualMachine(vmi)
+		vmi.OwnerReferences = []metav1.OwnerReference{*metav1.NewControllerRef(vm, v1.VirtualMachineGroupVersionKind)}
+		setup(vmi, vm)
+		setBody(&v1.SetLinkStateOptions{Name: bridgeNetName, State: v1.InterfaceStateLinkDown})
+
+		app.SetLinkStateRequestHandler(request, response)
+		Expect(recorder.Code).To(Equal(http.StatusAccepted))
+
+		vm, err := virtClient.KubevirtV1().VirtualMachines(metav1.NamespaceDefault).
+			Get(context.Background(), testVMIName, metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(interfaceState(vm.Spec.Template.Spec.Domain.Devices.Interfaces, bridgeNetName)).To(Equal(v1.InterfaceStateLinkDown))
+		Expect(interfaceState(getVMI().Spec.Domain.Devices.Interfaces, bridgeNetName)).To(BeEmpty())
+	})
+
+	It("should not patch when the link is already in the requested state", func() {
+		setup(newVMI(v1.Running))
+		setBody(&v1.SetLinkStateOptions{Name: bridgeNetName, State: v1.InterfaceStateLinkUp})
+
+		app.SetLinkStateRequestHandler(request, response)
+		Expect(recorder.Code).To(Equal(http.StatusAccepted))
+		Expect(interfaceState(getVMI().Spec.Domain.Devices.Interfaces, bridgeNetName)).To(BeEmpty())
+	})
+
+	DescribeTable("should reject invalid options", func(opts *v1.SetLinkStateOptions) {
+		setup(newVMI(v1.Running))
+		setBody(opts)
+
+		app.SetLinkStateRequestHandler(request, response)
+		ExpectStatusErrorWithCode(recorder, http.StatusBadRequest)
+	},
+		Entry("without a name", &v1.SetLinkStateOptions{State: v1.InterfaceStateLinkDown}),
+		Entry("with an unknown state", &v1.SetLinkStateOptions{Name: bridgeNetName, State: v1.InterfaceStateAbsent}),
+	)
+
+	It("should reject a request without a body", func() {
+		setup(newVMI(v1.Running))
+
+		app.SetLinkStateRequestHandler(request, response)
+		ExpectStatusErrorWithCode(recorder, http.StatusBadRequest)
+	})
+
+	DescribeTable("should refuse to set the link state", func(phase v1.VirtualMachineInstancePhase, name string) {
+		setup(newVMI(phase))
+		setBody(&v1.SetLinkStateOptions{Name: name, State: v1.InterfaceStateLinkDown})
+
+		app.SetLinkStateRequestHandler(request, response)
+		ExpectStatusErrorWithCode(recorder, http.StatusConflict)
+	},
+		Entry("when the VMI is not running", v1.Scheduled, bridgeNetName),
+		Entry("of an unknown interface", v1.Running, "green"),
+		Entry("of an SR-IOV interface", v1.Running, sriovNetName),
+	)
+
+	It("should fail if the VMI does not exist", func() {
+		setup()
+		setBody(&v1.SetLinkStateOptions{Name: bridgeNetName, State: v1.InterfaceStateLinkDown})
+
+		app.SetLinkStateRequestHandler(request, response)
+		ExpectStatusErrorWithCode(recorder, http.StatusNotFound)
+	})
+})
@@ -45,8 +45,12 @@ const (
 	pvcAccessModeErr          = "pvc access mode can't be read only"
 	pvcSizeErrFmt             = "pvc size [%s] should be bigger then [%s]"
 	memoryDumpNameConflictErr = "can't request memory dump for pvc [%s] while pvc [%s] is still associated as the memory dump pvc"
+	chunkSizeErrFmt           = "memory dump chunk size should be at least [%s]"
 )
 
+// minMemoryDumpChunkSize keeps the dump from being flushed to disk for every few pages
+var minMemoryDumpChunkSize = resource.MustParse("1Mi")
+
 func (app *SubresourceAPIApp) fetchPersistentVolumeClaim(name string, namespace string) (*k8sv1.PersistentVolumeClaim, *errors.StatusError) {
 	pvc, err := app.virtCli.CoreV1().PersistentVolumeClaims(namespace).Get(context.TODO(), name, metav1.GetOptions{})
 	if err != nil {
@@ -69,7 +73,8 @@ func (app *SubresourceAPIApp) fetchCDIConfig() (*cdiv1.CDIConfig, *errors.Status
 	return cdiConfig, nil
 }
 
-func (app *SubresourceAPIApp) validateMemoryDumpClaim(vmi *v1.VirtualMachineInstance, claimName, namespace string) *errors.StatusError {
+func (app *SubresourceAPIApp) validateMemoryDumpClaim(vmi *v1.VirtualMachineInstance, claimName, namespace string,
+	compressed bool) *errors.StatusError {
 	pvc, err := app.fetchPersistentVolumeClaim(claimName, namespace)
 	if err != nil {
 		return err
@@ -82,6 +87,11 @@ func (app *SubresourceAPIApp) validateMemoryDumpClaim(vmi *v1.VirtualMachineInst
 		return errors.NewConflict(v1.Resource("persistentvolumeclaim"), claimName, fmt.Errorf(pvcAccessModeErr))
 	}
 
+	// The size of a compressed dump is not known upfront
+	if compressed {
+		return nil
+	}
+
 	pvcSize := pvc.Spec.Resources.Requests.Storage()
 	scaledPvcSize := resource.NewScaledQuantity(pvcSize.ScaledValue(resource.Kilo), resource.Kilo)
 
@@ -119,6 +129,10 @@ func (app *SubresourceAPIApp) validateMemoryDumpRequest(vm *v1.VirtualMachine, m
 		memoryDumpReq.ClaimName = vm.Status.MemoryDumpRequest.ClaimName
 	}
 
+	if memoryDumpReq.ChunkSize != nil && memoryDumpReq.ChunkSize.Cmp(minMemoryDumpChunkSize) < 0 {
+		return errors.NewBadRequest(fmt.Sprintf(chunkSizeErrFmt, minMemoryDumpChunkSize.String()))
+	}
+
 	vmi, statErr := app.FetchVirtualMachineInstance(vm.Namespace, vm.Name)
 	if statErr != nil {
 		return statErr
@@ -128,7 +142,7 @@ func (app *SubresourceAPIApp) validateMemoryDumpRequest(vm *v1.VirtualMachine, m
 		return errors.NewConflict(v1.Resource("virtualmachineinstance"), vm.Name, fmt.Errorf(vmiNotRunning))
 	}
 
-	if statErr = app.validateMemoryDumpClaim(vmi, memoryDumpReq.ClaimName, vm.Namespace); statErr != nil {
+	if statErr = app.validateMemoryDumpClaim(vmi, memoryDumpReq.ClaimName, vm.Namespace, memoryDumpReq.Compression != ""); statErr != nil {
 		return statErr
 	}
 
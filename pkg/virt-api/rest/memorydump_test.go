@@ -52,6 +52,7 @@ import (
 	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
 	"kubevirt.io/kubevirt/pkg/libvmi"
 	libvmistatus "kubevirt.io/kubevirt/pkg/libvmi/status"
+	"kubevirt.io/kubevirt/pkg/pointer"
 	storagetypes "kubevirt.io/kubevirt/pkg/storage/types"
 	"kubevirt.io/kubevirt/pkg/testutils"
 	"kubevirt.io/kubevirt/pkg/virt-config/featuregate"
@@ -251,6 +252,14 @@ var _ = Describe("Memory dump Subresource api", func() {
 		Entry("VM with a memory dump request pvc size too small should fail", &v1.VirtualMachineMemoryDumpRequest{
 			ClaimName: testPVCName,
 		}, http.StatusConflict, true, true, createTestPVC("1Gi", fs, notReadOnly)),
+		Entry("VM with a compressed memory dump request to a pvc smaller than the memory should succeed", &v1.VirtualMachineMemoryDumpRequest{
+			ClaimName:   testPVCName,
+			Compression: v1.MemoryDumpCompressionZstd,
+		}, http.StatusAccepted, true, true, createTestPVC("1Gi", fs, notReadOnly)),
+		Entry("VM with a memory dump request with a too small chunk size should fail", &v1.VirtualMachineMemoryDumpRequest{
+			ClaimName: testPVCName,
+			ChunkSize: pointer.P(resource.MustParse("4Ki")),
+		}, http.StatusBadRequest, true, true, createTestPVC("2Gi", fs, notReadOnly)),
 	)
 
 	DescribeTable("With memory dump request", func(memDumpReq, prevMemDumpReq *v1.VirtualMachineMemoryDumpRequest, statusCode int) {
@@ -0,0 +1,109 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package rest
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/emicklei/go-restful/v3"
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
+	"kubevirt.io/kubevirt/pkg/controller"
+)
+
+const migrationFinished = "migration has already finished"
+
+// CancelMigrationRequestHandler asks virt-controller to cancel a migration by adding the migrationCancelRequested
+// condition. virt-controller aborts the migration job, deletes the target pod and moves the migration to the
+// Cancelled phase. Cancelling a migration which is already being cancelled is a no-op.
+func (app *SubresourceAPIApp) CancelMigrationRequestHandler(request *restful.Request, response *restful.Response) {
+	name := request.PathParameter("name")
+	namespace := request.PathParameter("namespace")
+
+	bodyStruct := &v1.MigrationCancelOptions{}
+	if request.Request.Body != nil {
+		if err := decodeBody(request, bodyStruct); err != nil {
+			writeError(err, response)
+			return
+		}
+	}
+
+	migration, err := app.virtCli.VirtualMachineInstanceMigration(namespace).Get(context.Background(), name, metav1.GetOptions{})
+	if err != nil {
+		if errors.IsNotFound(err) {
+			writeError(errors.NewNotFound(v1.Resource("virtualmachineinstancemigration"), name), response)
+			return
+		}
+		writeError(errors.NewInternalError(fmt.Errorf("unable to retrieve migration [%s]: %v", name, err)), response)
+		return
+	}
+
+	if migration.IsFinal() {
+		writeError(errors.NewConflict(v1.Resource("virtualmachineinstancemigration"), name, fmt.Errorf(migrationFinished)), response)
+		return
+	}
+
+	conditionManager := controller.NewVirtualMachineInstanceMigrationConditionManager()
+	if conditionManager.HasCondition(migration, v1.VirtualMachineInstanceMigrationCancelRequested) {
+		response.WriteHeader(http.StatusAccepted)
+		return
+	}
+
+	conditions := append(migration.Status.Conditions, v1.VirtualMachineInstanceMigrationCondition{
+		Type:               v1.VirtualMachineInstanceMigrationCancelRequested,
+		Status:             k8sv1.ConditionTrue,
+		LastProbeTime:      metav1.Now(),
+		LastTransitionTime: metav1.Now(),
+		Reason:             "CancelRequested",
+		Message:            bodyStruct.Reason,
+	})
+	patchBytes, err := patch.New(
+		patch.WithTest("/metadata/resourceVersion", migration.ResourceVersion),
+		patch.WithAdd("/status/conditions", conditions),
+	).GeneratePayload()
+	if err != nil {
+		writeError(errors.NewInternalError(err), response)
+		return
+	}
+
+	log.Log.Object(migration).V(2).Infof("Cancelling migration: %s", string(patchBytes))
+	_, err = app.virtCli.VirtualMachineInstanceMigration(namespace).
+		Patch(context.Background(), name, types.JSONPatchType, patchBytes, metav1.PatchOptions{}, "status")
+	if err != nil {
+		if strings.Contains(err.Error(), jsonpatchTestErr) {
+			writeError(errors.NewConflict(v1.Resource("virtualmachineinstancemigration"), name, err), response)
+		} else {
+			writeError(errors.NewInternalError(err), response)
+		}
+		return
+	}
+
+	response.WriteHeader(http.StatusAccepted)
+}
@@ -0,0 +1,153 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package rest
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/emicklei/go-restful/v3"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	k8sv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+	kubevirtfake "kubevirt.io/client-go/kubevirt/fake"
+
+	"kubevirt.io/kubevirt/pkg/controller"
+)
+
+var _ = Describe("Migration cancel subresource", func() {
+	const migrationName = "testmigration"
+
+	var (
+		request    *restful.Request
+		response   *restful.Response
+		recorder   *httptest.ResponseRecorder
+		app        *SubresourceAPIApp
+		virtClient *kubevirtfake.Clientset
+	)
+
+	newMigration := func(phase v1.VirtualMachineInstanceMigrationPhase) *v1.VirtualMachineInstanceMigration {
+		return &v1.VirtualMachineInstanceMigration{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:            migrationName,
+				Namespace:       metav1.NamespaceDefault,
+				ResourceVersion: "1",
+			},
+			Spec:   v1.VirtualMachineInstanceMigrationSpec{VMIName: testVMIName},
+			Status: v1.VirtualMachineInstanceMigrationStatus{Phase: phase},
+		}
+	}
+
+	setup := func(migrations ...*v1.VirtualMachineInstanceMigration) {
+		virtClient = kubevirtfake.NewSimpleClientset()
+		for _, migration := range migrations {
+			Expect(virtClient.Tracker().Add(migration)).To(Succeed())
+		}
+		mockVirtClient := kubecli.NewMockKubevirtClient(gomock.NewController(GinkgoT()))
+		mockVirtClient.EXPECT().VirtualMachineInstanceMigration(metav1.NamespaceDefault).
+			Return(virtClient.KubevirtV1().VirtualMachineInstanceMigrations(metav1.NamespaceDefault)).AnyTimes()
+		app = &SubresourceAPIApp{virtCli: mockVirtClient}
+	}
+
+	getMigration := func() *v1.VirtualMachineInstanceMigration {
+		migration, err := virtClient.KubevirtV1().VirtualMachineInstanceMigrations(metav1.NamespaceDefault).
+			Get(context.Background(), migrationName, metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		return migration
+	}
+
+	BeforeEach(func() {
+		request = restful.NewRequest(&http.Request{})
+		request.PathParameters()["name"] = migrationName
+		request.PathParameters()["namespace"] = metav1.NamespaceDefault
+		recorder = httptest.NewRecorder()
+		response = restful.NewResponse(recorder)
+		response.SetRequestAccepts(restful.MIME_JSON)
+	})
+
+	It("should request the cancellation with the given reason", func() {
+		setup(newMigration(v1.MigrationRunning))
+		body, err := json.Marshal(&v1.MigrationCancelOptions{Reason: "node maintenance was called off"})
+		Expect(err).ToNot(HaveOccurred())
+		request.Request.Body = io.NopCloser(bytes.NewBuffer(body))
+
+		app.CancelMigrationRequestHandler(request, response)
+		Expect(recorder.Code).To(Equal(http.StatusAccepted))
+
+		migration := getMigration()
+		Expect(migration.Status.Conditions).To(ContainElement(And(
+			HaveField("Type", v1.VirtualMachineInstanceMigrationCancelRequested),
+			HaveField("Status", k8sv1.ConditionTrue),
+			HaveField("Message", "node maintenance was called off"),
+		)))
+	})
+
+	It("should accept a request without a body", func() {
+		setup(newMigration(v1.MigrationPending))
+
+		app.CancelMigrationRequestHandler(request, response)
+		Expect(recorder.Code).To(Equal(http.StatusAccepted))
+		Expect(controller.NewVirtualMachineInstanceMigrationConditionManager().
+			HasCondition(getMigration(), v1.VirtualMachineInstanceMigrationCancelRequested)).To(BeTrue())
+	})
+
+	It("should not add the condition twice", func() {
+		migration := newMigration(v1.MigrationRunning)
+		migration.Status.Conditions = []v1.VirtualMachineInstanceMigrationCondition{{
+			Type:    v1.VirtualMachineInstanceMigrationCancelRequested,
+			Status:  k8sv1.ConditionTrue,
+			Message: "first",
+		}}
+		setup(migration)
+
+		app.CancelMigrationRequestHandler(request, response)
+		Expect(recorder.Code).To(Equal(http.StatusAccepted))
+		Expect(getMigration().Status.Conditions).To(HaveLen(1))
+	})
+
+	DescribeTable("should refuse to cancel a finished migration", func(phase v1.VirtualMachineInstanceMigrationPhase) {
+		setup(newMigration(phase))
+
+		app.CancelMigrationRequestHandler(request, response)
+		Expect(recorder.Code).To(Equal(http.StatusConflict))
+		Expect(recorder.Body.String()).To(ContainSubstring(migrationFinished))
+		Expect(getMigration().Status.Conditions).To(BeEmpty())
+	},
+		Entry("succeeded", v1.MigrationSucceeded),
+		Entry("failed", v1.MigrationFailed),
+		Entry("cancelled", v1.MigrationCancelled),
+	)
+
+	It("should fail if the migration does not exist", func() {
+		setup()
+
+		app.CancelMigrationRequestHandler(request, response)
+		Expect(recorder.Code).To(Equal(http.StatusNotFound))
+	})
+})
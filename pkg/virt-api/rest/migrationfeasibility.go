@@ -0,0 +1,288 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package rest
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/emicklei/go-restful/v3"
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/selection"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/controller"
+)
+
+const (
+	unfitUnschedulable  = "were unschedulable"
+	unfitTaints         = "had untolerated taints"
+	unfitNotSchedulable = "were not marked schedulable for VMs"
+	unfitCPU            = "lacked the CPU model or features of the VMI"
+	unfitNodeSelector   = "didn't match the node selector"
+	unfitNodeAffinity   = "didn't match the node affinity"
+	unfitResources      = "lacked the resources of the VMI"
+)
+
+// MigrationFeasibilityRequestHandler evaluates whether a running VMI can be live migrated without creating a migration.
+// It reports the reason of the LiveMigratable condition and whether any other node fits the migration target pod.
+func (app *SubresourceAPIApp) MigrationFeasibilityRequestHandler(request *restful.Request, response *restful.Response) {
+	name := request.PathParameter("name")
+	namespace := request.PathParameter("namespace")
+
+	vmi, statusErr := app.FetchVirtualMachineInstance(namespace, name)
+	if statusErr != nil {
+		writeError(statusErr, response)
+		return
+	}
+	if !vmi.IsRunning() {
+		writeError(errors.NewConflict(v1.Resource("virtualmachineinstance"), vmi.Name, fmt.Errorf(vmiNotRunning)), response)
+		return
+	}
+
+	feasibility, err := app.migrationFeasibility(vmi)
+	if err != nil {
+		writeError(errors.NewInternalError(err), response)
+		return
+	}
+	if err := response.WriteEntity(feasibility); err != nil {
+		log.Log.Reason(err).Error("Failed to write http response.")
+	}
+}
+
+func (app *SubresourceAPIApp) migrationFeasibility(vmi *v1.VirtualMachineInstance) (*v1.VirtualMachineInstanceMigrationFeasibility, error) {
+	feasibility := &v1.VirtualMachineInstanceMigrationFeasibility{}
+
+	condition := controller.NewVirtualMachineInstanceConditionManager().GetCondition(vmi, v1.VirtualMachineInstanceIsMigratable)
+	if condition != nil && condition.Status == k8sv1.ConditionFalse {
+		feasibility.Blockers = append(feasibility.Blockers, v1.MigrationBlocker{
+			Reason:  condition.Reason,
+			Message: condition.Message,
+		})
+	}
+
+	podName, err := app.findPod(vmi.Namespace, vmi)
+	if err != nil {
+		return nil, fmt.Errorf("unable to find the pod of the VMI: %v", err)
+	}
+	if podName == "" {
+		return nil, fmt.Errorf("the VMI has no running pod")
+	}
+	pod, err := app.virtCli.CoreV1().Pods(vmi.Namespace).Get(context.Background(), podName, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("unable to get the pod %s of the VMI: %v", podName, err)
+	}
+	nodes, err := app.virtCli.CoreV1().Nodes().List(context.Background(), metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("unable to list the nodes: %v", err)
+	}
+
+	nodeSelector := pod.Spec.NodeSelector
+	for i := range nodes.Items {
+		if nodes.Items[i].Name == vmi.Status.NodeName {
+			nodeSelector = migrationTargetNodeSelector(vmi, pod, &nodes.Items[i])
+			break
+		}
+	}
+
+	unfit := map[string]int{}
+	candidates := 0
+	for i := range nodes.Items {
+		node := &nodes.Items[i]
+		if node.Name == vmi.Status.NodeName {
+			continue
+		}
+		candidates++
+		if reason := unfitReason(node, pod, nodeSelector); reason != "" {
+			unfit[reason]++
+			continue
+		}
+		feasibility.TargetNodes = append(feasibility.TargetNodes, node.Name)
+	}
+	if len(feasibility.TargetNodes) == 0 {
+		feasibility.Blockers = append(feasibility.Blockers, v1.MigrationBlocker{
+			Reason:  v1.VirtualMachineInstanceReasonNoMigrationTargetNode,
+			Message: noTargetNodeMessage(candidates, unfit),
+		})
+	}
+
+	feasibility.Migratable = len(feasibility.Blockers) == 0
+	return feasibility, nil
+}
+
+// migrationTargetNodeSelector returns the node selector of the migration target pod. Until a VMI with the host-model
+// CPU migrated once, the target has to support the CPU model and the required features of the source node.
+func migrationTargetNodeSelector(vmi *v1.VirtualMachineInstance, pod *k8sv1.Pod, sourceNode *k8sv1.Node) map[string]string {
+	nodeSelector := map[string]string{}
+	for key, value := range pod.Spec.NodeSelector {
+		nodeSelector[key] = value
+	}
+	if cpu := vmi.Spec.Domain.CPU; cpu == nil || cpu.Model != v1.CPUModeHostModel {
+		return nodeSelector
+	}
+	for key := range nodeSelector {
+		if strings.HasPrefix(key, v1.SupportedHostModelMigrationCPU) {
+			return nodeSelector
+		}
+	}
+	for key, value := range sourceNode.Labels {
+		if strings.HasPrefix(key, v1.HostModelCPULabel) {
+			nodeSelector[v1.SupportedHostModelMigrationCPU+strings.TrimPrefix(key, v1.HostModelCPULabel)] = value
+		}
+		if strings.HasPrefix(key, v1.HostModelRequiredFeaturesLabel) {
+			nodeSelector[v1.CPUFeatureLabel+strings.TrimPrefix(key, v1.HostModelRequiredFeaturesLabel)] = value
+		}
+	}
+	return nodeSelector
+}
+
+// unfitReason returns why the migration target pod can not be scheduled to the node, or an empty string if it can
+func unfitReason(node *k8sv1.Node, pod *k8sv1.Pod, nodeSelector map[string]string) string {
+	if node.Spec.Unschedulable {
+		return unfitUnschedulable
+	}
+	for i := range node.Spec.Taints {
+		taint := &node.Spec.Taints[i]
+		if taint.Effect != k8sv1.TaintEffectNoSchedule && taint.Effect != k8sv1.TaintEffectNoExecute {
+			continue
+		}
+		if !toleratesTaint(pod.Spec.Tolerations, taint) {
+			return unfitTaints
+		}
+	}
+
+	var reason string
+	for key, value := range nodeSelector {
+		if nodeValue, exists := node.Labels[key]; exists && nodeValue == value {
+			continue
+		}
+		switch {
+		case key == v1.NodeSchedulable:
+			return unfitNotSchedulable
+		case strings.HasPrefix(key, v1.CPUModelLabel), strings.HasPrefix(key, v1.SupportedHostModelMigrationCPU),
+			strings.HasPrefix(key, v1.CPUFeatureLabel):
+			reason = unfitCPU
+		case reason == "":
+			reason = unfitNodeSelector
+		}
+	}
+	if reason != "" {
+		return reason
+	}
+
+	if affinity := pod.Spec.Affinity; affinity != nil && affinity.NodeAffinity != nil {
+		if required := affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution; required != nil {
+			if !matchesNodeSelectorTerms(node, required.NodeSelectorTerms) {
+				return unfitNodeAffinity
+			}
+		}
+	}
+	if !hasAllocatableResources(node, pod) {
+		return unfitResources
+	}
+	return ""
+}
+
+func toleratesTaint(tolerations []k8sv1.Toleration, taint *k8sv1.Taint) bool {
+	for i := range tolerations {
+		if tolerations[i].ToleratesTaint(taint) {
+			return true
+		}
+	}
+	return false
+}
+
+// hasAllocatableResources returns false if the node does not provide one of the extended resources, e.g. of SR-IOV
+// networks or device plugins, requested by the pod
+func hasAllocatableResources(node *k8sv1.Node, pod *k8sv1.Pod) bool {
+	for _, container := range pod.Spec.Containers {
+		for name := range container.Resources.Limits {
+			if !strings.Contains(string(name), "/") {
+				continue
+			}
+			if allocatable, exists := node.Status.Allocatable[name]; !exists || allocatable.IsZero() {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func matchesNodeSelectorTerms(node *k8sv1.Node, terms []k8sv1.NodeSelectorTerm) bool {
+	for _, term := range terms {
+		if len(term.MatchExpressions) == 0 && len(term.MatchFields) == 0 {
+			continue
+		}
+		labelSelector, err := nodeSelectorRequirementsAsSelector(term.MatchExpressions)
+		if err != nil || !labelSelector.Matches(labels.Set(node.Labels)) {
+			continue
+		}
+		fieldSelector, err := nodeSelectorRequirementsAsSelector(term.MatchFields)
+		if err != nil || !fieldSelector.Matches(labels.Set{"metadata.name": node.Name}) {
+			continue
+		}
+		return true
+	}
+	return false
+}
+
+func nodeSelectorRequirementsAsSelector(requirements []k8sv1.NodeSelectorRequirement) (labels.Selector, error) {
+	operators := map[k8sv1.NodeSelectorOperator]selection.Operator{
+		k8sv1.NodeSelectorOpIn:           selection.In,
+		k8sv1.NodeSelectorOpNotIn:        selection.NotIn,
+		k8sv1.NodeSelectorOpExists:       selection.Exists,
+		k8sv1.NodeSelectorOpDoesNotExist: selection.DoesNotExist,
+		k8sv1.NodeSelectorOpGt:           selection.GreaterThan,
+		k8sv1.NodeSelectorOpLt:           selection.LessThan,
+	}
+	selector := labels.NewSelector()
+	for _, requirement := range requirements {
+		operator, exists := operators[requirement.Operator]
+		if !exists {
+			return nil, fmt.Errorf("unsupported node selector operator %s", requirement.Operator)
+		}
+		labelRequirement, err := labels.NewRequirement(requirement.Key, operator, requirement.Values)
+		if err != nil {
+			return nil, err
+		}
+		selector = selector.Add(*labelRequirement)
+	}
+	return selector, nil
+}
+
+// noTargetNodeMessage summarizes why the nodes do not fit in the format of the scheduler
+func noTargetNodeMessage(candidates int, unfit map[string]int) string {
+	if candidates == 0 {
+		return "there is no other node in the cluster"
+	}
+	var reasons []string
+	for reason, count := range unfit {
+		reasons = append(reasons, fmt.Sprintf("%d node(s) %s", count, reason))
+	}
+	sort.Strings(reasons)
+	return fmt.Sprintf("0/%d nodes are available: %s", candidates, strings.Join(reasons, ", "))
+}
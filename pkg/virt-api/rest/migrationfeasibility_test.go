@@ -0,0 +1,210 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package rest
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/emicklei/go-restful/v3"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	k8sv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+	kubevirtfake "kubevirt.io/client-go/kubevirt/fake"
+
+	"kubevirt.io/kubevirt/pkg/libvmi"
+	libvmistatus "kubevirt.io/kubevirt/pkg/libvmi/status"
+)
+
+var _ = Describe("Migration feasibility subresource", func() {
+	const (
+		sourceNode = "node01"
+		hostModel  = "Skylake-Client-IBRS"
+	)
+
+	var (
+		request  *restful.Request
+		response *restful.Response
+		recorder *httptest.ResponseRecorder
+		app      *SubresourceAPIApp
+	)
+
+	newNode := func(name string, labels map[string]string) *k8sv1.Node {
+		nodeLabels := map[string]string{v1.NodeSchedulable: "true"}
+		for key, value := range labels {
+			nodeLabels[key] = value
+		}
+		return &k8sv1.Node{ObjectMeta: metav1.ObjectMeta{Name: name, Labels: nodeLabels}}
+	}
+
+	newLauncherPod := func(vmi *v1.VirtualMachineInstance, nodeSelector map[string]string) *k8sv1.Pod {
+		return &k8sv1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "virt-launcher-" + vmi.Name,
+				Namespace: vmi.Namespace,
+				Labels:    map[string]string{v1.AppLabel: "virt-launcher", v1.CreatedByLabel: string(vmi.UID)},
+			},
+			Spec: k8sv1.PodSpec{
+				NodeName:     sourceNode,
+				NodeSelector: nodeSelector,
+			},
+			Status: k8sv1.PodStatus{Phase: k8sv1.PodRunning},
+		}
+	}
+
+	setup := func(vmi *v1.VirtualMachineInstance, objects ...runtime.Object) {
+		kubeClient := fake.NewSimpleClientset(objects...)
+		virtClient := kubevirtfake.NewSimpleClientset(vmi)
+		mockVirtClient := kubecli.NewMockKubevirtClient(gomock.NewController(GinkgoT()))
+		mockVirtClient.EXPECT().CoreV1().Return(kubeClient.CoreV1()).AnyTimes()
+		mockVirtClient.EXPECT().VirtualMachineInstance(metav1.NamespaceDefault).
+			Return(virtClient.KubevirtV1().VirtualMachineInstances(metav1.NamespaceDefault)).AnyTimes()
+		app = &SubresourceAPIApp{virtCli: mockVirtClient}
+	}
+
+	feasibility := func() *v1.VirtualMachineInstanceMigrationFeasibility {
+		app.MigrationFeasibilityRequestHandler(request, response)
+		Expect(recorder.Code).To(Equal(http.StatusOK))
+		result := &v1.VirtualMachineInstanceMigrationFeasibility{}
+		Expect(json.Unmarshal(recorder.Body.Bytes(), result)).To(Succeed())
+		return result
+	}
+
+	newRunningVMI := func(opts ...libvmi.Option) *v1.VirtualMachineInstance {
+		opts = append([]libvmi.Option{
+			libvmi.WithName(testVMIName),
+			libvmi.WithNamespace(metav1.NamespaceDefault),
+			libvmistatus.WithStatus(libvmistatus.New(
+				libvmistatus.WithPhase(v1.Running),
+				libvmistatus.WithNodeName(sourceNode),
+			)),
+		}, opts...)
+		vmi := libvmi.New(opts...)
+		vmi.UID = "vmi-uid"
+		return vmi
+	}
+
+	BeforeEach(func() {
+		request = restful.NewRequest(&http.Request{})
+		request.PathParameters()["name"] = testVMIName
+		request.PathParameters()["namespace"] = metav1.NamespaceDefault
+		recorder = httptest.NewRecorder()
+		response = restful.NewResponse(recorder)
+		response.SetRequestAccepts(restful.MIME_JSON)
+	})
+
+	It("should fail for a missing VMI", func() {
+		setup(newRunningVMI(libvmi.WithName("othervmi")))
+		app.MigrationFeasibilityRequestHandler(request, response)
+		Expect(recorder.Code).To(Equal(http.StatusNotFound))
+	})
+
+	It("should fail for a VMI which is not running", func() {
+		vmi := libvmi.New(libvmi.WithName(testVMIName), libvmi.WithNamespace(metav1.NamespaceDefault))
+		setup(vmi)
+		app.MigrationFeasibilityRequestHandler(request, response)
+		Expect(recorder.Code).To(Equal(http.StatusConflict))
+	})
+
+	It("should report the nodes the VMI can be migrated to", func() {
+		vmi := newRunningVMI(libvmi.WithCPUModel(v1.CPUModeHostModel))
+		tainted := newNode("node04", map[string]string{v1.SupportedHostModelMigrationCPU + hostModel: "true"})
+		tainted.Spec.Taints = []k8sv1.Taint{{Key: "dedicated", Value: "storage", Effect: k8sv1.TaintEffectNoSchedule}}
+		setup(vmi,
+			newLauncherPod(vmi, map[string]string{v1.NodeSchedulable: "true"}),
+			newNode(sourceNode, map[string]string{v1.HostModelCPULabel + hostModel: "true"}),
+			newNode("node02", map[string]string{v1.SupportedHostModelMigrationCPU + hostModel: "true"}),
+			newNode("node03", nil),
+			tainted,
+		)
+
+		result := feasibility()
+		Expect(result.Migratable).To(BeTrue())
+		Expect(result.Blockers).To(BeEmpty())
+		Expect(result.TargetNodes).To(ConsistOf("node02"))
+	})
+
+	It("should report all reasons blocking the migration", func() {
+		vmi := newRunningVMI()
+		vmi.Status.Conditions = []v1.VirtualMachineInstanceCondition{{
+			Type:    v1.VirtualMachineInstanceIsMigratable,
+			Status:  k8sv1.ConditionFalse,
+			Reason:  v1.VirtualMachineInstanceReasonHostDeviceNotMigratable,
+			Message: "VMI uses a PCI host devices",
+		}}
+		cordoned := newNode("node04", map[string]string{"zone": "a"})
+		cordoned.Spec.Unschedulable = true
+		setup(vmi,
+			newLauncherPod(vmi, map[string]string{v1.NodeSchedulable: "true", "zone": "a", v1.CPUModelLabel + "Haswell": "true"}),
+			newNode(sourceNode, nil),
+			newNode("node02", map[string]string{"zone": "b", v1.CPUModelLabel + "Haswell": "true"}),
+			newNode("node03", map[string]string{"zone": "a"}),
+			cordoned,
+		)
+
+		result := feasibility()
+		Expect(result.Migratable).To(BeFalse())
+		Expect(result.TargetNodes).To(BeEmpty())
+		Expect(result.Blockers).To(ConsistOf(
+			v1.MigrationBlocker{
+				Reason:  v1.VirtualMachineInstanceReasonHostDeviceNotMigratable,
+				Message: "VMI uses a PCI host devices",
+			},
+			v1.MigrationBlocker{
+				Reason: v1.VirtualMachineInstanceReasonNoMigrationTargetNode,
+				Message: "0/3 nodes are available: 1 node(s) didn't match the node selector, " +
+					"1 node(s) lacked the CPU model or features of the VMI, 1 node(s) were unschedulable",
+			},
+		))
+	})
+
+	It("should respect the required node affinity of the VMI", func() {
+		vmi := newRunningVMI()
+		pod := newLauncherPod(vmi, nil)
+		pod.Spec.Affinity = &k8sv1.Affinity{NodeAffinity: &k8sv1.NodeAffinity{
+			RequiredDuringSchedulingIgnoredDuringExecution: &k8sv1.NodeSelector{
+				NodeSelectorTerms: []k8sv1.NodeSelectorTerm{{
+					MatchExpressions: []k8sv1.NodeSelectorRequirement{{
+						Key:      "zone",
+						Operator: k8sv1.NodeSelectorOpNotIn,
+						Values:   []string{"b"},
+					}},
+				}},
+			},
+		}}
+		setup(vmi, pod,
+			newNode(sourceNode, nil),
+			newNode("node02", map[string]string{"zone": "b"}),
+			newNode("node03", map[string]string{"zone": "c"}),
+		)
+
+		result := feasibility()
+		Expect(result.Migratable).To(BeTrue())
+		Expect(result.TargetNodes).To(ConsistOf("node03"))
+	})
+})
@@ -0,0 +1,78 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package rest
+
+import (
+	"fmt"
+	"time"
+
+	restful "github.com/emicklei/go-restful/v3"
+	"k8s.io/apimachinery/pkg/api/errors"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+
+	"kubevirt.io/kubevirt/pkg/network/vmispec"
+	"kubevirt.io/kubevirt/pkg/virt-api/definitions"
+)
+
+// PacketCaptureRequestHandler streams the traffic of a VMI interface in the pcap format.
+// The capture is done by virt-handler, on the tap device connecting the guest interface.
+func (app *SubresourceAPIApp) PacketCaptureRequestHandler(request *restful.Request, response *restful.Response) {
+	ifaceName := request.QueryParameter(definitions.InterfaceParamName)
+	if ifaceName == "" {
+		writeError(errors.NewBadRequest("interface name is required but not provided"), response)
+		return
+	}
+	duration := request.QueryParameter(definitions.DurationParamName)
+	if duration != "" {
+		if d, err := time.ParseDuration(duration); err != nil || d <= 0 {
+			writeError(errors.NewBadRequest(fmt.Sprintf("invalid capture duration %q", duration)), response)
+			return
+		}
+	}
+
+	streamer := NewRawStreamer(
+		app.FetchVirtualMachineInstance,
+		validateVMIForPacketCapture(ifaceName),
+		app.virtHandlerDialer(func(vmi *v1.VirtualMachineInstance, conn kubecli.VirtHandlerConn) (string, error) {
+			return conn.PcapURI(vmi, ifaceName, duration)
+		}),
+	)
+
+	streamer.Handle(request, response)
+}
+
+func validateVMIForPacketCapture(ifaceName string) validator {
+	return func(vmi *v1.VirtualMachineInstance) *errors.StatusError {
+		if !vmi.IsRunning() {
+			return errors.NewBadRequest(vmiNotRunning)
+		}
+		iface := vmispec.LookupInterfaceByName(vmi.Spec.Domain.Devices.Interfaces, ifaceName)
+		if iface == nil {
+			return errors.NewBadRequest(fmt.Sprintf("interface %s not found", ifaceName))
+		}
+		if iface.Bridge == nil && iface.Masquerade == nil {
+			return errors.NewBadRequest(
+				fmt.Sprintf("interface %s can not be captured, only the bridge and masquerade bindings are supported", ifaceName))
+		}
+		return nil
+	}
+}
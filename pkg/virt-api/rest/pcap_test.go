@@ -0,0 +1,122 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package rest
+
+import (
+	"context"
+	"crypto/tls"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+
+	"github.com/emicklei/go-restful/v3"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+	kubevirtfake "kubevirt.io/client-go/kubevirt/fake"
+
+	"kubevirt.io/kubevirt/pkg/libvmi"
+	libvmistatus "kubevirt.io/kubevirt/pkg/libvmi/status"
+	"kubevirt.io/kubevirt/pkg/testutils"
+)
+
+var _ = Describe("Packet capture Subresource api", func() {
+	const (
+		bridgeIface = "default"
+		sriovIface  = "sriov"
+	)
+
+	var (
+		recorder   *httptest.ResponseRecorder
+		request    *restful.Request
+		response   *restful.Response
+		virtClient *kubevirtfake.Clientset
+		app        *SubresourceAPIApp
+	)
+
+	BeforeEach(func() {
+		recorder = httptest.NewRecorder()
+		request = restful.NewRequest(&http.Request{URL: &url.URL{}})
+		request.PathParameters()["name"] = testVMIName
+		request.PathParameters()["namespace"] = metav1.NamespaceDefault
+		response = restful.NewResponse(recorder)
+
+		ctrl := gomock.NewController(GinkgoT())
+		mockVirtClient := kubecli.NewMockKubevirtClient(ctrl)
+		virtClient = kubevirtfake.NewSimpleClientset()
+		mockVirtClient.EXPECT().VirtualMachineInstance(metav1.NamespaceDefault).Return(virtClient.KubevirtV1().VirtualMachineInstances(metav1.NamespaceDefault)).AnyTimes()
+
+		config, _, _ := testutils.NewFakeClusterConfigUsingKV(&v1.KubeVirt{})
+		app = NewSubresourceAPIApp(mockVirtClient, 0, &tls.Config{InsecureSkipVerify: true}, config)
+
+		vmi := libvmi.New(
+			libvmi.WithName(testVMIName),
+			libvmi.WithInterface(libvmi.InterfaceDeviceWithBridgeBinding(bridgeIface)),
+			libvmi.WithNetwork(v1.DefaultPodNetwork()),
+			libvmi.WithInterface(libvmi.InterfaceDeviceWithSRIOVBinding(sriovIface)),
+			libvmi.WithNetwork(libvmi.MultusNetwork(sriovIface, "sriov-nad")),
+			libvmistatus.WithStatus(libvmistatus.New(libvmistatus.WithPhase(v1.Running))),
+		)
+		_, err := virtClient.KubevirtV1().VirtualMachineInstances(metav1.NamespaceDefault).Create(context.Background(), vmi, metav1.CreateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	setQuery := func(params map[string]string) {
+		query := url.Values{}
+		for key, value := range params {
+			query.Set(key, value)
+		}
+		request.Request.URL.RawQuery = query.Encode()
+	}
+
+	DescribeTable("should reject the request", func(params map[string]string, message string) {
+		setQuery(params)
+
+		app.PacketCaptureRequestHandler(request, response)
+
+		ExpectStatusErrorWithCode(recorder, http.StatusBadRequest)
+		ExpectMessage(recorder, Equal(message))
+	},
+		Entry("without interface", map[string]string{}, "interface name is required but not provided"),
+		Entry("with an invalid duration", map[string]string{"interface": bridgeIface, "duration": "soon"}, `invalid capture duration "soon"`),
+		Entry("with a negative duration", map[string]string{"interface": bridgeIface, "duration": "-1s"}, `invalid capture duration "-1s"`),
+		Entry("with an unknown interface", map[string]string{"interface": "unknown"}, "interface unknown not found"),
+		Entry("with an interface without tap device", map[string]string{"interface": sriovIface},
+			"interface sriov can not be captured, only the bridge and masquerade bindings are supported"),
+	)
+
+	It("should reject the request when the VMI is not running", func() {
+		vmi, err := virtClient.KubevirtV1().VirtualMachineInstances(metav1.NamespaceDefault).Get(context.Background(), testVMIName, metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		vmi.Status.Phase = v1.Scheduling
+		_, err = virtClient.KubevirtV1().VirtualMachineInstances(metav1.NamespaceDefault).Update(context.Background(), vmi, metav1.UpdateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		setQuery(map[string]string{"interface": bridgeIface})
+
+		app.PacketCaptureRequestHandler(request, response)
+
+		ExpectStatusErrorWithCode(recorder, http.StatusBadRequest)
+		ExpectMessage(recorder, Equal(vmiNotRunning))
+	})
+})
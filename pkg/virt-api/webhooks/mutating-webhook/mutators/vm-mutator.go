@@ -33,6 +33,7 @@ import (
 	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
 	"kubevirt.io/kubevirt/pkg/defaults"
 	instancetypeVMWebhooks "kubevirt.io/kubevirt/pkg/instancetype/webhooks/vm"
+	"kubevirt.io/kubevirt/pkg/network/macpool"
 	webhookutils "kubevirt.io/kubevirt/pkg/util/webhooks"
 	"kubevirt.io/kubevirt/pkg/virt-api/webhooks"
 	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
@@ -46,12 +47,14 @@ type instancetypeVMsMutator interface {
 type VMsMutator struct {
 	ClusterConfig       *virtconfig.ClusterConfig
 	instancetypeMutator instancetypeVMsMutator
+	macPool             *macpool.Pool
 }
 
-func NewVMsMutator(clusterConfig *virtconfig.ClusterConfig, virtCli kubecli.KubevirtClient) *VMsMutator {
+func NewVMsMutator(clusterConfig *virtconfig.ClusterConfig, virtCli kubecli.KubevirtClient, macPool *macpool.Pool) *VMsMutator {
 	return &VMsMutator{
 		ClusterConfig:       clusterConfig,
 		instancetypeMutator: instancetypeVMWebhooks.NewMutator(virtCli),
+		macPool:             macPool,
 	}
 }
 
@@ -87,6 +90,12 @@ func (mutator *VMsMutator) Mutate(ar *admissionv1.AdmissionReview) *admissionv1.
 	preferenceSpec, _ := mutator.instancetypeMutator.FindPreference(vm)
 	defaults.SetVirtualMachineDefaults(vm, mutator.ClusterConfig, preferenceSpec)
 
+	if mutator.ClusterConfig.MacAddressPoolEnabled() {
+		if err := mutator.macPool.AllocateMacAddresses(mutator.ClusterConfig.GetMacAddressPool(), vm, oldVM); err != nil {
+			return webhookutils.ToAdmissionResponseError(err)
+		}
+	}
+
 	patchBytes, err := patch.New(
 		patch.WithReplace("/spec", vm.Spec),
 		patch.WithReplace("/metadata", vm.ObjectMeta),
@@ -46,9 +46,12 @@ import (
 	instancetypeclientset "kubevirt.io/client-go/kubevirt/typed/instancetype/v1beta1"
 
 	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
+	"kubevirt.io/kubevirt/pkg/controller"
 	instancetypeVMWebhooks "kubevirt.io/kubevirt/pkg/instancetype/webhooks/vm"
+	"kubevirt.io/kubevirt/pkg/network/macpool"
 	"kubevirt.io/kubevirt/pkg/testutils"
 	"kubevirt.io/kubevirt/pkg/virt-api/webhooks"
+	"kubevirt.io/kubevirt/pkg/virt-config/featuregate"
 )
 
 var _ = Describe("VirtualMachine Mutator", func() {
@@ -175,6 +178,33 @@ var _ = Describe("VirtualMachine Mutator", func() {
 		}
 	})
 
+	It("should allocate MAC addresses from the pool on VM create", func() {
+		testutils.UpdateFakeKubeVirtClusterConfig(kvStore, &v1.KubeVirt{
+			Spec: v1.KubeVirtSpec{
+				Configuration: v1.KubeVirtConfiguration{
+					DeveloperConfiguration: &v1.DeveloperConfiguration{
+						FeatureGates: []string{featuregate.MacAddressPoolGate},
+					},
+					NetworkConfiguration: &v1.NetworkConfiguration{
+						MacAddressPool: &v1.MacAddressPoolConfiguration{
+							Ranges: []v1.MacAddressRange{{Start: "02:00:00:00:00:00", End: "02:00:00:00:00:00"}},
+						},
+					},
+				},
+			},
+		})
+		mutator.macPool = macpool.New(
+			cache.NewIndexer(cache.MetaNamespaceKeyFunc, controller.GetVirtualMachineInformerIndexers()),
+			k8sClient.CoreV1().ConfigMaps("kubevirt"),
+		)
+		vm.Spec.Template.Spec.Domain.Devices.Interfaces = []v1.Interface{*v1.DefaultBridgeNetworkInterface()}
+		vm.Spec.Template.Spec.Networks = []v1.Network{*v1.DefaultPodNetwork()}
+
+		vmSpec, _ := getVMSpecMetaFromResponse(rt.GOARCH)
+		Expect(vmSpec.Template.Spec.Domain.Devices.Interfaces).To(HaveLen(1))
+		Expect(vmSpec.Template.Spec.Domain.Devices.Interfaces[0].MacAddress).To(Equal("02:00:00:00:00:00"))
+	})
+
 	DescribeTable("should apply configurable defaults on VM create", func(arch string, amd64MachineType string, arm64MachineType string, ppc64leMachineType string, s390xMachineType string, result string) {
 		testutils.UpdateFakeKubeVirtClusterConfig(kvStore, &v1.KubeVirt{
 			Spec: v1.KubeVirtSpec{
@@ -85,6 +85,12 @@ func (mutator *VMIsMutator) Mutate(ar *admissionv1.AdmissionReview) *admissionv1
 			return webhookutils.ToAdmissionResponseError(err)
 		}
 
+		// Placing the emulator thread on its own pCPU is what IsolateEmulatorThread does
+		if placement := newVMI.Spec.Domain.CPU.ThreadPlacement; placement != nil &&
+			(placement.EmulatorThread == v1.ThreadPlacementDedicated || placement.EmulatorThread == v1.ThreadPlacementSameNUMANode) {
+			newVMI.Spec.Domain.CPU.IsolateEmulatorThread = true
+		}
+
 		if newVMI.Spec.Domain.CPU.IsolateEmulatorThread {
 			_, emulatorThreadCompleteToEvenParityAnnotationExists := mutator.ClusterConfig.GetConfigFromKubeVirtCR().Annotations[v1.EmulatorThreadCompleteToEvenParity]
 			if emulatorThreadCompleteToEvenParityAnnotationExists &&
@@ -614,6 +614,18 @@ var _ = Describe("VirtualMachineInstance Mutator", func() {
 		Expect(exist).To(BeTrue())
 	})
 
+	DescribeTable("should isolate the emulator thread depending on its placement", func(policy v1.ThreadPlacementPolicy, expectIsolated bool) {
+		vmi.Spec.Domain.CPU = &v1.CPU{ThreadPlacement: &v1.ThreadPlacement{EmulatorThread: policy}}
+
+		_, vmiSpec, _ := getMetaSpecStatusFromAdmit(vmi.Spec.Architecture)
+		Expect(vmiSpec.Domain.CPU.IsolateEmulatorThread).To(Equal(expectIsolated))
+	},
+		Entry("with a dedicated placement", v1.ThreadPlacementDedicated, true),
+		Entry("with a placement on the NUMA node of the vCPUs", v1.ThreadPlacementSameNUMANode, true),
+		Entry("with a shared placement", v1.ThreadPlacementShared, false),
+		Entry("without a placement", v1.ThreadPlacementPolicy(""), false),
+	)
+
 	It("should convert CPU requests to sockets", func() {
 		vmi.Spec.Domain.CPU = &v1.CPU{Model: "EPYC"}
 		vmi.Spec.Domain.Resources.Requests = k8sv1.ResourceList{
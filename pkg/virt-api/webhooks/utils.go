@@ -72,6 +72,8 @@ type Informers struct {
 	VMRestoreInformer  cache.SharedIndexInformer
 	DataSourceInformer cache.SharedIndexInformer
 	NamespaceInformer  cache.SharedIndexInformer
+	NodeInformer       cache.SharedIndexInformer
+	VMInformer         cache.SharedIndexInformer
 }
 
 func IsARM64(vmiSpec *v1.VirtualMachineInstanceSpec) bool {
@@ -0,0 +1,125 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package admitters
+
+import (
+	"fmt"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	k8sv1 "k8s.io/api/core/v1"
+	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	metrics "kubevirt.io/kubevirt/pkg/monitoring/metrics/virt-api"
+	hwutil "kubevirt.io/kubevirt/pkg/util/hardware"
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
+)
+
+// Types of the advisories, used as label of the admission warnings counter.
+// There is no advisory for the guest agent channel, virt-launcher always attaches it.
+const (
+	advisoryNoEvictionStrategy   = "no-eviction-strategy"
+	advisoryEmulatedDiskBus      = "emulated-disk-bus"
+	advisoryOversizedCPUTopology = "oversized-cpu-topology"
+)
+
+type advisory struct {
+	warningType string
+	message     string
+}
+
+type advisoryCheck func(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec, config *virtconfig.ClusterConfig) []advisory
+
+var advisoryChecks = []advisoryCheck{
+	checkEvictionStrategy,
+	checkEmulatedDiskBus,
+	checkCPUTopologySize,
+}
+
+// warnDiscouragedConfigurations returns admission warnings for valid configurations which are known to cause
+// trouble later on. Warnings of created objects are counted per type.
+func warnDiscouragedConfigurations(ar *admissionv1.AdmissionRequest, field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec, config *virtconfig.ClusterConfig) []string {
+	if !config.AdmissionAdvisoriesEnabled() {
+		return nil
+	}
+
+	isDryRun := ar.DryRun != nil && *ar.DryRun
+	var warnings []string
+	for _, check := range advisoryChecks {
+		for _, a := range check(field, spec, config) {
+			warnings = append(warnings, a.message)
+			if !isDryRun && ar.Operation == admissionv1.Create {
+				metrics.NewAdmissionWarning(a.warningType)
+			}
+		}
+	}
+	return warnings
+}
+
+func checkEvictionStrategy(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec, config *virtconfig.ClusterConfig) []advisory {
+	strategy := spec.EvictionStrategy
+	if strategy == nil {
+		strategy = config.GetConfig().EvictionStrategy
+	}
+	if strategy != nil && *strategy != v1.EvictionStrategyNone {
+		return nil
+	}
+	return []advisory{{
+		warningType: advisoryNoEvictionStrategy,
+		message: fmt.Sprintf("%s is not set to a strategy other than %s, the VM is shut down when its node is drained",
+			field.Child("evictionStrategy").String(), v1.EvictionStrategyNone),
+	}}
+}
+
+func checkEmulatedDiskBus(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec, _ *virtconfig.ClusterConfig) []advisory {
+	var advisories []advisory
+	for idx, disk := range spec.Domain.Devices.Disks {
+		if disk.Disk == nil || disk.Disk.Bus != v1.DiskBusSATA {
+			continue
+		}
+		advisories = append(advisories, advisory{
+			warningType: advisoryEmulatedDiskBus,
+			message: fmt.Sprintf("%s is the emulated %s bus, use %s for better performance if the guest supports it",
+				field.Child("domain", "devices", "disks").Index(idx).Child("disk", "bus").String(), v1.DiskBusSATA, v1.DiskBusVirtio),
+		})
+	}
+	return advisories
+}
+
+// checkCPUTopologySize warns about guests which see more vCPUs than the CPU limit of the VMI allows them to use
+func checkCPUTopologySize(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec, _ *virtconfig.ClusterConfig) []advisory {
+	if spec.Domain.CPU == nil {
+		return nil
+	}
+	limit, exists := spec.Domain.Resources.Limits[k8sv1.ResourceCPU]
+	if !exists {
+		return nil
+	}
+	vCPUs := hwutil.GetNumberOfVCPUs(spec.Domain.CPU)
+	if vCPUs == 0 || vCPUs*1000 <= limit.MilliValue() {
+		return nil
+	}
+	return []advisory{{
+		warningType: advisoryOversizedCPUTopology,
+		message: fmt.Sprintf("%s has %d vCPUs but %s only allows %s CPUs, the vCPUs are throttled",
+			field.Child("domain", "cpu").String(), vCPUs, field.Child("domain", "resources", "limits", "cpu").String(), limit.String()),
+	}}
+}
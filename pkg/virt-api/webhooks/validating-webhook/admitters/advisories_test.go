@@ -0,0 +1,157 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package admitters
+
+import (
+	"context"
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	authv1 "k8s.io/api/authentication/v1"
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/api"
+
+	"kubevirt.io/kubevirt/pkg/libvmi"
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/testutils"
+	"kubevirt.io/kubevirt/pkg/virt-api/webhooks"
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
+	"kubevirt.io/kubevirt/pkg/virt-config/featuregate"
+)
+
+var _ = Describe("Admission advisories", func() {
+	const noEvictionWarning = "spec.evictionStrategy is not set to a strategy other than None, the VM is shut down when its node is drained"
+
+	newConfig := func(featureGates ...string) *virtconfig.ClusterConfig {
+		config, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{
+			DeveloperConfiguration: &v1.DeveloperConfiguration{FeatureGates: featureGates},
+		})
+		return config
+	}
+
+	warnings := func(vmi *v1.VirtualMachineInstance, config *virtconfig.ClusterConfig) []string {
+		return warnDiscouragedConfigurations(&admissionv1.AdmissionRequest{Operation: admissionv1.Create}, k8sfield.NewPath("spec"), &vmi.Spec, config)
+	}
+
+	It("should not warn if the feature gate is disabled", func() {
+		Expect(warnings(libvmi.New(), newConfig())).To(BeEmpty())
+	})
+
+	DescribeTable("should warn about the missing eviction strategy", func(strategy *v1.EvictionStrategy, expectWarning bool) {
+		vmi := libvmi.New()
+		vmi.Spec.EvictionStrategy = strategy
+		if expectWarning {
+			Expect(warnings(vmi, newConfig(featuregate.AdmissionAdvisoriesGate))).To(ConsistOf(noEvictionWarning))
+		} else {
+			Expect(warnings(vmi, newConfig(featuregate.AdmissionAdvisoriesGate))).To(BeEmpty())
+		}
+	},
+		Entry("if it is not set", nil, true),
+		Entry("if it is None", pointer.P(v1.EvictionStrategyNone), true),
+		Entry("not if it is LiveMigrate", pointer.P(v1.EvictionStrategyLiveMigrate), false),
+	)
+
+	It("should use the eviction strategy of the cluster", func() {
+		config, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{
+			DeveloperConfiguration: &v1.DeveloperConfiguration{FeatureGates: []string{featuregate.AdmissionAdvisoriesGate}},
+			EvictionStrategy:       pointer.P(v1.EvictionStrategyLiveMigrateIfPossible),
+		})
+		Expect(warnings(libvmi.New(), config)).To(BeEmpty())
+	})
+
+	It("should warn about disks on the SATA bus", func() {
+		vmi := libvmi.New(
+			libvmi.WithEvictionStrategy(v1.EvictionStrategyLiveMigrate),
+			libvmi.WithContainerDisk("virtio", "image"),
+			libvmi.WithContainerSATADisk("sata", "image"),
+		)
+
+		Expect(warnings(vmi, newConfig(featuregate.AdmissionAdvisoriesGate))).To(ConsistOf(
+			"spec.domain.devices.disks[1].disk.bus is the emulated sata bus, use virtio for better performance if the guest supports it",
+		))
+	})
+
+	DescribeTable("should warn about more vCPUs than the CPU limit allows", func(limit string, expectWarning bool) {
+		vmi := libvmi.New(
+			libvmi.WithEvictionStrategy(v1.EvictionStrategyLiveMigrate),
+			libvmi.WithCPUCount(2, 2, 1),
+			libvmi.WithLimitCPU(limit),
+		)
+		if expectWarning {
+			Expect(warnings(vmi, newConfig(featuregate.AdmissionAdvisoriesGate))).To(ConsistOf(
+				fmt.Sprintf("spec.domain.cpu has 4 vCPUs but spec.domain.resources.limits.cpu only allows %s CPUs, the vCPUs are throttled", limit),
+			))
+		} else {
+			Expect(warnings(vmi, newConfig(featuregate.AdmissionAdvisoriesGate))).To(BeEmpty())
+		}
+	},
+		Entry("if the limit is lower", "2", true),
+		Entry("not if the limit fits", "4", false),
+	)
+
+	Context("on VMI creation", func() {
+		var admitter *VMICreateAdmitter
+
+		BeforeEach(func() {
+			admitter = &VMICreateAdmitter{
+				ClusterConfig:           newConfig(featuregate.AdmissionAdvisoriesGate),
+				KubeVirtServiceAccounts: webhooks.KubeVirtServiceAccounts("kubevirt"),
+			}
+		})
+
+		It("should return the warnings", func() {
+			ar, err := newAdmissionReviewForVMICreation(api.NewMinimalVMI("testvmi"))
+			Expect(err).ToNot(HaveOccurred())
+
+			resp := admitter.Admit(context.Background(), ar)
+			Expect(resp.Allowed).To(BeTrue())
+			Expect(resp.Warnings).To(ConsistOf(noEvictionWarning))
+		})
+
+		It("should not return the warnings to virt-controller", func() {
+			ar, err := newAdmissionReviewForVMICreation(api.NewMinimalVMI("testvmi"))
+			Expect(err).ToNot(HaveOccurred())
+			ar.Request.UserInfo = authv1.UserInfo{Username: "system:serviceaccount:kubevirt:kubevirt-controller"}
+
+			resp := admitter.Admit(context.Background(), ar)
+			Expect(resp.Allowed).To(BeTrue())
+			Expect(resp.Warnings).To(BeEmpty())
+		})
+	})
+
+	It("should return the warnings of the VM template", func() {
+		vm := libvmi.NewVirtualMachine(libvmi.New(libvmi.WithResourceMemory("64Mi")))
+		vm.Spec.Template.Spec.Domain.Resources.Limits = k8sv1.ResourceList{k8sv1.ResourceCPU: resource.MustParse("1")}
+		vm.Spec.Template.Spec.Domain.CPU = &v1.CPU{Sockets: 2}
+
+		Expect(warnDiscouragedConfigurations(&admissionv1.AdmissionRequest{Operation: admissionv1.Update, DryRun: pointer.P(true)},
+			k8sfield.NewPath("spec", "template", "spec"), &vm.Spec.Template.Spec, newConfig(featuregate.AdmissionAdvisoriesGate))).To(ConsistOf(
+			"spec.template.spec.evictionStrategy is not set to a strategy other than None, the VM is shut down when its node is drained",
+			"spec.template.spec.domain.cpu has 2 vCPUs but spec.template.spec.domain.resources.limits.cpu only allows 1 CPUs, the vCPUs are throttled",
+		))
+	})
+})
@@ -71,7 +71,7 @@ func ensureNoMigrationConflict(ctx context.Context, virtClient kubevirt.Interfac
 	}
 	if len(list.Items) > 0 {
 		for _, mig := range list.Items {
-			if mig.Status.Phase == v1.MigrationSucceeded || mig.Status.Phase == v1.MigrationFailed {
+			if mig.IsFinal() {
 				continue
 			}
 			return fmt.Errorf("in-flight migration detected. Active migration job (%s) is currently already in progress for VMI %s.", string(mig.UID), mig.Spec.VMIName)
@@ -36,7 +36,7 @@ type MigrationUpdateAdmitter struct {
 }
 
 func ensureSelectorLabelSafe(newMigration *v1.VirtualMachineInstanceMigration, oldMigration *v1.VirtualMachineInstanceMigration) []metav1.StatusCause {
-	if newMigration.Status.Phase != v1.MigrationSucceeded && newMigration.Status.Phase != v1.MigrationFailed && oldMigration.Labels != nil {
+	if !newMigration.IsFinal() && oldMigration.Labels != nil {
 		oldLabel, oldExists := oldMigration.Labels[v1.MigrationSelectorLabel]
 		if newMigration.Labels == nil {
 			if oldExists {
@@ -33,6 +33,7 @@ import (
 	admissionv1 "k8s.io/api/admission/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	migrationutils "kubevirt.io/kubevirt/pkg/util/migrations"
 	webhookutils "kubevirt.io/kubevirt/pkg/util/webhooks"
 )
 
@@ -71,6 +72,25 @@ func (admitter *MigrationPolicyAdmitter) Admit(_ context.Context, ar *admissionv
 		})
 	}
 
+	if spec.MaxDowntimeMilliseconds != nil && *spec.MaxDowntimeMilliseconds < 0 {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "must not be negative",
+			Field:   sourceField.Child("maxDowntimeMilliseconds").String(),
+		})
+	}
+
+	if spec.PostCopyAfterIterations != nil && *spec.PostCopyAfterIterations < 0 {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "must not be negative",
+			Field:   sourceField.Child("postCopyAfterIterations").String(),
+		})
+	}
+
+	causes = append(causes, migrationutils.ValidateParallelMigration(sourceField, spec.ParallelMigrationChannels, spec.Compression)...)
+	causes = append(causes, migrationutils.ValidateMaintenanceWindows(sourceField.Child("maintenanceWindows"), spec.MaintenanceWindows)...)
+
 	if spec.BandwidthPerMigration != nil {
 		quantity, ok := spec.BandwidthPerMigration.AsInt64()
 		if !ok {
@@ -22,11 +22,13 @@ package admitters
 import (
 	"context"
 	"encoding/json"
+	"time"
 
 	"k8s.io/apimachinery/pkg/api/resource"
 
 	"kubevirt.io/api/migrations"
 
+	v1 "kubevirt.io/api/core/v1"
 	migrationsv1 "kubevirt.io/api/migrations/v1alpha1"
 
 	. "github.com/onsi/ginkgo/v2"
@@ -64,6 +66,36 @@ var _ = Describe("Validating MigrationPolicy Admitter", func() {
 		Entry("negative CompletionTimeoutPerGiB",
 			migrationsv1.MigrationPolicySpec{CompletionTimeoutPerGiB: pointer.P(int64(-1))},
 		),
+
+		Entry("negative MaxDowntimeMilliseconds",
+			migrationsv1.MigrationPolicySpec{MaxDowntimeMilliseconds: pointer.P(int64(-1))},
+		),
+
+		Entry("negative PostCopyAfterIterations",
+			migrationsv1.MigrationPolicySpec{PostCopyAfterIterations: pointer.P(int64(-1))},
+		),
+
+		Entry("zero ParallelMigrationChannels",
+			migrationsv1.MigrationPolicySpec{ParallelMigrationChannels: pointer.P(uint32(0))},
+		),
+
+		Entry("unknown compression method",
+			migrationsv1.MigrationPolicySpec{Compression: &v1.MigrationCompression{Method: "lz4"}},
+		),
+
+		Entry("out of range compression level",
+			migrationsv1.MigrationPolicySpec{
+				Compression: &v1.MigrationCompression{Method: v1.MigrationCompressionZstd, Level: pointer.P(int32(21))},
+			},
+		),
+
+		Entry("invalid MaintenanceWindows schedule",
+			migrationsv1.MigrationPolicySpec{
+				MaintenanceWindows: []migrationsv1.MaintenanceWindow{
+					{Schedule: "0 25 * * *", Duration: metav1.Duration{Duration: time.Hour}},
+				},
+			},
+		),
 	)
 
 	DescribeTable("should accept migration policy with", func(policySpec migrationsv1.MigrationPolicySpec) {
@@ -90,6 +122,28 @@ var _ = Describe("Validating MigrationPolicy Admitter", func() {
 			migrationsv1.MigrationPolicySpec{BandwidthPerMigration: resource.NewScaledQuantity(0, 1)},
 		),
 
+		Entry("MaxDowntimeMilliseconds and PostCopyAfterIterations",
+			migrationsv1.MigrationPolicySpec{
+				MaxDowntimeMilliseconds: pointer.P(int64(500)),
+				PostCopyAfterIterations: pointer.P(int64(3)),
+			},
+		),
+
+		Entry("ParallelMigrationChannels and Compression",
+			migrationsv1.MigrationPolicySpec{
+				ParallelMigrationChannels: pointer.P(uint32(16)),
+				Compression:               &v1.MigrationCompression{Method: v1.MigrationCompressionZlib, Level: pointer.P(int32(1))},
+			},
+		),
+
+		Entry("MaintenanceWindows",
+			migrationsv1.MigrationPolicySpec{
+				MaintenanceWindows: []migrationsv1.MaintenanceWindow{
+					{Schedule: "0 2 * * 1-5", Duration: metav1.Duration{Duration: 2 * time.Hour}},
+				},
+			},
+		),
+
 		Entry("empty spec",
 			migrationsv1.MigrationPolicySpec{},
 		),
@@ -96,6 +96,11 @@ const (
 
 var isValidExpression = regexp.MustCompile(`^[A-Za-z0-9_.+-]+$`).MatchString
 
+var (
+	isValidUSBVendorProduct = regexp.MustCompile(`^[0-9a-fA-F]{4}:[0-9a-fA-F]{4}$`).MatchString
+	isValidUSBBusPort       = regexp.MustCompile(`^[0-9]+-[0-9]+(\.[0-9]+)*$`).MatchString
+)
+
 // SpecValidator validates the given VMI spec
 type SpecValidator func(*k8sfield.Path, *v1.VirtualMachineInstanceSpec, *virtconfig.ClusterConfig) []metav1.StatusCause
 
@@ -141,9 +146,15 @@ func (admitter *VMICreateAdmitter) Admit(_ context.Context, ar *admissionv1.Admi
 		return webhookutils.ToAdmissionResponse(causes)
 	}
 
+	warnings := warnDeprecatedAPIs(&vmi.Spec, admitter.ClusterConfig)
+	// VMIs of VMs are created by virt-controller, the warnings were already returned for the VM
+	if !isKubeVirtServiceAccount {
+		warnings = append(warnings, warnDiscouragedConfigurations(ar.Request, k8sfield.NewPath("spec"), &vmi.Spec, admitter.ClusterConfig)...)
+	}
+
 	return &admissionv1.AdmissionResponse{
 		Allowed:  true,
-		Warnings: warnDeprecatedAPIs(&vmi.Spec, admitter.ClusterConfig),
+		Warnings: warnings,
 	}
 }
 
@@ -179,9 +190,14 @@ func ValidateVirtualMachineInstanceSpec(field *k8sfield.Path, spec *v1.VirtualMa
 	causes = append(causes, validateCpuPinning(field, spec, config)...)
 	causes = append(causes, validateNUMA(field, spec, config)...)
 	causes = append(causes, validateCPUIsolatorThread(field, spec)...)
+	causes = append(causes, validateHousekeepingCPU(field, spec, config)...)
+	causes = append(causes, validateThreadPlacement(field, spec, config)...)
 	causes = append(causes, validateCPUFeaturePolicies(field, spec)...)
+	causes = append(causes, validateMitigatedCPUVulnerabilities(field, spec)...)
 	causes = append(causes, validateCPUHotplug(field, spec)...)
 	causes = append(causes, validateStartStrategy(field, spec)...)
+	causes = append(causes, validateShutdownPolicy(field.Child("shutdownPolicy"), spec.ShutdownPolicy)...)
+	causes = append(causes, validateGuestLicense(field.Child("guestLicense"), spec.GuestLicense)...)
 	causes = append(causes, validateRealtime(field, spec)...)
 	causes = append(causes, validateSpecAffinity(field, spec)...)
 	causes = append(causes, validateSpecTopologySpreadConstraints(field, spec)...)
@@ -215,6 +231,7 @@ func ValidateVirtualMachineInstanceSpec(field *k8sfield.Path, spec *v1.VirtualMa
 	causes = append(causes, validateLiveMigration(field, spec, config)...)
 	causes = append(causes, validateMDEVRamFB(field, spec)...)
 	causes = append(causes, validateHostDevicesWithPassthroughEnabled(field, spec, config)...)
+	causes = append(causes, validateUSBDevices(field, spec, config)...)
 	causes = append(causes, validateSoundDevices(field, spec)...)
 	causes = append(causes, validateLaunchSecurity(field, spec, config)...)
 	causes = append(causes, validateVSOCK(field, spec, config)...)
@@ -222,6 +239,51 @@ func ValidateVirtualMachineInstanceSpec(field *k8sfield.Path, spec *v1.VirtualMa
 	causes = append(causes, validatePersistentState(field, spec, config)...)
 	causes = append(causes, validateDownwardMetrics(field, spec, config)...)
 	causes = append(causes, validateFilesystemsWithVirtIOFSEnabled(field, spec, config)...)
+	causes = append(causes, validateFilesystemsIDMapping(field, spec)...)
+
+	return causes
+}
+
+func validateFilesystemsIDMapping(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec) (causes []metav1.StatusCause) {
+	for i, fs := range spec.Domain.Devices.Filesystems {
+		if fs.Virtiofs == nil || fs.Virtiofs.IDMapping == nil {
+			continue
+		}
+		idMappingField := field.Child("domain", "devices", "filesystems").Index(i).Child("virtiofs", "idMapping")
+		causes = append(causes, validateVirtiofsIDRanges(idMappingField.Child("userIDs"), fs.Virtiofs.IDMapping.UserIDs)...)
+		causes = append(causes, validateVirtiofsIDRanges(idMappingField.Child("groupIDs"), fs.Virtiofs.IDMapping.GroupIDs)...)
+	}
+
+	return causes
+}
+
+// validateVirtiofsIDRanges makes sure the ID translation is unambiguous in both
+// directions, i.e. neither the guest nor the host side of two ranges overlap.
+func validateVirtiofsIDRanges(field *k8sfield.Path, ranges []v1.VirtiofsIDRange) (causes []metav1.StatusCause) {
+	overlaps := func(firstA, countA, firstB, countB int64) bool {
+		return firstA < firstB+countB && firstB < firstA+countA
+	}
+
+	for i, r := range ranges {
+		if r.Count < 1 {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s must map at least one ID", field.Index(i).String()),
+				Field:   field.Index(i).Child("count").String(),
+			})
+			continue
+		}
+		for j := 0; j < i; j++ {
+			if overlaps(r.GuestID, r.Count, ranges[j].GuestID, ranges[j].Count) ||
+				overlaps(r.HostID, r.Count, ranges[j].HostID, ranges[j].Count) {
+				causes = append(causes, metav1.StatusCause{
+					Type:    metav1.CauseTypeFieldValueInvalid,
+					Message: fmt.Sprintf("%s overlaps with %s", field.Index(i).String(), field.Index(j).String()),
+					Field:   field.Index(i).String(),
+				})
+			}
+		}
+	}
 
 	return causes
 }
@@ -515,6 +577,86 @@ func validateHostDevicesWithPassthroughEnabled(field *k8sfield.Path, spec *v1.Vi
 	return causes
 }
 
+func validateUSBDevices(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec, config *virtconfig.ClusterConfig) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	if len(spec.Domain.Devices.USBDevices) == 0 {
+		return causes
+	}
+
+	usbDevicesField := field.Child("domain", "devices", "usbDevices")
+	if !config.USBPassthroughEnabled() {
+		return append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s feature gate is not enabled in kubevirt-config", featuregate.USBPassthroughGate),
+			Field:   usbDevicesField.String(),
+		})
+	}
+
+	names := map[string]struct{}{}
+	for i, device := range spec.Domain.Devices.USBDevices {
+		deviceField := usbDevicesField.Index(i)
+		if device.Name == "" {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueRequired,
+				Message: fmt.Sprintf("%s must have a name", deviceField.String()),
+				Field:   deviceField.Child("name").String(),
+			})
+		} else if _, exists := names[device.Name]; exists {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueDuplicate,
+				Message: fmt.Sprintf("%s name %s is already used by another USB device", deviceField.String(), device.Name),
+				Field:   deviceField.Child("name").String(),
+			})
+		}
+		names[device.Name] = struct{}{}
+
+		switch {
+		case (device.VendorProduct == "") == (device.BusPort == ""):
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s must select the device by exactly one of vendorProduct or busPort", deviceField.String()),
+				Field:   deviceField.String(),
+			})
+		case device.VendorProduct != "" && !isValidUSBVendorProduct(device.VendorProduct):
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s vendorProduct %s must be of the form vendor_id:product_id, e.g. 1050:0407", deviceField.String(), device.VendorProduct),
+				Field:   deviceField.Child("vendorProduct").String(),
+			})
+		case device.BusPort != "" && !isValidUSBBusPort(device.BusPort):
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s busPort %s must be of the form bus-port[.port...], e.g. 1-2.3", deviceField.String(), device.BusPort),
+				Field:   deviceField.Child("busPort").String(),
+			})
+		case !isPermittedUSBDevice(device, config.GetPermittedHostDevices()):
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s is not permitted by the USB passthrough devices of the permitted host devices in kubevirt-config", deviceField.String()),
+				Field:   deviceField.String(),
+			})
+		}
+	}
+	return causes
+}
+
+// isPermittedUSBDevice tells if the cluster admin permits a device with the selector of the USB device.
+// A permitted device may set both selectors, virt-handler only allocates the devices matching all of them.
+func isPermittedUSBDevice(device v1.USBDevice, permittedHostDevices *v1.PermittedHostDevices) bool {
+	if permittedHostDevices == nil {
+		return false
+	}
+	for _, permitted := range permittedHostDevices.USBPassthrough {
+		if device.VendorProduct != "" && strings.EqualFold(device.VendorProduct, permitted.VendorProduct) {
+			return true
+		}
+		if device.BusPort != "" && device.BusPort == permitted.BusPort {
+			return true
+		}
+	}
+	return false
+}
+
 func validateSoundDevices(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec) []metav1.StatusCause {
 	var causes []metav1.StatusCause
 	if spec.Domain.Devices.Sound == nil {
@@ -670,6 +812,23 @@ func validateCPUFeaturePolicies(field *k8sfield.Path, spec *v1.VirtualMachineIns
 	return causes
 }
 
+func validateMitigatedCPUVulnerabilities(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	if spec.Domain.CPU == nil {
+		return causes
+	}
+	for idx, vulnerability := range spec.Domain.CPU.MitigatedVulnerabilities {
+		if errs := validation.IsQualifiedName(v1.MitigatedCPUVulnerabilityLabel + vulnerability); len(errs) > 0 {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("CPU vulnerability %s is not a valid vulnerability name: %s", vulnerability, strings.Join(errs, ", ")),
+				Field:   field.Child("domain", "cpu", "mitigatedVulnerabilities").Index(idx).String(),
+			})
+		}
+	}
+	return causes
+}
+
 func validateCPUIsolatorThread(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec) []metav1.StatusCause {
 	var causes []metav1.StatusCause
 	if spec.Domain.CPU != nil && spec.Domain.CPU.IsolateEmulatorThread && !spec.Domain.CPU.DedicatedCPUPlacement {
@@ -682,6 +841,97 @@ func validateCPUIsolatorThread(field *k8sfield.Path, spec *v1.VirtualMachineInst
 	return causes
 }
 
+func validateHousekeepingCPU(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec, config *virtconfig.ClusterConfig) []metav1.StatusCause {
+	if spec.Domain.CPU == nil || spec.Domain.CPU.Housekeeping == nil {
+		return nil
+	}
+
+	var causes []metav1.StatusCause
+	housekeepingField := field.Child("domain", "cpu", "housekeeping")
+	if !config.HousekeepingCPUEnabled() {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s feature gate is not enabled in kubevirt-config, invalid entry %s", featuregate.HousekeepingCPUGate, housekeepingField.String()),
+			Field:   housekeepingField.String(),
+		})
+	}
+	if spec.Domain.CPU.DedicatedCPUPlacement {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s can not be combined with dedicated CPU placement, use isolateEmulatorThread instead", housekeepingField.String()),
+			Field:   housekeepingField.String(),
+		})
+	}
+	// The kernel does not accept CFS quotas below 1ms per period of 100ms
+	if spec.Domain.CPU.Housekeeping.CPU.MilliValue() < 10 {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s must be at least 10m", housekeepingField.Child("cpu").String()),
+			Field:   housekeepingField.Child("cpu").String(),
+		})
+	}
+	return causes
+}
+
+func validateThreadPlacement(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec, config *virtconfig.ClusterConfig) []metav1.StatusCause {
+	if spec.Domain.CPU == nil || spec.Domain.CPU.ThreadPlacement == nil {
+		return nil
+	}
+
+	var causes []metav1.StatusCause
+	placement := spec.Domain.CPU.ThreadPlacement
+	placementField := field.Child("domain", "cpu", "threadPlacement")
+	if !config.ThreadPlacementEnabled() {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s feature gate is not enabled in kubevirt-config, invalid entry %s", featuregate.ThreadPlacementGate, placementField.String()),
+			Field:   placementField.String(),
+		})
+	}
+	if !spec.Domain.CPU.DedicatedCPUPlacement {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s should be only set in combination with DedicatedCPUPlacement", placementField.String()),
+			Field:   placementField.String(),
+		})
+	}
+
+	policies := []struct {
+		name   string
+		policy v1.ThreadPlacementPolicy
+	}{{"emulatorThread", placement.EmulatorThread}, {"ioThreads", placement.IOThreads}}
+	for _, p := range policies {
+		name, policy := p.name, p.policy
+		switch policy {
+		case "", v1.ThreadPlacementShared, v1.ThreadPlacementDedicated, v1.ThreadPlacementSameNUMANode:
+		default:
+			causes = append(causes, metav1.StatusCause{
+				Type: metav1.CauseTypeFieldValueNotSupported,
+				Message: fmt.Sprintf("%s is not supported for %s, supported values are %s, %s and %s", policy, placementField.Child(name).String(),
+					v1.ThreadPlacementShared, v1.ThreadPlacementDedicated, v1.ThreadPlacementSameNUMANode),
+				Field: placementField.Child(name).String(),
+			})
+		}
+	}
+
+	if placement.EmulatorThread == v1.ThreadPlacementShared && spec.Domain.CPU.IsolateEmulatorThread {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s can not be %s if IsolateEmulatorThread is set", placementField.Child("emulatorThread").String(), v1.ThreadPlacementShared),
+			Field:   placementField.Child("emulatorThread").String(),
+		})
+	}
+	if placement.IOThreads != "" && placement.IOThreads != v1.ThreadPlacementShared &&
+		spec.Domain.IOThreadsPolicy != nil && *spec.Domain.IOThreadsPolicy == v1.IOThreadsPolicySupplementalPool {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s can not be combined with the %s IOThreads policy, which already places the IOThreads on dedicated pCPUs", placementField.Child("ioThreads").String(), v1.IOThreadsPolicySupplementalPool),
+			Field:   placementField.Child("ioThreads").String(),
+		})
+	}
+	return causes
+}
+
 func validateCpuPinning(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec, config *virtconfig.ClusterConfig) []metav1.StatusCause {
 	var causes []metav1.StatusCause
 	if spec.Domain.CPU != nil && spec.Domain.CPU.DedicatedCPUPlacement {
@@ -843,6 +1093,56 @@ func validateStartStrategy(field *k8sfield.Path, spec *v1.VirtualMachineInstance
 	return causes
 }
 
+func validateShutdownPolicy(field *k8sfield.Path, policy *v1.ShutdownPolicy) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	if policy == nil {
+		return causes
+	}
+	timeouts := []struct {
+		name    string
+		seconds *int64
+	}{
+		{"acpiTimeoutSeconds", policy.ACPITimeoutSeconds},
+		{"guestAgentTimeoutSeconds", policy.GuestAgentTimeoutSeconds},
+	}
+	for _, timeout := range timeouts {
+		if timeout.seconds != nil && *timeout.seconds < 0 {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s must not be negative", field.Child(timeout.name).String()),
+				Field:   field.Child(timeout.name).String(),
+			})
+		}
+	}
+	return causes
+}
+
+func validateGuestLicense(field *k8sfield.Path, license *v1.GuestLicense) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	if license == nil {
+		return causes
+	}
+	switch license.OSFamily {
+	case "", v1.GuestOSFamilyLinux, v1.GuestOSFamilyWindows, v1.GuestOSFamilyOther:
+	default:
+		causes = append(causes, metav1.StatusCause{
+			Type: metav1.CauseTypeFieldValueNotSupported,
+			Message: fmt.Sprintf("%s must be one of %s, %s or %s", field.Child("osFamily").String(),
+				v1.GuestOSFamilyLinux, v1.GuestOSFamilyWindows, v1.GuestOSFamilyOther),
+			Field: field.Child("osFamily").String(),
+		})
+	}
+	// The license model is exposed as a metric label
+	for _, msg := range validation.IsValidLabelValue(license.LicenseModel) {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s is invalid: %s", field.Child("licenseModel").String(), msg),
+			Field:   field.Child("licenseModel").String(),
+		})
+	}
+	return causes
+}
+
 func validateMemoryRequestsAndLimits(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec) []metav1.StatusCause {
 	var causes []metav1.StatusCause
 	if spec.Domain.Resources.Requests.Memory().Value() > 0 && spec.Domain.Resources.Limits.Memory().Value() > 0 && spec.Domain.Resources.Requests.Memory().Value() != spec.Domain.Resources.Limits.Memory().Value() {
@@ -1478,6 +1778,9 @@ func validateDomainSpec(field *k8sfield.Path, spec *v1.DomainSpec) []metav1.Stat
 
 	causes = append(causes, validateDevices(field.Child("devices"), &spec.Devices)...)
 	causes = append(causes, validateFirmware(field.Child("firmware"), spec.Firmware)...)
+	if spec.Clock != nil {
+		causes = append(causes, validateGuestTimeSync(field.Child("clock", "guestTimeSync"), spec.Clock.GuestTimeSync)...)
+	}
 
 	if secureBootEnabled(spec.Firmware) && !smmFeatureEnabled(spec.Features) {
 		causes = append(causes, metav1.StatusCause{
@@ -1490,6 +1793,31 @@ func validateDomainSpec(field *k8sfield.Path, spec *v1.DomainSpec) []metav1.Stat
 	return causes
 }
 
+func validateGuestTimeSync(field *k8sfield.Path, timeSync *v1.GuestTimeSync) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	if timeSync == nil {
+		return causes
+	}
+	switch timeSync.Policy {
+	case "", v1.GuestTimeSyncOnResume, v1.GuestTimeSyncOnDrift, v1.GuestTimeSyncDisabled:
+	default:
+		causes = append(causes, metav1.StatusCause{
+			Type: metav1.CauseTypeFieldValueNotSupported,
+			Message: fmt.Sprintf("%s must be one of %s, %s or %s", field.Child("policy").String(),
+				v1.GuestTimeSyncOnResume, v1.GuestTimeSyncOnDrift, v1.GuestTimeSyncDisabled),
+			Field: field.Child("policy").String(),
+		})
+	}
+	if timeSync.DriftThresholdSeconds != nil && *timeSync.DriftThresholdSeconds == 0 {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s must be greater than 0", field.Child("driftThresholdSeconds").String()),
+			Field:   field.Child("driftThresholdSeconds").String(),
+		})
+	}
+	return causes
+}
+
 func validateAccessCredentials(field *k8sfield.Path, accessCredentials []v1.AccessCredential, volumes []v1.Volume) []metav1.StatusCause {
 	var causes []metav1.StatusCause
 
@@ -1704,6 +2032,24 @@ func validateVolumes(field *k8sfield.Path, volumes []v1.Volume, config *virtconf
 			})
 		}
 
+		if volume.Ephemeral != nil && volume.Ephemeral.Overlay != nil {
+			overlayField := field.Index(idx).Child("ephemeral", "overlay")
+			if volume.Ephemeral.Overlay.StorageClassName == "" {
+				causes = append(causes, metav1.StatusCause{
+					Type:    metav1.CauseTypeFieldValueRequired,
+					Message: fmt.Sprintf("%s must be set", overlayField.Child("storageClassName").String()),
+					Field:   overlayField.Child("storageClassName").String(),
+				})
+			}
+			if volume.Ephemeral.Overlay.Capacity.Sign() <= 0 {
+				causes = append(causes, metav1.StatusCause{
+					Type:    metav1.CauseTypeFieldValueInvalid,
+					Message: fmt.Sprintf("%s must be greater than zero", overlayField.Child("capacity").String()),
+					Field:   overlayField.Child("capacity").String(),
+				})
+			}
+		}
+
 		// Verify cloud init data is within size limits
 		if volume.CloudInitNoCloud != nil || volume.CloudInitConfigDrive != nil {
 			var userDataSecretRef, networkDataSecretRef *k8sv1.LocalObjectReference
@@ -2132,6 +2478,46 @@ func validateErrorPolicy(field *k8sfield.Path, idx int, disk v1.Disk) []metav1.S
 	return causes
 }
 
+func validateIOTune(field *k8sfield.Path, idx int, disk v1.Disk) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	if disk.IOTune == nil {
+		return causes
+	}
+	ioTuneField := field.Index(idx).Child("ioTune")
+	ioTune := disk.IOTune
+	for _, limit := range []struct {
+		name  string
+		value *resource.Quantity
+	}{
+		{"totalBytesSec", ioTune.TotalBytesSec},
+		{"readBytesSec", ioTune.ReadBytesSec},
+		{"writeBytesSec", ioTune.WriteBytesSec},
+	} {
+		if limit.value != nil && limit.value.Sign() < 0 {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s must not be negative", ioTuneField.Child(limit.name).String()),
+				Field:   ioTuneField.Child(limit.name).String(),
+			})
+		}
+	}
+	if ioTune.TotalBytesSec != nil && (ioTune.ReadBytesSec != nil || ioTune.WriteBytesSec != nil) {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s can't be combined with readBytesSec or writeBytesSec", ioTuneField.Child("totalBytesSec").String()),
+			Field:   ioTuneField.Child("totalBytesSec").String(),
+		})
+	}
+	if ioTune.TotalIOPSSec != nil && (ioTune.ReadIOPSSec != nil || ioTune.WriteIOPSSec != nil) {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s can't be combined with readIOPSSec or writeIOPSSec", ioTuneField.Child("totalIOPSSec").String()),
+			Field:   ioTuneField.Child("totalIOPSSec").String(),
+		})
+	}
+	return causes
+}
+
 func validateDiskNameAsContainerName(field *k8sfield.Path, idx int, disk v1.Disk) []metav1.StatusCause {
 	var causes []metav1.StatusCause
 	for _, err := range validation.IsDNS1123Label(disk.Name) {
@@ -2209,6 +2595,7 @@ func validateDisks(field *k8sfield.Path, disks []v1.Disk) []metav1.StatusCause {
 		causes = append(causes, validateCacheMode(field, idx, disk)...)
 		causes = append(causes, validateIOMode(field, idx, disk)...)
 		causes = append(causes, validateErrorPolicy(field, idx, disk)...)
+		causes = append(causes, validateIOTune(field, idx, disk)...)
 		// Verify disk and volume name can be a valid container name since disk
 		// name can become a container name which will fail to schedule if invalid
 		causes = append(causes, validateDiskNameAsContainerName(field, idx, disk)...)
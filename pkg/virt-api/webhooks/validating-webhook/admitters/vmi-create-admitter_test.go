@@ -1190,6 +1190,34 @@ var _ = Describe("Validating VMICreate Admitter", func() {
 			Entry("config map should be accepted when the deprecated feature gate is enabled", featuregate.VirtIOFSGate, true, libvmi.WithConfigMapFs("sharedconfigmap", "sharedconfigmap")),
 		)
 
+		DescribeTable("virtiofs ID mapping", func(idMapping *v1.VirtiofsIDMapping, expectedField string) {
+			enableFeatureGate(featuregate.VirtIOFSStorageVolumeGate)
+			vmi := libvmi.New(libvmi.WithFilesystemPVC("sharedtestdisk"))
+			vmi.Spec.Domain.Devices.Filesystems[0].Virtiofs.IDMapping = idMapping
+
+			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+			if expectedField == "" {
+				Expect(causes).To(BeEmpty())
+			} else {
+				Expect(causes).To(HaveLen(1))
+				Expect(causes[0].Field).To(Equal(expectedField))
+			}
+		},
+			Entry("should accept disjoint ranges", &v1.VirtiofsIDMapping{
+				UserIDs:  []v1.VirtiofsIDRange{{GuestID: 0, HostID: 107, Count: 1}, {GuestID: 1000, HostID: 1000, Count: 100}},
+				GroupIDs: []v1.VirtiofsIDRange{{GuestID: 0, HostID: 107, Count: 1}},
+			}, ""),
+			Entry("should reject overlapping guest IDs", &v1.VirtiofsIDMapping{
+				UserIDs: []v1.VirtiofsIDRange{{GuestID: 1000, HostID: 1000, Count: 100}, {GuestID: 1050, HostID: 5000, Count: 10}},
+			}, "fake.domain.devices.filesystems[0].virtiofs.idMapping.userIDs[1]"),
+			Entry("should reject overlapping host IDs", &v1.VirtiofsIDMapping{
+				GroupIDs: []v1.VirtiofsIDRange{{GuestID: 0, HostID: 107, Count: 1}, {GuestID: 1000, HostID: 100, Count: 10}},
+			}, "fake.domain.devices.filesystems[0].virtiofs.idMapping.groupIDs[1]"),
+			Entry("should reject empty ranges", &v1.VirtiofsIDMapping{
+				UserIDs: []v1.VirtiofsIDRange{{GuestID: 0, HostID: 107, Count: 0}},
+			}, "fake.domain.devices.filesystems[0].virtiofs.idMapping.userIDs[0].count"),
+		)
+
 		It("should reject host devices when feature gate is disabled", func() {
 			vmi := api.NewMinimalVMI("testvm")
 			vmi.Spec.Domain.Devices.HostDevices = []v1.HostDevice{
@@ -1356,6 +1384,80 @@ var _ = Describe("Validating VMICreate Admitter", func() {
 			Expect(causes[0].Field).To(Equal("fake.startStrategy"))
 			Expect(causes[0].Message).To(Equal("either fake.startStrategy or fake.livenessProbe should be provided.Pausing VMI with LivenessProbe is not supported"))
 		})
+		It("should accept a shutdown policy with stage timeouts", func() {
+			vmi := api.NewMinimalVMI("testvmi")
+			vmi.Spec.ShutdownPolicy = &v1.ShutdownPolicy{
+				ACPITimeoutSeconds:       pointer.P(int64(10)),
+				GuestAgentTimeoutSeconds: pointer.P(int64(0)),
+			}
+
+			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+			Expect(causes).To(BeEmpty())
+		})
+		It("should reject a shutdown policy with negative stage timeouts", func() {
+			vmi := api.NewMinimalVMI("testvmi")
+			vmi.Spec.ShutdownPolicy = &v1.ShutdownPolicy{
+				ACPITimeoutSeconds:       pointer.P(int64(-1)),
+				GuestAgentTimeoutSeconds: pointer.P(int64(-1)),
+			}
+
+			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+			Expect(causes).To(HaveLen(2))
+			Expect(causes[0].Field).To(Equal("fake.shutdownPolicy.acpiTimeoutSeconds"))
+			Expect(causes[0].Message).To(Equal("fake.shutdownPolicy.acpiTimeoutSeconds must not be negative"))
+			Expect(causes[1].Field).To(Equal("fake.shutdownPolicy.guestAgentTimeoutSeconds"))
+		})
+		It("should accept a guest license", func() {
+			vmi := api.NewMinimalVMI("testvmi")
+			vmi.Spec.GuestLicense = &v1.GuestLicense{
+				OSFamily:     v1.GuestOSFamilyWindows,
+				LicenseModel: "perCore",
+				BYOL:         pointer.P(true),
+			}
+
+			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+			Expect(causes).To(BeEmpty())
+		})
+		It("should reject a guest license with an unknown OS family and an invalid license model", func() {
+			vmi := api.NewMinimalVMI("testvmi")
+			vmi.Spec.GuestLicense = &v1.GuestLicense{
+				OSFamily:     "solaris",
+				LicenseModel: "per core",
+			}
+
+			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+			Expect(causes).To(HaveLen(2))
+			Expect(causes[0].Field).To(Equal("fake.guestLicense.osFamily"))
+			Expect(causes[0].Message).To(Equal("fake.guestLicense.osFamily must be one of linux, windows or other"))
+			Expect(causes[1].Field).To(Equal("fake.guestLicense.licenseModel"))
+		})
+		It("should accept a guest time sync policy with a drift threshold", func() {
+			vmi := api.NewMinimalVMI("testvmi")
+			vmi.Spec.Domain.Clock = &v1.Clock{
+				GuestTimeSync: &v1.GuestTimeSync{
+					Policy:                v1.GuestTimeSyncOnDrift,
+					DriftThresholdSeconds: pointer.P(uint32(5)),
+				},
+			}
+
+			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+			Expect(causes).To(BeEmpty())
+		})
+		It("should reject an unknown guest time sync policy and a zero drift threshold", func() {
+			vmi := api.NewMinimalVMI("testvmi")
+			vmi.Spec.Domain.Clock = &v1.Clock{
+				GuestTimeSync: &v1.GuestTimeSync{
+					Policy:                "Always",
+					DriftThresholdSeconds: pointer.P(uint32(0)),
+				},
+			}
+
+			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+			Expect(causes).To(HaveLen(2))
+			Expect(causes[0].Field).To(Equal("fake.domain.clock.guestTimeSync.policy"))
+			Expect(causes[0].Message).To(Equal("fake.domain.clock.guestTimeSync.policy must be one of OnResume, OnDrift or Disabled"))
+			Expect(causes[1].Field).To(Equal("fake.domain.clock.guestTimeSync.driftThresholdSeconds"))
+		})
 		Context("with kernel boot defined", func() {
 
 			createKernelBoot := func(kernelArgs, initrdPath, kernelPath, image string) *v1.KernelBoot {
@@ -1607,6 +1709,102 @@ var _ = Describe("Validating VMICreate Admitter", func() {
 		})
 	})
 
+	Context("with housekeeping CPU", func() {
+		newVMIWithHousekeeping := func(cpu string) *v1.VirtualMachineInstance {
+			vmi := newBaseVmi()
+			vmi.Spec.Domain.CPU = &v1.CPU{Housekeeping: &v1.Housekeeping{CPU: resource.MustParse(cpu)}}
+			return vmi
+		}
+
+		It("should accept the housekeeping CPU with the feature gate enabled", func() {
+			enableFeatureGate(featuregate.HousekeepingCPUGate)
+			vmi := newVMIWithHousekeeping("200m")
+			Expect(ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)).To(BeEmpty())
+		})
+
+		It("should reject the housekeeping CPU if the feature gate is disabled", func() {
+			vmi := newVMIWithHousekeeping("200m")
+			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+			Expect(causes).To(HaveLen(1))
+			Expect(causes[0].Field).To(Equal("fake.domain.cpu.housekeeping"))
+			Expect(causes[0].Message).To(ContainSubstring(featuregate.HousekeepingCPUGate))
+		})
+
+		It("should reject the housekeeping CPU with dedicated CPU placement", func() {
+			enableFeatureGate(featuregate.HousekeepingCPUGate)
+			vmi := newBaseVmi(libvmi.WithDedicatedCPUPlacement(), libvmi.WithCPUCount(2, 1, 1), libvmi.WithLimitMemory("512Mi"))
+			vmi.Spec.Domain.CPU.Housekeeping = &v1.Housekeeping{CPU: resource.MustParse("200m")}
+			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+			Expect(causes).To(HaveLen(1))
+			Expect(causes[0].Field).To(Equal("fake.domain.cpu.housekeeping"))
+		})
+
+		It("should reject a housekeeping CPU below the minimal CFS quota", func() {
+			enableFeatureGate(featuregate.HousekeepingCPUGate)
+			vmi := newVMIWithHousekeeping("5m")
+			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+			Expect(causes).To(HaveLen(1))
+			Expect(causes[0].Field).To(Equal("fake.domain.cpu.housekeeping.cpu"))
+		})
+	})
+
+	Context("with thread placement", func() {
+		newVMIWithThreadPlacement := func(placement *v1.ThreadPlacement) *v1.VirtualMachineInstance {
+			vmi := newBaseVmi(libvmi.WithDedicatedCPUPlacement(), libvmi.WithCPUCount(2, 1, 1), libvmi.WithLimitMemory("512Mi"))
+			vmi.Spec.Domain.CPU.ThreadPlacement = placement
+			return vmi
+		}
+
+		It("should accept the thread placement with the feature gate enabled", func() {
+			enableFeatureGate(featuregate.ThreadPlacementGate)
+			vmi := newVMIWithThreadPlacement(&v1.ThreadPlacement{
+				EmulatorThread: v1.ThreadPlacementSameNUMANode,
+				IOThreads:      v1.ThreadPlacementDedicated,
+			})
+			vmi.Spec.Domain.CPU.IsolateEmulatorThread = true
+			Expect(ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)).To(BeEmpty())
+		})
+
+		It("should reject the thread placement if the feature gate is disabled", func() {
+			vmi := newVMIWithThreadPlacement(&v1.ThreadPlacement{EmulatorThread: v1.ThreadPlacementShared})
+			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+			Expect(causes).To(HaveLen(1))
+			Expect(causes[0].Field).To(Equal("fake.domain.cpu.threadPlacement"))
+			Expect(causes[0].Message).To(ContainSubstring(featuregate.ThreadPlacementGate))
+		})
+
+		It("should reject the thread placement without dedicated CPU placement", func() {
+			enableFeatureGate(featuregate.ThreadPlacementGate)
+			vmi := newBaseVmi()
+			vmi.Spec.Domain.CPU = &v1.CPU{ThreadPlacement: &v1.ThreadPlacement{IOThreads: v1.ThreadPlacementDedicated}}
+			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+			Expect(causes).To(HaveLen(1))
+			Expect(causes[0].Field).To(Equal("fake.domain.cpu.threadPlacement"))
+		})
+
+		DescribeTable("should reject", func(placement *v1.ThreadPlacement, isolateEmulatorThread bool, ioThreadsPolicy *v1.IOThreadsPolicy, expectedField string) {
+			enableFeatureGate(featuregate.ThreadPlacementGate)
+			vmi := newVMIWithThreadPlacement(placement)
+			vmi.Spec.Domain.CPU.IsolateEmulatorThread = isolateEmulatorThread
+			vmi.Spec.Domain.IOThreadsPolicy = ioThreadsPolicy
+			if ioThreadsPolicy != nil {
+				vmi.Spec.Domain.IOThreads = &v1.DiskIOThreads{SupplementalPoolThreadCount: pointer.P(uint32(2))}
+			}
+			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+			Expect(causes).To(HaveLen(1))
+			Expect(causes[0].Field).To(Equal(expectedField))
+		},
+			Entry("an unknown emulator thread policy", &v1.ThreadPlacement{EmulatorThread: "anywhere"}, false, nil,
+				"fake.domain.cpu.threadPlacement.emulatorThread"),
+			Entry("an unknown IOThreads policy", &v1.ThreadPlacement{IOThreads: "anywhere"}, false, nil,
+				"fake.domain.cpu.threadPlacement.ioThreads"),
+			Entry("a shared emulator thread which is isolated", &v1.ThreadPlacement{EmulatorThread: v1.ThreadPlacementShared}, true, nil,
+				"fake.domain.cpu.threadPlacement.emulatorThread"),
+			Entry("dedicated IOThreads with the supplemental pool", &v1.ThreadPlacement{IOThreads: v1.ThreadPlacementDedicated}, false,
+				pointer.P(v1.IOThreadsPolicySupplementalPool), "fake.domain.cpu.threadPlacement.ioThreads"),
+		)
+	})
+
 	Context("with AccessCredentials", func() {
 		It("should accept a valid ssh access credential with configdrive propagation", func() {
 			vmi := newBaseVmi(libvmi.WithCloudInitConfigDrive(libvmici.WithConfigDriveUserData(" ")))
@@ -1874,6 +2072,27 @@ var _ = Describe("Validating VMICreate Admitter", func() {
 		})
 	})
 
+	Context("with mitigated CPU vulnerabilities", func() {
+		It("should accept vulnerability names", func() {
+			vmi := api.NewMinimalVMI("testvm")
+			vmi.Spec.Domain.CPU = &v1.CPU{
+				MitigatedVulnerabilities: []string{"retbleed", "spec_store_bypass"},
+			}
+			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+			Expect(causes).To(BeEmpty())
+		})
+
+		It("should reject a vulnerability name which does not form a valid label", func() {
+			vmi := api.NewMinimalVMI("testvm")
+			vmi.Spec.Domain.CPU = &v1.CPU{
+				MitigatedVulnerabilities: []string{"retbleed", "spectre v2"},
+			}
+			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+			Expect(causes).To(HaveLen(1))
+			Expect(causes[0].Field).To(Equal("fake.domain.cpu.mitigatedVulnerabilities[1]"))
+		})
+	})
+
 	Context("with Disk", func() {
 		DescribeTable("should accept valid disks",
 			func(disk v1.Disk) {
@@ -2248,6 +2467,55 @@ var _ = Describe("Validating VMICreate Admitter", func() {
 			Entry("enospace", v1.DiskErrorPolicyEnospace),
 		)
 
+		DescribeTable("should reject disk with invalid ioTune", func(ioTune *v1.DiskIOTune, field, message string) {
+			vmi := api.NewMinimalVMI("testvmi")
+			vmi.Spec.Domain.Devices.Disks = append(vmi.Spec.Domain.Devices.Disks, v1.Disk{
+				Name: "testdisk", IOTune: ioTune, DiskDevice: v1.DiskDevice{
+					Disk: &v1.DiskTarget{}}})
+
+			causes := validateDisks(k8sfield.NewPath("fake"), vmi.Spec.Domain.Devices.Disks)
+			Expect(causes).To(HaveLen(1))
+			Expect(string(causes[0].Type)).To(Equal("FieldValueInvalid"))
+			Expect(causes[0].Field).To(Equal(field))
+			Expect(causes[0].Message).To(Equal(message))
+		},
+			Entry("with a negative throughput", &v1.DiskIOTune{ReadBytesSec: pointer.P(resource.MustParse("-1Mi"))},
+				"fake[0].ioTune.readBytesSec", "fake[0].ioTune.readBytesSec must not be negative"),
+			Entry("with total and read throughput", &v1.DiskIOTune{
+				TotalBytesSec: pointer.P(resource.MustParse("10Mi")),
+				ReadBytesSec:  pointer.P(resource.MustParse("5Mi")),
+			}, "fake[0].ioTune.totalBytesSec", "fake[0].ioTune.totalBytesSec can't be combined with readBytesSec or writeBytesSec"),
+			Entry("with total and write operations", &v1.DiskIOTune{
+				TotalIOPSSec: pointer.P(uint64(1000)),
+				WriteIOPSSec: pointer.P(uint64(500)),
+			}, "fake[0].ioTune.totalIOPSSec", "fake[0].ioTune.totalIOPSSec can't be combined with readIOPSSec or writeIOPSSec"),
+		)
+
+		DescribeTable("It should accept a disk with a valid ioTune", func(ioTune *v1.DiskIOTune) {
+			vmi := api.NewMinimalVMI("testvmi")
+			vmi.Spec.Domain.Devices.Disks = append(vmi.Spec.Domain.Devices.Disks, v1.Disk{
+				Name: "testdisk", IOTune: ioTune, DiskDevice: v1.DiskDevice{
+					Disk: &v1.DiskTarget{}}})
+
+			causes := validateDisks(k8sfield.NewPath("fake"), vmi.Spec.Domain.Devices.Disks)
+			Expect(causes).To(BeEmpty())
+		},
+			Entry("with total limits", &v1.DiskIOTune{
+				TotalBytesSec: pointer.P(resource.MustParse("10Mi")),
+				TotalIOPSSec:  pointer.P(uint64(1000)),
+			}),
+			Entry("with read and write limits", &v1.DiskIOTune{
+				ReadBytesSec:  pointer.P(resource.MustParse("10Mi")),
+				WriteBytesSec: pointer.P(resource.MustParse("5Mi")),
+				ReadIOPSSec:   pointer.P(uint64(1000)),
+				WriteIOPSSec:  pointer.P(uint64(500)),
+			}),
+			Entry("with total throughput and read operations", &v1.DiskIOTune{
+				TotalBytesSec: pointer.P(resource.MustParse("10Mi")),
+				ReadIOPSSec:   pointer.P(uint64(1000)),
+			}),
+		)
+
 		It("should reject invalid SN characters", func() {
 			vmi := api.NewMinimalVMI("testvmi")
 			order := uint(1)
@@ -2542,6 +2810,31 @@ var _ = Describe("Validating VMICreate Admitter", func() {
 			Expect(causes).To(HaveLen(1))
 			Expect(causes[0].Message).To(ContainSubstring("fake must have max one downwardMetric volume set"))
 		})
+		DescribeTable("should validate the overlay of ephemeral volumes", func(overlay *v1.EphemeralOverlay, expectedFields ...string) {
+			vmi := api.NewMinimalVMI("testvmi")
+			vmi.Spec.Volumes = append(vmi.Spec.Volumes, v1.Volume{
+				Name: "testEphemeral",
+				VolumeSource: v1.VolumeSource{
+					Ephemeral: &v1.EphemeralVolumeSource{
+						PersistentVolumeClaim: &k8sv1.PersistentVolumeClaimVolumeSource{ClaimName: "base"},
+						Overlay:               overlay,
+					},
+				},
+			})
+
+			causes := validateVolumes(k8sfield.NewPath("fake"), vmi.Spec.Volumes, config)
+			Expect(causes).To(HaveLen(len(expectedFields)))
+			for i, expectedField := range expectedFields {
+				Expect(causes[i].Field).To(Equal(expectedField))
+			}
+		},
+			Entry("accept no overlay", nil),
+			Entry("accept a complete overlay", &v1.EphemeralOverlay{StorageClassName: "local", Capacity: resource.MustParse("1Gi")}),
+			Entry("reject a missing storage class", &v1.EphemeralOverlay{Capacity: resource.MustParse("1Gi")},
+				"fake[0].ephemeral.overlay.storageClassName"),
+			Entry("reject a missing capacity", &v1.EphemeralOverlay{StorageClassName: "local"},
+				"fake[0].ephemeral.overlay.capacity"),
+		)
 		It("should reject hostDisk volumes if the feature gate is not enabled", func() {
 			vmi := api.NewMinimalVMI("testvmi")
 
@@ -3070,6 +3363,74 @@ var _ = Describe("Validating VMICreate Admitter", func() {
 		})
 	})
 
+	Context("with USB devices", func() {
+		var vmi *v1.VirtualMachineInstance
+		BeforeEach(func() {
+			vmi = api.NewMinimalVMI("testvmi")
+			kvConfig := kv.DeepCopy()
+			kvConfig.Spec.Configuration.DeveloperConfiguration.FeatureGates = []string{featuregate.USBPassthroughGate}
+			kvConfig.Spec.Configuration.PermittedHostDevices = &v1.PermittedHostDevices{
+				USBPassthrough: []v1.USBPassthroughHostDevice{
+					{VendorProduct: "1050:0407"},
+					{BusPort: "1-2.3"},
+					{VendorProduct: "072f:90cc", BusPort: "1-4"},
+				},
+			}
+			testutils.UpdateFakeKubeVirtClusterConfig(kvStore, kvConfig)
+		})
+
+		It("should reject USB devices when the feature gate is disabled", func() {
+			disableFeatureGates()
+			vmi.Spec.Domain.Devices.USBDevices = []v1.USBDevice{{Name: "dongle", VendorProduct: "1050:0407"}}
+			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+			Expect(causes).To(HaveLen(1))
+			Expect(causes[0].Message).To(ContainSubstring(fmt.Sprintf("%s feature gate is not enabled", featuregate.USBPassthroughGate)))
+		})
+
+		It("should accept USB devices selected by vendor:product or bus-port", func() {
+			vmi.Spec.Domain.Devices.USBDevices = []v1.USBDevice{
+				{Name: "dongle", VendorProduct: "1050:0407"},
+				{Name: "smartcard", BusPort: "1-2.3"},
+			}
+			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+			Expect(causes).To(BeEmpty())
+		})
+
+		DescribeTable("should reject invalid USB devices", func(devices []v1.USBDevice, field string) {
+			vmi.Spec.Domain.Devices.USBDevices = devices
+			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+			Expect(causes).To(HaveLen(1))
+			Expect(causes[0].Field).To(Equal(field))
+		},
+			Entry("without name", []v1.USBDevice{{VendorProduct: "1050:0407"}}, "fake.domain.devices.usbDevices[0].name"),
+			Entry("with a duplicate name", []v1.USBDevice{{Name: "dongle", VendorProduct: "1050:0407"}, {Name: "dongle", BusPort: "1-2.3"}},
+				"fake.domain.devices.usbDevices[1].name"),
+			Entry("without selector", []v1.USBDevice{{Name: "dongle"}}, "fake.domain.devices.usbDevices[0]"),
+			Entry("with both selectors", []v1.USBDevice{{Name: "dongle", VendorProduct: "1050:0407", BusPort: "1-2"}}, "fake.domain.devices.usbDevices[0]"),
+			Entry("with an invalid vendor:product", []v1.USBDevice{{Name: "dongle", VendorProduct: "yubikey"}}, "fake.domain.devices.usbDevices[0].vendorProduct"),
+			Entry("with an invalid bus-port", []v1.USBDevice{{Name: "dongle", BusPort: "usb1"}}, "fake.domain.devices.usbDevices[0].busPort"),
+			Entry("with a vendor:product which is not permitted", []v1.USBDevice{{Name: "dongle", VendorProduct: "0781:5583"}}, "fake.domain.devices.usbDevices[0]"),
+			Entry("with a bus-port which is not permitted", []v1.USBDevice{{Name: "dongle", BusPort: "1-2.4"}}, "fake.domain.devices.usbDevices[0]"),
+		)
+
+		It("should accept the USB devices selecting either selector of a permitted device", func() {
+			vmi.Spec.Domain.Devices.USBDevices = []v1.USBDevice{
+				{Name: "smartcard", VendorProduct: "072F:90CC"},
+				{Name: "reader", BusPort: "1-4"},
+			}
+			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+			Expect(causes).To(BeEmpty())
+		})
+
+		It("should reject USB devices when no USB passthrough device is permitted", func() {
+			enableFeatureGate(featuregate.USBPassthroughGate)
+			vmi.Spec.Domain.Devices.USBDevices = []v1.USBDevice{{Name: "dongle", VendorProduct: "1050:0407"}}
+			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+			Expect(causes).To(HaveLen(1))
+			Expect(causes[0].Message).To(ContainSubstring("is not permitted"))
+		})
+	})
+
 	Context("with affinity checks", func() {
 		var vmi *v1.VirtualMachineInstance
 		BeforeEach(func() {
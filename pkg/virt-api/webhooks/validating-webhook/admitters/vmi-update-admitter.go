@@ -232,15 +232,16 @@ func verifyHotplugVolumes(newHotplugVolumeMap, oldHotplugVolumeMap map[string]v1
 					})
 				}
 				disk := newDisks[k]
-				if disk.Disk == nil && disk.LUN == nil {
+				if disk.Disk == nil && disk.LUN == nil && disk.CDRom == nil {
 					return webhookutils.ToAdmissionResponse([]metav1.StatusCause{
 						{
 							Type:    metav1.CauseTypeFieldValueInvalid,
-							Message: fmt.Sprintf("Disk %s requires diskDevice of type 'disk' or 'lun' to be hotplugged.", k),
+							Message: fmt.Sprintf("Disk %s requires diskDevice of type 'disk', 'lun' or 'cdrom' to be hotplugged.", k),
 						},
 					})
 				}
-				if (disk.Disk == nil || disk.Disk.Bus != "scsi") && (disk.LUN == nil || disk.LUN.Bus != "scsi") {
+				if (disk.Disk == nil || disk.Disk.Bus != "scsi") && (disk.LUN == nil || disk.LUN.Bus != "scsi") &&
+					(disk.CDRom == nil || disk.CDRom.Bus != "scsi") {
 					return webhookutils.ToAdmissionResponse([]metav1.StatusCause{
 						{
 							Type:    metav1.CauseTypeFieldValueInvalid,
@@ -589,6 +589,26 @@ var _ = Describe("Validating VMIUpdate Admitter", func() {
 		return res
 	}
 
+	makeCDRomDisksInvalidBusLastDisk := func(indexes ...int) []v1.Disk {
+		res := makeCDRomDisks(indexes...)
+		for i, index := range indexes {
+			if i == len(indexes)-1 {
+				res[index].CDRom.Bus = v1.DiskBusSATA
+			}
+		}
+		return res
+	}
+
+	makeCDRomDisksDuplicateBootOrder := func(indexes ...int) []v1.Disk {
+		res := makeCDRomDisks(indexes...)
+		for i, index := range indexes {
+			if i == len(indexes)-1 {
+				res[index].BootOrder = pointer.P(uint(1))
+			}
+		}
+		return res
+	}
+
 	makeDisksWithIOThreads := func(indexes ...int) []v1.Disk {
 		res := makeDisks(indexes...)
 		for i, index := range indexes {
@@ -787,14 +807,31 @@ var _ = Describe("Validating VMIUpdate Admitter", func() {
 			makeFilesystems(),
 			makeStatus(1, 0),
 			makeExpected("hotplugged Disk volume-name-1 does not use a scsi bus", "")),
-		Entry("Should reject if we add disk with neither Disk nor LUN type",
+		Entry("Should accept if we add CD-ROM disk with valid SCSI bus",
 			makeVolumes(0, 1),
 			makeVolumes(0),
 			makeCDRomDisks(0, 1),
 			makeCDRomDisks(0),
 			makeFilesystems(),
 			makeStatus(1, 0),
-			makeExpected("Disk volume-name-1 requires diskDevice of type 'disk' or 'lun' to be hotplugged.", "")),
+			nil),
+		Entry("Should reject if we add CD-ROM disk with invalid bus",
+			makeVolumes(0, 1),
+			makeVolumes(0),
+			makeCDRomDisksInvalidBusLastDisk(0, 1),
+			makeCDRomDisks(0),
+			makeFilesystems(),
+			makeStatus(1, 0),
+			makeExpected("hotplugged Disk volume-name-1 does not use a scsi bus", "")),
+		Entry("Should reject if we add CD-ROM disk with a boot order used by another disk",
+			makeVolumes(0, 1),
+			makeVolumes(0),
+			makeCDRomDisksDuplicateBootOrder(0, 1),
+			makeCDRomDisks(0),
+			makeFilesystems(),
+			makeStatus(1, 0),
+			makeExpected("Boot order for spec.domain.devices.disks[1].bootOrder already set for a different device.",
+				"spec.domain.devices.disks[1].bootOrder")),
 		Entry("Should reject if we add disk with invalid boot order",
 			makeVolumes(0, 1),
 			makeVolumes(0),
@@ -38,11 +38,15 @@ import (
 	"kubevirt.io/kubevirt/pkg/controller"
 	"kubevirt.io/kubevirt/pkg/defaults"
 	"kubevirt.io/kubevirt/pkg/instancetype/conflict"
+	instancetypeNamespacePolicy "kubevirt.io/kubevirt/pkg/instancetype/namespacepolicy"
+	instancetypeNodes "kubevirt.io/kubevirt/pkg/instancetype/nodes"
 	instancetypeWebhooks "kubevirt.io/kubevirt/pkg/instancetype/webhooks/vm"
 	"kubevirt.io/kubevirt/pkg/liveupdate/memory"
 	metrics "kubevirt.io/kubevirt/pkg/monitoring/metrics/virt-api"
 	netadmitter "kubevirt.io/kubevirt/pkg/network/admitter"
+	"kubevirt.io/kubevirt/pkg/network/macpool"
 	storageAdmitters "kubevirt.io/kubevirt/pkg/storage/admitters"
+	storagetypes "kubevirt.io/kubevirt/pkg/storage/types"
 	migrationutil "kubevirt.io/kubevirt/pkg/util/migrations"
 	webhookutils "kubevirt.io/kubevirt/pkg/util/webhooks"
 	"kubevirt.io/kubevirt/pkg/virt-api/webhooks"
@@ -64,23 +68,45 @@ type instancetypeVMsAdmitter interface {
 	) (conflict.Conflicts, error)
 }
 
+type nodeCompatibilityChecker interface {
+	Check(*instancetypev1beta1.VirtualMachineInstancetypeSpec, *v1.VirtualMachineInstanceSpec) error
+}
+
+type namespacePolicyChecker interface {
+	Check([]v1.InstancetypeNamespacePolicy, *v1.VirtualMachine) ([]metav1.StatusCause, error)
+}
+
+type macAddressUsageFinder interface {
+	FindOtherVMWithMacAddress(vm *v1.VirtualMachine, macAddress string) (string, error)
+}
+
 type VMsAdmitter struct {
-	VirtClient              kubecli.KubevirtClient
-	DataSourceInformer      cache.SharedIndexInformer
-	NamespaceInformer       cache.SharedIndexInformer
-	InstancetypeAdmitter    instancetypeVMsAdmitter
-	ClusterConfig           *virtconfig.ClusterConfig
-	KubeVirtServiceAccounts map[string]struct{}
+	VirtClient               kubecli.KubevirtClient
+	DataSourceInformer       cache.SharedIndexInformer
+	NamespaceInformer        cache.SharedIndexInformer
+	InstancetypeAdmitter     instancetypeVMsAdmitter
+	NodeCompatibilityChecker nodeCompatibilityChecker
+	NamespacePolicyChecker   namespacePolicyChecker
+	MacAddressUsageFinder    macAddressUsageFinder
+	ClusterConfig            *virtconfig.ClusterConfig
+	KubeVirtServiceAccounts  map[string]struct{}
 }
 
 func NewVMsAdmitter(clusterConfig *virtconfig.ClusterConfig, client kubecli.KubevirtClient, informers *webhooks.Informers, kubeVirtServiceAccounts map[string]struct{}) *VMsAdmitter {
+	namespacePolicyChecker := instancetypeNamespacePolicy.New(informers.NamespaceInformer.GetStore())
+	clusterPreferencePolicy := func(namespace, name string) error {
+		return namespacePolicyChecker.CheckClusterPreference(clusterConfig.GetInstancetypeNamespacePolicies(), namespace, name)
+	}
 	return &VMsAdmitter{
-		VirtClient:              client,
-		DataSourceInformer:      informers.DataSourceInformer,
-		NamespaceInformer:       informers.NamespaceInformer,
-		InstancetypeAdmitter:    instancetypeWebhooks.NewAdmitter(client),
-		ClusterConfig:           clusterConfig,
-		KubeVirtServiceAccounts: kubeVirtServiceAccounts,
+		VirtClient:               client,
+		DataSourceInformer:       informers.DataSourceInformer,
+		NamespaceInformer:        informers.NamespaceInformer,
+		InstancetypeAdmitter:     instancetypeWebhooks.NewAdmitter(client, clusterPreferencePolicy),
+		NodeCompatibilityChecker: instancetypeNodes.New(informers.NodeInformer.GetStore()),
+		NamespacePolicyChecker:   namespacePolicyChecker,
+		MacAddressUsageFinder:    macpool.NewUsageFinder(informers.VMInformer.GetIndexer()),
+		ClusterConfig:            clusterConfig,
+		KubeVirtServiceAccounts:  kubeVirtServiceAccounts,
 	}
 }
 
@@ -109,6 +135,22 @@ func (admitter *VMsAdmitter) Admit(ctx context.Context, ar *admissionv1.Admissio
 		}
 	}
 
+	causes, err := admitter.checkNamespacePolicies(ar.Request, &vm)
+	if err != nil {
+		return webhookutils.ToAdmissionResponseError(err)
+	}
+	if len(causes) > 0 {
+		return webhookutils.ToAdmissionResponse(causes)
+	}
+
+	causes, err = admitter.checkMacAddressConflicts(ar.Request, &vm)
+	if err != nil {
+		return webhookutils.ToAdmissionResponseError(err)
+	}
+	if len(causes) > 0 {
+		return webhookutils.ToAdmissionResponse(causes)
+	}
+
 	// We apply any referenced instancetype and preferences early here to the VirtualMachine in order to
 	// validate the resulting VirtualMachineInstanceSpec below. As we don't want to persist these changes
 	// we pass a copy of the original VirtualMachine here and to the validation call below.
@@ -132,6 +174,11 @@ func (admitter *VMsAdmitter) Admit(ctx context.Context, ar *admissionv1.Admissio
 		}})
 	}
 
+	nodeCompatibilityWarnings, causes := admitter.checkNodeCompatibility(ar.Request, &vm, instancetypeSpec, &vmCopy.Spec.Template.Spec)
+	if len(causes) > 0 {
+		return webhookutils.ToAdmissionResponse(causes)
+	}
+
 	if ar.Request.Operation == admissionv1.Create {
 		clusterCfg := admitter.ClusterConfig.GetConfig()
 		if devCfg := clusterCfg.DeveloperConfiguration; devCfg != nil {
@@ -173,9 +220,13 @@ func (admitter *VMsAdmitter) Admit(ctx context.Context, ar *admissionv1.Admissio
 	}
 
 	warnings := warnDeprecatedAPIs(&vm.Spec.Template.Spec, admitter.ClusterConfig)
+	warnings = append(warnings, nodeCompatibilityWarnings...)
 	if vm.Spec.Running != nil {
 		warnings = append(warnings, "spec.running is deprecated, please use spec.runStrategy instead.")
 	}
+	if !isKubeVirtServiceAccount {
+		warnings = append(warnings, warnDiscouragedConfigurations(ar.Request, k8sfield.NewPath("spec", "template", "spec"), &vmCopy.Spec.Template.Spec, admitter.ClusterConfig)...)
+	}
 
 	return &admissionv1.AdmissionResponse{
 		Allowed:  true,
@@ -183,6 +234,115 @@ func (admitter *VMsAdmitter) Admit(ctx context.Context, ar *admissionv1.Admissio
 	}
 }
 
+// checkMacAddressConflicts rejects MAC addresses used by other VirtualMachines when the MAC address pool is enabled.
+// Only the MAC addresses which are new to the VirtualMachine are checked, so that existing conflicts do not block updates.
+func (admitter *VMsAdmitter) checkMacAddressConflicts(request *admissionv1.AdmissionRequest, vm *v1.VirtualMachine) ([]metav1.StatusCause, error) {
+	if !admitter.ClusterConfig.MacAddressPoolEnabled() || vm.Spec.Template == nil {
+		return nil, nil
+	}
+
+	oldMacAddresses := map[string]struct{}{}
+	if request.Operation == admissionv1.Update {
+		oldVM := v1.VirtualMachine{}
+		if err := json.Unmarshal(request.OldObject.Raw, &oldVM); err != nil {
+			return nil, err
+		}
+		if oldVM.Spec.Template != nil {
+			for _, iface := range oldVM.Spec.Template.Spec.Domain.Devices.Interfaces {
+				oldMacAddresses[iface.MacAddress] = struct{}{}
+			}
+		}
+	}
+
+	var causes []metav1.StatusCause
+	for idx, iface := range vm.Spec.Template.Spec.Domain.Devices.Interfaces {
+		if _, exists := oldMacAddresses[iface.MacAddress]; exists || iface.MacAddress == "" {
+			continue
+		}
+		// Invalid MAC addresses are reported by the network validation
+		otherVM, err := admitter.MacAddressUsageFinder.FindOtherVMWithMacAddress(vm, iface.MacAddress)
+		if err != nil || otherVM == "" {
+			continue
+		}
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueDuplicate,
+			Message: fmt.Sprintf("MAC address %s is already used by VirtualMachine %s", iface.MacAddress, otherVM),
+			Field:   k8sfield.NewPath("spec", "template", "spec", "domain", "devices", "interfaces").Index(idx).Child("macAddress").String(),
+		})
+	}
+	return causes, nil
+}
+
+// checkNodeCompatibility looks for a node providing the resources of the instance type when the VirtualMachine is
+// created or moved to another instance type. Depending on the policy of the cluster it returns a warning or a cause.
+func (admitter *VMsAdmitter) checkNodeCompatibility(
+	request *admissionv1.AdmissionRequest,
+	vm *v1.VirtualMachine,
+	instancetypeSpec *instancetypev1beta1.VirtualMachineInstancetypeSpec,
+	vmiSpec *v1.VirtualMachineInstanceSpec,
+) ([]string, []metav1.StatusCause) {
+	policy := admitter.ClusterConfig.GetInstancetypeNodeCompatibilityPolicy()
+	if policy == v1.NodeCompatibilityIgnore || instancetypeSpec == nil {
+		return nil, nil
+	}
+
+	// Updates keeping the instance type, e.g. storing its revisionName, must not fail when nodes went away in the meantime
+	if request.Operation == admissionv1.Update {
+		oldVM := v1.VirtualMachine{}
+		if err := json.Unmarshal(request.OldObject.Raw, &oldVM); err == nil && oldVM.Spec.Instancetype != nil &&
+			oldVM.Spec.Instancetype.Name == vm.Spec.Instancetype.Name && oldVM.Spec.Instancetype.Kind == vm.Spec.Instancetype.Kind {
+			return nil, nil
+		}
+	}
+
+	err := admitter.NodeCompatibilityChecker.Check(instancetypeSpec, vmiSpec)
+	if err == nil {
+		return nil, nil
+	}
+	message := fmt.Sprintf("the VM cannot be scheduled with instance type %s: %v", vm.Spec.Instancetype.Name, err)
+	if policy == v1.NodeCompatibilityReject {
+		return nil, []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: message,
+			Field:   k8sfield.NewPath("spec", "instancetype").String(),
+		}}
+	}
+	return []string{message}, nil
+}
+
+func (admitter *VMsAdmitter) checkNamespacePolicies(
+	request *admissionv1.AdmissionRequest, vm *v1.VirtualMachine,
+) ([]metav1.StatusCause, error) {
+	policies := admitter.ClusterConfig.GetInstancetypeNamespacePolicies()
+	if len(policies) == 0 || (vm.Spec.Instancetype == nil && vm.Spec.Preference == nil) {
+		return nil, nil
+	}
+
+	references := &v1.VirtualMachine{
+		ObjectMeta: metav1.ObjectMeta{Namespace: request.Namespace},
+		Spec: v1.VirtualMachineSpec{
+			Instancetype: vm.Spec.Instancetype,
+			Preference:   vm.Spec.Preference,
+		},
+	}
+	// Updates keeping a reference, e.g. storing its revisionName, must not fail when the policies changed in the meantime
+	if request.Operation == admissionv1.Update {
+		oldVM := v1.VirtualMachine{}
+		if err := json.Unmarshal(request.OldObject.Raw, &oldVM); err == nil {
+			if old := oldVM.Spec.Instancetype; old != nil && vm.Spec.Instancetype != nil &&
+				old.Name == vm.Spec.Instancetype.Name && old.Kind == vm.Spec.Instancetype.Kind {
+				references.Spec.Instancetype = nil
+			}
+			if old := oldVM.Spec.Preference; old != nil && vm.Spec.Preference != nil &&
+				old.Name == vm.Spec.Preference.Name && old.Kind == vm.Spec.Preference.Kind {
+				references.Spec.Preference = nil
+			}
+		}
+	}
+
+	return admitter.NamespacePolicyChecker.Check(policies, references)
+}
+
 func (admitter *VMsAdmitter) AdmitStatus(ctx context.Context, ar *admissionv1.AdmissionReview) *admissionv1.AdmissionResponse {
 	vm, _, err := webhookutils.GetVMFromAdmissionReview(ar)
 	if err != nil {
@@ -223,10 +383,126 @@ func ValidateVirtualMachineSpec(field *k8sfield.Path, spec *v1.VirtualMachineSpe
 	causes = append(causes, storageAdmitters.ValidateDataVolumeTemplate(field, spec)...)
 	causes = append(causes, validateRunStrategy(field, spec)...)
 	causes = append(causes, validateLiveUpdateFeatures(field, spec, config)...)
+	causes = append(causes, validateStorageMigration(field.Child("storageMigration"), spec)...)
+	causes = append(causes, validateVolumeAutoExpansion(field.Child("volumeAutoExpansion"), spec, config)...)
 
 	return causes
 }
 
+func validateVolumeAutoExpansion(
+	field *k8sfield.Path, spec *v1.VirtualMachineSpec, config *virtconfig.ClusterConfig,
+) (causes []metav1.StatusCause) {
+	expansion := spec.VolumeAutoExpansion
+	if expansion == nil {
+		return causes
+	}
+
+	if !config.VolumeAutoExpansionEnabled() {
+		return append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueNotSupported,
+			Message: fmt.Sprintf("%s feature gate is not enabled", featuregate.VolumeAutoExpansionGate),
+			Field:   field.String(),
+		})
+	}
+
+	if expansion.UsageThresholdPercentage < 1 || expansion.UsageThresholdPercentage > 99 {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "the usage threshold must be between 1 and 99 percent",
+			Field:   field.Child("usageThresholdPercentage").String(),
+		})
+	}
+	if expansion.IncrementPercentage != nil && *expansion.IncrementPercentage < 1 {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "the increment must be at least 1 percent",
+			Field:   field.Child("incrementPercentage").String(),
+		})
+	}
+
+	if len(expansion.Volumes) == 0 {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueRequired,
+			Message: "at least one volume to expand is required",
+			Field:   field.Child("volumes").String(),
+		})
+	}
+	volumes := storagetypes.GetVolumesByName(&spec.Template.Spec)
+	serials := make(map[string]string)
+	for _, disk := range spec.Template.Spec.Domain.Devices.Disks {
+		serials[disk.Name] = disk.Serial
+	}
+	for i, name := range expansion.Volumes {
+		volume, exists := volumes[name]
+		switch {
+		case !exists:
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("volume %s does not exist", name),
+				Field:   field.Child("volumes").Index(i).String(),
+			})
+		case volume.PersistentVolumeClaim == nil && volume.DataVolume == nil:
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("volume %s is neither a PersistentVolumeClaim nor a DataVolume", name),
+				Field:   field.Child("volumes").Index(i).String(),
+			})
+		case serials[name] == "":
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("the disk of volume %s needs a serial to find its filesystems in the guest", name),
+				Field:   field.Child("volumes").Index(i).String(),
+			})
+		}
+	}
+	return causes
+}
+
+func validateStorageMigration(field *k8sfield.Path, spec *v1.VirtualMachineSpec) (causes []metav1.StatusCause) {
+	migration := spec.StorageMigration
+	if migration == nil {
+		return causes
+	}
+
+	if migration.StorageClassName == "" {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueRequired,
+			Message: "the StorageClass to migrate the volumes to is required",
+			Field:   field.Child("storageClassName").String(),
+		})
+	}
+
+	if migration.Method != nil &&
+		*migration.Method != v1.StorageMigrationMethodBlockCopy && *migration.Method != v1.StorageMigrationMethodCDI {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueNotSupported,
+			Message: fmt.Sprintf("Invalid storage migration method (%s)", *migration.Method),
+			Field:   field.Child("method").String(),
+		})
+	}
+
+	volumes := storagetypes.GetVolumesByName(&spec.Template.Spec)
+	for i, name := range migration.Volumes {
+		volume, exists := volumes[name]
+		if !exists {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("volume %s does not exist", name),
+				Field:   field.Child("volumes").Index(i).String(),
+			})
+			continue
+		}
+		if volume.PersistentVolumeClaim == nil && volume.DataVolume == nil {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("volume %s is neither a PersistentVolumeClaim nor a DataVolume", name),
+				Field:   field.Child("volumes").Index(i).String(),
+			})
+		}
+	}
+	return causes
+}
+
 func validateRunStrategy(field *k8sfield.Path, spec *v1.VirtualMachineSpec) (causes []metav1.StatusCause) {
 	if spec.Running != nil && spec.RunStrategy != nil {
 		causes = append(causes, metav1.StatusCause{
@@ -453,18 +729,20 @@ func validateDiskConfiguration(disk *v1.Disk, name string) []metav1.StatusCause
 			Field:   k8sfield.NewPath("Status", "volumeRequests").String(),
 		}}
 	}
-	if disk.DiskDevice.Disk == nil && disk.DiskDevice.LUN == nil {
+	switch {
+	case disk.DiskDevice.Disk != nil:
+		bus = disk.DiskDevice.Disk.Bus
+	case disk.DiskDevice.LUN != nil:
+		bus = disk.DiskDevice.LUN.Bus
+	case disk.DiskDevice.CDRom != nil:
+		bus = disk.DiskDevice.CDRom.Bus
+	default:
 		return []metav1.StatusCause{{
 			Type:    metav1.CauseTypeFieldValueInvalid,
-			Message: fmt.Sprintf("AddVolume request for [%s] requires diskDevice of type 'disk' or 'lun' to be used.", name),
+			Message: fmt.Sprintf("AddVolume request for [%s] requires diskDevice of type 'disk', 'lun' or 'cdrom' to be used.", name),
 			Field:   k8sfield.NewPath("Status", "volumeRequests").String(),
 		}}
 	}
-	if disk.DiskDevice.Disk != nil {
-		bus = disk.DiskDevice.Disk.Bus
-	} else {
-		bus = disk.DiskDevice.LUN.Bus
-	}
 	if bus != "scsi" {
 		return []metav1.StatusCause{{
 			Type:    metav1.CauseTypeFieldValueInvalid,
@@ -51,6 +51,7 @@ import (
 	cdiv1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
 
 	"kubevirt.io/kubevirt/pkg/instancetype/conflict"
+	instancetypeNamespacePolicy "kubevirt.io/kubevirt/pkg/instancetype/namespacepolicy"
 	instancetypeWebhooks "kubevirt.io/kubevirt/pkg/instancetype/webhooks/vm"
 	"kubevirt.io/kubevirt/pkg/libvmi"
 	"kubevirt.io/kubevirt/pkg/liveupdate/memory"
@@ -192,6 +193,155 @@ var _ = Describe("Validating VM Admitter", func() {
 		})
 	})
 
+	DescribeTable("should validate the storage migration", func(migration *v1.VirtualMachineStorageMigration, expectedField string) {
+		vm := libvmi.NewVirtualMachine(libvmi.New(
+			libvmi.WithPersistentVolumeClaim("disk", "disk0"),
+			libvmi.WithContainerDisk("containerdisk", "image"),
+		))
+		vm.Spec.StorageMigration = migration
+
+		resp := admitVm(vmsAdmitter, vm)
+		if expectedField == "" {
+			Expect(resp.Allowed).To(BeTrue())
+			return
+		}
+		Expect(resp.Allowed).To(BeFalse())
+		Expect(resp.Result.Details.Causes).To(HaveLen(1))
+		Expect(resp.Result.Details.Causes[0].Field).To(Equal(expectedField))
+	},
+		Entry("accept all the volumes", &v1.VirtualMachineStorageMigration{StorageClassName: "new"}, ""),
+		Entry("accept a selected claim", &v1.VirtualMachineStorageMigration{
+			StorageClassName: "new",
+			Volumes:          []string{"disk"},
+			Method:           pointer.P(v1.StorageMigrationMethodCDI),
+		}, ""),
+		Entry("reject a missing StorageClass", &v1.VirtualMachineStorageMigration{}, "spec.storageMigration.storageClassName"),
+		Entry("reject an unknown method", &v1.VirtualMachineStorageMigration{
+			StorageClassName: "new",
+			Method:           pointer.P(v1.VirtualMachineStorageMigrationMethod("rsync")),
+		}, "spec.storageMigration.method"),
+		Entry("reject an unknown volume", &v1.VirtualMachineStorageMigration{
+			StorageClassName: "new",
+			Volumes:          []string{"disk", "missing"},
+		}, "spec.storageMigration.volumes[1]"),
+		Entry("reject a volume without a claim", &v1.VirtualMachineStorageMigration{
+			StorageClassName: "new",
+			Volumes:          []string{"containerdisk"},
+		}, "spec.storageMigration.volumes[0]"),
+	)
+
+	DescribeTable("should validate the volume auto-expansion", func(featureGates []string, expansion *v1.VolumeAutoExpansion, expectedField string) {
+		disableFeatureGates()
+		enableFeatureGate(featureGates...)
+		vm := libvmi.NewVirtualMachine(libvmi.New(
+			libvmi.WithPersistentVolumeClaim("disk", "disk0", libvmi.WithDiskSerial("disk-serial")),
+			libvmi.WithPersistentVolumeClaim("noserial", "disk1"),
+			libvmi.WithContainerDisk("containerdisk", "image"),
+		))
+		vm.Spec.VolumeAutoExpansion = expansion
+
+		resp := admitVm(vmsAdmitter, vm)
+		if expectedField == "" {
+			Expect(resp.Allowed).To(BeTrue())
+			return
+		}
+		Expect(resp.Allowed).To(BeFalse())
+		Expect(resp.Result.Details.Causes).To(HaveLen(1))
+		Expect(resp.Result.Details.Causes[0].Field).To(Equal(expectedField))
+	},
+		Entry("accept a volume with a serial", []string{featuregate.VolumeAutoExpansionGate}, &v1.VolumeAutoExpansion{
+			Volumes:                  []string{"disk"},
+			UsageThresholdPercentage: 80,
+			IncrementPercentage:      pointer.P(int32(10)),
+		}, ""),
+		Entry("reject it without the feature gate", nil, &v1.VolumeAutoExpansion{
+			Volumes:                  []string{"disk"},
+			UsageThresholdPercentage: 80,
+		}, "spec.volumeAutoExpansion"),
+		Entry("reject an invalid threshold", []string{featuregate.VolumeAutoExpansionGate}, &v1.VolumeAutoExpansion{
+			Volumes:                  []string{"disk"},
+			UsageThresholdPercentage: 100,
+		}, "spec.volumeAutoExpansion.usageThresholdPercentage"),
+		Entry("reject an invalid increment", []string{featuregate.VolumeAutoExpansionGate}, &v1.VolumeAutoExpansion{
+			Volumes:                  []string{"disk"},
+			UsageThresholdPercentage: 80,
+			IncrementPercentage:      pointer.P(int32(0)),
+		}, "spec.volumeAutoExpansion.incrementPercentage"),
+		Entry("reject missing volumes", []string{featuregate.VolumeAutoExpansionGate}, &v1.VolumeAutoExpansion{
+			Volumes:                  []string{},
+			UsageThresholdPercentage: 80,
+		}, "spec.volumeAutoExpansion.volumes"),
+		Entry("reject an unknown volume", []string{featuregate.VolumeAutoExpansionGate}, &v1.VolumeAutoExpansion{
+			Volumes:                  []string{"disk", "missing"},
+			UsageThresholdPercentage: 80,
+		}, "spec.volumeAutoExpansion.volumes[1]"),
+		Entry("reject a volume without a claim", []string{featuregate.VolumeAutoExpansionGate}, &v1.VolumeAutoExpansion{
+			Volumes:                  []string{"containerdisk"},
+			UsageThresholdPercentage: 80,
+		}, "spec.volumeAutoExpansion.volumes[0]"),
+		Entry("reject a volume without a serial", []string{featuregate.VolumeAutoExpansionGate}, &v1.VolumeAutoExpansion{
+			Volumes:                  []string{"noserial"},
+			UsageThresholdPercentage: 80,
+		}, "spec.volumeAutoExpansion.volumes[0]"),
+	)
+
+	Context("with the MAC address pool", func() {
+		const usedMacAddress = "02:00:00:00:00:01"
+
+		newVMWithMacAddress := func(macAddress string) *v1.VirtualMachine {
+			secondaryIface := libvmi.InterfaceDeviceWithBridgeBinding("blue")
+			secondaryIface.MacAddress = macAddress
+			return libvmi.NewVirtualMachine(libvmi.New(
+				libvmi.WithInterface(*v1.DefaultBridgeNetworkInterface()),
+				libvmi.WithNetwork(v1.DefaultPodNetwork()),
+				libvmi.WithInterface(secondaryIface),
+				libvmi.WithNetwork(libvmi.MultusNetwork("blue", "blue-nad")),
+			))
+		}
+
+		BeforeEach(func() {
+			enableFeatureGate(featuregate.MacAddressPoolGate)
+			vmsAdmitter.MacAddressUsageFinder = &fakeMacAddressUsageFinder{
+				users: map[string]string{usedMacAddress: "default/other-vm"},
+			}
+		})
+
+		AfterEach(func() {
+			disableFeatureGates()
+		})
+
+		It("should reject a MAC address used by another VM", func() {
+			vm := newVMWithMacAddress(usedMacAddress)
+
+			resp := admitVm(vmsAdmitter, vm)
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(resp.Result.Details.Causes).To(HaveLen(1))
+			Expect(resp.Result.Details.Causes[0].Type).To(Equal(metav1.CauseTypeFieldValueDuplicate))
+			Expect(resp.Result.Details.Causes[0].Field).To(Equal("spec.template.spec.domain.devices.interfaces[1].macAddress"))
+		})
+
+		It("should accept a MAC address not used by another VM", func() {
+			vm := newVMWithMacAddress("02:00:00:00:00:02")
+
+			Expect(admitVm(vmsAdmitter, vm).Allowed).To(BeTrue())
+		})
+
+		It("should accept an update keeping a conflicting MAC address", func() {
+			oldVM := newVMWithMacAddress(usedMacAddress)
+			vm := oldVM.DeepCopy()
+			vm.Labels = map[string]string{"updated": "true"}
+
+			Expect(admitVmUpdate(vmsAdmitter, oldVM, vm).Allowed).To(BeTrue())
+		})
+
+		It("should accept a MAC address used by another VM without the feature gate", func() {
+			disableFeatureGates()
+			vm := newVMWithMacAddress(usedMacAddress)
+
+			Expect(admitVm(vmsAdmitter, vm).Allowed).To(BeTrue())
+		})
+	})
+
 	It("should allow VM that is being deleted", func() {
 		vmi := api.NewMinimalVMI("testvmi")
 		now := metav1.Now()
@@ -532,12 +682,12 @@ var _ = Describe("Validating VM Admitter", func() {
 			},
 		},
 			false),
-		Entry("with invalid request to add volume with invalid disk type", []v1.VirtualMachineVolumeRequest{
+		Entry("with valid request to add volume to a CD-ROM", []v1.VirtualMachineVolumeRequest{
 			{
 				AddVolumeOptions: &v1.AddVolumeOptions{
-					Name: "testCDRom",
+					Name: "testcdrom",
 					Disk: &v1.Disk{
-						Name: "testCDRom",
+						Name: "testcdrom",
 						DiskDevice: v1.DiskDevice{
 							CDRom: &v1.CDRomTarget{
 								Bus: "scsi",
@@ -551,6 +701,68 @@ var _ = Describe("Validating VM Admitter", func() {
 					},
 				},
 			},
+		},
+			true),
+		Entry("with invalid request to add volume to a CD-ROM with invalid bus", []v1.VirtualMachineVolumeRequest{
+			{
+				AddVolumeOptions: &v1.AddVolumeOptions{
+					Name: "testcdrom",
+					Disk: &v1.Disk{
+						Name: "testcdrom",
+						DiskDevice: v1.DiskDevice{
+							CDRom: &v1.CDRomTarget{
+								Bus: "sata",
+							},
+						},
+					},
+					VolumeSource: &v1.HotplugVolumeSource{
+						PersistentVolumeClaim: &v1.PersistentVolumeClaimVolumeSource{PersistentVolumeClaimVolumeSource: k8sv1.PersistentVolumeClaimVolumeSource{
+							ClaimName: "cdRomtest",
+						}},
+					},
+				},
+			},
+		},
+			false),
+		Entry("with invalid request to add volume to a CD-ROM with a boot order used by another disk", []v1.VirtualMachineVolumeRequest{
+			{
+				AddVolumeOptions: &v1.AddVolumeOptions{
+					Name: "testcdrom",
+					Disk: &v1.Disk{
+						Name: "testcdrom",
+						DiskDevice: v1.DiskDevice{
+							CDRom: &v1.CDRomTarget{
+								Bus: "scsi",
+							},
+						},
+						BootOrder: pointer.P(uint(1)),
+					},
+					VolumeSource: &v1.HotplugVolumeSource{
+						PersistentVolumeClaim: &v1.PersistentVolumeClaimVolumeSource{PersistentVolumeClaimVolumeSource: k8sv1.PersistentVolumeClaimVolumeSource{
+							ClaimName: "cdRomtest",
+						}},
+					},
+				},
+			},
+			{
+				AddVolumeOptions: &v1.AddVolumeOptions{
+					Name: "testdisk2",
+					Disk: &v1.Disk{
+						Name: "testdisk2",
+						DiskDevice: v1.DiskDevice{
+							Disk: &v1.DiskTarget{
+								Bus: "scsi",
+							},
+						},
+						BootOrder: pointer.P(uint(1)),
+					},
+					VolumeSource: &v1.HotplugVolumeSource{
+						PersistentVolumeClaim: &v1.PersistentVolumeClaimVolumeSource{PersistentVolumeClaimVolumeSource: k8sv1.PersistentVolumeClaimVolumeSource{
+							ClaimName: "madeup",
+						}},
+					},
+				},
+			},
 		},
 			false),
 		Entry("with invalid request to add volume with dedicated IOThreads", []v1.VirtualMachineVolumeRequest{
@@ -1314,7 +1526,7 @@ var _ = Describe("Validating VM Admitter", func() {
 			_, err = virtClient.VirtualMachinePreference(vm.Namespace).Create(context.Background(), testPreference, metav1.CreateOptions{})
 			Expect(err).ToNot(HaveOccurred())
 
-			vmsAdmitter.InstancetypeAdmitter = instancetypeWebhooks.NewAdmitter(virtClient)
+			vmsAdmitter.InstancetypeAdmitter = instancetypeWebhooks.NewAdmitter(virtClient, nil)
 		})
 
 		It("should reject if instancetype is not found", func() {
@@ -1832,6 +2044,156 @@ var _ = Describe("Validating VM Admitter", func() {
 
 	})
 
+	Context("with an instance type needing resources no node provides", func() {
+		var checker *fakeNodeCompatibilityChecker
+
+		setNodeCompatibilityPolicy := func(policy v1.InstancetypeNodeCompatibilityPolicy) {
+			kv := testutils.GetFakeKubeVirtClusterConfig(kvStore)
+			kv.Spec.Configuration.Instancetype = &v1.InstancetypeConfiguration{NodeCompatibilityPolicy: &policy}
+			testutils.UpdateFakeKubeVirtClusterConfig(kvStore, kv)
+			DeferCleanup(func() {
+				kv := testutils.GetFakeKubeVirtClusterConfig(kvStore)
+				kv.Spec.Configuration.Instancetype = nil
+				testutils.UpdateFakeKubeVirtClusterConfig(kvStore, kv)
+			})
+		}
+
+		newInstancetypeVM := func() *v1.VirtualMachine {
+			vm := libvmi.NewVirtualMachine(libvmi.New(), libvmi.WithInstancetype("hugepages"))
+			vm.Spec.Template.Spec.Domain.Resources = v1.ResourceRequirements{}
+			return vm
+		}
+
+		BeforeEach(func() {
+			checker = &fakeNodeCompatibilityChecker{err: fmt.Errorf("no schedulable node provides hugepages-1Gi=4Gi")}
+			vmsAdmitter.NodeCompatibilityChecker = checker
+			instancetypeAdmitter := instancetypeWebhooks.NewMockAdmitter()
+			instancetypeAdmitter.ApplyToVMFunc = func(*v1.VirtualMachine) (
+				*instancetypev1beta1.VirtualMachineInstancetypeSpec,
+				*instancetypev1beta1.VirtualMachinePreferenceSpec,
+				[]metav1.StatusCause,
+			) {
+				return &instancetypev1beta1.VirtualMachineInstancetypeSpec{}, nil, nil
+			}
+			vmsAdmitter.InstancetypeAdmitter = instancetypeAdmitter
+		})
+
+		It("should not look at the nodes by default", func() {
+			resp := admitVm(vmsAdmitter, newInstancetypeVM())
+			Expect(resp.Allowed).To(BeTrue())
+			Expect(resp.Warnings).ToNot(ContainElement(ContainSubstring("instance type")))
+			Expect(checker.calls).To(BeZero())
+		})
+
+		It("should warn with the warn policy", func() {
+			setNodeCompatibilityPolicy(v1.NodeCompatibilityWarn)
+
+			resp := admitVm(vmsAdmitter, newInstancetypeVM())
+			Expect(resp.Allowed).To(BeTrue())
+			Expect(resp.Warnings).To(ContainElement(
+				"the VM cannot be scheduled with instance type hugepages: no schedulable node provides hugepages-1Gi=4Gi"))
+		})
+
+		It("should reject with the reject policy", func() {
+			setNodeCompatibilityPolicy(v1.NodeCompatibilityReject)
+
+			resp := admitVm(vmsAdmitter, newInstancetypeVM())
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(resp.Result.Details.Causes).To(ConsistOf(metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Field:   "spec.instancetype",
+				Message: "the VM cannot be scheduled with instance type hugepages: no schedulable node provides hugepages-1Gi=4Gi",
+			}))
+		})
+
+		It("should admit a VM providing its resources with the reject policy", func() {
+			setNodeCompatibilityPolicy(v1.NodeCompatibilityReject)
+			checker.err = nil
+
+			resp := admitVm(vmsAdmitter, newInstancetypeVM())
+			Expect(resp.Allowed).To(BeTrue())
+			Expect(checker.calls).To(Equal(1))
+		})
+
+		It("should not look at the nodes on updates keeping the instance type", func() {
+			setNodeCompatibilityPolicy(v1.NodeCompatibilityReject)
+
+			oldVM := newInstancetypeVM()
+			vm := oldVM.DeepCopy()
+			vm.Spec.Instancetype.RevisionName = "revision"
+
+			resp := admitVmUpdate(vmsAdmitter, oldVM, vm)
+			Expect(resp.Allowed).To(BeTrue())
+			Expect(checker.calls).To(BeZero())
+		})
+
+		It("should look at the nodes on updates changing the instance type", func() {
+			setNodeCompatibilityPolicy(v1.NodeCompatibilityReject)
+
+			oldVM := newInstancetypeVM()
+			oldVM.Spec.Instancetype.Name = "small"
+
+			resp := admitVmUpdate(vmsAdmitter, oldVM, newInstancetypeVM())
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(checker.calls).To(Equal(1))
+		})
+	})
+
+	Context("with instance type namespace policies", func() {
+		BeforeEach(func() {
+			vmsAdmitter.NamespacePolicyChecker = instancetypeNamespacePolicy.New(namespaceInformer.GetStore())
+
+			kv := testutils.GetFakeKubeVirtClusterConfig(kvStore)
+			kv.Spec.Configuration.Instancetype = &v1.InstancetypeConfiguration{
+				NamespacePolicies: []v1.InstancetypeNamespacePolicy{{
+					ClusterInstancetypes: []string{"small"},
+					ClusterPreferences:   []string{"linux"},
+				}},
+			}
+			testutils.UpdateFakeKubeVirtClusterConfig(kvStore, kv)
+			DeferCleanup(func() {
+				kv := testutils.GetFakeKubeVirtClusterConfig(kvStore)
+				kv.Spec.Configuration.Instancetype = nil
+				testutils.UpdateFakeKubeVirtClusterConfig(kvStore, kv)
+			})
+		})
+
+		newVM := func(opts ...libvmi.VMOption) *v1.VirtualMachine {
+			vm := libvmi.NewVirtualMachine(libvmi.New(), opts...)
+			vm.Spec.Template.Spec.Domain.Resources = v1.ResourceRequirements{}
+			return vm
+		}
+
+		It("should admit a VM referencing an allowed cluster instance type and preference", func() {
+			resp := admitVm(vmsAdmitter, newVM(libvmi.WithClusterInstancetype("small"), libvmi.WithClusterPreference("linux")))
+			Expect(resp.Allowed).To(BeTrue())
+		})
+
+		It("should reject a VM referencing cluster instance types and preferences not allowed in its namespace", func() {
+			resp := admitVm(vmsAdmitter, newVM(libvmi.WithClusterInstancetype("large"), libvmi.WithClusterPreference("windows")))
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(resp.Result.Details.Causes).To(HaveLen(2))
+			Expect(resp.Result.Details.Causes[0].Field).To(Equal("spec.instancetype.name"))
+			Expect(resp.Result.Details.Causes[0].Message).To(HavePrefix("cluster instance type large is not allowed in namespace"))
+			Expect(resp.Result.Details.Causes[1].Field).To(Equal("spec.preference.name"))
+			Expect(resp.Result.Details.Causes[1].Message).To(HavePrefix("cluster preference windows is not allowed in namespace"))
+		})
+
+		It("should admit a VM referencing a namespaced instance type", func() {
+			resp := admitVm(vmsAdmitter, newVM(libvmi.WithInstancetype("large")))
+			Expect(resp.Allowed).To(BeTrue())
+		})
+
+		It("should admit updates keeping a cluster instance type which is not allowed anymore", func() {
+			oldVM := newVM(libvmi.WithClusterInstancetype("large"))
+			vm := oldVM.DeepCopy()
+			vm.Spec.Instancetype.RevisionName = "revision"
+
+			resp := admitVmUpdate(vmsAdmitter, oldVM, vm)
+			Expect(resp.Allowed).To(BeTrue())
+		})
+	})
+
 	It("should raise a warning when Deprecated API is used", func() {
 		const testsFGName = "test-deprecated"
 		featuregate.RegisterFeatureGate(featuregate.FeatureGate{
@@ -1907,3 +2269,41 @@ func admitVm(admitter *VMsAdmitter, vm *v1.VirtualMachine) *admissionv1.Admissio
 
 	return admitter.Admit(context.Background(), ar)
 }
+
+func admitVmUpdate(admitter *VMsAdmitter, oldVM, vm *v1.VirtualMachine) *admissionv1.AdmissionResponse {
+	oldVMBytes, _ := json.Marshal(oldVM)
+	vmBytes, _ := json.Marshal(vm)
+
+	ar := &admissionv1.AdmissionReview{
+		Request: &admissionv1.AdmissionRequest{
+			Resource: webhooks.VirtualMachineGroupVersionResource,
+			OldObject: runtime.RawExtension{
+				Raw: oldVMBytes,
+			},
+			Object: runtime.RawExtension{
+				Raw: vmBytes,
+			},
+			Operation: admissionv1.Update,
+		},
+	}
+
+	return admitter.Admit(context.Background(), ar)
+}
+
+type fakeNodeCompatibilityChecker struct {
+	err   error
+	calls int
+}
+
+func (c *fakeNodeCompatibilityChecker) Check(*instancetypev1beta1.VirtualMachineInstancetypeSpec, *v1.VirtualMachineInstanceSpec) error {
+	c.calls++
+	return c.err
+}
+
+type fakeMacAddressUsageFinder struct {
+	users map[string]string
+}
+
+func (f *fakeMacAddressUsageFinder) FindOtherVMWithMacAddress(_ *v1.VirtualMachine, macAddress string) (string, error) {
+	return f.users[macAddress], nil
+}
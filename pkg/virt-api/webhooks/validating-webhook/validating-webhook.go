@@ -84,6 +84,10 @@ func ServeVMSnapshots(resp http.ResponseWriter, req *http.Request, clusterConfig
 	validating_webhooks.Serve(resp, req, storageAdmitters.NewVMSnapshotAdmitter(clusterConfig, virtCli))
 }
 
+func ServeVMSnapshotSchedules(resp http.ResponseWriter, req *http.Request, clusterConfig *virtconfig.ClusterConfig) {
+	validating_webhooks.Serve(resp, req, storageAdmitters.NewVMSnapshotScheduleAdmitter(clusterConfig))
+}
+
 func ServeVMRestores(resp http.ResponseWriter, req *http.Request, clusterConfig *virtconfig.ClusterConfig, virtCli kubecli.KubevirtClient, informers *webhooks.Informers) {
 	validating_webhooks.Serve(resp, req, storageAdmitters.NewVMRestoreAdmitter(clusterConfig, virtCli, informers.VMRestoreInformer))
 }
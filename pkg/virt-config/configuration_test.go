@@ -665,6 +665,84 @@ var _ = Describe("test configuration", func() {
 			[]string{"nvidia-223", "nvidia-229"}),
 	)
 
+	It("should return the mdev type counts of the node configurations matching the node", func() {
+		node := &kubev1.Node{ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"testLabel1": "true"}}}
+		clusterConfig, _, _ := testutils.NewFakeClusterConfigUsingKV(&v1.KubeVirt{
+			ObjectMeta: metav1.ObjectMeta{
+				ResourceVersion: rand.String(10),
+				Name:            "kubevirt",
+				Namespace:       "kubevirt",
+			},
+			Spec: v1.KubeVirtSpec{Configuration: v1.KubeVirtConfiguration{
+				MediatedDevicesConfiguration: &v1.MediatedDevicesConfiguration{
+					NodeMediatedDeviceTypes: []v1.NodeMediatedDeviceTypesConfig{
+						{
+							NodeSelector:         map[string]string{"testLabel1": "true"},
+							MediatedDeviceTypes:  []string{"nvidia-223", "nvidia-229"},
+							MediatedDeviceCounts: []v1.MediatedDeviceTypeCount{{Type: "nvidia-223", Count: 4}},
+						},
+						{
+							NodeSelector:         map[string]string{"testLabel2": "true"},
+							MediatedDeviceTypes:  []string{"nvidia-229"},
+							MediatedDeviceCounts: []v1.MediatedDeviceTypeCount{{Type: "nvidia-229", Count: 1}},
+						},
+					},
+				},
+			}},
+			Status: v1.KubeVirtStatus{
+				Phase: v1.KubeVirtPhaseDeploying,
+			},
+		})
+		Expect(clusterConfig.GetDesiredMDEVTypeCounts(node)).To(Equal(map[string]int{"nvidia-223": 4}))
+	})
+
+	It("should return the first dedicated CPU pool selecting the node", func() {
+		node := &kubev1.Node{ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"testLabel1": "true"}}}
+		clusterConfig, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{
+			DedicatedCPUPools: []v1.DedicatedCPUPool{
+				{Name: "other", NodeSelector: map[string]string{"testLabel2": "true"}, CPUs: "2-3"},
+				{Name: "vms", NodeSelector: map[string]string{"testLabel1": "true"}, CPUs: "4-7"},
+				{Name: "all", CPUs: "8-15"},
+			},
+		})
+		Expect(clusterConfig.GetDedicatedCPUPool(node)).To(Equal(&v1.DedicatedCPUPool{
+			Name: "vms", NodeSelector: map[string]string{"testLabel1": "true"}, CPUs: "4-7",
+		}))
+		Expect(clusterConfig.GetDedicatedCPUPool(&kubev1.Node{})).To(HaveField("Name", "all"))
+	})
+
+	It("should return the swap configuration only for the nodes it selects", func() {
+		node := &kubev1.Node{ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"testLabel1": "true"}}}
+		clusterConfig, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{
+			SwapConfiguration: &v1.SwapConfiguration{
+				NodeSelector:            map[string]string{"testLabel1": "true"},
+				EvictionSwapUsedPercent: pointer.P(int32(80)),
+			},
+		})
+		Expect(clusterConfig.GetSwapConfiguration(node)).To(HaveField("EvictionSwapUsedPercent", HaveValue(BeEquivalentTo(80))))
+		Expect(clusterConfig.GetSwapConfiguration(&kubev1.Node{})).To(BeNil())
+	})
+
+	It("should return the first hugepages pool of each page size matching the node", func() {
+		node := &kubev1.Node{ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"testLabel1": "true"}}}
+		clusterConfig, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{
+			HugepagesPools: []v1.HugepagesPool{
+				{Name: "other", NodeSelector: map[string]string{"testLabel2": "true"}, PageSize: "2Mi", Count: 64},
+				{Name: "vms", NodeSelector: map[string]string{"testLabel1": "true"}, PageSize: "2Mi", Count: 1024},
+				{Name: "all-2m", PageSize: "2048Ki", Count: 128},
+				{Name: "all-1g", PageSize: "1Gi", Count: 4},
+			},
+		})
+		Expect(clusterConfig.GetHugepagesPools(node)).To(ConsistOf(
+			HaveField("Name", "vms"),
+			HaveField("Name", "all-1g"),
+		))
+		Expect(clusterConfig.GetHugepagesPools(&kubev1.Node{})).To(ConsistOf(
+			HaveField("Name", "all-2m"),
+			HaveField("Name", "all-1g"),
+		))
+	})
+
 	DescribeTable("when kubevirt CR holds config", func(value v1.KubeVirtConfiguration, getPart func(*v1.KubeVirtConfiguration) interface{}, result string) {
 		clusterConfig, _, _ := testutils.NewFakeClusterConfigUsingKV(&v1.KubeVirt{
 			ObjectMeta: metav1.ObjectMeta{
@@ -805,4 +883,35 @@ var _ = Describe("test configuration", func() {
 		Entry("reference when FG set andInstancetypeConfiguration.ReferencePolicy is reference", &v1.InstancetypeConfiguration{ReferencePolicy: pointer.P(v1.Reference)}, enableInstancetypeReferencePolicyFG, v1.Reference),
 		Entry("expand when FG set andInstancetypeConfiguration.ReferencePolicy is expand", &v1.InstancetypeConfiguration{ReferencePolicy: pointer.P(v1.Expand)}, enableInstancetypeReferencePolicyFG, v1.Expand),
 	)
+
+	DescribeTable("GetInstancetypeRevisionUpgradePolicy should return", func(
+		instancetypeConfig *v1.InstancetypeConfiguration, expectedPolicy v1.InstancetypeRevisionUpgradePolicy) {
+		clusterConfig, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(
+			&v1.KubeVirtConfiguration{
+				Instancetype: instancetypeConfig,
+			},
+		)
+		Expect(clusterConfig.GetInstancetypeRevisionUpgradePolicy()).To(Equal(expectedPolicy))
+	},
+		Entry("manual when InstancetypeConfiguration is nil", nil, v1.RevisionUpgradeManual),
+		Entry("manual when InstancetypeConfiguration.RevisionUpgradePolicy is nil", &v1.InstancetypeConfiguration{}, v1.RevisionUpgradeManual),
+		Entry("onRestart when InstancetypeConfiguration.RevisionUpgradePolicy is onRestart",
+			&v1.InstancetypeConfiguration{RevisionUpgradePolicy: pointer.P(v1.RevisionUpgradeOnRestart)}, v1.RevisionUpgradeOnRestart),
+	)
+
+	DescribeTable("GetInstancetypeNodeCompatibilityPolicy should return", func(
+		instancetypeConfig *v1.InstancetypeConfiguration, expectedPolicy v1.InstancetypeNodeCompatibilityPolicy) {
+		clusterConfig, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(
+			&v1.KubeVirtConfiguration{
+				Instancetype: instancetypeConfig,
+			},
+		)
+		Expect(clusterConfig.GetInstancetypeNodeCompatibilityPolicy()).To(Equal(expectedPolicy))
+	},
+		Entry("ignore when InstancetypeConfiguration is nil", nil, v1.NodeCompatibilityIgnore),
+		Entry("ignore when InstancetypeConfiguration.NodeCompatibilityPolicy is nil",
+			&v1.InstancetypeConfiguration{}, v1.NodeCompatibilityIgnore),
+		Entry("reject when InstancetypeConfiguration.NodeCompatibilityPolicy is reject",
+			&v1.InstancetypeConfiguration{NodeCompatibilityPolicy: pointer.P(v1.NodeCompatibilityReject)}, v1.NodeCompatibilityReject),
+	)
 })
@@ -176,3 +176,51 @@ func (config *ClusterConfig) AlignCPUsEnabled() bool {
 func (config *ClusterConfig) NodeRestrictionEnabled() bool {
 	return config.isFeatureGateEnabled(featuregate.NodeRestrictionGate)
 }
+
+func (config *ClusterConfig) ImagePrefetchEnabled() bool {
+	return config.isFeatureGateEnabled(featuregate.ImagePrefetchGate)
+}
+
+func (config *ClusterConfig) MemoryOverheadCalibrationEnabled() bool {
+	return config.isFeatureGateEnabled(featuregate.MemoryOverheadCalibrationGate)
+}
+
+func (config *ClusterConfig) HousekeepingCPUEnabled() bool {
+	return config.isFeatureGateEnabled(featuregate.HousekeepingCPUGate)
+}
+
+func (config *ClusterConfig) ThreadPlacementEnabled() bool {
+	return config.isFeatureGateEnabled(featuregate.ThreadPlacementGate)
+}
+
+func (config *ClusterConfig) SchedulerExtenderEnabled() bool {
+	return config.isFeatureGateEnabled(featuregate.SchedulerExtenderGate)
+}
+
+func (config *ClusterConfig) AdmissionAdvisoriesEnabled() bool {
+	return config.isFeatureGateEnabled(featuregate.AdmissionAdvisoriesGate)
+}
+
+func (config *ClusterConfig) GuestMetricsEndpointsEnabled() bool {
+	return config.isFeatureGateEnabled(featuregate.GuestMetricsEndpointsGate)
+}
+
+func (config *ClusterConfig) VolumeAutoExpansionEnabled() bool {
+	return config.isFeatureGateEnabled(featuregate.VolumeAutoExpansionGate)
+}
+
+func (config *ClusterConfig) MacAddressPoolEnabled() bool {
+	return config.isFeatureGateEnabled(featuregate.MacAddressPoolGate)
+}
+
+func (config *ClusterConfig) VMFirewallEnabled() bool {
+	return config.isFeatureGateEnabled(featuregate.VMFirewallGate)
+}
+
+func (config *ClusterConfig) USBPassthroughEnabled() bool {
+	return config.isFeatureGateEnabled(featuregate.USBPassthroughGate)
+}
+
+func (config *ClusterConfig) BlockLatencyHistogramsEnabled() bool {
+	return config.isFeatureGateEnabled(featuregate.BlockLatencyHistogramsGate)
+}
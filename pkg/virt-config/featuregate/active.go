@@ -67,6 +67,82 @@ const (
 
 	VirtIOFSConfigVolumesGate = "EnableVirtioFsConfigVolumes"
 	VirtIOFSStorageVolumeGate = "EnableVirtioFsStorageVolumes"
+
+	// Alpha: v1.6.0
+	//
+	// ImagePrefetchGate enables the ImagePrefetch API, which stages containerDisk images
+	// and DataSources on nodes ahead of a planned mass start of VMs.
+	ImagePrefetchGate = "ImagePrefetch"
+
+	// Alpha: v1.6.0
+	//
+	// MemoryOverheadCalibrationGate raises the memory overhead of new virt-launcher pods to the
+	// highest ratio between measured and estimated overhead which virt-handler reported on the nodes.
+	MemoryOverheadCalibrationGate = "MemoryOverheadCalibration"
+
+	// Alpha: v1.6.0
+	//
+	// HousekeepingCPUGate allows VMIs to give the QEMU threads which do not run vCPUs their own CPU budget
+	// in a child cgroup of the compute container.
+	HousekeepingCPUGate = "HousekeepingCPU"
+
+	// Alpha: v1.6.0
+	//
+	// ThreadPlacementGate allows VMIs with dedicated CPUs to choose where their emulator thread and IOThreads run.
+	ThreadPlacementGate = "ThreadPlacement"
+
+	// Alpha: v1.6.0
+	//
+	// SchedulerExtenderGate enables the scheduler extender served by virt-controller, which scores nodes for
+	// virt-launcher pods by hugepage availability, NUMA fit, SR-IOV VF availability and VM anti-affinity.
+	SchedulerExtenderGate = "SchedulerExtender"
+
+	// Alpha: v1.6.0
+	//
+	// AdmissionAdvisoriesGate makes virt-api return admission warnings for discouraged, but valid, VM configurations.
+	AdmissionAdvisoriesGate = "AdmissionAdvisories"
+
+	// Alpha: v1.6.0
+	//
+	// GuestMetricsEndpointsGate makes virt-controller maintain a headless Service and EndpointSlice for VMs annotated
+	// with the port of a metrics exporter in the guest, so that Prometheus can scrape it.
+	GuestMetricsEndpointsGate = "GuestMetricsEndpoints"
+
+	// Alpha: v1.6.0
+	//
+	// VolumeAutoExpansionGate makes virt-handler report the usage of the guest filesystems on the volumes and
+	// virt-controller expand the PVCs of the VMs with a volume auto-expansion when the filesystems are filling up.
+	VolumeAutoExpansionGate = "VolumeAutoExpansion"
+
+	// Alpha: v1.6.0
+	//
+	// MacAddressPoolGate makes virt-api allocate the MAC addresses of VirtualMachine interfaces from the ranges
+	// of the MAC address pool configured in the KubeVirt CR, and reject MAC addresses used by other VirtualMachines.
+	MacAddressPoolGate = "MacAddressPool"
+
+	// Alpha: v1.6.0
+	//
+	// VMFirewallGate makes virt-controller translate the firewall annotation of VMIs into a NetworkPolicy
+	// selecting their virt-launcher pod.
+	VMFirewallGate = "VMFirewall"
+
+	// Alpha: v1.6.0
+	//
+	// USBPassthroughGate allows VMIs to request USB devices plugged into their node by vendor:product or bus-port,
+	// which virt-handler allocates among the VMIs of the node and attaches as they get plugged.
+	USBPassthroughGate = "USBPassthrough"
+
+	// Alpha: v1.6.0
+	//
+	// BlockLatencyHistogramsGate makes virt-launcher build the read, write and flush latency histograms of the
+	// disks from the block stats of libvirt, which virt-handler then exports as metrics.
+	BlockLatencyHistogramsGate = "BlockLatencyHistograms"
+
+	// Alpha: v1.6.0
+	//
+	// EmergencyAPIGate makes virt-operator deploy virt-handler with the local emergency API to pause, unpause or
+	// force-stop the VMIs of the node without the cluster API.
+	EmergencyAPIGate = "EmergencyAPI"
 )
 
 func init() {
@@ -94,4 +170,17 @@ func init() {
 	RegisterFeatureGate(FeatureGate{Name: InstancetypeReferencePolicy, State: Beta})
 	RegisterFeatureGate(FeatureGate{Name: VirtIOFSConfigVolumesGate, State: Alpha})
 	RegisterFeatureGate(FeatureGate{Name: VirtIOFSStorageVolumeGate, State: Alpha})
+	RegisterFeatureGate(FeatureGate{Name: ImagePrefetchGate, State: Alpha})
+	RegisterFeatureGate(FeatureGate{Name: MemoryOverheadCalibrationGate, State: Alpha})
+	RegisterFeatureGate(FeatureGate{Name: HousekeepingCPUGate, State: Alpha})
+	RegisterFeatureGate(FeatureGate{Name: ThreadPlacementGate, State: Alpha})
+	RegisterFeatureGate(FeatureGate{Name: SchedulerExtenderGate, State: Alpha})
+	RegisterFeatureGate(FeatureGate{Name: AdmissionAdvisoriesGate, State: Alpha})
+	RegisterFeatureGate(FeatureGate{Name: GuestMetricsEndpointsGate, State: Alpha})
+	RegisterFeatureGate(FeatureGate{Name: VolumeAutoExpansionGate, State: Alpha})
+	RegisterFeatureGate(FeatureGate{Name: MacAddressPoolGate, State: Alpha})
+	RegisterFeatureGate(FeatureGate{Name: VMFirewallGate, State: Alpha})
+	RegisterFeatureGate(FeatureGate{Name: USBPassthroughGate, State: Alpha})
+	RegisterFeatureGate(FeatureGate{Name: BlockLatencyHistogramsGate, State: Alpha})
+	RegisterFeatureGate(FeatureGate{Name: EmergencyAPIGate, State: Alpha})
 }
@@ -339,6 +339,26 @@ func (c *ClusterConfig) GetDesiredMDEVTypes(node *k8sv1.Node) []string {
 	return mdevTypesConf.MediatedDeviceTypes
 }
 
+// GetDesiredMDEVTypeCounts returns the number of instances to create for the mdev types which set one on the node
+func (c *ClusterConfig) GetDesiredMDEVTypeCounts(node *k8sv1.Node) map[string]int {
+	counts := map[string]int{}
+	mdevTypesConf := c.GetConfig().MediatedDevicesConfiguration
+	if mdevTypesConf == nil {
+		return counts
+	}
+	for _, nodeConfig := range mdevTypesConf.NodeMediatedDeviceTypes {
+		if !canSelectNode(nodeConfig.NodeSelector, node) {
+			continue
+		}
+		for _, typeCount := range nodeConfig.MediatedDeviceCounts {
+			if _, exists := counts[typeCount.Type]; !exists {
+				counts[typeCount.Type] = int(typeCount.Count)
+			}
+		}
+	}
+	return counts
+}
+
 type virtComponent int
 
 const (
@@ -438,6 +458,56 @@ func (c *ClusterConfig) GetKSMConfiguration() *v1.KSMConfiguration {
 	return c.GetConfig().KSMConfiguration
 }
 
+func (c *ClusterConfig) GetStartConfiguration() *v1.StartConfiguration {
+	return c.GetConfig().StartConfiguration
+}
+
+func (c *ClusterConfig) GetCanaryConfiguration() *v1.CanaryConfiguration {
+	return c.GetConfig().CanaryConfiguration
+}
+
+func (c *ClusterConfig) GetPlacementHintsConfiguration() *v1.PlacementHintsConfiguration {
+	return c.GetConfig().PlacementHints
+}
+
+func (c *ClusterConfig) GetCPUFairShareConfiguration() *v1.CPUFairShareConfiguration {
+	return c.GetConfig().CPUFairShare
+}
+
+// GetDedicatedCPUPool returns the first dedicated CPU pool whose node selector matches the node, if any
+func (c *ClusterConfig) GetDedicatedCPUPool(node *k8sv1.Node) *v1.DedicatedCPUPool {
+	for _, pool := range c.GetConfig().DedicatedCPUPools {
+		if canSelectNode(pool.NodeSelector, node) {
+			return pool.DeepCopy()
+		}
+	}
+	return nil
+}
+
+// GetSwapConfiguration returns the swap configuration if its node selector matches the node
+func (c *ClusterConfig) GetSwapConfiguration(node *k8sv1.Node) *v1.SwapConfiguration {
+	swapConfig := c.GetConfig().SwapConfiguration
+	if swapConfig == nil || !canSelectNode(swapConfig.NodeSelector, node) {
+		return nil
+	}
+	return swapConfig.DeepCopy()
+}
+
+// GetHugepagesPools returns, for each page size, the first hugepages pool of that size whose node selector matches the node
+func (c *ClusterConfig) GetHugepagesPools(node *k8sv1.Node) []v1.HugepagesPool {
+	var pools []v1.HugepagesPool
+	pageSizes := map[int64]bool{}
+	for _, pool := range c.GetConfig().HugepagesPools {
+		pageSize, err := resource.ParseQuantity(pool.PageSize)
+		if err != nil || pageSizes[pageSize.Value()] || !canSelectNode(pool.NodeSelector, node) {
+			continue
+		}
+		pageSizes[pageSize.Value()] = true
+		pools = append(pools, *pool.DeepCopy())
+	}
+	return pools
+}
+
 func (c *ClusterConfig) GetMaximumCpuSockets() (numOfSockets uint32) {
 	liveConfig := c.GetConfig().LiveUpdateConfiguration
 	if liveConfig != nil && liveConfig.MaxCpuSockets != nil {
@@ -477,6 +547,14 @@ func (c *ClusterConfig) GetNetworkBindings() map[string]v1.InterfaceBindingPlugi
 	return nil
 }
 
+func (c *ClusterConfig) GetMacAddressPool() *v1.MacAddressPoolConfiguration {
+	networkConfig := c.GetConfig().NetworkConfiguration
+	if networkConfig != nil {
+		return networkConfig.MacAddressPool
+	}
+	return nil
+}
+
 func (config *ClusterConfig) VGADisplayForEFIGuestsEnabled() bool {
 	VGADisplayForEFIGuestsAnnotationExists := false
 	kv := config.GetConfigFromKubeVirtCR()
@@ -496,6 +574,40 @@ func (c *ClusterConfig) GetInstancetypeReferencePolicy() v1.InstancetypeReferenc
 	return policy
 }
 
+func (c *ClusterConfig) GetInstancetypeRevisionUpgradePolicy() v1.InstancetypeRevisionUpgradePolicy {
+	// Default to the Manual InstancetypeRevisionUpgradePolicy
+	policy := v1.RevisionUpgradeManual
+	instancetypeConfig := c.GetConfig().Instancetype
+	if instancetypeConfig != nil && instancetypeConfig.RevisionUpgradePolicy != nil {
+		policy = *instancetypeConfig.RevisionUpgradePolicy
+	}
+	return policy
+}
+
+func (c *ClusterConfig) GetInstancetypeNodeCompatibilityPolicy() v1.InstancetypeNodeCompatibilityPolicy {
+	// Default to the Ignore InstancetypeNodeCompatibilityPolicy
+	policy := v1.NodeCompatibilityIgnore
+	instancetypeConfig := c.GetConfig().Instancetype
+	if instancetypeConfig != nil && instancetypeConfig.NodeCompatibilityPolicy != nil {
+		policy = *instancetypeConfig.NodeCompatibilityPolicy
+	}
+	return policy
+}
+
+func (c *ClusterConfig) GetInstancetypeNamespacePolicies() []v1.InstancetypeNamespacePolicy {
+	if instancetypeConfig := c.GetConfig().Instancetype; instancetypeConfig != nil {
+		return instancetypeConfig.NamespacePolicies
+	}
+	return nil
+}
+
+func (c *ClusterConfig) GetInstancetypeRightsizing() *v1.InstancetypeRightsizing {
+	if instancetypeConfig := c.GetConfig().Instancetype; instancetypeConfig != nil {
+		return instancetypeConfig.Rightsizing
+	}
+	return nil
+}
+
 func (c *ClusterConfig) ClusterProfilerEnabled() bool {
 	return c.GetConfig().DeveloperConfiguration.ClusterProfiler ||
 		c.isFeatureGateDefined(featuregate.ClusterProfiler)
@@ -23,6 +23,8 @@ type NodeSelectorRenderer struct {
 	realtimeEnabled  bool
 	sevEnabled       bool
 	sevESEnabled     bool
+
+	mitigatedCPUVulnerabilityLabels []string
 }
 
 type NodeSelectorRendererOption func(renderer *NodeSelectorRenderer)
@@ -79,6 +81,9 @@ func (nsr *NodeSelectorRenderer) Render() map[string]string {
 	if nsr.sevESEnabled {
 		nsr.enableSelectorLabel(v1.SEVESLabel)
 	}
+	for _, mitigatedCPUVulnerabilityLabel := range nsr.mitigatedCPUVulnerabilityLabels {
+		nsr.enableSelectorLabel(mitigatedCPUVulnerabilityLabel)
+	}
 
 	return nsr.podNodeSelectors
 }
@@ -134,6 +139,16 @@ func WithMachineType(machineType string) NodeSelectorRendererOption {
 	}
 }
 
+// WithMitigatedCPUVulnerabilities selects the nodes which are mitigated against, or not affected by,
+// the given CPU vulnerabilities
+func WithMitigatedCPUVulnerabilities(vulnerabilities ...string) NodeSelectorRendererOption {
+	return func(renderer *NodeSelectorRenderer) {
+		for _, vulnerability := range vulnerabilities {
+			renderer.mitigatedCPUVulnerabilityLabels = append(renderer.mitigatedCPUVulnerabilityLabels, v1.MitigatedCPUVulnerabilityLabel+vulnerability)
+		}
+	}
+}
+
 func WithTSCTimer(tscFrequency *int64) NodeSelectorRendererOption {
 	return func(renderer *NodeSelectorRenderer) {
 		renderer.tscFrequency = tscFrequency
@@ -145,6 +160,14 @@ func CPUModelLabelFromCPUModel(vmi *v1.VirtualMachineInstance) (label string, er
 		err = fmt.Errorf("Cannot create CPU Model label, vmi spec is mising CPU model")
 		return
 	}
+	if vmi.Spec.Domain.CPU.Model == v1.CPUModeClusterCommon {
+		if !topology.AreClusterCommonCPUTopologyHintsDefined(vmi) {
+			err = fmt.Errorf("Cannot create CPU Model label, the cluster-common CPU model is not resolved yet")
+			return
+		}
+		label = cpuModelLabel(vmi.Status.TopologyHints.CPUModel)
+		return
+	}
 	label = cpuModelLabel(vmi.Spec.Domain.CPU.Model)
 	return
 }
@@ -162,6 +185,11 @@ func CPUFeatureLabelsFromCPUFeatures(vmi *v1.VirtualMachineInstance) []string {
 			}
 		}
 	}
+	if topology.IsClusterCommonCPUModelRequested(vmi) && topology.AreClusterCommonCPUTopologyHintsDefined(vmi) {
+		for _, feature := range vmi.Status.TopologyHints.CPUFeatures {
+			labels = append(labels, v1.CPUFeatureLabel+feature)
+		}
+	}
 	return labels
 }
 
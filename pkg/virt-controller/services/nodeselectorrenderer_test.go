@@ -92,6 +92,21 @@ var _ = Describe("Node Selector Renderer", func() {
 				})
 			})
 
+			When("mitigated CPU vulnerabilities are requested", func() {
+				BeforeEach(func() {
+					nsr = NewNodeSelectorRenderer(emptySelectors(), emptySelectors(), "", WithMitigatedCPUVulnerabilities("retbleed", "mds"))
+				})
+
+				It("requires the node to be mitigated against those particular vulnerabilities", func() {
+					Expect(nsr.Render()).To(
+						Equal(map[string]string{
+							"kubevirt.io/schedulable":                               "true",
+							"mitigated-cpu-vulnerability.node.kubevirt.io/retbleed": "true",
+							"mitigated-cpu-vulnerability.node.kubevirt.io/mds":      "true",
+						}))
+				})
+			})
+
 			When("architecture set on VMI", func() {
 
 				BeforeEach(func() {
@@ -61,6 +61,30 @@ func doesVMIRequireCPUForIOThreads(vmi *v1.VirtualMachineInstance) bool {
 		*vmi.Spec.Domain.IOThreads.SupplementalPoolThreadCount > 0
 }
 
+// doesVMIRequireDedicatedIOThreadsCPU mirrors virt-launcher, which only pins the IOThreads to their own pcpu
+// if the VMI has IOThreads at all
+func doesVMIRequireDedicatedIOThreadsCPU(vmi *v1.VirtualMachineInstance) bool {
+	cpu := vmi.Spec.Domain.CPU
+	return vmi.IsCPUDedicated() && hasIOThreads(vmi) && cpu.ThreadPlacement != nil &&
+		(cpu.ThreadPlacement.IOThreads == v1.ThreadPlacementDedicated || cpu.ThreadPlacement.IOThreads == v1.ThreadPlacementSameNUMANode)
+}
+
+func hasIOThreads(vmi *v1.VirtualMachineInstance) bool {
+	if vmi.Spec.Domain.IOThreadsPolicy != nil {
+		return true
+	}
+	for _, disk := range vmi.Spec.Domain.Devices.Disks {
+		if disk.DedicatedIOThread != nil && *disk.DedicatedIOThread {
+			return true
+		}
+	}
+	return false
+}
+
+func hasHousekeepingCPU(vmi *v1.VirtualMachineInstance) bool {
+	return vmi.Spec.Domain.CPU != nil && vmi.Spec.Domain.CPU.Housekeeping != nil
+}
+
 func NewResourceRenderer(vmLimits k8sv1.ResourceList, vmRequests k8sv1.ResourceList, options ...ResourceRendererOption) *ResourceRenderer {
 	limits := map[k8sv1.ResourceName]resource.Quantity{}
 	requests := map[k8sv1.ResourceName]resource.Quantity{}
@@ -145,6 +169,25 @@ func WithoutDedicatedCPU(cpu *v1.CPU, cpuAllocationRatio int, withCPULimits bool
 	}
 }
 
+// WithHousekeepingCPU adds the CPU budget of the housekeeping threads to the CPU requests
+// and, if they are set, to the CPU limits of the compute container
+func WithHousekeepingCPU(cpu *v1.CPU) ResourceRendererOption {
+	return func(renderer *ResourceRenderer) {
+		housekeeping := cpu.Housekeeping
+		if _, ok := renderer.vmRequests[k8sv1.ResourceCPU]; ok {
+			addToCPU(renderer.vmRequests, housekeeping.CPU)
+		} else {
+			addToCPU(renderer.calculatedRequests, housekeeping.CPU)
+		}
+
+		if _, ok := renderer.vmLimits[k8sv1.ResourceCPU]; ok {
+			addToCPU(renderer.vmLimits, housekeeping.CPU)
+		} else if _, ok := renderer.calculatedLimits[k8sv1.ResourceCPU]; ok {
+			addToCPU(renderer.calculatedLimits, housekeeping.CPU)
+		}
+	}
+}
+
 func WithIOThreads(iothreads *v1.DiskIOThreads) ResourceRendererOption {
 	return func(renderer *ResourceRenderer) {
 		if iothreads == nil || iothreads.SupplementalPoolThreadCount == nil || *iothreads.SupplementalPoolThreadCount < 1 {
@@ -258,6 +301,25 @@ func WithCPUPinning(cpu *v1.CPU, annotations map[string]string, additionalCPUs u
 	}
 }
 
+// WithDedicatedIOThreadsCPU allocates a pcpu for the IOThreads if they should not share the pcpus of the other threads
+func WithDedicatedIOThreadsCPU() ResourceRendererOption {
+	return func(renderer *ResourceRenderer) {
+		ioThreadsCPU := *resource.NewQuantity(1, resource.BinarySI)
+
+		limits, exists := renderer.vmLimits[k8sv1.ResourceCPU]
+		if !exists {
+			limits = renderer.calculatedLimits[k8sv1.ResourceCPU]
+		}
+		limits.Add(ioThreadsCPU)
+		renderer.vmLimits[k8sv1.ResourceCPU] = limits
+
+		if cpuRequest, ok := renderer.vmRequests[k8sv1.ResourceCPU]; ok {
+			cpuRequest.Add(ioThreadsCPU)
+			renderer.vmRequests[k8sv1.ResourceCPU] = cpuRequest
+		}
+	}
+}
+
 func WithNetworkResources(networkToResourceMap map[string]string) ResourceRendererOption {
 	return func(renderer *ResourceRenderer) {
 		resources := renderer.ResourceRequirements()
@@ -8,6 +8,7 @@ import (
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/types"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/tools/cache"
 
@@ -84,6 +85,29 @@ var _ = Describe("Resource pod spec renderer", func() {
 		})
 	})
 
+	Context("WithHousekeepingCPU option", func() {
+		cpu := &v1.CPU{Cores: 2, Housekeeping: &v1.Housekeeping{CPU: resource.MustParse("200m")}}
+
+		It("adds the housekeeping CPU to the calculated CPU requests and limits", func() {
+			rr = NewResourceRenderer(nil, nil, WithoutDedicatedCPU(cpu, 1, true), WithHousekeepingCPU(cpu))
+			Expect(rr.Requests()).To(HaveKeyWithValue(kubev1.ResourceCPU, matchQuantity("2200m")))
+			Expect(rr.Limits()).To(HaveKeyWithValue(kubev1.ResourceCPU, matchQuantity("2200m")))
+		})
+
+		It("adds the housekeeping CPU to the user provided CPU requests and limits", func() {
+			resources := kubev1.ResourceList{kubev1.ResourceCPU: resource.MustParse("2")}
+			rr = NewResourceRenderer(resources, resources, WithoutDedicatedCPU(cpu, 10, false), WithHousekeepingCPU(cpu))
+			Expect(rr.Requests()).To(HaveKeyWithValue(kubev1.ResourceCPU, matchQuantity("2200m")))
+			Expect(rr.Limits()).To(HaveKeyWithValue(kubev1.ResourceCPU, matchQuantity("2200m")))
+		})
+
+		It("does not add CPU limits if there are none", func() {
+			rr = NewResourceRenderer(nil, nil, WithoutDedicatedCPU(cpu, 10, false), WithHousekeepingCPU(cpu))
+			Expect(rr.Requests()).To(HaveKeyWithValue(kubev1.ResourceCPU, matchQuantity("400m")))
+			Expect(rr.Limits()).To(BeEmpty())
+		})
+	})
+
 	Context("WithMemoryOverhead option", func() {
 		baseMemory := resource.MustParse("64M")
 		memOverhead := resource.MustParse("128M")
@@ -257,6 +281,47 @@ var _ = Describe("Resource pod spec renderer", func() {
 				), "should have the limits")
 			})
 		})
+
+		DescribeTable("allocates an additional CPU for the IOThreads", func(policy v1.ThreadPlacementPolicy, ioThreadsPolicy *v1.IOThreadsPolicy, isolateEmulatorThread bool, expectedCPUs string) {
+			cpu := &v1.CPU{
+				Cores:                 2,
+				DedicatedCPUPlacement: true,
+				IsolateEmulatorThread: isolateEmulatorThread,
+				ThreadPlacement:       &v1.ThreadPlacement{IOThreads: policy},
+			}
+			vmi := &v1.VirtualMachineInstance{
+				Spec: v1.VirtualMachineInstanceSpec{
+					Domain: v1.DomainSpec{CPU: cpu, IOThreadsPolicy: ioThreadsPolicy},
+				},
+			}
+			options := []ResourceRendererOption{WithCPUPinning(cpu, nil, 0)}
+			if doesVMIRequireDedicatedIOThreadsCPU(vmi) {
+				options = append(options, WithDedicatedIOThreadsCPU())
+			}
+			rr = NewResourceRenderer(nil, userSpecifiedCPU, options...)
+			Expect(rr.Limits()).To(HaveKeyWithValue(kubev1.ResourceCPU, matchQuantity(expectedCPUs)))
+		},
+			Entry("if they are shared", v1.ThreadPlacementShared, pointer.P(v1.IOThreadsPolicyShared), false, "2"),
+			Entry("if they are dedicated", v1.ThreadPlacementDedicated, pointer.P(v1.IOThreadsPolicyShared), false, "3"),
+			Entry("if they are on the NUMA node of the vCPUs", v1.ThreadPlacementSameNUMANode, pointer.P(v1.IOThreadsPolicyAuto), false, "3"),
+			Entry("and the isolated emulator thread if they are dedicated", v1.ThreadPlacementDedicated, pointer.P(v1.IOThreadsPolicyShared), true, "4"),
+			Entry("unless the VMI has no IOThreads", v1.ThreadPlacementDedicated, nil, false, "2"),
+		)
+
+		It("should consider IOThreads of disks with a dedicated IOThread", func() {
+			vmi := &v1.VirtualMachineInstance{
+				Spec: v1.VirtualMachineInstanceSpec{
+					Domain: v1.DomainSpec{
+						CPU: &v1.CPU{
+							DedicatedCPUPlacement: true,
+							ThreadPlacement:       &v1.ThreadPlacement{IOThreads: v1.ThreadPlacementDedicated},
+						},
+						Devices: v1.Devices{Disks: []v1.Disk{{Name: "disk0", DedicatedIOThread: pointer.P(true)}}},
+					},
+				},
+			}
+			Expect(doesVMIRequireDedicatedIOThreadsCPU(vmi)).To(BeTrue())
+		})
 	})
 
 	Context("WithNetworkResources option", func() {
@@ -743,3 +808,11 @@ func addResources(firstQuantity resource.Quantity, resources ...resource.Quantit
 	}
 	return firstQuantity
 }
+
+// matchQuantity compares the value only, the cached string format of the rendered quantities differs
+func matchQuantity(expected string) types.GomegaMatcher {
+	quantity := resource.MustParse(expected)
+	return WithTransform(func(q resource.Quantity) int {
+		return q.Cmp(quantity)
+	}, BeZero())
+}
@@ -14,14 +14,18 @@ import (
 	"kubevirt.io/client-go/log"
 
 	"kubevirt.io/kubevirt/pkg/config"
+	ephemeraldisk "kubevirt.io/kubevirt/pkg/ephemeral-disk"
 	"kubevirt.io/kubevirt/pkg/hooks"
 	hostdisk "kubevirt.io/kubevirt/pkg/host-disk"
 	"kubevirt.io/kubevirt/pkg/network/downwardapi"
+	"kubevirt.io/kubevirt/pkg/pointer"
 	"kubevirt.io/kubevirt/pkg/storage/types"
 	"kubevirt.io/kubevirt/pkg/util"
 	"kubevirt.io/kubevirt/pkg/virtiofs"
 )
 
+const ephemeralOverlaySuffix = "-overlay"
+
 type VolumeRendererOption func(renderer *VolumeRenderer) error
 
 type VolumeRenderer struct {
@@ -593,9 +597,38 @@ func (vr *VolumeRenderer) handleEphemeralVolume(volume v1.Volume, pvcStore cache
 			PersistentVolumeClaim: volume.Ephemeral.PersistentVolumeClaim,
 		},
 	})
+	if volume.Ephemeral.Overlay != nil {
+		vr.handleEphemeralOverlay(volume.Name, volume.Ephemeral.Overlay)
+	}
 	return nil
 }
 
+// handleEphemeralOverlay mounts a generic ephemeral volume where virt-launcher
+// creates the overlay of the volume, so the writes of the guest end up on it.
+func (vr *VolumeRenderer) handleEphemeralOverlay(volumeName string, overlay *v1.EphemeralOverlay) {
+	overlayVolumeName := volumeName + ephemeralOverlaySuffix
+	vr.podVolumes = append(vr.podVolumes, k8sv1.Volume{
+		Name: overlayVolumeName,
+		VolumeSource: k8sv1.VolumeSource{
+			Ephemeral: &k8sv1.EphemeralVolumeSource{
+				VolumeClaimTemplate: &k8sv1.PersistentVolumeClaimTemplate{
+					Spec: k8sv1.PersistentVolumeClaimSpec{
+						AccessModes:      []k8sv1.PersistentVolumeAccessMode{k8sv1.ReadWriteOnce},
+						StorageClassName: pointer.P(overlay.StorageClassName),
+						Resources: k8sv1.VolumeResourceRequirements{
+							Requests: k8sv1.ResourceList{
+								k8sv1.ResourceStorage: overlay.Capacity,
+							},
+						},
+					},
+				},
+			},
+		},
+	})
+	vr.podVolumeMounts = append(vr.podVolumeMounts,
+		mountPath(overlayVolumeName, filepath.Join(vr.ephemeralDiskDir, ephemeraldisk.DiskDataDir, volumeName)))
+}
+
 func (vr *VolumeRenderer) handleDataVolume(volume v1.Volume, pvcStore cache.Store) error {
 	claimName := volume.DataVolume.Name
 	if err := vr.addPVCToLaunchManifest(pvcStore, volume, claimName); err != nil {
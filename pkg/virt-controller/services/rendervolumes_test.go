@@ -8,9 +8,12 @@ import (
 	. "github.com/onsi/gomega"
 
 	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	"k8s.io/client-go/tools/cache"
 
 	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/pointer"
 )
 
 var _ = Describe("Container spec renderer", func() {
@@ -94,6 +97,69 @@ var _ = Describe("Container spec renderer", func() {
 		})
 	})
 
+	Context("with ephemeral volume option and a dedicated overlay volume", func() {
+		const ephemeralVolumeName = "evn"
+		var overlayCapacity = resource.MustParse("5Gi")
+
+		BeforeEach(func() {
+			ephemeralVolumeOption := v1.Volume{
+				Name: ephemeralVolumeName,
+				VolumeSource: v1.VolumeSource{
+					Ephemeral: &v1.EphemeralVolumeSource{
+						PersistentVolumeClaim: &k8sv1.PersistentVolumeClaimVolumeSource{},
+						Overlay: &v1.EphemeralOverlay{
+							StorageClassName: "local",
+							Capacity:         overlayCapacity,
+						},
+					},
+				},
+			}
+
+			pvcStore := &cache.FakeCustomStore{
+				GetByKeyFunc: func(key string) (item interface{}, exists bool, err error) {
+					return &k8sv1.PersistentVolumeClaim{
+						Spec: k8sv1.PersistentVolumeClaimSpec{},
+					}, true, nil
+				},
+			}
+
+			var err error
+			vsr, err = NewVolumeRenderer(namespace, ephemeralDisk, containerDisk, virtShareDir, withVMIVolumes(pvcStore, []v1.Volume{ephemeralVolumeOption}, nil))
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should mount the overlay volume where the overlay of the disk is created", func() {
+			Expect(vsr.Mounts()).To(ConsistOf(
+				append(
+					defaultVolumeMounts(),
+					k8sv1.VolumeMount{
+						Name:      ephemeralVolumeName,
+						MountPath: vmiDiskPath(ephemeralVolumeName)},
+					k8sv1.VolumeMount{
+						Name:      ephemeralVolumeName + "-overlay",
+						MountPath: fmt.Sprintf("%s/disk-data/%s", ephemeralDisk, ephemeralVolumeName)})))
+		})
+
+		It("should feature a generic ephemeral volume for the overlay", func() {
+			Expect(vsr.Volumes()).To(ContainElement(k8sv1.Volume{
+				Name: ephemeralVolumeName + "-overlay",
+				VolumeSource: k8sv1.VolumeSource{
+					Ephemeral: &k8sv1.EphemeralVolumeSource{
+						VolumeClaimTemplate: &k8sv1.PersistentVolumeClaimTemplate{
+							Spec: k8sv1.PersistentVolumeClaimSpec{
+								AccessModes:      []k8sv1.PersistentVolumeAccessMode{k8sv1.ReadWriteOnce},
+								StorageClassName: pointer.P("local"),
+								Resources: k8sv1.VolumeResourceRequirements{
+									Requests: k8sv1.ResourceList{k8sv1.ResourceStorage: overlayCapacity},
+								},
+							},
+						},
+					},
+				},
+			}))
+		})
+	})
+
 	Context("with host disk volume option", func() {
 		const (
 			hostDiskName = "tiny-winy-disk"
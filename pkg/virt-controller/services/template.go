@@ -167,6 +167,7 @@ type templateService struct {
 	netBindingPluginMemoryCalculator netBindingPluginMemoryCalculator
 	annotationsGenerators            []annotationsGenerator
 	netTargetAnnotationsGenerator    targetAnnotationsGenerator
+	nodeStore                        cache.Store
 }
 
 func isFeatureStateEnabled(fs *v1.FeatureState) bool {
@@ -300,6 +301,10 @@ func (t *templateService) RenderMigrationManifest(vmi *v1.VirtualMachineInstance
 		maps.Copy(targetPod.Annotations, netAnnotations)
 	}
 
+	if t.isMigrationQuotaExcluded(vmi.Namespace) {
+		targetPod.Labels[v1.MigrationQuotaExcludedLabel] = "true"
+	}
+
 	return targetPod, err
 }
 
@@ -722,6 +727,9 @@ func (t *templateService) newNodeSelectorRenderer(vmi *v1.VirtualMachineInstance
 		log.Log.V(4).Info("Add SEV-ES node label selector")
 		opts = append(opts, WithSEVESSelector())
 	}
+	if vmi.Spec.Domain.CPU != nil && len(vmi.Spec.Domain.CPU.MitigatedVulnerabilities) > 0 {
+		opts = append(opts, WithMitigatedCPUVulnerabilities(vmi.Spec.Domain.CPU.MitigatedVulnerabilities...))
+	}
 
 	return NewNodeSelectorRenderer(
 		vmi.Spec.NodeSelector,
@@ -1477,13 +1485,32 @@ func (t *templateService) doesVMIRequireAutoCPULimits(vmi *v1.VirtualMachineInst
 	return false
 }
 
+func (t *templateService) isMigrationQuotaExcluded(namespace string) bool {
+	if t.namespaceStore == nil {
+		return false
+	}
+
+	obj, exists, err := t.namespaceStore.GetByKey(namespace)
+	if err != nil || !exists {
+		return false
+	}
+
+	ns, ok := obj.(*k8sv1.Namespace)
+	if !ok {
+		log.Log.Errorf("couldn't cast object to Namespace: %+v", obj)
+		return false
+	}
+
+	return ns.Annotations[v1.MigrationQuotaExclusionAnnotation] == "true"
+}
+
 func (t *templateService) VMIResourcePredicates(vmi *v1.VirtualMachineInstance, networkToResourceMap map[string]string) VMIResourcePredicates {
 	// Set default with vmi Architecture. compatible with multi-architecture hybrid environments
 	vmiCPUArch := vmi.Spec.Architecture
 	if vmiCPUArch == "" {
 		vmiCPUArch = t.clusterConfig.GetClusterCPUArch()
 	}
-	memoryOverhead := GetMemoryOverhead(vmi, vmiCPUArch, t.clusterConfig.GetConfig().AdditionalGuestMemoryOverheadRatio)
+	memoryOverhead := GetMemoryOverhead(vmi, vmiCPUArch, t.memoryOverheadRatio())
 
 	if t.netBindingPluginMemoryCalculator != nil {
 		memoryOverhead.Add(
@@ -1500,11 +1527,16 @@ func (t *templateService) VMIResourcePredicates(vmi *v1.VirtualMachineInstance,
 		vmi.Spec.Domain.IOThreads.SupplementalPoolThreadCount != nil {
 		additionalCPUs = *vmi.Spec.Domain.IOThreads.SupplementalPoolThreadCount
 	}
+	if doesVMIRequireDedicatedIOThreadsCPU(vmi) {
+		additionalCPUs++
+	}
 	return VMIResourcePredicates{
 		vmi: vmi,
 		resourceRules: []VMIResourceRule{
 			NewVMIResourceRule(doesVMIRequireDedicatedCPU, WithCPUPinning(vmi.Spec.Domain.CPU, vmi.Annotations, additionalCPUs)),
+			NewVMIResourceRule(doesVMIRequireDedicatedIOThreadsCPU, WithDedicatedIOThreadsCPU()),
 			NewVMIResourceRule(not(doesVMIRequireDedicatedCPU), WithoutDedicatedCPU(vmi.Spec.Domain.CPU, t.clusterConfig.GetCPUAllocationRatio(), withCPULimits)),
+			NewVMIResourceRule(hasHousekeepingCPU, WithHousekeepingCPU(vmi.Spec.Domain.CPU)),
 			NewVMIResourceRule(hasHugePages, WithHugePages(vmi.Spec.Domain.Memory, memoryOverhead)),
 			NewVMIResourceRule(not(hasHugePages), WithMemoryOverhead(vmi.Spec.Domain.Resources, memoryOverhead)),
 			NewVMIResourceRule(t.doesVMIRequireAutoMemoryLimits, WithAutoMemoryLimits(vmi.Namespace, t.namespaceStore)),
@@ -1520,6 +1552,41 @@ func (t *templateService) VMIResourcePredicates(vmi *v1.VirtualMachineInstance,
 	}
 }
 
+// memoryOverheadRatio returns the additional memory overhead ratio of the cluster config. With memory overhead
+// calibration the highest ratio which virt-handler measured on the nodes is used instead, if it is higher.
+func (t *templateService) memoryOverheadRatio() *string {
+	configuredRatio := t.clusterConfig.GetConfig().AdditionalGuestMemoryOverheadRatio
+	if t.nodeStore == nil || !t.clusterConfig.MemoryOverheadCalibrationEnabled() {
+		return configuredRatio
+	}
+
+	ratio := 1.0
+	if configuredRatio != nil && *configuredRatio != "" {
+		if parsed, err := strconv.ParseFloat(*configuredRatio, 64); err == nil {
+			ratio = parsed
+		}
+	}
+
+	calibrated := false
+	for _, obj := range t.nodeStore.List() {
+		node := obj.(*k8sv1.Node)
+		value, exists := node.Annotations[v1.MemoryOverheadRatioAnnotation]
+		if !exists {
+			continue
+		}
+		if measured, err := strconv.ParseFloat(value, 64); err == nil && measured > ratio {
+			ratio = measured
+			calibrated = true
+		}
+	}
+	if !calibrated {
+		return configuredRatio
+	}
+
+	calibratedRatio := strconv.FormatFloat(ratio, 'f', -1, 64)
+	return &calibratedRatio
+}
+
 func (t *templateService) doesVMIRequireAutoMemoryLimits(vmi *v1.VirtualMachineInstance) bool {
 	return t.doesVMIRequireAutoResourceLimits(vmi, k8sv1.ResourceMemory)
 }
@@ -1576,6 +1643,13 @@ func WithNetBindingPluginMemoryCalculator(netBindingPluginMemoryCalculator netBi
 	}
 }
 
+// WithNodeStore provides the nodes to pick up the memory overhead ratios measured by virt-handler
+func WithNodeStore(nodeStore cache.Store) templateServiceOption {
+	return func(service *templateService) {
+		service.nodeStore = nodeStore
+	}
+}
+
 func WithAnnotationsGenerators(generators ...annotationsGenerator) templateServiceOption {
 	return func(service *templateService) {
 		service.annotationsGenerators = append(service.annotationsGenerators, generators...)
@@ -1143,6 +1143,36 @@ var _ = Describe("Template", func() {
 				}
 			})
 
+			It("should add node selectors for the resolved cluster-common cpu model and features", func() {
+				config, kvStore, svc = configFactory(defaultArch)
+				vmi := v1.VirtualMachineInstance{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "testvmi",
+						Namespace: "default",
+						UID:       "1234",
+					},
+					Spec: v1.VirtualMachineInstanceSpec{
+						Domain: v1.DomainSpec{
+							CPU: &v1.CPU{Model: v1.CPUModeClusterCommon},
+						},
+					},
+					Status: v1.VirtualMachineInstanceStatus{
+						TopologyHints: &v1.TopologyHints{
+							CPUModel:    "Skylake-Client-IBRS",
+							CPUFeatures: []string{"ssbd", "md-clear"},
+						},
+					},
+				}
+
+				pod, err := svc.RenderLaunchManifest(&vmi)
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(pod.Spec.NodeSelector).To(HaveKeyWithValue(v1.CPUModelLabel+"Skylake-Client-IBRS", "true"))
+				Expect(pod.Spec.NodeSelector).To(HaveKeyWithValue(v1.CPUFeatureLabel+"ssbd", "true"))
+				Expect(pod.Spec.NodeSelector).To(HaveKeyWithValue(v1.CPUFeatureLabel+"md-clear", "true"))
+				Expect(pod.Spec.NodeSelector).ToNot(HaveKey(v1.CPUModelLabel + v1.CPUModeClusterCommon))
+			})
+
 			DescribeTable("should add node selector for machine type", func(specMachineType, statusMachineType, expectedMachineType string) {
 				config, kvStore, svc = configFactory(defaultArch)
 				vmi := v1.VirtualMachineInstance{
@@ -1227,6 +1257,22 @@ var _ = Describe("Template", func() {
 				Expect(pod.Spec.NodeSelector).To(Not(HaveKey(ContainSubstring(v1.RealtimeLabel))))
 			})
 
+			It("should add mitigated CPU vulnerability node label selectors", func() {
+				config, kvStore, svc = configFactory(defaultArch)
+				vmi := v1.VirtualMachineInstance{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "testvmi", Namespace: "default", UID: "1234",
+					},
+					Spec: v1.VirtualMachineInstanceSpec{Volumes: []v1.Volume{}, Domain: v1.DomainSpec{
+						CPU: &v1.CPU{MitigatedVulnerabilities: []string{"retbleed", "mds"}},
+					}},
+				}
+				pod, err := svc.RenderLaunchManifest(&vmi)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(pod.Spec.NodeSelector).To(HaveKeyWithValue(v1.MitigatedCPUVulnerabilityLabel+"retbleed", "true"))
+				Expect(pod.Spec.NodeSelector).To(HaveKeyWithValue(v1.MitigatedCPUVulnerabilityLabel+"mds", "true"))
+			})
+
 			Context("When scheduling SEV workloads", func() {
 				var vmi *v1.VirtualMachineInstance
 
@@ -5199,6 +5245,75 @@ var _ = Describe("Template", func() {
 		})
 	})
 
+	Context("with memory overhead calibration", func() {
+		const configuredRatio = "1.5"
+
+		var nodeStore cache.Store
+
+		newService := func(featureGates ...string) TemplateService {
+			config, kvStore, _ = configFactory(defaultArch)
+			kvConfig := kv.DeepCopy()
+			kvConfig.Spec.Configuration.AdditionalGuestMemoryOverheadRatio = pointer.P(configuredRatio)
+			kvConfig.Spec.Configuration.DeveloperConfiguration.FeatureGates = featureGates
+			testutils.UpdateFakeKubeVirtClusterConfig(kvStore, kvConfig)
+
+			return NewTemplateService("kubevirt/virt-launcher",
+				240,
+				"/var/run/kubevirt",
+				"/var/run/kubevirt-ephemeral-disks",
+				"/var/run/kubevirt/container-disks",
+				v1.HotplugDiskDir,
+				"pull-secret-1",
+				pvcCache,
+				virtClient,
+				config,
+				qemuGid,
+				"kubevirt/vmexport",
+				resourceQuotaStore,
+				namespaceStore,
+				WithSidecarCreator(testSidecarCreator),
+				WithNodeStore(nodeStore),
+			)
+		}
+
+		addNode := func(name, ratio string) {
+			Expect(nodeStore.Add(&k8sv1.Node{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        name,
+					Annotations: map[string]string{v1.MemoryOverheadRatioAnnotation: ratio},
+				},
+			})).To(Succeed())
+		}
+
+		expectedMemory := func(vmi *v1.VirtualMachineInstance, ratio string) int64 {
+			memory := GetMemoryOverhead(vmi, defaultArch, pointer.P(ratio))
+			memory.Add(*vmi.Spec.Domain.Resources.Requests.Memory())
+			return memory.Value()
+		}
+
+		BeforeEach(func() {
+			nodeStore = cache.NewStore(cache.MetaNamespaceKeyFunc)
+		})
+
+		DescribeTable("should size the launcher pod", func(featureGates []string, nodeRatios []string, expectedRatio string) {
+			for i, ratio := range nodeRatios {
+				addNode(fmt.Sprintf("node%d", i), ratio)
+			}
+			vmi := libvmi.New(libvmi.WithNamespace("default"), libvmi.WithResourceMemory("1Gi"))
+
+			pod, err := newService(featureGates...).RenderLaunchManifest(vmi)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(pod.Spec.Containers[0].Resources.Requests.Memory().Value()).To(Equal(expectedMemory(vmi, expectedRatio)))
+		},
+			Entry("with the configured ratio if the feature gate is disabled",
+				nil, []string{"3"}, configuredRatio),
+			Entry("with the highest measured ratio",
+				[]string{featuregate.MemoryOverheadCalibrationGate}, []string{"1.75", "2.25", "invalid"}, "2.25"),
+			Entry("with the configured ratio if all measured ratios are lower",
+				[]string{featuregate.MemoryOverheadCalibrationGate}, []string{"0.8", "1.2"}, configuredRatio),
+		)
+	})
+
 	Context("Custom annotations Generation", func() {
 		const (
 			testNamespace = "default"
@@ -5434,6 +5549,44 @@ var _ = Describe("Template", func() {
 			Expect(err).To(MatchError(expectedErr))
 		})
 	})
+
+	Context("Migration quota exclusion", func() {
+		const testNamespace = "quota-excluded"
+
+		BeforeEach(func() {
+			config, kvStore, svc = configFactory(defaultArch)
+		})
+
+		AfterEach(func() {
+			Expect(namespaceStore.Delete(&k8sv1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: testNamespace}})).To(Succeed())
+		})
+
+		DescribeTable("should label the migration target pod", func(annotations map[string]string, expectExcluded bool) {
+			Expect(namespaceStore.Add(&k8sv1.Namespace{
+				ObjectMeta: metav1.ObjectMeta{Name: testNamespace, Annotations: annotations},
+			})).To(Succeed())
+
+			vmi := libvmi.New(libvmi.WithNamespace(testNamespace))
+
+			sourcePod, err := svc.RenderLaunchManifest(vmi)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(sourcePod.Labels).ToNot(HaveKey(v1.MigrationQuotaExcludedLabel))
+
+			targetPod, err := svc.RenderMigrationManifest(vmi, nil, sourcePod)
+			Expect(err).ToNot(HaveOccurred())
+			if expectExcluded {
+				Expect(targetPod.Labels).To(HaveKeyWithValue(v1.MigrationQuotaExcludedLabel, "true"))
+			} else {
+				Expect(targetPod.Labels).ToNot(HaveKey(v1.MigrationQuotaExcludedLabel))
+			}
+		},
+			Entry("when the namespace excludes migration target pods from the quota",
+				map[string]string{v1.MigrationQuotaExclusionAnnotation: "true"}, true),
+			Entry("not when the namespace has no exclusion annotation", nil, false),
+			Entry("not when the exclusion annotation is not true",
+				map[string]string{v1.MigrationQuotaExclusionAnnotation: "yes"}, false),
+		)
+	})
 })
 
 func networkInfoAnnotVolume() k8sv1.Volume {
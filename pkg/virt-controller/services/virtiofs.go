@@ -16,9 +16,9 @@ import (
 )
 
 func generateVirtioFSContainers(vmi *v1.VirtualMachineInstance, image string, config *virtconfig.ClusterConfig) []k8sv1.Container {
-	passthroughFSVolumes := make(map[string]struct{})
+	passthroughFSVolumes := make(map[string]*v1.FilesystemVirtiofs)
 	for i := range vmi.Spec.Domain.Devices.Filesystems {
-		passthroughFSVolumes[vmi.Spec.Domain.Devices.Filesystems[i].Name] = struct{}{}
+		passthroughFSVolumes[vmi.Spec.Domain.Devices.Filesystems[i].Name] = vmi.Spec.Domain.Devices.Filesystems[i].Virtiofs
 	}
 	if len(passthroughFSVolumes) == 0 {
 		return nil
@@ -26,9 +26,9 @@ func generateVirtioFSContainers(vmi *v1.VirtualMachineInstance, image string, co
 
 	containers := []k8sv1.Container{}
 	for _, volume := range vmi.Spec.Volumes {
-		if _, isPassthroughFSVolume := passthroughFSVolumes[volume.Name]; isPassthroughFSVolume {
+		if fs, isPassthroughFSVolume := passthroughFSVolumes[volume.Name]; isPassthroughFSVolume {
 			resources := resourcesForVirtioFSContainer(vmi.IsCPUDedicated(), vmi.IsCPUDedicated() || vmi.WantsToHaveQOSGuaranteed(), config)
-			container := generateContainerFromVolume(&volume, image, resources)
+			container := generateContainerFromVolume(&volume, fs, image, resources)
 			containers = append(containers, container)
 
 		}
@@ -91,12 +91,40 @@ func virtioFSMountPoint(volume *v1.Volume) string {
 	return volumeMountPoint
 }
 
-func generateContainerFromVolume(volume *v1.Volume, image string, resources k8sv1.ResourceRequirements) k8sv1.Container {
+func virtioFSCacheMode(fs *v1.FilesystemVirtiofs) v1.VirtiofsCacheMode {
+	if fs == nil || fs.Cache == "" {
+		return v1.VirtiofsCacheAuto
+	}
+	return fs.Cache
+}
+
+// virtioFSIDMappingArgs translates the ID mapping into virtiofsd arguments, each
+// range mapping guest IDs to host IDs and back.
+func virtioFSIDMappingArgs(fs *v1.FilesystemVirtiofs) []string {
+	if fs == nil || fs.IDMapping == nil {
+		return nil
+	}
+
+	var args []string
+	for _, r := range fs.IDMapping.UserIDs {
+		args = append(args, fmt.Sprintf("--translate-uid=map:%d:%d:%d", r.GuestID, r.HostID, r.Count))
+	}
+	for _, r := range fs.IDMapping.GroupIDs {
+		args = append(args, fmt.Sprintf("--translate-gid=map:%d:%d:%d", r.GuestID, r.HostID, r.Count))
+	}
+	return args
+}
+
+func generateContainerFromVolume(
+	volume *v1.Volume, fs *v1.FilesystemVirtiofs, image string, resources k8sv1.ResourceRequirements,
+) k8sv1.Container {
 
 	socketPathArg := fmt.Sprintf("--socket-path=%s", virtiofs.VirtioFSSocketPath(volume.Name))
 	sourceArg := fmt.Sprintf("--shared-dir=%s", virtioFSMountPoint(volume))
+	cacheArg := fmt.Sprintf("--cache=%s", virtioFSCacheMode(fs))
 
-	args := []string{socketPathArg, sourceArg, "--sandbox=none", "--cache=auto"}
+	args := []string{socketPathArg, sourceArg, "--sandbox=none", cacheArg}
+	args = append(args, virtioFSIDMappingArgs(fs)...)
 
 	// If some files cannot be migrated, let's allow the migration to finish.
 	// Mark these files as invalid, the guest will not be able to access any such files,
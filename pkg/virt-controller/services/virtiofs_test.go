@@ -93,4 +93,53 @@ var _ = Describe("virtiofs container", func() {
 		Expect(container[1].SecurityContext.RunAsNonRoot).To(HaveValue(BeTrue()))
 		Expect(container[1].SecurityContext.AllowPrivilegeEscalation).To(HaveValue(BeFalse()))
 	})
+
+	It("should default to the auto cache mode without ID mapping", func() {
+		vmi := api.NewMinimalVMI("testvm")
+		vmi.Spec.Volumes = append(vmi.Spec.Volumes, v1.Volume{
+			Name: "shared",
+			VolumeSource: v1.VolumeSource{
+				PersistentVolumeClaim: testutils.NewFakePersistentVolumeSource(),
+			},
+		})
+		vmi.Spec.Domain.Devices.Filesystems = append(vmi.Spec.Domain.Devices.Filesystems, v1.Filesystem{
+			Name:     "shared",
+			Virtiofs: &v1.FilesystemVirtiofs{},
+		})
+
+		containers := generateVirtioFSContainers(vmi, "virtiofs-container", config)
+		Expect(containers).To(HaveLen(1))
+		Expect(containers[0].Args).To(ContainElement("--cache=auto"))
+		Expect(containers[0].Args).ToNot(ContainElement(HavePrefix("--translate-")))
+	})
+
+	It("should pass the cache mode and ID mapping to virtiofsd", func() {
+		vmi := api.NewMinimalVMI("testvm")
+		vmi.Spec.Volumes = append(vmi.Spec.Volumes, v1.Volume{
+			Name: "shared",
+			VolumeSource: v1.VolumeSource{
+				PersistentVolumeClaim: testutils.NewFakePersistentVolumeSource(),
+			},
+		})
+		vmi.Spec.Domain.Devices.Filesystems = append(vmi.Spec.Domain.Devices.Filesystems, v1.Filesystem{
+			Name: "shared",
+			Virtiofs: &v1.FilesystemVirtiofs{
+				Cache: v1.VirtiofsCacheNever,
+				IDMapping: &v1.VirtiofsIDMapping{
+					UserIDs:  []v1.VirtiofsIDRange{{GuestID: 0, HostID: 107, Count: 1}, {GuestID: 1000, HostID: 2000, Count: 10}},
+					GroupIDs: []v1.VirtiofsIDRange{{GuestID: 0, HostID: 107, Count: 1}},
+				},
+			},
+		})
+
+		containers := generateVirtioFSContainers(vmi, "virtiofs-container", config)
+		Expect(containers).To(HaveLen(1))
+		Expect(containers[0].Args).To(ContainElement("--cache=never"))
+		Expect(containers[0].Args).ToNot(ContainElement("--cache=auto"))
+		Expect(containers[0].Args).To(ContainElements(
+			"--translate-uid=map:0:107:1",
+			"--translate-uid=map:1000:2000:10",
+			"--translate-gid=map:0:107:1",
+		))
+	})
 })
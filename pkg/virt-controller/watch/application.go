@@ -37,13 +37,21 @@ import (
 
 	clone "kubevirt.io/api/clone/v1beta1"
 
+	"kubevirt.io/kubevirt/pkg/virt-controller/watch/canary"
+	"kubevirt.io/kubevirt/pkg/virt-controller/watch/circuitbreaker"
 	clonecontroller "kubevirt.io/kubevirt/pkg/virt-controller/watch/clone"
+	"kubevirt.io/kubevirt/pkg/virt-controller/watch/firewall"
+	"kubevirt.io/kubevirt/pkg/virt-controller/watch/guestmetrics"
 	"kubevirt.io/kubevirt/pkg/virt-controller/watch/migration"
 	"kubevirt.io/kubevirt/pkg/virt-controller/watch/node"
 	"kubevirt.io/kubevirt/pkg/virt-controller/watch/pool"
+	"kubevirt.io/kubevirt/pkg/virt-controller/watch/prefetch"
 	"kubevirt.io/kubevirt/pkg/virt-controller/watch/replicaset"
+	storagemigration "kubevirt.io/kubevirt/pkg/virt-controller/watch/storage-migration"
 	"kubevirt.io/kubevirt/pkg/virt-controller/watch/vm"
 	"kubevirt.io/kubevirt/pkg/virt-controller/watch/vmi"
+	volumeautoexpansion "kubevirt.io/kubevirt/pkg/virt-controller/watch/volume-autoexpansion"
+	volumepreparation "kubevirt.io/kubevirt/pkg/virt-controller/watch/volume-preparation"
 
 	"github.com/emicklei/go-restful/v3"
 	vsv1 "github.com/kubernetes-csi/external-snapshotter/client/v4/apis/volumesnapshot/v1"
@@ -64,6 +72,7 @@ import (
 
 	"kubevirt.io/kubevirt/pkg/util/ratelimiter"
 
+	"kubevirt.io/kubevirt/pkg/virt-controller/watch/schedulerextender"
 	"kubevirt.io/kubevirt/pkg/virt-controller/watch/topology"
 
 	"kubevirt.io/kubevirt/pkg/healthz"
@@ -71,6 +80,7 @@ import (
 
 	exportv1 "kubevirt.io/api/export/v1beta1"
 	poolv1 "kubevirt.io/api/pool/v1alpha1"
+	prefetchv1 "kubevirt.io/api/prefetch/v1alpha1"
 	snapshotv1 "kubevirt.io/api/snapshot/v1beta1"
 	"kubevirt.io/client-go/kubecli"
 	"kubevirt.io/client-go/log"
@@ -81,6 +91,7 @@ import (
 	clusterutil "kubevirt.io/kubevirt/pkg/util/cluster"
 
 	instancetypecontroller "kubevirt.io/kubevirt/pkg/instancetype/controller/vm"
+	"kubevirt.io/kubevirt/pkg/instancetype/rightsizing"
 	clientmetrics "kubevirt.io/kubevirt/pkg/monitoring/metrics/common/client"
 	metrics "kubevirt.io/kubevirt/pkg/monitoring/metrics/virt-controller"
 	"kubevirt.io/kubevirt/pkg/service"
@@ -92,6 +103,7 @@ import (
 	"kubevirt.io/kubevirt/pkg/virt-controller/services"
 	"kubevirt.io/kubevirt/pkg/virt-controller/watch/drain/disruptionbudget"
 	"kubevirt.io/kubevirt/pkg/virt-controller/watch/drain/evacuation"
+	"kubevirt.io/kubevirt/pkg/virt-controller/watch/fleet"
 	workloadupdater "kubevirt.io/kubevirt/pkg/virt-controller/watch/workload-updater"
 
 	netadmitter "kubevirt.io/kubevirt/pkg/network/admitter"
@@ -126,6 +138,11 @@ const (
 	defaultSnapshotControllerResyncPeriod = 5 * time.Minute
 	defaultNodeTopologyUpdatePeriod       = 30 * time.Second
 
+	circuitBreakerReportPeriod = 15 * time.Second
+	fleetSummaryUpdatePeriod   = 30 * time.Second
+	evacuationProgressPeriod   = 30 * time.Second
+	rightsizingPeriod          = 10 * time.Minute
+
 	defaultPromCertFilePath = "/etc/virt-controller/certificates/tls.crt"
 	defaultPromKeyFilePath  = "/etc/virt-controller/certificates/tls.key"
 )
@@ -194,6 +211,7 @@ type VirtControllerApp struct {
 	exportController             *export.VMExportController
 	snapshotController           *snapshot.VMSnapshotController
 	restoreController            *snapshot.VMRestoreController
+	snapshotScheduleController   *snapshot.VMSnapshotScheduleController
 	vmExportInformer             cache.SharedIndexInformer
 	routeCache                   cache.Store
 	ingressCache                 cache.Store
@@ -201,6 +219,7 @@ type VirtControllerApp struct {
 	vmSnapshotInformer           cache.SharedIndexInformer
 	vmSnapshotContentInformer    cache.SharedIndexInformer
 	vmRestoreInformer            cache.SharedIndexInformer
+	vmSnapshotScheduleInformer   cache.SharedIndexInformer
 	storageClassInformer         cache.SharedIndexInformer
 	allPodInformer               cache.SharedIndexInformer
 	resourceQuotaInformer        cache.SharedIndexInformer
@@ -212,6 +231,23 @@ type VirtControllerApp struct {
 	vmCloneInformer   cache.SharedIndexInformer
 	vmCloneController *clonecontroller.VMCloneController
 
+	imagePrefetchInformer   cache.SharedIndexInformer
+	imagePrefetchController *prefetch.Controller
+
+	guestMetricsServiceInformer       cache.SharedIndexInformer
+	guestMetricsEndpointSliceInformer cache.SharedIndexInformer
+	guestMetricsController            *guestmetrics.Controller
+
+	firewallNetworkPolicyInformer cache.SharedIndexInformer
+	firewallController            *firewall.Controller
+
+	canaryController *canary.Controller
+
+	storageMigrationController *storagemigration.Controller
+
+	volumeAutoExpansionController *volumeautoexpansion.Controller
+	volumePreparationThrottler    *volumepreparation.Throttler
+
 	instancetypeInformer        cache.SharedIndexInformer
 	clusterInstancetypeInformer cache.SharedIndexInformer
 	preferenceInformer          cache.SharedIndexInformer
@@ -231,6 +267,7 @@ type VirtControllerApp struct {
 	kubevirtNamespace          string
 	host                       string
 	evacuationController       *evacuation.EvacuationController
+	evacuationProgressUpdater  *evacuation.ProgressUpdater
 	disruptionBudgetController *disruptionbudget.DisruptionBudgetController
 
 	ctx context.Context
@@ -253,14 +290,22 @@ type VirtControllerApp struct {
 	exportControllerThreads           int
 	snapshotControllerThreads         int
 	restoreControllerThreads          int
+	snapshotScheduleControllerThreads int
 	snapshotControllerResyncPeriod    time.Duration
 	cloneControllerThreads            int
+	imagePrefetchControllerThreads    int
+	guestMetricsControllerThreads     int
+	firewallControllerThreads         int
+	storageMigrationControllerThreads int
+	volumeAutoExpansionThreads        int
 
 	caConfigMapName          string
 	promCertFilePath         string
 	promKeyFilePath          string
 	nodeTopologyUpdater      topology.NodeTopologyUpdater
 	nodeTopologyUpdatePeriod time.Duration
+	fleetSummaryUpdater      *fleet.SummaryUpdater
+	rightsizingRecommender   *rightsizing.Recommender
 	reloadableRateLimiter    *ratelimiter.ReloadableRateLimiter
 	leaderElector            *leaderelection.LeaderElector
 
@@ -275,6 +320,7 @@ func init() {
 	utilruntime.Must(exportv1.AddToScheme(scheme.Scheme))
 	utilruntime.Must(poolv1.AddToScheme(scheme.Scheme))
 	utilruntime.Must(clone.AddToScheme(scheme.Scheme))
+	utilruntime.Must(prefetchv1.AddToScheme(scheme.Scheme))
 }
 
 func Execute() {
@@ -382,6 +428,7 @@ func Execute() {
 	app.vmSnapshotInformer = app.informerFactory.VirtualMachineSnapshot()
 	app.vmSnapshotContentInformer = app.informerFactory.VirtualMachineSnapshotContent()
 	app.vmRestoreInformer = app.informerFactory.VirtualMachineRestore()
+	app.vmSnapshotScheduleInformer = app.informerFactory.VirtualMachineSnapshotSchedule()
 	app.storageClassInformer = app.informerFactory.StorageClass()
 	app.caExportConfigMapInformer = app.informerFactory.KubeVirtExportCAConfigMap()
 	app.exportRouteConfigMapInformer = app.informerFactory.ExportRouteConfigMap()
@@ -424,6 +471,10 @@ func Execute() {
 	app.migrationPolicyInformer = app.informerFactory.MigrationPolicy()
 
 	app.vmCloneInformer = app.informerFactory.VirtualMachineClone()
+	app.imagePrefetchInformer = app.informerFactory.ImagePrefetch()
+	app.guestMetricsServiceInformer = app.informerFactory.GuestMetricsService()
+	app.guestMetricsEndpointSliceInformer = app.informerFactory.GuestMetricsEndpointSlice()
+	app.firewallNetworkPolicyInformer = app.informerFactory.FirewallNetworkPolicy()
 
 	app.instancetypeInformer = app.informerFactory.VirtualMachineInstancetype()
 	app.clusterInstancetypeInformer = app.informerFactory.VirtualMachineClusterInstancetype()
@@ -465,9 +516,16 @@ func Execute() {
 	app.initEvacuationController()
 	app.initSnapshotController()
 	app.initRestoreController()
+	app.initSnapshotScheduleController()
 	app.initExportController()
 	app.initWorkloadUpdaterController()
 	app.initCloneController()
+	app.initImagePrefetchController()
+	app.initGuestMetricsController()
+	app.initFirewallController()
+	app.initCanaryController()
+	app.initStorageMigrationController()
+	app.initVolumeAutoExpansionController()
 	go app.Run()
 
 	<-app.reInitChan
@@ -518,6 +576,7 @@ func (vca *VirtControllerApp) Run() {
 		httpLogger := logger.With("service", "http")
 		_ = httpLogger.Level(log.INFO).Log("action", "listening", "interface", vca.BindAddress, "port", vca.Port)
 		http.Handle("/metrics", promhttp.Handler())
+		http.Handle(schedulerextender.PrioritizePath, schedulerextender.NewExtender(vca.kvPodInformer, vca.clusterConfig))
 		server := http.Server{
 			Addr:      vca.Address(),
 			Handler:   http.DefaultServeMux,
@@ -571,6 +630,7 @@ func (vca *VirtControllerApp) onStartedLeading() func(ctx context.Context) {
 		}
 
 		go vca.evacuationController.Run(vca.evacuationControllerThreads, stop)
+		go vca.evacuationProgressUpdater.Run(evacuationProgressPeriod, stop)
 		go vca.disruptionBudgetController.Run(vca.disruptionBudgetControllerThreads, stop)
 		go vca.nodeController.Run(vca.nodeControllerThreads, stop)
 		go vca.vmiController.Run(vca.vmiControllerThreads, stop)
@@ -588,18 +648,36 @@ func (vca *VirtControllerApp) onStartedLeading() func(ctx context.Context) {
 				log.Log.Warningf("error running the restore controller: %v", err)
 			}
 		}()
+		go func() {
+			if err := vca.snapshotScheduleController.Run(vca.snapshotScheduleControllerThreads, stop); err != nil {
+				log.Log.Warningf("error running the snapshot schedule controller: %v", err)
+			}
+		}()
 		go func() {
 			if err := vca.exportController.Run(vca.exportControllerThreads, stop); err != nil {
 				log.Log.Warningf("error running the export controller: %v", err)
 			}
 		}()
+		go circuitbreaker.NewConditionReporter(vca.clientSet, vca.kubeVirtInformer,
+			vca.snapshotController.CircuitBreaker,
+			vca.restoreController.CircuitBreaker,
+			vca.exportController.CircuitBreaker,
+		).Run(circuitBreakerReportPeriod, stop)
 		go vca.workloadUpdateController.Run(stop)
 		go vca.nodeTopologyUpdater.Run(vca.nodeTopologyUpdatePeriod, stop)
+		go vca.fleetSummaryUpdater.Run(fleetSummaryUpdatePeriod, stop)
+		go vca.rightsizingRecommender.Run(rightsizingPeriod, stop)
 		go func() {
 			if err := vca.vmCloneController.Run(vca.cloneControllerThreads, stop); err != nil {
 				log.Log.Warningf("error running the clone controller: %v", err)
 			}
 		}()
+		go vca.imagePrefetchController.Run(vca.imagePrefetchControllerThreads, stop)
+		go vca.guestMetricsController.Run(vca.guestMetricsControllerThreads, stop)
+		go vca.firewallController.Run(vca.firewallControllerThreads, stop)
+		go vca.canaryController.Run(stop)
+		go vca.storageMigrationController.Run(vca.storageMigrationControllerThreads, stop)
+		go vca.volumeAutoExpansionController.Run(vca.volumeAutoExpansionThreads, stop)
 
 		cache.WaitForCacheSync(stop, vca.persistentVolumeClaimInformer.HasSynced, vca.namespaceInformer.HasSynced, vca.resourceQuotaInformer.HasSynced)
 		close(vca.readyChan)
@@ -647,6 +725,7 @@ func (vca *VirtControllerApp) initCommon() {
 		services.WithNetBindingPluginMemoryCalculator(netbinding.MemoryCalculator{}),
 		services.WithAnnotationsGenerators(netAnnotationsGenerator, storageannotations.Generator{}),
 		services.WithNetTargetAnnotationsGenerator(netAnnotationsGenerator),
+		services.WithNodeStore(vca.nodeInformer.GetStore()),
 	)
 
 	topologyHinter := topology.NewTopologyHinter(vca.nodeInformer.GetStore(), vca.vmiInformer.GetStore(), vca.clusterConfig)
@@ -698,6 +777,7 @@ func (vca *VirtControllerApp) initCommon() {
 		vca.pdbInformer,
 		vca.migrationPolicyInformer,
 		vca.resourceQuotaInformer,
+		vca.namespaceInformer,
 		vca.vmiRecorder,
 		clientSet,
 		vca.clusterConfig,
@@ -707,6 +787,12 @@ func (vca *VirtControllerApp) initCommon() {
 	}
 
 	vca.nodeTopologyUpdater = topology.NewNodeTopologyUpdater(vca.clientSet, topologyHinter, vca.nodeInformer)
+	vca.fleetSummaryUpdater = fleet.NewSummaryUpdater(vca.clientSet,
+		vca.vmInformer, vca.vmiInformer, vca.migrationInformer, vca.nodeInformer, vca.kubeVirtInformer)
+	vca.rightsizingRecommender = rightsizing.NewRecommender(vca.clientSet,
+		vca.vmInformer, vca.clusterInstancetypeInformer, vca.clusterConfig)
+	vca.volumePreparationThrottler = volumepreparation.NewThrottler(vca.dataVolumeInformer.GetIndexer(),
+		vca.persistentVolumeClaimInformer.GetIndexer(), vca.storageClassInformer.GetStore(), vca.clusterConfig)
 }
 
 func (vca *VirtControllerApp) initReplicaSet() {
@@ -744,6 +830,7 @@ func (vca *VirtControllerApp) initVirtualMachines() {
 		vca.dataSourceInformer,
 		vca.namespaceStore,
 		vca.persistentVolumeClaimInformer,
+		vca.storageClassInformer,
 		vca.controllerRevisionInformer,
 		vca.kvPodInformer,
 		recorder,
@@ -763,6 +850,7 @@ func (vca *VirtControllerApp) initVirtualMachines() {
 			vca.clusterConfig,
 			recorder,
 		),
+		vca.volumePreparationThrottler,
 	)
 	if err != nil {
 		panic(err)
@@ -818,6 +906,7 @@ func (vca *VirtControllerApp) initEvacuationController() {
 	if err != nil {
 		panic(err)
 	}
+	vca.evacuationProgressUpdater = evacuation.NewProgressUpdater(vca.clientSet, vca.evacuationController, vca.kubeVirtInformer)
 }
 
 func (vca *VirtControllerApp) initSnapshotController() {
@@ -836,6 +925,7 @@ func (vca *VirtControllerApp) initSnapshotController() {
 		DVInformer:                vca.dataVolumeInformer,
 		CRInformer:                vca.controllerRevisionInformer,
 		Recorder:                  recorder,
+		CircuitBreaker:            circuitbreaker.New("snapshot"),
 		ResyncPeriod:              vca.snapshotControllerResyncPeriod,
 	}
 	if err := vca.snapshotController.Init(); err != nil {
@@ -857,6 +947,7 @@ func (vca *VirtControllerApp) initRestoreController() {
 		StorageClassInformer:      vca.storageClassInformer,
 		VolumeSnapshotProvider:    vca.snapshotController,
 		Recorder:                  recorder,
+		CircuitBreaker:            circuitbreaker.New("restore"),
 		CRInformer:                vca.controllerRevisionInformer,
 	}
 	if err := vca.restoreController.Init(); err != nil {
@@ -864,6 +955,21 @@ func (vca *VirtControllerApp) initRestoreController() {
 	}
 }
 
+func (vca *VirtControllerApp) initSnapshotScheduleController() {
+	recorder := vca.newRecorder(k8sv1.NamespaceAll, "snapshot-schedule-controller")
+	vca.snapshotScheduleController = &snapshot.VMSnapshotScheduleController{
+		Client:                     vca.clientSet,
+		VMSnapshotScheduleInformer: vca.vmSnapshotScheduleInformer,
+		VMSnapshotInformer:         vca.vmSnapshotInformer,
+		VMInformer:                 vca.vmInformer,
+		VMIInformer:                vca.vmiInformer,
+		Recorder:                   recorder,
+	}
+	if err := vca.snapshotScheduleController.Init(); err != nil {
+		panic(err)
+	}
+}
+
 func (vca *VirtControllerApp) initExportController() {
 	recorder := vca.newRecorder(k8sv1.NamespaceAll, "export-controller")
 	vca.exportController = &export.VMExportController{
@@ -875,6 +981,7 @@ func (vca *VirtControllerApp) initExportController() {
 		DataVolumeInformer:          vca.dataVolumeInformer,
 		ServiceInformer:             vca.exportServiceInformer,
 		Recorder:                    recorder,
+		CircuitBreaker:              circuitbreaker.New("export"),
 		ConfigMapInformer:           vca.caExportConfigMapInformer,
 		IngressCache:                vca.ingressCache,
 		RouteCache:                  vca.routeCache,
@@ -910,6 +1017,94 @@ func (vca *VirtControllerApp) initCloneController() {
 	}
 }
 
+func (vca *VirtControllerApp) initImagePrefetchController() {
+	var err error
+	recorder := vca.newRecorder(k8sv1.NamespaceAll, "imageprefetch-controller")
+	vca.imagePrefetchController, err = prefetch.NewController(vca.clientSet,
+		vca.imagePrefetchInformer,
+		vca.kvPodInformer,
+		vca.nodeInformer,
+		vca.dataSourceInformer,
+		recorder,
+		vca.clusterConfig,
+		vca.launcherImage,
+		vca.imagePullSecret)
+	if err != nil {
+		panic(err)
+	}
+}
+
+func (vca *VirtControllerApp) initGuestMetricsController() {
+	var err error
+	recorder := vca.newRecorder(k8sv1.NamespaceAll, "guestmetrics-controller")
+	vca.guestMetricsController, err = guestmetrics.NewController(vca.clientSet,
+		vca.vmInformer,
+		vca.vmiInformer,
+		vca.guestMetricsServiceInformer,
+		vca.guestMetricsEndpointSliceInformer,
+		recorder,
+		vca.clusterConfig)
+	if err != nil {
+		panic(err)
+	}
+}
+
+func (vca *VirtControllerApp) initCanaryController() {
+	var err error
+	recorder := vca.newRecorder(k8sv1.NamespaceAll, "canary-controller")
+	vca.canaryController, err = canary.NewController(vca.clientSet,
+		vca.vmiInformer,
+		recorder,
+		vca.clusterConfig,
+		vca.kubevirtNamespace)
+	if err != nil {
+		panic(err)
+	}
+}
+
+func (vca *VirtControllerApp) initStorageMigrationController() {
+	var err error
+	recorder := vca.newRecorder(k8sv1.NamespaceAll, "storagemigration-controller")
+	vca.storageMigrationController, err = storagemigration.NewController(vca.clientSet,
+		vca.vmInformer,
+		vca.vmiInformer,
+		vca.dataVolumeInformer,
+		vca.persistentVolumeClaimInformer,
+		recorder)
+	if err != nil {
+		panic(err)
+	}
+}
+
+func (vca *VirtControllerApp) initVolumeAutoExpansionController() {
+	var err error
+	recorder := vca.newRecorder(k8sv1.NamespaceAll, "volumeautoexpansion-controller")
+	vca.volumeAutoExpansionController, err = volumeautoexpansion.NewController(vca.clientSet,
+		vca.vmInformer,
+		vca.vmiInformer,
+		vca.persistentVolumeClaimInformer,
+		vca.storageClassInformer,
+		recorder,
+		vca.clusterConfig,
+		vca.volumePreparationThrottler)
+	if err != nil {
+		panic(err)
+	}
+}
+
+func (vca *VirtControllerApp) initFirewallController() {
+	var err error
+	recorder := vca.newRecorder(k8sv1.NamespaceAll, "firewall-controller")
+	vca.firewallController, err = firewall.NewController(vca.clientSet,
+		vca.vmiInformer,
+		vca.firewallNetworkPolicyInformer,
+		recorder,
+		vca.clusterConfig)
+	if err != nil {
+		panic(err)
+	}
+}
+
 func (vca *VirtControllerApp) leaderProbe(_ *restful.Request, response *restful.Response) {
 	res := map[string]interface{}{}
 
@@ -998,6 +1193,9 @@ func (vca *VirtControllerApp) AddFlags() {
 	flag.IntVar(&vca.restoreControllerThreads, "restore-controller-threads", defaultControllerThreads,
 		"Number of goroutines to run for restore controller")
 
+	flag.IntVar(&vca.snapshotScheduleControllerThreads, "snapshot-schedule-controller-threads", defaultControllerThreads,
+		"Number of goroutines to run for snapshot schedule controller")
+
 	flag.IntVar(&vca.exportControllerThreads, "export-controller-threads", defaultControllerThreads,
 		"Number of goroutines to run for virtual machine export controller")
 
@@ -1015,6 +1213,21 @@ func (vca *VirtControllerApp) AddFlags() {
 
 	flag.IntVar(&vca.cloneControllerThreads, "clone-controller-threads", defaultControllerThreads,
 		"Number of goroutines to run for clone controller")
+
+	flag.IntVar(&vca.imagePrefetchControllerThreads, "imageprefetch-controller-threads", defaultControllerThreads,
+		"Number of goroutines to run for image prefetch controller")
+
+	flag.IntVar(&vca.guestMetricsControllerThreads, "guestmetrics-controller-threads", defaultControllerThreads,
+		"Number of goroutines to run for guest metrics controller")
+
+	flag.IntVar(&vca.firewallControllerThreads, "firewall-controller-threads", defaultControllerThreads,
+		"Number of goroutines to run for firewall controller")
+
+	flag.IntVar(&vca.storageMigrationControllerThreads, "storagemigration-controller-threads", defaultControllerThreads,
+		"Number of goroutines to run for storage migration controller")
+
+	flag.IntVar(&vca.volumeAutoExpansionThreads, "volumeautoexpansion-controller-threads", defaultControllerThreads,
+		"Number of goroutines to run for volume auto-expansion controller")
 }
 
 func (vca *VirtControllerApp) setupLeaderElector() (err error) {
@@ -34,6 +34,8 @@ import (
 	"github.com/emicklei/go-restful/v3"
 	appsv1 "k8s.io/api/apps/v1"
 	k8sv1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	networkingv1 "k8s.io/api/networking/v1"
 	policyv1 "k8s.io/api/policy/v1"
 	storagev1 "k8s.io/api/storage/v1"
 	extv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
@@ -46,12 +48,14 @@ import (
 	exportv1 "kubevirt.io/api/export/v1beta1"
 	instancetypev1beta1 "kubevirt.io/api/instancetype/v1beta1"
 	migrationsv1 "kubevirt.io/api/migrations/v1alpha1"
+	prefetchv1 "kubevirt.io/api/prefetch/v1alpha1"
 	snapshotv1 "kubevirt.io/api/snapshot/v1beta1"
 	"kubevirt.io/client-go/kubecli"
 	cdiv1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
 
 	"kubevirt.io/kubevirt/pkg/controller"
 	instancetypecontroller "kubevirt.io/kubevirt/pkg/instancetype/controller/vm"
+	"kubevirt.io/kubevirt/pkg/instancetype/rightsizing"
 	metrics "kubevirt.io/kubevirt/pkg/monitoring/metrics/virt-controller"
 	"kubevirt.io/kubevirt/pkg/rest"
 	"kubevirt.io/kubevirt/pkg/storage/export/export"
@@ -59,15 +63,23 @@ import (
 	"kubevirt.io/kubevirt/pkg/testutils"
 	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
 	"kubevirt.io/kubevirt/pkg/virt-controller/services"
+	"kubevirt.io/kubevirt/pkg/virt-controller/watch/canary"
 	clonecontroller "kubevirt.io/kubevirt/pkg/virt-controller/watch/clone"
 	"kubevirt.io/kubevirt/pkg/virt-controller/watch/drain/disruptionbudget"
 	"kubevirt.io/kubevirt/pkg/virt-controller/watch/drain/evacuation"
+	"kubevirt.io/kubevirt/pkg/virt-controller/watch/firewall"
+	"kubevirt.io/kubevirt/pkg/virt-controller/watch/fleet"
+	"kubevirt.io/kubevirt/pkg/virt-controller/watch/guestmetrics"
 	"kubevirt.io/kubevirt/pkg/virt-controller/watch/migration"
 	"kubevirt.io/kubevirt/pkg/virt-controller/watch/node"
+	"kubevirt.io/kubevirt/pkg/virt-controller/watch/prefetch"
 	"kubevirt.io/kubevirt/pkg/virt-controller/watch/replicaset"
+	storagemigration "kubevirt.io/kubevirt/pkg/virt-controller/watch/storage-migration"
 	"kubevirt.io/kubevirt/pkg/virt-controller/watch/topology"
 	"kubevirt.io/kubevirt/pkg/virt-controller/watch/vm"
 	"kubevirt.io/kubevirt/pkg/virt-controller/watch/vmi"
+	volumeautoexpansion "kubevirt.io/kubevirt/pkg/virt-controller/watch/volume-autoexpansion"
+	volumepreparation "kubevirt.io/kubevirt/pkg/virt-controller/watch/volume-preparation"
 )
 
 func newValidGetRequest() *http.Request {
@@ -114,12 +126,17 @@ var _ = Describe("Application", func() {
 		storageClassInformer, _ := testutils.NewFakeInformerFor(&storagev1.StorageClass{})
 		crdInformer, _ := testutils.NewFakeInformerFor(&extv1.CustomResourceDefinition{})
 		vmRestoreInformer, _ := testutils.NewFakeInformerFor(&snapshotv1.VirtualMachineRestore{})
+		vmSnapshotScheduleInformer, _ := testutils.NewFakeInformerFor(&snapshotv1.VirtualMachineSnapshotSchedule{})
 		vmExportInformer, _ := testutils.NewFakeInformerFor(&exportv1.VirtualMachineExport{})
 		configMapInformer, _ := testutils.NewFakeInformerFor(&k8sv1.ConfigMap{})
 		routeConfigMapInformer, _ := testutils.NewFakeInformerFor(&k8sv1.ConfigMap{})
 		dvInformer, _ := testutils.NewFakeInformerFor(&cdiv1.DataVolume{})
 		exportServiceInformer, _ := testutils.NewFakeInformerFor(&k8sv1.Service{})
 		cloneInformer, _ := testutils.NewFakeInformerFor(&clone.VirtualMachineClone{})
+		imagePrefetchInformer, _ := testutils.NewFakeInformerFor(&prefetchv1.ImagePrefetch{})
+		guestMetricsServiceInformer, _ := testutils.NewFakeInformerFor(&k8sv1.Service{})
+		guestMetricsEndpointSliceInformer, _ := testutils.NewFakeInformerFor(&discoveryv1.EndpointSlice{})
+		firewallNetworkPolicyInformer, _ := testutils.NewFakeInformerFor(&networkingv1.NetworkPolicy{})
 		secretInformer, _ := testutils.NewFakeInformerFor(&k8sv1.Secret{})
 		instancetypeInformer, _ := testutils.NewFakeInformerFor(&instancetypev1beta1.VirtualMachineInstancetype{})
 		clusterInstancetypeInformer, _ := testutils.NewFakeInformerFor(&instancetypev1beta1.VirtualMachineClusterInstancetype{})
@@ -157,12 +174,15 @@ var _ = Describe("Application", func() {
 			},
 		)
 		app.rsController, _ = replicaset.NewController(vmiInformer, rsInformer, recorder, virtClient, uint(10))
+		volumePreparationThrottler := volumepreparation.NewThrottler(dataVolumeInformer.GetIndexer(),
+			pvcInformer.GetIndexer(), storageClassInformer.GetStore(), config)
 		app.vmController, _ = vm.NewController(vmiInformer,
 			vmInformer,
 			dataVolumeInformer,
 			dataSourceInformer,
 			namespaceInformer.GetStore(),
 			pvcInformer,
+			storageClassInformer,
 			crInformer,
 			podInformer,
 			recorder,
@@ -170,6 +190,7 @@ var _ = Describe("Application", func() {
 			config,
 			nil,
 			instancetypecontroller.NewMockController(),
+			volumePreparationThrottler,
 		)
 		app.migrationController, _ = migration.NewController(services.NewTemplateService("a", 240, "b", "c", "d", "e", "f", pvcInformer.GetStore(), virtClient, config, qemuGid, "g", resourceQuotaInformer.GetStore(), namespaceInformer.GetStore()),
 			vmiInformer,
@@ -182,6 +203,7 @@ var _ = Describe("Application", func() {
 			pdbInformer,
 			migrationPolicyInformer,
 			resourceQuotaInformer,
+			namespaceInformer,
 			recorder,
 			virtClient,
 			config,
@@ -215,6 +237,15 @@ var _ = Describe("Application", func() {
 			Recorder:                  recorder,
 		}
 		_ = app.restoreController.Init()
+		app.snapshotScheduleController = &snapshot.VMSnapshotScheduleController{
+			Client:                     virtClient,
+			VMSnapshotScheduleInformer: vmSnapshotScheduleInformer,
+			VMSnapshotInformer:         vmSnapshotInformer,
+			VMInformer:                 vmInformer,
+			VMIInformer:                vmiInformer,
+			Recorder:                   recorder,
+		}
+		_ = app.snapshotScheduleController.Init()
 		app.exportController = &export.VMExportController{
 			Client:                      virtClient,
 			ManifestRenderer:            services.NewTemplateService("a", 240, "b", "c", "d", "e", "f", pvcInformer.GetStore(), virtClient, config, qemuGid, "g", resourceQuotaInformer.GetStore(), namespaceInformer.GetStore()),
@@ -244,6 +275,10 @@ var _ = Describe("Application", func() {
 		app.nodeInformer = nodeInformer
 		app.resourceQuotaInformer = resourceQuotaInformer
 		app.namespaceInformer = namespaceInformer
+		app.kubeVirtInformer = kvInformer
+		app.fleetSummaryUpdater = fleet.NewSummaryUpdater(virtClient, vmInformer, vmiInformer, migrationInformer, nodeInformer, kvInformer)
+		app.evacuationProgressUpdater = evacuation.NewProgressUpdater(virtClient, app.evacuationController, kvInformer)
+		app.rightsizingRecommender = rightsizing.NewRecommender(virtClient, vmInformer, clusterInstancetypeInformer, config)
 		app.vmCloneController, _ = clonecontroller.NewVmCloneController(
 			virtClient,
 			cloneInformer,
@@ -254,6 +289,58 @@ var _ = Describe("Application", func() {
 			pvcInformer,
 			recorder,
 		)
+		app.imagePrefetchController, _ = prefetch.NewController(
+			virtClient,
+			imagePrefetchInformer,
+			podInformer,
+			nodeInformer,
+			dataSourceInformer,
+			recorder,
+			config,
+			"launcher-image",
+			"",
+		)
+		app.guestMetricsController, _ = guestmetrics.NewController(
+			virtClient,
+			vmInformer,
+			vmiInformer,
+			guestMetricsServiceInformer,
+			guestMetricsEndpointSliceInformer,
+			recorder,
+			config,
+		)
+		app.firewallController, _ = firewall.NewController(
+			virtClient,
+			vmiInformer,
+			firewallNetworkPolicyInformer,
+			recorder,
+			config,
+		)
+		app.canaryController, _ = canary.NewController(
+			virtClient,
+			vmiInformer,
+			recorder,
+			config,
+			"kubevirt",
+		)
+		app.storageMigrationController, _ = storagemigration.NewController(
+			virtClient,
+			vmInformer,
+			vmiInformer,
+			dataVolumeInformer,
+			pvcInformer,
+			recorder,
+		)
+		app.volumeAutoExpansionController, _ = volumeautoexpansion.NewController(
+			virtClient,
+			vmInformer,
+			vmiInformer,
+			pvcInformer,
+			storageClassInformer,
+			recorder,
+			config,
+			volumePreparationThrottler,
+		)
 
 		app.readyChan = make(chan bool)
 
@@ -0,0 +1,447 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package canary
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/utils/clock"
+
+	virtv1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/controller"
+	metrics "kubevirt.io/kubevirt/pkg/monitoring/metrics/virt-controller"
+	"kubevirt.io/kubevirt/pkg/pointer"
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
+)
+
+const (
+	// Name is the name of the canary VirtualMachineInstance
+	Name = "kubevirt-canary"
+	// LabelKey marks the canary VirtualMachineInstance, VirtualMachineInstances without it are never touched
+	LabelKey = "kubevirt.io/canary"
+
+	// The phases of a canary run, as reported by the metrics
+	PhaseCreate   = "create"
+	PhaseSchedule = "schedule"
+	PhaseBoot     = "boot"
+	PhaseProbe    = "probe"
+	PhaseDelete   = "delete"
+
+	FailedCanaryReason = "CanaryFailed"
+
+	DefaultInterval = 10 * time.Minute
+	DefaultTimeout  = 5 * time.Minute
+
+	// disabledRecheckInterval is how often the configuration is checked while the canary is disabled
+	disabledRecheckInterval = time.Minute
+	// deletionRecheckInterval is how often a canary VirtualMachineInstance which is deleted is checked
+	deletionRecheckInterval = 10 * time.Second
+	// queueKey is the only key of the queue, there is a single canary run at a time
+	queueKey = "canary"
+	diskName = "containerdisk"
+)
+
+var DefaultMemory = resource.MustParse("128Mi")
+
+// bootPhases are the phases observed from the status of the canary VirtualMachineInstance, in their order
+var bootPhases = []string{PhaseSchedule, PhaseBoot, PhaseProbe}
+
+// run tracks the canary VirtualMachineInstance which is currently booted or deleted
+type run struct {
+	uid       types.UID
+	namespace string
+	// completed is the number of bootPhases which succeeded
+	completed     int
+	failed        bool
+	aborted       bool
+	deleteStarted time.Time
+	deleteFailed  bool
+}
+
+func (r *run) ready() bool {
+	return r.completed == len(bootPhases)
+}
+
+// pendingPhase is the phase the run is waiting for or failed in
+func (r *run) pendingPhase() string {
+	if r.ready() {
+		return PhaseDelete
+	}
+	return bootPhases[r.completed]
+}
+
+// Controller periodically creates a small VirtualMachineInstance, waits for it to be scheduled,
+// to run and to become ready, and deletes it again. The duration and the result of every phase
+// are recorded as metrics, which gives a continuous end-to-end health signal for the cluster.
+type Controller struct {
+	clientset         kubecli.KubevirtClient
+	queue             workqueue.TypedRateLimitingInterface[string]
+	vmiStore          cache.Store
+	recorder          record.EventRecorder
+	clusterConfig     *virtconfig.ClusterConfig
+	kubevirtNamespace string
+	clock             clock.Clock
+	hasSynced         func() bool
+
+	lastStart time.Time
+	run       *run
+}
+
+// NewController creates a new instance of the canary Controller.
+func NewController(clientset kubecli.KubevirtClient,
+	vmiInformer cache.SharedIndexInformer,
+	recorder record.EventRecorder,
+	clusterConfig *virtconfig.ClusterConfig,
+	kubevirtNamespace string) (*Controller, error) {
+	return NewControllerWithClock(clientset, vmiInformer, recorder, clusterConfig, kubevirtNamespace, clock.RealClock{})
+}
+
+// NewControllerWithClock creates a new instance of the canary Controller measuring with the given clock.
+func NewControllerWithClock(clientset kubecli.KubevirtClient,
+	vmiInformer cache.SharedIndexInformer,
+	recorder record.EventRecorder,
+	clusterConfig *virtconfig.ClusterConfig,
+	kubevirtNamespace string,
+	clock clock.Clock) (*Controller, error) {
+	c := &Controller{
+		clientset: clientset,
+		queue: workqueue.NewTypedRateLimitingQueueWithConfig[string](
+			workqueue.DefaultTypedControllerRateLimiter[string](),
+			workqueue.TypedRateLimitingQueueConfig[string]{Name: "virt-controller-canary"},
+		),
+		vmiStore:          vmiInformer.GetStore(),
+		recorder:          recorder,
+		clusterConfig:     clusterConfig,
+		kubevirtNamespace: kubevirtNamespace,
+		clock:             clock,
+		hasSynced:         vmiInformer.HasSynced,
+	}
+
+	_, err := vmiInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    c.handleVMI,
+		DeleteFunc: c.handleVMI,
+		UpdateFunc: func(_, curr interface{}) { c.handleVMI(curr) },
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+func (c *Controller) handleVMI(obj interface{}) {
+	if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+		obj = tombstone.Obj
+	}
+	vmi, ok := obj.(*virtv1.VirtualMachineInstance)
+	if !ok || vmi.Name != Name {
+		return
+	}
+	if _, isCanary := vmi.Labels[LabelKey]; isCanary {
+		c.queue.Add(queueKey)
+	}
+}
+
+func (c *Controller) Run(stopCh <-chan struct{}) {
+	defer controller.HandlePanic()
+	defer c.queue.ShutDown()
+	log.Log.Info("Starting canary controller.")
+
+	cache.WaitForCacheSync(stopCh, c.hasSynced)
+
+	c.queue.Add(queueKey)
+	go func() {
+		for c.Execute() {
+		}
+	}()
+
+	<-stopCh
+	log.Log.Info("Stopping canary controller.")
+}
+
+// Execute runs the next step of the canary run. If there is an error it requeues
+// the key rate limited, otherwise it requeues it for the time the run has to wait.
+// Returns false if the queue is shut down.
+func (c *Controller) Execute() bool {
+	key, quit := c.queue.Get()
+	if quit {
+		return false
+	}
+	defer c.queue.Done(key)
+
+	requeueAfter, err := c.execute()
+	if err != nil {
+		log.Log.Reason(err).Info("reenqueuing canary")
+		c.queue.AddRateLimited(key)
+	} else {
+		c.queue.Forget(key)
+		c.queue.AddAfter(key, requeueAfter)
+	}
+	return true
+}
+
+func (c *Controller) execute() (time.Duration, error) {
+	conf := c.clusterConfig.GetCanaryConfiguration()
+
+	namespace := c.namespace(conf)
+	if c.run != nil {
+		namespace = c.run.namespace
+	}
+	vmi, err := c.getCanary(namespace)
+	if err != nil {
+		return 0, err
+	}
+
+	if vmi != nil {
+		if c.run == nil || c.run.uid != vmi.UID {
+			// The canary was created before virt-controller became the leader
+			c.run = &run{uid: vmi.UID, namespace: vmi.Namespace}
+		}
+		return c.sync(vmi, conf)
+	}
+
+	if c.run != nil {
+		c.finishRun()
+	}
+
+	if conf == nil {
+		return disabledRecheckInterval, nil
+	}
+	if !c.lastStart.IsZero() {
+		if remaining := interval(conf) - c.clock.Since(c.lastStart); remaining > 0 {
+			return remaining, nil
+		}
+	}
+	return c.createCanary(conf, c.namespace(conf))
+}
+
+func (c *Controller) namespace(conf *virtv1.CanaryConfiguration) string {
+	if conf != nil && conf.Namespace != "" {
+		return conf.Namespace
+	}
+	return c.kubevirtNamespace
+}
+
+// getCanary returns the canary VirtualMachineInstance in the namespace, nil if there is none
+func (c *Controller) getCanary(namespace string) (*virtv1.VirtualMachineInstance, error) {
+	obj, exists, err := c.vmiStore.GetByKey(controller.NamespacedKey(namespace, Name))
+	if err != nil || !exists {
+		return nil, err
+	}
+	vmi := obj.(*virtv1.VirtualMachineInstance)
+	if _, isCanary := vmi.Labels[LabelKey]; !isCanary {
+		return nil, fmt.Errorf("VirtualMachineInstance %s/%s exists but is not a canary", namespace, Name)
+	}
+	return vmi, nil
+}
+
+func (c *Controller) sync(vmi *virtv1.VirtualMachineInstance, conf *virtv1.CanaryConfiguration) (time.Duration, error) {
+	if vmi.DeletionTimestamp != nil {
+		if !c.run.deleteStarted.IsZero() && !c.run.deleteFailed && c.clock.Since(c.run.deleteStarted) > timeout(conf) {
+			c.run.deleteFailed = true
+			metrics.CanaryPhaseFailed(PhaseDelete)
+			c.recorder.Eventf(vmi, k8sv1.EventTypeWarning, FailedCanaryReason,
+				"Canary VirtualMachineInstance was not deleted within %s", timeout(conf))
+		}
+		return deletionRecheckInterval, nil
+	}
+
+	if conf == nil || vmi.Namespace != c.namespace(conf) {
+		// The run can not finish, so it is dropped without reporting a result
+		c.run.aborted = true
+		return c.deleteCanary(vmi)
+	}
+
+	c.observeBootPhases(vmi)
+	if c.run.ready() {
+		return c.deleteCanary(vmi)
+	}
+
+	if vmi.IsFinal() {
+		c.failRun(vmi, fmt.Sprintf("the VirtualMachineInstance is %s", vmi.Status.Phase))
+		return c.deleteCanary(vmi)
+	}
+
+	remaining := timeout(conf) - c.clock.Since(vmi.CreationTimestamp.Time)
+	if remaining <= 0 {
+		c.failRun(vmi, fmt.Sprintf("it did not complete within %s", timeout(conf)))
+		return c.deleteCanary(vmi)
+	}
+	return remaining, nil
+}
+
+// observeBootPhases records the boot phases which succeeded since they were last observed
+func (c *Controller) observeBootPhases(vmi *virtv1.VirtualMachineInstance) {
+	transitions := []*metav1.Time{
+		&vmi.CreationTimestamp,
+		phaseTransitionTime(vmi, virtv1.Scheduled),
+		phaseTransitionTime(vmi, virtv1.Running),
+		readyTime(vmi),
+	}
+	for ; c.run.completed < len(bootPhases); c.run.completed++ {
+		start, end := transitions[c.run.completed], transitions[c.run.completed+1]
+		if end == nil {
+			return
+		}
+		metrics.CanaryPhaseSucceeded(bootPhases[c.run.completed], nonNegative(end.Sub(start.Time)))
+	}
+}
+
+func (c *Controller) failRun(vmi *virtv1.VirtualMachineInstance, reason string) {
+	phase := c.run.pendingPhase()
+	c.run.failed = true
+	metrics.CanaryPhaseFailed(phase)
+	c.recorder.Eventf(vmi, k8sv1.EventTypeWarning, FailedCanaryReason, "Canary run failed in phase %s: %s", phase, reason)
+}
+
+// finishRun records the result of the run once its canary VirtualMachineInstance is gone
+func (c *Controller) finishRun() {
+	defer func() { c.run = nil }()
+	if c.run.aborted {
+		return
+	}
+
+	if c.run.deleteStarted.IsZero() {
+		// The canary was deleted by someone else before the run finished
+		c.run.failed = true
+		metrics.CanaryPhaseFailed(c.run.pendingPhase())
+	} else if !c.run.deleteFailed {
+		metrics.CanaryPhaseSucceeded(PhaseDelete, c.clock.Since(c.run.deleteStarted))
+	}
+	metrics.SetCanaryLastRunSucceeded(c.run.ready() && !c.run.failed && !c.run.deleteFailed)
+}
+
+func (c *Controller) createCanary(conf *virtv1.CanaryConfiguration, namespace string) (time.Duration, error) {
+	c.lastStart = c.clock.Now()
+	vmi, err := c.clientset.VirtualMachineInstance(namespace).
+		Create(context.Background(), newCanaryVMI(conf, namespace), metav1.CreateOptions{})
+	if err != nil {
+		// The next run starts after the interval, there is no point in retrying before
+		log.Log.Reason(err).Errorf("Failed to create the canary VirtualMachineInstance in namespace %s", namespace)
+		metrics.CanaryPhaseFailed(PhaseCreate)
+		metrics.SetCanaryLastRunSucceeded(false)
+		return interval(conf), nil
+	}
+	metrics.CanaryPhaseSucceeded(PhaseCreate, c.clock.Since(c.lastStart))
+
+	c.run = &run{uid: vmi.UID, namespace: namespace}
+	return timeout(conf), nil
+}
+
+func (c *Controller) deleteCanary(vmi *virtv1.VirtualMachineInstance) (time.Duration, error) {
+	if c.run.deleteStarted.IsZero() {
+		c.run.deleteStarted = c.clock.Now()
+	}
+	err := c.clientset.VirtualMachineInstance(vmi.Namespace).Delete(context.Background(), vmi.Name, metav1.DeleteOptions{
+		Preconditions: &metav1.Preconditions{UID: &vmi.UID},
+	})
+	if err != nil && !errors.IsNotFound(err) {
+		return 0, err
+	}
+	return deletionRecheckInterval, nil
+}
+
+func newCanaryVMI(conf *virtv1.CanaryConfiguration, namespace string) *virtv1.VirtualMachineInstance {
+	memory := DefaultMemory
+	if conf.Memory != nil {
+		memory = *conf.Memory
+	}
+	return &virtv1.VirtualMachineInstance{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      Name,
+			Namespace: namespace,
+			Labels:    map[string]string{LabelKey: ""},
+		},
+		Spec: virtv1.VirtualMachineInstanceSpec{
+			Domain: virtv1.DomainSpec{
+				Resources: virtv1.ResourceRequirements{
+					Requests: k8sv1.ResourceList{k8sv1.ResourceMemory: memory},
+				},
+				Devices: virtv1.Devices{
+					Disks: []virtv1.Disk{{
+						Name:       diskName,
+						DiskDevice: virtv1.DiskDevice{Disk: &virtv1.DiskTarget{Bus: virtv1.DiskBusVirtio}},
+					}},
+				},
+			},
+			Volumes: []virtv1.Volume{{
+				Name:         diskName,
+				VolumeSource: virtv1.VolumeSource{ContainerDisk: &virtv1.ContainerDiskSource{Image: conf.Image}},
+			}},
+			NodeSelector:                  conf.NodeSelector,
+			ReadinessProbe:                conf.ReadinessProbe.DeepCopy(),
+			TerminationGracePeriodSeconds: pointer.P(int64(0)),
+		},
+	}
+}
+
+func phaseTransitionTime(vmi *virtv1.VirtualMachineInstance, phase virtv1.VirtualMachineInstancePhase) *metav1.Time {
+	for i := range vmi.Status.PhaseTransitionTimestamps {
+		if vmi.Status.PhaseTransitionTimestamps[i].Phase == phase {
+			return &vmi.Status.PhaseTransitionTimestamps[i].PhaseTransitionTimestamp
+		}
+	}
+	return nil
+}
+
+func readyTime(vmi *virtv1.VirtualMachineInstance) *metav1.Time {
+	for i := range vmi.Status.Conditions {
+		condition := &vmi.Status.Conditions[i]
+		if condition.Type == virtv1.VirtualMachineInstanceReady && condition.Status == k8sv1.ConditionTrue {
+			return &condition.LastTransitionTime
+		}
+	}
+	return nil
+}
+
+func interval(conf *virtv1.CanaryConfiguration) time.Duration {
+	if conf.Interval != nil && conf.Interval.Duration > 0 {
+		return conf.Interval.Duration
+	}
+	return DefaultInterval
+}
+
+func timeout(conf *virtv1.CanaryConfiguration) time.Duration {
+	if conf != nil && conf.Timeout != nil && conf.Timeout.Duration > 0 {
+		return conf.Timeout.Duration
+	}
+	return DefaultTimeout
+}
+
+func nonNegative(duration time.Duration) time.Duration {
+	// Transitions can be reported slightly out of order because of time skew between components
+	if duration < 0 {
+		return 0
+	}
+	return duration
+}
@@ -0,0 +1,269 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package canary
+
+import (
+	"context"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+	clocktesting "k8s.io/utils/clock/testing"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+	kubevirtfake "kubevirt.io/client-go/kubevirt/fake"
+
+	metrics "kubevirt.io/kubevirt/pkg/monitoring/metrics/virt-controller"
+	"kubevirt.io/kubevirt/pkg/testutils"
+)
+
+var _ = Describe("Canary controller", func() {
+	const kubevirtNamespace = "kubevirt"
+
+	var (
+		controller    *Controller
+		recorder      *record.FakeRecorder
+		virtClientset *kubevirtfake.Clientset
+		vmiStore      cache.Store
+		fakeClock     *clocktesting.FakeClock
+	)
+
+	canaryConf := &v1.CanaryConfiguration{
+		Image:        "registry:5000/kubevirt/cirros-container-disk-demo:devel",
+		Interval:     &metav1.Duration{Duration: 10 * time.Minute},
+		Timeout:      &metav1.Duration{Duration: 3 * time.Minute},
+		NodeSelector: map[string]string{"canary": "true"},
+	}
+
+	setupController := func(conf *v1.CanaryConfiguration) {
+		virtClient := kubecli.NewMockKubevirtClient(gomock.NewController(GinkgoT()))
+		virtClientset = kubevirtfake.NewSimpleClientset()
+		virtClient.EXPECT().VirtualMachineInstance(gomock.Any()).DoAndReturn(func(namespace string) kubecli.VirtualMachineInstanceInterface {
+			return virtClientset.KubevirtV1().VirtualMachineInstances(namespace)
+		}).AnyTimes()
+
+		vmiInformer, _ := testutils.NewFakeInformerFor(&v1.VirtualMachineInstance{})
+		vmiStore = vmiInformer.GetStore()
+		recorder = record.NewFakeRecorder(100)
+		config, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{CanaryConfiguration: conf})
+		fakeClock = clocktesting.NewFakeClock(time.Now().Truncate(time.Second))
+
+		var err error
+		controller, err = NewControllerWithClock(virtClient, vmiInformer, recorder, config, kubevirtNamespace, fakeClock)
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	// syncStore copies the canary VirtualMachineInstance from the API to the informer store
+	syncStore := func() *v1.VirtualMachineInstance {
+		vmi, err := virtClientset.KubevirtV1().VirtualMachineInstances(kubevirtNamespace).Get(context.Background(), Name, metav1.GetOptions{})
+		if errors.IsNotFound(err) {
+			Expect(vmiStore.Replace(nil, "")).To(Succeed())
+			return nil
+		}
+		Expect(err).ToNot(HaveOccurred())
+		if vmi.UID == "" {
+			// The fake clientset does not set them
+			vmi.UID = "canary-uid"
+			vmi.CreationTimestamp = metav1.NewTime(fakeClock.Now())
+			vmi, err = virtClientset.KubevirtV1().VirtualMachineInstances(kubevirtNamespace).
+				Update(context.Background(), vmi, metav1.UpdateOptions{})
+			Expect(err).ToNot(HaveOccurred())
+		}
+		Expect(vmiStore.Update(vmi)).To(Succeed())
+		return vmi
+	}
+
+	execute := func() time.Duration {
+		requeueAfter, err := controller.execute()
+		Expect(err).ToNot(HaveOccurred())
+		return requeueAfter
+	}
+
+	phasesTotal := func(phase, result string) float64 {
+		value, err := metrics.GetCanaryPhasesTotal(phase, result)
+		Expect(err).ToNot(HaveOccurred())
+		return value
+	}
+
+	lastRunSucceeded := func() float64 {
+		value, err := metrics.GetCanaryLastRunSucceeded()
+		Expect(err).ToNot(HaveOccurred())
+		return value
+	}
+
+	toPhases := func(vmi *v1.VirtualMachineInstance, phases ...v1.VirtualMachineInstancePhase) {
+		for i, phase := range phases {
+			vmi.Status.Phase = phase
+			vmi.Status.PhaseTransitionTimestamps = append(vmi.Status.PhaseTransitionTimestamps, v1.VirtualMachineInstancePhaseTransitionTimestamp{
+				Phase:                    phase,
+				PhaseTransitionTimestamp: metav1.NewTime(vmi.CreationTimestamp.Add(time.Duration(i+1) * 10 * time.Second)),
+			})
+		}
+		Expect(vmiStore.Update(vmi)).To(Succeed())
+	}
+
+	setReady := func(vmi *v1.VirtualMachineInstance) {
+		vmi.Status.Conditions = append(vmi.Status.Conditions, v1.VirtualMachineInstanceCondition{
+			Type:               v1.VirtualMachineInstanceReady,
+			Status:             k8sv1.ConditionTrue,
+			LastTransitionTime: metav1.NewTime(vmi.CreationTimestamp.Add(45 * time.Second)),
+		})
+		Expect(vmiStore.Update(vmi)).To(Succeed())
+	}
+
+	It("should not create a canary if it is not configured", func() {
+		setupController(nil)
+		Expect(execute()).To(Equal(disabledRecheckInterval))
+
+		vmis, err := virtClientset.KubevirtV1().VirtualMachineInstances(kubevirtNamespace).List(context.Background(), metav1.ListOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(vmis.Items).To(BeEmpty())
+	})
+
+	It("should create the canary VirtualMachineInstance", func() {
+		setupController(canaryConf)
+		created := phasesTotal(PhaseCreate, metrics.CanaryResultSucceeded)
+		Expect(execute()).To(Equal(canaryConf.Timeout.Duration))
+
+		vmi := syncStore()
+		Expect(vmi).ToNot(BeNil())
+		Expect(vmi.Labels).To(HaveKey(LabelKey))
+		Expect(vmi.Spec.Volumes[0].ContainerDisk.Image).To(Equal(canaryConf.Image))
+		Expect(vmi.Spec.NodeSelector).To(Equal(canaryConf.NodeSelector))
+		Expect(vmi.Spec.Domain.Resources.Requests[k8sv1.ResourceMemory]).To(Equal(resource.MustParse("128Mi")))
+		Expect(phasesTotal(PhaseCreate, metrics.CanaryResultSucceeded)).To(Equal(created + 1))
+	})
+
+	It("should create the canary VirtualMachineInstance in the configured namespace", func() {
+		conf := canaryConf.DeepCopy()
+		conf.Namespace = "canaries"
+		setupController(conf)
+		execute()
+
+		_, err := virtClientset.KubevirtV1().VirtualMachineInstances("canaries").Get(context.Background(), Name, metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("should record the phases of a successful run", func() {
+		setupController(canaryConf)
+		scheduled := phasesTotal(PhaseSchedule, metrics.CanaryResultSucceeded)
+		booted := phasesTotal(PhaseBoot, metrics.CanaryResultSucceeded)
+		probed := phasesTotal(PhaseProbe, metrics.CanaryResultSucceeded)
+		deleted := phasesTotal(PhaseDelete, metrics.CanaryResultSucceeded)
+
+		execute()
+		vmi := syncStore()
+		toPhases(vmi, v1.Scheduling, v1.Scheduled, v1.Running)
+		fakeClock.Step(40 * time.Second)
+		execute()
+		Expect(phasesTotal(PhaseSchedule, metrics.CanaryResultSucceeded)).To(Equal(scheduled + 1))
+		Expect(phasesTotal(PhaseBoot, metrics.CanaryResultSucceeded)).To(Equal(booted + 1))
+		Expect(phasesTotal(PhaseProbe, metrics.CanaryResultSucceeded)).To(Equal(probed))
+
+		setReady(vmi)
+		fakeClock.Step(10 * time.Second)
+		Expect(execute()).To(Equal(deletionRecheckInterval))
+		Expect(phasesTotal(PhaseProbe, metrics.CanaryResultSucceeded)).To(Equal(probed + 1))
+		Expect(syncStore()).To(BeNil())
+
+		fakeClock.Step(5 * time.Second)
+		Expect(execute()).To(Equal(canaryConf.Interval.Duration - 55*time.Second))
+		Expect(phasesTotal(PhaseDelete, metrics.CanaryResultSucceeded)).To(Equal(deleted + 1))
+		Expect(lastRunSucceeded()).To(Equal(float64(1)))
+	})
+
+	It("should start the next run once the interval passed", func() {
+		setupController(canaryConf)
+		execute()
+		vmi := syncStore()
+		toPhases(vmi, v1.Scheduling, v1.Scheduled, v1.Running)
+		setReady(vmi)
+		execute()
+		syncStore()
+		execute()
+
+		fakeClock.Step(canaryConf.Interval.Duration)
+		Expect(execute()).To(Equal(canaryConf.Timeout.Duration))
+		Expect(syncStore()).ToNot(BeNil())
+	})
+
+	It("should fail the run if the canary does not become ready in time", func() {
+		setupController(canaryConf)
+		failed := phasesTotal(PhaseBoot, metrics.CanaryResultFailed)
+
+		execute()
+		vmi := syncStore()
+		toPhases(vmi, v1.Scheduling, v1.Scheduled)
+		fakeClock.Step(time.Minute)
+		Expect(execute()).To(Equal(2 * time.Minute))
+
+		fakeClock.Step(2 * time.Minute)
+		Expect(execute()).To(Equal(deletionRecheckInterval))
+		Expect(phasesTotal(PhaseBoot, metrics.CanaryResultFailed)).To(Equal(failed + 1))
+		testutils.ExpectEvent(recorder, FailedCanaryReason)
+
+		Expect(syncStore()).To(BeNil())
+		execute()
+		Expect(lastRunSucceeded()).To(Equal(float64(0)))
+	})
+
+	It("should fail the run if the canary fails", func() {
+		setupController(canaryConf)
+		failed := phasesTotal(PhaseSchedule, metrics.CanaryResultFailed)
+
+		execute()
+		vmi := syncStore()
+		toPhases(vmi, v1.Scheduling, v1.Failed)
+		Expect(execute()).To(Equal(deletionRecheckInterval))
+		Expect(phasesTotal(PhaseSchedule, metrics.CanaryResultFailed)).To(Equal(failed + 1))
+		testutils.ExpectEvent(recorder, FailedCanaryReason)
+	})
+
+	It("should delete the canary without a result once it is disabled", func() {
+		setupController(canaryConf)
+		execute()
+		Expect(syncStore()).ToNot(BeNil())
+
+		controller.clusterConfig, _, _ = testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{})
+		Expect(execute()).To(Equal(deletionRecheckInterval))
+		Expect(syncStore()).To(BeNil())
+		Expect(execute()).To(Equal(disabledRecheckInterval))
+		Expect(controller.run).To(BeNil())
+	})
+
+	It("should not touch a VirtualMachineInstance which is not a canary", func() {
+		setupController(canaryConf)
+		vmi := newCanaryVMI(canaryConf, kubevirtNamespace)
+		vmi.Labels = nil
+		Expect(vmiStore.Add(vmi)).To(Succeed())
+
+		_, err := controller.execute()
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -0,0 +1,163 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package circuitbreaker
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/utils/clock"
+
+	"kubevirt.io/client-go/log"
+
+	metrics "kubevirt.io/kubevirt/pkg/monitoring/metrics/virt-controller"
+)
+
+const (
+	// DefaultErrorBudget is the number of failed reconciles within the window after which the circuit breaker opens
+	DefaultErrorBudget = 50
+	// DefaultWindow is the sliding window the failed reconciles are counted in
+	DefaultWindow = 5 * time.Minute
+	// DefaultCooldown is how long the reconciles of a controller are paused once its circuit breaker opened
+	DefaultCooldown = 2 * time.Minute
+)
+
+// CircuitBreaker pauses the reconciles of a controller which keeps failing, e.g. because of an external dependency
+// being unavailable, instead of hot-looping on the failing keys. Once the cooldown passed the breaker is half-open:
+// the next successful reconcile closes it, the next failed one opens it again.
+type CircuitBreaker struct {
+	controller string
+	budget     int
+	window     time.Duration
+	cooldown   time.Duration
+	clock      clock.Clock
+
+	lock        sync.Mutex
+	failures    []time.Time
+	openUntil   time.Time
+	openedAfter int
+	halfOpen    bool
+	lastError   error
+}
+
+func New(controller string) *CircuitBreaker {
+	return NewWithClock(controller, DefaultErrorBudget, DefaultWindow, DefaultCooldown, clock.RealClock{})
+}
+
+func NewWithClock(controller string, budget int, window, cooldown time.Duration, clock clock.Clock) *CircuitBreaker {
+	metrics.SetCircuitBreakerOpen(controller, false)
+	metrics.SetErrorBudgetBurnRatio(controller, 0)
+	return &CircuitBreaker{
+		controller: controller,
+		budget:     budget,
+		window:     window,
+		cooldown:   cooldown,
+		clock:      clock,
+	}
+}
+
+func (b *CircuitBreaker) Controller() string {
+	return b.controller
+}
+
+// Allow returns zero if a reconcile may run, or how long the circuit breaker stays open
+func (b *CircuitBreaker) Allow() time.Duration {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	if b.openUntil.IsZero() {
+		return 0
+	}
+	if remaining := b.openUntil.Sub(b.clock.Now()); remaining > 0 {
+		return remaining
+	}
+
+	b.openUntil = time.Time{}
+	b.failures = nil
+	b.halfOpen = true
+	metrics.SetCircuitBreakerOpen(b.controller, false)
+	metrics.SetErrorBudgetBurnRatio(b.controller, 0)
+	log.Log.Infof("circuit breaker of the %s controller is half-open, resuming reconciles", b.controller)
+	return 0
+}
+
+// Record counts the result of a reconcile against the error budget. Conflicts are part of the normal operation of
+// controllers and are not counted.
+func (b *CircuitBreaker) Record(err error) {
+	if err != nil && errors.IsConflict(err) {
+		return
+	}
+
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	if err == nil {
+		if b.halfOpen {
+			b.halfOpen = false
+			b.lastError = nil
+			log.Log.Infof("circuit breaker of the %s controller is closed", b.controller)
+		}
+		return
+	}
+	if !b.openUntil.IsZero() {
+		// Reconciles which started before the breaker opened
+		return
+	}
+
+	now := b.clock.Now()
+	metrics.IncReconcileErrors(b.controller)
+	b.lastError = err
+	b.failures = append(b.pruneFailures(now), now)
+	metrics.SetErrorBudgetBurnRatio(b.controller, float64(len(b.failures))/float64(b.budget))
+
+	if b.halfOpen || len(b.failures) >= b.budget {
+		b.open(now)
+	}
+}
+
+func (b *CircuitBreaker) open(now time.Time) {
+	b.openUntil = now.Add(b.cooldown)
+	b.openedAfter = len(b.failures)
+	b.halfOpen = false
+	metrics.SetCircuitBreakerOpen(b.controller, true)
+	log.Log.Reason(b.lastError).Errorf("circuit breaker of the %s controller is open, pausing reconciles for %s", b.controller, b.cooldown)
+}
+
+func (b *CircuitBreaker) pruneFailures(now time.Time) []time.Time {
+	idx := 0
+	for idx < len(b.failures) && now.Sub(b.failures[idx]) >= b.window {
+		idx++
+	}
+	return b.failures[idx:]
+}
+
+// Status returns whether the circuit breaker is open and a message about the failures which opened it
+func (b *CircuitBreaker) Status() (bool, string) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	if b.openUntil.IsZero() || !b.clock.Now().Before(b.openUntil) {
+		return false, ""
+	}
+	return true, fmt.Sprintf("%s controller paused until %s, %d reconciles failed within %s, last error: %v",
+		b.controller, b.openUntil.UTC().Format(time.RFC3339), b.openedAfter, b.window, b.lastError)
+}
@@ -0,0 +1,114 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package circuitbreaker_test
+
+import (
+	"errors"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	clocktesting "k8s.io/utils/clock/testing"
+
+	"kubevirt.io/kubevirt/pkg/virt-controller/watch/circuitbreaker"
+)
+
+var _ = Describe("CircuitBreaker", func() {
+	const (
+		budget   = 3
+		window   = time.Minute
+		cooldown = 30 * time.Second
+	)
+
+	var (
+		fakeClock *clocktesting.FakeClock
+		breaker   *circuitbreaker.CircuitBreaker
+		failure   error
+	)
+
+	BeforeEach(func() {
+		fakeClock = clocktesting.NewFakeClock(time.Now())
+		breaker = circuitbreaker.NewWithClock("test", budget, window, cooldown, fakeClock)
+		failure = errors.New("storage unavailable")
+	})
+
+	failTimes := func(n int) {
+		for i := 0; i < n; i++ {
+			Expect(breaker.Allow()).To(BeZero())
+			breaker.Record(failure)
+		}
+	}
+
+	It("should stay closed below the error budget", func() {
+		failTimes(budget - 1)
+		Expect(breaker.Allow()).To(BeZero())
+		open, _ := breaker.Status()
+		Expect(open).To(BeFalse())
+	})
+
+	It("should open once the error budget is used up", func() {
+		failTimes(budget)
+		Expect(breaker.Allow()).To(Equal(cooldown))
+
+		open, message := breaker.Status()
+		Expect(open).To(BeTrue())
+		Expect(message).To(ContainSubstring("test controller paused until"))
+		Expect(message).To(ContainSubstring("3 reconciles failed within 1m0s, last error: storage unavailable"))
+	})
+
+	It("should only count the failures within the window", func() {
+		failTimes(budget - 1)
+		fakeClock.Step(window)
+		failTimes(budget - 1)
+		Expect(breaker.Allow()).To(BeZero())
+	})
+
+	It("should not count conflicts", func() {
+		conflict := k8serrors.NewConflict(schema.GroupResource{Resource: "virtualmachinesnapshots"}, "snap", errors.New("modified"))
+		for i := 0; i < budget; i++ {
+			breaker.Record(conflict)
+		}
+		Expect(breaker.Allow()).To(BeZero())
+	})
+
+	It("should close after the cooldown when the next reconcile succeeds", func() {
+		failTimes(budget)
+		fakeClock.Step(cooldown)
+
+		Expect(breaker.Allow()).To(BeZero())
+		breaker.Record(nil)
+		failTimes(budget - 1)
+		Expect(breaker.Allow()).To(BeZero())
+	})
+
+	It("should open again after the cooldown when the next reconcile fails", func() {
+		failTimes(budget)
+		fakeClock.Step(cooldown)
+
+		failTimes(1)
+		Expect(breaker.Allow()).To(Equal(cooldown))
+		open, message := breaker.Status()
+		Expect(open).To(BeTrue())
+		Expect(message).To(ContainSubstring("1 reconciles failed"))
+	})
+})
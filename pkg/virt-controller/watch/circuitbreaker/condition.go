@@ -0,0 +1,147 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package circuitbreaker
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	k8sv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+
+	virtv1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
+)
+
+const (
+	ConditionReasonCircuitBreakerOpen = "CircuitBreakerOpen"
+	ConditionReasonControllersHealthy = "AllCircuitBreakersClosed"
+
+	conditionsPath = "/status/conditions"
+)
+
+// ConditionReporter reflects the open circuit breakers in the ControllersDegraded condition of the KubeVirt CR
+type ConditionReporter struct {
+	clientset     kubecli.KubevirtClient
+	kubeVirtStore cache.Store
+	breakers      []*CircuitBreaker
+}
+
+func NewConditionReporter(
+	clientset kubecli.KubevirtClient, kubeVirtInformer cache.SharedIndexInformer, breakers ...*CircuitBreaker,
+) *ConditionReporter {
+	return &ConditionReporter{
+		clientset:     clientset,
+		kubeVirtStore: kubeVirtInformer.GetStore(),
+		breakers:      breakers,
+	}
+}
+
+func (r *ConditionReporter) Run(period time.Duration, stopCh <-chan struct{}) {
+	wait.Until(func() {
+		if err := r.Sync(); err != nil {
+			log.Log.Reason(err).Warning("failed to report the circuit breakers of the controllers on the KubeVirt CR")
+		}
+	}, period, stopCh)
+}
+
+func (r *ConditionReporter) Sync() error {
+	kvs := r.kubeVirtStore.List()
+	if len(kvs) != 1 {
+		return nil
+	}
+	kv := kvs[0].(*virtv1.KubeVirt)
+
+	var messages []string
+	for _, breaker := range r.breakers {
+		if open, message := breaker.Status(); open {
+			messages = append(messages, message)
+		}
+	}
+
+	idx := -1
+	for i, condition := range kv.Status.Conditions {
+		if condition.Type == virtv1.KubeVirtConditionControllersDegraded {
+			idx = i
+			break
+		}
+	}
+	if idx < 0 && len(messages) == 0 {
+		return nil
+	}
+
+	desired := virtv1.KubeVirtCondition{
+		Type:    virtv1.KubeVirtConditionControllersDegraded,
+		Status:  k8sv1.ConditionFalse,
+		Reason:  ConditionReasonControllersHealthy,
+		Message: "All controllers are reconciling.",
+	}
+	if len(messages) > 0 {
+		desired.Status = k8sv1.ConditionTrue
+		desired.Reason = ConditionReasonCircuitBreakerOpen
+		desired.Message = strings.Join(messages, "; ")
+	}
+
+	now := metav1.Now()
+	conditions := append([]virtv1.KubeVirtCondition{}, kv.Status.Conditions...)
+	if idx < 0 {
+		desired.LastProbeTime = now
+		desired.LastTransitionTime = now
+		conditions = append(conditions, desired)
+	} else {
+		current := conditions[idx]
+		if current.Status == desired.Status && current.Reason == desired.Reason && current.Message == desired.Message {
+			return nil
+		}
+		desired.LastProbeTime = now
+		desired.LastTransitionTime = current.LastTransitionTime
+		if current.Status != desired.Status {
+			desired.LastTransitionTime = now
+		}
+		conditions[idx] = desired
+	}
+
+	patchSet := patch.New()
+	if kv.Status.Conditions == nil {
+		patchSet.AddOption(patch.WithAdd(conditionsPath, conditions))
+	} else {
+		patchSet.AddOption(
+			patch.WithTest(conditionsPath, kv.Status.Conditions),
+			patch.WithReplace(conditionsPath, conditions),
+		)
+	}
+	patchBytes, err := patchSet.GeneratePayload()
+	if err != nil {
+		return err
+	}
+	if _, err := r.clientset.KubeVirt(kv.Namespace).PatchStatus(
+		context.Background(), kv.Name, types.JSONPatchType, patchBytes, metav1.PatchOptions{}); err != nil {
+		return fmt.Errorf("unable to patch the %s condition of the KubeVirt CR: %v", virtv1.KubeVirtConditionControllersDegraded, err)
+	}
+	return nil
+}
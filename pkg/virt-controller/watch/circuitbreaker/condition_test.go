@@ -0,0 +1,119 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package circuitbreaker_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	k8sv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+	clocktesting "k8s.io/utils/clock/testing"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+	kubevirtfake "kubevirt.io/client-go/kubevirt/fake"
+
+	"kubevirt.io/kubevirt/pkg/testutils"
+	"kubevirt.io/kubevirt/pkg/virt-controller/watch/circuitbreaker"
+)
+
+var _ = Describe("ConditionReporter", func() {
+	var (
+		fakeClock        *clocktesting.FakeClock
+		breaker          *circuitbreaker.CircuitBreaker
+		kubeVirtInformer cache.SharedIndexInformer
+		fakeVirtClient   *kubevirtfake.Clientset
+		reporter         *circuitbreaker.ConditionReporter
+	)
+
+	BeforeEach(func() {
+		fakeClock = clocktesting.NewFakeClock(time.Now())
+		breaker = circuitbreaker.NewWithClock("snapshot", 1, time.Minute, time.Minute, fakeClock)
+
+		kubeVirtInformer, _ = testutils.NewFakeInformerFor(&v1.KubeVirt{})
+		fakeVirtClient = kubevirtfake.NewSimpleClientset()
+		virtClient := kubecli.NewMockKubevirtClient(gomock.NewController(GinkgoT()))
+		virtClient.EXPECT().KubeVirt(k8sv1.NamespaceDefault).Return(fakeVirtClient.KubevirtV1().KubeVirts(k8sv1.NamespaceDefault)).AnyTimes()
+
+		reporter = circuitbreaker.NewConditionReporter(virtClient, kubeVirtInformer, breaker)
+	})
+
+	addKubeVirt := func(conditions ...v1.KubeVirtCondition) {
+		kv := &v1.KubeVirt{
+			ObjectMeta: metav1.ObjectMeta{Name: "kubevirt", Namespace: k8sv1.NamespaceDefault},
+			Status:     v1.KubeVirtStatus{Conditions: conditions},
+		}
+		_, err := fakeVirtClient.KubevirtV1().KubeVirts(k8sv1.NamespaceDefault).Create(context.Background(), kv, metav1.CreateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(kubeVirtInformer.GetStore().Add(kv)).To(Succeed())
+	}
+
+	degradedCondition := func() *v1.KubeVirtCondition {
+		kv, err := fakeVirtClient.KubevirtV1().KubeVirts(k8sv1.NamespaceDefault).Get(context.Background(), "kubevirt", metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		for _, condition := range kv.Status.Conditions {
+			if condition.Type == v1.KubeVirtConditionControllersDegraded {
+				return &condition
+			}
+		}
+		return nil
+	}
+
+	It("should not add the condition while all circuit breakers are closed", func() {
+		addKubeVirt()
+		Expect(reporter.Sync()).To(Succeed())
+		Expect(degradedCondition()).To(BeNil())
+	})
+
+	It("should set the condition when a circuit breaker is open", func() {
+		available := v1.KubeVirtCondition{Type: v1.KubeVirtConditionAvailable, Status: k8sv1.ConditionTrue}
+		addKubeVirt(available)
+		breaker.Record(errors.New("storage unavailable"))
+
+		Expect(reporter.Sync()).To(Succeed())
+		condition := degradedCondition()
+		Expect(condition).ToNot(BeNil())
+		Expect(condition.Status).To(Equal(k8sv1.ConditionTrue))
+		Expect(condition.Reason).To(Equal(circuitbreaker.ConditionReasonCircuitBreakerOpen))
+		Expect(condition.Message).To(ContainSubstring("snapshot controller paused until"))
+		Expect(condition.Message).To(ContainSubstring("last error: storage unavailable"))
+	})
+
+	It("should clear the condition once the circuit breakers are closed", func() {
+		addKubeVirt(v1.KubeVirtCondition{
+			Type:   v1.KubeVirtConditionControllersDegraded,
+			Status: k8sv1.ConditionTrue,
+			Reason: circuitbreaker.ConditionReasonCircuitBreakerOpen,
+		})
+
+		Expect(reporter.Sync()).To(Succeed())
+		condition := degradedCondition()
+		Expect(condition).ToNot(BeNil())
+		Expect(condition.Status).To(Equal(k8sv1.ConditionFalse))
+		Expect(condition.Reason).To(Equal(circuitbreaker.ConditionReasonControllersHealthy))
+	})
+})
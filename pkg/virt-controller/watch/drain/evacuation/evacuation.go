@@ -435,7 +435,7 @@ func (c *EvacuationController) sync(node *k8sv1.Node, vmisOnNode []*virtv1.Virtu
 		return nil
 	}
 
-	// TODO: should the order be randomized?
+	sortByEvacuationPriority(migrationCandidates, c.vmiPodIndexer)
 	selectedCandidates := migrationCandidates[0:diff]
 
 	log.DefaultLogger().Infof("node: %v, migrations: %v, candidates: %v, selected: %v", node.Name, len(activeMigrations), len(migrationCandidates), len(selectedCandidates))
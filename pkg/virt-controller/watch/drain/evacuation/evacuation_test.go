@@ -10,8 +10,10 @@ import (
 	. "github.com/onsi/gomega"
 
 	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/kubernetes/fake"
 	"k8s.io/client-go/testing"
 	"k8s.io/client-go/tools/cache"
@@ -539,6 +541,128 @@ var _ = Describe("Evacuation", func() {
 		})
 	})
 
+	Context("evacuation order", func() {
+		addVMIWithPod := func(name string, priority int32, memory string) {
+			vmi := newVirtualMachineMarkedForEviction(name, "node01")
+			vmi.UID = types.UID(name)
+			vmi.Status.Memory = &v1.MemoryStatus{GuestCurrent: pointer.P(resource.MustParse(memory))}
+			pod := newPod(vmi, name+"-pod", k8sv1.PodRunning, true)
+			pod.OwnerReferences = []metav1.OwnerReference{*metav1.NewControllerRef(vmi, v1.VirtualMachineInstanceGroupVersionKind)}
+			pod.Spec.NodeName = "node01"
+			pod.Spec.Priority = pointer.P(priority)
+			podSource.Add(pod)
+			Eventually(func() bool {
+				_, exists, _ := podInformer.GetStore().Get(pod)
+				return exists
+			}).Should(BeTrue())
+			vmiFeeder.Add(vmi)
+		}
+
+		It("should migrate the VMIs with the highest priority and the smallest memory first", func() {
+			// The fake client does not generate names
+			fakeVirtClient.PrependReactor("create", "virtualmachineinstancemigrations", func(action testing.Action) (bool, runtime.Object, error) {
+				migration := action.(testing.CreateAction).GetObject().(*v1.VirtualMachineInstanceMigration)
+				migration.Name = migration.GenerateName + migration.Spec.VMIName
+				return false, nil, nil
+			})
+			addNode(newNode("node01"))
+			addVMIWithPod("large-low", 0, "64Gi")
+			addVMIWithPod("small-low", 0, "1Gi")
+			addVMIWithPod("large-high", 1000, "64Gi")
+
+			sanityExecute()
+			testutils.ExpectEvents(recorder,
+				SuccessfulCreateVirtualMachineInstanceMigrationReason,
+				SuccessfulCreateVirtualMachineInstanceMigrationReason,
+			)
+
+			migrationList, err := fakeVirtClient.KubevirtV1().VirtualMachineInstanceMigrations(k8sv1.NamespaceDefault).List(context.TODO(), metav1.ListOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(migrationList.Items).To(ConsistOf(
+				HaveField("Spec.VMIName", "large-high"),
+				HaveField("Spec.VMIName", "small-low"),
+			))
+		})
+
+		It("should fall back to the guest memory of the spec", func() {
+			vmi := newVirtualMachine("testvm", "node01")
+			vmi.Spec.Domain.Memory = &v1.Memory{Guest: pointer.P(resource.MustParse("2Gi"))}
+			Expect(memoryFootprint(vmi)).To(Equal(resource.MustParse("2Gi")))
+
+			vmi.Status.Memory = &v1.MemoryStatus{GuestCurrent: pointer.P(resource.MustParse("4Gi"))}
+			Expect(memoryFootprint(vmi)).To(Equal(resource.MustParse("4Gi")))
+		})
+	})
+
+	Context("evacuation progress", func() {
+		var kubeVirtInformer cache.SharedIndexInformer
+
+		BeforeEach(func() {
+			kubeVirtInformer, _ = testutils.NewFakeInformerFor(&v1.KubeVirt{})
+			kv := &v1.KubeVirt{ObjectMeta: metav1.ObjectMeta{Name: "kubevirt", Namespace: k8sv1.NamespaceDefault}}
+			_, err := fakeVirtClient.KubevirtV1().KubeVirts(k8sv1.NamespaceDefault).Create(context.Background(), kv, metav1.CreateOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(kubeVirtInformer.GetStore().Add(kv)).To(Succeed())
+			virtClient.EXPECT().KubeVirt(k8sv1.NamespaceDefault).Return(fakeVirtClient.KubevirtV1().KubeVirts(k8sv1.NamespaceDefault)).AnyTimes()
+		})
+
+		addEvacuatingVMI := func(name string, migratable bool) *v1.VirtualMachineInstance {
+			vmi := newVirtualMachineMarkedForEviction(name, "node01")
+			vmi.Status.Memory = &v1.MemoryStatus{GuestCurrent: pointer.P(resource.MustParse("1Gi"))}
+			if !migratable {
+				vmi.Status.Conditions[0].Status = k8sv1.ConditionFalse
+			}
+			vmiFeeder.Add(vmi)
+			return vmi
+		}
+
+		getKubeVirt := func() *v1.KubeVirt {
+			kv, err := fakeVirtClient.KubevirtV1().KubeVirts(k8sv1.NamespaceDefault).Get(context.Background(), "kubevirt", metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			return kv
+		}
+
+		It("should report the VMIs which still have to leave the node", func() {
+			addNode(newNode("node01"))
+			addNode(newNode("node02"))
+			migrating := addEvacuatingVMI("migrating", true)
+			migrationFeeder.Add(newMigration("mig", migrating.Name, v1.MigrationRunning))
+			addEvacuatingVMI("pending", true)
+			addEvacuatingVMI("notmigratable", false)
+			vmiFeeder.Add(newVirtualMachine("notevacuating", "node02"))
+
+			evacuations := controller.NodeEvacuations()
+			Expect(evacuations).To(HaveLen(1))
+			Expect(evacuations[0].Node).To(Equal("node01"))
+			Expect(evacuations[0].Pending).To(BeEquivalentTo(1))
+			Expect(evacuations[0].Migrating).To(BeEquivalentTo(1))
+			Expect(evacuations[0].NotMigratable).To(BeEquivalentTo(1))
+			Expect(evacuations[0].RemainingMemory.Cmp(resource.MustParse("3Gi"))).To(BeZero())
+		})
+
+		It("should publish the progress on the KubeVirt CR and clear it once the node is evacuated", func() {
+			updater := NewProgressUpdater(virtClient, controller, kubeVirtInformer)
+			addNode(newNode("node01"))
+			vmi := addEvacuatingVMI("pending", true)
+
+			Expect(updater.Sync()).To(Succeed())
+			kv := getKubeVirt()
+			Expect(kv.Status.NodeEvacuations).To(ConsistOf(HaveField("Node", "node01")))
+
+			Expect(kubeVirtInformer.GetStore().Update(kv)).To(Succeed())
+			fakeVirtClient.ClearActions()
+			Expect(updater.Sync()).To(Succeed())
+			Expect(fakeVirtClient.Actions()).To(BeEmpty())
+
+			vmi = vmi.DeepCopy()
+			vmi.Status.NodeName = "node02"
+			vmiFeeder.Modify(vmi)
+
+			Expect(updater.Sync()).To(Succeed())
+			Expect(getKubeVirt().Status.NodeEvacuations).To(BeEmpty())
+		})
+	})
+
 	AfterEach(func() {
 		close(stop)
 		// Ensure that we add checks for expected events to every test
@@ -0,0 +1,82 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package evacuation
+
+import (
+	"sort"
+
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/client-go/tools/cache"
+
+	virtv1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/controller"
+)
+
+// sortByEvacuationPriority orders the VMIs to evacuate by the priority of their virt-launcher pod, highest first.
+// VMIs of the same priority are ordered by their memory footprint, smallest first, so that a few large VMIs
+// don't hold back the evacuation of all the others.
+func sortByEvacuationPriority(vmis []*virtv1.VirtualMachineInstance, podIndexer cache.Indexer) {
+	priorities := make(map[*virtv1.VirtualMachineInstance]int32, len(vmis))
+	memory := make(map[*virtv1.VirtualMachineInstance]int64, len(vmis))
+	for _, vmi := range vmis {
+		priorities[vmi] = podPriority(vmi, podIndexer)
+		footprint := memoryFootprint(vmi)
+		memory[vmi] = footprint.Value()
+	}
+
+	sort.SliceStable(vmis, func(i, j int) bool {
+		a, b := vmis[i], vmis[j]
+		if priorities[a] != priorities[b] {
+			return priorities[a] > priorities[b]
+		}
+		if memory[a] != memory[b] {
+			return memory[a] < memory[b]
+		}
+		if a.Namespace != b.Namespace {
+			return a.Namespace < b.Namespace
+		}
+		return a.Name < b.Name
+	})
+}
+
+// podPriority returns the priority the scheduler resolved from the priority class of the VMI
+func podPriority(vmi *virtv1.VirtualMachineInstance, podIndexer cache.Indexer) int32 {
+	pod, err := controller.CurrentVMIPod(vmi, podIndexer)
+	if err != nil || pod == nil || pod.Spec.Priority == nil {
+		return 0
+	}
+	return *pod.Spec.Priority
+}
+
+// memoryFootprint returns the guest memory which has to be transferred to migrate the VMI
+func memoryFootprint(vmi *virtv1.VirtualMachineInstance) resource.Quantity {
+	if vmi.Status.Memory != nil && vmi.Status.Memory.GuestCurrent != nil {
+		return *vmi.Status.Memory.GuestCurrent
+	}
+	if vmi.Spec.Domain.Memory != nil && vmi.Spec.Domain.Memory.Guest != nil {
+		return *vmi.Spec.Domain.Memory.Guest
+	}
+	if memory, ok := vmi.Spec.Domain.Resources.Requests[k8sv1.ResourceMemory]; ok {
+		return memory
+	}
+	return resource.Quantity{}
+}
@@ -0,0 +1,184 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package evacuation
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/resource"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+
+	virtv1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
+	"kubevirt.io/kubevirt/pkg/controller"
+	metrics "kubevirt.io/kubevirt/pkg/monitoring/metrics/virt-controller"
+	migrationutils "kubevirt.io/kubevirt/pkg/util/migrations"
+)
+
+const nodeEvacuationsPath = "/status/nodeEvacuations"
+
+// ProgressUpdater reports the progress of the nodes which are being evacuated in the status of the KubeVirt CR
+// and the matching metrics
+type ProgressUpdater struct {
+	clientset        kubecli.KubevirtClient
+	evacuation       *EvacuationController
+	kubeVirtInformer cache.SharedIndexInformer
+}
+
+func NewProgressUpdater(
+	clientset kubecli.KubevirtClient,
+	evacuation *EvacuationController,
+	kubeVirtInformer cache.SharedIndexInformer,
+) *ProgressUpdater {
+	return &ProgressUpdater{
+		clientset:        clientset,
+		evacuation:       evacuation,
+		kubeVirtInformer: kubeVirtInformer,
+	}
+}
+
+func (u *ProgressUpdater) Run(period time.Duration, stopCh <-chan struct{}) {
+	cache.WaitForCacheSync(stopCh, u.evacuation.hasSynced, u.kubeVirtInformer.HasSynced)
+	wait.Until(func() {
+		if err := u.Sync(); err != nil {
+			log.Log.Reason(err).Warning("failed to update the evacuation progress of the KubeVirt CR")
+		}
+	}, period, stopCh)
+}
+
+func (u *ProgressUpdater) Sync() error {
+	evacuations := u.evacuation.NodeEvacuations()
+	metrics.SetNodeEvacuations(evacuations)
+
+	kvs := u.kubeVirtInformer.GetStore().List()
+	if len(kvs) != 1 {
+		return nil
+	}
+	kv := kvs[0].(*virtv1.KubeVirt)
+	if equalIgnoringUpdateTime(kv.Status.NodeEvacuations, evacuations) {
+		return nil
+	}
+
+	var patchSet *patch.PatchSet
+	if len(evacuations) == 0 {
+		patchSet = patch.New(patch.WithRemove(nodeEvacuationsPath))
+	} else {
+		patchSet = patch.New(patch.WithAdd(nodeEvacuationsPath, evacuations))
+	}
+	patchBytes, err := patchSet.GeneratePayload()
+	if err != nil {
+		return err
+	}
+	if _, err := u.clientset.KubeVirt(kv.Namespace).PatchStatus(
+		context.Background(), kv.Name, types.JSONPatchType, patchBytes, v1.PatchOptions{}); err != nil {
+		return fmt.Errorf("unable to patch the evacuation progress of the KubeVirt CR: %v", err)
+	}
+	return nil
+}
+
+func equalIgnoringUpdateTime(current, evacuations []virtv1.NodeEvacuationStatus) bool {
+	if len(current) != len(evacuations) {
+		return false
+	}
+	for i := range current {
+		unchanged := current[i].DeepCopy()
+		unchanged.LastUpdateTime = evacuations[i].LastUpdateTime
+		if !equality.Semantic.DeepEqual(*unchanged, evacuations[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// NodeEvacuations returns the progress of all the nodes which still have VMIs to evacuate, ordered by node name
+func (c *EvacuationController) NodeEvacuations() []virtv1.NodeEvacuationStatus {
+	taint := &k8sv1.Taint{
+		Key:    *c.clusterConfig.GetMigrationConfiguration().NodeDrainTaintKey,
+		Effect: k8sv1.TaintEffectNoSchedule,
+	}
+	migrating := map[string]bool{}
+	for _, migration := range migrationutils.ListUnfinishedMigrations(c.migrationStore) {
+		migrating[migration.Namespace+"/"+migration.Spec.VMIName] = true
+	}
+	conditionManager := controller.NewVirtualMachineInstanceConditionManager()
+	now := v1.Now()
+
+	var evacuations []virtv1.NodeEvacuationStatus
+	for _, obj := range c.nodeStore.List() {
+		node := obj.(*k8sv1.Node)
+		vmis, err := c.listVMIsOnNode(node.Name)
+		if err != nil {
+			log.Log.Reason(err).Warningf("failed to list the VMIs on node %s", node.Name)
+			continue
+		}
+
+		evacuation := virtv1.NodeEvacuationStatus{Node: node.Name, LastUpdateTime: now}
+		remainingMemory := resource.Quantity{}
+		for _, vmi := range evacuatingVMIs(node, vmis, taint) {
+			if vmi.IsFinal() || vmi.DeletionTimestamp != nil || !migrationutils.VMIMigratableOnEviction(c.clusterConfig, vmi) {
+				continue
+			}
+			switch {
+			case migrating[vmi.Namespace+"/"+vmi.Name]:
+				evacuation.Migrating++
+			case !conditionManager.HasConditionWithStatus(vmi, virtv1.VirtualMachineInstanceIsMigratable, k8sv1.ConditionTrue):
+				evacuation.NotMigratable++
+			default:
+				evacuation.Pending++
+			}
+			remainingMemory.Add(memoryFootprint(vmi))
+		}
+		if evacuation.Pending+evacuation.Migrating+evacuation.NotMigratable == 0 {
+			continue
+		}
+		evacuation.RemainingMemory = &remainingMemory
+		evacuations = append(evacuations, evacuation)
+	}
+
+	sort.Slice(evacuations, func(i, j int) bool {
+		return evacuations[i].Node < evacuations[j].Node
+	})
+	return evacuations
+}
+
+// evacuatingVMIs returns the VMIs which still have to leave the node, including the ones which are migrating already
+func evacuatingVMIs(node *k8sv1.Node, vmisOnNode []*virtv1.VirtualMachineInstance, taint *k8sv1.Taint) []*virtv1.VirtualMachineInstance {
+	if nodeHasTaint(taint, node) {
+		return vmisOnNode
+	}
+	var evacuating []*virtv1.VirtualMachineInstance
+	for _, vmi := range vmisOnNode {
+		if vmi.IsMarkedForEviction() && !hasMigratedOnEviction(vmi) {
+			evacuating = append(evacuating, vmi)
+		}
+	}
+	return evacuating
+}
@@ -0,0 +1,356 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package firewall
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	k8sv1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
+
+	virtv1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/controller"
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
+)
+
+const (
+	SuccessfulCreateNetworkPolicyReason = "SuccessfulCreateFirewallNetworkPolicy"
+	SuccessfulDeleteNetworkPolicyReason = "SuccessfulDeleteFirewallNetworkPolicy"
+	FailedCreateNetworkPolicyReason     = "FailedCreateFirewallNetworkPolicy"
+	InvalidFirewallReason               = "InvalidFirewall"
+
+	networkPolicyNameSuffix = "-firewall"
+)
+
+// Controller keeps a NetworkPolicy enforcing the firewall of every VMI with the FirewallAnnotation.
+// The NetworkPolicy selects the virt-launcher pods of the VMI, including the target pod of a migration,
+// and is garbage collected with the VMI. Until the NetworkPolicy is created, the traffic of the
+// virt-launcher pod is only restricted by the other NetworkPolicies of the namespace.
+type Controller struct {
+	clientset          kubecli.KubevirtClient
+	queue              workqueue.TypedRateLimitingInterface[string]
+	vmiStore           cache.Store
+	networkPolicyStore cache.Store
+	recorder           record.EventRecorder
+	clusterConfig      *virtconfig.ClusterConfig
+	hasSynced          func() bool
+}
+
+// NewController creates a new instance of the firewall Controller.
+func NewController(clientset kubecli.KubevirtClient,
+	vmiInformer cache.SharedIndexInformer,
+	networkPolicyInformer cache.SharedIndexInformer,
+	recorder record.EventRecorder,
+	clusterConfig *virtconfig.ClusterConfig) (*Controller, error) {
+	c := &Controller{
+		clientset: clientset,
+		queue: workqueue.NewTypedRateLimitingQueueWithConfig[string](
+			workqueue.DefaultTypedControllerRateLimiter[string](),
+			workqueue.TypedRateLimitingQueueConfig[string]{Name: "virt-controller-firewall"},
+		),
+		vmiStore:           vmiInformer.GetStore(),
+		networkPolicyStore: networkPolicyInformer.GetStore(),
+		recorder:           recorder,
+		clusterConfig:      clusterConfig,
+	}
+
+	c.hasSynced = func() bool {
+		return vmiInformer.HasSynced() && networkPolicyInformer.HasSynced()
+	}
+
+	_, err := vmiInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    c.enqueue,
+		DeleteFunc: c.enqueue,
+		UpdateFunc: func(_, curr interface{}) { c.enqueue(curr) },
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = networkPolicyInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    c.handleNetworkPolicy,
+		DeleteFunc: c.handleNetworkPolicy,
+		UpdateFunc: func(_, curr interface{}) { c.handleNetworkPolicy(curr) },
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+func (c *Controller) enqueue(obj interface{}) {
+	key, err := controller.KeyFunc(obj)
+	if err != nil {
+		log.Log.Reason(err).Error("Failed to extract key from object.")
+		return
+	}
+	// Not delayed, the virt-launcher pod is unprotected until the NetworkPolicy exists
+	c.queue.Add(key)
+}
+
+// handleNetworkPolicy enqueues the VMI a NetworkPolicy belongs to, so that changes made by others are reverted
+func (c *Controller) handleNetworkPolicy(obj interface{}) {
+	if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+		obj = tombstone.Obj
+	}
+	networkPolicy, ok := obj.(*networkingv1.NetworkPolicy)
+	if !ok {
+		return
+	}
+	owner := metav1.GetControllerOf(networkPolicy)
+	if owner == nil || owner.Kind != virtv1.VirtualMachineInstanceGroupVersionKind.Kind {
+		return
+	}
+	c.queue.Add(controller.NamespacedKey(networkPolicy.Namespace, owner.Name))
+}
+
+func (c *Controller) Run(threadiness int, stopCh <-chan struct{}) {
+	defer controller.HandlePanic()
+	defer c.queue.ShutDown()
+	log.Log.Info("Starting firewall controller.")
+
+	cache.WaitForCacheSync(stopCh, c.hasSynced)
+
+	for i := 0; i < threadiness; i++ {
+		go wait.Until(c.runWorker, time.Second, stopCh)
+	}
+
+	<-stopCh
+	log.Log.Info("Stopping firewall controller.")
+}
+
+func (c *Controller) runWorker() {
+	for c.Execute() {
+	}
+}
+
+// Execute runs commands from the controller queue, if there is
+// an error it requeues the command. Returns false if the queue
+// is empty.
+func (c *Controller) Execute() bool {
+	key, quit := c.queue.Get()
+	if quit {
+		return false
+	}
+	defer c.queue.Done(key)
+
+	if err := c.execute(key); err != nil {
+		log.Log.Reason(err).Infof("reenqueuing firewall of VMI %v", key)
+		c.queue.AddRateLimited(key)
+	} else {
+		log.Log.V(4).Infof("processed firewall of VMI %v", key)
+		c.queue.Forget(key)
+	}
+	return true
+}
+
+func (c *Controller) execute(key string) error {
+	obj, exists, err := c.vmiStore.GetByKey(key)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		// The NetworkPolicy is garbage collected with the VMI
+		return nil
+	}
+	vmi := obj.(*virtv1.VirtualMachineInstance)
+
+	value, configured := vmi.Annotations[virtv1.FirewallAnnotation]
+	if !configured || !c.clusterConfig.VMFirewallEnabled() {
+		return c.deleteNetworkPolicy(vmi)
+	}
+	if vmi.IsFinal() {
+		return nil
+	}
+
+	spec, err := parseSpec(value)
+	if err != nil {
+		// The current NetworkPolicy, if any, is kept in place rather than opening the VMI to all traffic
+		c.recorder.Eventf(vmi, k8sv1.EventTypeWarning, InvalidFirewallReason,
+			"The annotation %s is not a valid firewall: %v", virtv1.FirewallAnnotation, err)
+		return nil
+	}
+
+	if errs := validation.IsDNS1123Subdomain(networkPolicyName(vmi.Name)); len(errs) > 0 {
+		c.recorder.Eventf(vmi, k8sv1.EventTypeWarning, FailedCreateNetworkPolicyReason,
+			"The firewall of VMI %s can not be enforced by a NetworkPolicy: %s", vmi.Name, strings.Join(errs, ", "))
+		return nil
+	}
+
+	return c.syncNetworkPolicy(vmi, spec)
+}
+
+func networkPolicyName(vmiName string) string {
+	return vmiName + networkPolicyNameSuffix
+}
+
+func (c *Controller) deleteNetworkPolicy(vmi *virtv1.VirtualMachineInstance) error {
+	name := networkPolicyName(vmi.Name)
+	obj, exists, err := c.networkPolicyStore.GetByKey(controller.NamespacedKey(vmi.Namespace, name))
+	if err != nil || !exists {
+		return err
+	}
+	networkPolicy := obj.(*networkingv1.NetworkPolicy)
+	if !metav1.IsControlledBy(networkPolicy, vmi) || networkPolicy.DeletionTimestamp != nil {
+		return nil
+	}
+	err = c.clientset.NetworkingV1().NetworkPolicies(vmi.Namespace).Delete(context.Background(), name, metav1.DeleteOptions{})
+	if err != nil && !errors.IsNotFound(err) {
+		return err
+	}
+	c.recorder.Eventf(vmi, k8sv1.EventTypeNormal, SuccessfulDeleteNetworkPolicyReason, "Deleted firewall network policy %s", name)
+	return nil
+}
+
+func (c *Controller) syncNetworkPolicy(vmi *virtv1.VirtualMachineInstance, spec *Spec) error {
+	desired := renderNetworkPolicy(vmi, spec)
+
+	obj, exists, err := c.networkPolicyStore.GetByKey(controller.NamespacedKey(desired.Namespace, desired.Name))
+	if err != nil {
+		return err
+	}
+	if !exists {
+		_, err := c.clientset.NetworkingV1().NetworkPolicies(desired.Namespace).Create(context.Background(), desired, metav1.CreateOptions{})
+		if errors.IsAlreadyExists(err) {
+			return fmt.Errorf("network policy %s/%s is not in the cache yet", desired.Namespace, desired.Name)
+		}
+		if err != nil {
+			c.recorder.Eventf(vmi, k8sv1.EventTypeWarning, FailedCreateNetworkPolicyReason,
+				"Error creating firewall network policy %s: %v", desired.Name, err)
+			return err
+		}
+		c.recorder.Eventf(vmi, k8sv1.EventTypeNormal, SuccessfulCreateNetworkPolicyReason, "Created firewall network policy %s", desired.Name)
+		return nil
+	}
+
+	networkPolicy := obj.(*networkingv1.NetworkPolicy)
+	if !metav1.IsControlledBy(networkPolicy, vmi) {
+		c.recorder.Eventf(vmi, k8sv1.EventTypeWarning, FailedCreateNetworkPolicyReason,
+			"NetworkPolicy %s already exists and is not managed by KubeVirt", desired.Name)
+		return nil
+	}
+	if equality.Semantic.DeepEqual(networkPolicy.Spec, desired.Spec) {
+		return nil
+	}
+	updated := networkPolicy.DeepCopy()
+	updated.Spec = desired.Spec
+	_, err = c.clientset.NetworkingV1().NetworkPolicies(updated.Namespace).Update(context.Background(), updated, metav1.UpdateOptions{})
+	return err
+}
+
+func renderNetworkPolicy(vmi *virtv1.VirtualMachineInstance, spec *Spec) *networkingv1.NetworkPolicy {
+	networkPolicy := &networkingv1.NetworkPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      networkPolicyName(vmi.Name),
+			Namespace: vmi.Namespace,
+			Labels: map[string]string{
+				virtv1.AppLabel: virtv1.FirewallAppLabelValue,
+			},
+			OwnerReferences: []metav1.OwnerReference{
+				*metav1.NewControllerRef(vmi, virtv1.VirtualMachineInstanceGroupVersionKind),
+			},
+		},
+		Spec: networkingv1.NetworkPolicySpec{
+			// The source and target pods of a migration are both created by the VMI
+			PodSelector: metav1.LabelSelector{
+				MatchLabels: map[string]string{
+					virtv1.AppLabel:       "virt-launcher",
+					virtv1.CreatedByLabel: string(vmi.UID),
+				},
+			},
+			PolicyTypes: []networkingv1.PolicyType{},
+		},
+	}
+
+	if spec.Ingress != nil {
+		networkPolicy.Spec.PolicyTypes = append(networkPolicy.Spec.PolicyTypes, networkingv1.PolicyTypeIngress)
+		networkPolicy.Spec.Ingress = []networkingv1.NetworkPolicyIngressRule{}
+		for _, rule := range spec.Ingress {
+			networkPolicy.Spec.Ingress = append(networkPolicy.Spec.Ingress, networkingv1.NetworkPolicyIngressRule{
+				Ports: renderPorts(rule.Ports),
+				From:  renderPeers(rule.CIDRs),
+			})
+		}
+	}
+	if spec.Egress != nil {
+		networkPolicy.Spec.PolicyTypes = append(networkPolicy.Spec.PolicyTypes, networkingv1.PolicyTypeEgress)
+		networkPolicy.Spec.Egress = []networkingv1.NetworkPolicyEgressRule{}
+		for _, rule := range spec.Egress {
+			networkPolicy.Spec.Egress = append(networkPolicy.Spec.Egress, networkingv1.NetworkPolicyEgressRule{
+				Ports: renderPorts(rule.Ports),
+				To:    renderPeers(rule.CIDRs),
+			})
+		}
+	}
+	return networkPolicy
+}
+
+func renderPorts(ports []Port) []networkingv1.NetworkPolicyPort {
+	var policyPorts []networkingv1.NetworkPolicyPort
+	for _, port := range ports {
+		protocol := k8sv1.Protocol(strings.ToUpper(port.Protocol))
+		if protocol == "" {
+			protocol = k8sv1.ProtocolTCP
+		}
+		policyPort := networkingv1.NetworkPolicyPort{Protocol: &protocol, EndPort: port.EndPort}
+		if port.Port != 0 {
+			policyPort.Port = port.toIntOrString()
+		}
+		policyPorts = append(policyPorts, policyPort)
+	}
+	return policyPorts
+}
+
+func renderPeers(cidrs []string) []networkingv1.NetworkPolicyPeer {
+	var peers []networkingv1.NetworkPolicyPeer
+	for _, cidr := range cidrs {
+		peers = append(peers, networkingv1.NetworkPolicyPeer{IPBlock: &networkingv1.IPBlock{CIDR: cidr}})
+	}
+	return peers
+}
+
+func parseSpec(value string) (*Spec, error) {
+	spec := &Spec{}
+	decoder := json.NewDecoder(strings.NewReader(value))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(spec); err != nil {
+		return nil, err
+	}
+	if err := spec.validate(); err != nil {
+		return nil, err
+	}
+	return spec, nil
+}
@@ -0,0 +1,220 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package firewall
+
+import (
+	"context"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	k8sv1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/record"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+
+	virtcontroller "kubevirt.io/kubevirt/pkg/controller"
+	"kubevirt.io/kubevirt/pkg/libvmi"
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/testutils"
+	"kubevirt.io/kubevirt/pkg/virt-config/featuregate"
+)
+
+var _ = Describe("Firewall controller", func() {
+	const (
+		testNamespace = "default"
+		vmiName       = "testvmi"
+		sshFirewall   = `{"ingress": [{"ports": [{"port": 22}], "cidrs": ["10.0.0.0/8"]}]}`
+	)
+
+	var (
+		controller *Controller
+		recorder   *record.FakeRecorder
+		k8sClient  *k8sfake.Clientset
+	)
+
+	newVMI := func(firewall string) *v1.VirtualMachineInstance {
+		var opts []libvmi.Option
+		if firewall != "" {
+			opts = append(opts, libvmi.WithAnnotation(v1.FirewallAnnotation, firewall))
+		}
+		vmi := libvmi.New(append(opts, libvmi.WithName(vmiName), libvmi.WithNamespace(testNamespace))...)
+		vmi.UID = "vmi-uid"
+		return vmi
+	}
+
+	setupController := func(featureGates []string, objs ...runtime.Object) {
+		virtClient := kubecli.NewMockKubevirtClient(gomock.NewController(GinkgoT()))
+		vmiInformer, _ := testutils.NewFakeInformerFor(&v1.VirtualMachineInstance{})
+		networkPolicyInformer, _ := testutils.NewFakeInformerFor(&networkingv1.NetworkPolicy{})
+		recorder = record.NewFakeRecorder(100)
+		config, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{
+			DeveloperConfiguration: &v1.DeveloperConfiguration{FeatureGates: featureGates},
+		})
+
+		var err error
+		controller, err = NewController(virtClient, vmiInformer, networkPolicyInformer, recorder, config)
+		Expect(err).ToNot(HaveOccurred())
+
+		k8sClient = k8sfake.NewSimpleClientset()
+		virtClient.EXPECT().NetworkingV1().Return(k8sClient.NetworkingV1()).AnyTimes()
+
+		for _, obj := range objs {
+			switch o := obj.(type) {
+			case *v1.VirtualMachineInstance:
+				Expect(vmiInformer.GetStore().Add(o)).To(Succeed())
+			case *networkingv1.NetworkPolicy:
+				Expect(networkPolicyInformer.GetStore().Add(o)).To(Succeed())
+				_, err := k8sClient.NetworkingV1().NetworkPolicies(o.Namespace).Create(context.Background(), o, metav1.CreateOptions{})
+				Expect(err).ToNot(HaveOccurred())
+			}
+		}
+	}
+
+	execute := func() {
+		Expect(controller.execute(virtcontroller.NamespacedKey(testNamespace, vmiName))).To(Succeed())
+	}
+
+	getNetworkPolicy := func() (*networkingv1.NetworkPolicy, error) {
+		return k8sClient.NetworkingV1().NetworkPolicies(testNamespace).Get(context.Background(), vmiName+"-firewall", metav1.GetOptions{})
+	}
+
+	mustParseSpec := func(value string) *Spec {
+		spec, err := parseSpec(value)
+		Expect(err).ToNot(HaveOccurred())
+		return spec
+	}
+
+	gates := []string{featuregate.VMFirewallGate}
+
+	It("should enforce the firewall of an annotated VMI with a NetworkPolicy", func() {
+		setupController(gates, newVMI(sshFirewall))
+		execute()
+
+		networkPolicy, err := getNetworkPolicy()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(networkPolicy.Labels).To(HaveKeyWithValue(v1.AppLabel, v1.FirewallAppLabelValue))
+		Expect(metav1.GetControllerOf(networkPolicy).UID).To(Equal(types.UID("vmi-uid")))
+		Expect(networkPolicy.Spec.PodSelector.MatchLabels).To(Equal(map[string]string{
+			v1.AppLabel:       "virt-launcher",
+			v1.CreatedByLabel: "vmi-uid",
+		}))
+		Expect(networkPolicy.Spec.PolicyTypes).To(ConsistOf(networkingv1.PolicyTypeIngress))
+		Expect(networkPolicy.Spec.Ingress).To(Equal([]networkingv1.NetworkPolicyIngressRule{{
+			Ports: []networkingv1.NetworkPolicyPort{{
+				Protocol: pointer.P(k8sv1.ProtocolTCP),
+				Port:     pointer.P(intstr.FromInt32(22)),
+			}},
+			From: []networkingv1.NetworkPolicyPeer{{IPBlock: &networkingv1.IPBlock{CIDR: "10.0.0.0/8"}}},
+		}}))
+		Expect(networkPolicy.Spec.Egress).To(BeEmpty())
+		testutils.ExpectEvent(recorder, SuccessfulCreateNetworkPolicyReason)
+	})
+
+	It("should deny all the traffic of a direction with no rules", func() {
+		networkPolicy := renderNetworkPolicy(newVMI(""), mustParseSpec(`{"ingress": [], "egress": []}`))
+
+		Expect(networkPolicy.Spec.PolicyTypes).To(ConsistOf(networkingv1.PolicyTypeIngress, networkingv1.PolicyTypeEgress))
+		Expect(networkPolicy.Spec.Ingress).To(BeEmpty())
+		Expect(networkPolicy.Spec.Egress).To(BeEmpty())
+	})
+
+	It("should render port ranges and egress rules", func() {
+		networkPolicy := renderNetworkPolicy(newVMI(""),
+			mustParseSpec(`{"egress": [{"ports": [{"protocol": "udp", "port": 5000, "endPort": 5010}]}]}`))
+
+		Expect(networkPolicy.Spec.PolicyTypes).To(ConsistOf(networkingv1.PolicyTypeEgress))
+		Expect(networkPolicy.Spec.Egress).To(Equal([]networkingv1.NetworkPolicyEgressRule{{
+			Ports: []networkingv1.NetworkPolicyPort{{
+				Protocol: pointer.P(k8sv1.ProtocolUDP),
+				Port:     pointer.P(intstr.FromInt32(5000)),
+				EndPort:  pointer.P(int32(5010)),
+			}},
+		}}))
+	})
+
+	It("should update the NetworkPolicy when the firewall changes", func() {
+		networkPolicy := renderNetworkPolicy(newVMI(""), mustParseSpec(`{"ingress": []}`))
+		setupController(gates, newVMI(sshFirewall), networkPolicy)
+		execute()
+
+		networkPolicy, err := getNetworkPolicy()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(networkPolicy.Spec.Ingress).To(HaveLen(1))
+	})
+
+	DescribeTable("should delete the NetworkPolicy", func(featureGates []string, firewall string) {
+		networkPolicy := renderNetworkPolicy(newVMI(""), mustParseSpec(sshFirewall))
+		setupController(featureGates, newVMI(firewall), networkPolicy)
+		execute()
+
+		_, err := getNetworkPolicy()
+		Expect(errors.IsNotFound(err)).To(BeTrue())
+		testutils.ExpectEvent(recorder, SuccessfulDeleteNetworkPolicyReason)
+	},
+		Entry("when the annotation is removed", gates, ""),
+		Entry("when the feature gate is disabled", nil, sshFirewall),
+	)
+
+	It("should keep the NetworkPolicy when the firewall is invalid", func() {
+		networkPolicy := renderNetworkPolicy(newVMI(""), mustParseSpec(sshFirewall))
+		setupController(gates, newVMI(`{"ingress": [{"cidrs": ["10.0.0.0"]}]}`), networkPolicy)
+		execute()
+
+		networkPolicy, err := getNetworkPolicy()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(networkPolicy.Spec.Ingress[0].From[0].IPBlock.CIDR).To(Equal("10.0.0.0/8"))
+		testutils.ExpectEvent(recorder, InvalidFirewallReason)
+	})
+
+	It("should not touch a NetworkPolicy which is not owned by the VMI", func() {
+		networkPolicy := renderNetworkPolicy(newVMI(""), mustParseSpec(`{"ingress": []}`))
+		networkPolicy.OwnerReferences = nil
+		setupController(gates, newVMI(sshFirewall), networkPolicy)
+		execute()
+
+		networkPolicy, err := getNetworkPolicy()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(networkPolicy.Spec.Ingress).To(BeEmpty())
+		testutils.ExpectEvent(recorder, FailedCreateNetworkPolicyReason)
+	})
+
+	DescribeTable("should reject an invalid firewall", func(firewall string) {
+		_, err := parseSpec(firewall)
+		Expect(err).To(HaveOccurred())
+	},
+		Entry("not JSON", "allow ssh"),
+		Entry("unknown field", `{"ingres": []}`),
+		Entry("unsupported protocol", `{"ingress": [{"ports": [{"protocol": "ICMP"}]}]}`),
+		Entry("port out of range", `{"ingress": [{"ports": [{"port": 70000}]}]}`),
+		Entry("port range without port", `{"ingress": [{"ports": [{"endPort": 80}]}]}`),
+		Entry("reversed port range", `{"ingress": [{"ports": [{"port": 80, "endPort": 22}]}]}`),
+		Entry("invalid CIDR", `{"egress": [{"cidrs": ["10.0.0.0/33"]}]}`),
+	)
+})
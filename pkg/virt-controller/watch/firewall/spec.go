@@ -0,0 +1,87 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package firewall
+
+import (
+	"fmt"
+	"net"
+	"strings"
+
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+// Spec is the firewall of a VMI, as held by the FirewallAnnotation.
+// A nil direction is not restricted, an empty one denies all its traffic.
+type Spec struct {
+	Ingress []Rule `json:"ingress,omitempty"`
+	Egress  []Rule `json:"egress,omitempty"`
+}
+
+// Rule allows the traffic to the ports from, or to, the CIDRs.
+// Without ports all the ports are allowed, without CIDRs all the peers are allowed.
+type Rule struct {
+	Ports []Port   `json:"ports,omitempty"`
+	CIDRs []string `json:"cidrs,omitempty"`
+}
+
+// Port is a port, or a port range up to EndPort, of TCP (the default), UDP or SCTP.
+// Port 0 stands for all the ports of the protocol.
+type Port struct {
+	Protocol string `json:"protocol,omitempty"`
+	Port     int32  `json:"port,omitempty"`
+	EndPort  *int32 `json:"endPort,omitempty"`
+}
+
+func (p Port) toIntOrString() *intstr.IntOrString {
+	port := intstr.FromInt32(p.Port)
+	return &port
+}
+
+func (s *Spec) validate() error {
+	for _, rule := range append(append([]Rule{}, s.Ingress...), s.Egress...) {
+		for _, port := range rule.Ports {
+			if err := port.validate(); err != nil {
+				return err
+			}
+		}
+		for _, cidr := range rule.CIDRs {
+			if _, _, err := net.ParseCIDR(cidr); err != nil {
+				return fmt.Errorf("invalid CIDR %q", cidr)
+			}
+		}
+	}
+	return nil
+}
+
+func (p Port) validate() error {
+	switch k8sv1.Protocol(strings.ToUpper(p.Protocol)) {
+	case "", k8sv1.ProtocolTCP, k8sv1.ProtocolUDP, k8sv1.ProtocolSCTP:
+	default:
+		return fmt.Errorf("unsupported protocol %q", p.Protocol)
+	}
+	if p.Port < 0 || p.Port > 65535 {
+		return fmt.Errorf("invalid port %d", p.Port)
+	}
+	if p.EndPort != nil && (p.Port == 0 || *p.EndPort < p.Port || *p.EndPort > 65535) {
+		return fmt.Errorf("invalid port range %d-%d", p.Port, *p.EndPort)
+	}
+	return nil
+}
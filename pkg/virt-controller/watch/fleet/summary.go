@@ -0,0 +1,167 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+package fleet
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+
+	virtv1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
+	"kubevirt.io/kubevirt/pkg/controller"
+	metrics "kubevirt.io/kubevirt/pkg/monitoring/metrics/virt-controller"
+)
+
+const fleetSummaryPath = "/status/fleetSummary"
+
+// SummaryUpdater aggregates the VirtualMachines, VirtualMachineInstances, migrations and nodes of the cluster into
+// the fleet summary of the KubeVirt CR and the matching metrics
+type SummaryUpdater struct {
+	clientset         kubecli.KubevirtClient
+	vmInformer        cache.SharedIndexInformer
+	vmiInformer       cache.SharedIndexInformer
+	migrationInformer cache.SharedIndexInformer
+	nodeInformer      cache.SharedIndexInformer
+	kubeVirtInformer  cache.SharedIndexInformer
+}
+
+func NewSummaryUpdater(
+	clientset kubecli.KubevirtClient,
+	vmInformer, vmiInformer, migrationInformer, nodeInformer, kubeVirtInformer cache.SharedIndexInformer,
+) *SummaryUpdater {
+	return &SummaryUpdater{
+		clientset:         clientset,
+		vmInformer:        vmInformer,
+		vmiInformer:       vmiInformer,
+		migrationInformer: migrationInformer,
+		nodeInformer:      nodeInformer,
+		kubeVirtInformer:  kubeVirtInformer,
+	}
+}
+
+func (u *SummaryUpdater) Run(period time.Duration, stopCh <-chan struct{}) {
+	cache.WaitForCacheSync(stopCh,
+		u.vmInformer.HasSynced,
+		u.vmiInformer.HasSynced,
+		u.migrationInformer.HasSynced,
+		u.nodeInformer.HasSynced,
+		u.kubeVirtInformer.HasSynced,
+	)
+	wait.Until(func() {
+		if err := u.Sync(); err != nil {
+			log.Log.Reason(err).Warning("failed to update the fleet summary of the KubeVirt CR")
+		}
+	}, period, stopCh)
+}
+
+func (u *SummaryUpdater) Sync() error {
+	summary := u.Summarize()
+	metrics.SetFleetSummary(summary)
+
+	kvs := u.kubeVirtInformer.GetStore().List()
+	if len(kvs) != 1 {
+		return nil
+	}
+	kv := kvs[0].(*virtv1.KubeVirt)
+	if current := kv.Status.FleetSummary; current != nil {
+		unchanged := current.DeepCopy()
+		unchanged.LastUpdateTime = summary.LastUpdateTime
+		if equality.Semantic.DeepEqual(unchanged, summary) {
+			return nil
+		}
+	}
+
+	patchBytes, err := patch.New(patch.WithAdd(fleetSummaryPath, summary)).GeneratePayload()
+	if err != nil {
+		return err
+	}
+	if _, err := u.clientset.KubeVirt(kv.Namespace).PatchStatus(
+		context.Background(), kv.Name, types.JSONPatchType, patchBytes, metav1.PatchOptions{}); err != nil {
+		return fmt.Errorf("unable to patch the fleet summary of the KubeVirt CR: %v", err)
+	}
+	return nil
+}
+
+// Summarize aggregates the current content of the informers
+func (u *SummaryUpdater) Summarize() *virtv1.KubeVirtFleetSummary {
+	summary := &virtv1.KubeVirtFleetSummary{
+		VirtualMachines:         map[string]int32{},
+		VirtualMachineInstances: map[string]int32{},
+		LastUpdateTime:          metav1.Now(),
+	}
+	pending := map[virtv1.KubeVirtPendingActionReason]int32{}
+	conditionManager := controller.NewVirtualMachineConditionManager()
+
+	for _, obj := range u.vmInformer.GetStore().List() {
+		vm := obj.(*virtv1.VirtualMachine)
+		if runStrategy, err := vm.RunStrategy(); err == nil {
+			summary.VirtualMachines[string(runStrategy)]++
+		}
+		if conditionManager.HasConditionWithStatus(vm, virtv1.VirtualMachineRestartRequired, k8sv1.ConditionTrue) {
+			pending[virtv1.PendingActionRestartRequired]++
+		}
+	}
+
+	for _, obj := range u.vmiInformer.GetStore().List() {
+		vmi := obj.(*virtv1.VirtualMachineInstance)
+		summary.VirtualMachineInstances[string(vmi.Status.Phase)]++
+		if vmi.IsFinal() {
+			continue
+		}
+		if _, outdated := vmi.Labels[virtv1.OutdatedLauncherImageLabel]; outdated {
+			pending[virtv1.PendingActionOutdatedLauncherImage]++
+		}
+		if vmi.Status.EvacuationNodeName != "" {
+			pending[virtv1.PendingActionEvacuation]++
+		}
+	}
+
+	for _, obj := range u.migrationInformer.GetStore().List() {
+		if migration := obj.(*virtv1.VirtualMachineInstanceMigration); !migration.IsFinal() {
+			summary.MigrationsInFlight++
+		}
+	}
+
+	for _, obj := range u.nodeInformer.GetStore().List() {
+		node := obj.(*k8sv1.Node)
+		if !node.Spec.Unschedulable && node.Labels[virtv1.NodeSchedulable] == "true" {
+			summary.SchedulableNodes++
+		}
+	}
+
+	for reason, count := range pending {
+		summary.PendingActions = append(summary.PendingActions, virtv1.KubeVirtPendingAction{Reason: reason, Count: count})
+	}
+	sort.Slice(summary.PendingActions, func(i, j int) bool {
+		return summary.PendingActions[i].Reason < summary.PendingActions[j].Reason
+	})
+	return summary
+}
@@ -0,0 +1,168 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+package fleet_test
+
+import (
+	"context"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	k8sv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+
+	virtv1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+	kubevirtfake "kubevirt.io/client-go/kubevirt/fake"
+
+	"kubevirt.io/kubevirt/pkg/libvmi"
+	"kubevirt.io/kubevirt/pkg/testutils"
+	"kubevirt.io/kubevirt/pkg/virt-controller/watch/fleet"
+)
+
+var _ = Describe("Fleet summary", func() {
+	var (
+		vmInformer        cache.SharedIndexInformer
+		vmiInformer       cache.SharedIndexInformer
+		migrationInformer cache.SharedIndexInformer
+		nodeInformer      cache.SharedIndexInformer
+		kubeVirtInformer  cache.SharedIndexInformer
+		fakeVirtClient    *kubevirtfake.Clientset
+		updater           *fleet.SummaryUpdater
+	)
+
+	BeforeEach(func() {
+		vmInformer, _ = testutils.NewFakeInformerFor(&virtv1.VirtualMachine{})
+		vmiInformer, _ = testutils.NewFakeInformerFor(&virtv1.VirtualMachineInstance{})
+		migrationInformer, _ = testutils.NewFakeInformerFor(&virtv1.VirtualMachineInstanceMigration{})
+		nodeInformer, _ = testutils.NewFakeInformerFor(&k8sv1.Node{})
+		kubeVirtInformer, _ = testutils.NewFakeInformerFor(&virtv1.KubeVirt{})
+
+		fakeVirtClient = kubevirtfake.NewSimpleClientset()
+		virtClient := kubecli.NewMockKubevirtClient(gomock.NewController(GinkgoT()))
+		virtClient.EXPECT().KubeVirt(k8sv1.NamespaceDefault).Return(fakeVirtClient.KubevirtV1().KubeVirts(k8sv1.NamespaceDefault)).AnyTimes()
+
+		updater = fleet.NewSummaryUpdater(virtClient, vmInformer, vmiInformer, migrationInformer, nodeInformer, kubeVirtInformer)
+	})
+
+	addVM := func(runStrategy virtv1.VirtualMachineRunStrategy, conditions ...virtv1.VirtualMachineCondition) {
+		vm := libvmi.NewVirtualMachine(libvmi.New(), libvmi.WithRunStrategy(runStrategy))
+		vm.Status.Conditions = conditions
+		Expect(vmInformer.GetStore().Add(vm)).To(Succeed())
+	}
+
+	addVMI := func(phase virtv1.VirtualMachineInstancePhase, opts ...libvmi.Option) *virtv1.VirtualMachineInstance {
+		vmi := libvmi.New(opts...)
+		vmi.Status.Phase = phase
+		Expect(vmiInformer.GetStore().Add(vmi)).To(Succeed())
+		return vmi
+	}
+
+	addMigration := func(name string, phase virtv1.VirtualMachineInstanceMigrationPhase) {
+		migration := &virtv1.VirtualMachineInstanceMigration{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: k8sv1.NamespaceDefault},
+			Status:     virtv1.VirtualMachineInstanceMigrationStatus{Phase: phase},
+		}
+		Expect(migrationInformer.GetStore().Add(migration)).To(Succeed())
+	}
+
+	addNode := func(name string, schedulable, cordoned bool) {
+		node := &k8sv1.Node{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Labels: map[string]string{}},
+			Spec:       k8sv1.NodeSpec{Unschedulable: cordoned},
+		}
+		if schedulable {
+			node.Labels[virtv1.NodeSchedulable] = "true"
+		}
+		Expect(nodeInformer.GetStore().Add(node)).To(Succeed())
+	}
+
+	addKubeVirt := func() {
+		kv := &virtv1.KubeVirt{ObjectMeta: metav1.ObjectMeta{Name: "kubevirt", Namespace: k8sv1.NamespaceDefault}}
+		_, err := fakeVirtClient.KubevirtV1().KubeVirts(k8sv1.NamespaceDefault).Create(context.Background(), kv, metav1.CreateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(kubeVirtInformer.GetStore().Add(kv)).To(Succeed())
+	}
+
+	It("should aggregate the workloads and nodes of the cluster", func() {
+		restartRequired := virtv1.VirtualMachineCondition{Type: virtv1.VirtualMachineRestartRequired, Status: k8sv1.ConditionTrue}
+		addVM(virtv1.RunStrategyAlways, restartRequired)
+		addVM(virtv1.RunStrategyAlways)
+		addVM(virtv1.RunStrategyHalted)
+
+		addVMI(virtv1.Running, libvmi.WithLabel(virtv1.OutdatedLauncherImageLabel, ""))
+		addVMI(virtv1.Running).Status.EvacuationNodeName = "node01"
+		addVMI(virtv1.Failed, libvmi.WithLabel(virtv1.OutdatedLauncherImageLabel, ""))
+
+		addMigration("running", virtv1.MigrationRunning)
+		addMigration("pending", virtv1.MigrationPending)
+		addMigration("succeeded", virtv1.MigrationSucceeded)
+
+		addNode("node01", true, true)
+		addNode("node02", true, false)
+		addNode("node03", false, false)
+
+		summary := updater.Summarize()
+		Expect(summary.VirtualMachines).To(Equal(map[string]int32{
+			string(virtv1.RunStrategyAlways): 2,
+			string(virtv1.RunStrategyHalted): 1,
+		}))
+		Expect(summary.VirtualMachineInstances).To(Equal(map[string]int32{
+			string(virtv1.Running): 2,
+			string(virtv1.Failed):  1,
+		}))
+		Expect(summary.MigrationsInFlight).To(BeEquivalentTo(2))
+		Expect(summary.SchedulableNodes).To(BeEquivalentTo(1))
+		Expect(summary.PendingActions).To(Equal([]virtv1.KubeVirtPendingAction{
+			{Reason: virtv1.PendingActionEvacuation, Count: 1},
+			{Reason: virtv1.PendingActionOutdatedLauncherImage, Count: 1},
+			{Reason: virtv1.PendingActionRestartRequired, Count: 1},
+		}))
+	})
+
+	It("should publish the summary on the KubeVirt CR", func() {
+		addKubeVirt()
+		addVM(virtv1.RunStrategyAlways)
+		addNode("node01", true, false)
+
+		Expect(updater.Sync()).To(Succeed())
+
+		kv, err := fakeVirtClient.KubevirtV1().KubeVirts(k8sv1.NamespaceDefault).Get(context.Background(), "kubevirt", metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(kv.Status.FleetSummary).ToNot(BeNil())
+		Expect(kv.Status.FleetSummary.VirtualMachines).To(HaveKeyWithValue(string(virtv1.RunStrategyAlways), int32(1)))
+		Expect(kv.Status.FleetSummary.SchedulableNodes).To(BeEquivalentTo(1))
+	})
+
+	It("should not patch the KubeVirt CR when the summary did not change", func() {
+		addKubeVirt()
+		addVM(virtv1.RunStrategyAlways)
+		Expect(updater.Sync()).To(Succeed())
+
+		kv, err := fakeVirtClient.KubevirtV1().KubeVirts(k8sv1.NamespaceDefault).Get(context.Background(), "kubevirt", metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(kubeVirtInformer.GetStore().Update(kv)).To(Succeed())
+		fakeVirtClient.ClearActions()
+
+		Expect(updater.Sync()).To(Succeed())
+		Expect(fakeVirtClient.Actions()).To(BeEmpty())
+	})
+})
@@ -0,0 +1,463 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package guestmetrics
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+
+	k8sv1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
+
+	virtv1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/controller"
+	"kubevirt.io/kubevirt/pkg/network/vmispec"
+	"kubevirt.io/kubevirt/pkg/pointer"
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
+)
+
+const (
+	// ManagedByValue is the value of the managed-by label on the EndpointSlices, which keeps the
+	// EndpointSlice controller of Kubernetes away from them
+	ManagedByValue = "virt-controller.kubevirt.io"
+
+	// PortName is the name of the port of the Services and EndpointSlices
+	PortName = "metrics"
+
+	// The annotations understood by the common Prometheus configurations discovering scrape targets from Services
+	PrometheusScrapeAnnotation = "prometheus.io/scrape"
+	PrometheusPortAnnotation   = "prometheus.io/port"
+	PrometheusPathAnnotation   = "prometheus.io/path"
+
+	SuccessfulCreateServiceReason = "SuccessfulCreateGuestMetricsService"
+	SuccessfulDeleteServiceReason = "SuccessfulDeleteGuestMetricsService"
+	FailedCreateServiceReason     = "FailedCreateGuestMetricsService"
+	InvalidMetricsPortReason      = "InvalidGuestMetricsPort"
+
+	serviceNameSuffix = "-guest-metrics"
+	defaultPath       = "/metrics"
+	defaultAddDelay   = 1 * time.Second
+)
+
+// Controller keeps a headless Service without selector and an EndpointSlice pointing at the
+// metrics exporter in the guest of every VM with the GuestMetricsPortAnnotation. The endpoint
+// follows the IP address and node of the VMI, so it stays correct across migrations.
+type Controller struct {
+	clientset          kubecli.KubevirtClient
+	queue              workqueue.TypedRateLimitingInterface[string]
+	vmStore            cache.Store
+	vmiStore           cache.Store
+	serviceStore       cache.Store
+	endpointSliceStore cache.Store
+	recorder           record.EventRecorder
+	clusterConfig      *virtconfig.ClusterConfig
+	hasSynced          func() bool
+}
+
+// NewController creates a new instance of the guest metrics Controller.
+func NewController(clientset kubecli.KubevirtClient,
+	vmInformer cache.SharedIndexInformer,
+	vmiInformer cache.SharedIndexInformer,
+	serviceInformer cache.SharedIndexInformer,
+	endpointSliceInformer cache.SharedIndexInformer,
+	recorder record.EventRecorder,
+	clusterConfig *virtconfig.ClusterConfig) (*Controller, error) {
+	c := &Controller{
+		clientset: clientset,
+		queue: workqueue.NewTypedRateLimitingQueueWithConfig[string](
+			workqueue.DefaultTypedControllerRateLimiter[string](),
+			workqueue.TypedRateLimitingQueueConfig[string]{Name: "virt-controller-guest-metrics"},
+		),
+		vmStore:            vmInformer.GetStore(),
+		vmiStore:           vmiInformer.GetStore(),
+		serviceStore:       serviceInformer.GetStore(),
+		endpointSliceStore: endpointSliceInformer.GetStore(),
+		recorder:           recorder,
+		clusterConfig:      clusterConfig,
+	}
+
+	c.hasSynced = func() bool {
+		return vmInformer.HasSynced() && vmiInformer.HasSynced() && serviceInformer.HasSynced() && endpointSliceInformer.HasSynced()
+	}
+
+	// The VMI of a VM has the same name, so both are handled with the key of the VM
+	for _, informer := range []cache.SharedIndexInformer{vmInformer, vmiInformer} {
+		_, err := informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+			AddFunc:    c.enqueue,
+			DeleteFunc: c.enqueue,
+			UpdateFunc: func(_, curr interface{}) { c.enqueue(curr) },
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for _, informer := range []cache.SharedIndexInformer{serviceInformer, endpointSliceInformer} {
+		_, err := informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+			AddFunc:    c.handleOwned,
+			DeleteFunc: c.handleOwned,
+			UpdateFunc: func(_, curr interface{}) { c.handleOwned(curr) },
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return c, nil
+}
+
+func (c *Controller) enqueue(obj interface{}) {
+	key, err := controller.KeyFunc(obj)
+	if err != nil {
+		log.Log.Reason(err).Error("Failed to extract key from object.")
+		return
+	}
+	c.queue.AddAfter(key, defaultAddDelay)
+}
+
+// handleOwned enqueues the VM a Service or EndpointSlice belongs to, so that changes made by others are reverted
+func (c *Controller) handleOwned(obj interface{}) {
+	if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+		obj = tombstone.Obj
+	}
+	object, ok := obj.(metav1.Object)
+	if !ok {
+		return
+	}
+	vmName, exists := object.GetLabels()[virtv1.VirtualMachineNameLabel]
+	if !exists {
+		return
+	}
+	c.queue.AddAfter(controller.NamespacedKey(object.GetNamespace(), vmName), defaultAddDelay)
+}
+
+func (c *Controller) Run(threadiness int, stopCh <-chan struct{}) {
+	defer controller.HandlePanic()
+	defer c.queue.ShutDown()
+	log.Log.Info("Starting guest metrics controller.")
+
+	cache.WaitForCacheSync(stopCh, c.hasSynced)
+
+	for i := 0; i < threadiness; i++ {
+		go wait.Until(c.runWorker, time.Second, stopCh)
+	}
+
+	<-stopCh
+	log.Log.Info("Stopping guest metrics controller.")
+}
+
+func (c *Controller) runWorker() {
+	for c.Execute() {
+	}
+}
+
+// Execute runs commands from the controller queue, if there is
+// an error it requeues the command. Returns false if the queue
+// is empty.
+func (c *Controller) Execute() bool {
+	key, quit := c.queue.Get()
+	if quit {
+		return false
+	}
+	defer c.queue.Done(key)
+
+	if err := c.execute(key); err != nil {
+		log.Log.Reason(err).Infof("reenqueuing guest metrics of VM %v", key)
+		c.queue.AddRateLimited(key)
+	} else {
+		log.Log.V(4).Infof("processed guest metrics of VM %v", key)
+		c.queue.Forget(key)
+	}
+	return true
+}
+
+func (c *Controller) execute(key string) error {
+	namespace, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		return err
+	}
+
+	obj, exists, err := c.vmStore.GetByKey(key)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		// The Service and EndpointSlice are garbage collected with the VM
+		return nil
+	}
+	vm := obj.(*virtv1.VirtualMachine)
+
+	port, configured := c.metricsPort(vm)
+	if !configured || vm.DeletionTimestamp != nil {
+		return c.deleteService(vm, namespace, serviceName(name))
+	}
+
+	if errs := validation.IsDNS1035Label(serviceName(name)); len(errs) > 0 {
+		c.recorder.Eventf(vm, k8sv1.EventTypeWarning, FailedCreateServiceReason,
+			"The guest metrics of VM %s can not be exposed by a Service: %s", name, strings.Join(errs, ", "))
+		return nil
+	}
+
+	service, err := c.syncService(vm, port)
+	if err != nil || service == nil {
+		return err
+	}
+
+	var vmi *virtv1.VirtualMachineInstance
+	obj, exists, err = c.vmiStore.GetByKey(key)
+	if err != nil {
+		return err
+	}
+	if exists {
+		vmi = obj.(*virtv1.VirtualMachineInstance)
+	}
+	return c.syncEndpointSlice(service, vmi, port)
+}
+
+// metricsPort returns the port of the exporter in the guest, it reports an event for an invalid port
+func (c *Controller) metricsPort(vm *virtv1.VirtualMachine) (int32, bool) {
+	if !c.clusterConfig.GuestMetricsEndpointsEnabled() {
+		return 0, false
+	}
+	value, exists := vm.Annotations[virtv1.GuestMetricsPortAnnotation]
+	if !exists {
+		return 0, false
+	}
+	port, err := strconv.ParseInt(value, 10, 32)
+	if err != nil || port < 1 || port > 65535 {
+		c.recorder.Eventf(vm, k8sv1.EventTypeWarning, InvalidMetricsPortReason,
+			"The annotation %s must be a port number, got %q", virtv1.GuestMetricsPortAnnotation, value)
+		return 0, false
+	}
+	return int32(port), true
+}
+
+func serviceName(vmName string) string {
+	return vmName + serviceNameSuffix
+}
+
+func (c *Controller) deleteService(vm *virtv1.VirtualMachine, namespace, name string) error {
+	obj, exists, err := c.serviceStore.GetByKey(controller.NamespacedKey(namespace, name))
+	if err != nil || !exists {
+		return err
+	}
+	service := obj.(*k8sv1.Service)
+	if !metav1.IsControlledBy(service, vm) || service.DeletionTimestamp != nil {
+		return nil
+	}
+	// The EndpointSlice is garbage collected with the Service
+	err = c.clientset.CoreV1().Services(namespace).Delete(context.Background(), name, metav1.DeleteOptions{})
+	if err != nil && !errors.IsNotFound(err) {
+		return err
+	}
+	c.recorder.Eventf(vm, k8sv1.EventTypeNormal, SuccessfulDeleteServiceReason, "Deleted guest metrics service %s", name)
+	return nil
+}
+
+// syncService creates or updates the Service of the VM, it returns nil if the Service belongs to somebody else
+func (c *Controller) syncService(vm *virtv1.VirtualMachine, port int32) (*k8sv1.Service, error) {
+	desired := renderService(vm, port)
+
+	obj, exists, err := c.serviceStore.GetByKey(controller.NamespacedKey(desired.Namespace, desired.Name))
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		service, err := c.clientset.CoreV1().Services(desired.Namespace).Create(context.Background(), desired, metav1.CreateOptions{})
+		if errors.IsAlreadyExists(err) {
+			c.recorder.Eventf(vm, k8sv1.EventTypeWarning, FailedCreateServiceReason,
+				"Service %s already exists and is not managed by KubeVirt", desired.Name)
+			return nil, nil
+		}
+		if err != nil {
+			c.recorder.Eventf(vm, k8sv1.EventTypeWarning, FailedCreateServiceReason,
+				"Error creating guest metrics service %s: %v", desired.Name, err)
+			return nil, err
+		}
+		c.recorder.Eventf(vm, k8sv1.EventTypeNormal, SuccessfulCreateServiceReason, "Created guest metrics service %s", desired.Name)
+		return service, nil
+	}
+
+	service := obj.(*k8sv1.Service)
+	if !metav1.IsControlledBy(service, vm) {
+		c.recorder.Eventf(vm, k8sv1.EventTypeWarning, FailedCreateServiceReason,
+			"Service %s already exists and is not managed by KubeVirt", desired.Name)
+		return nil, nil
+	}
+	if equality.Semantic.DeepEqual(service.Annotations, desired.Annotations) &&
+		equality.Semantic.DeepEqual(service.Spec.Ports, desired.Spec.Ports) {
+		return service, nil
+	}
+	updated := service.DeepCopy()
+	updated.Annotations = desired.Annotations
+	updated.Spec.Ports = desired.Spec.Ports
+	return c.clientset.CoreV1().Services(updated.Namespace).Update(context.Background(), updated, metav1.UpdateOptions{})
+}
+
+func renderService(vm *virtv1.VirtualMachine, port int32) *k8sv1.Service {
+	path := vm.Annotations[virtv1.GuestMetricsPathAnnotation]
+	if path == "" {
+		path = defaultPath
+	}
+	return &k8sv1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      serviceName(vm.Name),
+			Namespace: vm.Namespace,
+			Labels: map[string]string{
+				virtv1.AppLabel:                virtv1.GuestMetricsAppLabelValue,
+				virtv1.VirtualMachineNameLabel: vm.Name,
+			},
+			Annotations: map[string]string{
+				PrometheusScrapeAnnotation: "true",
+				PrometheusPortAnnotation:   strconv.Itoa(int(port)),
+				PrometheusPathAnnotation:   path,
+			},
+			OwnerReferences: []metav1.OwnerReference{
+				*metav1.NewControllerRef(vm, virtv1.VirtualMachineGroupVersionKind),
+			},
+		},
+		Spec: k8sv1.ServiceSpec{
+			// No selector, the endpoints are maintained by this controller
+			ClusterIP: k8sv1.ClusterIPNone,
+			Ports: []k8sv1.ServicePort{{
+				Name:       PortName,
+				Protocol:   k8sv1.ProtocolTCP,
+				Port:       port,
+				TargetPort: intstr.FromInt32(port),
+			}},
+		},
+	}
+}
+
+func (c *Controller) syncEndpointSlice(service *k8sv1.Service, vmi *virtv1.VirtualMachineInstance, port int32) error {
+	desired := renderEndpointSlice(service, vmi, port)
+
+	obj, exists, err := c.endpointSliceStore.GetByKey(controller.NamespacedKey(desired.Namespace, desired.Name))
+	if err != nil {
+		return err
+	}
+	if exists {
+		slice := obj.(*discoveryv1.EndpointSlice)
+		if slice.AddressType == desired.AddressType {
+			if equality.Semantic.DeepEqual(slice.Endpoints, desired.Endpoints) &&
+				equality.Semantic.DeepEqual(slice.Ports, desired.Ports) {
+				return nil
+			}
+			updated := slice.DeepCopy()
+			updated.Endpoints = desired.Endpoints
+			updated.Ports = desired.Ports
+			_, err := c.clientset.DiscoveryV1().EndpointSlices(updated.Namespace).Update(context.Background(), updated, metav1.UpdateOptions{})
+			return err
+		}
+		// The address type can not be changed, the EndpointSlice is recreated for the new IP family
+		err := c.clientset.DiscoveryV1().EndpointSlices(slice.Namespace).Delete(context.Background(), slice.Name, metav1.DeleteOptions{})
+		if err != nil && !errors.IsNotFound(err) {
+			return err
+		}
+	}
+	_, err = c.clientset.DiscoveryV1().EndpointSlices(desired.Namespace).Create(context.Background(), desired, metav1.CreateOptions{})
+	if errors.IsAlreadyExists(err) {
+		return fmt.Errorf("endpoint slice %s/%s is not in the cache yet", desired.Namespace, desired.Name)
+	}
+	return err
+}
+
+func renderEndpointSlice(service *k8sv1.Service, vmi *virtv1.VirtualMachineInstance, port int32) *discoveryv1.EndpointSlice {
+	slice := &discoveryv1.EndpointSlice{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      service.Name,
+			Namespace: service.Namespace,
+			Labels: map[string]string{
+				virtv1.AppLabel:                virtv1.GuestMetricsAppLabelValue,
+				virtv1.VirtualMachineNameLabel: service.Labels[virtv1.VirtualMachineNameLabel],
+				discoveryv1.LabelServiceName:   service.Name,
+				discoveryv1.LabelManagedBy:     ManagedByValue,
+			},
+			OwnerReferences: []metav1.OwnerReference{
+				*metav1.NewControllerRef(service, k8sv1.SchemeGroupVersion.WithKind("Service")),
+			},
+		},
+		AddressType: discoveryv1.AddressTypeIPv4,
+		Endpoints:   []discoveryv1.Endpoint{},
+		Ports: []discoveryv1.EndpointPort{{
+			Name:     pointer.P(PortName),
+			Protocol: pointer.P(k8sv1.ProtocolTCP),
+			Port:     pointer.P(port),
+		}},
+	}
+
+	ip := guestIP(vmi)
+	if ip == nil {
+		return slice
+	}
+	if ip.To4() == nil {
+		slice.AddressType = discoveryv1.AddressTypeIPv6
+	}
+	running := vmi.Status.Phase == virtv1.Running
+	slice.Endpoints = append(slice.Endpoints, discoveryv1.Endpoint{
+		Addresses: []string{ip.String()},
+		Conditions: discoveryv1.EndpointConditions{
+			Ready:       pointer.P(running && vmi.DeletionTimestamp == nil),
+			Serving:     pointer.P(running),
+			Terminating: pointer.P(vmi.DeletionTimestamp != nil),
+		},
+		NodeName: pointer.P(vmi.Status.NodeName),
+		TargetRef: &k8sv1.ObjectReference{
+			Kind:      virtv1.VirtualMachineInstanceGroupVersionKind.Kind,
+			Namespace: vmi.Namespace,
+			Name:      vmi.Name,
+			UID:       vmi.UID,
+		},
+	})
+	return slice
+}
+
+// guestIP returns the address of the guest on the pod network, as reported on the VMI. After a migration
+// it is the address of the target pod.
+func guestIP(vmi *virtv1.VirtualMachineInstance) net.IP {
+	if vmi == nil || vmi.IsFinal() || vmi.Status.NodeName == "" {
+		return nil
+	}
+	podNetwork := vmispec.LookupPodNetwork(vmi.Spec.Networks)
+	if podNetwork == nil {
+		return nil
+	}
+	iface := vmispec.LookupInterfaceStatusByName(vmi.Status.Interfaces, podNetwork.Name)
+	if iface == nil {
+		return nil
+	}
+	return net.ParseIP(iface.IP)
+}
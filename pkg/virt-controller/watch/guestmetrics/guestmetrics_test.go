@@ -0,0 +1,264 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package guestmetrics
+
+import (
+	"context"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	k8sv1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/record"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+
+	virtcontroller "kubevirt.io/kubevirt/pkg/controller"
+	"kubevirt.io/kubevirt/pkg/libvmi"
+	libvmistatus "kubevirt.io/kubevirt/pkg/libvmi/status"
+	"kubevirt.io/kubevirt/pkg/testutils"
+	"kubevirt.io/kubevirt/pkg/virt-config/featuregate"
+)
+
+var _ = Describe("Guest metrics controller", func() {
+	const (
+		testNamespace = "default"
+		vmName        = "testvm"
+	)
+
+	var (
+		controller *Controller
+		recorder   *record.FakeRecorder
+		k8sClient  *k8sfake.Clientset
+	)
+
+	newVM := func(annotations map[string]string) *v1.VirtualMachine {
+		vm := libvmi.NewVirtualMachine(
+			libvmi.New(libvmi.WithName(vmName), libvmi.WithNamespace(testNamespace)),
+			libvmi.WithAnnotations(annotations),
+		)
+		vm.UID = "vm-uid"
+		return vm
+	}
+
+	newVMI := func(phase v1.VirtualMachineInstancePhase, node, ip string) *v1.VirtualMachineInstance {
+		vmi := libvmi.New(
+			libvmi.WithName(vmName),
+			libvmi.WithNamespace(testNamespace),
+			libvmi.WithInterface(libvmi.InterfaceDeviceWithMasqueradeBinding()),
+			libvmi.WithNetwork(v1.DefaultPodNetwork()),
+			libvmistatus.WithStatus(libvmistatus.New(
+				libvmistatus.WithPhase(phase),
+				libvmistatus.WithNodeName(node),
+				libvmistatus.WithInterfaceStatus(v1.VirtualMachineInstanceNetworkInterface{Name: "default", IP: ip}),
+			)),
+		)
+		vmi.UID = "vmi-uid"
+		return vmi
+	}
+
+	setupController := func(featureGates []string, objs ...runtime.Object) {
+		virtClient := kubecli.NewMockKubevirtClient(gomock.NewController(GinkgoT()))
+		vmInformer, _ := testutils.NewFakeInformerFor(&v1.VirtualMachine{})
+		vmiInformer, _ := testutils.NewFakeInformerFor(&v1.VirtualMachineInstance{})
+		serviceInformer, _ := testutils.NewFakeInformerFor(&k8sv1.Service{})
+		endpointSliceInformer, _ := testutils.NewFakeInformerFor(&discoveryv1.EndpointSlice{})
+		recorder = record.NewFakeRecorder(100)
+		config, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{
+			DeveloperConfiguration: &v1.DeveloperConfiguration{FeatureGates: featureGates},
+		})
+
+		var err error
+		controller, err = NewController(virtClient, vmInformer, vmiInformer, serviceInformer, endpointSliceInformer, recorder, config)
+		Expect(err).ToNot(HaveOccurred())
+
+		k8sClient = k8sfake.NewSimpleClientset()
+		virtClient.EXPECT().CoreV1().Return(k8sClient.CoreV1()).AnyTimes()
+		virtClient.EXPECT().DiscoveryV1().Return(k8sClient.DiscoveryV1()).AnyTimes()
+
+		for _, obj := range objs {
+			switch o := obj.(type) {
+			case *v1.VirtualMachine:
+				Expect(vmInformer.GetStore().Add(o)).To(Succeed())
+			case *v1.VirtualMachineInstance:
+				Expect(vmiInformer.GetStore().Add(o)).To(Succeed())
+			case *k8sv1.Service:
+				Expect(serviceInformer.GetStore().Add(o)).To(Succeed())
+				_, err := k8sClient.CoreV1().Services(o.Namespace).Create(context.Background(), o, metav1.CreateOptions{})
+				Expect(err).ToNot(HaveOccurred())
+			case *discoveryv1.EndpointSlice:
+				Expect(endpointSliceInformer.GetStore().Add(o)).To(Succeed())
+				_, err := k8sClient.DiscoveryV1().EndpointSlices(o.Namespace).Create(context.Background(), o, metav1.CreateOptions{})
+				Expect(err).ToNot(HaveOccurred())
+			}
+		}
+	}
+
+	execute := func() {
+		Expect(controller.execute(virtcontroller.NamespacedKey(testNamespace, vmName))).To(Succeed())
+	}
+
+	getService := func() (*k8sv1.Service, error) {
+		return k8sClient.CoreV1().Services(testNamespace).Get(context.Background(), vmName+"-guest-metrics", metav1.GetOptions{})
+	}
+
+	getEndpointSlice := func() *discoveryv1.EndpointSlice {
+		slice, err := k8sClient.DiscoveryV1().EndpointSlices(testNamespace).
+			Get(context.Background(), vmName+"-guest-metrics", metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		return slice
+	}
+
+	annotated := map[string]string{
+		v1.GuestMetricsPortAnnotation: "9100",
+		v1.GuestMetricsPathAnnotation: "/node/metrics",
+	}
+	gates := []string{featuregate.GuestMetricsEndpointsGate}
+
+	It("should expose the exporter in the guest of an annotated VM", func() {
+		setupController(gates, newVM(annotated), newVMI(v1.Running, "node01", "10.244.0.5"))
+		execute()
+
+		service, err := getService()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(service.Spec.ClusterIP).To(Equal(k8sv1.ClusterIPNone))
+		Expect(service.Spec.Selector).To(BeEmpty())
+		Expect(service.Spec.Ports).To(HaveLen(1))
+		Expect(service.Spec.Ports[0].Port).To(Equal(int32(9100)))
+		Expect(service.Annotations).To(Equal(map[string]string{
+			PrometheusScrapeAnnotation: "true",
+			PrometheusPortAnnotation:   "9100",
+			PrometheusPathAnnotation:   "/node/metrics",
+		}))
+		Expect(service.Labels).To(HaveKeyWithValue(v1.AppLabel, v1.GuestMetricsAppLabelValue))
+		Expect(metav1.GetControllerOf(service).UID).To(Equal(types.UID("vm-uid")))
+		testutils.ExpectEvent(recorder, SuccessfulCreateServiceReason)
+
+		slice := getEndpointSlice()
+		Expect(slice.Labels).To(HaveKeyWithValue(discoveryv1.LabelServiceName, service.Name))
+		Expect(slice.Labels).To(HaveKeyWithValue(discoveryv1.LabelManagedBy, ManagedByValue))
+		Expect(slice.AddressType).To(Equal(discoveryv1.AddressTypeIPv4))
+		Expect(*slice.Ports[0].Port).To(Equal(int32(9100)))
+		Expect(slice.Endpoints).To(HaveLen(1))
+		Expect(slice.Endpoints[0].Addresses).To(ConsistOf("10.244.0.5"))
+		Expect(*slice.Endpoints[0].Conditions.Ready).To(BeTrue())
+		Expect(*slice.Endpoints[0].NodeName).To(Equal("node01"))
+		Expect(slice.Endpoints[0].TargetRef.Name).To(Equal(vmName))
+	})
+
+	It("should move the endpoint to the target of a migration", func() {
+		vm := newVM(annotated)
+		service := renderService(vm, 9100)
+		slice := renderEndpointSlice(service, newVMI(v1.Running, "node01", "10.244.0.5"), 9100)
+		setupController(gates, vm, service, slice, newVMI(v1.Running, "node02", "10.244.1.7"))
+		execute()
+
+		slice = getEndpointSlice()
+		Expect(slice.Endpoints).To(HaveLen(1))
+		Expect(slice.Endpoints[0].Addresses).To(ConsistOf("10.244.1.7"))
+		Expect(*slice.Endpoints[0].NodeName).To(Equal("node02"))
+	})
+
+	It("should recreate the EndpointSlice when the IP family of the guest changes", func() {
+		vm := newVM(annotated)
+		service := renderService(vm, 9100)
+		slice := renderEndpointSlice(service, newVMI(v1.Running, "node01", "10.244.0.5"), 9100)
+		setupController(gates, vm, service, slice, newVMI(v1.Running, "node01", "fd10:244::5"))
+		execute()
+
+		slice = getEndpointSlice()
+		Expect(slice.AddressType).To(Equal(discoveryv1.AddressTypeIPv6))
+		Expect(slice.Endpoints[0].Addresses).To(ConsistOf("fd10:244::5"))
+	})
+
+	It("should keep the EndpointSlice empty while the VM is not running", func() {
+		setupController(gates, newVM(annotated))
+		execute()
+
+		_, err := getService()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(getEndpointSlice().Endpoints).To(BeEmpty())
+	})
+
+	It("should update the Service when the port changes", func() {
+		vm := newVM(annotated)
+		service := renderService(vm, 9100)
+		vm.Annotations[v1.GuestMetricsPortAnnotation] = "9200"
+		setupController(gates, vm, service, newVMI(v1.Running, "node01", "10.244.0.5"))
+		execute()
+
+		service, err := getService()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(service.Spec.Ports[0].Port).To(Equal(int32(9200)))
+		Expect(service.Annotations).To(HaveKeyWithValue(PrometheusPortAnnotation, "9200"))
+		Expect(*getEndpointSlice().Ports[0].Port).To(Equal(int32(9200)))
+	})
+
+	DescribeTable("should delete the Service", func(featureGates []string, annotations map[string]string) {
+		service := renderService(newVM(annotated), 9100)
+		setupController(featureGates, newVM(annotations), service)
+		execute()
+
+		_, err := getService()
+		Expect(errors.IsNotFound(err)).To(BeTrue())
+		testutils.ExpectEvent(recorder, SuccessfulDeleteServiceReason)
+	},
+		Entry("when the annotation is removed", gates, nil),
+		Entry("when the feature gate is disabled", nil, annotated),
+	)
+
+	It("should not touch a Service which is not owned by the VM", func() {
+		service := renderService(newVM(annotated), 9100)
+		service.OwnerReferences = nil
+		service.Spec.Ports[0].Port = 80
+		setupController(gates, newVM(annotated), service)
+		execute()
+
+		service, err := getService()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(service.Spec.Ports[0].Port).To(Equal(int32(80)))
+		testutils.ExpectEvent(recorder, FailedCreateServiceReason)
+	})
+
+	DescribeTable("should reject an invalid port", func(port string) {
+		setupController(gates, newVM(map[string]string{v1.GuestMetricsPortAnnotation: port}))
+		execute()
+
+		_, err := getService()
+		Expect(errors.IsNotFound(err)).To(BeTrue())
+		testutils.ExpectEvent(recorder, InvalidMetricsPortReason)
+	},
+		Entry("not a number", "http"),
+		Entry("out of range", "70000"),
+	)
+
+	It("should use the default path", func() {
+		service := renderService(newVM(map[string]string{v1.GuestMetricsPortAnnotation: "9100"}), 9100)
+		Expect(service.Annotations).To(HaveKeyWithValue(PrometheusPathAnnotation, "/metrics"))
+	})
+})
@@ -0,0 +1,203 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package migration
+
+import (
+	"time"
+
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	virtv1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/api/migrations/v1alpha1"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/controller"
+)
+
+const (
+	defaultTargetUtilizationPercent = 80
+	// A bandwidth of 0 disables the limit, a saturated network must not lift it
+	lowestTunedBandwidth = 1024 * 1024
+	// Running migrations are re-tuned as often as virt-handler samples the network of the node
+	bandwidthTuningInterval = 30 * time.Second
+)
+
+// tuneMigrationBandwidth limits the bandwidth of the migration to its share of the network headroom of the
+// source node, if auto-tuning is enabled and virt-handler reports the network of the node.
+func (c *Controller) tuneMigrationBandwidth(vmi *virtv1.VirtualMachineInstance) error {
+	conf := vmi.Status.MigrationState.MigrationConfiguration
+	if conf == nil || conf.BandwidthAutoTuning == nil {
+		return nil
+	}
+
+	bandwidth, tuned, err := c.tunedMigrationBandwidth(vmi, conf)
+	if err != nil || !tuned {
+		return err
+	}
+	conf.BandwidthPerMigration = resource.NewQuantity(bandwidth, resource.BinarySI)
+	return nil
+}
+
+// retuneMigrationBandwidth adjusts the bandwidth of a running migration to the current network usage of the source
+// node, virt-handler applies the new bandwidth to the migration job. The migration is rechecked periodically.
+func (c *Controller) retuneMigrationBandwidth(key string, migration *virtv1.VirtualMachineInstanceMigration, vmi *virtv1.VirtualMachineInstance) error {
+	migrationState := vmi.Status.MigrationState
+	if migrationState == nil || migrationState.MigrationUID != migration.UID || migrationState.StartTimestamp == nil ||
+		migrationState.AbortRequested || migrationState.MigrationConfiguration == nil ||
+		migrationState.MigrationConfiguration.BandwidthAutoTuning == nil {
+		return nil
+	}
+	c.Queue.AddAfter(key, bandwidthTuningInterval)
+
+	// The tuned bandwidth replaced the static limit, which still caps the new bandwidth
+	conf := migrationState.MigrationConfiguration.DeepCopy()
+	limit, err := c.staticBandwidthPerMigration(vmi)
+	if err != nil {
+		return err
+	}
+	conf.BandwidthPerMigration = limit
+
+	bandwidth, tuned, err := c.tunedMigrationBandwidth(vmi, conf)
+	if err != nil || !tuned {
+		return err
+	}
+	if current := migrationState.MigrationConfiguration.BandwidthPerMigration; current != nil && current.Value() == bandwidth {
+		return nil
+	}
+
+	vmiCopy := vmi.DeepCopy()
+	vmiCopy.Status.MigrationState.MigrationConfiguration.BandwidthPerMigration = resource.NewQuantity(bandwidth, resource.BinarySI)
+	if err := c.patchVMI(vmi, vmiCopy); err != nil {
+		return err
+	}
+	log.Log.Object(vmi).V(3).Infof("Re-tuned the migration bandwidth to %d bytes/s", bandwidth)
+	return nil
+}
+
+func (c *Controller) tunedMigrationBandwidth(vmi *virtv1.VirtualMachineInstance, conf *virtv1.MigrationConfiguration) (int64, bool, error) {
+	obj, exists, err := c.nodeStore.GetByKey(vmi.Status.NodeName)
+	if err != nil || !exists {
+		return 0, false, err
+	}
+
+	runningMigrations, err := c.findRunningMigrations()
+	if err != nil {
+		return 0, false, err
+	}
+	outboundMigrations, migrationTraffic := c.outboundMigrationTrafficOnNode(vmi.Status.NodeName, runningMigrations)
+
+	bandwidth, tuned := tunedBandwidth(obj.(*k8sv1.Node), conf, outboundMigrations, migrationTraffic)
+	if tuned {
+		log.Log.Object(vmi).V(3).Infof("Tuned the migration bandwidth to %d bytes/s for %d outbound migrations",
+			bandwidth, outboundMigrations)
+	}
+	return bandwidth, tuned, nil
+}
+
+// outboundMigrationTrafficOnNode counts the outbound migrations of the node and estimates the traffic of those which
+// already transfer the guest by their bandwidth, the usage reported by virt-handler includes it.
+func (c *Controller) outboundMigrationTrafficOnNode(node string, runningMigrations []*virtv1.VirtualMachineInstanceMigration) (int, int64) {
+	count := 0
+	traffic := int64(0)
+	for _, migration := range runningMigrations {
+		key := controller.NamespacedKey(migration.Namespace, migration.Spec.VMIName)
+		obj, exists, _ := c.vmiStore.GetByKey(key)
+		if !exists {
+			continue
+		}
+		vmi := obj.(*virtv1.VirtualMachineInstance)
+		if vmi.Status.NodeName != node {
+			continue
+		}
+		count++
+
+		migrationState := vmi.Status.MigrationState
+		if migrationState == nil || migrationState.MigrationUID != migration.UID || migrationState.StartTimestamp == nil ||
+			migrationState.MigrationConfiguration == nil || migrationState.MigrationConfiguration.BandwidthPerMigration == nil {
+			continue
+		}
+		traffic += migrationState.MigrationConfiguration.BandwidthPerMigration.Value()
+	}
+	return count, traffic
+}
+
+// staticBandwidthPerMigration returns the bandwidth per migration of the cluster or of the migration policy of the VMI
+func (c *Controller) staticBandwidthPerMigration(vmi *virtv1.VirtualMachineInstance) (*resource.Quantity, error) {
+	conf := c.clusterConfig.GetMigrationConfiguration().DeepCopy()
+	if policyName := vmi.Status.MigrationState.MigrationPolicyName; policyName != nil && *policyName != "" {
+		obj, exists, err := c.migrationPolicyStore.GetByKey(*policyName)
+		if err != nil {
+			return nil, err
+		}
+		if exists {
+			if _, err := obj.(*v1alpha1.MigrationPolicy).GetMigrationConfByPolicy(conf); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return conf.BandwidthPerMigration, nil
+}
+
+// tunedBandwidth shares the headroom between the targeted network bandwidth of the node and the bandwidth used by
+// anything but the transferring migrations evenly between the outbound migrations, including the one being tuned.
+// The result is rounded down to MiB/s, the unit of the migration job, and kept between the minimum of the
+// auto-tuning and the static bandwidth per migration.
+func tunedBandwidth(node *k8sv1.Node, conf *virtv1.MigrationConfiguration, outboundMigrations int, migrationTraffic int64) (int64, bool) {
+	capacity, ok := nodeNetworkAnnotation(node, virtv1.MigrationNetworkCapacityAnnotation)
+	if !ok || capacity <= 0 {
+		return 0, false
+	}
+	usage, ok := nodeNetworkAnnotation(node, virtv1.MigrationNetworkUsageAnnotation)
+	if !ok {
+		return 0, false
+	}
+
+	targetUtilization := int64(defaultTargetUtilizationPercent)
+	if conf.BandwidthAutoTuning.TargetUtilizationPercent != nil {
+		targetUtilization = int64(*conf.BandwidthAutoTuning.TargetUtilizationPercent)
+	}
+	outboundMigrations = max(outboundMigrations, 1)
+
+	otherTraffic := max(usage-migrationTraffic, 0)
+	headroom := max(capacity/100*targetUtilization-otherTraffic, 0)
+	bandwidth := headroom / int64(outboundMigrations) / lowestTunedBandwidth * lowestTunedBandwidth
+
+	if conf.BandwidthPerMigration != nil && conf.BandwidthPerMigration.Value() > 0 {
+		bandwidth = min(bandwidth, conf.BandwidthPerMigration.Value())
+	}
+	if minBandwidth := conf.BandwidthAutoTuning.MinBandwidthPerMigration; minBandwidth != nil {
+		bandwidth = max(bandwidth, minBandwidth.Value())
+	}
+	return max(bandwidth, lowestTunedBandwidth), true
+}
+
+func nodeNetworkAnnotation(node *k8sv1.Node, annotation string) (int64, bool) {
+	value, exists := node.Annotations[annotation]
+	if !exists {
+		return 0, false
+	}
+	quantity, err := resource.ParseQuantity(value)
+	if err != nil {
+		log.Log.Object(node).Reason(err).Warningf("Ignoring the invalid annotation %s", annotation)
+		return 0, false
+	}
+	return quantity.Value(), true
+}
@@ -0,0 +1,91 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package migration
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	virtv1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/pointer"
+)
+
+var _ = Describe("Migration bandwidth auto-tuning", func() {
+	const mib = 1024 * 1024
+
+	newNode := func(capacity, usage string) *k8sv1.Node {
+		node := &k8sv1.Node{ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{}}}
+		if capacity != "" {
+			node.Annotations[virtv1.MigrationNetworkCapacityAnnotation] = capacity
+		}
+		if usage != "" {
+			node.Annotations[virtv1.MigrationNetworkUsageAnnotation] = usage
+		}
+		return node
+	}
+
+	newConf := func(bandwidthPerMigration, minBandwidth string, targetUtilization *int32) *virtv1.MigrationConfiguration {
+		conf := &virtv1.MigrationConfiguration{
+			BandwidthAutoTuning: &virtv1.MigrationBandwidthAutoTuning{TargetUtilizationPercent: targetUtilization},
+		}
+		if bandwidthPerMigration != "" {
+			conf.BandwidthPerMigration = pointer.P(resource.MustParse(bandwidthPerMigration))
+		}
+		if minBandwidth != "" {
+			conf.BandwidthAutoTuning.MinBandwidthPerMigration = pointer.P(resource.MustParse(minBandwidth))
+		}
+		return conf
+	}
+
+	DescribeTable("should share the network headroom of the node", func(node *k8sv1.Node, conf *virtv1.MigrationConfiguration,
+		outboundMigrations int, migrationTraffic int64, expectedBandwidth int64) {
+		bandwidth, tuned := tunedBandwidth(node, conf, outboundMigrations, migrationTraffic)
+		Expect(tuned).To(BeTrue())
+		Expect(bandwidth).To(Equal(expectedBandwidth))
+	},
+		Entry("with a single migration", newNode("1000Mi", "200Mi"), newConf("", "", nil), 1, int64(0), int64(600*mib)),
+		Entry("between the outbound migrations", newNode("1000Mi", "200Mi"), newConf("", "", nil), 3, int64(0), int64(200*mib)),
+		Entry("excluding the traffic of the transferring migrations", newNode("1000Mi", "700Mi"), newConf("", "", nil), 3,
+			int64(500*mib), int64(200*mib)),
+		Entry("with more migration traffic than usage", newNode("1000Mi", "100Mi"), newConf("", "", nil), 2,
+			int64(300*mib), int64(400*mib)),
+		Entry("rounded down to MiB", newNode("1000Mi", "0"), newConf("", "", nil), 3, int64(0), int64(266*mib)),
+		Entry("with a custom target utilization", newNode("1000Mi", "0"), newConf("", "", pointer.P(int32(50))), 1, int64(0), int64(500*mib)),
+		Entry("up to the bandwidth per migration", newNode("1000Mi", "0"), newConf("64Mi", "", nil), 1, int64(0), int64(64*mib)),
+		Entry("but not below the minimum bandwidth", newNode("1000Mi", "900Mi"), newConf("", "32Mi", nil), 1, int64(0), int64(32*mib)),
+		Entry("but never lift the limit of a saturated network", newNode("1000Mi", "1000Mi"), newConf("", "", nil), 2, int64(0), int64(mib)),
+		Entry("without outbound migrations", newNode("1000Mi", "200Mi"), newConf("", "", nil), 0, int64(0), int64(600*mib)),
+	)
+
+	DescribeTable("should not tune the bandwidth", func(node *k8sv1.Node) {
+		_, tuned := tunedBandwidth(node, newConf("", "", nil), 1, 0)
+		Expect(tuned).To(BeFalse())
+	},
+		Entry("without a reported network", newNode("", "")),
+		Entry("without a reported usage", newNode("1000Mi", "")),
+		Entry("with an unknown capacity", newNode("0", "0")),
+		Entry("with an invalid annotation", newNode("fast", "0")),
+	)
+})
@@ -60,6 +60,7 @@ import (
 	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
 	"kubevirt.io/kubevirt/pkg/virt-controller/services"
 	"kubevirt.io/kubevirt/pkg/virt-controller/watch/descheduler"
+	"kubevirt.io/kubevirt/pkg/virt-controller/watch/placement"
 
 	"sigs.k8s.io/controller-runtime/pkg/controller/priorityqueue"
 )
@@ -96,8 +97,14 @@ const defaultCatchAllPendingTimeoutSeconds = int64(60 * 15)
 // for potential future "semi-low" priority values.
 const lowPriority = -100
 
+// Automated migrations outside of the maintenance windows of their migration policy are rechecked
+// at the granularity of the window schedules
+const maintenanceWindowRecheckInterval = time.Minute
+
 var migrationBackoffError = errors.New(controller.MigrationBackoffReason)
 
+var migrationQueuedForMaintenanceWindowError = errors.New("waiting for a maintenance window of the migration policy to open")
+
 type Controller struct {
 	templateService      services.TemplateService
 	clientset            kubecli.KubevirtClient
@@ -111,12 +118,14 @@ type Controller struct {
 	storageProfileStore  cache.Store
 	pdbIndexer           cache.Indexer
 	migrationPolicyStore cache.Store
+	namespaceStore       cache.Store
 	resourceQuotaIndexer cache.Indexer
 	recorder             record.EventRecorder
 	podExpectations      *controller.UIDTrackingControllerExpectations
 	pvcExpectations      *controller.UIDTrackingControllerExpectations
 	migrationStartLock   *sync.Mutex
 	clusterConfig        *virtconfig.ClusterConfig
+	placementHinter      placement.Hinter
 	hasSynced            func() bool
 
 	// the set of cancelled migrations before being handed off to virt-handler.
@@ -139,6 +148,7 @@ func NewController(templateService services.TemplateService,
 	pdbInformer cache.SharedIndexInformer,
 	migrationPolicyInformer cache.SharedIndexInformer,
 	resourceQuotaInformer cache.SharedIndexInformer,
+	namespaceInformer cache.SharedIndexInformer,
 	recorder record.EventRecorder,
 	clientset kubecli.KubevirtClient,
 	clusterConfig *virtconfig.ClusterConfig,
@@ -159,12 +169,14 @@ func NewController(templateService services.TemplateService,
 		pdbIndexer:           pdbInformer.GetIndexer(),
 		resourceQuotaIndexer: resourceQuotaInformer.GetIndexer(),
 		migrationPolicyStore: migrationPolicyInformer.GetStore(),
+		namespaceStore:       namespaceInformer.GetStore(),
 		recorder:             recorder,
 		clientset:            clientset,
 		podExpectations:      controller.NewUIDTrackingControllerExpectations(controller.NewControllerExpectations()),
 		pvcExpectations:      controller.NewUIDTrackingControllerExpectations(controller.NewControllerExpectations()),
 		migrationStartLock:   &sync.Mutex{},
 		clusterConfig:        clusterConfig,
+		placementHinter:      placement.NewHinter(clusterConfig),
 		handOffMap:           make(map[string]struct{}),
 
 		unschedulablePendingTimeoutSeconds: defaultUnschedulablePendingTimeoutSeconds,
@@ -172,7 +184,8 @@ func NewController(templateService services.TemplateService,
 	}
 
 	c.hasSynced = func() bool {
-		return vmiInformer.HasSynced() && podInformer.HasSynced() && migrationInformer.HasSynced() && pdbInformer.HasSynced() && resourceQuotaInformer.HasSynced()
+		return vmiInformer.HasSynced() && podInformer.HasSynced() && migrationInformer.HasSynced() && pdbInformer.HasSynced() && resourceQuotaInformer.HasSynced() &&
+			namespaceInformer.HasSynced()
 	}
 
 	_, err := vmiInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
@@ -367,7 +380,7 @@ func (c *Controller) execute(key string) error {
 		return err
 	}
 
-	if syncErr != nil {
+	if syncErr != nil && !errors.Is(syncErr, migrationQueuedForMaintenanceWindowError) {
 		return syncErr
 	}
 
@@ -420,11 +433,26 @@ func (c *Controller) failMigration(migration *virtv1.VirtualMachineInstanceMigra
 	if err != nil {
 		return err
 	}
-	migration.Status.Phase = virtv1.MigrationFailed
+	if isCancelledBySubresource(migration) {
+		migration.Status.Phase = virtv1.MigrationCancelled
+	} else {
+		migration.Status.Phase = virtv1.MigrationFailed
+	}
 
 	return nil
 }
 
+// isCancelledBySubresource tells if the migration was cancelled through the cancel subresource
+func isCancelledBySubresource(migration *virtv1.VirtualMachineInstanceMigration) bool {
+	return controller.NewVirtualMachineInstanceMigrationConditionManager().
+		HasCondition(migration, virtv1.VirtualMachineInstanceMigrationCancelRequested)
+}
+
+// isCancelRequested tells if the migration was deleted or cancelled through the cancel subresource
+func isCancelRequested(migration *virtv1.VirtualMachineInstanceMigration) bool {
+	return migration.DeletionTimestamp != nil || isCancelledBySubresource(migration)
+}
+
 func (c *Controller) interruptMigration(migration *virtv1.VirtualMachineInstanceMigration, vmi *virtv1.VirtualMachineInstance) error {
 	if vmi == nil || !backendstorage.IsBackendStorageNeededForVMI(&vmi.Spec) {
 		return c.failMigration(migration)
@@ -480,8 +508,12 @@ func (c *Controller) updateStatus(migration *virtv1.VirtualMachineInstanceMigrat
 		}
 		c.recorder.Eventf(migration, k8sv1.EventTypeWarning, controller.FailedMigrationReason, "Migration failed vmi shutdown during migration.")
 		log.Log.Object(migration).Error("Unable to migrate vmi because vmi is shutdown.")
-	} else if migration.DeletionTimestamp != nil && !c.isMigrationHandedOff(migration, vmi) {
-		c.recorder.Eventf(migration, k8sv1.EventTypeWarning, controller.FailedMigrationReason, "Migration failed due to being canceled")
+	} else if isCancelRequested(migration) && !c.isMigrationHandedOff(migration, vmi) {
+		if cond := conditionManager.GetCondition(migration, virtv1.VirtualMachineInstanceMigrationCancelRequested); cond != nil {
+			c.recorder.Eventf(migration, k8sv1.EventTypeNormal, controller.CancelledMigrationReason, "Migration cancelled: %s", cond.Message)
+		} else {
+			c.recorder.Eventf(migration, k8sv1.EventTypeWarning, controller.FailedMigrationReason, "Migration failed due to being canceled")
+		}
 		if !conditionManager.HasCondition(migration, virtv1.VirtualMachineInstanceMigrationAbortRequested) {
 			condition := virtv1.VirtualMachineInstanceMigrationCondition{
 				Type:          virtv1.VirtualMachineInstanceMigrationAbortRequested,
@@ -534,7 +566,7 @@ func (c *Controller) updateStatus(migration *virtv1.VirtualMachineInstanceMigrat
 		c.recorder.Eventf(migration, k8sv1.EventTypeWarning, controller.FailedMigrationReason, "source node reported migration failed")
 		log.Log.Object(migration).Errorf("VMI %s/%s reported migration failed", vmi.Namespace, vmi.Name)
 
-	} else if migration.DeletionTimestamp != nil && !migration.IsFinal() &&
+	} else if isCancelRequested(migration) && !migration.IsFinal() &&
 		!conditionManager.HasCondition(migration, virtv1.VirtualMachineInstanceMigrationAbortRequested) {
 		condition := virtv1.VirtualMachineInstanceMigrationCondition{
 			Type:          virtv1.VirtualMachineInstanceMigrationAbortRequested,
@@ -556,7 +588,7 @@ func (c *Controller) updateStatus(migration *virtv1.VirtualMachineInstanceMigrat
 		}
 	}
 
-	if migrationCopy.Status.Phase == virtv1.MigrationFailed {
+	if migrationCopy.Status.Phase == virtv1.MigrationFailed || migrationCopy.Status.Phase == virtv1.MigrationCancelled {
 		if err := descheduler.MarkSourcePodEvictionCompleted(c.clientset, migrationCopy, c.podIndexer); err != nil {
 			return err
 		}
@@ -564,6 +596,9 @@ func (c *Controller) updateStatus(migration *virtv1.VirtualMachineInstanceMigrat
 
 	controller.SetVMIMigrationPhaseTransitionTimestamp(migration, migrationCopy)
 	controller.SetSourcePod(migrationCopy, vmi, c.podIndexer)
+	if !migration.IsFinal() {
+		syncMigrationProgress(migrationCopy, vmi)
+	}
 
 	if !equality.Semantic.DeepEqual(migration.Status, migrationCopy.Status) {
 		var err error
@@ -582,6 +617,20 @@ func (c *Controller) updateStatus(migration *virtv1.VirtualMachineInstanceMigrat
 	return nil
 }
 
+// syncMigrationProgress reflects the nodes and the progress of a running migration reported on the VMI, the
+// complete migration state is only stored once the migration is final.
+func syncMigrationProgress(migration *virtv1.VirtualMachineInstanceMigration, vmi *virtv1.VirtualMachineInstance) {
+	if vmi == nil || vmi.Status.MigrationState == nil || vmi.Status.MigrationState.MigrationUID != migration.UID {
+		return
+	}
+	if migration.Status.MigrationState == nil {
+		migration.Status.MigrationState = &virtv1.VirtualMachineInstanceMigrationState{}
+	}
+	migration.Status.MigrationState.SourceNode = vmi.Status.MigrationState.SourceNode
+	migration.Status.MigrationState.TargetNode = vmi.Status.MigrationState.TargetNode
+	migration.Status.MigrationState.Progress = vmi.Status.MigrationState.Progress.DeepCopy()
+}
+
 func (c *Controller) processMigrationPhase(
 	migration, migrationCopy *virtv1.VirtualMachineInstanceMigration,
 	pod, attachmentPod *k8sv1.Pod,
@@ -618,11 +667,21 @@ func (c *Controller) processMigrationPhase(
 			} else {
 				migrationCopy.Status.Phase = virtv1.MigrationScheduling
 			}
+		} else if errors.Is(syncError, migrationQueuedForMaintenanceWindowError) &&
+			!conditionManager.HasCondition(migration, virtv1.VirtualMachineInstanceMigrationQueuedForMaintenanceWindow) {
+			condition := virtv1.VirtualMachineInstanceMigrationCondition{
+				Type:          virtv1.VirtualMachineInstanceMigrationQueuedForMaintenanceWindow,
+				Status:        k8sv1.ConditionTrue,
+				LastProbeTime: v1.Now(),
+				Message:       syncError.Error(),
+			}
+			migrationCopy.Status.Conditions = append(migrationCopy.Status.Conditions, condition)
 		} else if syncError != nil && strings.Contains(syncError.Error(), "exceeded quota") && !conditionManager.HasCondition(migration, virtv1.VirtualMachineInstanceMigrationRejectedByResourceQuota) {
 			condition := virtv1.VirtualMachineInstanceMigrationCondition{
 				Type:          virtv1.VirtualMachineInstanceMigrationRejectedByResourceQuota,
 				Status:        k8sv1.ConditionTrue,
 				LastProbeTime: v1.Now(),
+				Message:       syncError.Error(),
 			}
 			migrationCopy.Status.Conditions = append(migrationCopy.Status.Conditions, condition)
 		}
@@ -630,6 +689,9 @@ func (c *Controller) processMigrationPhase(
 		if conditionManager.HasCondition(migrationCopy, virtv1.VirtualMachineInstanceMigrationRejectedByResourceQuota) {
 			conditionManager.RemoveCondition(migrationCopy, virtv1.VirtualMachineInstanceMigrationRejectedByResourceQuota)
 		}
+		if conditionManager.HasCondition(migrationCopy, virtv1.VirtualMachineInstanceMigrationQueuedForMaintenanceWindow) {
+			conditionManager.RemoveCondition(migrationCopy, virtv1.VirtualMachineInstanceMigrationQueuedForMaintenanceWindow)
+		}
 		if controller.IsPodReady(pod) {
 			if controller.VMIHasHotplugVolumes(vmi) {
 				if attachmentPod != nil && controller.IsPodReady(attachmentPod) {
@@ -792,6 +854,29 @@ func (c *Controller) createTargetPod(migration *virtv1.VirtualMachineInstanceMig
 		}
 	}
 
+	hintsRequest := &virtv1.PlacementHintsRequest{
+		Operation:     virtv1.PlacementHintsOperationMigration,
+		Namespace:     vmi.Namespace,
+		Name:          vmi.Name,
+		UID:           vmi.UID,
+		Labels:        vmi.Labels,
+		SourceNode:    vmi.Status.NodeName,
+		MigrationName: migration.Name,
+	}
+	if err := c.placementHinter.AddHints(templatePod, hintsRequest); err != nil {
+		c.recorder.Eventf(migration, k8sv1.EventTypeWarning, controller.FailedPlacementHintsReason, "%v", err)
+		return err
+	}
+
+	// The target pod doubles the usage of the VMI until the migration is complete, wait for the quota instead of failing the creation.
+	// Target pods excluded from the quota are left out by the quota webhook of the namespace.
+	if templatePod.Labels[virtv1.MigrationQuotaExcludedLabel] != "true" {
+		if err := c.checkResourceQuotas(vmi.Namespace, templatePod); err != nil {
+			c.recorder.Eventf(migration, k8sv1.EventTypeWarning, controller.FailedCreatePodReason, "Waiting for the namespace ResourceQuota to fit the migration target pod: %v", err)
+			return fmt.Errorf("failed to create vmi migration target pod: %v", err)
+		}
+	}
+
 	key := controller.MigrationKey(migration)
 	c.podExpectations.ExpectCreations(key, 1)
 	pod, err := c.clientset.CoreV1().Pods(vmi.GetNamespace()).Create(context.Background(), templatePod, v1.CreateOptions{})
@@ -899,6 +984,32 @@ func (c *Controller) handleMigrationBackoff(key string, vmi *virtv1.VirtualMachi
 	return nil
 }
 
+// handleMaintenanceWindow holds back migrations created by the evacuation controller or the workload updater
+// while all maintenance windows of the migration policy matching the VMI are closed.
+func (c *Controller) handleMaintenanceWindow(
+	key string, vmi *virtv1.VirtualMachineInstance, migration *virtv1.VirtualMachineInstanceMigration,
+) error {
+	_, existsEvacMig := migration.Annotations[virtv1.EvacuationMigrationAnnotation]
+	_, existsWorkUpdMig := migration.Annotations[virtv1.WorkloadUpdateMigrationAnnotation]
+	if !existsEvacMig && !existsWorkUpdMig {
+		return nil
+	}
+
+	policy, err := c.findMigrationPolicy(vmi)
+	if err != nil {
+		return err
+	}
+	if policy == nil || len(policy.Spec.MaintenanceWindows) == 0 ||
+		migrationsutil.IsInMaintenanceWindow(policy.Spec.MaintenanceWindows, time.Now()) {
+		return nil
+	}
+
+	log.Log.Object(migration).V(3).Infof("Waiting for a maintenance window of migration policy %s to migrate vmi %s/%s",
+		policy.Name, vmi.Namespace, vmi.Name)
+	c.Queue.AddWithOpts(priorityqueue.AddOpts{After: maintenanceWindowRecheckInterval, Priority: lowPriority}, key)
+	return migrationQueuedForMaintenanceWindowError
+}
+
 func (c *Controller) handleMarkMigrationFailedOnVMI(migration *virtv1.VirtualMachineInstanceMigration, vmi *virtv1.VirtualMachineInstance) error {
 
 	// Mark Migration Done on VMI if virt handler never started it.
@@ -995,6 +1106,10 @@ func (c *Controller) handleTargetPodHandoff(migration *virtv1.VirtualMachineInst
 		vmiCopy.Status.MigrationState.MigrationConfiguration = clusterMigrationConfigs
 	}
 
+	if err := c.tuneMigrationBandwidth(vmiCopy); err != nil {
+		return fmt.Errorf("failed to tune the migration bandwidth: %v", err)
+	}
+
 	if controller.VMIHasHotplugCPU(vmi) && vmi.IsCPUDedicated() {
 		cpuLimitsCount, err := getTargetPodLimitsCount(pod)
 		if err != nil {
@@ -1394,7 +1509,7 @@ func (c *Controller) sync(key string, migration *virtv1.VirtualMachineInstanceMi
 
 	switch migration.Status.Phase {
 	case virtv1.MigrationPending:
-		if migration.DeletionTimestamp != nil {
+		if isCancelRequested(migration) {
 			return c.handlePreHandoffMigrationCancel(migration, vmi, pod)
 		}
 		if err = c.handleMigrationBackoff(key, vmi, migration); errors.Is(err, migrationBackoffError) {
@@ -1404,6 +1519,10 @@ func (c *Controller) sync(key string, migration *virtv1.VirtualMachineInstanceMi
 		}
 
 		if !targetPodExists {
+			if err := c.handleMaintenanceWindow(key, vmi, migration); err != nil {
+				return err
+			}
+
 			sourcePod, err := controller.CurrentVMIPod(vmi, c.podIndexer)
 			if err != nil {
 				log.Log.Reason(err).Error("Failed to fetch pods for namespace from cache.")
@@ -1452,7 +1571,7 @@ func (c *Controller) sync(key string, migration *virtv1.VirtualMachineInstanceMi
 			return c.handlePendingPodTimeout(migration, vmi, pod)
 		}
 	case virtv1.MigrationScheduling:
-		if migration.DeletionTimestamp != nil {
+		if isCancelRequested(migration) {
 			return c.handlePreHandoffMigrationCancel(migration, vmi, pod)
 		}
 
@@ -1461,7 +1580,7 @@ func (c *Controller) sync(key string, migration *virtv1.VirtualMachineInstanceMi
 		}
 
 	case virtv1.MigrationScheduled:
-		if migration.DeletionTimestamp != nil && !c.isMigrationHandedOff(migration, vmi) {
+		if isCancelRequested(migration) && !c.isMigrationHandedOff(migration, vmi) {
 			return c.handlePreHandoffMigrationCancel(migration, vmi, pod)
 		}
 
@@ -1470,7 +1589,15 @@ func (c *Controller) sync(key string, migration *virtv1.VirtualMachineInstanceMi
 		if targetPodExists && controller.IsPodReady(pod) {
 			return c.handleTargetPodHandoff(migration, vmi, pod)
 		}
-	case virtv1.MigrationPreparingTarget, virtv1.MigrationTargetReady, virtv1.MigrationFailed:
+	case virtv1.MigrationPreparingTarget, virtv1.MigrationTargetReady, virtv1.MigrationFailed, virtv1.MigrationCancelled:
+		// The target pod of a migration cancelled before the hand off must not outlive it
+		if migration.Status.Phase == virtv1.MigrationCancelled && targetPodExists && pod.DeletionTimestamp == nil &&
+			!c.isMigrationHandedOff(migration, vmi) {
+			if err = c.handlePreHandoffMigrationCancel(migration, vmi, pod); err != nil {
+				return err
+			}
+		}
+
 		if (!targetPodExists || controller.PodIsDown(pod)) &&
 			vmi.Status.MigrationState != nil &&
 			len(vmi.Status.MigrationState.TargetDirectMigrationNodePorts) == 0 &&
@@ -1484,18 +1611,20 @@ func (c *Controller) sync(key string, migration *virtv1.VirtualMachineInstanceMi
 			}
 		}
 
-		if migration.Status.Phase != virtv1.MigrationFailed {
+		if migration.Status.Phase != virtv1.MigrationFailed && migration.Status.Phase != virtv1.MigrationCancelled {
 			return nil
 		}
 
 		return descheduler.MarkSourcePodEvictionCompleted(c.clientset, migration, c.podIndexer)
 	case virtv1.MigrationRunning:
-		if migration.DeletionTimestamp != nil && vmi.Status.MigrationState != nil {
+		if isCancelRequested(migration) && vmi.Status.MigrationState != nil {
 			err = c.markMigrationAbortInVmiStatus(migration, vmi)
 			if err != nil {
 				return err
 			}
+			return nil
 		}
+		return c.retuneMigrationBandwidth(key, migration, vmi)
 	}
 
 	return nil
@@ -2122,10 +2251,10 @@ func isNodeSuitableForHostModelMigration(node *k8sv1.Node, requiredNodeLabels ma
 	return true
 }
 
-func (c *Controller) matchMigrationPolicy(vmi *virtv1.VirtualMachineInstance, clusterMigrationConfiguration *virtv1.MigrationConfiguration) error {
-	vmiNamespace, err := c.clientset.CoreV1().Namespaces().Get(context.Background(), vmi.Namespace, v1.GetOptions{})
+func (c *Controller) findMigrationPolicy(vmi *virtv1.VirtualMachineInstance) (*v1alpha1.MigrationPolicy, error) {
+	vmiNamespace, err := c.getNamespace(vmi.Namespace)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	// Fetch cluster policies
@@ -2137,8 +2266,28 @@ func (c *Controller) matchMigrationPolicy(vmi *virtv1.VirtualMachineInstance, cl
 	}
 	policiesListObj := v1alpha1.MigrationPolicyList{Items: policies}
 
+	return matchPolicy(&policiesListObj, vmi, vmiNamespace), nil
+}
+
+// getNamespace reads the namespace from the informer, automated migrations waiting for a maintenance window look
+// it up every time they are rechecked. Only a namespace which was just created is fetched from the API server.
+func (c *Controller) getNamespace(name string) (*k8sv1.Namespace, error) {
+	obj, exists, err := c.namespaceStore.GetByKey(name)
+	if err != nil {
+		return nil, err
+	}
+	if exists {
+		return obj.(*k8sv1.Namespace), nil
+	}
+	return c.clientset.CoreV1().Namespaces().Get(context.Background(), name, v1.GetOptions{})
+}
+
+func (c *Controller) matchMigrationPolicy(vmi *virtv1.VirtualMachineInstance, clusterMigrationConfiguration *virtv1.MigrationConfiguration) error {
 	// Override cluster-wide migration configuration if migration policy is matched
-	matchedPolicy := matchPolicy(&policiesListObj, vmi, vmiNamespace)
+	matchedPolicy, err := c.findMigrationPolicy(vmi)
+	if err != nil {
+		return err
+	}
 
 	if matchedPolicy == nil {
 		log.Log.Object(vmi).Reason(err).Infof("no migration policy matched for VMI %s", vmi.Name)
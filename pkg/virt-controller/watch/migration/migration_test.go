@@ -40,11 +40,13 @@ import (
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/apimachinery/pkg/util/rand"
 	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/tools/record"
 
@@ -62,6 +64,7 @@ import (
 	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
 	"kubevirt.io/kubevirt/pkg/virt-controller/services"
 	"kubevirt.io/kubevirt/pkg/virt-controller/watch/descheduler"
+	"kubevirt.io/kubevirt/pkg/virt-controller/watch/placement"
 )
 
 var _ = Describe("Migration watcher", func() {
@@ -74,6 +77,8 @@ var _ = Describe("Migration watcher", func() {
 		kubeClient    *fake.Clientset
 		networkClient *fakenetworkclient.Clientset
 		namespace     k8sv1.Namespace
+
+		namespaceStore cache.Store
 	)
 	const qemuGid int64 = 107
 
@@ -244,6 +249,7 @@ var _ = Describe("Migration watcher", func() {
 		pdbInformer, _ := testutils.NewFakeInformerFor(&policyv1.PodDisruptionBudget{})
 		resourceQuotaInformer, _ := testutils.NewFakeInformerFor(&k8sv1.ResourceQuota{})
 		namespaceInformer, _ := testutils.NewFakeInformerFor(&k8sv1.Namespace{})
+		namespaceStore = namespaceInformer.GetStore()
 		migrationPolicyInformer, _ := testutils.NewFakeInformerFor(&migrationsv1.MigrationPolicy{})
 		recorder = record.NewFakeRecorder(100)
 		recorder.IncludeObject = true
@@ -266,6 +272,7 @@ var _ = Describe("Migration watcher", func() {
 			pdbInformer,
 			migrationPolicyInformer,
 			resourceQuotaInformer,
+			namespaceInformer,
 			recorder,
 			virtClient,
 			config,
@@ -654,6 +661,124 @@ var _ = Describe("Migration watcher", func() {
 			expectPodCreation(vmi.Namespace, vmi.UID, migration.UID, 1, 0, 0)
 		})
 
+		It("should add the placement hints to the target pod", func() {
+			hinter := &stubPlacementHinter{response: &virtv1.PlacementHintsResponse{
+				PreferredNodes: []virtv1.PreferredNode{{Node: "node03", Weight: 50}},
+			}}
+			controller.placementHinter = hinter
+			vmi := newVirtualMachine("testvmi", virtv1.Running)
+			addNodeNameToVMI(vmi, "node02")
+			migration := newMigration("testmigration", vmi.Name, virtv1.MigrationPending)
+
+			addMigration(migration)
+			addVirtualMachineInstance(vmi)
+			addPod(newSourcePodForVirtualMachine(vmi))
+
+			sanityExecute()
+
+			testutils.ExpectEvents(recorder, virtcontroller.SuccessfulCreatePodReason)
+			Expect(hinter.requests).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Operation":     Equal(virtv1.PlacementHintsOperationMigration),
+				"SourceNode":    Equal("node02"),
+				"MigrationName": Equal(migration.Name),
+			}))))
+			targetPod, err := getTargetPod(kubeClient, vmi.Namespace, vmi.UID, migration.UID)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(targetPod.Spec.Affinity.NodeAffinity.PreferredDuringSchedulingIgnoredDuringExecution).To(ConsistOf(
+				HaveField("Weight", int32(50)),
+			))
+		})
+
+		It("should not create the target pod if the placement hints are required and can't be retrieved", func() {
+			controller.placementHinter = &stubPlacementHinter{err: errors.New("placement service unavailable")}
+			vmi := newVirtualMachine("testvmi", virtv1.Running)
+			migration := newMigration("testmigration", vmi.Name, virtv1.MigrationPending)
+
+			addMigration(migration)
+			addVirtualMachineInstance(vmi)
+			addPod(newSourcePodForVirtualMachine(vmi))
+
+			controller.Execute()
+
+			testutils.ExpectEvents(recorder, virtcontroller.FailedPlacementHintsReason)
+			_, err := getTargetPod(kubeClient, vmi.Namespace, vmi.UID, migration.UID)
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should wait for the resource quota instead of creating a target pod exceeding it", func() {
+			vmi := newVirtualMachine("testvmi", virtv1.Running)
+			migration := newMigration("testmigration", vmi.Name, virtv1.MigrationPending)
+
+			Expect(controller.resourceQuotaIndexer.Add(&k8sv1.ResourceQuota{
+				ObjectMeta: metav1.ObjectMeta{Name: "pod-quota", Namespace: vmi.Namespace},
+				Status: k8sv1.ResourceQuotaStatus{
+					Hard: k8sv1.ResourceList{k8sv1.ResourcePods: resource.MustParse("1")},
+					Used: k8sv1.ResourceList{k8sv1.ResourcePods: resource.MustParse("1")},
+				},
+			})).To(Succeed())
+			addMigration(migration)
+			addVirtualMachineInstance(vmi)
+			addPod(newSourcePodForVirtualMachine(vmi))
+
+			controller.Execute()
+
+			testutils.ExpectEvents(recorder, virtcontroller.FailedCreatePodReason)
+			pods, err := kubeClient.CoreV1().Pods(vmi.Namespace).List(context.Background(), metav1.ListOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(pods.Items).To(HaveLen(1))
+			expectMigrationCondition(migration.Namespace, migration.Name, virtv1.VirtualMachineInstanceMigrationRejectedByResourceQuota)
+		})
+
+		It("should create target pod exceeding the resource quota if the namespace excludes it from the quota", func() {
+			vmi := newVirtualMachine("testvmi", virtv1.Running)
+			migration := newMigration("testmigration", vmi.Name, virtv1.MigrationPending)
+
+			excludedNamespace := namespace.DeepCopy()
+			excludedNamespace.Annotations = map[string]string{virtv1.MigrationQuotaExclusionAnnotation: "true"}
+			Expect(namespaceStore.Add(excludedNamespace)).To(Succeed())
+			Expect(controller.resourceQuotaIndexer.Add(&k8sv1.ResourceQuota{
+				ObjectMeta: metav1.ObjectMeta{Name: "pod-quota", Namespace: vmi.Namespace},
+				Status: k8sv1.ResourceQuotaStatus{
+					Hard: k8sv1.ResourceList{k8sv1.ResourcePods: resource.MustParse("1")},
+					Used: k8sv1.ResourceList{k8sv1.ResourcePods: resource.MustParse("1")},
+				},
+			})).To(Succeed())
+			addMigration(migration)
+			addVirtualMachineInstance(vmi)
+			addPod(newSourcePodForVirtualMachine(vmi))
+
+			sanityExecute()
+
+			testutils.ExpectEvents(recorder, virtcontroller.SuccessfulCreatePodReason)
+			pods, err := kubeClient.CoreV1().Pods(vmi.Namespace).List(context.Background(), metav1.ListOptions{
+				LabelSelector: fmt.Sprintf("%s=%s", virtv1.MigrationJobLabel, string(migration.UID)),
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(pods.Items).To(HaveLen(1))
+			Expect(pods.Items[0].Labels).To(HaveKeyWithValue(virtv1.MigrationQuotaExcludedLabel, "true"))
+		})
+
+		It("should create target pod if it fits into the resource quota", func() {
+			vmi := newVirtualMachine("testvmi", virtv1.Running)
+			migration := newMigration("testmigration", vmi.Name, virtv1.MigrationPending)
+
+			Expect(controller.resourceQuotaIndexer.Add(&k8sv1.ResourceQuota{
+				ObjectMeta: metav1.ObjectMeta{Name: "pod-quota", Namespace: vmi.Namespace},
+				Status: k8sv1.ResourceQuotaStatus{
+					Hard: k8sv1.ResourceList{k8sv1.ResourcePods: resource.MustParse("2")},
+					Used: k8sv1.ResourceList{k8sv1.ResourcePods: resource.MustParse("1")},
+				},
+			})).To(Succeed())
+			addMigration(migration)
+			addVirtualMachineInstance(vmi)
+			addPod(newSourcePodForVirtualMachine(vmi))
+
+			sanityExecute()
+
+			testutils.ExpectEvents(recorder, virtcontroller.SuccessfulCreatePodReason)
+			expectPodCreation(vmi.Namespace, vmi.UID, migration.UID, 1, 0, 0)
+		})
+
 		It("should not create target pod if multiple pods exist in a non finalized state for VMI", func() {
 			vmi := newVirtualMachine("testvmi", virtv1.Running)
 			migration := newMigration("testmigration", vmi.Name, virtv1.MigrationPending)
@@ -1325,6 +1450,88 @@ var _ = Describe("Migration watcher", func() {
 			expectVirtualMachineInstanceLabels(vmi.Namespace, vmi.Name, HaveKeyWithValue(virtv1.MigrationTargetNodeNameLabel, "node01"))
 		})
 
+		It("should hand pod over to target virt-handler with an auto-tuned bandwidth", func() {
+			setConfig(&virtv1.KubeVirtConfiguration{
+				MigrationConfiguration: &virtv1.MigrationConfiguration{
+					BandwidthPerMigration: pointer.P(resource.MustParse("1Gi")),
+					BandwidthAutoTuning:   &virtv1.MigrationBandwidthAutoTuning{},
+				},
+			})
+			addNode(&k8sv1.Node{ObjectMeta: metav1.ObjectMeta{
+				Name: "node02",
+				Annotations: map[string]string{
+					virtv1.MigrationNetworkCapacityAnnotation: "1000Mi",
+					virtv1.MigrationNetworkUsageAnnotation:    "200Mi",
+				},
+			}})
+
+			vmi := newVirtualMachine("testvmi", virtv1.Running)
+			addNodeNameToVMI(vmi, "node02")
+			migration := newMigration("testmigration", vmi.Name, virtv1.MigrationScheduled)
+
+			targetPod := newTargetPodForVirtualMachine(vmi, migration, k8sv1.PodRunning)
+			targetPod.Spec.NodeName = "node01"
+			targetPod.Status.ContainerStatuses = []k8sv1.ContainerStatus{{
+				Name: "compute", State: k8sv1.ContainerState{Running: &k8sv1.ContainerStateRunning{}},
+			}}
+
+			addMigration(migration)
+			addVirtualMachineInstance(vmi)
+			addPod(newSourcePodForVirtualMachine(vmi))
+			addPod(targetPod)
+
+			sanityExecute()
+
+			testutils.ExpectEvent(recorder, virtcontroller.SuccessfulHandOverPodReason)
+			updatedVMI, err := virtClientset.KubevirtV1().VirtualMachineInstances(vmi.Namespace).Get(context.Background(), vmi.Name, metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(updatedVMI.Status.MigrationState.MigrationConfiguration.BandwidthPerMigration.Value()).To(Equal(int64(600 * 1024 * 1024)))
+		})
+
+		It("should re-tune the bandwidth of a running migration to the traffic of the node without it", func() {
+			setConfig(&virtv1.KubeVirtConfiguration{
+				MigrationConfiguration: &virtv1.MigrationConfiguration{
+					BandwidthPerMigration: pointer.P(resource.MustParse("1Gi")),
+					BandwidthAutoTuning:   &virtv1.MigrationBandwidthAutoTuning{},
+				},
+			})
+			addNode(&k8sv1.Node{ObjectMeta: metav1.ObjectMeta{
+				Name: "node02",
+				Annotations: map[string]string{
+					virtv1.MigrationNetworkCapacityAnnotation: "1000Mi",
+					virtv1.MigrationNetworkUsageAnnotation:    "900Mi",
+				},
+			}})
+
+			vmi := newVirtualMachine("testvmi", virtv1.Running)
+			addNodeNameToVMI(vmi, "node02")
+			migration := newMigration("testmigration", vmi.Name, virtv1.MigrationRunning)
+			vmi.Status.MigrationState = &virtv1.VirtualMachineInstanceMigrationState{
+				MigrationUID:   migration.UID,
+				TargetNode:     "node01",
+				SourceNode:     "node02",
+				StartTimestamp: pointer.P(metav1.Now()),
+				MigrationConfiguration: &virtv1.MigrationConfiguration{
+					BandwidthPerMigration: pointer.P(resource.MustParse("600Mi")),
+					BandwidthAutoTuning:   &virtv1.MigrationBandwidthAutoTuning{},
+				},
+			}
+			targetPod := newTargetPodForVirtualMachine(vmi, migration, k8sv1.PodRunning)
+			targetPod.Spec.NodeName = "node01"
+
+			addMigration(migration)
+			addVirtualMachineInstance(vmi)
+			addPod(newSourcePodForVirtualMachine(vmi))
+			addPod(targetPod)
+
+			sanityExecute()
+
+			// 300Mi of the usage are not caused by the migration itself
+			updatedVMI, err := virtClientset.KubevirtV1().VirtualMachineInstances(vmi.Namespace).Get(context.Background(), vmi.Name, metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(updatedVMI.Status.MigrationState.MigrationConfiguration.BandwidthPerMigration.Value()).To(Equal(int64(500 * 1024 * 1024)))
+		})
+
 		It("should hand pod over to target virt-handler overriding previous state", func() {
 			vmi := newVirtualMachine("testvmi", virtv1.Running)
 			addNodeNameToVMI(vmi, "node02")
@@ -1618,6 +1825,39 @@ var _ = Describe("Migration watcher", func() {
 			expectMigrationFinalizerRemoved(migration.Namespace, migration.Name)
 		})
 
+		It("should reflect the nodes and the progress of a running migration", func() {
+			vmi := newVirtualMachine("testvmi", virtv1.Running)
+			addNodeNameToVMI(vmi, "node02")
+			migration := newMigration("testmigration", vmi.Name, virtv1.MigrationRunning)
+			targetPod := newTargetPodForVirtualMachine(vmi, migration, k8sv1.PodRunning)
+			targetPod.Spec.NodeName = "node01"
+
+			vmi.Status.MigrationState = &virtv1.VirtualMachineInstanceMigrationState{
+				MigrationUID:      migration.UID,
+				TargetNode:        "node01",
+				SourceNode:        "node02",
+				TargetNodeAddress: "10.10.10.10:1234",
+				StartTimestamp:    pointer.P(metav1.Now()),
+				Progress: &virtv1.MigrationProgress{
+					DataRemainingBytes: 3072,
+					DataTotalBytes:     4096,
+					PercentComplete:    25,
+				},
+			}
+			addMigration(migration)
+			addVirtualMachineInstance(vmi)
+			addPod(newSourcePodForVirtualMachine(vmi))
+			addPod(targetPod)
+
+			sanityExecute()
+
+			expectMigrationStateUpdated(migration.Namespace, migration.Name, &virtv1.VirtualMachineInstanceMigrationState{
+				SourceNode: "node02",
+				TargetNode: "node01",
+				Progress:   vmi.Status.MigrationState.Progress,
+			})
+		})
+
 		It("should delete itself if VMI no longer exists", func() {
 			migration := newMigration("testmigration", "somevmi", virtv1.MigrationRunning)
 			addMigration(migration)
@@ -2012,6 +2252,35 @@ var _ = Describe("Migration watcher", func() {
 				},
 				true,
 			),
+			Entry("set max downtime",
+				func(p *migrationsv1.MigrationPolicySpec) { p.MaxDowntimeMilliseconds = pointer.P(int64(800)) },
+				func(c *virtv1.MigrationConfiguration) {
+					Expect(c.MaxDowntimeMilliseconds).To(HaveValue(Equal(int64(800))))
+				},
+				true,
+			),
+			Entry("set post copy switchover iterations",
+				func(p *migrationsv1.MigrationPolicySpec) {
+					p.AllowPostCopy = pointer.P(true)
+					p.PostCopyAfterIterations = pointer.P(int64(3))
+				},
+				func(c *virtv1.MigrationConfiguration) {
+					Expect(c.AllowPostCopy).To(HaveValue(BeTrue()))
+					Expect(c.PostCopyAfterIterations).To(HaveValue(Equal(int64(3))))
+				},
+				true,
+			),
+			Entry("set parallel migration channels and compression",
+				func(p *migrationsv1.MigrationPolicySpec) {
+					p.ParallelMigrationChannels = pointer.P(uint32(16))
+					p.Compression = &virtv1.MigrationCompression{Method: virtv1.MigrationCompressionZstd}
+				},
+				func(c *virtv1.MigrationConfiguration) {
+					Expect(c.ParallelMigrationChannels).To(HaveValue(Equal(uint32(16))))
+					Expect(c.Compression).To(Equal(&virtv1.MigrationCompression{Method: virtv1.MigrationCompressionZstd}))
+				},
+				true,
+			),
 			Entry("nothing is changed",
 				func(p *migrationsv1.MigrationPolicySpec) {},
 				func(c *virtv1.MigrationConfiguration) {},
@@ -2097,6 +2366,195 @@ var _ = Describe("Migration watcher", func() {
 		})
 	})
 
+	Context("Migration cancellation through the cancel subresource", func() {
+		var vmi *virtv1.VirtualMachineInstance
+		var migration *virtv1.VirtualMachineInstanceMigration
+
+		BeforeEach(func() {
+			vmi = newVirtualMachine("testvmi", virtv1.Running)
+			addNodeNameToVMI(vmi, "node02")
+			migration = newMigration("testmigration", vmi.Name, virtv1.MigrationPending)
+			migration.Status.Conditions = append(migration.Status.Conditions, virtv1.VirtualMachineInstanceMigrationCondition{
+				Type:    virtv1.VirtualMachineInstanceMigrationCancelRequested,
+				Status:  k8sv1.ConditionTrue,
+				Message: "maintenance was called off",
+			})
+		})
+
+		expectMigrationPhase := func(phase virtv1.VirtualMachineInstanceMigrationPhase) {
+			updatedVMIM, err := virtClientset.KubevirtV1().VirtualMachineInstanceMigrations(migration.Namespace).
+				Get(context.Background(), migration.Name, metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(updatedVMIM.Status.Phase).To(Equal(phase))
+		}
+
+		DescribeTable("should delete the target pod and move to the cancelled phase before the hand off", func(
+			phase virtv1.VirtualMachineInstanceMigrationPhase,
+		) {
+			migration.Status.Phase = phase
+			addMigration(migration)
+			addVirtualMachineInstance(vmi)
+			addPod(newSourcePodForVirtualMachine(vmi))
+			addPod(newTargetPodForVirtualMachine(vmi, migration, k8sv1.PodPending))
+
+			sanityExecute()
+
+			testutils.ExpectEvents(recorder, virtcontroller.SuccessfulDeletePodReason, virtcontroller.CancelledMigrationReason)
+			expectPodDoesNotExist(vmi.Namespace, string(vmi.UID), string(migration.UID))
+			expectMigrationPhase(virtv1.MigrationCancelled)
+			expectMigrationCondition(migration.Namespace, migration.Name, virtv1.VirtualMachineInstanceMigrationAbortRequested)
+		},
+			Entry("pending", virtv1.MigrationPending),
+			Entry("scheduling", virtv1.MigrationScheduling),
+			Entry("scheduled", virtv1.MigrationScheduled),
+		)
+
+		It("should delete a target pod which outlived the cancelled migration", func() {
+			migration.Status.Phase = virtv1.MigrationCancelled
+			addMigration(migration)
+			addVirtualMachineInstance(vmi)
+			addPod(newSourcePodForVirtualMachine(vmi))
+			addPod(newTargetPodForVirtualMachine(vmi, migration, k8sv1.PodRunning))
+
+			sanityExecute()
+
+			testutils.ExpectEvent(recorder, virtcontroller.SuccessfulDeletePodReason)
+			expectPodDoesNotExist(vmi.Namespace, string(vmi.UID), string(migration.UID))
+		})
+
+		It("should abort a migration which was handed off", func() {
+			migration.Status.Phase = virtv1.MigrationRunning
+			targetPod := newTargetPodForVirtualMachine(vmi, migration, k8sv1.PodRunning)
+			targetPod.Spec.NodeName = "node01"
+			vmi.Status.MigrationState = &virtv1.VirtualMachineInstanceMigrationState{
+				MigrationUID:      migration.UID,
+				TargetNode:        "node01",
+				SourceNode:        "node02",
+				TargetNodeAddress: "10.10.10.10:1234",
+				StartTimestamp:    pointer.P(metav1.Now()),
+			}
+			controller.addHandOffKey(virtcontroller.MigrationKey(migration))
+			addMigration(migration)
+			addVirtualMachineInstance(vmi)
+			addPod(newSourcePodForVirtualMachine(vmi))
+			addPod(targetPod)
+
+			sanityExecute()
+
+			testutils.ExpectEvent(recorder, virtcontroller.SuccessfulAbortMigrationReason)
+			expectVirtualMachineInstanceMigrationState(vmi.Namespace, vmi.Name, PointTo(MatchFields(IgnoreExtras, Fields{
+				"MigrationUID":   Equal(migration.UID),
+				"AbortRequested": BeTrue(),
+			})))
+		})
+	})
+
+	Context("Migration maintenance windows", func() {
+		var vmi *virtv1.VirtualMachineInstance
+
+		addPolicyWithWindow := func(schedule string) {
+			policy := generatePolicyAndAlignVMI(vmi)
+			policy.Spec.MaintenanceWindows = []migrationsv1.MaintenanceWindow{
+				{Schedule: schedule, Duration: metav1.Duration{Duration: time.Minute}},
+			}
+			addMigrationPolicies(*policy)
+		}
+
+		// closedWindow opens every hour half an hour from now
+		closedWindow := func() string {
+			return fmt.Sprintf("%d * * * *", (time.Now().UTC().Minute()+30)%60)
+		}
+
+		BeforeEach(func() {
+			vmi = newVirtualMachine("testvmi", virtv1.Running)
+		})
+
+		DescribeTable("should queue an automated migration outside of the windows", func(ann string) {
+			migration := newMigration("testmigration", vmi.Name, virtv1.MigrationPending)
+			setAnnotation(ann, migration)
+			addPolicyWithWindow(closedWindow())
+			addMigration(migration)
+			addVirtualMachineInstance(vmi)
+			addPod(newSourcePodForVirtualMachine(vmi))
+
+			controller.Execute()
+
+			expectPodDoesNotExist(vmi.Namespace, string(vmi.UID), string(migration.UID))
+			expectMigrationPendingState(migration.Namespace, migration.Name)
+			expectMigrationCondition(migration.Namespace, migration.Name, virtv1.VirtualMachineInstanceMigrationQueuedForMaintenanceWindow)
+		},
+			Entry("with evacuation annotation", virtv1.EvacuationMigrationAnnotation),
+			Entry("with workload update annotation", virtv1.WorkloadUpdateMigrationAnnotation),
+		)
+
+		It("should read the namespace of the VMI from the informer", func() {
+			Expect(namespaceStore.Add(namespace.DeepCopy())).To(Succeed())
+			namespaceGets := 0
+			kubeClient.PrependReactor("get", "namespaces", func(_ k8stesting.Action) (bool, runtime.Object, error) {
+				namespaceGets++
+				return false, nil, nil
+			})
+			migration := newMigration("testmigration", vmi.Name, virtv1.MigrationPending)
+			setAnnotation(virtv1.WorkloadUpdateMigrationAnnotation, migration)
+			addPolicyWithWindow(closedWindow())
+			addMigration(migration)
+			addVirtualMachineInstance(vmi)
+			addPod(newSourcePodForVirtualMachine(vmi))
+
+			controller.Execute()
+
+			expectMigrationCondition(migration.Namespace, migration.Name, virtv1.VirtualMachineInstanceMigrationQueuedForMaintenanceWindow)
+			Expect(namespaceGets).To(BeZero())
+		})
+
+		It("should create the target pod of an automated migration within a window", func() {
+			migration := newMigration("testmigration", vmi.Name, virtv1.MigrationPending)
+			setAnnotation(virtv1.WorkloadUpdateMigrationAnnotation, migration)
+			addPolicyWithWindow("* * * * *")
+			addMigration(migration)
+			addVirtualMachineInstance(vmi)
+			addPod(newSourcePodForVirtualMachine(vmi))
+
+			sanityExecute()
+
+			testutils.ExpectEvents(recorder, virtcontroller.SuccessfulCreatePodReason)
+			expectPodCreation(vmi.Namespace, vmi.UID, migration.UID, 1, 0, 0)
+		})
+
+		It("should not queue a migration requested by a user", func() {
+			migration := newMigration("testmigration", vmi.Name, virtv1.MigrationPending)
+			addPolicyWithWindow(closedWindow())
+			addMigration(migration)
+			addVirtualMachineInstance(vmi)
+			addPod(newSourcePodForVirtualMachine(vmi))
+
+			sanityExecute()
+
+			testutils.ExpectEvents(recorder, virtcontroller.SuccessfulCreatePodReason)
+			expectPodCreation(vmi.Namespace, vmi.UID, migration.UID, 1, 0, 0)
+		})
+
+		It("should remove the condition once the target pod is scheduling", func() {
+			migration := newMigration("testmigration", vmi.Name, virtv1.MigrationScheduling)
+			setAnnotation(virtv1.WorkloadUpdateMigrationAnnotation, migration)
+			migration.Status.Conditions = []virtv1.VirtualMachineInstanceMigrationCondition{
+				{Type: virtv1.VirtualMachineInstanceMigrationQueuedForMaintenanceWindow, Status: k8sv1.ConditionTrue},
+			}
+			addMigration(migration)
+			addVirtualMachineInstance(vmi)
+			addPod(newSourcePodForVirtualMachine(vmi))
+			addPod(newTargetPodForVirtualMachine(vmi, migration, k8sv1.PodPending))
+
+			sanityExecute()
+
+			updatedVMIM, err := virtClientset.KubevirtV1().VirtualMachineInstanceMigrations(migration.Namespace).
+				Get(context.Background(), migration.Name, metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(updatedVMIM.Status.Phase).To(Equal(virtv1.MigrationScheduling))
+			Expect(updatedVMIM.Status.Conditions).To(BeEmpty())
+		})
+	})
+
 	Context("Migration backoff", func() {
 		var vmi *virtv1.VirtualMachineInstance
 
@@ -2649,3 +3107,20 @@ func getTargetPod(c *fake.Clientset, namespace string, uid types.UID, migrationU
 	}
 	return nil, errors.New("failed identifying target pod")
 }
+
+type stubPlacementHinter struct {
+	requests []*virtv1.PlacementHintsRequest
+	response *virtv1.PlacementHintsResponse
+	err      error
+}
+
+func (s *stubPlacementHinter) AddHints(pod *k8sv1.Pod, request *virtv1.PlacementHintsRequest) error {
+	s.requests = append(s.requests, request)
+	if s.err != nil {
+		return s.err
+	}
+	if s.response != nil {
+		placement.ApplyHints(pod, s.response)
+	}
+	return nil
+}
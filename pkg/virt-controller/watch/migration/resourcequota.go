@@ -0,0 +1,139 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package migration
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/client-go/tools/cache"
+)
+
+// checkResourceQuotas returns an error in the format of the ResourceQuota admission plugin if the target pod does not fit
+// into a ResourceQuota of its namespace. The source pod keeps counting against the quota until the migration is complete.
+func (c *Controller) checkResourceQuotas(namespace string, pod *k8sv1.Pod) error {
+	objs, err := c.resourceQuotaIndexer.ByIndex(cache.NamespaceIndex, namespace)
+	if err != nil {
+		return err
+	}
+
+	usage := podQuotaUsage(pod)
+	for _, obj := range objs {
+		quota := obj.(*k8sv1.ResourceQuota)
+		// Scoped quotas only apply to some pods, leave them to the admission plugin
+		if len(quota.Spec.Scopes) > 0 || quota.Spec.ScopeSelector != nil {
+			continue
+		}
+		if err := checkResourceQuota(quota, usage); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func checkResourceQuota(quota *k8sv1.ResourceQuota, usage k8sv1.ResourceList) error {
+	var exceeded []k8sv1.ResourceName
+	for name, requested := range usage {
+		hard, exists := quota.Status.Hard[name]
+		if !exists {
+			continue
+		}
+		total := quota.Status.Used[name].DeepCopy()
+		total.Add(requested)
+		if total.Cmp(hard) > 0 {
+			exceeded = append(exceeded, name)
+		}
+	}
+	if len(exceeded) == 0 {
+		return nil
+	}
+	sort.Slice(exceeded, func(i, j int) bool { return exceeded[i] < exceeded[j] })
+
+	return fmt.Errorf("exceeded quota: %s, requested: %s, used: %s, limited: %s",
+		quota.Name,
+		formatResources(exceeded, usage),
+		formatResources(exceeded, quota.Status.Used),
+		formatResources(exceeded, quota.Status.Hard),
+	)
+}
+
+// podQuotaUsage returns what the pod is charged by the ResourceQuota admission plugin for the compute resources
+func podQuotaUsage(pod *k8sv1.Pod) k8sv1.ResourceList {
+	usage := k8sv1.ResourceList{
+		k8sv1.ResourcePods: resource.MustParse("1"),
+		"count/pods":       resource.MustParse("1"),
+	}
+
+	requests, limits := podRequestsAndLimits(pod)
+	for name, quantity := range requests {
+		usage[k8sv1.DefaultResourceRequestsPrefix+name] = quantity
+		if name == k8sv1.ResourceCPU || name == k8sv1.ResourceMemory || name == k8sv1.ResourceEphemeralStorage {
+			usage[name] = quantity
+		}
+	}
+	for name, quantity := range limits {
+		usage[k8sv1.ResourceName("limits."+string(name))] = quantity
+	}
+	return usage
+}
+
+func podRequestsAndLimits(pod *k8sv1.Pod) (requests, limits k8sv1.ResourceList) {
+	requests, limits = k8sv1.ResourceList{}, k8sv1.ResourceList{}
+	for _, container := range pod.Spec.Containers {
+		addResources(requests, container.Resources.Requests)
+		addResources(limits, container.Resources.Limits)
+	}
+	// Init containers run one after the other, only the largest one counts if it is larger than all of the containers
+	for _, container := range pod.Spec.InitContainers {
+		maxResources(requests, container.Resources.Requests)
+		maxResources(limits, container.Resources.Limits)
+	}
+	addResources(requests, pod.Spec.Overhead)
+	addResources(limits, pod.Spec.Overhead)
+	return requests, limits
+}
+
+func addResources(list, other k8sv1.ResourceList) {
+	for name, quantity := range other {
+		current := list[name]
+		current.Add(quantity)
+		list[name] = current
+	}
+}
+
+func maxResources(list, other k8sv1.ResourceList) {
+	for name, quantity := range other {
+		if current, exists := list[name]; !exists || quantity.Cmp(current) > 0 {
+			list[name] = quantity.DeepCopy()
+		}
+	}
+}
+
+func formatResources(names []k8sv1.ResourceName, list k8sv1.ResourceList) string {
+	parts := make([]string, 0, len(names))
+	for _, name := range names {
+		quantity := list[name]
+		parts = append(parts, fmt.Sprintf("%s=%s", name, quantity.String()))
+	}
+	return strings.Join(parts, ",")
+}
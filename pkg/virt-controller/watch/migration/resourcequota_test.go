@@ -0,0 +1,112 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package migration
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("Migration target pod resource quota", func() {
+	newPod := func() *k8sv1.Pod {
+		return &k8sv1.Pod{
+			Spec: k8sv1.PodSpec{
+				Containers: []k8sv1.Container{{
+					Resources: k8sv1.ResourceRequirements{
+						Requests: k8sv1.ResourceList{
+							k8sv1.ResourceCPU:    resource.MustParse("500m"),
+							k8sv1.ResourceMemory: resource.MustParse("1Gi"),
+						},
+						Limits: k8sv1.ResourceList{
+							k8sv1.ResourceMemory: resource.MustParse("2Gi"),
+						},
+					},
+				}, {
+					Resources: k8sv1.ResourceRequirements{
+						Requests: k8sv1.ResourceList{
+							k8sv1.ResourceCPU:    resource.MustParse("100m"),
+							k8sv1.ResourceMemory: resource.MustParse("100Mi"),
+						},
+					},
+				}},
+				InitContainers: []k8sv1.Container{{
+					Resources: k8sv1.ResourceRequirements{
+						Requests: k8sv1.ResourceList{
+							k8sv1.ResourceCPU: resource.MustParse("2"),
+						},
+					},
+				}},
+				Overhead: k8sv1.ResourceList{
+					k8sv1.ResourceMemory: resource.MustParse("200Mi"),
+				},
+			},
+		}
+	}
+
+	It("should charge the pod like the resource quota admission plugin", func() {
+		usage := podQuotaUsage(newPod())
+
+		Expect(usage).To(HaveLen(7))
+		Expect(usage.Pods().String()).To(Equal("1"))
+		Expect(usage.Cpu().String()).To(Equal("2"))
+		Expect(usage.Memory().String()).To(Equal("1324Mi"))
+		requestsMemory := usage[k8sv1.ResourceRequestsMemory]
+		Expect(requestsMemory.String()).To(Equal("1324Mi"))
+		limitsMemory := usage[k8sv1.ResourceLimitsMemory]
+		Expect(limitsMemory.String()).To(Equal("2248Mi"))
+	})
+
+	DescribeTable("should check the resource quota", func(hard, used k8sv1.ResourceList, expectedErr string) {
+		err := checkResourceQuota(&k8sv1.ResourceQuota{
+			ObjectMeta: metav1.ObjectMeta{Name: "quota"},
+			Status:     k8sv1.ResourceQuotaStatus{Hard: hard, Used: used},
+		}, podQuotaUsage(newPod()))
+		if expectedErr == "" {
+			Expect(err).ToNot(HaveOccurred())
+		} else {
+			Expect(err).To(MatchError(expectedErr))
+		}
+	},
+		Entry("with room for the pod",
+			k8sv1.ResourceList{k8sv1.ResourceRequestsMemory: resource.MustParse("4Gi")},
+			k8sv1.ResourceList{k8sv1.ResourceRequestsMemory: resource.MustParse("1324Mi")},
+			"",
+		),
+		Entry("without room for the pod",
+			k8sv1.ResourceList{k8sv1.ResourceRequestsMemory: resource.MustParse("2Gi")},
+			k8sv1.ResourceList{k8sv1.ResourceRequestsMemory: resource.MustParse("1324Mi")},
+			"exceeded quota: quota, requested: requests.memory=1324Mi, used: requests.memory=1324Mi, limited: requests.memory=2Gi",
+		),
+		Entry("with several exhausted resources",
+			k8sv1.ResourceList{k8sv1.ResourcePods: resource.MustParse("1"), k8sv1.ResourceLimitsMemory: resource.MustParse("3Gi")},
+			k8sv1.ResourceList{k8sv1.ResourcePods: resource.MustParse("1"), k8sv1.ResourceLimitsMemory: resource.MustParse("2248Mi")},
+			"exceeded quota: quota, requested: limits.memory=2248Mi,pods=1, used: limits.memory=2248Mi,pods=1, limited: limits.memory=3Gi,pods=1",
+		),
+		Entry("with resources not limited by the quota",
+			k8sv1.ResourceList{k8sv1.ResourceServices: resource.MustParse("1")},
+			k8sv1.ResourceList{k8sv1.ResourceServices: resource.MustParse("1")},
+			"",
+		),
+	)
+})
@@ -0,0 +1,217 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package placement
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	k8sv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+
+	metrics "kubevirt.io/kubevirt/pkg/monitoring/metrics/virt-controller"
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
+)
+
+const (
+	defaultTimeout = 2 * time.Second
+	// maxResponseSize protects virt-controller from a misbehaving placement service
+	maxResponseSize = 1 << 20
+
+	minWeight = 1
+	maxWeight = 100
+)
+
+// Hinter consults the external placement service configured in the KubeVirt CR
+type Hinter interface {
+	// AddHints adds the hints of the placement service to the node affinity of the pod.
+	// An error is only returned when the failure policy is Fail.
+	AddHints(pod *k8sv1.Pod, request *v1.PlacementHintsRequest) error
+}
+
+type hinter struct {
+	clusterConfig *virtconfig.ClusterConfig
+
+	lock sync.Mutex
+	// client is rebuilt when the CA bundle changes
+	client   *http.Client
+	caBundle []byte
+}
+
+func NewHinter(clusterConfig *virtconfig.ClusterConfig) Hinter {
+	return &hinter{clusterConfig: clusterConfig}
+}
+
+func (h *hinter) AddHints(pod *k8sv1.Pod, request *v1.PlacementHintsRequest) error {
+	conf := h.clusterConfig.GetPlacementHintsConfiguration()
+	if conf == nil || conf.URL == "" {
+		return nil
+	}
+
+	response, err := h.requestHints(conf, request)
+	metrics.PlacementHintsRequested(string(request.Operation), err == nil)
+	if err != nil {
+		if conf.FailurePolicy != nil && *conf.FailurePolicy == v1.PlacementHintsFailurePolicyFail {
+			return fmt.Errorf("failed to get placement hints: %v", err)
+		}
+		log.Log.Reason(err).Warningf("Creating the pod of %s/%s without placement hints", request.Namespace, request.Name)
+		return nil
+	}
+
+	ApplyHints(pod, response)
+	return nil
+}
+
+func (h *hinter) requestHints(conf *v1.PlacementHintsConfiguration, request *v1.PlacementHintsRequest) (*v1.PlacementHintsResponse, error) {
+	client, err := h.getClient(conf.CABundle)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := json.Marshal(request)
+	if err != nil {
+		return nil, err
+	}
+
+	timeout := defaultTimeout
+	if conf.Timeout != nil && conf.Timeout.Duration > 0 {
+		timeout = conf.Timeout.Duration
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	httpRequest, err := http.NewRequestWithContext(ctx, http.MethodPost, conf.URL, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	httpRequest.Header.Set("Content-Type", "application/json")
+
+	httpResponse, err := client.Do(httpRequest)
+	if err != nil {
+		return nil, err
+	}
+	defer httpResponse.Body.Close()
+
+	if httpResponse.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("placement service returned %s", httpResponse.Status)
+	}
+
+	response := &v1.PlacementHintsResponse{}
+	if err := json.NewDecoder(io.LimitReader(httpResponse.Body, maxResponseSize)).Decode(response); err != nil {
+		return nil, fmt.Errorf("invalid response of the placement service: %v", err)
+	}
+	return response, nil
+}
+
+func (h *hinter) getClient(caBundle []byte) (*http.Client, error) {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	if h.client != nil && bytes.Equal(h.caBundle, caBundle) {
+		return h.client, nil
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if len(caBundle) > 0 {
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(caBundle) {
+			return nil, fmt.Errorf("invalid CA bundle of the placement service")
+		}
+		transport.TLSClientConfig = &tls.Config{RootCAs: pool, MinVersion: tls.VersionTLS12}
+	}
+
+	h.client = &http.Client{Transport: transport}
+	h.caBundle = caBundle
+	return h.client, nil
+}
+
+// ApplyHints adds the preferred nodes as preferred and the forbidden nodes as required node affinity to the pod.
+// Preferences with a weight outside of the range 1-100 are ignored.
+func ApplyHints(pod *k8sv1.Pod, response *v1.PlacementHintsResponse) {
+	var preferred []k8sv1.PreferredSchedulingTerm
+	for _, node := range response.PreferredNodes {
+		if node.Node == "" || node.Weight < minWeight || node.Weight > maxWeight {
+			continue
+		}
+		preferred = append(preferred, k8sv1.PreferredSchedulingTerm{
+			Weight: node.Weight,
+			Preference: k8sv1.NodeSelectorTerm{
+				MatchFields: []k8sv1.NodeSelectorRequirement{nodeNameRequirement(k8sv1.NodeSelectorOpIn, node.Node)},
+			},
+		})
+	}
+
+	var forbidden []string
+	for _, node := range response.ForbiddenNodes {
+		if node != "" {
+			forbidden = append(forbidden, node)
+		}
+	}
+
+	if len(preferred) == 0 && len(forbidden) == 0 {
+		return
+	}
+
+	if pod.Spec.Affinity == nil {
+		pod.Spec.Affinity = &k8sv1.Affinity{}
+	}
+	if pod.Spec.Affinity.NodeAffinity == nil {
+		pod.Spec.Affinity.NodeAffinity = &k8sv1.NodeAffinity{}
+	}
+	nodeAffinity := pod.Spec.Affinity.NodeAffinity
+
+	nodeAffinity.PreferredDuringSchedulingIgnoredDuringExecution = append(
+		nodeAffinity.PreferredDuringSchedulingIgnoredDuringExecution, preferred...)
+
+	if len(forbidden) == 0 {
+		return
+	}
+	requirement := nodeNameRequirement(k8sv1.NodeSelectorOpNotIn, forbidden...)
+	if nodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution == nil ||
+		len(nodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms) == 0 {
+		nodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution = &k8sv1.NodeSelector{
+			NodeSelectorTerms: []k8sv1.NodeSelectorTerm{{}},
+		}
+	}
+	// The terms are ORed, the forbidden nodes have to be excluded from each of them
+	terms := nodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms
+	for i := range terms {
+		terms[i].MatchFields = append(terms[i].MatchFields, requirement)
+	}
+}
+
+func nodeNameRequirement(operator k8sv1.NodeSelectorOperator, nodes ...string) k8sv1.NodeSelectorRequirement {
+	return k8sv1.NodeSelectorRequirement{
+		Key:      metav1.ObjectNameField,
+		Operator: operator,
+		Values:   nodes,
+	}
+}
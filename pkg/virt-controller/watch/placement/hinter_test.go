@@ -0,0 +1,219 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package placement
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	k8sv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	metrics "kubevirt.io/kubevirt/pkg/monitoring/metrics/virt-controller"
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/testutils"
+)
+
+var _ = Describe("Placement hinter", func() {
+	var (
+		server   *httptest.Server
+		received *v1.PlacementHintsRequest
+		handler  http.HandlerFunc
+	)
+
+	startRequest := &v1.PlacementHintsRequest{
+		Operation: v1.PlacementHintsOperationStart,
+		Namespace: "default",
+		Name:      "testvmi",
+		UID:       "vmi-uid",
+	}
+
+	respondWith := func(response *v1.PlacementHintsResponse) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			received = &v1.PlacementHintsRequest{}
+			Expect(json.NewDecoder(r.Body).Decode(received)).To(Succeed())
+			Expect(json.NewEncoder(w).Encode(response)).To(Succeed())
+		}
+	}
+
+	newHinter := func(conf *v1.PlacementHintsConfiguration) Hinter {
+		config, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{PlacementHints: conf})
+		return NewHinter(config)
+	}
+
+	requestsTotal := func(result string) float64 {
+		value, err := metrics.GetPlacementHintsRequestsTotal(string(v1.PlacementHintsOperationStart), result)
+		Expect(err).ToNot(HaveOccurred())
+		return value
+	}
+
+	BeforeEach(func() {
+		received = nil
+		handler = respondWith(&v1.PlacementHintsResponse{})
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			handler(w, r)
+		}))
+		DeferCleanup(server.Close)
+	})
+
+	It("should not touch the pod without a placement service", func() {
+		pod := &k8sv1.Pod{}
+		Expect(newHinter(nil).AddHints(pod, startRequest)).To(Succeed())
+		Expect(pod.Spec.Affinity).To(BeNil())
+	})
+
+	It("should send the request and apply the hints of the response", func() {
+		handler = respondWith(&v1.PlacementHintsResponse{
+			PreferredNodes: []v1.PreferredNode{{Node: "node01", Weight: 80}},
+			ForbiddenNodes: []string{"node02"},
+		})
+		succeeded := requestsTotal(metrics.PlacementHintsResultSucceeded)
+
+		pod := &k8sv1.Pod{}
+		Expect(newHinter(&v1.PlacementHintsConfiguration{URL: server.URL}).AddHints(pod, startRequest)).To(Succeed())
+		Expect(received).To(Equal(startRequest))
+		Expect(pod.Spec.Affinity.NodeAffinity.PreferredDuringSchedulingIgnoredDuringExecution).To(HaveLen(1))
+		Expect(pod.Spec.Affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms).To(HaveLen(1))
+		Expect(requestsTotal(metrics.PlacementHintsResultSucceeded)).To(Equal(succeeded + 1))
+	})
+
+	Context("when the placement service fails", func() {
+		BeforeEach(func() {
+			handler = func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusServiceUnavailable)
+			}
+		})
+
+		It("should create the pod without hints by default", func() {
+			failed := requestsTotal(metrics.PlacementHintsResultFailed)
+
+			pod := &k8sv1.Pod{}
+			Expect(newHinter(&v1.PlacementHintsConfiguration{URL: server.URL}).AddHints(pod, startRequest)).To(Succeed())
+			Expect(pod.Spec.Affinity).To(BeNil())
+			Expect(requestsTotal(metrics.PlacementHintsResultFailed)).To(Equal(failed + 1))
+		})
+
+		It("should fail with the Fail failure policy", func() {
+			hinter := newHinter(&v1.PlacementHintsConfiguration{
+				URL:           server.URL,
+				FailurePolicy: pointer.P(v1.PlacementHintsFailurePolicyFail),
+			})
+			Expect(hinter.AddHints(&k8sv1.Pod{}, startRequest)).To(MatchError(ContainSubstring("503")))
+		})
+	})
+
+	It("should give up once the timeout expired", func() {
+		done := make(chan struct{})
+		DeferCleanup(func() { close(done) })
+		handler = func(w http.ResponseWriter, r *http.Request) {
+			select {
+			case <-done:
+			case <-r.Context().Done():
+			}
+		}
+
+		hinter := newHinter(&v1.PlacementHintsConfiguration{
+			URL:           server.URL,
+			Timeout:       &metav1.Duration{Duration: 50 * time.Millisecond},
+			FailurePolicy: pointer.P(v1.PlacementHintsFailurePolicyFail),
+		})
+		Expect(hinter.AddHints(&k8sv1.Pod{}, startRequest)).To(MatchError(ContainSubstring("deadline exceeded")))
+	})
+
+	It("should fail with an invalid response", func() {
+		handler = func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte("not json"))
+		}
+
+		hinter := newHinter(&v1.PlacementHintsConfiguration{
+			URL:           server.URL,
+			FailurePolicy: pointer.P(v1.PlacementHintsFailurePolicyFail),
+		})
+		Expect(hinter.AddHints(&k8sv1.Pod{}, startRequest)).To(MatchError(ContainSubstring("invalid response")))
+	})
+
+	Context("ApplyHints", func() {
+		It("should prefer the nodes by weight and skip invalid weights", func() {
+			pod := &k8sv1.Pod{}
+			ApplyHints(pod, &v1.PlacementHintsResponse{
+				PreferredNodes: []v1.PreferredNode{
+					{Node: "node01", Weight: 100},
+					{Node: "node02", Weight: 0},
+					{Node: "node03", Weight: 101},
+					{Node: "", Weight: 50},
+				},
+			})
+			Expect(pod.Spec.Affinity.NodeAffinity.PreferredDuringSchedulingIgnoredDuringExecution).To(Equal([]k8sv1.PreferredSchedulingTerm{{
+				Weight: 100,
+				Preference: k8sv1.NodeSelectorTerm{
+					MatchFields: []k8sv1.NodeSelectorRequirement{
+						{Key: metav1.ObjectNameField, Operator: k8sv1.NodeSelectorOpIn, Values: []string{"node01"}},
+					},
+				},
+			}}))
+			Expect(pod.Spec.Affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution).To(BeNil())
+		})
+
+		It("should exclude the forbidden nodes from every required term", func() {
+			pod := &k8sv1.Pod{
+				Spec: k8sv1.PodSpec{
+					Affinity: &k8sv1.Affinity{
+						NodeAffinity: &k8sv1.NodeAffinity{
+							RequiredDuringSchedulingIgnoredDuringExecution: &k8sv1.NodeSelector{
+								NodeSelectorTerms: []k8sv1.NodeSelectorTerm{
+									{MatchExpressions: []k8sv1.NodeSelectorRequirement{
+										{Key: "zone", Operator: k8sv1.NodeSelectorOpIn, Values: []string{"a"}},
+									}},
+									{MatchExpressions: []k8sv1.NodeSelectorRequirement{
+										{Key: "zone", Operator: k8sv1.NodeSelectorOpIn, Values: []string{"b"}},
+									}},
+								},
+							},
+						},
+					},
+				},
+			}
+			ApplyHints(pod, &v1.PlacementHintsResponse{ForbiddenNodes: []string{"node01", "node02"}})
+
+			forbidden := k8sv1.NodeSelectorRequirement{
+				Key: metav1.ObjectNameField, Operator: k8sv1.NodeSelectorOpNotIn, Values: []string{"node01", "node02"},
+			}
+			terms := pod.Spec.Affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms
+			Expect(terms).To(HaveLen(2))
+			for _, term := range terms {
+				Expect(term.MatchExpressions).To(HaveLen(1))
+				Expect(term.MatchFields).To(ConsistOf(forbidden))
+			}
+		})
+
+		It("should leave the pod untouched without hints", func() {
+			pod := &k8sv1.Pod{}
+			ApplyHints(pod, &v1.PlacementHintsResponse{})
+			Expect(pod.Spec.Affinity).To(BeNil())
+		})
+	})
+})
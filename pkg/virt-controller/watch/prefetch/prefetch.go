@@ -0,0 +1,567 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package prefetch
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
+	cdiv1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
+
+	virtv1 "kubevirt.io/api/core/v1"
+	prefetchv1 "kubevirt.io/api/prefetch/v1alpha1"
+	"kubevirt.io/client-go/kubecli"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/controller"
+	metrics "kubevirt.io/kubevirt/pkg/monitoring/metrics/virt-controller"
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/util"
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
+)
+
+const (
+	// PodAppLabelValue is the value of the kubevirt.io label on prefetch pods
+	PodAppLabelValue = "image-prefetch"
+
+	SuccessfulCreatePodReason = "SuccessfulCreate"
+	FailedCreatePodReason     = "FailedCreate"
+	FailedPrefetchPodReason   = "FailedPrefetch"
+	PrefetchReadyReason       = "PrefetchReady"
+	PrefetchOverdueReason     = "PrefetchOverdue"
+
+	binVolumeName = "virt-bin-share-dir"
+
+	defaultAddDelay = 1 * time.Second
+)
+
+// Controller pulls the containerDisk images of an ImagePrefetch on the
+// selected nodes and tracks the readiness of its DataSources.
+type Controller struct {
+	clientset       kubecli.KubevirtClient
+	queue           workqueue.TypedRateLimitingInterface[string]
+	prefetchIndexer cache.Indexer
+	podIndexer      cache.Indexer
+	nodeStore       cache.Store
+	dataSourceStore cache.Store
+	recorder        record.EventRecorder
+	clusterConfig   *virtconfig.ClusterConfig
+	launcherImage   string
+	imagePullSecret string
+	hasSynced       func() bool
+}
+
+// NewController creates a new instance of the prefetch Controller.
+func NewController(clientset kubecli.KubevirtClient,
+	prefetchInformer cache.SharedIndexInformer,
+	podInformer cache.SharedIndexInformer,
+	nodeInformer cache.SharedIndexInformer,
+	dataSourceInformer cache.SharedIndexInformer,
+	recorder record.EventRecorder,
+	clusterConfig *virtconfig.ClusterConfig,
+	launcherImage string,
+	imagePullSecret string) (*Controller, error) {
+	c := &Controller{
+		clientset: clientset,
+		queue: workqueue.NewTypedRateLimitingQueueWithConfig[string](
+			workqueue.DefaultTypedControllerRateLimiter[string](),
+			workqueue.TypedRateLimitingQueueConfig[string]{Name: "virt-controller-prefetch"},
+		),
+		prefetchIndexer: prefetchInformer.GetIndexer(),
+		podIndexer:      podInformer.GetIndexer(),
+		nodeStore:       nodeInformer.GetStore(),
+		dataSourceStore: dataSourceInformer.GetStore(),
+		recorder:        recorder,
+		clusterConfig:   clusterConfig,
+		launcherImage:   launcherImage,
+		imagePullSecret: imagePullSecret,
+	}
+
+	c.hasSynced = func() bool {
+		return prefetchInformer.HasSynced() && podInformer.HasSynced() && nodeInformer.HasSynced() && dataSourceInformer.HasSynced()
+	}
+
+	_, err := prefetchInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    c.enqueuePrefetch,
+		DeleteFunc: c.enqueuePrefetch,
+		UpdateFunc: func(_, curr interface{}) { c.enqueuePrefetch(curr) },
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = podInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    c.handlePod,
+		DeleteFunc: c.handlePod,
+		UpdateFunc: func(_, curr interface{}) { c.handlePod(curr) },
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = nodeInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    c.handleNode,
+		DeleteFunc: c.handleNode,
+		UpdateFunc: func(old, curr interface{}) {
+			// a node whose labels changed may leave the selection of a prefetch
+			c.handleNode(old)
+			c.handleNode(curr)
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = dataSourceInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    c.handleDataSource,
+		DeleteFunc: c.handleDataSource,
+		UpdateFunc: func(_, curr interface{}) { c.handleDataSource(curr) },
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+func (c *Controller) enqueuePrefetch(obj interface{}) {
+	key, err := controller.KeyFunc(obj)
+	if err != nil {
+		log.Log.Reason(err).Error("Failed to extract key from image prefetch.")
+		return
+	}
+	c.queue.AddAfter(key, defaultAddDelay)
+}
+
+func (c *Controller) handlePod(obj interface{}) {
+	if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+		obj = tombstone.Obj
+	}
+	pod, ok := obj.(*k8sv1.Pod)
+	if !ok {
+		return
+	}
+	controllerRef := metav1.GetControllerOf(pod)
+	if controllerRef == nil || controllerRef.Kind != prefetchv1.ImagePrefetchKind {
+		return
+	}
+	c.queue.Add(controller.NamespacedKey(pod.Namespace, controllerRef.Name))
+}
+
+// handleNode enqueues the prefetches selecting the node
+func (c *Controller) handleNode(obj interface{}) {
+	if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+		obj = tombstone.Obj
+	}
+	node, ok := obj.(*k8sv1.Node)
+	if !ok {
+		return
+	}
+	for _, obj := range c.prefetchIndexer.List() {
+		prefetch := obj.(*prefetchv1.ImagePrefetch)
+		if labels.SelectorFromSet(prefetch.Spec.NodeSelector).Matches(labels.Set(node.Labels)) {
+			c.enqueuePrefetch(prefetch)
+		}
+	}
+}
+
+func (c *Controller) handleDataSource(obj interface{}) {
+	if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+		obj = tombstone.Obj
+	}
+	dataSource, ok := obj.(*cdiv1.DataSource)
+	if !ok {
+		return
+	}
+	for _, obj := range c.prefetchIndexer.List() {
+		prefetch := obj.(*prefetchv1.ImagePrefetch)
+		for _, ref := range prefetch.Spec.DataSources {
+			if ref.Name == dataSource.Name && dataSourceNamespace(prefetch, ref) == dataSource.Namespace {
+				c.enqueuePrefetch(prefetch)
+				break
+			}
+		}
+	}
+}
+
+func (c *Controller) Run(threadiness int, stopCh <-chan struct{}) {
+	defer controller.HandlePanic()
+	defer c.queue.ShutDown()
+	log.Log.Info("Starting image prefetch controller.")
+
+	cache.WaitForCacheSync(stopCh, c.hasSynced)
+
+	for i := 0; i < threadiness; i++ {
+		go wait.Until(c.runWorker, time.Second, stopCh)
+	}
+
+	<-stopCh
+	log.Log.Info("Stopping image prefetch controller.")
+}
+
+func (c *Controller) runWorker() {
+	for c.Execute() {
+	}
+}
+
+// Execute runs commands from the controller queue, if there is
+// an error it requeues the command. Returns false if the queue
+// is empty.
+func (c *Controller) Execute() bool {
+	key, quit := c.queue.Get()
+	if quit {
+		return false
+	}
+	defer c.queue.Done(key)
+
+	if err := c.execute(key); err != nil {
+		log.Log.Reason(err).Infof("reenqueuing image prefetch %v", key)
+		c.queue.AddRateLimited(key)
+	} else {
+		log.Log.V(4).Infof("processed image prefetch %v", key)
+		c.queue.Forget(key)
+	}
+	return true
+}
+
+func (c *Controller) execute(key string) error {
+	obj, exists, err := c.prefetchIndexer.GetByKey(key)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		namespace, name, err := cache.SplitMetaNamespaceKey(key)
+		if err != nil {
+			return err
+		}
+		metrics.DeleteImagePrefetchReadiness(name, namespace)
+		return nil
+	}
+	prefetch := obj.(*prefetchv1.ImagePrefetch)
+
+	if !c.clusterConfig.ImagePrefetchEnabled() {
+		log.Log.Object(prefetch).V(4).Infof("%s feature gate is disabled, ignoring image prefetch", prefetchv1.ImagePrefetchKind)
+		return nil
+	}
+	if prefetch.DeletionTimestamp != nil {
+		return nil
+	}
+
+	nodes, err := c.targetNodes(prefetch)
+	if err != nil {
+		return err
+	}
+
+	readyNodes, err := c.syncPods(prefetch, nodes)
+	if err != nil {
+		return err
+	}
+
+	return c.updateStatus(prefetch, int32(len(nodes)), readyNodes, c.countReadyDataSources(prefetch))
+}
+
+// targetNodes returns the names of the schedulable nodes matching the node selector
+func (c *Controller) targetNodes(prefetch *prefetchv1.ImagePrefetch) ([]string, error) {
+	if len(prefetch.Spec.ContainerDisks) == 0 {
+		return nil, nil
+	}
+	selector := labels.SelectorFromSet(prefetch.Spec.NodeSelector)
+
+	var nodes []string
+	for _, obj := range c.nodeStore.List() {
+		node := obj.(*k8sv1.Node)
+		if node.Spec.Unschedulable || !selector.Matches(labels.Set(node.Labels)) {
+			continue
+		}
+		nodes = append(nodes, node.Name)
+	}
+	return nodes, nil
+}
+
+// syncPods makes sure every target node runs a pod pulling the images and
+// returns the number of nodes on which the pod succeeded.
+func (c *Controller) syncPods(prefetch *prefetchv1.ImagePrefetch, nodes []string) (int32, error) {
+	objs, err := c.podIndexer.ByIndex(cache.NamespaceIndex, prefetch.Namespace)
+	if err != nil {
+		return 0, err
+	}
+
+	podsByNode := map[string]*k8sv1.Pod{}
+	for _, obj := range objs {
+		pod := obj.(*k8sv1.Pod)
+		if !metav1.IsControlledBy(pod, prefetch) || pod.DeletionTimestamp != nil {
+			continue
+		}
+		podsByNode[pod.Spec.NodeName] = pod
+	}
+
+	var readyNodes int32
+	for _, node := range nodes {
+		pod, exists := podsByNode[node]
+		if !exists {
+			if err := c.createPod(prefetch, node); err != nil {
+				return readyNodes, err
+			}
+			continue
+		}
+		switch pod.Status.Phase {
+		case k8sv1.PodSucceeded:
+			readyNodes++
+		case k8sv1.PodFailed:
+			// Remove the failed pod so that a new one retries the pull
+			c.recorder.Eventf(prefetch, k8sv1.EventTypeWarning, FailedPrefetchPodReason, "Prefetching images on node %s failed", node)
+			err := c.clientset.CoreV1().Pods(pod.Namespace).Delete(context.Background(), pod.Name, metav1.DeleteOptions{})
+			if err != nil && !errors.IsNotFound(err) {
+				return readyNodes, err
+			}
+		}
+	}
+	return readyNodes, nil
+}
+
+func (c *Controller) createPod(prefetch *prefetchv1.ImagePrefetch, node string) error {
+	pod, err := c.clientset.CoreV1().Pods(prefetch.Namespace).Create(context.Background(), c.renderPod(prefetch, node), metav1.CreateOptions{})
+	if err != nil {
+		c.recorder.Eventf(prefetch, k8sv1.EventTypeWarning, FailedCreatePodReason, "Error creating prefetch pod for node %s: %v", node, err)
+		return err
+	}
+	c.recorder.Eventf(prefetch, k8sv1.EventTypeNormal, SuccessfulCreatePodReason, "Created prefetch pod %s on node %s", pod.Name, node)
+	return nil
+}
+
+// renderPod returns a pod pinned to the node which runs a no-op container for
+// every containerDisk image, so that the kubelet pulls all of them.
+func (c *Controller) renderPod(prefetch *prefetchv1.ImagePrefetch, node string) *k8sv1.Pod {
+	userID := int64(util.NonRootUID)
+	securityContext := &k8sv1.SecurityContext{
+		RunAsUser:                &userID,
+		RunAsNonRoot:             pointer.P(true),
+		AllowPrivilegeEscalation: pointer.P(false),
+		Capabilities: &k8sv1.Capabilities{
+			Drop: []k8sv1.Capability{"ALL"},
+		},
+	}
+
+	var pullSecrets []k8sv1.LocalObjectReference
+	seenSecrets := map[string]bool{}
+	addPullSecret := func(name string) {
+		if name != "" && !seenSecrets[name] {
+			seenSecrets[name] = true
+			pullSecrets = append(pullSecrets, k8sv1.LocalObjectReference{Name: name})
+		}
+	}
+	addPullSecret(c.imagePullSecret)
+
+	var containers []k8sv1.Container
+	for i, disk := range prefetch.Spec.ContainerDisks {
+		addPullSecret(disk.ImagePullSecret)
+		containers = append(containers, k8sv1.Container{
+			Name:            fmt.Sprintf("disk-%d", i),
+			Image:           disk.Image,
+			ImagePullPolicy: disk.ImagePullPolicy,
+			Command:         []string{"/usr/bin/container-disk"},
+			Args:            []string{"--no-op"},
+			VolumeMounts: []k8sv1.VolumeMount{{
+				Name:      binVolumeName,
+				MountPath: "/usr/bin",
+			}},
+			Resources:       containerResources(),
+			SecurityContext: securityContext,
+		})
+	}
+
+	return &k8sv1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: prefetch.Name + "-",
+			Namespace:    prefetch.Namespace,
+			Labels: map[string]string{
+				virtv1.AppLabel:               PodAppLabelValue,
+				prefetchv1.ImagePrefetchLabel: prefetch.Name,
+			},
+			OwnerReferences: []metav1.OwnerReference{
+				*metav1.NewControllerRef(prefetch, prefetchv1.SchemeGroupVersion.WithKind(prefetchv1.ImagePrefetchKind)),
+			},
+		},
+		Spec: k8sv1.PodSpec{
+			NodeName:      node,
+			RestartPolicy: k8sv1.RestartPolicyNever,
+			InitContainers: []k8sv1.Container{{
+				Name:    "container-disk-binary",
+				Image:   c.launcherImage,
+				Command: []string{"/usr/bin/cp", "/usr/bin/container-disk", "/init/usr/bin/container-disk"},
+				VolumeMounts: []k8sv1.VolumeMount{{
+					Name:      binVolumeName,
+					MountPath: "/init/usr/bin",
+				}},
+				Resources:       containerResources(),
+				SecurityContext: securityContext,
+			}},
+			Containers:       containers,
+			ImagePullSecrets: pullSecrets,
+			Volumes: []k8sv1.Volume{{
+				Name: binVolumeName,
+				VolumeSource: k8sv1.VolumeSource{
+					EmptyDir: &k8sv1.EmptyDirVolumeSource{},
+				},
+			}},
+		},
+	}
+}
+
+func containerResources() k8sv1.ResourceRequirements {
+	return k8sv1.ResourceRequirements{
+		Requests: k8sv1.ResourceList{
+			k8sv1.ResourceCPU:    resource.MustParse("1m"),
+			k8sv1.ResourceMemory: resource.MustParse("1M"),
+		},
+		Limits: k8sv1.ResourceList{
+			k8sv1.ResourceCPU:    resource.MustParse("10m"),
+			k8sv1.ResourceMemory: resource.MustParse("40M"),
+		},
+	}
+}
+
+func (c *Controller) countReadyDataSources(prefetch *prefetchv1.ImagePrefetch) int32 {
+	var ready int32
+	for _, ref := range prefetch.Spec.DataSources {
+		obj, exists, err := c.dataSourceStore.GetByKey(controller.NamespacedKey(dataSourceNamespace(prefetch, ref), ref.Name))
+		if err != nil || !exists {
+			continue
+		}
+		for _, cond := range obj.(*cdiv1.DataSource).Status.Conditions {
+			if cond.Type == cdiv1.DataSourceReady && cond.Status == k8sv1.ConditionTrue {
+				ready++
+				break
+			}
+		}
+	}
+	return ready
+}
+
+func dataSourceNamespace(prefetch *prefetchv1.ImagePrefetch, ref prefetchv1.DataSourceReference) string {
+	if ref.Namespace != "" {
+		return ref.Namespace
+	}
+	return prefetch.Namespace
+}
+
+func readinessPercentage(targetNodes, readyNodes, dataSources, readyDataSources int32) int32 {
+	total := targetNodes + dataSources
+	if total == 0 {
+		return 100
+	}
+	return (readyNodes + readyDataSources) * 100 / total
+}
+
+// updateOverdueCondition records whether the prefetch missed its deadline, the
+// warning is only emitted when the prefetch becomes overdue.
+func (c *Controller) updateOverdueCondition(prefetch *prefetchv1.ImagePrefetch, overdue bool) {
+	var cond *prefetchv1.Condition
+	for i := range prefetch.Status.Conditions {
+		if prefetch.Status.Conditions[i].Type == prefetchv1.ConditionOverdue {
+			cond = &prefetch.Status.Conditions[i]
+		}
+	}
+	if cond == nil {
+		// Prefetches without a deadline do not need the condition
+		if prefetch.Spec.ReadyBy == nil {
+			return
+		}
+		prefetch.Status.Conditions = append(prefetch.Status.Conditions, prefetchv1.Condition{Type: prefetchv1.ConditionOverdue})
+		cond = &prefetch.Status.Conditions[len(prefetch.Status.Conditions)-1]
+	}
+
+	status := k8sv1.ConditionFalse
+	if overdue {
+		status = k8sv1.ConditionTrue
+	}
+	if cond.Status == status {
+		return
+	}
+	cond.Status = status
+	cond.LastTransitionTime = metav1.Now()
+	cond.Reason = ""
+	cond.Message = ""
+	if overdue {
+		deadline := prefetch.Spec.ReadyBy.UTC().Format(time.RFC3339)
+		cond.Reason = PrefetchOverdueReason
+		cond.Message = fmt.Sprintf("Prefetch was not ready by the deadline %s", deadline)
+		c.recorder.Eventf(prefetch, k8sv1.EventTypeWarning, PrefetchOverdueReason,
+			"Prefetch is %d%% ready after the deadline %s", prefetch.Status.ReadinessPercentage, deadline)
+	}
+}
+
+func (c *Controller) updateStatus(origPrefetch *prefetchv1.ImagePrefetch, targetNodes, readyNodes, readyDataSources int32) error {
+	prefetch := origPrefetch.DeepCopy()
+	if prefetch.Status == nil {
+		prefetch.Status = &prefetchv1.ImagePrefetchStatus{}
+	}
+
+	prefetch.Status.TargetNodes = targetNodes
+	prefetch.Status.ReadyNodes = readyNodes
+	prefetch.Status.ReadyDataSources = readyDataSources
+	prefetch.Status.ReadinessPercentage = readinessPercentage(targetNodes, readyNodes, int32(len(prefetch.Spec.DataSources)), readyDataSources)
+
+	switch {
+	case prefetch.Status.ReadinessPercentage == 100:
+		if prefetch.Status.Phase != prefetchv1.ImagePrefetchReady {
+			c.recorder.Eventf(prefetch, k8sv1.EventTypeNormal, PrefetchReadyReason, "All images and DataSources are staged")
+		}
+		prefetch.Status.Phase = prefetchv1.ImagePrefetchReady
+	case targetNodes > 0 || readyDataSources > 0:
+		prefetch.Status.Phase = prefetchv1.ImagePrefetchInProgress
+	default:
+		prefetch.Status.Phase = prefetchv1.ImagePrefetchPending
+	}
+
+	overdue := false
+	if prefetch.Status.Phase != prefetchv1.ImagePrefetchReady && prefetch.Spec.ReadyBy != nil {
+		if remaining := time.Until(prefetch.Spec.ReadyBy.Time); remaining > 0 {
+			key, err := controller.KeyFunc(prefetch)
+			if err != nil {
+				return err
+			}
+			// Make sure the deadline gets checked even if nothing else changes
+			c.queue.AddAfter(key, remaining)
+		} else {
+			overdue = true
+		}
+	}
+	c.updateOverdueCondition(prefetch, overdue)
+
+	metrics.SetImagePrefetchReadiness(prefetch)
+
+	if equality.Semantic.DeepEqual(prefetch.Status, origPrefetch.Status) {
+		return nil
+	}
+	_, err := c.clientset.GeneratedKubeVirtClient().PrefetchV1alpha1().ImagePrefetches(prefetch.Namespace).UpdateStatus(context.Background(), prefetch, metav1.UpdateOptions{})
+	return err
+}
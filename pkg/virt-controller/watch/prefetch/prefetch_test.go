@@ -0,0 +1,328 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package prefetch
+
+import (
+	"context"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	k8sv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+	"k8s.io/client-go/tools/record"
+	cdiv1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
+
+	v1 "kubevirt.io/api/core/v1"
+	prefetchv1 "kubevirt.io/api/prefetch/v1alpha1"
+	"kubevirt.io/client-go/kubecli"
+	kubevirtfake "kubevirt.io/client-go/kubevirt/fake"
+
+	virtcontroller "kubevirt.io/kubevirt/pkg/controller"
+	"kubevirt.io/kubevirt/pkg/testutils"
+	"kubevirt.io/kubevirt/pkg/virt-config/featuregate"
+)
+
+var _ = Describe("ImagePrefetch controller", func() {
+	const (
+		testNamespace = "default"
+		launcherImage = "virt-launcher:latest"
+	)
+
+	var (
+		controller     *Controller
+		recorder       *record.FakeRecorder
+		fakeVirtClient *kubevirtfake.Clientset
+		k8sClient      *k8sfake.Clientset
+		virtClient     *kubecli.MockKubevirtClient
+	)
+
+	newPrefetch := func() *prefetchv1.ImagePrefetch {
+		return &prefetchv1.ImagePrefetch{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "morning-boot",
+				Namespace: testNamespace,
+				UID:       "prefetch-uid",
+			},
+			Spec: prefetchv1.ImagePrefetchSpec{
+				ContainerDisks: []prefetchv1.ContainerDiskImage{
+					{Image: "registry:5000/fedora:latest"},
+					{Image: "registry:5000/cirros:latest", ImagePullSecret: "registry-secret"},
+				},
+				NodeSelector: map[string]string{"vdi": "true"},
+			},
+		}
+	}
+
+	newNode := func(name string, labels map[string]string) *k8sv1.Node {
+		return &k8sv1.Node{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Labels: labels},
+		}
+	}
+
+	newPod := func(prefetch *prefetchv1.ImagePrefetch, node string, phase k8sv1.PodPhase) *k8sv1.Pod {
+		pod := controller.renderPod(prefetch, node)
+		pod.Name = prefetch.Name + "-" + node
+		pod.Status.Phase = phase
+		return pod
+	}
+
+	newDataSource := func(name string, ready bool) *cdiv1.DataSource {
+		status := k8sv1.ConditionFalse
+		if ready {
+			status = k8sv1.ConditionTrue
+		}
+		return &cdiv1.DataSource{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: testNamespace},
+			Status: cdiv1.DataSourceStatus{
+				Conditions: []cdiv1.DataSourceCondition{{
+					Type:           cdiv1.DataSourceReady,
+					ConditionState: cdiv1.ConditionState{Status: status},
+				}},
+			},
+		}
+	}
+
+	setupController := func(featureGates []string, prefetch *prefetchv1.ImagePrefetch, objs ...interface{}) {
+		virtClient = kubecli.NewMockKubevirtClient(gomock.NewController(GinkgoT()))
+		prefetchInformer, _ := testutils.NewFakeInformerFor(&prefetchv1.ImagePrefetch{})
+		podInformer, _ := testutils.NewFakeInformerFor(&k8sv1.Pod{})
+		nodeInformer, _ := testutils.NewFakeInformerFor(&k8sv1.Node{})
+		dataSourceInformer, _ := testutils.NewFakeInformerFor(&cdiv1.DataSource{})
+		recorder = record.NewFakeRecorder(100)
+		config, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{
+			DeveloperConfiguration: &v1.DeveloperConfiguration{FeatureGates: featureGates},
+		})
+
+		var err error
+		controller, err = NewController(virtClient, prefetchInformer, podInformer, nodeInformer, dataSourceInformer, recorder, config, launcherImage, "")
+		Expect(err).ToNot(HaveOccurred())
+
+		fakeVirtClient = kubevirtfake.NewSimpleClientset()
+		_, err = fakeVirtClient.PrefetchV1alpha1().ImagePrefetches(prefetch.Namespace).Create(context.Background(), prefetch, metav1.CreateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		k8sClient = k8sfake.NewSimpleClientset()
+		// The fake clientset does not implement generateName
+		k8sClient.Fake.PrependReactor("create", "pods", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			pod := action.(k8stesting.CreateAction).GetObject().(*k8sv1.Pod)
+			if pod.Name == "" {
+				pod.Name = pod.GenerateName + pod.Spec.NodeName
+			}
+			return false, nil, nil
+		})
+		virtClient.EXPECT().GeneratedKubeVirtClient().Return(fakeVirtClient).AnyTimes()
+		virtClient.EXPECT().CoreV1().Return(k8sClient.CoreV1()).AnyTimes()
+
+		Expect(prefetchInformer.GetStore().Add(prefetch)).To(Succeed())
+		for _, obj := range objs {
+			switch o := obj.(type) {
+			case *k8sv1.Node:
+				Expect(nodeInformer.GetStore().Add(o)).To(Succeed())
+			case *k8sv1.Pod:
+				Expect(podInformer.GetStore().Add(o)).To(Succeed())
+				_, err := k8sClient.CoreV1().Pods(o.Namespace).Create(context.Background(), o, metav1.CreateOptions{})
+				Expect(err).ToNot(HaveOccurred())
+			case *cdiv1.DataSource:
+				Expect(dataSourceInformer.GetStore().Add(o)).To(Succeed())
+			}
+		}
+	}
+
+	execute := func(prefetch *prefetchv1.ImagePrefetch) *prefetchv1.ImagePrefetch {
+		key, err := virtcontroller.KeyFunc(prefetch)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(controller.execute(key)).To(Succeed())
+		updated, err := fakeVirtClient.PrefetchV1alpha1().ImagePrefetches(prefetch.Namespace).Get(context.Background(), prefetch.Name, metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		return updated
+	}
+
+	listPods := func() []k8sv1.Pod {
+		pods, err := k8sClient.CoreV1().Pods(testNamespace).List(context.Background(), metav1.ListOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		return pods.Items
+	}
+
+	It("should create a pod on every selected schedulable node", func() {
+		prefetch := newPrefetch()
+		unschedulable := newNode("node03", map[string]string{"vdi": "true"})
+		unschedulable.Spec.Unschedulable = true
+		setupController([]string{featuregate.ImagePrefetchGate}, prefetch,
+			newNode("node01", map[string]string{"vdi": "true"}),
+			newNode("node02", map[string]string{"vdi": "true"}),
+			unschedulable,
+			newNode("node04", nil),
+		)
+
+		updated := execute(prefetch)
+
+		pods := listPods()
+		Expect(pods).To(HaveLen(2))
+		Expect([]string{pods[0].Spec.NodeName, pods[1].Spec.NodeName}).To(ConsistOf("node01", "node02"))
+		for _, pod := range pods {
+			Expect(metav1.IsControlledBy(&pod, prefetch)).To(BeTrue())
+			Expect(pod.Labels).To(HaveKeyWithValue(prefetchv1.ImagePrefetchLabel, prefetch.Name))
+			Expect(pod.Spec.RestartPolicy).To(Equal(k8sv1.RestartPolicyNever))
+			Expect(pod.Spec.InitContainers).To(HaveLen(1))
+			Expect(pod.Spec.InitContainers[0].Image).To(Equal(launcherImage))
+			Expect(pod.Spec.Containers).To(HaveLen(2))
+			Expect(pod.Spec.Containers[0].Image).To(Equal("registry:5000/fedora:latest"))
+			Expect(pod.Spec.Containers[0].Args).To(ConsistOf("--no-op"))
+			Expect(pod.Spec.ImagePullSecrets).To(ConsistOf(k8sv1.LocalObjectReference{Name: "registry-secret"}))
+		}
+
+		Expect(updated.Status).ToNot(BeNil())
+		Expect(updated.Status.Phase).To(Equal(prefetchv1.ImagePrefetchInProgress))
+		Expect(updated.Status.TargetNodes).To(BeEquivalentTo(2))
+		Expect(updated.Status.ReadyNodes).To(BeEquivalentTo(0))
+		Expect(updated.Status.ReadinessPercentage).To(BeEquivalentTo(0))
+		testutils.ExpectEvent(recorder, SuccessfulCreatePodReason)
+	})
+
+	It("should report the readiness of nodes and DataSources", func() {
+		prefetch := newPrefetch()
+		prefetch.Spec.DataSources = []prefetchv1.DataSourceReference{{Name: "fedora"}, {Name: "rhel"}}
+		setupController([]string{featuregate.ImagePrefetchGate}, prefetch,
+			newNode("node01", map[string]string{"vdi": "true"}),
+			newNode("node02", map[string]string{"vdi": "true"}),
+			newPod(prefetch, "node01", k8sv1.PodSucceeded),
+			newPod(prefetch, "node02", k8sv1.PodPending),
+			newDataSource("fedora", true),
+			newDataSource("rhel", false),
+		)
+
+		updated := execute(prefetch)
+
+		Expect(listPods()).To(HaveLen(2))
+		Expect(updated.Status.Phase).To(Equal(prefetchv1.ImagePrefetchInProgress))
+		Expect(updated.Status.ReadyNodes).To(BeEquivalentTo(1))
+		Expect(updated.Status.ReadyDataSources).To(BeEquivalentTo(1))
+		Expect(updated.Status.ReadinessPercentage).To(BeEquivalentTo(50))
+	})
+
+	It("should become ready once all pods succeeded", func() {
+		prefetch := newPrefetch()
+		setupController([]string{featuregate.ImagePrefetchGate}, prefetch,
+			newNode("node01", map[string]string{"vdi": "true"}),
+			newPod(prefetch, "node01", k8sv1.PodSucceeded),
+		)
+
+		updated := execute(prefetch)
+
+		Expect(updated.Status.Phase).To(Equal(prefetchv1.ImagePrefetchReady))
+		Expect(updated.Status.ReadinessPercentage).To(BeEquivalentTo(100))
+		testutils.ExpectEvent(recorder, PrefetchReadyReason)
+	})
+
+	It("should delete failed pods so that the pull is retried", func() {
+		prefetch := newPrefetch()
+		setupController([]string{featuregate.ImagePrefetchGate}, prefetch,
+			newNode("node01", map[string]string{"vdi": "true"}),
+			newPod(prefetch, "node01", k8sv1.PodFailed),
+		)
+
+		execute(prefetch)
+
+		Expect(listPods()).To(BeEmpty())
+		testutils.ExpectEvent(recorder, FailedPrefetchPodReason)
+	})
+
+	It("should warn when the prefetch is not ready by the deadline", func() {
+		prefetch := newPrefetch()
+		prefetch.Spec.ReadyBy = &metav1.Time{Time: time.Now().Add(-time.Minute)}
+		setupController([]string{featuregate.ImagePrefetchGate}, prefetch,
+			newNode("node01", map[string]string{"vdi": "true"}),
+			newPod(prefetch, "node01", k8sv1.PodPending),
+		)
+
+		updated := execute(prefetch)
+
+		Expect(updated.Status.Conditions).To(ConsistOf(HaveField("Type", prefetchv1.ConditionOverdue)))
+		Expect(updated.Status.Conditions[0].Status).To(Equal(k8sv1.ConditionTrue))
+		Expect(updated.Status.Conditions[0].Reason).To(Equal(PrefetchOverdueReason))
+		testutils.ExpectEvent(recorder, PrefetchOverdueReason)
+
+		By("not warning again on the next reconcile")
+		Expect(controller.prefetchIndexer.Update(updated)).To(Succeed())
+		execute(updated)
+		Expect(recorder.Events).To(BeEmpty())
+	})
+
+	It("should clear the overdue condition once the deadline is moved", func() {
+		prefetch := newPrefetch()
+		prefetch.Spec.ReadyBy = &metav1.Time{Time: time.Now().Add(time.Hour)}
+		prefetch.Status = &prefetchv1.ImagePrefetchStatus{
+			Conditions: []prefetchv1.Condition{{
+				Type:   prefetchv1.ConditionOverdue,
+				Status: k8sv1.ConditionTrue,
+				Reason: PrefetchOverdueReason,
+			}},
+		}
+		setupController([]string{featuregate.ImagePrefetchGate}, prefetch,
+			newNode("node01", map[string]string{"vdi": "true"}),
+			newPod(prefetch, "node01", k8sv1.PodPending),
+		)
+
+		updated := execute(prefetch)
+
+		Expect(updated.Status.Conditions).To(HaveLen(1))
+		Expect(updated.Status.Conditions[0].Status).To(Equal(k8sv1.ConditionFalse))
+		Expect(updated.Status.Conditions[0].Reason).To(BeEmpty())
+	})
+
+	It("should only enqueue the prefetches selecting a changed node", func() {
+		prefetch := newPrefetch()
+		setupController([]string{featuregate.ImagePrefetchGate}, prefetch)
+		mockQueue := testutils.NewMockWorkQueue(controller.queue)
+		controller.queue = mockQueue
+
+		controller.handleNode(newNode("node01", nil))
+		Expect(mockQueue.GetAddAfterEnqueueCount()).To(BeZero())
+
+		controller.handleNode(newNode("node02", map[string]string{"vdi": "true"}))
+		Expect(mockQueue.GetAddAfterEnqueueCount()).To(Equal(1))
+	})
+
+	It("should do nothing when the feature gate is disabled", func() {
+		prefetch := newPrefetch()
+		setupController(nil, prefetch,
+			newNode("node01", map[string]string{"vdi": "true"}),
+		)
+
+		updated := execute(prefetch)
+
+		Expect(listPods()).To(BeEmpty())
+		Expect(updated.Status).To(BeNil())
+	})
+
+	DescribeTable("should calculate the readiness percentage", func(targetNodes, readyNodes, dataSources, readyDataSources, expected int) {
+		Expect(readinessPercentage(int32(targetNodes), int32(readyNodes), int32(dataSources), int32(readyDataSources))).To(BeEquivalentTo(expected))
+	},
+		Entry("with nothing to stage", 0, 0, 0, 0, 100),
+		Entry("with only nodes", 4, 1, 0, 0, 25),
+		Entry("with only DataSources", 0, 0, 2, 1, 50),
+		Entry("with nodes and DataSources", 3, 3, 1, 0, 75),
+	)
+})
@@ -0,0 +1,122 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package schedulerextender
+
+import (
+	"encoding/json"
+	"net/http"
+
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/tools/cache"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
+)
+
+const (
+	// PrioritizePath is the path kube-scheduler sends the prioritize requests of the extender to
+	PrioritizePath = "/scheduler-extender/prioritize"
+
+	// MaxPriority is the highest score the extender gives a node
+	MaxPriority int64 = 10
+)
+
+// Extender scores the candidate nodes of virt-launcher pods on behalf of kube-scheduler.
+// It only sees the pods of KubeVirt, so resources used by other pods are not taken into account.
+type Extender struct {
+	podIndexer    cache.Indexer
+	clusterConfig *virtconfig.ClusterConfig
+}
+
+func NewExtender(podInformer cache.SharedIndexInformer, clusterConfig *virtconfig.ClusterConfig) *Extender {
+	return &Extender{
+		podIndexer:    podInformer.GetIndexer(),
+		clusterConfig: clusterConfig,
+	}
+}
+
+func (e *Extender) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "only POST requests are supported", http.StatusMethodNotAllowed)
+		return
+	}
+
+	args := &ExtenderArgs{}
+	if err := json.NewDecoder(r.Body).Decode(args); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(e.Prioritize(args)); err != nil {
+		log.Log.Reason(err).Error("failed to write the scheduler extender response")
+	}
+}
+
+// Prioritize scores all candidate nodes of the pod. Every node gets the same score if the pod is
+// not a virt-launcher pod or the extender is disabled, which leaves the decision to kube-scheduler.
+func (e *Extender) Prioritize(args *ExtenderArgs) HostPriorityList {
+	var nodes []k8sv1.Node
+	if args.Nodes != nil {
+		nodes = args.Nodes.Items
+	} else if args.NodeNames != nil {
+		for _, name := range *args.NodeNames {
+			nodes = append(nodes, k8sv1.Node{})
+			nodes[len(nodes)-1].Name = name
+		}
+	}
+
+	priorities := make(HostPriorityList, 0, len(nodes))
+	if args.Pod == nil || !isLauncherPod(args.Pod) || !e.clusterConfig.SchedulerExtenderEnabled() {
+		for _, node := range nodes {
+			priorities = append(priorities, HostPriority{Host: node.Name})
+		}
+		return priorities
+	}
+
+	podsByNode := e.launcherPodsByNode()
+	for i := range nodes {
+		priorities = append(priorities, HostPriority{
+			Host:  nodes[i].Name,
+			Score: scoreNode(args.Pod, &nodes[i], podsByNode[nodes[i].Name]),
+		})
+	}
+	return priorities
+}
+
+// launcherPodsByNode returns the virt-launcher pods which are bound to a node and still hold their resources
+func (e *Extender) launcherPodsByNode() map[string][]*k8sv1.Pod {
+	podsByNode := map[string][]*k8sv1.Pod{}
+	for _, obj := range e.podIndexer.List() {
+		pod, ok := obj.(*k8sv1.Pod)
+		if !ok || !isLauncherPod(pod) || pod.Spec.NodeName == "" ||
+			pod.Status.Phase == k8sv1.PodSucceeded || pod.Status.Phase == k8sv1.PodFailed {
+			continue
+		}
+		podsByNode[pod.Spec.NodeName] = append(podsByNode[pod.Spec.NodeName], pod)
+	}
+	return podsByNode
+}
+
+func isLauncherPod(pod *k8sv1.Pod) bool {
+	return pod.Labels[v1.AppLabel] == "virt-launcher"
+}
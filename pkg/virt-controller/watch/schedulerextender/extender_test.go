@@ -0,0 +1,205 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package schedulerextender_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/cache"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/testutils"
+	"kubevirt.io/kubevirt/pkg/virt-config/featuregate"
+	"kubevirt.io/kubevirt/pkg/virt-controller/watch/schedulerextender"
+)
+
+var _ = Describe("Scheduler extender", func() {
+	const (
+		hugepages1Gi = k8sv1.ResourceName(k8sv1.ResourceHugePagesPrefix + "1Gi")
+		sriovVF      = k8sv1.ResourceName("intel.com/sriov")
+	)
+
+	var (
+		podStore cache.Store
+		extender *schedulerextender.Extender
+	)
+
+	newExtender := func(featureGates ...string) {
+		podInformer, _ := testutils.NewFakeInformerFor(&k8sv1.Pod{})
+		podStore = podInformer.GetStore()
+		config, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{
+			DeveloperConfiguration: &v1.DeveloperConfiguration{FeatureGates: featureGates},
+		})
+		extender = schedulerextender.NewExtender(podInformer, config)
+	}
+
+	BeforeEach(func() {
+		newExtender(featuregate.SchedulerExtenderGate)
+	})
+
+	newLauncherPod := func(name, nodeName string, requests, limits k8sv1.ResourceList) *k8sv1.Pod {
+		return &k8sv1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: metav1.NamespaceDefault,
+				UID:       types.UID(name),
+				Labels:    map[string]string{v1.AppLabel: "virt-launcher"},
+			},
+			Spec: k8sv1.PodSpec{
+				NodeName: nodeName,
+				Containers: []k8sv1.Container{{
+					Name:      "compute",
+					Resources: k8sv1.ResourceRequirements{Requests: requests, Limits: limits},
+				}},
+			},
+		}
+	}
+
+	newNode := func(name string, allocatable k8sv1.ResourceList, labels map[string]string) k8sv1.Node {
+		return k8sv1.Node{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Labels: labels},
+			Status:     k8sv1.NodeStatus{Allocatable: allocatable},
+		}
+	}
+
+	prioritize := func(pod *k8sv1.Pod, nodes ...k8sv1.Node) map[string]int64 {
+		scores := map[string]int64{}
+		for _, priority := range extender.Prioritize(&schedulerextender.ExtenderArgs{Pod: pod, Nodes: &k8sv1.NodeList{Items: nodes}}) {
+			scores[priority.Host] = priority.Score
+		}
+		return scores
+	}
+
+	It("should prefer the node with the most hugepages left", func() {
+		Expect(podStore.Add(newLauncherPod("running", "node1", k8sv1.ResourceList{hugepages1Gi: resource.MustParse("4Gi")}, nil))).To(Succeed())
+		pod := newLauncherPod("new", "", k8sv1.ResourceList{hugepages1Gi: resource.MustParse("2Gi")}, nil)
+
+		Expect(prioritize(pod,
+			newNode("node1", k8sv1.ResourceList{hugepages1Gi: resource.MustParse("8Gi")}, nil),
+			newNode("node2", k8sv1.ResourceList{hugepages1Gi: resource.MustParse("8Gi")}, nil),
+			newNode("node3", k8sv1.ResourceList{hugepages1Gi: resource.MustParse("1Gi")}, nil),
+		)).To(Equal(map[string]int64{"node1": 2, "node2": 7, "node3": 0}))
+	})
+
+	It("should prefer the node on which the dedicated CPUs fit on a single NUMA node", func() {
+		cpus := k8sv1.ResourceList{k8sv1.ResourceCPU: resource.MustParse("8")}
+		pod := newLauncherPod("new", "", cpus, cpus)
+
+		Expect(prioritize(pod,
+			newNode("node1", nil, map[string]string{v1.NUMANodesLabel: "2", v1.NUMANodeCPUsLabel: "16"}),
+			newNode("node2", nil, map[string]string{v1.NUMANodesLabel: "2", v1.NUMANodeCPUsLabel: "4"}),
+			newNode("node3", nil, map[string]string{v1.NUMANodesLabel: "1", v1.NUMANodeCPUsLabel: "4"}),
+		)).To(Equal(map[string]int64{"node1": 10, "node2": 5, "node3": 0}))
+	})
+
+	It("should not score the NUMA fit of pods without dedicated CPUs", func() {
+		pod := newLauncherPod("new", "", k8sv1.ResourceList{k8sv1.ResourceCPU: resource.MustParse("800m")}, nil)
+
+		Expect(prioritize(pod,
+			newNode("node1", nil, map[string]string{v1.NUMANodesLabel: "2", v1.NUMANodeCPUsLabel: "16"}),
+		)).To(Equal(map[string]int64{"node1": 0}))
+	})
+
+	It("should prefer the node with the most SR-IOV VFs left", func() {
+		Expect(podStore.Add(newLauncherPod("running", "node1", k8sv1.ResourceList{sriovVF: resource.MustParse("3")}, nil))).To(Succeed())
+		pod := newLauncherPod("new", "", k8sv1.ResourceList{
+			sriovVF: resource.MustParse("1"),
+			k8sv1.ResourceName("devices.kubevirt.io/kvm"): resource.MustParse("1"),
+		}, nil)
+
+		Expect(prioritize(pod,
+			newNode("node1", k8sv1.ResourceList{sriovVF: resource.MustParse("4")}, nil),
+			newNode("node2", k8sv1.ResourceList{sriovVF: resource.MustParse("4")}, nil),
+		)).To(Equal(map[string]int64{"node1": 0, "node2": 7}))
+	})
+
+	It("should avoid nodes running VMs the pod prefers not to share a node with", func() {
+		running := newLauncherPod("running", "node1", nil, nil)
+		running.Labels["app"] = "db"
+		Expect(podStore.Add(running)).To(Succeed())
+
+		pod := newLauncherPod("new", "", nil, nil)
+		pod.Spec.Affinity = &k8sv1.Affinity{PodAntiAffinity: &k8sv1.PodAntiAffinity{
+			PreferredDuringSchedulingIgnoredDuringExecution: []k8sv1.WeightedPodAffinityTerm{{
+				Weight: 100,
+				PodAffinityTerm: k8sv1.PodAffinityTerm{
+					LabelSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "db"}},
+					TopologyKey:   k8sv1.LabelHostname,
+				},
+			}},
+		}}
+
+		Expect(prioritize(pod, newNode("node1", nil, nil), newNode("node2", nil, nil))).To(Equal(map[string]int64{"node1": 0, "node2": 10}))
+	})
+
+	It("should average the scores which apply", func() {
+		cpus := k8sv1.ResourceList{k8sv1.ResourceCPU: resource.MustParse("8"), hugepages1Gi: resource.MustParse("2Gi")}
+		pod := newLauncherPod("new", "", cpus, cpus)
+
+		Expect(prioritize(pod,
+			newNode("node1", k8sv1.ResourceList{hugepages1Gi: resource.MustParse("4Gi")}, map[string]string{v1.NUMANodesLabel: "1", v1.NUMANodeCPUsLabel: "8"}),
+		)).To(Equal(map[string]int64{"node1": 7}))
+	})
+
+	DescribeTable("should give all nodes the same score", func(pod *k8sv1.Pod, featureGates ...string) {
+		newExtender(featureGates...)
+		Expect(prioritize(pod,
+			newNode("node1", k8sv1.ResourceList{hugepages1Gi: resource.MustParse("8Gi")}, nil),
+			newNode("node2", k8sv1.ResourceList{hugepages1Gi: resource.MustParse("1Gi")}, nil),
+		)).To(Equal(map[string]int64{"node1": 0, "node2": 0}))
+	},
+		Entry("if the feature gate is disabled", newLauncherPod("new", "", k8sv1.ResourceList{hugepages1Gi: resource.MustParse("1Gi")}, nil)),
+		Entry("if the pod is not a virt-launcher pod", &k8sv1.Pod{}, featuregate.SchedulerExtenderGate),
+	)
+
+	It("should serve prioritize requests", func() {
+		pod := newLauncherPod("new", "", k8sv1.ResourceList{hugepages1Gi: resource.MustParse("2Gi")}, nil)
+		body, err := json.Marshal(schedulerextender.ExtenderArgs{
+			Pod:   pod,
+			Nodes: &k8sv1.NodeList{Items: []k8sv1.Node{newNode("node1", k8sv1.ResourceList{hugepages1Gi: resource.MustParse("4Gi")}, nil)}},
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		recorder := httptest.NewRecorder()
+		extender.ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, schedulerextender.PrioritizePath, bytes.NewReader(body)))
+		Expect(recorder.Code).To(Equal(http.StatusOK))
+
+		var priorities schedulerextender.HostPriorityList
+		Expect(json.Unmarshal(recorder.Body.Bytes(), &priorities)).To(Succeed())
+		Expect(priorities).To(Equal(schedulerextender.HostPriorityList{{Host: "node1", Score: 5}}))
+	})
+
+	It("should reject invalid requests", func() {
+		recorder := httptest.NewRecorder()
+		extender.ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, schedulerextender.PrioritizePath, bytes.NewReader([]byte("{"))))
+		Expect(recorder.Code).To(Equal(http.StatusBadRequest))
+	})
+})
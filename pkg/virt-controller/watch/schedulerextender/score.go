@@ -0,0 +1,215 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package schedulerextender
+
+import (
+	"strconv"
+	"strings"
+
+	k8sv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+
+	v1 "kubevirt.io/api/core/v1"
+)
+
+const (
+	computeContainerName = "compute"
+	kubevirtDevicePrefix = "devices.kubevirt.io/"
+)
+
+// scorer returns the score of a node for the pod and false if it does not apply to the pod or the node
+type scorer func(pod *k8sv1.Pod, node *k8sv1.Node, nodePods []*k8sv1.Pod) (int64, bool)
+
+var scorers = []scorer{
+	hugepagesScore,
+	numaScore,
+	deviceScore,
+	antiAffinityScore,
+}
+
+// scoreNode averages the scores of all scorers which apply to the pod and the node
+func scoreNode(pod *k8sv1.Pod, node *k8sv1.Node, nodePods []*k8sv1.Pod) int64 {
+	var total, count int64
+	for _, score := range scorers {
+		if s, ok := score(pod, node, nodePods); ok {
+			total += s
+			count++
+		}
+	}
+	if count == 0 {
+		return 0
+	}
+	return total / count
+}
+
+// hugepagesScore prefers nodes which have the most hugepages left after the pod is placed
+func hugepagesScore(pod *k8sv1.Pod, node *k8sv1.Node, nodePods []*k8sv1.Pod) (int64, bool) {
+	return freeResourcesScore(pod, node, nodePods, isHugepages)
+}
+
+// deviceScore prefers nodes which have the most SR-IOV VFs and other device plugin resources left after the
+// pod is placed. The NUMA node a device is attached to is only known to the device plugin, so the
+// node with the most free devices is the one where the topology manager most likely finds a device on
+// the NUMA node of the vCPUs.
+func deviceScore(pod *k8sv1.Pod, node *k8sv1.Node, nodePods []*k8sv1.Pod) (int64, bool) {
+	return freeResourcesScore(pod, node, nodePods, isDevice)
+}
+
+func freeResourcesScore(pod *k8sv1.Pod, node *k8sv1.Node, nodePods []*k8sv1.Pod, filter func(k8sv1.ResourceName) bool) (int64, bool) {
+	requested := podRequests(pod, filter)
+	if len(requested) == 0 {
+		return 0, false
+	}
+	used := k8sv1.ResourceList{}
+	for _, nodePod := range nodePods {
+		addResources(used, podRequests(nodePod, filter))
+	}
+
+	score := MaxPriority
+	for name, request := range requested {
+		allocatable, exists := node.Status.Allocatable[name]
+		if !exists || allocatable.IsZero() {
+			return 0, true
+		}
+		free := allocatable.DeepCopy()
+		free.Sub(used[name])
+		free.Sub(request)
+		if free.Sign() < 0 {
+			return 0, true
+		}
+		if s := MaxPriority * free.MilliValue() / allocatable.MilliValue(); s < score {
+			score = s
+		}
+	}
+	return score, true
+}
+
+// numaScore prefers nodes on which the dedicated CPUs of the pod fit on the fewest host NUMA nodes
+func numaScore(pod *k8sv1.Pod, node *k8sv1.Node, _ []*k8sv1.Pod) (int64, bool) {
+	cpus := dedicatedCPUs(pod)
+	if cpus == 0 {
+		return 0, false
+	}
+	numaNodes, err := strconv.ParseInt(node.Labels[v1.NUMANodesLabel], 10, 64)
+	if err != nil || numaNodes < 1 {
+		return 0, false
+	}
+	numaNodeCPUs, err := strconv.ParseInt(node.Labels[v1.NUMANodeCPUsLabel], 10, 64)
+	if err != nil || numaNodeCPUs < 1 {
+		return 0, false
+	}
+
+	neededNUMANodes := (cpus + numaNodeCPUs - 1) / numaNodeCPUs
+	if neededNUMANodes > numaNodes {
+		return 0, true
+	}
+	return MaxPriority / neededNUMANodes, true
+}
+
+// antiAffinityScore lowers the score of nodes running VMs the pod prefers not to share a node with
+func antiAffinityScore(pod *k8sv1.Pod, _ *k8sv1.Node, nodePods []*k8sv1.Pod) (int64, bool) {
+	if pod.Spec.Affinity == nil || pod.Spec.Affinity.PodAntiAffinity == nil {
+		return 0, false
+	}
+
+	var totalWeight, matchedWeight int64
+	for _, term := range pod.Spec.Affinity.PodAntiAffinity.PreferredDuringSchedulingIgnoredDuringExecution {
+		if term.PodAffinityTerm.TopologyKey != k8sv1.LabelHostname {
+			continue
+		}
+		totalWeight += int64(term.Weight)
+		if matchesAnyPod(pod, &term.PodAffinityTerm, nodePods) {
+			matchedWeight += int64(term.Weight)
+		}
+	}
+	if totalWeight == 0 {
+		return 0, false
+	}
+	return MaxPriority * (totalWeight - matchedWeight) / totalWeight, true
+}
+
+func matchesAnyPod(pod *k8sv1.Pod, term *k8sv1.PodAffinityTerm, nodePods []*k8sv1.Pod) bool {
+	selector, err := metav1.LabelSelectorAsSelector(term.LabelSelector)
+	if err != nil {
+		return false
+	}
+	namespaces := term.Namespaces
+	if len(namespaces) == 0 {
+		namespaces = []string{pod.Namespace}
+	}
+	for _, nodePod := range nodePods {
+		if nodePod.UID == pod.UID {
+			continue
+		}
+		for _, namespace := range namespaces {
+			if nodePod.Namespace == namespace && selector.Matches(labels.Set(nodePod.Labels)) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// dedicatedCPUs returns the number of CPUs the compute container gets exclusively from the CPU manager
+func dedicatedCPUs(pod *k8sv1.Pod) int64 {
+	for _, container := range pod.Spec.Containers {
+		if container.Name != computeContainerName {
+			continue
+		}
+		request, hasRequest := container.Resources.Requests[k8sv1.ResourceCPU]
+		limit, hasLimit := container.Resources.Limits[k8sv1.ResourceCPU]
+		if !hasRequest || !hasLimit || request.Cmp(limit) != 0 || request.MilliValue()%1000 != 0 {
+			return 0
+		}
+		return request.Value()
+	}
+	return 0
+}
+
+func podRequests(pod *k8sv1.Pod, filter func(k8sv1.ResourceName) bool) k8sv1.ResourceList {
+	requests := k8sv1.ResourceList{}
+	for _, container := range pod.Spec.Containers {
+		for name, quantity := range container.Resources.Requests {
+			if filter(name) {
+				addResources(requests, k8sv1.ResourceList{name: quantity})
+			}
+		}
+	}
+	return requests
+}
+
+func addResources(to, from k8sv1.ResourceList) {
+	for name, quantity := range from {
+		sum := to[name]
+		sum.Add(quantity)
+		to[name] = sum
+	}
+}
+
+func isHugepages(name k8sv1.ResourceName) bool {
+	return strings.HasPrefix(string(name), k8sv1.ResourceHugePagesPrefix)
+}
+
+// isDevice returns true for extended resources, like SR-IOV VFs, which are not provided by virt-handler
+func isDevice(name k8sv1.ResourceName) bool {
+	return strings.Contains(string(name), "/") && !strings.HasPrefix(string(name), kubevirtDevicePrefix) &&
+		!strings.HasPrefix(string(name), k8sv1.ResourceDefaultNamespacePrefix)
+}
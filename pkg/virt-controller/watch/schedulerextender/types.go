@@ -0,0 +1,48 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package schedulerextender
+
+import (
+	k8sv1 "k8s.io/api/core/v1"
+)
+
+// The types below mirror k8s.io/kube-scheduler/extender/v1, which is not vendored.
+// They are untagged like the upstream types so that they are encoded the same way.
+
+// ExtenderArgs represents the arguments needed by the extender to prioritize nodes for a pod
+type ExtenderArgs struct {
+	// Pod being scheduled
+	Pod *k8sv1.Pod
+	// List of candidate nodes where the pod can be scheduled, populated if the extender is not node cache capable
+	Nodes *k8sv1.NodeList
+	// List of candidate node names where the pod can be scheduled, populated if the extender is node cache capable
+	NodeNames *[]string
+}
+
+// HostPriority represents the priority of scheduling to a particular host, higher priority is better
+type HostPriority struct {
+	// Name of the host
+	Host string
+	// Score associated with the host
+	Score int64
+}
+
+// HostPriorityList declares a []HostPriority type
+type HostPriorityList []HostPriority
@@ -0,0 +1,639 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package storagemigration
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"strconv"
+	"strings"
+	"time"
+
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
+	cdiv1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
+
+	virtv1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
+	"kubevirt.io/kubevirt/pkg/controller"
+	storagetypes "kubevirt.io/kubevirt/pkg/storage/types"
+)
+
+const (
+	StorageMigrationStartedReason   = "StorageMigrationStarted"
+	StorageMigrationSucceededReason = "StorageMigrationSucceeded"
+	StorageMigrationFailedReason    = "StorageMigrationFailed"
+	FailedCreateDataVolumeReason    = "FailedCreateDataVolume"
+
+	// The names of the target DataVolumes end up in the labels of the CDI pods
+	maxTargetClaimNameLength = validation.DNS1035LabelMaxLength
+
+	defaultAddDelay = 1 * time.Second
+)
+
+// Controller migrates the volumes of the VMs with a storage migration to the requested StorageClass.
+// It creates a DataVolume in the StorageClass for every migrated volume and replaces the volumes of
+// the VM, once the DataVolumes are ready. A running VM copies the volumes with libvirt blockcopy
+// through the volume migration of the VM controller, the volumes of a stopped VM are cloned by CDI.
+type Controller struct {
+	clientset       kubecli.KubevirtClient
+	queue           workqueue.TypedRateLimitingInterface[string]
+	vmStore         cache.Store
+	vmiStore        cache.Store
+	dataVolumeStore cache.Store
+	pvcStore        cache.Store
+	recorder        record.EventRecorder
+	hasSynced       func() bool
+}
+
+// NewController creates a new instance of the storage migration Controller.
+func NewController(clientset kubecli.KubevirtClient,
+	vmInformer cache.SharedIndexInformer,
+	vmiInformer cache.SharedIndexInformer,
+	dataVolumeInformer cache.SharedIndexInformer,
+	pvcInformer cache.SharedIndexInformer,
+	recorder record.EventRecorder) (*Controller, error) {
+	c := &Controller{
+		clientset: clientset,
+		queue: workqueue.NewTypedRateLimitingQueueWithConfig[string](
+			workqueue.DefaultTypedControllerRateLimiter[string](),
+			workqueue.TypedRateLimitingQueueConfig[string]{Name: "virt-controller-storage-migration"},
+		),
+		vmStore:         vmInformer.GetStore(),
+		vmiStore:        vmiInformer.GetStore(),
+		dataVolumeStore: dataVolumeInformer.GetStore(),
+		pvcStore:        pvcInformer.GetStore(),
+		recorder:        recorder,
+	}
+
+	c.hasSynced = func() bool {
+		return vmInformer.HasSynced() && vmiInformer.HasSynced() && dataVolumeInformer.HasSynced() && pvcInformer.HasSynced()
+	}
+
+	// The VMI of a VM has the same name, so both are handled with the key of the VM
+	for _, informer := range []cache.SharedIndexInformer{vmInformer, vmiInformer} {
+		_, err := informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+			AddFunc:    c.enqueue,
+			DeleteFunc: c.enqueue,
+			UpdateFunc: func(_, curr interface{}) { c.enqueue(curr) },
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	_, err := dataVolumeInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    c.handleDataVolume,
+		DeleteFunc: c.handleDataVolume,
+		UpdateFunc: func(_, curr interface{}) { c.handleDataVolume(curr) },
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+func (c *Controller) enqueue(obj interface{}) {
+	key, err := controller.KeyFunc(obj)
+	if err != nil {
+		log.Log.Reason(err).Error("Failed to extract key from object.")
+		return
+	}
+	c.queue.AddAfter(key, defaultAddDelay)
+}
+
+// handleDataVolume enqueues the VM a target DataVolume is created for, to follow the progress of the copy
+func (c *Controller) handleDataVolume(obj interface{}) {
+	if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+		obj = tombstone.Obj
+	}
+	dataVolume, ok := obj.(*cdiv1.DataVolume)
+	if !ok {
+		return
+	}
+	vmName, exists := dataVolume.Labels[virtv1.VirtualMachineNameLabel]
+	if !exists {
+		return
+	}
+	c.queue.AddAfter(controller.NamespacedKey(dataVolume.Namespace, vmName), defaultAddDelay)
+}
+
+func (c *Controller) Run(threadiness int, stopCh <-chan struct{}) {
+	defer controller.HandlePanic()
+	defer c.queue.ShutDown()
+	log.Log.Info("Starting storage migration controller.")
+
+	cache.WaitForCacheSync(stopCh, c.hasSynced)
+
+	for i := 0; i < threadiness; i++ {
+		go wait.Until(c.runWorker, time.Second, stopCh)
+	}
+
+	<-stopCh
+	log.Log.Info("Stopping storage migration controller.")
+}
+
+func (c *Controller) runWorker() {
+	for c.Execute() {
+	}
+}
+
+// Execute runs commands from the controller queue, if there is
+// an error it requeues the command. Returns false if the queue
+// is empty.
+func (c *Controller) Execute() bool {
+	key, quit := c.queue.Get()
+	if quit {
+		return false
+	}
+	defer c.queue.Done(key)
+
+	if err := c.execute(key); err != nil {
+		log.Log.Reason(err).Infof("reenqueuing storage migration of VM %v", key)
+		c.queue.AddRateLimited(key)
+	} else {
+		log.Log.V(4).Infof("processed storage migration of VM %v", key)
+		c.queue.Forget(key)
+	}
+	return true
+}
+
+func (c *Controller) execute(key string) error {
+	obj, exists, err := c.vmStore.GetByKey(key)
+	if err != nil || !exists {
+		return err
+	}
+	vm := obj.(*virtv1.VirtualMachine)
+	if vm.DeletionTimestamp != nil {
+		return nil
+	}
+
+	var vmi *virtv1.VirtualMachineInstance
+	obj, exists, err = c.vmiStore.GetByKey(key)
+	if err != nil {
+		return err
+	}
+	if exists {
+		vmi = obj.(*virtv1.VirtualMachineInstance)
+	}
+
+	status, syncErr := c.sync(vm, vmi)
+	if err := c.updateStatus(vm, status); err != nil {
+		return err
+	}
+	return syncErr
+}
+
+func (c *Controller) sync(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance) (
+	*virtv1.VirtualMachineStorageMigrationStatus, error) {
+	migration := vm.Spec.StorageMigration
+	if migration == nil {
+		return nil, nil
+	}
+
+	status := vm.Status.StorageMigration.DeepCopy()
+	if status == nil || status.StorageClassName != migration.StorageClassName {
+		now := metav1.Now()
+		status = &virtv1.VirtualMachineStorageMigrationStatus{
+			StorageClassName: migration.StorageClassName,
+			Method:           migrationMethod(migration, vmi),
+			Phase:            virtv1.StorageMigrationPending,
+			StartTimestamp:   &now,
+		}
+	}
+
+	switch status.Phase {
+	case virtv1.StorageMigrationSucceeded, virtv1.StorageMigrationFailed:
+		return status, nil
+	case virtv1.StorageMigrationPending:
+		// Nothing is created while pending, the method and the volumes follow the VM until the copy starts
+		status.Method = migrationMethod(migration, vmi)
+		volumes, reason, err := c.migratedVolumes(vm, migration)
+		if err != nil {
+			return status, err
+		}
+		status.Volumes = volumes
+		if reason != "" {
+			status.Message = reason
+			return status, nil
+		}
+		if len(volumes) == 0 {
+			succeed(status, "all the volumes are already in the StorageClass")
+			return status, nil
+		}
+		if reason := waitingFor(status.Method, vmi); reason != "" {
+			status.Message = reason
+			return status, nil
+		}
+	}
+
+	status.Phase = virtv1.StorageMigrationRunning
+	status.Message = ""
+	ready, err := c.syncTargetDataVolumes(vm, status)
+	if err != nil || !ready {
+		return status, err
+	}
+
+	if !volumesReplaced(vm, status) {
+		return status, c.replaceVolumes(vm, status)
+	}
+
+	if status.Method == virtv1.StorageMigrationMethodCDI {
+		succeed(status, "")
+		return status, nil
+	}
+	c.syncBlockCopy(vm, vmi, status)
+	return status, nil
+}
+
+func migrationMethod(migration *virtv1.VirtualMachineStorageMigration,
+	vmi *virtv1.VirtualMachineInstance) virtv1.VirtualMachineStorageMigrationMethod {
+	if migration.Method != nil {
+		return *migration.Method
+	}
+	if isRunning(vmi) {
+		return virtv1.StorageMigrationMethodBlockCopy
+	}
+	return virtv1.StorageMigrationMethodCDI
+}
+
+func isRunning(vmi *virtv1.VirtualMachineInstance) bool {
+	return vmi != nil && !vmi.IsFinal()
+}
+
+// waitingFor tells why the copy with the method can not start yet
+func waitingFor(method virtv1.VirtualMachineStorageMigrationMethod, vmi *virtv1.VirtualMachineInstance) string {
+	switch {
+	case method == virtv1.StorageMigrationMethodBlockCopy && (!isRunning(vmi) || vmi.Status.Phase != virtv1.Running):
+		return "waiting for the VirtualMachine to run, BlockCopy migrates the volumes of a running VirtualMachine"
+	case method == virtv1.StorageMigrationMethodCDI && vmi != nil:
+		return "waiting for the VirtualMachine to stop, CDI migrates the volumes of a stopped VirtualMachine"
+	}
+	return ""
+}
+
+// migratedVolumes lists the PVC and DataVolume volumes of the VM which are not in the target StorageClass.
+// A reason is returned while the volumes can not be determined.
+func (c *Controller) migratedVolumes(vm *virtv1.VirtualMachine, migration *virtv1.VirtualMachineStorageMigration) (
+	[]virtv1.VirtualMachineStorageMigrationVolumeStatus, string, error) {
+	selected := map[string]bool{}
+	for _, name := range migration.Volumes {
+		selected[name] = false
+	}
+
+	var volumes []virtv1.VirtualMachineStorageMigrationVolumeStatus
+	for _, volume := range vm.Spec.Template.Spec.Volumes {
+		if _, ok := selected[volume.Name]; len(selected) > 0 && !ok {
+			continue
+		}
+		selected[volume.Name] = true
+		claimName := storagetypes.PVCNameFromVirtVolume(&volume)
+		if claimName == "" {
+			if len(migration.Volumes) > 0 {
+				return nil, fmt.Sprintf("volume %s is neither a PersistentVolumeClaim nor a DataVolume", volume.Name), nil
+			}
+			continue
+		}
+		pvc, err := storagetypes.GetPersistentVolumeClaimFromCache(vm.Namespace, claimName, c.pvcStore)
+		if err != nil {
+			return nil, "", err
+		}
+		if pvc == nil {
+			return nil, fmt.Sprintf("waiting for the claim %s of volume %s", claimName, volume.Name), nil
+		}
+		if pvc.Spec.StorageClassName != nil && *pvc.Spec.StorageClassName == migration.StorageClassName {
+			continue
+		}
+		volumes = append(volumes, virtv1.VirtualMachineStorageMigrationVolumeStatus{
+			VolumeName:  volume.Name,
+			SourceClaim: claimName,
+			TargetClaim: targetClaimName(claimName, migration.StorageClassName),
+		})
+	}
+
+	for _, name := range migration.Volumes {
+		if !selected[name] {
+			return nil, fmt.Sprintf("volume %s does not exist", name), nil
+		}
+	}
+	return volumes, "", nil
+}
+
+// targetClaimName derives the name of the target DataVolume from the source claim and the StorageClass
+func targetClaimName(claimName, storageClassName string) string {
+	name := fmt.Sprintf("%s-%s", claimName, storageClassName)
+	if len(name) <= maxTargetClaimNameLength {
+		return name
+	}
+	hash := fnv.New32a()
+	_, _ = hash.Write([]byte(name))
+	suffix := fmt.Sprintf("-%08x", hash.Sum32())
+	return strings.TrimRight(name[:maxTargetClaimNameLength-len(suffix)], "-.") + suffix
+}
+
+// syncTargetDataVolumes creates the missing target DataVolumes and tracks their progress.
+// It returns true once all of them are ready for the method of the migration.
+func (c *Controller) syncTargetDataVolumes(vm *virtv1.VirtualMachine, status *virtv1.VirtualMachineStorageMigrationStatus) (bool, error) {
+	ready := true
+	for i := range status.Volumes {
+		volume := &status.Volumes[i]
+		dataVolume, err := storagetypes.GetDataVolumeFromCache(vm.Namespace, volume.TargetClaim, c.dataVolumeStore)
+		if err != nil {
+			return false, err
+		}
+		if dataVolume == nil {
+			if err := c.createTargetDataVolume(vm, status, volume); err != nil {
+				return false, err
+			}
+			ready = false
+			continue
+		}
+
+		switch dataVolume.Status.Phase {
+		case cdiv1.Failed:
+			fail(status, fmt.Sprintf("DataVolume %s failed", dataVolume.Name))
+			return false, nil
+		case cdiv1.Succeeded:
+			if status.Method == virtv1.StorageMigrationMethodCDI {
+				volume.PercentComplete = 100
+			}
+			continue
+		case cdiv1.WaitForFirstConsumer, cdiv1.PendingPopulation:
+			// The blank target of a blockcopy is bound by the target pod of the migration
+			if status.Method == virtv1.StorageMigrationMethodBlockCopy {
+				continue
+			}
+		}
+		if status.Method == virtv1.StorageMigrationMethodCDI {
+			volume.PercentComplete = parseProgress(dataVolume.Status.Progress)
+		}
+		ready = false
+	}
+	return ready, nil
+}
+
+func parseProgress(progress cdiv1.DataVolumeProgress) int32 {
+	percent, err := strconv.ParseFloat(strings.TrimSuffix(string(progress), "%"), 64)
+	if err != nil || percent < 0 {
+		return 0
+	}
+	return int32(min(percent, 100))
+}
+
+func (c *Controller) createTargetDataVolume(vm *virtv1.VirtualMachine, status *virtv1.VirtualMachineStorageMigrationStatus,
+	volume *virtv1.VirtualMachineStorageMigrationVolumeStatus) error {
+	pvc, err := storagetypes.GetPersistentVolumeClaimFromCache(vm.Namespace, volume.SourceClaim, c.pvcStore)
+	if err != nil {
+		return err
+	}
+	if pvc == nil {
+		return fmt.Errorf("claim %s of volume %s does not exist", volume.SourceClaim, volume.VolumeName)
+	}
+
+	dataVolume := newTargetDataVolume(vm, pvc, status, volume)
+	_, err = c.clientset.CdiClient().CdiV1beta1().DataVolumes(vm.Namespace).Create(context.Background(), dataVolume, metav1.CreateOptions{})
+	if err != nil && !errors.IsAlreadyExists(err) {
+		c.recorder.Eventf(vm, k8sv1.EventTypeWarning, FailedCreateDataVolumeReason,
+			"Error creating DataVolume %s for the storage migration: %v", dataVolume.Name, err)
+		return err
+	}
+	return nil
+}
+
+func newTargetDataVolume(vm *virtv1.VirtualMachine, pvc *k8sv1.PersistentVolumeClaim, status *virtv1.VirtualMachineStorageMigrationStatus,
+	volume *virtv1.VirtualMachineStorageMigrationVolumeStatus) *cdiv1.DataVolume {
+	size, exists := pvc.Status.Capacity[k8sv1.ResourceStorage]
+	if !exists {
+		size = pvc.Spec.Resources.Requests[k8sv1.ResourceStorage]
+	}
+	storageClassName := status.StorageClassName
+
+	dataVolume := &cdiv1.DataVolume{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      volume.TargetClaim,
+			Namespace: vm.Namespace,
+			Labels: map[string]string{
+				virtv1.VirtualMachineNameLabel: vm.Name,
+			},
+		},
+		Spec: cdiv1.DataVolumeSpec{
+			Storage: &cdiv1.StorageSpec{
+				StorageClassName: &storageClassName,
+				VolumeMode:       pvc.Spec.VolumeMode,
+				Resources: k8sv1.VolumeResourceRequirements{
+					Requests: k8sv1.ResourceList{k8sv1.ResourceStorage: size},
+				},
+			},
+		},
+	}
+	if status.Method == virtv1.StorageMigrationMethodCDI {
+		dataVolume.Spec.Source = &cdiv1.DataVolumeSource{
+			PVC: &cdiv1.DataVolumeSourcePVC{Namespace: vm.Namespace, Name: volume.SourceClaim},
+		}
+	} else {
+		dataVolume.Spec.Source = &cdiv1.DataVolumeSource{Blank: &cdiv1.DataVolumeBlankImage{}}
+	}
+	// The target of a DataVolume template replaces the template and shares the life-cycle of the VM
+	if dataVolumeTemplateIndex(vm, volume.SourceClaim) >= 0 {
+		dataVolume.Labels[virtv1.CreatedByLabel] = string(vm.UID)
+		dataVolume.OwnerReferences = []metav1.OwnerReference{*metav1.NewControllerRef(vm, virtv1.VirtualMachineGroupVersionKind)}
+	}
+	return dataVolume
+}
+
+func dataVolumeTemplateIndex(vm *virtv1.VirtualMachine, name string) int {
+	for i, template := range vm.Spec.DataVolumeTemplates {
+		if template.Name == name {
+			return i
+		}
+	}
+	return -1
+}
+
+func volumesReplaced(vm *virtv1.VirtualMachine, status *virtv1.VirtualMachineStorageMigrationStatus) bool {
+	vmVolumes := storagetypes.GetVolumesByName(&vm.Spec.Template.Spec)
+	for _, volume := range status.Volumes {
+		vmVolume, exists := vmVolumes[volume.VolumeName]
+		if !exists || storagetypes.PVCNameFromVirtVolume(vmVolume) != volume.TargetClaim {
+			return false
+		}
+	}
+	return true
+}
+
+// replaceVolumes points the volumes of the VM to the target DataVolumes. The DataVolume templates of the
+// migrated volumes are replaced as well, since every template has to be referenced by a volume.
+// A running VM migrates to the new volumes with the volume migration.
+func (c *Controller) replaceVolumes(vm *virtv1.VirtualMachine, status *virtv1.VirtualMachineStorageMigrationStatus) error {
+	volumes := make([]virtv1.Volume, len(vm.Spec.Template.Spec.Volumes))
+	copy(volumes, vm.Spec.Template.Spec.Volumes)
+	templates := make([]virtv1.DataVolumeTemplateSpec, len(vm.Spec.DataVolumeTemplates))
+	copy(templates, vm.Spec.DataVolumeTemplates)
+
+	for _, migrated := range status.Volumes {
+		for i := range volumes {
+			if volumes[i].Name == migrated.VolumeName {
+				volumes[i].VolumeSource = virtv1.VolumeSource{
+					DataVolume: &virtv1.DataVolumeSource{Name: migrated.TargetClaim},
+				}
+			}
+		}
+		if i := dataVolumeTemplateIndex(vm, migrated.SourceClaim); i >= 0 {
+			dataVolume, err := storagetypes.GetDataVolumeFromCache(vm.Namespace, migrated.TargetClaim, c.dataVolumeStore)
+			if err != nil || dataVolume == nil {
+				return fmt.Errorf("failed to get the DataVolume %s: %v", migrated.TargetClaim, err)
+			}
+			templates[i] = virtv1.DataVolumeTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        migrated.TargetClaim,
+					Labels:      templates[i].Labels,
+					Annotations: templates[i].Annotations,
+				},
+				Spec: *dataVolume.Spec.DeepCopy(),
+			}
+		}
+	}
+
+	patchSet := patch.New(
+		patch.WithTest("/spec/template/spec/volumes", vm.Spec.Template.Spec.Volumes),
+		patch.WithReplace("/spec/template/spec/volumes", volumes),
+	)
+	if len(templates) > 0 && !equality.Semantic.DeepEqual(templates, vm.Spec.DataVolumeTemplates) {
+		patchSet.AddOption(
+			patch.WithTest("/spec/dataVolumeTemplates", vm.Spec.DataVolumeTemplates),
+			patch.WithReplace("/spec/dataVolumeTemplates", templates),
+		)
+	}
+	if status.Method == virtv1.StorageMigrationMethodBlockCopy {
+		patchSet.AddOption(patch.WithAdd("/spec/updateVolumesStrategy", virtv1.UpdateVolumesStrategyMigration))
+	}
+	patchBytes, err := patchSet.GeneratePayload()
+	if err != nil {
+		return err
+	}
+
+	log.Log.Object(vm).V(3).Infof("Replacing the volumes migrated to StorageClass %s", status.StorageClassName)
+	_, err = c.clientset.VirtualMachine(vm.Namespace).Patch(
+		context.Background(), vm.Name, types.JSONPatchType, patchBytes, metav1.PatchOptions{})
+	return err
+}
+
+// syncBlockCopy follows the volume migration of the running VM, it is done once the VMI runs with the target volumes
+func (c *Controller) syncBlockCopy(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance,
+	status *virtv1.VirtualMachineStorageMigrationStatus) {
+	vmConditions := controller.NewVirtualMachineConditionManager()
+	vmiConditions := controller.NewVirtualMachineInstanceConditionManager()
+	// The VM controller asks for a manual recovery if the VMI stopped during the volume migration
+	if vmConditions.HasCondition(vm, virtv1.VirtualMachineManualRecoveryRequired) {
+		fail(status, "the volume migration of the VirtualMachine requires a manual recovery")
+		return
+	}
+	if !isRunning(vmi) {
+		volumesChange := virtv1.VirtualMachineConditionType(virtv1.VirtualMachineInstanceVolumesChange)
+		if !vmConditions.HasConditionWithStatus(vm, volumesChange, k8sv1.ConditionTrue) {
+			succeed(status, "")
+		}
+		return
+	}
+
+	vmiVolumes := storagetypes.GetVolumesByName(&vmi.Spec)
+	migrated := len(vmi.Status.MigratedVolumes) == 0 &&
+		!vmiConditions.HasConditionWithStatus(vmi, virtv1.VirtualMachineInstanceVolumesChange, k8sv1.ConditionTrue)
+	for _, volume := range status.Volumes {
+		vmiVolume, exists := vmiVolumes[volume.VolumeName]
+		if !exists || storagetypes.PVCNameFromVirtVolume(vmiVolume) != volume.TargetClaim {
+			migrated = false
+		}
+	}
+	if migrated {
+		succeed(status, "")
+		return
+	}
+
+	migrationState := vmi.Status.MigrationState
+	if migrationState == nil || migrationState.Completed || migrationState.Progress == nil {
+		return
+	}
+	for i := range status.Volumes {
+		status.Volumes[i].PercentComplete = migrationState.Progress.PercentComplete
+	}
+}
+
+func succeed(status *virtv1.VirtualMachineStorageMigrationStatus, message string) {
+	now := metav1.Now()
+	status.Phase = virtv1.StorageMigrationSucceeded
+	status.Message = message
+	status.EndTimestamp = &now
+	for i := range status.Volumes {
+		status.Volumes[i].PercentComplete = 100
+	}
+}
+
+func fail(status *virtv1.VirtualMachineStorageMigrationStatus, message string) {
+	now := metav1.Now()
+	status.Phase = virtv1.StorageMigrationFailed
+	status.Message = message
+	status.EndTimestamp = &now
+}
+
+func (c *Controller) updateStatus(vm *virtv1.VirtualMachine, status *virtv1.VirtualMachineStorageMigrationStatus) error {
+	if equality.Semantic.DeepEqual(vm.Status.StorageMigration, status) {
+		return nil
+	}
+
+	vmCopy := vm.DeepCopy()
+	vmCopy.Status.StorageMigration = status
+	if _, err := c.clientset.VirtualMachine(vm.Namespace).UpdateStatus(context.Background(), vmCopy, metav1.UpdateOptions{}); err != nil {
+		return err
+	}
+
+	var oldPhase virtv1.VirtualMachineStorageMigrationPhase
+	if vm.Status.StorageMigration != nil {
+		oldPhase = vm.Status.StorageMigration.Phase
+	}
+	if status == nil || status.Phase == oldPhase {
+		return nil
+	}
+	switch status.Phase {
+	case virtv1.StorageMigrationRunning:
+		c.recorder.Eventf(vm, k8sv1.EventTypeNormal, StorageMigrationStartedReason,
+			"Migrating %d volumes to StorageClass %s with %s", len(status.Volumes), status.StorageClassName, status.Method)
+	case virtv1.StorageMigrationSucceeded:
+		c.recorder.Eventf(vm, k8sv1.EventTypeNormal, StorageMigrationSucceededReason,
+			"Migrated the volumes to StorageClass %s", status.StorageClassName)
+	case virtv1.StorageMigrationFailed:
+		c.recorder.Eventf(vm, k8sv1.EventTypeWarning, StorageMigrationFailedReason,
+			"Failed to migrate the volumes to StorageClass %s: %s", status.StorageClassName, status.Message)
+	}
+	return nil
+}
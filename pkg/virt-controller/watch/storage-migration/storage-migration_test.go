@@ -0,0 +1,389 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package storagemigration
+
+import (
+	"context"
+	"strings"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	k8stesting "k8s.io/client-go/testing"
+	"k8s.io/client-go/tools/record"
+	cdiv1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
+
+	v1 "kubevirt.io/api/core/v1"
+	cdifake "kubevirt.io/client-go/containerizeddataimporter/fake"
+	"kubevirt.io/client-go/kubecli"
+	kubevirtfake "kubevirt.io/client-go/kubevirt/fake"
+
+	"kubevirt.io/kubevirt/pkg/libvmi"
+	libvmistatus "kubevirt.io/kubevirt/pkg/libvmi/status"
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/testutils"
+)
+
+var _ = Describe("Storage migration controller", func() {
+	const (
+		testNamespace = "default"
+		vmName        = "testvm"
+		sourceClaim   = "disk0"
+		targetClass   = "new-class"
+		targetClaim   = "disk0-new-class"
+	)
+
+	var (
+		controller *Controller
+		recorder   *record.FakeRecorder
+		virtClient *kubevirtfake.Clientset
+		cdiClient  *cdifake.Clientset
+	)
+
+	newVM := func(method *v1.VirtualMachineStorageMigrationMethod) *v1.VirtualMachine {
+		vm := libvmi.NewVirtualMachine(libvmi.New(
+			libvmi.WithName(vmName),
+			libvmi.WithNamespace(testNamespace),
+			libvmi.WithPersistentVolumeClaim("disk", sourceClaim),
+		))
+		vm.UID = "vm-uid"
+		vm.Spec.StorageMigration = &v1.VirtualMachineStorageMigration{
+			StorageClassName: targetClass,
+			Method:           method,
+		}
+		return vm
+	}
+
+	newVMI := func(opts ...libvmistatus.Option) *v1.VirtualMachineInstance {
+		return libvmi.New(
+			libvmi.WithName(vmName),
+			libvmi.WithNamespace(testNamespace),
+			libvmi.WithPersistentVolumeClaim("disk", sourceClaim),
+			libvmistatus.WithStatus(libvmistatus.New(append(opts, libvmistatus.WithPhase(v1.Running))...)),
+		)
+	}
+
+	newPVC := func(name, storageClass string) *k8sv1.PersistentVolumeClaim {
+		return &k8sv1.PersistentVolumeClaim{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: testNamespace},
+			Spec: k8sv1.PersistentVolumeClaimSpec{
+				StorageClassName: pointer.P(storageClass),
+				VolumeMode:       pointer.P(k8sv1.PersistentVolumeBlock),
+			},
+			Status: k8sv1.PersistentVolumeClaimStatus{
+				Capacity: k8sv1.ResourceList{k8sv1.ResourceStorage: resource.MustParse("10Gi")},
+			},
+		}
+	}
+
+	newTargetDataVolume := func(phase cdiv1.DataVolumePhase, progress cdiv1.DataVolumeProgress) *cdiv1.DataVolume {
+		return &cdiv1.DataVolume{
+			ObjectMeta: metav1.ObjectMeta{Name: targetClaim, Namespace: testNamespace},
+			Spec: cdiv1.DataVolumeSpec{
+				Storage: &cdiv1.StorageSpec{StorageClassName: pointer.P(targetClass)},
+			},
+			Status: cdiv1.DataVolumeStatus{Phase: phase, Progress: progress},
+		}
+	}
+
+	runningStatus := func(method v1.VirtualMachineStorageMigrationMethod) *v1.VirtualMachineStorageMigrationStatus {
+		return &v1.VirtualMachineStorageMigrationStatus{
+			StorageClassName: targetClass,
+			Method:           method,
+			Phase:            v1.StorageMigrationRunning,
+			Volumes: []v1.VirtualMachineStorageMigrationVolumeStatus{
+				{VolumeName: "disk", SourceClaim: sourceClaim, TargetClaim: targetClaim},
+			},
+		}
+	}
+
+	replaceVolume := func(vm *v1.VirtualMachine) {
+		vm.Spec.Template.Spec.Volumes[0].VolumeSource = v1.VolumeSource{
+			DataVolume: &v1.DataVolumeSource{Name: targetClaim},
+		}
+	}
+
+	setupController := func(objs ...runtime.Object) {
+		mockClient := kubecli.NewMockKubevirtClient(gomock.NewController(GinkgoT()))
+		vmInformer, _ := testutils.NewFakeInformerFor(&v1.VirtualMachine{})
+		vmiInformer, _ := testutils.NewFakeInformerFor(&v1.VirtualMachineInstance{})
+		dataVolumeInformer, _ := testutils.NewFakeInformerFor(&cdiv1.DataVolume{})
+		pvcInformer, _ := testutils.NewFakeInformerFor(&k8sv1.PersistentVolumeClaim{})
+		recorder = record.NewFakeRecorder(100)
+
+		var err error
+		controller, err = NewController(mockClient, vmInformer, vmiInformer, dataVolumeInformer, pvcInformer, recorder)
+		Expect(err).ToNot(HaveOccurred())
+
+		virtClient = kubevirtfake.NewSimpleClientset()
+		cdiClient = cdifake.NewSimpleClientset()
+		// Like the status subresource, status updates keep the spec
+		virtClient.PrependReactor("update", "virtualmachines", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			update := action.(k8stesting.UpdateAction)
+			if update.GetSubresource() != "status" {
+				return false, nil, nil
+			}
+			vm := update.GetObject().(*v1.VirtualMachine)
+			obj, err := virtClient.Tracker().Get(update.GetResource(), update.GetNamespace(), vm.Name)
+			if err != nil {
+				return true, nil, err
+			}
+			stored := obj.(*v1.VirtualMachine).DeepCopy()
+			stored.Status = vm.Status
+			return true, stored, virtClient.Tracker().Update(update.GetResource(), stored, update.GetNamespace())
+		})
+		mockClient.EXPECT().VirtualMachine(testNamespace).Return(virtClient.KubevirtV1().VirtualMachines(testNamespace)).AnyTimes()
+		mockClient.EXPECT().CdiClient().Return(cdiClient).AnyTimes()
+
+		for _, obj := range objs {
+			switch o := obj.(type) {
+			case *v1.VirtualMachine:
+				Expect(vmInformer.GetStore().Add(o)).To(Succeed())
+				Expect(virtClient.Tracker().Add(o)).To(Succeed())
+			case *v1.VirtualMachineInstance:
+				Expect(vmiInformer.GetStore().Add(o)).To(Succeed())
+			case *cdiv1.DataVolume:
+				Expect(dataVolumeInformer.GetStore().Add(o)).To(Succeed())
+			case *k8sv1.PersistentVolumeClaim:
+				Expect(pvcInformer.GetStore().Add(o)).To(Succeed())
+			}
+		}
+	}
+
+	sync := func() *v1.VirtualMachine {
+		Expect(controller.execute(testNamespace + "/" + vmName)).To(Succeed())
+		vm, err := virtClient.KubevirtV1().VirtualMachines(testNamespace).Get(context.Background(), vmName, metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		return vm
+	}
+
+	getDataVolume := func() *cdiv1.DataVolume {
+		dataVolume, err := cdiClient.CdiV1beta1().DataVolumes(testNamespace).Get(context.Background(), targetClaim, metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		return dataVolume
+	}
+
+	It("should not touch a VM without a storage migration", func() {
+		vm := newVM(nil)
+		vm.Spec.StorageMigration = nil
+		setupController(vm, newPVC(sourceClaim, "old-class"))
+
+		Expect(controller.execute(testNamespace + "/" + vmName)).To(Succeed())
+		Expect(virtClient.Actions()).To(BeEmpty())
+		Expect(cdiClient.Actions()).To(BeEmpty())
+	})
+
+	It("should succeed right away if the volumes are already in the StorageClass", func() {
+		setupController(newVM(nil), newPVC(sourceClaim, targetClass))
+
+		status := sync().Status.StorageMigration
+		Expect(status.Phase).To(Equal(v1.StorageMigrationSucceeded))
+		Expect(status.Volumes).To(BeEmpty())
+		Expect(cdiClient.Actions()).To(BeEmpty())
+	})
+
+	It("should wait for the VM to stop before cloning the volumes with CDI", func() {
+		setupController(newVM(pointer.P(v1.StorageMigrationMethodCDI)), newVMI(), newPVC(sourceClaim, "old-class"))
+
+		status := sync().Status.StorageMigration
+		Expect(status.Phase).To(Equal(v1.StorageMigrationPending))
+		Expect(status.Message).To(ContainSubstring("waiting for the VirtualMachine to stop"))
+		Expect(cdiClient.Actions()).To(BeEmpty())
+	})
+
+	It("should wait for a selected volume which does not exist", func() {
+		vm := newVM(nil)
+		vm.Spec.StorageMigration.Volumes = []string{"missing"}
+		setupController(vm, newPVC(sourceClaim, "old-class"))
+
+		status := sync().Status.StorageMigration
+		Expect(status.Phase).To(Equal(v1.StorageMigrationPending))
+		Expect(status.Message).To(Equal("volume missing does not exist"))
+	})
+
+	Context("with BlockCopy", func() {
+		It("should create a blank target DataVolume for a running VM", func() {
+			setupController(newVM(nil), newVMI(), newPVC(sourceClaim, "old-class"))
+
+			status := sync().Status.StorageMigration
+			Expect(status.Method).To(Equal(v1.StorageMigrationMethodBlockCopy))
+			Expect(status.Phase).To(Equal(v1.StorageMigrationRunning))
+			Expect(status.Volumes).To(Equal(runningStatus(v1.StorageMigrationMethodBlockCopy).Volumes))
+			testutils.ExpectEvent(recorder, StorageMigrationStartedReason)
+
+			dataVolume := getDataVolume()
+			Expect(dataVolume.Labels).To(HaveKeyWithValue(v1.VirtualMachineNameLabel, vmName))
+			Expect(dataVolume.OwnerReferences).To(BeEmpty())
+			Expect(dataVolume.Spec.Source.Blank).ToNot(BeNil())
+			Expect(dataVolume.Spec.Storage.StorageClassName).To(HaveValue(Equal(targetClass)))
+			Expect(dataVolume.Spec.Storage.VolumeMode).To(HaveValue(Equal(k8sv1.PersistentVolumeBlock)))
+			Expect(dataVolume.Spec.Storage.Resources.Requests.Storage().String()).To(Equal("10Gi"))
+		})
+
+		It("should replace the volumes and migrate once the target DataVolume waits for its consumer", func() {
+			vm := newVM(nil)
+			vm.Status.StorageMigration = runningStatus(v1.StorageMigrationMethodBlockCopy)
+			setupController(vm, newVMI(), newPVC(sourceClaim, "old-class"), newTargetDataVolume(cdiv1.WaitForFirstConsumer, ""))
+
+			vm = sync()
+			Expect(vm.Spec.Template.Spec.Volumes[0].DataVolume).To(Equal(&v1.DataVolumeSource{Name: targetClaim}))
+			Expect(vm.Spec.UpdateVolumesStrategy).To(HaveValue(Equal(v1.UpdateVolumesStrategyMigration)))
+			Expect(vm.Status.StorageMigration.Phase).To(Equal(v1.StorageMigrationRunning))
+		})
+
+		It("should report the progress of the volume migration", func() {
+			vm := newVM(nil)
+			replaceVolume(vm)
+			vm.Status.StorageMigration = runningStatus(v1.StorageMigrationMethodBlockCopy)
+			vmi := newVMI(
+				libvmistatus.WithMigratedVolume(v1.StorageMigratedVolumeInfo{VolumeName: "disk"}),
+				libvmistatus.WithMigrationState(v1.VirtualMachineInstanceMigrationState{
+					Progress: &v1.MigrationProgress{PercentComplete: 40},
+				}),
+			)
+			setupController(vm, vmi, newPVC(sourceClaim, "old-class"), newTargetDataVolume(cdiv1.WaitForFirstConsumer, ""))
+
+			status := sync().Status.StorageMigration
+			Expect(status.Phase).To(Equal(v1.StorageMigrationRunning))
+			Expect(status.Volumes[0].PercentComplete).To(Equal(int32(40)))
+		})
+
+		It("should succeed once the VMI runs with the target volumes", func() {
+			vm := newVM(nil)
+			replaceVolume(vm)
+			vm.Status.StorageMigration = runningStatus(v1.StorageMigrationMethodBlockCopy)
+			vmi := newVMI()
+			vmi.Spec.Volumes[0].VolumeSource = vm.Spec.Template.Spec.Volumes[0].VolumeSource
+			setupController(vm, vmi, newPVC(sourceClaim, "old-class"), newTargetDataVolume(cdiv1.Succeeded, "100.0%"))
+
+			status := sync().Status.StorageMigration
+			Expect(status.Phase).To(Equal(v1.StorageMigrationSucceeded))
+			Expect(status.EndTimestamp).ToNot(BeNil())
+			Expect(status.Volumes[0].PercentComplete).To(Equal(int32(100)))
+			testutils.ExpectEvent(recorder, StorageMigrationSucceededReason)
+		})
+
+		It("should fail if the volume migration requires a manual recovery", func() {
+			vm := newVM(nil)
+			replaceVolume(vm)
+			vm.Status.StorageMigration = runningStatus(v1.StorageMigrationMethodBlockCopy)
+			vm.Status.Conditions = []v1.VirtualMachineCondition{{
+				Type:   v1.VirtualMachineManualRecoveryRequired,
+				Status: k8sv1.ConditionTrue,
+			}}
+			setupController(vm, newPVC(sourceClaim, "old-class"), newTargetDataVolume(cdiv1.WaitForFirstConsumer, ""))
+
+			status := sync().Status.StorageMigration
+			Expect(status.Phase).To(Equal(v1.StorageMigrationFailed))
+			testutils.ExpectEvent(recorder, StorageMigrationFailedReason)
+		})
+	})
+
+	Context("with CDI", func() {
+		It("should clone the source claim of a stopped VM", func() {
+			setupController(newVM(nil), newPVC(sourceClaim, "old-class"))
+
+			status := sync().Status.StorageMigration
+			Expect(status.Method).To(Equal(v1.StorageMigrationMethodCDI))
+			Expect(status.Phase).To(Equal(v1.StorageMigrationRunning))
+			Expect(getDataVolume().Spec.Source.PVC).To(Equal(&cdiv1.DataVolumeSourcePVC{Namespace: testNamespace, Name: sourceClaim}))
+		})
+
+		It("should report the progress of the clone", func() {
+			vm := newVM(nil)
+			vm.Status.StorageMigration = runningStatus(v1.StorageMigrationMethodCDI)
+			setupController(vm, newPVC(sourceClaim, "old-class"), newTargetDataVolume(cdiv1.CloneInProgress, "45.52%"))
+
+			vm = sync()
+			Expect(vm.Status.StorageMigration.Volumes[0].PercentComplete).To(Equal(int32(45)))
+			Expect(vm.Spec.Template.Spec.Volumes[0].PersistentVolumeClaim).ToNot(BeNil())
+		})
+
+		It("should replace the volumes and the DataVolume templates once the clone succeeded", func() {
+			vm := newVM(nil)
+			vm.Spec.Template.Spec.Volumes[0].VolumeSource = v1.VolumeSource{
+				DataVolume: &v1.DataVolumeSource{Name: sourceClaim},
+			}
+			vm.Spec.DataVolumeTemplates = []v1.DataVolumeTemplateSpec{{
+				ObjectMeta: metav1.ObjectMeta{Name: sourceClaim, Labels: map[string]string{"app": "test"}},
+			}}
+			vm.Status.StorageMigration = runningStatus(v1.StorageMigrationMethodCDI)
+			setupController(vm, newPVC(sourceClaim, "old-class"), newTargetDataVolume(cdiv1.Succeeded, "100.0%"))
+
+			vm = sync()
+			Expect(vm.Spec.UpdateVolumesStrategy).To(BeNil())
+			Expect(vm.Spec.Template.Spec.Volumes[0].DataVolume).To(Equal(&v1.DataVolumeSource{Name: targetClaim}))
+			Expect(vm.Spec.DataVolumeTemplates).To(HaveLen(1))
+			Expect(vm.Spec.DataVolumeTemplates[0].Name).To(Equal(targetClaim))
+			Expect(vm.Spec.DataVolumeTemplates[0].Labels).To(HaveKeyWithValue("app", "test"))
+			Expect(vm.Spec.DataVolumeTemplates[0].Spec.Storage.StorageClassName).To(HaveValue(Equal(targetClass)))
+
+			controller.vmStore.Update(vm)
+			Expect(sync().Status.StorageMigration.Phase).To(Equal(v1.StorageMigrationSucceeded))
+		})
+
+		It("should own the target of a DataVolume template", func() {
+			vm := newVM(nil)
+			vm.Spec.Template.Spec.Volumes[0].VolumeSource = v1.VolumeSource{
+				DataVolume: &v1.DataVolumeSource{Name: sourceClaim},
+			}
+			vm.Spec.DataVolumeTemplates = []v1.DataVolumeTemplateSpec{{ObjectMeta: metav1.ObjectMeta{Name: sourceClaim}}}
+			setupController(vm, newPVC(sourceClaim, "old-class"))
+
+			sync()
+			dataVolume := getDataVolume()
+			Expect(dataVolume.OwnerReferences).To(HaveLen(1))
+			Expect(dataVolume.OwnerReferences[0].UID).To(Equal(vm.UID))
+			Expect(dataVolume.Labels).To(HaveKeyWithValue(v1.CreatedByLabel, string(vm.UID)))
+		})
+
+		It("should fail if the clone failed", func() {
+			vm := newVM(nil)
+			vm.Status.StorageMigration = runningStatus(v1.StorageMigrationMethodCDI)
+			setupController(vm, newPVC(sourceClaim, "old-class"), newTargetDataVolume(cdiv1.Failed, ""))
+
+			status := sync().Status.StorageMigration
+			Expect(status.Phase).To(Equal(v1.StorageMigrationFailed))
+			Expect(status.Message).To(Equal("DataVolume disk0-new-class failed"))
+		})
+	})
+
+	It("should clear the status once the storage migration is removed", func() {
+		vm := newVM(nil)
+		vm.Spec.StorageMigration = nil
+		vm.Status.StorageMigration = runningStatus(v1.StorageMigrationMethodCDI)
+		setupController(vm)
+
+		Expect(sync().Status.StorageMigration).To(BeNil())
+	})
+
+	It("should keep the names of the target claims short and unique", func() {
+		name := targetClaimName(strings.Repeat("a", 60), "class")
+		Expect(len(name)).To(BeNumerically("<=", maxTargetClaimNameLength))
+		Expect(name).To(Equal(targetClaimName(strings.Repeat("a", 60), "class")))
+		Expect(name).ToNot(Equal(targetClaimName(strings.Repeat("a", 60), "other-class")))
+		Expect(targetClaimName("disk", "class")).To(Equal("disk-class"))
+	})
+})
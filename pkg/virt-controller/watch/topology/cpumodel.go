@@ -0,0 +1,120 @@
+package topology
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	v1 "k8s.io/api/core/v1"
+
+	k6tv1 "kubevirt.io/api/core/v1"
+)
+
+// nonMigratableCPUFeatures are the features QEMU blocks the migration of the guests using them with.
+// The node-labeller labels them like any other host feature, but the common CPU must keep the VMI migratable,
+// and invtsc would also require a TSC frequency to boot.
+var nonMigratableCPUFeatures = map[string]bool{
+	"invtsc":  true,
+	"monitor": true,
+}
+
+// IsClusterCommonCPUModelRequested tells if the VMI requests the CPU model and features common to the nodes it
+// can be scheduled to
+func IsClusterCommonCPUModelRequested(vmi *k6tv1.VirtualMachineInstance) bool {
+	return vmi != nil && vmi.Spec.Domain.CPU != nil && vmi.Spec.Domain.CPU.Model == k6tv1.CPUModeClusterCommon
+}
+
+func AreClusterCommonCPUTopologyHintsDefined(vmi *k6tv1.VirtualMachineInstance) bool {
+	if vmi == nil {
+		return false
+	}
+
+	topologyHints := vmi.Status.TopologyHints
+	return topologyHints != nil && topologyHints.CPUModel != ""
+}
+
+// MatchesNodeSelector filters the nodes carrying all the labels of the selector
+func MatchesNodeSelector(selector map[string]string) FilterPredicateFunc {
+	return func(node *v1.Node) bool {
+		if node == nil {
+			return false
+		}
+		for key, value := range selector {
+			if nodeValue, exists := node.Labels[key]; !exists || nodeValue != value {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+// ClusterCommonCPU returns the CPU model and the migratable CPU features usable on all the nodes of the pool.
+// Among the common models, the host model of a node of the pool is preferred, and then the model usable
+// on the fewest nodes of the cluster, as it is the most recent one.
+func ClusterCommonCPU(pool []*v1.Node, cluster []*v1.Node) (model string, features []string, err error) {
+	if len(pool) == 0 {
+		return "", nil, fmt.Errorf("no schedulable node matches the node selector")
+	}
+
+	models := commonLabelSuffixes(pool, k6tv1.CPUModelLabel)
+	if len(models) == 0 {
+		return "", nil, fmt.Errorf("the %d nodes matching the node selector have no CPU model in common", len(pool))
+	}
+
+	hostModels := map[string]bool{}
+	for _, node := range pool {
+		for _, hostModel := range labelSuffixes(node, k6tv1.HostModelCPULabel) {
+			hostModels[hostModel] = true
+		}
+	}
+	usage := map[string]int{}
+	for _, node := range cluster {
+		for _, usableModel := range labelSuffixes(node, k6tv1.CPUModelLabel) {
+			usage[usableModel]++
+		}
+	}
+	sort.Slice(models, func(i, j int) bool {
+		if hostModels[models[i]] != hostModels[models[j]] {
+			return hostModels[models[i]]
+		}
+		if usage[models[i]] != usage[models[j]] {
+			return usage[models[i]] < usage[models[j]]
+		}
+		return models[i] < models[j]
+	})
+
+	for _, feature := range commonLabelSuffixes(pool, k6tv1.CPUFeatureLabel) {
+		if !nonMigratableCPUFeatures[feature] {
+			features = append(features, feature)
+		}
+	}
+	sort.Strings(features)
+	return models[0], features, nil
+}
+
+// commonLabelSuffixes returns the suffixes of the labels with the prefix set to true on all the nodes
+func commonLabelSuffixes(nodes []*v1.Node, prefix string) []string {
+	counts := map[string]int{}
+	for _, node := range nodes {
+		for _, suffix := range labelSuffixes(node, prefix) {
+			counts[suffix]++
+		}
+	}
+	var common []string
+	for suffix, count := range counts {
+		if count == len(nodes) {
+			common = append(common, suffix)
+		}
+	}
+	return common
+}
+
+func labelSuffixes(node *v1.Node, prefix string) []string {
+	var suffixes []string
+	for key, value := range node.Labels {
+		if value == "true" && strings.HasPrefix(key, prefix) {
+			suffixes = append(suffixes, strings.TrimPrefix(key, prefix))
+		}
+	}
+	return suffixes
+}
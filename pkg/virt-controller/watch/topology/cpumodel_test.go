@@ -0,0 +1,103 @@
+package topology_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	virtv1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/virt-controller/watch/topology"
+)
+
+var _ = Describe("Cluster-common CPU model", func() {
+
+	It("should prefer a common host model of the pool", func() {
+		skylake := nodeWithCPU("skylake", "Skylake-Client-IBRS", []string{"Skylake-Client-IBRS", "Haswell-noTSX"}, []string{"ssbd", "md-clear"})
+		cascadelake := nodeWithCPU("cascadelake", "Cascadelake-Server", []string{"Cascadelake-Server", "Skylake-Client-IBRS", "Haswell-noTSX"}, []string{"ssbd", "md-clear", "avx512vnni"})
+
+		model, features, err := topology.ClusterCommonCPU(
+			[]*v1.Node{skylake, cascadelake},
+			[]*v1.Node{skylake, cascadelake},
+		)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(model).To(Equal("Skylake-Client-IBRS"))
+		Expect(features).To(Equal([]string{"md-clear", "ssbd"}))
+	})
+
+	It("should prefer the common model usable on the fewest nodes of the cluster", func() {
+		epyc := nodeWithCPU("epyc", "EPYC-Rome", []string{"EPYC-Rome", "Opteron_G3", "Westmere"}, nil)
+		skylake := nodeWithCPU("skylake", "Skylake-Client-IBRS", []string{"Skylake-Client-IBRS", "Opteron_G3", "Westmere"}, nil)
+		broadwell := nodeWithCPU("broadwell", "Broadwell", []string{"Broadwell", "Westmere"}, nil)
+
+		model, features, err := topology.ClusterCommonCPU(
+			[]*v1.Node{epyc, skylake},
+			[]*v1.Node{epyc, skylake, broadwell},
+		)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(model).To(Equal("Opteron_G3"))
+		Expect(features).To(BeEmpty())
+	})
+
+	It("should leave out the non-migratable features", func() {
+		skylake := nodeWithCPU("skylake", "Skylake-Client-IBRS", []string{"Skylake-Client-IBRS"}, []string{"ssbd", "invtsc", "monitor"})
+		cascadelake := nodeWithCPU("cascadelake", "Cascadelake-Server", []string{"Cascadelake-Server", "Skylake-Client-IBRS"}, []string{"ssbd", "invtsc", "monitor"})
+
+		_, features, err := topology.ClusterCommonCPU(
+			[]*v1.Node{skylake, cascadelake},
+			[]*v1.Node{skylake, cascadelake},
+		)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(features).To(Equal([]string{"ssbd"}))
+	})
+
+	It("should fail without nodes", func() {
+		_, _, err := topology.ClusterCommonCPU(nil, nil)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should fail when the nodes have no model in common", func() {
+		epyc := nodeWithCPU("epyc", "EPYC-Rome", []string{"EPYC-Rome"}, nil)
+		skylake := nodeWithCPU("skylake", "Skylake-Client-IBRS", []string{"Skylake-Client-IBRS"}, nil)
+
+		_, _, err := topology.ClusterCommonCPU([]*v1.Node{epyc, skylake}, []*v1.Node{epyc, skylake})
+		Expect(err).To(MatchError(ContainSubstring("no CPU model in common")))
+	})
+
+	It("should match the nodes carrying all the labels of the node selector", func() {
+		nodes := topology.NodesToObjects(
+			nodeWithLabels("node0", map[string]string{"pool": "a", "zone": "1"}),
+			nodeWithLabels("node1", map[string]string{"pool": "a", "zone": "2"}),
+			nodeWithLabels("node2", map[string]string{"pool": "b", "zone": "1"}),
+			nil,
+		)
+		Expect(topology.FilterNodesFromCache(nodes,
+			topology.MatchesNodeSelector(map[string]string{"pool": "a"}),
+		)).To(ConsistOf(nodes[0], nodes[1]))
+	})
+})
+
+func nodeWithCPU(name, hostModel string, models []string, features []string) *v1.Node {
+	labels := map[string]string{
+		virtv1.NodeSchedulable:               "true",
+		virtv1.HostModelCPULabel + hostModel: "true",
+	}
+	for _, model := range models {
+		labels[virtv1.CPUModelLabel+model] = "true"
+		labels[virtv1.SupportedHostModelMigrationCPU+model] = "true"
+	}
+	for _, feature := range features {
+		labels[virtv1.CPUFeatureLabel+feature] = "true"
+	}
+	return nodeWithLabels(name, labels)
+}
+
+func nodeWithLabels(name string, labels map[string]string) *v1.Node {
+	return &v1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   name,
+			Labels: labels,
+		},
+	}
+}
@@ -4,7 +4,10 @@ package topology
 
 import (
 	"fmt"
+	"maps"
+	"strings"
 
+	v1 "k8s.io/api/core/v1"
 	"k8s.io/client-go/tools/cache"
 
 	"kubevirt.io/kubevirt/pkg/pointer"
@@ -33,6 +36,15 @@ func (t *topologyHinter) IsTscFrequencyRequired(vmi *k6tv1.VirtualMachineInstanc
 
 func (t *topologyHinter) TopologyHintsForVMI(vmi *k6tv1.VirtualMachineInstance) (hints *k6tv1.TopologyHints, requirement TscFrequencyRequirementType, err error) {
 	requirement = GetTscFrequencyRequirement(vmi).Type
+
+	if IsClusterCommonCPUModelRequested(vmi) {
+		model, features, err := t.clusterCommonCPUForVMI(vmi)
+		if err != nil {
+			return nil, requirement, fmt.Errorf("failed to determine the CPU model common to the nodes of the VMI: %v", err)
+		}
+		hints = &k6tv1.TopologyHints{CPUModel: model, CPUFeatures: features}
+	}
+
 	if requirement == NotRequired || vmi.Spec.Architecture != "amd64" {
 		return
 	}
@@ -42,10 +54,28 @@ func (t *topologyHinter) TopologyHintsForVMI(vmi *k6tv1.VirtualMachineInstance)
 		return nil, requirement, fmt.Errorf("failed to determine the lowest tsc frequency on the cluster: %v", err)
 	}
 
-	hints = &k6tv1.TopologyHints{TSCFrequency: pointer.P(int64(freq))}
+	if hints == nil {
+		hints = &k6tv1.TopologyHints{}
+	}
+	hints.TSCFrequency = pointer.P(int64(freq))
 	return
 }
 
+// clusterCommonCPUForVMI returns the CPU model and features common to the schedulable nodes matching the
+// node selectors of the VMI and of the cluster
+func (t *topologyHinter) clusterCommonCPUForVMI(vmi *k6tv1.VirtualMachineInstance) (string, []string, error) {
+	selector := map[string]string{}
+	if vmi.Spec.Architecture != "" {
+		selector[v1.LabelArchStable] = strings.ToLower(vmi.Spec.Architecture)
+	}
+	maps.Copy(selector, t.clusterConfig.GetNodeSelectors())
+	maps.Copy(selector, vmi.Spec.NodeSelector)
+
+	nodes := t.nodeStore.List()
+	pool := FilterNodesFromCache(nodes, IsSchedulable, MatchesNodeSelector(selector))
+	return ClusterCommonCPU(pool, FilterNodesFromCache(nodes))
+}
+
 func (t *topologyHinter) LowestTSCFrequencyOnCluster() (int64, error) {
 	configTSCFrequency := t.clusterConfig.GetMinimumClusterTSCFrequency()
 	if configTSCFrequency != nil {
@@ -72,6 +72,58 @@ var _ = Describe("Hinter", func() {
 		g.Expect(hinter.TSCFrequenciesInUse()).To(g.ConsistOf(int64(100), int64(90), int64(123), int64(80)))
 	})
 
+	It("should propose the CPU model common to the nodes matching the node selector of the VMI", func() {
+		hinter := hinterWithNodes(
+			nodeWithCPUModels("node0", "a", "Skylake-Client-IBRS", "Haswell-noTSX"),
+			nodeWithCPUModels("node1", "a", "Cascadelake-Server", "Skylake-Client-IBRS", "Haswell-noTSX"),
+			nodeWithCPUModels("node2", "b", "Haswell-noTSX"),
+		)
+		vmi := &virtv1.VirtualMachineInstance{
+			Spec: virtv1.VirtualMachineInstanceSpec{
+				Domain: virtv1.DomainSpec{
+					CPU: &virtv1.CPU{Model: virtv1.CPUModeClusterCommon},
+				},
+				NodeSelector: map[string]string{"pool": "a"},
+			},
+		}
+		hints, requirement, err := hinter.TopologyHintsForVMI(vmi)
+		g.Expect(err).ToNot(g.HaveOccurred())
+		g.Expect(requirement).To(g.Equal(NotRequired))
+		g.Expect(hints).To(g.Equal(
+			&virtv1.TopologyHints{
+				CPUModel: "Skylake-Client-IBRS",
+			},
+		))
+	})
+
+	It("should keep the VMI migratable without TSC frequency when the nodes carry invtsc", func() {
+		node0 := nodeWithCPUModels("node0", "a", "Skylake-Client-IBRS")
+		node1 := nodeWithCPUModels("node1", "a", "Skylake-Client-IBRS")
+		for _, node := range []*v1.Node{node0, node1} {
+			node.Labels[v1.LabelArchStable] = "amd64"
+			node.Labels[virtv1.CPUFeatureLabel+"invtsc"] = "true"
+			node.Labels[virtv1.CPUFeatureLabel+"ssbd"] = "true"
+		}
+		hinter := hinterWithNodes(node0, node1)
+		vmi := &virtv1.VirtualMachineInstance{
+			Spec: virtv1.VirtualMachineInstanceSpec{
+				Architecture: "amd64",
+				Domain: virtv1.DomainSpec{
+					CPU: &virtv1.CPU{Model: virtv1.CPUModeClusterCommon},
+				},
+			},
+		}
+		hints, requirement, err := hinter.TopologyHintsForVMI(vmi)
+		g.Expect(err).ToNot(g.HaveOccurred())
+		g.Expect(requirement).To(g.Equal(NotRequired))
+		g.Expect(hints).To(g.Equal(
+			&virtv1.TopologyHints{
+				CPUModel:    "Skylake-Client-IBRS",
+				CPUFeatures: []string{"ssbd"},
+			},
+		))
+	})
+
 	DescribeTable("should not propose a TSC frequency on architectures like", func(arch string) {
 		hinter := hinterWithNodes(
 			NodeWithInvalidTSC("node0"),
@@ -144,6 +196,23 @@ func NodeWithTSC(name string, frequency int64, scalable bool, schedulable ...int
 	}
 }
 
+func nodeWithCPUModels(name, pool string, models ...string) *v1.Node {
+	labels := map[string]string{
+		"pool":                               pool,
+		virtv1.NodeSchedulable:               "true",
+		virtv1.HostModelCPULabel + models[0]: "true",
+	}
+	for _, model := range models {
+		labels[virtv1.CPUModelLabel+model] = "true"
+	}
+	return &v1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   name,
+			Labels: labels,
+		},
+	}
+}
+
 func NodeWithInvalidTSC(name string) *v1.Node {
 	return &v1.Node{
 		ObjectMeta: metav1.ObjectMeta{
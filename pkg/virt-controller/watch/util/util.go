@@ -35,9 +35,20 @@ import (
 	cdiv1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
 
 	typesutil "kubevirt.io/kubevirt/pkg/storage/types"
+	"kubevirt.io/kubevirt/pkg/virt-controller/watch/circuitbreaker"
 )
 
 func ProcessWorkItem(queue workqueue.TypedRateLimitingInterface[string], handler func(string) (time.Duration, error)) bool {
+	return ProcessWorkItemWithBreaker(queue, nil, handler)
+}
+
+// ProcessWorkItemWithBreaker works like ProcessWorkItem, but requeues the keys without calling the handler
+// while the circuit breaker of the controller is open. A nil circuit breaker is never open.
+func ProcessWorkItemWithBreaker(
+	queue workqueue.TypedRateLimitingInterface[string],
+	breaker *circuitbreaker.CircuitBreaker,
+	handler func(string) (time.Duration, error),
+) bool {
 	obj, shutdown := queue.Get()
 	if shutdown {
 		return false
@@ -46,7 +57,18 @@ func ProcessWorkItem(queue workqueue.TypedRateLimitingInterface[string], handler
 	err := func(key string) error {
 		defer queue.Done(obj)
 
-		if requeueAfter, err := handler(key); requeueAfter > 0 || err != nil {
+		if breaker != nil {
+			if openFor := breaker.Allow(); openFor > 0 {
+				queue.AddAfter(key, openFor)
+				return nil
+			}
+		}
+
+		requeueAfter, err := handler(key)
+		if breaker != nil {
+			breaker.Record(err)
+		}
+		if requeueAfter > 0 || err != nil {
 			if requeueAfter > 0 {
 				queue.AddAfter(key, requeueAfter)
 			} else {
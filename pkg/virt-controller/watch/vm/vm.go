@@ -72,6 +72,7 @@ import (
 	traceUtils "kubevirt.io/kubevirt/pkg/util/trace"
 	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
 	volumemig "kubevirt.io/kubevirt/pkg/virt-controller/watch/volume-migration"
+	volumepreparation "kubevirt.io/kubevirt/pkg/virt-controller/watch/volume-preparation"
 )
 
 const (
@@ -108,15 +109,16 @@ const (
 )
 
 const (
-	hotplugVolumeErrorReason     = "HotPlugVolumeError"
-	hotplugCPUErrorReason        = "HotPlugCPUError"
-	failedUpdateErrorReason      = "FailedUpdateError"
-	failedCreateReason           = "FailedCreate"
-	vmiFailedDeleteReason        = "FailedDelete"
-	affinityChangeErrorReason    = "AffinityChangeError"
-	hotplugMemoryErrorReason     = "HotPlugMemoryError"
-	volumesUpdateErrorReason     = "VolumesUpdateError"
-	tolerationsChangeErrorReason = "TolerationsChangeError"
+	hotplugVolumeErrorReason      = "HotPlugVolumeError"
+	hotplugCPUErrorReason         = "HotPlugCPUError"
+	failedUpdateErrorReason       = "FailedUpdateError"
+	failedCreateReason            = "FailedCreate"
+	vmiFailedDeleteReason         = "FailedDelete"
+	affinityChangeErrorReason     = "AffinityChangeError"
+	hotplugMemoryErrorReason      = "HotPlugMemoryError"
+	volumesUpdateErrorReason      = "VolumesUpdateError"
+	tolerationsChangeErrorReason  = "TolerationsChangeError"
+	hotplugHostDevicesErrorReason = "HotPlugHostDevicesError"
 )
 
 const defaultMaxCrashLoopBackoffDelaySeconds = 300
@@ -127,6 +129,7 @@ func NewController(vmiInformer cache.SharedIndexInformer,
 	dataSourceInformer cache.SharedIndexInformer,
 	namespaceStore cache.Store,
 	pvcInformer cache.SharedIndexInformer,
+	storageClassInformer cache.SharedIndexInformer,
 	crInformer cache.SharedIndexInformer,
 	podInformer cache.SharedIndexInformer,
 	recorder record.EventRecorder,
@@ -134,6 +137,7 @@ func NewController(vmiInformer cache.SharedIndexInformer,
 	clusterConfig *virtconfig.ClusterConfig,
 	netSynchronizer synchronizer,
 	instancetypeController instancetypeHandler,
+	volumePreparationThrottler *volumepreparation.Throttler,
 ) (*Controller, error) {
 
 	c := &Controller{
@@ -147,6 +151,7 @@ func NewController(vmiInformer cache.SharedIndexInformer,
 		dataSourceStore:        dataSourceInformer.GetStore(),
 		namespaceStore:         namespaceStore,
 		pvcStore:               pvcInformer.GetStore(),
+		storageClassStore:      storageClassInformer.GetStore(),
 		crIndexer:              crInformer.GetIndexer(),
 		instancetypeController: instancetypeController,
 		recorder:               recorder,
@@ -157,14 +162,16 @@ func NewController(vmiInformer cache.SharedIndexInformer,
 			response, err := dv.AuthorizeSA(requestNamespace, requestName, proxy, saNamespace, saName)
 			return response.Allowed, response.Reason, err
 		},
-		clusterConfig:   clusterConfig,
-		netSynchronizer: netSynchronizer,
+		clusterConfig:              clusterConfig,
+		netSynchronizer:            netSynchronizer,
+		volumePreparationThrottler: volumePreparationThrottler,
 	}
 
 	c.hasSynced = func() bool {
 		return vmiInformer.HasSynced() && vmInformer.HasSynced() &&
 			dataVolumeInformer.HasSynced() && dataSourceInformer.HasSynced() &&
-			pvcInformer.HasSynced() && crInformer.HasSynced() && podInformer.HasSynced()
+			pvcInformer.HasSynced() && storageClassInformer.HasSynced() &&
+			crInformer.HasSynced() && podInformer.HasSynced()
 	}
 
 	_, err := vmInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
@@ -252,6 +259,7 @@ type Controller struct {
 	dataSourceStore        cache.Store
 	namespaceStore         cache.Store
 	pvcStore               cache.Store
+	storageClassStore      cache.Store
 	crIndexer              cache.Indexer
 	instancetypeController instancetypeHandler
 	recorder               record.EventRecorder
@@ -261,7 +269,8 @@ type Controller struct {
 	clusterConfig          *virtconfig.ClusterConfig
 	hasSynced              func() bool
 
-	netSynchronizer synchronizer
+	netSynchronizer            synchronizer
+	volumePreparationThrottler *volumepreparation.Throttler
 }
 
 func (c *Controller) Run(threadiness int, stopCh <-chan struct{}) {
@@ -498,10 +507,21 @@ func (c *Controller) handleDataVolumes(vm *virtv1.VirtualMachine) (bool, error)
 				return ready, err
 			}
 
+			if admission := c.volumePreparationThrottler.AdmitDataVolume(newDataVolume); !admission.Admitted {
+				if admission.NewlyThrottled {
+					c.recorder.Eventf(vm, k8score.EventTypeNormal, volumepreparation.ThrottledReason,
+						"Delaying creation of DataVolume %s, storage class %s reached the limit of %d parallel volume preparations",
+						newDataVolume.Name, admission.StorageClass, admission.Limit)
+				}
+				c.Queue.AddAfter(vmKey, volumepreparation.RequeueInterval)
+				continue
+			}
+
 			c.dataVolumeExpectations.ExpectCreations(vmKey, 1)
 			curDataVolume, err = c.clientset.CdiClient().CdiV1beta1().DataVolumes(vm.Namespace).Create(context.Background(), newDataVolume, metav1.CreateOptions{})
 			if err != nil {
 				c.dataVolumeExpectations.CreationObserved(vmKey)
+				c.volumePreparationThrottler.ReleaseDataVolume(newDataVolume)
 				if pvc != nil && strings.Contains(err.Error(), "already exists") {
 					// If the PVC already exists, we can ignore the error and continue
 					// probably old version of CDI
@@ -765,6 +785,81 @@ func (c *Controller) handleAffinityChangeRequest(vm *virtv1.VirtualMachine, vmi
 	return nil
 }
 
+func (c *Controller) vmiHostDevicesPatch(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance) error {
+	devices := vm.Spec.Template.Spec.Domain.Devices
+	patchset := patch.New()
+
+	if !equality.Semantic.DeepEqual(devices.GPUs, vmi.Spec.Domain.Devices.GPUs) {
+		if vmi.Spec.Domain.Devices.GPUs == nil {
+			patchset.AddOption(patch.WithAdd("/spec/domain/devices/gpus", devices.GPUs))
+		} else {
+			patchset.AddOption(
+				patch.WithTest("/spec/domain/devices/gpus", vmi.Spec.Domain.Devices.GPUs),
+				patch.WithReplace("/spec/domain/devices/gpus", devices.GPUs))
+		}
+	}
+
+	if !equality.Semantic.DeepEqual(devices.HostDevices, vmi.Spec.Domain.Devices.HostDevices) {
+		if vmi.Spec.Domain.Devices.HostDevices == nil {
+			patchset.AddOption(patch.WithAdd("/spec/domain/devices/hostDevices", devices.HostDevices))
+		} else {
+			patchset.AddOption(
+				patch.WithTest("/spec/domain/devices/hostDevices", vmi.Spec.Domain.Devices.HostDevices),
+				patch.WithReplace("/spec/domain/devices/hostDevices", devices.HostDevices))
+		}
+	}
+
+	if !equality.Semantic.DeepEqual(devices.USBDevices, vmi.Spec.Domain.Devices.USBDevices) {
+		if vmi.Spec.Domain.Devices.USBDevices == nil {
+			patchset.AddOption(patch.WithAdd("/spec/domain/devices/usbDevices", devices.USBDevices))
+		} else {
+			patchset.AddOption(
+				patch.WithTest("/spec/domain/devices/usbDevices", vmi.Spec.Domain.Devices.USBDevices),
+				patch.WithReplace("/spec/domain/devices/usbDevices", devices.USBDevices))
+		}
+	}
+
+	generatedPatch, err := patchset.GeneratePayload()
+	if err != nil {
+		return err
+	}
+
+	_, err = c.clientset.VirtualMachineInstance(vmi.Namespace).Patch(context.Background(), vmi.Name, types.JSONPatchType, generatedPatch, metav1.PatchOptions{})
+	return err
+}
+
+// handleHostDevicesChangeRequest adds the GPUs and host devices added to the template spec to the VMI.
+// The VMI controller then migrates the VMI to a pod which has them allocated, see VirtualMachineInstanceHostDevicesChange.
+// The USB devices of the template spec are synced to the VMI as well, virt-handler attaches and detaches them.
+func (c *Controller) handleHostDevicesChangeRequest(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance) error {
+	if vmi == nil || vmi.DeletionTimestamp != nil {
+		return nil
+	}
+
+	vmCopyWithInstancetype := vm.DeepCopy()
+	if err := c.instancetypeController.ApplyToVM(vmCopyWithInstancetype); err != nil {
+		return err
+	}
+
+	devices := vmCopyWithInstancetype.Spec.Template.Spec.Domain.Devices
+	if equality.Semantic.DeepEqual(devices.GPUs, vmi.Spec.Domain.Devices.GPUs) &&
+		equality.Semantic.DeepEqual(devices.HostDevices, vmi.Spec.Domain.Devices.HostDevices) &&
+		equality.Semantic.DeepEqual(devices.USBDevices, vmi.Spec.Domain.Devices.USBDevices) {
+		return nil
+	}
+
+	if migrations.IsMigrating(vmi) {
+		return fmt.Errorf("GPUs and host devices should not be changed during VMI migration")
+	}
+
+	if err := c.vmiHostDevicesPatch(vmCopyWithInstancetype, vmi); err != nil {
+		log.Log.Object(vmi).Errorf("unable to patch vmi to update GPUs and host devices: %v", err)
+		return err
+	}
+
+	return nil
+}
+
 func (c *Controller) handleVolumeRequests(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance) error {
 	if len(vm.Status.VolumeRequests) == 0 {
 		return nil
@@ -2122,6 +2217,9 @@ func (c *Controller) updateDataVolume(old, cur interface{}) {
 		}
 		return
 	}
+	if curDataVolume.Status.Phase != oldDataVolume.Status.Phase {
+		c.volumePreparationThrottler.UpdateMetrics()
+	}
 	curControllerRef := metav1.GetControllerOf(curDataVolume)
 	oldControllerRef := metav1.GetControllerOf(oldDataVolume)
 	controllerRefChanged := !equality.Semantic.DeepEqual(curControllerRef, oldControllerRef)
@@ -2159,6 +2257,7 @@ func (c *Controller) deleteDataVolume(obj interface{}) {
 			}
 		}
 	}
+	c.volumePreparationThrottler.UpdateMetrics()
 	c.queueVMsForDataVolume(dataVolume)
 }
 
@@ -2403,6 +2502,7 @@ func (c *Controller) updateStatus(vm, vmOrig *virtv1.VirtualMachine, vmi *virtv1
 	// On a successful migration, the volume change condition is removed and we need to detect the removal before the synchronization of the VMI
 	// condition to the VM
 	syncVolumeMigration(vm, vmi)
+	c.syncDataVolumeFailureCondition(vm)
 	syncConditions(vm, vmi, syncErr)
 	c.setPrintableStatus(vm, vmi)
 
@@ -2596,7 +2696,54 @@ func (c *Controller) isVirtualMachineStatusDataVolumeError(vm *virtv1.VirtualMac
 		log.Log.Object(vm).Errorf("%v", err)
 		return true
 	}
-	return false
+	return controller.NewVirtualMachineConditionManager().HasConditionWithStatus(vm,
+		virtv1.VirtualMachineDataVolumeFailure, k8score.ConditionTrue)
+}
+
+// syncDataVolumeFailureCondition reports in the DataVolumeFailure condition
+// why the DataVolumes of the VM can't be populated.
+func (c *Controller) syncDataVolumeFailureCondition(vm *virtv1.VirtualMachine) {
+	var reason string
+	var messages []string
+	for _, volume := range vm.Spec.Template.Spec.Volumes {
+		if volume.DataVolume == nil {
+			continue
+		}
+
+		dv, err := storagetypes.GetDataVolumeFromCache(vm.Namespace, volume.DataVolume.Name, c.dataVolumeStore)
+		if err != nil || dv == nil {
+			continue
+		}
+
+		if failure := storagetypes.GetDataVolumeFailure(dv, c.storageClassStore); failure != nil {
+			if reason == "" {
+				reason = failure.Reason
+			}
+			messages = append(messages, failure.Message)
+		}
+	}
+
+	cm := controller.NewVirtualMachineConditionManager()
+	if len(messages) == 0 {
+		cm.RemoveCondition(vm, virtv1.VirtualMachineDataVolumeFailure)
+		return
+	}
+
+	message := strings.Join(messages, "; ")
+	if cond := cm.GetCondition(vm, virtv1.VirtualMachineDataVolumeFailure); cond != nil &&
+		cond.Status == k8score.ConditionTrue && cond.Reason == reason && cond.Message == message {
+		return
+	}
+
+	now := metav1.Now()
+	cm.UpdateCondition(vm, &virtv1.VirtualMachineCondition{
+		Type:               virtv1.VirtualMachineDataVolumeFailure,
+		Status:             k8score.ConditionTrue,
+		Reason:             reason,
+		Message:            message,
+		LastProbeTime:      now,
+		LastTransitionTime: now,
+	})
 }
 
 func syncReadyConditionFromVMI(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance) {
@@ -2651,9 +2798,10 @@ func syncConditions(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstanc
 
 	// sync VMI conditions, ignore list represents conditions that are not synced generically
 	syncIgnoreMap := map[string]interface{}{
-		string(virtv1.VirtualMachineReady):           nil,
-		string(virtv1.VirtualMachineFailure):         nil,
-		string(virtv1.VirtualMachineRestartRequired): nil,
+		string(virtv1.VirtualMachineReady):             nil,
+		string(virtv1.VirtualMachineFailure):           nil,
+		string(virtv1.VirtualMachineRestartRequired):   nil,
+		string(virtv1.VirtualMachineDataVolumeFailure): nil,
 	}
 	vmiCondMap := make(map[string]interface{})
 
@@ -2868,6 +3016,36 @@ func validLiveUpdateDisks(oldVMSpec *virtv1.VirtualMachineSpec, vm *virtv1.Virtu
 	return true
 }
 
+// validLiveUpdateHostDevices returns true if GPUs and host devices were only added to the template spec.
+// They can be hotplugged by migrating the VMI, but not unplugged.
+func validLiveUpdateHostDevices(oldVMSpec *virtv1.VirtualMachineSpec, vm *virtv1.VirtualMachine) bool {
+	oldDevices := oldVMSpec.Template.Spec.Domain.Devices
+	devices := vm.Spec.Template.Spec.Domain.Devices
+	return onlyAddedByName(oldDevices.GPUs, devices.GPUs, func(gpu virtv1.GPU) string { return gpu.Name }) &&
+		onlyAddedByName(oldDevices.HostDevices, devices.HostDevices, func(dev virtv1.HostDevice) string { return dev.Name })
+}
+
+// validLiveUpdateUSBDevices returns true if the USB devices of the template spec can be changed without a restart.
+// The domain needs a USB controller to attach them to, which it only has if it was started with USB devices.
+func validLiveUpdateUSBDevices(oldVMSpec *virtv1.VirtualMachineSpec, vm *virtv1.VirtualMachine) bool {
+	return len(oldVMSpec.Template.Spec.Domain.Devices.USBDevices) > 0 ||
+		len(vm.Spec.Template.Spec.Domain.Devices.USBDevices) == 0
+}
+
+func onlyAddedByName[T any](oldItems, items []T, nameOf func(T) string) bool {
+	itemsByName := make(map[string]T, len(items))
+	for _, item := range items {
+		itemsByName[nameOf(item)] = item
+	}
+	for _, oldItem := range oldItems {
+		item, exists := itemsByName[nameOf(oldItem)]
+		if !exists || !equality.Semantic.DeepEqual(oldItem, item) {
+			return false
+		}
+	}
+	return true
+}
+
 func setRestartRequired(vm *virtv1.VirtualMachine, message string) {
 	vmConditions := controller.NewVirtualMachineConditionManager()
 	vmConditions.UpdateCondition(vm, &virtv1.VirtualMachineCondition{
@@ -2908,6 +3086,13 @@ func (c *Controller) addRestartRequiredIfNeeded(lastSeenVMSpec *virtv1.VirtualMa
 		if validLiveUpdateDisks(lastSeenVMSpec, currentVM) {
 			lastSeenVMSpec.Template.Spec.Domain.Devices.Disks = currentVM.Spec.Template.Spec.Domain.Devices.Disks
 		}
+		if validLiveUpdateHostDevices(lastSeenVMSpec, currentVM) {
+			lastSeenVMSpec.Template.Spec.Domain.Devices.GPUs = currentVM.Spec.Template.Spec.Domain.Devices.GPUs
+			lastSeenVMSpec.Template.Spec.Domain.Devices.HostDevices = currentVM.Spec.Template.Spec.Domain.Devices.HostDevices
+		}
+		if validLiveUpdateUSBDevices(lastSeenVMSpec, currentVM) {
+			lastSeenVMSpec.Template.Spec.Domain.Devices.USBDevices = currentVM.Spec.Template.Spec.Domain.Devices.USBDevices
+		}
 		if lastSeenVMSpec.Template.Spec.Domain.CPU != nil && currentVM.Spec.Template.Spec.Domain.CPU != nil {
 			lastSeenVMSpec.Template.Spec.Domain.CPU.Sockets = currentVM.Spec.Template.Spec.Domain.CPU.Sockets
 		}
@@ -3078,6 +3263,10 @@ func (c *Controller) sync(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineI
 		if err := c.handleVolumeUpdateRequest(vmCopy, vmi); err != nil {
 			return vm, vmi, common.NewSyncError(fmt.Errorf("error encountered while handling volumes update requests: %v", err), volumesUpdateErrorReason), nil
 		}
+
+		if err := c.handleHostDevicesChangeRequest(vmCopy, vmi); err != nil {
+			return vm, vmi, common.NewSyncError(fmt.Errorf("error encountered while handling host devices hotplug requests: %v", err), hotplugHostDevicesErrorReason), nil
+		}
 	}
 
 	if !equality.Semantic.DeepEqual(vm.Spec, vmCopy.Spec) || !equality.Semantic.DeepEqual(vm.ObjectMeta, vmCopy.ObjectMeta) {
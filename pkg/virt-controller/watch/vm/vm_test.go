@@ -15,6 +15,7 @@ import (
 	appsv1 "k8s.io/api/apps/v1"
 	authorizationv1 "k8s.io/api/authorization/v1"
 	k8sv1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -45,11 +46,13 @@ import (
 	instancetypecontroller "kubevirt.io/kubevirt/pkg/instancetype/controller/vm"
 	"kubevirt.io/kubevirt/pkg/instancetype/revision"
 	"kubevirt.io/kubevirt/pkg/pointer"
+	storagetypes "kubevirt.io/kubevirt/pkg/storage/types"
 	"kubevirt.io/kubevirt/pkg/testutils"
 	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
 	"kubevirt.io/kubevirt/pkg/virt-controller/watch/common"
 	watchtesting "kubevirt.io/kubevirt/pkg/virt-controller/watch/testing"
 	watchutil "kubevirt.io/kubevirt/pkg/virt-controller/watch/util"
+	volumepreparation "kubevirt.io/kubevirt/pkg/virt-controller/watch/volume-preparation"
 
 	gomegatypes "github.com/onsi/gomega/types"
 
@@ -94,11 +97,12 @@ var _ = Describe("VirtualMachine", func() {
 			virtFakeClient.PrependReactor("patch", "virtualmachines",
 				PatchReactor(Handle, virtFakeClient.Tracker(), ModifyVM))
 
-			dataVolumeInformer, _ := testutils.NewFakeInformerFor(&cdiv1.DataVolume{})
+			dataVolumeInformer, _ := testutils.NewFakeInformerWithIndexersFor(&cdiv1.DataVolume{}, virtcontroller.GetDataVolumeInformerIndexers())
 			dataSourceInformer, _ := testutils.NewFakeInformerFor(&cdiv1.DataSource{})
 			vmiInformer, _ := testutils.NewFakeInformerWithIndexersFor(&v1.VirtualMachineInstance{}, virtcontroller.GetVMIInformerIndexers())
 			vmInformer, _ := testutils.NewFakeInformerWithIndexersFor(&v1.VirtualMachine{}, virtcontroller.GetVirtualMachineInformerIndexers())
-			pvcInformer, _ := testutils.NewFakeInformerFor(&k8sv1.PersistentVolumeClaim{})
+			pvcInformer, _ := testutils.NewFakeInformerWithIndexersFor(&k8sv1.PersistentVolumeClaim{}, virtcontroller.GetPersistentVolumeClaimInformerIndexers())
+			storageClassInformer, _ := testutils.NewFakeInformerFor(&storagev1.StorageClass{})
 			namespaceInformer, _ := testutils.NewFakeInformerFor(&k8sv1.Namespace{})
 
 			ns1 := &k8sv1.Namespace{
@@ -138,6 +142,7 @@ var _ = Describe("VirtualMachine", func() {
 				dataSourceInformer,
 				namespaceInformer.GetStore(),
 				pvcInformer,
+				storageClassInformer,
 				crInformer,
 				podInformer,
 				recorder,
@@ -145,6 +150,7 @@ var _ = Describe("VirtualMachine", func() {
 				config,
 				nil,
 				instancetypecontroller.NewMockController(),
+				volumepreparation.NewThrottler(dataVolumeInformer.GetIndexer(), pvcInformer.GetIndexer(), storageClassInformer.GetStore(), config),
 			)
 
 			// Wrap our workqueue to have a way to detect when we are done processing updates
@@ -412,6 +418,131 @@ var _ = Describe("VirtualMachine", func() {
 			Expect(vm.Status.PrintableStatus).To(Equal(v1.VirtualMachineStatusProvisioning))
 
 		})
+
+		Context("with volume preparation limits", func() {
+			const limitedStorageClass = "slow-csi"
+
+			BeforeEach(func() {
+				testutils.UpdateFakeKubeVirtClusterConfig(kvStore, &v1.KubeVirt{
+					Spec: v1.KubeVirtSpec{
+						Configuration: v1.KubeVirtConfiguration{
+							StartConfiguration: &v1.StartConfiguration{
+								VolumePreparationLimits: []v1.VolumePreparationLimit{{
+									StorageClassName:           limitedStorageClass,
+									ParallelVolumePreparations: 1,
+								}},
+							},
+						},
+					},
+				})
+			})
+
+			newVMWithDataVolumeTemplate := func(storageClassName *string) *v1.VirtualMachine {
+				vm, _ := watchtesting.DefaultVirtualMachine(true)
+				vm.Spec.Template.Spec.Volumes = append(vm.Spec.Template.Spec.Volumes, v1.Volume{
+					Name: "disk",
+					VolumeSource: v1.VolumeSource{
+						DataVolume: &v1.DataVolumeSource{
+							Name: "dv1",
+						},
+					},
+				})
+				vm.Spec.DataVolumeTemplates = append(vm.Spec.DataVolumeTemplates, v1.DataVolumeTemplateSpec{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "dv1",
+					},
+					Spec: cdiv1.DataVolumeSpec{
+						PVC: &k8sv1.PersistentVolumeClaimSpec{
+							StorageClassName: storageClassName,
+						},
+					},
+				})
+				vm, err := virtFakeClient.KubevirtV1().VirtualMachines(vm.Namespace).Create(context.TODO(), vm, metav1.CreateOptions{})
+				Expect(err).To(Succeed())
+				return vm
+			}
+
+			addPreparingDataVolume := func(storageClassName string, phase cdiv1.DataVolumePhase) {
+				Expect(controller.dataVolumeStore.Add(&cdiv1.DataVolume{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "other-dv",
+						Namespace: "other",
+					},
+					Spec: cdiv1.DataVolumeSpec{
+						Storage: &cdiv1.StorageSpec{
+							StorageClassName: pointer.P(storageClassName),
+						},
+					},
+					Status: cdiv1.DataVolumeStatus{
+						Phase: phase,
+					},
+				})).To(Succeed())
+			}
+
+			It("should delay the DataVolume creation while the storage class is at its limit", func() {
+				addPreparingDataVolume(limitedStorageClass, cdiv1.CloneInProgress)
+				vm := newVMWithDataVolumeTemplate(pointer.P(limitedStorageClass))
+				addVirtualMachine(vm)
+
+				createCount := 0
+				shouldExpectDataVolumeCreation(vm.UID, map[string]string{"kubevirt.io/created-by": string(vm.UID)}, map[string]string{}, &createCount)
+
+				sanityExecute(vm)
+				Expect(createCount).To(BeZero())
+				testutils.ExpectEvent(recorder, volumepreparation.ThrottledReason)
+				Expect(mockQueue.GetAddAfterEnqueueCount()).To(Equal(1))
+			})
+
+			It("should report the delay of the DataVolume creation once", func() {
+				addPreparingDataVolume(limitedStorageClass, cdiv1.CloneInProgress)
+				vm := newVMWithDataVolumeTemplate(pointer.P(limitedStorageClass))
+				addVirtualMachine(vm)
+
+				sanityExecute(vm)
+				testutils.ExpectEvent(recorder, volumepreparation.ThrottledReason)
+
+				sanityExecute(vm)
+				Expect(recorder.Events).To(BeEmpty())
+				Expect(mockQueue.GetAddAfterEnqueueCount()).To(Equal(2))
+			})
+
+			It("should resolve the default storage class of the cluster", func() {
+				Expect(controller.storageClassStore.Add(&storagev1.StorageClass{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:        limitedStorageClass,
+						Annotations: map[string]string{"storageclass.kubernetes.io/is-default-class": "true"},
+					},
+				})).To(Succeed())
+				addPreparingDataVolume(limitedStorageClass, cdiv1.ImportInProgress)
+				vm := newVMWithDataVolumeTemplate(nil)
+				addVirtualMachine(vm)
+
+				createCount := 0
+				shouldExpectDataVolumeCreation(vm.UID, map[string]string{"kubevirt.io/created-by": string(vm.UID)}, map[string]string{}, &createCount)
+
+				sanityExecute(vm)
+				Expect(createCount).To(BeZero())
+				testutils.ExpectEvent(recorder, volumepreparation.ThrottledReason)
+			})
+
+			DescribeTable("should create the DataVolume", func(storageClassName string, phase cdiv1.DataVolumePhase) {
+				addPreparingDataVolume(limitedStorageClass, phase)
+				vm := newVMWithDataVolumeTemplate(pointer.P(storageClassName))
+				addVirtualMachine(vm)
+
+				createCount := 0
+				shouldExpectDataVolumeCreation(vm.UID, map[string]string{"kubevirt.io/created-by": string(vm.UID)}, map[string]string{}, &createCount)
+
+				sanityExecute(vm)
+				Expect(createCount).To(Equal(1))
+				testutils.ExpectEvent(recorder, SuccessfulDataVolumeCreateReason)
+			},
+				Entry("when the other preparations of the storage class finished", limitedStorageClass, cdiv1.Succeeded),
+				Entry("when the other DataVolume waits for its first consumer", limitedStorageClass, cdiv1.WaitForFirstConsumer),
+				Entry("when the storage class has no limit", "fast-csi", cdiv1.CloneInProgress),
+			)
+		})
+
 		Context("Disk un/hotplug", func() {
 			addVolumeReactor := func(virtFakeClient *fake.Clientset) {
 				virtFakeClient.PrependReactor("put", "virtualmachineinstances/addvolume", func(action testing.Action) (handled bool, ret runtime.Object, err error) {
@@ -3674,7 +3805,7 @@ var _ = Describe("VirtualMachine", func() {
 					Expect(vm.Status.PrintableStatus).To(Equal(v1.VirtualMachineStatusProvisioning))
 				})
 
-				It("should set a Provisioning status and add a Failure condition if DV PVC creation fails due to exceeded quota", func() {
+				It("should set a DataVolumeError status and add Failure conditions if DV PVC creation fails due to exceeded quota", func() {
 					vm, err := virtFakeClient.KubevirtV1().VirtualMachines(vm.Namespace).Create(context.TODO(), vm, metav1.CreateOptions{})
 					Expect(err).To(Succeed())
 					addVirtualMachine(vm)
@@ -3694,7 +3825,7 @@ var _ = Describe("VirtualMachine", func() {
 
 					vm, err = virtFakeClient.KubevirtV1().VirtualMachines(vm.Namespace).Get(context.TODO(), vm.Name, metav1.GetOptions{})
 					Expect(err).To(Succeed())
-					Expect(vm.Status.PrintableStatus).To(Equal(v1.VirtualMachineStatusProvisioning))
+					Expect(vm.Status.PrintableStatus).To(Equal(v1.VirtualMachineStatusDataVolumeError))
 
 					cond := virtcontroller.NewVirtualMachineConditionManager().GetCondition(vm, v1.VirtualMachineFailure)
 					Expect(cond).To(Not(BeNil()))
@@ -3707,6 +3838,56 @@ var _ = Describe("VirtualMachine", func() {
 							ContainSubstring("forbidden: exceeded quota: storage"),
 						),
 					}))
+
+					cond = virtcontroller.NewVirtualMachineConditionManager().GetCondition(vm, v1.VirtualMachineDataVolumeFailure)
+					Expect(cond).To(Not(BeNil()))
+					Expect(cond.Status).To(Equal(k8sv1.ConditionTrue))
+					Expect(cond.Reason).To(Equal(storagetypes.DataVolumeQuotaExceededReason))
+					Expect(cond.Message).To(ContainSubstring("forbidden: exceeded quota: storage"))
+				})
+
+				It("should set a DataVolumeError status and add a DataVolumeFailure condition if the storage class does not exist", func() {
+					vm.Spec.DataVolumeTemplates[0].Spec.Storage = &cdiv1.StorageSpec{StorageClassName: pointer.P("missing")}
+					vm, err := virtFakeClient.KubevirtV1().VirtualMachines(vm.Namespace).Create(context.TODO(), vm, metav1.CreateOptions{})
+					Expect(err).To(Succeed())
+					addVirtualMachine(vm)
+
+					dv, _ := watchutil.CreateDataVolumeManifest(virtClient, vm.Spec.DataVolumeTemplates[0], vm)
+					dv.Status.Phase = cdiv1.Pending
+					controller.dataVolumeStore.Add(dv)
+
+					sanityExecute(vm)
+
+					vm, err = virtFakeClient.KubevirtV1().VirtualMachines(vm.Namespace).Get(context.TODO(), vm.Name, metav1.GetOptions{})
+					Expect(err).To(Succeed())
+					Expect(vm.Status.PrintableStatus).To(Equal(v1.VirtualMachineStatusDataVolumeError))
+
+					cond := virtcontroller.NewVirtualMachineConditionManager().GetCondition(vm, v1.VirtualMachineDataVolumeFailure)
+					Expect(cond).To(Not(BeNil()))
+					Expect(cond.Reason).To(Equal(storagetypes.DataVolumeStorageClassNotFoundReason))
+					Expect(cond.Message).To(ContainSubstring("storage class missing"))
+				})
+
+				It("should remove the DataVolumeFailure condition when the DataVolume recovers", func() {
+					vm.Status.Conditions = append(vm.Status.Conditions, v1.VirtualMachineCondition{
+						Type:   v1.VirtualMachineDataVolumeFailure,
+						Status: k8sv1.ConditionTrue,
+						Reason: storagetypes.DataVolumeSourceNotFoundReason,
+					})
+					vm, err := virtFakeClient.KubevirtV1().VirtualMachines(vm.Namespace).Create(context.TODO(), vm, metav1.CreateOptions{})
+					Expect(err).To(Succeed())
+					addVirtualMachine(vm)
+
+					dv, _ := watchutil.CreateDataVolumeManifest(virtClient, vm.Spec.DataVolumeTemplates[0], vm)
+					dv.Status.Phase = cdiv1.ImportInProgress
+					controller.dataVolumeStore.Add(dv)
+
+					sanityExecute(vm)
+
+					vm, err = virtFakeClient.KubevirtV1().VirtualMachines(vm.Namespace).Get(context.TODO(), vm.Name, metav1.GetOptions{})
+					Expect(err).To(Succeed())
+					Expect(vm.Status.PrintableStatus).To(Equal(v1.VirtualMachineStatusProvisioning))
+					Expect(virtcontroller.NewVirtualMachineConditionManager().HasCondition(vm, v1.VirtualMachineDataVolumeFailure)).To(BeFalse())
 				})
 			})
 
@@ -5292,6 +5473,109 @@ var _ = Describe("VirtualMachine", func() {
 				)
 			})
 
+			Context("GPUs and host devices", func() {
+				gpu := v1.GPU{Name: "gpu1", DeviceName: "vendor.com/gpu"}
+				hostDevice := v1.HostDevice{Name: "nvme1", DeviceName: "vendor.com/nvme"}
+
+				It("should add the devices added to the template spec to the VMI", func() {
+					testutils.UpdateFakeKubeVirtClusterConfig(kvStore, &v1.KubeVirt{
+						Spec: v1.KubeVirtSpec{
+							Configuration: v1.KubeVirtConfiguration{
+								VMRolloutStrategy: &liveUpdate,
+							},
+						},
+					})
+
+					vm, vmi := watchtesting.DefaultVirtualMachine(true)
+					vm.Spec.Template.Spec.Domain.Devices.GPUs = []v1.GPU{gpu}
+					vm.Spec.Template.Spec.Domain.Devices.HostDevices = []v1.HostDevice{hostDevice}
+					vmi.Spec.Domain.Devices.HostDevices = []v1.HostDevice{hostDevice}
+
+					vm, err := virtFakeClient.KubevirtV1().VirtualMachines(vm.Namespace).Create(context.TODO(), vm, metav1.CreateOptions{})
+					Expect(err).To(Succeed())
+
+					vmi, err = virtFakeClient.KubevirtV1().VirtualMachineInstances(vm.Namespace).Create(context.Background(), vmi, metav1.CreateOptions{})
+					Expect(err).NotTo(HaveOccurred())
+					Expect(controller.vmiIndexer.Add(vmi)).To(Succeed())
+
+					addVirtualMachine(vm)
+
+					sanityExecute(vm)
+
+					Expect(kvtesting.FilterActions(&virtFakeClient.Fake, "patch", "virtualmachineinstances")).To(HaveLen(1))
+
+					vmi, err = virtFakeClient.KubevirtV1().VirtualMachineInstances(vm.Namespace).Get(context.TODO(), vm.Name, metav1.GetOptions{})
+					Expect(err).ToNot(HaveOccurred())
+					Expect(vmi.Spec.Domain.Devices.GPUs).To(Equal([]v1.GPU{gpu}))
+					Expect(vmi.Spec.Domain.Devices.HostDevices).To(Equal([]v1.HostDevice{hostDevice}))
+				})
+
+				DescribeTable("should be live-updatable", func(oldGPUs, gpus []v1.GPU, oldHostDevices, hostDevices []v1.HostDevice, liveUpdatable bool) {
+					oldVM, _ := watchtesting.DefaultVirtualMachine(true)
+					oldVM.Spec.Template.Spec.Domain.Devices.GPUs = oldGPUs
+					oldVM.Spec.Template.Spec.Domain.Devices.HostDevices = oldHostDevices
+					vm, _ := watchtesting.DefaultVirtualMachine(true)
+					vm.Spec.Template.Spec.Domain.Devices.GPUs = gpus
+					vm.Spec.Template.Spec.Domain.Devices.HostDevices = hostDevices
+
+					Expect(validLiveUpdateHostDevices(&oldVM.Spec, vm)).To(Equal(liveUpdatable))
+				},
+					Entry("when a GPU is added", nil, []v1.GPU{gpu}, nil, nil, true),
+					Entry("when a host device is added next to an existing one",
+						nil, nil, []v1.HostDevice{hostDevice}, []v1.HostDevice{hostDevice, {Name: "nvme2", DeviceName: "vendor.com/nvme"}}, true),
+					Entry("not when a GPU is removed", []v1.GPU{gpu}, nil, nil, nil, false),
+					Entry("not when a host device is changed",
+						nil, nil, []v1.HostDevice{hostDevice}, []v1.HostDevice{{Name: "nvme1", DeviceName: "vendor.com/other"}}, false),
+				)
+			})
+
+			Context("USB devices", func() {
+				token := v1.USBDevice{Name: "token", VendorProduct: "1050:0407"}
+				reader := v1.USBDevice{Name: "reader", BusPort: "2-4"}
+
+				It("should sync the USB devices of the template spec to the VMI", func() {
+					testutils.UpdateFakeKubeVirtClusterConfig(kvStore, &v1.KubeVirt{
+						Spec: v1.KubeVirtSpec{
+							Configuration: v1.KubeVirtConfiguration{
+								VMRolloutStrategy: &liveUpdate,
+							},
+						},
+					})
+
+					vm, vmi := watchtesting.DefaultVirtualMachine(true)
+					vm.Spec.Template.Spec.Domain.Devices.USBDevices = []v1.USBDevice{reader}
+					vmi.Spec.Domain.Devices.USBDevices = []v1.USBDevice{token}
+
+					vm, err := virtFakeClient.KubevirtV1().VirtualMachines(vm.Namespace).Create(context.TODO(), vm, metav1.CreateOptions{})
+					Expect(err).To(Succeed())
+
+					vmi, err = virtFakeClient.KubevirtV1().VirtualMachineInstances(vm.Namespace).Create(context.Background(), vmi, metav1.CreateOptions{})
+					Expect(err).NotTo(HaveOccurred())
+					Expect(controller.vmiIndexer.Add(vmi)).To(Succeed())
+
+					addVirtualMachine(vm)
+
+					sanityExecute(vm)
+
+					vmi, err = virtFakeClient.KubevirtV1().VirtualMachineInstances(vm.Namespace).Get(context.TODO(), vm.Name, metav1.GetOptions{})
+					Expect(err).ToNot(HaveOccurred())
+					Expect(vmi.Spec.Domain.Devices.USBDevices).To(Equal([]v1.USBDevice{reader}))
+				})
+
+				DescribeTable("should be live-updatable", func(oldUSBDevices, usbDevices []v1.USBDevice, liveUpdatable bool) {
+					oldVM, _ := watchtesting.DefaultVirtualMachine(true)
+					oldVM.Spec.Template.Spec.Domain.Devices.USBDevices = oldUSBDevices
+					vm, _ := watchtesting.DefaultVirtualMachine(true)
+					vm.Spec.Template.Spec.Domain.Devices.USBDevices = usbDevices
+
+					Expect(validLiveUpdateUSBDevices(&oldVM.Spec, vm)).To(Equal(liveUpdatable))
+				},
+					Entry("when a USB device is added next to an existing one", []v1.USBDevice{token}, []v1.USBDevice{token, reader}, true),
+					Entry("when all the USB devices are removed", []v1.USBDevice{token}, nil, true),
+					Entry("not when a USB device is added to a VM without any", nil, []v1.USBDevice{token}, false),
+				)
+			})
+
 			Context("Affinity", func() {
 				It("should be live-updated", func() {
 					testutils.UpdateFakeKubeVirtClusterConfig(kvStore, &v1.KubeVirt{
@@ -0,0 +1,106 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package vmi
+
+import (
+	k8sv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	virtv1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/controller"
+)
+
+// syncHostDevicesHotplug requests a migration of the VMI when GPUs or host devices were hotplugged,
+// since the device plugins can only allocate them to the target pod. Once the pod has them allocated,
+// virt-handler attaches them to the domain.
+// Devices attached to the domain prevent its migration, so devices can only be hotplugged into VMIs
+// which have none allocated yet.
+func (c *Controller) syncHostDevicesHotplug(vmi *virtv1.VirtualMachineInstance, pod *k8sv1.Pod) {
+	vmiConditions := controller.NewVirtualMachineInstanceConditionManager()
+	missing, allocated := hostDeviceResourcesAllocation(vmi, pod)
+	if !missing {
+		vmiConditions.RemoveCondition(vmi, virtv1.VirtualMachineInstanceHostDevicesChange)
+		return
+	}
+
+	condition := virtv1.VirtualMachineInstanceCondition{
+		Type:               virtv1.VirtualMachineInstanceHostDevicesChange,
+		LastTransitionTime: metav1.Now(),
+		Status:             k8sv1.ConditionTrue,
+	}
+	switch {
+	case allocated:
+		condition.Status = k8sv1.ConditionFalse
+		condition.Reason = virtv1.VirtualMachineInstanceReasonHostDeviceNotMigratable
+		condition.Message = "GPUs and host devices can only be hotplugged into a VMI which has none allocated, a restart is required"
+	case !isMigratableIgnoringHostDevices(vmi):
+		condition.Status = k8sv1.ConditionFalse
+		condition.Reason = virtv1.VirtualMachineInstanceReasonNotMigratable
+		condition.Message = "GPUs and host devices can only be hotplugged by migrating the VMI, a restart is required"
+	}
+	if vmiConditions.HasConditionWithStatusAndReason(vmi, condition.Type, condition.Status, condition.Reason) {
+		return
+	}
+	vmiConditions.UpdateCondition(vmi, &condition)
+}
+
+// hostDeviceResourcesAllocation compares the device plugin resources of the GPUs and host devices of the VMI
+// with the ones allocated to the compute container of the pod. It reports whether some are missing, and whether
+// some are allocated.
+func hostDeviceResourcesAllocation(vmi *virtv1.VirtualMachineInstance, pod *k8sv1.Pod) (missing, allocated bool) {
+	requested := map[k8sv1.ResourceName]int64{}
+	for _, gpu := range vmi.Spec.Domain.Devices.GPUs {
+		requested[k8sv1.ResourceName(gpu.DeviceName)]++
+	}
+	for _, hostDevice := range vmi.Spec.Domain.Devices.HostDevices {
+		requested[k8sv1.ResourceName(hostDevice.DeviceName)]++
+	}
+	if len(requested) == 0 {
+		return false, false
+	}
+
+	var limits k8sv1.ResourceList
+	for _, container := range pod.Spec.Containers {
+		if container.Name == "compute" {
+			limits = container.Resources.Limits
+			break
+		}
+	}
+	for resourceName, count := range requested {
+		allocatedCount := limits.Name(resourceName, "").Value()
+		if allocatedCount < count {
+			missing = true
+		}
+		if allocatedCount > 0 {
+			allocated = true
+		}
+	}
+	return missing, allocated
+}
+
+// isMigratableIgnoringHostDevices tells if the VMI can be migrated once virt-handler stops considering
+// the devices to hotplug, which are not attached to the domain.
+func isMigratableIgnoringHostDevices(vmi *virtv1.VirtualMachineInstance) bool {
+	vmiConditions := controller.NewVirtualMachineInstanceConditionManager()
+	return vmiConditions.HasConditionWithStatus(vmi, virtv1.VirtualMachineInstanceIsMigratable, k8sv1.ConditionTrue) ||
+		vmiConditions.HasConditionWithStatusAndReason(vmi, virtv1.VirtualMachineInstanceIsMigratable, k8sv1.ConditionFalse,
+			virtv1.VirtualMachineInstanceReasonHostDeviceNotMigratable)
+}
@@ -0,0 +1,230 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package vmi
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	k8sv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+
+	virtv1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/controller"
+	metrics "kubevirt.io/kubevirt/pkg/monitoring/metrics/virt-controller"
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
+)
+
+const (
+	startQueuedReason         = "StartQueued"
+	startQueueRequeueInterval = 5 * time.Second
+)
+
+type startThrottledError struct {
+	position int
+	length   int
+}
+
+func (e *startThrottledError) Error() string {
+	return fmt.Sprintf("waiting for a start slot, position %d of %d in the start queue", e.position, e.length)
+}
+
+func (e *startThrottledError) Reason() string {
+	return startQueuedReason
+}
+
+func (e *startThrottledError) RequiresRequeue() bool {
+	return false
+}
+
+type queuedStart struct {
+	key       string
+	namespace string
+	created   metav1.Time
+	since     time.Time
+}
+
+// startThrottler enforces the cluster and namespace limits on VMIs which are starting at the same time.
+// A VMI is starting from the creation of its launcher pod until it leaves the Scheduled phase,
+// except while its pod is unschedulable.
+// VMIs over the limits wait in a queue ordered by their creation time.
+type startThrottler struct {
+	lock          sync.Mutex
+	vmiIndexer    cache.Indexer
+	clusterConfig *virtconfig.ClusterConfig
+	// admitted holds VMIs which may create their pod but are not yet observed beyond the Pending phase
+	admitted map[string]string
+	queued   map[string]*queuedStart
+}
+
+func newStartThrottler(vmiIndexer cache.Indexer, clusterConfig *virtconfig.ClusterConfig) *startThrottler {
+	return &startThrottler{
+		vmiIndexer:    vmiIndexer,
+		clusterConfig: clusterConfig,
+		admitted:      map[string]string{},
+		queued:        map[string]*queuedStart{},
+	}
+}
+
+// admit decides if the VMI may create its launcher pod now. If it has to wait, admit
+// returns the 1-based position of the VMI in the start queue and the length of the queue.
+func (t *startThrottler) admit(vmi *virtv1.VirtualMachineInstance) (admitted bool, position int, length int) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	config := t.clusterConfig.GetStartConfiguration()
+	if config == nil || (config.ParallelStartsPerCluster == nil && config.ParallelStartsPerNamespace == nil) {
+		if len(t.queued) > 0 || len(t.admitted) > 0 {
+			t.admitted = map[string]string{}
+			t.queued = map[string]*queuedStart{}
+			metrics.SetVMIStartQueueLength(0)
+		}
+		return true, 0, 0
+	}
+
+	t.forgetStarted()
+
+	key := controller.VirtualMachineInstanceKey(vmi)
+	if _, exists := t.admitted[key]; exists {
+		return true, 0, 0
+	}
+	if _, exists := t.queued[key]; !exists {
+		t.queued[key] = &queuedStart{key: key, namespace: vmi.Namespace, created: vmi.CreationTimestamp, since: time.Now()}
+	}
+
+	clusterStarting, namespaceStarting := t.countStarting()
+	queue := t.sortedQueue()
+
+	clusterAhead := 0
+	namespaceAhead := map[string]int{}
+	for i, entry := range queue {
+		eligible := config.ParallelStartsPerNamespace == nil ||
+			namespaceStarting[entry.namespace]+namespaceAhead[entry.namespace] < int(*config.ParallelStartsPerNamespace)
+		namespaceAhead[entry.namespace]++
+
+		if entry.key != key {
+			if eligible {
+				clusterAhead++
+			}
+			continue
+		}
+
+		if eligible && (config.ParallelStartsPerCluster == nil || clusterStarting+clusterAhead < int(*config.ParallelStartsPerCluster)) {
+			delete(t.queued, key)
+			t.admitted[key] = entry.namespace
+			metrics.ObserveVMIStartQueueWait(time.Since(entry.since))
+			metrics.SetVMIStartQueueLength(len(t.queued))
+			return true, 0, 0
+		}
+		position = i + 1
+		break
+	}
+
+	metrics.SetVMIStartQueueLength(len(t.queued))
+	return false, position, len(queue)
+}
+
+// forgetStarted drops admitted VMIs which were observed beyond the Pending phase
+// and queued VMIs which are gone or no longer waiting to start
+func (t *startThrottler) forgetStarted() {
+	waitingToStart := func(key string) bool {
+		obj, exists, err := t.vmiIndexer.GetByKey(key)
+		if err != nil || !exists {
+			return false
+		}
+		vmi := obj.(*virtv1.VirtualMachineInstance)
+		return vmi.IsUnprocessed() && vmi.DeletionTimestamp == nil
+	}
+
+	for key := range t.admitted {
+		if !waitingToStart(key) {
+			delete(t.admitted, key)
+		}
+	}
+	for key := range t.queued {
+		if !waitingToStart(key) {
+			delete(t.queued, key)
+		}
+	}
+}
+
+func (t *startThrottler) countStarting() (int, map[string]int) {
+	cluster := 0
+	namespaces := map[string]int{}
+	for _, namespace := range t.vmiIndexer.ListIndexFuncValues(controller.StartingVMIIndex) {
+		keys, err := t.vmiIndexer.IndexKeys(controller.StartingVMIIndex, namespace)
+		if err != nil {
+			continue
+		}
+		cluster += len(keys)
+		namespaces[namespace] += len(keys)
+	}
+	for _, namespace := range t.admitted {
+		cluster++
+		namespaces[namespace]++
+	}
+	return cluster, namespaces
+}
+
+func (t *startThrottler) sortedQueue() []*queuedStart {
+	queue := make([]*queuedStart, 0, len(t.queued))
+	for _, entry := range t.queued {
+		queue = append(queue, entry)
+	}
+	sort.Slice(queue, func(i, j int) bool {
+		if !queue[i].created.Equal(&queue[j].created) {
+			return queue[i].created.Before(&queue[j].created)
+		}
+		return queue[i].key < queue[j].key
+	})
+	return queue
+}
+
+// syncStartThrottledCondition reports the position of a waiting VMI in the start queue
+func syncStartThrottledCondition(vmi *virtv1.VirtualMachineInstance, syncErr error) {
+	conditionManager := controller.NewVirtualMachineInstanceConditionManager()
+	throttled, isThrottled := syncErr.(*startThrottledError)
+	if !isThrottled {
+		if conditionManager.HasCondition(vmi, virtv1.VirtualMachineInstanceStartThrottled) {
+			conditionManager.RemoveCondition(vmi, virtv1.VirtualMachineInstanceStartThrottled)
+		}
+		return
+	}
+
+	transitionTime := metav1.Now()
+	if condition := conditionManager.GetCondition(vmi, virtv1.VirtualMachineInstanceStartThrottled); condition != nil {
+		if condition.Message == throttled.Error() {
+			return
+		}
+		transitionTime = condition.LastTransitionTime
+		conditionManager.RemoveCondition(vmi, virtv1.VirtualMachineInstanceStartThrottled)
+	}
+	vmi.Status.Conditions = append(vmi.Status.Conditions, virtv1.VirtualMachineInstanceCondition{
+		Type:               virtv1.VirtualMachineInstanceStartThrottled,
+		Status:             k8sv1.ConditionTrue,
+		Reason:             startQueuedReason,
+		Message:            throttled.Error(),
+		LastTransitionTime: transitionTime,
+	})
+}
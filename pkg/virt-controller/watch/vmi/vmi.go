@@ -46,6 +46,7 @@ import (
 
 	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
 	"kubevirt.io/kubevirt/pkg/controller"
+	"kubevirt.io/kubevirt/pkg/network/vmispec"
 	backendstorage "kubevirt.io/kubevirt/pkg/storage/backend-storage"
 	storagetypes "kubevirt.io/kubevirt/pkg/storage/types"
 	"kubevirt.io/kubevirt/pkg/util"
@@ -56,6 +57,7 @@ import (
 	"kubevirt.io/kubevirt/pkg/virt-controller/services"
 	"kubevirt.io/kubevirt/pkg/virt-controller/watch/common"
 	"kubevirt.io/kubevirt/pkg/virt-controller/watch/descheduler"
+	"kubevirt.io/kubevirt/pkg/virt-controller/watch/placement"
 	"kubevirt.io/kubevirt/pkg/virt-controller/watch/topology"
 	"kubevirt.io/kubevirt/pkg/virt-controller/watch/vsock"
 )
@@ -108,6 +110,8 @@ func NewController(templateService services.TemplateService,
 		topologyHinter:          topologyHinter,
 		cidsMap:                 vsock.NewCIDsMap(),
 		backendStorage:          backendstorage.NewBackendStorage(clientset, clusterConfig, storageClassInformer.GetStore(), storageProfileInformer.GetStore(), pvcInformer.GetIndexer()),
+		startThrottler:          newStartThrottler(vmiInformer.GetIndexer(), clusterConfig),
+		placementHinter:         placement.NewHinter(clusterConfig),
 		netAnnotationsGenerator: netAnnotationsGenerator,
 		updateNetworkStatus:     netStatusUpdater,
 		validateNetworkSpec:     netSpecValidator,
@@ -202,6 +206,8 @@ type Controller struct {
 	clusterConfig           *virtconfig.ClusterConfig
 	cidsMap                 vsock.Allocator
 	backendStorage          *backendstorage.BackendStorage
+	startThrottler          *startThrottler
+	placementHinter         placement.Hinter
 	hasSynced               func() bool
 	netAnnotationsGenerator annotationsGenerator
 	updateNetworkStatus     statusUpdater
@@ -510,7 +516,7 @@ func (c *Controller) updateStatus(vmi *virtv1.VirtualMachineInstance, pod *k8sv1
 		} else {
 			vmiCopy.Status.Phase = virtv1.Pending
 			if vmi.Status.TopologyHints == nil {
-				if topologyHints, tscRequirement, err := c.topologyHinter.TopologyHintsForVMI(vmi); err != nil && tscRequirement == topology.RequiredForBoot {
+				if topologyHints, tscRequirement, err := c.topologyHinter.TopologyHintsForVMI(vmi); err != nil && (tscRequirement == topology.RequiredForBoot || topology.IsClusterCommonCPUModelRequested(vmi)) {
 					c.recorder.Eventf(vmi, k8sv1.EventTypeWarning, controller.FailedGatherhingClusterTopologyHints, err.Error())
 					return common.NewSyncError(err, controller.FailedGatherhingClusterTopologyHints)
 				} else if topologyHints != nil {
@@ -676,6 +682,9 @@ func (c *Controller) updateStatus(vmi *virtv1.VirtualMachineInstance, pod *k8sv1
 			c.syncVolumesUpdate(vmiCopy)
 		}
 
+		c.syncSRIOVHotplug(vmiCopy)
+		c.syncHostDevicesHotplug(vmiCopy, pod)
+
 	case vmi.IsScheduled():
 		if !vmiPodExists {
 			vmiCopy.Status.Phase = virtv1.Failed
@@ -705,6 +714,12 @@ func (c *Controller) updateStatus(vmi *virtv1.VirtualMachineInstance, pod *k8sv1
 		return nil
 	}
 
+	syncStartThrottledCondition(vmiCopy, syncErr)
+	if _, throttled := syncErr.(*startThrottledError); throttled {
+		// waiting in the start queue is reported on its own condition
+		syncErr = nil
+	}
+
 	reason := ""
 	if syncErr != nil {
 		reason = syncErr.Reason()
@@ -850,6 +865,16 @@ func (c *Controller) syncReadyConditionFromPod(vmi *virtv1.VirtualMachineInstanc
 			LastTransitionTime: now,
 		})
 
+	} else if controller.IsMeshProxyNotReady(pod) {
+		vmiConditions.UpdateCondition(vmi, &virtv1.VirtualMachineInstanceCondition{
+			Type:               virtv1.VirtualMachineInstanceReady,
+			Status:             k8sv1.ConditionFalse,
+			Reason:             virtv1.MeshProxyNotReadyReason,
+			Message:            "service mesh proxy of the virt-launcher pod is not ready",
+			LastProbeTime:      now,
+			LastTransitionTime: now,
+		})
+
 	} else if podReadyCond := podConditions.GetCondition(pod, k8sv1.PodReady); podReadyCond != nil {
 		vmiConditions.UpdateCondition(vmi, &virtv1.VirtualMachineInstanceCondition{
 			Type:               virtv1.VirtualMachineInstanceReady,
@@ -984,7 +1009,7 @@ func (c *Controller) sync(vmi *virtv1.VirtualMachineInstance, pod *k8sv1.Pod, da
 			return nil, pod
 		}
 		// let's check if we already have topology hints or if we are still waiting for them
-		if vmi.Status.TopologyHints == nil && c.topologyHinter.IsTscFrequencyRequired(vmi) {
+		if vmi.Status.TopologyHints == nil && (c.topologyHinter.IsTscFrequencyRequired(vmi) || topology.IsClusterCommonCPUModelRequested(vmi)) {
 			log.Log.V(3).Object(vmi).Infof("Delaying pod creation until topology hints are set")
 			return nil, pod
 		}
@@ -1025,6 +1050,14 @@ func (c *Controller) sync(vmi *virtv1.VirtualMachineInstance, pod *k8sv1.Pod, da
 			return common.NewSyncError(fmt.Errorf("PVC pending"), controller.BackendStorageNotReadyReason), pod
 		}
 
+		if !isWaitForFirstConsumer {
+			if admitted, position, length := c.startThrottler.admit(vmi); !admitted {
+				log.Log.V(3).Object(vmi).Infof("Delaying pod creation, the VMI is at position %d of %d in the start queue", position, length)
+				c.Queue.AddAfter(key, startQueueRequeueInterval)
+				return &startThrottledError{position: position, length: length}, pod
+			}
+		}
+
 		var templatePod *k8sv1.Pod
 		if isWaitForFirstConsumer {
 			log.Log.V(3).Object(vmi).Infof("Scheduling temporary pod for WaitForFirstConsumer DV")
@@ -1047,6 +1080,18 @@ func (c *Controller) sync(vmi *virtv1.VirtualMachineInstance, pod *k8sv1.Pod, da
 			return common.NewSyncError(fmt.Errorf("failed create validation: %v", validateErr), "FailedCreateValidation"), pod
 		}
 
+		hintsRequest := &virtv1.PlacementHintsRequest{
+			Operation: virtv1.PlacementHintsOperationStart,
+			Namespace: vmi.Namespace,
+			Name:      vmi.Name,
+			UID:       vmi.UID,
+			Labels:    vmi.Labels,
+		}
+		if err := c.placementHinter.AddHints(templatePod, hintsRequest); err != nil {
+			c.recorder.Eventf(vmi, k8sv1.EventTypeWarning, controller.FailedPlacementHintsReason, "%v", err)
+			return common.NewSyncError(err, controller.FailedPlacementHintsReason), pod
+		}
+
 		vmiKey := controller.VirtualMachineInstanceKey(vmi)
 		pod, err := c.createPod(vmiKey, vmi.Namespace, templatePod)
 		if k8serrors.IsForbidden(err) && strings.Contains(err.Error(), "violates PodSecurity") {
@@ -1852,6 +1897,32 @@ func (c *Controller) syncMemoryHotplug(vmi *virtv1.VirtualMachineInstance) {
 	}
 }
 
+// syncSRIOVHotplug requests a migration of the VMI when SR-IOV interfaces were hotplugged,
+// since the VFs can only be allocated to the target pod. Once the pod reports the interfaces,
+// virt-handler attaches the VFs to the domain.
+func (c *Controller) syncSRIOVHotplug(vmi *virtv1.VirtualMachineInstance) {
+	vmiConditions := controller.NewVirtualMachineInstanceConditionManager()
+	if len(vmispec.SRIOVInterfacesToHotplug(vmi)) == 0 {
+		vmiConditions.RemoveCondition(vmi, virtv1.VirtualMachineInstanceSRIOVChange)
+		return
+	}
+
+	condition := virtv1.VirtualMachineInstanceCondition{
+		Type:               virtv1.VirtualMachineInstanceSRIOVChange,
+		LastTransitionTime: v1.Now(),
+		Status:             k8sv1.ConditionTrue,
+	}
+	if !vmiConditions.HasConditionWithStatus(vmi, virtv1.VirtualMachineInstanceIsMigratable, k8sv1.ConditionTrue) {
+		condition.Status = k8sv1.ConditionFalse
+		condition.Reason = virtv1.VirtualMachineInstanceReasonNotMigratable
+		condition.Message = "SR-IOV interfaces can only be hotplugged by migrating the VMI, a restart is required"
+	}
+	if vmiConditions.HasConditionWithStatusAndReason(vmi, condition.Type, condition.Status, condition.Reason) {
+		return
+	}
+	vmiConditions.UpdateCondition(vmi, &condition)
+}
+
 func (c *Controller) requireVolumesUpdate(vmi *virtv1.VirtualMachineInstance) bool {
 	if len(vmi.Status.MigratedVolumes) < 1 {
 		return false
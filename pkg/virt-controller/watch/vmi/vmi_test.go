@@ -21,6 +21,7 @@ package vmi
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
 	"time"
@@ -62,6 +63,7 @@ import (
 	watchtesting "kubevirt.io/kubevirt/pkg/virt-controller/watch/testing"
 
 	"kubevirt.io/kubevirt/pkg/virt-controller/watch/descheduler"
+	"kubevirt.io/kubevirt/pkg/virt-controller/watch/placement"
 	"kubevirt.io/kubevirt/pkg/virt-controller/watch/topology"
 )
 
@@ -2157,6 +2159,143 @@ var _ = Describe("VirtualMachineInstance watcher", func() {
 				Expect(vmi.Labels).To(HaveKeyWithValue(virtv1.MemoryHotplugOverheadRatioLabel, overheadRatio))
 			})
 		})
+
+		Context("with SR-IOV hotplug", func() {
+			const sriovNetworkName = "sriov"
+
+			newVMIWithSRIOVInterface := func(migratable k8sv1.ConditionStatus) *virtv1.VirtualMachineInstance {
+				vmi := newPendingVirtualMachine("testvmi")
+				vmi.Status.Phase = virtv1.Running
+				vmi.Spec.Domain.Devices.Interfaces = []virtv1.Interface{{
+					Name:                   sriovNetworkName,
+					InterfaceBindingMethod: virtv1.InterfaceBindingMethod{SRIOV: &virtv1.InterfaceSRIOV{}},
+				}}
+				vmi.Spec.Networks = []virtv1.Network{{
+					Name:          sriovNetworkName,
+					NetworkSource: virtv1.NetworkSource{Multus: &virtv1.MultusNetwork{NetworkName: "sriov-nad"}},
+				}}
+				vmi.Status.Conditions = []virtv1.VirtualMachineInstanceCondition{{
+					Type:   virtv1.VirtualMachineInstanceIsMigratable,
+					Status: migratable,
+				}}
+				return vmi
+			}
+
+			It("should add SRIOVChange condition when the pod lacks a requested SR-IOV interface", func() {
+				vmi := newVMIWithSRIOVInterface(k8sv1.ConditionTrue)
+				pod := newPodForVirtualMachine(vmi, k8sv1.PodRunning)
+				addActivePods(vmi, pod.UID, "")
+
+				addVirtualMachine(vmi)
+				addPod(pod)
+
+				sanityExecute()
+				expectVMIWithMatcherConditions(vmi.Namespace, vmi.Name, ContainElement(MatchFields(IgnoreExtras,
+					Fields{
+						"Type":   BeEquivalentTo(virtv1.VirtualMachineInstanceSRIOVChange),
+						"Status": Equal(k8sv1.ConditionTrue),
+					})),
+				)
+			})
+
+			It("should set SRIOVChange condition to false when the VMI is not migratable", func() {
+				vmi := newVMIWithSRIOVInterface(k8sv1.ConditionFalse)
+
+				controller.syncSRIOVHotplug(vmi)
+
+				Expect(vmi.Status.Conditions).To(ContainElement(MatchFields(IgnoreExtras,
+					Fields{
+						"Type":   BeEquivalentTo(virtv1.VirtualMachineInstanceSRIOVChange),
+						"Status": Equal(k8sv1.ConditionFalse),
+						"Reason": Equal(virtv1.VirtualMachineInstanceReasonNotMigratable),
+					})),
+				)
+			})
+
+			It("should remove SRIOVChange condition once the pod reports the SR-IOV interface", func() {
+				vmi := newVMIWithSRIOVInterface(k8sv1.ConditionTrue)
+				vmi.Status.Conditions = append(vmi.Status.Conditions, virtv1.VirtualMachineInstanceCondition{
+					Type:   virtv1.VirtualMachineInstanceSRIOVChange,
+					Status: k8sv1.ConditionTrue,
+				})
+				vmi.Status.Interfaces = []virtv1.VirtualMachineInstanceNetworkInterface{{Name: sriovNetworkName}}
+
+				controller.syncSRIOVHotplug(vmi)
+
+				Expect(vmi.Status.Conditions).ToNot(ContainElement(MatchFields(IgnoreExtras,
+					Fields{"Type": BeEquivalentTo(virtv1.VirtualMachineInstanceSRIOVChange)})),
+				)
+			})
+		})
+
+		Context("with host devices hotplug", func() {
+			const gpuResourceName = "vendor.com/gpu"
+
+			newVMIWithGPU := func(migratable k8sv1.ConditionStatus, reason string) *virtv1.VirtualMachineInstance {
+				vmi := newPendingVirtualMachine("testvmi")
+				vmi.Status.Phase = virtv1.Running
+				vmi.Spec.Domain.Devices.GPUs = []virtv1.GPU{{Name: "gpu1", DeviceName: gpuResourceName}}
+				vmi.Status.Conditions = []virtv1.VirtualMachineInstanceCondition{{
+					Type:   virtv1.VirtualMachineInstanceIsMigratable,
+					Status: migratable,
+					Reason: reason,
+				}}
+				return vmi
+			}
+
+			newPodWithGPUs := func(vmi *virtv1.VirtualMachineInstance, gpus int64) *k8sv1.Pod {
+				pod := newPodForVirtualMachine(vmi, k8sv1.PodRunning)
+				pod.Spec.Containers = []k8sv1.Container{{
+					Name: "compute",
+					Resources: k8sv1.ResourceRequirements{Limits: k8sv1.ResourceList{
+						gpuResourceName: *resource.NewQuantity(gpus, resource.DecimalSI),
+					}},
+				}}
+				return pod
+			}
+
+			DescribeTable("should set the HostDevicesChange condition when the pod lacks a requested device",
+				func(vmi *virtv1.VirtualMachineInstance, allocatedGPUs int64, status k8sv1.ConditionStatus, reason string) {
+					controller.syncHostDevicesHotplug(vmi, newPodWithGPUs(vmi, allocatedGPUs))
+
+					Expect(vmi.Status.Conditions).To(ContainElement(MatchFields(IgnoreExtras,
+						Fields{
+							"Type":   BeEquivalentTo(virtv1.VirtualMachineInstanceHostDevicesChange),
+							"Status": Equal(status),
+							"Reason": Equal(reason),
+						})),
+					)
+				},
+				Entry("to true when the VMI is migratable",
+					newVMIWithGPU(k8sv1.ConditionTrue, ""), int64(0), k8sv1.ConditionTrue, ""),
+				Entry("to true when only the devices to hotplug prevent the migration",
+					newVMIWithGPU(k8sv1.ConditionFalse, virtv1.VirtualMachineInstanceReasonHostDeviceNotMigratable), int64(0), k8sv1.ConditionTrue, ""),
+				Entry("to false when the VMI is not migratable",
+					newVMIWithGPU(k8sv1.ConditionFalse, virtv1.VirtualMachineInstanceReasonSEVNotMigratable), int64(0),
+					k8sv1.ConditionFalse, virtv1.VirtualMachineInstanceReasonNotMigratable),
+				Entry("to false when the pod has devices allocated",
+					func() *virtv1.VirtualMachineInstance {
+						vmi := newVMIWithGPU(k8sv1.ConditionTrue, "")
+						vmi.Spec.Domain.Devices.GPUs = append(vmi.Spec.Domain.Devices.GPUs, virtv1.GPU{Name: "gpu2", DeviceName: gpuResourceName})
+						return vmi
+					}(), int64(1),
+					k8sv1.ConditionFalse, virtv1.VirtualMachineInstanceReasonHostDeviceNotMigratable),
+			)
+
+			It("should remove the HostDevicesChange condition once the pod has the devices allocated", func() {
+				vmi := newVMIWithGPU(k8sv1.ConditionTrue, "")
+				vmi.Status.Conditions = append(vmi.Status.Conditions, virtv1.VirtualMachineInstanceCondition{
+					Type:   virtv1.VirtualMachineInstanceHostDevicesChange,
+					Status: k8sv1.ConditionTrue,
+				})
+
+				controller.syncHostDevicesHotplug(vmi, newPodWithGPUs(vmi, 1))
+
+				Expect(vmi.Status.Conditions).ToNot(ContainElement(MatchFields(IgnoreExtras,
+					Fields{"Type": BeEquivalentTo(virtv1.VirtualMachineInstanceHostDevicesChange)})),
+				)
+			})
+		})
 	})
 
 	Context("hotplug volume", func() {
@@ -3363,6 +3502,46 @@ var _ = Describe("VirtualMachineInstance watcher", func() {
 				expectMatchingPodCreation(vmi)
 			})
 		})
+
+		Context("with the cluster-common CPU model", func() {
+			getVmiWithClusterCommonCPUModel := func() *virtv1.VirtualMachineInstance {
+				vmi := newPendingVirtualMachine("testvmi")
+				vmi.Spec.Domain.CPU = &virtv1.CPU{Model: virtv1.CPUModeClusterCommon}
+				return vmi
+			}
+
+			mockClusterCommonCPU := func(hints *virtv1.TopologyHints, err error) {
+				mockHinter := topology.NewMockHinter(gomock.NewController(GinkgoT()))
+				mockHinter.EXPECT().TopologyHintsForVMI(gomock.Any()).Return(hints, topology.NotRequired, err).AnyTimes()
+				mockHinter.EXPECT().IsTscFrequencyRequired(gomock.Any()).Return(false).AnyTimes()
+				controller.topologyHinter = mockHinter
+			}
+
+			It("should set the common CPU model and features as topology hints", func() {
+				mockClusterCommonCPU(&virtv1.TopologyHints{CPUModel: "Skylake-Client-IBRS", CPUFeatures: []string{"ssbd"}}, nil)
+				vmi := getVmiWithClusterCommonCPUModel()
+				addVirtualMachine(vmi)
+				sanityExecute()
+
+				updatedVmi, err := virtClientset.KubevirtV1().VirtualMachineInstances(vmi.Namespace).Get(context.Background(), vmi.Name, metav1.GetOptions{})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(topology.AreClusterCommonCPUTopologyHintsDefined(updatedVmi)).To(BeTrue())
+				Expect(updatedVmi.Status.TopologyHints.CPUModel).To(Equal("Skylake-Client-IBRS"))
+				Expect(updatedVmi.Status.TopologyHints.CPUFeatures).To(ConsistOf("ssbd"))
+			})
+
+			It("should fail the sync when the nodes have no CPU model in common", func() {
+				mockClusterCommonCPU(nil, fmt.Errorf("the nodes matching the node selector have no CPU model in common"))
+				vmi := getVmiWithClusterCommonCPUModel()
+				addVirtualMachine(vmi)
+				sanityExecute()
+
+				testutils.ExpectEvent(recorder, kvcontroller.FailedGatherhingClusterTopologyHints)
+				updatedVmi, err := virtClientset.KubevirtV1().VirtualMachineInstances(vmi.Namespace).Get(context.Background(), vmi.Name, metav1.GetOptions{})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(topology.AreClusterCommonCPUTopologyHintsDefined(updatedVmi)).To(BeFalse())
+			})
+		})
 	})
 
 	Context("auto attach VSOCK", func() {
@@ -3558,6 +3737,200 @@ var _ = Describe("VirtualMachineInstance watcher", func() {
 		)
 	})
 
+	Context("with start limits", func() {
+		setStartConfiguration := func(startConfig *virtv1.StartConfiguration) {
+			kvCR := testutils.GetFakeKubeVirtClusterConfig(kvStore)
+			kvCR.Spec.Configuration.StartConfiguration = startConfig
+			testutils.UpdateFakeKubeVirtClusterConfig(kvStore, kvCR)
+		}
+
+		newStartingVMI := func(name, namespace string) *virtv1.VirtualMachineInstance {
+			vmi := api.NewMinimalVMIWithNS(namespace, name)
+			vmi.Status.Phase = virtv1.Scheduling
+			return vmi
+		}
+
+		newQueuedVMI := func(name, namespace string, created time.Time) *virtv1.VirtualMachineInstance {
+			vmi := newPendingVirtualMachine(name)
+			vmi.Namespace = namespace
+			vmi.CreationTimestamp = metav1.NewTime(created)
+			return vmi
+		}
+
+		It("should delay pod creation and report the queue position when the cluster limit is reached", func() {
+			setStartConfiguration(&virtv1.StartConfiguration{ParallelStartsPerCluster: pointer.P(uint32(1))})
+			Expect(controller.vmiIndexer.Add(newStartingVMI("starting", k8sv1.NamespaceDefault))).To(Succeed())
+			vmi := newPendingVirtualMachine("testvmi")
+			addVirtualMachine(vmi)
+
+			sanityExecute()
+
+			pods, err := kubeClient.CoreV1().Pods(vmi.Namespace).List(context.Background(), metav1.ListOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(pods.Items).To(BeEmpty())
+			expectVMIBeInPhase(vmi.Namespace, vmi.Name, virtv1.Pending)
+			expectVMIWithMatcherConditions(vmi.Namespace, vmi.Name,
+				ContainElement(MatchFields(IgnoreExtras, Fields{
+					"Type":    Equal(virtv1.VirtualMachineInstanceStartThrottled),
+					"Status":  Equal(k8sv1.ConditionTrue),
+					"Reason":  Equal(startQueuedReason),
+					"Message": Equal("waiting for a start slot, position 1 of 1 in the start queue"),
+				})),
+				Not(ContainElement(MatchFields(IgnoreExtras, Fields{
+					"Type": Equal(virtv1.VirtualMachineInstanceSynchronized),
+				}))),
+			)
+			Expect(mockQueue.GetAddAfterEnqueueCount()).To(Equal(1))
+		})
+
+		It("should create the pod and remove the throttled condition once a start slot is free", func() {
+			setStartConfiguration(&virtv1.StartConfiguration{ParallelStartsPerCluster: pointer.P(uint32(1))})
+			vmi := newPendingVirtualMachine("testvmi")
+			vmi.Status.Conditions = append(vmi.Status.Conditions, virtv1.VirtualMachineInstanceCondition{
+				Type:   virtv1.VirtualMachineInstanceStartThrottled,
+				Status: k8sv1.ConditionTrue,
+				Reason: startQueuedReason,
+			})
+			addVirtualMachine(vmi)
+
+			sanityExecute()
+
+			testutils.ExpectEvent(recorder, kvcontroller.SuccessfulCreatePodReason)
+			expectMatchingPodCreation(vmi)
+			expectVMIWithMatcherConditions(vmi.Namespace, vmi.Name,
+				Not(ContainElement(MatchFields(IgnoreExtras, Fields{
+					"Type": Equal(virtv1.VirtualMachineInstanceStartThrottled),
+				}))),
+			)
+		})
+
+		It("should admit queued VMIs in the order of their creation", func() {
+			setStartConfiguration(&virtv1.StartConfiguration{ParallelStartsPerCluster: pointer.P(uint32(1))})
+			starting := newStartingVMI("starting", k8sv1.NamespaceDefault)
+			Expect(controller.vmiIndexer.Add(starting)).To(Succeed())
+			now := time.Now()
+			older := newQueuedVMI("older", k8sv1.NamespaceDefault, now.Add(-time.Minute))
+			newer := newQueuedVMI("newer", k8sv1.NamespaceDefault, now)
+			Expect(controller.vmiIndexer.Add(older)).To(Succeed())
+			Expect(controller.vmiIndexer.Add(newer)).To(Succeed())
+
+			admitted, position, length := controller.startThrottler.admit(newer)
+			Expect(admitted).To(BeFalse())
+			Expect(position).To(Equal(1))
+			Expect(length).To(Equal(1))
+
+			admitted, position, length = controller.startThrottler.admit(older)
+			Expect(admitted).To(BeFalse())
+			Expect(position).To(Equal(1))
+			Expect(length).To(Equal(2))
+
+			starting = starting.DeepCopy()
+			starting.Status.Phase = virtv1.Running
+			Expect(controller.vmiIndexer.Update(starting)).To(Succeed())
+
+			admitted, position, _ = controller.startThrottler.admit(newer)
+			Expect(admitted).To(BeFalse())
+			Expect(position).To(Equal(2))
+
+			admitted, _, _ = controller.startThrottler.admit(older)
+			Expect(admitted).To(BeTrue())
+
+			By("counting the admitted VMI as starting until it leaves the Pending phase")
+			admitted, position, length = controller.startThrottler.admit(newer)
+			Expect(admitted).To(BeFalse())
+			Expect(position).To(Equal(1))
+			Expect(length).To(Equal(1))
+		})
+
+		It("should only hold back VMIs of namespaces which reached their limit", func() {
+			setStartConfiguration(&virtv1.StartConfiguration{ParallelStartsPerNamespace: pointer.P(uint32(1))})
+			Expect(controller.vmiIndexer.Add(newStartingVMI("starting", "busy"))).To(Succeed())
+			now := time.Now()
+			blocked := newQueuedVMI("blocked", "busy", now.Add(-time.Minute))
+			other := newQueuedVMI("other", "idle", now)
+			Expect(controller.vmiIndexer.Add(blocked)).To(Succeed())
+			Expect(controller.vmiIndexer.Add(other)).To(Succeed())
+
+			admitted, position, length := controller.startThrottler.admit(blocked)
+			Expect(admitted).To(BeFalse())
+			Expect(position).To(Equal(1))
+			Expect(length).To(Equal(1))
+
+			admitted, _, _ = controller.startThrottler.admit(other)
+			Expect(admitted).To(BeTrue())
+		})
+
+		It("should not count VMIs whose pod is unschedulable as starting", func() {
+			setStartConfiguration(&virtv1.StartConfiguration{ParallelStartsPerCluster: pointer.P(uint32(1))})
+			unschedulable := newStartingVMI("unschedulable", k8sv1.NamespaceDefault)
+			unschedulable.Status.Conditions = []virtv1.VirtualMachineInstanceCondition{{
+				Type:   virtv1.VirtualMachineInstanceConditionType(k8sv1.PodScheduled),
+				Status: k8sv1.ConditionFalse,
+				Reason: k8sv1.PodReasonUnschedulable,
+			}}
+			Expect(controller.vmiIndexer.Add(unschedulable)).To(Succeed())
+			vmi := newQueuedVMI("testvmi", k8sv1.NamespaceDefault, time.Now())
+			Expect(controller.vmiIndexer.Add(vmi)).To(Succeed())
+
+			admitted, _, _ := controller.startThrottler.admit(vmi)
+			Expect(admitted).To(BeTrue())
+		})
+
+		It("should not limit starts without a start configuration", func() {
+			Expect(controller.vmiIndexer.Add(newStartingVMI("starting", k8sv1.NamespaceDefault))).To(Succeed())
+			vmi := newQueuedVMI("testvmi", k8sv1.NamespaceDefault, time.Now())
+			Expect(controller.vmiIndexer.Add(vmi)).To(Succeed())
+
+			admitted, _, _ := controller.startThrottler.admit(vmi)
+			Expect(admitted).To(BeTrue())
+		})
+	})
+
+	Context("Placement hints", func() {
+		var hinter *stubPlacementHinter
+
+		BeforeEach(func() {
+			hinter = &stubPlacementHinter{}
+			controller.placementHinter = hinter
+		})
+
+		It("should add the hints to the launcher pod", func() {
+			hinter.response = &virtv1.PlacementHintsResponse{ForbiddenNodes: []string{"node01"}}
+			vmi := newPendingVirtualMachine("testvmi")
+			addVirtualMachine(vmi)
+
+			sanityExecute()
+
+			testutils.ExpectEvent(recorder, kvcontroller.SuccessfulCreatePodReason)
+			Expect(hinter.requests).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Operation": Equal(virtv1.PlacementHintsOperationStart),
+				"Name":      Equal(vmi.Name),
+				"UID":       Equal(vmi.UID),
+			}))))
+			expectMatchingPodCreation(vmi, WithTransform(func(pod *k8sv1.Pod) []k8sv1.NodeSelectorTerm {
+				return pod.Spec.Affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms
+			}, ConsistOf(MatchFields(IgnoreExtras, Fields{
+				"MatchFields": ContainElement(MatchFields(IgnoreExtras, Fields{
+					"Operator": Equal(k8sv1.NodeSelectorOpNotIn),
+					"Values":   ConsistOf("node01"),
+				})),
+			}))))
+		})
+
+		It("should not create the launcher pod if the hints are required and can't be retrieved", func() {
+			hinter.err = errors.New("placement service unavailable")
+			vmi := newPendingVirtualMachine("testvmi")
+			addVirtualMachine(vmi)
+
+			sanityExecute()
+
+			testutils.ExpectEvent(recorder, kvcontroller.FailedPlacementHintsReason)
+			pods, err := kubeClient.CoreV1().Pods(vmi.Namespace).List(context.Background(), metav1.ListOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(pods.Items).To(BeEmpty())
+		})
+	})
+
 	Context("When a migration exists", func() {
 		It("should delay pod creation if the migration is running", func() {
 			vmi := newPendingVirtualMachine("testvmi")
@@ -3924,6 +4297,23 @@ func (alc *fakeAllocator) Remove(key string) {
 	alc.calls = append(alc.calls, "Remove")
 }
 
+type stubPlacementHinter struct {
+	requests []*virtv1.PlacementHintsRequest
+	response *virtv1.PlacementHintsResponse
+	err      error
+}
+
+func (s *stubPlacementHinter) AddHints(pod *k8sv1.Pod, request *virtv1.PlacementHintsRequest) error {
+	s.requests = append(s.requests, request)
+	if s.err != nil {
+		return s.err
+	}
+	if s.response != nil {
+		placement.ApplyHints(pod, s.response)
+	}
+	return nil
+}
+
 type stubNetworkAnnotationsGenerator struct {
 	annotations map[string]string
 }
@@ -0,0 +1,344 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package volumeautoexpansion
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	k8sv1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
+
+	virtv1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/controller"
+	storagetypes "kubevirt.io/kubevirt/pkg/storage/types"
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
+	volumepreparation "kubevirt.io/kubevirt/pkg/virt-controller/watch/volume-preparation"
+)
+
+const (
+	// FilesystemSizeAnnotation records on an expanded PVC the size of the guest filesystems on it at the
+	// time of the expansion. The PVC is not expanded again before the guest has grown the filesystems.
+	FilesystemSizeAnnotation = "kubevirt.io/auto-expansion-filesystem-size"
+
+	SuccessfulExpandReason = "SuccessfulVolumeAutoExpansion"
+	FailedExpandReason     = "FailedVolumeAutoExpansion"
+
+	defaultIncrementPercentage = 20
+	sizeAlignment              = 1024 * 1024
+
+	defaultAddDelay = 1 * time.Second
+)
+
+// Controller expands the PVCs of the VMs with a volume auto-expansion, when the usage of the guest
+// filesystems on them, as reported by virt-handler on the VMI, crosses the threshold of the VM.
+// The disk grows in the guest with the ExpandDisks feature gate, the guest has to grow the
+// filesystems itself, e.g. with cloud-init growpart.
+type Controller struct {
+	clientset         kubecli.KubevirtClient
+	queue             workqueue.TypedRateLimitingInterface[string]
+	vmStore           cache.Store
+	vmiStore          cache.Store
+	pvcStore          cache.Store
+	storageClassStore cache.Store
+	recorder          record.EventRecorder
+	clusterConfig     *virtconfig.ClusterConfig
+	hasSynced         func() bool
+
+	volumePreparationThrottler *volumepreparation.Throttler
+}
+
+// NewController creates a new instance of the volume auto-expansion Controller.
+func NewController(clientset kubecli.KubevirtClient,
+	vmInformer cache.SharedIndexInformer,
+	vmiInformer cache.SharedIndexInformer,
+	pvcInformer cache.SharedIndexInformer,
+	storageClassInformer cache.SharedIndexInformer,
+	recorder record.EventRecorder,
+	clusterConfig *virtconfig.ClusterConfig,
+	volumePreparationThrottler *volumepreparation.Throttler) (*Controller, error) {
+	c := &Controller{
+		clientset: clientset,
+		queue: workqueue.NewTypedRateLimitingQueueWithConfig[string](
+			workqueue.DefaultTypedControllerRateLimiter[string](),
+			workqueue.TypedRateLimitingQueueConfig[string]{Name: "virt-controller-volume-autoexpansion"},
+		),
+		vmStore:           vmInformer.GetStore(),
+		vmiStore:          vmiInformer.GetStore(),
+		pvcStore:          pvcInformer.GetStore(),
+		storageClassStore: storageClassInformer.GetStore(),
+		recorder:          recorder,
+		clusterConfig:     clusterConfig,
+
+		volumePreparationThrottler: volumePreparationThrottler,
+	}
+
+	c.hasSynced = func() bool {
+		return vmInformer.HasSynced() && vmiInformer.HasSynced() && pvcInformer.HasSynced() && storageClassInformer.HasSynced()
+	}
+
+	// The VMI of a VM has the same name, so both are handled with the key of the VM
+	for _, informer := range []cache.SharedIndexInformer{vmInformer, vmiInformer} {
+		_, err := informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+			AddFunc:    c.enqueue,
+			UpdateFunc: func(_, curr interface{}) { c.enqueue(curr) },
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	_, err := pvcInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		UpdateFunc: c.updatePVC,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+// updatePVC refreshes the volume preparation metrics when a resize of the PVC starts or ends
+func (c *Controller) updatePVC(old, curr interface{}) {
+	oldPVC := old.(*k8sv1.PersistentVolumeClaim)
+	currPVC := curr.(*k8sv1.PersistentVolumeClaim)
+	if controller.IsPersistentVolumeClaimResizing(oldPVC) != controller.IsPersistentVolumeClaimResizing(currPVC) {
+		c.volumePreparationThrottler.UpdateMetrics()
+	}
+}
+
+func (c *Controller) enqueue(obj interface{}) {
+	key, err := controller.KeyFunc(obj)
+	if err != nil {
+		log.Log.Reason(err).Error("Failed to extract key from object.")
+		return
+	}
+	c.queue.AddAfter(key, defaultAddDelay)
+}
+
+func (c *Controller) Run(threadiness int, stopCh <-chan struct{}) {
+	defer controller.HandlePanic()
+	defer c.queue.ShutDown()
+	log.Log.Info("Starting volume auto-expansion controller.")
+
+	cache.WaitForCacheSync(stopCh, c.hasSynced)
+
+	for i := 0; i < threadiness; i++ {
+		go wait.Until(c.runWorker, time.Second, stopCh)
+	}
+
+	<-stopCh
+	log.Log.Info("Stopping volume auto-expansion controller.")
+}
+
+func (c *Controller) runWorker() {
+	for c.Execute() {
+	}
+}
+
+// Execute runs commands from the controller queue, if there is
+// an error it requeues the command. Returns false if the queue
+// is empty.
+func (c *Controller) Execute() bool {
+	key, quit := c.queue.Get()
+	if quit {
+		return false
+	}
+	defer c.queue.Done(key)
+
+	if err := c.execute(key); err != nil {
+		log.Log.Reason(err).Infof("reenqueuing volume auto-expansion of VM %v", key)
+		c.queue.AddRateLimited(key)
+	} else {
+		log.Log.V(4).Infof("processed volume auto-expansion of VM %v", key)
+		c.queue.Forget(key)
+	}
+	return true
+}
+
+func (c *Controller) execute(key string) error {
+	if !c.clusterConfig.VolumeAutoExpansionEnabled() {
+		return nil
+	}
+
+	obj, exists, err := c.vmStore.GetByKey(key)
+	if err != nil || !exists {
+		return err
+	}
+	vm := obj.(*virtv1.VirtualMachine)
+	expansion := vm.Spec.VolumeAutoExpansion
+	if expansion == nil || vm.DeletionTimestamp != nil || vm.Spec.Template == nil {
+		return nil
+	}
+
+	obj, exists, err = c.vmiStore.GetByKey(key)
+	if err != nil || !exists {
+		return err
+	}
+	vmi := obj.(*virtv1.VirtualMachineInstance)
+	if vmi.Status.Phase != virtv1.Running {
+		return nil
+	}
+
+	volumes := storagetypes.GetVolumesByName(&vm.Spec.Template.Spec)
+	for _, volumeStatus := range vmi.Status.VolumeStatus {
+		if !contains(expansion.Volumes, volumeStatus.Name) || !crossesThreshold(volumeStatus.GuestFilesystemUsage, expansion) {
+			continue
+		}
+		volume, exists := volumes[volumeStatus.Name]
+		if !exists {
+			continue
+		}
+		claimName := storagetypes.PVCNameFromVirtVolume(volume)
+		if claimName == "" {
+			continue
+		}
+		if err := c.expand(vm, vmi.Namespace, claimName, volumeStatus, expansion); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func contains(volumes []string, name string) bool {
+	for _, volume := range volumes {
+		if volume == name {
+			return true
+		}
+	}
+	return false
+}
+
+func crossesThreshold(usage *virtv1.GuestFilesystemUsage, expansion *virtv1.VolumeAutoExpansion) bool {
+	if usage == nil || usage.TotalBytes <= 0 {
+		return false
+	}
+	return usage.UsedBytes*100 >= usage.TotalBytes*int64(expansion.UsageThresholdPercentage)
+}
+
+// expand requests more storage for the PVC, unless a resize is in progress or the guest did not grow
+// its filesystems after the previous expansion
+func (c *Controller) expand(vm *virtv1.VirtualMachine, namespace, claimName string,
+	volumeStatus virtv1.VolumeStatus, expansion *virtv1.VolumeAutoExpansion) error {
+	obj, exists, err := c.pvcStore.GetByKey(controller.NamespacedKey(namespace, claimName))
+	if err != nil || !exists {
+		return err
+	}
+	pvc := obj.(*k8sv1.PersistentVolumeClaim)
+
+	capacity, exists := pvc.Status.Capacity[k8sv1.ResourceStorage]
+	if !exists {
+		return nil
+	}
+	request := pvc.Spec.Resources.Requests[k8sv1.ResourceStorage]
+	if request.Cmp(capacity) > 0 {
+		log.Log.Object(vm).V(3).Infof("PVC %s/%s is being resized", namespace, claimName)
+		return nil
+	}
+
+	usage := volumeStatus.GuestFilesystemUsage
+	if value, exists := pvc.Annotations[FilesystemSizeAnnotation]; exists {
+		previousSize, err := strconv.ParseInt(value, 10, 64)
+		if err == nil && usage.TotalBytes <= previousSize {
+			log.Log.Object(vm).V(3).Infof("The guest did not grow the filesystems on volume %s yet", volumeStatus.Name)
+			return nil
+		}
+	}
+
+	if !c.allowsExpansion(pvc) {
+		c.recorder.Eventf(vm, k8sv1.EventTypeWarning, FailedExpandReason,
+			"PVC %s of volume %s can not be expanded, its StorageClass does not allow volume expansion", claimName, volumeStatus.Name)
+		return nil
+	}
+
+	size := newSize(capacity, expansion)
+	if size.Cmp(capacity) <= 0 {
+		log.Log.Object(vm).V(3).Infof("PVC %s/%s reached the maximum size of the volume auto-expansion", namespace, claimName)
+		return nil
+	}
+
+	if admission := c.volumePreparationThrottler.AdmitResize(pvc); !admission.Admitted {
+		if admission.NewlyThrottled {
+			c.recorder.Eventf(vm, k8sv1.EventTypeNormal, volumepreparation.ThrottledReason,
+				"Delaying expansion of PVC %s, storage class %s reached the limit of %d parallel volume preparations",
+				claimName, admission.StorageClass, admission.Limit)
+		}
+		c.queue.AddAfter(controller.NamespacedKey(vm.Namespace, vm.Name), volumepreparation.RequeueInterval)
+		return nil
+	}
+
+	updated := pvc.DeepCopy()
+	if updated.Annotations == nil {
+		updated.Annotations = map[string]string{}
+	}
+	updated.Annotations[FilesystemSizeAnnotation] = strconv.FormatInt(usage.TotalBytes, 10)
+	if updated.Spec.Resources.Requests == nil {
+		updated.Spec.Resources.Requests = k8sv1.ResourceList{}
+	}
+	updated.Spec.Resources.Requests[k8sv1.ResourceStorage] = size
+	_, err = c.clientset.CoreV1().PersistentVolumeClaims(namespace).Update(context.Background(), updated, metav1.UpdateOptions{})
+	if err != nil {
+		c.volumePreparationThrottler.ReleaseResize(pvc)
+		c.recorder.Eventf(vm, k8sv1.EventTypeWarning, FailedExpandReason, "Error expanding PVC %s: %v", claimName, err)
+		return err
+	}
+	c.recorder.Eventf(vm, k8sv1.EventTypeNormal, SuccessfulExpandReason,
+		"Expanded PVC %s of volume %s from %s to %s, the guest filesystems on it were %d%% full",
+		claimName, volumeStatus.Name, capacity.String(), size.String(), usage.UsedBytes*100/usage.TotalBytes)
+	return nil
+}
+
+func (c *Controller) allowsExpansion(pvc *k8sv1.PersistentVolumeClaim) bool {
+	if pvc.Spec.StorageClassName == nil {
+		return false
+	}
+	obj, exists, err := c.storageClassStore.GetByKey(*pvc.Spec.StorageClassName)
+	if err != nil || !exists {
+		return false
+	}
+	storageClass := obj.(*storagev1.StorageClass)
+	return storageClass.AllowVolumeExpansion != nil && *storageClass.AllowVolumeExpansion
+}
+
+// newSize returns the capacity increased by the increment of the expansion, aligned to MiB
+// and capped by its maximum size
+func newSize(capacity resource.Quantity, expansion *virtv1.VolumeAutoExpansion) resource.Quantity {
+	increment := int64(defaultIncrementPercentage)
+	if expansion.IncrementPercentage != nil {
+		increment = int64(*expansion.IncrementPercentage)
+	}
+	value := capacity.Value() + capacity.Value()*increment/100
+	value = (value + sizeAlignment - 1) / sizeAlignment * sizeAlignment
+	size := *resource.NewQuantity(value, resource.BinarySI)
+	if expansion.MaxSize != nil && size.Cmp(*expansion.MaxSize) > 0 {
+		return expansion.MaxSize.DeepCopy()
+	}
+	return size
+}
@@ -0,0 +1,244 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package volumeautoexpansion
+
+import (
+	"context"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	k8sv1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/record"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+	cdiv1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
+
+	virtcontroller "kubevirt.io/kubevirt/pkg/controller"
+	"kubevirt.io/kubevirt/pkg/libvmi"
+	libvmistatus "kubevirt.io/kubevirt/pkg/libvmi/status"
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/testutils"
+	"kubevirt.io/kubevirt/pkg/virt-config/featuregate"
+	volumepreparation "kubevirt.io/kubevirt/pkg/virt-controller/watch/volume-preparation"
+)
+
+var _ = Describe("Volume auto-expansion controller", func() {
+	const (
+		testNamespace    = "default"
+		vmName           = "testvm"
+		claimName        = "data"
+		storageClassName = "expandable"
+		gib              = 1024 * 1024 * 1024
+	)
+
+	var (
+		controller *Controller
+		recorder   *record.FakeRecorder
+		k8sClient  *k8sfake.Clientset
+	)
+
+	newVM := func() *v1.VirtualMachine {
+		vm := libvmi.NewVirtualMachine(libvmi.New(
+			libvmi.WithName(vmName),
+			libvmi.WithNamespace(testNamespace),
+			libvmi.WithPersistentVolumeClaim("datadisk", claimName),
+		))
+		vm.Spec.VolumeAutoExpansion = &v1.VolumeAutoExpansion{
+			Volumes:                  []string{"datadisk"},
+			UsageThresholdPercentage: 80,
+		}
+		return vm
+	}
+
+	newVMI := func(usedBytes, totalBytes int64) *v1.VirtualMachineInstance {
+		return libvmi.New(
+			libvmi.WithName(vmName),
+			libvmi.WithNamespace(testNamespace),
+			libvmi.WithPersistentVolumeClaim("datadisk", claimName),
+			libvmistatus.WithStatus(libvmistatus.New(
+				libvmistatus.WithPhase(v1.Running),
+				libvmistatus.WithVolumeStatus(v1.VolumeStatus{
+					Name:                 "datadisk",
+					GuestFilesystemUsage: &v1.GuestFilesystemUsage{UsedBytes: usedBytes, TotalBytes: totalBytes},
+				}),
+			)),
+		)
+	}
+
+	newPVC := func(request, capacity string) *k8sv1.PersistentVolumeClaim {
+		return &k8sv1.PersistentVolumeClaim{
+			ObjectMeta: metav1.ObjectMeta{Name: claimName, Namespace: testNamespace},
+			Spec: k8sv1.PersistentVolumeClaimSpec{
+				StorageClassName: pointer.P(storageClassName),
+				Resources: k8sv1.VolumeResourceRequirements{
+					Requests: k8sv1.ResourceList{k8sv1.ResourceStorage: resource.MustParse(request)},
+				},
+			},
+			Status: k8sv1.PersistentVolumeClaimStatus{
+				Capacity: k8sv1.ResourceList{k8sv1.ResourceStorage: resource.MustParse(capacity)},
+			},
+		}
+	}
+
+	newStorageClass := func(allowExpansion bool) *storagev1.StorageClass {
+		return &storagev1.StorageClass{
+			ObjectMeta:           metav1.ObjectMeta{Name: storageClassName},
+			AllowVolumeExpansion: pointer.P(allowExpansion),
+		}
+	}
+
+	setupController := func(featureGates []string, objs ...runtime.Object) {
+		virtClient := kubecli.NewMockKubevirtClient(gomock.NewController(GinkgoT()))
+		vmInformer, _ := testutils.NewFakeInformerFor(&v1.VirtualMachine{})
+		vmiInformer, _ := testutils.NewFakeInformerFor(&v1.VirtualMachineInstance{})
+		pvcInformer, _ := testutils.NewFakeInformerWithIndexersFor(&k8sv1.PersistentVolumeClaim{}, virtcontroller.GetPersistentVolumeClaimInformerIndexers())
+		dataVolumeInformer, _ := testutils.NewFakeInformerWithIndexersFor(&cdiv1.DataVolume{}, virtcontroller.GetDataVolumeInformerIndexers())
+		storageClassInformer, _ := testutils.NewFakeInformerFor(&storagev1.StorageClass{})
+		recorder = record.NewFakeRecorder(100)
+		config, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{
+			DeveloperConfiguration: &v1.DeveloperConfiguration{FeatureGates: featureGates},
+			StartConfiguration: &v1.StartConfiguration{
+				VolumePreparationLimits: []v1.VolumePreparationLimit{{
+					StorageClassName:           storageClassName,
+					ParallelVolumePreparations: 1,
+				}},
+			},
+		})
+		throttler := volumepreparation.NewThrottler(dataVolumeInformer.GetIndexer(), pvcInformer.GetIndexer(), storageClassInformer.GetStore(), config)
+
+		var err error
+		controller, err = NewController(virtClient, vmInformer, vmiInformer, pvcInformer, storageClassInformer, recorder, config, throttler)
+		Expect(err).ToNot(HaveOccurred())
+
+		k8sClient = k8sfake.NewSimpleClientset()
+		virtClient.EXPECT().CoreV1().Return(k8sClient.CoreV1()).AnyTimes()
+
+		for _, obj := range objs {
+			switch o := obj.(type) {
+			case *v1.VirtualMachine:
+				Expect(vmInformer.GetStore().Add(o)).To(Succeed())
+			case *v1.VirtualMachineInstance:
+				Expect(vmiInformer.GetStore().Add(o)).To(Succeed())
+			case *storagev1.StorageClass:
+				Expect(storageClassInformer.GetStore().Add(o)).To(Succeed())
+			case *k8sv1.PersistentVolumeClaim:
+				Expect(pvcInformer.GetStore().Add(o)).To(Succeed())
+				_, err := k8sClient.CoreV1().PersistentVolumeClaims(o.Namespace).Create(context.Background(), o, metav1.CreateOptions{})
+				Expect(err).ToNot(HaveOccurred())
+			}
+		}
+		k8sClient.ClearActions()
+	}
+
+	execute := func() {
+		Expect(controller.execute(virtcontroller.NamespacedKey(testNamespace, vmName))).To(Succeed())
+	}
+
+	getPVC := func() *k8sv1.PersistentVolumeClaim {
+		pvc, err := k8sClient.CoreV1().PersistentVolumeClaims(testNamespace).Get(context.Background(), claimName, metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		return pvc
+	}
+
+	enabled := []string{featuregate.VolumeAutoExpansionGate}
+
+	It("should expand the PVC when the guest filesystems cross the threshold", func() {
+		setupController(enabled, newVM(), newVMI(9*gib, 10*gib), newPVC("10Gi", "10Gi"), newStorageClass(true))
+		execute()
+
+		pvc := getPVC()
+		Expect(pvc.Spec.Resources.Requests.Storage().Value()).To(Equal(int64(12 * gib)))
+		Expect(pvc.Annotations).To(HaveKeyWithValue(FilesystemSizeAnnotation, "10737418240"))
+		testutils.ExpectEvent(recorder, SuccessfulExpandReason)
+	})
+
+	It("should expand the PVC by the configured increment and not beyond the maximum size", func() {
+		vm := newVM()
+		vm.Spec.VolumeAutoExpansion.IncrementPercentage = pointer.P(int32(50))
+		vm.Spec.VolumeAutoExpansion.MaxSize = pointer.P(resource.MustParse("14Gi"))
+		setupController(enabled, vm, newVMI(9*gib, 10*gib), newPVC("10Gi", "10Gi"), newStorageClass(true))
+		execute()
+
+		Expect(getPVC().Spec.Resources.Requests.Storage().String()).To(Equal("14Gi"))
+		testutils.ExpectEvent(recorder, SuccessfulExpandReason)
+	})
+
+	DescribeTable("should not expand the PVC", func(featureGates []string, vmi *v1.VirtualMachineInstance, pvc *k8sv1.PersistentVolumeClaim) {
+		vm := newVM()
+		vm.Spec.VolumeAutoExpansion.MaxSize = pointer.P(resource.MustParse("10Gi"))
+		setupController(featureGates, vm, vmi, pvc, newStorageClass(true))
+		execute()
+
+		Expect(k8sClient.Actions()).To(BeEmpty())
+		Expect(recorder.Events).To(BeEmpty())
+	},
+		Entry("without the feature gate", nil, newVMI(9*gib, 10*gib), newPVC("5Gi", "5Gi")),
+		Entry("below the threshold", enabled, newVMI(7*gib, 10*gib), newPVC("5Gi", "5Gi")),
+		Entry("without reported usage", enabled, newVMI(0, 0), newPVC("5Gi", "5Gi")),
+		Entry("while it is being resized", enabled, newVMI(9*gib, 10*gib), newPVC("6Gi", "5Gi")),
+		Entry("at the maximum size", enabled, newVMI(9*gib, 10*gib), newPVC("10Gi", "10Gi")),
+	)
+
+	It("should not expand the PVC again before the guest grew the filesystems", func() {
+		pvc := newPVC("10Gi", "10Gi")
+		pvc.Annotations = map[string]string{FilesystemSizeAnnotation: "8589934592"}
+		setupController(enabled, newVM(), newVMI(7*gib, 8*gib), pvc, newStorageClass(true))
+		execute()
+
+		Expect(k8sClient.Actions()).To(BeEmpty())
+
+		Expect(controller.vmiStore.Update(newVMI(9*gib, 10*gib))).To(Succeed())
+		execute()
+
+		Expect(getPVC().Spec.Resources.Requests.Storage().Value()).To(Equal(int64(12 * gib)))
+		testutils.ExpectEvent(recorder, SuccessfulExpandReason)
+	})
+
+	It("should delay the expansion while the storage class is at its volume preparation limit", func() {
+		resizing := newPVC("6Gi", "5Gi")
+		resizing.Name = "resizing"
+		setupController(enabled, newVM(), newVMI(9*gib, 10*gib), newPVC("10Gi", "10Gi"), resizing, newStorageClass(true))
+		execute()
+
+		Expect(k8sClient.Actions()).To(BeEmpty())
+		testutils.ExpectEvent(recorder, volumepreparation.ThrottledReason)
+
+		By("reporting the delay once")
+		execute()
+		Expect(k8sClient.Actions()).To(BeEmpty())
+		Expect(recorder.Events).To(BeEmpty())
+	})
+
+	It("should report a PVC whose StorageClass does not allow expansion", func() {
+		setupController(enabled, newVM(), newVMI(9*gib, 10*gib), newPVC("10Gi", "10Gi"), newStorageClass(false))
+		execute()
+
+		Expect(k8sClient.Actions()).To(BeEmpty())
+		testutils.ExpectEvent(recorder, FailedExpandReason)
+	})
+})
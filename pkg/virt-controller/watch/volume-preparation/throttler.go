@@ -0,0 +1,274 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package volumepreparation
+
+import (
+	"sync"
+	"time"
+
+	k8score "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	"k8s.io/client-go/tools/cache"
+
+	cdiv1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
+
+	"kubevirt.io/kubevirt/pkg/controller"
+	metrics "kubevirt.io/kubevirt/pkg/monitoring/metrics/virt-controller"
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
+)
+
+const (
+	// ThrottledReason is added in an event when a volume preparation is delayed
+	// because its storage class reached the volume preparation limit
+	ThrottledReason = "VolumePreparationThrottled"
+
+	// RequeueInterval is the delay after which a throttled volume preparation is retried
+	RequeueInterval = 5 * time.Second
+
+	// admittedExpiry bounds how long an admitted volume preparation is counted
+	// before it shows up in the cache
+	admittedExpiry = time.Minute
+	// throttledExpiry bounds how long a throttled volume preparation, which is not retried anymore, is remembered
+	throttledExpiry = 3 * RequeueInterval
+
+	defaultVirtStorageClassAnnotation = "storageclass.kubevirt.io/is-default-virt-class"
+	defaultStorageClassAnnotation     = "storageclass.kubernetes.io/is-default-class"
+)
+
+// Admission is the decision of the Throttler on a volume preparation
+type Admission struct {
+	Admitted bool
+	// NewlyThrottled is only set when a volume preparation is refused the first time,
+	// so that the delay is reported once
+	NewlyThrottled bool
+	StorageClass   string
+	Limit          uint32
+}
+
+type preparation struct {
+	key    string
+	resize bool
+}
+
+type admittedPreparation struct {
+	storageClass string
+	since        time.Time
+}
+
+// Throttler enforces the per storage class limits on the volumes which are cloned, imported or
+// resized at the same time. It is consulted before a DataVolume is created from a DataVolumeTemplate
+// and before a PVC is expanded, and counts the volume preparations of the whole cluster.
+type Throttler struct {
+	lock              sync.Mutex
+	dataVolumeIndexer cache.Indexer
+	pvcIndexer        cache.Indexer
+	storageClassStore cache.Store
+	clusterConfig     *virtconfig.ClusterConfig
+	// admitted holds volume preparations which were allowed to start but not yet observed in the cache
+	admitted map[preparation]admittedPreparation
+	// throttled holds the last refusal of the volume preparations which are waiting for their storage class
+	throttled map[preparation]time.Time
+}
+
+func NewThrottler(dataVolumeIndexer, pvcIndexer cache.Indexer, storageClassStore cache.Store, clusterConfig *virtconfig.ClusterConfig) *Throttler {
+	return &Throttler{
+		dataVolumeIndexer: dataVolumeIndexer,
+		pvcIndexer:        pvcIndexer,
+		storageClassStore: storageClassStore,
+		clusterConfig:     clusterConfig,
+		admitted:          map[preparation]admittedPreparation{},
+		throttled:         map[preparation]time.Time{},
+	}
+}
+
+// AdmitDataVolume decides if the DataVolume may be created now
+func (t *Throttler) AdmitDataVolume(dataVolume *cdiv1.DataVolume) Admission {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	return t.admit(preparation{key: controller.DataVolumeKey(dataVolume)}, t.dataVolumeStorageClass(dataVolume))
+}
+
+// ReleaseDataVolume forgets an admitted DataVolume which could not be created
+func (t *Throttler) ReleaseDataVolume(dataVolume *cdiv1.DataVolume) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	delete(t.admitted, preparation{key: controller.DataVolumeKey(dataVolume)})
+}
+
+// AdmitResize decides if the PVC may be expanded now
+func (t *Throttler) AdmitResize(pvc *k8score.PersistentVolumeClaim) Admission {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	return t.admit(preparation{key: controller.NamespacedKey(pvc.Namespace, pvc.Name), resize: true}, t.pvcStorageClass(pvc))
+}
+
+// ReleaseResize forgets an admitted PVC expansion which could not be requested
+func (t *Throttler) ReleaseResize(pvc *k8score.PersistentVolumeClaim) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	delete(t.admitted, preparation{key: controller.NamespacedKey(pvc.Namespace, pvc.Name), resize: true})
+}
+
+// UpdateMetrics reports the volume preparations which are in flight per storage class
+// as long as volume preparation limits are configured
+func (t *Throttler) UpdateMetrics() {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	if config := t.clusterConfig.GetStartConfiguration(); config == nil || len(config.VolumePreparationLimits) == 0 {
+		metrics.SetVolumePreparationsInFlight(nil)
+		return
+	}
+	t.countInFlight()
+}
+
+func (t *Throttler) limitFor(storageClass string) (uint32, bool) {
+	config := t.clusterConfig.GetStartConfiguration()
+	if config == nil {
+		return 0, false
+	}
+	for _, limit := range config.VolumePreparationLimits {
+		if limit.StorageClassName == storageClass {
+			return limit.ParallelVolumePreparations, true
+		}
+	}
+	return 0, false
+}
+
+func (t *Throttler) admit(p preparation, storageClass string) Admission {
+	limit, limited := t.limitFor(storageClass)
+	if !limited {
+		delete(t.throttled, p)
+		return Admission{Admitted: true, StorageClass: storageClass}
+	}
+
+	inFlight := t.countInFlight()
+	if inFlight[storageClass] >= int(limit) {
+		_, throttled := t.throttled[p]
+		t.throttled[p] = time.Now()
+		return Admission{NewlyThrottled: !throttled, StorageClass: storageClass, Limit: limit}
+	}
+
+	delete(t.throttled, p)
+	t.admitted[p] = admittedPreparation{storageClass: storageClass, since: time.Now()}
+	inFlight[storageClass]++
+	metrics.SetVolumePreparationsInFlight(inFlight)
+	return Admission{Admitted: true, StorageClass: storageClass, Limit: limit}
+}
+
+// countInFlight counts the indexed DataVolumes and PVCs and the admitted volume preparations which are
+// in flight per storage class. Admitted volume preparations which showed up in the cache or expired are
+// forgotten, as well as throttled ones which are not retried anymore.
+func (t *Throttler) countInFlight() map[string]int {
+	inFlight := map[string]int{}
+	for _, indexedStorageClass := range t.dataVolumeIndexer.ListIndexFuncValues(controller.VolumePreparationIndex) {
+		objs, err := t.dataVolumeIndexer.ByIndex(controller.VolumePreparationIndex, indexedStorageClass)
+		if err != nil {
+			continue
+		}
+		for _, obj := range objs {
+			inFlight[t.dataVolumeStorageClass(obj.(*cdiv1.DataVolume))]++
+		}
+	}
+	for _, indexedStorageClass := range t.pvcIndexer.ListIndexFuncValues(controller.VolumePreparationIndex) {
+		keys, err := t.pvcIndexer.IndexKeys(controller.VolumePreparationIndex, indexedStorageClass)
+		if err != nil {
+			continue
+		}
+		storageClass := indexedStorageClass
+		if storageClass == "" {
+			storageClass = t.defaultStorageClass(false)
+		}
+		inFlight[storageClass] += len(keys)
+	}
+
+	now := time.Now()
+	for p, admitted := range t.admitted {
+		if now.Sub(admitted.since) > admittedExpiry || t.observed(p) {
+			delete(t.admitted, p)
+			continue
+		}
+		inFlight[admitted.storageClass]++
+	}
+	for p, since := range t.throttled {
+		if now.Sub(since) > throttledExpiry {
+			delete(t.throttled, p)
+		}
+	}
+	metrics.SetVolumePreparationsInFlight(inFlight)
+	return inFlight
+}
+
+// observed returns true once the created DataVolume or the expanded PVC shows up in the cache
+func (t *Throttler) observed(p preparation) bool {
+	if !p.resize {
+		_, exists, err := t.dataVolumeIndexer.GetByKey(p.key)
+		return err == nil && exists
+	}
+	obj, exists, err := t.pvcIndexer.GetByKey(p.key)
+	return err == nil && exists && controller.IsPersistentVolumeClaimResizing(obj.(*k8score.PersistentVolumeClaim))
+}
+
+// dataVolumeStorageClass resolves the storage class of a DataVolume from its PVC,
+// its spec or the default storage class of the cluster
+func (t *Throttler) dataVolumeStorageClass(dataVolume *cdiv1.DataVolume) string {
+	obj, exists, err := t.pvcIndexer.GetByKey(controller.DataVolumeKey(dataVolume))
+	if err == nil && exists {
+		pvc := obj.(*k8score.PersistentVolumeClaim)
+		if pvc.Spec.StorageClassName != nil && *pvc.Spec.StorageClassName != "" {
+			return *pvc.Spec.StorageClassName
+		}
+	}
+
+	if dataVolume.Spec.PVC != nil && dataVolume.Spec.PVC.StorageClassName != nil && *dataVolume.Spec.PVC.StorageClassName != "" {
+		return *dataVolume.Spec.PVC.StorageClassName
+	}
+	if dataVolume.Spec.Storage != nil && dataVolume.Spec.Storage.StorageClassName != nil && *dataVolume.Spec.Storage.StorageClassName != "" {
+		return *dataVolume.Spec.Storage.StorageClassName
+	}
+	return t.defaultStorageClass(dataVolume.Spec.Storage != nil)
+}
+
+func (t *Throttler) pvcStorageClass(pvc *k8score.PersistentVolumeClaim) string {
+	if pvc.Spec.StorageClassName != nil && *pvc.Spec.StorageClassName != "" {
+		return *pvc.Spec.StorageClassName
+	}
+	return t.defaultStorageClass(false)
+}
+
+// defaultStorageClass returns the default storage class of the cluster, or the one
+// for virtualization workloads if it is preferred
+func (t *Throttler) defaultStorageClass(preferVirt bool) string {
+	k8sDefault := ""
+	for _, obj := range t.storageClassStore.List() {
+		storageClass := obj.(*storagev1.StorageClass)
+		if preferVirt && storageClass.Annotations[defaultVirtStorageClassAnnotation] == "true" {
+			return storageClass.Name
+		}
+		if storageClass.Annotations[defaultStorageClassAnnotation] == "true" {
+			k8sDefault = storageClass.Name
+		}
+	}
+	return k8sDefault
+}
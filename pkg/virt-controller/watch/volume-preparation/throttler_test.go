@@ -0,0 +1,278 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package volumepreparation
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	k8sv1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+
+	v1 "kubevirt.io/api/core/v1"
+	cdiv1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
+
+	"kubevirt.io/kubevirt/pkg/controller"
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/testutils"
+)
+
+var _ = Describe("Volume preparation throttler", func() {
+	const (
+		namespace    = "default"
+		limitedClass = "limited"
+		otherClass   = "other"
+	)
+
+	var (
+		throttler         *Throttler
+		dataVolumeIndexer cache.Indexer
+		pvcIndexer        cache.Indexer
+		storageClassStore cache.Store
+	)
+
+	BeforeEach(func() {
+		dataVolumeInformer, _ := testutils.NewFakeInformerWithIndexersFor(&cdiv1.DataVolume{}, controller.GetDataVolumeInformerIndexers())
+		pvcInformer, _ := testutils.NewFakeInformerWithIndexersFor(&k8sv1.PersistentVolumeClaim{}, controller.GetPersistentVolumeClaimInformerIndexers())
+		storageClassInformer, _ := testutils.NewFakeInformerFor(&storagev1.StorageClass{})
+		dataVolumeIndexer = dataVolumeInformer.GetIndexer()
+		pvcIndexer = pvcInformer.GetIndexer()
+		storageClassStore = storageClassInformer.GetStore()
+
+		config, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{
+			StartConfiguration: &v1.StartConfiguration{
+				VolumePreparationLimits: []v1.VolumePreparationLimit{{
+					StorageClassName:           limitedClass,
+					ParallelVolumePreparations: 1,
+				}},
+			},
+		})
+		throttler = NewThrottler(dataVolumeIndexer, pvcIndexer, storageClassStore, config)
+	})
+
+	newDataVolume := func(name string, storageClass *string, phase cdiv1.DataVolumePhase) *cdiv1.DataVolume {
+		return &cdiv1.DataVolume{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+			Spec: cdiv1.DataVolumeSpec{
+				Storage: &cdiv1.StorageSpec{StorageClassName: storageClass},
+			},
+			Status: cdiv1.DataVolumeStatus{Phase: phase},
+		}
+	}
+
+	newPVC := func(name string, storageClass *string, request, capacity string) *k8sv1.PersistentVolumeClaim {
+		return &k8sv1.PersistentVolumeClaim{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+			Spec: k8sv1.PersistentVolumeClaimSpec{
+				StorageClassName: storageClass,
+				Resources: k8sv1.VolumeResourceRequirements{
+					Requests: k8sv1.ResourceList{k8sv1.ResourceStorage: resource.MustParse(request)},
+				},
+			},
+			Status: k8sv1.PersistentVolumeClaimStatus{
+				Capacity: k8sv1.ResourceList{k8sv1.ResourceStorage: resource.MustParse(capacity)},
+			},
+		}
+	}
+
+	newStorageClass := func(name string, annotations map[string]string) *storagev1.StorageClass {
+		return &storagev1.StorageClass{ObjectMeta: metav1.ObjectMeta{Name: name, Annotations: annotations}}
+	}
+
+	Context("in-flight counting", func() {
+		It("should admit volume preparations of storage classes without a limit", func() {
+			Expect(dataVolumeIndexer.Add(newDataVolume("busy", pointer.P(otherClass), cdiv1.ImportInProgress))).To(Succeed())
+
+			admission := throttler.AdmitDataVolume(newDataVolume("dv", pointer.P(otherClass), ""))
+			Expect(admission.Admitted).To(BeTrue())
+			Expect(admission.StorageClass).To(Equal(otherClass))
+		})
+
+		It("should throttle a DataVolume once the in-flight DataVolumes reach the limit", func() {
+			Expect(dataVolumeIndexer.Add(newDataVolume("busy", pointer.P(limitedClass), cdiv1.CloneInProgress))).To(Succeed())
+
+			admission := throttler.AdmitDataVolume(newDataVolume("dv", pointer.P(limitedClass), ""))
+			Expect(admission.Admitted).To(BeFalse())
+			Expect(admission.StorageClass).To(Equal(limitedClass))
+			Expect(admission.Limit).To(Equal(uint32(1)))
+		})
+
+		It("should not count DataVolumes which are done", func() {
+			Expect(dataVolumeIndexer.Add(newDataVolume("done", pointer.P(limitedClass), cdiv1.Succeeded))).To(Succeed())
+			Expect(dataVolumeIndexer.Add(newDataVolume("failed", pointer.P(limitedClass), cdiv1.Failed))).To(Succeed())
+
+			Expect(throttler.AdmitDataVolume(newDataVolume("dv", pointer.P(limitedClass), "")).Admitted).To(BeTrue())
+		})
+
+		It("should count the resizing PVCs against the limit", func() {
+			Expect(pvcIndexer.Add(newPVC("resizing", pointer.P(limitedClass), "2Gi", "1Gi"))).To(Succeed())
+
+			Expect(throttler.AdmitDataVolume(newDataVolume("dv", pointer.P(limitedClass), "")).Admitted).To(BeFalse())
+			Expect(throttler.AdmitResize(newPVC("pvc", pointer.P(limitedClass), "2Gi", "1Gi")).Admitted).To(BeFalse())
+		})
+
+		It("should not count PVCs which are not resizing", func() {
+			Expect(pvcIndexer.Add(newPVC("resized", pointer.P(limitedClass), "2Gi", "2Gi"))).To(Succeed())
+
+			Expect(throttler.AdmitResize(newPVC("pvc", pointer.P(limitedClass), "2Gi", "1Gi")).Admitted).To(BeTrue())
+		})
+	})
+
+	Context("storage class resolution", func() {
+		It("should take the storage class of the PVC of an existing DataVolume", func() {
+			Expect(pvcIndexer.Add(newPVC("dv", pointer.P(limitedClass), "1Gi", "1Gi"))).To(Succeed())
+
+			admission := throttler.AdmitDataVolume(newDataVolume("dv", nil, ""))
+			Expect(admission.StorageClass).To(Equal(limitedClass))
+		})
+
+		It("should prefer the default virt storage class for DataVolumes using the storage API", func() {
+			Expect(storageClassStore.Add(newStorageClass(otherClass, map[string]string{defaultStorageClassAnnotation: "true"}))).To(Succeed())
+			Expect(storageClassStore.Add(newStorageClass(limitedClass, map[string]string{defaultVirtStorageClassAnnotation: "true"}))).To(Succeed())
+
+			admission := throttler.AdmitDataVolume(newDataVolume("dv", nil, ""))
+			Expect(admission.StorageClass).To(Equal(limitedClass))
+		})
+
+		It("should take the default storage class for DataVolumes using the PVC API", func() {
+			Expect(storageClassStore.Add(newStorageClass(otherClass, map[string]string{defaultStorageClassAnnotation: "true"}))).To(Succeed())
+			Expect(storageClassStore.Add(newStorageClass(limitedClass, map[string]string{defaultVirtStorageClassAnnotation: "true"}))).To(Succeed())
+			dataVolume := newDataVolume("dv", nil, "")
+			dataVolume.Spec.Storage = nil
+			dataVolume.Spec.PVC = &k8sv1.PersistentVolumeClaimSpec{}
+
+			admission := throttler.AdmitDataVolume(dataVolume)
+			Expect(admission.StorageClass).To(Equal(otherClass))
+		})
+
+		It("should count the in-flight DataVolumes without a storage class against the default storage class", func() {
+			Expect(storageClassStore.Add(newStorageClass(limitedClass, map[string]string{defaultStorageClassAnnotation: "true"}))).To(Succeed())
+			busy := newDataVolume("busy", nil, cdiv1.ImportInProgress)
+			busy.Spec.Storage = nil
+			busy.Spec.PVC = &k8sv1.PersistentVolumeClaimSpec{}
+			Expect(dataVolumeIndexer.Add(busy)).To(Succeed())
+
+			Expect(throttler.AdmitDataVolume(newDataVolume("dv", pointer.P(limitedClass), "")).Admitted).To(BeFalse())
+		})
+
+		It("should count the resizing PVCs without a storage class against the default storage class", func() {
+			Expect(storageClassStore.Add(newStorageClass(limitedClass, map[string]string{defaultStorageClassAnnotation: "true"}))).To(Succeed())
+			Expect(pvcIndexer.Add(newPVC("resizing", nil, "2Gi", "1Gi"))).To(Succeed())
+
+			Expect(throttler.AdmitResize(newPVC("pvc", pointer.P(limitedClass), "2Gi", "1Gi")).Admitted).To(BeFalse())
+		})
+	})
+
+	Context("admitted volume preparations", func() {
+		It("should count an admitted DataVolume until it shows up in the cache", func() {
+			dataVolume := newDataVolume("dv", pointer.P(limitedClass), "")
+			Expect(throttler.AdmitDataVolume(dataVolume).Admitted).To(BeTrue())
+			Expect(throttler.AdmitDataVolume(newDataVolume("next", pointer.P(limitedClass), "")).Admitted).To(BeFalse())
+
+			dataVolume.Status.Phase = cdiv1.Succeeded
+			Expect(dataVolumeIndexer.Add(dataVolume)).To(Succeed())
+
+			Expect(throttler.AdmitDataVolume(newDataVolume("next", pointer.P(limitedClass), "")).Admitted).To(BeTrue())
+			Expect(throttler.admitted).ToNot(HaveKey(preparation{key: controller.DataVolumeKey(dataVolume)}))
+		})
+
+		It("should count an admitted resize until the PVC is resizing in the cache", func() {
+			pvc := newPVC("pvc", pointer.P(limitedClass), "1Gi", "1Gi")
+			Expect(pvcIndexer.Add(pvc)).To(Succeed())
+			Expect(throttler.AdmitResize(pvc).Admitted).To(BeTrue())
+			Expect(throttler.admitted).To(HaveKey(preparation{key: controller.NamespacedKey(namespace, "pvc"), resize: true}))
+
+			pvc = newPVC("pvc", pointer.P(limitedClass), "2Gi", "1Gi")
+			Expect(pvcIndexer.Update(pvc)).To(Succeed())
+
+			Expect(throttler.AdmitResize(newPVC("next", pointer.P(limitedClass), "2Gi", "1Gi")).Admitted).To(BeFalse())
+			Expect(throttler.admitted).ToNot(HaveKey(preparation{key: controller.NamespacedKey(namespace, "pvc"), resize: true}))
+		})
+
+		It("should forget an admitted DataVolume which never shows up in the cache", func() {
+			dataVolume := newDataVolume("dv", pointer.P(limitedClass), "")
+			Expect(throttler.AdmitDataVolume(dataVolume).Admitted).To(BeTrue())
+
+			key := preparation{key: controller.DataVolumeKey(dataVolume)}
+			throttler.admitted[key] = admittedPreparation{storageClass: limitedClass, since: time.Now().Add(-admittedExpiry - time.Second)}
+
+			Expect(throttler.AdmitDataVolume(newDataVolume("next", pointer.P(limitedClass), "")).Admitted).To(BeTrue())
+			Expect(throttler.admitted).ToNot(HaveKey(key))
+		})
+
+		It("should free the slot of a released DataVolume", func() {
+			dataVolume := newDataVolume("dv", pointer.P(limitedClass), "")
+			Expect(throttler.AdmitDataVolume(dataVolume).Admitted).To(BeTrue())
+
+			throttler.ReleaseDataVolume(dataVolume)
+
+			Expect(throttler.AdmitDataVolume(newDataVolume("next", pointer.P(limitedClass), "")).Admitted).To(BeTrue())
+		})
+
+		It("should free the slot of a released resize", func() {
+			pvc := newPVC("pvc", pointer.P(limitedClass), "2Gi", "1Gi")
+			Expect(throttler.AdmitResize(pvc).Admitted).To(BeTrue())
+
+			throttler.ReleaseResize(pvc)
+
+			Expect(throttler.AdmitResize(newPVC("next", pointer.P(limitedClass), "2Gi", "1Gi")).Admitted).To(BeTrue())
+		})
+	})
+
+	Context("throttled volume preparations", func() {
+		BeforeEach(func() {
+			Expect(dataVolumeIndexer.Add(newDataVolume("busy", pointer.P(limitedClass), cdiv1.ImportInProgress))).To(Succeed())
+		})
+
+		It("should only report a throttled DataVolume as newly throttled on the first refusal", func() {
+			dataVolume := newDataVolume("dv", pointer.P(limitedClass), "")
+
+			Expect(throttler.AdmitDataVolume(dataVolume).NewlyThrottled).To(BeTrue())
+			Expect(throttler.AdmitDataVolume(dataVolume).NewlyThrottled).To(BeFalse())
+		})
+
+		It("should report a throttled DataVolume again once it was not retried for a while", func() {
+			dataVolume := newDataVolume("dv", pointer.P(limitedClass), "")
+			Expect(throttler.AdmitDataVolume(dataVolume).NewlyThrottled).To(BeTrue())
+
+			key := preparation{key: controller.DataVolumeKey(dataVolume)}
+			throttler.throttled[key] = time.Now().Add(-throttledExpiry - time.Second)
+			throttler.UpdateMetrics()
+			Expect(throttler.throttled).ToNot(HaveKey(key))
+
+			Expect(throttler.AdmitDataVolume(dataVolume).NewlyThrottled).To(BeTrue())
+		})
+
+		It("should forget a throttled DataVolume once it is admitted", func() {
+			dataVolume := newDataVolume("dv", pointer.P(limitedClass), "")
+			Expect(throttler.AdmitDataVolume(dataVolume).Admitted).To(BeFalse())
+
+			Expect(dataVolumeIndexer.Delete(newDataVolume("busy", pointer.P(limitedClass), cdiv1.ImportInProgress))).To(Succeed())
+
+			Expect(throttler.AdmitDataVolume(dataVolume).Admitted).To(BeTrue())
+			Expect(throttler.throttled).To(BeEmpty())
+		})
+	})
+})
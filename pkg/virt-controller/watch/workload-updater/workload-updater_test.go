@@ -511,6 +511,40 @@ var _ = Describe("Workload Updater", func() {
 
 			Expect(controller.doesRequireMigration(vmi)).To(BeTrue())
 		})
+
+		DescribeTable("VMI migration when SR-IOV hotplug is requested", func(status k8sv1.ConditionStatus, requiresMigration bool) {
+			vmi := libvmi.New(
+				libvmi.WithName("testvm"),
+				libvmistatus.WithStatus(
+					libvmistatus.New(libvmistatus.WithCondition(v1.VirtualMachineInstanceCondition{
+						Type:   v1.VirtualMachineInstanceSRIOVChange,
+						Status: status,
+					})),
+				),
+			)
+
+			Expect(controller.doesRequireMigration(vmi)).To(Equal(requiresMigration))
+		},
+			Entry("is needed when the VMI is migratable", k8sv1.ConditionTrue, true),
+			Entry("is not needed when the VMI is not migratable", k8sv1.ConditionFalse, false),
+		)
+
+		DescribeTable("VMI migration when host devices hotplug is requested", func(status k8sv1.ConditionStatus, requiresMigration bool) {
+			vmi := libvmi.New(
+				libvmi.WithName("testvm"),
+				libvmistatus.WithStatus(
+					libvmistatus.New(libvmistatus.WithCondition(v1.VirtualMachineInstanceCondition{
+						Type:   v1.VirtualMachineInstanceHostDevicesChange,
+						Status: status,
+					})),
+				),
+			)
+
+			Expect(controller.doesRequireMigration(vmi)).To(Equal(requiresMigration))
+		},
+			Entry("is needed when the devices can be hotplugged", k8sv1.ConditionTrue, true),
+			Entry("is not needed when the devices can not be hotplugged", k8sv1.ConditionFalse, false),
+		)
 	})
 
 	Context("Abort changes due to an automated live update", func() {
@@ -141,6 +141,15 @@ func (store *GhostRecordStore) findBySocket(socketFile string) (ghostRecord, boo
 	return ghostRecord{}, false
 }
 
+// Lookup returns the command socket and the UID of the VMI recorded when its domain was started on the node
+func (store *GhostRecordStore) Lookup(namespace string, name string) (socketFile string, uid types.UID, exists bool) {
+	store.Lock()
+	defer store.Unlock()
+
+	record, ok := store.cache[namespace+"/"+name]
+	return record.SocketFile, record.UID, ok
+}
+
 func (store *GhostRecordStore) Exists(namespace string, name string) bool {
 	store.Lock()
 	defer store.Unlock()
@@ -150,6 +150,19 @@ var _ = Describe("Domain informer", func() {
 			Expect(record.SocketFile).To(Equal("somefile2"))
 		})
 
+		It("Should look up the socket and UID of a ghost record", func() {
+			err := ghostRecordStore.Add("test1-namespace", "test1", "somefile1", "1234-1")
+			Expect(err).ToNot(HaveOccurred())
+
+			socketFile, uid, exists := ghostRecordStore.Lookup("test1-namespace", "test1")
+			Expect(exists).To(BeTrue())
+			Expect(socketFile).To(Equal("somefile1"))
+			Expect(string(uid)).To(Equal("1234-1"))
+
+			_, _, exists = ghostRecordStore.Lookup("test1-namespace", "does-not-exist")
+			Expect(exists).To(BeFalse())
+		})
+
 		It("Should delete ghost record from cache and disk", func() {
 			err := ghostRecordStore.Add("test1-namespace", "test1", "somefile1", "1234-1")
 			Expect(err).ToNot(HaveOccurred())
@@ -70,6 +70,30 @@ type Manager interface {
 
 	// Get list of threads attached to cgroup
 	GetCgroupThreads() ([]int, error)
+
+	// GetMemoryPeak returns the highest memory usage of the cgroup in bytes.
+	// If the kernel does not track the peak, the current usage is returned.
+	GetMemoryPeak() (uint64, error)
+
+	// SetCpuQuota limits the CPU time of a subcgroup to quota microseconds in every period
+	SetCpuQuota(subCgroup string, quota int64, period uint64) error
+
+	// SetPodCpuWeight sets the cgroup v2 CPU weight, between 1 and 10000, of the pod the cgroup belongs to.
+	// On cgroup v1 the weight is converted to CPU shares.
+	SetPodCpuWeight(weight uint64) error
+
+	// GetPodCpuPressure returns the share of the last 10 seconds in which tasks of the pod waited for CPU.
+	// It is only supported on cgroup v2.
+	GetPodCpuPressure() (float64, error)
+
+	// SetMemorySwapMax limits the swap usage of the cgroup in bytes. It is only supported on cgroup v2.
+	SetMemorySwapMax(bytes uint64) error
+
+	// SetMemoryLow protects bytes of the memory of the cgroup from reclaim. It is only supported on cgroup v2.
+	SetMemoryLow(bytes uint64) error
+
+	// GetMemorySwapUsage returns the swap usage of the cgroup in bytes. It is only supported on cgroup v2.
+	GetMemorySwapUsage() (uint64, error)
 }
 
 // This is here so that mockgen would create a mock out of it. That way we would have a mocked runc manager.
@@ -1,6 +1,9 @@
 package cgroup
 
 import (
+	"os"
+	"path/filepath"
+
 	"github.com/golang/mock/gomock"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -175,4 +178,116 @@ var _ = Describe("cgroup manager", func() {
 			},
 		),
 	)
+
+	Context("memory peak on v2", func() {
+		BeforeEach(func() {
+			v2DirPath = GinkgoT().TempDir()
+		})
+
+		It("should read memory.peak", func() {
+			Expect(os.WriteFile(filepath.Join(v2DirPath, "memory.peak"), []byte("2048\n"), 0600)).To(Succeed())
+			Expect(os.WriteFile(filepath.Join(v2DirPath, "memory.current"), []byte("1024\n"), 0600)).To(Succeed())
+
+			manager, err := newMockManager(V2)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(manager.GetMemoryPeak()).To(Equal(uint64(2048)))
+		})
+
+		It("should fall back to memory.current if memory.peak does not exist", func() {
+			Expect(os.WriteFile(filepath.Join(v2DirPath, "memory.current"), []byte("1024\n"), 0600)).To(Succeed())
+
+			manager, err := newMockManager(V2)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(manager.GetMemoryPeak()).To(Equal(uint64(1024)))
+		})
+	})
+
+	Context("pod cpu on v2", func() {
+		var podDirPath string
+
+		BeforeEach(func() {
+			podDirPath = GinkgoT().TempDir()
+			v2DirPath = filepath.Join(podDirPath, "cri-containerd-1234.scope", "container")
+			Expect(os.MkdirAll(v2DirPath, 0700)).To(Succeed())
+		})
+
+		It("should set cpu.weight of the pod", func() {
+			runc_cgroups.TestMode = true
+			DeferCleanup(func() { runc_cgroups.TestMode = false })
+
+			manager, err := newMockManager(V2)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(manager.SetPodCpuWeight(500)).To(Succeed())
+
+			content, err := os.ReadFile(filepath.Join(podDirPath, "cpu.weight"))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(string(content)).To(Equal("500"))
+		})
+
+		It("should read the cpu pressure of the pod", func() {
+			pressure := "some avg10=12.50 avg60=3.00 avg300=1.00 total=1234\nfull avg10=2.00 avg60=1.00 avg300=0.50 total=567\n"
+			Expect(os.WriteFile(filepath.Join(podDirPath, "cpu.pressure"), []byte(pressure), 0600)).To(Succeed())
+
+			manager, err := newMockManager(V2)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(manager.GetPodCpuPressure()).To(BeNumerically("~", 0.125, 0.0001))
+		})
+
+		It("should fail if the cpu pressure is not readable", func() {
+			manager, err := newMockManager(V2)
+			Expect(err).ShouldNot(HaveOccurred())
+			_, err = manager.GetPodCpuPressure()
+			Expect(err).Should(HaveOccurred())
+		})
+	})
+
+	Context("memory swap on v2", func() {
+		BeforeEach(func() {
+			v2DirPath = GinkgoT().TempDir()
+			runc_cgroups.TestMode = true
+			DeferCleanup(func() { runc_cgroups.TestMode = false })
+		})
+
+		It("should set memory.swap.max and memory.low", func() {
+			manager, err := newMockManager(V2)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(manager.SetMemorySwapMax(4096)).To(Succeed())
+			Expect(manager.SetMemoryLow(2048)).To(Succeed())
+
+			Expect(os.ReadFile(filepath.Join(v2DirPath, "memory.swap.max"))).To(BeEquivalentTo("4096"))
+			Expect(os.ReadFile(filepath.Join(v2DirPath, "memory.low"))).To(BeEquivalentTo("2048"))
+		})
+
+		It("should read memory.swap.current", func() {
+			Expect(os.WriteFile(filepath.Join(v2DirPath, "memory.swap.current"), []byte("1024\n"), 0600)).To(Succeed())
+
+			manager, err := newMockManager(V2)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(manager.GetMemorySwapUsage()).To(Equal(uint64(1024)))
+		})
+	})
+
+	It("should not support swap on v1", func() {
+		manager, err := newMockManager(V1)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(manager.SetMemorySwapMax(4096)).ToNot(Succeed())
+		Expect(manager.SetMemoryLow(2048)).ToNot(Succeed())
+		_, err = manager.GetMemorySwapUsage()
+		Expect(err).Should(HaveOccurred())
+	})
+
+	DescribeTable("should return the pod cgroup of a container cgroup", func(containerPath, expectedPath string) {
+		Expect(podCgroupPath(containerPath)).To(Equal(expectedPath))
+	},
+		Entry("with crun", "/sys/fs/cgroup/kubepods.slice/pod.slice/crio-1234.scope/container", "/sys/fs/cgroup/kubepods.slice/pod.slice"),
+		Entry("without crun", "/sys/fs/cgroup/kubepods.slice/pod.slice/crio-1234.scope", "/sys/fs/cgroup/kubepods.slice/pod.slice"),
+	)
+
+	DescribeTable("should convert cpu weights to cpu shares", func(weight, shares uint64) {
+		Expect(cpuWeightToShares(weight)).To(Equal(shares))
+	},
+		Entry("minimum", uint64(1), uint64(2)),
+		Entry("default", uint64(100), uint64(2597)),
+		Entry("maximum", uint64(10000), uint64(262144)),
+	)
 })
@@ -202,6 +202,51 @@ func (v *v1Manager) GetCgroupThreads() ([]int, error) {
 	return getCgroupThreadsHelper(v, "tasks")
 }
 
+func (v *v1Manager) GetMemoryPeak() (uint64, error) {
+	return getMemoryPeakHelper(v, "memory.max_usage_in_bytes", "memory.usage_in_bytes")
+}
+
 func (v *v1Manager) SetCpuSet(subcgroup string, cpulist []int) error {
 	return setCpuSetHelper(v, subcgroup, cpulist)
 }
+
+func (v *v1Manager) SetCpuQuota(subCgroup string, quota int64, period uint64) error {
+	cgroupPath, err := v.GetBasePathToHostSubsystem("cpu")
+	if err != nil {
+		return err
+	}
+	cgroupPath = filepath.Join(cgroupPath, subCgroup)
+
+	// The period has to be set first, the quota is validated against it
+	err = runc_cgroups.WriteFile(cgroupPath, "cpu.cfs_period_us", strconv.FormatUint(period, 10))
+	if err != nil {
+		return err
+	}
+
+	return runc_cgroups.WriteFile(cgroupPath, "cpu.cfs_quota_us", strconv.FormatInt(quota, 10))
+}
+
+func (v *v1Manager) SetPodCpuWeight(weight uint64) error {
+	cgroupPath, err := v.GetBasePathToHostSubsystem("cpu")
+	if err != nil {
+		return err
+	}
+
+	return runc_cgroups.WriteFile(podCgroupPath(cgroupPath), "cpu.shares", strconv.FormatUint(cpuWeightToShares(weight), 10))
+}
+
+func (v *v1Manager) GetPodCpuPressure() (float64, error) {
+	return 0, fmt.Errorf("cgroup %s does not track the CPU pressure of a pod", V1)
+}
+
+func (v *v1Manager) SetMemorySwapMax(_ uint64) error {
+	return fmt.Errorf("cgroup %s does not support limiting the swap usage", V1)
+}
+
+func (v *v1Manager) SetMemoryLow(_ uint64) error {
+	return fmt.Errorf("cgroup %s does not support protecting memory from reclaim", V1)
+}
+
+func (v *v1Manager) GetMemorySwapUsage() (uint64, error) {
+	return 0, fmt.Errorf("cgroup %s does not track the swap usage separately", V1)
+}
@@ -2,6 +2,7 @@ package cgroup
 
 import (
 	"errors"
+	"fmt"
 	"os"
 	"path"
 	"path/filepath"
@@ -159,6 +160,48 @@ func (v *v2Manager) GetCgroupThreads() ([]int, error) {
 	return getCgroupThreadsHelper(v, "cgroup.threads")
 }
 
+// GetMemoryPeak falls back to memory.current on kernels older than 5.19 which do not provide memory.peak
+func (v *v2Manager) GetMemoryPeak() (uint64, error) {
+	return getMemoryPeakHelper(v, "memory.peak", "memory.current")
+}
+
 func (v *v2Manager) SetCpuSet(subcgroup string, cpulist []int) error {
 	return setCpuSetHelper(v, subcgroup, cpulist)
 }
+
+func (v *v2Manager) SetCpuQuota(subCgroup string, quota int64, period uint64) error {
+	cgroupPath, err := v.GetBasePathToHostSubsystem("cpu")
+	if err != nil {
+		return err
+	}
+
+	return runc_cgroups.WriteFile(filepath.Join(cgroupPath, subCgroup), "cpu.max", fmt.Sprintf("%d %d", quota, period))
+}
+
+func (v *v2Manager) SetPodCpuWeight(weight uint64) error {
+	return runc_cgroups.WriteFile(podCgroupPath(v.dirPath), "cpu.weight", strconv.FormatUint(weight, 10))
+}
+
+func (v *v2Manager) GetPodCpuPressure() (float64, error) {
+	content, err := os.ReadFile(filepath.Join(podCgroupPath(v.dirPath), "cpu.pressure"))
+	if err != nil {
+		return 0, err
+	}
+	return parseCpuPressure(string(content))
+}
+
+func (v *v2Manager) SetMemorySwapMax(bytes uint64) error {
+	return runc_cgroups.WriteFile(v.dirPath, "memory.swap.max", strconv.FormatUint(bytes, 10))
+}
+
+func (v *v2Manager) SetMemoryLow(bytes uint64) error {
+	return runc_cgroups.WriteFile(v.dirPath, "memory.low", strconv.FormatUint(bytes, 10))
+}
+
+func (v *v2Manager) GetMemorySwapUsage() (uint64, error) {
+	content, err := os.ReadFile(filepath.Join(v.dirPath, "memory.swap.current"))
+	if err != nil {
+		return 0, err
+	}
+	return strconv.ParseUint(strings.TrimSpace(string(content)), 10, 64)
+}
@@ -113,6 +113,79 @@ func (_mr *_MockManagerRecorder) GetCgroupThreads() *gomock.Call {
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "GetCgroupThreads")
 }
 
+func (_m *MockManager) GetMemoryPeak() (uint64, error) {
+	ret := _m.ctrl.Call(_m, "GetMemoryPeak")
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockManagerRecorder) GetMemoryPeak() *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "GetMemoryPeak")
+}
+
+func (_m *MockManager) SetCpuQuota(subCgroup string, quota int64, period uint64) error {
+	ret := _m.ctrl.Call(_m, "SetCpuQuota", subCgroup, quota, period)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockManagerRecorder) SetCpuQuota(arg0, arg1, arg2 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "SetCpuQuota", arg0, arg1, arg2)
+}
+
+func (_m *MockManager) SetPodCpuWeight(weight uint64) error {
+	ret := _m.ctrl.Call(_m, "SetPodCpuWeight", weight)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockManagerRecorder) SetPodCpuWeight(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "SetPodCpuWeight", arg0)
+}
+
+func (_m *MockManager) GetPodCpuPressure() (float64, error) {
+	ret := _m.ctrl.Call(_m, "GetPodCpuPressure")
+	ret0, _ := ret[0].(float64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockManagerRecorder) GetPodCpuPressure() *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "GetPodCpuPressure")
+}
+
+func (_m *MockManager) SetMemorySwapMax(bytes uint64) error {
+	ret := _m.ctrl.Call(_m, "SetMemorySwapMax", bytes)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockManagerRecorder) SetMemorySwapMax(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "SetMemorySwapMax", arg0)
+}
+
+func (_m *MockManager) SetMemoryLow(bytes uint64) error {
+	ret := _m.ctrl.Call(_m, "SetMemoryLow", bytes)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockManagerRecorder) SetMemoryLow(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "SetMemoryLow", arg0)
+}
+
+func (_m *MockManager) GetMemorySwapUsage() (uint64, error) {
+	ret := _m.ctrl.Call(_m, "GetMemorySwapUsage")
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockManagerRecorder) GetMemorySwapUsage() *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "GetMemorySwapUsage")
+}
+
 // Mock of runcManager interface
 type MockruncManager struct {
 	ctrl     *gomock.Controller
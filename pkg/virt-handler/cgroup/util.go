@@ -322,6 +322,25 @@ func getCgroupThreadsHelper(manager Manager, fname string) ([]int, error) {
 	return tIds, nil
 }
 
+// getMemoryPeakHelper reads the peak memory usage of the cgroup from peakFile,
+// or the current usage from usageFile if the peak is not available.
+func getMemoryPeakHelper(manager Manager, peakFile, usageFile string) (uint64, error) {
+	subSysPath, err := manager.GetBasePathToHostSubsystem("memory")
+	if err != nil {
+		return 0, err
+	}
+
+	content, err := os.ReadFile(filepath.Join(subSysPath, peakFile))
+	if errors.Is(err, os.ErrNotExist) {
+		content, err = os.ReadFile(filepath.Join(subSysPath, usageFile))
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	return strconv.ParseUint(strings.TrimSpace(string(content)), 10, 64)
+}
+
 // set cpus "cpusList" on the allowed CPUs. Optionally on a subcgroup of
 // the pods control group (if subcgroup != nil).
 func setCpuSetHelper(manager Manager, subCgroup string, cpusList []int) error {
@@ -338,3 +357,38 @@ func setCpuSetHelper(manager Manager, subCgroup string, cpusList []int) error {
 
 	return runc_cgroups.WriteFile(subSysPath, "cpuset.cpus", wVal)
 }
+
+// podCgroupPath returns the cgroup of the pod a container cgroup belongs to.
+// crun based installations nest the container into a "container" cgroup below the scope.
+func podCgroupPath(containerPath string) string {
+	parentPath := filepath.Dir(containerPath)
+	if filepath.Base(containerPath) == "container" && strings.HasSuffix(parentPath, ".scope") {
+		return filepath.Dir(parentPath)
+	}
+	return parentPath
+}
+
+// parseCpuPressure returns the avg10 value of the "some" line of a cpu.pressure file as a ratio
+func parseCpuPressure(content string) (float64, error) {
+	for _, line := range strings.Split(content, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) == 0 || fields[0] != "some" {
+			continue
+		}
+		for _, field := range fields[1:] {
+			if value, found := strings.CutPrefix(field, "avg10="); found {
+				avg10, err := strconv.ParseFloat(value, 64)
+				if err != nil {
+					return 0, fmt.Errorf("invalid cpu pressure %q: %v", field, err)
+				}
+				return avg10 / 100, nil
+			}
+		}
+	}
+	return 0, fmt.Errorf("cpu pressure does not contain the avg10 value of some")
+}
+
+// cpuWeightToShares converts a cgroup v2 CPU weight to cgroup v1 CPU shares, the inverse of the conversion done by the kubelet
+func cpuWeightToShares(weight uint64) uint64 {
+	return 2 + ((weight-1)*262142)/9999
+}
@@ -0,0 +1,136 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package cpufairshare
+
+import (
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+
+	metrics "kubevirt.io/kubevirt/pkg/monitoring/metrics/virt-handler"
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
+	"kubevirt.io/kubevirt/pkg/virt-handler/cgroup"
+)
+
+// Interval is the interval in which the CPU weights of the launchers are applied and their CPU pressure is measured.
+// Applying the weights periodically also restores them after the kubelet updated the pod cgroup.
+const Interval = 30 * time.Second
+
+type cgroupManagerFunc func(vmi *v1.VirtualMachineInstance) (cgroup.Manager, error)
+
+// Weigher sets the CPU weight of the virt-launcher pods on the node to the weight of the CPU fair share tier
+// of their namespace and reports the CPU pressure of every tier.
+// Pods of a namespace which is removed from a tier keep the weight of the tier until they are recreated.
+type Weigher struct {
+	vmiStore      cache.Store
+	clusterConfig *virtconfig.ClusterConfig
+	host          string
+	cgroupManager cgroupManagerFunc
+}
+
+func NewWeigher(vmiStore cache.Store, clusterConfig *virtconfig.ClusterConfig, host string) *Weigher {
+	return &Weigher{
+		vmiStore:      vmiStore,
+		clusterConfig: clusterConfig,
+		host:          host,
+		cgroupManager: func(vmi *v1.VirtualMachineInstance) (cgroup.Manager, error) {
+			return cgroup.NewManagerFromVM(vmi, host)
+		},
+	}
+}
+
+func (w *Weigher) Run(interval time.Duration, stopCh <-chan struct{}) {
+	wait.Until(w.weigh, interval, stopCh)
+}
+
+type tierPressure struct {
+	vmis     int
+	measured int
+	pressure float64
+}
+
+func (w *Weigher) weigh() {
+	metrics.ResetCPUFairShare()
+
+	conf := w.clusterConfig.GetCPUFairShareConfiguration()
+	if conf == nil || len(conf.Tiers) == 0 {
+		return
+	}
+	tiers := tiersByNamespace(conf.Tiers)
+
+	pressures := map[string]*tierPressure{}
+	for _, tier := range conf.Tiers {
+		pressures[tier.Name] = &tierPressure{}
+	}
+
+	for _, obj := range w.vmiStore.List() {
+		vmi := obj.(*v1.VirtualMachineInstance)
+		tier, exists := tiers[vmi.Namespace]
+		if !exists || !vmi.IsRunning() || vmi.Status.NodeName != w.host {
+			continue
+		}
+		pressures[tier.Name].vmis++
+
+		manager, err := w.cgroupManager(vmi)
+		if err != nil {
+			log.Log.Object(vmi).Reason(err).V(4).Info("failed to get the cgroup manager of the launcher")
+			continue
+		}
+		if err := manager.SetPodCpuWeight(tier.Weight); err != nil {
+			log.Log.Object(vmi).Reason(err).Errorf("failed to set the CPU weight of tier %s", tier.Name)
+		}
+
+		pressure, err := manager.GetPodCpuPressure()
+		if err != nil {
+			log.Log.Object(vmi).Reason(err).V(4).Info("failed to read the CPU pressure of the launcher")
+			continue
+		}
+		pressures[tier.Name].pressure += pressure
+		pressures[tier.Name].measured++
+	}
+
+	for name, tier := range pressures {
+		metrics.SetCPUFairShareVMIs(w.host, name, tier.vmis)
+		if tier.measured > 0 {
+			metrics.SetCPUFairSharePressureRatio(w.host, name, tier.pressure/float64(tier.measured))
+		}
+	}
+}
+
+// tiersByNamespace maps the namespaces to their tier, the first tier wins if a namespace is part of several
+func tiersByNamespace(tiers []v1.CPUFairShareTier) map[string]v1.CPUFairShareTier {
+	byNamespace := map[string]v1.CPUFairShareTier{}
+	for _, tier := range tiers {
+		if tier.Weight < 1 || tier.Weight > 10000 {
+			log.Log.Warningf("Ignoring CPU fair share tier %s with invalid weight %d", tier.Name, tier.Weight)
+			continue
+		}
+		for _, namespace := range tier.Namespaces {
+			if _, exists := byNamespace[namespace]; !exists {
+				byNamespace[namespace] = tier
+			}
+		}
+	}
+	return byNamespace
+}
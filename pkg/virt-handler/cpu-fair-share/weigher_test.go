@@ -0,0 +1,161 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package cpufairshare
+
+import (
+	"errors"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	metrics "kubevirt.io/kubevirt/pkg/monitoring/metrics/virt-handler"
+	"kubevirt.io/kubevirt/pkg/testutils"
+	"kubevirt.io/kubevirt/pkg/virt-handler/cgroup"
+)
+
+var _ = Describe("CPU fair share weigher", func() {
+	const host = "node01"
+
+	var (
+		ctrl     *gomock.Controller
+		vmiStore cache.Store
+		managers map[string]*cgroup.MockManager
+	)
+
+	newVMI := func(namespace, name string) *v1.VirtualMachineInstance {
+		return &v1.VirtualMachineInstance{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+			Status: v1.VirtualMachineInstanceStatus{
+				Phase:    v1.Running,
+				NodeName: host,
+			},
+		}
+	}
+
+	addVMI := func(vmi *v1.VirtualMachineInstance) *cgroup.MockManager {
+		Expect(vmiStore.Add(vmi)).To(Succeed())
+		managers[vmi.Namespace+"/"+vmi.Name] = cgroup.NewMockManager(ctrl)
+		return managers[vmi.Namespace+"/"+vmi.Name]
+	}
+
+	newWeigher := func(conf *v1.CPUFairShareConfiguration) *Weigher {
+		clusterConfig, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{CPUFairShare: conf})
+		weigher := NewWeigher(vmiStore, clusterConfig, host)
+		weigher.cgroupManager = func(vmi *v1.VirtualMachineInstance) (cgroup.Manager, error) {
+			manager, exists := managers[vmi.Namespace+"/"+vmi.Name]
+			if !exists {
+				return nil, errors.New("no cgroup")
+			}
+			return manager, nil
+		}
+		return weigher
+	}
+
+	tiers := &v1.CPUFairShareConfiguration{
+		Tiers: []v1.CPUFairShareTier{
+			{Name: "gold", Weight: 400, Namespaces: []string{"gold-a", "gold-b"}},
+			{Name: "bronze", Weight: 50, Namespaces: []string{"bronze"}},
+		},
+	}
+
+	pressureRatio := func(tier string) float64 {
+		value, err := metrics.GetCPUFairSharePressureRatio(host, tier)
+		Expect(err).ToNot(HaveOccurred())
+		return value
+	}
+
+	vmis := func(tier string) float64 {
+		value, err := metrics.GetCPUFairShareVMIs(host, tier)
+		Expect(err).ToNot(HaveOccurred())
+		return value
+	}
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		vmiStore = cache.NewStore(cache.MetaNamespaceKeyFunc)
+		managers = map[string]*cgroup.MockManager{}
+	})
+
+	It("should set the weight of the tier and report the average pressure per tier", func() {
+		goldA := addVMI(newVMI("gold-a", "vmi"))
+		goldA.EXPECT().SetPodCpuWeight(uint64(400)).Return(nil)
+		goldA.EXPECT().GetPodCpuPressure().Return(0.1, nil)
+		goldB := addVMI(newVMI("gold-b", "vmi"))
+		goldB.EXPECT().SetPodCpuWeight(uint64(400)).Return(nil)
+		goldB.EXPECT().GetPodCpuPressure().Return(0.3, nil)
+		bronze := addVMI(newVMI("bronze", "vmi"))
+		bronze.EXPECT().SetPodCpuWeight(uint64(50)).Return(nil)
+		bronze.EXPECT().GetPodCpuPressure().Return(0.6, nil)
+
+		newWeigher(tiers).weigh()
+
+		Expect(vmis("gold")).To(Equal(2.0))
+		Expect(pressureRatio("gold")).To(BeNumerically("~", 0.2, 0.0001))
+		Expect(vmis("bronze")).To(Equal(1.0))
+		Expect(pressureRatio("bronze")).To(BeNumerically("~", 0.6, 0.0001))
+	})
+
+	It("should not touch VMIs without a tier, on other nodes or not running", func() {
+		addVMI(newVMI("untiered", "vmi"))
+		other := newVMI("gold-a", "other")
+		other.Status.NodeName = "node02"
+		addVMI(other)
+		pending := newVMI("gold-a", "pending")
+		pending.Status.Phase = v1.Pending
+		addVMI(pending)
+
+		newWeigher(tiers).weigh()
+
+		Expect(vmis("gold")).To(BeZero())
+	})
+
+	It("should still set the weight if the pressure can not be read", func() {
+		manager := addVMI(newVMI("bronze", "vmi"))
+		manager.EXPECT().SetPodCpuWeight(uint64(50)).Return(nil)
+		manager.EXPECT().GetPodCpuPressure().Return(0.0, errors.New("not supported"))
+
+		newWeigher(tiers).weigh()
+
+		Expect(vmis("bronze")).To(Equal(1.0))
+	})
+
+	It("should do nothing without tiers", func() {
+		addVMI(newVMI("gold-a", "vmi"))
+
+		newWeigher(nil).weigh()
+	})
+
+	It("should prefer the first tier of a namespace and ignore invalid weights", func() {
+		byNamespace := tiersByNamespace([]v1.CPUFairShareTier{
+			{Name: "invalid", Weight: 0, Namespaces: []string{"a"}},
+			{Name: "first", Weight: 100, Namespaces: []string{"a", "b"}},
+			{Name: "second", Weight: 200, Namespaces: []string{"b"}},
+		})
+		Expect(byNamespace).To(HaveLen(2))
+		Expect(byNamespace["a"].Name).To(Equal("first"))
+		Expect(byNamespace["b"].Name).To(Equal("first"))
+	})
+})
@@ -0,0 +1,207 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package cpupool
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/cache"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/checkpoint"
+	"kubevirt.io/kubevirt/pkg/util/hardware"
+)
+
+const (
+	allocationsCheckpointKey  = "allocations"
+	reservedCPUsCheckpointKey = "reserved-cpus"
+	maxCPUs                   = 50000
+)
+
+// Manager reserves the dedicated CPU pool of the node for the VMIs with dedicated CPUs: the CPUs of the pool
+// are removed from the cgroups of the burstable and best-effort pods, and each VMI with dedicated CPUs gets its
+// own CPUs of the pool allocated.
+// The allocations are checkpointed, so that they survive virt-handler restarts.
+type Manager struct {
+	lock        sync.Mutex
+	checkpoint  checkpoint.CheckpointManager
+	vmiStores   []cache.Store
+	pool        *v1.DedicatedCPUPool
+	poolCPUs    []int
+	allocations map[types.UID][]int
+	// reservedCPUs are the CPUs removed from the cgroups of the burstable and best-effort pods
+	reservedCPUs []int
+}
+
+func NewManager(stateDir string, vmiStores ...cache.Store) *Manager {
+	m := &Manager{
+		checkpoint:  checkpoint.NewSimpleCheckpointManager(stateDir),
+		vmiStores:   vmiStores,
+		allocations: map[types.UID][]int{},
+	}
+	if err := m.checkpoint.Get(allocationsCheckpointKey, &m.allocations); err != nil && !errors.Is(err, os.ErrNotExist) {
+		log.DefaultLogger().Reason(err).Error("failed to recover the dedicated CPU pool allocations")
+	}
+	if err := m.checkpoint.Get(reservedCPUsCheckpointKey, &m.reservedCPUs); err != nil && !errors.Is(err, os.ErrNotExist) {
+		log.DefaultLogger().Reason(err).Error("failed to recover the reserved dedicated CPU pool")
+	}
+	return m
+}
+
+// SetPool reserves the CPUs of the pool on the node, or gives the reserved CPUs back to the burstable and
+// best-effort pods if the pool is nil. It is called periodically, so that the cgroups of new pods get restricted
+// as well. The cgroups are left alone if no pool was reserved, in particular when the kubelet manages the cpusets
+// with its static CPU manager policy. The allocations of running VMIs are kept when the pool changes.
+func (m *Manager) SetPool(pool *v1.DedicatedCPUPool) error {
+	var poolCPUs []int
+	if pool != nil {
+		var err error
+		poolCPUs, err = hardware.ParseCPUSetLine(pool.CPUs, maxCPUs)
+		if err != nil {
+			return fmt.Errorf("failed to parse the CPUs of the dedicated CPU pool %s: %v", pool.Name, err)
+		}
+	}
+
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	if len(poolCPUs) == 0 && len(m.reservedCPUs) == 0 {
+		m.pool, m.poolCPUs = nil, nil
+		return nil
+	}
+	// the reservation is recorded before the cgroups are restricted, so that they get restored after a restart
+	if len(poolCPUs) > 0 {
+		if err := m.checkpoint.Store(reservedCPUsCheckpointKey, poolCPUs); err != nil {
+			return err
+		}
+	}
+	if err := restrictSharedCgroups(poolCPUs); err != nil {
+		return err
+	}
+	if len(poolCPUs) == 0 {
+		if err := m.checkpoint.Delete(reservedCPUsCheckpointKey); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return err
+		}
+	}
+	m.reservedCPUs = poolCPUs
+	if pool != nil && (m.pool == nil || m.pool.Name != pool.Name || m.pool.CPUs != pool.CPUs) {
+		log.DefaultLogger().Infof("Reserved the dedicated CPU pool %s with the CPUs %s", pool.Name, pool.CPUs)
+	}
+	m.pool, m.poolCPUs = pool, poolCPUs
+	return nil
+}
+
+// Allocate returns the CPUs of the pool allocated to a VMI with dedicated CPUs, allocating them if needed.
+// Nothing is returned for VMIs without dedicated CPUs, or if no pool is reserved on the node.
+func (m *Manager) Allocate(vmi *v1.VirtualMachineInstance) ([]int, error) {
+	if !vmi.IsCPUDedicated() {
+		return nil, nil
+	}
+
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	if cpus, exists := m.allocations[vmi.UID]; exists {
+		return cpus, nil
+	}
+	if m.pool == nil {
+		return nil, nil
+	}
+
+	m.releaseGoneVMIs()
+	taken := map[int]bool{}
+	for _, cpus := range m.allocations {
+		for _, cpu := range cpus {
+			taken[cpu] = true
+		}
+	}
+	needed := requiredCPUs(vmi)
+	var cpus []int
+	for _, cpu := range m.poolCPUs {
+		if len(cpus) == needed {
+			break
+		}
+		if !taken[cpu] {
+			cpus = append(cpus, cpu)
+		}
+	}
+	if len(cpus) < needed {
+		return nil, fmt.Errorf("the dedicated CPU pool %s has %d free CPUs, %d are required", m.pool.Name, len(cpus), needed)
+	}
+
+	m.allocations[vmi.UID] = cpus
+	if err := m.checkpoint.Store(allocationsCheckpointKey, m.allocations); err != nil {
+		delete(m.allocations, vmi.UID)
+		return nil, err
+	}
+	return cpus, nil
+}
+
+// Release releases the CPUs of the pool allocated to a VMI
+func (m *Manager) Release(vmiUID types.UID) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if _, exists := m.allocations[vmiUID]; !exists {
+		return nil
+	}
+	delete(m.allocations, vmiUID)
+	return m.checkpoint.Store(allocationsCheckpointKey, m.allocations)
+}
+
+// releaseGoneVMIs drops the allocations of VMIs which are neither running on the node nor migrating to it
+// anymore, in case virt-handler missed their cleanup.
+func (m *Manager) releaseGoneVMIs() {
+	known := map[types.UID]bool{}
+	for _, store := range m.vmiStores {
+		for _, obj := range store.List() {
+			if vmi, ok := obj.(*v1.VirtualMachineInstance); ok && !vmi.IsFinal() {
+				known[vmi.UID] = true
+			}
+		}
+	}
+	for uid := range m.allocations {
+		if !known[uid] {
+			delete(m.allocations, uid)
+		}
+	}
+}
+
+// requiredCPUs returns the number of CPUs the compute container of a VMI with dedicated CPUs is given
+func requiredCPUs(vmi *v1.VirtualMachineInstance) int {
+	cpu := vmi.Spec.Domain.CPU
+	needed := int(hardware.GetNumberOfVCPUs(cpu))
+	if cpu.IsolateEmulatorThread {
+		needed++
+		if _, exists := vmi.Annotations[v1.EmulatorThreadCompleteToEvenParity]; exists && needed%2 == 1 {
+			needed++
+		}
+	}
+	if cpu.ThreadPlacement != nil &&
+		(cpu.ThreadPlacement.IOThreads == v1.ThreadPlacementDedicated || cpu.ThreadPlacement.IOThreads == v1.ThreadPlacementSameNUMANode) {
+		needed++
+	}
+	return needed
+}
@@ -0,0 +1,188 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package cpupool
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	runc_cgroups "github.com/opencontainers/runc/libcontainer/cgroups"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/cache"
+
+	v1 "kubevirt.io/api/core/v1"
+)
+
+var _ = Describe("Dedicated CPU pool", func() {
+	var cgroupRoot string
+
+	writeCgroup := func(path, file, cpus string) {
+		Expect(os.MkdirAll(filepath.Join(cgroupRoot, path), 0755)).To(Succeed())
+		Expect(os.WriteFile(filepath.Join(cgroupRoot, path, file), []byte(cpus+"\n"), 0644)).To(Succeed())
+	}
+
+	readCgroup := func(path string) string {
+		content, err := os.ReadFile(filepath.Join(cgroupRoot, path, "cpuset.cpus"))
+		Expect(err).ToNot(HaveOccurred())
+		return strings.TrimSpace(string(content))
+	}
+
+	BeforeEach(func() {
+		originalPath, originalMode := hostCgroupPath, isCgroupV2
+		hostCgroupPath = GinkgoT().TempDir()
+		DeferCleanup(func() { hostCgroupPath, isCgroupV2 = originalPath, originalMode })
+		runc_cgroups.TestMode = true
+		DeferCleanup(func() { runc_cgroups.TestMode = false })
+	})
+
+	Context("with cgroup v2", func() {
+		BeforeEach(func() {
+			isCgroupV2 = func() bool { return true }
+			cgroupRoot = hostCgroupPath
+			writeCgroup("kubepods.slice", "cpuset.cpus.effective", "0-7")
+			writeCgroup("kubepods.slice/kubepods-burstable.slice", "cpuset.cpus", "")
+			writeCgroup("kubepods.slice/kubepods-besteffort.slice", "cpuset.cpus", "")
+		})
+
+		It("should remove the CPUs of the pool from the burstable and best-effort pods", func() {
+			Expect(restrictSharedCgroups([]int{4, 5, 6, 7})).To(Succeed())
+			Expect(readCgroup("kubepods.slice/kubepods-burstable.slice")).To(Equal("0,1,2,3"))
+			Expect(readCgroup("kubepods.slice/kubepods-besteffort.slice")).To(Equal("0,1,2,3"))
+		})
+
+		It("should give the CPUs back when the pool is removed", func() {
+			Expect(restrictSharedCgroups([]int{4, 5, 6, 7})).To(Succeed())
+			Expect(restrictSharedCgroups(nil)).To(Succeed())
+			Expect(readCgroup("kubepods.slice/kubepods-burstable.slice")).To(Equal("0,1,2,3,4,5,6,7"))
+		})
+
+		It("should leave the cgroups alone if no pool was reserved", func() {
+			manager := NewManager(GinkgoT().TempDir())
+			Expect(manager.SetPool(nil)).To(Succeed())
+			Expect(readCgroup("kubepods.slice/kubepods-burstable.slice")).To(BeEmpty())
+		})
+
+		It("should give the CPUs of a pool reserved before a restart back", func() {
+			stateDir := GinkgoT().TempDir()
+			Expect(NewManager(stateDir).SetPool(&v1.DedicatedCPUPool{Name: "vms", CPUs: "4-7"})).To(Succeed())
+			Expect(readCgroup("kubepods.slice/kubepods-burstable.slice")).To(Equal("0,1,2,3"))
+
+			Expect(NewManager(stateDir).SetPool(nil)).To(Succeed())
+			Expect(readCgroup("kubepods.slice/kubepods-burstable.slice")).To(Equal("0,1,2,3,4,5,6,7"))
+		})
+
+		It("should fail if the pool leaves no CPU to the pods", func() {
+			Expect(restrictSharedCgroups([]int{0, 1, 2, 3, 4, 5, 6, 7})).To(MatchError(ContainSubstring("leaves no CPU")))
+		})
+	})
+
+	Context("with cgroup v1", func() {
+		BeforeEach(func() {
+			isCgroupV2 = func() bool { return false }
+			cgroupRoot = filepath.Join(hostCgroupPath, "cpuset")
+			writeCgroup("kubepods", "cpuset.cpus", "0-7")
+			writeCgroup("kubepods/burstable", "cpuset.cpus", "0-7")
+			writeCgroup("kubepods/burstable/pod1", "cpuset.cpus", "0-7")
+			writeCgroup("kubepods/burstable/pod1/container1", "cpuset.cpus", "0-7")
+		})
+
+		It("should restrict the whole tree of the burstable pods", func() {
+			Expect(restrictSharedCgroups([]int{6, 7})).To(Succeed())
+			Expect(readCgroup("kubepods/burstable")).To(Equal("0,1,2,3,4,5"))
+			Expect(readCgroup("kubepods/burstable/pod1")).To(Equal("0,1,2,3,4,5"))
+			Expect(readCgroup("kubepods/burstable/pod1/container1")).To(Equal("0,1,2,3,4,5"))
+			Expect(readCgroup("kubepods")).To(Equal("0-7"))
+		})
+	})
+
+	Context("allocations", func() {
+		var (
+			stateDir string
+			vmiStore cache.Store
+			manager  *Manager
+		)
+
+		newVMI := func(uid string, cores uint32) *v1.VirtualMachineInstance {
+			vmi := &v1.VirtualMachineInstance{}
+			vmi.UID = types.UID(uid)
+			vmi.Name = uid
+			vmi.Spec.Domain.CPU = &v1.CPU{Cores: cores, DedicatedCPUPlacement: true}
+			Expect(vmiStore.Add(vmi)).To(Succeed())
+			return vmi
+		}
+
+		BeforeEach(func() {
+			isCgroupV2 = func() bool { return true }
+			stateDir = GinkgoT().TempDir()
+			vmiStore = cache.NewStore(cache.MetaNamespaceKeyFunc)
+			manager = NewManager(stateDir, vmiStore)
+			Expect(manager.SetPool(&v1.DedicatedCPUPool{Name: "vms", CPUs: "4-7"})).To(Succeed())
+		})
+
+		It("should allocate distinct CPUs of the pool to the VMIs", func() {
+			Expect(manager.Allocate(newVMI("vmi1", 2))).To(Equal([]int{4, 5}))
+			Expect(manager.Allocate(newVMI("vmi2", 2))).To(Equal([]int{6, 7}))
+		})
+
+		It("should keep the allocation of a VMI", func() {
+			vmi := newVMI("vmi1", 2)
+			Expect(manager.Allocate(vmi)).To(Equal([]int{4, 5}))
+			Expect(manager.Allocate(vmi)).To(Equal([]int{4, 5}))
+		})
+
+		It("should count the CPU of the isolated emulator thread", func() {
+			vmi := newVMI("vmi1", 2)
+			vmi.Spec.Domain.CPU.IsolateEmulatorThread = true
+			Expect(manager.Allocate(vmi)).To(Equal([]int{4, 5, 6}))
+		})
+
+		It("should not allocate CPUs to VMIs without dedicated CPUs", func() {
+			vmi := newVMI("vmi1", 2)
+			vmi.Spec.Domain.CPU.DedicatedCPUPlacement = false
+			Expect(manager.Allocate(vmi)).To(BeEmpty())
+		})
+
+		It("should fail if the pool has not enough free CPUs", func() {
+			Expect(manager.Allocate(newVMI("vmi1", 3))).To(HaveLen(3))
+			_, err := manager.Allocate(newVMI("vmi2", 2))
+			Expect(err).To(MatchError(ContainSubstring("has 1 free CPUs, 2 are required")))
+		})
+
+		It("should reuse the CPUs of released and gone VMIs", func() {
+			Expect(manager.Allocate(newVMI("vmi1", 2))).To(Equal([]int{4, 5}))
+			gone := newVMI("vmi2", 2)
+			Expect(manager.Allocate(gone)).To(Equal([]int{6, 7}))
+			Expect(vmiStore.Delete(gone)).To(Succeed())
+			Expect(manager.Release("vmi1")).To(Succeed())
+			Expect(manager.Allocate(newVMI("vmi3", 4))).To(Equal([]int{4, 5, 6, 7}))
+		})
+
+		It("should recover the allocations after a restart", func() {
+			Expect(manager.Allocate(newVMI("vmi1", 2))).To(Equal([]int{4, 5}))
+
+			manager = NewManager(stateDir, vmiStore)
+			Expect(manager.SetPool(&v1.DedicatedCPUPool{Name: "vms", CPUs: "4-7"})).To(Succeed())
+			Expect(manager.Allocate(newVMI("vmi2", 2))).To(Equal([]int{6, 7}))
+		})
+	})
+})
@@ -0,0 +1,170 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package cpupool
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	runc_cgroups "github.com/opencontainers/runc/libcontainer/cgroups"
+
+	"kubevirt.io/kubevirt/pkg/util/hardware"
+	cgroupconsts "kubevirt.io/kubevirt/pkg/virt-handler/cgroup/constants"
+)
+
+var (
+	hostCgroupPath = cgroupconsts.HostCgroupBasePath
+	isCgroupV2     = runc_cgroups.IsCgroup2UnifiedMode
+
+	// sharedQoSCgroups are the cgroups of the burstable and best-effort pods, with the systemd and the cgroupfs
+	// drivers of the kubelet. The guaranteed pods, which the VMIs with dedicated CPUs are, live next to them.
+	sharedQoSCgroups = []string{
+		"kubepods.slice/kubepods-burstable.slice",
+		"kubepods.slice/kubepods-besteffort.slice",
+		"kubepods/burstable",
+		"kubepods/besteffort",
+	}
+)
+
+// restrictSharedCgroups removes the CPUs of the pool from the cpusets of the burstable and best-effort pods.
+// They get all the CPUs of their parent cgroup back if the pool is empty.
+func restrictSharedCgroups(poolCPUs []int) error {
+	root := hostCgroupPath
+	if !isCgroupV2() {
+		root = filepath.Join(root, "cpuset")
+	}
+	reserved := map[int]bool{}
+	for _, cpu := range poolCPUs {
+		reserved[cpu] = true
+	}
+
+	for _, qosCgroup := range sharedQoSCgroups {
+		path := filepath.Join(root, qosCgroup)
+		if _, err := os.Stat(path); os.IsNotExist(err) {
+			continue
+		}
+		parentCPUs, err := readCPUs(filepath.Dir(path))
+		if err != nil {
+			return err
+		}
+		var shared []int
+		for _, cpu := range parentCPUs {
+			if !reserved[cpu] {
+				shared = append(shared, cpu)
+			}
+		}
+		if len(shared) == 0 {
+			return fmt.Errorf("the dedicated CPU pool leaves no CPU to the pods of %s", qosCgroup)
+		}
+
+		if isCgroupV2() {
+			// the descendants are restricted by the effective cpuset of the QoS cgroup
+			err = writeCPUs(path, shared)
+		} else {
+			err = restrictTree(path, shared)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// restrictTree sets the cpusets of a cgroup v1 tree. The cpuset of a cgroup has to be a subset of the one of
+// its parent, so the cpusets are first narrowed from the leaves up, then set from the root down.
+func restrictTree(path string, cpus []int) error {
+	allowed := map[int]bool{}
+	for _, cpu := range cpus {
+		allowed[cpu] = true
+	}
+	narrow := func(cgroupPath string) error {
+		current, err := readCPUs(cgroupPath)
+		if err != nil {
+			return err
+		}
+		var narrowed []int
+		for _, cpu := range current {
+			if allowed[cpu] {
+				narrowed = append(narrowed, cpu)
+			}
+		}
+		if len(narrowed) == 0 || len(narrowed) == len(current) {
+			return nil
+		}
+		return writeCPUs(cgroupPath, narrowed)
+	}
+	if err := walkCgroups(path, narrow, true); err != nil {
+		return err
+	}
+	return walkCgroups(path, func(cgroupPath string) error {
+		return writeCPUs(cgroupPath, cpus)
+	}, false)
+}
+
+func walkCgroups(path string, visit func(string) error, childrenFirst bool) error {
+	if !childrenFirst {
+		if err := visit(path); err != nil {
+			return err
+		}
+	}
+	entries, err := os.ReadDir(path)
+	if err != nil {
+		return err
+	}
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+		if err := walkCgroups(filepath.Join(path, entry.Name()), visit, childrenFirst); err != nil {
+			return err
+		}
+	}
+	if childrenFirst {
+		return visit(path)
+	}
+	return nil
+}
+
+func readCPUs(cgroupPath string) ([]int, error) {
+	fileName := "cpuset.cpus"
+	if isCgroupV2() {
+		fileName = "cpuset.cpus.effective"
+	}
+	content, err := os.ReadFile(filepath.Join(cgroupPath, fileName))
+	if err != nil {
+		return nil, err
+	}
+	cpus := strings.TrimSpace(string(content))
+	if cpus == "" {
+		return nil, nil
+	}
+	return hardware.ParseCPUSetLine(cpus, maxCPUs)
+}
+
+func writeCPUs(cgroupPath string, cpus []int) error {
+	cpuStrings := make([]string, 0, len(cpus))
+	for _, cpu := range cpus {
+		cpuStrings = append(cpuStrings, strconv.Itoa(cpu))
+	}
+	return runc_cgroups.WriteFile(cgroupPath, "cpuset.cpus", strings.Join(cpuStrings, ","))
+}
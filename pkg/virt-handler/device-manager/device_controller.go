@@ -21,15 +21,19 @@ package device_manager
 
 import (
 	"context"
+	"encoding/json"
 	"math"
 	"os"
 	"strings"
 	"sync"
 	"time"
 
+	k8sv1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 	k8scli "k8s.io/client-go/kubernetes/typed/core/v1"
 
+	v1 "kubevirt.io/api/core/v1"
 	"kubevirt.io/client-go/log"
 
 	"kubevirt.io/kubevirt/pkg/storage/reservation"
@@ -312,13 +316,57 @@ func (c *DeviceController) refreshMediatedDeviceTypes() bool {
 	externallyProvidedMdevMap := c.getExternallyProvidedMdevs()
 
 	nodeDesiredMdevTypesList := c.virtConfig.GetDesiredMDEVTypes(node)
-	requiresDevicePluginsUpdate, err = c.mdevTypesManager.updateMDEVTypesConfiguration(nodeDesiredMdevTypesList, externallyProvidedMdevMap)
+	nodeDesiredMdevTypeCounts := c.virtConfig.GetDesiredMDEVTypeCounts(node)
+	requiresDevicePluginsUpdate, err = c.mdevTypesManager.updateMDEVTypesConfiguration(nodeDesiredMdevTypesList, nodeDesiredMdevTypeCounts, externallyProvidedMdevMap)
 	if err != nil {
 		log.Log.Reason(err).Errorf("failed to configure the desired mdev types: %s", strings.Join(nodeDesiredMdevTypesList, ", "))
 	}
+
+	var selfConfiguredTypes []string
+	for _, mdevType := range nodeDesiredMdevTypesList {
+		if _, external := externallyProvidedMdevMap[mdevType]; !external {
+			selfConfiguredTypes = append(selfConfiguredTypes, mdevType)
+		}
+	}
+	drift := c.mdevTypesManager.configurationDrift(selfConfiguredTypes, nodeDesiredMdevTypeCounts)
+	if err := c.reportMediatedDevicesDrift(node, drift); err != nil {
+		log.Log.Reason(err).Errorf("failed to report the mdev types configuration drift on node %s", c.host)
+	}
 	return requiresDevicePluginsUpdate
 }
 
+// reportMediatedDevicesDrift annotates the node with the mdev types which are not configured as desired
+func (c *DeviceController) reportMediatedDevicesDrift(node *k8sv1.Node, drift map[string]mdevTypeDrift) error {
+	annotation := ""
+	if len(drift) > 0 {
+		driftJSON, err := json.Marshal(drift)
+		if err != nil {
+			return err
+		}
+		annotation = string(driftJSON)
+		log.Log.Warningf("mdev types are not configured as desired on node %s: %s", c.host, annotation)
+	}
+	if node.Annotations[v1.MediatedDevicesDriftAnnotation] == annotation {
+		return nil
+	}
+
+	// a null value removes the annotation
+	var value interface{}
+	if annotation != "" {
+		value = annotation
+	}
+	patchBytes, err := json.Marshal(map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"annotations": map[string]interface{}{v1.MediatedDevicesDriftAnnotation: value},
+		},
+	})
+	if err != nil {
+		return err
+	}
+	_, err = c.clientset.Nodes().Patch(context.Background(), c.host, types.MergePatchType, patchBytes, metav1.PatchOptions{})
+	return err
+}
+
 func (c *DeviceController) refreshPermittedDevices() {
 	logger := log.DefaultLogger()
 	debugDevAdded := []string{}
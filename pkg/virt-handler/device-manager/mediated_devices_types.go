@@ -38,6 +38,25 @@ type MDEVTypesManager struct {
 	availableMdevTypesMap   map[string][]string
 	unconfiguredParentsMap  map[string]struct{}
 	mdevsConfigurationMutex sync.Mutex
+	// remainingInstancesMap holds how many instances can still be created for the types with a desired count
+	remainingInstancesMap map[string]int
+}
+
+// mdevTypeDrift describes a desired mdev type which is not configured as desired on the node
+type mdevTypeDrift struct {
+	Desired    *int `json:"desired,omitempty"`
+	Configured int  `json:"configured"`
+}
+
+// configuredMDEV is an mdev instance existing on the node
+type configuredMDEV struct {
+	uuid     string
+	typeID   string
+	typeName string
+}
+
+func (d configuredMDEV) isOfType(mdevType string) bool {
+	return mdevType == d.typeID || (d.typeName != "" && mdevType == d.typeName)
 }
 
 func NewMDEVTypesManager() *MDEVTypesManager {
@@ -75,7 +94,7 @@ func (m *MDEVTypesManager) getAlreadyConfiguredMdevParents() (map[string]struct{
 	return configuredPCICards, nil
 }
 
-func (m *MDEVTypesManager) updateMDEVTypesConfiguration(desiredTypesList []string, externallyProvidedTypesMap map[string]struct{}) (bool, error) {
+func (m *MDEVTypesManager) updateMDEVTypesConfiguration(desiredTypesList []string, desiredCounts map[string]int, externallyProvidedTypesMap map[string]struct{}) (bool, error) {
 	m.mdevsConfigurationMutex.Lock()
 	defer m.mdevsConfigurationMutex.Unlock()
 
@@ -95,8 +114,9 @@ func (m *MDEVTypesManager) updateMDEVTypesConfiguration(desiredTypesList []strin
 	// the following will remove all configured types that have not been
 	// created by an external provider and are not in the desiredTypesMap
 	removeUndesiredMDEVs(typesToKeepMap)
+	removeExcessMDEVs(desiredCounts)
 
-	err := m.discoverConfigurableMDEVTypes(desiredTypesMap)
+	err := m.discoverConfigurableMDEVTypes(desiredTypesMap, desiredCounts)
 	if err != nil {
 		log.Log.Reason(err).Error("failed to discover which mdev types are available for configuration")
 		return false, err
@@ -109,10 +129,33 @@ func (m *MDEVTypesManager) updateMDEVTypesConfiguration(desiredTypesList []strin
 	return true, nil
 }
 
+// configurationDrift returns the desired mdev types which do not have the desired number of instances on the node,
+// or no instance at all when no number is desired
+func (m *MDEVTypesManager) configurationDrift(desiredTypesList []string, desiredCounts map[string]int) map[string]mdevTypeDrift {
+	m.mdevsConfigurationMutex.Lock()
+	defer m.mdevsConfigurationMutex.Unlock()
+
+	mdevs := listConfiguredMDEVs()
+	drift := map[string]mdevTypeDrift{}
+	for _, mdevType := range desiredTypesList {
+		configured := countMDEVsOfType(mdevs, mdevType)
+		count, limited := desiredCounts[mdevType]
+		switch {
+		case limited && configured != count:
+			drift[mdevType] = mdevTypeDrift{Desired: &count, Configured: configured}
+		case !limited && configured == 0:
+			drift[mdevType] = mdevTypeDrift{Configured: configured}
+		}
+	}
+	return drift
+}
+
 // discoverConfigurableMDEVTypes will create an intersection of desired and configurable available mdev types
-func (m *MDEVTypesManager) discoverConfigurableMDEVTypes(desiredTypesMap map[string]struct{}) error {
+func (m *MDEVTypesManager) discoverConfigurableMDEVTypes(desiredTypesMap map[string]struct{}, desiredCounts map[string]int) error {
 	// initialize unconfigured parents map
 	m.unconfiguredParentsMap = make(map[string]struct{})
+	m.remainingInstancesMap = make(map[string]int)
+	mdevs := listConfiguredMDEVs()
 
 	// a map of mdev providers that already have configured mdevs
 	existingMdevProviders, err := m.getAlreadyConfiguredMdevParents()
@@ -152,6 +195,18 @@ func (m *MDEVTypesManager) discoverConfigurableMDEVTypes(desiredTypesMap map[str
 		_, typeNameExist := desiredTypesMap[typeNameStr]
 		_, typeIDExist := desiredTypesMap[typeID]
 		if typeNameExist || typeIDExist {
+			count, limited := desiredCounts[typeNameStr]
+			if !limited {
+				count, limited = desiredCounts[typeID]
+			}
+			if limited {
+				remaining := count - countMDEVsOfType(mdevs, typeID)
+				if remaining <= 0 {
+					continue
+				}
+				m.remainingInstancesMap[typeID] = remaining
+			}
+
 			ar, exist := m.availableMdevTypesMap[typeID]
 			if !exist {
 				ar = []string{}
@@ -207,12 +262,16 @@ func (m *MDEVTypesManager) configureDesiredMDEVTypes() {
 				parent, remainingParents := m.getNextAvailableParentToConfigure(parents)
 				parents = remainingParents
 				if parent != "" {
-					if err := createMdevTypes(mdevTypeToConfigure, parent); err == nil {
+					if err := m.createMdevTypes(mdevTypeToConfigure, parent); err == nil {
 						m.availableMdevTypesMap[mdevTypeToConfigure] = remainingParents
 						// remove the already configured parent
 						delete(m.unconfiguredParentsMap, parent)
 					}
 				}
+				// the desired number of instances has been created, no more parents are needed
+				if remaining, limited := m.remainingInstancesMap[mdevTypeToConfigure]; limited && remaining <= 0 {
+					parents = nil
+				}
 			}
 			if len(parents) == 0 {
 				delete(m.availableMdevTypesMap, mdevTypeToConfigure)
@@ -227,19 +286,26 @@ func (m *MDEVTypesManager) configureDesiredMDEVTypes() {
 	}
 }
 
-func createMdevTypes(mdevType string, parentID string) error {
+func (m *MDEVTypesManager) createMdevTypes(mdevType string, parentID string) error {
 	instances, err := Handler.ReadMDEVAvailableInstances(mdevType, parentID)
 	if err != nil {
 		log.Log.Reason(err).Errorf("failed to create mdevs of type %s, failed to obtain number of instances", mdevType)
 		return err
 	}
-	// create mdevs for all available instances
+	remaining, limited := m.remainingInstancesMap[mdevType]
+	if limited && remaining < instances {
+		instances = remaining
+	}
+	// create mdevs for all available instances, or up to the desired number of instances
 	for i := 0; i < instances; i++ {
 		err := Handler.CreateMDEVType(mdevType, parentID)
 		if err != nil {
 			log.Log.Reason(err).Errorf("failed to create mdevs of type %s", mdevType)
 			return err
 		}
+		if limited {
+			m.remainingInstancesMap[mdevType]--
+		}
 	}
 	return nil
 }
@@ -286,3 +352,56 @@ func removeUndesiredMDEVs(desiredTypesMap map[string]struct{}) {
 		}
 	}
 }
+
+// removeExcessMDEVs removes the instances of the types with a desired count which exceed it
+func removeExcessMDEVs(desiredCounts map[string]int) {
+	if len(desiredCounts) == 0 {
+		return
+	}
+	mdevs := listConfiguredMDEVs()
+	for mdevType, count := range desiredCounts {
+		for _, mdev := range mdevs {
+			if !mdev.isOfType(mdevType) {
+				continue
+			}
+			if count > 0 {
+				count--
+				continue
+			}
+			if err := Handler.RemoveMDEVType(mdev.uuid); err != nil {
+				log.Log.Reason(err).Warningf("failed to remove excess mdev %s of type %s", mdev.uuid, mdevType)
+			}
+		}
+	}
+}
+
+func listConfiguredMDEVs() []configuredMDEV {
+	files, err := os.ReadDir(mdevBasePath)
+	if err != nil {
+		return nil
+	}
+
+	var mdevs []configuredMDEV
+	for _, file := range files {
+		originFile, err := os.Readlink(filepath.Join(mdevBasePath, file.Name(), "mdev_type"))
+		if err != nil {
+			continue
+		}
+		mdev := configuredMDEV{uuid: file.Name(), typeID: filepath.Base(originFile)}
+		if rawName, err := os.ReadFile(filepath.Join(mdevBasePath, file.Name(), "mdev_type/name")); err == nil {
+			mdev.typeName = strings.TrimSpace(strings.Replace(string(rawName), " ", "_", -1))
+		}
+		mdevs = append(mdevs, mdev)
+	}
+	return mdevs
+}
+
+func countMDEVsOfType(mdevs []configuredMDEV, mdevType string) int {
+	count := 0
+	for _, mdev := range mdevs {
+		if mdev.isOfType(mdevType) {
+			count++
+		}
+	}
+	return count
+}
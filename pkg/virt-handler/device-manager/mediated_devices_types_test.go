@@ -2,6 +2,7 @@ package device_manager
 
 import (
 	"bufio"
+	"context"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -290,7 +291,7 @@ var _ = Describe("Mediated Devices Types configuration", func() {
 			sc := scenario()
 			createTempMDEVSysfsStructure(sc.pciMDEVDevicesMap)
 			mdevManager := NewMDEVTypesManager()
-			_, err := mdevManager.updateMDEVTypesConfiguration(sc.desiredDevicesList, noExternallyConfiguredMdevs)
+			_, err := mdevManager.updateMDEVTypesConfiguration(sc.desiredDevicesList, nil, noExternallyConfiguredMdevs)
 			Expect(err).ToNot(HaveOccurred())
 
 			By("creating the desired mdev types")
@@ -321,7 +322,7 @@ var _ = Describe("Mediated Devices Types configuration", func() {
 			}
 
 			By("removing all created mdevs")
-			_, err = mdevManager.updateMDEVTypesConfiguration([]string{}, noExternallyConfiguredMdevs)
+			_, err = mdevManager.updateMDEVTypesConfiguration([]string{}, nil, noExternallyConfiguredMdevs)
 			Expect(err).ToNot(HaveOccurred())
 			files, err := os.ReadDir(fakeMdevDevicesPath)
 			Expect(err).ToNot(HaveOccurred())
@@ -332,6 +333,63 @@ var _ = Describe("Mediated Devices Types configuration", func() {
 			Entry("many types many cards", multipleTypeOneCards),
 			Entry("no cards support requeted types", noCardsSupportTypes),
 		)
+
+		It("should create and remove instances to match the desired count", func() {
+			sc := oneTypeManyCards()
+			createTempMDEVSysfsStructure(sc.pciMDEVDevicesMap)
+			mdevManager := NewMDEVTypesManager()
+
+			By("creating the instances over several cards")
+			desiredCounts := map[string]int{"nvidia-223": 10}
+			_, err := mdevManager.updateMDEVTypesConfiguration(sc.desiredDevicesList, desiredCounts, map[string]struct{}{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(countCreatedMdevs("nvidia-223")).To(Equal(10))
+			Expect(mdevManager.configurationDrift(sc.desiredDevicesList, desiredCounts)).To(BeEmpty())
+
+			By("removing the excess instances")
+			desiredCounts = map[string]int{"nvidia-223": 3}
+			_, err = mdevManager.updateMDEVTypesConfiguration(sc.desiredDevicesList, desiredCounts, map[string]struct{}{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(countCreatedMdevs("nvidia-223")).To(Equal(3))
+		})
+
+		It("should report the types which could not be configured as desired", func() {
+			sc := oneTypeManyCards()
+			createTempMDEVSysfsStructure(sc.pciMDEVDevicesMap)
+			mdevManager := NewMDEVTypesManager()
+
+			desiredTypes := []string{"nvidia-223", "i915-GVTg_V5_4"}
+			desiredCounts := map[string]int{"nvidia-223": 30}
+			_, err := mdevManager.updateMDEVTypesConfiguration(desiredTypes, desiredCounts, map[string]struct{}{})
+			Expect(err).ToNot(HaveOccurred())
+
+			desired := 30
+			Expect(mdevManager.configurationDrift(desiredTypes, desiredCounts)).To(Equal(map[string]mdevTypeDrift{
+				"nvidia-223":     {Desired: &desired, Configured: 24},
+				"i915-GVTg_V5_4": {Configured: 0},
+			}))
+		})
+
+		It("should annotate the node with the configuration drift", func() {
+			node := &kubev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "master"}}
+			_, err := clientTest.CoreV1().Nodes().Create(context.Background(), node, metav1.CreateOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			fakeClusterConfig, _, _ := testutils.NewFakeClusterConfigUsingKV(&v1.KubeVirt{})
+			deviceController := NewDeviceController("master", 100, "rw", nil, fakeClusterConfig, clientTest.CoreV1())
+
+			desired := 4
+			Expect(deviceController.reportMediatedDevicesDrift(node, map[string]mdevTypeDrift{
+				"nvidia-223": {Desired: &desired, Configured: 2},
+			})).To(Succeed())
+			node, err = clientTest.CoreV1().Nodes().Get(context.Background(), "master", metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(node.Annotations).To(HaveKeyWithValue(v1.MediatedDevicesDriftAnnotation, `{"nvidia-223":{"desired":4,"configured":2}}`))
+
+			Expect(deviceController.reportMediatedDevicesDrift(node, map[string]mdevTypeDrift{})).To(Succeed())
+			node, err = clientTest.CoreV1().Nodes().Get(context.Background(), "master", metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(node.Annotations).ToNot(HaveKey(v1.MediatedDevicesDriftAnnotation))
+		})
 		DescribeTable("should create and remove relevant mdev types matching a specific node", func(scenario func() *scenarioValues, late bool) {
 			sc := scenario()
 			clientTest = fake.NewSimpleClientset()
@@ -0,0 +1,177 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package emergencyapi
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/util/net/unixhttp"
+	cmdclient "kubevirt.io/kubevirt/pkg/virt-handler/cmd-client"
+)
+
+const (
+	// Only root on the node may talk to the API and read its token
+	socketMode = 0600
+	tokenMode  = 0600
+
+	tokenBytes = 32
+)
+
+// vmiLocator locates the virt-launcher command socket of a VMI from the records virt-handler keeps on the node
+type vmiLocator interface {
+	Lookup(namespace, name string) (socketFile string, uid types.UID, exists bool)
+}
+
+// Server lets root on the node pause, unpause or force-stop the VMIs running on the node on a local UNIX socket.
+// It only relies on the ghost records virt-handler checkpoints on the node when it starts a domain and on the
+// virt-launcher command sockets, so that it keeps working as a break-glass tool while the cluster API is
+// unreachable, even when virt-handler restarted in the meantime.
+// Every request needs the bearer token written to the token file when the server starts.
+type Server struct {
+	locator vmiLocator
+	token   string
+	// launcherClient connects to the virt-launcher command socket, it is replaced in the tests
+	launcherClient func(socketFile string) (cmdclient.LauncherClient, error)
+	// isUnresponsive tells if the virt-launcher command socket is gone, it is replaced in the tests
+	isUnresponsive func(socketFile string) bool
+}
+
+func NewServer(locator vmiLocator) *Server {
+	return &Server{
+		locator:        locator,
+		launcherClient: cmdclient.NewClient,
+		isUnresponsive: cmdclient.IsSocketUnresponsive,
+	}
+}
+
+// Run writes a new token to the token file and serves the API on the socket until the stop channel is closed
+func (s *Server) Run(socketPath, tokenPath string, stop <-chan struct{}) error {
+	token, err := generateToken()
+	if err != nil {
+		return err
+	}
+	if err := writeToken(tokenPath, token); err != nil {
+		return err
+	}
+	s.token = token
+
+	log.Log.Infof("serving the emergency API on %s", socketPath)
+	return unixhttp.Serve(socketPath, socketMode, s.Handler(), stop)
+}
+
+func (s *Server) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("PUT /v1/namespaces/{namespace}/virtualmachineinstances/{name}/pause", s.operationHandler("pause",
+		func(client cmdclient.LauncherClient, vmi *v1.VirtualMachineInstance) error {
+			return client.PauseVirtualMachine(vmi)
+		}))
+	mux.HandleFunc("PUT /v1/namespaces/{namespace}/virtualmachineinstances/{name}/unpause", s.operationHandler("unpause",
+		func(client cmdclient.LauncherClient, vmi *v1.VirtualMachineInstance) error {
+			return client.UnpauseVirtualMachine(vmi)
+		}))
+	mux.HandleFunc("PUT /v1/namespaces/{namespace}/virtualmachineinstances/{name}/forcestop", s.operationHandler("force-stop",
+		func(client cmdclient.LauncherClient, vmi *v1.VirtualMachineInstance) error {
+			return client.KillVirtualMachine(vmi)
+		}))
+	return s.authenticate(mux)
+}
+
+func (s *Server) authenticate(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		token, found := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if !found || s.token == "" || subtle.ConstantTimeCompare([]byte(token), []byte(s.token)) != 1 {
+			http.Error(w, "a valid bearer token is required", http.StatusUnauthorized)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+func (s *Server) operationHandler(operation string, run func(cmdclient.LauncherClient, *v1.VirtualMachineInstance) error) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		namespace, name := r.PathValue("namespace"), r.PathValue("name")
+		key := namespace + "/" + name
+		socketFile, uid, exists := s.locator.Lookup(namespace, name)
+		if !exists {
+			http.Error(w, "VirtualMachineInstance "+key+" is not running on this node", http.StatusNotFound)
+			return
+		}
+		if s.isUnresponsive(socketFile) {
+			http.Error(w, "VirtualMachineInstance "+key+" is not running", http.StatusConflict)
+			return
+		}
+
+		// virt-launcher only needs the identity of the VMI to find its domain
+		vmi := &v1.VirtualMachineInstance{
+			ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name, UID: uid},
+		}
+		client, err := s.launcherClient(socketFile)
+		if err != nil {
+			log.Log.Object(vmi).Reason(err).Errorf("failed to connect to the virt-launcher to %s the VMI", operation)
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		defer client.Close()
+
+		log.Log.Object(vmi).Warningf("%s of the VMI requested on the emergency API", operation)
+		if err := run(client, vmi); err != nil {
+			log.Log.Object(vmi).Reason(err).Errorf("failed to %s the VMI", operation)
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		w.WriteHeader(http.StatusAccepted)
+	}
+}
+
+func generateToken() (string, error) {
+	token := make([]byte, tokenBytes)
+	if _, err := rand.Read(token); err != nil {
+		return "", fmt.Errorf("failed to generate the emergency API token: %v", err)
+	}
+	return hex.EncodeToString(token), nil
+}
+
+// writeToken atomically replaces the token file, so that the token of a previous run is never left readable
+// with other permissions
+func writeToken(tokenPath, token string) error {
+	tmpPath := tokenPath + ".tmp"
+	if err := os.Remove(tmpPath); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("failed to remove the emergency API token: %v", err)
+	}
+	if err := os.WriteFile(tmpPath, []byte(token+"\n"), tokenMode); err != nil {
+		return fmt.Errorf("failed to write the emergency API token: %v", err)
+	}
+	if err := os.Rename(tmpPath, tokenPath); err != nil {
+		return fmt.Errorf("failed to write the emergency API token: %v", err)
+	}
+	return nil
+}
@@ -0,0 +1,203 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package emergencyapi
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/util/net/unixhttp"
+	cmdclient "kubevirt.io/kubevirt/pkg/virt-handler/cmd-client"
+)
+
+type fakeLocator map[string]string
+
+func (l fakeLocator) Lookup(namespace, name string) (string, types.UID, bool) {
+	socketFile, exists := l[namespace+"/"+name]
+	return socketFile, "testvmi-uid", exists
+}
+
+var _ = Describe("Emergency API", func() {
+	const token = "secret"
+
+	var (
+		locator     fakeLocator
+		unreachable map[string]bool
+		client      *cmdclient.MockLauncherClient
+		server      *Server
+	)
+
+	BeforeEach(func() {
+		ctrl := gomock.NewController(GinkgoT())
+		locator = fakeLocator{}
+		unreachable = map[string]bool{}
+		client = cmdclient.NewMockLauncherClient(ctrl)
+
+		server = NewServer(locator)
+		server.token = token
+		server.launcherClient = func(socketFile string) (cmdclient.LauncherClient, error) {
+			Expect(socketFile).To(Equal("/pods/launcher.sock"))
+			return client, nil
+		}
+		server.isUnresponsive = func(socketFile string) bool {
+			return unreachable[socketFile]
+		}
+	})
+
+	addVMI := func(running bool) *v1.VirtualMachineInstance {
+		locator["default/testvmi"] = "/pods/launcher.sock"
+		unreachable["/pods/launcher.sock"] = !running
+		return &v1.VirtualMachineInstance{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "testvmi", UID: "testvmi-uid"},
+		}
+	}
+
+	put := func(path, authorization string) *httptest.ResponseRecorder {
+		recorder := httptest.NewRecorder()
+		request := httptest.NewRequest(http.MethodPut, path, nil)
+		if authorization != "" {
+			request.Header.Set("Authorization", authorization)
+		}
+		server.Handler().ServeHTTP(recorder, request)
+		return recorder
+	}
+
+	DescribeTable("should run the operation on the VMI", func(operation string, expect func(vmi *v1.VirtualMachineInstance)) {
+		vmi := addVMI(true)
+		expect(vmi)
+		client.EXPECT().Close()
+
+		recorder := put("/v1/namespaces/default/virtualmachineinstances/testvmi/"+operation, "Bearer "+token)
+		Expect(recorder.Code).To(Equal(http.StatusAccepted))
+	},
+		Entry("pause", "pause", func(vmi *v1.VirtualMachineInstance) {
+			client.EXPECT().PauseVirtualMachine(vmi).Return(nil)
+		}),
+		Entry("unpause", "unpause", func(vmi *v1.VirtualMachineInstance) {
+			client.EXPECT().UnpauseVirtualMachine(vmi).Return(nil)
+		}),
+		Entry("force-stop", "forcestop", func(vmi *v1.VirtualMachineInstance) {
+			client.EXPECT().KillVirtualMachine(vmi).Return(nil)
+		}),
+	)
+
+	DescribeTable("should refuse requests without a valid token", func(authorization string) {
+		addVMI(true)
+
+		recorder := put("/v1/namespaces/default/virtualmachineinstances/testvmi/forcestop", authorization)
+		Expect(recorder.Code).To(Equal(http.StatusUnauthorized))
+	},
+		Entry("without authorization", ""),
+		Entry("with a wrong token", "Bearer wrong"),
+		Entry("with the token but another scheme", "Basic "+token),
+	)
+
+	It("should refuse all requests before a token is generated", func() {
+		addVMI(true)
+		server.token = ""
+
+		Expect(put("/v1/namespaces/default/virtualmachineinstances/testvmi/pause", "Bearer ").Code).To(Equal(http.StatusUnauthorized))
+	})
+
+	It("should fail for a VMI which is not on the node", func() {
+		Expect(put("/v1/namespaces/default/virtualmachineinstances/testvmi/pause", "Bearer "+token).Code).To(Equal(http.StatusNotFound))
+	})
+
+	It("should refuse operations on a VMI whose virt-launcher is gone", func() {
+		addVMI(false)
+
+		Expect(put("/v1/namespaces/default/virtualmachineinstances/testvmi/forcestop", "Bearer "+token).Code).To(Equal(http.StatusConflict))
+	})
+
+	It("should fail when the operation fails", func() {
+		vmi := addVMI(true)
+		client.EXPECT().PauseVirtualMachine(vmi).Return(fmt.Errorf("domain is not running"))
+		client.EXPECT().Close()
+
+		recorder := put("/v1/namespaces/default/virtualmachineinstances/testvmi/pause", "Bearer "+token)
+		Expect(recorder.Code).To(Equal(http.StatusInternalServerError))
+		Expect(recorder.Body.String()).To(ContainSubstring("domain is not running"))
+	})
+
+	It("should refuse requests other than PUT", func() {
+		addVMI(true)
+
+		recorder := httptest.NewRecorder()
+		request := httptest.NewRequest(http.MethodGet, "/v1/namespaces/default/virtualmachineinstances/testvmi/pause", nil)
+		request.Header.Set("Authorization", "Bearer "+token)
+		server.Handler().ServeHTTP(recorder, request)
+		Expect(recorder.Code).To(Equal(http.StatusMethodNotAllowed))
+	})
+
+	It("should serve the API on a socket with a token only root can access", func() {
+		vmi := addVMI(true)
+		client.EXPECT().KillVirtualMachine(vmi).Return(nil)
+		client.EXPECT().Close()
+		dir := GinkgoT().TempDir()
+		socketPath := filepath.Join(dir, "emergency-api.sock")
+		tokenPath := filepath.Join(dir, "emergency-api.token")
+		Expect(os.WriteFile(tokenPath, []byte("stale"), 0644)).To(Succeed())
+		stop := make(chan struct{})
+		done := make(chan error)
+		go func() {
+			done <- server.Run(socketPath, tokenPath, stop)
+		}()
+
+		var generated []byte
+		Eventually(func() string {
+			generated, _ = os.ReadFile(tokenPath)
+			return string(generated)
+		}).ShouldNot(Equal("stale"))
+		info, err := os.Stat(tokenPath)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(info.Mode().Perm()).To(Equal(os.FileMode(tokenMode)))
+
+		request, err := http.NewRequest(http.MethodPut, "http://localhost/v1/namespaces/default/virtualmachineinstances/testvmi/forcestop", nil)
+		Expect(err).ToNot(HaveOccurred())
+		request.Header.Set("Authorization", "Bearer "+strings.TrimSpace(string(generated)))
+		var response *http.Response
+		Eventually(func() error {
+			response, err = unixhttp.Client(socketPath).Do(request)
+			return err
+		}).Should(Succeed())
+		defer response.Body.Close()
+		Expect(response.StatusCode).To(Equal(http.StatusAccepted))
+
+		info, err = os.Stat(socketPath)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(info.Mode().Perm()).To(Equal(os.FileMode(socketMode)))
+
+		close(stop)
+		Eventually(done).Should(Receive(BeNil()))
+	})
+})
@@ -24,9 +24,15 @@ import (
 
 const failedSetCPUManagerLabelFmt = "failed to set a cpu manager label on host %s"
 
+// DedicatedCPUPool reserves the dedicated CPU pool of the node, if any
+type DedicatedCPUPool interface {
+	SetPool(pool *v1.DedicatedCPUPool) error
+}
+
 type HeartBeat struct {
 	clientset                 k8scli.CoreV1Interface
 	deviceManagerController   device_manager.DeviceControllerInterface
+	dedicatedCPUPool          DedicatedCPUPool
 	clusterConfig             *virtconfig.ClusterConfig
 	host                      string
 	cpuManagerPaths           []string
@@ -34,12 +40,13 @@ type HeartBeat struct {
 	devicePluginWaitTimeout   time.Duration
 }
 
-func NewHeartBeat(clientset k8scli.CoreV1Interface, deviceManager device_manager.DeviceControllerInterface, clusterConfig *virtconfig.ClusterConfig, host string) *HeartBeat {
+func NewHeartBeat(clientset k8scli.CoreV1Interface, deviceManager device_manager.DeviceControllerInterface, dedicatedCPUPool DedicatedCPUPool, clusterConfig *virtconfig.ClusterConfig, host string) *HeartBeat {
 	const cpuManagerOS3Path = virtutil.HostRootMount + "var/lib/origin/openshift.local.volumes/cpu_manager_state"
 	const cpuManagerPath = virtutil.KubeletRoot + "/cpu_manager_state"
 	return &HeartBeat{
 		clientset:               clientset,
 		deviceManagerController: deviceManager,
+		dedicatedCPUPool:        dedicatedCPUPool,
 		clusterConfig:           clusterConfig,
 		host:                    host,
 		// This is a temporary workaround until k8s bug #66525 is resolved
@@ -126,23 +133,43 @@ func (h *HeartBeat) do() {
 	}
 
 	var data []byte
-	// Label the node if cpu manager is running on it
+	node, err := h.clientset.Nodes().Get(context.Background(), h.host, metav1.GetOptions{})
+	if err != nil {
+		log.DefaultLogger().Reason(err).Errorf("Can't get node %s", h.host)
+		return
+	}
+	// Nodes in maintenance do not accept new VMIs until the maintenance is over
+	if _, inMaintenance := node.Annotations[v1.NodeMaintenanceAnnotation]; inMaintenance {
+		kubevirtSchedulable = "false"
+	}
+
+	// Label the node if cpu manager is running on it, or if virt-handler reserves a dedicated CPU pool on it
 	// This is a temporary workaround until k8s bug #66525 is resolved
 	cpuManagerEnabled := false
+	var dedicatedCPUPool *v1.DedicatedCPUPool
 	if h.clusterConfig.CPUManagerEnabled() {
 		cpuManagerEnabled = h.isCPUManagerEnabled(h.cpuManagerPaths)
+		if !cpuManagerEnabled {
+			dedicatedCPUPool = h.clusterConfig.GetDedicatedCPUPool(node)
+		}
 	}
-
-	node, err := h.clientset.Nodes().Get(context.Background(), h.host, metav1.GetOptions{})
-	if err != nil {
-		log.DefaultLogger().Reason(err).Errorf("Can't get node %s", h.host)
-		return
+	dedicatedCPUPoolLabel := "null"
+	if h.dedicatedCPUPool != nil {
+		if err := h.dedicatedCPUPool.SetPool(dedicatedCPUPool); err != nil {
+			log.DefaultLogger().Reason(err).Errorf("Can't reserve the dedicated CPU pool on host %s", h.host)
+			dedicatedCPUPool = nil
+		}
+		if dedicatedCPUPool != nil {
+			cpuManagerEnabled = true
+			dedicatedCPUPoolLabel = fmt.Sprintf("%q", dedicatedCPUPool.Name)
+		}
 	}
 	ksmEnabled, ksmEnabledByUs := handleKSM(node, h.clusterConfig)
 
-	data = []byte(fmt.Sprintf(`{"metadata": { "labels": {"%s": "%s", "%s": "%t", "%s": "%t"}, "annotations": {"%s": %s, "%s": "%t"}}}`,
+	data = []byte(fmt.Sprintf(`{"metadata": { "labels": {"%s": "%s", "%s": "%t", "%s": %s, "%s": "%t"}, "annotations": {"%s": %s, "%s": "%t"}}}`,
 		v1.NodeSchedulable, kubevirtSchedulable,
 		v1.CPUManager, cpuManagerEnabled,
+		v1.DedicatedCPUPoolLabel, dedicatedCPUPoolLabel,
 		v1.KSMEnabledLabel, ksmEnabled,
 		v1.VirtHandlerHeartbeat, string(now),
 		v1.KSMHandlerManagedAnnotation, ksmEnabledByUs,
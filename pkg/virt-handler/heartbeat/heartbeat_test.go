@@ -7,6 +7,7 @@ import (
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	gomegatypes "github.com/onsi/gomega/types"
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes/fake"
@@ -39,7 +40,7 @@ var _ = Describe("Heartbeat", func() {
 	})
 	Context("upon finishing", func() {
 		It("should set the node to not schedulable", func() {
-			heartbeat := NewHeartBeat(fakeClient.CoreV1(), deviceController(true), config(), "mynode")
+			heartbeat := NewHeartBeat(fakeClient.CoreV1(), deviceController(true), nil, config(), "mynode")
 			stopChan := make(chan struct{})
 			done := heartbeat.Run(30*time.Second, stopChan)
 			Eventually(func() map[string]string {
@@ -58,7 +59,7 @@ var _ = Describe("Heartbeat", func() {
 	})
 
 	DescribeTable("with cpumanager featuregate should set the node to", func(deviceController device_manager.DeviceControllerInterface, cpuManagerPaths []string, schedulable string, cpumanager string) {
-		heartbeat := NewHeartBeat(fakeClient.CoreV1(), deviceController, config(featuregate.CPUManager), "mynode")
+		heartbeat := NewHeartBeat(fakeClient.CoreV1(), deviceController, nil, config(featuregate.CPUManager), "mynode")
 		heartbeat.cpuManagerPaths = cpuManagerPaths
 		heartbeat.do()
 		node, err := fakeClient.CoreV1().Nodes().Get(context.Background(), "mynode", metav1.GetOptions{})
@@ -92,8 +93,40 @@ var _ = Describe("Heartbeat", func() {
 		),
 	)
 
+	DescribeTable("with a dedicated CPU pool selecting the node", func(cpuManagerPaths []string, reservedPool *virtv1.DedicatedCPUPool, cpumanager string, poolLabel gomegatypes.GomegaMatcher) {
+		pool := virtv1.DedicatedCPUPool{Name: "vms", CPUs: "4-7"}
+		clusterConfig, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&virtv1.KubeVirtConfiguration{
+			DeveloperConfiguration: &virtv1.DeveloperConfiguration{
+				FeatureGates: []string{featuregate.CPUManager},
+			},
+			DedicatedCPUPools: []virtv1.DedicatedCPUPool{pool},
+		})
+		cpuPool := &fakeDedicatedCPUPool{}
+		heartbeat := NewHeartBeat(fakeClient.CoreV1(), deviceController(true), cpuPool, clusterConfig, "mynode")
+		heartbeat.cpuManagerPaths = cpuManagerPaths
+		heartbeat.do()
+		node, err := fakeClient.CoreV1().Nodes().Get(context.Background(), "mynode", metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(node.Labels).To(HaveKeyWithValue(virtv1.CPUManager, cpumanager))
+		Expect(node.Labels).To(poolLabel)
+		Expect(cpuPool.pool).To(Equal(reservedPool))
+	},
+		Entry("should reserve the pool when the cpu manager policy is none",
+			[]string{cpu_manager_none_path},
+			&virtv1.DedicatedCPUPool{Name: "vms", CPUs: "4-7"},
+			"true",
+			HaveKeyWithValue(virtv1.DedicatedCPUPoolLabel, "vms"),
+		),
+		Entry("should not reserve the pool when the cpu manager policy is static",
+			[]string{cpu_manager_static_path},
+			nil,
+			"true",
+			Not(HaveKey(virtv1.DedicatedCPUPoolLabel)),
+		),
+	)
+
 	DescribeTable("without cpumanager featuregate should set the node to", func(deviceController device_manager.DeviceControllerInterface, schedulable string) {
-		heartbeat := NewHeartBeat(fakeClient.CoreV1(), deviceController, config(), "mynode")
+		heartbeat := NewHeartBeat(fakeClient.CoreV1(), deviceController, nil, config(), "mynode")
 		heartbeat.do()
 		node, err := fakeClient.CoreV1().Nodes().Get(context.Background(), "mynode", metav1.GetOptions{})
 		Expect(err).ToNot(HaveOccurred())
@@ -110,8 +143,18 @@ var _ = Describe("Heartbeat", func() {
 		),
 	)
 
+	It("should set the node in maintenance to not schedulable", func() {
+		node.Annotations = map[string]string{virtv1.NodeMaintenanceAnnotation: "kernel upgrade"}
+		fakeClient = fake.NewSimpleClientset(node)
+		heartbeat := NewHeartBeat(fakeClient.CoreV1(), deviceController(true), nil, config(), "mynode")
+		heartbeat.do()
+		node, err := fakeClient.CoreV1().Nodes().Get(context.Background(), "mynode", metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(node.Labels).To(HaveKeyWithValue(virtv1.NodeSchedulable, "false"))
+	})
+
 	DescribeTable("without deviceplugin and", func(deviceController device_manager.DeviceControllerInterface, initiallySchedulable string, finallySchedulable string) {
-		heartbeat := NewHeartBeat(fakeClient.CoreV1(), deviceController, config(), "mynode")
+		heartbeat := NewHeartBeat(fakeClient.CoreV1(), deviceController, nil, config(), "mynode")
 		heartbeat.devicePluginWaitTimeout = 2 * time.Second
 		heartbeat.devicePluginPollIntervall = 10 * time.Millisecond
 		stopChan := make(chan struct{})
@@ -174,6 +217,15 @@ func (f *fakeDeviceController) RefreshMediatedDeviceTypes() {
 	return
 }
 
+type fakeDedicatedCPUPool struct {
+	pool *virtv1.DedicatedCPUPool
+}
+
+func (f *fakeDedicatedCPUPool) SetPool(pool *virtv1.DedicatedCPUPool) error {
+	f.pool = pool
+	return nil
+}
+
 func config(featuregates ...string) *virtconfig.ClusterConfig {
 	cfg := &virtv1.KubeVirtConfiguration{
 		DeveloperConfiguration: &virtv1.DeveloperConfiguration{
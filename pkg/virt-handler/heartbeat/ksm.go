@@ -34,6 +34,7 @@ import (
 	kubevirtv1 "kubevirt.io/api/core/v1"
 	"kubevirt.io/client-go/log"
 
+	metrics "kubevirt.io/kubevirt/pkg/monitoring/metrics/virt-handler"
 	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
 )
 
@@ -58,6 +59,8 @@ var (
 	ksmSleepPath = ksmBasePath + "sleep_millisecs"
 	ksmPagesPath = ksmBasePath + "pages_to_scan"
 
+	ksmPagesSharingPath = ksmBasePath + "pages_sharing"
+
 	memInfoPath = "/proc/meminfo"
 )
 
@@ -115,14 +118,24 @@ func getKsmPages() (int, error) {
 }
 
 // Inspired from https://github.com/oVirt/mom/blob/master/doc/ksm.rules
-func calculateNewRunSleepAndPages(node *v1.Node, running bool) (ksmState, error) {
-	pagesBoost := getIntParam(node, kubevirtv1.KSMPagesBoostOverride, pagesBoostDefault, 0, math.MaxInt)
-	pagesDecay := getIntParam(node, kubevirtv1.KSMPagesDecayOverride, pagesDecayDefault, math.MinInt, 0)
-	nPagesMin := getIntParam(node, kubevirtv1.KSMPagesMinOverride, nPagesMinDefault, 0, math.MaxInt)
-	nPagesMax := getIntParam(node, kubevirtv1.KSMPagesMaxOverride, nPagesMaxDefault, nPagesMin, math.MaxInt)
-	nPagesInit := getIntParam(node, kubevirtv1.KSMPagesInitOverride, nPagesInitDefault, nPagesMin, nPagesMax)
-	sleepMsBaseline := uint64(getIntParam(node, kubevirtv1.KSMSleepMsBaselineOverride, sleepMsBaselineDefault, 1, math.MaxInt))
-	freePercent := getFloatParam(node, kubevirtv1.KSMFreePercentOverride, freePercentDefault, 0, 1)
+// The parameters are taken from the override annotations of the node, then from the tuning of the KubeVirt CR.
+func calculateNewRunSleepAndPages(node *v1.Node, tuning *kubevirtv1.KSMTuning, running bool) (ksmState, error) {
+	if tuning == nil {
+		tuning = &kubevirtv1.KSMTuning{}
+	}
+	pagesBoost := getIntParam(node, kubevirtv1.KSMPagesBoostOverride,
+		getTunedIntParam(tuning.PagesBoost, "pagesBoost", pagesBoostDefault, 0, math.MaxInt), 0, math.MaxInt)
+	pagesDecay := getIntParam(node, kubevirtv1.KSMPagesDecayOverride,
+		getTunedIntParam(tuning.PagesDecay, "pagesDecay", pagesDecayDefault, math.MinInt, 0), math.MinInt, 0)
+	nPagesMin := getIntParam(node, kubevirtv1.KSMPagesMinOverride,
+		getTunedIntParam(tuning.PagesMin, "pagesMin", nPagesMinDefault, 0, math.MaxInt), 0, math.MaxInt)
+	nPagesMax := getIntParam(node, kubevirtv1.KSMPagesMaxOverride,
+		getTunedIntParam(tuning.PagesMax, "pagesMax", nPagesMaxDefault, nPagesMin, math.MaxInt), nPagesMin, math.MaxInt)
+	nPagesInit := getIntParam(node, kubevirtv1.KSMPagesInitOverride,
+		getTunedIntParam(tuning.PagesInit, "pagesInit", nPagesInitDefault, nPagesMin, nPagesMax), nPagesMin, nPagesMax)
+	sleepMsBaseline := uint64(getIntParam(node, kubevirtv1.KSMSleepMsBaselineOverride,
+		getTunedIntParam(tuning.SleepMsBaseline, "sleepMsBaseline", sleepMsBaselineDefault, 1, math.MaxInt), 1, math.MaxInt))
+	freePercent := getFloatParam(node, kubevirtv1.KSMFreePercentOverride, getTunedFreePercent(tuning.FreePercent), 0, 1)
 	ksm := ksmState{running: running}
 	total, available, err := getTotalAndAvailableMem()
 	if err != nil {
@@ -216,6 +229,22 @@ func boundCheck[T int | float32](value, defaultValue, lowerBound, upperBound T,
 	return value
 }
 
+// getTunedIntParam returns the value of a parameter of the KSM tuning if it is set and in bounds, the default otherwise
+func getTunedIntParam(tuned *int32, param string, defaultValue, lowerBound, upperBound int) int {
+	if tuned == nil {
+		return defaultValue
+	}
+	return boundCheck(int(*tuned), defaultValue, lowerBound, upperBound, fmt.Sprintf("%s tuning value out of bounds", param))
+}
+
+// getTunedFreePercent converts the free percentage of the KSM tuning into the fraction of free memory
+func getTunedFreePercent(tuned *int32) float32 {
+	if tuned == nil {
+		return freePercentDefault
+	}
+	return boundCheck(float32(*tuned)/100, freePercentDefault, 0, 1, "freePercent tuning value out of bounds")
+}
+
 func getFloatParam(node *v1.Node, param string, defaultValue, lowerBound, upperBound float32) float32 {
 	override, ok := node.Annotations[param]
 	if !ok {
@@ -248,11 +277,13 @@ func getIntParam(node *v1.Node, param string, defaultValue, lowerBound, upperBou
 // will set the outcome value to the n.KSM struct
 // If the node labels match the selector terms, the ksm will be enabled.
 // Empty Selector will enable ksm for every node
+// The ksm policy label of the node, if set, enables or disables the ksm whatever the selector is.
 func handleKSM(node *v1.Node, clusterConfig *virtconfig.ClusterConfig) (ksmLabelValue, ksmEnabledByUs bool) {
 	available, enabled := loadKSM()
 	if !available {
 		return false, false
 	}
+	reportKSMSavedBytes(node.Name)
 
 	ksmConfig := clusterConfig.GetKSMConfiguration()
 	if ksmConfig == nil {
@@ -263,20 +294,20 @@ func handleKSM(node *v1.Node, clusterConfig *virtconfig.ClusterConfig) (ksmLabel
 		return false, false
 	}
 
-	selector, err := metav1.LabelSelectorAsSelector(ksmConfig.NodeLabelSelector)
+	selected, err := isNodeSelectedForKSM(node, ksmConfig)
 	if err != nil {
 		log.DefaultLogger().Errorf("An error occurred while converting the ksm selector: %s", err)
 		return false, false
 	}
 
-	if !selector.Matches(labels.Set(node.ObjectMeta.Labels)) {
+	if !selected {
 		if enabled {
 			disableKSM(node)
 		}
 
 		return false, false
 	}
-	ksm, err := calculateNewRunSleepAndPages(node, enabled)
+	ksm, err := calculateNewRunSleepAndPages(node, ksmConfig.Tuning, enabled)
 	if err != nil {
 		log.DefaultLogger().Reason(err).Errorf("An error occurred while calculating the new KSM values")
 		return true, false
@@ -291,6 +322,39 @@ func handleKSM(node *v1.Node, clusterConfig *virtconfig.ClusterConfig) (ksmLabel
 	return true, ksm.running
 }
 
+func isNodeSelectedForKSM(node *v1.Node, ksmConfig *kubevirtv1.KSMConfiguration) (bool, error) {
+	switch policy := node.Labels[kubevirtv1.KSMPolicyLabel]; policy {
+	case kubevirtv1.KSMPolicyEnabled:
+		return true, nil
+	case kubevirtv1.KSMPolicyDisabled:
+		return false, nil
+	case "":
+	default:
+		log.DefaultLogger().Warningf("Ignoring the unknown ksm policy %q of node %s", policy, node.Name)
+	}
+
+	selector, err := metav1.LabelSelectorAsSelector(ksmConfig.NodeLabelSelector)
+	if err != nil {
+		return false, err
+	}
+	return selector.Matches(labels.Set(node.ObjectMeta.Labels)), nil
+}
+
+// reportKSMSavedBytes reports the memory saved by the pages KSM merged, whether virt-handler manages KSM or not
+func reportKSMSavedBytes(nodeName string) {
+	pagesSharing, err := os.ReadFile(ksmPagesSharingPath)
+	if err != nil {
+		log.DefaultLogger().Reason(err).V(4).Info("Unable to read the number of pages shared by KSM")
+		return
+	}
+	pages, err := strconv.ParseUint(strings.TrimSpace(string(pagesSharing)), 10, 64)
+	if err != nil {
+		log.DefaultLogger().Reason(err).Error("Unable to parse the number of pages shared by KSM")
+		return
+	}
+	metrics.SetKSMSavedBytes(nodeName, float64(pages*uint64(os.Getpagesize())))
+}
+
 func disableKSM(node *v1.Node) {
 	if value, found := node.GetAnnotations()[kubevirtv1.KSMHandlerManagedAnnotation]; found && value == "true" {
 		err := os.WriteFile(ksmRunPath, []byte("0\n"), 0644)
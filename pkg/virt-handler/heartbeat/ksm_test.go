@@ -37,6 +37,8 @@ import (
 	"k8s.io/client-go/kubernetes/fake"
 	kubevirtv1 "kubevirt.io/api/core/v1"
 
+	metrics "kubevirt.io/kubevirt/pkg/monitoring/metrics/virt-handler"
+	"kubevirt.io/kubevirt/pkg/pointer"
 	"kubevirt.io/kubevirt/pkg/testutils"
 	"kubevirt.io/kubevirt/pkg/virt-config/featuregate"
 
@@ -63,6 +65,8 @@ var _ = Describe("KSM", func() {
 		Expect(err).NotTo(HaveOccurred())
 		err = os.WriteFile(filepath.Join(fakeSysKSMDir, "pages_to_scan"), []byte("100\n"), 0644)
 		Expect(err).NotTo(HaveOccurred())
+		err = os.WriteFile(filepath.Join(fakeSysKSMDir, "pages_sharing"), []byte("1000\n"), 0644)
+		Expect(err).NotTo(HaveOccurred())
 	}
 
 	createCustomMemInfo := func(pressure bool) {
@@ -109,6 +113,7 @@ var _ = Describe("KSM", func() {
 		ksmRunPath = ksmBasePath + "run"
 		ksmSleepPath = ksmBasePath + "sleep_millisecs"
 		ksmPagesPath = ksmBasePath + "pages_to_scan"
+		ksmPagesSharingPath = ksmBasePath + "pages_sharing"
 	})
 
 	AfterEach(func() {
@@ -124,7 +129,7 @@ var _ = Describe("KSM", func() {
 			}
 			fakeClient := fake.NewSimpleClientset(node)
 			createCustomMemInfo(false)
-			heartbeat := NewHeartBeat(fakeClient.CoreV1(), deviceController(true), config(featuregate.CPUManager), "mynode")
+			heartbeat := NewHeartBeat(fakeClient.CoreV1(), deviceController(true), nil, config(featuregate.CPUManager), "mynode")
 
 			heartbeat.do()
 			node, err := fakeClient.CoreV1().Nodes().Get(context.TODO(), "mynode", metav1.GetOptions{})
@@ -139,6 +144,20 @@ var _ = Describe("KSM", func() {
 			Expect(err).ToNot(HaveOccurred())
 			Expect(node.Labels).To(HaveKeyWithValue(kubevirtv1.KSMEnabledLabel, "false"))
 		})
+
+		It("should report the memory saved by KSM", func() {
+			node := &v1.Node{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "mynode",
+				},
+			}
+			fakeClient := fake.NewSimpleClientset(node)
+			createCustomMemInfo(false)
+			heartbeat := NewHeartBeat(fakeClient.CoreV1(), deviceController(true), nil, config(), "mynode")
+
+			heartbeat.do()
+			Expect(metrics.GetKSMSavedBytes("mynode")).To(BeEquivalentTo(1000 * os.Getpagesize()))
+		})
 	})
 
 	When("ksmConfiguration is provided,", func() {
@@ -182,7 +201,7 @@ var _ = Describe("KSM", func() {
 				},
 			}
 			fakeClient := fake.NewSimpleClientset(node)
-			heartbeat := NewHeartBeat(fakeClient.CoreV1(), deviceController(true), clusterConfig, "mynode")
+			heartbeat := NewHeartBeat(fakeClient.CoreV1(), deviceController(true), nil, clusterConfig, "mynode")
 
 			heartbeat.do()
 
@@ -212,7 +231,7 @@ var _ = Describe("KSM", func() {
 			err := os.WriteFile(filepath.Join(fakeSysKSMDir, "run"), []byte(initialKsmValue), 0644)
 			Expect(err).ToNot(HaveOccurred())
 			createCustomMemInfo(true)
-			heartbeat := NewHeartBeat(fakeClient.CoreV1(), deviceController(true), clusterConfig, "mynode")
+			heartbeat := NewHeartBeat(fakeClient.CoreV1(), deviceController(true), nil, clusterConfig, "mynode")
 
 			heartbeat.do()
 
@@ -245,6 +264,16 @@ var _ = Describe("KSM", func() {
 				HaveKeyWithValue(kubevirtv1.KSMEnabledLabel, "false"), HaveKeyWithValue(kubevirtv1.KSMHandlerManagedAnnotation, "false"),
 				"0",
 			),
+			Entry("enable ksm if the node has the enabled ksm policy label, even if its labels do not match ksmConfiguration.nodeLabelSelector",
+				"0\n", nil, map[string]string{"test_label": "false", kubevirtv1.KSMPolicyLabel: kubevirtv1.KSMPolicyEnabled}, make(map[string]string),
+				HaveKeyWithValue(kubevirtv1.KSMEnabledLabel, "true"), HaveKeyWithValue(kubevirtv1.KSMHandlerManagedAnnotation, "true"),
+				"1",
+			),
+			Entry("disable ksm if the node has the disabled ksm policy label, even if its labels match ksmConfiguration.nodeLabelSelector",
+				"1\n", nil, map[string]string{"test_label": "true", kubevirtv1.KSMPolicyLabel: kubevirtv1.KSMPolicyDisabled}, map[string]string{kubevirtv1.KSMHandlerManagedAnnotation: "true"},
+				HaveKeyWithValue(kubevirtv1.KSMEnabledLabel, "false"), HaveKeyWithValue(kubevirtv1.KSMHandlerManagedAnnotation, "false"),
+				"0",
+			),
 		)
 
 		It("should adapt to memory pressure", func() {
@@ -264,7 +293,7 @@ var _ = Describe("KSM", func() {
 			}
 			fakeClient := fake.NewSimpleClientset(node)
 			createCustomMemInfo(false)
-			heartbeat := NewHeartBeat(fakeClient.CoreV1(), deviceController(true), clusterConfig, "mynode")
+			heartbeat := NewHeartBeat(fakeClient.CoreV1(), deviceController(true), nil, clusterConfig, "mynode")
 
 			By("running a first heartbeat and expecting no change")
 			heartbeat.do()
@@ -306,6 +335,45 @@ var _ = Describe("KSM", func() {
 			expectKSMState(expected)
 		})
 
+		It("should use the tuning of the KubeVirt CR, unless the node overrides it", func() {
+			kv.Spec.Configuration.KSMConfiguration.Tuning = &kubevirtv1.KSMTuning{
+				PagesBoost:      pointer.P(int32(200)),
+				PagesMax:        pointer.P(int32(500)),
+				PagesInit:       pointer.P(int32(150)),
+				SleepMsBaseline: pointer.P(int32(-1)), // Out of bounds, should use default: 100
+				FreePercent:     pointer.P(int32(10)),
+			}
+			clusterConfig, _, _ := testutils.NewFakeClusterConfigUsingKV(kv)
+
+			node := &v1.Node{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "mynode",
+					Labels:      map[string]string{"test_label": "true"},
+					Annotations: map[string]string{kubevirtv1.KSMPagesMaxOverride: "400"},
+				},
+			}
+			expected := ksmState{
+				running: true,
+				sleep:   sleepMsBaselineDefault * (16 * 1024 * 1024) / (memTotal - memAvailablePressure),
+				pages:   150,
+			}
+			fakeClient := fake.NewSimpleClientset(node)
+			createCustomMemInfo(true)
+			heartbeat := NewHeartBeat(fakeClient.CoreV1(), deviceController(true), nil, clusterConfig, "mynode")
+
+			By("running a first heartbeat and expecting KSM to start with the tuned initial pages")
+			heartbeat.do()
+			expectKSMState(expected)
+
+			By("expecting the number of pages to scan to increase by the tuned boost up to the overridden max value")
+			heartbeat.do()
+			expected.pages = 150 + 200
+			expectKSMState(expected)
+			heartbeat.do()
+			expected.pages = 400
+			expectKSMState(expected)
+		})
+
 		It("should use override values if provided", func() {
 			clusterConfig, _, _ := testutils.NewFakeClusterConfigUsingKV(kv)
 
@@ -332,7 +400,7 @@ var _ = Describe("KSM", func() {
 			}
 			fakeClient := fake.NewSimpleClientset(node)
 			createCustomMemInfo(false)
-			heartbeat := NewHeartBeat(fakeClient.CoreV1(), deviceController(true), clusterConfig, "mynode")
+			heartbeat := NewHeartBeat(fakeClient.CoreV1(), deviceController(true), nil, clusterConfig, "mynode")
 
 			By("running a first heartbeat and expecting the right values")
 			heartbeat.do()
@@ -0,0 +1,200 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package hugepages
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	k8scli "k8s.io/client-go/kubernetes/typed/core/v1"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
+)
+
+// Interval is the interval in which the hugepages of the node are provisioned. Provisioning periodically also
+// retries the allocation of hugepages the kernel could not allocate before, e.g. because of memory fragmentation.
+const Interval = 1 * time.Minute
+
+const (
+	stateAnnotationSuffix     = "-state"
+	allocatedAnnotationSuffix = "-allocated"
+)
+
+// Provisioner allocates at runtime the hugepages of the hugepages pools selecting the node, and reports the
+// provisioning of each page size in the annotations of the node. The annotations also record the page sizes
+// it manages, so that their hugepages are freed once no pool of that size selects the node anymore, also
+// across restarts of virt-handler.
+type Provisioner struct {
+	nodes         k8scli.NodeInterface
+	clusterConfig *virtconfig.ClusterConfig
+	host          string
+	hugepagesPath string
+}
+
+func NewProvisioner(nodes k8scli.NodeInterface, clusterConfig *virtconfig.ClusterConfig, host string) *Provisioner {
+	return &Provisioner{
+		nodes:         nodes,
+		clusterConfig: clusterConfig,
+		host:          host,
+		hugepagesPath: "/sys/kernel/mm/hugepages",
+	}
+}
+
+func (p *Provisioner) Run(interval time.Duration, stopCh <-chan struct{}) {
+	wait.Until(p.provision, interval, stopCh)
+}
+
+func (p *Provisioner) provision() {
+	node, err := p.nodes.Get(context.Background(), p.host, metav1.GetOptions{})
+	if err != nil {
+		log.Log.Reason(err).Errorf("Can't get node %s", p.host)
+		return
+	}
+
+	annotations := map[string]interface{}{}
+	requested := map[string]bool{}
+	for _, pool := range p.clusterConfig.GetHugepagesPools(node) {
+		pageSize, err := resource.ParseQuantity(pool.PageSize)
+		if err != nil {
+			continue
+		}
+		requested[pageSize.String()] = true
+		state, allocated := p.allocate(pageSize, int64(pool.Count))
+		annotations[stateAnnotation(pageSize)] = state
+		annotations[allocatedAnnotation(pageSize)] = strconv.FormatInt(allocated, 10)
+	}
+
+	for key := range node.Annotations {
+		if !strings.HasPrefix(key, v1.HugepagesAnnotationPrefix) || !strings.HasSuffix(key, stateAnnotationSuffix) {
+			continue
+		}
+		pageSize, err := resource.ParseQuantity(strings.TrimSuffix(strings.TrimPrefix(key, v1.HugepagesAnnotationPrefix), stateAnnotationSuffix))
+		if err != nil || requested[pageSize.String()] {
+			continue
+		}
+		if !p.supported(pageSize) {
+			// the kernel has no hugepages of the size to free
+			annotations[stateAnnotation(pageSize)] = nil
+			annotations[allocatedAnnotation(pageSize)] = nil
+			continue
+		}
+		if state, allocated := p.allocate(pageSize, 0); state != v1.HugepagesProvisioned {
+			// keep the page size managed to retry freeing its hugepages
+			annotations[stateAnnotation(pageSize)] = v1.HugepagesProvisioningFailed
+			annotations[allocatedAnnotation(pageSize)] = strconv.FormatInt(allocated, 10)
+			continue
+		}
+		annotations[stateAnnotation(pageSize)] = nil
+		annotations[allocatedAnnotation(pageSize)] = nil
+	}
+
+	p.patchNode(node.Annotations, annotations)
+}
+
+// allocate sets the number of persistent hugepages of the size and returns the provisioning state and the number of
+// hugepages the kernel allocated. Hugepages in use are only freed by the kernel once they are released.
+func (p *Provisioner) allocate(pageSize resource.Quantity, count int64) (state string, allocated int64) {
+	nrHugepagesPath := filepath.Join(p.pageSizePath(pageSize), "nr_hugepages")
+	current, err := readCount(nrHugepagesPath)
+	if err != nil {
+		log.Log.Reason(err).Errorf("failed to read the %s hugepages of node %s", pageSize.String(), p.host)
+		return v1.HugepagesProvisioningFailed, 0
+	}
+	if current == count {
+		return v1.HugepagesProvisioned, current
+	}
+
+	// #nosec No risk for path injection, the page size is a quantity
+	if err := os.WriteFile(nrHugepagesPath, []byte(strconv.FormatInt(count, 10)), 0644); err != nil {
+		log.Log.Reason(err).Errorf("failed to allocate %d %s hugepages on node %s", count, pageSize.String(), p.host)
+		return v1.HugepagesProvisioningFailed, current
+	}
+	allocated, err = readCount(nrHugepagesPath)
+	if err != nil {
+		log.Log.Reason(err).Errorf("failed to read the %s hugepages of node %s", pageSize.String(), p.host)
+		return v1.HugepagesProvisioningFailed, 0
+	}
+	log.Log.Infof("Allocated %d of %d %s hugepages on node %s", allocated, count, pageSize.String(), p.host)
+	if allocated < count {
+		return v1.HugepagesPartiallyProvisioned, allocated
+	}
+	return v1.HugepagesProvisioned, allocated
+}
+
+// supported returns whether the kernel exposes the hugepages of the size.
+func (p *Provisioner) supported(pageSize resource.Quantity) bool {
+	_, err := os.Stat(p.pageSizePath(pageSize))
+	return !os.IsNotExist(err)
+}
+
+func (p *Provisioner) pageSizePath(pageSize resource.Quantity) string {
+	return filepath.Join(p.hugepagesPath, fmt.Sprintf("hugepages-%dkB", pageSize.Value()/1024))
+}
+
+func (p *Provisioner) patchNode(current map[string]string, annotations map[string]interface{}) {
+	changed := false
+	for key, value := range annotations {
+		currentValue, exists := current[key]
+		if value == nil && exists || value != nil && (!exists || currentValue != value) {
+			changed = true
+		}
+	}
+	if !changed {
+		return
+	}
+	patch, err := json.Marshal(map[string]interface{}{"metadata": map[string]interface{}{"annotations": annotations}})
+	if err != nil {
+		log.Log.Reason(err).Error("failed to marshal the hugepages annotations")
+		return
+	}
+	if _, err := p.nodes.Patch(context.Background(), p.host, types.MergePatchType, patch, metav1.PatchOptions{}); err != nil {
+		log.Log.Reason(err).Errorf("Can't patch the hugepages provisioning of node %s", p.host)
+	}
+}
+
+func stateAnnotation(pageSize resource.Quantity) string {
+	return v1.HugepagesAnnotationPrefix + pageSize.String() + stateAnnotationSuffix
+}
+
+func allocatedAnnotation(pageSize resource.Quantity) string {
+	return v1.HugepagesAnnotationPrefix + pageSize.String() + allocatedAnnotationSuffix
+}
+
+func readCount(path string) (int64, error) {
+	// #nosec No risk for path injection, the page size is a quantity
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return 0, err
+	}
+	return strconv.ParseInt(strings.TrimSpace(string(content)), 10, 64)
+}
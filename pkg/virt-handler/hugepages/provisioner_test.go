@@ -0,0 +1,141 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package hugepages
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	k8sv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/testutils"
+)
+
+var _ = Describe("Hugepages provisioner", func() {
+	const host = "node01"
+
+	var (
+		client        *fake.Clientset
+		hugepagesPath string
+	)
+
+	newProvisioner := func(pools ...v1.HugepagesPool) *Provisioner {
+		clusterConfig, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{HugepagesPools: pools})
+		provisioner := NewProvisioner(client.CoreV1().Nodes(), clusterConfig, host)
+		provisioner.hugepagesPath = hugepagesPath
+		return provisioner
+	}
+
+	nrHugepages := func(dir string) string {
+		content, err := os.ReadFile(filepath.Join(hugepagesPath, dir, "nr_hugepages"))
+		Expect(err).ToNot(HaveOccurred())
+		return string(content)
+	}
+
+	getNode := func() *k8sv1.Node {
+		node, err := client.CoreV1().Nodes().Get(context.Background(), host, metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		return node
+	}
+
+	BeforeEach(func() {
+		client = fake.NewSimpleClientset(&k8sv1.Node{
+			ObjectMeta: metav1.ObjectMeta{Name: host, Labels: map[string]string{"hugepages": "true"}},
+		})
+		hugepagesPath = GinkgoT().TempDir()
+		for _, dir := range []string{"hugepages-2048kB", "hugepages-1048576kB"} {
+			Expect(os.Mkdir(filepath.Join(hugepagesPath, dir), 0755)).To(Succeed())
+			Expect(os.WriteFile(filepath.Join(hugepagesPath, dir, "nr_hugepages"), []byte("0\n"), 0644)).To(Succeed())
+		}
+	})
+
+	It("should allocate the hugepages of the pools selecting the node and report the provisioning", func() {
+		newProvisioner(
+			v1.HugepagesPool{Name: "vms", NodeSelector: map[string]string{"hugepages": "true"}, PageSize: "2048Ki", Count: 1024},
+			v1.HugepagesPool{Name: "large", PageSize: "1Gi", Count: 4},
+			v1.HugepagesPool{Name: "other", NodeSelector: map[string]string{"hugepages": "false"}, PageSize: "1Gi", Count: 8},
+		).provision()
+
+		Expect(nrHugepages("hugepages-2048kB")).To(Equal("1024"))
+		Expect(nrHugepages("hugepages-1048576kB")).To(Equal("4"))
+		Expect(getNode().Annotations).To(Equal(map[string]string{
+			"hugepages.kubevirt.io/2Mi-state":     v1.HugepagesProvisioned,
+			"hugepages.kubevirt.io/2Mi-allocated": "1024",
+			"hugepages.kubevirt.io/1Gi-state":     v1.HugepagesProvisioned,
+			"hugepages.kubevirt.io/1Gi-allocated": "4",
+		}))
+	})
+
+	It("should report the page sizes the node does not support as failed", func() {
+		newProvisioner(v1.HugepagesPool{Name: "vms", PageSize: "16Mi", Count: 64}).provision()
+
+		Expect(getNode().Annotations).To(Equal(map[string]string{
+			"hugepages.kubevirt.io/16Mi-state":     v1.HugepagesProvisioningFailed,
+			"hugepages.kubevirt.io/16Mi-allocated": "0",
+		}))
+	})
+
+	It("should drop the page sizes the node does not support once no pool selects them anymore", func() {
+		newProvisioner(
+			v1.HugepagesPool{Name: "vms", PageSize: "2Mi", Count: 1024},
+			v1.HugepagesPool{Name: "unsupported", PageSize: "16Mi", Count: 64},
+		).provision()
+
+		newProvisioner(v1.HugepagesPool{Name: "vms", PageSize: "2Mi", Count: 1024}).provision()
+
+		Expect(getNode().Annotations).To(Equal(map[string]string{
+			"hugepages.kubevirt.io/2Mi-state":     v1.HugepagesProvisioned,
+			"hugepages.kubevirt.io/2Mi-allocated": "1024",
+		}))
+	})
+
+	It("should free the hugepages of the page sizes no pool selects anymore", func() {
+		newProvisioner(
+			v1.HugepagesPool{Name: "vms", PageSize: "2Mi", Count: 1024},
+			v1.HugepagesPool{Name: "large", PageSize: "1Gi", Count: 4},
+		).provision()
+
+		newProvisioner(v1.HugepagesPool{Name: "vms", PageSize: "2Mi", Count: 512}).provision()
+
+		Expect(nrHugepages("hugepages-2048kB")).To(Equal("512"))
+		Expect(nrHugepages("hugepages-1048576kB")).To(Equal("0"))
+		Expect(getNode().Annotations).To(Equal(map[string]string{
+			"hugepages.kubevirt.io/2Mi-state":     v1.HugepagesProvisioned,
+			"hugepages.kubevirt.io/2Mi-allocated": "512",
+		}))
+	})
+
+	It("should leave the hugepages of the node alone without pools", func() {
+		Expect(os.WriteFile(filepath.Join(hugepagesPath, "hugepages-2048kB", "nr_hugepages"), []byte("64\n"), 0644)).To(Succeed())
+
+		newProvisioner().provision()
+
+		Expect(nrHugepages("hugepages-2048kB")).To(Equal("64\n"))
+		Expect(getNode().Annotations).To(BeEmpty())
+	})
+})
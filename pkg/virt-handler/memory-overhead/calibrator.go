@@ -0,0 +1,178 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package memoryoverhead
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"runtime"
+	"strconv"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	k8scli "k8s.io/client-go/kubernetes/typed/core/v1"
+	"k8s.io/client-go/tools/cache"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+
+	metrics "kubevirt.io/kubevirt/pkg/monitoring/metrics/virt-handler"
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
+	"kubevirt.io/kubevirt/pkg/virt-controller/services"
+	"kubevirt.io/kubevirt/pkg/virt-handler/cgroup"
+)
+
+// CalibrationInterval is the interval in which the memory overhead of the launchers is measured
+const CalibrationInterval = time.Minute
+
+type netBindingPluginMemoryCalculator interface {
+	Calculate(vmi *v1.VirtualMachineInstance, registeredPlugins map[string]v1.InterfaceBindingPlugin) resource.Quantity
+}
+
+type memoryPeakFunc func(vmi *v1.VirtualMachineInstance) (uint64, error)
+
+// Calibrator compares the memory overhead of the virt-launcher pods on the node with the estimation
+// which was used to size the pods. The highest ratio between both is published on the node, where
+// virt-controller can pick it up to size new launcher pods.
+type Calibrator struct {
+	nodes                            k8scli.NodeInterface
+	vmiStore                         cache.Store
+	clusterConfig                    *virtconfig.ClusterConfig
+	netBindingPluginMemoryCalculator netBindingPluginMemoryCalculator
+	host                             string
+	memoryPeak                       memoryPeakFunc
+	publishedRatio                   string
+}
+
+func NewCalibrator(nodes k8scli.NodeInterface, vmiStore cache.Store, clusterConfig *virtconfig.ClusterConfig,
+	netBindingPluginMemoryCalculator netBindingPluginMemoryCalculator, host string) *Calibrator {
+	return &Calibrator{
+		nodes:                            nodes,
+		vmiStore:                         vmiStore,
+		clusterConfig:                    clusterConfig,
+		netBindingPluginMemoryCalculator: netBindingPluginMemoryCalculator,
+		host:                             host,
+		memoryPeak: func(vmi *v1.VirtualMachineInstance) (uint64, error) {
+			manager, err := cgroup.NewManagerFromVM(vmi, host)
+			if err != nil {
+				return 0, err
+			}
+			return manager.GetMemoryPeak()
+		},
+	}
+}
+
+func (c *Calibrator) Run(interval time.Duration, stopCh <-chan struct{}) {
+	wait.Until(c.calibrate, interval, stopCh)
+}
+
+func (c *Calibrator) calibrate() {
+	metrics.ResetLauncherMemoryOverheadMeasured()
+
+	ratio, measured := 0.0, false
+	for _, obj := range c.vmiStore.List() {
+		vmi := obj.(*v1.VirtualMachineInstance)
+		if !vmi.IsRunning() || vmi.Status.NodeName != c.host {
+			continue
+		}
+
+		peak, err := c.memoryPeak(vmi)
+		if err != nil {
+			log.Log.Object(vmi).Reason(err).V(4).Info("failed to read the memory usage of the launcher")
+			continue
+		}
+
+		overhead := measuredOverhead(vmi, peak)
+		metrics.SetLauncherMemoryOverheadMeasured(vmi.Namespace, vmi.Name, c.host, overhead)
+
+		estimated := c.estimatedOverhead(vmi)
+		if overhead == 0 || estimated <= 0 {
+			continue
+		}
+		ratio = math.Max(ratio, float64(overhead)/float64(estimated))
+		measured = true
+	}
+
+	if !measured {
+		return
+	}
+	// Round up to not publish a ratio below the measured one
+	ratio = math.Ceil(ratio*100) / 100
+	metrics.SetNodeMemoryOverheadRatio(c.host, ratio)
+	// The ratio is only picked up by virt-controller with the feature gate
+	if !c.clusterConfig.MemoryOverheadCalibrationEnabled() {
+		return
+	}
+	c.publish(strconv.FormatFloat(ratio, 'f', 2, 64))
+}
+
+// estimatedOverhead is the overhead without the additional ratio of the cluster config,
+// otherwise the published ratio would be applied on top of itself
+func (c *Calibrator) estimatedOverhead(vmi *v1.VirtualMachineInstance) int64 {
+	arch := vmi.Spec.Architecture
+	if arch == "" {
+		arch = runtime.GOARCH
+	}
+	overhead := services.GetMemoryOverhead(vmi, arch, nil)
+	if c.netBindingPluginMemoryCalculator != nil {
+		overhead.Add(c.netBindingPluginMemoryCalculator.Calculate(vmi, c.clusterConfig.GetNetworkBindings()))
+	}
+	return overhead.Value()
+}
+
+func (c *Calibrator) publish(ratio string) {
+	if ratio == c.publishedRatio {
+		return
+	}
+	patch := []byte(fmt.Sprintf(`{"metadata": {"annotations": {"%s": "%s"}}}`, v1.MemoryOverheadRatioAnnotation, ratio))
+	if _, err := c.nodes.Patch(context.Background(), c.host, types.MergePatchType, patch, metav1.PatchOptions{}); err != nil {
+		log.Log.Reason(err).Errorf("Can't patch the memory overhead ratio of node %s", c.host)
+		return
+	}
+	c.publishedRatio = ratio
+}
+
+// measuredOverhead subtracts the guest memory from the peak memory usage of the compute container.
+// Hugepages are not accounted to the memory cgroup, so the whole usage is overhead for such guests.
+func measuredOverhead(vmi *v1.VirtualMachineInstance, peak uint64) uint64 {
+	if vmi.Spec.Domain.Memory != nil && vmi.Spec.Domain.Memory.Hugepages != nil {
+		return peak
+	}
+
+	var guest int64
+	switch {
+	case vmi.Status.Memory != nil && vmi.Status.Memory.GuestCurrent != nil:
+		guest = vmi.Status.Memory.GuestCurrent.Value()
+	case vmi.Spec.Domain.Memory != nil && vmi.Spec.Domain.Memory.Guest != nil:
+		guest = vmi.Spec.Domain.Memory.Guest.Value()
+	default:
+		guest = vmi.Spec.Domain.Resources.Requests.Memory().Value()
+	}
+
+	// The guest did not touch all of its memory yet
+	if guest < 0 || peak <= uint64(guest) {
+		return 0
+	}
+	return peak - uint64(guest)
+}
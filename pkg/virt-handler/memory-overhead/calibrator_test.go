@@ -0,0 +1,183 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package memoryoverhead
+
+import (
+	"context"
+	"errors"
+	"runtime"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/cache"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/testutils"
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
+	"kubevirt.io/kubevirt/pkg/virt-config/featuregate"
+	"kubevirt.io/kubevirt/pkg/virt-controller/services"
+)
+
+var _ = Describe("Memory overhead calibrator", func() {
+	const host = "node01"
+
+	var (
+		client     *fake.Clientset
+		vmiStore   cache.Store
+		calibrator *Calibrator
+		peaks      map[string]uint64
+		kvStore    cache.Store
+	)
+
+	newVMI := func(name, memory string) *v1.VirtualMachineInstance {
+		vmi := &v1.VirtualMachineInstance{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: metav1.NamespaceDefault},
+			Status: v1.VirtualMachineInstanceStatus{
+				Phase:    v1.Running,
+				NodeName: host,
+			},
+		}
+		vmi.Spec.Domain.Resources.Requests = k8sv1.ResourceList{k8sv1.ResourceMemory: resource.MustParse(memory)}
+		return vmi
+	}
+
+	estimated := func(vmi *v1.VirtualMachineInstance) uint64 {
+		overhead := services.GetMemoryOverhead(vmi, runtime.GOARCH, nil)
+		return uint64(overhead.Value())
+	}
+
+	nodeAnnotation := func() (string, bool) {
+		node, err := client.CoreV1().Nodes().Get(context.Background(), host, metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		value, exists := node.Annotations[v1.MemoryOverheadRatioAnnotation]
+		return value, exists
+	}
+
+	BeforeEach(func() {
+		client = fake.NewSimpleClientset(&k8sv1.Node{ObjectMeta: metav1.ObjectMeta{Name: host}})
+		vmiStore = cache.NewStore(cache.MetaNamespaceKeyFunc)
+		var clusterConfig *virtconfig.ClusterConfig
+		clusterConfig, _, kvStore = testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{
+			DeveloperConfiguration: &v1.DeveloperConfiguration{
+				FeatureGates: []string{featuregate.MemoryOverheadCalibrationGate},
+			},
+		})
+		peaks = map[string]uint64{}
+
+		calibrator = NewCalibrator(client.CoreV1().Nodes(), vmiStore, clusterConfig, nil, host)
+		calibrator.memoryPeak = func(vmi *v1.VirtualMachineInstance) (uint64, error) {
+			peak, exists := peaks[vmi.Name]
+			if !exists {
+				return 0, errors.New("no cgroup")
+			}
+			return peak, nil
+		}
+	})
+
+	It("should publish the highest ratio between measured and estimated overhead", func() {
+		small := newVMI("small", "1Gi")
+		large := newVMI("large", "4Gi")
+		Expect(vmiStore.Add(small)).To(Succeed())
+		Expect(vmiStore.Add(large)).To(Succeed())
+		peaks[small.Name] = 1024*1024*1024 + estimated(small)
+		peaks[large.Name] = 4*1024*1024*1024 + 2*estimated(large)
+
+		calibrator.calibrate()
+
+		value, exists := nodeAnnotation()
+		Expect(exists).To(BeTrue())
+		Expect(value).To(Equal("2.00"))
+	})
+
+	It("should ignore VMIs which did not touch their memory or can not be measured", func() {
+		measured := newVMI("measured", "1Gi")
+		Expect(vmiStore.Add(measured)).To(Succeed())
+		Expect(vmiStore.Add(newVMI("untouched", "1Gi"))).To(Succeed())
+		Expect(vmiStore.Add(newVMI("unknown", "1Gi"))).To(Succeed())
+		peaks[measured.Name] = 1024*1024*1024 + estimated(measured)/2
+		peaks["untouched"] = 512 * 1024 * 1024
+
+		calibrator.calibrate()
+
+		value, _ := nodeAnnotation()
+		Expect(value).To(Equal("0.50"))
+	})
+
+	It("should not touch the node without running VMIs", func() {
+		stopped := newVMI("stopped", "1Gi")
+		stopped.Status.Phase = v1.Succeeded
+		Expect(vmiStore.Add(stopped)).To(Succeed())
+		peaks[stopped.Name] = 2 * 1024 * 1024 * 1024
+
+		calibrator.calibrate()
+
+		_, exists := nodeAnnotation()
+		Expect(exists).To(BeFalse())
+	})
+
+	It("should not touch the node when the feature gate is disabled", func() {
+		kv := testutils.GetFakeKubeVirtClusterConfig(kvStore)
+		kv.Spec.Configuration.DeveloperConfiguration.FeatureGates = nil
+		testutils.UpdateFakeKubeVirtClusterConfig(kvStore, kv)
+		vmi := newVMI("vmi", "1Gi")
+		Expect(vmiStore.Add(vmi)).To(Succeed())
+		peaks[vmi.Name] = 1024*1024*1024 + estimated(vmi)
+
+		calibrator.calibrate()
+
+		_, exists := nodeAnnotation()
+		Expect(exists).To(BeFalse())
+	})
+
+	It("should only patch the node if the ratio changed", func() {
+		vmi := newVMI("vmi", "1Gi")
+		Expect(vmiStore.Add(vmi)).To(Succeed())
+		peaks[vmi.Name] = 1024*1024*1024 + estimated(vmi)
+
+		calibrator.calibrate()
+		calibrator.calibrate()
+
+		patches := 0
+		for _, action := range client.Actions() {
+			if action.GetVerb() == "patch" {
+				patches++
+			}
+		}
+		Expect(patches).To(Equal(1))
+	})
+
+	DescribeTable("measured overhead", func(vmi *v1.VirtualMachineInstance, peak, expected uint64) {
+		Expect(measuredOverhead(vmi, peak)).To(Equal(expected))
+	},
+		Entry("subtracts the guest memory", newVMI("vmi", "1Gi"), uint64(1024+1)*1024*1024, uint64(1024*1024)),
+		Entry("is zero if the guest did not touch its memory", newVMI("vmi", "1Gi"), uint64(1024*1024), uint64(0)),
+		Entry("is the whole usage with hugepages", func() *v1.VirtualMachineInstance {
+			vmi := newVMI("vmi", "1Gi")
+			vmi.Spec.Domain.Memory = &v1.Memory{Hugepages: &v1.Hugepages{PageSize: "2Mi"}}
+			return vmi
+		}(), uint64(1024*1024), uint64(1024*1024)),
+	)
+})
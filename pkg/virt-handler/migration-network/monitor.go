@@ -0,0 +1,297 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package migrationnetwork
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	k8scli "k8s.io/client-go/kubernetes/typed/core/v1"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
+)
+
+// Interval is the interval in which the usage of the migration network is sampled
+const Interval = 30 * time.Second
+
+const (
+	sysClassNet = "/sys/class/net"
+	// virt-handler does not use the host network, the NICs of the node are read through the mounts and the
+	// routes of the host
+	hostSysClassNet = "/proc/1/root/sys/class/net"
+	hostRoutes      = "/proc/1/net/route"
+	hostIPv6Routes  = "/proc/1/net/ipv6_route"
+	// The usage is published in steps of the capacity to not patch the node on every sample
+	usageSteps = 100
+)
+
+type interfaceByIPFunc func(ip string) (string, error)
+
+type sample struct {
+	rxBytes uint64
+	txBytes uint64
+	time    time.Time
+}
+
+// Monitor publishes the capacity and the usage of the host NIC which carries the migrations of the node,
+// virt-controller tunes the bandwidth of the migrations to them if the bandwidth auto-tuning is enabled.
+type Monitor struct {
+	nodes           k8scli.NodeInterface
+	clusterConfig   *virtconfig.ClusterConfig
+	host            string
+	migrationIP     string
+	sysClassNet     string
+	hostSysClassNet string
+	hostRoutes      string
+	hostIPv6Routes  string
+	interfaceByIP   interfaceByIPFunc
+	now             func() time.Time
+
+	lastSample *sample
+	published  string
+}
+
+func NewMonitor(nodes k8scli.NodeInterface, clusterConfig *virtconfig.ClusterConfig, host, migrationIP string) *Monitor {
+	return &Monitor{
+		nodes:           nodes,
+		clusterConfig:   clusterConfig,
+		host:            host,
+		migrationIP:     migrationIP,
+		sysClassNet:     sysClassNet,
+		hostSysClassNet: hostSysClassNet,
+		hostRoutes:      hostRoutes,
+		hostIPv6Routes:  hostIPv6Routes,
+		interfaceByIP:   interfaceByIP,
+		now:             time.Now,
+	}
+}
+
+func (m *Monitor) Run(interval time.Duration, stopCh <-chan struct{}) {
+	wait.Until(m.report, interval, stopCh)
+}
+
+func (m *Monitor) report() {
+	conf := m.clusterConfig.GetMigrationConfiguration()
+	if conf == nil || conf.BandwidthAutoTuning == nil {
+		m.lastSample = nil
+		return
+	}
+
+	podIface, err := m.interfaceByIP(m.migrationIP)
+	if err != nil {
+		log.Log.Reason(err).V(4).Info("failed to find the migration network interface")
+		return
+	}
+	iface, err := m.hostInterface(podIface)
+	if err != nil {
+		log.Log.Reason(err).V(4).Infof("failed to find the host NIC of %s", podIface)
+		return
+	}
+	capacity, err := m.capacity(iface)
+	if err != nil {
+		log.Log.Reason(err).V(4).Infof("failed to read the speed of %s", iface)
+		return
+	}
+	current, err := m.sample(iface)
+	if err != nil {
+		log.Log.Reason(err).V(4).Infof("failed to read the statistics of %s", iface)
+		return
+	}
+
+	last := m.lastSample
+	m.lastSample = current
+	if last == nil || !current.time.After(last.time) || current.rxBytes < last.rxBytes || current.txBytes < last.txBytes {
+		return
+	}
+
+	seconds := current.time.Sub(last.time).Seconds()
+	rate := int64(float64(max(current.rxBytes-last.rxBytes, current.txBytes-last.txBytes)) / seconds)
+	step := max(capacity/usageSteps, 1)
+	usage := min((rate+step-1)/step*step, capacity)
+
+	m.publish(capacity, usage)
+}
+
+// hostInterface finds the host NIC the traffic of the migration interface of the pod leaves the node through.
+// The interface of a migration network attached with macvlan or ipvlan is linked to it, the pod network is routed
+// through the NIC of the default route of the host.
+func (m *Monitor) hostInterface(podIface string) (string, error) {
+	iflink, err := readInt(filepath.Join(m.sysClassNet, podIface, "iflink"))
+	if err != nil {
+		return "", err
+	}
+	ifindex, err := readInt(filepath.Join(m.sysClassNet, podIface, "ifindex"))
+	if err != nil {
+		return "", err
+	}
+	if iflink != ifindex {
+		if iface, found := m.hostRootInterfaceByIndex(iflink); found {
+			return iface, nil
+		}
+	}
+	return m.defaultRouteInterface()
+}
+
+// hostRootInterfaceByIndex finds the host interface with the index, if it is not linked to another interface itself
+// like the host end of a veth pair
+func (m *Monitor) hostRootInterfaceByIndex(index int64) (string, bool) {
+	entries, err := os.ReadDir(m.hostSysClassNet)
+	if err != nil {
+		return "", false
+	}
+	for _, entry := range entries {
+		ifindex, err := readInt(filepath.Join(m.hostSysClassNet, entry.Name(), "ifindex"))
+		if err != nil || ifindex != index {
+			continue
+		}
+		iflink, err := readInt(filepath.Join(m.hostSysClassNet, entry.Name(), "iflink"))
+		return entry.Name(), err == nil && iflink == ifindex
+	}
+	return "", false
+}
+
+// defaultRouteInterface returns the interface of the IPv4 default route of the host with the lowest metric, or
+// the one of the IPv6 default route on single stack IPv6 nodes
+func (m *Monitor) defaultRouteInterface() (string, error) {
+	// Iface Destination Gateway Flags RefCnt Use Metric Mask ...
+	iface, err := defaultRoute(m.hostRoutes, func(fields []string) (string, string, bool) {
+		return fields[0], fields[6], len(fields) > 7 && fields[1] == "00000000" && fields[7] == "00000000"
+	})
+	if err == nil {
+		return iface, nil
+	}
+	// Destination PrefixLength Source SourcePrefixLength NextHop Metric RefCnt Use Flags Iface
+	return defaultRoute(m.hostIPv6Routes, func(fields []string) (string, string, bool) {
+		return fields[len(fields)-1], fields[5], len(fields) > 9 && strings.Trim(fields[0], "0") == "" &&
+			fields[1] == "00" && fields[len(fields)-1] != "lo"
+	})
+}
+
+func defaultRoute(routes string, isDefault func(fields []string) (iface, metric string, isDefault bool)) (string, error) {
+	content, err := os.ReadFile(routes)
+	if err != nil {
+		return "", err
+	}
+	defaultIface := ""
+	lowestMetric := uint64(0)
+	for _, line := range strings.Split(string(content), "\n") {
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			continue
+		}
+		iface, metricHex, ok := isDefault(fields)
+		if !ok {
+			continue
+		}
+		metric, err := strconv.ParseUint(metricHex, 16, 64)
+		if err != nil {
+			continue
+		}
+		if defaultIface == "" || metric < lowestMetric {
+			defaultIface, lowestMetric = iface, metric
+		}
+	}
+	if defaultIface == "" {
+		return "", fmt.Errorf("no default route in %s", routes)
+	}
+	return defaultIface, nil
+}
+
+// capacity converts the speed of the interface from Mbit/s to bytes per second
+func (m *Monitor) capacity(iface string) (int64, error) {
+	speed, err := m.readStatistic(filepath.Join(iface, "speed"))
+	if err != nil {
+		return 0, err
+	}
+	if speed <= 0 {
+		return 0, fmt.Errorf("unknown speed")
+	}
+	return speed * 1000 * 1000 / 8, nil
+}
+
+func (m *Monitor) sample(iface string) (*sample, error) {
+	rxBytes, err := m.readStatistic(filepath.Join(iface, "statistics", "rx_bytes"))
+	if err != nil {
+		return nil, err
+	}
+	txBytes, err := m.readStatistic(filepath.Join(iface, "statistics", "tx_bytes"))
+	if err != nil {
+		return nil, err
+	}
+	return &sample{rxBytes: uint64(rxBytes), txBytes: uint64(txBytes), time: m.now()}, nil
+}
+
+func (m *Monitor) readStatistic(path string) (int64, error) {
+	return readInt(filepath.Join(m.hostSysClassNet, path))
+}
+
+func readInt(path string) (int64, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return 0, err
+	}
+	return strconv.ParseInt(strings.TrimSpace(string(content)), 10, 64)
+}
+
+func (m *Monitor) publish(capacity, usage int64) {
+	patch := fmt.Sprintf(`{"metadata": {"annotations": {"%s": "%s", "%s": "%s"}}}`,
+		v1.MigrationNetworkCapacityAnnotation, resource.NewQuantity(capacity, resource.DecimalSI).String(),
+		v1.MigrationNetworkUsageAnnotation, resource.NewQuantity(usage, resource.DecimalSI).String())
+	if patch == m.published {
+		return
+	}
+	if _, err := m.nodes.Patch(context.Background(), m.host, types.MergePatchType, []byte(patch), metav1.PatchOptions{}); err != nil {
+		log.Log.Reason(err).Errorf("Can't patch the migration network usage of node %s", m.host)
+		return
+	}
+	m.published = patch
+}
+
+func interfaceByIP(ip string) (string, error) {
+	ifaces, err := net.Interfaces()
+	if err != nil {
+		return "", err
+	}
+	for _, iface := range ifaces {
+		addrs, err := iface.Addrs()
+		if err != nil {
+			continue
+		}
+		for _, addr := range addrs {
+			if ipNet, ok := addr.(*net.IPNet); ok && ipNet.IP.Equal(net.ParseIP(ip)) {
+				return iface.Name, nil
+			}
+		}
+	}
+	return "", fmt.Errorf("no interface has the IP %s", ip)
+}
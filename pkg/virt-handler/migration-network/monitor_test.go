@@ -0,0 +1,193 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package migrationnetwork
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	k8sv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/testutils"
+)
+
+var _ = Describe("Migration network monitor", func() {
+	const (
+		host     = "node01"
+		podIface = "migration0"
+		iface    = "eth0"
+	)
+
+	var (
+		client  *fake.Clientset
+		monitor *Monitor
+		now     time.Time
+	)
+
+	writeFile := func(path string, value int64) {
+		Expect(os.MkdirAll(filepath.Dir(path), 0755)).To(Succeed())
+		Expect(os.WriteFile(path, []byte(strconv.FormatInt(value, 10)+"\n"), 0644)).To(Succeed())
+	}
+
+	writeStatistic := func(path string, value int64) {
+		writeFile(filepath.Join(monitor.hostSysClassNet, iface, path), value)
+	}
+
+	writeInterface := func(sysClassNet, name string, ifindex, iflink int64) {
+		writeFile(filepath.Join(sysClassNet, name, "ifindex"), ifindex)
+		writeFile(filepath.Join(sysClassNet, name, "iflink"), iflink)
+	}
+
+	writeRoutes := func(path, routes string) {
+		Expect(os.WriteFile(path, []byte(routes), 0644)).To(Succeed())
+	}
+
+	writeCounters := func(rxBytes, txBytes int64) {
+		writeStatistic("statistics/rx_bytes", rxBytes)
+		writeStatistic("statistics/tx_bytes", txBytes)
+	}
+
+	nodeAnnotations := func() map[string]string {
+		node, err := client.CoreV1().Nodes().Get(context.Background(), host, metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		return node.Annotations
+	}
+
+	newReporter := func(conf *v1.MigrationConfiguration) {
+		clusterConfig, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{MigrationConfiguration: conf})
+		monitor = NewMonitor(client.CoreV1().Nodes(), clusterConfig, host, "10.0.0.1")
+		monitor.sysClassNet = GinkgoT().TempDir()
+		monitor.hostSysClassNet = GinkgoT().TempDir()
+		hostProcNet := GinkgoT().TempDir()
+		monitor.hostRoutes = filepath.Join(hostProcNet, "route")
+		monitor.hostIPv6Routes = filepath.Join(hostProcNet, "ipv6_route")
+		monitor.interfaceByIP = func(ip string) (string, error) {
+			Expect(ip).To(Equal("10.0.0.1"))
+			return podIface, nil
+		}
+		monitor.now = func() time.Time { return now }
+
+		// The pod interface is the end of a veth pair, the pod network leaves the node through eth0
+		writeInterface(monitor.sysClassNet, podIface, 3, 12)
+		writeInterface(monitor.hostSysClassNet, "veth1234", 12, 3)
+		writeInterface(monitor.hostSysClassNet, iface, 2, 2)
+		writeRoutes(monitor.hostRoutes, ipv4Routes)
+	}
+
+	BeforeEach(func() {
+		client = fake.NewSimpleClientset(&k8sv1.Node{ObjectMeta: metav1.ObjectMeta{Name: host}})
+		now = time.Now()
+		newReporter(&v1.MigrationConfiguration{BandwidthAutoTuning: &v1.MigrationBandwidthAutoTuning{}})
+		// 8000 Mbit/s
+		writeStatistic("speed", 8000)
+	})
+
+	It("should measure the host NIC of the default route", func() {
+		Expect(monitor.hostInterface(podIface)).To(Equal(iface))
+	})
+
+	It("should measure the host NIC of the default route of single stack IPv6 nodes", func() {
+		Expect(os.Remove(monitor.hostRoutes)).To(Succeed())
+		writeRoutes(monitor.hostIPv6Routes, ipv6Routes)
+		Expect(monitor.hostInterface(podIface)).To(Equal("eth1"))
+	})
+
+	It("should measure the host NIC a migration network interface is linked to", func() {
+		writeInterface(monitor.sysClassNet, podIface, 3, 4)
+		writeInterface(monitor.hostSysClassNet, "bond0", 4, 4)
+		Expect(monitor.hostInterface(podIface)).To(Equal("bond0"))
+	})
+
+	It("should publish the capacity and the usage of the migration interface", func() {
+		writeCounters(0, 0)
+		monitor.report()
+		Expect(nodeAnnotations()).To(BeEmpty())
+
+		now = now.Add(10 * time.Second)
+		writeCounters(1000*1000*1000, 2505*1000*1000)
+		monitor.report()
+		Expect(nodeAnnotations()).To(And(
+			HaveKeyWithValue(v1.MigrationNetworkCapacityAnnotation, "1G"),
+			// 250.5MB/s rounded up to the next percent of the capacity
+			HaveKeyWithValue(v1.MigrationNetworkUsageAnnotation, "260M"),
+		))
+	})
+
+	It("should not exceed the capacity", func() {
+		writeCounters(0, 0)
+		monitor.report()
+		now = now.Add(time.Second)
+		writeCounters(0, 2000*1000*1000)
+		monitor.report()
+		Expect(nodeAnnotations()).To(HaveKeyWithValue(v1.MigrationNetworkUsageAnnotation, "1G"))
+	})
+
+	It("should skip samples after the counters were reset", func() {
+		writeCounters(1000, 1000)
+		monitor.report()
+		now = now.Add(time.Second)
+		writeCounters(0, 0)
+		monitor.report()
+		Expect(nodeAnnotations()).To(BeEmpty())
+	})
+
+	It("should not publish an unknown speed", func() {
+		writeStatistic("speed", -1)
+		writeCounters(0, 0)
+		monitor.report()
+		now = now.Add(time.Second)
+		writeCounters(1000, 1000)
+		monitor.report()
+		Expect(nodeAnnotations()).To(BeEmpty())
+	})
+
+	It("should not report without the auto-tuning", func() {
+		newReporter(&v1.MigrationConfiguration{})
+		writeStatistic("speed", 8000)
+		writeCounters(0, 0)
+		monitor.report()
+		now = now.Add(time.Second)
+		writeCounters(1000, 1000)
+		monitor.report()
+		Expect(nodeAnnotations()).To(BeEmpty())
+	})
+})
+
+const ipv4Routes = `Iface	Destination	Gateway 	Flags	RefCnt	Use	Metric	Mask		MTU	Window	IRTT
+eth1	00000000	0102A8C0	0003	0	0	200	00000000	0	0	0
+eth0	00000000	0101A8C0	0003	0	0	100	00000000	0	0	0
+eth0	0001A8C0	00000000	0001	0	0	100	00FFFFFF	0	0	0
+`
+
+const ipv6Routes = `fd000000000000000000000000000000 40 00000000000000000000000000000000 00 00000000000000000000000000000000 00000100 00000001 00000000 00000001     eth0
+00000000000000000000000000000000 00 00000000000000000000000000000000 00 fd000000000000000000000000000001 00000400 00000001 00000000 00000003     eth0
+00000000000000000000000000000000 00 00000000000000000000000000000000 00 fd000000000000000000000000000001 00000100 00000001 00000000 00000003     eth1
+00000000000000000000000000000000 00 00000000000000000000000000000000 00 00000000000000000000000000000000 ffffffff 00000001 00000000 00200200       lo
+`
@@ -0,0 +1,97 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package nodeapi
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"k8s.io/client-go/tools/cache"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/util/net/unixhttp"
+	"kubevirt.io/kubevirt/pkg/virt-handler/isolation"
+)
+
+// Only root on the node may talk to the API, there is no further authorization
+const socketMode = 0600
+
+// Server exposes the VMIs running on the node and the host resources backing them on a local UNIX socket.
+// It lets node agents correlate host resources with the VMs without access to the cluster API.
+// The API is read-only, only GET requests are served.
+type Server struct {
+	vmiStore          cache.Store
+	isolationDetector isolation.PodIsolationDetector
+	// cgroupPaths returns the cgroup paths of a process by controller, it is replaced in the tests
+	cgroupPaths func(pid int) (map[string]string, error)
+}
+
+func NewServer(vmiStore cache.Store, isolationDetector isolation.PodIsolationDetector) *Server {
+	return &Server{
+		vmiStore:          vmiStore,
+		isolationDetector: isolationDetector,
+		cgroupPaths:       readCgroupPaths,
+	}
+}
+
+// Run serves the API on the socket until the stop channel is closed
+func (s *Server) Run(socketPath string, stop <-chan struct{}) error {
+	log.Log.Infof("serving the node API on %s", socketPath)
+	return unixhttp.Serve(socketPath, socketMode, s.Handler(), stop)
+}
+
+func (s *Server) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /v1/virtualmachineinstances", s.listHandler)
+	mux.HandleFunc("GET /v1/namespaces/{namespace}/virtualmachineinstances/{name}", s.getHandler)
+	return mux
+}
+
+func (s *Server) listHandler(w http.ResponseWriter, _ *http.Request) {
+	list := &VirtualMachineInstanceList{Items: []VirtualMachineInstance{}}
+	for _, obj := range s.vmiStore.List() {
+		list.Items = append(list.Items, s.describe(obj.(*v1.VirtualMachineInstance)))
+	}
+	sortVirtualMachineInstances(list.Items)
+	writeJSON(w, list)
+}
+
+func (s *Server) getHandler(w http.ResponseWriter, r *http.Request) {
+	key := r.PathValue("namespace") + "/" + r.PathValue("name")
+	obj, exists, err := s.vmiStore.GetByKey(key)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if !exists {
+		http.Error(w, "VirtualMachineInstance "+key+" is not running on this node", http.StatusNotFound)
+		return
+	}
+	writeJSON(w, s.describe(obj.(*v1.VirtualMachineInstance)))
+}
+
+func writeJSON(w http.ResponseWriter, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(body); err != nil {
+		log.Log.Reason(err).Error("failed to write the node API response")
+	}
+}
@@ -0,0 +1,184 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package nodeapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"k8s.io/client-go/tools/cache"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/libvmi"
+	"kubevirt.io/kubevirt/pkg/util/net/unixhttp"
+	"kubevirt.io/kubevirt/pkg/virt-handler/isolation"
+)
+
+var _ = Describe("Node API", func() {
+	const launcherPid = 1234
+
+	var (
+		vmiStore cache.Store
+		detector *isolation.MockPodIsolationDetector
+		server   *Server
+	)
+
+	BeforeEach(func() {
+		ctrl := gomock.NewController(GinkgoT())
+		vmiStore = cache.NewStore(cache.DeletionHandlingMetaNamespaceKeyFunc)
+		detector = isolation.NewMockPodIsolationDetector(ctrl)
+		isolationResult := isolation.NewMockIsolationResult(ctrl)
+		isolationResult.EXPECT().Pid().Return(launcherPid).AnyTimes()
+		detector.EXPECT().Detect(gomock.Any()).Return(isolationResult, nil).AnyTimes()
+
+		server = NewServer(vmiStore, detector)
+		server.cgroupPaths = func(pid int) (map[string]string, error) {
+			return map[string]string{unifiedCgroup: fmt.Sprintf("/kubepods.slice/pod%d", pid)}, nil
+		}
+	})
+
+	addVMI := func(namespace, name string, opts ...libvmi.Option) *v1.VirtualMachineInstance {
+		vmi := libvmi.New(append([]libvmi.Option{libvmi.WithNamespace(namespace)}, opts...)...)
+		vmi.Name = name
+		vmi.Status.Phase = v1.Running
+		Expect(vmiStore.Add(vmi)).To(Succeed())
+		return vmi
+	}
+
+	get := func(path string) *httptest.ResponseRecorder {
+		recorder := httptest.NewRecorder()
+		server.Handler().ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, path, nil))
+		return recorder
+	}
+
+	It("should list the VMIs on the node with their host resources", func() {
+		addVMI("ns2", "vmi")
+		addVMI("ns1", "vmi")
+
+		recorder := get("/v1/virtualmachineinstances")
+		Expect(recorder.Code).To(Equal(http.StatusOK))
+		list := &VirtualMachineInstanceList{}
+		Expect(json.Unmarshal(recorder.Body.Bytes(), list)).To(Succeed())
+		Expect(list.Items).To(HaveLen(2))
+		Expect(list.Items[0].Namespace).To(Equal("ns1"))
+		Expect(list.Items[0].DomainName).To(Equal("ns1_vmi"))
+		Expect(list.Items[0].Pid).To(Equal(launcherPid))
+		Expect(list.Items[0].NetworkNamespace).To(Equal("/proc/1234/ns/net"))
+		Expect(list.Items[0].CgroupPaths).To(HaveKeyWithValue(unifiedCgroup, "/kubepods.slice/pod1234"))
+		Expect(list.Items[1].Namespace).To(Equal("ns2"))
+	})
+
+	It("should return an empty list without VMIs", func() {
+		recorder := get("/v1/virtualmachineinstances")
+		Expect(recorder.Code).To(Equal(http.StatusOK))
+		Expect(recorder.Body.String()).To(MatchJSON(`{"items":[]}`))
+	})
+
+	It("should get a single VMI", func() {
+		addVMI("default", "testvmi")
+
+		recorder := get("/v1/namespaces/default/virtualmachineinstances/testvmi")
+		Expect(recorder.Code).To(Equal(http.StatusOK))
+		vmi := &VirtualMachineInstance{}
+		Expect(json.Unmarshal(recorder.Body.Bytes(), vmi)).To(Succeed())
+		Expect(vmi.Name).To(Equal("testvmi"))
+		Expect(vmi.Phase).To(Equal(v1.Running))
+	})
+
+	It("should fail for a VMI which is not on the node", func() {
+		Expect(get("/v1/namespaces/default/virtualmachineinstances/testvmi").Code).To(Equal(http.StatusNotFound))
+	})
+
+	It("should refuse requests other than GET", func() {
+		addVMI("default", "testvmi")
+
+		recorder := httptest.NewRecorder()
+		request := httptest.NewRequest(http.MethodDelete, "/v1/namespaces/default/virtualmachineinstances/testvmi", nil)
+		server.Handler().ServeHTTP(recorder, request)
+		Expect(recorder.Code).To(Equal(http.StatusMethodNotAllowed))
+	})
+
+	It("should describe the tap devices of the bridge and masquerade bindings", func() {
+		vmi := addVMI("default", "testvmi",
+			libvmi.WithInterface(libvmi.InterfaceDeviceWithMasqueradeBinding()),
+			libvmi.WithNetwork(v1.DefaultPodNetwork()),
+			libvmi.WithInterface(libvmi.InterfaceDeviceWithBridgeBinding("blue")),
+			libvmi.WithNetwork(libvmi.MultusNetwork("blue", "blue-nad")),
+			libvmi.WithInterface(libvmi.InterfaceDeviceWithSRIOVBinding("red")),
+			libvmi.WithNetwork(libvmi.MultusNetwork("red", "red-nad")),
+		)
+		vmi.Status.Interfaces = []v1.VirtualMachineInstanceNetworkInterface{
+			{Name: "default", MAC: "02:00:00:00:00:01", PodInterfaceName: "eth0"},
+		}
+
+		interfaces := server.describe(vmi).Interfaces
+		Expect(interfaces).To(HaveLen(3))
+		Expect(interfaces[0]).To(Equal(Interface{Name: "default", PodInterfaceName: "eth0", TapDevice: "tap0", MAC: "02:00:00:00:00:01"}))
+		Expect(interfaces[1].Name).To(Equal("blue"))
+		Expect(interfaces[1].PodInterfaceName).To(HavePrefix("pod"))
+		Expect(interfaces[1].TapDevice).To(Equal("tap" + interfaces[1].PodInterfaceName[3:]))
+		Expect(interfaces[2].Name).To(Equal("red"))
+		Expect(interfaces[2].TapDevice).To(BeEmpty())
+	})
+
+	It("should not detect the host resources of a finished VMI", func() {
+		vmi := addVMI("default", "testvmi")
+		vmi.Status.Phase = v1.Succeeded
+
+		description := server.describe(vmi)
+		Expect(description.Pid).To(BeZero())
+		Expect(description.CgroupPaths).To(BeEmpty())
+	})
+
+	It("should serve the API on a socket only root can access", func() {
+		addVMI("default", "testvmi")
+		socketPath := filepath.Join(GinkgoT().TempDir(), "node-api.sock")
+		stop := make(chan struct{})
+		done := make(chan error)
+		go func() {
+			done <- server.Run(socketPath, stop)
+		}()
+
+		var response *http.Response
+		Eventually(func() error {
+			var err error
+			response, err = unixhttp.Client(socketPath).Get("http://localhost/v1/namespaces/default/virtualmachineinstances/testvmi")
+			return err
+		}).Should(Succeed())
+		defer response.Body.Close()
+		Expect(response.StatusCode).To(Equal(http.StatusOK))
+
+		info, err := os.Stat(socketPath)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(info.Mode().Perm()).To(Equal(os.FileMode(socketMode)))
+
+		close(stop)
+		Eventually(done).Should(Receive(BeNil()))
+	})
+})
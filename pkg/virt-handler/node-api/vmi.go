@@ -0,0 +1,144 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package nodeapi
+
+import (
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strconv"
+
+	runc_cgroups "github.com/opencontainers/runc/libcontainer/cgroups"
+	"k8s.io/apimachinery/pkg/types"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/network/link"
+	"kubevirt.io/kubevirt/pkg/network/namescheme"
+	"kubevirt.io/kubevirt/pkg/network/vmispec"
+	cgroupconsts "kubevirt.io/kubevirt/pkg/virt-handler/cgroup/constants"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+)
+
+// unifiedCgroup is the key of the cgroup v2 hierarchy, which has no controller name
+const unifiedCgroup = "unified"
+
+type VirtualMachineInstanceList struct {
+	Items []VirtualMachineInstance `json:"items"`
+}
+
+// VirtualMachineInstance describes a VMI running on the node and the host resources backing it
+type VirtualMachineInstance struct {
+	Namespace string                         `json:"namespace"`
+	Name      string                         `json:"name"`
+	UID       types.UID                      `json:"uid"`
+	Phase     v1.VirtualMachineInstancePhase `json:"phase"`
+	// DomainName is the name of the libvirt domain of the VMI
+	DomainName string `json:"domainName"`
+	// Pid is the host pid of the virt-launcher process, the host details are missing if it could not be detected
+	Pid int `json:"pid,omitempty"`
+	// NetworkNamespace is the host path of the network namespace the tap devices are in
+	NetworkNamespace string `json:"networkNamespace,omitempty"`
+	// CgroupPaths are the cgroup paths of the virt-launcher process by controller, relative to the cgroup mount.
+	// The cgroup v2 hierarchy is reported as "unified".
+	CgroupPaths map[string]string `json:"cgroupPaths,omitempty"`
+	Interfaces  []Interface       `json:"interfaces,omitempty"`
+}
+
+type Interface struct {
+	// Name is the name of the interface in the VMI spec
+	Name string `json:"name"`
+	// PodInterfaceName is the name of the interface in the network namespace of the virt-launcher pod
+	PodInterfaceName string `json:"podInterfaceName,omitempty"`
+	// TapDevice is the tap device connecting the guest, it is only set for the bridge and masquerade bindings
+	TapDevice string `json:"tapDevice,omitempty"`
+	MAC       string `json:"mac,omitempty"`
+}
+
+func (s *Server) describe(vmi *v1.VirtualMachineInstance) VirtualMachineInstance {
+	description := VirtualMachineInstance{
+		Namespace:  vmi.Namespace,
+		Name:       vmi.Name,
+		UID:        vmi.UID,
+		Phase:      vmi.Status.Phase,
+		DomainName: api.VMINamespaceKeyFunc(vmi),
+		Interfaces: describeInterfaces(vmi),
+	}
+	if vmi.IsFinal() {
+		return description
+	}
+
+	isolationResult, err := s.isolationDetector.Detect(vmi)
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).V(4).Info("no isolation details for the node API")
+		return description
+	}
+	description.Pid = isolationResult.Pid()
+	description.NetworkNamespace = filepath.Join(cgroupconsts.ProcMountPoint, strconv.Itoa(isolationResult.Pid()), "ns", "net")
+	if description.CgroupPaths, err = s.cgroupPaths(isolationResult.Pid()); err != nil {
+		log.Log.Object(vmi).Reason(err).V(4).Info("no cgroup paths for the node API")
+	}
+	return description
+}
+
+func describeInterfaces(vmi *v1.VirtualMachineInstance) []Interface {
+	podIfaceNames := namescheme.UpdatePrimaryPodIfaceNameFromVMIStatus(
+		namescheme.CreateHashedNetworkNameScheme(vmi.Spec.Networks), vmi.Spec.Networks, vmi.Status.Interfaces)
+
+	var interfaces []Interface
+	for _, iface := range vmi.Spec.Domain.Devices.Interfaces {
+		description := Interface{Name: iface.Name}
+		if status := vmispec.LookupInterfaceStatusByName(vmi.Status.Interfaces, iface.Name); status != nil {
+			description.MAC = status.MAC
+			description.PodInterfaceName = status.PodInterfaceName
+		}
+		if description.PodInterfaceName == "" {
+			description.PodInterfaceName = podIfaceNames[iface.Name]
+		}
+		network := vmispec.LookupNetworkByName(vmi.Spec.Networks, iface.Name)
+		if network != nil && description.PodInterfaceName != "" && (iface.Bridge != nil || iface.Masquerade != nil) {
+			description.TapDevice = link.GenerateTapDeviceName(description.PodInterfaceName, *network)
+		}
+		interfaces = append(interfaces, description)
+	}
+	return interfaces
+}
+
+func readCgroupPaths(pid int) (map[string]string, error) {
+	paths, err := runc_cgroups.ParseCgroupFile(filepath.Join(cgroupconsts.ProcMountPoint, strconv.Itoa(pid), cgroupconsts.CgroupStr))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the cgroups of pid %d: %v", pid, err)
+	}
+	if path, ok := paths[""]; ok {
+		delete(paths, "")
+		paths[unifiedCgroup] = path
+	}
+	return paths, nil
+}
+
+func sortVirtualMachineInstances(vmis []VirtualMachineInstance) {
+	sort.Slice(vmis, func(i, j int) bool {
+		if vmis[i].Namespace != vmis[j].Namespace {
+			return vmis[i].Namespace < vmis[j].Namespace
+		}
+		return vmis[i].Name < vmis[j].Name
+	})
+}
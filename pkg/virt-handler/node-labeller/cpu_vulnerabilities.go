@@ -0,0 +1,103 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package nodelabeller
+
+import (
+	"bufio"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/validation"
+
+	kubevirtv1 "kubevirt.io/api/core/v1"
+)
+
+const (
+	cpuVulnerabilitiesPath = "/sys/devices/system/cpu/vulnerabilities"
+	cpuInfoPath            = "/proc/cpuinfo"
+)
+
+// getCPUVulnerabilities returns the mitigation status of the CPU vulnerabilities the kernel reports,
+// keyed by the name of the vulnerability, e.g. retbleed or mds
+func (n *NodeLabeller) getCPUVulnerabilities() (map[string]string, error) {
+	entries, err := os.ReadDir(n.cpuVulnerabilitiesPath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	vulnerabilities := map[string]string{}
+	for _, entry := range entries {
+		if entry.IsDir() || len(validation.IsQualifiedName(kubevirtv1.CPUVulnerabilityLabel+entry.Name())) > 0 {
+			continue
+		}
+		content, err := os.ReadFile(filepath.Join(n.cpuVulnerabilitiesPath, entry.Name()))
+		if err != nil {
+			return nil, err
+		}
+		vulnerabilities[entry.Name()] = cpuVulnerabilityStatus(string(content))
+	}
+	return vulnerabilities, nil
+}
+
+// cpuVulnerabilityStatus maps the content of a vulnerability file of the kernel, e.g.
+// "Mitigation: untrained return thunk; SMT enabled with STIBP protection", to its status
+func cpuVulnerabilityStatus(content string) string {
+	switch {
+	case strings.HasPrefix(content, "Not affected"):
+		return kubevirtv1.CPUVulnerabilityNotAffected
+	case strings.HasPrefix(content, "Mitigation"):
+		return kubevirtv1.CPUVulnerabilityMitigated
+	case strings.HasPrefix(content, "Vulnerable"):
+		return kubevirtv1.CPUVulnerabilityVulnerable
+	default:
+		return kubevirtv1.CPUVulnerabilityUnknown
+	}
+}
+
+// getCPUMicrocode returns the microcode revision of the first CPU listed in cpuinfo, or an empty string
+// if it is not reported
+func (n *NodeLabeller) getCPUMicrocode() (string, error) {
+	f, err := os.Open(n.cpuInfoPath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return "", nil
+		}
+		return "", err
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		key, value, found := strings.Cut(scanner.Text(), ":")
+		if !found || strings.TrimSpace(key) != "microcode" {
+			continue
+		}
+		revision := strings.TrimSpace(value)
+		if len(validation.IsValidLabelValue(revision)) > 0 {
+			return "", nil
+		}
+		return revision, nil
+	}
+	return "", scanner.Err()
+}
@@ -24,6 +24,7 @@ import (
 	"fmt"
 	"os/exec"
 	"runtime"
+	"strconv"
 	"strings"
 	"time"
 
@@ -58,6 +59,11 @@ var nodeLabellerLabels = []string{
 	kubevirtv1.HostModelRequiredFeaturesLabel,
 	kubevirtv1.NodeHostModelIsObsoleteLabel,
 	kubevirtv1.SupportedMachineTypeLabel,
+	kubevirtv1.NUMANodesLabel,
+	kubevirtv1.NUMANodeCPUsLabel,
+	kubevirtv1.CPUVulnerabilityLabel,
+	kubevirtv1.MitigatedCPUVulnerabilityLabel,
+	kubevirtv1.CPUMicrocodeLabel,
 }
 
 // NodeLabeller struct holds information needed to run node-labeller
@@ -76,16 +82,19 @@ type NodeLabeller struct {
 	domCapabilitiesFileName string
 	cpuCounter              *libvirtxml.CapsHostCPUCounter
 	guestCaps               []libvirtxml.CapsGuest
+	numaCells               []libvirtxml.CapsHostNUMACell
 	hostCPUModel            hostCPUModel
 	SEV                     SEVConfiguration
 	arch                    archLabeller
+	cpuVulnerabilitiesPath  string
+	cpuInfoPath             string
 }
 
-func NewNodeLabeller(clusterConfig *virtconfig.ClusterConfig, nodeClient k8scli.NodeInterface, host string, recorder record.EventRecorder, cpuCounter *libvirtxml.CapsHostCPUCounter, guestCaps []libvirtxml.CapsGuest) (*NodeLabeller, error) {
-	return newNodeLabeller(clusterConfig, nodeClient, host, NodeLabellerVolumePath, recorder, cpuCounter, guestCaps)
+func NewNodeLabeller(clusterConfig *virtconfig.ClusterConfig, nodeClient k8scli.NodeInterface, host string, recorder record.EventRecorder, cpuCounter *libvirtxml.CapsHostCPUCounter, guestCaps []libvirtxml.CapsGuest, numaCells []libvirtxml.CapsHostNUMACell) (*NodeLabeller, error) {
+	return newNodeLabeller(clusterConfig, nodeClient, host, NodeLabellerVolumePath, recorder, cpuCounter, guestCaps, numaCells)
 
 }
-func newNodeLabeller(clusterConfig *virtconfig.ClusterConfig, nodeClient k8scli.NodeInterface, host, volumePath string, recorder record.EventRecorder, cpuCounter *libvirtxml.CapsHostCPUCounter, guestCaps []libvirtxml.CapsGuest) (*NodeLabeller, error) {
+func newNodeLabeller(clusterConfig *virtconfig.ClusterConfig, nodeClient k8scli.NodeInterface, host, volumePath string, recorder record.EventRecorder, cpuCounter *libvirtxml.CapsHostCPUCounter, guestCaps []libvirtxml.CapsGuest, numaCells []libvirtxml.CapsHostNUMACell) (*NodeLabeller, error) {
 	n := &NodeLabeller{
 		recorder:      recorder,
 		nodeClient:    nodeClient,
@@ -100,8 +109,11 @@ func newNodeLabeller(clusterConfig *virtconfig.ClusterConfig, nodeClient k8scli.
 		domCapabilitiesFileName: "virsh_domcapabilities.xml",
 		cpuCounter:              cpuCounter,
 		guestCaps:               guestCaps,
+		numaCells:               numaCells,
 		hostCPUModel:            hostCPUModel{requiredFeatures: make(map[string]bool, 0)},
 		arch:                    newArchLabeller(runtime.GOARCH),
+		cpuVulnerabilitiesPath:  cpuVulnerabilitiesPath,
+		cpuInfoPath:             cpuInfoPath,
 	}
 
 	err := n.loadAll()
@@ -297,6 +309,30 @@ func (n *NodeLabeller) prepareLabels(node *v1.Node, cpuModels []string, cpuFeatu
 		newLabels[kubevirtv1.SEVESLabel] = ""
 	}
 
+	if len(n.numaCells) > 0 {
+		newLabels[kubevirtv1.NUMANodesLabel] = strconv.Itoa(len(n.numaCells))
+		newLabels[kubevirtv1.NUMANodeCPUsLabel] = strconv.Itoa(smallestNUMACellCPUs(n.numaCells))
+	}
+
+	vulnerabilities, err := n.getCPUVulnerabilities()
+	if err != nil {
+		n.logger.Reason(err).Error("failed to read the CPU vulnerabilities of the node")
+	}
+	for name, status := range vulnerabilities {
+		newLabels[kubevirtv1.CPUVulnerabilityLabel+name] = status
+		if status == kubevirtv1.CPUVulnerabilityMitigated || status == kubevirtv1.CPUVulnerabilityNotAffected {
+			newLabels[kubevirtv1.MitigatedCPUVulnerabilityLabel+name] = "true"
+		}
+	}
+
+	microcode, err := n.getCPUMicrocode()
+	if err != nil {
+		n.logger.Reason(err).Error("failed to read the CPU microcode revision of the node")
+	}
+	if microcode != "" {
+		newLabels[kubevirtv1.CPUMicrocodeLabel] = microcode
+	}
+
 	return newLabels
 }
 
@@ -332,6 +368,21 @@ func isNodeRealtimeCapable() (bool, error) {
 	return fmt.Sprintf("%s = -1", kernelSchedRealtimeRuntimeInMicrosecods) == st, nil
 }
 
+// smallestNUMACellCPUs returns the number of CPUs of the host NUMA cell with the fewest CPUs
+func smallestNUMACellCPUs(cells []libvirtxml.CapsHostNUMACell) int {
+	smallest := -1
+	for _, cell := range cells {
+		cpus := 0
+		if cell.CPUS != nil {
+			cpus = len(cell.CPUS.CPUs)
+		}
+		if smallest < 0 || cpus < smallest {
+			smallest = cpus
+		}
+	}
+	return smallest
+}
+
 func isNodeLabellerLabel(label string) bool {
 	for _, prefix := range nodeLabellerLabels {
 		if strings.HasPrefix(label, prefix) {
@@ -49,6 +49,7 @@ var _ = Describe("Node-labeller ", func() {
 	var kubeClient *fake.Clientset
 	var cpuCounter *libvirtxml.CapsHostCPUCounter
 	var guestsCaps []libvirtxml.CapsGuest
+	var numaCells []libvirtxml.CapsHostNUMACell
 
 	initNodeLabeller := func(kubevirt *v1.KubeVirt) {
 		config, _, _ := testutils.NewFakeClusterConfigUsingKV(kubevirt)
@@ -56,8 +57,10 @@ var _ = Describe("Node-labeller ", func() {
 		recorder.IncludeObject = true
 
 		var err error
-		nlController, err = newNodeLabeller(config, kubeClient.CoreV1().Nodes(), nodeName, "testdata", recorder, cpuCounter, guestsCaps)
+		nlController, err = newNodeLabeller(config, kubeClient.CoreV1().Nodes(), nodeName, "testdata", recorder, cpuCounter, guestsCaps, numaCells)
 		Expect(err).ToNot(HaveOccurred())
+		nlController.cpuVulnerabilitiesPath = "testdata/cpu_vulnerabilities"
+		nlController.cpuInfoPath = "testdata/cpuinfo"
 	}
 
 	BeforeEach(func() {
@@ -78,6 +81,11 @@ var _ = Describe("Node-labeller ", func() {
 			},
 		}
 
+		numaCells = []libvirtxml.CapsHostNUMACell{
+			{ID: 0, CPUS: &libvirtxml.CapsHostNUMACPUs{Num: 4, CPUs: []libvirtxml.CapsHostNUMACPU{{ID: 0}, {ID: 1}, {ID: 2}, {ID: 3}}}},
+			{ID: 1, CPUS: &libvirtxml.CapsHostNUMACPUs{Num: 2, CPUs: []libvirtxml.CapsHostNUMACPU{{ID: 4}, {ID: 5}}}},
+		}
+
 		node := newNode(nodeName)
 		kubeClient = fake.NewSimpleClientset(node)
 		initNodeLabeller(&v1.KubeVirt{
@@ -166,6 +174,56 @@ var _ = Describe("Node-labeller ", func() {
 		Expect(node.Labels).To(HaveKey(v1.SEVESLabel))
 	})
 
+	It("should add NUMA labels", func() {
+		res := nlController.execute()
+		Expect(res).To(BeTrue())
+
+		node := retrieveNode(kubeClient)
+		Expect(node.Labels).To(HaveKeyWithValue(v1.NUMANodesLabel, "2"))
+		Expect(node.Labels).To(HaveKeyWithValue(v1.NUMANodeCPUsLabel, "2"))
+	})
+
+	It("should add CPU vulnerability labels", func() {
+		res := nlController.execute()
+		Expect(res).To(BeTrue())
+
+		node := retrieveNode(kubeClient)
+		Expect(node.Labels).To(SatisfyAll(
+			HaveKeyWithValue(v1.CPUVulnerabilityLabel+"retbleed", v1.CPUVulnerabilityMitigated),
+			HaveKeyWithValue(v1.CPUVulnerabilityLabel+"mds", v1.CPUVulnerabilityMitigated),
+			HaveKeyWithValue(v1.CPUVulnerabilityLabel+"meltdown", v1.CPUVulnerabilityNotAffected),
+			HaveKeyWithValue(v1.CPUVulnerabilityLabel+"srbds", v1.CPUVulnerabilityVulnerable),
+			HaveKeyWithValue(v1.CPUVulnerabilityLabel+"gather_data_sampling", v1.CPUVulnerabilityUnknown),
+			HaveKeyWithValue(v1.MitigatedCPUVulnerabilityLabel+"retbleed", "true"),
+			HaveKeyWithValue(v1.MitigatedCPUVulnerabilityLabel+"mds", "true"),
+			HaveKeyWithValue(v1.MitigatedCPUVulnerabilityLabel+"meltdown", "true"),
+			Not(HaveKey(v1.MitigatedCPUVulnerabilityLabel+"srbds")),
+			Not(HaveKey(v1.MitigatedCPUVulnerabilityLabel+"gather_data_sampling")),
+		))
+	})
+
+	It("should add CPU microcode label", func() {
+		res := nlController.execute()
+		Expect(res).To(BeTrue())
+
+		node := retrieveNode(kubeClient)
+		Expect(node.Labels).To(HaveKeyWithValue(v1.CPUMicrocodeLabel, "0x2007006"))
+	})
+
+	It("should not add CPU vulnerability and microcode labels when the kernel does not report them", func() {
+		nlController.cpuVulnerabilitiesPath = "testdata/missing"
+		nlController.cpuInfoPath = "testdata/missing"
+		res := nlController.execute()
+		Expect(res).To(BeTrue())
+
+		node := retrieveNode(kubeClient)
+		for label := range node.Labels {
+			Expect(label).ToNot(HavePrefix(v1.CPUVulnerabilityLabel))
+			Expect(label).ToNot(HavePrefix(v1.MitigatedCPUVulnerabilityLabel))
+		}
+		Expect(node.Labels).ToNot(HaveKey(v1.CPUMicrocodeLabel))
+	})
+
 	It("should add usable cpu model labels for the host cpu model", func() {
 		res := nlController.execute()
 		Expect(res).To(BeTrue())
@@ -0,0 +1,138 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package nodemaintenance
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	k8scli "k8s.io/client-go/kubernetes/typed/core/v1"
+	"k8s.io/client-go/tools/cache"
+
+	v1 "kubevirt.io/api/core/v1"
+	kubevirtv1 "kubevirt.io/client-go/kubevirt/typed/core/v1"
+	"kubevirt.io/client-go/log"
+
+	migrationutils "kubevirt.io/kubevirt/pkg/util/migrations"
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
+)
+
+// Interval is the interval in which the maintenance of the node is driven
+const Interval = 10 * time.Second
+
+// Evacuator drives the maintenance of the node. While the node has the maintenance annotation, it stops the
+// scheduling of VMIs to the node, marks the VMIs which can be live migrated for evacuation and reports the
+// progress of the evacuation on the node. The evacuation controller then migrates the marked VMIs away.
+type Evacuator struct {
+	nodes         k8scli.NodeInterface
+	virtClient    kubevirtv1.KubevirtV1Interface
+	vmiStore      cache.Store
+	clusterConfig *virtconfig.ClusterConfig
+	host          string
+}
+
+func NewEvacuator(nodes k8scli.NodeInterface, virtClient kubevirtv1.KubevirtV1Interface, vmiStore cache.Store,
+	clusterConfig *virtconfig.ClusterConfig, host string) *Evacuator {
+	return &Evacuator{
+		nodes:         nodes,
+		virtClient:    virtClient,
+		vmiStore:      vmiStore,
+		clusterConfig: clusterConfig,
+		host:          host,
+	}
+}
+
+func (e *Evacuator) Run(interval time.Duration, stopCh <-chan struct{}) {
+	wait.Until(e.evacuate, interval, stopCh)
+}
+
+func (e *Evacuator) evacuate() {
+	node, err := e.nodes.Get(context.Background(), e.host, metav1.GetOptions{})
+	if err != nil {
+		log.Log.Reason(err).Errorf("Can't get node %s", e.host)
+		return
+	}
+
+	if _, inMaintenance := node.Annotations[v1.NodeMaintenanceAnnotation]; !inMaintenance {
+		// The heartbeat makes the node schedulable again
+		if _, reported := node.Labels[v1.NodeMaintenanceStateLabel]; reported {
+			e.patchNode(fmt.Sprintf(`{"metadata": {"labels": {"%s": null}, "annotations": {"%s": null}}}`,
+				v1.NodeMaintenanceStateLabel, v1.NodeMaintenanceRemainingVMIsAnnotation))
+		}
+		return
+	}
+
+	remaining, evacuable := 0, 0
+	for _, obj := range e.vmiStore.List() {
+		vmi := obj.(*v1.VirtualMachineInstance)
+		if vmi.Status.NodeName != e.host || vmi.IsFinal() {
+			continue
+		}
+		remaining++
+		if !vmi.IsMigratable() || !migrationutils.VMIMigratableOnEviction(e.clusterConfig, vmi) {
+			continue
+		}
+		evacuable++
+		if !vmi.IsMarkedForEviction() {
+			e.markForEvacuation(vmi)
+		}
+	}
+
+	state := maintenanceState(remaining, evacuable)
+	if node.Labels[v1.NodeSchedulable] == "false" && node.Labels[v1.NodeMaintenanceStateLabel] == state &&
+		node.Annotations[v1.NodeMaintenanceRemainingVMIsAnnotation] == strconv.Itoa(remaining) {
+		return
+	}
+	e.patchNode(fmt.Sprintf(`{"metadata": {"labels": {"%s": "false", "%s": "%s"}, "annotations": {"%s": "%d"}}}`,
+		v1.NodeSchedulable, v1.NodeMaintenanceStateLabel, state, v1.NodeMaintenanceRemainingVMIsAnnotation, remaining))
+}
+
+// markForEvacuation marks the VMI for evacuation the same way the eviction of its pod does
+func (e *Evacuator) markForEvacuation(vmi *v1.VirtualMachineInstance) {
+	vmiCopy := vmi.DeepCopy()
+	vmiCopy.Status.EvacuationNodeName = e.host
+	if _, err := e.virtClient.VirtualMachineInstances(vmi.Namespace).Update(context.Background(), vmiCopy, metav1.UpdateOptions{}); err != nil {
+		log.Log.Object(vmi).Reason(err).Error("failed to mark the VMI for evacuation")
+		return
+	}
+	log.Log.Object(vmi).Infof("Marked the VMI for evacuation from node %s in maintenance", e.host)
+}
+
+func (e *Evacuator) patchNode(patch string) {
+	if _, err := e.nodes.Patch(context.Background(), e.host, types.MergePatchType, []byte(patch), metav1.PatchOptions{}); err != nil {
+		log.Log.Reason(err).Errorf("Can't patch the maintenance state of node %s", e.host)
+	}
+}
+
+func maintenanceState(remaining, evacuable int) string {
+	switch {
+	case remaining == 0:
+		return v1.NodeMaintenanceCompleted
+	case evacuable == 0:
+		return v1.NodeMaintenanceBlocked
+	default:
+		return v1.NodeMaintenanceEvacuating
+	}
+}
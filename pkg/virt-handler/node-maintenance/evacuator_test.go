@@ -0,0 +1,162 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package nodemaintenance
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	k8sv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/cache"
+
+	v1 "kubevirt.io/api/core/v1"
+	kubevirtfake "kubevirt.io/client-go/kubevirt/fake"
+
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/testutils"
+)
+
+var _ = Describe("Node maintenance evacuator", func() {
+	const host = "node01"
+
+	var (
+		client     *fake.Clientset
+		virtClient *kubevirtfake.Clientset
+		vmiStore   cache.Store
+		evacuator  *Evacuator
+	)
+
+	newVMI := func(name string, strategy v1.EvictionStrategy, migratable bool) *v1.VirtualMachineInstance {
+		vmi := &v1.VirtualMachineInstance{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: metav1.NamespaceDefault},
+			Spec:       v1.VirtualMachineInstanceSpec{EvictionStrategy: pointer.P(strategy)},
+			Status: v1.VirtualMachineInstanceStatus{
+				Phase:    v1.Running,
+				NodeName: host,
+			},
+		}
+		if migratable {
+			vmi.Status.Conditions = []v1.VirtualMachineInstanceCondition{
+				{Type: v1.VirtualMachineInstanceIsMigratable, Status: k8sv1.ConditionTrue},
+			}
+		}
+		return vmi
+	}
+
+	addVMI := func(vmi *v1.VirtualMachineInstance) {
+		Expect(vmiStore.Add(vmi)).To(Succeed())
+		_, err := virtClient.KubevirtV1().VirtualMachineInstances(vmi.Namespace).Create(context.Background(), vmi, metav1.CreateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	getNode := func() *k8sv1.Node {
+		node, err := client.CoreV1().Nodes().Get(context.Background(), host, metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		return node
+	}
+
+	setMaintenance := func(inMaintenance bool) {
+		node := getNode()
+		if inMaintenance {
+			node.Annotations = map[string]string{v1.NodeMaintenanceAnnotation: "kernel upgrade"}
+		} else {
+			delete(node.Annotations, v1.NodeMaintenanceAnnotation)
+		}
+		_, err := client.CoreV1().Nodes().Update(context.Background(), node, metav1.UpdateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	evacuationNodeName := func(name string) string {
+		vmi, err := virtClient.KubevirtV1().VirtualMachineInstances(metav1.NamespaceDefault).Get(context.Background(), name, metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		return vmi.Status.EvacuationNodeName
+	}
+
+	BeforeEach(func() {
+		client = fake.NewSimpleClientset(&k8sv1.Node{
+			ObjectMeta: metav1.ObjectMeta{Name: host, Labels: map[string]string{v1.NodeSchedulable: "true"}},
+		})
+		virtClient = kubevirtfake.NewSimpleClientset()
+		vmiStore = cache.NewStore(cache.MetaNamespaceKeyFunc)
+		clusterConfig, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{})
+		evacuator = NewEvacuator(client.CoreV1().Nodes(), virtClient.KubevirtV1(), vmiStore, clusterConfig, host)
+	})
+
+	It("should leave nodes which are not in maintenance alone", func() {
+		addVMI(newVMI("migratable", v1.EvictionStrategyLiveMigrate, true))
+
+		evacuator.evacuate()
+
+		Expect(getNode().Labels).To(Equal(map[string]string{v1.NodeSchedulable: "true"}))
+		Expect(evacuationNodeName("migratable")).To(BeEmpty())
+	})
+
+	It("should stop the scheduling to the node and evacuate the migratable VMIs", func() {
+		addVMI(newVMI("migratable", v1.EvictionStrategyLiveMigrate, true))
+		addVMI(newVMI("not-migratable", v1.EvictionStrategyLiveMigrateIfPossible, false))
+		addVMI(newVMI("not-evicted", v1.EvictionStrategyNone, true))
+		setMaintenance(true)
+
+		evacuator.evacuate()
+
+		node := getNode()
+		Expect(node.Labels).To(HaveKeyWithValue(v1.NodeSchedulable, "false"))
+		Expect(node.Labels).To(HaveKeyWithValue(v1.NodeMaintenanceStateLabel, v1.NodeMaintenanceEvacuating))
+		Expect(node.Annotations).To(HaveKeyWithValue(v1.NodeMaintenanceRemainingVMIsAnnotation, "3"))
+		Expect(evacuationNodeName("migratable")).To(Equal(host))
+		Expect(evacuationNodeName("not-migratable")).To(BeEmpty())
+		Expect(evacuationNodeName("not-evicted")).To(BeEmpty())
+	})
+
+	DescribeTable("should report the maintenance", func(vmis []*v1.VirtualMachineInstance, state, remaining string) {
+		for _, vmi := range vmis {
+			addVMI(vmi)
+		}
+		setMaintenance(true)
+
+		evacuator.evacuate()
+
+		node := getNode()
+		Expect(node.Labels).To(HaveKeyWithValue(v1.NodeMaintenanceStateLabel, state))
+		Expect(node.Annotations).To(HaveKeyWithValue(v1.NodeMaintenanceRemainingVMIsAnnotation, remaining))
+	},
+		Entry("as completed when no VMI is left", nil, v1.NodeMaintenanceCompleted, "0"),
+		Entry("as blocked when only VMIs which can not be migrated are left",
+			[]*v1.VirtualMachineInstance{newVMI("not-migratable", v1.EvictionStrategyLiveMigrate, false)},
+			v1.NodeMaintenanceBlocked, "1"),
+	)
+
+	It("should remove the maintenance state when the maintenance is over", func() {
+		setMaintenance(true)
+		evacuator.evacuate()
+		Expect(getNode().Labels).To(HaveKey(v1.NodeMaintenanceStateLabel))
+
+		setMaintenance(false)
+		evacuator.evacuate()
+
+		node := getNode()
+		Expect(node.Labels).ToNot(HaveKey(v1.NodeMaintenanceStateLabel))
+		Expect(node.Annotations).ToNot(HaveKey(v1.NodeMaintenanceRemainingVMIsAnnotation))
+	})
+})
@@ -59,10 +59,11 @@ func virtualMachineOptions(
 		}
 		options.ExpandDisksEnabled = clusterConfig.ExpandDisksEnabled()
 		options.ClusterConfig = &cmdv1.ClusterConfig{
-			ExpandDisksEnabled:        clusterConfig.ExpandDisksEnabled(),
-			FreePageReportingDisabled: clusterConfig.IsFreePageReportingDisabled(),
-			BochsDisplayForEFIGuests:  bochsDisplay,
-			SerialConsoleLogDisabled:  clusterConfig.IsSerialConsoleLogDisabled(),
+			ExpandDisksEnabled:            clusterConfig.ExpandDisksEnabled(),
+			FreePageReportingDisabled:     clusterConfig.IsFreePageReportingDisabled(),
+			BochsDisplayForEFIGuests:      bochsDisplay,
+			SerialConsoleLogDisabled:      clusterConfig.IsSerialConsoleLogDisabled(),
+			BlockLatencyHistogramsEnabled: clusterConfig.BlockLatencyHistogramsEnabled(),
 		}
 	}
 
@@ -120,7 +120,7 @@ func (lh *LifecycleHandler) FreezeHandler(request *restful.Request, response *re
 	}
 
 	unfreezeTimeoutSeconds := int32(unfreezeTimeout.UnfreezeTimeout.Seconds())
-	err = client.FreezeVirtualMachine(vmi, unfreezeTimeoutSeconds)
+	err = client.FreezeVirtualMachine(vmi, unfreezeTimeoutSeconds, unfreezeTimeout.Volumes)
 	if err != nil {
 		log.Log.Object(vmi).Reason(err).Error(failedFreezeVMI)
 		response.WriteError(http.StatusBadRequest, err)
@@ -0,0 +1,134 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package rest
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/emicklei/go-restful/v3"
+	"github.com/gorilla/websocket"
+
+	v1 "kubevirt.io/api/core/v1"
+	kvcorev1 "kubevirt.io/client-go/kubevirt/typed/core/v1"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/network/link"
+	"kubevirt.io/kubevirt/pkg/network/namescheme"
+	"kubevirt.io/kubevirt/pkg/network/netns"
+	"kubevirt.io/kubevirt/pkg/network/pcap"
+	"kubevirt.io/kubevirt/pkg/network/vmispec"
+)
+
+// PacketCaptureHandler streams the traffic of the tap device of a VMI interface in the pcap format.
+// The capture lasts for the requested duration, or until the client disconnects.
+func (t *ConsoleHandler) PacketCaptureHandler(request *restful.Request, response *restful.Response) {
+	vmi, code, err := getVMI(request, t.vmiStore)
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Error(failedRetrieveVMI)
+		response.WriteError(code, err)
+		return
+	}
+
+	tapName, err := tapDeviceName(vmi, request.QueryParameter("interface"))
+	if err != nil {
+		response.WriteError(http.StatusBadRequest, err)
+		return
+	}
+	var duration time.Duration
+	if durationParam := request.QueryParameter("duration"); durationParam != "" {
+		if duration, err = time.ParseDuration(durationParam); err != nil {
+			response.WriteError(http.StatusBadRequest, err)
+			return
+		}
+	}
+
+	isolationResult, err := t.podIsolationDetector.Detect(vmi)
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Error("Failed to detect the network namespace for the packet capture")
+		response.WriteError(http.StatusInternalServerError, err)
+		return
+	}
+
+	clientSocket, err := kvcorev1.NewUpgrader().Upgrade(response.ResponseWriter, request.Request, nil)
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Error("Failed to upgrade client websocket connection")
+		response.WriteError(http.StatusInternalServerError, err)
+		return
+	}
+	defer clientSocket.Close()
+
+	ctx, cancel := context.WithCancel(request.Request.Context())
+	defer cancel()
+	if duration > 0 {
+		ctx, cancel = context.WithTimeout(ctx, duration)
+		defer cancel()
+	}
+
+	pcapReader, pcapWriter := io.Pipe()
+	copyDone := make(chan struct{})
+	go func() {
+		defer close(copyDone)
+		_, err := kvcorev1.CopyTo(clientSocket, pcapReader)
+		pcapReader.CloseWithError(err)
+		cancel()
+	}()
+	go func() {
+		// Nothing is expected from the client, reading only notices it disconnected
+		_, _ = kvcorev1.CopyFrom(io.Discard, clientSocket)
+		cancel()
+	}()
+
+	log.Log.Object(vmi).Infof("Capturing the traffic of %s", tapName)
+	err = pcap.Capture(netns.New(isolationResult.Pid()), tapName, pcapWriter, ctx.Done())
+	pcapWriter.CloseWithError(err)
+	<-copyDone
+	// A normal closure lets the client tell a complete capture from a broken connection
+	_ = clientSocket.WriteControl(websocket.CloseMessage,
+		websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""), time.Now().Add(time.Second))
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Infof("Packet capture of %s ended", tapName)
+	}
+}
+
+// tapDeviceName returns the tap device connecting the guest interface, only the bridge and masquerade bindings have one.
+func tapDeviceName(vmi *v1.VirtualMachineInstance, ifaceName string) (string, error) {
+	iface := vmispec.LookupInterfaceByName(vmi.Spec.Domain.Devices.Interfaces, ifaceName)
+	if iface == nil {
+		return "", fmt.Errorf("interface %s not found", ifaceName)
+	}
+	network := vmispec.LookupNetworkByName(vmi.Spec.Networks, ifaceName)
+	if network == nil || (iface.Bridge == nil && iface.Masquerade == nil) {
+		return "", fmt.Errorf("interface %s has no tap device to capture", ifaceName)
+	}
+
+	podIfaceName := ""
+	if ifaceStatus := vmispec.LookupInterfaceStatusByName(vmi.Status.Interfaces, ifaceName); ifaceStatus != nil {
+		podIfaceName = ifaceStatus.PodInterfaceName
+	}
+	if podIfaceName == "" {
+		podIfaceName = namescheme.UpdatePrimaryPodIfaceNameFromVMIStatus(
+			namescheme.CreateHashedNetworkNameScheme(vmi.Spec.Networks), vmi.Spec.Networks, vmi.Status.Interfaces)[ifaceName]
+	}
+	return link.GenerateTapDeviceName(podIfaceName, *network), nil
+}
@@ -0,0 +1,255 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package swap
+
+import (
+	"context"
+	"time"
+
+	"github.com/prometheus/procfs"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	k8scli "k8s.io/client-go/kubernetes/typed/core/v1"
+	"k8s.io/client-go/tools/cache"
+
+	v1 "kubevirt.io/api/core/v1"
+	kubevirtv1 "kubevirt.io/client-go/kubevirt/typed/core/v1"
+	"kubevirt.io/client-go/log"
+
+	metrics "kubevirt.io/kubevirt/pkg/monitoring/metrics/virt-handler"
+	migrationutils "kubevirt.io/kubevirt/pkg/util/migrations"
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
+	"kubevirt.io/kubevirt/pkg/virt-handler/cgroup"
+)
+
+// Interval is the interval in which the swap of the overcommitted VMIs is configured and the memory pressure
+// of the node is measured. Configuring the swap periodically also restores it after the kubelet updated the cgroups.
+const Interval = 30 * time.Second
+
+type cgroupManagerFunc func(vmi *v1.VirtualMachineInstance) (cgroup.Manager, error)
+
+// Manager lets the virt-launcher pods of the overcommitted VMIs on the node swap out the guest memory above
+// their memory request, while protecting the requested memory from reclaim. When the swap usage or the memory
+// pressure of the node exceed the thresholds of the swap configuration, it marks the overcommitted VMI using
+// the most swap for evacuation, so that it gets live migrated away before the node runs out of memory.
+type Manager struct {
+	nodes         k8scli.NodeInterface
+	virtClient    kubevirtv1.KubevirtV1Interface
+	vmiStore      cache.Store
+	clusterConfig *virtconfig.ClusterConfig
+	host          string
+	procPath      string
+	cgroupManager cgroupManagerFunc
+	configured    map[types.UID]struct{}
+}
+
+func NewManager(nodes k8scli.NodeInterface, virtClient kubevirtv1.KubevirtV1Interface, vmiStore cache.Store,
+	clusterConfig *virtconfig.ClusterConfig, host string) *Manager {
+	return &Manager{
+		nodes:         nodes,
+		virtClient:    virtClient,
+		vmiStore:      vmiStore,
+		clusterConfig: clusterConfig,
+		host:          host,
+		procPath:      procfs.DefaultMountPoint,
+		cgroupManager: func(vmi *v1.VirtualMachineInstance) (cgroup.Manager, error) {
+			return cgroup.NewManagerFromVM(vmi, host)
+		},
+		configured: map[types.UID]struct{}{},
+	}
+}
+
+func (m *Manager) Run(interval time.Duration, stopCh <-chan struct{}) {
+	wait.Until(m.sync, interval, stopCh)
+}
+
+type evictionCandidate struct {
+	vmi      *v1.VirtualMachineInstance
+	swapUsed uint64
+}
+
+func (m *Manager) sync() {
+	metrics.ResetLauncherSwapUsed()
+
+	node, err := m.nodes.Get(context.Background(), m.host, metav1.GetOptions{})
+	if err != nil {
+		log.Log.Reason(err).Errorf("Can't get node %s", m.host)
+		return
+	}
+	conf := m.clusterConfig.GetSwapConfiguration(node)
+	vmis := m.listVMIsOnNode()
+
+	overcommitted := map[types.UID]struct{}{}
+	var candidates []evictionCandidate
+	evacuating := false
+	for _, vmi := range vmis {
+		if vmi.IsMarkedForEviction() || migrationutils.IsMigrating(vmi) {
+			evacuating = true
+		}
+		excess, request := overcommittedMemory(vmi)
+		if conf == nil || excess == 0 {
+			continue
+		}
+		overcommitted[vmi.UID] = struct{}{}
+
+		manager, err := m.cgroupManager(vmi)
+		if err != nil {
+			log.Log.Object(vmi).Reason(err).V(4).Info("failed to get the cgroup manager of the launcher")
+			continue
+		}
+		if err := configureSwap(manager, excess, request); err != nil {
+			log.Log.Object(vmi).Reason(err).Error("failed to configure the swap of the launcher")
+			continue
+		}
+		m.configured[vmi.UID] = struct{}{}
+
+		swapUsed, err := manager.GetMemorySwapUsage()
+		if err != nil {
+			log.Log.Object(vmi).Reason(err).V(4).Info("failed to read the swap usage of the launcher")
+			continue
+		}
+		metrics.SetLauncherSwapUsed(vmi.Namespace, vmi.Name, m.host, swapUsed)
+		if vmi.IsMigratable() && migrationutils.VMIMigratableOnEviction(m.clusterConfig, vmi) {
+			candidates = append(candidates, evictionCandidate{vmi: vmi, swapUsed: swapUsed})
+		}
+	}
+	m.unconfigure(vmis, overcommitted)
+
+	if !m.measurePressure(conf) || evacuating {
+		return
+	}
+	var heaviest *evictionCandidate
+	for i := range candidates {
+		if heaviest == nil || candidates[i].swapUsed > heaviest.swapUsed {
+			heaviest = &candidates[i]
+		}
+	}
+	if heaviest != nil {
+		m.evacuate(heaviest.vmi)
+	}
+}
+
+func (m *Manager) listVMIsOnNode() []*v1.VirtualMachineInstance {
+	var vmis []*v1.VirtualMachineInstance
+	for _, obj := range m.vmiStore.List() {
+		vmi := obj.(*v1.VirtualMachineInstance)
+		if vmi.IsRunning() && vmi.Status.NodeName == m.host {
+			vmis = append(vmis, vmi)
+		}
+	}
+	return vmis
+}
+
+// unconfigure takes the swap away from the launchers which were configured before and are no longer overcommitted,
+// e.g. because the swap configuration was removed or no longer selects the node
+func (m *Manager) unconfigure(vmis []*v1.VirtualMachineInstance, overcommitted map[types.UID]struct{}) {
+	onNode := map[types.UID]*v1.VirtualMachineInstance{}
+	for _, vmi := range vmis {
+		onNode[vmi.UID] = vmi
+	}
+	for uid := range m.configured {
+		if _, exists := overcommitted[uid]; exists {
+			continue
+		}
+		vmi, exists := onNode[uid]
+		if !exists {
+			delete(m.configured, uid)
+			continue
+		}
+		manager, err := m.cgroupManager(vmi)
+		if err != nil {
+			log.Log.Object(vmi).Reason(err).V(4).Info("failed to get the cgroup manager of the launcher")
+			continue
+		}
+		if err := configureSwap(manager, 0, 0); err != nil {
+			log.Log.Object(vmi).Reason(err).Error("failed to reset the swap of the launcher")
+			continue
+		}
+		delete(m.configured, uid)
+	}
+}
+
+// measurePressure reports the swap usage and the memory pressure of the node, and tells if one of them exceeds
+// its eviction threshold
+func (m *Manager) measurePressure(conf *v1.SwapConfiguration) bool {
+	fs, err := procfs.NewFS(m.procPath)
+	if err != nil {
+		log.Log.Reason(err).Info("failed to access /proc")
+		return false
+	}
+
+	exceeded := false
+	if memInfo, err := fs.Meminfo(); err != nil {
+		log.Log.Reason(err).V(4).Info("failed to read the memory info of the node")
+	} else if memInfo.SwapTotalBytes != nil && memInfo.SwapFreeBytes != nil {
+		total, used := *memInfo.SwapTotalBytes, *memInfo.SwapTotalBytes-*memInfo.SwapFreeBytes
+		metrics.SetNodeSwapUsed(m.host, used)
+		if conf != nil && conf.EvictionSwapUsedPercent != nil && total > 0 &&
+			used*100 > total*uint64(*conf.EvictionSwapUsedPercent) {
+			exceeded = true
+		}
+	}
+
+	if psi, err := fs.PSIStatsForResource("memory"); err != nil {
+		log.Log.Reason(err).V(4).Info("failed to read the memory pressure of the node")
+	} else if psi.Full != nil {
+		metrics.SetNodeMemoryPressure(m.host, psi.Full.Avg10/100)
+		if conf != nil && conf.EvictionMemoryPressurePercent != nil &&
+			psi.Full.Avg10 > float64(*conf.EvictionMemoryPressurePercent) {
+			exceeded = true
+		}
+	}
+	return exceeded
+}
+
+// evacuate marks the VMI for evacuation the same way the eviction of its pod does, the evacuation controller
+// then migrates it away from the node
+func (m *Manager) evacuate(vmi *v1.VirtualMachineInstance) {
+	vmiCopy := vmi.DeepCopy()
+	vmiCopy.Status.EvacuationNodeName = m.host
+	if _, err := m.virtClient.VirtualMachineInstances(vmi.Namespace).Update(context.Background(), vmiCopy, metav1.UpdateOptions{}); err != nil {
+		log.Log.Object(vmi).Reason(err).Error("failed to mark the VMI for evacuation")
+		return
+	}
+	log.Log.Object(vmi).Infof("Marked the VMI for evacuation from node %s under memory pressure", m.host)
+}
+
+// overcommittedMemory returns the guest memory of the VMI above its memory request and the memory request.
+// VMIs backed by hugepages are never overcommitted, hugepages can not be swapped.
+func overcommittedMemory(vmi *v1.VirtualMachineInstance) (excess, request uint64) {
+	memory := vmi.Spec.Domain.Memory
+	if memory == nil || memory.Guest == nil || memory.Hugepages != nil {
+		return 0, 0
+	}
+	guest := memory.Guest.Value()
+	requested := vmi.Spec.Domain.Resources.Requests.Memory().Value()
+	if guest <= requested {
+		return 0, 0
+	}
+	return uint64(guest - requested), uint64(requested)
+}
+
+func configureSwap(manager cgroup.Manager, swapMax, low uint64) error {
+	if err := manager.SetMemorySwapMax(swapMax); err != nil {
+		return err
+	}
+	return manager.SetMemoryLow(low)
+}
@@ -0,0 +1,221 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package swap
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/cache"
+
+	v1 "kubevirt.io/api/core/v1"
+	kubevirtfake "kubevirt.io/client-go/kubevirt/fake"
+
+	metrics "kubevirt.io/kubevirt/pkg/monitoring/metrics/virt-handler"
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/testutils"
+	"kubevirt.io/kubevirt/pkg/virt-handler/cgroup"
+)
+
+var _ = Describe("Swap manager", func() {
+	const (
+		host     = "node01"
+		swapNode = "swap"
+	)
+
+	var (
+		ctrl       *gomock.Controller
+		client     *fake.Clientset
+		virtClient *kubevirtfake.Clientset
+		vmiStore   cache.Store
+		managers   map[string]*cgroup.MockManager
+		procPath   string
+	)
+
+	newVMI := func(name, guest, request string) *v1.VirtualMachineInstance {
+		vmi := &v1.VirtualMachineInstance{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: metav1.NamespaceDefault, UID: types.UID(name)},
+			Spec: v1.VirtualMachineInstanceSpec{
+				EvictionStrategy: pointer.P(v1.EvictionStrategyLiveMigrate),
+			},
+			Status: v1.VirtualMachineInstanceStatus{
+				Phase:    v1.Running,
+				NodeName: host,
+				Conditions: []v1.VirtualMachineInstanceCondition{
+					{Type: v1.VirtualMachineInstanceIsMigratable, Status: k8sv1.ConditionTrue},
+				},
+			},
+		}
+		vmi.Spec.Domain.Memory = &v1.Memory{Guest: pointer.P(resource.MustParse(guest))}
+		vmi.Spec.Domain.Resources.Requests = k8sv1.ResourceList{k8sv1.ResourceMemory: resource.MustParse(request)}
+		return vmi
+	}
+
+	addVMI := func(vmi *v1.VirtualMachineInstance) *cgroup.MockManager {
+		Expect(vmiStore.Add(vmi)).To(Succeed())
+		_, err := virtClient.KubevirtV1().VirtualMachineInstances(vmi.Namespace).Create(context.Background(), vmi, metav1.CreateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		managers[vmi.Name] = cgroup.NewMockManager(ctrl)
+		return managers[vmi.Name]
+	}
+
+	newManager := func(conf *v1.SwapConfiguration) *Manager {
+		clusterConfig, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{SwapConfiguration: conf})
+		manager := NewManager(client.CoreV1().Nodes(), virtClient.KubevirtV1(), vmiStore, clusterConfig, host)
+		manager.procPath = procPath
+		manager.cgroupManager = func(vmi *v1.VirtualMachineInstance) (cgroup.Manager, error) {
+			manager, exists := managers[vmi.Name]
+			if !exists {
+				return nil, errors.New("no cgroup")
+			}
+			return manager, nil
+		}
+		return manager
+	}
+
+	writeNodeState := func(swapTotalKiB, swapFreeKiB int, fullAvg10 string) {
+		memInfo := fmt.Sprintf("MemTotal: 16384000 kB\nSwapTotal: %d kB\nSwapFree: %d kB\n", swapTotalKiB, swapFreeKiB)
+		Expect(os.WriteFile(filepath.Join(procPath, "meminfo"), []byte(memInfo), 0600)).To(Succeed())
+		pressure := fmt.Sprintf("some avg10=20.00 avg60=10.00 avg300=5.00 total=1234\nfull avg10=%s avg60=5.00 avg300=2.00 total=567\n", fullAvg10)
+		Expect(os.WriteFile(filepath.Join(procPath, "pressure", "memory"), []byte(pressure), 0600)).To(Succeed())
+	}
+
+	evacuationNodeName := func(name string) string {
+		vmi, err := virtClient.KubevirtV1().VirtualMachineInstances(metav1.NamespaceDefault).Get(context.Background(), name, metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		return vmi.Status.EvacuationNodeName
+	}
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		client = fake.NewSimpleClientset(&k8sv1.Node{
+			ObjectMeta: metav1.ObjectMeta{Name: host, Labels: map[string]string{swapNode: "true"}},
+		})
+		virtClient = kubevirtfake.NewSimpleClientset()
+		vmiStore = cache.NewStore(cache.MetaNamespaceKeyFunc)
+		managers = map[string]*cgroup.MockManager{}
+		procPath = GinkgoT().TempDir()
+		Expect(os.Mkdir(filepath.Join(procPath, "pressure"), 0700)).To(Succeed())
+		writeNodeState(1024, 1024, "0.00")
+	})
+
+	It("should give the overcommitted VMIs access to the swap and report their swap usage", func() {
+		overcommitted := addVMI(newVMI("overcommitted", "2Gi", "1Gi"))
+		overcommitted.EXPECT().SetMemorySwapMax(uint64(1024 * 1024 * 1024)).Return(nil)
+		overcommitted.EXPECT().SetMemoryLow(uint64(1024 * 1024 * 1024)).Return(nil)
+		overcommitted.EXPECT().GetMemorySwapUsage().Return(uint64(4096), nil)
+		addVMI(newVMI("committed", "1Gi", "1Gi"))
+		hugepages := newVMI("hugepages", "2Gi", "1Gi")
+		hugepages.Spec.Domain.Memory.Hugepages = &v1.Hugepages{PageSize: "2Mi"}
+		addVMI(hugepages)
+
+		newManager(&v1.SwapConfiguration{}).sync()
+
+		Expect(metrics.GetLauncherSwapUsed(metav1.NamespaceDefault, "overcommitted", host)).To(BeEquivalentTo(4096))
+	})
+
+	It("should take the swap away when the swap configuration no longer selects the node", func() {
+		vmiManager := addVMI(newVMI("overcommitted", "2Gi", "1Gi"))
+		vmiManager.EXPECT().SetMemorySwapMax(uint64(1024 * 1024 * 1024)).Return(nil)
+		vmiManager.EXPECT().SetMemoryLow(uint64(1024 * 1024 * 1024)).Return(nil)
+		vmiManager.EXPECT().GetMemorySwapUsage().Return(uint64(0), nil)
+		manager := newManager(&v1.SwapConfiguration{NodeSelector: map[string]string{swapNode: "true"}})
+		manager.sync()
+
+		node, err := client.CoreV1().Nodes().Get(context.Background(), host, metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		node.Labels = nil
+		_, err = client.CoreV1().Nodes().Update(context.Background(), node, metav1.UpdateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		vmiManager.EXPECT().SetMemorySwapMax(uint64(0)).Return(nil)
+		vmiManager.EXPECT().SetMemoryLow(uint64(0)).Return(nil)
+		manager.sync()
+
+		Expect(manager.configured).To(BeEmpty())
+		manager.sync()
+	})
+
+	DescribeTable("should evacuate the overcommitted VMI using the most swap", func(swapFreeKiB int, fullAvg10 string, evacuated bool) {
+		writeNodeState(1024, swapFreeKiB, fullAvg10)
+		for name, swapUsed := range map[string]uint64{"light": 1024, "heavy": 4096} {
+			vmiManager := addVMI(newVMI(name, "2Gi", "1Gi"))
+			vmiManager.EXPECT().SetMemorySwapMax(gomock.Any()).Return(nil)
+			vmiManager.EXPECT().SetMemoryLow(gomock.Any()).Return(nil)
+			vmiManager.EXPECT().GetMemorySwapUsage().Return(swapUsed, nil)
+		}
+
+		newManager(&v1.SwapConfiguration{
+			EvictionSwapUsedPercent:       pointer.P(int32(80)),
+			EvictionMemoryPressurePercent: pointer.P(int32(10)),
+		}).sync()
+
+		if evacuated {
+			Expect(evacuationNodeName("heavy")).To(Equal(host))
+		} else {
+			Expect(evacuationNodeName("heavy")).To(BeEmpty())
+		}
+		Expect(evacuationNodeName("light")).To(BeEmpty())
+	},
+		Entry("when the swap usage exceeds its threshold", 100, "0.00", true),
+		Entry("when the memory pressure exceeds its threshold", 1024, "12.50", true),
+		Entry("but not below the thresholds", 512, "5.00", false),
+	)
+
+	It("should not evacuate a VMI while another one is evacuated", func() {
+		writeNodeState(1024, 0, "50.00")
+		vmiManager := addVMI(newVMI("overcommitted", "2Gi", "1Gi"))
+		vmiManager.EXPECT().SetMemorySwapMax(gomock.Any()).Return(nil)
+		vmiManager.EXPECT().SetMemoryLow(gomock.Any()).Return(nil)
+		vmiManager.EXPECT().GetMemorySwapUsage().Return(uint64(4096), nil)
+		evacuated := newVMI("evacuated", "1Gi", "1Gi")
+		evacuated.Status.EvacuationNodeName = host
+		addVMI(evacuated)
+
+		newManager(&v1.SwapConfiguration{EvictionSwapUsedPercent: pointer.P(int32(80))}).sync()
+
+		Expect(evacuationNodeName("overcommitted")).To(BeEmpty())
+	})
+
+	It("should not evacuate VMIs which can not be live migrated", func() {
+		writeNodeState(1024, 0, "50.00")
+		vmi := newVMI("overcommitted", "2Gi", "1Gi")
+		vmi.Spec.EvictionStrategy = pointer.P(v1.EvictionStrategyNone)
+		vmiManager := addVMI(vmi)
+		vmiManager.EXPECT().SetMemorySwapMax(gomock.Any()).Return(nil)
+		vmiManager.EXPECT().SetMemoryLow(gomock.Any()).Return(nil)
+		vmiManager.EXPECT().GetMemorySwapUsage().Return(uint64(4096), nil)
+
+		newManager(&v1.SwapConfiguration{EvictionSwapUsedPercent: pointer.P(int32(80))}).sync()
+
+		Expect(evacuationNodeName("overcommitted")).To(BeEmpty())
+	})
+})
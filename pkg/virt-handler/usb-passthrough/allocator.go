@@ -0,0 +1,177 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package usbpassthrough
+
+import (
+	"sync"
+
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/cache"
+
+	v1 "kubevirt.io/api/core/v1"
+)
+
+// Allocator arbitrates the USB devices plugged into the node between the VMIs running on it:
+// a device is allocated to a single VMI at a time, and stays allocated to it until it is unplugged
+// or the VMI stops requesting it.
+// The allocations are kept in memory, and recovered from the status of the VMIs when virt-handler restarts.
+type Allocator struct {
+	lock        sync.Mutex
+	vmiStore    cache.Store
+	allocations map[types.UID]map[string]Device
+}
+
+func NewAllocator(vmiStore cache.Store) *Allocator {
+	return &Allocator{
+		vmiStore:    vmiStore,
+		allocations: map[types.UID]map[string]Device{},
+	}
+}
+
+// Allocate allocates a permitted host device to each USB device of the VMI. The devices already allocated
+// to the VMI are kept as long as they are plugged and permitted, the others are released and returned so
+// that the access to them can be revoked. USB devices which no free host device matches are left out of
+// the allocation.
+func (a *Allocator) Allocate(vmi *v1.VirtualMachineInstance, permittedDevices []v1.USBPassthroughHostDevice) (allocated map[string]Device, released []Device, err error) {
+	discovered, err := discoverDevices()
+	if err != nil {
+		return nil, nil, err
+	}
+	var devices []Device
+	for _, device := range discovered {
+		if device.permitted(permittedDevices) {
+			devices = append(devices, device)
+		}
+	}
+
+	a.lock.Lock()
+	defer a.lock.Unlock()
+
+	previous, exists := a.allocations[vmi.UID]
+	if !exists {
+		previous = recoverAllocation(vmi)
+	}
+	plugged := make(map[string]Device, len(devices))
+	for _, device := range devices {
+		plugged[device.BusPort] = device
+	}
+	taken := a.takenByOtherVMIs(vmi.UID)
+
+	allocated = map[string]Device{}
+	usbDevices := vmi.Spec.Domain.Devices.USBDevices
+	for _, usbDevice := range usbDevices {
+		device, wasAllocated := previous[usbDevice.Name]
+		if !wasAllocated {
+			continue
+		}
+		current, isPlugged := plugged[device.BusPort]
+		if !isPlugged || !current.sameNode(device) || !current.matches(usbDevice) || taken[device.BusPort] {
+			continue
+		}
+		allocated[usbDevice.Name] = current
+		taken[current.BusPort] = true
+	}
+	for _, usbDevice := range usbDevices {
+		if _, isAllocated := allocated[usbDevice.Name]; isAllocated {
+			continue
+		}
+		for _, device := range devices {
+			if !taken[device.BusPort] && device.matches(usbDevice) {
+				allocated[usbDevice.Name] = device
+				taken[device.BusPort] = true
+				break
+			}
+		}
+	}
+
+	for name, device := range previous {
+		if current, isAllocated := allocated[name]; !isAllocated || !current.sameNode(device) {
+			released = append(released, device)
+		}
+	}
+	a.allocations[vmi.UID] = allocated
+	return copyAllocation(allocated), released, nil
+}
+
+// Allocated returns the host devices allocated to the USB devices of a VMI, and whether the allocation
+// of the VMI was synced since virt-handler started.
+func (a *Allocator) Allocated(vmiUID types.UID) (map[string]Device, bool) {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+	allocation, exists := a.allocations[vmiUID]
+	return copyAllocation(allocation), exists
+}
+
+// Release releases the host devices allocated to a VMI
+func (a *Allocator) Release(vmiUID types.UID) {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+	delete(a.allocations, vmiUID)
+}
+
+// takenByOtherVMIs returns the bus and port paths of the devices allocated to other VMIs,
+// including the ones recorded in the status of VMIs this instance did not sync yet.
+func (a *Allocator) takenByOtherVMIs(vmiUID types.UID) map[string]bool {
+	taken := map[string]bool{}
+	for uid, allocation := range a.allocations {
+		if uid == vmiUID {
+			continue
+		}
+		for _, device := range allocation {
+			taken[device.BusPort] = true
+		}
+	}
+	for _, obj := range a.vmiStore.List() {
+		vmi, ok := obj.(*v1.VirtualMachineInstance)
+		if !ok || vmi.UID == vmiUID || vmi.IsFinal() {
+			continue
+		}
+		if _, synced := a.allocations[vmi.UID]; synced {
+			continue
+		}
+		for _, device := range recoverAllocation(vmi) {
+			taken[device.BusPort] = true
+		}
+	}
+	return taken
+}
+
+func recoverAllocation(vmi *v1.VirtualMachineInstance) map[string]Device {
+	allocation := map[string]Device{}
+	for _, status := range vmi.Status.USBDevices {
+		if status.Phase == v1.USBDevicePending || status.BusPort == "" {
+			continue
+		}
+		allocation[status.Name] = Device{
+			BusPort:      status.BusPort,
+			Bus:          status.Bus,
+			DeviceNumber: status.DeviceNumber,
+		}
+	}
+	return allocation
+}
+
+func copyAllocation(allocation map[string]Device) map[string]Device {
+	allocationCopy := make(map[string]Device, len(allocation))
+	for name, device := range allocation {
+		allocationCopy[name] = device
+	}
+	return allocationCopy
+}
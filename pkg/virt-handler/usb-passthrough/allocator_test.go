@@ -0,0 +1,210 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package usbpassthrough
+
+import (
+	"os"
+	"path/filepath"
+	"strconv"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/cache"
+
+	v1 "kubevirt.io/api/core/v1"
+)
+
+var _ = Describe("USB devices allocator", func() {
+	const (
+		yubikey   = "1050:0407"
+		smartcard = "04e6:5116"
+	)
+
+	var (
+		vmiStore  cache.Store
+		allocator *Allocator
+	)
+
+	permitted := []v1.USBPassthroughHostDevice{{VendorProduct: yubikey}, {BusPort: "2-4"}, {BusPort: "1-2"}}
+
+	plugDevice := func(device Device) {
+		path := filepath.Join(sysfsUSBDevicesPath, device.BusPort)
+		Expect(os.MkdirAll(path, 0755)).To(Succeed())
+		for name, value := range map[string]string{
+			"idVendor":     device.Vendor,
+			"idProduct":    device.Product,
+			"bDeviceClass": device.Class,
+			"busnum":       strconv.Itoa(device.Bus),
+			"devnum":       strconv.Itoa(device.DeviceNumber),
+		} {
+			Expect(os.WriteFile(filepath.Join(path, name), []byte(value+"\n"), 0644)).To(Succeed())
+		}
+	}
+
+	unplugDevice := func(device Device) {
+		Expect(os.RemoveAll(filepath.Join(sysfsUSBDevicesPath, device.BusPort))).To(Succeed())
+	}
+
+	newVMI := func(uid string, usbDevices ...v1.USBDevice) *v1.VirtualMachineInstance {
+		vmi := &v1.VirtualMachineInstance{}
+		vmi.UID = types.UID(uid)
+		vmi.Name = uid
+		vmi.Spec.Domain.Devices.USBDevices = usbDevices
+		return vmi
+	}
+
+	yubikey1 := Device{BusPort: "1-1", Vendor: "1050", Product: "0407", Class: "00", Bus: 1, DeviceNumber: 2}
+	yubikey2 := Device{BusPort: "1-2.3", Vendor: "1050", Product: "0407", Class: "00", Bus: 1, DeviceNumber: 5}
+	reader := Device{BusPort: "2-4", Vendor: "04e6", Product: "5116", Class: "00", Bus: 2, DeviceNumber: 3}
+	hub := Device{BusPort: "1-2", Vendor: "05e3", Product: "0608", Class: usbClassHub, Bus: 1, DeviceNumber: 4}
+
+	BeforeEach(func() {
+		originalPath := sysfsUSBDevicesPath
+		sysfsUSBDevicesPath = GinkgoT().TempDir()
+		DeferCleanup(func() { sysfsUSBDevicesPath = originalPath })
+
+		// Root hubs and interfaces are not devices which can be passed through
+		Expect(os.MkdirAll(filepath.Join(sysfsUSBDevicesPath, "usb1"), 0755)).To(Succeed())
+		Expect(os.MkdirAll(filepath.Join(sysfsUSBDevicesPath, "1-1:1.0"), 0755)).To(Succeed())
+		plugDevice(yubikey1)
+		plugDevice(yubikey2)
+		plugDevice(reader)
+		plugDevice(hub)
+
+		vmiStore = cache.NewStore(cache.MetaNamespaceKeyFunc)
+		allocator = NewAllocator(vmiStore)
+	})
+
+	It("should discover the USB devices plugged into the node", func() {
+		Expect(discoverDevices()).To(Equal([]Device{yubikey1, hub, yubikey2, reader}))
+	})
+
+	It("should allocate devices selected by vendor:product or bus-port", func() {
+		vmi := newVMI("vmi1",
+			v1.USBDevice{Name: "token", VendorProduct: yubikey},
+			v1.USBDevice{Name: "reader", BusPort: "2-4"},
+		)
+
+		allocated, released, err := allocator.Allocate(vmi, permitted)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(released).To(BeEmpty())
+		Expect(allocated).To(Equal(map[string]Device{"token": yubikey1, "reader": reader}))
+		allocation, exists := allocator.Allocated(vmi.UID)
+		Expect(exists).To(BeTrue())
+		Expect(allocation).To(Equal(allocated))
+	})
+
+	It("should not allocate a device to several VMIs", func() {
+		vmi1 := newVMI("vmi1", v1.USBDevice{Name: "token", VendorProduct: yubikey})
+		vmi2 := newVMI("vmi2", v1.USBDevice{Name: "token", VendorProduct: yubikey})
+		vmi3 := newVMI("vmi3", v1.USBDevice{Name: "token", VendorProduct: yubikey})
+
+		allocated, _, err := allocator.Allocate(vmi1, permitted)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(allocated).To(Equal(map[string]Device{"token": yubikey1}))
+		allocated, _, err = allocator.Allocate(vmi2, permitted)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(allocated).To(Equal(map[string]Device{"token": yubikey2}))
+		allocated, _, err = allocator.Allocate(vmi3, permitted)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(allocated).To(BeEmpty())
+
+		allocator.Release(vmi1.UID)
+		allocated, _, err = allocator.Allocate(vmi3, permitted)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(allocated).To(Equal(map[string]Device{"token": yubikey1}))
+	})
+
+	It("should not allocate the devices recorded in the status of VMIs which were not synced yet", func() {
+		vmi1 := newVMI("vmi1", v1.USBDevice{Name: "token", VendorProduct: yubikey})
+		vmi1.Status.USBDevices = []v1.USBDeviceStatus{
+			{Name: "token", Phase: v1.USBDeviceAttached, BusPort: yubikey2.BusPort, Bus: yubikey2.Bus, DeviceNumber: yubikey2.DeviceNumber},
+		}
+		Expect(vmiStore.Add(vmi1)).To(Succeed())
+		vmi2 := newVMI("vmi2", v1.USBDevice{Name: "token", BusPort: yubikey2.BusPort})
+
+		allocated, _, err := allocator.Allocate(vmi2, permitted)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(allocated).To(BeEmpty())
+
+		By("recovering the allocation of the VMI from its status")
+		allocated, _, err = allocator.Allocate(vmi1, permitted)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(allocated).To(Equal(map[string]Device{"token": yubikey2}))
+	})
+
+	It("should release the devices which are unplugged and allocate them again once plugged", func() {
+		vmi := newVMI("vmi1", v1.USBDevice{Name: "reader", VendorProduct: smartcard})
+		_, _, err := allocator.Allocate(vmi, permitted)
+		Expect(err).ToNot(HaveOccurred())
+
+		unplugDevice(reader)
+		allocated, released, err := allocator.Allocate(vmi, permitted)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(allocated).To(BeEmpty())
+		Expect(released).To(ConsistOf(reader))
+
+		replugged := reader
+		replugged.DeviceNumber = 7
+		plugDevice(replugged)
+		allocated, released, err = allocator.Allocate(vmi, permitted)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(allocated).To(Equal(map[string]Device{"reader": replugged}))
+		Expect(released).To(BeEmpty())
+	})
+
+	It("should release the devices which the VMI does not request anymore", func() {
+		vmi := newVMI("vmi1", v1.USBDevice{Name: "token", VendorProduct: yubikey}, v1.USBDevice{Name: "reader", VendorProduct: smartcard})
+		_, _, err := allocator.Allocate(vmi, permitted)
+		Expect(err).ToNot(HaveOccurred())
+
+		vmi.Spec.Domain.Devices.USBDevices = vmi.Spec.Domain.Devices.USBDevices[:1]
+		allocated, released, err := allocator.Allocate(vmi, permitted)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(allocated).To(Equal(map[string]Device{"token": yubikey1}))
+		Expect(released).To(ConsistOf(reader))
+	})
+
+	It("should only allocate the devices permitted by the cluster admin", func() {
+		vmi := newVMI("vmi1", v1.USBDevice{Name: "token", VendorProduct: yubikey}, v1.USBDevice{Name: "reader", VendorProduct: smartcard})
+		allocated, _, err := allocator.Allocate(vmi, []v1.USBPassthroughHostDevice{{VendorProduct: yubikey, BusPort: "1-2.3"}})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(allocated).To(Equal(map[string]Device{"token": yubikey2}))
+	})
+
+	It("should not allocate hubs", func() {
+		vmi := newVMI("vmi1", v1.USBDevice{Name: "hub", BusPort: hub.BusPort})
+		allocated, _, err := allocator.Allocate(vmi, permitted)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(allocated).To(BeEmpty())
+	})
+
+	It("should release the devices which are not permitted anymore", func() {
+		vmi := newVMI("vmi1", v1.USBDevice{Name: "reader", BusPort: reader.BusPort})
+		_, _, err := allocator.Allocate(vmi, permitted)
+		Expect(err).ToNot(HaveOccurred())
+
+		allocated, released, err := allocator.Allocate(vmi, permitted[:1])
+		Expect(err).ToNot(HaveOccurred())
+		Expect(allocated).To(BeEmpty())
+		Expect(released).To(ConsistOf(reader))
+	})
+})
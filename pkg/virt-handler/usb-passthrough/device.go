@@ -0,0 +1,149 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package usbpassthrough
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	v1 "kubevirt.io/api/core/v1"
+)
+
+var sysfsUSBDevicesPath = "/sys/bus/usb/devices"
+
+// usbClassHub is the device class of USB hubs, which are never passed through
+const usbClassHub = "09"
+
+// The kernel names USB devices after their bus and port path, e.g. 1-2.3, while
+// root hubs are named usbN and interfaces have a configuration suffix, e.g. 1-2.3:1.0.
+var isUSBDeviceName = regexp.MustCompile(`^[0-9]+-[0-9]+(\.[0-9]+)*$`).MatchString
+
+// Device is a USB device plugged into the node
+type Device struct {
+	// BusPort is the bus and port path the kernel names the device after, e.g. 1-2.3
+	BusPort string
+	// Vendor and Product are the identifiers of the device, as 4 hex digits
+	Vendor  string
+	Product string
+	// Class is the device class, as 2 hex digits
+	Class string
+	// Bus and DeviceNumber address the device node under /dev/bus/usb
+	Bus          int
+	DeviceNumber int
+}
+
+// DevicePath returns the path of the character device of the USB device
+func (d Device) DevicePath() string {
+	return fmt.Sprintf("/dev/bus/usb/%03d/%03d", d.Bus, d.DeviceNumber)
+}
+
+// matches tells if the device is selected by the USB device of a VMI
+func (d Device) matches(usbDevice v1.USBDevice) bool {
+	if usbDevice.BusPort != "" {
+		return d.BusPort == usbDevice.BusPort
+	}
+	return strings.EqualFold(d.Vendor+":"+d.Product, usbDevice.VendorProduct)
+}
+
+// permitted tells if the device is permitted to be passed through by the cluster admin. Hubs never are,
+// since the devices plugged into them would be passed through too.
+func (d Device) permitted(permittedDevices []v1.USBPassthroughHostDevice) bool {
+	if d.Class == usbClassHub {
+		return false
+	}
+	for _, permitted := range permittedDevices {
+		if permitted.VendorProduct == "" && permitted.BusPort == "" {
+			continue
+		}
+		if permitted.VendorProduct != "" && !strings.EqualFold(d.Vendor+":"+d.Product, permitted.VendorProduct) {
+			continue
+		}
+		if permitted.BusPort != "" && d.BusPort != permitted.BusPort {
+			continue
+		}
+		return true
+	}
+	return false
+}
+
+// sameNode tells if both devices are addressed by the same device node, which changes when a device is replugged
+func (d Device) sameNode(other Device) bool {
+	return d.BusPort == other.BusPort && d.Bus == other.Bus && d.DeviceNumber == other.DeviceNumber
+}
+
+// discoverDevices lists the USB devices plugged into the node, ordered by bus and port path
+func discoverDevices() ([]Device, error) {
+	entries, err := os.ReadDir(sysfsUSBDevicesPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list the USB devices: %v", err)
+	}
+
+	var devices []Device
+	for _, entry := range entries {
+		if !isUSBDeviceName(entry.Name()) {
+			continue
+		}
+		device, err := readDevice(filepath.Join(sysfsUSBDevicesPath, entry.Name()))
+		if err != nil {
+			// The device may have been unplugged in the meantime
+			continue
+		}
+		device.BusPort = entry.Name()
+		devices = append(devices, device)
+	}
+	sort.Slice(devices, func(i, j int) bool {
+		return devices[i].BusPort < devices[j].BusPort
+	})
+	return devices, nil
+}
+
+func readDevice(path string) (Device, error) {
+	readAttribute := func(name string) (string, error) {
+		value, err := os.ReadFile(filepath.Join(path, name))
+		return strings.TrimSpace(string(value)), err
+	}
+
+	var device Device
+	var err error
+	if device.Vendor, err = readAttribute("idVendor"); err != nil {
+		return device, err
+	}
+	if device.Product, err = readAttribute("idProduct"); err != nil {
+		return device, err
+	}
+	if device.Class, err = readAttribute("bDeviceClass"); err != nil {
+		return device, err
+	}
+	for name, value := range map[string]*int{"busnum": &device.Bus, "devnum": &device.DeviceNumber} {
+		attribute, err := readAttribute(name)
+		if err != nil {
+			return device, err
+		}
+		if *value, err = strconv.Atoi(attribute); err != nil {
+			return device, fmt.Errorf("invalid %s %q: %v", name, attribute, err)
+		}
+	}
+	return device, nil
+}
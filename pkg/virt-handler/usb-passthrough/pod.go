@@ -0,0 +1,114 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package usbpassthrough
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"syscall"
+
+	"github.com/opencontainers/runc/libcontainer/configs"
+	"github.com/opencontainers/runc/libcontainer/devices"
+	"golang.org/x/sys/unix"
+
+	diskutils "kubevirt.io/kubevirt/pkg/ephemeral-disk-utils"
+	"kubevirt.io/kubevirt/pkg/safepath"
+	"kubevirt.io/kubevirt/pkg/util"
+	"kubevirt.io/kubevirt/pkg/virt-handler/cgroup"
+)
+
+// ExposeDevice gives the compute container access to a USB device: it creates the character device in the
+// root of the container, owned by the qemu user, and allows the device in the cgroup of the container.
+func ExposeDevice(launcherRoot *safepath.Path, device Device, cgroupManager cgroup.Manager) error {
+	info, err := os.Stat(filepath.Join(util.HostRootMount, device.DevicePath()))
+	if err != nil {
+		return fmt.Errorf("failed to find the USB device %s: %v", device.BusPort, err)
+	}
+	rdev := info.Sys().(*syscall.Stat_t).Rdev
+
+	dir := launcherRoot
+	elements := strings.Split(strings.TrimPrefix(device.DevicePath(), "/"), "/")
+	for _, name := range elements[:len(elements)-1] {
+		if dir, err = joinOrCreateDir(dir, name); err != nil {
+			return fmt.Errorf("failed to create the directory of the USB device %s: %v", device.BusPort, err)
+		}
+	}
+	nodeName := elements[len(elements)-1]
+	if _, err := safepath.JoinNoFollow(dir, nodeName); errors.Is(err, os.ErrNotExist) {
+		if err := safepath.MknodAtNoFollow(dir, nodeName, 0660|syscall.S_IFCHR, rdev); err != nil && !os.IsExist(err) {
+			return fmt.Errorf("failed to create the USB device %s: %v", device.BusPort, err)
+		}
+	} else if err != nil {
+		return err
+	}
+	devicePath, err := safepath.JoinNoFollow(dir, nodeName)
+	if err != nil {
+		return err
+	}
+	if err := diskutils.DefaultOwnershipManager.SetFileOwnership(devicePath); err != nil {
+		return err
+	}
+
+	return setDeviceRule(rdev, true, cgroupManager)
+}
+
+// RevokeDevice denies the compute container the access to a USB device which is not allocated to it anymore.
+// The device node may be reused by the next device plugged into the node.
+func RevokeDevice(device Device, cgroupManager cgroup.Manager) error {
+	return setDeviceRule(unix.Mkdev(usbDeviceMajor, deviceMinor(device)), false, cgroupManager)
+}
+
+// The USB character devices have a fixed major, and a minor derived from the bus and device numbers
+const usbDeviceMajor = 189
+
+func deviceMinor(device Device) uint32 {
+	return uint32((device.Bus-1)*128 + device.DeviceNumber - 1)
+}
+
+func joinOrCreateDir(parent *safepath.Path, name string) (*safepath.Path, error) {
+	dir, err := safepath.JoinNoFollow(parent, name)
+	if errors.Is(err, os.ErrNotExist) {
+		if err := safepath.MkdirAtNoFollow(parent, name, 0755); err != nil && !errors.Is(err, os.ErrExist) {
+			return nil, err
+		}
+		return safepath.JoinNoFollow(parent, name)
+	}
+	return dir, err
+}
+
+func setDeviceRule(dev uint64, allow bool, cgroupManager cgroup.Manager) error {
+	deviceRule := &devices.Rule{
+		Type:        devices.CharDevice,
+		Major:       int64(unix.Major(dev)),
+		Minor:       int64(unix.Minor(dev)),
+		Permissions: "rwm",
+		Allow:       allow,
+	}
+	if cgroupManager == nil {
+		return fmt.Errorf("failed to apply device rule %+v: cgroup manager is nil", *deviceRule)
+	}
+	if err := cgroupManager.Set(&configs.Resources{Devices: []*devices.Rule{deviceRule}}); err != nil {
+		return fmt.Errorf("failed to apply device rule %+v: %v", *deviceRule, err)
+	}
+	return nil
+}
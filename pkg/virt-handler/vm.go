@@ -63,6 +63,7 @@ import (
 	cmdv1 "kubevirt.io/kubevirt/pkg/handler-launcher-com/cmd/v1"
 	hostdisk "kubevirt.io/kubevirt/pkg/host-disk"
 	hotplugdisk "kubevirt.io/kubevirt/pkg/hotplug-disk"
+	metrics "kubevirt.io/kubevirt/pkg/monitoring/metrics/virt-handler"
 	netcache "kubevirt.io/kubevirt/pkg/network/cache"
 	"kubevirt.io/kubevirt/pkg/network/domainspec"
 	neterrors "kubevirt.io/kubevirt/pkg/network/errors"
@@ -84,13 +85,16 @@ import (
 	"kubevirt.io/kubevirt/pkg/virt-handler/cgroup"
 	cmdclient "kubevirt.io/kubevirt/pkg/virt-handler/cmd-client"
 	container_disk "kubevirt.io/kubevirt/pkg/virt-handler/container-disk"
+	cpupool "kubevirt.io/kubevirt/pkg/virt-handler/cpu-pool"
 	device_manager "kubevirt.io/kubevirt/pkg/virt-handler/device-manager"
 	"kubevirt.io/kubevirt/pkg/virt-handler/heartbeat"
 	hotplug_volume "kubevirt.io/kubevirt/pkg/virt-handler/hotplug-disk"
 	"kubevirt.io/kubevirt/pkg/virt-handler/isolation"
 	migrationproxy "kubevirt.io/kubevirt/pkg/virt-handler/migration-proxy"
 	"kubevirt.io/kubevirt/pkg/virt-handler/selinux"
+	usbpassthrough "kubevirt.io/kubevirt/pkg/virt-handler/usb-passthrough"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/converter/vcpu"
 	"kubevirt.io/kubevirt/pkg/virtiofs"
 )
 
@@ -116,7 +120,18 @@ const (
 	failedDetectIsolationFmt              = "failed to detect isolation for launcher pod: %v"
 	unableCreateVirtLauncherConnectionFmt = "unable to create virt-launcher client connection: %v"
 	// This value was determined after consulting with libvirt developers and performing extensive testing.
+	// It is used unless the migration configuration sets the number of parallel migration channels.
 	parallelMultifdMigrationThreads = uint(8)
+
+	housekeepingCgroupName = "housekeeping"
+	// housekeepingCPUPeriod is the CFS period in microseconds the housekeeping CPU quota is applied to
+	housekeepingCPUPeriod = 100000
+
+	// The aliases virt-launcher gives to the GPUs and host devices of the domain start with these prefixes
+	gpuAliasPrefix           = "gpu-"
+	hostDeviceAliasPrefix    = "hostdevice-"
+	usbHostDeviceAliasPrefix = "usb-host-"
+	usbDeviceAliasPrefix     = "usbdevice-"
 )
 
 const (
@@ -151,6 +166,8 @@ const (
 
 	// MemoryHotplugFailedReason is the reason set when the VM cannot hotplug memory
 	memoryHotplugFailedReason = "Memory Hotplug Failed"
+	// parallelMigrationIgnoredReason is the reason set when the configured parallel migration channels can't be used
+	parallelMigrationIgnoredReason = "ParallelMigrationIgnored"
 )
 
 var getCgroupManager = func(vmi *v1.VirtualMachineInstance, host string) (cgroup.Manager, error) {
@@ -195,6 +212,11 @@ func NewController(
 		return nil, err
 	}
 
+	dedicatedCPUPoolState := filepath.Join(virtPrivateDir, "dedicated-cpu-pool-state")
+	if err := os.MkdirAll(dedicatedCPUPoolState, 0700); err != nil {
+		return nil, err
+	}
+
 	c := &VirtualMachineController{
 		queue:                            queue,
 		recorder:                         recorder,
@@ -216,6 +238,10 @@ func NewController(
 		hostCpuModel:                     hostCpuModel,
 		vmiExpectations:                  controller.NewUIDTrackingControllerExpectations(controller.NewControllerExpectations()),
 		sriovHotplugExecutorPool:         executor.NewRateLimitedExecutorPool(executor.NewExponentialLimitedBackoffCreator()),
+		hostDevicesHotplugExecutorPool:   executor.NewRateLimitedExecutorPool(executor.NewExponentialLimitedBackoffCreator()),
+		usbDevicesHotplugExecutorPool:    executor.NewRateLimitedExecutorPool(executor.NewExponentialLimitedBackoffCreator()),
+		usbAllocator:                     usbpassthrough.NewAllocator(vmiSourceInformer.GetStore()),
+		dedicatedCPUPool:                 cpupool.NewManager(dedicatedCPUPoolState, vmiSourceInformer.GetStore(), vmiTargetInformer.GetStore()),
 		ioErrorRetryManager:              NewFailRetryManager("io-error-retry", 10*time.Second, 3*time.Minute, 30*time.Second),
 		netConf:                          netConf,
 		netStat:                          netStat,
@@ -278,7 +304,7 @@ func NewController(
 		device_manager.PermanentHostDevicePlugins(maxDevices, permissions),
 		clusterConfig,
 		clientset.CoreV1())
-	c.heartBeat = heartbeat.NewHeartBeat(clientset.CoreV1(), c.deviceManagerController, clusterConfig, host)
+	c.heartBeat = heartbeat.NewHeartBeat(clientset.CoreV1(), c.deviceManagerController, c.dedicatedCPUPool, clusterConfig, host)
 
 	return c, nil
 }
@@ -321,6 +347,11 @@ type VirtualMachineController struct {
 	vmiExpectations             *controller.UIDTrackingControllerExpectations
 	ioErrorRetryManager         *FailRetryManager
 	hasSynced                   func() bool
+
+	hostDevicesHotplugExecutorPool *executor.RateLimitedExecutorPool
+	usbDevicesHotplugExecutorPool  *executor.RateLimitedExecutorPool
+	usbAllocator                   *usbpassthrough.Allocator
+	dedicatedCPUPool               *cpupool.Manager
 }
 
 type virtLauncherCriticalSecurebootError struct {
@@ -558,6 +589,13 @@ func domainPausedFailedPostCopy(domain *api.Domain) bool {
 	return domain != nil && domain.Status.Status == api.Paused && domain.Status.Reason == api.ReasonPausedPostcopyFailed
 }
 
+// postCopyRecoveryPending returns true while virt-launcher did not report the result of a post copy migration.
+// virt-launcher tries to resume a failed post copy migration before giving up on the guest.
+func postCopyRecoveryPending(domain *api.Domain) bool {
+	migration := domain.Spec.Metadata.KubeVirt.Migration
+	return migration != nil && migration.Mode == v1.MigrationPostCopy && migration.EndTimestamp == nil
+}
+
 func domainMigrated(domain *api.Domain) bool {
 	return domain != nil && domain.Status.Status == api.Shutoff && domain.Status.Reason == api.ReasonMigrated
 }
@@ -600,6 +638,61 @@ func (c *VirtualMachineController) setMigrationProgressStatus(vmi *v1.VirtualMac
 	vmi.Status.MigrationState.Completed = migrationMetadata.Completed
 	vmi.Status.MigrationState.Failed = migrationMetadata.Failed
 	vmi.Status.MigrationState.Mode = migrationMetadata.Mode
+	if migrationMetadata.DataTotal > 0 {
+		vmi.Status.MigrationState.Progress = &v1.MigrationProgress{
+			DataProcessedBytes:   int64(migrationMetadata.DataProcessed),
+			DataRemainingBytes:   int64(migrationMetadata.DataRemaining),
+			DataTotalBytes:       int64(migrationMetadata.DataTotal),
+			DowntimeMilliseconds: int64(migrationMetadata.Downtime),
+			PercentComplete:      migrationPercentComplete(migrationMetadata.DataTotal, migrationMetadata.DataRemaining),
+		}
+	}
+	updatePostCopyRecoveryCondition(vmi, migrationMetadata)
+}
+
+func updatePostCopyRecoveryCondition(vmi *v1.VirtualMachineInstance, migrationMetadata *api.MigrationMetadata) {
+	if migrationMetadata.PostCopyRecoveryAttempts == 0 {
+		return
+	}
+
+	cond := &v1.VirtualMachineInstanceCondition{
+		Type:               v1.VirtualMachineInstancePostCopyRecovered,
+		Status:             k8sv1.ConditionFalse,
+		LastProbeTime:      metav1.Now(),
+		LastTransitionTime: metav1.Now(),
+		Reason:             v1.VirtualMachineInstanceReasonPostCopyRecoveryInProgress,
+		Message:            "Resuming the post copy migration which lost the connection to the target",
+	}
+	result := ""
+	switch {
+	case migrationMetadata.PostCopyRecovered:
+		cond.Status = k8sv1.ConditionTrue
+		cond.Reason = v1.VirtualMachineInstanceReasonPostCopyRecoverySucceeded
+		cond.Message = fmt.Sprintf("Resumed the post copy migration after %d attempts", migrationMetadata.PostCopyRecoveryAttempts)
+		result = metrics.PostCopyRecoveryResultSucceeded
+	case migrationMetadata.Failed:
+		cond.Reason = v1.VirtualMachineInstanceReasonPostCopyRecoveryFailed
+		cond.Message = fmt.Sprintf("Failed to resume the post copy migration after %d attempts", migrationMetadata.PostCopyRecoveryAttempts)
+		result = metrics.PostCopyRecoveryResultFailed
+	}
+
+	condManager := controller.NewVirtualMachineInstanceConditionManager()
+	if current := condManager.GetCondition(vmi, v1.VirtualMachineInstancePostCopyRecovered); current != nil && current.Reason == cond.Reason {
+		return
+	}
+	condManager.UpdateCondition(vmi, cond)
+	if result != "" {
+		metrics.IncPostCopyRecoveries(result)
+	}
+}
+
+// migrationPercentComplete returns the share of the data already transferred. Pages dirtied by the guest while the
+// migration runs are transferred again, thus the remaining data and not the processed one is used.
+func migrationPercentComplete(total, remaining uint64) int32 {
+	if total == 0 || remaining >= total {
+		return 0
+	}
+	return int32((total - remaining) * 100 / total)
 }
 
 func (c *VirtualMachineController) migrationSourceUpdateVMIStatus(origVMI *v1.VirtualMachineInstance, domain *api.Domain) error {
@@ -1001,18 +1094,21 @@ func (c *VirtualMachineController) updateVolumeStatusesFromDomain(vmi *v1.Virtua
 
 func (c *VirtualMachineController) updateGuestInfoFromDomain(vmi *v1.VirtualMachineInstance, domain *api.Domain) {
 
-	if domain == nil || domain.Status.OSInfo.Name == "" || vmi.Status.GuestOSInfo.Name == domain.Status.OSInfo.Name {
+	if domain == nil || domain.Status.OSInfo.Name == "" {
 		return
 	}
 
-	vmi.Status.GuestOSInfo.Name = domain.Status.OSInfo.Name
-	vmi.Status.GuestOSInfo.Version = domain.Status.OSInfo.Version
-	vmi.Status.GuestOSInfo.KernelRelease = domain.Status.OSInfo.KernelRelease
-	vmi.Status.GuestOSInfo.PrettyName = domain.Status.OSInfo.PrettyName
-	vmi.Status.GuestOSInfo.VersionID = domain.Status.OSInfo.VersionId
-	vmi.Status.GuestOSInfo.KernelVersion = domain.Status.OSInfo.KernelVersion
-	vmi.Status.GuestOSInfo.Machine = domain.Status.OSInfo.Machine
-	vmi.Status.GuestOSInfo.ID = domain.Status.OSInfo.Id
+	vmi.Status.GuestOSInfo = v1.VirtualMachineInstanceGuestOSInfo{
+		Name:          domain.Status.OSInfo.Name,
+		Version:       domain.Status.OSInfo.Version,
+		KernelRelease: domain.Status.OSInfo.KernelRelease,
+		PrettyName:    domain.Status.OSInfo.PrettyName,
+		VersionID:     domain.Status.OSInfo.VersionId,
+		KernelVersion: domain.Status.OSInfo.KernelVersion,
+		Machine:       domain.Status.OSInfo.Machine,
+		ID:            domain.Status.OSInfo.Id,
+		Hostname:      domain.Status.OSInfo.Hostname,
+	}
 }
 
 func (c *VirtualMachineController) updateAccessCredentialConditions(vmi *v1.VirtualMachineInstance, domain *api.Domain, condManager *controller.VirtualMachineInstanceConditionManager) {
@@ -1162,6 +1258,58 @@ func (c *VirtualMachineController) updateGuestAgentConditions(vmi *v1.VirtualMac
 	return nil
 }
 
+// updateGuestFilesystemUsage reports the usage of the guest filesystems on the volumes whose disks have a serial
+func (c *VirtualMachineController) updateGuestFilesystemUsage(
+	vmi *v1.VirtualMachineInstance, condManager *controller.VirtualMachineInstanceConditionManager,
+) {
+	volumeBySerial := make(map[string]string)
+	for _, disk := range vmi.Spec.Domain.Devices.Disks {
+		if disk.Serial != "" {
+			volumeBySerial[disk.Serial] = disk.Name
+		}
+	}
+
+	usage := make(map[string]*v1.GuestFilesystemUsage)
+	if c.clusterConfig.VolumeAutoExpansionEnabled() && len(volumeBySerial) > 0 &&
+		condManager.HasCondition(vmi, v1.VirtualMachineInstanceAgentConnected) {
+		client, err := c.getLauncherClient(vmi)
+		if err != nil {
+			log.Log.Object(vmi).Reason(err).Warning("Failed to get the guest filesystems")
+			return
+		}
+		filesystems, err := client.GetFilesystems()
+		if err != nil {
+			log.Log.Object(vmi).Reason(err).Warning("Failed to get the guest filesystems")
+			return
+		}
+
+		// A filesystem mounted several times is only counted once
+		counted := make(map[string]bool)
+		for _, fs := range filesystems.Items {
+			if counted[fs.DiskName] {
+				continue
+			}
+			for _, disk := range fs.Disk {
+				volumeName, exists := volumeBySerial[disk.Serial]
+				if !exists {
+					continue
+				}
+				if usage[volumeName] == nil {
+					usage[volumeName] = &v1.GuestFilesystemUsage{}
+				}
+				usage[volumeName].UsedBytes += int64(fs.UsedBytes)
+				usage[volumeName].TotalBytes += int64(fs.TotalBytes)
+				counted[fs.DiskName] = true
+				break
+			}
+		}
+	}
+
+	for i := range vmi.Status.VolumeStatus {
+		vmi.Status.VolumeStatus[i].GuestFilesystemUsage = usage[vmi.Status.VolumeStatus[i].Name]
+	}
+}
+
 func (c *VirtualMachineController) updatePausedConditions(vmi *v1.VirtualMachineInstance, domain *api.Domain, condManager *controller.VirtualMachineInstanceConditionManager) {
 
 	// Update paused condition in case VMI was paused / unpaused
@@ -1175,15 +1323,49 @@ func (c *VirtualMachineController) updatePausedConditions(vmi *v1.VirtualMachine
 		}
 	} else if condManager.HasCondition(vmi, v1.VirtualMachineInstancePaused) {
 		log.Log.Object(vmi).V(3).Info("Removing paused condition")
+		c.recordPauseDuration(vmi, condManager.GetCondition(vmi, v1.VirtualMachineInstancePaused))
 		condManager.RemoveCondition(vmi, v1.VirtualMachineInstancePaused)
 	}
 }
 
+// recordPauseDuration adds the duration of the pause which ended to the pause status of the VMI,
+// so that applications which are sensitive to time jumps in the guest can be handled
+func (c *VirtualMachineController) recordPauseDuration(vmi *v1.VirtualMachineInstance, pausedCondition *v1.VirtualMachineInstanceCondition) {
+	duration := time.Since(pausedCondition.LastTransitionTime.Time).Truncate(time.Second)
+	if duration < 0 {
+		duration = 0
+	}
+
+	if vmi.Status.PauseStatus == nil {
+		vmi.Status.PauseStatus = &v1.PauseStatus{}
+	}
+	vmi.Status.PauseStatus.LastPauseDurationSeconds = int64(duration.Seconds())
+	vmi.Status.PauseStatus.TotalPauseDurationSeconds += int64(duration.Seconds())
+
+	c.recorder.Eventf(vmi, k8sv1.EventTypeNormal, v1.Resumed.String(), "VirtualMachineInstance resumed after being paused for %s", duration)
+}
+
 func dumpTargetFile(vmiName, volName string) string {
 	targetFileName := fmt.Sprintf("%s-%s-%s.memory.dump", vmiName, volName, time.Now().Format("20060102-150405"))
 	return targetFileName
 }
 
+// memoryDumpFileExtension tells the extension of the dump to the memory dump volume, depending on its compression
+func memoryDumpFileExtension(vmi *v1.VirtualMachineInstance, volName string) string {
+	for _, volume := range vmi.Spec.Volumes {
+		if volume.Name != volName || volume.MemoryDump == nil {
+			continue
+		}
+		switch volume.MemoryDump.Compression {
+		case v1.MemoryDumpCompressionGzip:
+			return ".gz"
+		case v1.MemoryDumpCompressionZstd:
+			return ".zst"
+		}
+	}
+	return ""
+}
+
 func (c *VirtualMachineController) updateMemoryDumpInfo(vmi *v1.VirtualMachineInstance, volumeStatus v1.VolumeStatus, domain *api.Domain) (v1.VolumeStatus, bool) {
 	needsRefresh := false
 	switch volumeStatus.Phase {
@@ -1193,7 +1375,8 @@ func (c *VirtualMachineController) updateMemoryDumpInfo(vmi *v1.VirtualMachineIn
 		volumeStatus.Phase = v1.MemoryDumpVolumeInProgress
 		volumeStatus.Message = fmt.Sprintf("Memory dump Volume %s is attached, getting memory dump", volumeStatus.Name)
 		volumeStatus.Reason = VolumeMountedToPodReason
-		volumeStatus.MemoryDumpVolume.TargetFileName = dumpTargetFile(vmi.Name, volumeStatus.Name)
+		volumeStatus.MemoryDumpVolume.TargetFileName = dumpTargetFile(vmi.Name, volumeStatus.Name) +
+			memoryDumpFileExtension(vmi, volumeStatus.Name)
 	case v1.MemoryDumpVolumeInProgress:
 		memoryDumpMetadata := domain.Spec.Metadata.KubeVirt.MemoryDump
 		if memoryDumpMetadata == nil || memoryDumpMetadata.FileName != volumeStatus.MemoryDumpVolume.TargetFileName {
@@ -1204,6 +1387,13 @@ func (c *VirtualMachineController) updateMemoryDumpInfo(vmi *v1.VirtualMachineIn
 		if memoryDumpMetadata.StartTimestamp != nil {
 			volumeStatus.MemoryDumpVolume.StartTimestamp = memoryDumpMetadata.StartTimestamp
 		}
+		if memoryDumpMetadata.DataTotal > 0 {
+			volumeStatus.MemoryDumpVolume.Progress = &v1.MemoryDumpProgress{
+				DataProcessedBytes: int64(memoryDumpMetadata.DataProcessed),
+				DataTotalBytes:     int64(memoryDumpMetadata.DataTotal),
+				PercentComplete:    int32(min(memoryDumpMetadata.DataProcessed, memoryDumpMetadata.DataTotal) * 100 / memoryDumpMetadata.DataTotal),
+			}
+		}
 		if memoryDumpMetadata.EndTimestamp != nil && memoryDumpMetadata.Failed {
 			log.Log.Object(vmi).Errorf("Memory dump to pvc %s failed: %v", volumeStatus.Name, memoryDumpMetadata.FailureReason)
 			volumeStatus.Message = fmt.Sprintf("Memory dump to pvc %s failed: %v", volumeStatus.Name, memoryDumpMetadata.FailureReason)
@@ -1222,8 +1412,24 @@ func (c *VirtualMachineController) updateMemoryDumpInfo(vmi *v1.VirtualMachineIn
 }
 
 func (c *VirtualMachineController) updateFSFreezeStatus(vmi *v1.VirtualMachineInstance, domain *api.Domain) {
+	if domain == nil {
+		return
+	}
 
-	if domain == nil || domain.Status.FSFreezeStatus.Status == "" {
+	if fsFreeze := domain.Spec.Metadata.KubeVirt.FSFreeze; fsFreeze != nil && fsFreeze.StartTimestamp != nil {
+		if fsFreeze.EndTimestamp == nil {
+			info := &v1.FSFreezeInfo{FreezeTimestamp: fsFreeze.StartTimestamp.DeepCopy()}
+			if fsFreeze.Volumes != "" {
+				info.Volumes = strings.Split(fsFreeze.Volumes, ",")
+			}
+			vmi.Status.FSFreezeInfo = info
+		} else {
+			duration := fsFreeze.EndTimestamp.Sub(fsFreeze.StartTimestamp.Time)
+			vmi.Status.FSFreezeInfo = &v1.FSFreezeInfo{LastFreezeDurationSeconds: int64(duration.Seconds())}
+		}
+	}
+
+	if domain.Status.FSFreezeStatus.Status == "" {
 		return
 	}
 
@@ -1235,6 +1441,13 @@ func (c *VirtualMachineController) updateFSFreezeStatus(vmi *v1.VirtualMachineIn
 
 }
 
+func (c *VirtualMachineController) updateShutdownStage(vmi *v1.VirtualMachineInstance, domain *api.Domain) {
+	if domain == nil || domain.Spec.Metadata.KubeVirt.GracePeriod == nil || domain.Spec.Metadata.KubeVirt.GracePeriod.ShutdownStage == "" {
+		return
+	}
+	vmi.Status.ShutdownStage = v1.ShutdownStage(domain.Spec.Metadata.KubeVirt.GracePeriod.ShutdownStage)
+}
+
 func IsoGuestVolumePath(namespace, name string, volume *v1.Volume) string {
 	const basepath = "/var/run"
 	switch {
@@ -1351,7 +1564,11 @@ func (c *VirtualMachineController) updateVMIStatusFromDomain(vmi *v1.VirtualMach
 	c.updateGuestInfoFromDomain(vmi, domain)
 	c.updateVolumeStatusesFromDomain(vmi, domain)
 	c.updateFSFreezeStatus(vmi, domain)
+	c.updateShutdownStage(vmi, domain)
 	c.updateMachineType(vmi, domain)
+	c.updateCgroupLayout(vmi, domain)
+	c.updateThreadPlacement(vmi, domain)
+	c.updateUSBDevicesStatus(vmi, domain)
 	if err = c.updateMemoryInfo(vmi, domain); err != nil {
 		return err
 	}
@@ -1406,6 +1623,8 @@ func (c *VirtualMachineController) updateVMIStatus(origVMI *v1.VirtualMachineIns
 		return err
 	}
 
+	c.updateGuestFilesystemUsage(vmi, condManager)
+
 	// Store containerdisks and kernelboot checksums
 	if err := c.updateChecksumInfo(vmi, syncError); err != nil {
 		return err
@@ -1430,6 +1649,9 @@ func (c *VirtualMachineController) updateVMIStatus(origVMI *v1.VirtualMachineIns
 	// Record an event on the VMI when the VMI's phase changes
 	if oldStatus.Phase != vmi.Status.Phase {
 		c.recordPhaseChangeEvent(vmi)
+		if vmi.IsFinal() && vmi.Status.ShutdownStage != "" {
+			metrics.IncShutdownStage(string(vmi.Status.ShutdownStage))
+		}
 	}
 
 	return nil
@@ -1530,6 +1752,10 @@ func (c *VirtualMachineController) calculateLiveMigrationCondition(vmi *v1.Virtu
 		return newNonMigratableCondition("VMI uses a PCI host devices", v1.VirtualMachineInstanceReasonHostDeviceNotMigratable), isBlockMigration
 	}
 
+	if len(vmi.Spec.Domain.Devices.USBDevices) > 0 {
+		return newNonMigratableCondition("VMI uses USB devices", v1.VirtualMachineInstanceReasonUSBDeviceNotMigratable), isBlockMigration
+	}
+
 	if util.IsSEVVMI(vmi) {
 		return newNonMigratableCondition("VMI uses SEV", v1.VirtualMachineInstanceReasonSEVNotMigratable), isBlockMigration
 	}
@@ -1553,9 +1779,19 @@ func (c *VirtualMachineController) calculateLiveMigrationCondition(vmi *v1.Virtu
 }
 
 func vmiContainsPCIHostDevice(vmi *v1.VirtualMachineInstance) bool {
+	if isHostDevicesHotplugPending(vmi) {
+		return false
+	}
 	return len(vmi.Spec.Domain.Devices.HostDevices) > 0 || len(vmi.Spec.Domain.Devices.GPUs) > 0
 }
 
+// isHostDevicesHotplugPending tells if the GPUs and host devices of the VMI wait for a migration to a pod
+// which has them allocated, in which case none of them is attached to the domain.
+func isHostDevicesHotplugPending(vmi *v1.VirtualMachineInstance) bool {
+	cond := controller.NewVirtualMachineInstanceConditionManager().GetCondition(vmi, v1.VirtualMachineInstanceHostDevicesChange)
+	return cond != nil && cond.Reason != v1.VirtualMachineInstanceReasonHostDeviceNotMigratable
+}
+
 type multipleNonMigratableCondition struct {
 	reasons []string
 	msgs    []string
@@ -1613,6 +1849,10 @@ func (c *VirtualMachineController) calculateLiveStorageMigrationCondition(vmi *v
 		multiCond.addNonMigratableCondition(v1.VirtualMachineInstanceReasonHostDeviceNotMigratable, "VMI uses a PCI host devices")
 	}
 
+	if len(vmi.Spec.Domain.Devices.USBDevices) > 0 {
+		multiCond.addNonMigratableCondition(v1.VirtualMachineInstanceReasonUSBDeviceNotMigratable, "VMI uses USB devices")
+	}
+
 	if util.IsSEVVMI(vmi) {
 		multiCond.addNonMigratableCondition(v1.VirtualMachineInstanceReasonSEVNotMigratable, "VMI uses SEV")
 	}
@@ -1886,7 +2126,7 @@ func (c *VirtualMachineController) defaultExecute(key string,
 		domain.Status.Status != ""
 
 	domainMigrated := domainExists && domainMigrated(domain)
-	forceShutdownIrrecoverable = domainExists && domainPausedFailedPostCopy(domain)
+	forceShutdownIrrecoverable = domainExists && domainPausedFailedPostCopy(domain) && !postCopyRecoveryPending(domain)
 
 	gracefulShutdown := c.hasGracefulShutdownTrigger(domain)
 	if gracefulShutdown && vmi.IsRunning() {
@@ -2156,6 +2396,12 @@ func (c *VirtualMachineController) processVmCleanup(vmi *v1.VirtualMachineInstan
 	c.teardownNetwork(vmi)
 
 	c.sriovHotplugExecutorPool.Delete(vmi.UID)
+	c.hostDevicesHotplugExecutorPool.Delete(vmi.UID)
+	c.usbDevicesHotplugExecutorPool.Delete(vmi.UID)
+	c.usbAllocator.Release(vmi.UID)
+	if err := c.dedicatedCPUPool.Release(vmi.UID); err != nil {
+		return err
+	}
 
 	// Watch dog file and command client must be the last things removed here
 	if err := c.closeLauncherClient(vmi); err != nil {
@@ -2631,6 +2877,20 @@ func isMigrationInProgress(vmi *v1.VirtualMachineInstance, domain *api.Domain) b
 	return false
 }
 
+// retunedMigrationBandwidth returns the bandwidth virt-controller re-tuned an auto-tuned migration to, if the
+// running migration job does not use it yet
+func retunedMigrationBandwidth(vmi *v1.VirtualMachineInstance, domain *api.Domain) (resource.Quantity, bool) {
+	conf := vmi.Status.MigrationState.MigrationConfiguration
+	if conf == nil || conf.BandwidthAutoTuning == nil || conf.BandwidthPerMigration == nil {
+		return resource.Quantity{}, false
+	}
+	bandwidth, err := vcpu.QuantityToMebiByte(*conf.BandwidthPerMigration)
+	if err != nil || bandwidth == 0 || bandwidth == domain.Spec.Metadata.KubeVirt.Migration.Bandwidth {
+		return resource.Quantity{}, false
+	}
+	return *conf.BandwidthPerMigration, true
+}
+
 func (c *VirtualMachineController) vmUpdateHelperMigrationSource(origVMI *v1.VirtualMachineInstance, domain *api.Domain) error {
 
 	client, err := c.getLauncherClient(origVMI)
@@ -2645,6 +2905,9 @@ func (c *VirtualMachineController) vmUpdateHelperMigrationSource(origVMI *v1.Vir
 		}
 	} else {
 		if isMigrationInProgress(origVMI, domain) {
+			if bandwidth, retuned := retunedMigrationBandwidth(origVMI, domain); retuned {
+				return client.MigrateVirtualMachine(origVMI, &cmdclient.MigrationOptions{Bandwidth: bandwidth})
+			}
 			// we already started this migration, no need to rerun this
 			log.DefaultLogger().Errorf("migration %s has already been started", origVMI.Status.MigrationState.MigrationUID)
 			return nil
@@ -2669,8 +2932,16 @@ func (c *VirtualMachineController) vmUpdateHelperMigrationSource(origVMI *v1.Vir
 			AllowPostCopy:           *migrationConfiguration.AllowPostCopy,
 			AllowWorkloadDisruption: *migrationConfiguration.AllowWorkloadDisruption,
 		}
+		if migrationConfiguration.MaxDowntimeMilliseconds != nil {
+			options.MaxDowntimeMilliseconds = *migrationConfiguration.MaxDowntimeMilliseconds
+		}
+		if migrationConfiguration.PostCopyAfterIterations != nil {
+			options.PostCopyAfterIterations = *migrationConfiguration.PostCopyAfterIterations
+		}
 
-		configureParallelMigrationThreads(options, origVMI)
+		if reason := configureParallelMigrationThreads(options, origVMI, migrationConfiguration); reason != "" {
+			c.recorder.Event(origVMI, k8sv1.EventTypeWarning, parallelMigrationIgnoredReason, reason)
+		}
 
 		marshalledOptions, err := json.Marshal(options)
 		if err != nil {
@@ -2875,8 +3146,63 @@ func (c *VirtualMachineController) affinePitThread(vmi *v1.VirtualMachineInstanc
 	return unix.SchedSetaffinity(pitpid, &Mask)
 }
 
+// housekeepingThreads returns the threads of the compute container which are not vCPU threads
+func housekeepingThreads(vmi *v1.VirtualMachineInstance, cgroupManager cgroup.Manager) ([]int, error) {
+	tids, err := cgroupManager.GetCgroupThreads()
+	if err != nil {
+		return nil, err
+	}
+	hktids := make([]int, 0, 10)
+
+	for _, tid := range tids {
+		proc, err := ps.FindProcess(tid)
+		if err != nil {
+			log.Log.Object(vmi).Errorf("Failure to find process: %s", err.Error())
+			return nil, err
+		}
+		if proc == nil {
+			return nil, fmt.Errorf("failed to find process with tid: %d", tid)
+		}
+		comm := proc.Executable()
+		if strings.Contains(comm, "CPU ") && strings.Contains(comm, "KVM") {
+			continue
+		}
+		hktids = append(hktids, tid)
+	}
+	return hktids, nil
+}
+
+// configureHousekeepingCPUQuota limits the housekeeping threads of a VMI without dedicated CPUs
+// to the CPU budget requested in the VMI spec, so that they can't steal CPU time from the vCPUs.
+func (c *VirtualMachineController) configureHousekeepingCPUQuota(vmi *v1.VirtualMachineInstance, cgroupManager cgroup.Manager) error {
+	if err := cgroupManager.CreateChildCgroup(housekeepingCgroupName, "cpu"); err != nil {
+		return err
+	}
+
+	housekeepingCPU := vmi.Spec.Domain.CPU.Housekeeping.CPU
+	quota := housekeepingCPU.MilliValue() * housekeepingCPUPeriod / 1000
+	if err := cgroupManager.SetCpuQuota(housekeepingCgroupName, quota, housekeepingCPUPeriod); err != nil {
+		return err
+	}
+
+	hktids, err := housekeepingThreads(vmi, cgroupManager)
+	if err != nil {
+		return err
+	}
+
+	log.Log.V(3).Object(vmi).Infof("hk thread ids: %v, cpu quota: %d", hktids, quota)
+	for _, tid := range hktids {
+		if err := cgroupManager.AttachTID("cpu", housekeepingCgroupName, tid); err != nil {
+			log.Log.Object(vmi).Errorf("Error attaching tid %d: %v", tid, err.Error())
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (c *VirtualMachineController) configureHousekeepingCgroup(vmi *v1.VirtualMachineInstance, cgroupManager cgroup.Manager) error {
-	if err := cgroupManager.CreateChildCgroup("housekeeping", "cpuset"); err != nil {
+	if err := cgroupManager.CreateChildCgroup(housekeepingCgroupName, "cpuset"); err != nil {
 		log.Log.Reason(err).Error("CreateChildCgroup ")
 		return err
 	}
@@ -2902,36 +3228,19 @@ func (c *VirtualMachineController) configureHousekeepingCgroup(vmi *v1.VirtualMa
 
 	log.Log.V(3).Object(vmi).Infof("housekeeping cpu: %v", hkcpus)
 
-	err = cgroupManager.SetCpuSet("housekeeping", hkcpus)
+	err = cgroupManager.SetCpuSet(housekeepingCgroupName, hkcpus)
 	if err != nil {
 		return err
 	}
 
-	tids, err := cgroupManager.GetCgroupThreads()
+	hktids, err := housekeepingThreads(vmi, cgroupManager)
 	if err != nil {
 		return err
 	}
-	hktids := make([]int, 0, 10)
-
-	for _, tid := range tids {
-		proc, err := ps.FindProcess(tid)
-		if err != nil {
-			log.Log.Object(vmi).Errorf("Failure to find process: %s", err.Error())
-			return err
-		}
-		if proc == nil {
-			return fmt.Errorf("failed to find process with tid: %d", tid)
-		}
-		comm := proc.Executable()
-		if strings.Contains(comm, "CPU ") && strings.Contains(comm, "KVM") {
-			continue
-		}
-		hktids = append(hktids, tid)
-	}
 
 	log.Log.V(3).Object(vmi).Infof("hk thread ids: %v", hktids)
 	for _, tid := range hktids {
-		err = cgroupManager.AttachTID("cpuset", "housekeeping", tid)
+		err = cgroupManager.AttachTID("cpuset", housekeepingCgroupName, tid)
 		if err != nil {
 			log.Log.Object(vmi).Errorf("Error attaching tid %d: %v", tid, err.Error())
 			return err
@@ -3001,6 +3310,10 @@ func (c *VirtualMachineController) handleRunningVMI(vmi *v1.VirtualMachineInstan
 		log.Log.Object(vmi).Error(err.Error())
 	}
 
+	if err := c.hotplugHostDevices(vmi); err != nil {
+		log.Log.Object(vmi).Error(err.Error())
+	}
+
 	if err := c.hotplugVolumeMounter.Mount(vmi, cgroupManager); err != nil {
 		return err
 	}
@@ -3018,6 +3331,14 @@ func (c *VirtualMachineController) handleRunningVMI(vmi *v1.VirtualMachineInstan
 		return err
 	}
 
+	if err := c.syncUSBDevices(vmi, isolationRes, cgroupManager); err != nil {
+		return err
+	}
+
+	if err := c.hotplugUSBDevices(vmi); err != nil {
+		log.Log.Object(vmi).Error(err.Error())
+	}
+
 	if err := c.netConf.Setup(vmi, netsetup.FilterNetsForLiveUpdate(vmi), isolationRes.Pid()); err != nil {
 		log.Log.Object(vmi).Error(err.Error())
 		c.recorder.Event(vmi, k8sv1.EventTypeWarning, "NicHotplug", err.Error())
@@ -3065,6 +3386,14 @@ func (c *VirtualMachineController) handleStartingVMI(
 		return false, err
 	}
 
+	if err := c.syncUSBDevices(vmi, isolationRes, cgroupManager); err != nil {
+		return false, err
+	}
+
+	if err := c.pinToDedicatedCPUPool(vmi, cgroupManager); err != nil {
+		return false, err
+	}
+
 	if err := c.adjustResources(vmi); err != nil {
 		return false, err
 	}
@@ -3192,6 +3521,10 @@ func (c *VirtualMachineController) handleHousekeeping(vmi *v1.VirtualMachineInst
 		if err != nil {
 			return err
 		}
+	} else if hasHousekeepingCPU(vmi) {
+		if err := c.configureHousekeepingCPUQuota(vmi, cgroupManager); err != nil {
+			return err
+		}
 	}
 
 	// Configure vcpu scheduler for realtime workloads and affine PIT thread for dedicated CPU
@@ -3252,13 +3585,193 @@ func (c *VirtualMachineController) hotplugSriovInterfaces(vmi *v1.VirtualMachine
 
 	rateLimitedExecutor := c.sriovHotplugExecutorPool.LoadOrStore(vmi.UID)
 	return rateLimitedExecutor.Exec(func() error {
-		return c.hotplugSriovInterfacesCommand(vmi)
+		return c.hotplugHostDevicesCommand(vmi, "failed to hot-plug SR-IOV interfaces")
+	})
+}
+
+// hotplugHostDevices attaches the GPUs and host devices which were allocated to the pod by migrating the VMI.
+func (c *VirtualMachineController) hotplugHostDevices(vmi *v1.VirtualMachineInstance) error {
+	if controller.NewVirtualMachineInstanceConditionManager().HasCondition(vmi, v1.VirtualMachineInstanceHostDevicesChange) {
+		return nil
+	}
+
+	domain, exists, _, err := c.getDomainFromCache(controller.VirtualMachineInstanceKey(vmi))
+	if err != nil {
+		return err
+	}
+	if !exists || !hasHostDevicesToAttach(vmi, domain) {
+		c.hostDevicesHotplugExecutorPool.Delete(vmi.UID)
+		return nil
+	}
+
+	rateLimitedExecutor := c.hostDevicesHotplugExecutorPool.LoadOrStore(vmi.UID)
+	return rateLimitedExecutor.Exec(func() error {
+		return c.hotplugHostDevicesCommand(vmi, "failed to hot-plug GPUs and host devices")
+	})
+}
+
+func hasHostDevicesToAttach(vmi *v1.VirtualMachineInstance, domain *api.Domain) bool {
+	attachedAliases := map[string]struct{}{}
+	for _, hostDevice := range domain.Spec.Devices.HostDevices {
+		if hostDevice.Alias != nil {
+			attachedAliases[hostDevice.Alias.GetName()] = struct{}{}
+		}
+	}
+	isAttached := func(aliases ...string) bool {
+		for _, alias := range aliases {
+			if _, exists := attachedAliases[alias]; exists {
+				return true
+			}
+		}
+		return false
+	}
+
+	for _, gpu := range vmi.Spec.Domain.Devices.GPUs {
+		if !isAttached(gpuAliasPrefix + gpu.Name) {
+			return true
+		}
+	}
+	for _, hostDevice := range vmi.Spec.Domain.Devices.HostDevices {
+		if !isAttached(hostDeviceAliasPrefix+hostDevice.Name, usbHostDeviceAliasPrefix+hostDevice.Name) {
+			return true
+		}
+	}
+	return false
+}
+
+// syncUSBDevices allocates host devices to the USB devices of the VMI and exposes them to the compute container.
+// The access to the devices released since the previous sync, because they were unplugged or are not requested
+// anymore, is revoked. The allocation is reported in the status of the VMI which is sent to virt-launcher.
+func (c *VirtualMachineController) syncUSBDevices(vmi *v1.VirtualMachineInstance, isolationRes isolation.IsolationResult, cgroupManager cgroup.Manager) error {
+	if len(vmi.Spec.Domain.Devices.USBDevices) == 0 && len(vmi.Status.USBDevices) == 0 {
+		if _, exists := c.usbAllocator.Allocated(vmi.UID); !exists {
+			return nil
+		}
+	}
+
+	var permittedDevices []v1.USBPassthroughHostDevice
+	if permittedHostDevices := c.clusterConfig.GetPermittedHostDevices(); permittedHostDevices != nil {
+		permittedDevices = permittedHostDevices.USBPassthrough
+	}
+	allocated, released, err := c.usbAllocator.Allocate(vmi, permittedDevices)
+	if err != nil {
+		return fmt.Errorf("failed to allocate USB devices: %v", err)
+	}
+	for _, device := range released {
+		if err := usbpassthrough.RevokeDevice(device, cgroupManager); err != nil {
+			return err
+		}
+	}
+
+	if len(allocated) > 0 {
+		launcherRoot, err := isolationRes.MountRoot()
+		if err != nil {
+			return err
+		}
+		for _, device := range allocated {
+			if err := usbpassthrough.ExposeDevice(launcherRoot, device, cgroupManager); err != nil {
+				return err
+			}
+		}
+	}
+
+	vmi.Status.USBDevices = usbDevicesStatus(vmi, allocated, nil)
+	return nil
+}
+
+// hotplugUSBDevices attaches the USB devices allocated to the VMI which are not attached to the domain,
+// and detaches the ones which are not allocated to it anymore.
+func (c *VirtualMachineController) hotplugUSBDevices(vmi *v1.VirtualMachineInstance) error {
+	domain, exists, _, err := c.getDomainFromCache(controller.VirtualMachineInstanceKey(vmi))
+	if err != nil {
+		return err
+	}
+	if !exists || !hasUSBDevicesToSync(vmi, domain) {
+		c.usbDevicesHotplugExecutorPool.Delete(vmi.UID)
+		return nil
+	}
+
+	rateLimitedExecutor := c.usbDevicesHotplugExecutorPool.LoadOrStore(vmi.UID)
+	return rateLimitedExecutor.Exec(func() error {
+		return c.hotplugHostDevicesCommand(vmi, "failed to hot-attach USB devices")
 	})
 }
 
-func (c *VirtualMachineController) hotplugSriovInterfacesCommand(vmi *v1.VirtualMachineInstance) error {
-	const errMsgPrefix = "failed to hot-plug SR-IOV interfaces"
+// hasUSBDevicesToSync tells if the USB devices attached to the domain differ from the ones allocated to the VMI
+func hasUSBDevicesToSync(vmi *v1.VirtualMachineInstance, domain *api.Domain) bool {
+	attached := attachedUSBDevices(domain)
+	allocatedCount := 0
+	for _, status := range vmi.Status.USBDevices {
+		if status.Phase == v1.USBDevicePending {
+			continue
+		}
+		allocatedCount++
+		if address, isAttached := attached[usbDeviceAliasPrefix+status.Name]; !isAttached ||
+			address != usbDeviceAddress(status.Bus, status.DeviceNumber) {
+			return true
+		}
+	}
+	return len(attached) != allocatedCount
+}
+
+// updateUSBDevicesStatus reports the host devices allocated to the USB devices of the VMI, and whether they are
+// attached to the domain. The status is left untouched until the allocation is synced, since the allocation
+// is recovered from it.
+func (c *VirtualMachineController) updateUSBDevicesStatus(vmi *v1.VirtualMachineInstance, domain *api.Domain) {
+	allocated, exists := c.usbAllocator.Allocated(vmi.UID)
+	if !exists {
+		return
+	}
+	vmi.Status.USBDevices = usbDevicesStatus(vmi, allocated, domain)
+}
+
+// usbDevicesStatus builds the status of the USB devices of the VMI from the host devices allocated to them.
+// The allocated devices which are attached to the given domain are reported as attached.
+func usbDevicesStatus(vmi *v1.VirtualMachineInstance, allocated map[string]usbpassthrough.Device, domain *api.Domain) []v1.USBDeviceStatus {
+	attached := attachedUSBDevices(domain)
+
+	var statuses []v1.USBDeviceStatus
+	for _, usbDevice := range vmi.Spec.Domain.Devices.USBDevices {
+		status := v1.USBDeviceStatus{Name: usbDevice.Name, Phase: v1.USBDevicePending}
+		if device, isAllocated := allocated[usbDevice.Name]; isAllocated {
+			status.Phase = v1.USBDeviceAllocated
+			status.BusPort = device.BusPort
+			status.Bus = device.Bus
+			status.DeviceNumber = device.DeviceNumber
+			if address, isAttached := attached[usbDeviceAliasPrefix+usbDevice.Name]; isAttached &&
+				address == usbDeviceAddress(device.Bus, device.DeviceNumber) {
+				status.Phase = v1.USBDeviceAttached
+			}
+		}
+		statuses = append(statuses, status)
+	}
+	return statuses
+}
 
+// attachedUSBDevices returns the bus and device numbers of the USB devices attached to the domain, by alias
+func attachedUSBDevices(domain *api.Domain) map[string]string {
+	attached := map[string]string{}
+	if domain == nil {
+		return attached
+	}
+	for _, hostDevice := range domain.Spec.Devices.HostDevices {
+		if hostDevice.Alias == nil || !strings.HasPrefix(hostDevice.Alias.GetName(), usbDeviceAliasPrefix) {
+			continue
+		}
+		var address string
+		if hostDevice.Source.Address != nil {
+			address = hostDevice.Source.Address.Bus + ":" + hostDevice.Source.Address.Device
+		}
+		attached[hostDevice.Alias.GetName()] = address
+	}
+	return attached
+}
+
+func usbDeviceAddress(bus, deviceNumber int) string {
+	return fmt.Sprintf("%d:%d", bus, deviceNumber)
+}
+
+func (c *VirtualMachineController) hotplugHostDevicesCommand(vmi *v1.VirtualMachineInstance, errMsgPrefix string) error {
 	client, err := c.getVerifiedLauncherClient(vmi)
 	if err != nil {
 		return fmt.Errorf("%s: %v", errMsgPrefix, err)
@@ -3477,7 +3990,11 @@ func (c *VirtualMachineController) finalizeMigration(vmi *v1.VirtualMachineInsta
 	}
 	removeMigratedVolumes(vmi)
 
-	options := &cmdv1.VirtualMachineOptions{}
+	options := &cmdv1.VirtualMachineOptions{
+		ClusterConfig: &cmdv1.ClusterConfig{
+			BlockLatencyHistogramsEnabled: c.clusterConfig.BlockLatencyHistogramsEnabled(),
+		},
+	}
 	options.InterfaceMigration = domainspec.BindingMigrationByInterfaceName(vmi.Spec.Domain.Devices.Interfaces, c.clusterConfig.GetNetworkBindings())
 	if err := client.FinalizeVirtualMachineMigration(vmi, options); err != nil {
 		log.Log.Object(vmi).Reason(err).Error(errorMessage)
@@ -3536,6 +4053,10 @@ func (c *VirtualMachineController) reportDedicatedCPUSetForMigratingVMI(vmi *v1.
 		return err
 	}
 
+	if err := c.pinToDedicatedCPUPool(vmi, cgroupManager); err != nil {
+		return err
+	}
+
 	cpusetStr, err := cgroupManager.GetCpuSet()
 	if err != nil {
 		return err
@@ -3551,6 +4072,17 @@ func (c *VirtualMachineController) reportDedicatedCPUSetForMigratingVMI(vmi *v1.
 	return nil
 }
 
+// pinToDedicatedCPUPool restricts the compute container of a VMI with dedicated CPUs to the CPUs allocated to it
+// from the dedicated CPU pool of the node, if any. It has to be done before the domain is created, since
+// virt-launcher pins the vCPUs to the CPU set of its cgroup.
+func (c *VirtualMachineController) pinToDedicatedCPUPool(vmi *v1.VirtualMachineInstance, cgroupManager cgroup.Manager) error {
+	cpus, err := c.dedicatedCPUPool.Allocate(vmi)
+	if err != nil || len(cpus) == 0 {
+		return err
+	}
+	return cgroupManager.SetCpuSet("", cpus)
+}
+
 func (c *VirtualMachineController) reportTargetTopologyForMigratingVMI(vmi *v1.VirtualMachineInstance) error {
 	options := virtualMachineOptions(nil, 0, nil, c.capabilities, c.clusterConfig)
 	topology, err := json.Marshal(options.Topology)
@@ -3590,6 +4122,72 @@ func (c *VirtualMachineController) updateMachineType(vmi *v1.VirtualMachineInsta
 	}
 }
 
+func hasHousekeepingCPU(vmi *v1.VirtualMachineInstance) bool {
+	return vmi.Spec.Domain.CPU != nil && vmi.Spec.Domain.CPU.Housekeeping != nil
+}
+
+// updateCgroupLayout reports the housekeeping cgroup of the compute container, if the VMI has one
+func (c *VirtualMachineController) updateCgroupLayout(vmi *v1.VirtualMachineInstance, domain *api.Domain) {
+	if domain == nil || vmi == nil {
+		return
+	}
+
+	var housekeeping *v1.HousekeepingCgroup
+	if vmi.IsCPUDedicated() && vmi.Spec.Domain.CPU.IsolateEmulatorThread {
+		if domain.Spec.CPUTune != nil && domain.Spec.CPUTune.EmulatorPin != nil {
+			housekeeping = &v1.HousekeepingCgroup{
+				Name:   housekeepingCgroupName,
+				CPUSet: domain.Spec.CPUTune.EmulatorPin.CPUSet,
+			}
+		}
+	} else if hasHousekeepingCPU(vmi) {
+		cpuLimit := vmi.Spec.Domain.CPU.Housekeeping.CPU.DeepCopy()
+		housekeeping = &v1.HousekeepingCgroup{
+			Name:     housekeepingCgroupName,
+			CPULimit: &cpuLimit,
+		}
+	}
+
+	if housekeeping == nil {
+		vmi.Status.CgroupLayout = nil
+		return
+	}
+
+	version := cgroup.V1
+	if cgroups.IsCgroup2UnifiedMode() {
+		version = cgroup.V2
+	}
+	vmi.Status.CgroupLayout = &v1.CgroupLayout{
+		Version:      string(version),
+		Housekeeping: housekeeping,
+	}
+}
+
+// updateThreadPlacement reports the pCPUs the emulator thread and the IOThreads are pinned to
+func (c *VirtualMachineController) updateThreadPlacement(vmi *v1.VirtualMachineInstance, domain *api.Domain) {
+	if domain == nil || vmi == nil {
+		return
+	}
+
+	cpuTune := domain.Spec.CPUTune
+	if cpuTune == nil || (cpuTune.EmulatorPin == nil && len(cpuTune.IOThreadPin) == 0) {
+		vmi.Status.ThreadPlacement = nil
+		return
+	}
+
+	placement := &v1.ThreadPlacementStatus{}
+	if cpuTune.EmulatorPin != nil {
+		placement.EmulatorThreadCPUSet = cpuTune.EmulatorPin.CPUSet
+	}
+	for _, pin := range cpuTune.IOThreadPin {
+		placement.IOThreads = append(placement.IOThreads, v1.IOThreadPlacement{
+			ID:     pin.IOThread,
+			CPUSet: pin.CPUSet,
+		})
+	}
+	vmi.Status.ThreadPlacement = placement
+}
+
 func (c *VirtualMachineController) hotplugCPU(vmi *v1.VirtualMachineInstance, client cmdclient.LauncherClient) error {
 	vmiConditions := controller.NewVirtualMachineInstanceConditionManager()
 
@@ -3732,17 +4330,53 @@ func (c *VirtualMachineController) updateMemoryInfo(vmi *v1.VirtualMachineInstan
 	}
 	currentGuest := parseLibvirtQuantity(int64(domain.Spec.CurrentMemory.Value), domain.Spec.CurrentMemory.Unit)
 	vmi.Status.Memory.GuestCurrent = currentGuest
+	vmi.Status.Memory.GuestUsable = usableGuestMemory(vmi, domain, currentGuest)
 	return nil
 }
 
-func configureParallelMigrationThreads(options *cmdclient.MigrationOptions, vm *v1.VirtualMachineInstance) {
+// usableGuestMemory returns the boot memory plus the memory currently plugged into the virtio-mem device.
+// The guest may not release all the memory asked to be unplugged, therefore the plugged size is taken
+// from what the device reports and not from what was requested.
+func usableGuestMemory(vmi *v1.VirtualMachineInstance, domain *api.Domain, currentGuest *resource.Quantity) *resource.Quantity {
+	memoryDevice := domain.Spec.Devices.Memory
+	if memoryDevice == nil || memoryDevice.Target == nil || vmi.Status.Memory.GuestAtBoot == nil {
+		return currentGuest
+	}
+	usable := vmi.Status.Memory.GuestAtBoot.DeepCopy()
+	if plugged := parseLibvirtQuantity(int64(memoryDevice.Target.Current.Value), memoryDevice.Target.Current.Unit); plugged != nil {
+		usable.Add(*plugged)
+	}
+	return &usable
+}
+
+// configureParallelMigrationThreads returns why explicitly configured parallel migration channels or a compression
+// are not used for the VMI, so that it can be surfaced instead of silently migrating without them
+func configureParallelMigrationThreads(options *cmdclient.MigrationOptions, vm *v1.VirtualMachineInstance,
+	conf *v1.MigrationConfiguration) string {
 	// When the CPU is limited, there's a risk of the migration threads choking the CPU resources on the compute container.
 	// For this reason, we will avoid configuring migration threads in such scenarios.
 	if cpuLimit, cpuLimitExists := vm.Spec.Domain.Resources.Limits[k8sv1.ResourceCPU]; cpuLimitExists && !cpuLimit.IsZero() {
-		return
+		if (conf.ParallelMigrationChannels != nil && *conf.ParallelMigrationChannels > 1) || conf.Compression != nil {
+			return "The configured parallel migration channels and compression are not used because the CPU of the VirtualMachineInstance is limited"
+		}
+		return ""
+	}
+
+	threads := parallelMultifdMigrationThreads
+	if conf.ParallelMigrationChannels != nil {
+		threads = uint(*conf.ParallelMigrationChannels)
+	}
+	// A single channel is a regular migration over one connection
+	if threads <= 1 {
+		if conf.Compression != nil {
+			return "The configured migration compression is not used because it requires more than one parallel migration channel"
+		}
+		return ""
 	}
 
-	options.ParallelMigrationThreads = pointer.P(parallelMultifdMigrationThreads)
+	options.ParallelMigrationThreads = pointer.P(threads)
+	options.Compression = conf.Compression.DeepCopy()
+	return ""
 }
 
 func isReadOnlyDisk(disk *v1.Disk) bool {
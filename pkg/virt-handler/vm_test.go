@@ -57,6 +57,7 @@ import (
 	cmdv1 "kubevirt.io/kubevirt/pkg/handler-launcher-com/cmd/v1"
 	"kubevirt.io/kubevirt/pkg/libvmi"
 	libvmistatus "kubevirt.io/kubevirt/pkg/libvmi/status"
+	metrics "kubevirt.io/kubevirt/pkg/monitoring/metrics/virt-handler"
 	netcache "kubevirt.io/kubevirt/pkg/network/cache"
 	neterrors "kubevirt.io/kubevirt/pkg/network/errors"
 	"kubevirt.io/kubevirt/pkg/pointer"
@@ -64,6 +65,7 @@ import (
 	"kubevirt.io/kubevirt/pkg/testutils"
 	"kubevirt.io/kubevirt/pkg/util"
 	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
+	"kubevirt.io/kubevirt/pkg/virt-config/featuregate"
 	"kubevirt.io/kubevirt/pkg/virt-controller/services"
 	virtcache "kubevirt.io/kubevirt/pkg/virt-handler/cache"
 	"kubevirt.io/kubevirt/pkg/virt-handler/cgroup"
@@ -73,6 +75,7 @@ import (
 	"kubevirt.io/kubevirt/pkg/virt-handler/isolation"
 	migrationproxy "kubevirt.io/kubevirt/pkg/virt-handler/migration-proxy"
 	notifyserver "kubevirt.io/kubevirt/pkg/virt-handler/notify-server"
+	usbpassthrough "kubevirt.io/kubevirt/pkg/virt-handler/usb-passthrough"
 	notifyclient "kubevirt.io/kubevirt/pkg/virt-launcher/notify-client"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
 )
@@ -960,6 +963,14 @@ var _ = Describe("VirtualMachineInstance", func() {
 			domain.Status.Status = api.Running
 			domain.Status.Reason = ""
 
+			for i := range updatedVMI.Status.Conditions {
+				if updatedVMI.Status.Conditions[i].Type == v1.VirtualMachineInstancePaused {
+					updatedVMI.Status.Conditions[i].LastTransitionTime = metav1.NewTime(time.Now().Add(-90 * time.Second))
+				}
+			}
+			updatedVMI.Status.PauseStatus = &v1.PauseStatus{LastPauseDurationSeconds: 10, TotalPauseDurationSeconds: 10}
+			updatedVMI, err = virtfakeClient.KubevirtV1().VirtualMachineInstances(metav1.NamespaceDefault).UpdateStatus(context.TODO(), updatedVMI, metav1.UpdateOptions{})
+			Expect(err).NotTo(HaveOccurred())
 			addVMI(updatedVMI.DeepCopy())
 			addDomain(domain)
 
@@ -986,6 +997,11 @@ var _ = Describe("VirtualMachineInstance", func() {
 					"Status": Equal(k8sv1.ConditionTrue)},
 				),
 			))
+
+			By("recording the duration of the pause")
+			Expect(updatedVMI.Status.PauseStatus.LastPauseDurationSeconds).To(BeNumerically(">=", 90))
+			Expect(updatedVMI.Status.PauseStatus.TotalPauseDurationSeconds).To(Equal(updatedVMI.Status.PauseStatus.LastPauseDurationSeconds + 10))
+			testutils.ExpectEvent(recorder, "resumed after being paused for 1m3")
 		})
 
 		It("should move VirtualMachineInstance from Scheduled to Failed if watchdog file is missing", func() {
@@ -1520,6 +1536,64 @@ var _ = Describe("VirtualMachineInstance", func() {
 				controller.updateVolumeStatusesFromDomain(vmi, domain)
 			})
 
+			It("Should report the progress of the memory dump", func() {
+				vmi := api2.NewMinimalVMI("testvmi")
+				vmi.UID = vmiTestUUID
+				vmi.Status.Phase = v1.Running
+				vmi.Spec.Volumes = append(vmi.Spec.Volumes, v1.Volume{
+					Name: "test",
+				})
+				volumeStatus := v1.VolumeStatus{
+					Name:  "test",
+					Phase: v1.MemoryDumpVolumeInProgress,
+					HotplugVolume: &v1.HotplugVolumeStatus{
+						AttachPodName: "testpod",
+						AttachPodUID:  "1234",
+					},
+					MemoryDumpVolume: &v1.DomainMemoryDumpInfo{
+						ClaimName:      "test",
+						TargetFileName: dumpTargetFile(vmi.Name, "test"),
+					},
+				}
+				vmi.Status.VolumeStatus = append(vmi.Status.VolumeStatus, volumeStatus)
+				domain := api.NewMinimalDomainWithUUID("testvmi", vmiTestUUID)
+				now := metav1.Now()
+				domain.Spec.Metadata.KubeVirt.MemoryDump = &api.MemoryDumpMetadata{
+					FileName:       dumpTargetFile(vmi.Name, "test"),
+					StartTimestamp: &now,
+					DataProcessed:  256,
+					DataTotal:      1024,
+				}
+				domain.Status.Status = api.Running
+				addVMI(vmi)
+				addDomain(domain)
+
+				mockHotplugVolumeMounter.EXPECT().IsMounted(vmi, "test", gomock.Any()).Return(true, nil)
+				controller.updateVolumeStatusesFromDomain(vmi, domain)
+
+				Expect(vmi.Status.VolumeStatus[0].Phase).To(Equal(v1.MemoryDumpVolumeInProgress))
+				Expect(vmi.Status.VolumeStatus[0].MemoryDumpVolume.Progress).To(Equal(&v1.MemoryDumpProgress{
+					DataProcessedBytes: 256,
+					DataTotalBytes:     1024,
+					PercentComplete:    25,
+				}))
+			})
+
+			DescribeTable("Should name the memory dump after its compression", func(compression v1.MemoryDumpCompression, extension string) {
+				vmi := api2.NewMinimalVMI("testvmi")
+				vmi.Spec.Volumes = append(vmi.Spec.Volumes, v1.Volume{
+					Name: "test",
+					VolumeSource: v1.VolumeSource{
+						MemoryDump: &v1.MemoryDumpVolumeSource{Compression: compression},
+					},
+				})
+				Expect(memoryDumpFileExtension(vmi, "test")).To(Equal(extension))
+			},
+				Entry("without compression", v1.MemoryDumpCompression(""), ""),
+				Entry("with gzip", v1.MemoryDumpCompressionGzip, ".gz"),
+				Entry("with zstd", v1.MemoryDumpCompressionZstd, ".zst"),
+			)
+
 			It("Should generate memory dump failed event if memory dump failed", func() {
 				vmi := api2.NewMinimalVMI("testvmi")
 				vmi.UID = vmiTestUUID
@@ -1838,6 +1912,157 @@ var _ = Describe("VirtualMachineInstance", func() {
 			sanityExecute()
 		})
 
+		DescribeTable("should pass a re-tuned bandwidth on to the running migration", func(bandwidth string, expectMigrate bool) {
+			vmi := api2.NewMinimalVMI("testvmi")
+			vmi.UID = vmiTestUUID
+			vmi.ObjectMeta.ResourceVersion = "1"
+			vmi.Status.Phase = v1.Running
+			vmi.Labels = map[string]string{v1.MigrationTargetNodeNameLabel: "othernode"}
+			vmi.Status.NodeName = host
+			vmi.Status.Interfaces = make([]v1.VirtualMachineInstanceNetworkInterface, 0)
+			startTimestamp := metav1.Now()
+			vmi.Status.MigrationState = &v1.VirtualMachineInstanceMigrationState{
+				TargetNode:                     "othernode",
+				TargetNodeAddress:              "127.0.0.1:12345",
+				SourceNode:                     host,
+				MigrationUID:                   "123",
+				TargetDirectMigrationNodePorts: map[string]int{"49152": 12132},
+				StartTimestamp:                 &startTimestamp,
+				MigrationConfiguration: &v1.MigrationConfiguration{
+					BandwidthPerMigration: pointer.P(resource.MustParse(bandwidth)),
+					BandwidthAutoTuning:   &v1.MigrationBandwidthAutoTuning{},
+				},
+			}
+			vmi.Status.Conditions = []v1.VirtualMachineInstanceCondition{
+				{
+					Type:   v1.VirtualMachineInstanceIsMigratable,
+					Status: k8sv1.ConditionTrue,
+				},
+			}
+			vmi = addActivePods(vmi, podTestUUID, host)
+
+			domain := api.NewMinimalDomainWithUUID("testvmi", vmiTestUUID)
+			domain.Status.Status = api.Running
+			domain.Spec.Metadata.KubeVirt.Migration = &api.MigrationMetadata{
+				StartTimestamp: &startTimestamp,
+				UID:            "123",
+				Bandwidth:      64,
+			}
+			addDomain(domain)
+			addVMI(vmi)
+			if expectMigrate {
+				client.EXPECT().MigrateVirtualMachine(gomock.Any(), gomock.Any()).Do(func(_ *v1.VirtualMachineInstance, options *cmdclient.MigrationOptions) {
+					Expect(options.Bandwidth.Equal(resource.MustParse(bandwidth))).To(BeTrue())
+				})
+			}
+			sanityExecute()
+		},
+			Entry("when it changed", "128Mi", true),
+			Entry("but not when the migration already uses it", "64Mi", false),
+		)
+
+		It("should report the migration progress on the vmi", func() {
+			vmi := api2.NewMinimalVMI("testvmi")
+			vmi.UID = vmiTestUUID
+			vmi.ObjectMeta.ResourceVersion = "1"
+			vmi.Status.Phase = v1.Running
+			vmi.Labels = make(map[string]string)
+			vmi.Status.NodeName = host
+			vmi.Labels[v1.MigrationTargetNodeNameLabel] = "othernode"
+			vmi.Status.Interfaces = make([]v1.VirtualMachineInstanceNetworkInterface, 0)
+			startTimestamp := metav1.Now()
+			vmi.Status.MigrationState = &v1.VirtualMachineInstanceMigrationState{
+				TargetNode:                     "othernode",
+				TargetNodeAddress:              "127.0.0.1:12345",
+				SourceNode:                     host,
+				MigrationUID:                   "123",
+				TargetDirectMigrationNodePorts: map[string]int{"49152": 12132},
+				StartTimestamp:                 &startTimestamp,
+			}
+			vmi.Status.Conditions = []v1.VirtualMachineInstanceCondition{
+				{
+					Type:   v1.VirtualMachineInstanceIsMigratable,
+					Status: k8sv1.ConditionTrue,
+				},
+			}
+			vmi = addActivePods(vmi, podTestUUID, host)
+
+			domain := api.NewMinimalDomainWithUUID("testvmi", vmiTestUUID)
+			domain.Status.Status = api.Running
+			domain.Spec.Metadata.KubeVirt.Migration = &api.MigrationMetadata{
+				StartTimestamp: &startTimestamp,
+				UID:            "123",
+				DataProcessed:  1024,
+				DataRemaining:  3072,
+				DataTotal:      4096,
+				Downtime:       300,
+			}
+			addDomain(domain)
+			addVMI(vmi)
+			createVMI(vmi)
+			sanityExecute()
+
+			updatedVMI, err := virtfakeClient.KubevirtV1().VirtualMachineInstances(metav1.NamespaceDefault).Get(context.TODO(), vmi.Name, metav1.GetOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(updatedVMI.Status.MigrationState.Progress).To(Equal(&v1.MigrationProgress{
+				DataProcessedBytes:   1024,
+				DataRemainingBytes:   3072,
+				DataTotalBytes:       4096,
+				DowntimeMilliseconds: 300,
+				PercentComplete:      25,
+			}))
+		})
+
+		DescribeTable("should report the recovery of a failed post copy migration", func(
+			migration *api.MigrationMetadata, status k8sv1.ConditionStatus, reason, result string) {
+			var before float64
+			if result != "" {
+				var err error
+				before, err = metrics.GetPostCopyRecoveries(result)
+				Expect(err).ToNot(HaveOccurred())
+			}
+
+			vmi := api2.NewMinimalVMI("testvmi")
+			updatePostCopyRecoveryCondition(vmi, migration)
+			updatePostCopyRecoveryCondition(vmi, migration)
+
+			Expect(vmi.Status.Conditions).To(ConsistOf(And(
+				HaveField("Type", v1.VirtualMachineInstancePostCopyRecovered),
+				HaveField("Status", status),
+				HaveField("Reason", reason),
+			)))
+			if result != "" {
+				Expect(metrics.GetPostCopyRecoveries(result)).To(Equal(before + 1))
+			}
+		},
+			Entry("in progress", &api.MigrationMetadata{PostCopyRecoveryAttempts: 1},
+				k8sv1.ConditionFalse, v1.VirtualMachineInstanceReasonPostCopyRecoveryInProgress, ""),
+			Entry("succeeded", &api.MigrationMetadata{PostCopyRecoveryAttempts: 2, PostCopyRecovered: true},
+				k8sv1.ConditionTrue, v1.VirtualMachineInstanceReasonPostCopyRecoverySucceeded, metrics.PostCopyRecoveryResultSucceeded),
+			Entry("failed", &api.MigrationMetadata{PostCopyRecoveryAttempts: 3, Failed: true},
+				k8sv1.ConditionFalse, v1.VirtualMachineInstanceReasonPostCopyRecoveryFailed, metrics.PostCopyRecoveryResultFailed),
+		)
+
+		It("should not report a post copy recovery without attempts", func() {
+			vmi := api2.NewMinimalVMI("testvmi")
+			updatePostCopyRecoveryCondition(vmi, &api.MigrationMetadata{Mode: v1.MigrationPostCopy})
+			Expect(vmi.Status.Conditions).To(BeEmpty())
+		})
+
+		DescribeTable("should wait for virt-launcher to recover a failed post copy migration", func(
+			migration *api.MigrationMetadata, pending bool) {
+			domain := api.NewMinimalDomainWithUUID("testvmi", vmiTestUUID)
+			domain.Status.Status = api.Paused
+			domain.Status.Reason = api.ReasonPausedPostcopyFailed
+			domain.Spec.Metadata.KubeVirt.Migration = migration
+			Expect(postCopyRecoveryPending(domain)).To(Equal(pending))
+		},
+			Entry("while the migration did not end", &api.MigrationMetadata{Mode: v1.MigrationPostCopy}, true),
+			Entry("not once the migration ended", &api.MigrationMetadata{Mode: v1.MigrationPostCopy, EndTimestamp: pointer.P(metav1.Now())}, false),
+			Entry("not without a post copy migration", &api.MigrationMetadata{Mode: v1.MigrationPreCopy}, false),
+			Entry("not without a migration", nil, false),
+		)
+
 		It("should abort vmi migration vmi when migration object indicates deletion", func() {
 			vmi := api2.NewMinimalVMI("testvmi")
 			vmi.UID = vmiTestUUID
@@ -2725,6 +2950,110 @@ var _ = Describe("VirtualMachineInstance", func() {
 			Expect(condition.Reason).To(Equal(v1.VirtualMachineInstanceReasonSEVNotMigratable))
 		})
 
+		DescribeTable("with GPUs", func(hotplugCondition *v1.VirtualMachineInstanceCondition, expectedStatus k8sv1.ConditionStatus) {
+			vmi := api2.NewMinimalVMI("testvmi")
+			vmi.Spec.Domain.Devices.GPUs = []v1.GPU{{Name: "gpu1", DeviceName: "vendor.com/gpu"}}
+			if hotplugCondition != nil {
+				vmi.Status.Conditions = append(vmi.Status.Conditions, *hotplugCondition)
+			}
+
+			condition, _ := controller.calculateLiveMigrationCondition(vmi)
+			Expect(condition.Type).To(Equal(v1.VirtualMachineInstanceIsMigratable))
+			Expect(condition.Status).To(Equal(expectedStatus))
+		},
+			Entry("should not be allowed to live-migrate", nil, k8sv1.ConditionFalse),
+			Entry("should be allowed to live-migrate when the GPUs wait for hotplug",
+				&v1.VirtualMachineInstanceCondition{Type: v1.VirtualMachineInstanceHostDevicesChange, Status: k8sv1.ConditionTrue},
+				k8sv1.ConditionTrue),
+			Entry("should not be allowed to live-migrate when the pod has GPUs allocated",
+				&v1.VirtualMachineInstanceCondition{
+					Type:   v1.VirtualMachineInstanceHostDevicesChange,
+					Status: k8sv1.ConditionFalse,
+					Reason: v1.VirtualMachineInstanceReasonHostDeviceNotMigratable,
+				},
+				k8sv1.ConditionFalse),
+		)
+
+		DescribeTable("should tell if GPUs and host devices need to be attached to the domain", func(attachedAliases []string, expected bool) {
+			vmi := api2.NewMinimalVMI("testvmi")
+			vmi.Spec.Domain.Devices.GPUs = []v1.GPU{{Name: "gpu1", DeviceName: "vendor.com/gpu"}}
+			vmi.Spec.Domain.Devices.HostDevices = []v1.HostDevice{{Name: "usb1", DeviceName: "vendor.com/usb"}}
+			domain := api.NewMinimalDomain("testvmi")
+			for _, alias := range attachedAliases {
+				domain.Spec.Devices.HostDevices = append(domain.Spec.Devices.HostDevices, api.HostDevice{Alias: api.NewUserDefinedAlias(alias)})
+			}
+
+			Expect(hasHostDevicesToAttach(vmi, domain)).To(Equal(expected))
+		},
+			Entry("when none is attached", nil, true),
+			Entry("when the host device is not attached", []string{"gpu-gpu1"}, true),
+			Entry("not when all are attached", []string{"gpu-gpu1", "usb-host-usb1"}, false),
+		)
+
+		It("should not be allowed to live-migrate if the VMI uses USB devices", func() {
+			vmi := api2.NewMinimalVMI("testvmi")
+			vmi.Spec.Domain.Devices.USBDevices = []v1.USBDevice{{Name: "token", VendorProduct: "1050:0407"}}
+
+			condition, _ := controller.calculateLiveMigrationCondition(vmi)
+			Expect(condition.Type).To(Equal(v1.VirtualMachineInstanceIsMigratable))
+			Expect(condition.Status).To(Equal(k8sv1.ConditionFalse))
+			Expect(condition.Reason).To(Equal(v1.VirtualMachineInstanceReasonUSBDeviceNotMigratable))
+		})
+
+		Context("with USB devices", func() {
+			newUSBHostDevice := func(name, bus, device string) api.HostDevice {
+				return api.HostDevice{
+					Type:   api.HostDeviceUSB,
+					Alias:  api.NewUserDefinedAlias(usbDeviceAliasPrefix + name),
+					Source: api.HostDeviceSource{Address: &api.Address{Bus: bus, Device: device}},
+				}
+			}
+
+			var (
+				vmi       *v1.VirtualMachineInstance
+				allocated map[string]usbpassthrough.Device
+			)
+
+			BeforeEach(func() {
+				vmi = api2.NewMinimalVMI("testvmi")
+				vmi.Spec.Domain.Devices.USBDevices = []v1.USBDevice{
+					{Name: "token", VendorProduct: "1050:0407"},
+					{Name: "reader", BusPort: "2-4"},
+				}
+				allocated = map[string]usbpassthrough.Device{
+					"token": {BusPort: "1-2", Vendor: "1050", Product: "0407", Bus: 1, DeviceNumber: 5},
+				}
+			})
+
+			It("should report the allocated and attached devices", func() {
+				domain := api.NewMinimalDomain("testvmi")
+				domain.Spec.Devices.HostDevices = []api.HostDevice{newUSBHostDevice("token", "1", "5")}
+
+				Expect(usbDevicesStatus(vmi, allocated, nil)).To(Equal([]v1.USBDeviceStatus{
+					{Name: "token", Phase: v1.USBDeviceAllocated, BusPort: "1-2", Bus: 1, DeviceNumber: 5},
+					{Name: "reader", Phase: v1.USBDevicePending},
+				}))
+				Expect(usbDevicesStatus(vmi, allocated, domain)).To(Equal([]v1.USBDeviceStatus{
+					{Name: "token", Phase: v1.USBDeviceAttached, BusPort: "1-2", Bus: 1, DeviceNumber: 5},
+					{Name: "reader", Phase: v1.USBDevicePending},
+				}))
+			})
+
+			DescribeTable("should tell if the USB devices of the domain need to be synced", func(hostDevices []api.HostDevice, expected bool) {
+				vmi.Status.USBDevices = usbDevicesStatus(vmi, allocated, nil)
+				domain := api.NewMinimalDomain("testvmi")
+				domain.Spec.Devices.HostDevices = hostDevices
+
+				Expect(hasUSBDevicesToSync(vmi, domain)).To(Equal(expected))
+			},
+				Entry("when the allocated device is not attached", nil, true),
+				Entry("when the allocated device was replugged", []api.HostDevice{newUSBHostDevice("token", "1", "3")}, true),
+				Entry("when a device which is not allocated is attached",
+					[]api.HostDevice{newUSBHostDevice("token", "1", "5"), newUSBHostDevice("reader", "2", "7")}, true),
+				Entry("not when the allocated devices are attached", []api.HostDevice{newUSBHostDevice("token", "1", "5")}, false),
+			)
+		})
+
 		It("should not be allowed to live-migrate if the VMI uses SCSI persistent reservation", func() {
 			vmi := api2.NewMinimalVMI("testvmi")
 
@@ -2936,6 +3265,7 @@ var _ = Describe("VirtualMachineInstance", func() {
 				guestOSMachine       = "x86_64"
 				guestOSKernelRelease = "5.14.10-300.fc35.x86_64"
 				guestOSKernelVersion = "#1 SMP Thu Oct 7 20:48:44 UTC 2021"
+				guestOSHostname      = "testvmi.example.com"
 			)
 
 			vmi.Status.GuestOSInfo = v1.VirtualMachineInstanceGuestOSInfo{}
@@ -2952,6 +3282,7 @@ var _ = Describe("VirtualMachineInstance", func() {
 				Machine:       guestOSMachine,
 				KernelRelease: guestOSKernelRelease,
 				KernelVersion: guestOSKernelVersion,
+				Hostname:      guestOSHostname,
 			}
 
 			addVMI(vmi)
@@ -2971,6 +3302,36 @@ var _ = Describe("VirtualMachineInstance", func() {
 			Expect(updatedVMI.Status.GuestOSInfo.Machine).To(Equal(domain.Status.OSInfo.Machine))
 			Expect(updatedVMI.Status.GuestOSInfo.KernelRelease).To(Equal(domain.Status.OSInfo.KernelRelease))
 			Expect(updatedVMI.Status.GuestOSInfo.KernelVersion).To(Equal(domain.Status.OSInfo.KernelVersion))
+			Expect(updatedVMI.Status.GuestOSInfo.Hostname).To(Equal(domain.Status.OSInfo.Hostname))
+		})
+
+		It("should refresh the Guest OS Information in VMI status when the guest kernel changes", func() {
+			vmi := api2.NewMinimalVMI("testvmi")
+			vmi.UID = vmiTestUUID
+			vmi.ObjectMeta.ResourceVersion = "1"
+			vmi.Status.Phase = v1.Scheduled
+			vmi.Status.GuestOSInfo = v1.VirtualMachineInstanceGuestOSInfo{
+				Name:          "Fedora Linux",
+				KernelRelease: "5.14.10-300.fc35.x86_64",
+			}
+
+			domain := api.NewMinimalDomainWithUUID("testvmi", vmiTestUUID)
+			domain.Status.Status = api.Running
+			domain.Status.OSInfo = api.GuestOSInfo{
+				Name:          "Fedora Linux",
+				KernelRelease: "5.15.4-201.fc35.x86_64",
+			}
+
+			addVMI(vmi)
+			addDomain(domain)
+			createVMI(vmi)
+
+			sanityExecute()
+
+			testutils.ExpectEvent(recorder, VMIStarted)
+			updatedVMI, err := virtfakeClient.KubevirtV1().VirtualMachineInstances(metav1.NamespaceDefault).Get(context.TODO(), vmi.Name, metav1.GetOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(updatedVMI.Status.GuestOSInfo.KernelRelease).To(Equal("5.15.4-201.fc35.x86_64"))
 		})
 
 		It("should update Guest FSFreeze Status in VMI status if fs frozen", func() {
@@ -3021,6 +3382,229 @@ var _ = Describe("VirtualMachineInstance", func() {
 			Expect(updatedVMI.Status.FSFreezeStatus).To(BeEmpty())
 		})
 
+		It("should report the frozen volumes in VMI status", func() {
+			vmi := api2.NewMinimalVMI("testvmi")
+			vmi.UID = vmiTestUUID
+			vmi.ObjectMeta.ResourceVersion = "1"
+			vmi.Status.Phase = v1.Scheduled
+			freezeTimestamp := metav1.NewTime(time.Now().Add(-time.Minute).Truncate(time.Second))
+
+			domain := api.NewMinimalDomainWithUUID("testvmi", vmiTestUUID)
+			domain.Status.Status = api.Running
+			domain.Status.FSFreezeStatus = api.FSFreeze{Status: api.FSFrozen}
+			domain.Spec.Metadata.KubeVirt.FSFreeze = &api.FSFreezeMetadata{
+				Volumes:        "datadisk,logdisk",
+				StartTimestamp: &freezeTimestamp,
+			}
+
+			addVMI(vmi)
+			addDomain(domain)
+			createVMI(vmi)
+
+			sanityExecute()
+
+			testutils.ExpectEvent(recorder, VMIStarted)
+			updatedVMI, err := virtfakeClient.KubevirtV1().VirtualMachineInstances(metav1.NamespaceDefault).Get(context.TODO(), vmi.Name, metav1.GetOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(updatedVMI.Status.FSFreezeInfo).ToNot(BeNil())
+			Expect(updatedVMI.Status.FSFreezeInfo.Volumes).To(Equal([]string{"datadisk", "logdisk"}))
+			Expect(updatedVMI.Status.FSFreezeInfo.FreezeTimestamp.Equal(&freezeTimestamp)).To(BeTrue())
+		})
+
+		It("should report the last freeze duration in VMI status once thawed", func() {
+			vmi := api2.NewMinimalVMI("testvmi")
+			vmi.UID = vmiTestUUID
+			vmi.ObjectMeta.ResourceVersion = "1"
+			vmi.Status.Phase = v1.Scheduled
+			vmi.Status.FSFreezeInfo = &v1.FSFreezeInfo{Volumes: []string{"datadisk"}}
+			now := time.Now()
+			startTimestamp := metav1.NewTime(now.Add(-30 * time.Second))
+			endTimestamp := metav1.NewTime(now)
+
+			domain := api.NewMinimalDomainWithUUID("testvmi", vmiTestUUID)
+			domain.Status.Status = api.Running
+			domain.Status.FSFreezeStatus = api.FSFreeze{Status: api.FSThawed}
+			domain.Spec.Metadata.KubeVirt.FSFreeze = &api.FSFreezeMetadata{
+				Volumes:        "datadisk",
+				StartTimestamp: &startTimestamp,
+				EndTimestamp:   &endTimestamp,
+			}
+
+			addVMI(vmi)
+			addDomain(domain)
+			createVMI(vmi)
+
+			sanityExecute()
+
+			testutils.ExpectEvent(recorder, VMIStarted)
+			updatedVMI, err := virtfakeClient.KubevirtV1().VirtualMachineInstances(metav1.NamespaceDefault).Get(context.TODO(), vmi.Name, metav1.GetOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(updatedVMI.Status.FSFreezeInfo).To(Equal(&v1.FSFreezeInfo{LastFreezeDurationSeconds: 30}))
+		})
+
+		Context("guest filesystem usage", func() {
+			newVMIWithDisks := func() *v1.VirtualMachineInstance {
+				vmi := api2.NewMinimalVMI("testvmi")
+				vmi.UID = vmiTestUUID
+				vmi.Spec.Domain.Devices.Disks = []v1.Disk{
+					{Name: "rootdisk"},
+					{Name: "datadisk", Serial: "data-serial"},
+				}
+				vmi.Status.VolumeStatus = []v1.VolumeStatus{
+					{Name: "datadisk", GuestFilesystemUsage: &v1.GuestFilesystemUsage{UsedBytes: 1, TotalBytes: 2}},
+					{Name: "rootdisk"},
+				}
+				vmi.Status.Conditions = []v1.VirtualMachineInstanceCondition{
+					{Type: v1.VirtualMachineInstanceAgentConnected, Status: k8sv1.ConditionTrue},
+				}
+				return vmi
+			}
+
+			enableVolumeAutoExpansion := func() {
+				controller.clusterConfig, _, _ = testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{
+					DeveloperConfiguration: &v1.DeveloperConfiguration{
+						FeatureGates: []string{featuregate.VolumeAutoExpansionGate},
+					},
+				})
+			}
+
+			It("should report the usage of the filesystems on the disks with a serial", func() {
+				enableVolumeAutoExpansion()
+				vmi := newVMIWithDisks()
+				client.EXPECT().GetFilesystems().Return(v1.VirtualMachineInstanceFileSystemList{
+					Items: []v1.VirtualMachineInstanceFileSystem{
+						{DiskName: "vda1", MountPoint: "/", UsedBytes: 100, TotalBytes: 1000},
+						{DiskName: "vdb1", MountPoint: "/data", UsedBytes: 300, TotalBytes: 1000,
+							Disk: []v1.VirtualMachineInstanceFileSystemDisk{{Serial: "data-serial"}}},
+						{DiskName: "vdb1", MountPoint: "/var/data", UsedBytes: 300, TotalBytes: 1000,
+							Disk: []v1.VirtualMachineInstanceFileSystemDisk{{Serial: "data-serial"}}},
+						{DiskName: "vdb2", MountPoint: "/logs", UsedBytes: 200, TotalBytes: 1000,
+							Disk: []v1.VirtualMachineInstanceFileSystemDisk{{Serial: "data-serial"}}},
+					},
+				}, nil)
+
+				controller.updateGuestFilesystemUsage(vmi, virtcontroller.NewVirtualMachineInstanceConditionManager())
+				Expect(vmi.Status.VolumeStatus[0].GuestFilesystemUsage).To(Equal(&v1.GuestFilesystemUsage{UsedBytes: 500, TotalBytes: 2000}))
+				Expect(vmi.Status.VolumeStatus[1].GuestFilesystemUsage).To(BeNil())
+			})
+
+			It("should not report the usage without the feature gate", func() {
+				vmi := newVMIWithDisks()
+				controller.updateGuestFilesystemUsage(vmi, virtcontroller.NewVirtualMachineInstanceConditionManager())
+				Expect(vmi.Status.VolumeStatus[0].GuestFilesystemUsage).To(BeNil())
+			})
+
+			It("should not report the usage without a connected guest agent", func() {
+				enableVolumeAutoExpansion()
+				vmi := newVMIWithDisks()
+				vmi.Status.Conditions = nil
+				controller.updateGuestFilesystemUsage(vmi, virtcontroller.NewVirtualMachineInstanceConditionManager())
+				Expect(vmi.Status.VolumeStatus[0].GuestFilesystemUsage).To(BeNil())
+			})
+
+			It("should keep the reported usage if the guest filesystems can not be retrieved", func() {
+				enableVolumeAutoExpansion()
+				vmi := newVMIWithDisks()
+				client.EXPECT().GetFilesystems().Return(v1.VirtualMachineInstanceFileSystemList{}, fmt.Errorf("agent unavailable"))
+				controller.updateGuestFilesystemUsage(vmi, virtcontroller.NewVirtualMachineInstanceConditionManager())
+				Expect(vmi.Status.VolumeStatus[0].GuestFilesystemUsage).To(Equal(&v1.GuestFilesystemUsage{UsedBytes: 1, TotalBytes: 2}))
+			})
+		})
+
+		It("should update the shutdown stage in VMI status", func() {
+			vmi := api2.NewMinimalVMI("testvmi")
+			vmi.UID = vmiTestUUID
+			vmi.ObjectMeta.ResourceVersion = "1"
+			vmi.Status.Phase = v1.Scheduled
+
+			domain := api.NewMinimalDomainWithUUID("testvmi", vmiTestUUID)
+			domain.Status.Status = api.Running
+			domain.Spec.Metadata.KubeVirt.GracePeriod = &api.GracePeriodMetadata{
+				DeletionGracePeriodSeconds: 30,
+				ShutdownStage:              string(v1.ShutdownStageGuestAgent),
+			}
+
+			addVMI(vmi)
+			addDomain(domain)
+			createVMI(vmi)
+
+			sanityExecute()
+
+			testutils.ExpectEvent(recorder, VMIStarted)
+			updatedVMI, err := virtfakeClient.KubevirtV1().VirtualMachineInstances(metav1.NamespaceDefault).Get(context.TODO(), vmi.Name, metav1.GetOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(updatedVMI.Status.ShutdownStage).To(Equal(v1.ShutdownStageGuestAgent))
+		})
+
+		Context("cgroup layout", func() {
+			It("should report the housekeeping CPU limit", func() {
+				vmi := api2.NewMinimalVMI("testvmi")
+				vmi.Spec.Domain.CPU = &v1.CPU{Housekeeping: &v1.Housekeeping{CPU: resource.MustParse("200m")}}
+				domain := api.NewMinimalDomainWithUUID("testvmi", vmiTestUUID)
+
+				controller.updateCgroupLayout(vmi, domain)
+				Expect(vmi.Status.CgroupLayout).ToNot(BeNil())
+				Expect(vmi.Status.CgroupLayout.Version).To(Or(Equal("v1"), Equal("v2")))
+				Expect(vmi.Status.CgroupLayout.Housekeeping.Name).To(Equal("housekeeping"))
+				Expect(vmi.Status.CgroupLayout.Housekeeping.CPUSet).To(BeEmpty())
+				Expect(vmi.Status.CgroupLayout.Housekeeping.CPULimit.String()).To(Equal("200m"))
+			})
+
+			It("should report the housekeeping CPU set of an isolated emulator thread", func() {
+				vmi := api2.NewMinimalVMI("testvmi")
+				vmi.Spec.Domain.CPU = &v1.CPU{DedicatedCPUPlacement: true, IsolateEmulatorThread: true}
+				domain := api.NewMinimalDomainWithUUID("testvmi", vmiTestUUID)
+				domain.Spec.CPUTune = &api.CPUTune{EmulatorPin: &api.CPUEmulatorPin{CPUSet: "3"}}
+
+				controller.updateCgroupLayout(vmi, domain)
+				Expect(vmi.Status.CgroupLayout).ToNot(BeNil())
+				Expect(vmi.Status.CgroupLayout.Housekeeping.CPUSet).To(Equal("3"))
+				Expect(vmi.Status.CgroupLayout.Housekeeping.CPULimit).To(BeNil())
+			})
+
+			It("should not report a layout without a housekeeping cgroup", func() {
+				vmi := api2.NewMinimalVMI("testvmi")
+				vmi.Status.CgroupLayout = &v1.CgroupLayout{Version: "v2"}
+				domain := api.NewMinimalDomainWithUUID("testvmi", vmiTestUUID)
+
+				controller.updateCgroupLayout(vmi, domain)
+				Expect(vmi.Status.CgroupLayout).To(BeNil())
+			})
+		})
+
+		Context("thread placement", func() {
+			It("should report the pCPUs of the emulator thread and the IOThreads", func() {
+				vmi := api2.NewMinimalVMI("testvmi")
+				domain := api.NewMinimalDomainWithUUID("testvmi", vmiTestUUID)
+				domain.Spec.CPUTune = &api.CPUTune{
+					EmulatorPin: &api.CPUEmulatorPin{CPUSet: "3"},
+					IOThreadPin: []api.CPUTuneIOThreadPin{
+						{IOThread: 1, CPUSet: "4"},
+						{IOThread: 2, CPUSet: "4"},
+					},
+				}
+
+				controller.updateThreadPlacement(vmi, domain)
+				Expect(vmi.Status.ThreadPlacement).To(Equal(&v1.ThreadPlacementStatus{
+					EmulatorThreadCPUSet: "3",
+					IOThreads: []v1.IOThreadPlacement{
+						{ID: 1, CPUSet: "4"},
+						{ID: 2, CPUSet: "4"},
+					},
+				}))
+			})
+
+			It("should not report a placement if no thread is pinned", func() {
+				vmi := api2.NewMinimalVMI("testvmi")
+				vmi.Status.ThreadPlacement = &v1.ThreadPlacementStatus{EmulatorThreadCPUSet: "3"}
+				domain := api.NewMinimalDomainWithUUID("testvmi", vmiTestUUID)
+				domain.Spec.CPUTune = &api.CPUTune{VCPUPin: []api.CPUTuneVCPUPin{{VCPU: 0, CPUSet: "2"}}}
+
+				controller.updateThreadPlacement(vmi, domain)
+				Expect(vmi.Status.ThreadPlacement).To(BeNil())
+			})
+		})
+
 		It("should update Memory information in VMI status", func() {
 			initialMemory := resource.MustParse("128Ki")
 			vmi := api2.NewMinimalVMI("testvmi")
@@ -3053,6 +3637,28 @@ var _ = Describe("VirtualMachineInstance", func() {
 			Expect(err).NotTo(HaveOccurred())
 			Expect(updatedVMI.Status.Memory.GuestCurrent).To(Equal(pointer.P(resource.MustParse("512Ki"))))
 		})
+
+		It("should report the memory plugged into the virtio-mem device as usable", func() {
+			vmi := api2.NewMinimalVMI("testvmi")
+			vmi.Status.Memory = &v1.MemoryStatus{
+				GuestAtBoot:    pointer.P(resource.MustParse("1Gi")),
+				GuestRequested: pointer.P(resource.MustParse("2Gi")),
+			}
+
+			domain := api.NewMinimalDomainWithUUID("testvmi", vmiTestUUID)
+			domain.Spec.CurrentMemory = &api.Memory{Value: 2, Unit: "GiB"}
+			domain.Spec.Devices.Memory = &api.MemoryDevice{
+				Model: "virtio-mem",
+				Target: &api.MemoryTarget{
+					Requested: api.Memory{Value: 1024 * 1024, Unit: "KiB"},
+					Current:   api.Memory{Value: 2 * 1024 * 1024, Unit: "KiB"},
+				},
+			}
+
+			Expect(controller.updateMemoryInfo(vmi, domain)).To(Succeed())
+			Expect(vmi.Status.Memory.GuestCurrent.Value()).To(BeEquivalentTo(2 * 1024 * 1024 * 1024))
+			Expect(vmi.Status.Memory.GuestUsable.Value()).To(BeEquivalentTo(3 * 1024 * 1024 * 1024))
+		})
 	})
 
 	Context("VirtualMachineInstance controller gets informed about disk information", func() {
@@ -3345,6 +3951,56 @@ var _ = Describe("VirtualMachineInstance", func() {
 				controller.Execute()
 				testutils.ExpectEvent(recorder, VMIMigrating)
 			})
+
+			It("should configure the parallel migration channels and compression of the migration configuration", func() {
+				compression := &v1.MigrationCompression{Method: v1.MigrationCompressionZstd, Level: pointer.P(int32(3))}
+				migrationConfiguration := controller.clusterConfig.GetMigrationConfiguration()
+				migrationConfiguration.ParallelMigrationChannels = pointer.P(uint32(16))
+				migrationConfiguration.Compression = compression
+				vmi.Status.MigrationState.MigrationConfiguration = migrationConfiguration
+				Expect(controller.vmiSourceStore.Update(vmi)).To(Succeed())
+
+				client.EXPECT().MigrateVirtualMachine(gomock.Any(), gomock.Any()).Do(func(_ *v1.VirtualMachineInstance, options *cmdclient.MigrationOptions) {
+					Expect(options.ParallelMigrationThreads).To(HaveValue(Equal(uint(16))))
+					Expect(options.Compression).To(Equal(compression))
+				}).Times(1).Return(nil)
+
+				controller.Execute()
+				testutils.ExpectEvent(recorder, VMIMigrating)
+			})
+
+			It("should migrate over a single connection with a single parallel migration channel", func() {
+				migrationConfiguration := controller.clusterConfig.GetMigrationConfiguration()
+				migrationConfiguration.ParallelMigrationChannels = pointer.P(uint32(1))
+				migrationConfiguration.Compression = &v1.MigrationCompression{Method: v1.MigrationCompressionZlib}
+				vmi.Status.MigrationState.MigrationConfiguration = migrationConfiguration
+				Expect(controller.vmiSourceStore.Update(vmi)).To(Succeed())
+
+				client.EXPECT().MigrateVirtualMachine(gomock.Any(), gomock.Any()).Do(func(_ *v1.VirtualMachineInstance, options *cmdclient.MigrationOptions) {
+					Expect(options.ParallelMigrationThreads).To(BeNil())
+					Expect(options.Compression).To(BeNil())
+				}).Times(1).Return(nil)
+
+				controller.Execute()
+				testutils.ExpectEvent(recorder, parallelMigrationIgnoredReason)
+				testutils.ExpectEvent(recorder, VMIMigrating)
+			})
+
+			It("should report configured parallel migration channels which are not used because the CPU is limited", func() {
+				vmi.Spec.Domain.Resources.Limits[k8sv1.ResourceCPU] = resource.MustParse("4")
+				migrationConfiguration := controller.clusterConfig.GetMigrationConfiguration()
+				migrationConfiguration.ParallelMigrationChannels = pointer.P(uint32(16))
+				vmi.Status.MigrationState.MigrationConfiguration = migrationConfiguration
+				Expect(controller.vmiSourceStore.Update(vmi)).To(Succeed())
+
+				client.EXPECT().MigrateVirtualMachine(gomock.Any(), gomock.Any()).Do(func(_ *v1.VirtualMachineInstance, options *cmdclient.MigrationOptions) {
+					Expect(options.ParallelMigrationThreads).To(BeNil())
+				}).Times(1).Return(nil)
+
+				controller.Execute()
+				testutils.ExpectEvent(recorder, parallelMigrationIgnoredReason)
+				testutils.ExpectEvent(recorder, VMIMigrating)
+			})
 		})
 	})
 
@@ -395,6 +395,7 @@ func (n *Notifier) StartDomainNotifier(
 	qemuAgentUserInterval time.Duration,
 	qemuAgentVersionInterval time.Duration,
 	qemuAgentFSFreezeStatusInterval time.Duration,
+	qemuAgentTimeInterval time.Duration,
 	metadataCache *metadata.Cache,
 ) error {
 
@@ -416,6 +417,7 @@ func (n *Notifier) StartDomainNotifier(
 		qemuAgentUserInterval,
 		qemuAgentVersionInterval,
 		qemuAgentFSFreezeStatusInterval,
+		qemuAgentTimeInterval,
 	)
 
 	// Run the event process logic in a separate go-routine to not block libvirt
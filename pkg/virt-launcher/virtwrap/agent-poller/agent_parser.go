@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"regexp"
+	"time"
 
 	"kubevirt.io/client-go/log"
 
@@ -156,6 +157,20 @@ func parseHostname(agentReply string) (string, error) {
 	return result.Hostname, nil
 }
 
+// parseGuestTime from the agent response, the guest time is reported in nanoseconds since the epoch
+func parseGuestTime(agentReply string) (time.Time, error) {
+	result := struct {
+		Return int64 `json:"return"`
+	}{}
+
+	err := json.Unmarshal([]byte(agentReply), &result)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return time.Unix(0, result.Return), nil
+}
+
 // parseFSFreezeStatus from the agent response
 func ParseFSFreezeStatus(agentReply string) (api.FSFreeze, error) {
 	response := stripAgentStringResponse(agentReply)
@@ -184,8 +199,8 @@ func parseTimezone(agentReply string) (api.Timezone, error) {
 	}, nil
 }
 
-// parseFilesystem from the agent response
-func parseFilesystem(agentReply string) ([]api.Filesystem, error) {
+// ParseFilesystem from the agent response
+func ParseFilesystem(agentReply string) ([]api.Filesystem, error) {
 	result := []Filesystem{}
 	response := stripAgentResponse(agentReply)
 
@@ -294,7 +294,7 @@ var _ = Describe("Qemu agent poller", func() {
 					},
 				},
 			}
-			Expect(parseFilesystem(jsonInput)).To(Equal(expectedFilesystem))
+			Expect(ParseFilesystem(jsonInput)).To(Equal(expectedFilesystem))
 		})
 
 		It("should parse Users", func() {
@@ -19,7 +19,9 @@
 package agentpoller
 
 import (
+	"slices"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"k8s.io/apimachinery/pkg/api/equality"
@@ -47,6 +49,7 @@ const (
 	GET_FILESYSTEM      AgentCommand = "guest-get-fsinfo"
 	GET_AGENT           AgentCommand = "guest-info"
 	GET_FSFREEZE_STATUS AgentCommand = "guest-fsfreeze-status"
+	GET_TIME            AgentCommand = "guest-get-time"
 
 	pollInitialInterval = 10 * time.Second
 )
@@ -62,6 +65,8 @@ type AgentUpdatedEvent struct {
 type AsyncAgentStore struct {
 	store        sync.Map
 	AgentUpdated chan AgentUpdatedEvent
+	// clockDriftThreshold is the drift in nanoseconds above which the guest clock is set to the host time
+	clockDriftThreshold atomic.Int64
 }
 
 // NewAsyncAgentStore creates new agent store
@@ -76,6 +81,12 @@ func NewAsyncAgentStore() AsyncAgentStore {
 // it fires up updated event
 func (s *AsyncAgentStore) Store(key AgentCommand, value interface{}) {
 
+	// The guest clock drift changes on every poll and is only reported in the domain stats
+	if key == GET_TIME {
+		s.store.Store(key, value)
+		return
+	}
+
 	oldData, _ := s.store.Load(key)
 	updated := (oldData == nil) || !equality.Semantic.DeepEqual(oldData, value)
 
@@ -84,7 +95,7 @@ func (s *AsyncAgentStore) Store(key AgentCommand, value interface{}) {
 	if updated {
 		domainInfo := api.DomainGuestInfo{}
 		switch key {
-		case GET_OSINFO, GET_INTERFACES, GET_FSFREEZE_STATUS:
+		case GET_OSINFO, GET_HOSTNAME, GET_INTERFACES, GET_FSFREEZE_STATUS:
 			domainInfo.OSInfo = s.GetGuestOSInfo()
 			domainInfo.Interfaces = s.GetInterfaceStatus()
 			domainInfo.FSFreezeStatus = s.GetFSFreezeStatus()
@@ -137,11 +148,14 @@ func (s *AsyncAgentStore) GetInterfaceStatus() []api.InterfaceStatus {
 	return nil
 }
 
-// GetGuestOSInfo returns the Guest OS version and architecture
+// GetGuestOSInfo returns the Guest OS version, architecture and hostname
 func (s *AsyncAgentStore) GetGuestOSInfo() *api.GuestOSInfo {
 	data, ok := s.store.Load(GET_OSINFO)
 	if ok {
 		osInfo := data.(api.GuestOSInfo)
+		if hostname, ok := s.store.Load(GET_HOSTNAME); ok {
+			osInfo.Hostname = hostname.(string)
+		}
 		return &osInfo
 	}
 
@@ -209,6 +223,22 @@ func (s *AsyncAgentStore) GetUsers(limit int) []api.User {
 	return limitedUsers
 }
 
+// GetGuestClockDrift returns the offset of the guest clock from the host clock, if it was measured
+// since the guest agent connected
+func (s *AsyncAgentStore) GetGuestClockDrift() (time.Duration, bool) {
+	data, ok := s.store.Load(GET_TIME)
+	if !ok {
+		return 0, false
+	}
+	return data.(time.Duration), true
+}
+
+// SetClockDriftThreshold sets the drift above which the poller sets the guest clock to the host time,
+// 0 disables it. It is set from the VMI spec, which the poller does not know.
+func (s *AsyncAgentStore) SetClockDriftThreshold(threshold time.Duration) {
+	s.clockDriftThreshold.Store(int64(threshold))
+}
+
 // PollerWorker collects the data from the guest agent
 // only unique items are stored as configuration
 type PollerWorker struct {
@@ -277,6 +307,7 @@ func CreatePoller(
 	qemuAgentUserInterval time.Duration,
 	qemuAgentVersionInterval time.Duration,
 	qemuAgentFSFreezeStatusInterval time.Duration,
+	qemuAgentTimeInterval time.Duration,
 ) *AgentPoller {
 	p := &AgentPoller{
 		Connection: connecton,
@@ -311,6 +342,11 @@ func CreatePoller(
 		CallTick:      qemuAgentFSFreezeStatusInterval,
 		AgentCommands: []AgentCommand{GET_FSFREEZE_STATUS},
 	})
+	// time command group
+	p.workers = append(p.workers, PollerWorker{
+		CallTick:      qemuAgentTimeInterval,
+		AgentCommands: []AgentCommand{GET_TIME},
+	})
 
 	return p
 }
@@ -326,6 +362,9 @@ func (p *AgentPoller) Start() {
 		log.Log.Infof("Starting agent poller with commands: %v", p.workers[i].AgentCommands)
 		go p.workers[i].Poll(func(commands []AgentCommand) {
 			executeAgentCommands(commands, p.Connection, p.agentStore, p.domainName)
+			if slices.Contains(commands, GET_TIME) {
+				p.resyncDriftedGuestClock()
+			}
 		}, p.agentDone, pollInitialInterval)
 	}
 }
@@ -336,13 +375,17 @@ func (p *AgentPoller) Stop() {
 		close(p.agentDone)
 		p.agentDone = nil
 	}
+	// The drift can not be measured anymore without the agent
+	p.agentStore.store.Delete(GET_TIME)
 }
 
 // With libvirt 5.6.0 direct call to agent can be replaced with call to libvirt Domain.GetGuestInfo
 func executeAgentCommands(commands []AgentCommand, con cli.Connection, agentStore *AsyncAgentStore, domainName string) {
 	for _, command := range commands {
 		// replace with direct call to libvirt function when 5.6.0 is available
+		sent := time.Now()
 		cmdResult, err := con.QemuAgentCommand(`{"execute":"`+string(command)+`"}`, domainName)
+		received := time.Now()
 		if err != nil {
 			// skip the command on error, it is not vital
 			continue
@@ -394,12 +437,19 @@ func executeAgentCommands(commands []AgentCommand, con cli.Connection, agentStor
 			}
 			agentStore.Store(GET_FSFREEZE_STATUS, fsfreezeStatus)
 		case GET_FILESYSTEM:
-			filesystems, err := parseFilesystem(cmdResult)
+			filesystems, err := ParseFilesystem(cmdResult)
 			if err != nil {
 				log.Log.Errorf("Cannot parse guest agent filesystem %s", err.Error())
 				continue
 			}
 			agentStore.Store(GET_FILESYSTEM, filesystems)
+		case GET_TIME:
+			guestTime, err := parseGuestTime(cmdResult)
+			if err != nil {
+				log.Log.Errorf("Cannot parse guest agent time %s", err.Error())
+				continue
+			}
+			agentStore.Store(GET_TIME, guestClockDrift(guestTime, sent, received))
 		case GET_AGENT:
 			agent, err := parseAgent(cmdResult)
 			if err != nil {
@@ -170,6 +170,28 @@ var _ = Describe("Qemu agent poller", func() {
 
 			Expect(*osInfo).To(Equal(fakeInfo))
 		})
+
+		It("should report the hostname with the osInfo", func() {
+			var agentStore = NewAsyncAgentStore()
+			agentStore.Store(GET_OSINFO, fakeInfo)
+			agentStore.Store(GET_HOSTNAME, "testvm.example.com")
+			osInfo := agentStore.GetGuestOSInfo()
+
+			expectedInfo := fakeInfo
+			expectedInfo.Hostname = "testvm.example.com"
+			Expect(*osInfo).To(Equal(expectedInfo))
+		})
+
+		It("should fire an event when the hostname changes", func() {
+			var agentStore = NewAsyncAgentStore()
+			agentStore.Store(GET_OSINFO, fakeInfo)
+			Expect(agentStore.AgentUpdated).To(Receive())
+
+			agentStore.Store(GET_HOSTNAME, "testvm.example.com")
+			event := AgentUpdatedEvent{}
+			Expect(agentStore.AgentUpdated).To(Receive(&event))
+			Expect(event.DomainInfo.OSInfo.Hostname).To(Equal("testvm.example.com"))
+		})
 	})
 
 	Context("PollerWorker", func() {
@@ -0,0 +1,86 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package agentpoller
+
+import (
+	"time"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+)
+
+const defaultClockDriftThresholdSeconds = 2
+
+// GuestTimeSyncPolicy returns the policy selecting when the guest clock of the VMI is set to the host time
+func GuestTimeSyncPolicy(vmi *v1.VirtualMachineInstance) v1.GuestTimeSyncPolicy {
+	clock := vmi.Spec.Domain.Clock
+	if clock == nil || clock.GuestTimeSync == nil || clock.GuestTimeSync.Policy == "" {
+		return v1.GuestTimeSyncOnResume
+	}
+	return clock.GuestTimeSync.Policy
+}
+
+// ClockDriftThreshold returns the drift above which the guest clock of the VMI is set to the host time,
+// or 0 if the guest clock is not set when it drifts
+func ClockDriftThreshold(vmi *v1.VirtualMachineInstance) time.Duration {
+	if GuestTimeSyncPolicy(vmi) != v1.GuestTimeSyncOnDrift {
+		return 0
+	}
+	seconds := uint32(defaultClockDriftThresholdSeconds)
+	if threshold := vmi.Spec.Domain.Clock.GuestTimeSync.DriftThresholdSeconds; threshold != nil {
+		seconds = *threshold
+	}
+	return time.Duration(seconds) * time.Second
+}
+
+// guestClockDrift returns the offset of the guest clock from the host clock. The guest time is compared to
+// the host time in the middle of the agent round trip, positive when the guest clock is ahead.
+func guestClockDrift(guestTime, sent, received time.Time) time.Duration {
+	return guestTime.Sub(sent.Add(received.Sub(sent) / 2))
+}
+
+// resyncDriftedGuestClock sets the guest clock to the host time when it drifted away by more than the
+// threshold, e.g. after the node resumed from suspend
+func (p *AgentPoller) resyncDriftedGuestClock() {
+	threshold := time.Duration(p.agentStore.clockDriftThreshold.Load())
+	if threshold == 0 {
+		return
+	}
+	drift, measured := p.agentStore.GetGuestClockDrift()
+	if !measured || drift.Abs() <= threshold {
+		return
+	}
+
+	dom, err := p.Connection.LookupDomainByName(p.domainName)
+	if err != nil {
+		log.Log.Reason(err).Warningf("failed to look up domain %s to set the guest time", p.domainName)
+		return
+	}
+	defer dom.Free()
+
+	now := time.Now()
+	if err := dom.SetTime(now.Unix(), uint(now.Nanosecond()), 0); err != nil {
+		log.Log.Reason(err).Warningf("failed to set the guest time of domain %s drifted by %v", p.domainName, drift)
+		return
+	}
+	log.Log.Infof("set the guest time of domain %s drifted by %v", p.domainName, drift)
+	// The drift is measured again on the next poll
+	p.agentStore.store.Delete(GET_TIME)
+}
@@ -0,0 +1,142 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package agentpoller
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/libvmi"
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/cli"
+)
+
+var _ = Describe("Guest clock", func() {
+	const domainName = "default_testvmi"
+
+	withGuestTimeSync := func(timeSync *v1.GuestTimeSync) libvmi.Option {
+		return func(vmi *v1.VirtualMachineInstance) {
+			vmi.Spec.Domain.Clock = &v1.Clock{GuestTimeSync: timeSync}
+		}
+	}
+
+	DescribeTable("should resolve the guest time sync policy and drift threshold", func(vmi *v1.VirtualMachineInstance, policy v1.GuestTimeSyncPolicy, threshold time.Duration) {
+		Expect(GuestTimeSyncPolicy(vmi)).To(Equal(policy))
+		Expect(ClockDriftThreshold(vmi)).To(Equal(threshold))
+	},
+		Entry("by default", libvmi.New(), v1.GuestTimeSyncOnResume, time.Duration(0)),
+		Entry("without a policy", libvmi.New(withGuestTimeSync(&v1.GuestTimeSync{})), v1.GuestTimeSyncOnResume, time.Duration(0)),
+		Entry("when disabled", libvmi.New(withGuestTimeSync(&v1.GuestTimeSync{Policy: v1.GuestTimeSyncDisabled})), v1.GuestTimeSyncDisabled, time.Duration(0)),
+		Entry("on drift with the default threshold", libvmi.New(withGuestTimeSync(&v1.GuestTimeSync{Policy: v1.GuestTimeSyncOnDrift})), v1.GuestTimeSyncOnDrift, 2*time.Second),
+		Entry("on drift with a threshold", libvmi.New(withGuestTimeSync(&v1.GuestTimeSync{
+			Policy:                v1.GuestTimeSyncOnDrift,
+			DriftThresholdSeconds: pointer.P(uint32(30)),
+		})), v1.GuestTimeSyncOnDrift, 30*time.Second),
+	)
+
+	It("should parse the guest time", func() {
+		guestTime, err := parseGuestTime(`{"return": 1434446441125066000}`)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(guestTime.UnixNano()).To(Equal(int64(1434446441125066000)))
+	})
+
+	It("should measure the drift against the middle of the agent round trip", func() {
+		sent := time.Unix(1000, 0)
+		Expect(guestClockDrift(time.Unix(1005, 0), sent, sent.Add(2*time.Second))).To(Equal(4 * time.Second))
+		Expect(guestClockDrift(time.Unix(999, 0), sent, sent)).To(Equal(-time.Second))
+	})
+
+	It("should store the drift without firing an event", func() {
+		agentStore := NewAsyncAgentStore()
+		_, measured := agentStore.GetGuestClockDrift()
+		Expect(measured).To(BeFalse())
+
+		agentStore.Store(GET_TIME, 3*time.Second)
+		Expect(agentStore.AgentUpdated).ToNot(Receive())
+		drift, measured := agentStore.GetGuestClockDrift()
+		Expect(measured).To(BeTrue())
+		Expect(drift).To(Equal(3 * time.Second))
+	})
+
+	Context("resync", func() {
+		var (
+			conn       *cli.MockConnection
+			domain     *cli.MockVirDomain
+			agentStore AsyncAgentStore
+			poller     *AgentPoller
+		)
+
+		BeforeEach(func() {
+			ctrl := gomock.NewController(GinkgoT())
+			conn = cli.NewMockConnection(ctrl)
+			domain = cli.NewMockVirDomain(ctrl)
+			agentStore = NewAsyncAgentStore()
+			poller = CreatePoller(conn, "", domainName, &agentStore, time.Minute, time.Minute, time.Minute, time.Minute, time.Minute, time.Minute)
+		})
+
+		It("should set the guest time when the guest clock drifted above the threshold", func() {
+			agentStore.SetClockDriftThreshold(2 * time.Second)
+			agentStore.Store(GET_TIME, -5*time.Second)
+			conn.EXPECT().LookupDomainByName(domainName).Return(domain, nil)
+			domain.EXPECT().SetTime(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+			domain.EXPECT().Free()
+
+			poller.resyncDriftedGuestClock()
+			_, measured := agentStore.GetGuestClockDrift()
+			Expect(measured).To(BeFalse())
+		})
+
+		It("should keep the drift when the guest time can not be set", func() {
+			agentStore.SetClockDriftThreshold(2 * time.Second)
+			agentStore.Store(GET_TIME, 5*time.Second)
+			conn.EXPECT().LookupDomainByName(domainName).Return(domain, nil)
+			domain.EXPECT().SetTime(gomock.Any(), gomock.Any(), gomock.Any()).Return(fmt.Errorf("agent unresponsive"))
+			domain.EXPECT().Free()
+
+			poller.resyncDriftedGuestClock()
+			_, measured := agentStore.GetGuestClockDrift()
+			Expect(measured).To(BeTrue())
+		})
+
+		DescribeTable("should not set the guest time", func(threshold, drift time.Duration) {
+			agentStore.SetClockDriftThreshold(threshold)
+			agentStore.Store(GET_TIME, drift)
+
+			poller.resyncDriftedGuestClock()
+		},
+			Entry("without a threshold", time.Duration(0), time.Hour),
+			Entry("below the threshold", 2*time.Second, -time.Second),
+		)
+
+		It("should forget the drift when the agent disconnects", func() {
+			agentStore.Store(GET_TIME, time.Second)
+			poller.Stop()
+
+			_, measured := agentStore.GetGuestClockDrift()
+			Expect(measured).To(BeFalse())
+		})
+	})
+})
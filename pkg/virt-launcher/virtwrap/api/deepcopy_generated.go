@@ -619,6 +619,11 @@ func (in *Controller) DeepCopyInto(out *Controller) {
 		*out = new(Address)
 		**out = **in
 	}
+	if in.Target != nil {
+		in, out := &in.Target, &out.Target
+		*out = new(ControllerTarget)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -658,6 +663,27 @@ func (in *ControllerDriver) DeepCopy() *ControllerDriver {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ControllerTarget) DeepCopyInto(out *ControllerTarget) {
+	*out = *in
+	if in.Node != nil {
+		in, out := &in.Node, &out.Node
+		*out = new(uint32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ControllerTarget.
+func (in *ControllerTarget) DeepCopy() *ControllerTarget {
+	if in == nil {
+		return nil
+	}
+	out := new(ControllerTarget)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Defaulter) DeepCopyInto(out *Defaulter) {
 	*out = *in
@@ -856,6 +882,11 @@ func (in *Disk) DeepCopyInto(out *Disk) {
 		*out = new(BlockIO)
 		**out = **in
 	}
+	if in.IOTune != nil {
+		in, out := &in.IOTune, &out.IOTune
+		*out = new(IOTune)
+		**out = **in
+	}
 	if in.FilesystemOverhead != nil {
 		in, out := &in.FilesystemOverhead, &out.FilesystemOverhead
 		*out = new(v1.Percent)
@@ -1345,6 +1376,30 @@ func (in *FSFreeze) DeepCopy() *FSFreeze {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FSFreezeMetadata) DeepCopyInto(out *FSFreezeMetadata) {
+	*out = *in
+	if in.StartTimestamp != nil {
+		in, out := &in.StartTimestamp, &out.StartTimestamp
+		*out = (*in).DeepCopy()
+	}
+	if in.EndTimestamp != nil {
+		in, out := &in.EndTimestamp, &out.EndTimestamp
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FSFreezeMetadata.
+func (in *FSFreezeMetadata) DeepCopy() *FSFreezeMetadata {
+	if in == nil {
+		return nil
+	}
+	out := new(FSFreezeMetadata)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *FeatureEnabled) DeepCopyInto(out *FeatureEnabled) {
 	*out = *in
@@ -1962,6 +2017,22 @@ func (in *IOThreads) DeepCopy() *IOThreads {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IOTune) DeepCopyInto(out *IOTune) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IOTune.
+func (in *IOTune) DeepCopy() *IOTune {
+	if in == nil {
+		return nil
+	}
+	out := new(IOTune)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Input) DeepCopyInto(out *Input) {
 	*out = *in
@@ -2238,6 +2309,11 @@ func (in *KubeVirtMetadata) DeepCopyInto(out *KubeVirtMetadata) {
 		*out = new(MemoryDumpMetadata)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.FSFreeze != nil {
+		in, out := &in.FSFreeze, &out.FSFreeze
+		*out = new(FSFreezeMetadata)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
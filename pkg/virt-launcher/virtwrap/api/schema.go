@@ -134,6 +134,7 @@ type GuestOSInfo struct {
 	KernelVersion string
 	Machine       string
 	Id            string
+	Hostname      string
 }
 
 type InterfaceStatus struct {
@@ -390,6 +391,7 @@ type KubeVirtMetadata struct {
 	Migration        *MigrationMetadata        `xml:"migration,omitempty"`
 	AccessCredential *AccessCredentialMetadata `xml:"accessCredential,omitempty"`
 	MemoryDump       *MemoryDumpMetadata       `xml:"memoryDump,omitempty"`
+	FSFreeze         *FSFreezeMetadata         `xml:"fsFreeze,omitempty"`
 }
 
 type AccessCredentialMetadata struct {
@@ -404,6 +406,16 @@ type MemoryDumpMetadata struct {
 	Completed      bool         `xml:"completed,omitempty"`
 	Failed         bool         `xml:"failed,omitempty"`
 	FailureReason  string       `xml:"failureReason,omitempty"`
+	DataProcessed  uint64       `xml:"dataProcessed,omitempty"`
+	DataTotal      uint64       `xml:"dataTotal,omitempty"`
+}
+
+type FSFreezeMetadata struct {
+	// Volumes is the comma separated list of the volumes whose filesystems were frozen,
+	// all the filesystems were frozen if it's empty
+	Volumes        string       `xml:"volumes,omitempty"`
+	StartTimestamp *metav1.Time `xml:"startTimestamp,omitempty"`
+	EndTimestamp   *metav1.Time `xml:"endTimestamp,omitempty"`
 }
 
 type MigrationMetadata struct {
@@ -415,12 +427,22 @@ type MigrationMetadata struct {
 	FailureReason  string           `xml:"failureReason,omitempty"`
 	AbortStatus    string           `xml:"abortStatus,omitempty"`
 	Mode           v1.MigrationMode `xml:"mode,omitempty"`
+	DataProcessed  uint64           `xml:"dataProcessed,omitempty"`
+	DataRemaining  uint64           `xml:"dataRemaining,omitempty"`
+	DataTotal      uint64           `xml:"dataTotal,omitempty"`
+	Downtime       uint64           `xml:"downtime,omitempty"`
+	// Bandwidth is the bandwidth of the migration job in MiB/s
+	Bandwidth uint64 `xml:"bandwidth,omitempty"`
+	// PostCopyRecoveryAttempts counts the attempts to resume the post copy migration after the connection broke
+	PostCopyRecoveryAttempts int  `xml:"postCopyRecoveryAttempts,omitempty"`
+	PostCopyRecovered        bool `xml:"postCopyRecovered,omitempty"`
 }
 
 type GracePeriodMetadata struct {
 	DeletionGracePeriodSeconds int64        `xml:"deletionGracePeriodSeconds"`
 	DeletionTimestamp          *metav1.Time `xml:"deletionTimestamp,omitempty"`
 	MarkedForGracefulShutdown  *bool        `xml:"markedForGracefulShutdown,omitempty"`
+	ShutdownStage              string       `xml:"shutdownStage,omitempty"`
 }
 
 type Commandline struct {
@@ -641,6 +663,7 @@ type Controller struct {
 	Driver  *ControllerDriver `xml:"driver,omitempty"`
 	Alias   *Alias            `xml:"alias,omitempty"`
 	Address *Address          `xml:"address,omitempty"`
+	Target  *ControllerTarget `xml:"target,omitempty"`
 }
 
 // END Controller -----------------------------
@@ -654,6 +677,14 @@ type ControllerDriver struct {
 
 // END ControllerDriver
 
+// BEGIN ControllerTarget
+type ControllerTarget struct {
+	BusNr string  `xml:"busNr,attr,omitempty"`
+	Node  *uint32 `xml:"node,omitempty"`
+}
+
+// END ControllerTarget
+
 // BEGIN Disk -----------------------------
 
 type Disk struct {
@@ -672,6 +703,7 @@ type Disk struct {
 	Address            *Address      `xml:"address,omitempty"`
 	Model              string        `xml:"model,attr,omitempty"`
 	BlockIO            *BlockIO      `xml:"blockio,omitempty"`
+	IOTune             *IOTune       `xml:"iotune,omitempty"`
 	FilesystemOverhead *v1.Percent   `xml:"filesystemOverhead,omitempty"`
 	Capacity           *int64        `xml:"capacity,omitempty"`
 	ExpandDisksEnabled bool          `xml:"expandDisksEnabled,omitempty"`
@@ -749,6 +781,15 @@ type BlockIO struct {
 	PhysicalBlockSize uint `xml:"physical_block_size,attr,omitempty"`
 }
 
+type IOTune struct {
+	TotalBytesSec uint64 `xml:"total_bytes_sec,omitempty"`
+	ReadBytesSec  uint64 `xml:"read_bytes_sec,omitempty"`
+	WriteBytesSec uint64 `xml:"write_bytes_sec,omitempty"`
+	TotalIopsSec  uint64 `xml:"total_iops_sec,omitempty"`
+	ReadIopsSec   uint64 `xml:"read_iops_sec,omitempty"`
+	WriteIopsSec  uint64 `xml:"write_iops_sec,omitempty"`
+}
+
 type Reservations struct {
 	Managed            string              `xml:"managed,attr,omitempty"`
 	SourceReservations *SourceReservations `xml:"source,omitempty"`
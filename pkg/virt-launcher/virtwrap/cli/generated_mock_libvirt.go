@@ -490,6 +490,26 @@ func (_mr *_MockVirDomainRecorder) MigrateStartPostCopy(arg0 interface{}) *gomoc
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "MigrateStartPostCopy", arg0)
 }
 
+func (_m *MockVirDomain) MigrateSetMaxDowntime(downtime uint64, flags uint32) error {
+	ret := _m.ctrl.Call(_m, "MigrateSetMaxDowntime", downtime, flags)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockVirDomainRecorder) MigrateSetMaxDowntime(arg0, arg1 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "MigrateSetMaxDowntime", arg0, arg1)
+}
+
+func (_m *MockVirDomain) MigrateSetMaxSpeed(speed uint64, flags libvirt.DomainMigrateMaxSpeedFlags) error {
+	ret := _m.ctrl.Call(_m, "MigrateSetMaxSpeed", speed, flags)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockVirDomainRecorder) MigrateSetMaxSpeed(arg0, arg1 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "MigrateSetMaxSpeed", arg0, arg1)
+}
+
 func (_m *MockVirDomain) MemoryStats(nrStats uint32, flags uint32) ([]libvirt.DomainMemoryStat, error) {
 	ret := _m.ctrl.Call(_m, "MemoryStats", nrStats, flags)
 	ret0, _ := ret[0].([]libvirt.DomainMemoryStat)
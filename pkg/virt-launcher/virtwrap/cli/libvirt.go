@@ -313,10 +313,11 @@ func (l *LibvirtConnection) GetDomainStats(statsTypes libvirt.DomainStatsTypes,
 			return list, err
 		}
 
-		devAliasMap, err := l.GetDeviceAliasMap(domStat.Domain)
+		domSpec, err := getDomainSpec(domStat.Domain)
 		if err != nil {
 			return list, err
 		}
+		devAliasMap := l.GetDeviceAliasMap(domSpec)
 
 		domInfo, err := domStat.Domain.GetInfo()
 		if err != nil {
@@ -361,18 +362,20 @@ func (l *LibvirtConnection) GetSEVInfo() (*api.SEVNodeParameters, error) {
 	return sevNodeParameters, nil
 }
 
-func (l *LibvirtConnection) GetDeviceAliasMap(domain *libvirt.Domain) (map[string]string, error) {
-	devAliasMap := make(map[string]string)
-
+func getDomainSpec(domain *libvirt.Domain) (*api.DomainSpec, error) {
 	domSpec := &api.DomainSpec{}
 	domxml, err := domain.GetXMLDesc(0)
 	if err != nil {
-		return devAliasMap, err
+		return nil, err
 	}
-	err = xml.Unmarshal([]byte(domxml), domSpec)
-	if err != nil {
-		return devAliasMap, err
+	if err := xml.Unmarshal([]byte(domxml), domSpec); err != nil {
+		return nil, err
 	}
+	return domSpec, nil
+}
+
+func (l *LibvirtConnection) GetDeviceAliasMap(domSpec *api.DomainSpec) map[string]string {
+	devAliasMap := make(map[string]string)
 
 	for _, iface := range domSpec.Devices.Interfaces {
 		if iface.Target != nil {
@@ -384,7 +387,7 @@ func (l *LibvirtConnection) GetDeviceAliasMap(domain *libvirt.Domain) (map[strin
 		devAliasMap[disk.Target.Device] = disk.Alias.GetName()
 	}
 
-	return devAliasMap, nil
+	return devAliasMap
 }
 
 // Installs a watchdog which will check periodically if the libvirt connection is still alive.
@@ -522,6 +525,8 @@ type VirDomain interface {
 	GetXMLDesc(flags libvirt.DomainXMLFlags) (string, error)
 	MigrateToURI3(string, *libvirt.DomainMigrateParameters, libvirt.DomainMigrateFlags) error
 	MigrateStartPostCopy(flags uint32) error
+	MigrateSetMaxDowntime(downtime uint64, flags uint32) error
+	MigrateSetMaxSpeed(speed uint64, flags libvirt.DomainMigrateMaxSpeedFlags) error
 	MemoryStats(nrStats uint32, flags uint32) ([]libvirt.DomainMemoryStat, error)
 	GetJobStats(flags libvirt.DomainGetJobStatsFlags) (*libvirt.DomainJobInfo, error)
 	GetJobInfo() (*libvirt.DomainJobInfo, error)
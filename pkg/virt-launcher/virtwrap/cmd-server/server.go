@@ -265,7 +265,7 @@ func (l *Launcher) FreezeVirtualMachine(_ context.Context, request *cmdv1.Freeze
 		return response, nil
 	}
 
-	if err := l.domainManager.FreezeVMI(vmi, request.UnfreezeTimeoutSeconds); err != nil {
+	if err := l.domainManager.FreezeVMI(vmi, request.UnfreezeTimeoutSeconds, request.Volumes); err != nil {
 		log.Log.Object(vmi).Reason(err).Errorf("Failed to freeze vmi")
 		response.Success = false
 		response.Message = getErrorMessage(err)
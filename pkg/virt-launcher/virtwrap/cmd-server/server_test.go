@@ -104,8 +104,8 @@ var _ = Describe("Virt remote commands", func() {
 
 		It("should freeze a vmi", func() {
 			vmi := v1.NewVMIReferenceFromName("testvmi")
-			domainManager.EXPECT().FreezeVMI(vmi, int32(0))
-			Expect(client.FreezeVirtualMachine(vmi, int32(0))).To(Succeed())
+			domainManager.EXPECT().FreezeVMI(vmi, int32(0), nil)
+			Expect(client.FreezeVirtualMachine(vmi, int32(0), nil)).To(Succeed())
 		})
 
 		It("should unfreeze a vmi", func() {
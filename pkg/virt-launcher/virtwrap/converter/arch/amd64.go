@@ -84,6 +84,10 @@ func (converterAMD64) IsUSBNeeded(vmi *v1.VirtualMachineInstance) bool {
 		return true
 	}
 
+	if len(vmi.Spec.Domain.Devices.USBDevices) > 0 {
+		return true
+	}
+
 	return false
 }
 
@@ -111,6 +115,10 @@ func (converterAMD64) ShouldVerboseLogsBeEnabled() bool {
 	return true
 }
 
+func (converterAMD64) SupportPCIeExpanderBus() bool {
+	return true
+}
+
 func (converterAMD64) HasVMPort() bool {
 	return true
 }
@@ -95,6 +95,11 @@ func (converterARM64) ShouldVerboseLogsBeEnabled() bool {
 	return false
 }
 
+func (converterARM64) SupportPCIeExpanderBus() bool {
+	// the NUMA affinity of PCI devices is only passed through on the q35 machine type
+	return false
+}
+
 func (converterARM64) HasVMPort() bool {
 	return false
 }
@@ -45,6 +45,7 @@ type Converter interface {
 	IsROMTuningSupported() bool
 	RequiresMPXCPUValidation() bool
 	ShouldVerboseLogsBeEnabled() bool
+	SupportPCIeExpanderBus() bool
 }
 
 func NewConverter(arch string) Converter {
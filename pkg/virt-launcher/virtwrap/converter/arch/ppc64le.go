@@ -82,6 +82,11 @@ func (converterPPC64) ShouldVerboseLogsBeEnabled() bool {
 	return false
 }
 
+func (converterPPC64) SupportPCIeExpanderBus() bool {
+	// the NUMA affinity of PCI devices is only passed through on the q35 machine type
+	return false
+}
+
 func (converterPPC64) HasVMPort() bool {
 	return false
 }
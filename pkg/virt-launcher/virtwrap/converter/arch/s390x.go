@@ -90,6 +90,11 @@ func (converterS390X) ShouldVerboseLogsBeEnabled() bool {
 	return false
 }
 
+func (converterS390X) SupportPCIeExpanderBus() bool {
+	// the NUMA affinity of PCI devices is only passed through on the q35 machine type
+	return false
+}
+
 func (converterS390X) HasVMPort() bool {
 	return false
 }
@@ -38,6 +38,7 @@ import (
 	"golang.org/x/sys/unix"
 
 	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 
 	v1 "kubevirt.io/api/core/v1"
 	"kubevirt.io/client-go/log"
@@ -101,6 +102,8 @@ type ConverterContext struct {
 	SRIOVDevices                    []api.HostDevice
 	GenericHostDevices              []api.HostDevice
 	GPUHostDevices                  []api.HostDevice
+	USBHostDevices                  []api.HostDevice
+	HostDeviceNUMANodes             map[api.Address]uint32
 	EFIConfiguration                *EFIConfiguration
 	MemBalloonStatsPeriod           uint
 	UseVirtioTransitional           bool
@@ -113,6 +116,7 @@ type ConverterContext struct {
 	BochsForEFIGuests               bool
 	SerialConsoleLog                bool
 	DomainAttachmentByInterfaceName map[string]string
+	VDPADevicePathByInterfaceName   map[string]string
 }
 
 func assignDiskToSCSIController(disk *api.Disk, unit int) {
@@ -175,10 +179,14 @@ func Convert_v1_Disk_To_api_Disk(c *ConverterContext, diskDevice *v1.Disk, disk
 			setReservation(disk)
 		}
 	} else if diskDevice.CDRom != nil {
+		var unit int
 		disk.Device = "cdrom"
 		disk.Target.Tray = string(diskDevice.CDRom.Tray)
 		disk.Target.Bus = diskDevice.CDRom.Bus
-		disk.Target.Device, _ = makeDeviceName(diskDevice.Name, diskDevice.CDRom.Bus, prefixMap)
+		disk.Target.Device, unit = makeDeviceName(diskDevice.Name, diskDevice.CDRom.Bus, prefixMap)
+		if diskDevice.CDRom.Bus == "scsi" {
+			assignDiskToSCSIController(disk, unit)
+		}
 		if diskDevice.CDRom.ReadOnly != nil {
 			disk.ReadOnly = toApiReadOnly(*diskDevice.CDRom.ReadOnly)
 		} else {
@@ -208,6 +216,7 @@ func Convert_v1_Disk_To_api_Disk(c *ConverterContext, diskDevice *v1.Disk, disk
 	if diskDevice.BootOrder != nil {
 		disk.BootOrder = &api.BootOrder{Order: *diskDevice.BootOrder}
 	}
+	disk.IOTune = toApiIOTune(diskDevice.IOTune)
 	if c.UseLaunchSecurity && disk.Target.Bus == v1.DiskBusVirtio {
 		disk.Driver.IOMMU = "on"
 	}
@@ -533,6 +542,32 @@ func toApiReadOnly(src bool) *api.ReadOnly {
 	return nil
 }
 
+func toApiIOTune(ioTune *v1.DiskIOTune) *api.IOTune {
+	if ioTune == nil {
+		return nil
+	}
+	bytesSec := func(q *resource.Quantity) uint64 {
+		if q == nil {
+			return 0
+		}
+		return uint64(q.Value())
+	}
+	iopsSec := func(v *uint64) uint64 {
+		if v == nil {
+			return 0
+		}
+		return *v
+	}
+	return &api.IOTune{
+		TotalBytesSec: bytesSec(ioTune.TotalBytesSec),
+		ReadBytesSec:  bytesSec(ioTune.ReadBytesSec),
+		WriteBytesSec: bytesSec(ioTune.WriteBytesSec),
+		TotalIopsSec:  iopsSec(ioTune.TotalIOPSSec),
+		ReadIopsSec:   iopsSec(ioTune.ReadIOPSSec),
+		WriteIopsSec:  iopsSec(ioTune.WriteIOPSSec),
+	}
+}
+
 // Add_Agent_To_api_Channel creates the channel for guest agent communication
 func Add_Agent_To_api_Channel() (channel api.Channel) {
 	channel.Type = "unix"
@@ -1716,12 +1751,20 @@ func Convert_v1_VirtualMachineInstance_To_api_Domain(vmi *v1.VirtualMachineInsta
 
 	if vmi.Spec.Domain.CPU != nil {
 		// Set VM CPU model and vendor
-		if vmi.Spec.Domain.CPU.Model != "" {
-			if vmi.Spec.Domain.CPU.Model == v1.CPUModeHostModel || vmi.Spec.Domain.CPU.Model == v1.CPUModeHostPassthrough {
-				domain.Spec.CPU.Mode = vmi.Spec.Domain.CPU.Model
+		cpuModel := vmi.Spec.Domain.CPU.Model
+		if cpuModel == v1.CPUModeClusterCommon {
+			// The model is resolved by virt-controller before the pod gets created, fall back to host-model otherwise
+			cpuModel = v1.CPUModeHostModel
+			if topology.AreClusterCommonCPUTopologyHintsDefined(vmi) {
+				cpuModel = vmi.Status.TopologyHints.CPUModel
+			}
+		}
+		if cpuModel != "" {
+			if cpuModel == v1.CPUModeHostModel || cpuModel == v1.CPUModeHostPassthrough {
+				domain.Spec.CPU.Mode = cpuModel
 			} else {
 				domain.Spec.CPU.Mode = "custom"
-				domain.Spec.CPU.Model = vmi.Spec.Domain.CPU.Model
+				domain.Spec.CPU.Model = cpuModel
 			}
 		}
 
@@ -1736,6 +1779,18 @@ func Convert_v1_VirtualMachineInstance_To_api_Domain(vmi *v1.VirtualMachineInsta
 				})
 			}
 		}
+		if topology.IsClusterCommonCPUModelRequested(vmi) && topology.AreClusterCommonCPUTopologyHintsDefined(vmi) {
+			for _, feature := range vmi.Status.TopologyHints.CPUFeatures {
+				if _, exists := existingFeatures[feature]; exists {
+					continue
+				}
+				existingFeatures[feature] = struct{}{}
+				domain.Spec.CPU.Features = append(domain.Spec.CPU.Features, api.CPUFeature{
+					Name:   feature,
+					Policy: "require",
+				})
+			}
+		}
 
 		/*
 						Libvirt validation fails when a CPU model is usable
@@ -1751,7 +1806,7 @@ func Convert_v1_VirtualMachineInstance_To_api_Domain(vmi *v1.VirtualMachineInsta
 		*/
 
 		_, exists := existingFeatures["mpx"]
-		if c.Architecture.RequiresMPXCPUValidation() && !exists && vmi.Spec.Domain.CPU.Model != v1.CPUModeHostModel && vmi.Spec.Domain.CPU.Model != v1.CPUModeHostPassthrough {
+		if c.Architecture.RequiresMPXCPUValidation() && !exists && cpuModel != v1.CPUModeHostModel && cpuModel != v1.CPUModeHostPassthrough {
 			domain.Spec.CPU.Features = append(domain.Spec.CPU.Features, api.CPUFeature{
 				Name:   "mpx",
 				Policy: "disable",
@@ -1780,6 +1835,7 @@ func Convert_v1_VirtualMachineInstance_To_api_Domain(vmi *v1.VirtualMachineInsta
 
 	domain.Spec.Devices.HostDevices = append(domain.Spec.Devices.HostDevices, c.GenericHostDevices...)
 	domain.Spec.Devices.HostDevices = append(domain.Spec.Devices.HostDevices, c.GPUHostDevices...)
+	domain.Spec.Devices.HostDevices = append(domain.Spec.Devices.HostDevices, c.USBHostDevices...)
 
 	if vmi.Spec.Domain.CPU == nil || vmi.Spec.Domain.CPU.Model == "" {
 		domain.Spec.CPU.Mode = v1.CPUModeHostModel
@@ -1849,6 +1905,13 @@ func Convert_v1_VirtualMachineInstance_To_api_Domain(vmi *v1.VirtualMachineInsta
 	domain.Spec.Devices.Interfaces = append(domain.Spec.Devices.Interfaces, domainInterfaces...)
 	domain.Spec.Devices.HostDevices = append(domain.Spec.Devices.HostDevices, c.SRIOVDevices...)
 
+	// Reflect the NUMA locality of the host devices in the guest NUMA topology
+	if vmi.IsCPUDedicated() && c.Architecture.SupportPCIeExpanderBus() {
+		if err := PlaceHostDevicesOnGuestNUMANodes(&domain.Spec, c.HostDeviceNUMANodes); err != nil {
+			return err
+		}
+	}
+
 	// Add Ignition Command Line if present
 	ignitiondata := vmi.Annotations[v1.IgnitionAnnotation]
 	if ignitiondata != "" && strings.Contains(ignitiondata, "ignition") {
@@ -222,6 +222,9 @@ var _ = Describe("Converter", func() {
 			Entry("Disk-type disk", v1.DiskDevice{
 				Disk: &v1.DiskTarget{Bus: "scsi"},
 			}),
+			Entry("CDRom-type disk", v1.DiskDevice{
+				CDRom: &v1.CDRomTarget{Bus: "scsi"},
+			}),
 		)
 
 		DescribeTable("Should add boot order when provided", func(arch, expectedModel string) {
@@ -267,6 +270,31 @@ var _ = Describe("Converter", func() {
 			MultiArchEntry(""),
 		)
 
+		DescribeTable("should set the disk IO limits if requested", func(arch string) {
+			v1Disk := &v1.Disk{
+				IOTune: &v1.DiskIOTune{
+					ReadBytesSec:  pointer.P(resource.MustParse("10Mi")),
+					WriteBytesSec: pointer.P(resource.MustParse("5Mi")),
+					TotalIOPSSec:  pointer.P(uint64(1000)),
+				},
+			}
+			xml := diskToDiskXML(arch, v1Disk)
+			expectedXML := `<Disk device="" type="">
+  <source></source>
+  <target></target>
+  <driver name="qemu" type=""></driver>
+  <alias name="ua-"></alias>
+  <iotune>
+    <read_bytes_sec>10485760</read_bytes_sec>
+    <write_bytes_sec>5242880</write_bytes_sec>
+    <total_iops_sec>1000</total_iops_sec>
+  </iotune>
+</Disk>`
+			Expect(xml).To(Equal(expectedXML))
+		},
+			MultiArchEntry(""),
+		)
+
 		DescribeTable("should not set disk I/O mode if not requested", func(arch string) {
 			v1Disk := &v1.Disk{}
 			xml := diskToDiskXML(arch, v1Disk)
@@ -990,6 +1018,45 @@ var _ = Describe("Converter", func() {
 			)
 		})
 
+		Context("when the cluster-common CPU model is requested", func() {
+			It("should convert the resolved CPU model and features", func() {
+				v1.SetObjectDefaults_VirtualMachineInstance(vmi)
+				vmi.Spec.Domain.CPU = &v1.CPU{
+					Model: v1.CPUModeClusterCommon,
+					Features: []v1.CPUFeature{
+						{
+							Name:   "ssbd",
+							Policy: "disable",
+						},
+					},
+				}
+				vmi.Status.TopologyHints = &v1.TopologyHints{
+					CPUModel:    "Skylake-Client-IBRS",
+					CPUFeatures: []string{"md-clear", "ssbd"},
+				}
+				domainSpec := vmiToDomainXMLToDomainSpec(vmi, c)
+
+				Expect(domainSpec.CPU.Mode).To(Equal("custom"))
+				Expect(domainSpec.CPU.Model).To(Equal("Skylake-Client-IBRS"))
+				Expect(domainSpec.CPU.Features).To(ContainElements(
+					api.CPUFeature{Name: "ssbd", Policy: "disable"},
+					api.CPUFeature{Name: "md-clear", Policy: "require"},
+				))
+				Expect(domainSpec.CPU.Features).ToNot(ContainElement(api.CPUFeature{Name: "ssbd", Policy: "require"}))
+			})
+
+			It("should fall back to host-model when the CPU model is not resolved", func() {
+				v1.SetObjectDefaults_VirtualMachineInstance(vmi)
+				vmi.Spec.Domain.CPU = &v1.CPU{
+					Model: v1.CPUModeClusterCommon,
+				}
+				domainSpec := vmiToDomainXMLToDomainSpec(vmi, c)
+
+				Expect(domainSpec.CPU.Mode).To(Equal("host-model"))
+				Expect(domainSpec.CPU.Model).To(BeEmpty())
+			})
+		})
+
 		Context("when CPU spec defined and model not", func() {
 			It("should set host-model CPU mode", func() {
 				v1.SetObjectDefaults_VirtualMachineInstance(vmi)
@@ -1193,6 +1260,24 @@ var _ = Describe("Converter", func() {
 			Entry("should be disabled on s390x when device with no bus is present", s390x, "", BeTrue()),
 		)
 
+		It("should enable the usb controller and add the USB host devices when the VMI has USB devices", func() {
+			v1.SetObjectDefaults_VirtualMachineInstance(vmi)
+			vmi.Spec.Domain.Devices.Inputs = nil
+			vmi.Spec.Domain.Devices.USBDevices = []v1.USBDevice{{Name: "token", VendorProduct: "1050:0407"}}
+			usbHostDevice := api.HostDevice{
+				Type:   api.HostDeviceUSB,
+				Mode:   "subsystem",
+				Alias:  api.NewUserDefinedAlias("usbdevice-token"),
+				Source: api.HostDeviceSource{Address: &api.Address{Bus: "1", Device: "5"}},
+			}
+			c.Architecture = archconverter.NewConverter(amd64)
+			c.USBHostDevices = []api.HostDevice{usbHostDevice}
+
+			domain := vmiToDomain(vmi, c)
+			Expect(domain.Spec.Devices.Controllers).To(ContainElement(And(HaveField("Type", "usb"), HaveField("Model", "qemu-xhci"))))
+			Expect(domain.Spec.Devices.HostDevices).To(ContainElement(usbHostDevice))
+		})
+
 		It("should fail when input device is set to ps2 bus", func() {
 			v1.SetObjectDefaults_VirtualMachineInstance(vmi)
 			vmi.Spec.Domain.Devices.Inputs[0].Bus = "ps2"
@@ -1570,6 +1655,21 @@ var _ = Describe("Converter", func() {
 			Expect(domain.Spec.Devices.Interfaces).To(HaveLen(1))
 			Expect(domain.Spec.Devices.Interfaces[0].LinkState.State).To(Equal("down"))
 		})
+		It("Should not set the domain interface bandwidth, as virt-handler shapes the tap device", func() {
+			v1.SetObjectDefaults_VirtualMachineInstance(vmi)
+			vmi.Spec.Domain.Devices.Interfaces = []v1.Interface{
+				*v1.DefaultBridgeNetworkInterface(),
+			}
+			vmi.Spec.Domain.Devices.Interfaces[0].Bandwidth = &v1.InterfaceBandwidth{
+				Inbound: &v1.InterfaceBandwidthLimit{Average: resource.MustParse("1Mi")},
+			}
+			vmi.Spec.Networks = []v1.Network{*v1.DefaultPodNetwork()}
+
+			domain := vmiToDomain(vmi, c)
+			Expect(domain).ToNot(BeNil())
+			Expect(domain.Spec.Devices.Interfaces).To(HaveLen(1))
+			Expect(domain.Spec.Devices.Interfaces[0].BandWidth).To(BeNil())
+		})
 		It("Should set domain interface source correctly for multus", func() {
 			v1.SetObjectDefaults_VirtualMachineInstance(vmi)
 			vmi.Spec.Domain.Devices.Interfaces = []v1.Interface{
@@ -1730,6 +1830,44 @@ var _ = Describe("Converter", func() {
 			Expect(domain).ToNot(BeNil())
 			Expect(domain.Spec.Devices.Interfaces).To(BeEmpty())
 		})
+		It("Should create network configuration for an interface using a binding plugin with vdpa domain attachment", func() {
+			const (
+				bindingName = "vdpa"
+				netName     = "vdpanet"
+				devicePath  = "/dev/vhost-vdpa-0"
+			)
+			c.DomainAttachmentByInterfaceName[netName] = string(v1.Vdpa)
+			c.VDPADevicePathByInterfaceName = map[string]string{netName: devicePath}
+			v1.SetObjectDefaults_VirtualMachineInstance(vmi)
+
+			iface := v1.Interface{Name: netName, Binding: &v1.PluginBinding{Name: bindingName}, MacAddress: "de:ad:00:00:be:af"}
+			vmi.Spec.Networks = []v1.Network{{
+				Name:          netName,
+				NetworkSource: v1.NetworkSource{Multus: &v1.MultusNetwork{NetworkName: "vdpa-nad"}},
+			}}
+			vmi.Spec.Domain.Devices.Interfaces = []v1.Interface{iface}
+
+			domain := vmiToDomain(vmi, c)
+			Expect(domain).ToNot(BeNil())
+			Expect(domain.Spec.Devices.Interfaces).To(HaveLen(1))
+			Expect(domain.Spec.Devices.Interfaces[0].Type).To(Equal("vdpa"))
+			Expect(domain.Spec.Devices.Interfaces[0].Source).To(Equal(api.InterfaceSource{Device: devicePath}))
+			Expect(domain.Spec.Devices.Interfaces[0].MAC).To(Equal(&api.MAC{MAC: "de:ad:00:00:be:af"}))
+			Expect(domain.Spec.Devices.Interfaces[0].Driver).To(BeNil())
+		})
+		It("Should fail to create network configuration for a vdpa interface without its device", func() {
+			const netName = "vdpanet"
+			c.DomainAttachmentByInterfaceName[netName] = string(v1.Vdpa)
+			v1.SetObjectDefaults_VirtualMachineInstance(vmi)
+
+			vmi.Spec.Networks = []v1.Network{{
+				Name:          netName,
+				NetworkSource: v1.NetworkSource{Multus: &v1.MultusNetwork{NetworkName: "vdpa-nad"}},
+			}}
+			vmi.Spec.Domain.Devices.Interfaces = []v1.Interface{{Name: netName, Binding: &v1.PluginBinding{Name: "vdpa"}}}
+
+			Expect(Convert_v1_VirtualMachineInstance_To_api_Domain(vmi, &api.Domain{}, c)).ToNot(Succeed())
+		})
 		It("creates SRIOV hostdev", func() {
 			v1.SetObjectDefaults_VirtualMachineInstance(vmi)
 			domain := &api.Domain{}
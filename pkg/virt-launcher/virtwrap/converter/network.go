@@ -48,7 +48,8 @@ func CreateDomainInterfaces(vmi *v1.VirtualMachineInstance, c *ConverterContext)
 			return nil, fmt.Errorf("failed to find network %s", iface.Name)
 		}
 
-		if (iface.Binding != nil && c.DomainAttachmentByInterfaceName[iface.Name] != string(v1.Tap)) || iface.SRIOV != nil {
+		domainAttachment := c.DomainAttachmentByInterfaceName[iface.Name]
+		if (iface.Binding != nil && domainAttachment != string(v1.Tap) && domainAttachment != string(v1.Vdpa)) || iface.SRIOV != nil {
 			continue
 		}
 
@@ -77,7 +78,7 @@ func CreateDomainInterfaces(vmi *v1.VirtualMachineInstance, c *ConverterContext)
 			domainIface.ACPI = &api.ACPI{Index: uint(iface.ACPIIndex)}
 		}
 
-		if c.DomainAttachmentByInterfaceName[iface.Name] == string(v1.Tap) {
+		if domainAttachment == string(v1.Tap) {
 			// use "ethernet" interface type, since we're using pre-configured tap devices
 			// https://libvirt.org/formatdomain.html#elementsNICSEthernet
 			domainIface.Type = "ethernet"
@@ -89,6 +90,12 @@ func CreateDomainInterfaces(vmi *v1.VirtualMachineInstance, c *ConverterContext)
 			}
 		}
 
+		if domainAttachment == string(v1.Vdpa) {
+			if err := configureVDPAInterface(&domainIface, iface, c.VDPADevicePathByInterfaceName); err != nil {
+				return nil, err
+			}
+		}
+
 		if c.UseLaunchSecurity {
 			// It's necessary to disable the iPXE option ROM as iPXE is not aware of SEV
 			domainIface.Rom = &api.Rom{Enabled: "no"}
@@ -110,6 +117,27 @@ func CreateDomainInterfaces(vmi *v1.VirtualMachineInstance, c *ConverterContext)
 	return domainInterfaces, nil
 }
 
+// configureVDPAInterface connects the interface to the vhost-vdpa character device of its network.
+// The datapath is offloaded to the hardware, the MAC address is set on the device by libvirt.
+// https://libvirt.org/formatdomain.html#vdpa-devices
+func configureVDPAInterface(domainIface *api.Interface, iface v1.Interface, devicePathByInterfaceName map[string]string) error {
+	devicePath, exists := devicePathByInterfaceName[iface.Name]
+	if !exists {
+		return fmt.Errorf("failed to find the vDPA device of interface %s", iface.Name)
+	}
+	domainIface.Type = "vdpa"
+	domainIface.Source = api.InterfaceSource{Device: devicePath}
+	// The queues are provided by the device, there is no vhost driver involved
+	domainIface.Driver = nil
+	if iface.MacAddress != "" {
+		domainIface.MAC = &api.MAC{MAC: iface.MacAddress}
+	}
+	if iface.BootOrder != nil {
+		domainIface.BootOrder = &api.BootOrder{Order: *iface.BootOrder}
+	}
+	return nil
+}
+
 func GetInterfaceType(iface *v1.Interface) string {
 	if iface.Model != "" {
 		return iface.Model
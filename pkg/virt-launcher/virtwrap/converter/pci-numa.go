@@ -0,0 +1,123 @@
+package converter
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+)
+
+const (
+	pcieExpanderBusModel = "pcie-expander-bus"
+	pcieRootPortModel    = "pcie-root-port"
+
+	// bus numbers are assigned to the expander buses from the top, the root bus keeps the lowest ones
+	maxPCIBusNr          = 255
+	pcieExpanderBusSlots = 32
+)
+
+// PlaceHostDevicesOnGuestNUMANodes attaches the PCI host devices to pcie-expander-bus controllers bound to the guest
+// NUMA cell mapped to the host NUMA node of each device, so that the guest sees the NUMA locality of the devices.
+// Every device gets a pcie-root-port of its own on the expander bus of its cell.
+// Devices with an explicit guest PCI address, or on a host NUMA node no guest cell is mapped to, are left as is.
+func PlaceHostDevicesOnGuestNUMANodes(spec *api.DomainSpec, hostDeviceNUMANodes map[api.Address]uint32) error {
+	if spec.NUMATune == nil || len(spec.NUMATune.MemNodes) == 0 || len(hostDeviceNUMANodes) == 0 {
+		return nil
+	}
+
+	guestCellByHostNode := map[string]uint32{}
+	for _, memNode := range spec.NUMATune.MemNodes {
+		guestCellByHostNode[memNode.NodeSet] = memNode.CellID
+	}
+
+	devicesByGuestCell := map[uint32][]int{}
+	for i, hostDev := range spec.Devices.HostDevices {
+		if hostDev.Type != api.HostDevicePCI || hostDev.Source.Address == nil {
+			continue
+		}
+		// keep explicit requests for pci addresses
+		if hostDev.Address != nil && hostDev.Address.Domain != "" {
+			continue
+		}
+		hostNode, exists := hostDeviceNUMANodes[*hostDev.Source.Address]
+		if !exists {
+			continue
+		}
+		guestCell, exists := guestCellByHostNode[strconv.FormatUint(uint64(hostNode), 10)]
+		if !exists {
+			continue
+		}
+		devicesByGuestCell[guestCell] = append(devicesByGuestCell[guestCell], i)
+	}
+
+	var guestCells []uint32
+	for guestCell := range devicesByGuestCell {
+		guestCells = append(guestCells, guestCell)
+	}
+	sort.Slice(guestCells, func(i, j int) bool { return guestCells[i] < guestCells[j] })
+
+	index := nextPCIControllerIndex(spec.Devices.Controllers)
+	busNr := maxPCIBusNr + 1
+	for _, guestCell := range guestCells {
+		devices := devicesByGuestCell[guestCell]
+		if len(devices) > pcieExpanderBusSlots {
+			return fmt.Errorf("%d host devices are local to the guest NUMA cell %d, at most %d are supported", len(devices), guestCell, pcieExpanderBusSlots)
+		}
+		// the expander bus needs a bus number for itself and one for each of its root ports
+		busNr -= len(devices) + 1
+		if busNr <= 0 {
+			return fmt.Errorf("not enough PCI bus numbers left to place the host devices on the guest NUMA cells")
+		}
+
+		expanderBusIndex := index
+		index++
+		spec.Devices.Controllers = append(spec.Devices.Controllers, api.Controller{
+			Type:  "pci",
+			Index: strconv.Itoa(expanderBusIndex),
+			Model: pcieExpanderBusModel,
+			Target: &api.ControllerTarget{
+				BusNr: strconv.Itoa(busNr),
+				Node:  pointer.P(guestCell),
+			},
+		})
+		for slot, i := range devices {
+			rootPortIndex := index
+			index++
+			spec.Devices.Controllers = append(spec.Devices.Controllers, api.Controller{
+				Type:    "pci",
+				Index:   strconv.Itoa(rootPortIndex),
+				Model:   pcieRootPortModel,
+				Address: pciAddressOnBus(expanderBusIndex, slot),
+			})
+			spec.Devices.HostDevices[i].Address = pciAddressOnBus(rootPortIndex, 0)
+		}
+	}
+	return nil
+}
+
+// nextPCIControllerIndex returns the first index after the ones of the pci controllers of the domain,
+// index 0 being the root bus
+func nextPCIControllerIndex(controllers []api.Controller) int {
+	next := 1
+	for _, controller := range controllers {
+		if controller.Type != "pci" {
+			continue
+		}
+		if index, err := strconv.Atoi(controller.Index); err == nil && index >= next {
+			next = index + 1
+		}
+	}
+	return next
+}
+
+func pciAddressOnBus(bus int, slot int) *api.Address {
+	return &api.Address{
+		Type:     api.AddressPCI,
+		Domain:   "0x0000",
+		Bus:      fmt.Sprintf("%#02x", bus),
+		Slot:     fmt.Sprintf("%#02x", slot),
+		Function: "0x0",
+	}
+}
@@ -0,0 +1,93 @@
+package converter
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+)
+
+var _ = Describe("Host devices NUMA placement", func() {
+	sourceAddress := func(bus string) *api.Address {
+		return &api.Address{Type: api.AddressPCI, Domain: "0x0000", Bus: bus, Slot: "0x00", Function: "0x0"}
+	}
+	pciHostDevice := func(bus string) api.HostDevice {
+		return api.HostDevice{Type: api.HostDevicePCI, Source: api.HostDeviceSource{Address: sourceAddress(bus)}}
+	}
+
+	var spec *api.DomainSpec
+
+	BeforeEach(func() {
+		spec = &api.DomainSpec{
+			NUMATune: &api.NUMATune{
+				MemNodes: []api.MemNode{
+					{CellID: 0, Mode: "strict", NodeSet: "1"},
+					{CellID: 1, Mode: "strict", NodeSet: "0"},
+				},
+			},
+		}
+		spec.Devices.Controllers = []api.Controller{{Type: "usb", Index: "0", Model: "none"}}
+	})
+
+	It("should attach the host devices to an expander bus bound to the guest cell of their host node", func() {
+		spec.Devices.HostDevices = []api.HostDevice{pciHostDevice("0x81"), pciHostDevice("0x03"), pciHostDevice("0x82")}
+		numaNodes := map[api.Address]uint32{
+			*sourceAddress("0x81"): 1,
+			*sourceAddress("0x03"): 0,
+			*sourceAddress("0x82"): 1,
+		}
+
+		Expect(PlaceHostDevicesOnGuestNUMANodes(spec, numaNodes)).To(Succeed())
+
+		Expect(spec.Devices.Controllers).To(Equal([]api.Controller{
+			{Type: "usb", Index: "0", Model: "none"},
+			{Type: "pci", Index: "1", Model: "pcie-expander-bus", Target: &api.ControllerTarget{BusNr: "253", Node: pointer.P(uint32(0))}},
+			{Type: "pci", Index: "2", Model: "pcie-root-port", Address: pciAddressOnBus(1, 0)},
+			{Type: "pci", Index: "3", Model: "pcie-root-port", Address: pciAddressOnBus(1, 1)},
+			{Type: "pci", Index: "4", Model: "pcie-expander-bus", Target: &api.ControllerTarget{BusNr: "251", Node: pointer.P(uint32(1))}},
+			{Type: "pci", Index: "5", Model: "pcie-root-port", Address: pciAddressOnBus(4, 0)},
+		}))
+		Expect(spec.Devices.HostDevices[0].Address).To(Equal(pciAddressOnBus(2, 0)))
+		Expect(spec.Devices.HostDevices[1].Address).To(Equal(pciAddressOnBus(5, 0)))
+		Expect(spec.Devices.HostDevices[2].Address).To(Equal(pciAddressOnBus(3, 0)))
+	})
+
+	It("should leave the host devices with an explicit address or no guest cell on their host node as is", func() {
+		explicit := pciHostDevice("0x81")
+		explicit.Address = &api.Address{Type: api.AddressPCI, Domain: "0x0000", Bus: "0x00", Slot: "0x10", Function: "0x0"}
+		spec.Devices.HostDevices = []api.HostDevice{explicit, pciHostDevice("0x82"), pciHostDevice("0x83")}
+		numaNodes := map[api.Address]uint32{
+			*sourceAddress("0x81"): 1,
+			*sourceAddress("0x82"): 2,
+		}
+
+		Expect(PlaceHostDevicesOnGuestNUMANodes(spec, numaNodes)).To(Succeed())
+
+		Expect(spec.Devices.Controllers).To(HaveLen(1))
+		Expect(spec.Devices.HostDevices[0].Address).To(Equal(explicit.Address))
+		Expect(spec.Devices.HostDevices[1].Address).To(BeNil())
+		Expect(spec.Devices.HostDevices[2].Address).To(BeNil())
+	})
+
+	It("should not place the host devices without a guest NUMA topology", func() {
+		spec.NUMATune = nil
+		spec.Devices.HostDevices = []api.HostDevice{pciHostDevice("0x81")}
+
+		Expect(PlaceHostDevicesOnGuestNUMANodes(spec, map[api.Address]uint32{*sourceAddress("0x81"): 0})).To(Succeed())
+
+		Expect(spec.Devices.Controllers).To(HaveLen(1))
+		Expect(spec.Devices.HostDevices[0].Address).To(BeNil())
+	})
+
+	It("should fail when more host devices are local to a guest cell than its expander bus has slots", func() {
+		numaNodes := map[api.Address]uint32{}
+		for bus := 0; bus <= pcieExpanderBusSlots; bus++ {
+			hostDev := pciHostDevice(pciAddressOnBus(bus, 0).Bus)
+			spec.Devices.HostDevices = append(spec.Devices.HostDevices, hostDev)
+			numaNodes[*hostDev.Source.Address] = 0
+		}
+
+		Expect(PlaceHostDevicesOnGuestNUMANodes(spec, numaNodes)).ToNot(Succeed())
+	})
+})
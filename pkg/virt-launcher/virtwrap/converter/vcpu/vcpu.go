@@ -2,6 +2,7 @@ package vcpu
 
 import (
 	"fmt"
+	"slices"
 	"strconv"
 	"strings"
 
@@ -21,6 +22,7 @@ import (
 type VCPUPool interface {
 	FitCores() (tune *api.CPUTune, err error)
 	FitThread() (thread uint32, err error)
+	FitThreadOnCells(cellIDs []uint32) (thread uint32, err error)
 }
 
 func CalculateRequestedVCPUs(cpuTopology *api.CPUTopology) uint32 {
@@ -28,6 +30,7 @@ func CalculateRequestedVCPUs(cpuTopology *api.CPUTopology) uint32 {
 }
 
 type cell struct {
+	id                  uint32
 	fullCoresList       [][]uint32
 	fragmentedCoresList []uint32
 	threadsPerCore      int
@@ -124,8 +127,8 @@ func newCPUPool(requestedToplogy *api.CPUTopology, nodeTopology *v1.Topology, cp
 	pool := &cpuPool{threadsPerCore: int(requestedToplogy.Threads), cores: int(requestedToplogy.Cores * requestedToplogy.Sockets), allowCellCrossing: allowCellCrossing, availableThreads: len(cpuSet)}
 	cores := cpuChunksToCells(cpuSet, nodeTopology)
 
-	for _, coresOnCell := range cores {
-		c := cell{threadsPerCore: int(requestedToplogy.Threads)}
+	for i, coresOnCell := range cores {
+		c := cell{id: nodeTopology.NumaCells[i].Id, threadsPerCore: int(requestedToplogy.Threads)}
 		for j, core := range coresOnCell {
 			if len(core) >= c.threadsPerCore {
 				c.fullCoresList = append(c.fullCoresList, coresOnCell[j])
@@ -225,6 +228,17 @@ func (p *cpuPool) FitThread() (thread uint32, err error) {
 	return *t, nil
 }
 
+// FitThreadOnCells allocates a single thread from one of the given host numa cells
+func (p *cpuPool) FitThreadOnCells(cellIDs []uint32) (thread uint32, err error) {
+	for _, cell := range p.cells {
+		if cell.IsEmpty() || !slices.Contains(cellIDs, cell.id) {
+			continue
+		}
+		return *cell.GetThread(), nil
+	}
+	return 0, fmt.Errorf("no remaining unassigned threads on numa cells %v", cellIDs)
+}
+
 func fitChunk(cells []*cell, requested int, allocator func(cells []*cell, idx int) []uint32) (threads []uint32, remainingCores int) {
 	for idx := range cells {
 		for {
@@ -420,6 +434,64 @@ func FormatDomainIOThreadPin(vmi *v12.VirtualMachineInstance, domain *api.Domain
 	return nil
 }
 
+// cellBoundPool restricts the allocation of individual threads to the host numa cells the vCPUs are pinned to
+type cellBoundPool struct {
+	VCPUPool
+	cellIDs []uint32
+}
+
+func (p *cellBoundPool) FitThread() (thread uint32, err error) {
+	return p.FitThreadOnCells(p.cellIDs)
+}
+
+func newCellBoundPool(cpuPool VCPUPool, topology *v1.Topology, cpuTune *api.CPUTune) (VCPUPool, error) {
+	cells, err := involvedCells(cpuToCell(topology), cpuTune)
+	if err != nil {
+		return nil, err
+	}
+	cellIDs := make([]uint32, 0, len(cells))
+	for id := range cells {
+		cellIDs = append(cellIDs, id)
+	}
+	slices.Sort(cellIDs)
+	return &cellBoundPool{VCPUPool: cpuPool, cellIDs: cellIDs}, nil
+}
+
+// threadPlacementPool returns the pool the threads with the given placement policy are allocated from
+func threadPlacementPool(cpuPool VCPUPool, policy v12.ThreadPlacementPolicy, topology *v1.Topology, cpuTune *api.CPUTune) (VCPUPool, error) {
+	if policy != v12.ThreadPlacementSameNUMANode {
+		return cpuPool, nil
+	}
+	return newCellBoundPool(cpuPool, topology, cpuTune)
+}
+
+func emulatorThreadPlacement(vmi *v12.VirtualMachineInstance) v12.ThreadPlacementPolicy {
+	if vmi.Spec.Domain.CPU.ThreadPlacement == nil {
+		return ""
+	}
+	return vmi.Spec.Domain.CPU.ThreadPlacement.EmulatorThread
+}
+
+func ioThreadsPlacement(vmi *v12.VirtualMachineInstance) v12.ThreadPlacementPolicy {
+	if vmi.Spec.Domain.CPU.ThreadPlacement == nil {
+		return ""
+	}
+	return vmi.Spec.Domain.CPU.ThreadPlacement.IOThreads
+}
+
+// formatDedicatedIOThreadPin pins all IOThreads together on an additional pCPU
+func formatDedicatedIOThreadPin(cpuPool VCPUPool, domain *api.Domain) error {
+	thread, err := cpuPool.FitThread()
+	if err != nil {
+		return fmt.Errorf("no CPU allocated for the IOThreads: %v", err)
+	}
+	cpuSet := strconv.Itoa(int(thread))
+	for i := 1; i <= int(domain.Spec.IOThreads.IOThreads); i++ {
+		appendDomainIOThreadPin(domain, uint32(i), cpuSet)
+	}
+	return nil
+}
+
 func FormatEmulatorThreadPin(cpuPool VCPUPool, vmiAnnotations map[string]string, vCPUs int64) (string, error) {
 	var emulatorThreads []uint32
 
@@ -493,14 +565,29 @@ func AdjustDomainForTopologyAndCPUSet(domain *api.Domain, vmi *v12.VirtualMachin
 	var emulatorThreadsCPUSet string
 	if vmi.Spec.Domain.CPU.IsolateEmulatorThread {
 		vCPUs := hardware.GetNumberOfVCPUs(vmi.Spec.Domain.CPU)
-		if emulatorThreadsCPUSet, err = FormatEmulatorThreadPin(cpuPool, vmi.Annotations, vCPUs); err != nil {
+		emulatorPool, err := threadPlacementPool(cpuPool, emulatorThreadPlacement(vmi), topology, cpuTune)
+		if err != nil {
+			log.Log.Reason(err).Error("failed to determine the numa cells of the vCPUs")
+			return err
+		}
+		if emulatorThreadsCPUSet, err = FormatEmulatorThreadPin(emulatorPool, vmi.Annotations, vCPUs); err != nil {
 			log.Log.Reason(err).Error("failed to format emulation thread pin")
 			return err
 		}
 		appendDomainEmulatorThreadPin(domain, emulatorThreadsCPUSet)
 	}
 	if useIOThreads {
-		if err := FormatDomainIOThreadPin(vmi, domain, emulatorThreadsCPUSet, cpuset); err != nil {
+		if policy := ioThreadsPlacement(vmi); policy == v12.ThreadPlacementDedicated || policy == v12.ThreadPlacementSameNUMANode {
+			ioThreadsPool, err := threadPlacementPool(cpuPool, policy, topology, cpuTune)
+			if err != nil {
+				log.Log.Reason(err).Error("failed to determine the numa cells of the vCPUs")
+				return err
+			}
+			if err := formatDedicatedIOThreadPin(ioThreadsPool, domain); err != nil {
+				log.Log.Reason(err).Error("failed to format domain iothread pinning.")
+				return err
+			}
+		} else if err := FormatDomainIOThreadPin(vmi, domain, emulatorThreadsCPUSet, cpuset); err != nil {
 			log.Log.Reason(err).Error("failed to format domain iothread pinning.")
 			return err
 		}
@@ -529,6 +616,113 @@ func AdjustDomainForTopologyAndCPUSet(domain *api.Domain, vmi *v12.VirtualMachin
 	return nil
 }
 
+// AdjustDomainForHotpluggedVCPUs keeps the pinning of the vCPUs which are already pinned and pins the hotplugged vCPUs
+// to the pCPUs of the pod which are still unassigned. The pCPUs are taken from the host numa cells of the already
+// pinned vCPUs first, other cells are only used if these are exhausted.
+func AdjustDomainForHotpluggedVCPUs(domain *api.Domain, vmi *v12.VirtualMachineInstance, topology *v1.Topology, cpuset []int, useIOThreads bool) error {
+	if domain.Spec.CPUTune == nil || len(domain.Spec.CPUTune.VCPUPin) == 0 {
+		return AdjustDomainForTopologyAndCPUSet(domain, vmi, topology, cpuset, useIOThreads)
+	}
+
+	requestedTopology := GetCPUTopology(vmi)
+	vcpus := CalculateRequestedVCPUs(requestedTopology)
+
+	pinned := map[uint32]struct{}{}
+	var keptPins []api.CPUTuneVCPUPin
+	for _, pin := range domain.Spec.CPUTune.VCPUPin {
+		if pin.VCPU < vcpus {
+			keptPins = append(keptPins, pin)
+			pinned[pin.VCPU] = struct{}{}
+		}
+	}
+	var unpinned []uint32
+	for vcpu := uint32(0); vcpu < vcpus; vcpu++ {
+		if _, exists := pinned[vcpu]; !exists {
+			unpinned = append(unpinned, vcpu)
+		}
+	}
+	domain.Spec.CPUTune.VCPUPin = keptPins
+	if len(unpinned) == 0 {
+		return nil
+	}
+
+	freeCPUs, err := unassignedCPUs(domain.Spec.CPUTune, cpuset)
+	if err != nil {
+		return err
+	}
+
+	cells, err := involvedCells(cpuToCell(topology), domain.Spec.CPUTune)
+	if err != nil {
+		return err
+	}
+	var preferredCells, otherCells []*v1.Cell
+	for _, cell := range topology.NumaCells {
+		if _, exists := cells[cell.Id]; exists {
+			preferredCells = append(preferredCells, cell)
+		} else {
+			otherCells = append(otherCells, cell)
+		}
+	}
+
+	threads := requestedTopology.Threads
+	if uint32(len(unpinned))%threads != 0 {
+		threads = 1
+	}
+	hotpluggedTopology := &api.CPUTopology{Sockets: 1, Cores: uint32(len(unpinned)) / threads, Threads: threads}
+	newPool := NewRelaxedCPUPool
+	if isNumaPassthrough(vmi) {
+		newPool = NewStrictCPUPool
+	}
+
+	cpuTune, err := newPool(hotpluggedTopology, &v1.Topology{NumaCells: preferredCells}, freeCPUs).FitCores()
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Infof("hotplugged vCPUs do not fit on the numa cells of the pinned vCPUs, using other cells as well")
+		orderedCells := append(append([]*v1.Cell{}, preferredCells...), otherCells...)
+		cpuTune, err = newPool(hotpluggedTopology, &v1.Topology{NumaCells: orderedCells}, freeCPUs).FitCores()
+		if err != nil {
+			log.Log.Reason(err).Error("failed to pin the hotplugged vCPUs.")
+			return err
+		}
+	}
+	for idx, pin := range cpuTune.VCPUPin {
+		pin.VCPU = unpinned[idx]
+		domain.Spec.CPUTune.VCPUPin = append(domain.Spec.CPUTune.VCPUPin, pin)
+	}
+	return nil
+}
+
+// unassignedCPUs returns the pCPUs of the cpuset no vCPU, emulator thread or IOThread is pinned to
+func unassignedCPUs(cpuTune *api.CPUTune, cpuset []int) ([]int, error) {
+	assigned := map[int]struct{}{}
+	cpuSets := []string{}
+	for _, pin := range cpuTune.VCPUPin {
+		cpuSets = append(cpuSets, pin.CPUSet)
+	}
+	for _, pin := range cpuTune.IOThreadPin {
+		cpuSets = append(cpuSets, pin.CPUSet)
+	}
+	if cpuTune.EmulatorPin != nil {
+		cpuSets = append(cpuSets, cpuTune.EmulatorPin.CPUSet)
+	}
+	for _, cpuSet := range cpuSets {
+		cpus, err := hardware.ParseCPUSetLine(cpuSet, len(cpuset)+1)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse pinned cpuset %q: %v", cpuSet, err)
+		}
+		for _, cpu := range cpus {
+			assigned[cpu] = struct{}{}
+		}
+	}
+
+	var free []int
+	for _, cpu := range cpuset {
+		if _, exists := assigned[cpu]; !exists {
+			free = append(free, cpu)
+		}
+	}
+	return free, nil
+}
+
 func convertCPUListToCPUSet(allocatedCPUs []uint32) string {
 	const delimiter = ","
 	var allocatedCPUsString []string
@@ -12,6 +12,7 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
+	v12 "kubevirt.io/api/core/v1"
 	"kubevirt.io/client-go/log"
 
 	v1 "kubevirt.io/kubevirt/pkg/handler-launcher-com/cmd/v1"
@@ -176,6 +177,115 @@ var _ = Describe("VCPU pinning", func() {
 			[]uint32{7, 6, 2, 8, 3, 9, 4, 10, 5, 11},
 		),
 	)
+
+	Context("with thread placement", func() {
+		var (
+			pool     VCPUPool
+			topology *v1.Topology
+			cpuTune  *api.CPUTune
+		)
+
+		BeforeEach(func() {
+			var err error
+			topology = hostTopology(2, 1, 0, 1, 2, 3, 4, 5, 6, 7)
+			pool = NewRelaxedCPUPool(&api.CPUTopology{Sockets: 1, Cores: 2, Threads: 1}, topology, []int{0, 1, 2, 4})
+			cpuTune, err = pool.FitCores()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(cpuTuneToThreads(cpuTune)).To(Equal([]int{0, 1}))
+		})
+
+		It("should pick individual threads on the given numa cells", func() {
+			Expect(pool.FitThreadOnCells([]uint32{1})).To(Equal(uint32(4)))
+			Expect(pool.FitThreadOnCells([]uint32{1})).Error().To(MatchError("no remaining unassigned threads on numa cells [1]"))
+			Expect(pool.FitThreadOnCells([]uint32{0, 1})).To(Equal(uint32(2)))
+		})
+
+		It("should pick threads on the numa cells of the vCPUs with sameNUMANode", func() {
+			placementPool, err := threadPlacementPool(pool, v12.ThreadPlacementSameNUMANode, topology, cpuTune)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(placementPool.FitThread()).To(Equal(uint32(2)))
+			Expect(placementPool.FitThread()).Error().To(HaveOccurred())
+		})
+
+		It("should pick threads on any numa cell with dedicated", func() {
+			Expect(pool.FitThreadOnCells([]uint32{0})).To(Equal(uint32(2)))
+			placementPool, err := threadPlacementPool(pool, v12.ThreadPlacementDedicated, topology, cpuTune)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(placementPool.FitThread()).To(Equal(uint32(4)))
+		})
+
+		It("should pin all IOThreads together on a dedicated thread", func() {
+			domain := &api.Domain{Spec: api.DomainSpec{
+				CPUTune:   cpuTune,
+				IOThreads: &api.IOThreads{IOThreads: 2},
+			}}
+			Expect(formatDedicatedIOThreadPin(pool, domain)).To(Succeed())
+			Expect(domain.Spec.CPUTune.IOThreadPin).To(Equal([]api.CPUTuneIOThreadPin{
+				{IOThread: 1, CPUSet: "2"},
+				{IOThread: 2, CPUSet: "2"},
+			}))
+		})
+	})
+
+	Context("with hotplugged vCPUs", func() {
+		var (
+			topology *v1.Topology
+			vmi      *v12.VirtualMachineInstance
+		)
+
+		pinsOf := func(cpuSets ...string) *api.CPUTune {
+			cpuTune := &api.CPUTune{}
+			for idx, cpuSet := range cpuSets {
+				cpuTune.VCPUPin = append(cpuTune.VCPUPin, api.CPUTuneVCPUPin{VCPU: uint32(idx), CPUSet: cpuSet})
+			}
+			return cpuTune
+		}
+
+		BeforeEach(func() {
+			topology = hostTopology(2, 1, 0, 1, 2, 3, 4, 5, 6, 7)
+			vmi = &v12.VirtualMachineInstance{Spec: v12.VirtualMachineInstanceSpec{Domain: v12.DomainSpec{
+				CPU: &v12.CPU{Sockets: 2, Cores: 1, Threads: 1, MaxSockets: 4, DedicatedCPUPlacement: true},
+			}}}
+		})
+
+		It("should pin them on the numa cell of the pinned vCPUs", func() {
+			domain := &api.Domain{Spec: api.DomainSpec{CPUTune: pinsOf("4", "5")}}
+			Expect(AdjustDomainForHotpluggedVCPUs(domain, vmi, topology, []int{0, 1, 4, 5, 6, 7}, false)).To(Succeed())
+			Expect(cpuTuneToThreads(domain.Spec.CPUTune)).To(Equal([]int{4, 5}))
+
+			vmi.Spec.Domain.CPU.Sockets = 4
+			Expect(AdjustDomainForHotpluggedVCPUs(domain, vmi, topology, []int{0, 1, 4, 5, 6, 7}, false)).To(Succeed())
+			Expect(cpuTuneToThreads(domain.Spec.CPUTune)).To(Equal([]int{4, 5, 6, 7}))
+		})
+
+		It("should skip the pCPUs of the emulator thread", func() {
+			cpuTune := pinsOf("4", "5")
+			cpuTune.EmulatorPin = &api.CPUEmulatorPin{CPUSet: "6"}
+			domain := &api.Domain{Spec: api.DomainSpec{CPUTune: cpuTune}}
+			vmi.Spec.Domain.CPU.Sockets = 3
+			Expect(AdjustDomainForHotpluggedVCPUs(domain, vmi, topology, []int{0, 4, 5, 6, 7}, false)).To(Succeed())
+			Expect(cpuTuneToThreads(domain.Spec.CPUTune)).To(Equal([]int{4, 5, 7}))
+		})
+
+		It("should use other numa cells when the cell of the pinned vCPUs is exhausted", func() {
+			domain := &api.Domain{Spec: api.DomainSpec{CPUTune: pinsOf("4", "5")}}
+			vmi.Spec.Domain.CPU.Sockets = 4
+			Expect(AdjustDomainForHotpluggedVCPUs(domain, vmi, topology, []int{0, 1, 4, 5, 6}, false)).To(Succeed())
+			Expect(cpuTuneToThreads(domain.Spec.CPUTune)).To(Equal([]int{4, 5, 6, 0}))
+		})
+
+		It("should drop the pinning of unplugged vCPUs", func() {
+			domain := &api.Domain{Spec: api.DomainSpec{CPUTune: pinsOf("4", "5", "6")}}
+			Expect(AdjustDomainForHotpluggedVCPUs(domain, vmi, topology, []int{4, 5, 6, 7}, false)).To(Succeed())
+			Expect(cpuTuneToThreads(domain.Spec.CPUTune)).To(Equal([]int{4, 5}))
+		})
+
+		It("should fail when there are not enough pCPUs left", func() {
+			domain := &api.Domain{Spec: api.DomainSpec{CPUTune: pinsOf("4", "5")}}
+			vmi.Spec.Domain.CPU.Sockets = 4
+			Expect(AdjustDomainForHotpluggedVCPUs(domain, vmi, topology, []int{4, 5, 6}, false)).ToNot(Succeed())
+		})
+	})
 })
 
 func shuffleCPUSet(cpuSet ...int) []int {
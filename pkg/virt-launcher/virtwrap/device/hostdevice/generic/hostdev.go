@@ -70,6 +70,15 @@ func CreateHostDevicesFromPools(vmiHostDevices []v1.HostDevice, pciAddressPool,
 	return hostDevices, nil
 }
 
+// GetHostDevicesToAttach returns the generic host-devices of the VMI which are not attached to the domain.
+func GetHostDevicesToAttach(vmi *v1.VirtualMachineInstance, domainSpec *api.DomainSpec) ([]api.HostDevice, error) {
+	genericDevices, err := CreateHostDevices(vmi.Spec.Domain.Devices.HostDevices)
+	if err != nil {
+		return nil, err
+	}
+	return hostdevice.DifferenceHostDevicesByAlias(genericDevices, domainSpec.Devices.HostDevices), nil
+}
+
 func createHostDevicesMetadata(vmiHostDevices []v1.HostDevice) []hostdevice.HostDeviceMetaData {
 	var hostDevicesMetaData []hostdevice.HostDeviceMetaData
 	for _, dev := range vmiHostDevices {
@@ -61,6 +61,24 @@ func CreateHostDevicesFromPools(vmiGPUs []v1.GPU, pciAddressPool, mdevAddressPoo
 	return hostDevices, nil
 }
 
+// GetHostDevicesToAttach returns the GPU host-devices of the VMI which are not attached to the domain.
+// The display of a vGPU can only be set up at boot, it is turned off on the hotplugged ones.
+func GetHostDevicesToAttach(vmi *v1.VirtualMachineInstance, domainSpec *api.DomainSpec) ([]api.HostDevice, error) {
+	gpuDevices, err := CreateHostDevices(vmi.Spec.Domain.Devices.GPUs)
+	if err != nil {
+		return nil, err
+	}
+	currentAttachedGPUHostDevices := hostdevice.FilterHostDevicesByAlias(domainSpec.Devices.HostDevices, AliasPrefix)
+
+	gpuHostDevicesToAttach := hostdevice.DifferenceHostDevicesByAlias(gpuDevices, currentAttachedGPUHostDevices)
+	for i := range gpuHostDevicesToAttach {
+		gpuHostDevicesToAttach[i].Display = ""
+		gpuHostDevicesToAttach[i].RamFB = ""
+	}
+
+	return gpuHostDevicesToAttach, nil
+}
+
 func createHostDevicesMetadata(vmiGPUs []v1.GPU) []hostdevice.HostDeviceMetaData {
 	var hostDevicesMetaData []hostdevice.HostDeviceMetaData
 	for _, dev := range vmiGPUs {
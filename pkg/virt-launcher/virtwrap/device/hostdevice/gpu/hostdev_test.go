@@ -186,6 +186,29 @@ var _ = Describe("GPU HostDevice", func() {
 		Expect(gpu.CreateHostDevicesFromPools(vmi.Spec.Domain.Devices.GPUs, pciPool, mdevPool)).
 			To(Equal([]api.HostDevice{expectHostDevice1}))
 	})
+	It("returns the GPUs which are not attached to the domain, without display", func() {
+		vmi.Spec.Domain.Devices.GPUs = []v1.GPU{
+			{DeviceName: gpuResource0, Name: gpuName0},
+			{DeviceName: gpuResource1, Name: gpuName1},
+		}
+		domainSpec := &api.DomainSpec{}
+		domainSpec.Devices.HostDevices = []api.HostDevice{{Alias: api.NewUserDefinedAlias(gpu.AliasPrefix + gpuName0)}}
+
+		env := []envData{
+			newResourceEnv(v1.PCIResourcePrefix, envGPUResource0, gpuPCIAddress0),
+			newResourceEnv(v1.MDevResourcePrefix, envGPUResource1, gpuMDEVAddress1),
+		}
+		withEnvironmentContext(env, func() {
+			hostMDEVAddress := api.Address{UUID: gpuMDEVAddress1}
+			Expect(gpu.GetHostDevicesToAttach(vmi, domainSpec)).To(Equal([]api.HostDevice{{
+				Alias:  api.NewUserDefinedAlias(gpu.AliasPrefix + gpuName1),
+				Source: api.HostDeviceSource{Address: &hostMDEVAddress},
+				Type:   api.HostDeviceMDev,
+				Mode:   "subsystem",
+				Model:  "vfio-pci",
+			}}))
+		})
+	})
 })
 
 type stubAddressPool struct {
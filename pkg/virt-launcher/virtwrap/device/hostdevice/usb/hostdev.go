@@ -0,0 +1,90 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package usb
+
+import (
+	"strconv"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/device/hostdevice"
+)
+
+const AliasPrefix = "usbdevice-"
+
+// CreateHostDevices creates the host-devices of the USB devices which virt-handler allocated to the VMI.
+// virt-handler reports the allocated devices in the status of the VMI it sends.
+func CreateHostDevices(vmi *v1.VirtualMachineInstance) []api.HostDevice {
+	var hostDevices []api.HostDevice
+	for _, usbDevice := range vmi.Status.USBDevices {
+		if usbDevice.Phase == v1.USBDevicePending || usbDevice.Bus == 0 {
+			continue
+		}
+		hostDevices = append(hostDevices, api.HostDevice{
+			Type:  api.HostDeviceUSB,
+			Mode:  "subsystem",
+			Alias: api.NewUserDefinedAlias(AliasPrefix + usbDevice.Name),
+			Source: api.HostDeviceSource{
+				Address: &api.Address{
+					Bus:    strconv.Itoa(usbDevice.Bus),
+					Device: strconv.Itoa(usbDevice.DeviceNumber),
+				},
+			},
+		})
+	}
+	return hostDevices
+}
+
+// GetHostDevicesToAttach returns the USB host-devices allocated to the VMI which are not attached to the domain.
+func GetHostDevicesToAttach(vmi *v1.VirtualMachineInstance, domainSpec *api.DomainSpec) []api.HostDevice {
+	return difference(CreateHostDevices(vmi), hostdevice.FilterHostDevicesByAlias(domainSpec.Devices.HostDevices, AliasPrefix))
+}
+
+// GetHostDevicesToDetach returns the USB host-devices attached to the domain which are not allocated to the VMI anymore,
+// either because they were unplugged from the node or removed from the VMI.
+func GetHostDevicesToDetach(vmi *v1.VirtualMachineInstance, domainSpec *api.DomainSpec) []api.HostDevice {
+	return difference(hostdevice.FilterHostDevicesByAlias(domainSpec.Devices.HostDevices, AliasPrefix), CreateHostDevices(vmi))
+}
+
+// difference returns the host-devices of the first slice which are not in the second one. A replugged device gets
+// a new address on the bus, so both the alias and the source address have to match.
+func difference(hostDevices, otherHostDevices []api.HostDevice) []api.HostDevice {
+	type key struct{ alias, bus, device string }
+	keyOf := func(hostDevice api.HostDevice) key {
+		k := key{alias: hostDevice.Alias.GetName()}
+		if hostDevice.Source.Address != nil {
+			k.bus, k.device = hostDevice.Source.Address.Bus, hostDevice.Source.Address.Device
+		}
+		return k
+	}
+
+	otherKeys := make(map[key]struct{}, len(otherHostDevices))
+	for _, hostDevice := range otherHostDevices {
+		otherKeys[keyOf(hostDevice)] = struct{}{}
+	}
+	var diff []api.HostDevice
+	for _, hostDevice := range hostDevices {
+		if _, exists := otherKeys[keyOf(hostDevice)]; !exists {
+			diff = append(diff, hostDevice)
+		}
+	}
+	return diff
+}
@@ -0,0 +1,115 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package usb_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/device/hostdevice/usb"
+)
+
+var _ = Describe("USB HostDevice", func() {
+	const (
+		usbName0 = "token"
+		usbName1 = "smartcard"
+	)
+
+	newHostDevice := func(name, bus, device string) api.HostDevice {
+		return api.HostDevice{
+			Type:   api.HostDeviceUSB,
+			Mode:   "subsystem",
+			Alias:  api.NewUserDefinedAlias(usb.AliasPrefix + name),
+			Source: api.HostDeviceSource{Address: &api.Address{Bus: bus, Device: device}},
+		}
+	}
+
+	var vmi *v1.VirtualMachineInstance
+
+	BeforeEach(func() {
+		vmi = &v1.VirtualMachineInstance{}
+	})
+
+	It("creates no device given no USB device allocated", func() {
+		Expect(usb.CreateHostDevices(vmi)).To(BeEmpty())
+	})
+
+	It("creates the devices allocated to the VMI", func() {
+		vmi.Status.USBDevices = []v1.USBDeviceStatus{
+			{Name: usbName0, Phase: v1.USBDeviceAllocated, BusPort: "1-2", Bus: 1, DeviceNumber: 4},
+			{Name: usbName1, Phase: v1.USBDevicePending},
+		}
+		Expect(usb.CreateHostDevices(vmi)).To(Equal([]api.HostDevice{newHostDevice(usbName0, "1", "4")}))
+	})
+
+	Context("hot-attach", func() {
+		var domainSpec *api.DomainSpec
+
+		BeforeEach(func() {
+			domainSpec = &api.DomainSpec{}
+			vmi.Status.USBDevices = []v1.USBDeviceStatus{
+				{Name: usbName0, Phase: v1.USBDeviceAttached, BusPort: "1-2", Bus: 1, DeviceNumber: 4},
+				{Name: usbName1, Phase: v1.USBDeviceAllocated, BusPort: "2-1", Bus: 2, DeviceNumber: 3},
+			}
+		})
+
+		It("attaches the devices missing from the domain", func() {
+			domainSpec.Devices.HostDevices = []api.HostDevice{newHostDevice(usbName0, "1", "4")}
+
+			Expect(usb.GetHostDevicesToAttach(vmi, domainSpec)).To(Equal([]api.HostDevice{newHostDevice(usbName1, "2", "3")}))
+			Expect(usb.GetHostDevicesToDetach(vmi, domainSpec)).To(BeEmpty())
+		})
+
+		It("detaches the devices which are not allocated anymore", func() {
+			vmi.Status.USBDevices = vmi.Status.USBDevices[:1]
+			domainSpec.Devices.HostDevices = []api.HostDevice{
+				newHostDevice(usbName0, "1", "4"),
+				newHostDevice(usbName1, "2", "3"),
+			}
+
+			Expect(usb.GetHostDevicesToAttach(vmi, domainSpec)).To(BeEmpty())
+			Expect(usb.GetHostDevicesToDetach(vmi, domainSpec)).To(Equal([]api.HostDevice{newHostDevice(usbName1, "2", "3")}))
+		})
+
+		It("reattaches a device which was replugged", func() {
+			domainSpec.Devices.HostDevices = []api.HostDevice{
+				newHostDevice(usbName0, "1", "2"),
+				newHostDevice(usbName1, "2", "3"),
+			}
+
+			Expect(usb.GetHostDevicesToDetach(vmi, domainSpec)).To(Equal([]api.HostDevice{newHostDevice(usbName0, "1", "2")}))
+			Expect(usb.GetHostDevicesToAttach(vmi, domainSpec)).To(Equal([]api.HostDevice{newHostDevice(usbName0, "1", "4")}))
+		})
+
+		It("ignores the other host devices of the domain", func() {
+			domainSpec.Devices.HostDevices = []api.HostDevice{
+				newHostDevice(usbName0, "1", "4"),
+				newHostDevice(usbName1, "2", "3"),
+				{Type: api.HostDeviceUSB, Alias: api.NewUserDefinedAlias("usb-host-other")},
+			}
+
+			Expect(usb.GetHostDevicesToAttach(vmi, domainSpec)).To(BeEmpty())
+			Expect(usb.GetHostDevicesToDetach(vmi, domainSpec)).To(BeEmpty())
+		})
+	})
+})
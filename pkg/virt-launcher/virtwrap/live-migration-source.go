@@ -66,6 +66,10 @@ const (
 	monitorLogInterval   = monitorLogPeriodMS / monitorSleepPeriodMS
 )
 
+const postCopyRecoveryAttempts = 3
+
+var postCopyRecoveryBackoff = 5 * time.Second
+
 type migrationDisks struct {
 	shared         map[string]bool
 	generated      map[string]bool
@@ -87,6 +91,7 @@ type migrationMonitor struct {
 	progressTimeout          int64
 	acceptableCompletionTime int64
 	migrationFailedWithError error
+	maxDowntimeSet           bool
 }
 
 type inflightMigrationAborted struct {
@@ -115,6 +120,9 @@ func generateMigrationFlags(isBlockMigration, migratePaused bool, options *cmdcl
 	if shouldConfigureParallel, _ := shouldConfigureParallelMigration(options); shouldConfigureParallel {
 		migrateFlags |= libvirt.MIGRATE_PARALLEL
 	}
+	if shouldConfigureMigrationCompression(options) {
+		migrateFlags |= libvirt.MIGRATE_COMPRESSED
+	}
 
 	return migrateFlags
 
@@ -318,18 +326,51 @@ func (l *LibvirtDomainManager) startMigration(vmi *v1.VirtualMachineInstance, op
 		return fmt.Errorf("cannot migration VMI until migrationState is ready")
 	}
 
+	bandwidth, err := vcpu.QuantityToMebiByte(options.Bandwidth)
+	if err != nil {
+		return err
+	}
+
 	inProgress, err := l.initializeMigrationMetadata(vmi, v1.MigrationPreCopy)
 	if err != nil {
 		return err
 	}
 	if inProgress {
-		return nil
+		return l.setMigrationBandwidth(vmi, bandwidth)
 	}
+	l.metadataCache.Migration.WithSafeBlock(func(migrationMetadata *api.MigrationMetadata, _ bool) {
+		migrationMetadata.Bandwidth = bandwidth
+	})
 
 	go l.migrate(vmi, options)
 	return nil
 }
 
+// setMigrationBandwidth applies a new bandwidth to the running migration, virt-controller re-tunes it to the
+// network usage of the node while the migration runs
+func (l *LibvirtDomainManager) setMigrationBandwidth(vmi *v1.VirtualMachineInstance, bandwidth uint64) error {
+	migrationMetadata, _ := l.metadataCache.Migration.Load()
+	if bandwidth == 0 || bandwidth == migrationMetadata.Bandwidth {
+		return nil
+	}
+
+	domName := api.VMINamespaceKeyFunc(vmi)
+	dom, err := l.virConn.LookupDomainByName(domName)
+	if err != nil {
+		return err
+	}
+	defer dom.Free()
+
+	if err := dom.MigrateSetMaxSpeed(bandwidth, 0); err != nil {
+		return err
+	}
+	l.metadataCache.Migration.WithSafeBlock(func(migrationMetadata *api.MigrationMetadata, _ bool) {
+		migrationMetadata.Bandwidth = bandwidth
+	})
+	log.Log.Object(vmi).Infof("Changed the migration bandwidth from %d to %d MiB/s", migrationMetadata.Bandwidth, bandwidth)
+	return nil
+}
+
 func (l *LibvirtDomainManager) initializeMigrationMetadata(vmi *v1.VirtualMachineInstance, migrationMode v1.MigrationMode) (bool, error) {
 	migrationMetadata, exists := l.metadataCache.Migration.Load()
 	if exists && migrationMetadata.UID == vmi.Status.MigrationState.MigrationUID {
@@ -418,6 +459,28 @@ func (l *LibvirtDomainManager) setMigrationAbortStatus(abortStatus v1.MigrationA
 	return l.setMigrationResultHelper(false, false, "", abortStatus)
 }
 
+// setMigrationProgress records the data transfer statistics of the running migration in the metadata
+// so that they are reported back on the VMI migration state
+func (l *LibvirtDomainManager) setMigrationProgress(stats *libvirt.DomainJobInfo) {
+	l.metadataCache.Migration.WithSafeBlock(func(migrationMetadata *api.MigrationMetadata, initialized bool) {
+		if !initialized {
+			return
+		}
+		if stats.DataProcessedSet {
+			migrationMetadata.DataProcessed = stats.DataProcessed
+		}
+		if stats.DataRemainingSet {
+			migrationMetadata.DataRemaining = stats.DataRemaining
+		}
+		if stats.DataTotalSet {
+			migrationMetadata.DataTotal = stats.DataTotal
+		}
+		if stats.DowntimeSet {
+			migrationMetadata.Downtime = stats.Downtime
+		}
+	})
+}
+
 func newMigrationMonitor(vmi *v1.VirtualMachineInstance, l *LibvirtDomainManager, options *cmdclient.MigrationOptions, migrationErr chan error) *migrationMonitor {
 	monitor := &migrationMonitor{
 		l:                        l,
@@ -455,6 +518,35 @@ func (m *migrationMonitor) shouldAssistMigrationToComplete(elapsed int64) bool {
 	return m.shouldTriggerTimeout(elapsed) && m.options.AllowWorkloadDisruption
 }
 
+// shouldSwitchToPostCopy returns true once the guest memory has been copied as many times as configured by
+// PostCopyAfterIterations without the migration completing
+func (m *migrationMonitor) shouldSwitchToPostCopy(stats *libvirt.DomainJobInfo) bool {
+	return m.options.AllowPostCopy && m.options.AllowWorkloadDisruption &&
+		m.options.PostCopyAfterIterations > 0 &&
+		stats.MemIterationSet && stats.MemIteration >= uint64(m.options.PostCopyAfterIterations)
+}
+
+func (m *migrationMonitor) startPostCopy(dom cli.VirDomain) {
+	if err := dom.MigrateStartPostCopy(0); err != nil {
+		log.Log.Object(m.vmi).Reason(err).Error("failed to start post migration")
+		return
+	}
+	m.l.updateVMIMigrationMode(v1.MigrationPostCopy)
+}
+
+// setMaxDowntime applies the configured maximum downtime once the migration job is running
+func (m *migrationMonitor) setMaxDowntime(dom cli.VirDomain) {
+	if m.maxDowntimeSet || m.options.MaxDowntimeMilliseconds <= 0 {
+		return
+	}
+	if err := dom.MigrateSetMaxDowntime(uint64(m.options.MaxDowntimeMilliseconds), 0); err != nil {
+		log.Log.Object(m.vmi).Reason(err).Warningf(
+			"failed to set the maximum downtime of the migration to %dms", m.options.MaxDowntimeMilliseconds)
+		return
+	}
+	m.maxDowntimeSet = true
+}
+
 func (m *migrationMonitor) isMigrationProgressing() bool {
 	logger := log.Log.Object(m.vmi)
 
@@ -523,6 +615,7 @@ func (m *migrationMonitor) processInflightMigration(dom cli.VirDomain, stats *li
 		m.lastProgressUpdate = now
 	}
 	m.progressWatermark = m.remainingData
+	m.setMaxDowntime(dom)
 
 	switch {
 	case m.isMigrationPostCopy():
@@ -534,17 +627,16 @@ func (m *migrationMonitor) processInflightMigration(dom cli.VirDomain, stats *li
 		// If we were to abort the migration due to a timeout while in post copy,
 		// then it would result in that active state being lost.
 
+	case m.shouldSwitchToPostCopy(stats) && !m.isPausedMigration():
+		logger.Infof("Starting post copy mode for migration after %d iterations", stats.MemIteration)
+		m.startPostCopy(dom)
+
 	case m.shouldAssistMigrationToComplete(elapsed) && !m.isPausedMigration():
 		if m.options.AllowPostCopy {
 			logger.Info("Starting post copy mode for migration")
 			// if a migration has stalled too long, post copy will be
 			// triggered when allowPostCopy is enabled
-			err := dom.MigrateStartPostCopy(0)
-			if err != nil {
-				logger.Reason(err).Error("failed to start post migration")
-				return nil
-			}
-			m.l.updateVMIMigrationMode(v1.MigrationPostCopy)
+			m.startPostCopy(dom)
 		} else {
 
 			logger.Info("Pausing the guest to allow migration to complete")
@@ -675,14 +767,20 @@ func (m *migrationMonitor) startMonitor() {
 			logInterval++
 			if logInterval%monitorLogInterval == 0 {
 				logMigrationInfo(logger, string(vmi.Status.MigrationState.MigrationUID), stats)
+				m.l.setMigrationProgress(stats)
 			}
 		case libvirt.DOMAIN_JOB_NONE:
 			completedJobInfo = m.determineNonRunningMigrationStatus(dom)
 		case libvirt.DOMAIN_JOB_COMPLETED:
 			logger.Info("Migration has been completed")
+			m.l.setMigrationProgress(stats)
 			m.l.setMigrationResult(false, "", "")
 			return
 		case libvirt.DOMAIN_JOB_FAILED:
+			if m.isMigrationPostCopy() && m.migrationFailedWithError == nil {
+				// The migration call did not return yet, a failed post copy migration may still be recovered
+				continue
+			}
 			logger.Info("Migration job failed")
 			m.l.setMigrationResult(true, fmt.Sprintf("%v", m.migrationFailedWithError), "")
 			return
@@ -771,6 +869,10 @@ func generateMigrationParams(dom cli.VirDomain, vmi *v1.VirtualMachineInstance,
 		ParallelConnections:    parallelMigrationThreads,
 	}
 
+	if shouldConfigureMigrationCompression(options) {
+		configureMigrationCompression(params, options.Compression)
+	}
+
 	copyDisks := getDiskTargetsForMigration(dom, vmi)
 	if len(copyDisks) != 0 {
 		params.MigrateDisks = copyDisks
@@ -1004,6 +1106,9 @@ func (l *LibvirtDomainManager) migrateHelper(vmi *v1.VirtualMachineInstance, opt
 	}
 
 	err = dom.MigrateToURI3(dstURI, params, migrateFlags)
+	if err != nil && isPostCopyFailed(dom) {
+		err = l.recoverPostCopy(vmi, dom, dstURI, params, migrateFlags, err)
+	}
 	if err != nil {
 		return fmt.Errorf("error encountered during MigrateToURI3 libvirt api call: %v", err)
 	}
@@ -1011,6 +1116,47 @@ func (l *LibvirtDomainManager) migrateHelper(vmi *v1.VirtualMachineInstance, opt
 	return nil
 }
 
+// isPostCopyFailed returns true if the connection to the target broke while the migration was in post copy mode.
+// The guest state is split between the source and the target then, and the guest stays paused until the
+// migration is resumed.
+func isPostCopyFailed(dom cli.VirDomain) bool {
+	state, reason, err := dom.GetState()
+	if err != nil {
+		return false
+	}
+	return util.ConvState(state) == api.Paused && util.ConvReason(state, reason) == api.ReasonPausedPostcopyFailed
+}
+
+// recoverPostCopy resumes a failed post copy migration over a new connection to the target
+func (l *LibvirtDomainManager) recoverPostCopy(vmi *v1.VirtualMachineInstance, dom cli.VirDomain, dstURI string,
+	params *libvirt.DomainMigrateParameters, migrateFlags libvirt.DomainMigrateFlags, err error) error {
+	logger := log.Log.Object(vmi)
+
+	for attempt := 1; attempt <= postCopyRecoveryAttempts; attempt++ {
+		logger.Reason(err).Warningf("Post copy migration failed, recovery attempt %d of %d", attempt, postCopyRecoveryAttempts)
+		l.setPostCopyRecovery(attempt, false)
+		time.Sleep(postCopyRecoveryBackoff)
+
+		err = dom.MigrateToURI3(dstURI, params, migrateFlags|libvirt.MIGRATE_POSTCOPY_RESUME)
+		if err == nil {
+			logger.Infof("Post copy migration recovered after %d attempts", attempt)
+			l.setPostCopyRecovery(attempt, true)
+			return nil
+		}
+		if !isPostCopyFailed(dom) {
+			break
+		}
+	}
+	return err
+}
+
+func (l *LibvirtDomainManager) setPostCopyRecovery(attempts int, recovered bool) {
+	l.metadataCache.Migration.WithSafeBlock(func(migrationMetadata *api.MigrationMetadata, _ bool) {
+		migrationMetadata.PostCopyRecoveryAttempts = attempts
+		migrationMetadata.PostCopyRecovered = recovered
+	})
+}
+
 // prepareDomainForMigration perform necessary operation
 // on the source domain just before migration
 func prepareDomainForMigration(virtConn cli.Connection, domain cli.VirDomain) error {
@@ -1079,3 +1225,29 @@ func shouldConfigureParallelMigration(options *cmdclient.MigrationOptions) (shou
 	threadsCount = int(*options.ParallelMigrationThreads)
 	return
 }
+
+// shouldConfigureMigrationCompression returns true if the guest memory sent over the multifd channels should be
+// compressed. QEMU only supports zlib and zstd compression for multifd migrations.
+func shouldConfigureMigrationCompression(options *cmdclient.MigrationOptions) bool {
+	if options == nil || options.Compression == nil {
+		return false
+	}
+	shouldConfigureParallel, _ := shouldConfigureParallelMigration(options)
+	return shouldConfigureParallel
+}
+
+func configureMigrationCompression(params *libvirt.DomainMigrateParameters, compression *v1.MigrationCompression) {
+	params.CompressionSet = true
+	params.Compression = string(compression.Method)
+	if compression.Level == nil {
+		return
+	}
+	switch compression.Method {
+	case v1.MigrationCompressionZlib:
+		params.CompressionZlibLevelSet = true
+		params.CompressionZlibLevel = int(*compression.Level)
+	case v1.MigrationCompressionZstd:
+		params.CompressionZstdLevelSet = true
+		params.CompressionZstdLevel = int(*compression.Level)
+	}
+}
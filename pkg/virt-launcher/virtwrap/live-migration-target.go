@@ -53,6 +53,10 @@ func (l *LibvirtDomainManager) finalizeMigrationTarget(vmi *v1.VirtualMachineIns
 		return err
 	}
 
+	if options.GetClusterConfig().GetBlockLatencyHistogramsEnabled() {
+		l.blockLatencyHistograms.Store(true)
+	}
+
 	return nil
 }
 
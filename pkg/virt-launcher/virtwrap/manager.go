@@ -28,6 +28,7 @@ package virtwrap
 import (
 	"context"
 	"crypto/sha256"
+	"encoding/json"
 	"encoding/xml"
 	"errors"
 	"fmt"
@@ -39,6 +40,7 @@ import (
 	"strconv"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"syscall"
 	"time"
 
@@ -65,6 +67,7 @@ import (
 	"kubevirt.io/kubevirt/pkg/liveupdate/memory"
 	"kubevirt.io/kubevirt/pkg/network/cache"
 	netsriov "kubevirt.io/kubevirt/pkg/network/deviceinfo"
+	"kubevirt.io/kubevirt/pkg/network/downwardapi"
 	netsetup "kubevirt.io/kubevirt/pkg/network/setup"
 	netvmispec "kubevirt.io/kubevirt/pkg/network/vmispec"
 	osdisk "kubevirt.io/kubevirt/pkg/os/disk"
@@ -89,9 +92,11 @@ import (
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/device/hostdevice/generic"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/device/hostdevice/gpu"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/device/hostdevice/sriov"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/device/hostdevice/usb"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/efi"
 	domainerrors "kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/errors"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/stats"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/statsconv"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/util"
 	virtcache "kubevirt.io/kubevirt/tools/cache"
 )
@@ -118,7 +123,7 @@ type DomainManager interface {
 	SyncVMI(*v1.VirtualMachineInstance, bool, *cmdv1.VirtualMachineOptions) (*api.DomainSpec, error)
 	PauseVMI(*v1.VirtualMachineInstance) error
 	UnpauseVMI(*v1.VirtualMachineInstance) error
-	FreezeVMI(*v1.VirtualMachineInstance, int32) error
+	FreezeVMI(*v1.VirtualMachineInstance, int32, []string) error
 	UnfreezeVMI(*v1.VirtualMachineInstance) error
 	ResetVMI(*v1.VirtualMachineInstance) error
 	SoftRebootVMI(*v1.VirtualMachineInstance) error
@@ -179,6 +184,10 @@ type LibvirtDomainManager struct {
 
 	metadataCache    *metadata.Cache
 	domainStatsCache *virtcache.TimeDefinedCache[*stats.DomainStats]
+
+	// set if the latency histograms of the disks are reported
+	blockLatencyHistograms atomic.Bool
+	latencyHistograms      statsconv.LatencyHistograms
 }
 
 type pausedVMIs struct {
@@ -244,6 +253,13 @@ func newLibvirtDomainManager(connection cli.Connection, virtShareDir, ephemeralD
 			return nil, nil
 		}
 
+		if manager.agentData != nil {
+			if drift, measured := manager.agentData.GetGuestClockDrift(); measured {
+				list[0].GuestClockDriftSet = true
+				list[0].GuestClockDrift = drift.Seconds()
+			}
+		}
+
 		return list[0], nil
 	}
 
@@ -357,6 +373,10 @@ func (l *LibvirtDomainManager) setGuestTime(vmi *v1.VirtualMachineInstance) erro
 	// It is not guaranteed that the time is actually set (it depends on guest
 	// environment, especially QEMU agent presence) or that the set time is
 	// very precise (NTP in the guest should take care of it if needed).
+	if agentpoller.GuestTimeSyncPolicy(vmi) == v1.GuestTimeSyncDisabled {
+		log.Log.Object(vmi).V(3).Info("guest time sync is disabled")
+		return nil
+	}
 
 	domName := api.VMINamespaceKeyFunc(vmi)
 	dom, err := l.virConn.LookupDomainByName(domName)
@@ -482,7 +502,7 @@ func (l *LibvirtDomainManager) UpdateVCPUs(vmi *v1.VirtualMachineInstance, optio
 		return fmt.Errorf("%s: %v", errMsgPrefix, err)
 	}
 
-	// Adjust guest vcpu config. Currently will handle vCPUs to pCPUs pinning
+	// Adjust guest vcpu config. Hotplugged vCPUs are pinned next to the already pinned ones
 	if vmi.IsCPUDedicated() {
 		useIOThreads := false
 		if options != nil && options.Topology != nil {
@@ -511,7 +531,7 @@ func (l *LibvirtDomainManager) UpdateVCPUs(vmi *v1.VirtualMachineInstance, optio
 			useIOThreads = true
 		}
 
-		err = vcpu.AdjustDomainForTopologyAndCPUSet(domain, vmi, topology, podCPUSet, useIOThreads)
+		err = vcpu.AdjustDomainForHotpluggedVCPUs(domain, vmi, topology, podCPUSet, useIOThreads)
 		if err != nil {
 			return fmt.Errorf("%s: %v", errMsgPrefix, err)
 		}
@@ -556,7 +576,9 @@ func maxSlice(slice []int) int {
 	return max
 }
 
-// HotplugHostDevices attach host-devices to running domain, currently only SRIOV host-devices are supported.
+// HotplugHostDevices attach the SR-IOV, GPU and generic host-devices allocated to the pod to the running domain.
+// It also syncs the USB devices of the domain with the ones virt-handler allocated to the VMI, detaching the
+// unplugged ones and attaching the plugged ones.
 // This operation runs in the background, only one hotplug operation can occur at a time.
 func (l *LibvirtDomainManager) HotplugHostDevices(vmi *v1.VirtualMachineInstance) error {
 	select {
@@ -593,13 +615,30 @@ func (l *LibvirtDomainManager) hotPlugHostDevices(vmi *v1.VirtualMachineInstance
 		return fmt.Errorf("%s: %v", errMsgPrefix, err)
 	}
 
+	if err := hostdevice.DetachHostDevices(domain, usb.GetHostDevicesToDetach(vmi, domainSpec)); err != nil {
+		return fmt.Errorf("%s: %v", errMsgPrefix, err)
+	}
+
 	sriovHostDevices, err := sriov.GetHostDevicesToAttach(vmi, domainSpec)
 	if err != nil {
 		return fmt.Errorf("%s: %v", errMsgPrefix, err)
 	}
 
-	if err := hostdevice.AttachHostDevices(domain, sriovHostDevices); err != nil {
-		return fmt.Errorf("%s: %v", errMsgPrefix, hostdevice.AttachHostDevices(domain, sriovHostDevices))
+	gpuHostDevices, err := gpu.GetHostDevicesToAttach(vmi, domainSpec)
+	if err != nil {
+		return fmt.Errorf("%s: %v", errMsgPrefix, err)
+	}
+
+	genericHostDevices, err := generic.GetHostDevicesToAttach(vmi, domainSpec)
+	if err != nil {
+		return fmt.Errorf("%s: %v", errMsgPrefix, err)
+	}
+
+	hostDevices := append(sriovHostDevices, gpuHostDevices...)
+	hostDevices = append(hostDevices, genericHostDevices...)
+	hostDevices = append(hostDevices, usb.GetHostDevicesToAttach(vmi, domainSpec)...)
+	if err := hostdevice.AttachHostDevices(domain, hostDevices); err != nil {
+		return fmt.Errorf("%s: %v", errMsgPrefix, err)
 	}
 
 	return nil
@@ -1064,6 +1103,12 @@ func (l *LibvirtDomainManager) generateConverterContext(vmi *v1.VirtualMachineIn
 		}
 
 		c.DomainAttachmentByInterfaceName = options.GetInterfaceDomainAttachment()
+
+		vdpaDevicePaths, err := vdpaDevicePathByInterfaceName(c.DomainAttachmentByInterfaceName)
+		if err != nil {
+			return nil, err
+		}
+		c.VDPADevicePathByInterfaceName = vdpaDevicePaths
 	}
 	c.DisksInfo = l.disksInfo
 
@@ -1087,11 +1132,63 @@ func (l *LibvirtDomainManager) generateConverterContext(vmi *v1.VirtualMachineIn
 			return nil, err
 		}
 		c.GPUHostDevices = gpuHostDevices
+		c.USBHostDevices = usb.CreateHostDevices(vmi)
+
+		if vmi.IsCPUDedicated() && vmi.Spec.Domain.CPU.NUMA != nil && vmi.Spec.Domain.CPU.NUMA.GuestMappingPassthrough != nil {
+			c.HostDeviceNUMANodes = hostDeviceNUMANodes(c.SRIOVDevices, c.GenericHostDevices, c.GPUHostDevices)
+		}
 	}
 
 	return c, nil
 }
 
+// vdpaDevicePathByInterfaceName returns the vhost-vdpa devices allocated to the interfaces with the vdpa domain attachment.
+// They are reported in the network-info, which is populated by virt-controller once the pod is running.
+func vdpaDevicePathByInterfaceName(domainAttachmentByInterfaceName map[string]string) (map[string]string, error) {
+	hasVDPAInterface := false
+	for _, domainAttachment := range domainAttachmentByInterfaceName {
+		hasVDPAInterface = hasVDPAInterface || domainAttachment == string(v1.Vdpa)
+	}
+	if !hasVDPAInterface {
+		return nil, nil
+	}
+
+	networkInfoPath := filepath.Join(downwardapi.MountPath, downwardapi.NetworkInfoVolumePath)
+	networkInfo, err := os.ReadFile(networkInfoPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the vDPA devices: %v", err)
+	}
+	if len(networkInfo) == 0 {
+		return nil, fmt.Errorf("failed to read the vDPA devices: %s is not populated yet", networkInfoPath)
+	}
+	return netsriov.VDPADevicePathByNetworkName(networkInfo)
+}
+
+// hostDeviceNUMANodes returns the host NUMA node of the PCI host devices by source address.
+// Devices which are not local to a NUMA node are left out.
+func hostDeviceNUMANodes(hostDevices ...[]api.HostDevice) map[api.Address]uint32 {
+	numaNodes := map[api.Address]uint32{}
+	for _, devices := range hostDevices {
+		for _, dev := range devices {
+			if dev.Type != api.HostDevicePCI || dev.Source.Address == nil {
+				continue
+			}
+			pciAddress := formatPCIAddressStr(dev.Source.Address)
+			numaNode, err := hardware.GetDeviceNumaNode(pciAddress)
+			if err != nil {
+				log.Log.Reason(err).Warningf("failed to read the NUMA node of the host device %s", pciAddress)
+				continue
+			}
+			// the kernel reports -1 for devices without NUMA affinity
+			if int32(*numaNode) < 0 {
+				continue
+			}
+			numaNodes[*dev.Source.Address] = *numaNode
+		}
+	}
+	return numaNodes
+}
+
 func isFreePageReportingEnabled(clusterFreePageReportingDisabled bool, vmi *v1.VirtualMachineInstance) bool {
 	if clusterFreePageReportingDisabled ||
 		(vmi.Spec.Domain.Devices.AutoattachMemBalloon != nil && *vmi.Spec.Domain.Devices.AutoattachMemBalloon == false) ||
@@ -1113,6 +1210,10 @@ func (l *LibvirtDomainManager) SyncVMI(vmi *v1.VirtualMachineInstance, allowEmul
 
 	logger := log.Log.Object(vmi)
 
+	if l.agentData != nil {
+		l.agentData.SetClockDriftThreshold(agentpoller.ClockDriftThreshold(vmi))
+	}
+
 	domain := &api.Domain{}
 
 	c, err := l.generateConverterContext(vmi, allowEmulation, options, false)
@@ -1145,7 +1246,7 @@ func (l *LibvirtDomainManager) SyncVMI(vmi *v1.VirtualMachineInstance, allowEmul
 	// TODO blocked state
 	switch {
 	case cli.IsDown(domState) && !vmi.IsRunning() && !vmi.IsFinal():
-		if err := l.startDomain(vmi, dom); err != nil {
+		if err := l.startDomain(vmi, dom, options); err != nil {
 			return nil, err
 		}
 	case cli.IsPaused(domState) && !l.paused.contains(vmi.UID):
@@ -1280,6 +1381,7 @@ func (l *LibvirtDomainManager) syncNetwork(
 func (l *LibvirtDomainManager) startDomain(
 	vmi *v1.VirtualMachineInstance,
 	dom cli.VirDomain,
+	options *cmdv1.VirtualMachineOptions,
 ) error {
 	logger := log.Log.Object(vmi)
 	if err := l.generateCloudInitISO(vmi, &dom); err != nil {
@@ -1297,6 +1399,9 @@ func (l *LibvirtDomainManager) startDomain(
 	if vmi.ShouldStartPaused() {
 		l.paused.add(vmi.UID)
 	}
+	if options.GetClusterConfig().GetBlockLatencyHistogramsEnabled() {
+		l.blockLatencyHistograms.Store(true)
+	}
 	return nil
 }
 
@@ -1555,7 +1660,7 @@ func (l *LibvirtDomainManager) memoryDump(vmi *v1.VirtualMachineInstance, dumpPa
 	logger.Infof("Starting memory dump")
 	failed := false
 	reason := ""
-	err = dom.CoreDumpWithFormat(dumpPath, libvirt.DOMAIN_CORE_DUMP_FORMAT_RAW, libvirt.DUMP_MEMORY_ONLY)
+	err = l.dumpMemory(dom, memoryDumpVolume(vmi, dumpPath), dumpPath)
 	if err != nil {
 		failed = true
 		reason = fmt.Sprintf("%s: %s", failedDomainMemoryDump, err)
@@ -1728,7 +1833,49 @@ func (l *LibvirtDomainManager) getParsedFSStatus(domainName string) (string, err
 	return fsfreezeStatus.Status, nil
 }
 
-func (l *LibvirtDomainManager) FreezeVMI(vmi *v1.VirtualMachineInstance, unfreezeTimeoutSeconds int32) error {
+// getFreezeMountpoints returns the guest mountpoints of the filesystems backed by the given volumes,
+// the volumes are matched with the guest disks through their serial
+func (l *LibvirtDomainManager) getFreezeMountpoints(vmi *v1.VirtualMachineInstance, domainName string, volumes []string) ([]string, error) {
+	cmdResult, err := l.virConn.QemuAgentCommand(`{"execute":"`+string(agentpoller.GET_FILESYSTEM)+`"}`, domainName)
+	if err != nil {
+		return nil, err
+	}
+	filesystems, err := agentpoller.ParseFilesystem(cmdResult)
+	if err != nil {
+		return nil, err
+	}
+
+	var mountpoints []string
+	for _, volume := range volumes {
+		serial := ""
+		for _, disk := range vmi.Spec.Domain.Devices.Disks {
+			if disk.Name == volume {
+				serial = disk.Serial
+				break
+			}
+		}
+		if serial == "" {
+			return nil, fmt.Errorf("volume %s does not match a disk with a serial", volume)
+		}
+
+		found := false
+		for _, fs := range filesystems {
+			for _, disk := range fs.Disk {
+				if disk.Serial == serial {
+					mountpoints = append(mountpoints, fs.Mountpoint)
+					found = true
+					break
+				}
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("no guest filesystem found on volume %s", volume)
+		}
+	}
+	return mountpoints, nil
+}
+
+func (l *LibvirtDomainManager) FreezeVMI(vmi *v1.VirtualMachineInstance, unfreezeTimeoutSeconds int32, volumes []string) error {
 	if l.migrationInProgress() {
 		return fmt.Errorf("Failed to freeze VMI, VMI is currently during migration")
 	}
@@ -1757,12 +1904,34 @@ func (l *LibvirtDomainManager) FreezeVMI(vmi *v1.VirtualMachineInstance, unfreez
 			return err
 		}
 	}
-	_, err = l.virConn.QemuAgentCommand(`{"execute":"guest-fsfreeze-freeze"}`, domainName)
+	freezeCmd := `{"execute":"guest-fsfreeze-freeze"}`
+	if len(volumes) > 0 {
+		mountpoints, err := l.getFreezeMountpoints(vmi, domainName, volumes)
+		if err != nil {
+			log.Log.Errorf("Failed to get the filesystems to freeze for vmi %s, %s", vmi.Name, err.Error())
+			return err
+		}
+		cmd, err := json.Marshal(map[string]interface{}{
+			"execute":   "guest-fsfreeze-freeze-list",
+			"arguments": map[string][]string{"mountpoints": mountpoints},
+		})
+		if err != nil {
+			return err
+		}
+		freezeCmd = string(cmd)
+	}
+	_, err = l.virConn.QemuAgentCommand(freezeCmd, domainName)
 	if err != nil {
 		log.Log.Errorf("Failed to freeze vmi, %s", err.Error())
 		return err
 	}
 
+	now := metav1.Now()
+	l.metadataCache.FSFreeze.Store(api.FSFreezeMetadata{
+		Volumes:        strings.Join(volumes, ","),
+		StartTimestamp: &now,
+	})
+
 	l.cancelSafetyUnfreeze()
 	if safetyUnfreezeTimeout != 0 {
 		go l.scheduleSafetyVMIUnfreeze(vmi, safetyUnfreezeTimeout)
@@ -1786,6 +1955,13 @@ func (l *LibvirtDomainManager) UnfreezeVMI(vmi *v1.VirtualMachineInstance) error
 		log.Log.Errorf("Failed to unfreeze vmi, %s", err.Error())
 		return err
 	}
+
+	l.metadataCache.FSFreeze.WithSafeBlock(func(fsFreezeMetadata *api.FSFreezeMetadata, initialized bool) {
+		if initialized && fsFreezeMetadata.StartTimestamp != nil && fsFreezeMetadata.EndTimestamp == nil {
+			now := metav1.Now()
+			fsFreezeMetadata.EndTimestamp = &now
+		}
+	})
 	return nil
 }
 
@@ -1868,18 +2044,29 @@ func (l *LibvirtDomainManager) SignalShutdownVMI(vmi *v1.VirtualMachineInstance)
 	}
 
 	if domState == libvirt.DOMAIN_RUNNING || domState == libvirt.DOMAIN_PAUSED {
-		err = dom.ShutdownFlags(libvirt.DOMAIN_SHUTDOWN_DEFAULT)
-		if err != nil {
-			log.Log.Object(vmi).Reason(err).Error("Signalling graceful shutdown failed.")
-			return err
+		stage, flags := l.shutdownStage(vmi)
+		if stage == v1.ShutdownStageForcedOff {
+			err = dom.DestroyFlags(libvirt.DOMAIN_DESTROY_DEFAULT)
+			if err != nil {
+				log.Log.Object(vmi).Reason(err).Error("Forcefully powering off the domain failed.")
+				return err
+			}
+			log.Log.Object(vmi).Infof("Guest of %s did not shut down in time, forcefully powered off", vmi.GetObjectMeta().GetName())
+		} else {
+			err = dom.ShutdownFlags(flags)
+			if err != nil {
+				log.Log.Object(vmi).Reason(err).Error("Signalling graceful shutdown failed.")
+				return err
+			}
+			log.Log.Object(vmi).Infof("Signaled graceful shutdown for %s", vmi.GetObjectMeta().GetName())
 		}
-		log.Log.Object(vmi).Infof("Signaled graceful shutdown for %s", vmi.GetObjectMeta().GetName())
 
 		l.metadataCache.GracePeriod.WithSafeBlock(func(gracePeriodMetadata *api.GracePeriodMetadata, _ bool) {
 			if gracePeriodMetadata.DeletionTimestamp == nil {
 				now := metav1.Now()
 				gracePeriodMetadata.DeletionTimestamp = &now
 			}
+			gracePeriodMetadata.ShutdownStage = string(stage)
 		})
 		log.Log.V(4).Infof("Graceful period set in metadata: %s", l.metadataCache.GracePeriod.String())
 	}
@@ -1887,6 +2074,38 @@ func (l *LibvirtDomainManager) SignalShutdownVMI(vmi *v1.VirtualMachineInstance)
 	return nil
 }
 
+// shutdownStage returns the stage of the shutdown policy of the VMI which is due, based on the time
+// passed since the shutdown was signaled first, and the flags to request the shutdown in this stage.
+// Without a shutdown policy no stage is returned and libvirt picks how the shutdown is requested.
+func (l *LibvirtDomainManager) shutdownStage(vmi *v1.VirtualMachineInstance) (v1.ShutdownStage, libvirt.DomainShutdownFlags) {
+	policy := vmi.Spec.ShutdownPolicy
+	if policy == nil {
+		return "", libvirt.DOMAIN_SHUTDOWN_DEFAULT
+	}
+
+	var elapsed time.Duration
+	if gracePeriod, exists := l.metadataCache.GracePeriod.Load(); exists && gracePeriod.DeletionTimestamp != nil {
+		elapsed = time.Since(gracePeriod.DeletionTimestamp.Time)
+	}
+
+	acpiTimeout := shutdownStageTimeout(policy.ACPITimeoutSeconds)
+	if elapsed < acpiTimeout {
+		return v1.ShutdownStageACPI, libvirt.DOMAIN_SHUTDOWN_ACPI_POWER_BTN
+	}
+	agentConnected := controller.NewVirtualMachineInstanceConditionManager().HasCondition(vmi, v1.VirtualMachineInstanceAgentConnected)
+	if agentConnected && elapsed < acpiTimeout+shutdownStageTimeout(policy.GuestAgentTimeoutSeconds) {
+		return v1.ShutdownStageGuestAgent, libvirt.DOMAIN_SHUTDOWN_GUEST_AGENT
+	}
+	return v1.ShutdownStageForcedOff, 0
+}
+
+func shutdownStageTimeout(timeoutSeconds *int64) time.Duration {
+	if timeoutSeconds == nil {
+		return time.Duration(v1.DefaultShutdownStageTimeoutSeconds) * time.Second
+	}
+	return time.Duration(*timeoutSeconds) * time.Second
+}
+
 func (l *LibvirtDomainManager) KillVMI(vmi *v1.VirtualMachineInstance) error {
 	domName := api.VMINamespaceKeyFunc(vmi)
 	dom, err := l.virConn.LookupDomainByName(domName)
@@ -2014,7 +2233,14 @@ func (l *LibvirtDomainManager) getDomainStats() ([]*stats.DomainStats, error) {
 	statsTypes := libvirt.DOMAIN_STATS_BALLOON | libvirt.DOMAIN_STATS_CPU_TOTAL | libvirt.DOMAIN_STATS_VCPU | libvirt.DOMAIN_STATS_INTERFACE | libvirt.DOMAIN_STATS_BLOCK | libvirt.DOMAIN_STATS_DIRTYRATE
 	flags := libvirt.CONNECT_GET_ALL_DOMAINS_STATS_RUNNING | libvirt.CONNECT_GET_ALL_DOMAINS_STATS_PAUSED
 
-	return l.virConn.GetDomainStats(statsTypes, l.migrateInfoStats, flags)
+	domStats, err := l.virConn.GetDomainStats(statsTypes, l.migrateInfoStats, flags)
+	if err != nil || !l.blockLatencyHistograms.Load() {
+		return domStats, err
+	}
+	for _, stat := range domStats {
+		l.latencyHistograms.Add(stat.Block)
+	}
+	return domStats, nil
 }
 
 func formatPCIAddressStr(address *api.Address) string {
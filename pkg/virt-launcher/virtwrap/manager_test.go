@@ -20,13 +20,17 @@
 package virtwrap
 
 import (
+	"bytes"
+	"compress/gzip"
 	"crypto/sha256"
 	_ "embed"
 	"encoding/base64"
 	"encoding/json"
 	"encoding/xml"
 	"fmt"
+	"io"
 	"os"
+	"os/exec"
 	"path/filepath"
 	"runtime"
 	"strings"
@@ -67,6 +71,7 @@ import (
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/converter/vcpu"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/efi"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/stats"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/statsconv"
 )
 
 var (
@@ -586,7 +591,7 @@ var _ = Describe("Manager", func() {
 			mockConn.EXPECT().QemuAgentCommand(`{"execute":"guest-fsfreeze-freeze"}`, testDomainName).Return("1", nil)
 			manager, _ := NewLibvirtDomainManager(mockConn, testVirtShareDir, testEphemeralDiskDir, nil, "/usr/share/OVMF", ephemeralDiskCreatorMock, metadataCache, nil, virtconfig.DefaultDiskVerificationMemoryLimitBytes)
 
-			Expect(manager.FreezeVMI(vmi, 0)).To(Succeed())
+			Expect(manager.FreezeVMI(vmi, 0, nil)).To(Succeed())
 		})
 		It("should fail freeze a VirtualMachineInstance during migration", func() {
 			vmi := newVMI(testNamespace, testVmName)
@@ -597,7 +602,41 @@ var _ = Describe("Manager", func() {
 
 			manager, _ := NewLibvirtDomainManager(mockConn, testVirtShareDir, testEphemeralDiskDir, nil, "/usr/share/OVMF", ephemeralDiskCreatorMock, metadataCache, nil, virtconfig.DefaultDiskVerificationMemoryLimitBytes)
 
-			Expect(manager.FreezeVMI(vmi, 0)).To(MatchError(ContainSubstring("VMI is currently during migration")))
+			Expect(manager.FreezeVMI(vmi, 0, nil)).To(MatchError(ContainSubstring("VMI is currently during migration")))
+		})
+		It("should freeze the filesystems of the selected volumes", func() {
+			vmi := newVMI(testNamespace, testVmName)
+			vmi.Spec.Domain.Devices.Disks = []v1.Disk{
+				{Name: "rootdisk", Serial: "root-serial"},
+				{Name: "datadisk", Serial: "data-serial"},
+			}
+			fsInfo := `{"return":[` +
+				`{"name":"vda1","mountpoint":"/","type":"xfs","disk":[{"serial":"root-serial","bus-type":"virtio"}]},` +
+				`{"name":"vdb1","mountpoint":"/data","type":"xfs","disk":[{"serial":"data-serial","bus-type":"virtio"}]}]}`
+
+			mockConn.EXPECT().QemuAgentCommand(`{"execute":"`+string(agentpoller.GET_FSFREEZE_STATUS)+`"}`, testDomainName).Return(expectedThawedOutput, nil)
+			mockConn.EXPECT().QemuAgentCommand(`{"execute":"`+string(agentpoller.GET_FILESYSTEM)+`"}`, testDomainName).Return(fsInfo, nil)
+			mockConn.EXPECT().QemuAgentCommand(`{"arguments":{"mountpoints":["/data"]},"execute":"guest-fsfreeze-freeze-list"}`, testDomainName).Return("1", nil)
+			manager, _ := NewLibvirtDomainManager(mockConn, testVirtShareDir, testEphemeralDiskDir, nil, "/usr/share/OVMF", ephemeralDiskCreatorMock, metadataCache, nil, virtconfig.DefaultDiskVerificationMemoryLimitBytes)
+
+			Expect(manager.FreezeVMI(vmi, 0, []string{"datadisk"})).To(Succeed())
+			fsFreeze, exists := metadataCache.FSFreeze.Load()
+			Expect(exists).To(BeTrue())
+			Expect(fsFreeze.Volumes).To(Equal("datadisk"))
+			Expect(fsFreeze.StartTimestamp).ToNot(BeNil())
+			Expect(fsFreeze.EndTimestamp).To(BeNil())
+		})
+		It("should fail to freeze a volume whose disk has no serial", func() {
+			vmi := newVMI(testNamespace, testVmName)
+			vmi.Spec.Domain.Devices.Disks = []v1.Disk{{Name: "datadisk"}}
+
+			mockConn.EXPECT().QemuAgentCommand(`{"execute":"`+string(agentpoller.GET_FSFREEZE_STATUS)+`"}`, testDomainName).Return(expectedThawedOutput, nil)
+			mockConn.EXPECT().QemuAgentCommand(`{"execute":"`+string(agentpoller.GET_FILESYSTEM)+`"}`, testDomainName).Return(`{"return":[]}`, nil)
+			manager, _ := NewLibvirtDomainManager(mockConn, testVirtShareDir, testEphemeralDiskDir, nil, "/usr/share/OVMF", ephemeralDiskCreatorMock, metadataCache, nil, virtconfig.DefaultDiskVerificationMemoryLimitBytes)
+
+			Expect(manager.FreezeVMI(vmi, 0, []string{"datadisk"})).To(MatchError(ContainSubstring("volume datadisk does not match a disk with a serial")))
+			_, exists := metadataCache.FSFreeze.Load()
+			Expect(exists).To(BeFalse())
 		})
 		It("should unfreeze a VirtualMachineInstance", func() {
 			vmi := newVMI(testNamespace, testVmName)
@@ -608,6 +647,19 @@ var _ = Describe("Manager", func() {
 
 			Expect(manager.UnfreezeVMI(vmi)).To(Succeed())
 		})
+		It("should record the end of the freeze on unfreeze", func() {
+			vmi := newVMI(testNamespace, testVmName)
+			now := metav1.Now()
+			metadataCache.FSFreeze.Store(api.FSFreezeMetadata{StartTimestamp: &now})
+
+			mockConn.EXPECT().QemuAgentCommand(`{"execute":"`+string(agentpoller.GET_FSFREEZE_STATUS)+`"}`, testDomainName).Return(expectedFrozenOutput, nil)
+			mockConn.EXPECT().QemuAgentCommand(`{"execute":"guest-fsfreeze-thaw"}`, testDomainName).Return("1", nil)
+			manager, _ := NewLibvirtDomainManager(mockConn, testVirtShareDir, testEphemeralDiskDir, nil, "/usr/share/OVMF", ephemeralDiskCreatorMock, metadataCache, nil, virtconfig.DefaultDiskVerificationMemoryLimitBytes)
+
+			Expect(manager.UnfreezeVMI(vmi)).To(Succeed())
+			fsFreeze, _ := metadataCache.FSFreeze.Load()
+			Expect(fsFreeze.EndTimestamp).ToNot(BeNil())
+		})
 		It("should automatically unfreeze after a timeout a frozen VirtualMachineInstance", func() {
 			vmi := newVMI(testNamespace, testVmName)
 
@@ -618,7 +670,7 @@ var _ = Describe("Manager", func() {
 			manager, _ := NewLibvirtDomainManager(mockConn, testVirtShareDir, testEphemeralDiskDir, nil, "/usr/share/OVMF", ephemeralDiskCreatorMock, metadataCache, nil, virtconfig.DefaultDiskVerificationMemoryLimitBytes)
 
 			var unfreezeTimeout time.Duration = 3 * time.Second
-			Expect(manager.FreezeVMI(vmi, int32(unfreezeTimeout.Seconds()))).To(Succeed())
+			Expect(manager.FreezeVMI(vmi, int32(unfreezeTimeout.Seconds()), nil)).To(Succeed())
 			// wait for the unfreeze timeout
 			time.Sleep(unfreezeTimeout + 2*time.Second)
 		})
@@ -633,7 +685,7 @@ var _ = Describe("Manager", func() {
 			manager, _ := NewLibvirtDomainManager(mockConn, testVirtShareDir, testEphemeralDiskDir, nil, "/usr/share/OVMF", ephemeralDiskCreatorMock, metadataCache, nil, virtconfig.DefaultDiskVerificationMemoryLimitBytes)
 
 			var unfreezeTimeout time.Duration = 3 * time.Second
-			Expect(manager.FreezeVMI(vmi, int32(unfreezeTimeout.Seconds()))).To(Succeed())
+			Expect(manager.FreezeVMI(vmi, int32(unfreezeTimeout.Seconds()), nil)).To(Succeed())
 			time.Sleep(time.Second)
 			Expect(manager.UnfreezeVMI(vmi)).To(Succeed())
 			// wait for the unfreeze timeout
@@ -689,6 +741,100 @@ var _ = Describe("Manager", func() {
 				return memoryDump.Failed
 			}, 5*time.Second).Should(BeTrue(), "failed memory dump result wasn't set")
 		})
+		It("should report the progress of the memory dump", func() {
+			oldInterval := memoryDumpProgressInterval
+			memoryDumpProgressInterval = 10 * time.Millisecond
+			DeferCleanup(func() { memoryDumpProgressInterval = oldInterval })
+
+			mockConn.EXPECT().LookupDomainByName(testDomainName).DoAndReturn(mockDomainWithFreeExpectation)
+			sampled := make(chan struct{})
+			mockDomain.EXPECT().GetJobInfo().MinTimes(1).DoAndReturn(func() (*libvirt.DomainJobInfo, error) {
+				select {
+				case <-sampled:
+				default:
+					close(sampled)
+				}
+				return &libvirt.DomainJobInfo{MemTotalSet: true, MemTotal: 1024, MemProcessedSet: true, MemProcessed: 512}, nil
+			})
+			mockDomain.EXPECT().CoreDumpWithFormat(testDumpPath, libvirt.DOMAIN_CORE_DUMP_FORMAT_RAW, libvirt.DUMP_MEMORY_ONLY).
+				DoAndReturn(func(_ string, _ libvirt.DomainCoreDumpFormat, _ libvirt.DomainCoreDumpFlags) error {
+					<-sampled
+					return nil
+				})
+
+			manager, _ := NewLibvirtDomainManager(mockConn, testVirtShareDir, testEphemeralDiskDir, nil, "/usr/share/OVMF", ephemeralDiskCreatorMock, metadataCache, nil, virtconfig.DefaultDiskVerificationMemoryLimitBytes)
+
+			vmi := newVMI(testNamespace, testVmName)
+			Expect(manager.MemoryDump(vmi, testDumpPath)).To(Succeed())
+			Eventually(func() bool {
+				memoryDump, _ := metadataCache.MemoryDump.Load()
+				return memoryDump.Completed
+			}, 5*time.Second).Should(BeTrue())
+			memoryDump, _ := metadataCache.MemoryDump.Load()
+			Expect(memoryDump.DataProcessed).To(Equal(uint64(512)))
+			Expect(memoryDump.DataTotal).To(Equal(uint64(1024)))
+		})
+		DescribeTable("should stream the memory dump through virt-launcher", func(compression v1.MemoryDumpCompression) {
+			if compression != "" {
+				if _, err := exec.LookPath(memoryDumpCompressors[compression][0]); err != nil {
+					Skip(fmt.Sprintf("%s is not available", compression))
+				}
+			}
+			shareDir := GinkgoT().TempDir()
+			dumpPath := filepath.Join(shareDir, "vol1", "vol1.memory.dump")
+			Expect(os.Mkdir(filepath.Dir(dumpPath), 0755)).To(Succeed())
+
+			vmi := newVMI(testNamespace, testVmName)
+			vmi.Spec.Volumes = append(vmi.Spec.Volumes, v1.Volume{
+				Name: "vol1",
+				VolumeSource: v1.VolumeSource{
+					MemoryDump: &v1.MemoryDumpVolumeSource{
+						Compression: compression,
+						ChunkSize:   virtpointer.P(resource.MustParse("1Mi")),
+					},
+				},
+			})
+
+			dump := bytes.Repeat([]byte("guest memory"), 300*1024)
+			mockConn.EXPECT().LookupDomainByName(testDomainName).DoAndReturn(mockDomainWithFreeExpectation)
+			mockDomain.EXPECT().CoreDumpWithFormat(gomock.Any(), libvirt.DOMAIN_CORE_DUMP_FORMAT_RAW, libvirt.DUMP_MEMORY_ONLY).
+				DoAndReturn(func(to string, _ libvirt.DomainCoreDumpFormat, _ libvirt.DomainCoreDumpFlags) error {
+					if to == dumpPath {
+						return fmt.Errorf("the dump is expected to be written to a pipe")
+					}
+					pipe, err := os.OpenFile(to, os.O_WRONLY, 0)
+					if err != nil {
+						return err
+					}
+					defer pipe.Close()
+					_, err = pipe.Write(dump)
+					return err
+				})
+
+			manager, _ := NewLibvirtDomainManager(mockConn, shareDir, testEphemeralDiskDir, nil, "/usr/share/OVMF", ephemeralDiskCreatorMock, metadataCache, nil, virtconfig.DefaultDiskVerificationMemoryLimitBytes)
+			Expect(manager.MemoryDump(vmi, dumpPath)).To(Succeed())
+			Eventually(func() bool {
+				memoryDump, _ := metadataCache.MemoryDump.Load()
+				return memoryDump.Completed
+			}, 5*time.Second).Should(BeTrue())
+			memoryDump, _ := metadataCache.MemoryDump.Load()
+			Expect(memoryDump.Failed).To(BeFalse(), memoryDump.FailureReason)
+
+			file, err := os.Open(dumpPath)
+			Expect(err).ToNot(HaveOccurred())
+			defer file.Close()
+			var reader io.Reader = file
+			if compression == v1.MemoryDumpCompressionGzip {
+				reader, err = gzip.NewReader(file)
+				Expect(err).ToNot(HaveOccurred())
+			}
+			written, err := io.ReadAll(reader)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(written).To(Equal(dump))
+		},
+			Entry("in chunks", v1.MemoryDumpCompression("")),
+			Entry("compressed with gzip", v1.MemoryDumpCompressionGzip),
+		)
 		It("should pause a VirtualMachineInstance", func() {
 			vmi := newVMI(testNamespace, testVmName)
 
@@ -748,6 +894,19 @@ var _ = Describe("Manager", func() {
 				return false
 			}, 20*time.Second, 1).Should(BeTrue(), "Free wasn't called")
 		})
+		It("should not set the guest time when unpausing a VirtualMachineInstance with the guest time sync disabled", func() {
+			vmi := newVMI(testNamespace, testVmName)
+			vmi.Spec.Domain.Clock = &v1.Clock{
+				GuestTimeSync: &v1.GuestTimeSync{Policy: v1.GuestTimeSyncDisabled},
+			}
+
+			mockConn.EXPECT().LookupDomainByName(testDomainName).DoAndReturn(mockDomainWithFreeExpectation)
+			mockDomain.EXPECT().GetState().Return(libvirt.DOMAIN_PAUSED, 1, nil)
+			mockDomain.EXPECT().Resume().Return(nil)
+			manager, _ := NewLibvirtDomainManager(mockConn, testVirtShareDir, testEphemeralDiskDir, nil, "/usr/share/OVMF", ephemeralDiskCreatorMock, metadataCache, nil, virtconfig.DefaultDiskVerificationMemoryLimitBytes)
+			// no call to set the time
+			Expect(manager.UnpauseVMI(vmi)).To(Succeed())
+		})
 		It("should not try to unpause a running VirtualMachineInstance", func() {
 			vmi := newVMI(testNamespace, testVmName)
 
@@ -1480,6 +1639,48 @@ var _ = Describe("Manager", func() {
 			gracePeriod, _ := metadataCache.GracePeriod.Load()
 			Expect(gracePeriod.DeletionTimestamp).NotTo(BeNil())
 		})
+
+		DescribeTable("Should escalate the shutdown according to the shutdown policy", func(signaledSecondsAgo int, agentConnected bool, expectedStage v1.ShutdownStage) {
+			mockDomain.EXPECT().GetState().AnyTimes().Return(libvirt.DOMAIN_RUNNING, 1, nil)
+			mockConn.EXPECT().LookupDomainByName(testDomainName).AnyTimes().DoAndReturn(mockDomainWithFreeExpectation)
+			switch expectedStage {
+			case v1.ShutdownStageACPI:
+				mockDomain.EXPECT().ShutdownFlags(libvirt.DOMAIN_SHUTDOWN_ACPI_POWER_BTN).Return(nil)
+			case v1.ShutdownStageGuestAgent:
+				mockDomain.EXPECT().ShutdownFlags(libvirt.DOMAIN_SHUTDOWN_GUEST_AGENT).Return(nil)
+			case v1.ShutdownStageForcedOff:
+				mockDomain.EXPECT().DestroyFlags(libvirt.DOMAIN_DESTROY_DEFAULT).Return(nil)
+			}
+
+			if signaledSecondsAgo > 0 {
+				signaled := metav1.NewTime(time.Now().Add(-time.Duration(signaledSecondsAgo) * time.Second))
+				metadataCache.GracePeriod.Set(api.GracePeriodMetadata{DeletionGracePeriodSeconds: 180, DeletionTimestamp: &signaled})
+			}
+			manager, _ := NewLibvirtDomainManager(mockConn, testVirtShareDir, testEphemeralDiskDir, nil, "/usr/share/OVMF", ephemeralDiskCreatorMock, metadataCache, nil, virtconfig.DefaultDiskVerificationMemoryLimitBytes)
+
+			vmi := newVMI(testNamespace, testVmName)
+			vmi.Spec.ShutdownPolicy = &v1.ShutdownPolicy{
+				ACPITimeoutSeconds:       virtpointer.P(int64(20)),
+				GuestAgentTimeoutSeconds: virtpointer.P(int64(20)),
+			}
+			if agentConnected {
+				vmi.Status.Conditions = []v1.VirtualMachineInstanceCondition{{
+					Type:   v1.VirtualMachineInstanceAgentConnected,
+					Status: k8sv1.ConditionTrue,
+				}}
+			}
+			Expect(manager.SignalShutdownVMI(vmi)).To(Succeed())
+
+			gracePeriod, _ := metadataCache.GracePeriod.Load()
+			Expect(gracePeriod.DeletionTimestamp).NotTo(BeNil())
+			Expect(gracePeriod.ShutdownStage).To(Equal(string(expectedStage)))
+		},
+			Entry("by pressing the ACPI power button first", 0, true, v1.ShutdownStageACPI),
+			Entry("by pressing the ACPI power button until the ACPI timeout expired", 10, true, v1.ShutdownStageACPI),
+			Entry("by sending the shutdown command through the guest agent after the ACPI timeout", 30, true, v1.ShutdownStageGuestAgent),
+			Entry("by forcefully powering off if no guest agent is connected", 30, false, v1.ShutdownStageForcedOff),
+			Entry("by forcefully powering off after the guest agent timeout", 50, true, v1.ShutdownStageForcedOff),
+		)
 	})
 	Context("test migration monitor", func() {
 		It("migration should be canceled if it's not progressing", func() {
@@ -1603,6 +1804,75 @@ var _ = Describe("Manager", func() {
 			monitor.startMonitor()
 		})
 
+		Context("with the convergence tuned by the migration configuration", func() {
+			var (
+				migrationErrorChan chan error
+				vmi                *v1.VirtualMachineInstance
+				manager            *LibvirtDomainManager
+				iterations         uint64
+			)
+
+			BeforeEach(func() {
+				migrationErrorChan = make(chan error)
+				DeferCleanup(func() { close(migrationErrorChan) })
+				vmi = newVMI(testNamespace, testVmName)
+				vmi.Status.MigrationState = &v1.VirtualMachineInstanceMigrationState{
+					MigrationUID: "111222333",
+				}
+				manager = &LibvirtDomainManager{
+					virConn:       mockConn,
+					virtShareDir:  testVirtShareDir,
+					metadataCache: metadataCache,
+				}
+
+				iterations = 0
+				mockConn.EXPECT().LookupDomainByName(testDomainName).DoAndReturn(mockDomainWithFreeExpectation)
+				mockDomain.EXPECT().GetState().AnyTimes().Return(libvirt.DOMAIN_RUNNING, 1, nil)
+				mockDomain.EXPECT().GetJobStats(libvirt.DomainGetJobStatsFlags(0)).AnyTimes().DoAndReturn(func(flag libvirt.DomainGetJobStatsFlags) (*libvirt.DomainJobInfo, error) {
+					iterations++
+					if iterations > 6 {
+						return &libvirt.DomainJobInfo{Type: libvirt.DOMAIN_JOB_COMPLETED}, nil
+					}
+					return &libvirt.DomainJobInfo{
+						Type:             libvirt.DOMAIN_JOB_UNBOUNDED,
+						DataRemaining:    uint64(32479827394 - 125*iterations),
+						DataRemainingSet: true,
+						MemIteration:     iterations,
+						MemIterationSet:  true,
+					}, nil
+				})
+			})
+
+			It("migration should set the maximum downtime once", func() {
+				options := &cmdclient.MigrationOptions{
+					Bandwidth:               resource.MustParse("64Mi"),
+					ProgressTimeout:         150,
+					CompletionTimeoutPerGiB: 150,
+					MaxDowntimeMilliseconds: 500,
+				}
+				mockDomain.EXPECT().MigrateSetMaxDowntime(uint64(500), uint32(0)).Times(1).Return(nil)
+
+				newMigrationMonitor(vmi, manager, options, migrationErrorChan).startMonitor()
+			})
+
+			It("migration should switch to PostCopy after the configured iterations", func() {
+				options := &cmdclient.MigrationOptions{
+					Bandwidth:               resource.MustParse("64Mi"),
+					ProgressTimeout:         150,
+					CompletionTimeoutPerGiB: 150,
+					AllowPostCopy:           true,
+					AllowWorkloadDisruption: true,
+					PostCopyAfterIterations: 3,
+				}
+				mockDomain.EXPECT().MigrateStartPostCopy(gomock.Eq(uint32(0))).Times(1).DoAndReturn(func(flag uint32) error {
+					Expect(iterations).To(BeEquivalentTo(3))
+					return nil
+				})
+
+				newMigrationMonitor(vmi, manager, options, migrationErrorChan).startMonitor()
+			})
+		})
+
 		It("migration should switch to PostCopy eventually", func() {
 			migrationErrorChan := make(chan error)
 			defer close(migrationErrorChan)
@@ -1950,6 +2220,29 @@ var _ = Describe("Manager", func() {
 			manager, _ := NewLibvirtDomainManager(mockConn, testVirtShareDir, testEphemeralDiskDir, nil, "/usr/share/OVMF", ephemeralDiskCreatorMock, metadataCache, nil, virtconfig.DefaultDiskVerificationMemoryLimitBytes)
 			Expect(manager.PrepareMigrationTarget(vmi, true, &cmdv1.VirtualMachineOptions{})).To(Succeed())
 		})
+		It("should apply a re-tuned bandwidth to the running migration", func() {
+			vmi := newVMI(testNamespace, testVmName)
+			vmi.Status.MigrationState = &v1.VirtualMachineInstanceMigrationState{
+				MigrationUID: "111222333",
+			}
+			metadataCache.Migration.Store(api.MigrationMetadata{
+				UID:            vmi.Status.MigrationState.MigrationUID,
+				StartTimestamp: virtpointer.P(metav1.Now()),
+				Bandwidth:      64,
+			})
+
+			manager, _ := NewLibvirtDomainManager(mockConn, testVirtShareDir, testEphemeralDiskDir, nil, "/usr/share/OVMF", ephemeralDiskCreatorMock, metadataCache, nil, virtconfig.DefaultDiskVerificationMemoryLimitBytes)
+			mockConn.EXPECT().LookupDomainByName(testDomainName).DoAndReturn(mockDomainWithFreeExpectation)
+			mockDomain.EXPECT().MigrateSetMaxSpeed(uint64(128), libvirt.DomainMigrateMaxSpeedFlags(0)).Return(nil)
+
+			options := &cmdclient.MigrationOptions{Bandwidth: resource.MustParse("128Mi")}
+			Expect(manager.MigrateVMI(vmi, options)).To(Succeed())
+			migration, _ := metadataCache.Migration.Load()
+			Expect(migration.Bandwidth).To(Equal(uint64(128)))
+
+			// The migration already uses the bandwidth
+			Expect(manager.MigrateVMI(vmi, options)).To(Succeed())
+		})
 		It("should verify that migration failure is set in the monitor thread", func() {
 			fake_jobinfo := func() *libvirt.DomainJobInfo {
 				return &libvirt.DomainJobInfo{
@@ -1995,6 +2288,84 @@ var _ = Describe("Manager", func() {
 			}, 5*time.Second, 2).Should(BeTrue(), fmt.Sprintf("failed migration result wasn't set [%+v]", migration))
 		})
 
+		Context("with a failed post copy migration", func() {
+			var manager DomainManager
+
+			BeforeEach(func() {
+				backoff := postCopyRecoveryBackoff
+				postCopyRecoveryBackoff = 0
+				DeferCleanup(func() { postCopyRecoveryBackoff = backoff })
+
+				vmi := newVMI(testNamespace, testVmName)
+				domainSpec := expectedDomainFor(vmi)
+				domainSpec.Metadata.KubeVirt.Migration = &api.MigrationMetadata{}
+				domainXml, err := xml.MarshalIndent(domainSpec, "", "\t")
+				Expect(err).ToNot(HaveOccurred())
+
+				manager, _ = NewLibvirtDomainManager(mockConn, testVirtShareDir, testEphemeralDiskDir, nil, "/usr/share/OVMF", ephemeralDiskCreatorMock, metadataCache, nil, virtconfig.DefaultDiskVerificationMemoryLimitBytes)
+				mockConn.EXPECT().LookupDomainByName(testDomainName).AnyTimes().DoAndReturn(mockDomainWithFreeExpectation)
+				mockDomain.EXPECT().GetState().AnyTimes().Return(libvirt.DOMAIN_PAUSED, int(libvirt.DOMAIN_PAUSED_POSTCOPY_FAILED), nil)
+				mockDomain.EXPECT().GetXMLDesc(gomock.Any()).AnyTimes().Return(string(domainXml), nil)
+			})
+
+			migrateVMI := func(jobType libvirt.DomainJobType) {
+				mockDomain.EXPECT().GetJobStats(libvirt.DomainGetJobStatsFlags(0)).AnyTimes().Return(&libvirt.DomainJobInfo{Type: jobType}, nil)
+				vmi := newVMI(testNamespace, testVmName)
+				vmi.Status.MigrationState = &v1.VirtualMachineInstanceMigrationState{MigrationUID: "111222333"}
+				options := &cmdclient.MigrationOptions{
+					Bandwidth:               resource.MustParse("64Mi"),
+					ProgressTimeout:         150,
+					CompletionTimeoutPerGiB: 300,
+					AllowPostCopy:           true,
+				}
+				Expect(manager.MigrateVMI(vmi, options)).To(Succeed())
+			}
+
+			It("should resume the migration", func() {
+				mockDomain.EXPECT().MigrateToURI3(gomock.Any(), gomock.Any(), gomock.Any()).Times(2).DoAndReturn(
+					func(_ string, _ *libvirt.DomainMigrateParameters, flags libvirt.DomainMigrateFlags) error {
+						if flags&libvirt.MIGRATE_POSTCOPY_RESUME == 0 {
+							return fmt.Errorf("connection to the target broke")
+						}
+						return nil
+					})
+
+				migrateVMI(libvirt.DOMAIN_JOB_COMPLETED)
+
+				Eventually(func() bool {
+					migration, _ := metadataCache.Migration.Load()
+					return migration.PostCopyRecovered
+				}, 5*time.Second, 100*time.Millisecond).Should(BeTrue())
+				migration, _ := metadataCache.Migration.Load()
+				Expect(migration.PostCopyRecoveryAttempts).To(Equal(1))
+				Expect(migration.Failed).To(BeFalse())
+			})
+
+			It("should fail the migration once all recovery attempts failed", func() {
+				// Give the monitor the chance to observe the failed job while the recovery is ongoing
+				postCopyRecoveryBackoff = 300 * time.Millisecond
+				mockDomain.EXPECT().MigrateToURI3(gomock.Any(), gomock.Any(), gomock.Any()).Times(postCopyRecoveryAttempts + 1).DoAndReturn(
+					func(_ string, _ *libvirt.DomainMigrateParameters, _ libvirt.DomainMigrateFlags) error {
+						// The monitor has to wait for the recovery even though the job failed
+						metadataCache.Migration.WithSafeBlock(func(migration *api.MigrationMetadata, _ bool) {
+							migration.Mode = v1.MigrationPostCopy
+						})
+						return fmt.Errorf("connection to the target broke")
+					})
+
+				migrateVMI(libvirt.DOMAIN_JOB_FAILED)
+
+				Eventually(func() bool {
+					migration, _ := metadataCache.Migration.Load()
+					return migration.Failed
+				}, 5*time.Second, 100*time.Millisecond).Should(BeTrue())
+				migration, _ := metadataCache.Migration.Load()
+				Expect(migration.PostCopyRecoveryAttempts).To(Equal(postCopyRecoveryAttempts))
+				Expect(migration.PostCopyRecovered).To(BeFalse())
+				Expect(migration.FailureReason).To(ContainSubstring("connection to the target broke"))
+			})
+		})
+
 		It("should detect inprogress migration job", func() {
 			vmi := newVMI(testNamespace, testVmName)
 			vmi.Status.MigrationState = &v1.VirtualMachineInstanceMigrationState{
@@ -2005,6 +2376,7 @@ var _ = Describe("Manager", func() {
 			startupMigrationMetadata.UID = vmi.Status.MigrationState.MigrationUID
 			t := metav1.Now()
 			startupMigrationMetadata.StartTimestamp = &t
+			startupMigrationMetadata.Bandwidth = 64
 			metadataCache.Migration.Store(startupMigrationMetadata)
 			manager, _ := NewLibvirtDomainManager(mockConn, testVirtShareDir, testEphemeralDiskDir, nil, "/usr/share/OVMF", ephemeralDiskCreatorMock, metadataCache, nil, virtconfig.DefaultDiskVerificationMemoryLimitBytes)
 
@@ -2191,6 +2563,64 @@ var _ = Describe("Manager", func() {
 			Expect(err).ToNot(HaveOccurred())
 			Expect(domStats).ToNot(BeNil())
 		})
+
+		It("should report the guest clock drift measured by the agent", func() {
+			mockConn.EXPECT().GetDomainStats(gomock.Any(), gomock.Any(), gomock.Any()).Return([]*stats.DomainStats{{}}, nil)
+
+			agentStore := agentpoller.NewAsyncAgentStore()
+			agentStore.Store(agentpoller.GET_TIME, -1500*time.Millisecond)
+			manager, _ := NewLibvirtDomainManager(mockConn, testVirtShareDir, testEphemeralDiskDir, &agentStore, "/usr/share/OVMF", ephemeralDiskCreatorMock, metadataCache, nil, virtconfig.DefaultDiskVerificationMemoryLimitBytes)
+			domStats, err := manager.GetDomainStats()
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(domStats.GuestClockDriftSet).To(BeTrue())
+			Expect(domStats.GuestClockDrift).To(Equal(-1.5))
+		})
+	})
+
+	Context("block latency histograms", func() {
+		It("should report the latency histograms built from the block stats of libvirt", func() {
+			newDomainStats := func(rdReqs, rdTimes uint64) []*stats.DomainStats {
+				return []*stats.DomainStats{{
+					Name: testDomainName,
+					Block: []stats.DomainStatsBlock{{
+						Name: "vda", Alias: "rootdisk",
+						RdReqsSet: true, RdReqs: rdReqs, RdTimesSet: true, RdTimes: rdTimes,
+					}},
+				}}
+			}
+			gomock.InOrder(
+				mockConn.EXPECT().GetDomainStats(gomock.Any(), gomock.Any(), gomock.Any()).Return(newDomainStats(0, 0), nil),
+				mockConn.EXPECT().GetDomainStats(gomock.Any(), gomock.Any(), gomock.Any()).Return(newDomainStats(2, 400_000), nil),
+			)
+
+			manager, _ := NewLibvirtDomainManager(mockConn, testVirtShareDir, testEphemeralDiskDir, nil, "/usr/share/OVMF", ephemeralDiskCreatorMock, metadataCache, nil, virtconfig.DefaultDiskVerificationMemoryLimitBytes)
+			manager.(*LibvirtDomainManager).blockLatencyHistograms.Store(true)
+			_, err := manager.(*LibvirtDomainManager).getDomainStats()
+			Expect(err).ToNot(HaveOccurred())
+			domStats, err := manager.(*LibvirtDomainManager).getDomainStats()
+			Expect(err).ToNot(HaveOccurred())
+
+			// two requests with 200us each
+			expectedBins := make([]uint64, len(statsconv.LatencyHistogramBoundaries)+1)
+			expectedBins[2] = 2
+			Expect(domStats[0].Block[0].RdLatencyHistogram).To(Equal(&stats.DomainStatsLatencyHistogram{
+				Boundaries: statsconv.LatencyHistogramBoundaries,
+				Bins:       expectedBins,
+			}))
+		})
+
+		It("should not report the latency histograms unless they are enabled", func() {
+			mockConn.EXPECT().GetDomainStats(gomock.Any(), gomock.Any(), gomock.Any()).Return([]*stats.DomainStats{{
+				Name:  testDomainName,
+				Block: []stats.DomainStatsBlock{{Name: "vda", RdReqsSet: true, RdTimesSet: true}},
+			}}, nil)
+
+			manager, _ := NewLibvirtDomainManager(mockConn, testVirtShareDir, testEphemeralDiskDir, nil, "/usr/share/OVMF", ephemeralDiskCreatorMock, metadataCache, nil, virtconfig.DefaultDiskVerificationMemoryLimitBytes)
+			domStats, err := manager.(*LibvirtDomainManager).getDomainStats()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(domStats[0].Block[0].RdLatencyHistogram).To(BeNil())
+		})
 	})
 
 	Context("on failed GetDomainSpecWithRuntimeInfo", func() {
@@ -2319,6 +2749,26 @@ var _ = Describe("Manager", func() {
 		Expect(libvirtmanager.hotPlugHostDevices(vmi)).To(Succeed())
 	})
 
+	It("executes hotPlugHostDevices for GPUs allocated to the pod after the domain started", func() {
+		os.Setenv("PCI_RESOURCE_VENDOR_COM_GPU", "0000:81:01.0")
+		defer os.Unsetenv("PCI_RESOURCE_VENDOR_COM_GPU")
+
+		manager, _ := NewLibvirtDomainManager(mockConn, testVirtShareDir, testEphemeralDiskDir, nil, "/usr/share/OVMF", ephemeralDiskCreatorMock, metadataCache, nil, virtconfig.DefaultDiskVerificationMemoryLimitBytes)
+		libvirtmanager := manager.(*LibvirtDomainManager)
+
+		vmi := newVMI(testNamespace, testVmName)
+		domainSpec := expectedDomainFor(vmi)
+		xml, err := xml.MarshalIndent(domainSpec, "", "\t")
+		Expect(err).NotTo(HaveOccurred())
+		vmi.Spec.Domain.Devices.GPUs = []v1.GPU{{Name: "gpu1", DeviceName: "vendor.com/gpu"}}
+
+		mockConn.EXPECT().LookupDomainByName(testDomainName).DoAndReturn(mockDomainWithFreeExpectation)
+		mockDomain.EXPECT().GetXMLDesc(libvirt.DomainXMLFlags(0)).Return(string(xml), nil)
+		mockDomain.EXPECT().AttachDeviceFlags(`<hostdev type="pci" managed="no"><source><address type="pci" domain="0x0000" bus="0x81" slot="0x01" function="0x0"></address></source><alias name="ua-gpu-gpu1"></alias></hostdev>`, libvirt.DomainDeviceModifyFlags(3)).Return(nil)
+
+		Expect(libvirtmanager.hotPlugHostDevices(vmi)).To(Succeed())
+	})
+
 	It("executes GetGuestInfo", func() {
 		agentStore := agentpoller.NewAsyncAgentStore()
 		agentStore.Store(agentpoller.GET_USERS, []api.User{
@@ -3177,6 +3627,39 @@ var _ = Describe("Manager helper functions", func() {
 		})
 	})
 
+	Context("migration compression", func() {
+		zstd := &v1.MigrationCompression{Method: v1.MigrationCompressionZstd}
+
+		DescribeTable("should set the compressed flag", func(options *cmdclient.MigrationOptions, expectCompressed bool) {
+			flags := generateMigrationFlags(false, false, options)
+			Expect(flags&libvirt.MIGRATE_COMPRESSED != 0).To(Equal(expectCompressed))
+		},
+			Entry("with compression over parallel connections",
+				&cmdclient.MigrationOptions{ParallelMigrationThreads: virtpointer.P(uint(8)), Compression: zstd}, true),
+			Entry("not without compression", &cmdclient.MigrationOptions{ParallelMigrationThreads: virtpointer.P(uint(8))}, false),
+			Entry("not over a single connection", &cmdclient.MigrationOptions{Compression: zstd}, false),
+			Entry("not with post-copy allowed",
+				&cmdclient.MigrationOptions{ParallelMigrationThreads: virtpointer.P(uint(8)), Compression: zstd, AllowPostCopy: true}, false),
+		)
+
+		DescribeTable("should configure the compression parameters",
+			func(compression *v1.MigrationCompression, expected *libvirt.DomainMigrateParameters) {
+				params := &libvirt.DomainMigrateParameters{}
+				configureMigrationCompression(params, compression)
+				Expect(params).To(Equal(expected))
+			},
+			Entry("with the default level", zstd, &libvirt.DomainMigrateParameters{CompressionSet: true, Compression: "zstd"}),
+			Entry("with a zlib level",
+				&v1.MigrationCompression{Method: v1.MigrationCompressionZlib, Level: virtpointer.P(int32(6))},
+				&libvirt.DomainMigrateParameters{CompressionSet: true, Compression: "zlib", CompressionZlibLevelSet: true, CompressionZlibLevel: 6},
+			),
+			Entry("with a zstd level",
+				&v1.MigrationCompression{Method: v1.MigrationCompressionZstd, Level: virtpointer.P(int32(15))},
+				&libvirt.DomainMigrateParameters{CompressionSet: true, Compression: "zstd", CompressionZstdLevelSet: true, CompressionZstdLevel: 15},
+			),
+		)
+	})
+
 })
 
 func newVMI(namespace, name string) *v1.VirtualMachineInstance {
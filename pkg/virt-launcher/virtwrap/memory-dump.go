@@ -0,0 +1,242 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package virtwrap
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/sys/unix"
+	"libvirt.org/go/libvirt"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/cli"
+)
+
+const defaultMemoryDumpChunkSize = 64 * 1024 * 1024
+
+var memoryDumpProgressInterval = 2 * time.Second
+
+var memoryDumpCompressors = map[v1.MemoryDumpCompression][]string{
+	v1.MemoryDumpCompressionGzip: {"gzip", "-c"},
+	v1.MemoryDumpCompressionZstd: {"zstd", "-q", "-c"},
+}
+
+// memoryDumpVolume returns the memory dump volume of the vmi the dump is written to
+func memoryDumpVolume(vmi *v1.VirtualMachineInstance, dumpPath string) *v1.MemoryDumpVolumeSource {
+	volumeName := filepath.Base(filepath.Dir(dumpPath))
+	for _, volume := range vmi.Spec.Volumes {
+		if volume.Name == volumeName && volume.MemoryDump != nil {
+			return volume.MemoryDump
+		}
+	}
+	return nil
+}
+
+func streamsMemoryDump(volume *v1.MemoryDumpVolumeSource) bool {
+	return volume != nil && (volume.Compression != "" || volume.ChunkSize != nil)
+}
+
+// dumpMemory lets libvirt write the dump directly to the volume, unless the dump has to be compressed or written
+// in chunks. In that case the dump is passed through a pipe so that the volume never holds the uncompressed dump
+// and the page cache of the node is not filled up with it.
+func (l *LibvirtDomainManager) dumpMemory(dom cli.VirDomain, volume *v1.MemoryDumpVolumeSource, dumpPath string) error {
+	stopProgress := l.monitorMemoryDumpProgress(dom)
+	defer stopProgress()
+
+	if !streamsMemoryDump(volume) {
+		return dom.CoreDumpWithFormat(dumpPath, libvirt.DOMAIN_CORE_DUMP_FORMAT_RAW, libvirt.DUMP_MEMORY_ONLY)
+	}
+	return l.streamMemoryDump(dom, volume, dumpPath)
+}
+
+func (l *LibvirtDomainManager) streamMemoryDump(dom cli.VirDomain, volume *v1.MemoryDumpVolumeSource, dumpPath string) error {
+	pipeDir, err := os.MkdirTemp(l.virtShareDir, "memory-dump")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(pipeDir)
+
+	pipePath := filepath.Join(pipeDir, "dump.pipe")
+	if err := unix.Mkfifo(pipePath, 0600); err != nil {
+		return fmt.Errorf("failed to create the memory dump pipe: %v", err)
+	}
+	// Opening the read end without blocking and holding a write end of our own makes sure that the reader neither
+	// waits forever if libvirt fails before opening the pipe, nor sees the end of the dump before libvirt started.
+	pipeReader, err := os.OpenFile(pipePath, os.O_RDONLY|unix.O_NONBLOCK, 0)
+	if err != nil {
+		return err
+	}
+	defer pipeReader.Close()
+	pipeWriter, err := os.OpenFile(pipePath, os.O_WRONLY, 0)
+	if err != nil {
+		return err
+	}
+	// The compressor inherits the read end and expects blocking reads
+	if err := unix.SetNonblock(int(pipeReader.Fd()), false); err != nil {
+		pipeWriter.Close()
+		return err
+	}
+
+	dumpFile, err := os.OpenFile(dumpPath, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0644)
+	if err != nil {
+		pipeWriter.Close()
+		return err
+	}
+	chunkSize := int64(defaultMemoryDumpChunkSize)
+	if volume.ChunkSize != nil && volume.ChunkSize.Value() > 0 {
+		chunkSize = volume.ChunkSize.Value()
+	}
+	writer := &chunkedWriter{file: dumpFile, chunkSize: chunkSize}
+
+	copyErr := make(chan error, 1)
+	go func() {
+		copyErr <- copyMemoryDump(pipeReader, writer, volume.Compression)
+	}()
+
+	dumpErr := dom.CoreDumpWithFormat(pipePath, libvirt.DOMAIN_CORE_DUMP_FORMAT_RAW, libvirt.DUMP_MEMORY_ONLY)
+	pipeWriter.Close()
+	err = <-copyErr
+	if dumpErr != nil {
+		dumpFile.Close()
+		return dumpErr
+	}
+	if err != nil {
+		dumpFile.Close()
+		return fmt.Errorf("failed to write the memory dump: %v", err)
+	}
+	if err := writer.sync(); err != nil {
+		dumpFile.Close()
+		return err
+	}
+	return dumpFile.Close()
+}
+
+func copyMemoryDump(dump *os.File, writer io.Writer, compression v1.MemoryDumpCompression) error {
+	if compression == "" {
+		_, err := io.Copy(writer, dump)
+		return err
+	}
+
+	args, ok := memoryDumpCompressors[compression]
+	if !ok {
+		return fmt.Errorf("unsupported memory dump compression %s", compression)
+	}
+	var stderr bytes.Buffer
+	cmd := exec.Command(args[0], args[1:]...)
+	cmd.Stdin = dump
+	cmd.Stdout = writer
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("%s failed: %v: %s", args[0], err, strings.TrimSpace(stderr.String()))
+	}
+	return nil
+}
+
+// chunkedWriter flushes the dump to the volume every chunk and drops the flushed chunk from the page cache
+type chunkedWriter struct {
+	file      *os.File
+	chunkSize int64
+	// synced is the offset up to which the dump is flushed
+	synced  int64
+	written int64
+}
+
+func (w *chunkedWriter) Write(p []byte) (int, error) {
+	n, err := w.file.Write(p)
+	w.written += int64(n)
+	if err != nil {
+		return n, err
+	}
+	if w.written-w.synced >= w.chunkSize {
+		if err := w.sync(); err != nil {
+			return n, err
+		}
+	}
+	return n, nil
+}
+
+func (w *chunkedWriter) sync() error {
+	if w.written == w.synced {
+		return nil
+	}
+	fd := int(w.file.Fd())
+	if err := unix.Fdatasync(fd); err != nil {
+		return fmt.Errorf("failed to flush the memory dump: %v", err)
+	}
+	if err := unix.Fadvise(fd, w.synced, w.written-w.synced, unix.FADV_DONTNEED); err != nil {
+		log.Log.Reason(err).V(4).Info("failed to drop the memory dump from the page cache")
+	}
+	w.synced = w.written
+	return nil
+}
+
+// monitorMemoryDumpProgress reports the progress of the dump job in the memory dump metadata until the returned
+// function is called
+func (l *LibvirtDomainManager) monitorMemoryDumpProgress(dom cli.VirDomain) func() {
+	stop := make(chan struct{})
+	wg := sync.WaitGroup{}
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		ticker := time.NewTicker(memoryDumpProgressInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-stop:
+				return
+			case <-ticker.C:
+				jobInfo, err := dom.GetJobInfo()
+				if err != nil {
+					log.Log.Reason(err).V(4).Info("failed to get the memory dump job info")
+					continue
+				}
+				l.setMemoryDumpProgress(jobInfo)
+			}
+		}
+	}()
+	return func() {
+		close(stop)
+		wg.Wait()
+	}
+}
+
+func (l *LibvirtDomainManager) setMemoryDumpProgress(jobInfo *libvirt.DomainJobInfo) {
+	if !jobInfo.MemTotalSet || !jobInfo.MemProcessedSet {
+		return
+	}
+	l.metadataCache.MemoryDump.WithSafeBlock(func(memoryDumpMetadata *api.MemoryDumpMetadata, initialized bool) {
+		if !initialized || memoryDumpMetadata.Completed {
+			return
+		}
+		memoryDumpMetadata.DataProcessed = jobInfo.MemProcessed
+		memoryDumpMetadata.DataTotal = jobInfo.MemTotal
+	})
+}
@@ -71,6 +71,10 @@ type DomainStats struct {
 	CPUMapSet bool
 	CPUMap    [][]bool
 	NrVirtCpu uint
+	// GuestClockDrift is the offset of the guest clock from the host clock in seconds, measured
+	// through the guest agent
+	GuestClockDriftSet bool
+	GuestClockDrift    float64
 }
 
 type DomainStatsCPU struct {
@@ -148,6 +152,19 @@ type DomainStatsBlock struct {
 	Capacity        uint64
 	PhysicalSet     bool
 	Physical        uint64
+	// Latency histograms built from the request counters, nil unless they are enabled
+	RdLatencyHistogram *DomainStatsLatencyHistogram `json:"RdLatencyHistogram,omitempty"`
+	WrLatencyHistogram *DomainStatsLatencyHistogram `json:"WrLatencyHistogram,omitempty"`
+	FlLatencyHistogram *DomainStatsLatencyHistogram `json:"FlLatencyHistogram,omitempty"`
+}
+
+// DomainStatsLatencyHistogram holds the number of requests completed in each
+// latency interval. The bins are delimited by the boundaries in nanoseconds,
+// the first bin counting requests faster than the first boundary and the last
+// one requests slower than the last boundary.
+type DomainStatsLatencyHistogram struct {
+	Boundaries []uint64
+	Bins       []uint64
 }
 
 // mimic existing structs, but data is taken from
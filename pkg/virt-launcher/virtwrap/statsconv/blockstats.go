@@ -0,0 +1,119 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright the KubeVirt Authors.
+ *
+ */
+
+package statsconv
+
+import (
+	"slices"
+	"sync"
+
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/stats"
+)
+
+// LatencyHistogramBoundaries are the boundaries, in nanoseconds, of the
+// latency histograms of the disks.
+var LatencyHistogramBoundaries = []uint64{
+	50_000,        // 50us
+	100_000,       // 100us
+	250_000,       // 250us
+	500_000,       // 500us
+	1_000_000,     // 1ms
+	2_500_000,     // 2.5ms
+	5_000_000,     // 5ms
+	10_000_000,    // 10ms
+	25_000_000,    // 25ms
+	50_000_000,    // 50ms
+	100_000_000,   // 100ms
+	250_000_000,   // 250ms
+	500_000_000,   // 500ms
+	1_000_000_000, // 1s
+	5_000_000_000, // 5s
+}
+
+// LatencyHistograms builds the latency histograms of the disks from the block
+// stats of libvirt. libvirt only reports the number of requests and the time
+// spent on them, so the requests completed between two samples are counted in
+// the bin of their average latency. The bins add up to the requests since the
+// first sample, only the spread of the latency within a sample is lost.
+// The zero value is ready to use.
+type LatencyHistograms struct {
+	lock  sync.Mutex
+	disks map[string]*diskLatencyHistograms
+}
+
+type diskLatencyHistograms struct {
+	rd latencyHistogram
+	wr latencyHistogram
+	fl latencyHistogram
+}
+
+type latencyHistogram struct {
+	sampled bool
+	reqs    uint64
+	times   uint64
+	bins    []uint64
+}
+
+// Add samples the request counters of the blocks and adds the histograms to them
+func (h *LatencyHistograms) Add(blocks []stats.DomainStatsBlock) {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	disks := make(map[string]*diskLatencyHistograms, len(blocks))
+	for i := range blocks {
+		block := &blocks[i]
+		key := block.Alias
+		if key == "" {
+			key = block.Name
+		}
+		disk, exists := h.disks[key]
+		if !exists {
+			disk = &diskLatencyHistograms{}
+		}
+		disks[key] = disk
+
+		block.RdLatencyHistogram = disk.rd.sample(block.RdReqsSet && block.RdTimesSet, block.RdReqs, block.RdTimes)
+		block.WrLatencyHistogram = disk.wr.sample(block.WrReqsSet && block.WrTimesSet, block.WrReqs, block.WrTimes)
+		block.FlLatencyHistogram = disk.fl.sample(block.FlReqsSet && block.FlTimesSet, block.FlReqs, block.FlTimes)
+	}
+	// Unplugged disks are dropped
+	h.disks = disks
+}
+
+func (h *latencyHistogram) sample(set bool, reqs, times uint64) *stats.DomainStatsLatencyHistogram {
+	if !set {
+		return nil
+	}
+	if !h.sampled || reqs < h.reqs || times < h.times {
+		// The requests before the first sample are unknown, and the counters start over if the disk is replaced
+		h.sampled = true
+		h.bins = make([]uint64, len(LatencyHistogramBoundaries)+1)
+	} else if completed := reqs - h.reqs; completed > 0 {
+		averageLatency := (times - h.times) / completed
+		bin, _ := slices.BinarySearch(LatencyHistogramBoundaries, averageLatency)
+		h.bins[bin] += completed
+	}
+	h.reqs = reqs
+	h.times = times
+
+	return &stats.DomainStatsLatencyHistogram{
+		Boundaries: LatencyHistogramBoundaries,
+		Bins:       slices.Clone(h.bins),
+	}
+}
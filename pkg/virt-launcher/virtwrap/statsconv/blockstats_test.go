@@ -0,0 +1,99 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright the KubeVirt Authors.
+ *
+ */
+
+package statsconv
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/stats"
+)
+
+var _ = Describe("Block latency histograms", func() {
+	var histograms *LatencyHistograms
+
+	newBlock := func(alias string, rdReqs, rdTimes uint64) stats.DomainStatsBlock {
+		return stats.DomainStatsBlock{
+			Name: "vda", Alias: alias,
+			RdReqsSet: true, RdReqs: rdReqs, RdTimesSet: true, RdTimes: rdTimes,
+		}
+	}
+
+	sample := func(blocks ...stats.DomainStatsBlock) []stats.DomainStatsBlock {
+		histograms.Add(blocks)
+		return blocks
+	}
+
+	bins := func(counts map[int]uint64) []uint64 {
+		bins := make([]uint64, len(LatencyHistogramBoundaries)+1)
+		for bin, count := range counts {
+			bins[bin] = count
+		}
+		return bins
+	}
+
+	BeforeEach(func() {
+		histograms = &LatencyHistograms{}
+	})
+
+	It("should count the requests since the first sample in the bin of their average latency", func() {
+		blocks := sample(newBlock("rootdisk", 100, 1_000_000_000))
+		Expect(blocks[0].RdLatencyHistogram).To(Equal(&stats.DomainStatsLatencyHistogram{
+			Boundaries: LatencyHistogramBoundaries,
+			Bins:       bins(nil),
+		}))
+
+		// 10 requests with 200us each, 5 requests with 2ms each
+		sample(newBlock("rootdisk", 110, 1_002_000_000))
+		blocks = sample(newBlock("rootdisk", 115, 1_012_000_000))
+		Expect(blocks[0].RdLatencyHistogram.Bins).To(Equal(bins(map[int]uint64{2: 10, 5: 5})))
+	})
+
+	It("should count requests slower than the last boundary in the last bin", func() {
+		sample(newBlock("rootdisk", 0, 0))
+		blocks := sample(newBlock("rootdisk", 1, 10_000_000_000))
+		Expect(blocks[0].RdLatencyHistogram.Bins).To(Equal(bins(map[int]uint64{len(LatencyHistogramBoundaries): 1})))
+	})
+
+	It("should start over when the counters are reset", func() {
+		sample(newBlock("rootdisk", 0, 0))
+		sample(newBlock("rootdisk", 10, 2_000_000))
+		blocks := sample(newBlock("rootdisk", 5, 1_000_000))
+		Expect(blocks[0].RdLatencyHistogram.Bins).To(Equal(bins(nil)))
+	})
+
+	It("should keep the histograms of the disks apart and drop unplugged disks", func() {
+		sample(newBlock("rootdisk", 0, 0), newBlock("datadisk", 0, 0))
+		blocks := sample(newBlock("rootdisk", 1, 200_000), newBlock("datadisk", 1, 2_000_000))
+		Expect(blocks[0].RdLatencyHistogram.Bins).To(Equal(bins(map[int]uint64{2: 1})))
+		Expect(blocks[1].RdLatencyHistogram.Bins).To(Equal(bins(map[int]uint64{5: 1})))
+
+		sample(newBlock("rootdisk", 1, 200_000))
+		blocks = sample(newBlock("rootdisk", 1, 200_000), newBlock("datadisk", 2, 4_000_000))
+		Expect(blocks[1].RdLatencyHistogram.Bins).To(Equal(bins(nil)))
+	})
+
+	It("should not report histograms without the counters", func() {
+		blocks := sample(stats.DomainStatsBlock{Name: "vda", Alias: "rootdisk"})
+		Expect(blocks[0].RdLatencyHistogram).To(BeNil())
+		Expect(blocks[0].WrLatencyHistogram).To(BeNil())
+		Expect(blocks[0].FlLatencyHistogram).To(BeNil())
+	})
+})
@@ -274,7 +274,9 @@ var Testdataexpected = `{
    ],
    "CPUMapSet": false,
    "CPUMap": null,
-   "NrVirtCpu": 0
+   "NrVirtCpu": 0,
+   "GuestClockDriftSet": false,
+   "GuestClockDrift": 0
  }`
 
 func LoadStats() ([]libvirt.DomainStats, error) {
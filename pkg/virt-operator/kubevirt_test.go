@@ -87,8 +87,8 @@ const (
 
 	NAMESPACE = "kubevirt-test"
 
-	resourceCount = 78
-	patchCount    = 50
+	resourceCount = 81
+	patchCount    = 53
 	updateCount   = 29
 )
 
@@ -1212,6 +1212,7 @@ func (k *KubeVirtTestData) addAllWithExclusionMap(config *util.KubeVirtDeploymen
 		components.NewVirtualMachineClusterInstancetypeCrd, components.NewVirtualMachinePoolCrd,
 		components.NewMigrationPolicyCrd, components.NewVirtualMachinePreferenceCrd,
 		components.NewVirtualMachineClusterPreferenceCrd, components.NewVirtualMachineCloneCrd,
+		components.NewImagePrefetchCrd, components.NewVirtualMachineSnapshotScheduleCrd,
 	}
 	for _, f := range functions {
 		crd, err := f()
@@ -1232,6 +1233,7 @@ func (k *KubeVirtTestData) addAllWithExclusionMap(config *util.KubeVirtDeploymen
 	all = append(all, components.NewPrometheusService(NAMESPACE))
 	all = append(all, components.NewApiServerService(NAMESPACE))
 	all = append(all, components.NewExportProxyService(NAMESPACE))
+	all = append(all, components.NewControllerService(NAMESPACE))
 
 	apiDeployment := getDefaultVirtApiDeployment(NAMESPACE, config)
 	apiDeploymentPdb := components.NewPodDisruptionBudgetForDeployment(apiDeployment)
@@ -2416,8 +2418,8 @@ var _ = Describe("KubeVirt Operator", func() {
 			Expect(kvTestData.controller.stores.ClusterRoleBindingCache.List()).To(HaveLen(7))
 			Expect(kvTestData.controller.stores.RoleCache.List()).To(HaveLen(5))
 			Expect(kvTestData.controller.stores.RoleBindingCache.List()).To(HaveLen(5))
-			Expect(kvTestData.controller.stores.OperatorCrdCache.List()).To(HaveLen(16))
-			Expect(kvTestData.controller.stores.ServiceCache.List()).To(HaveLen(4))
+			Expect(kvTestData.controller.stores.OperatorCrdCache.List()).To(HaveLen(18))
+			Expect(kvTestData.controller.stores.ServiceCache.List()).To(HaveLen(5))
 			Expect(kvTestData.controller.stores.DeploymentCache.List()).To(HaveLen(1))
 			Expect(kvTestData.controller.stores.DaemonSetCache.List()).To(BeEmpty())
 			Expect(kvTestData.controller.stores.ValidationWebhookCache.List()).To(HaveLen(3))
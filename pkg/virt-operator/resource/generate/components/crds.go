@@ -44,6 +44,7 @@ import (
 	instancetypev1alpha2 "kubevirt.io/api/instancetype/v1alpha2"
 	instancetypev1beta1 "kubevirt.io/api/instancetype/v1beta1"
 	poolv1 "kubevirt.io/api/pool/v1alpha1"
+	prefetchv1 "kubevirt.io/api/prefetch/v1alpha1"
 	snapshotv1alpha1 "kubevirt.io/api/snapshot/v1alpha1"
 	snapshotv1beta1 "kubevirt.io/api/snapshot/v1beta1"
 
@@ -66,9 +67,11 @@ var (
 	VIRTUALMACHINEPOOL               = "virtualmachinepools." + poolv1.SchemeGroupVersion.Group
 	VIRTUALMACHINESNAPSHOT           = "virtualmachinesnapshots." + snapshotv1beta1.SchemeGroupVersion.Group
 	VIRTUALMACHINESNAPSHOTCONTENT    = "virtualmachinesnapshotcontents." + snapshotv1beta1.SchemeGroupVersion.Group
+	VIRTUALMACHINESNAPSHOTSCHEDULE   = "virtualmachinesnapshotschedules." + snapshotv1beta1.SchemeGroupVersion.Group
 	VIRTUALMACHINEEXPORT             = "virtualmachineexports." + exportv1beta1.SchemeGroupVersion.Group
 	MIGRATIONPOLICY                  = "migrationpolicies." + migrationsv1.MigrationPolicyKind.Group
 	VIRTUALMACHINECLONE              = "virtualmachineclones." + clone.GroupName
+	IMAGEPREFETCH                    = "imageprefetches." + prefetchv1.SchemeGroupVersion.Group
 )
 
 func addFieldsToVersion(version *extv1.CustomResourceDefinitionVersion, fields ...interface{}) error {
@@ -80,6 +83,8 @@ func addFieldsToVersion(version *extv1.CustomResourceDefinitionVersion, fields .
 			version.Subresources = v
 		case *extv1.CustomResourceValidation:
 			version.Schema = v
+		case []extv1.SelectableField:
+			version.SelectableFields = v
 		default:
 			return fmt.Errorf("cannot add field of type %T to a CustomResourceDefinitionVersion", v)
 		}
@@ -167,8 +172,14 @@ func NewVirtualMachineInstanceCrd() (*extv1.CustomResourceDefinition, error) {
 		{Name: "IP", Type: "string", JSONPath: ".status.interfaces[0].ipAddress"},
 		{Name: "NodeName", Type: "string", JSONPath: ".status.nodeName"},
 		{Name: "Ready", Type: "string", JSONPath: ".status.conditions[?(@.type=='Ready')].status"},
-		{Name: "Live-Migratable", Type: "string", JSONPath: ".status.conditions[?(@.type=='LiveMigratable')].status", Priority: 1},
+		{Name: "Uptime", Type: "date", JSONPath: ".status.phaseTransitionTimestamps[?(@.phase=='Running')].phaseTransitionTimestamp"},
+		{Name: "Live-Migratable", Type: "string", JSONPath: ".status.conditions[?(@.type=='LiveMigratable')].status"},
+		{Name: "Agent", Type: "string", JSONPath: ".status.conditions[?(@.type=='AgentConnected')].status"},
 		{Name: "Paused", Type: "string", JSONPath: ".status.conditions[?(@.type=='Paused')].status", Priority: 1},
+	}, []extv1.SelectableField{
+		// Allows listing the VMIs of a node or in a phase with a field selector, on clusters with CustomResourceFieldSelectors
+		{JSONPath: ".status.nodeName"},
+		{JSONPath: ".status.phase"},
 	})
 	if err != nil {
 		return nil, err
@@ -327,6 +338,12 @@ func NewVirtualMachineInstanceMigrationCrd() (*extv1.CustomResourceDefinition, e
 				Description: "The current phase of VM instance migration"},
 			{Name: "VMI", Type: "string", JSONPath: ".spec.vmiName",
 				Description: "The name of the VMI to perform the migration on"},
+			{Name: "Source", Type: "string", JSONPath: ".status.migrationState.sourceNode",
+				Description: "The node the VMI is migrated from"},
+			{Name: "Target", Type: "string", JSONPath: ".status.migrationState.targetNode",
+				Description: "The node the VMI is migrated to"},
+			{Name: "Progress", Type: "integer", JSONPath: ".status.migrationState.progress.percentComplete",
+				Description: "The percentage of the guest memory transferred to the target node"},
 		}, &extv1.CustomResourceSubresources{
 			Status: &extv1.CustomResourceSubresourceStatus{},
 		})
@@ -608,6 +625,52 @@ func NewVirtualMachineRestoreCrd() (*extv1.CustomResourceDefinition, error) {
 	return crd, nil
 }
 
+func NewVirtualMachineSnapshotScheduleCrd() (*extv1.CustomResourceDefinition, error) {
+	crd := newBlankCrd()
+
+	crd.ObjectMeta.Name = VIRTUALMACHINESNAPSHOTSCHEDULE
+	crd.Spec = extv1.CustomResourceDefinitionSpec{
+		Group: snapshotv1beta1.SchemeGroupVersion.Group,
+		Versions: []extv1.CustomResourceDefinitionVersion{
+			{
+				Name:    snapshotv1beta1.SchemeGroupVersion.Version,
+				Served:  true,
+				Storage: true,
+			},
+		},
+		Scope: extv1.NamespaceScoped,
+		Names: extv1.CustomResourceDefinitionNames{
+			Plural:     "virtualmachinesnapshotschedules",
+			Singular:   "virtualmachinesnapshotschedule",
+			Kind:       "VirtualMachineSnapshotSchedule",
+			ShortNames: []string{"vmsnapshotschedule", "vmsnapshotschedules"},
+			Categories: []string{
+				"all",
+			},
+		},
+	}
+	err := addFieldsToAllVersions(crd,
+		&extv1.CustomResourceSubresources{
+			Status: &extv1.CustomResourceSubresourceStatus{},
+		},
+		[]extv1.CustomResourceColumnDefinition{
+			{Name: "Schedule", Type: "string", JSONPath: ".spec.schedule"},
+			{Name: "Suspend", Type: "boolean", JSONPath: ".spec.suspend"},
+			{Name: "LastScheduleTime", Type: "date", JSONPath: ".status.lastScheduleTime"},
+			{Name: "LastSuccessTime", Type: "date", JSONPath: ".status.lastSuccessTime"},
+			{Name: "Error", Type: "string", JSONPath: errorMessageJSONPath},
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = patchValidationForAllVersions(crd); err != nil {
+		return nil, err
+	}
+	return crd, nil
+}
+
 func NewVirtualMachineExportCrd() (*extv1.CustomResourceDefinition, error) {
 	crd := newBlankCrd()
 
@@ -914,6 +977,48 @@ func NewVirtualMachineCloneCrd() (*extv1.CustomResourceDefinition, error) {
 	return crd, nil
 }
 
+func NewImagePrefetchCrd() (*extv1.CustomResourceDefinition, error) {
+	crd := newBlankCrd()
+
+	crd.ObjectMeta.Name = IMAGEPREFETCH
+	crd.Spec = extv1.CustomResourceDefinitionSpec{
+		Group: prefetchv1.SchemeGroupVersion.Group,
+		Versions: []extv1.CustomResourceDefinitionVersion{
+			{
+				Name:    prefetchv1.SchemeGroupVersion.Version,
+				Served:  true,
+				Storage: true,
+			},
+		},
+		Scope: extv1.NamespaceScoped,
+		Names: extv1.CustomResourceDefinitionNames{
+			Plural:     "imageprefetches",
+			Singular:   "imageprefetch",
+			ShortNames: []string{"prefetch", "prefetches"},
+			Kind:       prefetchv1.ImagePrefetchKind,
+		},
+	}
+	err := addFieldsToAllVersions(crd,
+		&extv1.CustomResourceSubresources{
+			Status: &extv1.CustomResourceSubresourceStatus{},
+		},
+		[]extv1.CustomResourceColumnDefinition{
+			{Name: "Phase", Type: "string", JSONPath: phaseJSONPath},
+			{Name: "Ready", Type: "integer", JSONPath: ".status.readinessPercentage",
+				Description: "Percentage of staged images and DataSources"},
+			{Name: "Age", Type: "date", JSONPath: creationTimestampJSONPath},
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = patchValidationForAllVersions(crd); err != nil {
+		return nil, err
+	}
+	return crd, nil
+}
+
 // NewKubeVirtPriorityClassCR is used for manifest generation
 func NewKubeVirtPriorityClassCR() *schedulingv1.PriorityClass {
 	return &schedulingv1.PriorityClass{
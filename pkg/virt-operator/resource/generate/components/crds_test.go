@@ -24,9 +24,22 @@ var _ = Describe("CRDs", func() {
 		Entry("for KV", NewKubeVirtCrd),
 		Entry("for VMSNAPSHOT", NewVirtualMachineSnapshotCrd),
 		Entry("for VMSNAPSHOTCONTENT", NewVirtualMachineSnapshotContentCrd),
+		Entry("for VMSNAPSHOTSCHEDULE", NewVirtualMachineSnapshotScheduleCrd),
 		Entry("for VMPOOL", NewVirtualMachinePoolCrd),
+		Entry("for IMAGEPREFETCH", NewImagePrefetchCrd),
 	)
 
+	It("VMI should allow field selectors on the node name and the phase", func() {
+		crd, err := NewVirtualMachineInstanceCrd()
+		Expect(err).NotTo(HaveOccurred())
+		for _, version := range crd.Spec.Versions {
+			Expect(version.SelectableFields).To(ConsistOf(
+				extv1.SelectableField{JSONPath: ".status.nodeName"},
+				extv1.SelectableField{JSONPath: ".status.phase"},
+			))
+		}
+	})
+
 	It("DataVolumeTemplates should have nullable a XPreserveUnknownFields on metadata", func() {
 		crd, err := NewVirtualMachineCrd()
 		Expect(err).NotTo(HaveOccurred())
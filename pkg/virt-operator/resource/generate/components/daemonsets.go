@@ -27,8 +27,17 @@ const (
 	prVolumeName    = "pr-helper-socket-vol"
 	devDirVol       = "dev-dir"
 	SidecarShimName = "sidecar-shim"
+
+	// EmergencyAPISocket is the socket of the emergency API of virt-handler on the node
+	EmergencyAPISocket = util.VirtPrivateDir + "/emergency-api.sock"
 )
 
+// EnableHandlerEmergencyAPI makes virt-handler serve the emergency API on the node
+func EnableHandlerEmergencyAPI(handler *appsv1.DaemonSet) {
+	container := &handler.Spec.Template.Spec.Containers[0]
+	container.Args = append(container.Args, "--emergency-api-socket", EmergencyAPISocket)
+}
+
 func RenderPrHelperContainer(image string, pullPolicy corev1.PullPolicy) corev1.Container {
 	bidi := corev1.MountPropagationBidirectional
 	return corev1.Container{
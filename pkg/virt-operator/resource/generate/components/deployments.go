@@ -118,6 +118,40 @@ func NewApiServerService(namespace string) *corev1.Service {
 	}
 }
 
+// NewControllerService exposes the scheduler extender of virt-controller. Only the leader passes the
+// readiness probe, so the service only routes to the instance whose informers are running.
+func NewControllerService(namespace string) *corev1.Service {
+	return &corev1.Service{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "v1",
+			Kind:       "Service",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: namespace,
+			Name:      VirtControllerServiceName,
+			Labels: map[string]string{
+				virtv1.AppLabel: VirtControllerName,
+			},
+		},
+		Spec: corev1.ServiceSpec{
+			Selector: map[string]string{
+				virtv1.AppLabel: VirtControllerName,
+			},
+			Ports: []corev1.ServicePort{
+				{
+					Port: 443,
+					TargetPort: intstr.IntOrString{
+						Type:   intstr.Int,
+						IntVal: 8443,
+					},
+					Protocol: corev1.ProtocolTCP,
+				},
+			},
+			Type: corev1.ServiceTypeClusterIP,
+		},
+	}
+}
+
 func NewExportProxyService(namespace string) *corev1.Service {
 	return &corev1.Service{
 		TypeMeta: metav1.TypeMeta{
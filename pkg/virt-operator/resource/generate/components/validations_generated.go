@@ -622,6 +622,140 @@ var CRDsValidation map[string]string = map[string]string{
   required:
   - spec
   type: object
+`,
+	"imageprefetch": `openAPIV3Schema:
+  description: |-
+    ImagePrefetch stages containerDisk images and DataSources on a set of nodes
+    ahead of a planned mass start of VirtualMachines.
+  properties:
+    apiVersion:
+      description: |-
+        APIVersion defines the versioned schema of this representation of an object.
+        Servers should convert recognized schemas to the latest internal value, and
+        may reject unrecognized values.
+        More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources
+      type: string
+    kind:
+      description: |-
+        Kind is a string value representing the REST resource this object represents.
+        Servers may infer this from the endpoint the client submits requests to.
+        Cannot be updated.
+        In CamelCase.
+        More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
+      type: string
+    metadata:
+      type: object
+    spec:
+      properties:
+        containerDisks:
+          description: ContainerDisks lists the containerDisk images which are pulled
+            on every selected node.
+          items:
+            properties:
+              image:
+                description: Image is the name of the containerDisk image.
+                type: string
+              imagePullPolicy:
+                description: ImagePullPolicy of the image. Defaults to IfNotPresent.
+                enum:
+                - Always
+                - Never
+                - IfNotPresent
+                type: string
+              imagePullSecret:
+                description: ImagePullSecret is the name of the Docker registry secret
+                  required to pull the image.
+                type: string
+            required:
+            - image
+            type: object
+          type: array
+          x-kubernetes-list-type: atomic
+        dataSources:
+          description: DataSources lists the DataSources whose volumes have to be
+            ready.
+          items:
+            properties:
+              name:
+                description: Name of the DataSource.
+                type: string
+              namespace:
+                description: Namespace of the DataSource. Defaults to the namespace
+                  of the ImagePrefetch.
+                type: string
+            required:
+            - name
+            type: object
+          type: array
+          x-kubernetes-list-type: atomic
+        nodeSelector:
+          additionalProperties:
+            type: string
+          description: |-
+            NodeSelector selects the nodes the containerDisk images are staged on.
+            All schedulable nodes are selected if it is empty.
+          type: object
+        readyBy:
+          description: |-
+            ReadyBy is the time of the planned event. A warning is emitted if the
+            prefetch did not complete by then.
+          format: date-time
+          nullable: true
+          type: string
+      type: object
+    status:
+      properties:
+        conditions:
+          items:
+            description: Condition defines conditions
+            properties:
+              lastProbeTime:
+                format: date-time
+                nullable: true
+                type: string
+              lastTransitionTime:
+                format: date-time
+                nullable: true
+                type: string
+              message:
+                type: string
+              reason:
+                type: string
+              status:
+                type: string
+              type:
+                description: ConditionType is the const type for Conditions
+                type: string
+            required:
+            - status
+            - type
+            type: object
+          type: array
+          x-kubernetes-list-type: atomic
+        phase:
+          type: string
+        readinessPercentage:
+          description: ReadinessPercentage is the share of staged items, nodes and
+            DataSources, in percent.
+          format: int32
+          type: integer
+        readyDataSources:
+          description: ReadyDataSources is the number of DataSources which are ready.
+          format: int32
+          type: integer
+        readyNodes:
+          description: ReadyNodes is the number of selected nodes which pulled all
+            containerDisk images.
+          format: int32
+          type: integer
+        targetNodes:
+          description: TargetNodes is the number of nodes selected by the node selector.
+          format: int32
+          type: integer
+      type: object
+  required:
+  - spec
+  type: object
 `,
 	"kubevirt": `openAPIV3Schema:
   description: KubeVirt represents the object deploying all KubeVirt resources
@@ -827,6 +961,179 @@ var CRDsValidation map[string]string = map[string]string{
                   type: object
               type: object
               x-kubernetes-map-type: atomic
+            canaryConfiguration:
+              description: |-
+                CanaryConfiguration enables a canary which periodically creates, boots, probes and deletes a small
+                VirtualMachineInstance and reports the duration and the result of each phase as metrics
+              nullable: true
+              properties:
+                image:
+                  description: Image is the containerDisk image booted by the canary
+                    VirtualMachineInstance
+                  type: string
+                interval:
+                  description: Interval is the time between the starts of two canary
+                    runs. Defaults to 10m
+                  type: string
+                memory:
+                  anyOf:
+                  - type: integer
+                  - type: string
+                  description: Memory is the guest memory of the canary VirtualMachineInstance.
+                    Defaults to 128Mi
+                  pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                  x-kubernetes-int-or-string: true
+                namespace:
+                  description: |-
+                    Namespace is the namespace the canary VirtualMachineInstance is created in.
+                    Defaults to the namespace KubeVirt is installed in
+                  type: string
+                nodeSelector:
+                  additionalProperties:
+                    type: string
+                  description: NodeSelector restricts the nodes the canary VirtualMachineInstance
+                    is scheduled on
+                  type: object
+                readinessProbe:
+                  description: |-
+                    ReadinessProbe has to succeed before the canary VirtualMachineInstance counts as ready.
+                    Without a probe the canary is ready once it is running
+                  properties:
+                    exec:
+                      description: |-
+                        One and only one of the following should be specified.
+                        Exec specifies the action to take, it will be executed on the guest through the qemu-guest-agent.
+                        If the guest agent is not available, this probe will fail.
+                      properties:
+                        command:
+                          description: |-
+                            Command is the command line to execute inside the container, the working directory for the
+                            command  is root ('/') in the container's filesystem. The command is simply exec'd, it is
+                            not run inside a shell, so traditional shell instructions ('|', etc) won't work. To use
+                            a shell, you need to explicitly call out to that shell.
+                            Exit status of 0 is treated as live/healthy and non-zero is unhealthy.
+                          items:
+                            type: string
+                          type: array
+                          x-kubernetes-list-type: atomic
+                      type: object
+                    failureThreshold:
+                      description: |-
+                        Minimum consecutive failures for the probe to be considered failed after having succeeded.
+                        Defaults to 3. Minimum value is 1.
+                      format: int32
+                      type: integer
+                    guestAgentPing:
+                      description: GuestAgentPing contacts the qemu-guest-agent for
+                        availability checks.
+                      type: object
+                    httpGet:
+                      description: HTTPGet specifies the http request to perform.
+                      properties:
+                        host:
+                          description: |-
+                            Host name to connect to, defaults to the pod IP. You probably want to set
+                            "Host" in httpHeaders instead.
+                          type: string
+                        httpHeaders:
+                          description: Custom headers to set in the request. HTTP
+                            allows repeated headers.
+                          items:
+                            description: HTTPHeader describes a custom header to be
+                              used in HTTP probes
+                            properties:
+                              name:
+                                description: |-
+                                  The header field name.
+                                  This will be canonicalized upon output, so case-variant names will be understood as the same header.
+                                type: string
+                              value:
+                                description: The header field value
+                                type: string
+                            required:
+                            - name
+                            - value
+                            type: object
+                          type: array
+                          x-kubernetes-list-type: atomic
+                        path:
+                          description: Path to access on the HTTP server.
+                          type: string
+                        port:
+                          anyOf:
+                          - type: integer
+                          - type: string
+                          description: |-
+                            Name or number of the port to access on the container.
+                            Number must be in the range 1 to 65535.
+                            Name must be an IANA_SVC_NAME.
+                          x-kubernetes-int-or-string: true
+                        scheme:
+                          description: |-
+                            Scheme to use for connecting to the host.
+                            Defaults to HTTP.
+                          type: string
+                      required:
+                      - port
+                      type: object
+                    initialDelaySeconds:
+                      description: |-
+                        Number of seconds after the VirtualMachineInstance has started before liveness probes are initiated.
+                        More info: https://kubernetes.io/docs/concepts/workloads/pods/pod-lifecycle#container-probes
+                      format: int32
+                      type: integer
+                    periodSeconds:
+                      description: |-
+                        How often (in seconds) to perform the probe.
+                        Default to 10 seconds. Minimum value is 1.
+                      format: int32
+                      type: integer
+                    successThreshold:
+                      description: |-
+                        Minimum consecutive successes for the probe to be considered successful after having failed.
+                        Defaults to 1. Must be 1 for liveness. Minimum value is 1.
+                      format: int32
+                      type: integer
+                    tcpSocket:
+                      description: |-
+                        TCPSocket specifies an action involving a TCP port.
+                        TCP hooks not yet supported
+                      properties:
+                        host:
+                          description: 'Optional: Host name to connect to, defaults
+                            to the pod IP.'
+                          type: string
+                        port:
+                          anyOf:
+                          - type: integer
+                          - type: string
+                          description: |-
+                            Number or name of the port to access on the container.
+                            Number must be in the range 1 to 65535.
+                            Name must be an IANA_SVC_NAME.
+                          x-kubernetes-int-or-string: true
+                      required:
+                      - port
+                      type: object
+                    timeoutSeconds:
+                      description: |-
+                        Number of seconds after which the probe times out.
+                        For exec probes the timeout fails the probe but does not terminate the command running on the guest.
+                        This means a blocking command can result in an increasing load on the guest.
+                        A small buffer will be added to the resulting workload exec probe to compensate for delays
+                        caused by the qemu guest exec mechanism.
+                        Defaults to 1 second. Minimum value is 1.
+                        More info: https://kubernetes.io/docs/concepts/workloads/pods/pod-lifecycle#container-probes
+                      format: int32
+                      type: integer
+                  type: object
+                timeout:
+                  description: Timeout is the time the canary VirtualMachineInstance
+                    has to become ready before the run fails. Defaults to 5m
+                  type: string
+              required:
+              - image
+              type: object
             commonInstancetypesDeployment:
               description: CommonInstancetypesDeployment controls the deployment of
                 common-instancetypes resources
@@ -870,16 +1177,88 @@ var CRDsValidation map[string]string = map[string]string{
                       type: object
                   type: object
               type: object
-            cpuModel:
-              type: string
-            cpuRequest:
-              anyOf:
-              - type: integer
-              - type: string
-              pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
-              x-kubernetes-int-or-string: true
-            defaultRuntimeClass:
-              type: string
+            cpuFairShare:
+              description: |-
+                CPUFairShare weights the CPU time of the virt-launcher pods by the tier of their namespace,
+                so that VirtualMachineInstances of higher tiers get more CPU time when a node is CPU saturated
+              nullable: true
+              properties:
+                tiers:
+                  description: Tiers are the CPU tiers, a namespace should only be
+                    part of one tier
+                  items:
+                    description: CPUFairShareTier is a group of namespaces sharing
+                      the same CPU weight
+                    properties:
+                      name:
+                        description: Name of the tier
+                        type: string
+                      namespaces:
+                        description: Namespaces of the tier
+                        items:
+                          type: string
+                        type: array
+                        x-kubernetes-list-type: set
+                      weight:
+                        description: |-
+                          Weight is the cgroup v2 cpu.weight of the virt-launcher pods of the tier, in the range 1-10000.
+                          The kubelet derives a weight of about 40 per requested CPU
+                        format: int64
+                        maximum: 10000
+                        minimum: 1
+                        type: integer
+                    required:
+                    - name
+                    - weight
+                    type: object
+                  type: array
+                  x-kubernetes-list-map-keys:
+                  - name
+                  x-kubernetes-list-type: map
+              type: object
+            cpuModel:
+              type: string
+            cpuRequest:
+              anyOf:
+              - type: integer
+              - type: string
+              pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+              x-kubernetes-int-or-string: true
+            dedicatedCPUPools:
+              description: |-
+                DedicatedCPUPools reserve host CPUs of the nodes for the VirtualMachineInstances with dedicated CPU placement,
+                on nodes where the CPU manager policy of the kubelet is not static
+              items:
+                description: |-
+                  DedicatedCPUPool is a set of host CPUs reserved on the nodes matching its node selector.
+                  virt-handler pins the virt-launcher pods of the VirtualMachineInstances with dedicated CPU placement to exclusive
+                  CPUs of the pool, and keeps the burstable and best-effort pods off the pool.
+                properties:
+                  cpus:
+                    description: CPUs of the pool, in the cpuset list format, e.g.
+                      4-15,20-23
+                    type: string
+                  name:
+                    description: Name of the pool, the nodes are labeled with the
+                      name of their pool
+                    type: string
+                  nodeSelector:
+                    additionalProperties:
+                      type: string
+                    description: |-
+                      NodeSelector selects the nodes the pool is reserved on, the first pool matching a node is used.
+                      The pool is reserved on all the nodes if it is empty
+                    type: object
+                required:
+                - cpus
+                - name
+                type: object
+              type: array
+              x-kubernetes-list-map-keys:
+              - name
+              x-kubernetes-list-type: map
+            defaultRuntimeClass:
+              type: string
             developerConfiguration:
               description: DeveloperConfiguration holds developer options
               properties:
@@ -1018,6 +1397,43 @@ var CRDsValidation map[string]string = map[string]string{
                       type: object
                   type: object
               type: object
+            hugepagesPools:
+              description: |-
+                HugepagesPools let virt-handler allocate hugepages on the nodes at runtime, so that VirtualMachineInstances
+                backed by hugepages can run without reserving the hugepages on the kernel command line
+              items:
+                description: |-
+                  HugepagesPool is a number of hugepages of a size allocated on the nodes matching its node selector.
+                  virt-handler allocates the hugepages at runtime, reports the provisioning in the annotations of the nodes,
+                  and frees the hugepages of a size once no pool of that size selects the node anymore.
+                properties:
+                  count:
+                    description: Count is the number of hugepages allocated on each
+                      node
+                    format: int32
+                    type: integer
+                  name:
+                    description: Name of the pool
+                    type: string
+                  nodeSelector:
+                    additionalProperties:
+                      type: string
+                    description: |-
+                      NodeSelector selects the nodes the hugepages are allocated on, the first pool of a page size matching
+                      a node is used. The hugepages are allocated on all the nodes if it is empty
+                    type: object
+                  pageSize:
+                    description: PageSize of the hugepages, e.g. 2Mi or 1Gi
+                    type: string
+                required:
+                - count
+                - name
+                - pageSize
+                type: object
+              type: array
+              x-kubernetes-list-map-keys:
+              - name
+              x-kubernetes-list-type: map
             imagePullPolicy:
               description: PullPolicy describes a policy for if/when to pull a container
                 image
@@ -1026,6 +1442,94 @@ var CRDsValidation map[string]string = map[string]string{
               description: Instancetype configuration
               nullable: true
               properties:
+                namespacePolicies:
+                  description: |-
+                    NamespacePolicies restrict which cluster instance types and cluster preferences the VMs of the selected namespaces may reference.
+                    If policies selecting a namespace list cluster instance types, VMs in the namespace may only reference the cluster instance types listed by any of them, the same applies to cluster preferences.
+                    Namespaced instance types and preferences are not restricted.
+                  items:
+                    description: InstancetypeNamespacePolicy allows the VMs of the
+                      selected namespaces to reference the listed cluster instance
+                      types and cluster preferences
+                    properties:
+                      clusterInstancetypes:
+                        description: ClusterInstancetypes lists the names of the VirtualMachineClusterInstancetypes
+                          the VMs may reference
+                        items:
+                          type: string
+                        type: array
+                        x-kubernetes-list-type: set
+                      clusterPreferences:
+                        description: ClusterPreferences lists the names of the VirtualMachineClusterPreferences
+                          the VMs may reference
+                        items:
+                          type: string
+                        type: array
+                        x-kubernetes-list-type: set
+                      namespaceSelector:
+                        description: NamespaceSelector selects the namespaces the
+                          policy applies to, an empty selector selects all namespaces
+                        properties:
+                          matchExpressions:
+                            description: matchExpressions is a list of label selector
+                              requirements. The requirements are ANDed.
+                            items:
+                              description: |-
+                                A label selector requirement is a selector that contains values, a key, and an operator that
+                                relates the key and values.
+                              properties:
+                                key:
+                                  description: key is the label key that the selector
+                                    applies to.
+                                  type: string
+                                operator:
+                                  description: |-
+                                    operator represents a key's relationship to a set of values.
+                                    Valid operators are In, NotIn, Exists and DoesNotExist.
+                                  type: string
+                                values:
+                                  description: |-
+                                    values is an array of string values. If the operator is In or NotIn,
+                                    the values array must be non-empty. If the operator is Exists or DoesNotExist,
+                                    the values array must be empty. This array is replaced during a strategic
+                                    merge patch.
+                                  items:
+                                    type: string
+                                  type: array
+                                  x-kubernetes-list-type: atomic
+                              required:
+                              - key
+                              - operator
+                              type: object
+                            type: array
+                            x-kubernetes-list-type: atomic
+                          matchLabels:
+                            additionalProperties:
+                              type: string
+                            description: |-
+                              matchLabels is a map of {key,value} pairs. A single {key,value} in the matchLabels
+                              map is equivalent to an element of matchExpressions, whose key field is "key", the
+                              operator is "In", and the values array contains only "value". The requirements are ANDed.
+                            type: object
+                        type: object
+                        x-kubernetes-map-type: atomic
+                    required:
+                    - namespaceSelector
+                    type: object
+                  type: array
+                  x-kubernetes-list-type: atomic
+                nodeCompatibilityPolicy:
+                  description: |-
+                    NodeCompatibilityPolicy defines how VMs are admitted when no node provides the hugepages, dedicated CPUs, GPUs or host devices of their instance type, supported values are:
+                    ignore (default) - Where the VM is admitted without looking at the nodes.
+                    warn - Where the VM is admitted with a warning.
+                    reject - Where the VM is rejected.
+                  enum:
+                  - ignore
+                  - warn
+                  - reject
+                  nullable: true
+                  type: string
                 referencePolicy:
                   description: |-
                     ReferencePolicy defines how an instance type or preference should be referenced by the VM after submission, supported values are:
@@ -1038,6 +1542,34 @@ var CRDsValidation map[string]string = map[string]string{
                   - expandAll
                   nullable: true
                   type: string
+                revisionUpgradePolicy:
+                  description: |-
+                    RevisionUpgradePolicy defines if VMs referencing an outdated ControllerRevision of an instance type are moved to the latest version, supported values are:
+                    manual (default) - Where the VM keeps its ControllerRevision until it is changed by the user.
+                    onRestart - Where the VM is moved to a ControllerRevision of the latest version of the instance type while it is not running, so it is used at the next start.
+                    The policy can be overridden per VM with the instancetype.kubevirt.io/revision-upgrade-policy annotation.
+                  enum:
+                  - manual
+                  - onRestart
+                  nullable: true
+                  type: string
+                rightsizing:
+                  description: |-
+                    Rightsizing enables the recommendation of cluster instance types from the observed usage of the VMs.
+                    The recommendation is set in the instancetype.kubevirt.io/recommended-instancetype annotation of the VMs.
+                  properties:
+                    prometheusURL:
+                      description: PrometheusURL is the address of the Prometheus
+                        API the kubevirt_vmi_vcpu_seconds_total and kubevirt_vmi_memory_used_bytes
+                        metrics are queried from
+                      type: string
+                    window:
+                      description: Window is the period the usage of the VMs is observed
+                        over, defaults to 24h
+                      type: string
+                  required:
+                  - prometheusURL
+                  type: object
               type: object
             ksmConfiguration:
               description: KSMConfiguration holds the information regarding the enabling
@@ -1047,6 +1579,7 @@ var CRDsValidation map[string]string = map[string]string{
                   description: |-
                     NodeLabelSelector is a selector that filters in which nodes the KSM will be enabled.
                     Empty NodeLabelSelector will enable ksm for every node.
+                    The kubevirt.io/ksm-policy label of a node, enabled or disabled, takes precedence over it.
                   properties:
                     matchExpressions:
                       description: matchExpressions is a list of label selector requirements.
@@ -1091,6 +1624,50 @@ var CRDsValidation map[string]string = map[string]string{
                       type: object
                   type: object
                   x-kubernetes-map-type: atomic
+                tuning:
+                  description: |-
+                    Tuning holds the parameters of the KSM scanning on the nodes.
+                    The KSM override annotations of a node take precedence over them.
+                  properties:
+                    freePercent:
+                      description: |-
+                        FreePercent is the percentage of available memory under which the node is under memory pressure.
+                        Defaults to 20.
+                      format: int32
+                      type: integer
+                    pagesBoost:
+                      description: PagesBoost is the number of pages to scan added
+                        on every heartbeat under memory pressure. Defaults to 300.
+                      format: int32
+                      type: integer
+                    pagesDecay:
+                      description: |-
+                        PagesDecay is the number of pages to scan added on every heartbeat without memory pressure,
+                        it can not be positive. Defaults to -50.
+                      format: int32
+                      type: integer
+                    pagesInit:
+                      description: PagesInit is the number of pages to scan when KSM
+                        starts. Defaults to 100.
+                      format: int32
+                      type: integer
+                    pagesMax:
+                      description: PagesMax is the maximum number of pages to scan.
+                        Defaults to 1250.
+                      format: int32
+                      type: integer
+                    pagesMin:
+                      description: PagesMin is the minimum number of pages to scan.
+                        Defaults to 64.
+                      format: int32
+                      type: integer
+                    sleepMsBaseline:
+                      description: |-
+                        SleepMsBaseline is the time KSM sleeps between scans on a node with 16GiB of used memory, in milliseconds.
+                        The sleep time is scaled down the more memory is used. Defaults to 100.
+                      format: int32
+                      type: integer
+                  type: object
               type: object
             liveUpdateConfiguration:
               description: LiveUpdateConfiguration holds defaults for live update
@@ -1146,6 +1723,29 @@ var CRDsValidation map[string]string = map[string]string{
                       MDEV types to be defined in a specific node that matches the
                       NodeSelector field.
                     properties:
+                      mediatedDeviceCounts:
+                        description: |-
+                          MediatedDeviceCounts sets how many instances of some of the mediatedDeviceTypes are created on the node.
+                          Types without a count get all the instances their parent devices can provide.
+                        items:
+                          description: MediatedDeviceTypeCount sets the number of instances
+                            of an mdev type created on a node.
+                          properties:
+                            count:
+                              description: Count is the number of instances of the
+                                type to create on the node.
+                              format: int32
+                              type: integer
+                            type:
+                              description: Type is the name or the ID of the mdev
+                                type, as listed in mediatedDeviceTypes.
+                              type: string
+                          required:
+                          - count
+                          - type
+                          type: object
+                        type: array
+                        x-kubernetes-list-type: atomic
                       mediatedDeviceTypes:
                         items:
                           type: string
@@ -1199,6 +1799,30 @@ var CRDsValidation map[string]string = map[string]string{
                     permitted, migration will be switched to post-copy or the VMI will be
                     paused to allow the migration to complete
                   type: boolean
+                bandwidthAutoTuning:
+                  description: |-
+                    BandwidthAutoTuning lets virt-controller derive the bandwidth of each migration from the number of
+                    concurrent outbound migrations and the network utilization which virt-handler reports for the source node.
+                    BandwidthPerMigration, if set, remains the upper limit. Defaults to no auto-tuning
+                  properties:
+                    minBandwidthPerMigration:
+                      anyOf:
+                      - type: integer
+                      - type: string
+                      description: |-
+                        MinBandwidthPerMigration is the lowest bandwidth a migration is limited to, even if the network of the
+                        source node is saturated. The value is in quantity per second. Defaults to 0 (no lower limit)
+                      pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                      x-kubernetes-int-or-string: true
+                    targetUtilizationPercent:
+                      description: |-
+                        TargetUtilizationPercent is the share of the migration network capacity of the source node which may be
+                        used in total. Migrations use whatever is left by the other traffic. Defaults to 80
+                      format: int32
+                      maximum: 100
+                      minimum: 1
+                      type: integer
+                  type: object
                 bandwidthPerMigration:
                   anyOf:
                   - type: integer
@@ -1215,6 +1839,27 @@ var CRDsValidation map[string]string = map[string]string{
                     to post-copy or cancelled depending on other settings. Defaults to 150
                   format: int64
                   type: integer
+                compression:
+                  description: |-
+                    Compression compresses the guest memory sent over the multifd channels of a live migration.
+                    It is ignored for migrations over a single connection. Defaults to no compression
+                  properties:
+                    level:
+                      description: |-
+                        Level is the compression level. Ranges from 1 to 9 for zlib and from 1 to 20 for zstd.
+                        Defaults to the hypervisor default of the method
+                      format: int32
+                      type: integer
+                    method:
+                      description: Method is the compression method. One of zlib or
+                        zstd
+                      enum:
+                      - zlib
+                      - zstd
+                      type: string
+                  required:
+                  - method
+                  type: object
                 disableTLS:
                   description: |-
                     When set to true, DisableTLS will disable the additional layer of live migration encryption
@@ -1227,6 +1872,13 @@ var CRDsValidation map[string]string = map[string]string{
                     That will ensure the target virt-launcher doesn't share categories with another pod on the node.
                     However, migrations will fail when using RWX volumes that don't automatically deal with SELinux levels.
                   type: boolean
+                maxDowntimeMilliseconds:
+                  description: |-
+                    MaxDowntimeMilliseconds is the maximum number of milliseconds the guest may be paused to switch over to the
+                    target at the end of a pre-copy migration. Larger values let migrations of busy VMIs converge sooner.
+                    Defaults to the hypervisor default (300)
+                  format: int64
+                  type: integer
                 network:
                   description: |-
                     Network is the name of the CNI network to use for live migrations. By default, migrations go
@@ -1237,6 +1889,13 @@ var CRDsValidation map[string]string = map[string]string{
                     NodeDrainTaintKey defines the taint key that indicates a node should be drained.
                     Note: this option relies on the deprecated node taint feature. Default: kubevirt.io/drain
                   type: string
+                parallelMigrationChannels:
+                  description: |-
+                    ParallelMigrationChannels is the number of multifd channels used to transfer the guest memory of a live
+                    migration. A value of 1 migrates over a single connection. Post-copy migrations and VMIs with a CPU limit
+                    always use a single connection. Defaults to 8
+                  format: int32
+                  type: integer
                 parallelMigrationsPerCluster:
                   description: |-
                     ParallelMigrationsPerCluster is the total number of concurrent live migrations
@@ -1249,6 +1908,13 @@ var CRDsValidation map[string]string = map[string]string{
                     allowed per node. Defaults to 2
                   format: int32
                   type: integer
+                postCopyAfterIterations:
+                  description: |-
+                    PostCopyAfterIterations switches a migration to post-copy once the guest memory has been copied that many
+                    times without the migration completing, instead of waiting for CompletionTimeoutPerGiB.
+                    Only applies if AllowPostCopy and AllowWorkloadDisruption are set. Defaults to 0 (disabled)
+                  format: int64
+                  type: integer
                 progressTimeout:
                   description: |-
                     ProgressTimeout is the maximum number of seconds a live migration is allowed to make no progress.
@@ -1303,7 +1969,7 @@ var CRDsValidation map[string]string = map[string]string{
                       domainAttachmentType:
                         description: |-
                           DomainAttachmentType is a standard domain network attachment method kubevirt supports.
-                          Supported values: "tap", "managedTap" (since v1.4).
+                          Supported values: "tap", "managedTap" (since v1.4), "vdpa" (since v1.6).
                           The standard domain attachment can be used instead or in addition to the sidecarImage.
                           version: 1alphav1
                         type: string
@@ -1341,6 +2007,70 @@ var CRDsValidation map[string]string = map[string]string{
                   type: object
                 defaultNetworkInterface:
                   type: string
+                macAddressPool:
+                  description: |-
+                    MacAddressPool holds the ranges the MAC addresses of VirtualMachine interfaces are allocated from.
+                    It requires the MacAddressPool feature gate.
+                  properties:
+                    namespaces:
+                      description: Namespaces holds the MAC address ranges dedicated
+                        to namespaces
+                      items:
+                        description: NamespaceMacAddressRanges dedicates MAC address
+                          ranges to a namespace
+                        properties:
+                          namespace:
+                            description: Namespace is the name of the namespace
+                            type: string
+                          ranges:
+                            description: Ranges are the MAC address ranges of the
+                              namespace
+                            items:
+                              description: MacAddressRange is an inclusive range of
+                                MAC addresses
+                              properties:
+                                end:
+                                  description: End is the last MAC address of the
+                                    range, e.g. 02:00:00:ff:ff:ff
+                                  type: string
+                                start:
+                                  description: Start is the first MAC address of the
+                                    range, e.g. 02:00:00:00:00:00
+                                  type: string
+                              required:
+                              - end
+                              - start
+                              type: object
+                            type: array
+                            x-kubernetes-list-type: atomic
+                        required:
+                        - namespace
+                        - ranges
+                        type: object
+                      type: array
+                      x-kubernetes-list-type: atomic
+                    ranges:
+                      description: Ranges are the MAC address ranges of the namespaces
+                        without dedicated ranges
+                      items:
+                        description: MacAddressRange is an inclusive range of MAC
+                          addresses
+                        properties:
+                          end:
+                            description: End is the last MAC address of the range,
+                              e.g. 02:00:00:ff:ff:ff
+                            type: string
+                          start:
+                            description: Start is the first MAC address of the range,
+                              e.g. 02:00:00:00:00:00
+                            type: string
+                        required:
+                        - end
+                        - start
+                        type: object
+                      type: array
+                      x-kubernetes-list-type: atomic
+                  type: object
                 permitBridgeInterfaceOnPodNetwork:
                   type: boolean
                 permitSlirpInterface:
@@ -1433,6 +2163,58 @@ var CRDsValidation map[string]string = map[string]string{
                     type: object
                   type: array
                   x-kubernetes-list-type: atomic
+                usbPassthrough:
+                  description: |-
+                    USBPassthrough lists the USB devices of the nodes which the usbDevices of VMIs may select.
+                    Hubs can not be passed through.
+                  items:
+                    description: |-
+                      USBPassthroughHostDevice permits the USB devices matching all of its set selectors to be passed through.
+                      At least one of the selectors has to be set.
+                    properties:
+                      busPort:
+                        description: |-
+                          BusPort permits the device plugged into the port, by the bus number and port path the kernel
+                          names the device after, e.g. 1-2.3
+                        type: string
+                      vendorProduct:
+                        description: VendorProduct permits the devices with the
+                          vendor_id:product_id tuple, e.g. 1050:0407
+                        type: string
+                    type: object
+                  type: array
+                  x-kubernetes-list-type: atomic
+              type: object
+            placementHints:
+              description: |-
+                PlacementHints configures an external placement service which virt-controller consults for preferred
+                and forbidden nodes when it creates the pod of a starting VirtualMachineInstance or a migration target pod
+              nullable: true
+              properties:
+                caBundle:
+                  description: |-
+                    CABundle is the PEM encoded CA bundle used to verify the certificate of the placement service.
+                    The system trust store is used if empty
+                  format: byte
+                  type: string
+                failurePolicy:
+                  description: |-
+                    FailurePolicy defines how a failed request or an invalid response is handled.
+                    Ignore creates the pod without hints, Fail retries later without creating the pod. Defaults to Ignore
+                  enum:
+                  - Ignore
+                  - Fail
+                  type: string
+                timeout:
+                  description: Timeout is the time the placement service has to answer.
+                    Defaults to 2s
+                  type: string
+                url:
+                  description: URL is the http or https endpoint of the placement
+                    service
+                  type: string
+              required:
+              - url
               type: object
             seccompConfiguration:
               description: SeccompConfiguration holds Seccomp configuration for Kubevirt
@@ -1468,7 +2250,50 @@ var CRDsValidation map[string]string = map[string]string{
                 version:
                   type: string
               type: object
-            supportContainerResources:
+            startConfiguration:
+              description: StartConfiguration limits how many VirtualMachineInstances
+                are allowed to start at the same time
+              nullable: true
+              properties:
+                parallelStartsPerCluster:
+                  description: |-
+                    ParallelStartsPerCluster is the maximum number of VirtualMachineInstances
+                    starting at the same time cluster-wide. Unlimited if not set
+                  format: int32
+                  type: integer
+                parallelStartsPerNamespace:
+                  description: |-
+                    ParallelStartsPerNamespace is the maximum number of VirtualMachineInstances
+                    starting at the same time in a single namespace. Unlimited if not set
+                  format: int32
+                  type: integer
+                volumePreparationLimits:
+                  description: |-
+                    VolumePreparationLimits limits the number of DataVolumes of a storage class which are
+                    cloned, imported or resized at the same time before their VirtualMachines can start
+                  items:
+                    description: |-
+                      VolumePreparationLimit holds the limit on concurrent volume preparations for a storage class.
+                      DataVolumes of VirtualMachines and PVC expansions exceeding the limit wait until a preparation finishes.
+                    properties:
+                      parallelVolumePreparations:
+                        description: |-
+                          ParallelVolumePreparations is the maximum number of volumes of the storage class
+                          which are cloned, imported or resized at the same time
+                        format: int32
+                        type: integer
+                      storageClassName:
+                        description: StorageClassName is the name of the storage class
+                          the limit applies to
+                        type: string
+                    required:
+                    - parallelVolumePreparations
+                    - storageClassName
+                    type: object
+                  type: array
+                  x-kubernetes-list-type: atomic
+              type: object
+            supportContainerResources:
               description: SupportContainerResources specifies the resource requirements
                 for various types of supporting containers such as container disks/virtiofs/sidecars
                 and hotplug attachment pods. If omitted a sensible default will be
@@ -1524,6 +2349,31 @@ var CRDsValidation map[string]string = map[string]string{
               items:
                 type: string
               type: array
+            swapConfiguration:
+              description: SwapConfiguration lets virt-handler give the overcommitted
+                VirtualMachineInstances access to the swap of the nodes
+              properties:
+                evictionMemoryPressurePercent:
+                  description: |-
+                    EvictionMemoryPressurePercent is the percentage of the last 10 seconds during which all the tasks of a node were
+                    stalled on memory, above which the overcommitted VMI using the most swap is evacuated from the node.
+                    The eviction on memory pressure is disabled if it is unset
+                  format: int32
+                  type: integer
+                evictionSwapUsedPercent:
+                  description: |-
+                    EvictionSwapUsedPercent is the percentage of the swap of a node in use above which the overcommitted VMI
+                    using the most swap is evacuated from the node. The eviction on swap usage is disabled if it is unset
+                  format: int32
+                  type: integer
+                nodeSelector:
+                  additionalProperties:
+                    type: string
+                  description: |-
+                    NodeSelector selects the nodes on which the swap of the VMIs is managed.
+                    All the nodes are selected if it is empty
+                  type: object
+              type: object
             tlsConfiguration:
               description: TLSConfiguration holds TLS options
               properties:
@@ -3790,6 +4640,63 @@ var CRDsValidation map[string]string = map[string]string{
           type: array
         defaultArchitecture:
           type: string
+        fleetSummary:
+          description: FleetSummary aggregates the state of the workloads and nodes
+            managed by KubeVirt
+          properties:
+            lastUpdateTime:
+              description: LastUpdateTime is the time the summary was aggregated at
+              format: date-time
+              type: string
+            migrationsInFlight:
+              description: MigrationsInFlight is the number of VirtualMachineInstanceMigrations
+                which did not finish yet
+              format: int32
+              type: integer
+            pendingActions:
+              description: PendingActions lists the actions which are outstanding
+                before the fleet is up to date
+              items:
+                description: KubeVirtPendingAction counts the workloads waiting for
+                  the same action
+                properties:
+                  count:
+                    format: int32
+                    type: integer
+                  reason:
+                    type: string
+                required:
+                - count
+                - reason
+                type: object
+              type: array
+              x-kubernetes-list-map-keys:
+              - reason
+              x-kubernetes-list-type: map
+            schedulableNodes:
+              description: SchedulableNodes is the number of nodes VirtualMachineInstances
+                can be scheduled on
+              format: int32
+              type: integer
+            virtualMachineInstances:
+              additionalProperties:
+                format: int32
+                type: integer
+              description: VirtualMachineInstances is the number of VirtualMachineInstances
+                by phase
+              type: object
+            virtualMachines:
+              additionalProperties:
+                format: int32
+                type: integer
+              description: VirtualMachines is the number of VirtualMachines by run
+                strategy
+              type: object
+          required:
+          - lastUpdateTime
+          - migrationsInFlight
+          - schedulableNodes
+          type: object
         generations:
           items:
             description: GenerationStatus keeps track of the generation for a given
@@ -3824,6 +4731,56 @@ var CRDsValidation map[string]string = map[string]string{
             type: object
           type: array
           x-kubernetes-list-type: atomic
+        nodeEvacuations:
+          description: NodeEvacuations reports the progress of the nodes which are
+            being evacuated
+          items:
+            description: |-
+              NodeEvacuationStatus reports the VirtualMachineInstances which still have to leave a node that is being evacuated,
+              as observed by virt-controller
+            properties:
+              lastUpdateTime:
+                description: LastUpdateTime is the time the progress was observed
+                  at
+                format: date-time
+                type: string
+              migrating:
+                description: Migrating is the number of VirtualMachineInstances with
+                  an evacuation migration in flight
+                format: int32
+                type: integer
+              node:
+                description: Node is the name of the node which is being evacuated
+                type: string
+              notMigratable:
+                description: NotMigratable is the number of VirtualMachineInstances
+                  which can't be live migrated and block the evacuation
+                format: int32
+                type: integer
+              pending:
+                description: Pending is the number of VirtualMachineInstances waiting
+                  for an evacuation migration
+                format: int32
+                type: integer
+              remainingMemory:
+                anyOf:
+                - type: integer
+                - type: string
+                description: RemainingMemory is the guest memory of all the VirtualMachineInstances
+                  which still have to leave the node
+                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                x-kubernetes-int-or-string: true
+            required:
+            - lastUpdateTime
+            - migrating
+            - node
+            - notMigratable
+            - pending
+            type: object
+          type: array
+          x-kubernetes-list-map-keys:
+          - node
+          x-kubernetes-list-type: map
         observedDeploymentConfig:
           type: string
         observedDeploymentID:
@@ -3894,6 +4851,58 @@ var CRDsValidation map[string]string = map[string]string{
         completionTimeoutPerGiB:
           format: int64
           type: integer
+        compression:
+          description: MigrationCompression defines the compression of the guest memory
+            sent by multifd live migrations
+          properties:
+            level:
+              description: |-
+                Level is the compression level. Ranges from 1 to 9 for zlib and from 1 to 20 for zstd.
+                Defaults to the hypervisor default of the method
+              format: int32
+              type: integer
+            method:
+              description: Method is the compression method. One of zlib or zstd
+              enum:
+              - zlib
+              - zstd
+              type: string
+          required:
+          - method
+          type: object
+        maintenanceWindows:
+          description: |-
+            MaintenanceWindows restrict the automated migrations of the matched VirtualMachineInstances, the ones
+            created by the workload updater and for evacuations, to the given windows. Automated migrations requested
+            outside of all windows stay pending until one opens. Migrations requested by users are never held back.
+          items:
+            description: MaintenanceWindow is a recurring time window during which
+              automated migrations are allowed
+            properties:
+              duration:
+                description: Duration is how long the window stays open, at most 7
+                  days.
+                type: string
+              schedule:
+                description: |-
+                  Schedule is a cron expression in the five field format "minute hour day-of-month month day-of-week",
+                  evaluated in UTC, at which the window opens. Fields support numbers, ranges, lists and steps.
+                type: string
+            required:
+            - duration
+            - schedule
+            type: object
+          type: array
+          x-kubernetes-list-type: atomic
+        maxDowntimeMilliseconds:
+          format: int64
+          type: integer
+        parallelMigrationChannels:
+          format: int32
+          type: integer
+        postCopyAfterIterations:
+          format: int64
+          type: integer
         selectors:
           properties:
             namespaceSelector:
@@ -4652,6 +5661,35 @@ var CRDsValidation map[string]string = map[string]string{
             Mutually exclusive with RunStrategy
             Deprecated: VirtualMachineInstance field "Running" is now deprecated, please use RunStrategy instead.
           type: boolean
+        storageMigration:
+          description: |-
+            StorageMigration moves the PVC and DataVolume volumes of the VirtualMachine to another StorageClass.
+            The volumes are copied to new DataVolumes, which replace the original volumes once the copy is done.
+            The original claims are retained.
+          properties:
+            method:
+              description: |-
+                Method is the way the volumes are copied. BlockCopy requires the VirtualMachine to run,
+                CDI requires it to be stopped. Defaults to BlockCopy if the VirtualMachine runs and to CDI otherwise.
+              enum:
+              - BlockCopy
+              - CDI
+              type: string
+            storageClassName:
+              description: StorageClassName is the StorageClass the volumes are migrated
+                to
+              type: string
+            volumes:
+              description: |-
+                Volumes restricts the migration to the named volumes. By default all the PVC and DataVolume
+                volumes whose claims are not in the target StorageClass are migrated.
+              items:
+                type: string
+              type: array
+              x-kubernetes-list-type: atomic
+          required:
+          - storageClassName
+          type: object
         template:
           description: Template is the direct specification of VirtualMachineInstance
           properties:
@@ -5774,6 +6812,27 @@ var CRDsValidation map[string]string = map[string]string{
                     clock:
                       description: Clock sets the clock and timers of the vmi.
                       properties:
+                        guestTimeSync:
+                          description: GuestTimeSync configures when the guest clock
+                            is set to the host time through the guest agent.
+                          properties:
+                            driftThresholdSeconds:
+                              description: |-
+                                DriftThresholdSeconds is the drift of the guest clock above which it is set to the host time
+                                with the "OnDrift" policy.
+                                Defaults to 2.
+                              format: int32
+                              type: integer
+                            policy:
+                              description: |-
+                                Policy selects when the guest clock is set to the host time.
+                                "OnResume" sets it after a live migration and when the vmi is unpaused.
+                                "OnDrift" additionally sets it whenever the guest clock drifts away from the host clock by more than
+                                the drift threshold, e.g. after the node resumed from suspend.
+                                "Disabled" never sets it, leaving the guest clock to the time synchronization of the guest.
+                                Defaults to "OnResume".
+                              type: string
+                          type: object
                         timer:
                           description: Timer specifies whih timers are attached to
                             the vmi.
@@ -5905,6 +6964,24 @@ var CRDsValidation map[string]string = map[string]string{
                             - name
                             type: object
                           type: array
+                        housekeeping:
+                          description: |-
+                            Housekeeping gives the QEMU threads which do not run vCPUs, like the emulator and IOThreads,
+                            their own CPU budget in a child cgroup of the compute container, so they don't take CPU time
+                            from the vCPUs. Can not be combined with DedicatedCPUPlacement, use IsolateEmulatorThread instead.
+                          properties:
+                            cpu:
+                              anyOf:
+                              - type: integer
+                              - type: string
+                              description: |-
+                                CPU is added to the CPU requests of the compute container, and to its CPU limits if they are set.
+                                The housekeeping threads are limited to this amount of CPU, the rest is left to the vCPUs.
+                              pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                              x-kubernetes-int-or-string: true
+                          required:
+                          - cpu
+                          type: object
                         isolateEmulatorThread:
                           description: |-
                             IsolateEmulatorThread requests one more dedicated pCPU to be allocated for the VMI to place
@@ -5916,12 +6993,21 @@ var CRDsValidation map[string]string = map[string]string{
                             be hotplugged
                           format: int32
                           type: integer
+                        mitigatedVulnerabilities:
+                          description: |-
+                            MitigatedVulnerabilities lists the CPU vulnerabilities, as named by the kernel in /sys/devices/system/cpu/vulnerabilities,
+                            e.g. retbleed or mds, the node of the VMI has to be mitigated against or not affected by.
+                          items:
+                            type: string
+                          type: array
+                          x-kubernetes-list-type: set
                         model:
                           description: |-
                             Model specifies the CPU model inside the VMI.
                             List of available models https://github.com/libvirt/libvirt/tree/master/src/cpu_map.
                             It is possible to specify special cases like "host-passthrough" to get the same CPU as the node
                             and "host-model" to get CPU closest to the node one.
+                            "cluster-common" selects the CPU model and features common to the nodes matching the node selector of the VMI.
                             Defaults to host-model.
                           type: string
                         numa:
@@ -5950,6 +7036,24 @@ var CRDsValidation map[string]string = map[string]string{
                             Must be a value greater or equal 1.
                           format: int32
                           type: integer
+                        threadPlacement:
+                          description: |-
+                            ThreadPlacement defines on which pCPUs the emulator thread and the IOThreads run.
+                            Requires DedicatedCPUPlacement.
+                          properties:
+                            emulatorThread:
+                              description: |-
+                                EmulatorThread defines where the emulator thread runs. With shared it runs on the pCPUs of the vCPUs,
+                                dedicated and sameNUMANode isolate it on an additional pCPU, like IsolateEmulatorThread does.
+                                Defaults to dedicated if IsolateEmulatorThread is set and to shared otherwise.
+                              type: string
+                            ioThreads:
+                              description: |-
+                                IOThreads defines where the IOThreads run. With shared they run on the pCPU of the isolated emulator
+                                thread, or on the pCPUs of the vCPUs if the emulator thread is not isolated. With dedicated and
+                                sameNUMANode all IOThreads run together on an additional pCPU. Defaults to shared.
+                              type: string
+                          type: object
                         threads:
                           description: |-
                             Threads specifies the number of threads inside the vmi.
@@ -6102,6 +7206,51 @@ var CRDsValidation map[string]string = map[string]string{
                                   IO specifies which QEMU disk IO mode should be used.
                                   Supported values are: native, default, threads.
                                 type: string
+                              ioTune:
+                                description: |-
+                                  IOTune limits the IO operations and the throughput of the disk.
+                                  It can be set when hotplugging the disk.
+                                properties:
+                                  readBytesSec:
+                                    anyOf:
+                                    - type: integer
+                                    - type: string
+                                    description: ReadBytesSec limits the read throughput
+                                      in bytes per second.
+                                    pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                    x-kubernetes-int-or-string: true
+                                  readIOPSSec:
+                                    description: ReadIOPSSec limits the number of
+                                      read operations per second.
+                                    format: int64
+                                    type: integer
+                                  totalBytesSec:
+                                    anyOf:
+                                    - type: integer
+                                    - type: string
+                                    description: TotalBytesSec limits the total throughput
+                                      of reads and writes in bytes per second.
+                                    pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                    x-kubernetes-int-or-string: true
+                                  totalIOPSSec:
+                                    description: TotalIOPSSec limits the total number
+                                      of read and write operations per second.
+                                    format: int64
+                                    type: integer
+                                  writeBytesSec:
+                                    anyOf:
+                                    - type: integer
+                                    - type: string
+                                    description: WriteBytesSec limits the write throughput
+                                      in bytes per second.
+                                    pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                    x-kubernetes-int-or-string: true
+                                  writeIOPSSec:
+                                    description: WriteIOPSSec limits the number of
+                                      write operations per second.
+                                    format: int64
+                                    type: integer
+                                type: object
                               lun:
                                 description: Attach a volume as a LUN to the vmi.
                                 properties:
@@ -6155,6 +7304,85 @@ var CRDsValidation map[string]string = map[string]string{
                                 type: string
                               virtiofs:
                                 description: Virtiofs is supported
+                                properties:
+                                  cache:
+                                    description: |-
+                                      Cache selects the caching policy of the virtiofs daemon serving the
+                                      filesystem. Use never when other pods write to the same volume
+                                      concurrently, so that the guest always sees their changes.
+                                      Supported values: auto, always, never. Defaults to auto.
+                                    enum:
+                                    - auto
+                                    - always
+                                    - never
+                                    type: string
+                                  idMapping:
+                                    description: |-
+                                      IDMapping translates the user and group IDs seen by the guest into
+                                      the IDs stored on the shared volume. Use it to let the guest and
+                                      other pods sharing the volume agree on file ownership.
+                                    properties:
+                                      groupIDs:
+                                        description: GroupIDs maps ranges of guest
+                                          group IDs to group IDs on the volume.
+                                        items:
+                                          properties:
+                                            count:
+                                              description: Count is the number of
+                                                consecutive IDs in the range.
+                                              format: int64
+                                              minimum: 1
+                                              type: integer
+                                            guestID:
+                                              description: GuestID is the first ID
+                                                of the range as seen by the guest.
+                                              format: int64
+                                              minimum: 0
+                                              type: integer
+                                            hostID:
+                                              description: HostID is the first ID
+                                                of the range as stored on the volume.
+                                              format: int64
+                                              minimum: 0
+                                              type: integer
+                                          required:
+                                          - count
+                                          - guestID
+                                          - hostID
+                                          type: object
+                                        type: array
+                                        x-kubernetes-list-type: atomic
+                                      userIDs:
+                                        description: UserIDs maps ranges of guest
+                                          user IDs to user IDs on the volume.
+                                        items:
+                                          properties:
+                                            count:
+                                              description: Count is the number of
+                                                consecutive IDs in the range.
+                                              format: int64
+                                              minimum: 1
+                                              type: integer
+                                            guestID:
+                                              description: GuestID is the first ID
+                                                of the range as seen by the guest.
+                                              format: int64
+                                              minimum: 0
+                                              type: integer
+                                            hostID:
+                                              description: HostID is the first ID
+                                                of the range as stored on the volume.
+                                              format: int64
+                                              minimum: 0
+                                              type: integer
+                                          required:
+                                          - count
+                                          - guestID
+                                          - hostID
+                                          type: object
+                                        type: array
+                                        x-kubernetes-list-type: atomic
+                                    type: object
                                 type: object
                             required:
                             - name
@@ -6259,6 +7487,74 @@ var CRDsValidation map[string]string = map[string]string{
                                   in PCI addresses assigned to the device.
                                   This value is required to be unique across all devices and be between 1 and (16*1024-1).
                                 type: integer
+                              bandwidth:
+                                description: |-
+                                  Bandwidth limits the traffic of the interface.
+                                  It is only supported on bridge and masquerade bindings.
+                                properties:
+                                  inbound:
+                                    description: Inbound limits the traffic received
+                                      by the guest.
+                                    properties:
+                                      average:
+                                        anyOf:
+                                        - type: integer
+                                        - type: string
+                                        description: Average is the average rate the
+                                          traffic is shaped to, in bytes per second.
+                                        pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                        x-kubernetes-int-or-string: true
+                                      burst:
+                                        anyOf:
+                                        - type: integer
+                                        - type: string
+                                        description: Burst is the amount of bytes
+                                          which may be transferred at the peak rate.
+                                        pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                        x-kubernetes-int-or-string: true
+                                      peak:
+                                        anyOf:
+                                        - type: integer
+                                        - type: string
+                                        description: Peak is the rate the traffic
+                                          may reach while bursting, in bytes per second.
+                                        pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                        x-kubernetes-int-or-string: true
+                                    required:
+                                    - average
+                                    type: object
+                                  outbound:
+                                    description: Outbound limits the traffic transmitted
+                                      by the guest.
+                                    properties:
+                                      average:
+                                        anyOf:
+                                        - type: integer
+                                        - type: string
+                                        description: Average is the average rate the
+                                          traffic is shaped to, in bytes per second.
+                                        pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                        x-kubernetes-int-or-string: true
+                                      burst:
+                                        anyOf:
+                                        - type: integer
+                                        - type: string
+                                        description: Burst is the amount of bytes
+                                          which may be transferred at the peak rate.
+                                        pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                        x-kubernetes-int-or-string: true
+                                      peak:
+                                        anyOf:
+                                        - type: integer
+                                        - type: string
+                                        description: Peak is the rate the traffic
+                                          may reach while bursting, in bytes per second.
+                                        pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                        x-kubernetes-int-or-string: true
+                                    required:
+                                    - average
+                                    type: object
+                                type: object
                               binding:
                                 description: |-
                                   Binding specifies the binding plugin that will be used to connect the interface to the guest.
@@ -6292,6 +7588,12 @@ var CRDsValidation map[string]string = map[string]string{
                                     description: If specified will pass option 67
                                       to interface's DHCP server
                                     type: string
+                                  mtu:
+                                    description: If specified will pass the MTU to
+                                      the VM via DHCP option 026 instead of the MTU
+                                      of the pod interface.
+                                    format: int32
+                                    type: integer
                                   ntpServers:
                                     description: If specified will pass the configured
                                       NTP server to the VM via DHCP option 042.
@@ -6320,6 +7622,29 @@ var CRDsValidation map[string]string = map[string]string{
                                       - value
                                       type: object
                                     type: array
+                                  routes:
+                                    description: If specified will pass the routes
+                                      to the VM via DHCP option 121, in addition to
+                                      the routes of the pod interface.
+                                    items:
+                                      description: DHCPRoute defines a classless static
+                                        route passed to the VM via DHCP.
+                                      properties:
+                                        destination:
+                                          description: |-
+                                            Destination is the IPv4 CIDR of the route
+                                            Required.
+                                          type: string
+                                        gateway:
+                                          description: Gateway is the IPv4 address
+                                            of the next hop, the route is on-link
+                                            if omitted
+                                          type: string
+                                      required:
+                                      - destination
+                                      type: object
+                                    type: array
+                                    x-kubernetes-list-type: atomic
                                   tftpServerName:
                                     description: If specified will pass option 66
                                       to interface's DHCP server
@@ -6461,14 +7786,41 @@ var CRDsValidation map[string]string = map[string]string{
                                 Defaults to false
                               type: boolean
                           type: object
-                        useVirtioTransitional:
+                        usbDevices:
                           description: |-
-                            Fall back to legacy virtio 0.9 support if virtio bus is selected on devices.
-                            This is helpful for old machines like CentOS6 or RHEL6 which
-                            do not understand virtio_non_transitional (virtio 1.0).
-                          type: boolean
-                        watchdog:
-                          description: Watchdog describes a watchdog device which
+                            USBDevices are host USB devices passed through to the vmi. They are allocated by virt-handler
+                            among the devices plugged into the node, and attached to the vmi as they get plugged.
+                          items:
+                            description: |-
+                              USBDevice selects a USB device plugged into the node of the vmi, either by its vendor and product
+                              identifiers or by the port it is plugged into. Exactly one of the selectors has to be set.
+                            properties:
+                              busPort:
+                                description: |-
+                                  BusPort selects the device plugged into a port, by the bus number and port path the kernel
+                                  names the device after, e.g. 1-2.3
+                                type: string
+                              name:
+                                description: Name of the USB device, unique within
+                                  the vmi
+                                type: string
+                              vendorProduct:
+                                description: VendorProduct selects a free device by
+                                  its vendor_id:product_id tuple, e.g. 1050:0407
+                                type: string
+                            required:
+                            - name
+                            type: object
+                          type: array
+                          x-kubernetes-list-type: atomic
+                        useVirtioTransitional:
+                          description: |-
+                            Fall back to legacy virtio 0.9 support if virtio bus is selected on devices.
+                            This is helpful for old machines like CentOS6 or RHEL6 which
+                            do not understand virtio_non_transitional (virtio 1.0).
+                          type: boolean
+                        watchdog:
+                          description: Watchdog describes a watchdog device which
                             can be added to the vmi.
                           properties:
                             i6300esb:
@@ -6955,6 +8307,26 @@ var CRDsValidation map[string]string = map[string]string{
                     - "LiveMigrateIfPossible": the same as "LiveMigrate" but only if the VirtualMachine is Live-Migratable, otherwise it will behave as "None".
                     - "External": the VirtualMachineInstance will be protected by a PDB and 'vmi.Status.EvacuationNodeName' will be set on eviction. This is mainly useful for cluster-api-provider-kubevirt (capk) which needs a way for VMI's to be blocked from eviction, yet signal capk that eviction has been called on the VMI so the capk controller can handle tearing the VMI down. Details can be found in the commit description https://github.com/kubevirt/kubevirt/commit/c1d77face705c8b126696bac9a3ee3825f27f1fa.
                   type: string
+                guestLicense:
+                  description: |-
+                    GuestLicense describes the licensing of the guest operating system.
+                    It is exposed in the kubevirt_vmi_guest_license_info metric.
+                  properties:
+                    byol:
+                      description: |-
+                        BYOL tells if the license of the guest operating system is brought by the owner of the VirtualMachineInstance
+                        instead of being provided with the cluster.
+                      type: boolean
+                    licenseModel:
+                      description: LicenseModel is the model the guest operating system
+                        is licensed under, like subscription or perCore.
+                      type: string
+                    osFamily:
+                      description: |-
+                        OSFamily is the family of the guest operating system, one of linux, windows or other.
+                        If not set, it is detected from the operating system reported by the guest agent.
+                      type: string
+                  type: object
                 hostname:
                   description: |-
                     Specifies the hostname of the vmi
@@ -7298,6 +8670,26 @@ var CRDsValidation map[string]string = map[string]string{
                     If specified, the VMI will be dispatched by specified scheduler.
                     If not specified, the VMI will be dispatched by default scheduler.
                   type: string
+                shutdownPolicy:
+                  description: |-
+                    ShutdownPolicy escalates the graceful shutdown of the guest in stages, from an ACPI power button press,
+                    to a shutdown command sent through the guest agent, to forcefully powering off the guest.
+                    If not set, the guest is asked to shut down until the termination grace period expires.
+                  properties:
+                    acpiTimeoutSeconds:
+                      description: |-
+                        ACPITimeoutSeconds is the time the guest is given to power off after the ACPI power button was pressed.
+                        Defaults to 30.
+                      format: int64
+                      type: integer
+                    guestAgentTimeoutSeconds:
+                      description: |-
+                        GuestAgentTimeoutSeconds is the time the guest is given to power off after the shutdown command was sent
+                        through the guest agent, before it is forcefully powered off. The stage is skipped if no guest agent is connected.
+                        Defaults to 30.
+                      format: int64
+                      type: integer
+                  type: object
                 startStrategy:
                   description: StartStrategy can be set to "Paused" if Virtual Machine
                     should be started in paused state.
@@ -7822,6 +9214,27 @@ var CRDsValidation map[string]string = map[string]string{
                           specified source and provides copy-on-write image on top
                           of it.
                         properties:
+                          overlay:
+                            description: |-
+                              Overlay places the copy-on-write overlay holding the writes of the guest
+                              on a dedicated volume instead of the ephemeral storage of the pod.
+                            properties:
+                              capacity:
+                                anyOf:
+                                - type: integer
+                                - type: string
+                                description: Capacity of the overlay volume, limiting
+                                  how much the guest can write.
+                                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                x-kubernetes-int-or-string: true
+                              storageClassName:
+                                description: StorageClassName is the storage class
+                                  of the overlay volume.
+                                type: string
+                            required:
+                            - capacity
+                            - storageClassName
+                            type: object
                           persistentVolumeClaim:
                             description: |-
                               PersistentVolumeClaimVolumeSource represents a reference to a PersistentVolumeClaim in the same namespace.
@@ -7874,11 +9287,26 @@ var CRDsValidation map[string]string = map[string]string{
                         description: MemoryDump is attached to the virt launcher and
                           is populated with a memory dump of the vmi
                         properties:
+                          chunkSize:
+                            anyOf:
+                            - type: integer
+                            - type: string
+                            description: ChunkSize is the amount of the dump which
+                              is written to the volume before it is flushed to disk
+                            pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                            x-kubernetes-int-or-string: true
                           claimName:
                             description: |-
                               claimName is the name of a PersistentVolumeClaim in the same namespace as the pod using this volume.
                               More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#persistentvolumeclaims
                             type: string
+                          compression:
+                            description: Compression compresses the memory dump while
+                              it is written to the volume
+                            enum:
+                            - gzip
+                            - zstd
+                            type: string
                           hotpluggable:
                             description: Hotpluggable indicates whether the volume
                               can be hotplugged and hotunplugged.
@@ -8001,6 +9429,43 @@ var CRDsValidation map[string]string = map[string]string{
         updateVolumesStrategy:
           description: UpdateVolumesStrategy is the strategy to apply on volumes updates
           type: string
+        volumeAutoExpansion:
+          description: |-
+            VolumeAutoExpansion expands the PVCs of the VirtualMachine when the guest filesystems on them are filling up.
+            It requires the VolumeAutoExpansion feature gate and a guest agent.
+          properties:
+            incrementPercentage:
+              description: IncrementPercentage is by how much, in percent of its capacity,
+                a PVC is expanded. Defaults to 20.
+              format: int32
+              minimum: 1
+              type: integer
+            maxSize:
+              anyOf:
+              - type: integer
+              - type: string
+              description: MaxSize is the size PVCs are not expanded beyond
+              pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+              x-kubernetes-int-or-string: true
+            usageThresholdPercentage:
+              description: |-
+                UsageThresholdPercentage is the usage of the guest filesystems on a volume, in percent of their size,
+                above which the PVC of the volume is expanded
+              format: int32
+              maximum: 99
+              minimum: 1
+              type: integer
+            volumes:
+              description: Volumes are the names of the PVC and DataVolume volumes
+                which are expanded. Their disks need a serial.
+              items:
+                type: string
+              type: array
+              x-kubernetes-list-type: set
+          required:
+          - usageThresholdPercentage
+          - volumes
+          type: object
       required:
       - template
       type: object
@@ -8083,10 +9548,28 @@ var CRDsValidation map[string]string = map[string]string{
             dump to the given pvc
           nullable: true
           properties:
+            chunkSize:
+              anyOf:
+              - type: integer
+              - type: string
+              description: |-
+                ChunkSize is the amount of the dump which is written to the pvc before it is flushed and dropped from the
+                page cache of the virt-launcher pod. Defaults to 64Mi if the dump is compressed, otherwise the dump is
+                written by the hypervisor directly
+              pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+              x-kubernetes-int-or-string: true
             claimName:
               description: ClaimName is the name of the pvc that will contain the
                 memory dump
               type: string
+            compression:
+              description: |-
+                Compression compresses the memory dump while it is written to the pvc. The compressed dump does not
+                have to fit the memory of the vm, the pvc size is therefore not validated. Defaults to no compression
+              enum:
+              - gzip
+              - zstd
+              type: string
             endTimestamp:
               description: EndTimestamp represents the time the memory dump was completed
               format: date-time
@@ -8209,6 +9692,60 @@ var CRDsValidation map[string]string = map[string]string{
             - action
             type: object
           type: array
+        storageMigration:
+          description: StorageMigration tracks the migration of the volumes to the
+            StorageClass requested in the spec
+          nullable: true
+          properties:
+            endTimestamp:
+              format: date-time
+              type: string
+            message:
+              description: Message explains why the storage migration is pending or
+                failed
+              type: string
+            method:
+              description: Method is the way the volumes are copied
+              type: string
+            phase:
+              description: Phase of the storage migration
+              type: string
+            startTimestamp:
+              format: date-time
+              type: string
+            storageClassName:
+              description: StorageClassName is the StorageClass the volumes are migrated
+                to
+              type: string
+            volumes:
+              description: Volumes lists the migrated volumes and their progress
+              items:
+                properties:
+                  percentComplete:
+                    description: PercentComplete is the progress of the copy of the
+                      volume
+                    format: int32
+                    type: integer
+                  sourceClaim:
+                    description: SourceClaim is the claim the volume is migrated from
+                    type: string
+                  targetClaim:
+                    description: TargetClaim is the DataVolume the volume is migrated
+                      to
+                    type: string
+                  volumeName:
+                    description: VolumeName is the name of the volume in the VirtualMachine
+                    type: string
+                required:
+                - sourceClaim
+                - targetClaim
+                - volumeName
+                type: object
+              type: array
+              x-kubernetes-list-type: atomic
+          required:
+          - storageClassName
+          type: object
         volumeRequests:
           description: |-
             VolumeRequests indicates a list of volumes add or remove from the VMI template and
@@ -8316,6 +9853,51 @@ var CRDsValidation map[string]string = map[string]string{
                           IO specifies which QEMU disk IO mode should be used.
                           Supported values are: native, default, threads.
                         type: string
+                      ioTune:
+                        description: |-
+                          IOTune limits the IO operations and the throughput of the disk.
+                          It can be set when hotplugging the disk.
+                        properties:
+                          readBytesSec:
+                            anyOf:
+                            - type: integer
+                            - type: string
+                            description: ReadBytesSec limits the read throughput in
+                              bytes per second.
+                            pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                            x-kubernetes-int-or-string: true
+                          readIOPSSec:
+                            description: ReadIOPSSec limits the number of read operations
+                              per second.
+                            format: int64
+                            type: integer
+                          totalBytesSec:
+                            anyOf:
+                            - type: integer
+                            - type: string
+                            description: TotalBytesSec limits the total throughput
+                              of reads and writes in bytes per second.
+                            pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                            x-kubernetes-int-or-string: true
+                          totalIOPSSec:
+                            description: TotalIOPSSec limits the total number of read
+                              and write operations per second.
+                            format: int64
+                            type: integer
+                          writeBytesSec:
+                            anyOf:
+                            - type: integer
+                            - type: string
+                            description: WriteBytesSec limits the write throughput
+                              in bytes per second.
+                            pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                            x-kubernetes-int-or-string: true
+                          writeIOPSSec:
+                            description: WriteIOPSSec limits the number of write operations
+                              per second.
+                            format: int64
+                            type: integer
+                        type: object
                       lun:
                         description: Attach a volume as a LUN to the vmi.
                         properties:
@@ -8794,284 +10376,1394 @@ var CRDsValidation map[string]string = map[string]string{
     spec:
       description: Required spec describing the instancetype
       properties:
-        annotations:
-          additionalProperties:
-            type: string
-          description: Optionally defines the required Annotations to be used by the
-            instance type and applied to the VirtualMachineInstance
-          type: object
-        cpu:
-          description: Required CPU related attributes of the instancetype.
-          properties:
-            dedicatedCPUPlacement:
-              description: |-
-                DedicatedCPUPlacement requests the scheduler to place the VirtualMachineInstance on a node
-                with enough dedicated pCPUs and pin the vCPUs to it.
-              type: boolean
-            guest:
-              description: |-
-                Required number of vCPUs to expose to the guest.
+        affinity:
+          description: |-
+            If affinity is specified, obey all the affinity rules.
 
-                The resulting CPU topology being derived from the optional PreferredCPUTopology attribute of CPUPreferences that itself defaults to PreferSockets.
-              format: int32
-              type: integer
-            isolateEmulatorThread:
-              description: |-
-                IsolateEmulatorThread requests one more dedicated pCPU to be allocated for the VMI to place
-                the emulator thread on it.
-              type: boolean
-            maxSockets:
-              description: MaxSockets specifies the maximum amount of sockets that
-                can be hotplugged
-              format: int32
-              type: integer
-            model:
-              description: |-
-                Model specifies the CPU model inside the VMI.
-                List of available models https://github.com/libvirt/libvirt/tree/master/src/cpu_map.
-                It is possible to specify special cases like "host-passthrough" to get the same CPU as the node
-                and "host-model" to get CPU closest to the node one.
-                Defaults to host-model.
-              type: string
-            numa:
-              description: NUMA allows specifying settings for the guest NUMA topology
-              properties:
-                guestMappingPassthrough:
-                  description: |-
-                    GuestMappingPassthrough will create an efficient guest topology based on host CPUs exclusively assigned to a pod.
-                    The created topology ensures that memory and CPUs on the virtual numa nodes never cross boundaries of host numa nodes.
-                  type: object
-              type: object
-            realtime:
-              description: Realtime instructs the virt-launcher to tune the VMI for
-                lower latency, optional for real time workloads
+            Affinity is the affinity of the VirtualMachineInstance to nodes and other pods required by the instancetype.
+          properties:
+            nodeAffinity:
+              description: Describes node affinity scheduling rules for the pod.
               properties:
-                mask:
+                preferredDuringSchedulingIgnoredDuringExecution:
                   description: |-
-                    Mask defines the vcpu mask expression that defines which vcpus are used for realtime. Format matches libvirt's expressions.
-                    Example: "0-3,^1","0,2,3","2-3"
-                  type: string
-              type: object
-          required:
-          - guest
-          type: object
-        gpus:
-          description: Optionally defines any GPU devices associated with the instancetype.
-          items:
-            properties:
-              deviceName:
-                type: string
-              name:
-                description: Name of the GPU device as exposed by a device plugin
-                type: string
-              tag:
-                description: If specified, the virtual network interface address and
-                  its tag will be provided to the guest via config drive
-                type: string
-              virtualGPUOptions:
-                properties:
-                  display:
+                    The scheduler will prefer to schedule pods to nodes that satisfy
+                    the affinity expressions specified by this field, but it may choose
+                    a node that violates one or more of the expressions. The node that is
+                    most preferred is the one with the greatest sum of weights, i.e.
+                    for each node that meets all of the scheduling requirements (resource
+                    request, requiredDuringScheduling affinity expressions, etc.),
+                    compute a sum by iterating through the elements of this field and adding
+                    "weight" to the sum if the node matches the corresponding matchExpressions; the
+                    node(s) with the highest sum are the most preferred.
+                  items:
+                    description: |-
+                      An empty preferred scheduling term matches all objects with implicit weight 0
+                      (i.e. it's a no-op). A null preferred scheduling term matches no objects (i.e. is also a no-op).
                     properties:
-                      enabled:
-                        description: |-
-                          Enabled determines if a display addapter backed by a vGPU should be enabled or disabled on the guest.
-                          Defaults to true.
-                        type: boolean
-                      ramFB:
-                        description: |-
-                          Enables a boot framebuffer, until the guest OS loads a real GPU driver
-                          Defaults to true.
+                      preference:
+                        description: A node selector term, associated with the corresponding
+                          weight.
                         properties:
-                          enabled:
-                            description: |-
-                              Enabled determines if the feature should be enabled or disabled on the guest.
-                              Defaults to true.
-                            type: boolean
-                        type: object
-                    type: object
-                type: object
-            required:
-            - deviceName
-            - name
-            type: object
-          type: array
-          x-kubernetes-list-type: atomic
-        hostDevices:
-          description: Optionally defines any HostDevices associated with the instancetype.
-          items:
-            properties:
-              deviceName:
-                description: DeviceName is the resource name of the host device exposed
-                  by a device plugin
-                type: string
-              name:
-                type: string
-              tag:
-                description: If specified, the virtual network interface address and
-                  its tag will be provided to the guest via config drive
-                type: string
-            required:
-            - deviceName
-            - name
-            type: object
-          type: array
-          x-kubernetes-list-type: atomic
-        ioThreadsPolicy:
-          description: Optionally defines the IOThreadsPolicy to be used by the instancetype.
-          type: string
-        launchSecurity:
-          description: Optionally defines the LaunchSecurity to be used by the instancetype.
-          properties:
-            sev:
-              description: AMD Secure Encrypted Virtualization (SEV).
-              properties:
-                attestation:
-                  description: If specified, run the attestation process for a vmi.
-                  type: object
-                dhCert:
-                  description: Base64 encoded guest owner's Diffie-Hellman key.
-                  type: string
-                policy:
+                          matchExpressions:
+                            description: A list of node selector requirements by node's
+                              labels.
+                            items:
+                              description: |-
+                                A node selector requirement is a selector that contains values, a key, and an operator
+                                that relates the key and values.
+                              properties:
+                                key:
+                                  description: The label key that the selector applies
+                                    to.
+                                  type: string
+                                operator:
+                                  description: |-
+                                    Represents a key's relationship to a set of values.
+                                    Valid operators are In, NotIn, Exists, DoesNotExist. Gt, and Lt.
+                                  type: string
+                                values:
+                                  description: |-
+                                    An array of string values. If the operator is In or NotIn,
+                                    the values array must be non-empty. If the operator is Exists or DoesNotExist,
+                                    the values array must be empty. If the operator is Gt or Lt, the values
+                                    array must have a single element, which will be interpreted as an integer.
+                                    This array is replaced during a strategic merge patch.
+                                  items:
+                                    type: string
+                                  type: array
+                                  x-kubernetes-list-type: atomic
+                              required:
+                              - key
+                              - operator
+                              type: object
+                            type: array
+                            x-kubernetes-list-type: atomic
+                          matchFields:
+                            description: A list of node selector requirements by node's
+                              fields.
+                            items:
+                              description: |-
+                                A node selector requirement is a selector that contains values, a key, and an operator
+                                that relates the key and values.
+                              properties:
+                                key:
+                                  description: The label key that the selector applies
+                                    to.
+                                  type: string
+                                operator:
+                                  description: |-
+                                    Represents a key's relationship to a set of values.
+                                    Valid operators are In, NotIn, Exists, DoesNotExist. Gt, and Lt.
+                                  type: string
+                                values:
+                                  description: |-
+                                    An array of string values. If the operator is In or NotIn,
+                                    the values array must be non-empty. If the operator is Exists or DoesNotExist,
+                                    the values array must be empty. If the operator is Gt or Lt, the values
+                                    array must have a single element, which will be interpreted as an integer.
+                                    This array is replaced during a strategic merge patch.
+                                  items:
+                                    type: string
+                                  type: array
+                                  x-kubernetes-list-type: atomic
+                              required:
+                              - key
+                              - operator
+                              type: object
+                            type: array
+                            x-kubernetes-list-type: atomic
+                        type: object
+                        x-kubernetes-map-type: atomic
+                      weight:
+                        description: Weight associated with matching the corresponding
+                          nodeSelectorTerm, in the range 1-100.
+                        format: int32
+                        type: integer
+                    required:
+                    - preference
+                    - weight
+                    type: object
+                  type: array
+                  x-kubernetes-list-type: atomic
+                requiredDuringSchedulingIgnoredDuringExecution:
                   description: |-
-                    Guest policy flags as defined in AMD SEV API specification.
-                    Note: due to security reasons it is not allowed to enable guest debugging. Therefore NoDebug flag is not exposed to users and is always true.
+                    If the affinity requirements specified by this field are not met at
+                    scheduling time, the pod will not be scheduled onto the node.
+                    If the affinity requirements specified by this field cease to be met
+                    at some point during pod execution (e.g. due to an update), the system
+                    may or may not try to eventually evict the pod from its node.
                   properties:
-                    encryptedState:
-                      description: |-
-                        SEV-ES is required.
-                        Defaults to false.
-                      type: boolean
+                    nodeSelectorTerms:
+                      description: Required. A list of node selector terms. The terms
+                        are ORed.
+                      items:
+                        description: |-
+                          A null or empty node selector term matches no objects. The requirements of
+                          them are ANDed.
+                          The TopologySelectorTerm type implements a subset of the NodeSelectorTerm.
+                        properties:
+                          matchExpressions:
+                            description: A list of node selector requirements by node's
+                              labels.
+                            items:
+                              description: |-
+                                A node selector requirement is a selector that contains values, a key, and an operator
+                                that relates the key and values.
+                              properties:
+                                key:
+                                  description: The label key that the selector applies
+                                    to.
+                                  type: string
+                                operator:
+                                  description: |-
+                                    Represents a key's relationship to a set of values.
+                                    Valid operators are In, NotIn, Exists, DoesNotExist. Gt, and Lt.
+                                  type: string
+                                values:
+                                  description: |-
+                                    An array of string values. If the operator is In or NotIn,
+                                    the values array must be non-empty. If the operator is Exists or DoesNotExist,
+                                    the values array must be empty. If the operator is Gt or Lt, the values
+                                    array must have a single element, which will be interpreted as an integer.
+                                    This array is replaced during a strategic merge patch.
+                                  items:
+                                    type: string
+                                  type: array
+                                  x-kubernetes-list-type: atomic
+                              required:
+                              - key
+                              - operator
+                              type: object
+                            type: array
+                            x-kubernetes-list-type: atomic
+                          matchFields:
+                            description: A list of node selector requirements by node's
+                              fields.
+                            items:
+                              description: |-
+                                A node selector requirement is a selector that contains values, a key, and an operator
+                                that relates the key and values.
+                              properties:
+                                key:
+                                  description: The label key that the selector applies
+                                    to.
+                                  type: string
+                                operator:
+                                  description: |-
+                                    Represents a key's relationship to a set of values.
+                                    Valid operators are In, NotIn, Exists, DoesNotExist. Gt, and Lt.
+                                  type: string
+                                values:
+                                  description: |-
+                                    An array of string values. If the operator is In or NotIn,
+                                    the values array must be non-empty. If the operator is Exists or DoesNotExist,
+                                    the values array must be empty. If the operator is Gt or Lt, the values
+                                    array must have a single element, which will be interpreted as an integer.
+                                    This array is replaced during a strategic merge patch.
+                                  items:
+                                    type: string
+                                  type: array
+                                  x-kubernetes-list-type: atomic
+                              required:
+                              - key
+                              - operator
+                              type: object
+                            type: array
+                            x-kubernetes-list-type: atomic
+                        type: object
+                        x-kubernetes-map-type: atomic
+                      type: array
+                      x-kubernetes-list-type: atomic
+                  required:
+                  - nodeSelectorTerms
                   type: object
-                session:
-                  description: Base64 encoded session blob.
-                  type: string
+                  x-kubernetes-map-type: atomic
               type: object
-          type: object
-        memory:
-          description: Required Memory related attributes of the instancetype.
-          properties:
-            guest:
-              anyOf:
-              - type: integer
-              - type: string
-              description: Required amount of memory which is visible inside the guest
-                OS.
-              pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
-              x-kubernetes-int-or-string: true
-            hugepages:
-              description: Optionally enables the use of hugepages for the VirtualMachineInstance
-                instead of regular memory.
+            podAffinity:
+              description: Describes pod affinity scheduling rules (e.g. co-locate
+                this pod in the same node, zone, etc. as some other pod(s)).
               properties:
-                pageSize:
-                  description: PageSize specifies the hugepage size, for x86_64 architecture
-                    valid values are 1Gi and 2Mi.
-                  type: string
-              type: object
-            maxGuest:
-              anyOf:
-              - type: integer
-              - type: string
-              description: |-
-                MaxGuest allows to specify the maximum amount of memory which is visible inside the Guest OS.
-                The delta between MaxGuest and Guest is the amount of memory that can be hot(un)plugged.
-              pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
-              x-kubernetes-int-or-string: true
-            overcommitPercent:
-              description: |-
-                OvercommitPercent is the percentage of the guest memory which will be overcommitted.
-                This means that the VMIs parent pod (virt-launcher) will request less
-                physical memory by a factor specified by the OvercommitPercent.
-                Overcommits can lead to memory exhaustion, which in turn can lead to crashes. Use carefully.
-                Defaults to 0
-              maximum: 100
-              minimum: 0
-              type: integer
-          required:
-          - guest
-          type: object
-        nodeSelector:
-          additionalProperties:
-            type: string
-          description: |-
-            NodeSelector is a selector which must be true for the vmi to fit on a node.
-            Selector which must match a node's labels for the vmi to be scheduled on that node.
-            More info: https://kubernetes.io/docs/concepts/configuration/assign-pod-node/
-
-            NodeSelector is the name of the custom node selector for the instancetype.
-          type: object
-        schedulerName:
-          description: |-
-            If specified, the VMI will be dispatched by specified scheduler.
-            If not specified, the VMI will be dispatched by default scheduler.
-
-            SchedulerName is the name of the custom K8s scheduler for the instancetype.
-          type: string
-      required:
-      - cpu
-      - memory
-      type: object
-  required:
-  - spec
-  type: object
-`,
-	"virtualmachineclusterpreference": `openAPIV3Schema:
-  description: VirtualMachineClusterPreference is a cluster scoped version of the
-    VirtualMachinePreference resource.
-  properties:
-    apiVersion:
-      description: |-
-        APIVersion defines the versioned schema of this representation of an object.
-        Servers should convert recognized schemas to the latest internal value, and
-        may reject unrecognized values.
-        More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources
-      type: string
-    kind:
-      description: |-
-        Kind is a string value representing the REST resource this object represents.
-        Servers may infer this from the endpoint the client submits requests to.
-        Cannot be updated.
-        In CamelCase.
-        More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
-      type: string
-    metadata:
-      type: object
-    spec:
-      description: Required spec describing the preferences
-      properties:
-        annotations:
-          additionalProperties:
-            type: string
-          description: Optionally defines preferred Annotations to be applied to the
-            VirtualMachineInstance
-          type: object
-        clock:
-          description: Clock optionally defines preferences associated with the Clock
-            attribute of a VirtualMachineInstance DomainSpec
-          properties:
-            preferredClockOffset:
-              description: ClockOffset allows specifying the UTC offset or the timezone
-                of the guest clock.
-              properties:
-                timezone:
+                preferredDuringSchedulingIgnoredDuringExecution:
                   description: |-
-                    Timezone sets the guest clock to the specified timezone.
-                    Zone name follows the TZ environment variable format (e.g. 'America/New_York').
-                  type: string
-                utc:
+                    The scheduler will prefer to schedule pods to nodes that satisfy
+                    the affinity expressions specified by this field, but it may choose
+                    a node that violates one or more of the expressions. The node that is
+                    most preferred is the one with the greatest sum of weights, i.e.
+                    for each node that meets all of the scheduling requirements (resource
+                    request, requiredDuringScheduling affinity expressions, etc.),
+                    compute a sum by iterating through the elements of this field and adding
+                    "weight" to the sum if the node has pods which matches the corresponding podAffinityTerm; the
+                    node(s) with the highest sum are the most preferred.
+                  items:
+                    description: The weights of all of the matched WeightedPodAffinityTerm
+                      fields are added per-node to find the most preferred node(s)
+                    properties:
+                      podAffinityTerm:
+                        description: Required. A pod affinity term, associated with
+                          the corresponding weight.
+                        properties:
+                          labelSelector:
+                            description: |-
+                              A label query over a set of resources, in this case pods.
+                              If it's null, this PodAffinityTerm matches with no Pods.
+                            properties:
+                              matchExpressions:
+                                description: matchExpressions is a list of label selector
+                                  requirements. The requirements are ANDed.
+                                items:
+                                  description: |-
+                                    A label selector requirement is a selector that contains values, a key, and an operator that
+                                    relates the key and values.
+                                  properties:
+                                    key:
+                                      description: key is the label key that the selector
+                                        applies to.
+                                      type: string
+                                    operator:
+                                      description: |-
+                                        operator represents a key's relationship to a set of values.
+                                        Valid operators are In, NotIn, Exists and DoesNotExist.
+                                      type: string
+                                    values:
+                                      description: |-
+                                        values is an array of string values. If the operator is In or NotIn,
+                                        the values array must be non-empty. If the operator is Exists or DoesNotExist,
+                                        the values array must be empty. This array is replaced during a strategic
+                                        merge patch.
+                                      items:
+                                        type: string
+                                      type: array
+                                      x-kubernetes-list-type: atomic
+                                  required:
+                                  - key
+                                  - operator
+                                  type: object
+                                type: array
+                                x-kubernetes-list-type: atomic
+                              matchLabels:
+                                additionalProperties:
+                                  type: string
+                                description: |-
+                                  matchLabels is a map of {key,value} pairs. A single {key,value} in the matchLabels
+                                  map is equivalent to an element of matchExpressions, whose key field is "key", the
+                                  operator is "In", and the values array contains only "value". The requirements are ANDed.
+                                type: object
+                            type: object
+                            x-kubernetes-map-type: atomic
+                          matchLabelKeys:
+                            description: |-
+                              MatchLabelKeys is a set of pod label keys to select which pods will
+                              be taken into consideration. The keys are used to lookup values from the
+                              incoming pod labels, those key-value labels are merged with 'labelSelector' as 'key in (value)'
+                              to select the group of existing pods which pods will be taken into consideration
+                              for the incoming pod's pod (anti) affinity. Keys that don't exist in the incoming
+                              pod labels will be ignored. The default value is empty.
+                              The same key is forbidden to exist in both matchLabelKeys and labelSelector.
+                              Also, matchLabelKeys cannot be set when labelSelector isn't set.
+                              This is a beta field and requires enabling MatchLabelKeysInPodAffinity feature gate (enabled by default).
+                            items:
+                              type: string
+                            type: array
+                            x-kubernetes-list-type: atomic
+                          mismatchLabelKeys:
+                            description: |-
+                              MismatchLabelKeys is a set of pod label keys to select which pods will
+                              be taken into consideration. The keys are used to lookup values from the
+                              incoming pod labels, those key-value labels are merged with 'labelSelector' as 'key notin (value)'
+                              to select the group of existing pods which pods will be taken into consideration
+                              for the incoming pod's pod (anti) affinity. Keys that don't exist in the incoming
+                              pod labels will be ignored. The default value is empty.
+                              The same key is forbidden to exist in both mismatchLabelKeys and labelSelector.
+                              Also, mismatchLabelKeys cannot be set when labelSelector isn't set.
+                              This is a beta field and requires enabling MatchLabelKeysInPodAffinity feature gate (enabled by default).
+                            items:
+                              type: string
+                            type: array
+                            x-kubernetes-list-type: atomic
+                          namespaceSelector:
+                            description: |-
+                              A label query over the set of namespaces that the term applies to.
+                              The term is applied to the union of the namespaces selected by this field
+                              and the ones listed in the namespaces field.
+                              null selector and null or empty namespaces list means "this pod's namespace".
+                              An empty selector ({}) matches all namespaces.
+                            properties:
+                              matchExpressions:
+                                description: matchExpressions is a list of label selector
+                                  requirements. The requirements are ANDed.
+                                items:
+                                  description: |-
+                                    A label selector requirement is a selector that contains values, a key, and an operator that
+                                    relates the key and values.
+                                  properties:
+                                    key:
+                                      description: key is the label key that the selector
+                                        applies to.
+                                      type: string
+                                    operator:
+                                      description: |-
+                                        operator represents a key's relationship to a set of values.
+                                        Valid operators are In, NotIn, Exists and DoesNotExist.
+                                      type: string
+                                    values:
+                                      description: |-
+                                        values is an array of string values. If the operator is In or NotIn,
+                                        the values array must be non-empty. If the operator is Exists or DoesNotExist,
+                                        the values array must be empty. This array is replaced during a strategic
+                                        merge patch.
+                                      items:
+                                        type: string
+                                      type: array
+                                      x-kubernetes-list-type: atomic
+                                  required:
+                                  - key
+                                  - operator
+                                  type: object
+                                type: array
+                                x-kubernetes-list-type: atomic
+                              matchLabels:
+                                additionalProperties:
+                                  type: string
+                                description: |-
+                                  matchLabels is a map of {key,value} pairs. A single {key,value} in the matchLabels
+                                  map is equivalent to an element of matchExpressions, whose key field is "key", the
+                                  operator is "In", and the values array contains only "value". The requirements are ANDed.
+                                type: object
+                            type: object
+                            x-kubernetes-map-type: atomic
+                          namespaces:
+                            description: |-
+                              namespaces specifies a static list of namespace names that the term applies to.
+                              The term is applied to the union of the namespaces listed in this field
+                              and the ones selected by namespaceSelector.
+                              null or empty namespaces list and null namespaceSelector means "this pod's namespace".
+                            items:
+                              type: string
+                            type: array
+                            x-kubernetes-list-type: atomic
+                          topologyKey:
+                            description: |-
+                              This pod should be co-located (affinity) or not co-located (anti-affinity) with the pods matching
+                              the labelSelector in the specified namespaces, where co-located is defined as running on a node
+                              whose value of the label with key topologyKey matches that of any node on which any of the
+                              selected pods is running.
+                              Empty topologyKey is not allowed.
+                            type: string
+                        required:
+                        - topologyKey
+                        type: object
+                      weight:
+                        description: |-
+                          weight associated with matching the corresponding podAffinityTerm,
+                          in the range 1-100.
+                        format: int32
+                        type: integer
+                    required:
+                    - podAffinityTerm
+                    - weight
+                    type: object
+                  type: array
+                  x-kubernetes-list-type: atomic
+                requiredDuringSchedulingIgnoredDuringExecution:
                   description: |-
-                    UTC sets the guest clock to UTC on each boot. If an offset is specified,
-                    guest changes to the clock will be kept during reboots and are not reset.
-                  properties:
-                    offsetSeconds:
-                      description: |-
-                        OffsetSeconds specifies an offset in seconds, relative to UTC. If set,
-                        guest changes to the clock will be kept during reboots and not reset.
-                      type: integer
-                  type: object
-              type: object
-            preferredTimer:
-              description: Timer specifies whih timers are attached to the vmi.
-              properties:
+                    If the affinity requirements specified by this field are not met at
+                    scheduling time, the pod will not be scheduled onto the node.
+                    If the affinity requirements specified by this field cease to be met
+                    at some point during pod execution (e.g. due to a pod label update), the
+                    system may or may not try to eventually evict the pod from its node.
+                    When there are multiple elements, the lists of nodes corresponding to each
+                    podAffinityTerm are intersected, i.e. all terms must be satisfied.
+                  items:
+                    description: |-
+                      Defines a set of pods (namely those matching the labelSelector
+                      relative to the given namespace(s)) that this pod should be
+                      co-located (affinity) or not co-located (anti-affinity) with,
+                      where co-located is defined as running on a node whose value of
+                      the label with key <topologyKey> matches that of any node on which
+                      a pod of the set of pods is running
+                    properties:
+                      labelSelector:
+                        description: |-
+                          A label query over a set of resources, in this case pods.
+                          If it's null, this PodAffinityTerm matches with no Pods.
+                        properties:
+                          matchExpressions:
+                            description: matchExpressions is a list of label selector
+                              requirements. The requirements are ANDed.
+                            items:
+                              description: |-
+                                A label selector requirement is a selector that contains values, a key, and an operator that
+                                relates the key and values.
+                              properties:
+                                key:
+                                  description: key is the label key that the selector
+                                    applies to.
+                                  type: string
+                                operator:
+                                  description: |-
+                                    operator represents a key's relationship to a set of values.
+                                    Valid operators are In, NotIn, Exists and DoesNotExist.
+                                  type: string
+                                values:
+                                  description: |-
+                                    values is an array of string values. If the operator is In or NotIn,
+                                    the values array must be non-empty. If the operator is Exists or DoesNotExist,
+                                    the values array must be empty. This array is replaced during a strategic
+                                    merge patch.
+                                  items:
+                                    type: string
+                                  type: array
+                                  x-kubernetes-list-type: atomic
+                              required:
+                              - key
+                              - operator
+                              type: object
+                            type: array
+                            x-kubernetes-list-type: atomic
+                          matchLabels:
+                            additionalProperties:
+                              type: string
+                            description: |-
+                              matchLabels is a map of {key,value} pairs. A single {key,value} in the matchLabels
+                              map is equivalent to an element of matchExpressions, whose key field is "key", the
+                              operator is "In", and the values array contains only "value". The requirements are ANDed.
+                            type: object
+                        type: object
+                        x-kubernetes-map-type: atomic
+                      matchLabelKeys:
+                        description: |-
+                          MatchLabelKeys is a set of pod label keys to select which pods will
+                          be taken into consideration. The keys are used to lookup values from the
+                          incoming pod labels, those key-value labels are merged with 'labelSelector' as 'key in (value)'
+                          to select the group of existing pods which pods will be taken into consideration
+                          for the incoming pod's pod (anti) affinity. Keys that don't exist in the incoming
+                          pod labels will be ignored. The default value is empty.
+                          The same key is forbidden to exist in both matchLabelKeys and labelSelector.
+                          Also, matchLabelKeys cannot be set when labelSelector isn't set.
+                          This is a beta field and requires enabling MatchLabelKeysInPodAffinity feature gate (enabled by default).
+                        items:
+                          type: string
+                        type: array
+                        x-kubernetes-list-type: atomic
+                      mismatchLabelKeys:
+                        description: |-
+                          MismatchLabelKeys is a set of pod label keys to select which pods will
+                          be taken into consideration. The keys are used to lookup values from the
+                          incoming pod labels, those key-value labels are merged with 'labelSelector' as 'key notin (value)'
+                          to select the group of existing pods which pods will be taken into consideration
+                          for the incoming pod's pod (anti) affinity. Keys that don't exist in the incoming
+                          pod labels will be ignored. The default value is empty.
+                          The same key is forbidden to exist in both mismatchLabelKeys and labelSelector.
+                          Also, mismatchLabelKeys cannot be set when labelSelector isn't set.
+                          This is a beta field and requires enabling MatchLabelKeysInPodAffinity feature gate (enabled by default).
+                        items:
+                          type: string
+                        type: array
+                        x-kubernetes-list-type: atomic
+                      namespaceSelector:
+                        description: |-
+                          A label query over the set of namespaces that the term applies to.
+                          The term is applied to the union of the namespaces selected by this field
+                          and the ones listed in the namespaces field.
+                          null selector and null or empty namespaces list means "this pod's namespace".
+                          An empty selector ({}) matches all namespaces.
+                        properties:
+                          matchExpressions:
+                            description: matchExpressions is a list of label selector
+                              requirements. The requirements are ANDed.
+                            items:
+                              description: |-
+                                A label selector requirement is a selector that contains values, a key, and an operator that
+                                relates the key and values.
+                              properties:
+                                key:
+                                  description: key is the label key that the selector
+                                    applies to.
+                                  type: string
+                                operator:
+                                  description: |-
+                                    operator represents a key's relationship to a set of values.
+                                    Valid operators are In, NotIn, Exists and DoesNotExist.
+                                  type: string
+                                values:
+                                  description: |-
+                                    values is an array of string values. If the operator is In or NotIn,
+                                    the values array must be non-empty. If the operator is Exists or DoesNotExist,
+                                    the values array must be empty. This array is replaced during a strategic
+                                    merge patch.
+                                  items:
+                                    type: string
+                                  type: array
+                                  x-kubernetes-list-type: atomic
+                              required:
+                              - key
+                              - operator
+                              type: object
+                            type: array
+                            x-kubernetes-list-type: atomic
+                          matchLabels:
+                            additionalProperties:
+                              type: string
+                            description: |-
+                              matchLabels is a map of {key,value} pairs. A single {key,value} in the matchLabels
+                              map is equivalent to an element of matchExpressions, whose key field is "key", the
+                              operator is "In", and the values array contains only "value". The requirements are ANDed.
+                            type: object
+                        type: object
+                        x-kubernetes-map-type: atomic
+                      namespaces:
+                        description: |-
+                          namespaces specifies a static list of namespace names that the term applies to.
+                          The term is applied to the union of the namespaces listed in this field
+                          and the ones selected by namespaceSelector.
+                          null or empty namespaces list and null namespaceSelector means "this pod's namespace".
+                        items:
+                          type: string
+                        type: array
+                        x-kubernetes-list-type: atomic
+                      topologyKey:
+                        description: |-
+                          This pod should be co-located (affinity) or not co-located (anti-affinity) with the pods matching
+                          the labelSelector in the specified namespaces, where co-located is defined as running on a node
+                          whose value of the label with key topologyKey matches that of any node on which any of the
+                          selected pods is running.
+                          Empty topologyKey is not allowed.
+                        type: string
+                    required:
+                    - topologyKey
+                    type: object
+                  type: array
+                  x-kubernetes-list-type: atomic
+              type: object
+            podAntiAffinity:
+              description: Describes pod anti-affinity scheduling rules (e.g. avoid
+                putting this pod in the same node, zone, etc. as some other pod(s)).
+              properties:
+                preferredDuringSchedulingIgnoredDuringExecution:
+                  description: |-
+                    The scheduler will prefer to schedule pods to nodes that satisfy
+                    the anti-affinity expressions specified by this field, but it may choose
+                    a node that violates one or more of the expressions. The node that is
+                    most preferred is the one with the greatest sum of weights, i.e.
+                    for each node that meets all of the scheduling requirements (resource
+                    request, requiredDuringScheduling anti-affinity expressions, etc.),
+                    compute a sum by iterating through the elements of this field and adding
+                    "weight" to the sum if the node has pods which matches the corresponding podAffinityTerm; the
+                    node(s) with the highest sum are the most preferred.
+                  items:
+                    description: The weights of all of the matched WeightedPodAffinityTerm
+                      fields are added per-node to find the most preferred node(s)
+                    properties:
+                      podAffinityTerm:
+                        description: Required. A pod affinity term, associated with
+                          the corresponding weight.
+                        properties:
+                          labelSelector:
+                            description: |-
+                              A label query over a set of resources, in this case pods.
+                              If it's null, this PodAffinityTerm matches with no Pods.
+                            properties:
+                              matchExpressions:
+                                description: matchExpressions is a list of label selector
+                                  requirements. The requirements are ANDed.
+                                items:
+                                  description: |-
+                                    A label selector requirement is a selector that contains values, a key, and an operator that
+                                    relates the key and values.
+                                  properties:
+                                    key:
+                                      description: key is the label key that the selector
+                                        applies to.
+                                      type: string
+                                    operator:
+                                      description: |-
+                                        operator represents a key's relationship to a set of values.
+                                        Valid operators are In, NotIn, Exists and DoesNotExist.
+                                      type: string
+                                    values:
+                                      description: |-
+                                        values is an array of string values. If the operator is In or NotIn,
+                                        the values array must be non-empty. If the operator is Exists or DoesNotExist,
+                                        the values array must be empty. This array is replaced during a strategic
+                                        merge patch.
+                                      items:
+                                        type: string
+                                      type: array
+                                      x-kubernetes-list-type: atomic
+                                  required:
+                                  - key
+                                  - operator
+                                  type: object
+                                type: array
+                                x-kubernetes-list-type: atomic
+                              matchLabels:
+                                additionalProperties:
+                                  type: string
+                                description: |-
+                                  matchLabels is a map of {key,value} pairs. A single {key,value} in the matchLabels
+                                  map is equivalent to an element of matchExpressions, whose key field is "key", the
+                                  operator is "In", and the values array contains only "value". The requirements are ANDed.
+                                type: object
+                            type: object
+                            x-kubernetes-map-type: atomic
+                          matchLabelKeys:
+                            description: |-
+                              MatchLabelKeys is a set of pod label keys to select which pods will
+                              be taken into consideration. The keys are used to lookup values from the
+                              incoming pod labels, those key-value labels are merged with 'labelSelector' as 'key in (value)'
+                              to select the group of existing pods which pods will be taken into consideration
+                              for the incoming pod's pod (anti) affinity. Keys that don't exist in the incoming
+                              pod labels will be ignored. The default value is empty.
+                              The same key is forbidden to exist in both matchLabelKeys and labelSelector.
+                              Also, matchLabelKeys cannot be set when labelSelector isn't set.
+                              This is a beta field and requires enabling MatchLabelKeysInPodAffinity feature gate (enabled by default).
+                            items:
+                              type: string
+                            type: array
+                            x-kubernetes-list-type: atomic
+                          mismatchLabelKeys:
+                            description: |-
+                              MismatchLabelKeys is a set of pod label keys to select which pods will
+                              be taken into consideration. The keys are used to lookup values from the
+                              incoming pod labels, those key-value labels are merged with 'labelSelector' as 'key notin (value)'
+                              to select the group of existing pods which pods will be taken into consideration
+                              for the incoming pod's pod (anti) affinity. Keys that don't exist in the incoming
+                              pod labels will be ignored. The default value is empty.
+                              The same key is forbidden to exist in both mismatchLabelKeys and labelSelector.
+                              Also, mismatchLabelKeys cannot be set when labelSelector isn't set.
+                              This is a beta field and requires enabling MatchLabelKeysInPodAffinity feature gate (enabled by default).
+                            items:
+                              type: string
+                            type: array
+                            x-kubernetes-list-type: atomic
+                          namespaceSelector:
+                            description: |-
+                              A label query over the set of namespaces that the term applies to.
+                              The term is applied to the union of the namespaces selected by this field
+                              and the ones listed in the namespaces field.
+                              null selector and null or empty namespaces list means "this pod's namespace".
+                              An empty selector ({}) matches all namespaces.
+                            properties:
+                              matchExpressions:
+                                description: matchExpressions is a list of label selector
+                                  requirements. The requirements are ANDed.
+                                items:
+                                  description: |-
+                                    A label selector requirement is a selector that contains values, a key, and an operator that
+                                    relates the key and values.
+                                  properties:
+                                    key:
+                                      description: key is the label key that the selector
+                                        applies to.
+                                      type: string
+                                    operator:
+                                      description: |-
+                                        operator represents a key's relationship to a set of values.
+                                        Valid operators are In, NotIn, Exists and DoesNotExist.
+                                      type: string
+                                    values:
+                                      description: |-
+                                        values is an array of string values. If the operator is In or NotIn,
+                                        the values array must be non-empty. If the operator is Exists or DoesNotExist,
+                                        the values array must be empty. This array is replaced during a strategic
+                                        merge patch.
+                                      items:
+                                        type: string
+                                      type: array
+                                      x-kubernetes-list-type: atomic
+                                  required:
+                                  - key
+                                  - operator
+                                  type: object
+                                type: array
+                                x-kubernetes-list-type: atomic
+                              matchLabels:
+                                additionalProperties:
+                                  type: string
+                                description: |-
+                                  matchLabels is a map of {key,value} pairs. A single {key,value} in the matchLabels
+                                  map is equivalent to an element of matchExpressions, whose key field is "key", the
+                                  operator is "In", and the values array contains only "value". The requirements are ANDed.
+                                type: object
+                            type: object
+                            x-kubernetes-map-type: atomic
+                          namespaces:
+                            description: |-
+                              namespaces specifies a static list of namespace names that the term applies to.
+                              The term is applied to the union of the namespaces listed in this field
+                              and the ones selected by namespaceSelector.
+                              null or empty namespaces list and null namespaceSelector means "this pod's namespace".
+                            items:
+                              type: string
+                            type: array
+                            x-kubernetes-list-type: atomic
+                          topologyKey:
+                            description: |-
+                              This pod should be co-located (affinity) or not co-located (anti-affinity) with the pods matching
+                              the labelSelector in the specified namespaces, where co-located is defined as running on a node
+                              whose value of the label with key topologyKey matches that of any node on which any of the
+                              selected pods is running.
+                              Empty topologyKey is not allowed.
+                            type: string
+                        required:
+                        - topologyKey
+                        type: object
+                      weight:
+                        description: |-
+                          weight associated with matching the corresponding podAffinityTerm,
+                          in the range 1-100.
+                        format: int32
+                        type: integer
+                    required:
+                    - podAffinityTerm
+                    - weight
+                    type: object
+                  type: array
+                  x-kubernetes-list-type: atomic
+                requiredDuringSchedulingIgnoredDuringExecution:
+                  description: |-
+                    If the anti-affinity requirements specified by this field are not met at
+                    scheduling time, the pod will not be scheduled onto the node.
+                    If the anti-affinity requirements specified by this field cease to be met
+                    at some point during pod execution (e.g. due to a pod label update), the
+                    system may or may not try to eventually evict the pod from its node.
+                    When there are multiple elements, the lists of nodes corresponding to each
+                    podAffinityTerm are intersected, i.e. all terms must be satisfied.
+                  items:
+                    description: |-
+                      Defines a set of pods (namely those matching the labelSelector
+                      relative to the given namespace(s)) that this pod should be
+                      co-located (affinity) or not co-located (anti-affinity) with,
+                      where co-located is defined as running on a node whose value of
+                      the label with key <topologyKey> matches that of any node on which
+                      a pod of the set of pods is running
+                    properties:
+                      labelSelector:
+                        description: |-
+                          A label query over a set of resources, in this case pods.
+                          If it's null, this PodAffinityTerm matches with no Pods.
+                        properties:
+                          matchExpressions:
+                            description: matchExpressions is a list of label selector
+                              requirements. The requirements are ANDed.
+                            items:
+                              description: |-
+                                A label selector requirement is a selector that contains values, a key, and an operator that
+                                relates the key and values.
+                              properties:
+                                key:
+                                  description: key is the label key that the selector
+                                    applies to.
+                                  type: string
+                                operator:
+                                  description: |-
+                                    operator represents a key's relationship to a set of values.
+                                    Valid operators are In, NotIn, Exists and DoesNotExist.
+                                  type: string
+                                values:
+                                  description: |-
+                                    values is an array of string values. If the operator is In or NotIn,
+                                    the values array must be non-empty. If the operator is Exists or DoesNotExist,
+                                    the values array must be empty. This array is replaced during a strategic
+                                    merge patch.
+                                  items:
+                                    type: string
+                                  type: array
+                                  x-kubernetes-list-type: atomic
+                              required:
+                              - key
+                              - operator
+                              type: object
+                            type: array
+                            x-kubernetes-list-type: atomic
+                          matchLabels:
+                            additionalProperties:
+                              type: string
+                            description: |-
+                              matchLabels is a map of {key,value} pairs. A single {key,value} in the matchLabels
+                              map is equivalent to an element of matchExpressions, whose key field is "key", the
+                              operator is "In", and the values array contains only "value". The requirements are ANDed.
+                            type: object
+                        type: object
+                        x-kubernetes-map-type: atomic
+                      matchLabelKeys:
+                        description: |-
+                          MatchLabelKeys is a set of pod label keys to select which pods will
+                          be taken into consideration. The keys are used to lookup values from the
+                          incoming pod labels, those key-value labels are merged with 'labelSelector' as 'key in (value)'
+                          to select the group of existing pods which pods will be taken into consideration
+                          for the incoming pod's pod (anti) affinity. Keys that don't exist in the incoming
+                          pod labels will be ignored. The default value is empty.
+                          The same key is forbidden to exist in both matchLabelKeys and labelSelector.
+                          Also, matchLabelKeys cannot be set when labelSelector isn't set.
+                          This is a beta field and requires enabling MatchLabelKeysInPodAffinity feature gate (enabled by default).
+                        items:
+                          type: string
+                        type: array
+                        x-kubernetes-list-type: atomic
+                      mismatchLabelKeys:
+                        description: |-
+                          MismatchLabelKeys is a set of pod label keys to select which pods will
+                          be taken into consideration. The keys are used to lookup values from the
+                          incoming pod labels, those key-value labels are merged with 'labelSelector' as 'key notin (value)'
+                          to select the group of existing pods which pods will be taken into consideration
+                          for the incoming pod's pod (anti) affinity. Keys that don't exist in the incoming
+                          pod labels will be ignored. The default value is empty.
+                          The same key is forbidden to exist in both mismatchLabelKeys and labelSelector.
+                          Also, mismatchLabelKeys cannot be set when labelSelector isn't set.
+                          This is a beta field and requires enabling MatchLabelKeysInPodAffinity feature gate (enabled by default).
+                        items:
+                          type: string
+                        type: array
+                        x-kubernetes-list-type: atomic
+                      namespaceSelector:
+                        description: |-
+                          A label query over the set of namespaces that the term applies to.
+                          The term is applied to the union of the namespaces selected by this field
+                          and the ones listed in the namespaces field.
+                          null selector and null or empty namespaces list means "this pod's namespace".
+                          An empty selector ({}) matches all namespaces.
+                        properties:
+                          matchExpressions:
+                            description: matchExpressions is a list of label selector
+                              requirements. The requirements are ANDed.
+                            items:
+                              description: |-
+                                A label selector requirement is a selector that contains values, a key, and an operator that
+                                relates the key and values.
+                              properties:
+                                key:
+                                  description: key is the label key that the selector
+                                    applies to.
+                                  type: string
+                                operator:
+                                  description: |-
+                                    operator represents a key's relationship to a set of values.
+                                    Valid operators are In, NotIn, Exists and DoesNotExist.
+                                  type: string
+                                values:
+                                  description: |-
+                                    values is an array of string values. If the operator is In or NotIn,
+                                    the values array must be non-empty. If the operator is Exists or DoesNotExist,
+                                    the values array must be empty. This array is replaced during a strategic
+                                    merge patch.
+                                  items:
+                                    type: string
+                                  type: array
+                                  x-kubernetes-list-type: atomic
+                              required:
+                              - key
+                              - operator
+                              type: object
+                            type: array
+                            x-kubernetes-list-type: atomic
+                          matchLabels:
+                            additionalProperties:
+                              type: string
+                            description: |-
+                              matchLabels is a map of {key,value} pairs. A single {key,value} in the matchLabels
+                              map is equivalent to an element of matchExpressions, whose key field is "key", the
+                              operator is "In", and the values array contains only "value". The requirements are ANDed.
+                            type: object
+                        type: object
+                        x-kubernetes-map-type: atomic
+                      namespaces:
+                        description: |-
+                          namespaces specifies a static list of namespace names that the term applies to.
+                          The term is applied to the union of the namespaces listed in this field
+                          and the ones selected by namespaceSelector.
+                          null or empty namespaces list and null namespaceSelector means "this pod's namespace".
+                        items:
+                          type: string
+                        type: array
+                        x-kubernetes-list-type: atomic
+                      topologyKey:
+                        description: |-
+                          This pod should be co-located (affinity) or not co-located (anti-affinity) with the pods matching
+                          the labelSelector in the specified namespaces, where co-located is defined as running on a node
+                          whose value of the label with key topologyKey matches that of any node on which any of the
+                          selected pods is running.
+                          Empty topologyKey is not allowed.
+                        type: string
+                    required:
+                    - topologyKey
+                    type: object
+                  type: array
+                  x-kubernetes-list-type: atomic
+              type: object
+          type: object
+        annotations:
+          additionalProperties:
+            type: string
+          description: Optionally defines the required Annotations to be used by the
+            instance type and applied to the VirtualMachineInstance
+          type: object
+        cpu:
+          description: Required CPU related attributes of the instancetype.
+          properties:
+            dedicatedCPUPlacement:
+              description: |-
+                DedicatedCPUPlacement requests the scheduler to place the VirtualMachineInstance on a node
+                with enough dedicated pCPUs and pin the vCPUs to it.
+              type: boolean
+            guest:
+              description: |-
+                Required number of vCPUs to expose to the guest.
+
+                The resulting CPU topology being derived from the optional PreferredCPUTopology attribute of CPUPreferences that itself defaults to PreferSockets.
+              format: int32
+              type: integer
+            isolateEmulatorThread:
+              description: |-
+                IsolateEmulatorThread requests one more dedicated pCPU to be allocated for the VMI to place
+                the emulator thread on it.
+              type: boolean
+            maxSockets:
+              description: MaxSockets specifies the maximum amount of sockets that
+                can be hotplugged
+              format: int32
+              type: integer
+            mitigatedVulnerabilities:
+              description: |-
+                MitigatedVulnerabilities lists the CPU vulnerabilities, as named by the kernel in /sys/devices/system/cpu/vulnerabilities,
+                e.g. retbleed or mds, the node of the VMI has to be mitigated against or not affected by.
+              items:
+                type: string
+              type: array
+              x-kubernetes-list-type: set
+            model:
+              description: |-
+                Model specifies the CPU model inside the VMI.
+                List of available models https://github.com/libvirt/libvirt/tree/master/src/cpu_map.
+                It is possible to specify special cases like "host-passthrough" to get the same CPU as the node
+                and "host-model" to get CPU closest to the node one.
+                "cluster-common" selects the CPU model and features common to the nodes matching the node selector of the VMI.
+                Defaults to host-model.
+              type: string
+            numa:
+              description: NUMA allows specifying settings for the guest NUMA topology
+              properties:
+                guestMappingPassthrough:
+                  description: |-
+                    GuestMappingPassthrough will create an efficient guest topology based on host CPUs exclusively assigned to a pod.
+                    The created topology ensures that memory and CPUs on the virtual numa nodes never cross boundaries of host numa nodes.
+                  type: object
+              type: object
+            realtime:
+              description: Realtime instructs the virt-launcher to tune the VMI for
+                lower latency, optional for real time workloads
+              properties:
+                mask:
+                  description: |-
+                    Mask defines the vcpu mask expression that defines which vcpus are used for realtime. Format matches libvirt's expressions.
+                    Example: "0-3,^1","0,2,3","2-3"
+                  type: string
+              type: object
+          required:
+          - guest
+          type: object
+        gpus:
+          description: Optionally defines any GPU devices associated with the instancetype.
+          items:
+            properties:
+              deviceName:
+                type: string
+              name:
+                description: Name of the GPU device as exposed by a device plugin
+                type: string
+              tag:
+                description: If specified, the virtual network interface address and
+                  its tag will be provided to the guest via config drive
+                type: string
+              virtualGPUOptions:
+                properties:
+                  display:
+                    properties:
+                      enabled:
+                        description: |-
+                          Enabled determines if a display addapter backed by a vGPU should be enabled or disabled on the guest.
+                          Defaults to true.
+                        type: boolean
+                      ramFB:
+                        description: |-
+                          Enables a boot framebuffer, until the guest OS loads a real GPU driver
+                          Defaults to true.
+                        properties:
+                          enabled:
+                            description: |-
+                              Enabled determines if the feature should be enabled or disabled on the guest.
+                              Defaults to true.
+                            type: boolean
+                        type: object
+                    type: object
+                type: object
+            required:
+            - deviceName
+            - name
+            type: object
+          type: array
+          x-kubernetes-list-type: atomic
+        hostDevices:
+          description: Optionally defines any HostDevices associated with the instancetype.
+          items:
+            properties:
+              deviceName:
+                description: DeviceName is the resource name of the host device exposed
+                  by a device plugin
+                type: string
+              name:
+                type: string
+              tag:
+                description: If specified, the virtual network interface address and
+                  its tag will be provided to the guest via config drive
+                type: string
+            required:
+            - deviceName
+            - name
+            type: object
+          type: array
+          x-kubernetes-list-type: atomic
+        ioThreadsPolicy:
+          description: Optionally defines the IOThreadsPolicy to be used by the instancetype.
+          type: string
+        launchSecurity:
+          description: Optionally defines the LaunchSecurity to be used by the instancetype.
+          properties:
+            sev:
+              description: AMD Secure Encrypted Virtualization (SEV).
+              properties:
+                attestation:
+                  description: If specified, run the attestation process for a vmi.
+                  type: object
+                dhCert:
+                  description: Base64 encoded guest owner's Diffie-Hellman key.
+                  type: string
+                policy:
+                  description: |-
+                    Guest policy flags as defined in AMD SEV API specification.
+                    Note: due to security reasons it is not allowed to enable guest debugging. Therefore NoDebug flag is not exposed to users and is always true.
+                  properties:
+                    encryptedState:
+                      description: |-
+                        SEV-ES is required.
+                        Defaults to false.
+                      type: boolean
+                  type: object
+                session:
+                  description: Base64 encoded session blob.
+                  type: string
+              type: object
+          type: object
+        memory:
+          description: Required Memory related attributes of the instancetype.
+          properties:
+            guest:
+              anyOf:
+              - type: integer
+              - type: string
+              description: Required amount of memory which is visible inside the guest
+                OS.
+              pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+              x-kubernetes-int-or-string: true
+            hugepages:
+              description: Optionally enables the use of hugepages for the VirtualMachineInstance
+                instead of regular memory.
+              properties:
+                pageSize:
+                  description: PageSize specifies the hugepage size, for x86_64 architecture
+                    valid values are 1Gi and 2Mi.
+                  type: string
+              type: object
+            maxGuest:
+              anyOf:
+              - type: integer
+              - type: string
+              description: |-
+                MaxGuest allows to specify the maximum amount of memory which is visible inside the Guest OS.
+                The delta between MaxGuest and Guest is the amount of memory that can be hot(un)plugged.
+              pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+              x-kubernetes-int-or-string: true
+            overcommitPercent:
+              description: |-
+                OvercommitPercent is the percentage of the guest memory which will be overcommitted.
+                This means that the VMIs parent pod (virt-launcher) will request less
+                physical memory by a factor specified by the OvercommitPercent.
+                Overcommits can lead to memory exhaustion, which in turn can lead to crashes. Use carefully.
+                Defaults to 0
+              maximum: 100
+              minimum: 0
+              type: integer
+          required:
+          - guest
+          type: object
+        nodeSelector:
+          additionalProperties:
+            type: string
+          description: |-
+            NodeSelector is a selector which must be true for the vmi to fit on a node.
+            Selector which must match a node's labels for the vmi to be scheduled on that node.
+            More info: https://kubernetes.io/docs/concepts/configuration/assign-pod-node/
+
+            NodeSelector is the name of the custom node selector for the instancetype.
+          type: object
+        schedulerName:
+          description: |-
+            If specified, the VMI will be dispatched by specified scheduler.
+            If not specified, the VMI will be dispatched by default scheduler.
+
+            SchedulerName is the name of the custom K8s scheduler for the instancetype.
+          type: string
+        topologySpreadConstraints:
+          description: |-
+            TopologySpreadConstraints describes how a group of VMIs will be spread across a given topology
+            domains. K8s scheduler will schedule VMI pods in a way which abides by the constraints.
+
+            TopologySpreadConstraints are the topology spread constraints required by the instancetype.
+          items:
+            description: TopologySpreadConstraint specifies how to spread matching
+              pods among the given topology.
+            properties:
+              labelSelector:
+                description: |-
+                  LabelSelector is used to find matching pods.
+                  Pods that match this label selector are counted to determine the number of pods
+                  in their corresponding topology domain.
+                properties:
+                  matchExpressions:
+                    description: matchExpressions is a list of label selector requirements.
+                      The requirements are ANDed.
+                    items:
+                      description: |-
+                        A label selector requirement is a selector that contains values, a key, and an operator that
+                        relates the key and values.
+                      properties:
+                        key:
+                          description: key is the label key that the selector applies
+                            to.
+                          type: string
+                        operator:
+                          description: |-
+                            operator represents a key's relationship to a set of values.
+                            Valid operators are In, NotIn, Exists and DoesNotExist.
+                          type: string
+                        values:
+                          description: |-
+                            values is an array of string values. If the operator is In or NotIn,
+                            the values array must be non-empty. If the operator is Exists or DoesNotExist,
+                            the values array must be empty. This array is replaced during a strategic
+                            merge patch.
+                          items:
+                            type: string
+                          type: array
+                          x-kubernetes-list-type: atomic
+                      required:
+                      - key
+                      - operator
+                      type: object
+                    type: array
+                    x-kubernetes-list-type: atomic
+                  matchLabels:
+                    additionalProperties:
+                      type: string
+                    description: |-
+                      matchLabels is a map of {key,value} pairs. A single {key,value} in the matchLabels
+                      map is equivalent to an element of matchExpressions, whose key field is "key", the
+                      operator is "In", and the values array contains only "value". The requirements are ANDed.
+                    type: object
+                type: object
+                x-kubernetes-map-type: atomic
+              matchLabelKeys:
+                description: |-
+                  MatchLabelKeys is a set of pod label keys to select the pods over which
+                  spreading will be calculated. The keys are used to lookup values from the
+                  incoming pod labels, those key-value labels are ANDed with labelSelector
+                  to select the group of existing pods over which spreading will be calculated
+                  for the incoming pod. The same key is forbidden to exist in both MatchLabelKeys and LabelSelector.
+                  MatchLabelKeys cannot be set when LabelSelector isn't set.
+                  Keys that don't exist in the incoming pod labels will
+                  be ignored. A null or empty list means only match against labelSelector.
+
+                  This is a beta field and requires the MatchLabelKeysInPodTopologySpread feature gate to be enabled (enabled by default).
+                items:
+                  type: string
+                type: array
+                x-kubernetes-list-type: atomic
+              maxSkew:
+                description: |-
+                  MaxSkew describes the degree to which pods may be unevenly distributed.
+                  When 'whenUnsatisfiable=DoNotSchedule', it is the maximum permitted difference
+                  between the number of matching pods in the target topology and the global minimum.
+                  The global minimum is the minimum number of matching pods in an eligible domain
+                  or zero if the number of eligible domains is less than MinDomains.
+                  For example, in a 3-zone cluster, MaxSkew is set to 1, and pods with the same
+                  labelSelector spread as 2/2/1:
+                  In this case, the global minimum is 1.
+                  | zone1 | zone2 | zone3 |
+                  |  P P  |  P P  |   P   |
+                  - if MaxSkew is 1, incoming pod can only be scheduled to zone3 to become 2/2/2;
+                  scheduling it onto zone1(zone2) would make the ActualSkew(3-1) on zone1(zone2)
+                  violate MaxSkew(1).
+                  - if MaxSkew is 2, incoming pod can be scheduled onto any zone.
+                  When 'whenUnsatisfiable=ScheduleAnyway', it is used to give higher precedence
+                  to topologies that satisfy it.
+                  It's a required field. Default value is 1 and 0 is not allowed.
+                format: int32
+                type: integer
+              minDomains:
+                description: |-
+                  MinDomains indicates a minimum number of eligible domains.
+                  When the number of eligible domains with matching topology keys is less than minDomains,
+                  Pod Topology Spread treats "global minimum" as 0, and then the calculation of Skew is performed.
+                  And when the number of eligible domains with matching topology keys equals or greater than minDomains,
+                  this value has no effect on scheduling.
+                  As a result, when the number of eligible domains is less than minDomains,
+                  scheduler won't schedule more than maxSkew Pods to those domains.
+                  If value is nil, the constraint behaves as if MinDomains is equal to 1.
+                  Valid values are integers greater than 0.
+                  When value is not nil, WhenUnsatisfiable must be DoNotSchedule.
+
+                  For example, in a 3-zone cluster, MaxSkew is set to 2, MinDomains is set to 5 and pods with the same
+                  labelSelector spread as 2/2/2:
+                  | zone1 | zone2 | zone3 |
+                  |  P P  |  P P  |  P P  |
+                  The number of domains is less than 5(MinDomains), so "global minimum" is treated as 0.
+                  In this situation, new pod with the same labelSelector cannot be scheduled,
+                  because computed skew will be 3(3 - 0) if new Pod is scheduled to any of the three zones,
+                  it will violate MaxSkew.
+                format: int32
+                type: integer
+              nodeAffinityPolicy:
+                description: |-
+                  NodeAffinityPolicy indicates how we will treat Pod's nodeAffinity/nodeSelector
+                  when calculating pod topology spread skew. Options are:
+                  - Honor: only nodes matching nodeAffinity/nodeSelector are included in the calculations.
+                  - Ignore: nodeAffinity/nodeSelector are ignored. All nodes are included in the calculations.
+
+                  If this value is nil, the behavior is equivalent to the Honor policy.
+                  This is a beta-level feature default enabled by the NodeInclusionPolicyInPodTopologySpread feature flag.
+                type: string
+              nodeTaintsPolicy:
+                description: |-
+                  NodeTaintsPolicy indicates how we will treat node taints when calculating
+                  pod topology spread skew. Options are:
+                  - Honor: nodes without taints, along with tainted nodes for which the incoming pod
+                  has a toleration, are included.
+                  - Ignore: node taints are ignored. All nodes are included.
+
+                  If this value is nil, the behavior is equivalent to the Ignore policy.
+                  This is a beta-level feature default enabled by the NodeInclusionPolicyInPodTopologySpread feature flag.
+                type: string
+              topologyKey:
+                description: |-
+                  TopologyKey is the key of node labels. Nodes that have a label with this key
+                  and identical values are considered to be in the same topology.
+                  We consider each <key, value> as a "bucket", and try to put balanced number
+                  of pods into each bucket.
+                  We define a domain as a particular instance of a topology.
+                  Also, we define an eligible domain as a domain whose nodes meet the requirements of
+                  nodeAffinityPolicy and nodeTaintsPolicy.
+                  e.g. If TopologyKey is "kubernetes.io/hostname", each Node is a domain of that topology.
+                  And, if TopologyKey is "topology.kubernetes.io/zone", each zone is a domain of that topology.
+                  It's a required field.
+                type: string
+              whenUnsatisfiable:
+                description: |-
+                  WhenUnsatisfiable indicates how to deal with a pod if it doesn't satisfy
+                  the spread constraint.
+                  - DoNotSchedule (default) tells the scheduler not to schedule it.
+                  - ScheduleAnyway tells the scheduler to schedule the pod in any location,
+                    but giving higher precedence to topologies that would help reduce the
+                    skew.
+                  A constraint is considered "Unsatisfiable" for an incoming pod
+                  if and only if every possible node assignment for that pod would violate
+                  "MaxSkew" on some topology.
+                  For example, in a 3-zone cluster, MaxSkew is set to 1, and pods with the same
+                  labelSelector spread as 3/1/1:
+                  | zone1 | zone2 | zone3 |
+                  | P P P |   P   |   P   |
+                  If WhenUnsatisfiable is set to DoNotSchedule, incoming pod can only be scheduled
+                  to zone2(zone3) to become 3/2/1(3/1/2) as ActualSkew(2-1) on zone2(zone3) satisfies
+                  MaxSkew(1). In other words, the cluster can still be imbalanced, but scheduler
+                  won't make it *more* imbalanced.
+                  It's a required field.
+                type: string
+            required:
+            - maxSkew
+            - topologyKey
+            - whenUnsatisfiable
+            type: object
+          type: array
+          x-kubernetes-list-map-keys:
+          - topologyKey
+          - whenUnsatisfiable
+          x-kubernetes-list-type: map
+      required:
+      - cpu
+      - memory
+      type: object
+  required:
+  - spec
+  type: object
+`,
+	"virtualmachineclusterpreference": `openAPIV3Schema:
+  description: VirtualMachineClusterPreference is a cluster scoped version of the
+    VirtualMachinePreference resource.
+  properties:
+    apiVersion:
+      description: |-
+        APIVersion defines the versioned schema of this representation of an object.
+        Servers should convert recognized schemas to the latest internal value, and
+        may reject unrecognized values.
+        More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources
+      type: string
+    kind:
+      description: |-
+        Kind is a string value representing the REST resource this object represents.
+        Servers may infer this from the endpoint the client submits requests to.
+        Cannot be updated.
+        In CamelCase.
+        More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
+      type: string
+    metadata:
+      type: object
+    spec:
+      description: Required spec describing the preferences
+      properties:
+        annotations:
+          additionalProperties:
+            type: string
+          description: Optionally defines preferred Annotations to be applied to the
+            VirtualMachineInstance
+          type: object
+        clock:
+          description: Clock optionally defines preferences associated with the Clock
+            attribute of a VirtualMachineInstance DomainSpec
+          properties:
+            preferredClockOffset:
+              description: ClockOffset allows specifying the UTC offset or the timezone
+                of the guest clock.
+              properties:
+                timezone:
+                  description: |-
+                    Timezone sets the guest clock to the specified timezone.
+                    Zone name follows the TZ environment variable format (e.g. 'America/New_York').
+                  type: string
+                utc:
+                  description: |-
+                    UTC sets the guest clock to UTC on each boot. If an offset is specified,
+                    guest changes to the clock will be kept during reboots and are not reset.
+                  properties:
+                    offsetSeconds:
+                      description: |-
+                        OffsetSeconds specifies an offset in seconds, relative to UTC. If set,
+                        guest changes to the clock will be kept during reboots and not reset.
+                      type: integer
+                  type: object
+              type: object
+            preferredTimer:
+              description: Timer specifies whih timers are attached to the vmi.
+              properties:
                 hpet:
                   description: HPET (High Precision Event Timer) - multiple timers
                     with periodic interrupts.
@@ -9617,6 +12309,30 @@ var CRDsValidation map[string]string = map[string]string{
                 Deprecated: Will be removed with v1beta2 or v1
               type: boolean
           type: object
+        inheritFrom:
+          description: |-
+            InheritFrom optionally references base preferences whose attributes are inherited.
+            The base preferences are merged in order, later ones taking precedence over earlier ones, and the attributes
+            set by this preference take precedence over all of them. Maps are merged, lists are replaced.
+            VirtualMachineClusterPreferences can only inherit from VirtualMachineClusterPreferences.
+          items:
+            description: PreferenceReference references a preference to inherit from
+            properties:
+              kind:
+                description: |-
+                  Kind specifies which preference resource is referenced.
+                  Allowed values are: "VirtualMachinePreference" and "VirtualMachineClusterPreference".
+                  If not specified, "VirtualMachineClusterPreference" is used by default.
+                  VirtualMachinePreferences are looked up in the namespace of the inheriting preference.
+                type: string
+              name:
+                description: Name is the name of the referenced preference
+                type: string
+            required:
+            - name
+            type: object
+          type: array
+          x-kubernetes-list-type: atomic
         machine:
           description: Machine optionally defines preferences associated with the
             Machine attribute of a VirtualMachineInstance DomainSpec
@@ -11065,6 +13781,27 @@ var CRDsValidation map[string]string = map[string]string{
             clock:
               description: Clock sets the clock and timers of the vmi.
               properties:
+                guestTimeSync:
+                  description: GuestTimeSync configures when the guest clock is set
+                    to the host time through the guest agent.
+                  properties:
+                    driftThresholdSeconds:
+                      description: |-
+                        DriftThresholdSeconds is the drift of the guest clock above which it is set to the host time
+                        with the "OnDrift" policy.
+                        Defaults to 2.
+                      format: int32
+                      type: integer
+                    policy:
+                      description: |-
+                        Policy selects when the guest clock is set to the host time.
+                        "OnResume" sets it after a live migration and when the vmi is unpaused.
+                        "OnDrift" additionally sets it whenever the guest clock drifts away from the host clock by more than
+                        the drift threshold, e.g. after the node resumed from suspend.
+                        "Disabled" never sets it, leaving the guest clock to the time synchronization of the guest.
+                        Defaults to "OnResume".
+                      type: string
+                  type: object
                 timer:
                   description: Timer specifies whih timers are attached to the vmi.
                   properties:
@@ -11193,6 +13930,24 @@ var CRDsValidation map[string]string = map[string]string{
                     - name
                     type: object
                   type: array
+                housekeeping:
+                  description: |-
+                    Housekeeping gives the QEMU threads which do not run vCPUs, like the emulator and IOThreads,
+                    their own CPU budget in a child cgroup of the compute container, so they don't take CPU time
+                    from the vCPUs. Can not be combined with DedicatedCPUPlacement, use IsolateEmulatorThread instead.
+                  properties:
+                    cpu:
+                      anyOf:
+                      - type: integer
+                      - type: string
+                      description: |-
+                        CPU is added to the CPU requests of the compute container, and to its CPU limits if they are set.
+                        The housekeeping threads are limited to this amount of CPU, the rest is left to the vCPUs.
+                      pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                      x-kubernetes-int-or-string: true
+                  required:
+                  - cpu
+                  type: object
                 isolateEmulatorThread:
                   description: |-
                     IsolateEmulatorThread requests one more dedicated pCPU to be allocated for the VMI to place
@@ -11204,12 +13959,21 @@ var CRDsValidation map[string]string = map[string]string{
                     be hotplugged
                   format: int32
                   type: integer
+                mitigatedVulnerabilities:
+                  description: |-
+                    MitigatedVulnerabilities lists the CPU vulnerabilities, as named by the kernel in /sys/devices/system/cpu/vulnerabilities,
+                    e.g. retbleed or mds, the node of the VMI has to be mitigated against or not affected by.
+                  items:
+                    type: string
+                  type: array
+                  x-kubernetes-list-type: set
                 model:
                   description: |-
                     Model specifies the CPU model inside the VMI.
                     List of available models https://github.com/libvirt/libvirt/tree/master/src/cpu_map.
                     It is possible to specify special cases like "host-passthrough" to get the same CPU as the node
                     and "host-model" to get CPU closest to the node one.
+                    "cluster-common" selects the CPU model and features common to the nodes matching the node selector of the VMI.
                     Defaults to host-model.
                   type: string
                 numa:
@@ -11238,6 +14002,24 @@ var CRDsValidation map[string]string = map[string]string{
                     Must be a value greater or equal 1.
                   format: int32
                   type: integer
+                threadPlacement:
+                  description: |-
+                    ThreadPlacement defines on which pCPUs the emulator thread and the IOThreads run.
+                    Requires DedicatedCPUPlacement.
+                  properties:
+                    emulatorThread:
+                      description: |-
+                        EmulatorThread defines where the emulator thread runs. With shared it runs on the pCPUs of the vCPUs,
+                        dedicated and sameNUMANode isolate it on an additional pCPU, like IsolateEmulatorThread does.
+                        Defaults to dedicated if IsolateEmulatorThread is set and to shared otherwise.
+                      type: string
+                    ioThreads:
+                      description: |-
+                        IOThreads defines where the IOThreads run. With shared they run on the pCPU of the isolated emulator
+                        thread, or on the pCPUs of the vCPUs if the emulator thread is not isolated. With dedicated and
+                        sameNUMANode all IOThreads run together on an additional pCPU. Defaults to shared.
+                      type: string
+                  type: object
                 threads:
                   description: |-
                     Threads specifies the number of threads inside the vmi.
@@ -11387,6 +14169,51 @@ var CRDsValidation map[string]string = map[string]string{
                           IO specifies which QEMU disk IO mode should be used.
                           Supported values are: native, default, threads.
                         type: string
+                      ioTune:
+                        description: |-
+                          IOTune limits the IO operations and the throughput of the disk.
+                          It can be set when hotplugging the disk.
+                        properties:
+                          readBytesSec:
+                            anyOf:
+                            - type: integer
+                            - type: string
+                            description: ReadBytesSec limits the read throughput in
+                              bytes per second.
+                            pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                            x-kubernetes-int-or-string: true
+                          readIOPSSec:
+                            description: ReadIOPSSec limits the number of read operations
+                              per second.
+                            format: int64
+                            type: integer
+                          totalBytesSec:
+                            anyOf:
+                            - type: integer
+                            - type: string
+                            description: TotalBytesSec limits the total throughput
+                              of reads and writes in bytes per second.
+                            pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                            x-kubernetes-int-or-string: true
+                          totalIOPSSec:
+                            description: TotalIOPSSec limits the total number of read
+                              and write operations per second.
+                            format: int64
+                            type: integer
+                          writeBytesSec:
+                            anyOf:
+                            - type: integer
+                            - type: string
+                            description: WriteBytesSec limits the write throughput
+                              in bytes per second.
+                            pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                            x-kubernetes-int-or-string: true
+                          writeIOPSSec:
+                            description: WriteIOPSSec limits the number of write operations
+                              per second.
+                            format: int64
+                            type: integer
+                        type: object
                       lun:
                         description: Attach a volume as a LUN to the vmi.
                         properties:
@@ -11439,6 +14266,85 @@ var CRDsValidation map[string]string = map[string]string{
                         type: string
                       virtiofs:
                         description: Virtiofs is supported
+                        properties:
+                          cache:
+                            description: |-
+                              Cache selects the caching policy of the virtiofs daemon serving the
+                              filesystem. Use never when other pods write to the same volume
+                              concurrently, so that the guest always sees their changes.
+                              Supported values: auto, always, never. Defaults to auto.
+                            enum:
+                            - auto
+                            - always
+                            - never
+                            type: string
+                          idMapping:
+                            description: |-
+                              IDMapping translates the user and group IDs seen by the guest into
+                              the IDs stored on the shared volume. Use it to let the guest and
+                              other pods sharing the volume agree on file ownership.
+                            properties:
+                              groupIDs:
+                                description: GroupIDs maps ranges of guest group IDs
+                                  to group IDs on the volume.
+                                items:
+                                  properties:
+                                    count:
+                                      description: Count is the number of consecutive
+                                        IDs in the range.
+                                      format: int64
+                                      minimum: 1
+                                      type: integer
+                                    guestID:
+                                      description: GuestID is the first ID of the
+                                        range as seen by the guest.
+                                      format: int64
+                                      minimum: 0
+                                      type: integer
+                                    hostID:
+                                      description: HostID is the first ID of the range
+                                        as stored on the volume.
+                                      format: int64
+                                      minimum: 0
+                                      type: integer
+                                  required:
+                                  - count
+                                  - guestID
+                                  - hostID
+                                  type: object
+                                type: array
+                                x-kubernetes-list-type: atomic
+                              userIDs:
+                                description: UserIDs maps ranges of guest user IDs
+                                  to user IDs on the volume.
+                                items:
+                                  properties:
+                                    count:
+                                      description: Count is the number of consecutive
+                                        IDs in the range.
+                                      format: int64
+                                      minimum: 1
+                                      type: integer
+                                    guestID:
+                                      description: GuestID is the first ID of the
+                                        range as seen by the guest.
+                                      format: int64
+                                      minimum: 0
+                                      type: integer
+                                    hostID:
+                                      description: HostID is the first ID of the range
+                                        as stored on the volume.
+                                      format: int64
+                                      minimum: 0
+                                      type: integer
+                                  required:
+                                  - count
+                                  - guestID
+                                  - hostID
+                                  type: object
+                                type: array
+                                x-kubernetes-list-type: atomic
+                            type: object
                         type: object
                     required:
                     - name
@@ -11541,6 +14447,74 @@ var CRDsValidation map[string]string = map[string]string{
                           in PCI addresses assigned to the device.
                           This value is required to be unique across all devices and be between 1 and (16*1024-1).
                         type: integer
+                      bandwidth:
+                        description: |-
+                          Bandwidth limits the traffic of the interface.
+                          It is only supported on bridge and masquerade bindings.
+                        properties:
+                          inbound:
+                            description: Inbound limits the traffic received by the
+                              guest.
+                            properties:
+                              average:
+                                anyOf:
+                                - type: integer
+                                - type: string
+                                description: Average is the average rate the traffic
+                                  is shaped to, in bytes per second.
+                                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                x-kubernetes-int-or-string: true
+                              burst:
+                                anyOf:
+                                - type: integer
+                                - type: string
+                                description: Burst is the amount of bytes which may
+                                  be transferred at the peak rate.
+                                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                x-kubernetes-int-or-string: true
+                              peak:
+                                anyOf:
+                                - type: integer
+                                - type: string
+                                description: Peak is the rate the traffic may reach
+                                  while bursting, in bytes per second.
+                                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                x-kubernetes-int-or-string: true
+                            required:
+                            - average
+                            type: object
+                          outbound:
+                            description: Outbound limits the traffic transmitted by
+                              the guest.
+                            properties:
+                              average:
+                                anyOf:
+                                - type: integer
+                                - type: string
+                                description: Average is the average rate the traffic
+                                  is shaped to, in bytes per second.
+                                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                x-kubernetes-int-or-string: true
+                              burst:
+                                anyOf:
+                                - type: integer
+                                - type: string
+                                description: Burst is the amount of bytes which may
+                                  be transferred at the peak rate.
+                                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                x-kubernetes-int-or-string: true
+                              peak:
+                                anyOf:
+                                - type: integer
+                                - type: string
+                                description: Peak is the rate the traffic may reach
+                                  while bursting, in bytes per second.
+                                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                x-kubernetes-int-or-string: true
+                            required:
+                            - average
+                            type: object
+                        type: object
                       binding:
                         description: |-
                           Binding specifies the binding plugin that will be used to connect the interface to the guest.
@@ -11574,6 +14548,11 @@ var CRDsValidation map[string]string = map[string]string{
                             description: If specified will pass option 67 to interface's
                               DHCP server
                             type: string
+                          mtu:
+                            description: If specified will pass the MTU to the VM
+                              via DHCP option 026 instead of the MTU of the pod interface.
+                            format: int32
+                            type: integer
                           ntpServers:
                             description: If specified will pass the configured NTP
                               server to the VM via DHCP option 042.
@@ -11602,6 +14581,28 @@ var CRDsValidation map[string]string = map[string]string{
                               - value
                               type: object
                             type: array
+                          routes:
+                            description: If specified will pass the routes to the
+                              VM via DHCP option 121, in addition to the routes of
+                              the pod interface.
+                            items:
+                              description: DHCPRoute defines a classless static route
+                                passed to the VM via DHCP.
+                              properties:
+                                destination:
+                                  description: |-
+                                    Destination is the IPv4 CIDR of the route
+                                    Required.
+                                  type: string
+                                gateway:
+                                  description: Gateway is the IPv4 address of the
+                                    next hop, the route is on-link if omitted
+                                  type: string
+                              required:
+                              - destination
+                              type: object
+                            type: array
+                            x-kubernetes-list-type: atomic
                           tftpServerName:
                             description: If specified will pass option 66 to interface's
                               DHCP server
@@ -11741,6 +14742,32 @@ var CRDsValidation map[string]string = map[string]string{
                         Defaults to false
                       type: boolean
                   type: object
+                usbDevices:
+                  description: |-
+                    USBDevices are host USB devices passed through to the vmi. They are allocated by virt-handler
+                    among the devices plugged into the node, and attached to the vmi as they get plugged.
+                  items:
+                    description: |-
+                      USBDevice selects a USB device plugged into the node of the vmi, either by its vendor and product
+                      identifiers or by the port it is plugged into. Exactly one of the selectors has to be set.
+                    properties:
+                      busPort:
+                        description: |-
+                          BusPort selects the device plugged into a port, by the bus number and port path the kernel
+                          names the device after, e.g. 1-2.3
+                        type: string
+                      name:
+                        description: Name of the USB device, unique within the vmi
+                        type: string
+                      vendorProduct:
+                        description: VendorProduct selects a free device by its vendor_id:product_id
+                          tuple, e.g. 1050:0407
+                        type: string
+                    required:
+                    - name
+                    type: object
+                  type: array
+                  x-kubernetes-list-type: atomic
                 useVirtioTransitional:
                   description: |-
                     Fall back to legacy virtio 0.9 support if virtio bus is selected on devices.
@@ -12228,6 +15255,26 @@ var CRDsValidation map[string]string = map[string]string{
             - "LiveMigrateIfPossible": the same as "LiveMigrate" but only if the VirtualMachine is Live-Migratable, otherwise it will behave as "None".
             - "External": the VirtualMachineInstance will be protected by a PDB and 'vmi.Status.EvacuationNodeName' will be set on eviction. This is mainly useful for cluster-api-provider-kubevirt (capk) which needs a way for VMI's to be blocked from eviction, yet signal capk that eviction has been called on the VMI so the capk controller can handle tearing the VMI down. Details can be found in the commit description https://github.com/kubevirt/kubevirt/commit/c1d77face705c8b126696bac9a3ee3825f27f1fa.
           type: string
+        guestLicense:
+          description: |-
+            GuestLicense describes the licensing of the guest operating system.
+            It is exposed in the kubevirt_vmi_guest_license_info metric.
+          properties:
+            byol:
+              description: |-
+                BYOL tells if the license of the guest operating system is brought by the owner of the VirtualMachineInstance
+                instead of being provided with the cluster.
+              type: boolean
+            licenseModel:
+              description: LicenseModel is the model the guest operating system is
+                licensed under, like subscription or perCore.
+              type: string
+            osFamily:
+              description: |-
+                OSFamily is the family of the guest operating system, one of linux, windows or other.
+                If not set, it is detected from the operating system reported by the guest agent.
+              type: string
+          type: object
         hostname:
           description: |-
             Specifies the hostname of the vmi
@@ -12570,6 +15617,26 @@ var CRDsValidation map[string]string = map[string]string{
             If specified, the VMI will be dispatched by specified scheduler.
             If not specified, the VMI will be dispatched by default scheduler.
           type: string
+        shutdownPolicy:
+          description: |-
+            ShutdownPolicy escalates the graceful shutdown of the guest in stages, from an ACPI power button press,
+            to a shutdown command sent through the guest agent, to forcefully powering off the guest.
+            If not set, the guest is asked to shut down until the termination grace period expires.
+          properties:
+            acpiTimeoutSeconds:
+              description: |-
+                ACPITimeoutSeconds is the time the guest is given to power off after the ACPI power button was pressed.
+                Defaults to 30.
+              format: int64
+              type: integer
+            guestAgentTimeoutSeconds:
+              description: |-
+                GuestAgentTimeoutSeconds is the time the guest is given to power off after the shutdown command was sent
+                through the guest agent, before it is forcefully powered off. The stage is skipped if no guest agent is connected.
+                Defaults to 30.
+              format: int64
+              type: integer
+          type: object
         startStrategy:
           description: StartStrategy can be set to "Paused" if Virtual Machine should
             be started in paused state.
@@ -13086,6 +16153,27 @@ var CRDsValidation map[string]string = map[string]string{
                 description: Ephemeral is a special volume source that "wraps" specified
                   source and provides copy-on-write image on top of it.
                 properties:
+                  overlay:
+                    description: |-
+                      Overlay places the copy-on-write overlay holding the writes of the guest
+                      on a dedicated volume instead of the ephemeral storage of the pod.
+                    properties:
+                      capacity:
+                        anyOf:
+                        - type: integer
+                        - type: string
+                        description: Capacity of the overlay volume, limiting how
+                          much the guest can write.
+                        pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                        x-kubernetes-int-or-string: true
+                      storageClassName:
+                        description: StorageClassName is the storage class of the
+                          overlay volume.
+                        type: string
+                    required:
+                    - capacity
+                    - storageClassName
+                    type: object
                   persistentVolumeClaim:
                     description: |-
                       PersistentVolumeClaimVolumeSource represents a reference to a PersistentVolumeClaim in the same namespace.
@@ -13136,11 +16224,26 @@ var CRDsValidation map[string]string = map[string]string{
                 description: MemoryDump is attached to the virt launcher and is populated
                   with a memory dump of the vmi
                 properties:
+                  chunkSize:
+                    anyOf:
+                    - type: integer
+                    - type: string
+                    description: ChunkSize is the amount of the dump which is written
+                      to the volume before it is flushed to disk
+                    pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                    x-kubernetes-int-or-string: true
                   claimName:
                     description: |-
                       claimName is the name of a PersistentVolumeClaim in the same namespace as the pod using this volume.
                       More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#persistentvolumeclaims
                     type: string
+                  compression:
+                    description: Compression compresses the memory dump while it is
+                      written to the volume
+                    enum:
+                    - gzip
+                    - zstd
+                    type: string
                   hotpluggable:
                     description: Hotpluggable indicates whether the volume can be
                       hotplugged and hotunplugged.
@@ -13273,6 +16376,39 @@ var CRDsValidation map[string]string = map[string]string{
             ActivePods is a mapping of pod UID to node name.
             It is possible for multiple pods to be running for a single VMI during migration.
           type: object
+        cgroupLayout:
+          description: |-
+            CgroupLayout describes how the threads of the virtual machine instance are placed in the cgroups
+            of the compute container. It is only reported if the housekeeping threads are separated from the vCPUs.
+          properties:
+            housekeeping:
+              description: Housekeeping is the child cgroup the housekeeping threads
+                are moved to
+              properties:
+                cpuLimit:
+                  anyOf:
+                  - type: integer
+                  - type: string
+                  description: CPULimit is the CPU time the housekeeping threads are
+                    limited to
+                  pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                  x-kubernetes-int-or-string: true
+                cpuSet:
+                  description: CPUSet are the CPUs the housekeeping threads are pinned
+                    to
+                  type: string
+                name:
+                  description: Name is the name of the child cgroup below the cgroup
+                    of the compute container
+                  type: string
+              required:
+              - name
+              type: object
+            version:
+              description: Version is the version of the cgroup hierarchy on the node,
+                v1 or v2
+              type: string
+          type: object
         conditions:
           description: Conditions are specific points in VirtualMachineInstance's
             pod runtime.
@@ -13329,6 +16465,29 @@ var CRDsValidation map[string]string = map[string]string{
             EvacuationNodeName is used to track the eviction process of a VMI. It stores the name of the node that we want
             to evacuate. It is meant to be used by KubeVirt core components only and can't be set or modified by users.
           type: string
+        fsFreezeInfo:
+          description: FSFreezeInfo reports which guest filesystems are frozen and
+            for how long they were frozen
+          properties:
+            freezeTimestamp:
+              description: FreezeTimestamp is the time the guest filesystems were
+                frozen at
+              format: date-time
+              type: string
+            lastFreezeDurationSeconds:
+              description: LastFreezeDurationSeconds is the duration of the most recent
+                freeze which ended
+              format: int64
+              type: integer
+            volumes:
+              description: |-
+                Volumes lists the volumes whose guest filesystems are frozen.
+                All the guest filesystems are frozen if it's empty.
+              items:
+                type: string
+              type: array
+              x-kubernetes-list-type: atomic
+          type: object
         fsFreezeStatus:
           description: |-
             FSFreezeStatus is the state of the fs of the guest
@@ -13340,6 +16499,10 @@ var CRDsValidation map[string]string = map[string]string{
             id:
               description: Guest OS Id
               type: string
+            hostname:
+              description: Short hostname of the guest as reported by the guest agent, not
+                its FQDN
+              type: string
             kernelRelease:
               description: Guest OS Kernel Release
               type: string
@@ -13368,7 +16531,7 @@ var CRDsValidation map[string]string = map[string]string{
             properties:
               infoSource:
                 description: 'Specifies the origin of the interface data collected.
-                  values: domain, guest-agent, multus-status.'
+                  values: domain, guest-agent, multus-status, arp-snooping.'
                 type: string
               interfaceName:
                 description: The interface name inside the Virtual Machine
@@ -13465,6 +16628,15 @@ var CRDsValidation map[string]string = map[string]string{
                 (hotplug) for the VirtualMachine.
               pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
               x-kubernetes-int-or-string: true
+            guestUsable:
+              anyOf:
+              - type: integer
+              - type: string
+              description: |-
+                GuestUsable specifies how much memory is actually usable by the guest.
+                It can stay above GuestRequested when the guest could not release all the memory being unplugged.
+              pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+              x-kubernetes-int-or-string: true
           type: object
         migratedVolumes:
           description: MigratedVolumes lists the source and destination volumes during
@@ -13630,6 +16802,30 @@ var CRDsValidation map[string]string = map[string]string{
                     permitted, migration will be switched to post-copy or the VMI will be
                     paused to allow the migration to complete
                   type: boolean
+                bandwidthAutoTuning:
+                  description: |-
+                    BandwidthAutoTuning lets virt-controller derive the bandwidth of each migration from the number of
+                    concurrent outbound migrations and the network utilization which virt-handler reports for the source node.
+                    BandwidthPerMigration, if set, remains the upper limit. Defaults to no auto-tuning
+                  properties:
+                    minBandwidthPerMigration:
+                      anyOf:
+                      - type: integer
+                      - type: string
+                      description: |-
+                        MinBandwidthPerMigration is the lowest bandwidth a migration is limited to, even if the network of the
+                        source node is saturated. The value is in quantity per second. Defaults to 0 (no lower limit)
+                      pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                      x-kubernetes-int-or-string: true
+                    targetUtilizationPercent:
+                      description: |-
+                        TargetUtilizationPercent is the share of the migration network capacity of the source node which may be
+                        used in total. Migrations use whatever is left by the other traffic. Defaults to 80
+                      format: int32
+                      maximum: 100
+                      minimum: 1
+                      type: integer
+                  type: object
                 bandwidthPerMigration:
                   anyOf:
                   - type: integer
@@ -13646,6 +16842,27 @@ var CRDsValidation map[string]string = map[string]string{
                     to post-copy or cancelled depending on other settings. Defaults to 150
                   format: int64
                   type: integer
+                compression:
+                  description: |-
+                    Compression compresses the guest memory sent over the multifd channels of a live migration.
+                    It is ignored for migrations over a single connection. Defaults to no compression
+                  properties:
+                    level:
+                      description: |-
+                        Level is the compression level. Ranges from 1 to 9 for zlib and from 1 to 20 for zstd.
+                        Defaults to the hypervisor default of the method
+                      format: int32
+                      type: integer
+                    method:
+                      description: Method is the compression method. One of zlib or
+                        zstd
+                      enum:
+                      - zlib
+                      - zstd
+                      type: string
+                  required:
+                  - method
+                  type: object
                 disableTLS:
                   description: |-
                     When set to true, DisableTLS will disable the additional layer of live migration encryption
@@ -13658,6 +16875,13 @@ var CRDsValidation map[string]string = map[string]string{
                     That will ensure the target virt-launcher doesn't share categories with another pod on the node.
                     However, migrations will fail when using RWX volumes that don't automatically deal with SELinux levels.
                   type: boolean
+                maxDowntimeMilliseconds:
+                  description: |-
+                    MaxDowntimeMilliseconds is the maximum number of milliseconds the guest may be paused to switch over to the
+                    target at the end of a pre-copy migration. Larger values let migrations of busy VMIs converge sooner.
+                    Defaults to the hypervisor default (300)
+                  format: int64
+                  type: integer
                 network:
                   description: |-
                     Network is the name of the CNI network to use for live migrations. By default, migrations go
@@ -13668,6 +16892,13 @@ var CRDsValidation map[string]string = map[string]string{
                     NodeDrainTaintKey defines the taint key that indicates a node should be drained.
                     Note: this option relies on the deprecated node taint feature. Default: kubevirt.io/drain
                   type: string
+                parallelMigrationChannels:
+                  description: |-
+                    ParallelMigrationChannels is the number of multifd channels used to transfer the guest memory of a live
+                    migration. A value of 1 migrates over a single connection. Post-copy migrations and VMIs with a CPU limit
+                    always use a single connection. Defaults to 8
+                  format: int32
+                  type: integer
                 parallelMigrationsPerCluster:
                   description: |-
                     ParallelMigrationsPerCluster is the total number of concurrent live migrations
@@ -13680,6 +16911,13 @@ var CRDsValidation map[string]string = map[string]string{
                     allowed per node. Defaults to 2
                   format: int32
                   type: integer
+                postCopyAfterIterations:
+                  description: |-
+                    PostCopyAfterIterations switches a migration to post-copy once the guest memory has been copied that many
+                    times without the migration completing, instead of waiting for CompletionTimeoutPerGiB.
+                    Only applies if AllowPostCopy and AllowWorkloadDisruption are set. Defaults to 0 (disabled)
+                  format: int64
+                  type: integer
                 progressTimeout:
                   description: |-
                     ProgressTimeout is the maximum number of seconds a live migration is allowed to make no progress.
@@ -13705,6 +16943,33 @@ var CRDsValidation map[string]string = map[string]string{
               description: Lets us know if the vmi is currently running pre or post
                 copy migration
               type: string
+            progress:
+              description: The data transfer statistics of the migration as reported
+                by the source
+              properties:
+                dataProcessedBytes:
+                  description: The amount of data transferred to the target so far
+                  format: int64
+                  type: integer
+                dataRemainingBytes:
+                  description: The amount of data still to be transferred to the target
+                  format: int64
+                  type: integer
+                dataTotalBytes:
+                  description: The total amount of data to be transferred
+                  format: int64
+                  type: integer
+                downtimeMilliseconds:
+                  description: The expected downtime while the migration is running
+                    and the measured downtime once it completed
+                  format: int64
+                  type: integer
+                percentComplete:
+                  description: The share of the data transferred to the target, from
+                    0 to 100
+                  format: int32
+                  type: integer
+              type: object
             sourceNode:
               description: The source node that the VMI originated on
               type: string
@@ -13770,6 +17035,24 @@ var CRDsValidation map[string]string = map[string]string{
           description: NodeName is the name where the VirtualMachineInstance is currently
             running.
           type: string
+        pauseStatus:
+          description: PauseStatus reports for how long the VirtualMachineInstance
+            was paused
+          properties:
+            lastPauseDurationSeconds:
+              description: LastPauseDurationSeconds is the duration of the most recent
+                pause which ended
+              format: int64
+              type: integer
+            totalPauseDurationSeconds:
+              description: TotalPauseDurationSeconds is the sum of the durations of
+                all pauses which ended
+              format: int64
+              type: integer
+          required:
+          - lastPauseDurationSeconds
+          - totalPauseDurationSeconds
+          type: object
         phase:
           description: Phase is the status of the VirtualMachineInstance in kubernetes
             world. It is not the VirtualMachineInstance status, but partially correlates
@@ -13814,12 +17097,88 @@ var CRDsValidation map[string]string = map[string]string{
           description: SELinuxContext is the actual SELinux context of the virt-launcher
             pod
           type: string
+        shutdownStage:
+          description: |-
+            ShutdownStage is the stage of the shutdown policy the guest is shut down in,
+            or was shut down in once the VirtualMachineInstance stopped
+          type: string
+        threadPlacement:
+          description: ThreadPlacement reports the host CPUs the emulator thread and
+            the IOThreads are pinned to
+          properties:
+            emulatorThreadCPUSet:
+              description: EmulatorThreadCPUSet are the host CPUs the emulator thread
+                is pinned to
+              type: string
+            ioThreads:
+              description: IOThreads are the host CPUs the IOThreads are pinned to
+              items:
+                description: IOThreadPlacement reports the host CPUs an IOThread is
+                  pinned to
+                properties:
+                  cpuSet:
+                    description: CPUSet are the host CPUs the IOThread is pinned to
+                    type: string
+                  id:
+                    description: ID is the id of the IOThread
+                    format: int64
+                    type: integer
+                required:
+                - cpuSet
+                - id
+                type: object
+              type: array
+              x-kubernetes-list-type: atomic
+          type: object
         topologyHints:
           properties:
+            cpuFeatures:
+              description: |-
+                CPUFeatures are the CPU features common to the nodes the VMI can be scheduled to,
+                required on top of CPUModel
+              items:
+                type: string
+              type: array
+              x-kubernetes-list-type: set
+            cpuModel:
+              description: |-
+                CPUModel is the CPU model common to the nodes the VMI can be scheduled to,
+                used when the VMI requests the cluster-common CPU model
+              type: string
             tscFrequency:
               format: int64
               type: integer
           type: object
+        usbDevices:
+          description: USBDevices reports the host USB devices allocated to the USB
+            devices of the VirtualMachineInstance
+          items:
+            description: USBDeviceStatus reports the host device allocated to a USB
+              device of the VirtualMachineInstance
+            properties:
+              bus:
+                description: Bus is the number of the bus of the allocated host device
+                type: integer
+              busPort:
+                description: BusPort is the bus and port path of the allocated host
+                  device, e.g. 1-2.3
+                type: string
+              deviceNumber:
+                description: DeviceNumber is the number of the allocated host device
+                  on its bus
+                type: integer
+              name:
+                description: Name of the USB device in the VirtualMachineInstance
+                  spec
+                type: string
+              phase:
+                description: Phase of the USB device
+                type: string
+            required:
+            - name
+            type: object
+          type: array
+          x-kubernetes-list-type: atomic
         virtualMachineRevisionName:
           description: |-
             VirtualMachineRevisionName is used to get the vm revision of the vmi when doing
@@ -13841,6 +17200,23 @@ var CRDsValidation map[string]string = map[string]string{
                     format: int32
                     type: integer
                 type: object
+              guestFilesystemUsage:
+                description: |-
+                  GuestFilesystemUsage is the usage of the guest filesystems on the volume, as reported by the guest agent.
+                  It is reported with the VolumeAutoExpansion feature gate, for the volumes whose disks have a serial.
+                properties:
+                  totalBytes:
+                    description: TotalBytes is the size of the filesystems
+                    format: int64
+                    type: integer
+                  usedBytes:
+                    description: UsedBytes is the space used on the filesystems
+                    format: int64
+                    type: integer
+                required:
+                - totalBytes
+                - usedBytes
+                type: object
               hotplugVolume:
                 description: If the volume is hotplug, this will contain the hotplug
                   status.
@@ -13866,6 +17242,24 @@ var CRDsValidation map[string]string = map[string]string{
                     description: EndTimestamp is the time when the memory dump completed
                     format: date-time
                     type: string
+                  progress:
+                    description: Progress is the share of the guest memory dumped
+                      so far
+                    properties:
+                      dataProcessedBytes:
+                        description: The amount of guest memory dumped so far
+                        format: int64
+                        type: integer
+                      dataTotalBytes:
+                        description: The total amount of guest memory to be dumped
+                        format: int64
+                        type: integer
+                      percentComplete:
+                        description: The share of the guest memory dumped so far,
+                          from 0 to 100
+                        format: int32
+                        type: integer
+                    type: object
                   startTimestamp:
                     description: StartTimestamp is the time when the memory dump started
                     format: date-time
@@ -14070,6 +17464,30 @@ var CRDsValidation map[string]string = map[string]string{
                     permitted, migration will be switched to post-copy or the VMI will be
                     paused to allow the migration to complete
                   type: boolean
+                bandwidthAutoTuning:
+                  description: |-
+                    BandwidthAutoTuning lets virt-controller derive the bandwidth of each migration from the number of
+                    concurrent outbound migrations and the network utilization which virt-handler reports for the source node.
+                    BandwidthPerMigration, if set, remains the upper limit. Defaults to no auto-tuning
+                  properties:
+                    minBandwidthPerMigration:
+                      anyOf:
+                      - type: integer
+                      - type: string
+                      description: |-
+                        MinBandwidthPerMigration is the lowest bandwidth a migration is limited to, even if the network of the
+                        source node is saturated. The value is in quantity per second. Defaults to 0 (no lower limit)
+                      pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                      x-kubernetes-int-or-string: true
+                    targetUtilizationPercent:
+                      description: |-
+                        TargetUtilizationPercent is the share of the migration network capacity of the source node which may be
+                        used in total. Migrations use whatever is left by the other traffic. Defaults to 80
+                      format: int32
+                      maximum: 100
+                      minimum: 1
+                      type: integer
+                  type: object
                 bandwidthPerMigration:
                   anyOf:
                   - type: integer
@@ -14086,6 +17504,27 @@ var CRDsValidation map[string]string = map[string]string{
                     to post-copy or cancelled depending on other settings. Defaults to 150
                   format: int64
                   type: integer
+                compression:
+                  description: |-
+                    Compression compresses the guest memory sent over the multifd channels of a live migration.
+                    It is ignored for migrations over a single connection. Defaults to no compression
+                  properties:
+                    level:
+                      description: |-
+                        Level is the compression level. Ranges from 1 to 9 for zlib and from 1 to 20 for zstd.
+                        Defaults to the hypervisor default of the method
+                      format: int32
+                      type: integer
+                    method:
+                      description: Method is the compression method. One of zlib or
+                        zstd
+                      enum:
+                      - zlib
+                      - zstd
+                      type: string
+                  required:
+                  - method
+                  type: object
                 disableTLS:
                   description: |-
                     When set to true, DisableTLS will disable the additional layer of live migration encryption
@@ -14098,6 +17537,13 @@ var CRDsValidation map[string]string = map[string]string{
                     That will ensure the target virt-launcher doesn't share categories with another pod on the node.
                     However, migrations will fail when using RWX volumes that don't automatically deal with SELinux levels.
                   type: boolean
+                maxDowntimeMilliseconds:
+                  description: |-
+                    MaxDowntimeMilliseconds is the maximum number of milliseconds the guest may be paused to switch over to the
+                    target at the end of a pre-copy migration. Larger values let migrations of busy VMIs converge sooner.
+                    Defaults to the hypervisor default (300)
+                  format: int64
+                  type: integer
                 network:
                   description: |-
                     Network is the name of the CNI network to use for live migrations. By default, migrations go
@@ -14108,6 +17554,13 @@ var CRDsValidation map[string]string = map[string]string{
                     NodeDrainTaintKey defines the taint key that indicates a node should be drained.
                     Note: this option relies on the deprecated node taint feature. Default: kubevirt.io/drain
                   type: string
+                parallelMigrationChannels:
+                  description: |-
+                    ParallelMigrationChannels is the number of multifd channels used to transfer the guest memory of a live
+                    migration. A value of 1 migrates over a single connection. Post-copy migrations and VMIs with a CPU limit
+                    always use a single connection. Defaults to 8
+                  format: int32
+                  type: integer
                 parallelMigrationsPerCluster:
                   description: |-
                     ParallelMigrationsPerCluster is the total number of concurrent live migrations
@@ -14120,6 +17573,13 @@ var CRDsValidation map[string]string = map[string]string{
                     allowed per node. Defaults to 2
                   format: int32
                   type: integer
+                postCopyAfterIterations:
+                  description: |-
+                    PostCopyAfterIterations switches a migration to post-copy once the guest memory has been copied that many
+                    times without the migration completing, instead of waiting for CompletionTimeoutPerGiB.
+                    Only applies if AllowPostCopy and AllowWorkloadDisruption are set. Defaults to 0 (disabled)
+                  format: int64
+                  type: integer
                 progressTimeout:
                   description: |-
                     ProgressTimeout is the maximum number of seconds a live migration is allowed to make no progress.
@@ -14145,6 +17605,33 @@ var CRDsValidation map[string]string = map[string]string{
               description: Lets us know if the vmi is currently running pre or post
                 copy migration
               type: string
+            progress:
+              description: The data transfer statistics of the migration as reported
+                by the source
+              properties:
+                dataProcessedBytes:
+                  description: The amount of data transferred to the target so far
+                  format: int64
+                  type: integer
+                dataRemainingBytes:
+                  description: The amount of data still to be transferred to the target
+                  format: int64
+                  type: integer
+                dataTotalBytes:
+                  description: The total amount of data to be transferred
+                  format: int64
+                  type: integer
+                downtimeMilliseconds:
+                  description: The expected downtime while the migration is running
+                    and the measured downtime once it completed
+                  format: int64
+                  type: integer
+                percentComplete:
+                  description: The share of the data transferred to the target, from
+                    0 to 100
+                  format: int32
+                  type: integer
+              type: object
             sourceNode:
               description: The source node that the VMI originated on
               type: string
@@ -14280,6 +17767,27 @@ var CRDsValidation map[string]string = map[string]string{
             clock:
               description: Clock sets the clock and timers of the vmi.
               properties:
+                guestTimeSync:
+                  description: GuestTimeSync configures when the guest clock is set
+                    to the host time through the guest agent.
+                  properties:
+                    driftThresholdSeconds:
+                      description: |-
+                        DriftThresholdSeconds is the drift of the guest clock above which it is set to the host time
+                        with the "OnDrift" policy.
+                        Defaults to 2.
+                      format: int32
+                      type: integer
+                    policy:
+                      description: |-
+                        Policy selects when the guest clock is set to the host time.
+                        "OnResume" sets it after a live migration and when the vmi is unpaused.
+                        "OnDrift" additionally sets it whenever the guest clock drifts away from the host clock by more than
+                        the drift threshold, e.g. after the node resumed from suspend.
+                        "Disabled" never sets it, leaving the guest clock to the time synchronization of the guest.
+                        Defaults to "OnResume".
+                      type: string
+                  type: object
                 timer:
                   description: Timer specifies whih timers are attached to the vmi.
                   properties:
@@ -14408,6 +17916,24 @@ var CRDsValidation map[string]string = map[string]string{
                     - name
                     type: object
                   type: array
+                housekeeping:
+                  description: |-
+                    Housekeeping gives the QEMU threads which do not run vCPUs, like the emulator and IOThreads,
+                    their own CPU budget in a child cgroup of the compute container, so they don't take CPU time
+                    from the vCPUs. Can not be combined with DedicatedCPUPlacement, use IsolateEmulatorThread instead.
+                  properties:
+                    cpu:
+                      anyOf:
+                      - type: integer
+                      - type: string
+                      description: |-
+                        CPU is added to the CPU requests of the compute container, and to its CPU limits if they are set.
+                        The housekeeping threads are limited to this amount of CPU, the rest is left to the vCPUs.
+                      pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                      x-kubernetes-int-or-string: true
+                  required:
+                  - cpu
+                  type: object
                 isolateEmulatorThread:
                   description: |-
                     IsolateEmulatorThread requests one more dedicated pCPU to be allocated for the VMI to place
@@ -14419,12 +17945,21 @@ var CRDsValidation map[string]string = map[string]string{
                     be hotplugged
                   format: int32
                   type: integer
+                mitigatedVulnerabilities:
+                  description: |-
+                    MitigatedVulnerabilities lists the CPU vulnerabilities, as named by the kernel in /sys/devices/system/cpu/vulnerabilities,
+                    e.g. retbleed or mds, the node of the VMI has to be mitigated against or not affected by.
+                  items:
+                    type: string
+                  type: array
+                  x-kubernetes-list-type: set
                 model:
                   description: |-
                     Model specifies the CPU model inside the VMI.
                     List of available models https://github.com/libvirt/libvirt/tree/master/src/cpu_map.
                     It is possible to specify special cases like "host-passthrough" to get the same CPU as the node
                     and "host-model" to get CPU closest to the node one.
+                    "cluster-common" selects the CPU model and features common to the nodes matching the node selector of the VMI.
                     Defaults to host-model.
                   type: string
                 numa:
@@ -14453,6 +17988,24 @@ var CRDsValidation map[string]string = map[string]string{
                     Must be a value greater or equal 1.
                   format: int32
                   type: integer
+                threadPlacement:
+                  description: |-
+                    ThreadPlacement defines on which pCPUs the emulator thread and the IOThreads run.
+                    Requires DedicatedCPUPlacement.
+                  properties:
+                    emulatorThread:
+                      description: |-
+                        EmulatorThread defines where the emulator thread runs. With shared it runs on the pCPUs of the vCPUs,
+                        dedicated and sameNUMANode isolate it on an additional pCPU, like IsolateEmulatorThread does.
+                        Defaults to dedicated if IsolateEmulatorThread is set and to shared otherwise.
+                      type: string
+                    ioThreads:
+                      description: |-
+                        IOThreads defines where the IOThreads run. With shared they run on the pCPU of the isolated emulator
+                        thread, or on the pCPUs of the vCPUs if the emulator thread is not isolated. With dedicated and
+                        sameNUMANode all IOThreads run together on an additional pCPU. Defaults to shared.
+                      type: string
+                  type: object
                 threads:
                   description: |-
                     Threads specifies the number of threads inside the vmi.
@@ -14602,6 +18155,51 @@ var CRDsValidation map[string]string = map[string]string{
                           IO specifies which QEMU disk IO mode should be used.
                           Supported values are: native, default, threads.
                         type: string
+                      ioTune:
+                        description: |-
+                          IOTune limits the IO operations and the throughput of the disk.
+                          It can be set when hotplugging the disk.
+                        properties:
+                          readBytesSec:
+                            anyOf:
+                            - type: integer
+                            - type: string
+                            description: ReadBytesSec limits the read throughput in
+                              bytes per second.
+                            pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                            x-kubernetes-int-or-string: true
+                          readIOPSSec:
+                            description: ReadIOPSSec limits the number of read operations
+                              per second.
+                            format: int64
+                            type: integer
+                          totalBytesSec:
+                            anyOf:
+                            - type: integer
+                            - type: string
+                            description: TotalBytesSec limits the total throughput
+                              of reads and writes in bytes per second.
+                            pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                            x-kubernetes-int-or-string: true
+                          totalIOPSSec:
+                            description: TotalIOPSSec limits the total number of read
+                              and write operations per second.
+                            format: int64
+                            type: integer
+                          writeBytesSec:
+                            anyOf:
+                            - type: integer
+                            - type: string
+                            description: WriteBytesSec limits the write throughput
+                              in bytes per second.
+                            pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                            x-kubernetes-int-or-string: true
+                          writeIOPSSec:
+                            description: WriteIOPSSec limits the number of write operations
+                              per second.
+                            format: int64
+                            type: integer
+                        type: object
                       lun:
                         description: Attach a volume as a LUN to the vmi.
                         properties:
@@ -14654,6 +18252,85 @@ var CRDsValidation map[string]string = map[string]string{
                         type: string
                       virtiofs:
                         description: Virtiofs is supported
+                        properties:
+                          cache:
+                            description: |-
+                              Cache selects the caching policy of the virtiofs daemon serving the
+                              filesystem. Use never when other pods write to the same volume
+                              concurrently, so that the guest always sees their changes.
+                              Supported values: auto, always, never. Defaults to auto.
+                            enum:
+                            - auto
+                            - always
+                            - never
+                            type: string
+                          idMapping:
+                            description: |-
+                              IDMapping translates the user and group IDs seen by the guest into
+                              the IDs stored on the shared volume. Use it to let the guest and
+                              other pods sharing the volume agree on file ownership.
+                            properties:
+                              groupIDs:
+                                description: GroupIDs maps ranges of guest group IDs
+                                  to group IDs on the volume.
+                                items:
+                                  properties:
+                                    count:
+                                      description: Count is the number of consecutive
+                                        IDs in the range.
+                                      format: int64
+                                      minimum: 1
+                                      type: integer
+                                    guestID:
+                                      description: GuestID is the first ID of the
+                                        range as seen by the guest.
+                                      format: int64
+                                      minimum: 0
+                                      type: integer
+                                    hostID:
+                                      description: HostID is the first ID of the range
+                                        as stored on the volume.
+                                      format: int64
+                                      minimum: 0
+                                      type: integer
+                                  required:
+                                  - count
+                                  - guestID
+                                  - hostID
+                                  type: object
+                                type: array
+                                x-kubernetes-list-type: atomic
+                              userIDs:
+                                description: UserIDs maps ranges of guest user IDs
+                                  to user IDs on the volume.
+                                items:
+                                  properties:
+                                    count:
+                                      description: Count is the number of consecutive
+                                        IDs in the range.
+                                      format: int64
+                                      minimum: 1
+                                      type: integer
+                                    guestID:
+                                      description: GuestID is the first ID of the
+                                        range as seen by the guest.
+                                      format: int64
+                                      minimum: 0
+                                      type: integer
+                                    hostID:
+                                      description: HostID is the first ID of the range
+                                        as stored on the volume.
+                                      format: int64
+                                      minimum: 0
+                                      type: integer
+                                  required:
+                                  - count
+                                  - guestID
+                                  - hostID
+                                  type: object
+                                type: array
+                                x-kubernetes-list-type: atomic
+                            type: object
                         type: object
                     required:
                     - name
@@ -14756,6 +18433,74 @@ var CRDsValidation map[string]string = map[string]string{
                           in PCI addresses assigned to the device.
                           This value is required to be unique across all devices and be between 1 and (16*1024-1).
                         type: integer
+                      bandwidth:
+                        description: |-
+                          Bandwidth limits the traffic of the interface.
+                          It is only supported on bridge and masquerade bindings.
+                        properties:
+                          inbound:
+                            description: Inbound limits the traffic received by the
+                              guest.
+                            properties:
+                              average:
+                                anyOf:
+                                - type: integer
+                                - type: string
+                                description: Average is the average rate the traffic
+                                  is shaped to, in bytes per second.
+                                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                x-kubernetes-int-or-string: true
+                              burst:
+                                anyOf:
+                                - type: integer
+                                - type: string
+                                description: Burst is the amount of bytes which may
+                                  be transferred at the peak rate.
+                                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                x-kubernetes-int-or-string: true
+                              peak:
+                                anyOf:
+                                - type: integer
+                                - type: string
+                                description: Peak is the rate the traffic may reach
+                                  while bursting, in bytes per second.
+                                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                x-kubernetes-int-or-string: true
+                            required:
+                            - average
+                            type: object
+                          outbound:
+                            description: Outbound limits the traffic transmitted by
+                              the guest.
+                            properties:
+                              average:
+                                anyOf:
+                                - type: integer
+                                - type: string
+                                description: Average is the average rate the traffic
+                                  is shaped to, in bytes per second.
+                                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                x-kubernetes-int-or-string: true
+                              burst:
+                                anyOf:
+                                - type: integer
+                                - type: string
+                                description: Burst is the amount of bytes which may
+                                  be transferred at the peak rate.
+                                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                x-kubernetes-int-or-string: true
+                              peak:
+                                anyOf:
+                                - type: integer
+                                - type: string
+                                description: Peak is the rate the traffic may reach
+                                  while bursting, in bytes per second.
+                                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                x-kubernetes-int-or-string: true
+                            required:
+                            - average
+                            type: object
+                        type: object
                       binding:
                         description: |-
                           Binding specifies the binding plugin that will be used to connect the interface to the guest.
@@ -14789,6 +18534,11 @@ var CRDsValidation map[string]string = map[string]string{
                             description: If specified will pass option 67 to interface's
                               DHCP server
                             type: string
+                          mtu:
+                            description: If specified will pass the MTU to the VM
+                              via DHCP option 026 instead of the MTU of the pod interface.
+                            format: int32
+                            type: integer
                           ntpServers:
                             description: If specified will pass the configured NTP
                               server to the VM via DHCP option 042.
@@ -14817,6 +18567,28 @@ var CRDsValidation map[string]string = map[string]string{
                               - value
                               type: object
                             type: array
+                          routes:
+                            description: If specified will pass the routes to the
+                              VM via DHCP option 121, in addition to the routes of
+                              the pod interface.
+                            items:
+                              description: DHCPRoute defines a classless static route
+                                passed to the VM via DHCP.
+                              properties:
+                                destination:
+                                  description: |-
+                                    Destination is the IPv4 CIDR of the route
+                                    Required.
+                                  type: string
+                                gateway:
+                                  description: Gateway is the IPv4 address of the
+                                    next hop, the route is on-link if omitted
+                                  type: string
+                              required:
+                              - destination
+                              type: object
+                            type: array
+                            x-kubernetes-list-type: atomic
                           tftpServerName:
                             description: If specified will pass option 66 to interface's
                               DHCP server
@@ -14956,6 +18728,32 @@ var CRDsValidation map[string]string = map[string]string{
                         Defaults to false
                       type: boolean
                   type: object
+                usbDevices:
+                  description: |-
+                    USBDevices are host USB devices passed through to the vmi. They are allocated by virt-handler
+                    among the devices plugged into the node, and attached to the vmi as they get plugged.
+                  items:
+                    description: |-
+                      USBDevice selects a USB device plugged into the node of the vmi, either by its vendor and product
+                      identifiers or by the port it is plugged into. Exactly one of the selectors has to be set.
+                    properties:
+                      busPort:
+                        description: |-
+                          BusPort selects the device plugged into a port, by the bus number and port path the kernel
+                          names the device after, e.g. 1-2.3
+                        type: string
+                      name:
+                        description: Name of the USB device, unique within the vmi
+                        type: string
+                      vendorProduct:
+                        description: VendorProduct selects a free device by its vendor_id:product_id
+                          tuple, e.g. 1050:0407
+                        type: string
+                    required:
+                    - name
+                    type: object
+                  type: array
+                  x-kubernetes-list-type: atomic
                 useVirtioTransitional:
                   description: |-
                     Fall back to legacy virtio 0.9 support if virtio bus is selected on devices.
@@ -15693,23 +19491,121 @@ var CRDsValidation map[string]string = map[string]string{
                       properties:
                         preferredDuringSchedulingIgnoredDuringExecution:
                           description: |-
-                            The scheduler will prefer to schedule pods to nodes that satisfy
-                            the affinity expressions specified by this field, but it may choose
-                            a node that violates one or more of the expressions. The node that is
-                            most preferred is the one with the greatest sum of weights, i.e.
-                            for each node that meets all of the scheduling requirements (resource
-                            request, requiredDuringScheduling affinity expressions, etc.),
-                            compute a sum by iterating through the elements of this field and adding
-                            "weight" to the sum if the node matches the corresponding matchExpressions; the
-                            node(s) with the highest sum are the most preferred.
-                          items:
-                            description: |-
-                              An empty preferred scheduling term matches all objects with implicit weight 0
-                              (i.e. it's a no-op). A null preferred scheduling term matches no objects (i.e. is also a no-op).
-                            properties:
-                              preference:
-                                description: A node selector term, associated with
-                                  the corresponding weight.
+                            The scheduler will prefer to schedule pods to nodes that satisfy
+                            the affinity expressions specified by this field, but it may choose
+                            a node that violates one or more of the expressions. The node that is
+                            most preferred is the one with the greatest sum of weights, i.e.
+                            for each node that meets all of the scheduling requirements (resource
+                            request, requiredDuringScheduling affinity expressions, etc.),
+                            compute a sum by iterating through the elements of this field and adding
+                            "weight" to the sum if the node matches the corresponding matchExpressions; the
+                            node(s) with the highest sum are the most preferred.
+                          items:
+                            description: |-
+                              An empty preferred scheduling term matches all objects with implicit weight 0
+                              (i.e. it's a no-op). A null preferred scheduling term matches no objects (i.e. is also a no-op).
+                            properties:
+                              preference:
+                                description: A node selector term, associated with
+                                  the corresponding weight.
+                                properties:
+                                  matchExpressions:
+                                    description: A list of node selector requirements
+                                      by node's labels.
+                                    items:
+                                      description: |-
+                                        A node selector requirement is a selector that contains values, a key, and an operator
+                                        that relates the key and values.
+                                      properties:
+                                        key:
+                                          description: The label key that the selector
+                                            applies to.
+                                          type: string
+                                        operator:
+                                          description: |-
+                                            Represents a key's relationship to a set of values.
+                                            Valid operators are In, NotIn, Exists, DoesNotExist. Gt, and Lt.
+                                          type: string
+                                        values:
+                                          description: |-
+                                            An array of string values. If the operator is In or NotIn,
+                                            the values array must be non-empty. If the operator is Exists or DoesNotExist,
+                                            the values array must be empty. If the operator is Gt or Lt, the values
+                                            array must have a single element, which will be interpreted as an integer.
+                                            This array is replaced during a strategic merge patch.
+                                          items:
+                                            type: string
+                                          type: array
+                                          x-kubernetes-list-type: atomic
+                                      required:
+                                      - key
+                                      - operator
+                                      type: object
+                                    type: array
+                                    x-kubernetes-list-type: atomic
+                                  matchFields:
+                                    description: A list of node selector requirements
+                                      by node's fields.
+                                    items:
+                                      description: |-
+                                        A node selector requirement is a selector that contains values, a key, and an operator
+                                        that relates the key and values.
+                                      properties:
+                                        key:
+                                          description: The label key that the selector
+                                            applies to.
+                                          type: string
+                                        operator:
+                                          description: |-
+                                            Represents a key's relationship to a set of values.
+                                            Valid operators are In, NotIn, Exists, DoesNotExist. Gt, and Lt.
+                                          type: string
+                                        values:
+                                          description: |-
+                                            An array of string values. If the operator is In or NotIn,
+                                            the values array must be non-empty. If the operator is Exists or DoesNotExist,
+                                            the values array must be empty. If the operator is Gt or Lt, the values
+                                            array must have a single element, which will be interpreted as an integer.
+                                            This array is replaced during a strategic merge patch.
+                                          items:
+                                            type: string
+                                          type: array
+                                          x-kubernetes-list-type: atomic
+                                      required:
+                                      - key
+                                      - operator
+                                      type: object
+                                    type: array
+                                    x-kubernetes-list-type: atomic
+                                type: object
+                                x-kubernetes-map-type: atomic
+                              weight:
+                                description: Weight associated with matching the corresponding
+                                  nodeSelectorTerm, in the range 1-100.
+                                format: int32
+                                type: integer
+                            required:
+                            - preference
+                            - weight
+                            type: object
+                          type: array
+                          x-kubernetes-list-type: atomic
+                        requiredDuringSchedulingIgnoredDuringExecution:
+                          description: |-
+                            If the affinity requirements specified by this field are not met at
+                            scheduling time, the pod will not be scheduled onto the node.
+                            If the affinity requirements specified by this field cease to be met
+                            at some point during pod execution (e.g. due to an update), the system
+                            may or may not try to eventually evict the pod from its node.
+                          properties:
+                            nodeSelectorTerms:
+                              description: Required. A list of node selector terms.
+                                The terms are ORed.
+                              items:
+                                description: |-
+                                  A null or empty node selector term matches no objects. The requirements of
+                                  them are ANDed.
+                                  The TopologySelectorTerm type implements a subset of the NodeSelectorTerm.
                                 properties:
                                   matchExpressions:
                                     description: A list of node selector requirements
@@ -15781,13 +19677,197 @@ var CRDsValidation map[string]string = map[string]string{
                                     x-kubernetes-list-type: atomic
                                 type: object
                                 x-kubernetes-map-type: atomic
+                              type: array
+                              x-kubernetes-list-type: atomic
+                          required:
+                          - nodeSelectorTerms
+                          type: object
+                          x-kubernetes-map-type: atomic
+                      type: object
+                    podAffinity:
+                      description: Describes pod affinity scheduling rules (e.g. co-locate
+                        this pod in the same node, zone, etc. as some other pod(s)).
+                      properties:
+                        preferredDuringSchedulingIgnoredDuringExecution:
+                          description: |-
+                            The scheduler will prefer to schedule pods to nodes that satisfy
+                            the affinity expressions specified by this field, but it may choose
+                            a node that violates one or more of the expressions. The node that is
+                            most preferred is the one with the greatest sum of weights, i.e.
+                            for each node that meets all of the scheduling requirements (resource
+                            request, requiredDuringScheduling affinity expressions, etc.),
+                            compute a sum by iterating through the elements of this field and adding
+                            "weight" to the sum if the node has pods which matches the corresponding podAffinityTerm; the
+                            node(s) with the highest sum are the most preferred.
+                          items:
+                            description: The weights of all of the matched WeightedPodAffinityTerm
+                              fields are added per-node to find the most preferred
+                              node(s)
+                            properties:
+                              podAffinityTerm:
+                                description: Required. A pod affinity term, associated
+                                  with the corresponding weight.
+                                properties:
+                                  labelSelector:
+                                    description: |-
+                                      A label query over a set of resources, in this case pods.
+                                      If it's null, this PodAffinityTerm matches with no Pods.
+                                    properties:
+                                      matchExpressions:
+                                        description: matchExpressions is a list of
+                                          label selector requirements. The requirements
+                                          are ANDed.
+                                        items:
+                                          description: |-
+                                            A label selector requirement is a selector that contains values, a key, and an operator that
+                                            relates the key and values.
+                                          properties:
+                                            key:
+                                              description: key is the label key that
+                                                the selector applies to.
+                                              type: string
+                                            operator:
+                                              description: |-
+                                                operator represents a key's relationship to a set of values.
+                                                Valid operators are In, NotIn, Exists and DoesNotExist.
+                                              type: string
+                                            values:
+                                              description: |-
+                                                values is an array of string values. If the operator is In or NotIn,
+                                                the values array must be non-empty. If the operator is Exists or DoesNotExist,
+                                                the values array must be empty. This array is replaced during a strategic
+                                                merge patch.
+                                              items:
+                                                type: string
+                                              type: array
+                                              x-kubernetes-list-type: atomic
+                                          required:
+                                          - key
+                                          - operator
+                                          type: object
+                                        type: array
+                                        x-kubernetes-list-type: atomic
+                                      matchLabels:
+                                        additionalProperties:
+                                          type: string
+                                        description: |-
+                                          matchLabels is a map of {key,value} pairs. A single {key,value} in the matchLabels
+                                          map is equivalent to an element of matchExpressions, whose key field is "key", the
+                                          operator is "In", and the values array contains only "value". The requirements are ANDed.
+                                        type: object
+                                    type: object
+                                    x-kubernetes-map-type: atomic
+                                  matchLabelKeys:
+                                    description: |-
+                                      MatchLabelKeys is a set of pod label keys to select which pods will
+                                      be taken into consideration. The keys are used to lookup values from the
+                                      incoming pod labels, those key-value labels are merged with 'labelSelector' as 'key in (value)'
+                                      to select the group of existing pods which pods will be taken into consideration
+                                      for the incoming pod's pod (anti) affinity. Keys that don't exist in the incoming
+                                      pod labels will be ignored. The default value is empty.
+                                      The same key is forbidden to exist in both matchLabelKeys and labelSelector.
+                                      Also, matchLabelKeys cannot be set when labelSelector isn't set.
+                                      This is a beta field and requires enabling MatchLabelKeysInPodAffinity feature gate (enabled by default).
+                                    items:
+                                      type: string
+                                    type: array
+                                    x-kubernetes-list-type: atomic
+                                  mismatchLabelKeys:
+                                    description: |-
+                                      MismatchLabelKeys is a set of pod label keys to select which pods will
+                                      be taken into consideration. The keys are used to lookup values from the
+                                      incoming pod labels, those key-value labels are merged with 'labelSelector' as 'key notin (value)'
+                                      to select the group of existing pods which pods will be taken into consideration
+                                      for the incoming pod's pod (anti) affinity. Keys that don't exist in the incoming
+                                      pod labels will be ignored. The default value is empty.
+                                      The same key is forbidden to exist in both mismatchLabelKeys and labelSelector.
+                                      Also, mismatchLabelKeys cannot be set when labelSelector isn't set.
+                                      This is a beta field and requires enabling MatchLabelKeysInPodAffinity feature gate (enabled by default).
+                                    items:
+                                      type: string
+                                    type: array
+                                    x-kubernetes-list-type: atomic
+                                  namespaceSelector:
+                                    description: |-
+                                      A label query over the set of namespaces that the term applies to.
+                                      The term is applied to the union of the namespaces selected by this field
+                                      and the ones listed in the namespaces field.
+                                      null selector and null or empty namespaces list means "this pod's namespace".
+                                      An empty selector ({}) matches all namespaces.
+                                    properties:
+                                      matchExpressions:
+                                        description: matchExpressions is a list of
+                                          label selector requirements. The requirements
+                                          are ANDed.
+                                        items:
+                                          description: |-
+                                            A label selector requirement is a selector that contains values, a key, and an operator that
+                                            relates the key and values.
+                                          properties:
+                                            key:
+                                              description: key is the label key that
+                                                the selector applies to.
+                                              type: string
+                                            operator:
+                                              description: |-
+                                                operator represents a key's relationship to a set of values.
+                                                Valid operators are In, NotIn, Exists and DoesNotExist.
+                                              type: string
+                                            values:
+                                              description: |-
+                                                values is an array of string values. If the operator is In or NotIn,
+                                                the values array must be non-empty. If the operator is Exists or DoesNotExist,
+                                                the values array must be empty. This array is replaced during a strategic
+                                                merge patch.
+                                              items:
+                                                type: string
+                                              type: array
+                                              x-kubernetes-list-type: atomic
+                                          required:
+                                          - key
+                                          - operator
+                                          type: object
+                                        type: array
+                                        x-kubernetes-list-type: atomic
+                                      matchLabels:
+                                        additionalProperties:
+                                          type: string
+                                        description: |-
+                                          matchLabels is a map of {key,value} pairs. A single {key,value} in the matchLabels
+                                          map is equivalent to an element of matchExpressions, whose key field is "key", the
+                                          operator is "In", and the values array contains only "value". The requirements are ANDed.
+                                        type: object
+                                    type: object
+                                    x-kubernetes-map-type: atomic
+                                  namespaces:
+                                    description: |-
+                                      namespaces specifies a static list of namespace names that the term applies to.
+                                      The term is applied to the union of the namespaces listed in this field
+                                      and the ones selected by namespaceSelector.
+                                      null or empty namespaces list and null namespaceSelector means "this pod's namespace".
+                                    items:
+                                      type: string
+                                    type: array
+                                    x-kubernetes-list-type: atomic
+                                  topologyKey:
+                                    description: |-
+                                      This pod should be co-located (affinity) or not co-located (anti-affinity) with the pods matching
+                                      the labelSelector in the specified namespaces, where co-located is defined as running on a node
+                                      whose value of the label with key topologyKey matches that of any node on which any of the
+                                      selected pods is running.
+                                      Empty topologyKey is not allowed.
+                                    type: string
+                                required:
+                                - topologyKey
+                                type: object
                               weight:
-                                description: Weight associated with matching the corresponding
-                                  nodeSelectorTerm, in the range 1-100.
+                                description: |-
+                                  weight associated with matching the corresponding podAffinityTerm,
+                                  in the range 1-100.
                                 format: int32
                                 type: integer
                             required:
-                            - preference
+                            - podAffinityTerm
                             - weight
                             type: object
                           type: array
@@ -15797,42 +19877,48 @@ var CRDsValidation map[string]string = map[string]string{
                             If the affinity requirements specified by this field are not met at
                             scheduling time, the pod will not be scheduled onto the node.
                             If the affinity requirements specified by this field cease to be met
-                            at some point during pod execution (e.g. due to an update), the system
-                            may or may not try to eventually evict the pod from its node.
-                          properties:
-                            nodeSelectorTerms:
-                              description: Required. A list of node selector terms.
-                                The terms are ORed.
-                              items:
+                            at some point during pod execution (e.g. due to a pod label update), the
+                            system may or may not try to eventually evict the pod from its node.
+                            When there are multiple elements, the lists of nodes corresponding to each
+                            podAffinityTerm are intersected, i.e. all terms must be satisfied.
+                          items:
+                            description: |-
+                              Defines a set of pods (namely those matching the labelSelector
+                              relative to the given namespace(s)) that this pod should be
+                              co-located (affinity) or not co-located (anti-affinity) with,
+                              where co-located is defined as running on a node whose value of
+                              the label with key <topologyKey> matches that of any node on which
+                              a pod of the set of pods is running
+                            properties:
+                              labelSelector:
                                 description: |-
-                                  A null or empty node selector term matches no objects. The requirements of
-                                  them are ANDed.
-                                  The TopologySelectorTerm type implements a subset of the NodeSelectorTerm.
+                                  A label query over a set of resources, in this case pods.
+                                  If it's null, this PodAffinityTerm matches with no Pods.
                                 properties:
                                   matchExpressions:
-                                    description: A list of node selector requirements
-                                      by node's labels.
+                                    description: matchExpressions is a list of label
+                                      selector requirements. The requirements are
+                                      ANDed.
                                     items:
                                       description: |-
-                                        A node selector requirement is a selector that contains values, a key, and an operator
-                                        that relates the key and values.
+                                        A label selector requirement is a selector that contains values, a key, and an operator that
+                                        relates the key and values.
                                       properties:
                                         key:
-                                          description: The label key that the selector
-                                            applies to.
+                                          description: key is the label key that the
+                                            selector applies to.
                                           type: string
                                         operator:
                                           description: |-
-                                            Represents a key's relationship to a set of values.
-                                            Valid operators are In, NotIn, Exists, DoesNotExist. Gt, and Lt.
+                                            operator represents a key's relationship to a set of values.
+                                            Valid operators are In, NotIn, Exists and DoesNotExist.
                                           type: string
                                         values:
                                           description: |-
-                                            An array of string values. If the operator is In or NotIn,
+                                            values is an array of string values. If the operator is In or NotIn,
                                             the values array must be non-empty. If the operator is Exists or DoesNotExist,
-                                            the values array must be empty. If the operator is Gt or Lt, the values
-                                            array must have a single element, which will be interpreted as an integer.
-                                            This array is replaced during a strategic merge patch.
+                                            the values array must be empty. This array is replaced during a strategic
+                                            merge patch.
                                           items:
                                             type: string
                                           type: array
@@ -15843,30 +19929,78 @@ var CRDsValidation map[string]string = map[string]string{
                                       type: object
                                     type: array
                                     x-kubernetes-list-type: atomic
-                                  matchFields:
-                                    description: A list of node selector requirements
-                                      by node's fields.
+                                  matchLabels:
+                                    additionalProperties:
+                                      type: string
+                                    description: |-
+                                      matchLabels is a map of {key,value} pairs. A single {key,value} in the matchLabels
+                                      map is equivalent to an element of matchExpressions, whose key field is "key", the
+                                      operator is "In", and the values array contains only "value". The requirements are ANDed.
+                                    type: object
+                                type: object
+                                x-kubernetes-map-type: atomic
+                              matchLabelKeys:
+                                description: |-
+                                  MatchLabelKeys is a set of pod label keys to select which pods will
+                                  be taken into consideration. The keys are used to lookup values from the
+                                  incoming pod labels, those key-value labels are merged with 'labelSelector' as 'key in (value)'
+                                  to select the group of existing pods which pods will be taken into consideration
+                                  for the incoming pod's pod (anti) affinity. Keys that don't exist in the incoming
+                                  pod labels will be ignored. The default value is empty.
+                                  The same key is forbidden to exist in both matchLabelKeys and labelSelector.
+                                  Also, matchLabelKeys cannot be set when labelSelector isn't set.
+                                  This is a beta field and requires enabling MatchLabelKeysInPodAffinity feature gate (enabled by default).
+                                items:
+                                  type: string
+                                type: array
+                                x-kubernetes-list-type: atomic
+                              mismatchLabelKeys:
+                                description: |-
+                                  MismatchLabelKeys is a set of pod label keys to select which pods will
+                                  be taken into consideration. The keys are used to lookup values from the
+                                  incoming pod labels, those key-value labels are merged with 'labelSelector' as 'key notin (value)'
+                                  to select the group of existing pods which pods will be taken into consideration
+                                  for the incoming pod's pod (anti) affinity. Keys that don't exist in the incoming
+                                  pod labels will be ignored. The default value is empty.
+                                  The same key is forbidden to exist in both mismatchLabelKeys and labelSelector.
+                                  Also, mismatchLabelKeys cannot be set when labelSelector isn't set.
+                                  This is a beta field and requires enabling MatchLabelKeysInPodAffinity feature gate (enabled by default).
+                                items:
+                                  type: string
+                                type: array
+                                x-kubernetes-list-type: atomic
+                              namespaceSelector:
+                                description: |-
+                                  A label query over the set of namespaces that the term applies to.
+                                  The term is applied to the union of the namespaces selected by this field
+                                  and the ones listed in the namespaces field.
+                                  null selector and null or empty namespaces list means "this pod's namespace".
+                                  An empty selector ({}) matches all namespaces.
+                                properties:
+                                  matchExpressions:
+                                    description: matchExpressions is a list of label
+                                      selector requirements. The requirements are
+                                      ANDed.
                                     items:
                                       description: |-
-                                        A node selector requirement is a selector that contains values, a key, and an operator
-                                        that relates the key and values.
+                                        A label selector requirement is a selector that contains values, a key, and an operator that
+                                        relates the key and values.
                                       properties:
                                         key:
-                                          description: The label key that the selector
-                                            applies to.
+                                          description: key is the label key that the
+                                            selector applies to.
                                           type: string
                                         operator:
                                           description: |-
-                                            Represents a key's relationship to a set of values.
-                                            Valid operators are In, NotIn, Exists, DoesNotExist. Gt, and Lt.
+                                            operator represents a key's relationship to a set of values.
+                                            Valid operators are In, NotIn, Exists and DoesNotExist.
                                           type: string
                                         values:
                                           description: |-
-                                            An array of string values. If the operator is In or NotIn,
+                                            values is an array of string values. If the operator is In or NotIn,
                                             the values array must be non-empty. If the operator is Exists or DoesNotExist,
-                                            the values array must be empty. If the operator is Gt or Lt, the values
-                                            array must have a single element, which will be interpreted as an integer.
-                                            This array is replaced during a strategic merge patch.
+                                            the values array must be empty. This array is replaced during a strategic
+                                            merge patch.
                                           items:
                                             type: string
                                           type: array
@@ -15877,27 +20011,53 @@ var CRDsValidation map[string]string = map[string]string{
                                       type: object
                                     type: array
                                     x-kubernetes-list-type: atomic
+                                  matchLabels:
+                                    additionalProperties:
+                                      type: string
+                                    description: |-
+                                      matchLabels is a map of {key,value} pairs. A single {key,value} in the matchLabels
+                                      map is equivalent to an element of matchExpressions, whose key field is "key", the
+                                      operator is "In", and the values array contains only "value". The requirements are ANDed.
+                                    type: object
                                 type: object
                                 x-kubernetes-map-type: atomic
-                              type: array
-                              x-kubernetes-list-type: atomic
-                          required:
-                          - nodeSelectorTerms
-                          type: object
-                          x-kubernetes-map-type: atomic
+                              namespaces:
+                                description: |-
+                                  namespaces specifies a static list of namespace names that the term applies to.
+                                  The term is applied to the union of the namespaces listed in this field
+                                  and the ones selected by namespaceSelector.
+                                  null or empty namespaces list and null namespaceSelector means "this pod's namespace".
+                                items:
+                                  type: string
+                                type: array
+                                x-kubernetes-list-type: atomic
+                              topologyKey:
+                                description: |-
+                                  This pod should be co-located (affinity) or not co-located (anti-affinity) with the pods matching
+                                  the labelSelector in the specified namespaces, where co-located is defined as running on a node
+                                  whose value of the label with key topologyKey matches that of any node on which any of the
+                                  selected pods is running.
+                                  Empty topologyKey is not allowed.
+                                type: string
+                            required:
+                            - topologyKey
+                            type: object
+                          type: array
+                          x-kubernetes-list-type: atomic
                       type: object
-                    podAffinity:
-                      description: Describes pod affinity scheduling rules (e.g. co-locate
-                        this pod in the same node, zone, etc. as some other pod(s)).
+                    podAntiAffinity:
+                      description: Describes pod anti-affinity scheduling rules (e.g.
+                        avoid putting this pod in the same node, zone, etc. as some
+                        other pod(s)).
                       properties:
                         preferredDuringSchedulingIgnoredDuringExecution:
                           description: |-
                             The scheduler will prefer to schedule pods to nodes that satisfy
-                            the affinity expressions specified by this field, but it may choose
+                            the anti-affinity expressions specified by this field, but it may choose
                             a node that violates one or more of the expressions. The node that is
                             most preferred is the one with the greatest sum of weights, i.e.
                             for each node that meets all of the scheduling requirements (resource
-                            request, requiredDuringScheduling affinity expressions, etc.),
+                            request, requiredDuringScheduling anti-affinity expressions, etc.),
                             compute a sum by iterating through the elements of this field and adding
                             "weight" to the sum if the node has pods which matches the corresponding podAffinityTerm; the
                             node(s) with the highest sum are the most preferred.
@@ -16048,2263 +20208,3062 @@ var CRDsValidation map[string]string = map[string]string{
                                       and the ones selected by namespaceSelector.
                                       null or empty namespaces list and null namespaceSelector means "this pod's namespace".
                                     items:
-                                      type: string
+                                      type: string
+                                    type: array
+                                    x-kubernetes-list-type: atomic
+                                  topologyKey:
+                                    description: |-
+                                      This pod should be co-located (affinity) or not co-located (anti-affinity) with the pods matching
+                                      the labelSelector in the specified namespaces, where co-located is defined as running on a node
+                                      whose value of the label with key topologyKey matches that of any node on which any of the
+                                      selected pods is running.
+                                      Empty topologyKey is not allowed.
+                                    type: string
+                                required:
+                                - topologyKey
+                                type: object
+                              weight:
+                                description: |-
+                                  weight associated with matching the corresponding podAffinityTerm,
+                                  in the range 1-100.
+                                format: int32
+                                type: integer
+                            required:
+                            - podAffinityTerm
+                            - weight
+                            type: object
+                          type: array
+                          x-kubernetes-list-type: atomic
+                        requiredDuringSchedulingIgnoredDuringExecution:
+                          description: |-
+                            If the anti-affinity requirements specified by this field are not met at
+                            scheduling time, the pod will not be scheduled onto the node.
+                            If the anti-affinity requirements specified by this field cease to be met
+                            at some point during pod execution (e.g. due to a pod label update), the
+                            system may or may not try to eventually evict the pod from its node.
+                            When there are multiple elements, the lists of nodes corresponding to each
+                            podAffinityTerm are intersected, i.e. all terms must be satisfied.
+                          items:
+                            description: |-
+                              Defines a set of pods (namely those matching the labelSelector
+                              relative to the given namespace(s)) that this pod should be
+                              co-located (affinity) or not co-located (anti-affinity) with,
+                              where co-located is defined as running on a node whose value of
+                              the label with key <topologyKey> matches that of any node on which
+                              a pod of the set of pods is running
+                            properties:
+                              labelSelector:
+                                description: |-
+                                  A label query over a set of resources, in this case pods.
+                                  If it's null, this PodAffinityTerm matches with no Pods.
+                                properties:
+                                  matchExpressions:
+                                    description: matchExpressions is a list of label
+                                      selector requirements. The requirements are
+                                      ANDed.
+                                    items:
+                                      description: |-
+                                        A label selector requirement is a selector that contains values, a key, and an operator that
+                                        relates the key and values.
+                                      properties:
+                                        key:
+                                          description: key is the label key that the
+                                            selector applies to.
+                                          type: string
+                                        operator:
+                                          description: |-
+                                            operator represents a key's relationship to a set of values.
+                                            Valid operators are In, NotIn, Exists and DoesNotExist.
+                                          type: string
+                                        values:
+                                          description: |-
+                                            values is an array of string values. If the operator is In or NotIn,
+                                            the values array must be non-empty. If the operator is Exists or DoesNotExist,
+                                            the values array must be empty. This array is replaced during a strategic
+                                            merge patch.
+                                          items:
+                                            type: string
+                                          type: array
+                                          x-kubernetes-list-type: atomic
+                                      required:
+                                      - key
+                                      - operator
+                                      type: object
+                                    type: array
+                                    x-kubernetes-list-type: atomic
+                                  matchLabels:
+                                    additionalProperties:
+                                      type: string
+                                    description: |-
+                                      matchLabels is a map of {key,value} pairs. A single {key,value} in the matchLabels
+                                      map is equivalent to an element of matchExpressions, whose key field is "key", the
+                                      operator is "In", and the values array contains only "value". The requirements are ANDed.
+                                    type: object
+                                type: object
+                                x-kubernetes-map-type: atomic
+                              matchLabelKeys:
+                                description: |-
+                                  MatchLabelKeys is a set of pod label keys to select which pods will
+                                  be taken into consideration. The keys are used to lookup values from the
+                                  incoming pod labels, those key-value labels are merged with 'labelSelector' as 'key in (value)'
+                                  to select the group of existing pods which pods will be taken into consideration
+                                  for the incoming pod's pod (anti) affinity. Keys that don't exist in the incoming
+                                  pod labels will be ignored. The default value is empty.
+                                  The same key is forbidden to exist in both matchLabelKeys and labelSelector.
+                                  Also, matchLabelKeys cannot be set when labelSelector isn't set.
+                                  This is a beta field and requires enabling MatchLabelKeysInPodAffinity feature gate (enabled by default).
+                                items:
+                                  type: string
+                                type: array
+                                x-kubernetes-list-type: atomic
+                              mismatchLabelKeys:
+                                description: |-
+                                  MismatchLabelKeys is a set of pod label keys to select which pods will
+                                  be taken into consideration. The keys are used to lookup values from the
+                                  incoming pod labels, those key-value labels are merged with 'labelSelector' as 'key notin (value)'
+                                  to select the group of existing pods which pods will be taken into consideration
+                                  for the incoming pod's pod (anti) affinity. Keys that don't exist in the incoming
+                                  pod labels will be ignored. The default value is empty.
+                                  The same key is forbidden to exist in both mismatchLabelKeys and labelSelector.
+                                  Also, mismatchLabelKeys cannot be set when labelSelector isn't set.
+                                  This is a beta field and requires enabling MatchLabelKeysInPodAffinity feature gate (enabled by default).
+                                items:
+                                  type: string
+                                type: array
+                                x-kubernetes-list-type: atomic
+                              namespaceSelector:
+                                description: |-
+                                  A label query over the set of namespaces that the term applies to.
+                                  The term is applied to the union of the namespaces selected by this field
+                                  and the ones listed in the namespaces field.
+                                  null selector and null or empty namespaces list means "this pod's namespace".
+                                  An empty selector ({}) matches all namespaces.
+                                properties:
+                                  matchExpressions:
+                                    description: matchExpressions is a list of label
+                                      selector requirements. The requirements are
+                                      ANDed.
+                                    items:
+                                      description: |-
+                                        A label selector requirement is a selector that contains values, a key, and an operator that
+                                        relates the key and values.
+                                      properties:
+                                        key:
+                                          description: key is the label key that the
+                                            selector applies to.
+                                          type: string
+                                        operator:
+                                          description: |-
+                                            operator represents a key's relationship to a set of values.
+                                            Valid operators are In, NotIn, Exists and DoesNotExist.
+                                          type: string
+                                        values:
+                                          description: |-
+                                            values is an array of string values. If the operator is In or NotIn,
+                                            the values array must be non-empty. If the operator is Exists or DoesNotExist,
+                                            the values array must be empty. This array is replaced during a strategic
+                                            merge patch.
+                                          items:
+                                            type: string
+                                          type: array
+                                          x-kubernetes-list-type: atomic
+                                      required:
+                                      - key
+                                      - operator
+                                      type: object
                                     type: array
                                     x-kubernetes-list-type: atomic
-                                  topologyKey:
+                                  matchLabels:
+                                    additionalProperties:
+                                      type: string
                                     description: |-
-                                      This pod should be co-located (affinity) or not co-located (anti-affinity) with the pods matching
-                                      the labelSelector in the specified namespaces, where co-located is defined as running on a node
-                                      whose value of the label with key topologyKey matches that of any node on which any of the
-                                      selected pods is running.
-                                      Empty topologyKey is not allowed.
-                                    type: string
-                                required:
-                                - topologyKey
+                                      matchLabels is a map of {key,value} pairs. A single {key,value} in the matchLabels
+                                      map is equivalent to an element of matchExpressions, whose key field is "key", the
+                                      operator is "In", and the values array contains only "value". The requirements are ANDed.
+                                    type: object
                                 type: object
-                              weight:
+                                x-kubernetes-map-type: atomic
+                              namespaces:
+                                description: |-
+                                  namespaces specifies a static list of namespace names that the term applies to.
+                                  The term is applied to the union of the namespaces listed in this field
+                                  and the ones selected by namespaceSelector.
+                                  null or empty namespaces list and null namespaceSelector means "this pod's namespace".
+                                items:
+                                  type: string
+                                type: array
+                                x-kubernetes-list-type: atomic
+                              topologyKey:
+                                description: |-
+                                  This pod should be co-located (affinity) or not co-located (anti-affinity) with the pods matching
+                                  the labelSelector in the specified namespaces, where co-located is defined as running on a node
+                                  whose value of the label with key topologyKey matches that of any node on which any of the
+                                  selected pods is running.
+                                  Empty topologyKey is not allowed.
+                                type: string
+                            required:
+                            - topologyKey
+                            type: object
+                          type: array
+                          x-kubernetes-list-type: atomic
+                      type: object
+                  type: object
+                architecture:
+                  description: Specifies the architecture of the vm guest you are
+                    attempting to run. Defaults to the compiled architecture of the
+                    KubeVirt components
+                  type: string
+                dnsConfig:
+                  description: |-
+                    Specifies the DNS parameters of a pod.
+                    Parameters specified here will be merged to the generated DNS
+                    configuration based on DNSPolicy.
+                  properties:
+                    nameservers:
+                      description: |-
+                        A list of DNS name server IP addresses.
+                        This will be appended to the base nameservers generated from DNSPolicy.
+                        Duplicated nameservers will be removed.
+                      items:
+                        type: string
+                      type: array
+                      x-kubernetes-list-type: atomic
+                    options:
+                      description: |-
+                        A list of DNS resolver options.
+                        This will be merged with the base options generated from DNSPolicy.
+                        Duplicated entries will be removed. Resolution options given in Options
+                        will override those that appear in the base DNSPolicy.
+                      items:
+                        description: PodDNSConfigOption defines DNS resolver options
+                          of a pod.
+                        properties:
+                          name:
+                            description: Required.
+                            type: string
+                          value:
+                            type: string
+                        type: object
+                      type: array
+                      x-kubernetes-list-type: atomic
+                    searches:
+                      description: |-
+                        A list of DNS search domains for host-name lookup.
+                        This will be appended to the base search paths generated from DNSPolicy.
+                        Duplicated search paths will be removed.
+                      items:
+                        type: string
+                      type: array
+                      x-kubernetes-list-type: atomic
+                  type: object
+                dnsPolicy:
+                  description: |-
+                    Set DNS policy for the pod.
+                    Defaults to "ClusterFirst".
+                    Valid values are 'ClusterFirstWithHostNet', 'ClusterFirst', 'Default' or 'None'.
+                    DNS parameters given in DNSConfig will be merged with the policy selected with DNSPolicy.
+                    To have DNS options set along with hostNetwork, you have to specify DNS policy
+                    explicitly to 'ClusterFirstWithHostNet'.
+                  type: string
+                domain:
+                  description: Specification of the desired behavior of the VirtualMachineInstance
+                    on the host.
+                  properties:
+                    chassis:
+                      description: Chassis specifies the chassis info passed to the
+                        domain.
+                      properties:
+                        asset:
+                          type: string
+                        manufacturer:
+                          type: string
+                        serial:
+                          type: string
+                        sku:
+                          type: string
+                        version:
+                          type: string
+                      type: object
+                    clock:
+                      description: Clock sets the clock and timers of the vmi.
+                      properties:
+                        guestTimeSync:
+                          description: GuestTimeSync configures when the guest clock
+                            is set to the host time through the guest agent.
+                          properties:
+                            driftThresholdSeconds:
+                              description: |-
+                                DriftThresholdSeconds is the drift of the guest clock above which it is set to the host time
+                                with the "OnDrift" policy.
+                                Defaults to 2.
+                              format: int32
+                              type: integer
+                            policy:
+                              description: |-
+                                Policy selects when the guest clock is set to the host time.
+                                "OnResume" sets it after a live migration and when the vmi is unpaused.
+                                "OnDrift" additionally sets it whenever the guest clock drifts away from the host clock by more than
+                                the drift threshold, e.g. after the node resumed from suspend.
+                                "Disabled" never sets it, leaving the guest clock to the time synchronization of the guest.
+                                Defaults to "OnResume".
+                              type: string
+                          type: object
+                        timer:
+                          description: Timer specifies whih timers are attached to
+                            the vmi.
+                          properties:
+                            hpet:
+                              description: HPET (High Precision Event Timer) - multiple
+                                timers with periodic interrupts.
+                              properties:
+                                present:
+                                  description: |-
+                                    Enabled set to false makes sure that the machine type or a preset can't add the timer.
+                                    Defaults to true.
+                                  type: boolean
+                                tickPolicy:
+                                  description: |-
+                                    TickPolicy determines what happens when QEMU misses a deadline for injecting a tick to the guest.
+                                    One of "delay", "catchup", "merge", "discard".
+                                  type: string
+                              type: object
+                            hyperv:
+                              description: Hyperv (Hypervclock) - lets guests read
+                                the host’s wall clock time (paravirtualized). For
+                                windows guests.
+                              properties:
+                                present:
+                                  description: |-
+                                    Enabled set to false makes sure that the machine type or a preset can't add the timer.
+                                    Defaults to true.
+                                  type: boolean
+                              type: object
+                            kvm:
+                              description: "KVM \t(KVM clock) - lets guests read the
+                                host’s wall clock time (paravirtualized). For linux
+                                guests."
+                              properties:
+                                present:
+                                  description: |-
+                                    Enabled set to false makes sure that the machine type or a preset can't add the timer.
+                                    Defaults to true.
+                                  type: boolean
+                              type: object
+                            pit:
+                              description: PIT (Programmable Interval Timer) - a timer
+                                with periodic interrupts.
+                              properties:
+                                present:
+                                  description: |-
+                                    Enabled set to false makes sure that the machine type or a preset can't add the timer.
+                                    Defaults to true.
+                                  type: boolean
+                                tickPolicy:
+                                  description: |-
+                                    TickPolicy determines what happens when QEMU misses a deadline for injecting a tick to the guest.
+                                    One of "delay", "catchup", "discard".
+                                  type: string
+                              type: object
+                            rtc:
+                              description: RTC (Real Time Clock) - a continuously
+                                running timer with periodic interrupts.
+                              properties:
+                                present:
+                                  description: |-
+                                    Enabled set to false makes sure that the machine type or a preset can't add the timer.
+                                    Defaults to true.
+                                  type: boolean
+                                tickPolicy:
+                                  description: |-
+                                    TickPolicy determines what happens when QEMU misses a deadline for injecting a tick to the guest.
+                                    One of "delay", "catchup".
+                                  type: string
+                                track:
+                                  description: Track the guest or the wall clock.
+                                  type: string
+                              type: object
+                          type: object
+                        timezone:
+                          description: |-
+                            Timezone sets the guest clock to the specified timezone.
+                            Zone name follows the TZ environment variable format (e.g. 'America/New_York').
+                          type: string
+                        utc:
+                          description: |-
+                            UTC sets the guest clock to UTC on each boot. If an offset is specified,
+                            guest changes to the clock will be kept during reboots and are not reset.
+                          properties:
+                            offsetSeconds:
+                              description: |-
+                                OffsetSeconds specifies an offset in seconds, relative to UTC. If set,
+                                guest changes to the clock will be kept during reboots and not reset.
+                              type: integer
+                          type: object
+                      type: object
+                      x-kubernetes-preserve-unknown-fields: true
+                    cpu:
+                      description: CPU allow specified the detailed CPU topology inside
+                        the vmi.
+                      properties:
+                        cores:
+                          description: |-
+                            Cores specifies the number of cores inside the vmi.
+                            Must be a value greater or equal 1.
+                          format: int32
+                          type: integer
+                        dedicatedCpuPlacement:
+                          description: |-
+                            DedicatedCPUPlacement requests the scheduler to place the VirtualMachineInstance on a node
+                            with enough dedicated pCPUs and pin the vCPUs to it.
+                          type: boolean
+                        features:
+                          description: Features specifies the CPU features list inside
+                            the VMI.
+                          items:
+                            description: CPUFeature allows specifying a CPU feature.
+                            properties:
+                              name:
+                                description: Name of the CPU feature
+                                type: string
+                              policy:
                                 description: |-
-                                  weight associated with matching the corresponding podAffinityTerm,
-                                  in the range 1-100.
-                                format: int32
-                                type: integer
+                                  Policy is the CPU feature attribute which can have the following attributes:
+                                  force    - The virtual CPU will claim the feature is supported regardless of it being supported by host CPU.
+                                  require  - Guest creation will fail unless the feature is supported by the host CPU or the hypervisor is able to emulate it.
+                                  optional - The feature will be supported by virtual CPU if and only if it is supported by host CPU.
+                                  disable  - The feature will not be supported by virtual CPU.
+                                  forbid   - Guest creation will fail if the feature is supported by host CPU.
+                                  Defaults to require
+                                type: string
                             required:
-                            - podAffinityTerm
-                            - weight
+                            - name
                             type: object
                           type: array
-                          x-kubernetes-list-type: atomic
-                        requiredDuringSchedulingIgnoredDuringExecution:
+                        housekeeping:
                           description: |-
-                            If the affinity requirements specified by this field are not met at
-                            scheduling time, the pod will not be scheduled onto the node.
-                            If the affinity requirements specified by this field cease to be met
-                            at some point during pod execution (e.g. due to a pod label update), the
-                            system may or may not try to eventually evict the pod from its node.
-                            When there are multiple elements, the lists of nodes corresponding to each
-                            podAffinityTerm are intersected, i.e. all terms must be satisfied.
+                            Housekeeping gives the QEMU threads which do not run vCPUs, like the emulator and IOThreads,
+                            their own CPU budget in a child cgroup of the compute container, so they don't take CPU time
+                            from the vCPUs. Can not be combined with DedicatedCPUPlacement, use IsolateEmulatorThread instead.
+                          properties:
+                            cpu:
+                              anyOf:
+                              - type: integer
+                              - type: string
+                              description: |-
+                                CPU is added to the CPU requests of the compute container, and to its CPU limits if they are set.
+                                The housekeeping threads are limited to this amount of CPU, the rest is left to the vCPUs.
+                              pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                              x-kubernetes-int-or-string: true
+                          required:
+                          - cpu
+                          type: object
+                        isolateEmulatorThread:
+                          description: |-
+                            IsolateEmulatorThread requests one more dedicated pCPU to be allocated for the VMI to place
+                            the emulator thread on it.
+                          type: boolean
+                        maxSockets:
+                          description: |-
+                            MaxSockets specifies the maximum amount of sockets that can
+                            be hotplugged
+                          format: int32
+                          type: integer
+                        mitigatedVulnerabilities:
+                          description: |-
+                            MitigatedVulnerabilities lists the CPU vulnerabilities, as named by the kernel in /sys/devices/system/cpu/vulnerabilities,
+                            e.g. retbleed or mds, the node of the VMI has to be mitigated against or not affected by.
+                          items:
+                            type: string
+                          type: array
+                          x-kubernetes-list-type: set
+                        model:
+                          description: |-
+                            Model specifies the CPU model inside the VMI.
+                            List of available models https://github.com/libvirt/libvirt/tree/master/src/cpu_map.
+                            It is possible to specify special cases like "host-passthrough" to get the same CPU as the node
+                            and "host-model" to get CPU closest to the node one.
+                            "cluster-common" selects the CPU model and features common to the nodes matching the node selector of the VMI.
+                            Defaults to host-model.
+                          type: string
+                        numa:
+                          description: NUMA allows specifying settings for the guest
+                            NUMA topology
+                          properties:
+                            guestMappingPassthrough:
+                              description: |-
+                                GuestMappingPassthrough will create an efficient guest topology based on host CPUs exclusively assigned to a pod.
+                                The created topology ensures that memory and CPUs on the virtual numa nodes never cross boundaries of host numa nodes.
+                              type: object
+                          type: object
+                        realtime:
+                          description: Realtime instructs the virt-launcher to tune
+                            the VMI for lower latency, optional for real time workloads
+                          properties:
+                            mask:
+                              description: |-
+                                Mask defines the vcpu mask expression that defines which vcpus are used for realtime. Format matches libvirt's expressions.
+                                Example: "0-3,^1","0,2,3","2-3"
+                              type: string
+                          type: object
+                        sockets:
+                          description: |-
+                            Sockets specifies the number of sockets inside the vmi.
+                            Must be a value greater or equal 1.
+                          format: int32
+                          type: integer
+                        threadPlacement:
+                          description: |-
+                            ThreadPlacement defines on which pCPUs the emulator thread and the IOThreads run.
+                            Requires DedicatedCPUPlacement.
+                          properties:
+                            emulatorThread:
+                              description: |-
+                                EmulatorThread defines where the emulator thread runs. With shared it runs on the pCPUs of the vCPUs,
+                                dedicated and sameNUMANode isolate it on an additional pCPU, like IsolateEmulatorThread does.
+                                Defaults to dedicated if IsolateEmulatorThread is set and to shared otherwise.
+                              type: string
+                            ioThreads:
+                              description: |-
+                                IOThreads defines where the IOThreads run. With shared they run on the pCPU of the isolated emulator
+                                thread, or on the pCPUs of the vCPUs if the emulator thread is not isolated. With dedicated and
+                                sameNUMANode all IOThreads run together on an additional pCPU. Defaults to shared.
+                              type: string
+                          type: object
+                        threads:
+                          description: |-
+                            Threads specifies the number of threads inside the vmi.
+                            Must be a value greater or equal 1.
+                          format: int32
+                          type: integer
+                      type: object
+                    devices:
+                      description: Devices allows adding disks, network interfaces,
+                        and others
+                      properties:
+                        autoattachGraphicsDevice:
+                          description: |-
+                            Whether to attach the default graphics device or not.
+                            VNC will not be available if set to false. Defaults to true.
+                          type: boolean
+                        autoattachInputDevice:
+                          description: |-
+                            Whether to attach an Input Device.
+                            Defaults to false.
+                          type: boolean
+                        autoattachMemBalloon:
+                          description: |-
+                            Whether to attach the Memory balloon device with default period.
+                            Period can be adjusted in virt-config.
+                            Defaults to true.
+                          type: boolean
+                        autoattachPodInterface:
+                          description: Whether to attach a pod network interface.
+                            Defaults to true.
+                          type: boolean
+                        autoattachSerialConsole:
+                          description: |-
+                            Whether to attach the default virtio-serial console or not.
+                            Serial console access will not be available if set to false. Defaults to true.
+                          type: boolean
+                        autoattachVSOCK:
+                          description: |-
+                            Whether to attach the VSOCK CID to the VM or not.
+                            VSOCK access will be available if set to true. Defaults to false.
+                          type: boolean
+                        blockMultiQueue:
+                          description: |-
+                            Whether or not to enable virtio multi-queue for block devices.
+                            Defaults to false.
+                          type: boolean
+                        clientPassthrough:
+                          description: To configure and access client devices such
+                            as redirecting USB
+                          type: object
+                        disableHotplug:
+                          description: DisableHotplug disabled the ability to hotplug
+                            disks.
+                          type: boolean
+                        disks:
+                          description: Disks describes disks, cdroms and luns which
+                            are connected to the vmi.
                           items:
-                            description: |-
-                              Defines a set of pods (namely those matching the labelSelector
-                              relative to the given namespace(s)) that this pod should be
-                              co-located (affinity) or not co-located (anti-affinity) with,
-                              where co-located is defined as running on a node whose value of
-                              the label with key <topologyKey> matches that of any node on which
-                              a pod of the set of pods is running
                             properties:
-                              labelSelector:
-                                description: |-
-                                  A label query over a set of resources, in this case pods.
-                                  If it's null, this PodAffinityTerm matches with no Pods.
-                                properties:
-                                  matchExpressions:
-                                    description: matchExpressions is a list of label
-                                      selector requirements. The requirements are
-                                      ANDed.
-                                    items:
-                                      description: |-
-                                        A label selector requirement is a selector that contains values, a key, and an operator that
-                                        relates the key and values.
-                                      properties:
-                                        key:
-                                          description: key is the label key that the
-                                            selector applies to.
-                                          type: string
-                                        operator:
-                                          description: |-
-                                            operator represents a key's relationship to a set of values.
-                                            Valid operators are In, NotIn, Exists and DoesNotExist.
-                                          type: string
-                                        values:
-                                          description: |-
-                                            values is an array of string values. If the operator is In or NotIn,
-                                            the values array must be non-empty. If the operator is Exists or DoesNotExist,
-                                            the values array must be empty. This array is replaced during a strategic
-                                            merge patch.
-                                          items:
-                                            type: string
-                                          type: array
-                                          x-kubernetes-list-type: atomic
-                                      required:
-                                      - key
-                                      - operator
-                                      type: object
-                                    type: array
-                                    x-kubernetes-list-type: atomic
-                                  matchLabels:
-                                    additionalProperties:
-                                      type: string
-                                    description: |-
-                                      matchLabels is a map of {key,value} pairs. A single {key,value} in the matchLabels
-                                      map is equivalent to an element of matchExpressions, whose key field is "key", the
-                                      operator is "In", and the values array contains only "value". The requirements are ANDed.
+                              blockSize:
+                                description: If specified, the virtual disk will be
+                                  presented with the given block sizes.
+                                properties:
+                                  custom:
+                                    description: CustomBlockSize represents the desired
+                                      logical and physical block size for a VM disk.
+                                    properties:
+                                      logical:
+                                        type: integer
+                                      physical:
+                                        type: integer
+                                    required:
+                                    - logical
+                                    - physical
+                                    type: object
+                                  matchVolume:
+                                    description: Represents if a feature is enabled
+                                      or disabled.
+                                    properties:
+                                      enabled:
+                                        description: |-
+                                          Enabled determines if the feature should be enabled or disabled on the guest.
+                                          Defaults to true.
+                                        type: boolean
                                     type: object
                                 type: object
-                                x-kubernetes-map-type: atomic
-                              matchLabelKeys:
+                              bootOrder:
                                 description: |-
-                                  MatchLabelKeys is a set of pod label keys to select which pods will
-                                  be taken into consideration. The keys are used to lookup values from the
-                                  incoming pod labels, those key-value labels are merged with 'labelSelector' as 'key in (value)'
-                                  to select the group of existing pods which pods will be taken into consideration
-                                  for the incoming pod's pod (anti) affinity. Keys that don't exist in the incoming
-                                  pod labels will be ignored. The default value is empty.
-                                  The same key is forbidden to exist in both matchLabelKeys and labelSelector.
-                                  Also, matchLabelKeys cannot be set when labelSelector isn't set.
-                                  This is a beta field and requires enabling MatchLabelKeysInPodAffinity feature gate (enabled by default).
-                                items:
-                                  type: string
-                                type: array
-                                x-kubernetes-list-type: atomic
-                              mismatchLabelKeys:
+                                  BootOrder is an integer value > 0, used to determine ordering of boot devices.
+                                  Lower values take precedence.
+                                  Each disk or interface that has a boot order must have a unique value.
+                                  Disks without a boot order are not tried if a disk with a boot order exists.
+                                type: integer
+                              cache:
                                 description: |-
-                                  MismatchLabelKeys is a set of pod label keys to select which pods will
-                                  be taken into consideration. The keys are used to lookup values from the
-                                  incoming pod labels, those key-value labels are merged with 'labelSelector' as 'key notin (value)'
-                                  to select the group of existing pods which pods will be taken into consideration
-                                  for the incoming pod's pod (anti) affinity. Keys that don't exist in the incoming
-                                  pod labels will be ignored. The default value is empty.
-                                  The same key is forbidden to exist in both mismatchLabelKeys and labelSelector.
-                                  Also, mismatchLabelKeys cannot be set when labelSelector isn't set.
-                                  This is a beta field and requires enabling MatchLabelKeysInPodAffinity feature gate (enabled by default).
-                                items:
-                                  type: string
-                                type: array
-                                x-kubernetes-list-type: atomic
-                              namespaceSelector:
+                                  Cache specifies which kvm disk cache mode should be used.
+                                  Supported values are: CacheNone, CacheWriteThrough.
+                                type: string
+                              cdrom:
+                                description: Attach a volume as a cdrom to the vmi.
+                                properties:
+                                  bus:
+                                    description: |-
+                                      Bus indicates the type of disk device to emulate.
+                                      supported values: virtio, sata, scsi.
+                                    type: string
+                                  readonly:
+                                    description: |-
+                                      ReadOnly.
+                                      Defaults to true.
+                                    type: boolean
+                                  tray:
+                                    description: |-
+                                      Tray indicates if the tray of the device is open or closed.
+                                      Allowed values are "open" and "closed".
+                                      Defaults to closed.
+                                    type: string
+                                type: object
+                              dedicatedIOThread:
                                 description: |-
-                                  A label query over the set of namespaces that the term applies to.
-                                  The term is applied to the union of the namespaces selected by this field
-                                  and the ones listed in the namespaces field.
-                                  null selector and null or empty namespaces list means "this pod's namespace".
-                                  An empty selector ({}) matches all namespaces.
+                                  dedicatedIOThread indicates this disk should have an exclusive IO Thread.
+                                  Enabling this implies useIOThreads = true.
+                                  Defaults to false.
+                                type: boolean
+                              disk:
+                                description: Attach a volume as a disk to the vmi.
                                 properties:
-                                  matchExpressions:
-                                    description: matchExpressions is a list of label
-                                      selector requirements. The requirements are
-                                      ANDed.
-                                    items:
-                                      description: |-
-                                        A label selector requirement is a selector that contains values, a key, and an operator that
-                                        relates the key and values.
-                                      properties:
-                                        key:
-                                          description: key is the label key that the
-                                            selector applies to.
-                                          type: string
-                                        operator:
-                                          description: |-
-                                            operator represents a key's relationship to a set of values.
-                                            Valid operators are In, NotIn, Exists and DoesNotExist.
-                                          type: string
-                                        values:
-                                          description: |-
-                                            values is an array of string values. If the operator is In or NotIn,
-                                            the values array must be non-empty. If the operator is Exists or DoesNotExist,
-                                            the values array must be empty. This array is replaced during a strategic
-                                            merge patch.
-                                          items:
-                                            type: string
-                                          type: array
-                                          x-kubernetes-list-type: atomic
-                                      required:
-                                      - key
-                                      - operator
-                                      type: object
-                                    type: array
-                                    x-kubernetes-list-type: atomic
-                                  matchLabels:
-                                    additionalProperties:
-                                      type: string
+                                  bus:
                                     description: |-
-                                      matchLabels is a map of {key,value} pairs. A single {key,value} in the matchLabels
-                                      map is equivalent to an element of matchExpressions, whose key field is "key", the
-                                      operator is "In", and the values array contains only "value". The requirements are ANDed.
-                                    type: object
+                                      Bus indicates the type of disk device to emulate.
+                                      supported values: virtio, sata, scsi, usb.
+                                    type: string
+                                  pciAddress:
+                                    description: 'If specified, the virtual disk will
+                                      be placed on the guests pci address with the
+                                      specified PCI address. For example: 0000:81:01.10'
+                                    type: string
+                                  readonly:
+                                    description: |-
+                                      ReadOnly.
+                                      Defaults to false.
+                                    type: boolean
                                 type: object
-                                x-kubernetes-map-type: atomic
-                              namespaces:
+                              errorPolicy:
+                                description: If specified, it can change the default
+                                  error policy (stop) for the disk
+                                type: string
+                              io:
                                 description: |-
-                                  namespaces specifies a static list of namespace names that the term applies to.
-                                  The term is applied to the union of the namespaces listed in this field
-                                  and the ones selected by namespaceSelector.
-                                  null or empty namespaces list and null namespaceSelector means "this pod's namespace".
-                                items:
-                                  type: string
-                                type: array
-                                x-kubernetes-list-type: atomic
-                              topologyKey:
+                                  IO specifies which QEMU disk IO mode should be used.
+                                  Supported values are: native, default, threads.
+                                type: string
+                              ioTune:
                                 description: |-
-                                  This pod should be co-located (affinity) or not co-located (anti-affinity) with the pods matching
-                                  the labelSelector in the specified namespaces, where co-located is defined as running on a node
-                                  whose value of the label with key topologyKey matches that of any node on which any of the
-                                  selected pods is running.
-                                  Empty topologyKey is not allowed.
+                                  IOTune limits the IO operations and the throughput of the disk.
+                                  It can be set when hotplugging the disk.
+                                properties:
+                                  readBytesSec:
+                                    anyOf:
+                                    - type: integer
+                                    - type: string
+                                    description: ReadBytesSec limits the read throughput
+                                      in bytes per second.
+                                    pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                    x-kubernetes-int-or-string: true
+                                  readIOPSSec:
+                                    description: ReadIOPSSec limits the number of
+                                      read operations per second.
+                                    format: int64
+                                    type: integer
+                                  totalBytesSec:
+                                    anyOf:
+                                    - type: integer
+                                    - type: string
+                                    description: TotalBytesSec limits the total throughput
+                                      of reads and writes in bytes per second.
+                                    pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                    x-kubernetes-int-or-string: true
+                                  totalIOPSSec:
+                                    description: TotalIOPSSec limits the total number
+                                      of read and write operations per second.
+                                    format: int64
+                                    type: integer
+                                  writeBytesSec:
+                                    anyOf:
+                                    - type: integer
+                                    - type: string
+                                    description: WriteBytesSec limits the write throughput
+                                      in bytes per second.
+                                    pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                    x-kubernetes-int-or-string: true
+                                  writeIOPSSec:
+                                    description: WriteIOPSSec limits the number of
+                                      write operations per second.
+                                    format: int64
+                                    type: integer
+                                type: object
+                              lun:
+                                description: Attach a volume as a LUN to the vmi.
+                                properties:
+                                  bus:
+                                    description: |-
+                                      Bus indicates the type of disk device to emulate.
+                                      supported values: virtio, sata, scsi.
+                                    type: string
+                                  readonly:
+                                    description: |-
+                                      ReadOnly.
+                                      Defaults to false.
+                                    type: boolean
+                                  reservation:
+                                    description: Reservation indicates if the disk
+                                      needs to support the persistent reservation
+                                      for the SCSI disk
+                                    type: boolean
+                                type: object
+                              name:
+                                description: Name is the device name
+                                type: string
+                              serial:
+                                description: Serial provides the ability to specify
+                                  a serial number for the disk device.
+                                type: string
+                              shareable:
+                                description: If specified the disk is made sharable
+                                  and multiple write from different VMs are permitted
+                                type: boolean
+                              tag:
+                                description: If specified, disk address and its tag
+                                  will be provided to the guest via config drive metadata
                                 type: string
                             required:
-                            - topologyKey
+                            - name
                             type: object
+                          maxItems: 256
                           type: array
-                          x-kubernetes-list-type: atomic
-                      type: object
-                    podAntiAffinity:
-                      description: Describes pod anti-affinity scheduling rules (e.g.
-                        avoid putting this pod in the same node, zone, etc. as some
-                        other pod(s)).
-                      properties:
-                        preferredDuringSchedulingIgnoredDuringExecution:
-                          description: |-
-                            The scheduler will prefer to schedule pods to nodes that satisfy
-                            the anti-affinity expressions specified by this field, but it may choose
-                            a node that violates one or more of the expressions. The node that is
-                            most preferred is the one with the greatest sum of weights, i.e.
-                            for each node that meets all of the scheduling requirements (resource
-                            request, requiredDuringScheduling anti-affinity expressions, etc.),
-                            compute a sum by iterating through the elements of this field and adding
-                            "weight" to the sum if the node has pods which matches the corresponding podAffinityTerm; the
-                            node(s) with the highest sum are the most preferred.
+                        downwardMetrics:
+                          description: DownwardMetrics creates a virtio serials for
+                            exposing the downward metrics to the vmi.
+                          type: object
+                        filesystems:
+                          description: Filesystems describes filesystem which is connected
+                            to the vmi.
                           items:
-                            description: The weights of all of the matched WeightedPodAffinityTerm
-                              fields are added per-node to find the most preferred
-                              node(s)
                             properties:
-                              podAffinityTerm:
-                                description: Required. A pod affinity term, associated
-                                  with the corresponding weight.
+                              name:
+                                description: Name is the device name
+                                type: string
+                              virtiofs:
+                                description: Virtiofs is supported
                                 properties:
-                                  labelSelector:
+                                  cache:
                                     description: |-
-                                      A label query over a set of resources, in this case pods.
-                                      If it's null, this PodAffinityTerm matches with no Pods.
+                                      Cache selects the caching policy of the virtiofs daemon serving the
+                                      filesystem. Use never when other pods write to the same volume
+                                      concurrently, so that the guest always sees their changes.
+                                      Supported values: auto, always, never. Defaults to auto.
+                                    enum:
+                                    - auto
+                                    - always
+                                    - never
+                                    type: string
+                                  idMapping:
+                                    description: |-
+                                      IDMapping translates the user and group IDs seen by the guest into
+                                      the IDs stored on the shared volume. Use it to let the guest and
+                                      other pods sharing the volume agree on file ownership.
                                     properties:
-                                      matchExpressions:
-                                        description: matchExpressions is a list of
-                                          label selector requirements. The requirements
-                                          are ANDed.
+                                      groupIDs:
+                                        description: GroupIDs maps ranges of guest
+                                          group IDs to group IDs on the volume.
                                         items:
-                                          description: |-
-                                            A label selector requirement is a selector that contains values, a key, and an operator that
-                                            relates the key and values.
                                           properties:
-                                            key:
-                                              description: key is the label key that
-                                                the selector applies to.
-                                              type: string
-                                            operator:
-                                              description: |-
-                                                operator represents a key's relationship to a set of values.
-                                                Valid operators are In, NotIn, Exists and DoesNotExist.
-                                              type: string
-                                            values:
-                                              description: |-
-                                                values is an array of string values. If the operator is In or NotIn,
-                                                the values array must be non-empty. If the operator is Exists or DoesNotExist,
-                                                the values array must be empty. This array is replaced during a strategic
-                                                merge patch.
-                                              items:
-                                                type: string
-                                              type: array
-                                              x-kubernetes-list-type: atomic
+                                            count:
+                                              description: Count is the number of
+                                                consecutive IDs in the range.
+                                              format: int64
+                                              minimum: 1
+                                              type: integer
+                                            guestID:
+                                              description: GuestID is the first ID
+                                                of the range as seen by the guest.
+                                              format: int64
+                                              minimum: 0
+                                              type: integer
+                                            hostID:
+                                              description: HostID is the first ID
+                                                of the range as stored on the volume.
+                                              format: int64
+                                              minimum: 0
+                                              type: integer
                                           required:
-                                          - key
-                                          - operator
+                                          - count
+                                          - guestID
+                                          - hostID
                                           type: object
                                         type: array
                                         x-kubernetes-list-type: atomic
-                                      matchLabels:
-                                        additionalProperties:
-                                          type: string
-                                        description: |-
-                                          matchLabels is a map of {key,value} pairs. A single {key,value} in the matchLabels
-                                          map is equivalent to an element of matchExpressions, whose key field is "key", the
-                                          operator is "In", and the values array contains only "value". The requirements are ANDed.
-                                        type: object
-                                    type: object
-                                    x-kubernetes-map-type: atomic
-                                  matchLabelKeys:
-                                    description: |-
-                                      MatchLabelKeys is a set of pod label keys to select which pods will
-                                      be taken into consideration. The keys are used to lookup values from the
-                                      incoming pod labels, those key-value labels are merged with 'labelSelector' as 'key in (value)'
-                                      to select the group of existing pods which pods will be taken into consideration
-                                      for the incoming pod's pod (anti) affinity. Keys that don't exist in the incoming
-                                      pod labels will be ignored. The default value is empty.
-                                      The same key is forbidden to exist in both matchLabelKeys and labelSelector.
-                                      Also, matchLabelKeys cannot be set when labelSelector isn't set.
-                                      This is a beta field and requires enabling MatchLabelKeysInPodAffinity feature gate (enabled by default).
-                                    items:
-                                      type: string
-                                    type: array
-                                    x-kubernetes-list-type: atomic
-                                  mismatchLabelKeys:
-                                    description: |-
-                                      MismatchLabelKeys is a set of pod label keys to select which pods will
-                                      be taken into consideration. The keys are used to lookup values from the
-                                      incoming pod labels, those key-value labels are merged with 'labelSelector' as 'key notin (value)'
-                                      to select the group of existing pods which pods will be taken into consideration
-                                      for the incoming pod's pod (anti) affinity. Keys that don't exist in the incoming
-                                      pod labels will be ignored. The default value is empty.
-                                      The same key is forbidden to exist in both mismatchLabelKeys and labelSelector.
-                                      Also, mismatchLabelKeys cannot be set when labelSelector isn't set.
-                                      This is a beta field and requires enabling MatchLabelKeysInPodAffinity feature gate (enabled by default).
-                                    items:
-                                      type: string
-                                    type: array
-                                    x-kubernetes-list-type: atomic
-                                  namespaceSelector:
-                                    description: |-
-                                      A label query over the set of namespaces that the term applies to.
-                                      The term is applied to the union of the namespaces selected by this field
-                                      and the ones listed in the namespaces field.
-                                      null selector and null or empty namespaces list means "this pod's namespace".
-                                      An empty selector ({}) matches all namespaces.
-                                    properties:
-                                      matchExpressions:
-                                        description: matchExpressions is a list of
-                                          label selector requirements. The requirements
-                                          are ANDed.
+                                      userIDs:
+                                        description: UserIDs maps ranges of guest
+                                          user IDs to user IDs on the volume.
                                         items:
-                                          description: |-
-                                            A label selector requirement is a selector that contains values, a key, and an operator that
-                                            relates the key and values.
                                           properties:
-                                            key:
-                                              description: key is the label key that
-                                                the selector applies to.
-                                              type: string
-                                            operator:
-                                              description: |-
-                                                operator represents a key's relationship to a set of values.
-                                                Valid operators are In, NotIn, Exists and DoesNotExist.
-                                              type: string
-                                            values:
-                                              description: |-
-                                                values is an array of string values. If the operator is In or NotIn,
-                                                the values array must be non-empty. If the operator is Exists or DoesNotExist,
-                                                the values array must be empty. This array is replaced during a strategic
-                                                merge patch.
-                                              items:
-                                                type: string
-                                              type: array
-                                              x-kubernetes-list-type: atomic
+                                            count:
+                                              description: Count is the number of
+                                                consecutive IDs in the range.
+                                              format: int64
+                                              minimum: 1
+                                              type: integer
+                                            guestID:
+                                              description: GuestID is the first ID
+                                                of the range as seen by the guest.
+                                              format: int64
+                                              minimum: 0
+                                              type: integer
+                                            hostID:
+                                              description: HostID is the first ID
+                                                of the range as stored on the volume.
+                                              format: int64
+                                              minimum: 0
+                                              type: integer
                                           required:
-                                          - key
-                                          - operator
+                                          - count
+                                          - guestID
+                                          - hostID
                                           type: object
                                         type: array
                                         x-kubernetes-list-type: atomic
-                                      matchLabels:
-                                        additionalProperties:
-                                          type: string
+                                    type: object
+                                type: object
+                            required:
+                            - name
+                            - virtiofs
+                            type: object
+                          type: array
+                          x-kubernetes-list-type: atomic
+                        gpus:
+                          description: Whether to attach a GPU device to the vmi.
+                          items:
+                            properties:
+                              deviceName:
+                                type: string
+                              name:
+                                description: Name of the GPU device as exposed by
+                                  a device plugin
+                                type: string
+                              tag:
+                                description: If specified, the virtual network interface
+                                  address and its tag will be provided to the guest
+                                  via config drive
+                                type: string
+                              virtualGPUOptions:
+                                properties:
+                                  display:
+                                    properties:
+                                      enabled:
                                         description: |-
-                                          matchLabels is a map of {key,value} pairs. A single {key,value} in the matchLabels
-                                          map is equivalent to an element of matchExpressions, whose key field is "key", the
-                                          operator is "In", and the values array contains only "value". The requirements are ANDed.
+                                          Enabled determines if a display addapter backed by a vGPU should be enabled or disabled on the guest.
+                                          Defaults to true.
+                                        type: boolean
+                                      ramFB:
+                                        description: |-
+                                          Enables a boot framebuffer, until the guest OS loads a real GPU driver
+                                          Defaults to true.
+                                        properties:
+                                          enabled:
+                                            description: |-
+                                              Enabled determines if the feature should be enabled or disabled on the guest.
+                                              Defaults to true.
+                                            type: boolean
                                         type: object
                                     type: object
-                                    x-kubernetes-map-type: atomic
-                                  namespaces:
-                                    description: |-
-                                      namespaces specifies a static list of namespace names that the term applies to.
-                                      The term is applied to the union of the namespaces listed in this field
-                                      and the ones selected by namespaceSelector.
-                                      null or empty namespaces list and null namespaceSelector means "this pod's namespace".
-                                    items:
-                                      type: string
-                                    type: array
-                                    x-kubernetes-list-type: atomic
-                                  topologyKey:
+                                type: object
+                            required:
+                            - deviceName
+                            - name
+                            type: object
+                          type: array
+                          x-kubernetes-list-type: atomic
+                        hostDevices:
+                          description: Whether to attach a host device to the vmi.
+                          items:
+                            properties:
+                              deviceName:
+                                description: DeviceName is the resource name of the
+                                  host device exposed by a device plugin
+                                type: string
+                              name:
+                                type: string
+                              tag:
+                                description: If specified, the virtual network interface
+                                  address and its tag will be provided to the guest
+                                  via config drive
+                                type: string
+                            required:
+                            - deviceName
+                            - name
+                            type: object
+                          type: array
+                          x-kubernetes-list-type: atomic
+                        inputs:
+                          description: Inputs describe input devices
+                          items:
+                            properties:
+                              bus:
+                                description: |-
+                                  Bus indicates the bus of input device to emulate.
+                                  Supported values: virtio, usb.
+                                type: string
+                              name:
+                                description: Name is the device name
+                                type: string
+                              type:
+                                description: |-
+                                  Type indicated the type of input device.
+                                  Supported values: tablet.
+                                type: string
+                            required:
+                            - name
+                            - type
+                            type: object
+                          type: array
+                        interfaces:
+                          description: Interfaces describe network interfaces which
+                            are added to the vmi.
+                          items:
+                            properties:
+                              acpiIndex:
+                                description: |-
+                                  If specified, the ACPI index is used to provide network interface device naming, that is stable across changes
+                                  in PCI addresses assigned to the device.
+                                  This value is required to be unique across all devices and be between 1 and (16*1024-1).
+                                type: integer
+                              bandwidth:
+                                description: |-
+                                  Bandwidth limits the traffic of the interface.
+                                  It is only supported on bridge and masquerade bindings.
+                                properties:
+                                  inbound:
+                                    description: Inbound limits the traffic received
+                                      by the guest.
+                                    properties:
+                                      average:
+                                        anyOf:
+                                        - type: integer
+                                        - type: string
+                                        description: Average is the average rate the
+                                          traffic is shaped to, in bytes per second.
+                                        pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                        x-kubernetes-int-or-string: true
+                                      burst:
+                                        anyOf:
+                                        - type: integer
+                                        - type: string
+                                        description: Burst is the amount of bytes
+                                          which may be transferred at the peak rate.
+                                        pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                        x-kubernetes-int-or-string: true
+                                      peak:
+                                        anyOf:
+                                        - type: integer
+                                        - type: string
+                                        description: Peak is the rate the traffic
+                                          may reach while bursting, in bytes per second.
+                                        pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                        x-kubernetes-int-or-string: true
+                                    required:
+                                    - average
+                                    type: object
+                                  outbound:
+                                    description: Outbound limits the traffic transmitted
+                                      by the guest.
+                                    properties:
+                                      average:
+                                        anyOf:
+                                        - type: integer
+                                        - type: string
+                                        description: Average is the average rate the
+                                          traffic is shaped to, in bytes per second.
+                                        pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                        x-kubernetes-int-or-string: true
+                                      burst:
+                                        anyOf:
+                                        - type: integer
+                                        - type: string
+                                        description: Burst is the amount of bytes
+                                          which may be transferred at the peak rate.
+                                        pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                        x-kubernetes-int-or-string: true
+                                      peak:
+                                        anyOf:
+                                        - type: integer
+                                        - type: string
+                                        description: Peak is the rate the traffic
+                                          may reach while bursting, in bytes per second.
+                                        pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                        x-kubernetes-int-or-string: true
+                                    required:
+                                    - average
+                                    type: object
+                                type: object
+                              binding:
+                                description: |-
+                                  Binding specifies the binding plugin that will be used to connect the interface to the guest.
+                                  It provides an alternative to InterfaceBindingMethod.
+                                  version: 1alphav1
+                                properties:
+                                  name:
                                     description: |-
-                                      This pod should be co-located (affinity) or not co-located (anti-affinity) with the pods matching
-                                      the labelSelector in the specified namespaces, where co-located is defined as running on a node
-                                      whose value of the label with key topologyKey matches that of any node on which any of the
-                                      selected pods is running.
-                                      Empty topologyKey is not allowed.
+                                      Name references to the binding name as denined in the kubevirt CR.
+                                      version: 1alphav1
                                     type: string
                                 required:
-                                - topologyKey
+                                - name
                                 type: object
-                              weight:
+                              bootOrder:
                                 description: |-
-                                  weight associated with matching the corresponding podAffinityTerm,
-                                  in the range 1-100.
-                                format: int32
+                                  BootOrder is an integer value > 0, used to determine ordering of boot devices.
+                                  Lower values take precedence.
+                                  Each interface or disk that has a boot order must have a unique value.
+                                  Interfaces without a boot order are not tried.
                                 type: integer
-                            required:
-                            - podAffinityTerm
-                            - weight
-                            type: object
-                          type: array
-                          x-kubernetes-list-type: atomic
-                        requiredDuringSchedulingIgnoredDuringExecution:
-                          description: |-
-                            If the anti-affinity requirements specified by this field are not met at
-                            scheduling time, the pod will not be scheduled onto the node.
-                            If the anti-affinity requirements specified by this field cease to be met
-                            at some point during pod execution (e.g. due to a pod label update), the
-                            system may or may not try to eventually evict the pod from its node.
-                            When there are multiple elements, the lists of nodes corresponding to each
-                            podAffinityTerm are intersected, i.e. all terms must be satisfied.
-                          items:
-                            description: |-
-                              Defines a set of pods (namely those matching the labelSelector
-                              relative to the given namespace(s)) that this pod should be
-                              co-located (affinity) or not co-located (anti-affinity) with,
-                              where co-located is defined as running on a node whose value of
-                              the label with key <topologyKey> matches that of any node on which
-                              a pod of the set of pods is running
-                            properties:
-                              labelSelector:
-                                description: |-
-                                  A label query over a set of resources, in this case pods.
-                                  If it's null, this PodAffinityTerm matches with no Pods.
+                              bridge:
+                                description: InterfaceBridge connects to a given network
+                                  via a linux bridge.
+                                type: object
+                              dhcpOptions:
+                                description: If specified the network interface will
+                                  pass additional DHCP options to the VMI
                                 properties:
-                                  matchExpressions:
-                                    description: matchExpressions is a list of label
-                                      selector requirements. The requirements are
-                                      ANDed.
+                                  bootFileName:
+                                    description: If specified will pass option 67
+                                      to interface's DHCP server
+                                    type: string
+                                  mtu:
+                                    description: If specified will pass the MTU to
+                                      the VM via DHCP option 026 instead of the MTU
+                                      of the pod interface.
+                                    format: int32
+                                    type: integer
+                                  ntpServers:
+                                    description: If specified will pass the configured
+                                      NTP server to the VM via DHCP option 042.
                                     items:
-                                      description: |-
-                                        A label selector requirement is a selector that contains values, a key, and an operator that
-                                        relates the key and values.
+                                      type: string
+                                    type: array
+                                  privateOptions:
+                                    description: 'If specified will pass extra DHCP
+                                      options for private use, range: 224-254'
+                                    items:
+                                      description: DHCPExtraOptions defines Extra
+                                        DHCP options for a VM.
                                       properties:
-                                        key:
-                                          description: key is the label key that the
-                                            selector applies to.
-                                          type: string
-                                        operator:
+                                        option:
                                           description: |-
-                                            operator represents a key's relationship to a set of values.
-                                            Valid operators are In, NotIn, Exists and DoesNotExist.
+                                            Option is an Integer value from 224-254
+                                            Required.
+                                          type: integer
+                                        value:
+                                          description: |-
+                                            Value is a String value for the Option provided
+                                            Required.
                                           type: string
-                                        values:
+                                      required:
+                                      - option
+                                      - value
+                                      type: object
+                                    type: array
+                                  routes:
+                                    description: If specified will pass the routes
+                                      to the VM via DHCP option 121, in addition to
+                                      the routes of the pod interface.
+                                    items:
+                                      description: DHCPRoute defines a classless static
+                                        route passed to the VM via DHCP.
+                                      properties:
+                                        destination:
                                           description: |-
-                                            values is an array of string values. If the operator is In or NotIn,
-                                            the values array must be non-empty. If the operator is Exists or DoesNotExist,
-                                            the values array must be empty. This array is replaced during a strategic
-                                            merge patch.
-                                          items:
-                                            type: string
-                                          type: array
-                                          x-kubernetes-list-type: atomic
+                                            Destination is the IPv4 CIDR of the route
+                                            Required.
+                                          type: string
+                                        gateway:
+                                          description: Gateway is the IPv4 address
+                                            of the next hop, the route is on-link
+                                            if omitted
+                                          type: string
                                       required:
-                                      - key
-                                      - operator
+                                      - destination
                                       type: object
                                     type: array
                                     x-kubernetes-list-type: atomic
-                                  matchLabels:
-                                    additionalProperties:
-                                      type: string
-                                    description: |-
-                                      matchLabels is a map of {key,value} pairs. A single {key,value} in the matchLabels
-                                      map is equivalent to an element of matchExpressions, whose key field is "key", the
-                                      operator is "In", and the values array contains only "value". The requirements are ANDed.
-                                    type: object
+                                  tftpServerName:
+                                    description: If specified will pass option 66
+                                      to interface's DHCP server
+                                    type: string
                                 type: object
-                                x-kubernetes-map-type: atomic
-                              matchLabelKeys:
+                              macAddress:
+                                description: 'Interface MAC address. For example:
+                                  de:ad:00:00:be:af or DE-AD-00-00-BE-AF.'
+                                type: string
+                              macvtap:
                                 description: |-
-                                  MatchLabelKeys is a set of pod label keys to select which pods will
-                                  be taken into consideration. The keys are used to lookup values from the
-                                  incoming pod labels, those key-value labels are merged with 'labelSelector' as 'key in (value)'
-                                  to select the group of existing pods which pods will be taken into consideration
-                                  for the incoming pod's pod (anti) affinity. Keys that don't exist in the incoming
-                                  pod labels will be ignored. The default value is empty.
-                                  The same key is forbidden to exist in both matchLabelKeys and labelSelector.
-                                  Also, matchLabelKeys cannot be set when labelSelector isn't set.
-                                  This is a beta field and requires enabling MatchLabelKeysInPodAffinity feature gate (enabled by default).
+                                  DeprecatedMacvtap is an alias to the deprecated Macvtap interface,
+                                  please refer to Kubevirt user guide for alternatives.
+                                  Deprecated: Removed in v1.3
+                                type: object
+                              masquerade:
+                                description: InterfaceMasquerade connects to a given
+                                  network using netfilter rules to nat the traffic.
+                                type: object
+                              model:
+                                description: |-
+                                  Interface model.
+                                  One of: e1000, e1000e, igb, ne2k_pci, pcnet, rtl8139, virtio.
+                                  Defaults to virtio.
+                                type: string
+                              name:
+                                description: |-
+                                  Logical name of the interface as well as a reference to the associated networks.
+                                  Must match the Name of a Network.
+                                type: string
+                              passt:
+                                description: |-
+                                  DeprecatedPasst is an alias to the deprecated Passt interface,
+                                  please refer to Kubevirt user guide for alternatives.
+                                  Deprecated: Removed in v1.3
+                                type: object
+                              pciAddress:
+                                description: 'If specified, the virtual network interface
+                                  will be placed on the guests pci address with the
+                                  specified PCI address. For example: 0000:81:01.10'
+                                type: string
+                              ports:
+                                description: List of ports to be forwarded to the
+                                  virtual machine.
                                 items:
-                                  type: string
+                                  description: |-
+                                    Port represents a port to expose from the virtual machine.
+                                    Default protocol TCP.
+                                    The port field is mandatory
+                                  properties:
+                                    name:
+                                      description: |-
+                                        If specified, this must be an IANA_SVC_NAME and unique within the pod. Each
+                                        named port in a pod must have a unique name. Name for the port that can be
+                                        referred to by services.
+                                      type: string
+                                    port:
+                                      description: |-
+                                        Number of port to expose for the virtual machine.
+                                        This must be a valid port number, 0 < x < 65536.
+                                      format: int32
+                                      type: integer
+                                    protocol:
+                                      description: |-
+                                        Protocol for port. Must be UDP or TCP.
+                                        Defaults to "TCP".
+                                      type: string
+                                  required:
+                                  - port
+                                  type: object
                                 type: array
-                                x-kubernetes-list-type: atomic
-                              mismatchLabelKeys:
+                              slirp:
+                                description: |-
+                                  DeprecatedSlirp is an alias to the deprecated Slirp interface
+                                  Deprecated: Removed in v1.3
+                                type: object
+                              sriov:
+                                description: InterfaceSRIOV connects to a given network
+                                  by passing-through an SR-IOV PCI device via vfio.
+                                type: object
+                              state:
+                                description: |-
+                                  State represents the requested operational state of the interface.
+                                  The supported values are:
+                                  'absent', expressing a request to remove the interface.
+                                  'down', expressing a request to set the link down.
+                                  'up', expressing a request to set the link up.
+                                  Empty value functions as 'up'.
+                                type: string
+                              tag:
+                                description: If specified, the virtual network interface
+                                  address and its tag will be provided to the guest
+                                  via config drive
+                                type: string
+                            required:
+                            - name
+                            type: object
+                          maxItems: 256
+                          type: array
+                        logSerialConsole:
+                          description: |-
+                            Whether to log the auto-attached default serial console or not.
+                            Serial console logs will be collect to a file and then streamed from a named 'guest-console-log'.
+                            Not relevant if autoattachSerialConsole is disabled.
+                            Defaults to cluster wide setting on VirtualMachineOptions.
+                          type: boolean
+                        networkInterfaceMultiqueue:
+                          description: If specified, virtual network interfaces configured
+                            with a virtio bus will also enable the vhost multiqueue
+                            feature for network devices. The number of queues created
+                            depends on additional factors of the VirtualMachineInstance,
+                            like the number of guest CPUs.
+                          type: boolean
+                        rng:
+                          description: Whether to have random number generator from
+                            host
+                          type: object
+                        sound:
+                          description: Whether to emulate a sound device.
+                          properties:
+                            model:
+                              description: |-
+                                We only support ich9 or ac97.
+                                If SoundDevice is not set: No sound card is emulated.
+                                If SoundDevice is set but Model is not: ich9
+                              type: string
+                            name:
+                              description: User's defined name for this sound device
+                              type: string
+                          required:
+                          - name
+                          type: object
+                        tpm:
+                          description: Whether to emulate a TPM device.
+                          properties:
+                            persistent:
+                              description: |-
+                                Persistent indicates the state of the TPM device should be kept accross reboots
+                                Defaults to false
+                              type: boolean
+                          type: object
+                        usbDevices:
+                          description: |-
+                            USBDevices are host USB devices passed through to the vmi. They are allocated by virt-handler
+                            among the devices plugged into the node, and attached to the vmi as they get plugged.
+                          items:
+                            description: |-
+                              USBDevice selects a USB device plugged into the node of the vmi, either by its vendor and product
+                              identifiers or by the port it is plugged into. Exactly one of the selectors has to be set.
+                            properties:
+                              busPort:
                                 description: |-
-                                  MismatchLabelKeys is a set of pod label keys to select which pods will
-                                  be taken into consideration. The keys are used to lookup values from the
-                                  incoming pod labels, those key-value labels are merged with 'labelSelector' as 'key notin (value)'
-                                  to select the group of existing pods which pods will be taken into consideration
-                                  for the incoming pod's pod (anti) affinity. Keys that don't exist in the incoming
-                                  pod labels will be ignored. The default value is empty.
-                                  The same key is forbidden to exist in both mismatchLabelKeys and labelSelector.
-                                  Also, mismatchLabelKeys cannot be set when labelSelector isn't set.
-                                  This is a beta field and requires enabling MatchLabelKeysInPodAffinity feature gate (enabled by default).
-                                items:
+                                  BusPort selects the device plugged into a port, by the bus number and port path the kernel
+                                  names the device after, e.g. 1-2.3
+                                type: string
+                              name:
+                                description: Name of the USB device, unique within
+                                  the vmi
+                                type: string
+                              vendorProduct:
+                                description: VendorProduct selects a free device by
+                                  its vendor_id:product_id tuple, e.g. 1050:0407
+                                type: string
+                            required:
+                            - name
+                            type: object
+                          type: array
+                          x-kubernetes-list-type: atomic
+                        useVirtioTransitional:
+                          description: |-
+                            Fall back to legacy virtio 0.9 support if virtio bus is selected on devices.
+                            This is helpful for old machines like CentOS6 or RHEL6 which
+                            do not understand virtio_non_transitional (virtio 1.0).
+                          type: boolean
+                        watchdog:
+                          description: Watchdog describes a watchdog device which
+                            can be added to the vmi.
+                          properties:
+                            i6300esb:
+                              description: i6300esb watchdog device.
+                              properties:
+                                action:
+                                  description: |-
+                                    The action to take. Valid values are poweroff, reset, shutdown.
+                                    Defaults to reset.
                                   type: string
-                                type: array
-                                x-kubernetes-list-type: atomic
-                              namespaceSelector:
-                                description: |-
-                                  A label query over the set of namespaces that the term applies to.
-                                  The term is applied to the union of the namespaces selected by this field
-                                  and the ones listed in the namespaces field.
-                                  null selector and null or empty namespaces list means "this pod's namespace".
-                                  An empty selector ({}) matches all namespaces.
-                                properties:
-                                  matchExpressions:
-                                    description: matchExpressions is a list of label
-                                      selector requirements. The requirements are
-                                      ANDed.
-                                    items:
+                              type: object
+                            name:
+                              description: Name of the watchdog.
+                              type: string
+                          required:
+                          - name
+                          type: object
+                      type: object
+                    features:
+                      description: Features like acpi, apic, hyperv, smm.
+                      properties:
+                        acpi:
+                          description: |-
+                            ACPI enables/disables ACPI inside the guest.
+                            Defaults to enabled.
+                          properties:
+                            enabled:
+                              description: |-
+                                Enabled determines if the feature should be enabled or disabled on the guest.
+                                Defaults to true.
+                              type: boolean
+                          type: object
+                        apic:
+                          description: Defaults to the machine type setting.
+                          properties:
+                            enabled:
+                              description: |-
+                                Enabled determines if the feature should be enabled or disabled on the guest.
+                                Defaults to true.
+                              type: boolean
+                            endOfInterrupt:
+                              description: |-
+                                EndOfInterrupt enables the end of interrupt notification in the guest.
+                                Defaults to false.
+                              type: boolean
+                          type: object
+                        hyperv:
+                          description: Defaults to the machine type setting.
+                          properties:
+                            evmcs:
+                              description: |-
+                                EVMCS Speeds up L2 vmexits, but disables other virtualization features. Requires vapic.
+                                Defaults to the machine type setting.
+                              properties:
+                                enabled:
+                                  description: |-
+                                    Enabled determines if the feature should be enabled or disabled on the guest.
+                                    Defaults to true.
+                                  type: boolean
+                              type: object
+                            frequencies:
+                              description: |-
+                                Frequencies improves the TSC clock source handling for Hyper-V on KVM.
+                                Defaults to the machine type setting.
+                              properties:
+                                enabled:
+                                  description: |-
+                                    Enabled determines if the feature should be enabled or disabled on the guest.
+                                    Defaults to true.
+                                  type: boolean
+                              type: object
+                            ipi:
+                              description: |-
+                                IPI improves performances in overcommited environments. Requires vpindex.
+                                Defaults to the machine type setting.
+                              properties:
+                                enabled:
+                                  description: |-
+                                    Enabled determines if the feature should be enabled or disabled on the guest.
+                                    Defaults to true.
+                                  type: boolean
+                              type: object
+                            reenlightenment:
+                              description: |-
+                                Reenlightenment enables the notifications on TSC frequency changes.
+                                Defaults to the machine type setting.
+                              properties:
+                                enabled:
+                                  description: |-
+                                    Enabled determines if the feature should be enabled or disabled on the guest.
+                                    Defaults to true.
+                                  type: boolean
+                              type: object
+                            relaxed:
+                              description: |-
+                                Relaxed instructs the guest OS to disable watchdog timeouts.
+                                Defaults to the machine type setting.
+                              properties:
+                                enabled:
+                                  description: |-
+                                    Enabled determines if the feature should be enabled or disabled on the guest.
+                                    Defaults to true.
+                                  type: boolean
+                              type: object
+                            reset:
+                              description: |-
+                                Reset enables Hyperv reboot/reset for the vmi. Requires synic.
+                                Defaults to the machine type setting.
+                              properties:
+                                enabled:
+                                  description: |-
+                                    Enabled determines if the feature should be enabled or disabled on the guest.
+                                    Defaults to true.
+                                  type: boolean
+                              type: object
+                            runtime:
+                              description: |-
+                                Runtime improves the time accounting to improve scheduling in the guest.
+                                Defaults to the machine type setting.
+                              properties:
+                                enabled:
+                                  description: |-
+                                    Enabled determines if the feature should be enabled or disabled on the guest.
+                                    Defaults to true.
+                                  type: boolean
+                              type: object
+                            spinlocks:
+                              description: Spinlocks allows to configure the spinlock
+                                retry attempts.
+                              properties:
+                                enabled:
+                                  description: |-
+                                    Enabled determines if the feature should be enabled or disabled on the guest.
+                                    Defaults to true.
+                                  type: boolean
+                                spinlocks:
+                                  description: |-
+                                    Retries indicates the number of retries.
+                                    Must be a value greater or equal 4096.
+                                    Defaults to 4096.
+                                  format: int32
+                                  type: integer
+                              type: object
+                            synic:
+                              description: |-
+                                SyNIC enables the Synthetic Interrupt Controller.
+                                Defaults to the machine type setting.
+                              properties:
+                                enabled:
+                                  description: |-
+                                    Enabled determines if the feature should be enabled or disabled on the guest.
+                                    Defaults to true.
+                                  type: boolean
+                              type: object
+                            synictimer:
+                              description: |-
+                                SyNICTimer enables Synthetic Interrupt Controller Timers, reducing CPU load.
+                                Defaults to the machine type setting.
+                              properties:
+                                direct:
+                                  description: Represents if a feature is enabled
+                                    or disabled.
+                                  properties:
+                                    enabled:
                                       description: |-
-                                        A label selector requirement is a selector that contains values, a key, and an operator that
-                                        relates the key and values.
-                                      properties:
-                                        key:
-                                          description: key is the label key that the
-                                            selector applies to.
-                                          type: string
-                                        operator:
-                                          description: |-
-                                            operator represents a key's relationship to a set of values.
-                                            Valid operators are In, NotIn, Exists and DoesNotExist.
-                                          type: string
-                                        values:
-                                          description: |-
-                                            values is an array of string values. If the operator is In or NotIn,
-                                            the values array must be non-empty. If the operator is Exists or DoesNotExist,
-                                            the values array must be empty. This array is replaced during a strategic
-                                            merge patch.
-                                          items:
-                                            type: string
-                                          type: array
-                                          x-kubernetes-list-type: atomic
-                                      required:
-                                      - key
-                                      - operator
-                                      type: object
-                                    type: array
-                                    x-kubernetes-list-type: atomic
-                                  matchLabels:
-                                    additionalProperties:
-                                      type: string
-                                    description: |-
-                                      matchLabels is a map of {key,value} pairs. A single {key,value} in the matchLabels
-                                      map is equivalent to an element of matchExpressions, whose key field is "key", the
-                                      operator is "In", and the values array contains only "value". The requirements are ANDed.
-                                    type: object
-                                type: object
-                                x-kubernetes-map-type: atomic
-                              namespaces:
-                                description: |-
-                                  namespaces specifies a static list of namespace names that the term applies to.
-                                  The term is applied to the union of the namespaces listed in this field
-                                  and the ones selected by namespaceSelector.
-                                  null or empty namespaces list and null namespaceSelector means "this pod's namespace".
-                                items:
+                                        Enabled determines if the feature should be enabled or disabled on the guest.
+                                        Defaults to true.
+                                      type: boolean
+                                  type: object
+                                enabled:
+                                  type: boolean
+                              type: object
+                            tlbflush:
+                              description: |-
+                                TLBFlush improves performances in overcommited environments. Requires vpindex.
+                                Defaults to the machine type setting.
+                              properties:
+                                enabled:
+                                  description: |-
+                                    Enabled determines if the feature should be enabled or disabled on the guest.
+                                    Defaults to true.
+                                  type: boolean
+                              type: object
+                            vapic:
+                              description: |-
+                                VAPIC improves the paravirtualized handling of interrupts.
+                                Defaults to the machine type setting.
+                              properties:
+                                enabled:
+                                  description: |-
+                                    Enabled determines if the feature should be enabled or disabled on the guest.
+                                    Defaults to true.
+                                  type: boolean
+                              type: object
+                            vendorid:
+                              description: |-
+                                VendorID allows setting the hypervisor vendor id.
+                                Defaults to the machine type setting.
+                              properties:
+                                enabled:
+                                  description: |-
+                                    Enabled determines if the feature should be enabled or disabled on the guest.
+                                    Defaults to true.
+                                  type: boolean
+                                vendorid:
+                                  description: |-
+                                    VendorID sets the hypervisor vendor id, visible to the vmi.
+                                    String up to twelve characters.
                                   type: string
-                                type: array
-                                x-kubernetes-list-type: atomic
-                              topologyKey:
-                                description: |-
-                                  This pod should be co-located (affinity) or not co-located (anti-affinity) with the pods matching
-                                  the labelSelector in the specified namespaces, where co-located is defined as running on a node
-                                  whose value of the label with key topologyKey matches that of any node on which any of the
-                                  selected pods is running.
-                                  Empty topologyKey is not allowed.
-                                type: string
-                            required:
-                            - topologyKey
-                            type: object
-                          type: array
-                          x-kubernetes-list-type: atomic
-                      type: object
-                  type: object
-                architecture:
-                  description: Specifies the architecture of the vm guest you are
-                    attempting to run. Defaults to the compiled architecture of the
-                    KubeVirt components
-                  type: string
-                dnsConfig:
-                  description: |-
-                    Specifies the DNS parameters of a pod.
-                    Parameters specified here will be merged to the generated DNS
-                    configuration based on DNSPolicy.
-                  properties:
-                    nameservers:
-                      description: |-
-                        A list of DNS name server IP addresses.
-                        This will be appended to the base nameservers generated from DNSPolicy.
-                        Duplicated nameservers will be removed.
-                      items:
-                        type: string
-                      type: array
-                      x-kubernetes-list-type: atomic
-                    options:
-                      description: |-
-                        A list of DNS resolver options.
-                        This will be merged with the base options generated from DNSPolicy.
-                        Duplicated entries will be removed. Resolution options given in Options
-                        will override those that appear in the base DNSPolicy.
-                      items:
-                        description: PodDNSConfigOption defines DNS resolver options
-                          of a pod.
-                        properties:
-                          name:
-                            description: Required.
-                            type: string
-                          value:
-                            type: string
-                        type: object
-                      type: array
-                      x-kubernetes-list-type: atomic
-                    searches:
-                      description: |-
-                        A list of DNS search domains for host-name lookup.
-                        This will be appended to the base search paths generated from DNSPolicy.
-                        Duplicated search paths will be removed.
-                      items:
-                        type: string
-                      type: array
-                      x-kubernetes-list-type: atomic
-                  type: object
-                dnsPolicy:
-                  description: |-
-                    Set DNS policy for the pod.
-                    Defaults to "ClusterFirst".
-                    Valid values are 'ClusterFirstWithHostNet', 'ClusterFirst', 'Default' or 'None'.
-                    DNS parameters given in DNSConfig will be merged with the policy selected with DNSPolicy.
-                    To have DNS options set along with hostNetwork, you have to specify DNS policy
-                    explicitly to 'ClusterFirstWithHostNet'.
-                  type: string
-                domain:
-                  description: Specification of the desired behavior of the VirtualMachineInstance
-                    on the host.
-                  properties:
-                    chassis:
-                      description: Chassis specifies the chassis info passed to the
-                        domain.
-                      properties:
-                        asset:
-                          type: string
-                        manufacturer:
-                          type: string
-                        serial:
-                          type: string
-                        sku:
-                          type: string
-                        version:
-                          type: string
+                              type: object
+                            vpindex:
+                              description: |-
+                                VPIndex enables the Virtual Processor Index to help windows identifying virtual processors.
+                                Defaults to the machine type setting.
+                              properties:
+                                enabled:
+                                  description: |-
+                                    Enabled determines if the feature should be enabled or disabled on the guest.
+                                    Defaults to true.
+                                  type: boolean
+                              type: object
+                          type: object
+                        hypervPassthrough:
+                          description: |-
+                            This enables all supported hyperv flags automatically.
+                            Bear in mind that if this enabled hyperV features cannot
+                            be enabled explicitly. In addition, a Virtual Machine
+                            using it will be non-migratable.
+                          properties:
+                            enabled:
+                              type: boolean
+                          type: object
+                        kvm:
+                          description: Configure how KVM presence is exposed to the
+                            guest.
+                          properties:
+                            hidden:
+                              description: |-
+                                Hide the KVM hypervisor from standard MSR based discovery.
+                                Defaults to false
+                              type: boolean
+                          type: object
+                        pvspinlock:
+                          description: |-
+                            Notify the guest that the host supports paravirtual spinlocks.
+                            For older kernels this feature should be explicitly disabled.
+                          properties:
+                            enabled:
+                              description: |-
+                                Enabled determines if the feature should be enabled or disabled on the guest.
+                                Defaults to true.
+                              type: boolean
+                          type: object
+                        smm:
+                          description: |-
+                            SMM enables/disables System Management Mode.
+                            TSEG not yet implemented.
+                          properties:
+                            enabled:
+                              description: |-
+                                Enabled determines if the feature should be enabled or disabled on the guest.
+                                Defaults to true.
+                              type: boolean
+                          type: object
                       type: object
-                    clock:
-                      description: Clock sets the clock and timers of the vmi.
+                    firmware:
+                      description: Firmware.
                       properties:
-                        timer:
-                          description: Timer specifies whih timers are attached to
-                            the vmi.
+                        acpi:
+                          description: Information that can be set in the ACPI table
+                          properties:
+                            slicNameRef:
+                              description: |-
+                                SlicNameRef should match the volume name of a secret object. The data in the secret should
+                                be a binary blob that follows the ACPI SLIC standard, see:
+                                https://learn.microsoft.com/en-us/previous-versions/windows/hardware/design/dn653305(v=vs.85)
+                              type: string
+                          type: object
+                        bootloader:
+                          description: Settings to control the bootloader that is
+                            used.
                           properties:
-                            hpet:
-                              description: HPET (High Precision Event Timer) - multiple
-                                timers with periodic interrupts.
+                            bios:
+                              description: If set (default), BIOS will be used.
                               properties:
-                                present:
-                                  description: |-
-                                    Enabled set to false makes sure that the machine type or a preset can't add the timer.
-                                    Defaults to true.
+                                useSerial:
+                                  description: If set, the BIOS output will be transmitted
+                                    over serial
                                   type: boolean
-                                tickPolicy:
-                                  description: |-
-                                    TickPolicy determines what happens when QEMU misses a deadline for injecting a tick to the guest.
-                                    One of "delay", "catchup", "merge", "discard".
-                                  type: string
                               type: object
-                            hyperv:
-                              description: Hyperv (Hypervclock) - lets guests read
-                                the host’s wall clock time (paravirtualized). For
-                                windows guests.
+                            efi:
+                              description: If set, EFI will be used instead of BIOS.
                               properties:
-                                present:
+                                persistent:
                                   description: |-
-                                    Enabled set to false makes sure that the machine type or a preset can't add the timer.
-                                    Defaults to true.
+                                    If set to true, Persistent will persist the EFI NVRAM across reboots.
+                                    Defaults to false
                                   type: boolean
-                              type: object
-                            kvm:
-                              description: "KVM \t(KVM clock) - lets guests read the
-                                host’s wall clock time (paravirtualized). For linux
-                                guests."
-                              properties:
-                                present:
+                                secureBoot:
                                   description: |-
-                                    Enabled set to false makes sure that the machine type or a preset can't add the timer.
-                                    Defaults to true.
+                                    If set, SecureBoot will be enabled and the OVMF roms will be swapped for
+                                    SecureBoot-enabled ones.
+                                    Requires SMM to be enabled.
+                                    Defaults to true
                                   type: boolean
                               type: object
-                            pit:
-                              description: PIT (Programmable Interval Timer) - a timer
-                                with periodic interrupts.
+                          type: object
+                        kernelBoot:
+                          description: Settings to set the kernel for booting.
+                          properties:
+                            container:
+                              description: Container defines the container that containes
+                                kernel artifacts
                               properties:
-                                present:
-                                  description: |-
-                                    Enabled set to false makes sure that the machine type or a preset can't add the timer.
-                                    Defaults to true.
-                                  type: boolean
-                                tickPolicy:
-                                  description: |-
-                                    TickPolicy determines what happens when QEMU misses a deadline for injecting a tick to the guest.
-                                    One of "delay", "catchup", "discard".
+                                image:
+                                  description: Image that contains initrd / kernel
+                                    files.
                                   type: string
-                              type: object
-                            rtc:
-                              description: RTC (Real Time Clock) - a continuously
-                                running timer with periodic interrupts.
-                              properties:
-                                present:
-                                  description: |-
-                                    Enabled set to false makes sure that the machine type or a preset can't add the timer.
-                                    Defaults to true.
-                                  type: boolean
-                                tickPolicy:
+                                imagePullPolicy:
                                   description: |-
-                                    TickPolicy determines what happens when QEMU misses a deadline for injecting a tick to the guest.
-                                    One of "delay", "catchup".
+                                    Image pull policy.
+                                    One of Always, Never, IfNotPresent.
+                                    Defaults to Always if :latest tag is specified, or IfNotPresent otherwise.
+                                    Cannot be updated.
+                                    More info: https://kubernetes.io/docs/concepts/containers/images#updating-images
                                   type: string
-                                track:
-                                  description: Track the guest or the wall clock.
+                                imagePullSecret:
+                                  description: ImagePullSecret is the name of the
+                                    Docker registry secret required to pull the image.
+                                    The secret must already exist.
                                   type: string
+                                initrdPath:
+                                  description: the fully-qualified path to the ramdisk
+                                    image in the host OS
+                                  type: string
+                                kernelPath:
+                                  description: The fully-qualified path to the kernel
+                                    image in the host OS
+                                  type: string
+                              required:
+                              - image
                               type: object
+                            kernelArgs:
+                              description: Arguments to be passed to the kernel at
+                                boot time
+                              type: string
                           type: object
-                        timezone:
-                          description: |-
-                            Timezone sets the guest clock to the specified timezone.
-                            Zone name follows the TZ environment variable format (e.g. 'America/New_York').
+                        serial:
+                          description: The system-serial-number in SMBIOS
                           type: string
-                        utc:
+                        uuid:
                           description: |-
-                            UTC sets the guest clock to UTC on each boot. If an offset is specified,
-                            guest changes to the clock will be kept during reboots and are not reset.
-                          properties:
-                            offsetSeconds:
-                              description: |-
-                                OffsetSeconds specifies an offset in seconds, relative to UTC. If set,
-                                guest changes to the clock will be kept during reboots and not reset.
-                              type: integer
-                          type: object
+                            UUID reported by the vmi bios.
+                            Defaults to a random generated uid.
+                          type: string
                       type: object
-                      x-kubernetes-preserve-unknown-fields: true
-                    cpu:
-                      description: CPU allow specified the detailed CPU topology inside
-                        the vmi.
+                    ioThreads:
+                      description: IOThreads specifies the IOThreads options.
                       properties:
-                        cores:
-                          description: |-
-                            Cores specifies the number of cores inside the vmi.
-                            Must be a value greater or equal 1.
-                          format: int32
-                          type: integer
-                        dedicatedCpuPlacement:
-                          description: |-
-                            DedicatedCPUPlacement requests the scheduler to place the VirtualMachineInstance on a node
-                            with enough dedicated pCPUs and pin the vCPUs to it.
-                          type: boolean
-                        features:
-                          description: Features specifies the CPU features list inside
-                            the VMI.
-                          items:
-                            description: CPUFeature allows specifying a CPU feature.
-                            properties:
-                              name:
-                                description: Name of the CPU feature
-                                type: string
-                              policy:
-                                description: |-
-                                  Policy is the CPU feature attribute which can have the following attributes:
-                                  force    - The virtual CPU will claim the feature is supported regardless of it being supported by host CPU.
-                                  require  - Guest creation will fail unless the feature is supported by the host CPU or the hypervisor is able to emulate it.
-                                  optional - The feature will be supported by virtual CPU if and only if it is supported by host CPU.
-                                  disable  - The feature will not be supported by virtual CPU.
-                                  forbid   - Guest creation will fail if the feature is supported by host CPU.
-                                  Defaults to require
-                                type: string
-                            required:
-                            - name
-                            type: object
-                          type: array
-                        isolateEmulatorThread:
-                          description: |-
-                            IsolateEmulatorThread requests one more dedicated pCPU to be allocated for the VMI to place
-                            the emulator thread on it.
-                          type: boolean
-                        maxSockets:
-                          description: |-
-                            MaxSockets specifies the maximum amount of sockets that can
-                            be hotplugged
+                        supplementalPoolThreadCount:
+                          description: SupplementalPoolThreadCount specifies how many
+                            iothreads are allocated for the supplementalPool policy.
                           format: int32
                           type: integer
-                        model:
-                          description: |-
-                            Model specifies the CPU model inside the VMI.
-                            List of available models https://github.com/libvirt/libvirt/tree/master/src/cpu_map.
-                            It is possible to specify special cases like "host-passthrough" to get the same CPU as the node
-                            and "host-model" to get CPU closest to the node one.
-                            Defaults to host-model.
-                          type: string
-                        numa:
-                          description: NUMA allows specifying settings for the guest
-                            NUMA topology
+                      type: object
+                    ioThreadsPolicy:
+                      description: |-
+                        Controls whether or not disks will share IOThreads.
+                        Omitting IOThreadsPolicy disables use of IOThreads.
+                        One of: shared, auto
+                      type: string
+                    launchSecurity:
+                      description: Launch Security setting of the vmi.
+                      properties:
+                        sev:
+                          description: AMD Secure Encrypted Virtualization (SEV).
                           properties:
-                            guestMappingPassthrough:
-                              description: |-
-                                GuestMappingPassthrough will create an efficient guest topology based on host CPUs exclusively assigned to a pod.
-                                The created topology ensures that memory and CPUs on the virtual numa nodes never cross boundaries of host numa nodes.
+                            attestation:
+                              description: If specified, run the attestation process
+                                for a vmi.
                               type: object
-                          type: object
-                        realtime:
-                          description: Realtime instructs the virt-launcher to tune
-                            the VMI for lower latency, optional for real time workloads
-                          properties:
-                            mask:
+                            dhCert:
+                              description: Base64 encoded guest owner's Diffie-Hellman
+                                key.
+                              type: string
+                            policy:
                               description: |-
-                                Mask defines the vcpu mask expression that defines which vcpus are used for realtime. Format matches libvirt's expressions.
-                                Example: "0-3,^1","0,2,3","2-3"
+                                Guest policy flags as defined in AMD SEV API specification.
+                                Note: due to security reasons it is not allowed to enable guest debugging. Therefore NoDebug flag is not exposed to users and is always true.
+                              properties:
+                                encryptedState:
+                                  description: |-
+                                    SEV-ES is required.
+                                    Defaults to false.
+                                  type: boolean
+                              type: object
+                            session:
+                              description: Base64 encoded session blob.
                               type: string
                           type: object
-                        sockets:
-                          description: |-
-                            Sockets specifies the number of sockets inside the vmi.
-                            Must be a value greater or equal 1.
-                          format: int32
-                          type: integer
-                        threads:
-                          description: |-
-                            Threads specifies the number of threads inside the vmi.
-                            Must be a value greater or equal 1.
-                          format: int32
-                          type: integer
                       type: object
-                    devices:
-                      description: Devices allows adding disks, network interfaces,
-                        and others
+                    machine:
+                      description: Machine type.
+                      properties:
+                        type:
+                          description: QEMU machine type is the actual chipset of
+                            the VirtualMachineInstance.
+                          type: string
+                      type: object
+                    memory:
+                      description: Memory allow specifying the VMI memory features.
                       properties:
-                        autoattachGraphicsDevice:
-                          description: |-
-                            Whether to attach the default graphics device or not.
-                            VNC will not be available if set to false. Defaults to true.
-                          type: boolean
-                        autoattachInputDevice:
-                          description: |-
-                            Whether to attach an Input Device.
-                            Defaults to false.
-                          type: boolean
-                        autoattachMemBalloon:
-                          description: |-
-                            Whether to attach the Memory balloon device with default period.
-                            Period can be adjusted in virt-config.
-                            Defaults to true.
-                          type: boolean
-                        autoattachPodInterface:
-                          description: Whether to attach a pod network interface.
-                            Defaults to true.
-                          type: boolean
-                        autoattachSerialConsole:
-                          description: |-
-                            Whether to attach the default virtio-serial console or not.
-                            Serial console access will not be available if set to false. Defaults to true.
-                          type: boolean
-                        autoattachVSOCK:
-                          description: |-
-                            Whether to attach the VSOCK CID to the VM or not.
-                            VSOCK access will be available if set to true. Defaults to false.
-                          type: boolean
-                        blockMultiQueue:
+                        guest:
+                          anyOf:
+                          - type: integer
+                          - type: string
                           description: |-
-                            Whether or not to enable virtio multi-queue for block devices.
-                            Defaults to false.
-                          type: boolean
-                        clientPassthrough:
-                          description: To configure and access client devices such
-                            as redirecting USB
-                          type: object
-                        disableHotplug:
-                          description: DisableHotplug disabled the ability to hotplug
-                            disks.
-                          type: boolean
-                        disks:
-                          description: Disks describes disks, cdroms and luns which
-                            are connected to the vmi.
-                          items:
-                            properties:
-                              blockSize:
-                                description: If specified, the virtual disk will be
-                                  presented with the given block sizes.
-                                properties:
-                                  custom:
-                                    description: CustomBlockSize represents the desired
-                                      logical and physical block size for a VM disk.
-                                    properties:
-                                      logical:
-                                        type: integer
-                                      physical:
-                                        type: integer
-                                    required:
-                                    - logical
-                                    - physical
-                                    type: object
-                                  matchVolume:
-                                    description: Represents if a feature is enabled
-                                      or disabled.
-                                    properties:
-                                      enabled:
-                                        description: |-
-                                          Enabled determines if the feature should be enabled or disabled on the guest.
-                                          Defaults to true.
-                                        type: boolean
-                                    type: object
-                                type: object
-                              bootOrder:
-                                description: |-
-                                  BootOrder is an integer value > 0, used to determine ordering of boot devices.
-                                  Lower values take precedence.
-                                  Each disk or interface that has a boot order must have a unique value.
-                                  Disks without a boot order are not tried if a disk with a boot order exists.
-                                type: integer
-                              cache:
-                                description: |-
-                                  Cache specifies which kvm disk cache mode should be used.
-                                  Supported values are: CacheNone, CacheWriteThrough.
-                                type: string
-                              cdrom:
-                                description: Attach a volume as a cdrom to the vmi.
-                                properties:
-                                  bus:
-                                    description: |-
-                                      Bus indicates the type of disk device to emulate.
-                                      supported values: virtio, sata, scsi.
-                                    type: string
-                                  readonly:
-                                    description: |-
-                                      ReadOnly.
-                                      Defaults to true.
-                                    type: boolean
-                                  tray:
-                                    description: |-
-                                      Tray indicates if the tray of the device is open or closed.
-                                      Allowed values are "open" and "closed".
-                                      Defaults to closed.
-                                    type: string
-                                type: object
-                              dedicatedIOThread:
-                                description: |-
-                                  dedicatedIOThread indicates this disk should have an exclusive IO Thread.
-                                  Enabling this implies useIOThreads = true.
-                                  Defaults to false.
-                                type: boolean
-                              disk:
-                                description: Attach a volume as a disk to the vmi.
-                                properties:
-                                  bus:
-                                    description: |-
-                                      Bus indicates the type of disk device to emulate.
-                                      supported values: virtio, sata, scsi, usb.
-                                    type: string
-                                  pciAddress:
-                                    description: 'If specified, the virtual disk will
-                                      be placed on the guests pci address with the
-                                      specified PCI address. For example: 0000:81:01.10'
-                                    type: string
-                                  readonly:
-                                    description: |-
-                                      ReadOnly.
-                                      Defaults to false.
-                                    type: boolean
-                                type: object
-                              errorPolicy:
-                                description: If specified, it can change the default
-                                  error policy (stop) for the disk
-                                type: string
-                              io:
-                                description: |-
-                                  IO specifies which QEMU disk IO mode should be used.
-                                  Supported values are: native, default, threads.
-                                type: string
-                              lun:
-                                description: Attach a volume as a LUN to the vmi.
-                                properties:
-                                  bus:
-                                    description: |-
-                                      Bus indicates the type of disk device to emulate.
-                                      supported values: virtio, sata, scsi.
-                                    type: string
-                                  readonly:
-                                    description: |-
-                                      ReadOnly.
-                                      Defaults to false.
-                                    type: boolean
-                                  reservation:
-                                    description: Reservation indicates if the disk
-                                      needs to support the persistent reservation
-                                      for the SCSI disk
-                                    type: boolean
-                                type: object
-                              name:
-                                description: Name is the device name
-                                type: string
-                              serial:
-                                description: Serial provides the ability to specify
-                                  a serial number for the disk device.
-                                type: string
-                              shareable:
-                                description: If specified the disk is made sharable
-                                  and multiple write from different VMs are permitted
-                                type: boolean
-                              tag:
-                                description: If specified, disk address and its tag
-                                  will be provided to the guest via config drive metadata
-                                type: string
-                            required:
-                            - name
-                            type: object
-                          maxItems: 256
-                          type: array
-                        downwardMetrics:
-                          description: DownwardMetrics creates a virtio serials for
-                            exposing the downward metrics to the vmi.
-                          type: object
-                        filesystems:
-                          description: Filesystems describes filesystem which is connected
-                            to the vmi.
-                          items:
-                            properties:
-                              name:
-                                description: Name is the device name
-                                type: string
-                              virtiofs:
-                                description: Virtiofs is supported
-                                type: object
-                            required:
-                            - name
-                            - virtiofs
-                            type: object
-                          type: array
-                          x-kubernetes-list-type: atomic
-                        gpus:
-                          description: Whether to attach a GPU device to the vmi.
-                          items:
-                            properties:
-                              deviceName:
-                                type: string
-                              name:
-                                description: Name of the GPU device as exposed by
-                                  a device plugin
-                                type: string
-                              tag:
-                                description: If specified, the virtual network interface
-                                  address and its tag will be provided to the guest
-                                  via config drive
-                                type: string
-                              virtualGPUOptions:
-                                properties:
-                                  display:
-                                    properties:
-                                      enabled:
-                                        description: |-
-                                          Enabled determines if a display addapter backed by a vGPU should be enabled or disabled on the guest.
-                                          Defaults to true.
-                                        type: boolean
-                                      ramFB:
-                                        description: |-
-                                          Enables a boot framebuffer, until the guest OS loads a real GPU driver
-                                          Defaults to true.
-                                        properties:
-                                          enabled:
-                                            description: |-
-                                              Enabled determines if the feature should be enabled or disabled on the guest.
-                                              Defaults to true.
-                                            type: boolean
-                                        type: object
-                                    type: object
-                                type: object
-                            required:
-                            - deviceName
-                            - name
-                            type: object
-                          type: array
-                          x-kubernetes-list-type: atomic
-                        hostDevices:
-                          description: Whether to attach a host device to the vmi.
+                            Guest allows to specifying the amount of memory which is visible inside the Guest OS.
+                            The Guest must lie between Requests and Limits from the resources section.
+                            Defaults to the requested memory in the resources section if not specified.
+                          pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                          x-kubernetes-int-or-string: true
+                        hugepages:
+                          description: Hugepages allow to use hugepages for the VirtualMachineInstance
+                            instead of regular memory.
+                          properties:
+                            pageSize:
+                              description: PageSize specifies the hugepage size, for
+                                x86_64 architecture valid values are 1Gi and 2Mi.
+                              type: string
+                          type: object
+                        maxGuest:
+                          anyOf:
+                          - type: integer
+                          - type: string
+                          description: |-
+                            MaxGuest allows to specify the maximum amount of memory which is visible inside the Guest OS.
+                            The delta between MaxGuest and Guest is the amount of memory that can be hot(un)plugged.
+                          pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                          x-kubernetes-int-or-string: true
+                      type: object
+                    resources:
+                      description: Resources describes the Compute Resources required
+                        by this vmi.
+                      properties:
+                        limits:
+                          additionalProperties:
+                            anyOf:
+                            - type: integer
+                            - type: string
+                            pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                            x-kubernetes-int-or-string: true
+                          description: |-
+                            Limits describes the maximum amount of compute resources allowed.
+                            Valid resource keys are "memory" and "cpu".
+                          type: object
+                        overcommitGuestOverhead:
+                          description: |-
+                            Don't ask the scheduler to take the guest-management overhead into account. Instead
+                            put the overhead only into the container's memory limit. This can lead to crashes if
+                            all memory is in use on a node. Defaults to false.
+                          type: boolean
+                        requests:
+                          additionalProperties:
+                            anyOf:
+                            - type: integer
+                            - type: string
+                            pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                            x-kubernetes-int-or-string: true
+                          description: |-
+                            Requests is a description of the initial vmi resources.
+                            Valid resource keys are "memory" and "cpu".
+                          type: object
+                      type: object
+                  required:
+                  - devices
+                  type: object
+                evictionStrategy:
+                  description: |-
+                    EvictionStrategy describes the strategy to follow when a node drain occurs.
+                    The possible options are:
+                    - "None": No action will be taken, according to the specified 'RunStrategy' the VirtualMachine will be restarted or shutdown.
+                    - "LiveMigrate": the VirtualMachineInstance will be migrated instead of being shutdown.
+                    - "LiveMigrateIfPossible": the same as "LiveMigrate" but only if the VirtualMachine is Live-Migratable, otherwise it will behave as "None".
+                    - "External": the VirtualMachineInstance will be protected by a PDB and 'vmi.Status.EvacuationNodeName' will be set on eviction. This is mainly useful for cluster-api-provider-kubevirt (capk) which needs a way for VMI's to be blocked from eviction, yet signal capk that eviction has been called on the VMI so the capk controller can handle tearing the VMI down. Details can be found in the commit description https://github.com/kubevirt/kubevirt/commit/c1d77face705c8b126696bac9a3ee3825f27f1fa.
+                  type: string
+                guestLicense:
+                  description: |-
+                    GuestLicense describes the licensing of the guest operating system.
+                    It is exposed in the kubevirt_vmi_guest_license_info metric.
+                  properties:
+                    byol:
+                      description: |-
+                        BYOL tells if the license of the guest operating system is brought by the owner of the VirtualMachineInstance
+                        instead of being provided with the cluster.
+                      type: boolean
+                    licenseModel:
+                      description: LicenseModel is the model the guest operating system
+                        is licensed under, like subscription or perCore.
+                      type: string
+                    osFamily:
+                      description: |-
+                        OSFamily is the family of the guest operating system, one of linux, windows or other.
+                        If not set, it is detected from the operating system reported by the guest agent.
+                      type: string
+                  type: object
+                hostname:
+                  description: |-
+                    Specifies the hostname of the vmi
+                    If not specified, the hostname will be set to the name of the vmi, if dhcp or cloud-init is configured properly.
+                  type: string
+                livenessProbe:
+                  description: |-
+                    Periodic probe of VirtualMachineInstance liveness.
+                    VirtualmachineInstances will be stopped if the probe fails.
+                    Cannot be updated.
+                    More info: https://kubernetes.io/docs/concepts/workloads/pods/pod-lifecycle#container-probes
+                  properties:
+                    exec:
+                      description: |-
+                        One and only one of the following should be specified.
+                        Exec specifies the action to take, it will be executed on the guest through the qemu-guest-agent.
+                        If the guest agent is not available, this probe will fail.
+                      properties:
+                        command:
+                          description: |-
+                            Command is the command line to execute inside the container, the working directory for the
+                            command  is root ('/') in the container's filesystem. The command is simply exec'd, it is
+                            not run inside a shell, so traditional shell instructions ('|', etc) won't work. To use
+                            a shell, you need to explicitly call out to that shell.
+                            Exit status of 0 is treated as live/healthy and non-zero is unhealthy.
                           items:
-                            properties:
-                              deviceName:
-                                description: DeviceName is the resource name of the
-                                  host device exposed by a device plugin
-                                type: string
-                              name:
-                                type: string
-                              tag:
-                                description: If specified, the virtual network interface
-                                  address and its tag will be provided to the guest
-                                  via config drive
-                                type: string
-                            required:
-                            - deviceName
-                            - name
-                            type: object
+                            type: string
                           type: array
                           x-kubernetes-list-type: atomic
-                        inputs:
-                          description: Inputs describe input devices
-                          items:
-                            properties:
-                              bus:
-                                description: |-
-                                  Bus indicates the bus of input device to emulate.
-                                  Supported values: virtio, usb.
-                                type: string
-                              name:
-                                description: Name is the device name
-                                type: string
-                              type:
-                                description: |-
-                                  Type indicated the type of input device.
-                                  Supported values: tablet.
-                                type: string
-                            required:
-                            - name
-                            - type
-                            type: object
-                          type: array
-                        interfaces:
-                          description: Interfaces describe network interfaces which
-                            are added to the vmi.
-                          items:
-                            properties:
-                              acpiIndex:
-                                description: |-
-                                  If specified, the ACPI index is used to provide network interface device naming, that is stable across changes
-                                  in PCI addresses assigned to the device.
-                                  This value is required to be unique across all devices and be between 1 and (16*1024-1).
-                                type: integer
-                              binding:
-                                description: |-
-                                  Binding specifies the binding plugin that will be used to connect the interface to the guest.
-                                  It provides an alternative to InterfaceBindingMethod.
-                                  version: 1alphav1
-                                properties:
-                                  name:
-                                    description: |-
-                                      Name references to the binding name as denined in the kubevirt CR.
-                                      version: 1alphav1
-                                    type: string
-                                required:
-                                - name
-                                type: object
-                              bootOrder:
-                                description: |-
-                                  BootOrder is an integer value > 0, used to determine ordering of boot devices.
-                                  Lower values take precedence.
-                                  Each interface or disk that has a boot order must have a unique value.
-                                  Interfaces without a boot order are not tried.
-                                type: integer
-                              bridge:
-                                description: InterfaceBridge connects to a given network
-                                  via a linux bridge.
-                                type: object
-                              dhcpOptions:
-                                description: If specified the network interface will
-                                  pass additional DHCP options to the VMI
-                                properties:
-                                  bootFileName:
-                                    description: If specified will pass option 67
-                                      to interface's DHCP server
-                                    type: string
-                                  ntpServers:
-                                    description: If specified will pass the configured
-                                      NTP server to the VM via DHCP option 042.
-                                    items:
-                                      type: string
-                                    type: array
-                                  privateOptions:
-                                    description: 'If specified will pass extra DHCP
-                                      options for private use, range: 224-254'
-                                    items:
-                                      description: DHCPExtraOptions defines Extra
-                                        DHCP options for a VM.
-                                      properties:
-                                        option:
-                                          description: |-
-                                            Option is an Integer value from 224-254
-                                            Required.
-                                          type: integer
-                                        value:
-                                          description: |-
-                                            Value is a String value for the Option provided
-                                            Required.
-                                          type: string
-                                      required:
-                                      - option
-                                      - value
-                                      type: object
-                                    type: array
-                                  tftpServerName:
-                                    description: If specified will pass option 66
-                                      to interface's DHCP server
-                                    type: string
-                                type: object
-                              macAddress:
-                                description: 'Interface MAC address. For example:
-                                  de:ad:00:00:be:af or DE-AD-00-00-BE-AF.'
-                                type: string
-                              macvtap:
-                                description: |-
-                                  DeprecatedMacvtap is an alias to the deprecated Macvtap interface,
-                                  please refer to Kubevirt user guide for alternatives.
-                                  Deprecated: Removed in v1.3
-                                type: object
-                              masquerade:
-                                description: InterfaceMasquerade connects to a given
-                                  network using netfilter rules to nat the traffic.
-                                type: object
-                              model:
-                                description: |-
-                                  Interface model.
-                                  One of: e1000, e1000e, igb, ne2k_pci, pcnet, rtl8139, virtio.
-                                  Defaults to virtio.
-                                type: string
+                      type: object
+                    failureThreshold:
+                      description: |-
+                        Minimum consecutive failures for the probe to be considered failed after having succeeded.
+                        Defaults to 3. Minimum value is 1.
+                      format: int32
+                      type: integer
+                    guestAgentPing:
+                      description: GuestAgentPing contacts the qemu-guest-agent for
+                        availability checks.
+                      type: object
+                    httpGet:
+                      description: HTTPGet specifies the http request to perform.
+                      properties:
+                        host:
+                          description: |-
+                            Host name to connect to, defaults to the pod IP. You probably want to set
+                            "Host" in httpHeaders instead.
+                          type: string
+                        httpHeaders:
+                          description: Custom headers to set in the request. HTTP
+                            allows repeated headers.
+                          items:
+                            description: HTTPHeader describes a custom header to be
+                              used in HTTP probes
+                            properties:
                               name:
                                 description: |-
-                                  Logical name of the interface as well as a reference to the associated networks.
-                                  Must match the Name of a Network.
-                                type: string
-                              passt:
-                                description: |-
-                                  DeprecatedPasst is an alias to the deprecated Passt interface,
-                                  please refer to Kubevirt user guide for alternatives.
-                                  Deprecated: Removed in v1.3
-                                type: object
-                              pciAddress:
-                                description: 'If specified, the virtual network interface
-                                  will be placed on the guests pci address with the
-                                  specified PCI address. For example: 0000:81:01.10'
-                                type: string
-                              ports:
-                                description: List of ports to be forwarded to the
-                                  virtual machine.
-                                items:
-                                  description: |-
-                                    Port represents a port to expose from the virtual machine.
-                                    Default protocol TCP.
-                                    The port field is mandatory
-                                  properties:
-                                    name:
-                                      description: |-
-                                        If specified, this must be an IANA_SVC_NAME and unique within the pod. Each
-                                        named port in a pod must have a unique name. Name for the port that can be
-                                        referred to by services.
-                                      type: string
-                                    port:
-                                      description: |-
-                                        Number of port to expose for the virtual machine.
-                                        This must be a valid port number, 0 < x < 65536.
-                                      format: int32
-                                      type: integer
-                                    protocol:
-                                      description: |-
-                                        Protocol for port. Must be UDP or TCP.
-                                        Defaults to "TCP".
-                                      type: string
-                                  required:
-                                  - port
-                                  type: object
-                                type: array
-                              slirp:
-                                description: |-
-                                  DeprecatedSlirp is an alias to the deprecated Slirp interface
-                                  Deprecated: Removed in v1.3
-                                type: object
-                              sriov:
-                                description: InterfaceSRIOV connects to a given network
-                                  by passing-through an SR-IOV PCI device via vfio.
-                                type: object
-                              state:
-                                description: |-
-                                  State represents the requested operational state of the interface.
-                                  The supported values are:
-                                  'absent', expressing a request to remove the interface.
-                                  'down', expressing a request to set the link down.
-                                  'up', expressing a request to set the link up.
-                                  Empty value functions as 'up'.
+                                  The header field name.
+                                  This will be canonicalized upon output, so case-variant names will be understood as the same header.
                                 type: string
-                              tag:
-                                description: If specified, the virtual network interface
-                                  address and its tag will be provided to the guest
-                                  via config drive
+                              value:
+                                description: The header field value
                                 type: string
                             required:
                             - name
+                            - value
                             type: object
-                          maxItems: 256
                           type: array
-                        logSerialConsole:
+                          x-kubernetes-list-type: atomic
+                        path:
+                          description: Path to access on the HTTP server.
+                          type: string
+                        port:
+                          anyOf:
+                          - type: integer
+                          - type: string
                           description: |-
-                            Whether to log the auto-attached default serial console or not.
-                            Serial console logs will be collect to a file and then streamed from a named 'guest-console-log'.
-                            Not relevant if autoattachSerialConsole is disabled.
-                            Defaults to cluster wide setting on VirtualMachineOptions.
-                          type: boolean
-                        networkInterfaceMultiqueue:
-                          description: If specified, virtual network interfaces configured
-                            with a virtio bus will also enable the vhost multiqueue
-                            feature for network devices. The number of queues created
-                            depends on additional factors of the VirtualMachineInstance,
-                            like the number of guest CPUs.
-                          type: boolean
-                        rng:
-                          description: Whether to have random number generator from
-                            host
-                          type: object
-                        sound:
-                          description: Whether to emulate a sound device.
-                          properties:
-                            model:
-                              description: |-
-                                We only support ich9 or ac97.
-                                If SoundDevice is not set: No sound card is emulated.
-                                If SoundDevice is set but Model is not: ich9
-                              type: string
-                            name:
-                              description: User's defined name for this sound device
-                              type: string
-                          required:
-                          - name
-                          type: object
-                        tpm:
-                          description: Whether to emulate a TPM device.
-                          properties:
-                            persistent:
-                              description: |-
-                                Persistent indicates the state of the TPM device should be kept accross reboots
-                                Defaults to false
-                              type: boolean
-                          type: object
-                        useVirtioTransitional:
+                            Name or number of the port to access on the container.
+                            Number must be in the range 1 to 65535.
+                            Name must be an IANA_SVC_NAME.
+                          x-kubernetes-int-or-string: true
+                        scheme:
                           description: |-
-                            Fall back to legacy virtio 0.9 support if virtio bus is selected on devices.
-                            This is helpful for old machines like CentOS6 or RHEL6 which
-                            do not understand virtio_non_transitional (virtio 1.0).
-                          type: boolean
-                        watchdog:
-                          description: Watchdog describes a watchdog device which
-                            can be added to the vmi.
-                          properties:
-                            i6300esb:
-                              description: i6300esb watchdog device.
-                              properties:
-                                action:
-                                  description: |-
-                                    The action to take. Valid values are poweroff, reset, shutdown.
-                                    Defaults to reset.
-                                  type: string
-                              type: object
-                            name:
-                              description: Name of the watchdog.
-                              type: string
-                          required:
-                          - name
-                          type: object
+                            Scheme to use for connecting to the host.
+                            Defaults to HTTP.
+                          type: string
+                      required:
+                      - port
                       type: object
-                    features:
-                      description: Features like acpi, apic, hyperv, smm.
+                    initialDelaySeconds:
+                      description: |-
+                        Number of seconds after the VirtualMachineInstance has started before liveness probes are initiated.
+                        More info: https://kubernetes.io/docs/concepts/workloads/pods/pod-lifecycle#container-probes
+                      format: int32
+                      type: integer
+                    periodSeconds:
+                      description: |-
+                        How often (in seconds) to perform the probe.
+                        Default to 10 seconds. Minimum value is 1.
+                      format: int32
+                      type: integer
+                    successThreshold:
+                      description: |-
+                        Minimum consecutive successes for the probe to be considered successful after having failed.
+                        Defaults to 1. Must be 1 for liveness. Minimum value is 1.
+                      format: int32
+                      type: integer
+                    tcpSocket:
+                      description: |-
+                        TCPSocket specifies an action involving a TCP port.
+                        TCP hooks not yet supported
+                      properties:
+                        host:
+                          description: 'Optional: Host name to connect to, defaults
+                            to the pod IP.'
+                          type: string
+                        port:
+                          anyOf:
+                          - type: integer
+                          - type: string
+                          description: |-
+                            Number or name of the port to access on the container.
+                            Number must be in the range 1 to 65535.
+                            Name must be an IANA_SVC_NAME.
+                          x-kubernetes-int-or-string: true
+                      required:
+                      - port
+                      type: object
+                    timeoutSeconds:
+                      description: |-
+                        Number of seconds after which the probe times out.
+                        For exec probes the timeout fails the probe but does not terminate the command running on the guest.
+                        This means a blocking command can result in an increasing load on the guest.
+                        A small buffer will be added to the resulting workload exec probe to compensate for delays
+                        caused by the qemu guest exec mechanism.
+                        Defaults to 1 second. Minimum value is 1.
+                        More info: https://kubernetes.io/docs/concepts/workloads/pods/pod-lifecycle#container-probes
+                      format: int32
+                      type: integer
+                  type: object
+                networks:
+                  description: List of networks that can be attached to a vm's virtual
+                    interface.
+                  items:
+                    description: Network represents a network type and a resource
+                      that should be connected to the vm.
+                    properties:
+                      multus:
+                        description: Represents the multus cni network.
+                        properties:
+                          default:
+                            description: |-
+                              Select the default network and add it to the
+                              multus-cni.io/default-network annotation.
+                            type: boolean
+                          networkName:
+                            description: |-
+                              References to a NetworkAttachmentDefinition CRD object. Format:
+                              <networkName>, <namespace>/<networkName>. If namespace is not
+                              specified, VMI namespace is assumed.
+                            type: string
+                        required:
+                        - networkName
+                        type: object
+                      name:
+                        description: |-
+                          Network name.
+                          Must be a DNS_LABEL and unique within the vm.
+                          More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+                        type: string
+                      pod:
+                        description: Represents the stock pod network interface.
+                        properties:
+                          vmIPv6NetworkCIDR:
+                            description: |-
+                              IPv6 CIDR for the vm network.
+                              Defaults to fd10:0:2::/120 if not specified.
+                            type: string
+                          vmNetworkCIDR:
+                            description: |-
+                              CIDR for vm network.
+                              Default 10.0.2.0/24 if not specified.
+                            type: string
+                        type: object
+                    required:
+                    - name
+                    type: object
+                  maxItems: 256
+                  type: array
+                nodeSelector:
+                  additionalProperties:
+                    type: string
+                  description: |-
+                    NodeSelector is a selector which must be true for the vmi to fit on a node.
+                    Selector which must match a node's labels for the vmi to be scheduled on that node.
+                    More info: https://kubernetes.io/docs/concepts/configuration/assign-pod-node/
+                  type: object
+                priorityClassName:
+                  description: |-
+                    If specified, indicates the pod's priority.
+                    If not specified, the pod priority will be default or zero if there is no
+                    default.
+                  type: string
+                readinessProbe:
+                  description: |-
+                    Periodic probe of VirtualMachineInstance service readiness.
+                    VirtualmachineInstances will be removed from service endpoints if the probe fails.
+                    Cannot be updated.
+                    More info: https://kubernetes.io/docs/concepts/workloads/pods/pod-lifecycle#container-probes
+                  properties:
+                    exec:
+                      description: |-
+                        One and only one of the following should be specified.
+                        Exec specifies the action to take, it will be executed on the guest through the qemu-guest-agent.
+                        If the guest agent is not available, this probe will fail.
                       properties:
-                        acpi:
+                        command:
                           description: |-
-                            ACPI enables/disables ACPI inside the guest.
-                            Defaults to enabled.
-                          properties:
-                            enabled:
-                              description: |-
-                                Enabled determines if the feature should be enabled or disabled on the guest.
-                                Defaults to true.
-                              type: boolean
-                          type: object
-                        apic:
-                          description: Defaults to the machine type setting.
-                          properties:
-                            enabled:
-                              description: |-
-                                Enabled determines if the feature should be enabled or disabled on the guest.
-                                Defaults to true.
-                              type: boolean
-                            endOfInterrupt:
-                              description: |-
-                                EndOfInterrupt enables the end of interrupt notification in the guest.
-                                Defaults to false.
-                              type: boolean
-                          type: object
-                        hyperv:
-                          description: Defaults to the machine type setting.
-                          properties:
-                            evmcs:
-                              description: |-
-                                EVMCS Speeds up L2 vmexits, but disables other virtualization features. Requires vapic.
-                                Defaults to the machine type setting.
-                              properties:
-                                enabled:
-                                  description: |-
-                                    Enabled determines if the feature should be enabled or disabled on the guest.
-                                    Defaults to true.
-                                  type: boolean
-                              type: object
-                            frequencies:
-                              description: |-
-                                Frequencies improves the TSC clock source handling for Hyper-V on KVM.
-                                Defaults to the machine type setting.
-                              properties:
-                                enabled:
-                                  description: |-
-                                    Enabled determines if the feature should be enabled or disabled on the guest.
-                                    Defaults to true.
-                                  type: boolean
-                              type: object
-                            ipi:
-                              description: |-
-                                IPI improves performances in overcommited environments. Requires vpindex.
-                                Defaults to the machine type setting.
-                              properties:
-                                enabled:
-                                  description: |-
-                                    Enabled determines if the feature should be enabled or disabled on the guest.
-                                    Defaults to true.
-                                  type: boolean
-                              type: object
-                            reenlightenment:
-                              description: |-
-                                Reenlightenment enables the notifications on TSC frequency changes.
-                                Defaults to the machine type setting.
-                              properties:
-                                enabled:
-                                  description: |-
-                                    Enabled determines if the feature should be enabled or disabled on the guest.
-                                    Defaults to true.
-                                  type: boolean
-                              type: object
-                            relaxed:
-                              description: |-
-                                Relaxed instructs the guest OS to disable watchdog timeouts.
-                                Defaults to the machine type setting.
-                              properties:
-                                enabled:
-                                  description: |-
-                                    Enabled determines if the feature should be enabled or disabled on the guest.
-                                    Defaults to true.
-                                  type: boolean
-                              type: object
-                            reset:
-                              description: |-
-                                Reset enables Hyperv reboot/reset for the vmi. Requires synic.
-                                Defaults to the machine type setting.
-                              properties:
-                                enabled:
-                                  description: |-
-                                    Enabled determines if the feature should be enabled or disabled on the guest.
-                                    Defaults to true.
-                                  type: boolean
-                              type: object
-                            runtime:
-                              description: |-
-                                Runtime improves the time accounting to improve scheduling in the guest.
-                                Defaults to the machine type setting.
-                              properties:
-                                enabled:
-                                  description: |-
-                                    Enabled determines if the feature should be enabled or disabled on the guest.
-                                    Defaults to true.
-                                  type: boolean
-                              type: object
-                            spinlocks:
-                              description: Spinlocks allows to configure the spinlock
-                                retry attempts.
-                              properties:
-                                enabled:
-                                  description: |-
-                                    Enabled determines if the feature should be enabled or disabled on the guest.
-                                    Defaults to true.
-                                  type: boolean
-                                spinlocks:
-                                  description: |-
-                                    Retries indicates the number of retries.
-                                    Must be a value greater or equal 4096.
-                                    Defaults to 4096.
-                                  format: int32
-                                  type: integer
-                              type: object
-                            synic:
-                              description: |-
-                                SyNIC enables the Synthetic Interrupt Controller.
-                                Defaults to the machine type setting.
-                              properties:
-                                enabled:
-                                  description: |-
-                                    Enabled determines if the feature should be enabled or disabled on the guest.
-                                    Defaults to true.
-                                  type: boolean
-                              type: object
-                            synictimer:
-                              description: |-
-                                SyNICTimer enables Synthetic Interrupt Controller Timers, reducing CPU load.
-                                Defaults to the machine type setting.
-                              properties:
-                                direct:
-                                  description: Represents if a feature is enabled
-                                    or disabled.
-                                  properties:
-                                    enabled:
-                                      description: |-
-                                        Enabled determines if the feature should be enabled or disabled on the guest.
-                                        Defaults to true.
-                                      type: boolean
-                                  type: object
-                                enabled:
-                                  type: boolean
-                              type: object
-                            tlbflush:
-                              description: |-
-                                TLBFlush improves performances in overcommited environments. Requires vpindex.
-                                Defaults to the machine type setting.
-                              properties:
-                                enabled:
-                                  description: |-
-                                    Enabled determines if the feature should be enabled or disabled on the guest.
-                                    Defaults to true.
-                                  type: boolean
-                              type: object
-                            vapic:
-                              description: |-
-                                VAPIC improves the paravirtualized handling of interrupts.
-                                Defaults to the machine type setting.
-                              properties:
-                                enabled:
-                                  description: |-
-                                    Enabled determines if the feature should be enabled or disabled on the guest.
-                                    Defaults to true.
-                                  type: boolean
-                              type: object
-                            vendorid:
-                              description: |-
-                                VendorID allows setting the hypervisor vendor id.
-                                Defaults to the machine type setting.
-                              properties:
-                                enabled:
-                                  description: |-
-                                    Enabled determines if the feature should be enabled or disabled on the guest.
-                                    Defaults to true.
-                                  type: boolean
-                                vendorid:
-                                  description: |-
-                                    VendorID sets the hypervisor vendor id, visible to the vmi.
-                                    String up to twelve characters.
-                                  type: string
-                              type: object
-                            vpindex:
-                              description: |-
-                                VPIndex enables the Virtual Processor Index to help windows identifying virtual processors.
-                                Defaults to the machine type setting.
-                              properties:
-                                enabled:
-                                  description: |-
-                                    Enabled determines if the feature should be enabled or disabled on the guest.
-                                    Defaults to true.
-                                  type: boolean
-                              type: object
-                          type: object
-                        hypervPassthrough:
+                            Command is the command line to execute inside the container, the working directory for the
+                            command  is root ('/') in the container's filesystem. The command is simply exec'd, it is
+                            not run inside a shell, so traditional shell instructions ('|', etc) won't work. To use
+                            a shell, you need to explicitly call out to that shell.
+                            Exit status of 0 is treated as live/healthy and non-zero is unhealthy.
+                          items:
+                            type: string
+                          type: array
+                          x-kubernetes-list-type: atomic
+                      type: object
+                    failureThreshold:
+                      description: |-
+                        Minimum consecutive failures for the probe to be considered failed after having succeeded.
+                        Defaults to 3. Minimum value is 1.
+                      format: int32
+                      type: integer
+                    guestAgentPing:
+                      description: GuestAgentPing contacts the qemu-guest-agent for
+                        availability checks.
+                      type: object
+                    httpGet:
+                      description: HTTPGet specifies the http request to perform.
+                      properties:
+                        host:
                           description: |-
-                            This enables all supported hyperv flags automatically.
-                            Bear in mind that if this enabled hyperV features cannot
-                            be enabled explicitly. In addition, a Virtual Machine
-                            using it will be non-migratable.
-                          properties:
-                            enabled:
-                              type: boolean
-                          type: object
-                        kvm:
-                          description: Configure how KVM presence is exposed to the
-                            guest.
-                          properties:
-                            hidden:
-                              description: |-
-                                Hide the KVM hypervisor from standard MSR based discovery.
-                                Defaults to false
-                              type: boolean
-                          type: object
-                        pvspinlock:
+                            Host name to connect to, defaults to the pod IP. You probably want to set
+                            "Host" in httpHeaders instead.
+                          type: string
+                        httpHeaders:
+                          description: Custom headers to set in the request. HTTP
+                            allows repeated headers.
+                          items:
+                            description: HTTPHeader describes a custom header to be
+                              used in HTTP probes
+                            properties:
+                              name:
+                                description: |-
+                                  The header field name.
+                                  This will be canonicalized upon output, so case-variant names will be understood as the same header.
+                                type: string
+                              value:
+                                description: The header field value
+                                type: string
+                            required:
+                            - name
+                            - value
+                            type: object
+                          type: array
+                          x-kubernetes-list-type: atomic
+                        path:
+                          description: Path to access on the HTTP server.
+                          type: string
+                        port:
+                          anyOf:
+                          - type: integer
+                          - type: string
                           description: |-
-                            Notify the guest that the host supports paravirtual spinlocks.
-                            For older kernels this feature should be explicitly disabled.
-                          properties:
-                            enabled:
-                              description: |-
-                                Enabled determines if the feature should be enabled or disabled on the guest.
-                                Defaults to true.
-                              type: boolean
-                          type: object
-                        smm:
+                            Name or number of the port to access on the container.
+                            Number must be in the range 1 to 65535.
+                            Name must be an IANA_SVC_NAME.
+                          x-kubernetes-int-or-string: true
+                        scheme:
                           description: |-
-                            SMM enables/disables System Management Mode.
-                            TSEG not yet implemented.
-                          properties:
-                            enabled:
-                              description: |-
-                                Enabled determines if the feature should be enabled or disabled on the guest.
-                                Defaults to true.
-                              type: boolean
-                          type: object
+                            Scheme to use for connecting to the host.
+                            Defaults to HTTP.
+                          type: string
+                      required:
+                      - port
                       type: object
-                    firmware:
-                      description: Firmware.
+                    initialDelaySeconds:
+                      description: |-
+                        Number of seconds after the VirtualMachineInstance has started before liveness probes are initiated.
+                        More info: https://kubernetes.io/docs/concepts/workloads/pods/pod-lifecycle#container-probes
+                      format: int32
+                      type: integer
+                    periodSeconds:
+                      description: |-
+                        How often (in seconds) to perform the probe.
+                        Default to 10 seconds. Minimum value is 1.
+                      format: int32
+                      type: integer
+                    successThreshold:
+                      description: |-
+                        Minimum consecutive successes for the probe to be considered successful after having failed.
+                        Defaults to 1. Must be 1 for liveness. Minimum value is 1.
+                      format: int32
+                      type: integer
+                    tcpSocket:
+                      description: |-
+                        TCPSocket specifies an action involving a TCP port.
+                        TCP hooks not yet supported
                       properties:
-                        acpi:
-                          description: Information that can be set in the ACPI table
-                          properties:
-                            slicNameRef:
-                              description: |-
-                                SlicNameRef should match the volume name of a secret object. The data in the secret should
-                                be a binary blob that follows the ACPI SLIC standard, see:
-                                https://learn.microsoft.com/en-us/previous-versions/windows/hardware/design/dn653305(v=vs.85)
-                              type: string
-                          type: object
-                        bootloader:
-                          description: Settings to control the bootloader that is
-                            used.
-                          properties:
-                            bios:
-                              description: If set (default), BIOS will be used.
-                              properties:
-                                useSerial:
-                                  description: If set, the BIOS output will be transmitted
-                                    over serial
-                                  type: boolean
-                              type: object
-                            efi:
-                              description: If set, EFI will be used instead of BIOS.
-                              properties:
-                                persistent:
-                                  description: |-
-                                    If set to true, Persistent will persist the EFI NVRAM across reboots.
-                                    Defaults to false
-                                  type: boolean
-                                secureBoot:
-                                  description: |-
-                                    If set, SecureBoot will be enabled and the OVMF roms will be swapped for
-                                    SecureBoot-enabled ones.
-                                    Requires SMM to be enabled.
-                                    Defaults to true
-                                  type: boolean
-                              type: object
-                          type: object
-                        kernelBoot:
-                          description: Settings to set the kernel for booting.
-                          properties:
-                            container:
-                              description: Container defines the container that containes
-                                kernel artifacts
+                        host:
+                          description: 'Optional: Host name to connect to, defaults
+                            to the pod IP.'
+                          type: string
+                        port:
+                          anyOf:
+                          - type: integer
+                          - type: string
+                          description: |-
+                            Number or name of the port to access on the container.
+                            Number must be in the range 1 to 65535.
+                            Name must be an IANA_SVC_NAME.
+                          x-kubernetes-int-or-string: true
+                      required:
+                      - port
+                      type: object
+                    timeoutSeconds:
+                      description: |-
+                        Number of seconds after which the probe times out.
+                        For exec probes the timeout fails the probe but does not terminate the command running on the guest.
+                        This means a blocking command can result in an increasing load on the guest.
+                        A small buffer will be added to the resulting workload exec probe to compensate for delays
+                        caused by the qemu guest exec mechanism.
+                        Defaults to 1 second. Minimum value is 1.
+                        More info: https://kubernetes.io/docs/concepts/workloads/pods/pod-lifecycle#container-probes
+                      format: int32
+                      type: integer
+                  type: object
+                schedulerName:
+                  description: |-
+                    If specified, the VMI will be dispatched by specified scheduler.
+                    If not specified, the VMI will be dispatched by default scheduler.
+                  type: string
+                shutdownPolicy:
+                  description: |-
+                    ShutdownPolicy escalates the graceful shutdown of the guest in stages, from an ACPI power button press,
+                    to a shutdown command sent through the guest agent, to forcefully powering off the guest.
+                    If not set, the guest is asked to shut down until the termination grace period expires.
+                  properties:
+                    acpiTimeoutSeconds:
+                      description: |-
+                        ACPITimeoutSeconds is the time the guest is given to power off after the ACPI power button was pressed.
+                        Defaults to 30.
+                      format: int64
+                      type: integer
+                    guestAgentTimeoutSeconds:
+                      description: |-
+                        GuestAgentTimeoutSeconds is the time the guest is given to power off after the shutdown command was sent
+                        through the guest agent, before it is forcefully powered off. The stage is skipped if no guest agent is connected.
+                        Defaults to 30.
+                      format: int64
+                      type: integer
+                  type: object
+                startStrategy:
+                  description: StartStrategy can be set to "Paused" if Virtual Machine
+                    should be started in paused state.
+                  type: string
+                subdomain:
+                  description: |-
+                    If specified, the fully qualified vmi hostname will be "<hostname>.<subdomain>.<pod namespace>.svc.<cluster domain>".
+                    If not specified, the vmi will not have a domainname at all. The DNS entry will resolve to the vmi,
+                    no matter if the vmi itself can pick up a hostname.
+                  type: string
+                terminationGracePeriodSeconds:
+                  description: Grace period observed after signalling a VirtualMachineInstance
+                    to stop after which the VirtualMachineInstance is force terminated.
+                  format: int64
+                  type: integer
+                tolerations:
+                  description: If toleration is specified, obey all the toleration
+                    rules.
+                  items:
+                    description: |-
+                      The pod this Toleration is attached to tolerates any taint that matches
+                      the triple <key,value,effect> using the matching operator <operator>.
+                    properties:
+                      effect:
+                        description: |-
+                          Effect indicates the taint effect to match. Empty means match all taint effects.
+                          When specified, allowed values are NoSchedule, PreferNoSchedule and NoExecute.
+                        type: string
+                      key:
+                        description: |-
+                          Key is the taint key that the toleration applies to. Empty means match all taint keys.
+                          If the key is empty, operator must be Exists; this combination means to match all values and all keys.
+                        type: string
+                      operator:
+                        description: |-
+                          Operator represents a key's relationship to the value.
+                          Valid operators are Exists and Equal. Defaults to Equal.
+                          Exists is equivalent to wildcard for value, so that a pod can
+                          tolerate all taints of a particular category.
+                        type: string
+                      tolerationSeconds:
+                        description: |-
+                          TolerationSeconds represents the period of time the toleration (which must be
+                          of effect NoExecute, otherwise this field is ignored) tolerates the taint. By default,
+                          it is not set, which means tolerate the taint forever (do not evict). Zero and
+                          negative values will be treated as 0 (evict immediately) by the system.
+                        format: int64
+                        type: integer
+                      value:
+                        description: |-
+                          Value is the taint value the toleration matches to.
+                          If the operator is Exists, the value should be empty, otherwise just a regular string.
+                        type: string
+                    type: object
+                  type: array
+                topologySpreadConstraints:
+                  description: |-
+                    TopologySpreadConstraints describes how a group of VMIs will be spread across a given topology
+                    domains. K8s scheduler will schedule VMI pods in a way which abides by the constraints.
+                  items:
+                    description: TopologySpreadConstraint specifies how to spread
+                      matching pods among the given topology.
+                    properties:
+                      labelSelector:
+                        description: |-
+                          LabelSelector is used to find matching pods.
+                          Pods that match this label selector are counted to determine the number of pods
+                          in their corresponding topology domain.
+                        properties:
+                          matchExpressions:
+                            description: matchExpressions is a list of label selector
+                              requirements. The requirements are ANDed.
+                            items:
+                              description: |-
+                                A label selector requirement is a selector that contains values, a key, and an operator that
+                                relates the key and values.
                               properties:
-                                image:
-                                  description: Image that contains initrd / kernel
-                                    files.
+                                key:
+                                  description: key is the label key that the selector
+                                    applies to.
                                   type: string
-                                imagePullPolicy:
+                                operator:
                                   description: |-
-                                    Image pull policy.
-                                    One of Always, Never, IfNotPresent.
-                                    Defaults to Always if :latest tag is specified, or IfNotPresent otherwise.
-                                    Cannot be updated.
-                                    More info: https://kubernetes.io/docs/concepts/containers/images#updating-images
-                                  type: string
-                                imagePullSecret:
-                                  description: ImagePullSecret is the name of the
-                                    Docker registry secret required to pull the image.
-                                    The secret must already exist.
-                                  type: string
-                                initrdPath:
-                                  description: the fully-qualified path to the ramdisk
-                                    image in the host OS
-                                  type: string
-                                kernelPath:
-                                  description: The fully-qualified path to the kernel
-                                    image in the host OS
+                                    operator represents a key's relationship to a set of values.
+                                    Valid operators are In, NotIn, Exists and DoesNotExist.
                                   type: string
+                                values:
+                                  description: |-
+                                    values is an array of string values. If the operator is In or NotIn,
+                                    the values array must be non-empty. If the operator is Exists or DoesNotExist,
+                                    the values array must be empty. This array is replaced during a strategic
+                                    merge patch.
+                                  items:
+                                    type: string
+                                  type: array
+                                  x-kubernetes-list-type: atomic
                               required:
-                              - image
+                              - key
+                              - operator
                               type: object
-                            kernelArgs:
-                              description: Arguments to be passed to the kernel at
-                                boot time
+                            type: array
+                            x-kubernetes-list-type: atomic
+                          matchLabels:
+                            additionalProperties:
                               type: string
-                          type: object
-                        serial:
-                          description: The system-serial-number in SMBIOS
-                          type: string
-                        uuid:
-                          description: |-
-                            UUID reported by the vmi bios.
-                            Defaults to a random generated uid.
+                            description: |-
+                              matchLabels is a map of {key,value} pairs. A single {key,value} in the matchLabels
+                              map is equivalent to an element of matchExpressions, whose key field is "key", the
+                              operator is "In", and the values array contains only "value". The requirements are ANDed.
+                            type: object
+                        type: object
+                        x-kubernetes-map-type: atomic
+                      matchLabelKeys:
+                        description: |-
+                          MatchLabelKeys is a set of pod label keys to select the pods over which
+                          spreading will be calculated. The keys are used to lookup values from the
+                          incoming pod labels, those key-value labels are ANDed with labelSelector
+                          to select the group of existing pods over which spreading will be calculated
+                          for the incoming pod. The same key is forbidden to exist in both MatchLabelKeys and LabelSelector.
+                          MatchLabelKeys cannot be set when LabelSelector isn't set.
+                          Keys that don't exist in the incoming pod labels will
+                          be ignored. A null or empty list means only match against labelSelector.
+
+                          This is a beta field and requires the MatchLabelKeysInPodTopologySpread feature gate to be enabled (enabled by default).
+                        items:
                           type: string
-                      type: object
-                    ioThreads:
-                      description: IOThreads specifies the IOThreads options.
-                      properties:
-                        supplementalPoolThreadCount:
-                          description: SupplementalPoolThreadCount specifies how many
-                            iothreads are allocated for the supplementalPool policy.
-                          format: int32
-                          type: integer
-                      type: object
-                    ioThreadsPolicy:
-                      description: |-
-                        Controls whether or not disks will share IOThreads.
-                        Omitting IOThreadsPolicy disables use of IOThreads.
-                        One of: shared, auto
-                      type: string
-                    launchSecurity:
-                      description: Launch Security setting of the vmi.
-                      properties:
-                        sev:
-                          description: AMD Secure Encrypted Virtualization (SEV).
-                          properties:
-                            attestation:
-                              description: If specified, run the attestation process
-                                for a vmi.
-                              type: object
-                            dhCert:
-                              description: Base64 encoded guest owner's Diffie-Hellman
-                                key.
-                              type: string
-                            policy:
-                              description: |-
-                                Guest policy flags as defined in AMD SEV API specification.
-                                Note: due to security reasons it is not allowed to enable guest debugging. Therefore NoDebug flag is not exposed to users and is always true.
+                        type: array
+                        x-kubernetes-list-type: atomic
+                      maxSkew:
+                        description: |-
+                          MaxSkew describes the degree to which pods may be unevenly distributed.
+                          When 'whenUnsatisfiable=DoNotSchedule', it is the maximum permitted difference
+                          between the number of matching pods in the target topology and the global minimum.
+                          The global minimum is the minimum number of matching pods in an eligible domain
+                          or zero if the number of eligible domains is less than MinDomains.
+                          For example, in a 3-zone cluster, MaxSkew is set to 1, and pods with the same
+                          labelSelector spread as 2/2/1:
+                          In this case, the global minimum is 1.
+                          | zone1 | zone2 | zone3 |
+                          |  P P  |  P P  |   P   |
+                          - if MaxSkew is 1, incoming pod can only be scheduled to zone3 to become 2/2/2;
+                          scheduling it onto zone1(zone2) would make the ActualSkew(3-1) on zone1(zone2)
+                          violate MaxSkew(1).
+                          - if MaxSkew is 2, incoming pod can be scheduled onto any zone.
+                          When 'whenUnsatisfiable=ScheduleAnyway', it is used to give higher precedence
+                          to topologies that satisfy it.
+                          It's a required field. Default value is 1 and 0 is not allowed.
+                        format: int32
+                        type: integer
+                      minDomains:
+                        description: |-
+                          MinDomains indicates a minimum number of eligible domains.
+                          When the number of eligible domains with matching topology keys is less than minDomains,
+                          Pod Topology Spread treats "global minimum" as 0, and then the calculation of Skew is performed.
+                          And when the number of eligible domains with matching topology keys equals or greater than minDomains,
+                          this value has no effect on scheduling.
+                          As a result, when the number of eligible domains is less than minDomains,
+                          scheduler won't schedule more than maxSkew Pods to those domains.
+                          If value is nil, the constraint behaves as if MinDomains is equal to 1.
+                          Valid values are integers greater than 0.
+                          When value is not nil, WhenUnsatisfiable must be DoNotSchedule.
+
+                          For example, in a 3-zone cluster, MaxSkew is set to 2, MinDomains is set to 5 and pods with the same
+                          labelSelector spread as 2/2/2:
+                          | zone1 | zone2 | zone3 |
+                          |  P P  |  P P  |  P P  |
+                          The number of domains is less than 5(MinDomains), so "global minimum" is treated as 0.
+                          In this situation, new pod with the same labelSelector cannot be scheduled,
+                          because computed skew will be 3(3 - 0) if new Pod is scheduled to any of the three zones,
+                          it will violate MaxSkew.
+                        format: int32
+                        type: integer
+                      nodeAffinityPolicy:
+                        description: |-
+                          NodeAffinityPolicy indicates how we will treat Pod's nodeAffinity/nodeSelector
+                          when calculating pod topology spread skew. Options are:
+                          - Honor: only nodes matching nodeAffinity/nodeSelector are included in the calculations.
+                          - Ignore: nodeAffinity/nodeSelector are ignored. All nodes are included in the calculations.
+
+                          If this value is nil, the behavior is equivalent to the Honor policy.
+                          This is a beta-level feature default enabled by the NodeInclusionPolicyInPodTopologySpread feature flag.
+                        type: string
+                      nodeTaintsPolicy:
+                        description: |-
+                          NodeTaintsPolicy indicates how we will treat node taints when calculating
+                          pod topology spread skew. Options are:
+                          - Honor: nodes without taints, along with tainted nodes for which the incoming pod
+                          has a toleration, are included.
+                          - Ignore: node taints are ignored. All nodes are included.
+
+                          If this value is nil, the behavior is equivalent to the Ignore policy.
+                          This is a beta-level feature default enabled by the NodeInclusionPolicyInPodTopologySpread feature flag.
+                        type: string
+                      topologyKey:
+                        description: |-
+                          TopologyKey is the key of node labels. Nodes that have a label with this key
+                          and identical values are considered to be in the same topology.
+                          We consider each <key, value> as a "bucket", and try to put balanced number
+                          of pods into each bucket.
+                          We define a domain as a particular instance of a topology.
+                          Also, we define an eligible domain as a domain whose nodes meet the requirements of
+                          nodeAffinityPolicy and nodeTaintsPolicy.
+                          e.g. If TopologyKey is "kubernetes.io/hostname", each Node is a domain of that topology.
+                          And, if TopologyKey is "topology.kubernetes.io/zone", each zone is a domain of that topology.
+                          It's a required field.
+                        type: string
+                      whenUnsatisfiable:
+                        description: |-
+                          WhenUnsatisfiable indicates how to deal with a pod if it doesn't satisfy
+                          the spread constraint.
+                          - DoNotSchedule (default) tells the scheduler not to schedule it.
+                          - ScheduleAnyway tells the scheduler to schedule the pod in any location,
+                            but giving higher precedence to topologies that would help reduce the
+                            skew.
+                          A constraint is considered "Unsatisfiable" for an incoming pod
+                          if and only if every possible node assignment for that pod would violate
+                          "MaxSkew" on some topology.
+                          For example, in a 3-zone cluster, MaxSkew is set to 1, and pods with the same
+                          labelSelector spread as 3/1/1:
+                          | zone1 | zone2 | zone3 |
+                          | P P P |   P   |   P   |
+                          If WhenUnsatisfiable is set to DoNotSchedule, incoming pod can only be scheduled
+                          to zone2(zone3) to become 3/2/1(3/1/2) as ActualSkew(2-1) on zone2(zone3) satisfies
+                          MaxSkew(1). In other words, the cluster can still be imbalanced, but scheduler
+                          won't make it *more* imbalanced.
+                          It's a required field.
+                        type: string
+                    required:
+                    - maxSkew
+                    - topologyKey
+                    - whenUnsatisfiable
+                    type: object
+                  type: array
+                  x-kubernetes-list-map-keys:
+                  - topologyKey
+                  - whenUnsatisfiable
+                  x-kubernetes-list-type: map
+                volumes:
+                  description: List of volumes that can be mounted by disks belonging
+                    to the vmi.
+                  items:
+                    description: Volume represents a named volume in a vmi.
+                    properties:
+                      cloudInitConfigDrive:
+                        description: |-
+                          CloudInitConfigDrive represents a cloud-init Config Drive user-data source.
+                          The Config Drive data will be added as a disk to the vmi. A proper cloud-init installation is required inside the guest.
+                          More info: https://cloudinit.readthedocs.io/en/latest/topics/datasources/configdrive.html
+                        properties:
+                          networkData:
+                            description: NetworkData contains config drive inline
+                              cloud-init networkdata.
+                            type: string
+                          networkDataBase64:
+                            description: NetworkDataBase64 contains config drive cloud-init
+                              networkdata as a base64 encoded string.
+                            type: string
+                          networkDataSecretRef:
+                            description: NetworkDataSecretRef references a k8s secret
+                              that contains config drive networkdata.
+                            properties:
+                              name:
+                                default: ""
+                                description: |-
+                                  Name of the referent.
+                                  This field is effectively required, but due to backwards compatibility is
+                                  allowed to be empty. Instances of this type with an empty value here are
+                                  almost certainly wrong.
+                                  More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+                                type: string
+                            type: object
+                            x-kubernetes-map-type: atomic
+                          secretRef:
+                            description: UserDataSecretRef references a k8s secret
+                              that contains config drive userdata.
+                            properties:
+                              name:
+                                default: ""
+                                description: |-
+                                  Name of the referent.
+                                  This field is effectively required, but due to backwards compatibility is
+                                  allowed to be empty. Instances of this type with an empty value here are
+                                  almost certainly wrong.
+                                  More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+                                type: string
+                            type: object
+                            x-kubernetes-map-type: atomic
+                          userData:
+                            description: UserData contains config drive inline cloud-init
+                              userdata.
+                            type: string
+                          userDataBase64:
+                            description: UserDataBase64 contains config drive cloud-init
+                              userdata as a base64 encoded string.
+                            type: string
+                        type: object
+                      cloudInitNoCloud:
+                        description: |-
+                          CloudInitNoCloud represents a cloud-init NoCloud user-data source.
+                          The NoCloud data will be added as a disk to the vmi. A proper cloud-init installation is required inside the guest.
+                          More info: http://cloudinit.readthedocs.io/en/latest/topics/datasources/nocloud.html
+                        properties:
+                          networkData:
+                            description: NetworkData contains NoCloud inline cloud-init
+                              networkdata.
+                            type: string
+                          networkDataBase64:
+                            description: NetworkDataBase64 contains NoCloud cloud-init
+                              networkdata as a base64 encoded string.
+                            type: string
+                          networkDataSecretRef:
+                            description: NetworkDataSecretRef references a k8s secret
+                              that contains NoCloud networkdata.
+                            properties:
+                              name:
+                                default: ""
+                                description: |-
+                                  Name of the referent.
+                                  This field is effectively required, but due to backwards compatibility is
+                                  allowed to be empty. Instances of this type with an empty value here are
+                                  almost certainly wrong.
+                                  More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+                                type: string
+                            type: object
+                            x-kubernetes-map-type: atomic
+                          secretRef:
+                            description: UserDataSecretRef references a k8s secret
+                              that contains NoCloud userdata.
+                            properties:
+                              name:
+                                default: ""
+                                description: |-
+                                  Name of the referent.
+                                  This field is effectively required, but due to backwards compatibility is
+                                  allowed to be empty. Instances of this type with an empty value here are
+                                  almost certainly wrong.
+                                  More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+                                type: string
+                            type: object
+                            x-kubernetes-map-type: atomic
+                          userData:
+                            description: UserData contains NoCloud inline cloud-init
+                              userdata.
+                            type: string
+                          userDataBase64:
+                            description: UserDataBase64 contains NoCloud cloud-init
+                              userdata as a base64 encoded string.
+                            type: string
+                        type: object
+                      configMap:
+                        description: |-
+                          ConfigMapSource represents a reference to a ConfigMap in the same namespace.
+                          More info: https://kubernetes.io/docs/tasks/configure-pod-container/configure-pod-configmap/
+                        properties:
+                          name:
+                            default: ""
+                            description: |-
+                              Name of the referent.
+                              This field is effectively required, but due to backwards compatibility is
+                              allowed to be empty. Instances of this type with an empty value here are
+                              almost certainly wrong.
+                              More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+                            type: string
+                          optional:
+                            description: Specify whether the ConfigMap or it's keys
+                              must be defined
+                            type: boolean
+                          volumeLabel:
+                            description: |-
+                              The volume label of the resulting disk inside the VMI.
+                              Different bootstrapping mechanisms require different values.
+                              Typical values are "cidata" (cloud-init), "config-2" (cloud-init) or "OEMDRV" (kickstart).
+                            type: string
+                        type: object
+                        x-kubernetes-map-type: atomic
+                      containerDisk:
+                        description: |-
+                          ContainerDisk references a docker image, embedding a qcow or raw disk.
+                          More info: https://kubevirt.gitbooks.io/user-guide/registry-disk.html
+                        properties:
+                          image:
+                            description: Image is the name of the image with the embedded
+                              disk.
+                            type: string
+                          imagePullPolicy:
+                            description: |-
+                              Image pull policy.
+                              One of Always, Never, IfNotPresent.
+                              Defaults to Always if :latest tag is specified, or IfNotPresent otherwise.
+                              Cannot be updated.
+                              More info: https://kubernetes.io/docs/concepts/containers/images#updating-images
+                            type: string
+                          imagePullSecret:
+                            description: ImagePullSecret is the name of the Docker
+                              registry secret required to pull the image. The secret
+                              must already exist.
+                            type: string
+                          path:
+                            description: Path defines the path to disk file in the
+                              container
+                            type: string
+                        required:
+                        - image
+                        type: object
+                      dataVolume:
+                        description: |-
+                          DataVolume represents the dynamic creation a PVC for this volume as well as
+                          the process of populating that PVC with a disk image.
+                        properties:
+                          hotpluggable:
+                            description: Hotpluggable indicates whether the volume
+                              can be hotplugged and hotunplugged.
+                            type: boolean
+                          name:
+                            description: Name of both the DataVolume and the PVC in
+                              the same namespace.
+                            type: string
+                        required:
+                        - name
+                        type: object
+                      downwardAPI:
+                        description: DownwardAPI represents downward API about the
+                          pod that should populate this volume
+                        properties:
+                          fields:
+                            description: Fields is a list of downward API volume file
+                            items:
+                              description: DownwardAPIVolumeFile represents information
+                                to create the file containing the pod field
                               properties:
-                                encryptedState:
-                                  description: |-
-                                    SEV-ES is required.
-                                    Defaults to false.
-                                  type: boolean
-                              type: object
-                            session:
-                              description: Base64 encoded session blob.
-                              type: string
-                          type: object
-                      type: object
-                    machine:
-                      description: Machine type.
-                      properties:
-                        type:
-                          description: QEMU machine type is the actual chipset of
-                            the VirtualMachineInstance.
-                          type: string
-                      type: object
-                    memory:
-                      description: Memory allow specifying the VMI memory features.
-                      properties:
-                        guest:
-                          anyOf:
-                          - type: integer
-                          - type: string
-                          description: |-
-                            Guest allows to specifying the amount of memory which is visible inside the Guest OS.
-                            The Guest must lie between Requests and Limits from the resources section.
-                            Defaults to the requested memory in the resources section if not specified.
-                          pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
-                          x-kubernetes-int-or-string: true
-                        hugepages:
-                          description: Hugepages allow to use hugepages for the VirtualMachineInstance
-                            instead of regular memory.
-                          properties:
-                            pageSize:
-                              description: PageSize specifies the hugepage size, for
-                                x86_64 architecture valid values are 1Gi and 2Mi.
-                              type: string
-                          type: object
-                        maxGuest:
-                          anyOf:
-                          - type: integer
-                          - type: string
-                          description: |-
-                            MaxGuest allows to specify the maximum amount of memory which is visible inside the Guest OS.
-                            The delta between MaxGuest and Guest is the amount of memory that can be hot(un)plugged.
-                          pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
-                          x-kubernetes-int-or-string: true
-                      type: object
-                    resources:
-                      description: Resources describes the Compute Resources required
-                        by this vmi.
-                      properties:
-                        limits:
-                          additionalProperties:
-                            anyOf:
-                            - type: integer
-                            - type: string
-                            pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
-                            x-kubernetes-int-or-string: true
-                          description: |-
-                            Limits describes the maximum amount of compute resources allowed.
-                            Valid resource keys are "memory" and "cpu".
-                          type: object
-                        overcommitGuestOverhead:
-                          description: |-
-                            Don't ask the scheduler to take the guest-management overhead into account. Instead
-                            put the overhead only into the container's memory limit. This can lead to crashes if
-                            all memory is in use on a node. Defaults to false.
-                          type: boolean
-                        requests:
-                          additionalProperties:
+                                fieldRef:
+                                  description: 'Required: Selects a field of the pod:
+                                    only annotations, labels, name, namespace and
+                                    uid are supported.'
+                                  properties:
+                                    apiVersion:
+                                      description: Version of the schema the FieldPath
+                                        is written in terms of, defaults to "v1".
+                                      type: string
+                                    fieldPath:
+                                      description: Path of the field to select in
+                                        the specified API version.
+                                      type: string
+                                  required:
+                                  - fieldPath
+                                  type: object
+                                  x-kubernetes-map-type: atomic
+                                mode:
+                                  description: |-
+                                    Optional: mode bits used to set permissions on this file, must be an octal value
+                                    between 0000 and 0777 or a decimal value between 0 and 511.
+                                    YAML accepts both octal and decimal values, JSON requires decimal values for mode bits.
+                                    If not specified, the volume defaultMode will be used.
+                                    This might be in conflict with other options that affect the file
+                                    mode, like fsGroup, and the result can be other mode bits set.
+                                  format: int32
+                                  type: integer
+                                path:
+                                  description: 'Required: Path is  the relative path
+                                    name of the file to be created. Must not be absolute
+                                    or contain the ''..'' path. Must be utf-8 encoded.
+                                    The first item of the relative path must not start
+                                    with ''..'''
+                                  type: string
+                                resourceFieldRef:
+                                  description: |-
+                                    Selects a resource of the container: only resources limits and requests
+                                    (limits.cpu, limits.memory, requests.cpu and requests.memory) are currently supported.
+                                  properties:
+                                    containerName:
+                                      description: 'Container name: required for volumes,
+                                        optional for env vars'
+                                      type: string
+                                    divisor:
+                                      anyOf:
+                                      - type: integer
+                                      - type: string
+                                      description: Specifies the output format of
+                                        the exposed resources, defaults to "1"
+                                      pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                      x-kubernetes-int-or-string: true
+                                    resource:
+                                      description: 'Required: resource to select'
+                                      type: string
+                                  required:
+                                  - resource
+                                  type: object
+                                  x-kubernetes-map-type: atomic
+                              required:
+                              - path
+                              type: object
+                            type: array
+                          volumeLabel:
+                            description: |-
+                              The volume label of the resulting disk inside the VMI.
+                              Different bootstrapping mechanisms require different values.
+                              Typical values are "cidata" (cloud-init), "config-2" (cloud-init) or "OEMDRV" (kickstart).
+                            type: string
+                        type: object
+                      downwardMetrics:
+                        description: |-
+                          DownwardMetrics adds a very small disk to VMIs which contains a limited view of host and guest
+                          metrics. The disk content is compatible with vhostmd (https://github.com/vhostmd/vhostmd) and vm-dump-metrics.
+                        type: object
+                      emptyDisk:
+                        description: |-
+                          EmptyDisk represents a temporary disk which shares the vmis lifecycle.
+                          More info: https://kubevirt.gitbooks.io/user-guide/disks-and-volumes.html
+                        properties:
+                          capacity:
                             anyOf:
                             - type: integer
                             - type: string
+                            description: Capacity of the sparse disk.
                             pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
                             x-kubernetes-int-or-string: true
-                          description: |-
-                            Requests is a description of the initial vmi resources.
-                            Valid resource keys are "memory" and "cpu".
-                          type: object
-                      type: object
-                  required:
-                  - devices
-                  type: object
-                evictionStrategy:
-                  description: |-
-                    EvictionStrategy describes the strategy to follow when a node drain occurs.
-                    The possible options are:
-                    - "None": No action will be taken, according to the specified 'RunStrategy' the VirtualMachine will be restarted or shutdown.
-                    - "LiveMigrate": the VirtualMachineInstance will be migrated instead of being shutdown.
-                    - "LiveMigrateIfPossible": the same as "LiveMigrate" but only if the VirtualMachine is Live-Migratable, otherwise it will behave as "None".
-                    - "External": the VirtualMachineInstance will be protected by a PDB and 'vmi.Status.EvacuationNodeName' will be set on eviction. This is mainly useful for cluster-api-provider-kubevirt (capk) which needs a way for VMI's to be blocked from eviction, yet signal capk that eviction has been called on the VMI so the capk controller can handle tearing the VMI down. Details can be found in the commit description https://github.com/kubevirt/kubevirt/commit/c1d77face705c8b126696bac9a3ee3825f27f1fa.
-                  type: string
-                hostname:
-                  description: |-
-                    Specifies the hostname of the vmi
-                    If not specified, the hostname will be set to the name of the vmi, if dhcp or cloud-init is configured properly.
-                  type: string
-                livenessProbe:
-                  description: |-
-                    Periodic probe of VirtualMachineInstance liveness.
-                    VirtualmachineInstances will be stopped if the probe fails.
-                    Cannot be updated.
-                    More info: https://kubernetes.io/docs/concepts/workloads/pods/pod-lifecycle#container-probes
-                  properties:
-                    exec:
-                      description: |-
-                        One and only one of the following should be specified.
-                        Exec specifies the action to take, it will be executed on the guest through the qemu-guest-agent.
-                        If the guest agent is not available, this probe will fail.
-                      properties:
-                        command:
-                          description: |-
-                            Command is the command line to execute inside the container, the working directory for the
-                            command  is root ('/') in the container's filesystem. The command is simply exec'd, it is
-                            not run inside a shell, so traditional shell instructions ('|', etc) won't work. To use
-                            a shell, you need to explicitly call out to that shell.
-                            Exit status of 0 is treated as live/healthy and non-zero is unhealthy.
-                          items:
-                            type: string
-                          type: array
-                          x-kubernetes-list-type: atomic
-                      type: object
-                    failureThreshold:
-                      description: |-
-                        Minimum consecutive failures for the probe to be considered failed after having succeeded.
-                        Defaults to 3. Minimum value is 1.
-                      format: int32
-                      type: integer
-                    guestAgentPing:
-                      description: GuestAgentPing contacts the qemu-guest-agent for
-                        availability checks.
-                      type: object
-                    httpGet:
-                      description: HTTPGet specifies the http request to perform.
-                      properties:
-                        host:
-                          description: |-
-                            Host name to connect to, defaults to the pod IP. You probably want to set
-                            "Host" in httpHeaders instead.
-                          type: string
-                        httpHeaders:
-                          description: Custom headers to set in the request. HTTP
-                            allows repeated headers.
-                          items:
-                            description: HTTPHeader describes a custom header to be
-                              used in HTTP probes
+                        required:
+                        - capacity
+                        type: object
+                      ephemeral:
+                        description: Ephemeral is a special volume source that "wraps"
+                          specified source and provides copy-on-write image on top
+                          of it.
+                        properties:
+                          overlay:
+                            description: |-
+                              Overlay places the copy-on-write overlay holding the writes of the guest
+                              on a dedicated volume instead of the ephemeral storage of the pod.
                             properties:
-                              name:
-                                description: |-
-                                  The header field name.
-                                  This will be canonicalized upon output, so case-variant names will be understood as the same header.
-                                type: string
-                              value:
-                                description: The header field value
+                              capacity:
+                                anyOf:
+                                - type: integer
+                                - type: string
+                                description: Capacity of the overlay volume, limiting
+                                  how much the guest can write.
+                                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                x-kubernetes-int-or-string: true
+                              storageClassName:
+                                description: StorageClassName is the storage class
+                                  of the overlay volume.
                                 type: string
                             required:
-                            - name
-                            - value
+                            - capacity
+                            - storageClassName
                             type: object
-                          type: array
-                          x-kubernetes-list-type: atomic
-                        path:
-                          description: Path to access on the HTTP server.
-                          type: string
-                        port:
-                          anyOf:
-                          - type: integer
-                          - type: string
-                          description: |-
-                            Name or number of the port to access on the container.
-                            Number must be in the range 1 to 65535.
-                            Name must be an IANA_SVC_NAME.
-                          x-kubernetes-int-or-string: true
-                        scheme:
-                          description: |-
-                            Scheme to use for connecting to the host.
-                            Defaults to HTTP.
-                          type: string
-                      required:
-                      - port
-                      type: object
-                    initialDelaySeconds:
-                      description: |-
-                        Number of seconds after the VirtualMachineInstance has started before liveness probes are initiated.
-                        More info: https://kubernetes.io/docs/concepts/workloads/pods/pod-lifecycle#container-probes
-                      format: int32
-                      type: integer
-                    periodSeconds:
-                      description: |-
-                        How often (in seconds) to perform the probe.
-                        Default to 10 seconds. Minimum value is 1.
-                      format: int32
-                      type: integer
-                    successThreshold:
-                      description: |-
-                        Minimum consecutive successes for the probe to be considered successful after having failed.
-                        Defaults to 1. Must be 1 for liveness. Minimum value is 1.
-                      format: int32
-                      type: integer
-                    tcpSocket:
-                      description: |-
-                        TCPSocket specifies an action involving a TCP port.
-                        TCP hooks not yet supported
-                      properties:
-                        host:
-                          description: 'Optional: Host name to connect to, defaults
-                            to the pod IP.'
-                          type: string
-                        port:
-                          anyOf:
-                          - type: integer
-                          - type: string
-                          description: |-
-                            Number or name of the port to access on the container.
-                            Number must be in the range 1 to 65535.
-                            Name must be an IANA_SVC_NAME.
-                          x-kubernetes-int-or-string: true
-                      required:
-                      - port
-                      type: object
-                    timeoutSeconds:
-                      description: |-
-                        Number of seconds after which the probe times out.
-                        For exec probes the timeout fails the probe but does not terminate the command running on the guest.
-                        This means a blocking command can result in an increasing load on the guest.
-                        A small buffer will be added to the resulting workload exec probe to compensate for delays
-                        caused by the qemu guest exec mechanism.
-                        Defaults to 1 second. Minimum value is 1.
-                        More info: https://kubernetes.io/docs/concepts/workloads/pods/pod-lifecycle#container-probes
-                      format: int32
-                      type: integer
-                  type: object
-                networks:
-                  description: List of networks that can be attached to a vm's virtual
-                    interface.
-                  items:
-                    description: Network represents a network type and a resource
-                      that should be connected to the vm.
-                    properties:
-                      multus:
-                        description: Represents the multus cni network.
+                          persistentVolumeClaim:
+                            description: |-
+                              PersistentVolumeClaimVolumeSource represents a reference to a PersistentVolumeClaim in the same namespace.
+                              Directly attached to the vmi via qemu.
+                              More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#persistentvolumeclaims
+                            properties:
+                              claimName:
+                                description: |-
+                                  claimName is the name of a PersistentVolumeClaim in the same namespace as the pod using this volume.
+                                  More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#persistentvolumeclaims
+                                type: string
+                              readOnly:
+                                description: |-
+                                  readOnly Will force the ReadOnly setting in VolumeMounts.
+                                  Default false.
+                                type: boolean
+                            required:
+                            - claimName
+                            type: object
+                        type: object
+                      hostDisk:
+                        description: HostDisk represents a disk created on the cluster
+                          level
                         properties:
-                          default:
-                            description: |-
-                              Select the default network and add it to the
-                              multus-cni.io/default-network annotation.
+                          capacity:
+                            anyOf:
+                            - type: integer
+                            - type: string
+                            description: Capacity of the sparse disk
+                            pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                            x-kubernetes-int-or-string: true
+                          path:
+                            description: The path to HostDisk image located on the
+                              cluster
+                            type: string
+                          shared:
+                            description: Shared indicate whether the path is shared
+                              between nodes
                             type: boolean
-                          networkName:
+                          type:
                             description: |-
-                              References to a NetworkAttachmentDefinition CRD object. Format:
-                              <networkName>, <namespace>/<networkName>. If namespace is not
-                              specified, VMI namespace is assumed.
+                              Contains information if disk.img exists or should be created
+                              allowed options are 'Disk' and 'DiskOrCreate'
                             type: string
                         required:
-                        - networkName
+                        - path
+                        - type
                         type: object
-                      name:
-                        description: |-
-                          Network name.
-                          Must be a DNS_LABEL and unique within the vm.
-                          More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
-                        type: string
-                      pod:
-                        description: Represents the stock pod network interface.
+                      memoryDump:
+                        description: MemoryDump is attached to the virt launcher and
+                          is populated with a memory dump of the vmi
                         properties:
-                          vmIPv6NetworkCIDR:
-                            description: |-
-                              IPv6 CIDR for the vm network.
-                              Defaults to fd10:0:2::/120 if not specified.
-                            type: string
-                          vmNetworkCIDR:
+                          chunkSize:
+                            anyOf:
+                            - type: integer
+                            - type: string
+                            description: ChunkSize is the amount of the dump which
+                              is written to the volume before it is flushed to disk
+                            pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                            x-kubernetes-int-or-string: true
+                          claimName:
                             description: |-
-                              CIDR for vm network.
-                              Default 10.0.2.0/24 if not specified.
+                              claimName is the name of a PersistentVolumeClaim in the same namespace as the pod using this volume.
+                              More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#persistentvolumeclaims
                             type: string
-                        type: object
-                    required:
-                    - name
-                    type: object
-                  maxItems: 256
-                  type: array
-                nodeSelector:
-                  additionalProperties:
-                    type: string
-                  description: |-
-                    NodeSelector is a selector which must be true for the vmi to fit on a node.
-                    Selector which must match a node's labels for the vmi to be scheduled on that node.
-                    More info: https://kubernetes.io/docs/concepts/configuration/assign-pod-node/
-                  type: object
-                priorityClassName:
-                  description: |-
-                    If specified, indicates the pod's priority.
-                    If not specified, the pod priority will be default or zero if there is no
-                    default.
-                  type: string
-                readinessProbe:
-                  description: |-
-                    Periodic probe of VirtualMachineInstance service readiness.
-                    VirtualmachineInstances will be removed from service endpoints if the probe fails.
-                    Cannot be updated.
-                    More info: https://kubernetes.io/docs/concepts/workloads/pods/pod-lifecycle#container-probes
-                  properties:
-                    exec:
-                      description: |-
-                        One and only one of the following should be specified.
-                        Exec specifies the action to take, it will be executed on the guest through the qemu-guest-agent.
-                        If the guest agent is not available, this probe will fail.
-                      properties:
-                        command:
-                          description: |-
-                            Command is the command line to execute inside the container, the working directory for the
-                            command  is root ('/') in the container's filesystem. The command is simply exec'd, it is
-                            not run inside a shell, so traditional shell instructions ('|', etc) won't work. To use
-                            a shell, you need to explicitly call out to that shell.
-                            Exit status of 0 is treated as live/healthy and non-zero is unhealthy.
-                          items:
+                          compression:
+                            description: Compression compresses the memory dump while
+                              it is written to the volume
+                            enum:
+                            - gzip
+                            - zstd
                             type: string
-                          type: array
-                          x-kubernetes-list-type: atomic
-                      type: object
-                    failureThreshold:
-                      description: |-
-                        Minimum consecutive failures for the probe to be considered failed after having succeeded.
-                        Defaults to 3. Minimum value is 1.
-                      format: int32
-                      type: integer
-                    guestAgentPing:
-                      description: GuestAgentPing contacts the qemu-guest-agent for
-                        availability checks.
-                      type: object
-                    httpGet:
-                      description: HTTPGet specifies the http request to perform.
-                      properties:
-                        host:
-                          description: |-
-                            Host name to connect to, defaults to the pod IP. You probably want to set
-                            "Host" in httpHeaders instead.
-                          type: string
-                        httpHeaders:
-                          description: Custom headers to set in the request. HTTP
-                            allows repeated headers.
-                          items:
-                            description: HTTPHeader describes a custom header to be
-                              used in HTTP probes
-                            properties:
-                              name:
-                                description: |-
-                                  The header field name.
-                                  This will be canonicalized upon output, so case-variant names will be understood as the same header.
-                                type: string
-                              value:
-                                description: The header field value
-                                type: string
-                            required:
-                            - name
-                            - value
-                            type: object
-                          type: array
-                          x-kubernetes-list-type: atomic
-                        path:
-                          description: Path to access on the HTTP server.
-                          type: string
-                        port:
-                          anyOf:
-                          - type: integer
-                          - type: string
-                          description: |-
-                            Name or number of the port to access on the container.
-                            Number must be in the range 1 to 65535.
-                            Name must be an IANA_SVC_NAME.
-                          x-kubernetes-int-or-string: true
-                        scheme:
-                          description: |-
-                            Scheme to use for connecting to the host.
-                            Defaults to HTTP.
-                          type: string
-                      required:
-                      - port
-                      type: object
-                    initialDelaySeconds:
-                      description: |-
-                        Number of seconds after the VirtualMachineInstance has started before liveness probes are initiated.
-                        More info: https://kubernetes.io/docs/concepts/workloads/pods/pod-lifecycle#container-probes
-                      format: int32
-                      type: integer
-                    periodSeconds:
-                      description: |-
-                        How often (in seconds) to perform the probe.
-                        Default to 10 seconds. Minimum value is 1.
-                      format: int32
-                      type: integer
-                    successThreshold:
-                      description: |-
-                        Minimum consecutive successes for the probe to be considered successful after having failed.
-                        Defaults to 1. Must be 1 for liveness. Minimum value is 1.
-                      format: int32
-                      type: integer
-                    tcpSocket:
-                      description: |-
-                        TCPSocket specifies an action involving a TCP port.
-                        TCP hooks not yet supported
-                      properties:
-                        host:
-                          description: 'Optional: Host name to connect to, defaults
-                            to the pod IP.'
-                          type: string
-                        port:
-                          anyOf:
-                          - type: integer
-                          - type: string
-                          description: |-
-                            Number or name of the port to access on the container.
-                            Number must be in the range 1 to 65535.
-                            Name must be an IANA_SVC_NAME.
-                          x-kubernetes-int-or-string: true
-                      required:
-                      - port
-                      type: object
-                    timeoutSeconds:
-                      description: |-
-                        Number of seconds after which the probe times out.
-                        For exec probes the timeout fails the probe but does not terminate the command running on the guest.
-                        This means a blocking command can result in an increasing load on the guest.
-                        A small buffer will be added to the resulting workload exec probe to compensate for delays
-                        caused by the qemu guest exec mechanism.
-                        Defaults to 1 second. Minimum value is 1.
-                        More info: https://kubernetes.io/docs/concepts/workloads/pods/pod-lifecycle#container-probes
-                      format: int32
-                      type: integer
-                  type: object
-                schedulerName:
-                  description: |-
-                    If specified, the VMI will be dispatched by specified scheduler.
-                    If not specified, the VMI will be dispatched by default scheduler.
-                  type: string
-                startStrategy:
-                  description: StartStrategy can be set to "Paused" if Virtual Machine
-                    should be started in paused state.
-                  type: string
-                subdomain:
-                  description: |-
-                    If specified, the fully qualified vmi hostname will be "<hostname>.<subdomain>.<pod namespace>.svc.<cluster domain>".
-                    If not specified, the vmi will not have a domainname at all. The DNS entry will resolve to the vmi,
-                    no matter if the vmi itself can pick up a hostname.
-                  type: string
-                terminationGracePeriodSeconds:
-                  description: Grace period observed after signalling a VirtualMachineInstance
-                    to stop after which the VirtualMachineInstance is force terminated.
-                  format: int64
-                  type: integer
-                tolerations:
-                  description: If toleration is specified, obey all the toleration
-                    rules.
-                  items:
-                    description: |-
-                      The pod this Toleration is attached to tolerates any taint that matches
-                      the triple <key,value,effect> using the matching operator <operator>.
-                    properties:
-                      effect:
-                        description: |-
-                          Effect indicates the taint effect to match. Empty means match all taint effects.
-                          When specified, allowed values are NoSchedule, PreferNoSchedule and NoExecute.
-                        type: string
-                      key:
+                          hotpluggable:
+                            description: Hotpluggable indicates whether the volume
+                              can be hotplugged and hotunplugged.
+                            type: boolean
+                          readOnly:
+                            description: |-
+                              readOnly Will force the ReadOnly setting in VolumeMounts.
+                              Default false.
+                            type: boolean
+                        required:
+                        - claimName
+                        type: object
+                      name:
                         description: |-
-                          Key is the taint key that the toleration applies to. Empty means match all taint keys.
-                          If the key is empty, operator must be Exists; this combination means to match all values and all keys.
+                          Volume's name.
+                          Must be a DNS_LABEL and unique within the vmi.
+                          More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
                         type: string
-                      operator:
+                      persistentVolumeClaim:
                         description: |-
-                          Operator represents a key's relationship to the value.
-                          Valid operators are Exists and Equal. Defaults to Equal.
-                          Exists is equivalent to wildcard for value, so that a pod can
-                          tolerate all taints of a particular category.
-                        type: string
-                      tolerationSeconds:
+                          PersistentVolumeClaimVolumeSource represents a reference to a PersistentVolumeClaim in the same namespace.
+                          Directly attached to the vmi via qemu.
+                          More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#persistentvolumeclaims
+                        properties:
+                          claimName:
+                            description: |-
+                              claimName is the name of a PersistentVolumeClaim in the same namespace as the pod using this volume.
+                              More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#persistentvolumeclaims
+                            type: string
+                          hotpluggable:
+                            description: Hotpluggable indicates whether the volume
+                              can be hotplugged and hotunplugged.
+                            type: boolean
+                          readOnly:
+                            description: |-
+                              readOnly Will force the ReadOnly setting in VolumeMounts.
+                              Default false.
+                            type: boolean
+                        required:
+                        - claimName
+                        type: object
+                      secret:
                         description: |-
-                          TolerationSeconds represents the period of time the toleration (which must be
-                          of effect NoExecute, otherwise this field is ignored) tolerates the taint. By default,
-                          it is not set, which means tolerate the taint forever (do not evict). Zero and
-                          negative values will be treated as 0 (evict immediately) by the system.
-                        format: int64
-                        type: integer
-                      value:
+                          SecretVolumeSource represents a reference to a secret data in the same namespace.
+                          More info: https://kubernetes.io/docs/concepts/configuration/secret/
+                        properties:
+                          optional:
+                            description: Specify whether the Secret or it's keys must
+                              be defined
+                            type: boolean
+                          secretName:
+                            description: |-
+                              Name of the secret in the pod's namespace to use.
+                              More info: https://kubernetes.io/docs/concepts/storage/volumes#secret
+                            type: string
+                          volumeLabel:
+                            description: |-
+                              The volume label of the resulting disk inside the VMI.
+                              Different bootstrapping mechanisms require different values.
+                              Typical values are "cidata" (cloud-init), "config-2" (cloud-init) or "OEMDRV" (kickstart).
+                            type: string
+                        type: object
+                      serviceAccount:
                         description: |-
-                          Value is the taint value the toleration matches to.
-                          If the operator is Exists, the value should be empty, otherwise just a regular string.
-                        type: string
+                          ServiceAccountVolumeSource represents a reference to a service account.
+                          There can only be one volume of this type!
+                          More info: https://kubernetes.io/docs/tasks/configure-pod-container/configure-service-account/
+                        properties:
+                          serviceAccountName:
+                            description: |-
+                              Name of the service account in the pod's namespace to use.
+                              More info: https://kubernetes.io/docs/tasks/configure-pod-container/configure-service-account/
+                            type: string
+                        type: object
+                      sysprep:
+                        description: Represents a Sysprep volume source.
+                        properties:
+                          configMap:
+                            description: ConfigMap references a ConfigMap that contains
+                              Sysprep answer file named autounattend.xml that should
+                              be attached as disk of CDROM type.
+                            properties:
+                              name:
+                                default: ""
+                                description: |-
+                                  Name of the referent.
+                                  This field is effectively required, but due to backwards compatibility is
+                                  allowed to be empty. Instances of this type with an empty value here are
+                                  almost certainly wrong.
+                                  More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+                                type: string
+                            type: object
+                            x-kubernetes-map-type: atomic
+                          secret:
+                            description: Secret references a k8s Secret that contains
+                              Sysprep answer file named autounattend.xml that should
+                              be attached as disk of CDROM type.
+                            properties:
+                              name:
+                                default: ""
+                                description: |-
+                                  Name of the referent.
+                                  This field is effectively required, but due to backwards compatibility is
+                                  allowed to be empty. Instances of this type with an empty value here are
+                                  almost certainly wrong.
+                                  More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+                                type: string
+                            type: object
+                            x-kubernetes-map-type: atomic
+                        type: object
+                    required:
+                    - name
                     type: object
+                  maxItems: 256
                   type: array
-                topologySpreadConstraints:
+              required:
+              - domain
+              type: object
+          type: object
+      required:
+      - selector
+      - template
+      type: object
+    status:
+      description: Status is the high level overview of how the VirtualMachineInstance
+        is doing. It contains information available to controllers and users.
+      nullable: true
+      properties:
+        conditions:
+          items:
+            properties:
+              lastProbeTime:
+                format: date-time
+                nullable: true
+                type: string
+              lastTransitionTime:
+                format: date-time
+                nullable: true
+                type: string
+              message:
+                type: string
+              reason:
+                type: string
+              status:
+                type: string
+              type:
+                type: string
+            required:
+            - status
+            - type
+            type: object
+          type: array
+        labelSelector:
+          description: Canonical form of the label selector for HPA which consumes
+            it through the scale subresource.
+          type: string
+        readyReplicas:
+          description: The number of ready replicas for this replica set.
+          format: int32
+          type: integer
+        replicas:
+          description: Total number of non-terminated pods targeted by this deployment
+            (their labels match the selector).
+          format: int32
+          type: integer
+      type: object
+  required:
+  - spec
+  type: object
+`,
+	"virtualmachineinstancetype": `openAPIV3Schema:
+  description: |-
+    VirtualMachineInstancetype resource contains quantitative and resource related VirtualMachine configuration
+    that can be used by multiple VirtualMachine resources.
+  properties:
+    apiVersion:
+      description: |-
+        APIVersion defines the versioned schema of this representation of an object.
+        Servers should convert recognized schemas to the latest internal value, and
+        may reject unrecognized values.
+        More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources
+      type: string
+    kind:
+      description: |-
+        Kind is a string value representing the REST resource this object represents.
+        Servers may infer this from the endpoint the client submits requests to.
+        Cannot be updated.
+        In CamelCase.
+        More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
+      type: string
+    metadata:
+      type: object
+    spec:
+      description: Required spec describing the instancetype
+      properties:
+        affinity:
+          description: |-
+            If affinity is specified, obey all the affinity rules.
+
+            Affinity is the affinity of the VirtualMachineInstance to nodes and other pods required by the instancetype.
+          properties:
+            nodeAffinity:
+              description: Describes node affinity scheduling rules for the pod.
+              properties:
+                preferredDuringSchedulingIgnoredDuringExecution:
                   description: |-
-                    TopologySpreadConstraints describes how a group of VMIs will be spread across a given topology
-                    domains. K8s scheduler will schedule VMI pods in a way which abides by the constraints.
+                    The scheduler will prefer to schedule pods to nodes that satisfy
+                    the affinity expressions specified by this field, but it may choose
+                    a node that violates one or more of the expressions. The node that is
+                    most preferred is the one with the greatest sum of weights, i.e.
+                    for each node that meets all of the scheduling requirements (resource
+                    request, requiredDuringScheduling affinity expressions, etc.),
+                    compute a sum by iterating through the elements of this field and adding
+                    "weight" to the sum if the node matches the corresponding matchExpressions; the
+                    node(s) with the highest sum are the most preferred.
                   items:
-                    description: TopologySpreadConstraint specifies how to spread
-                      matching pods among the given topology.
+                    description: |-
+                      An empty preferred scheduling term matches all objects with implicit weight 0
+                      (i.e. it's a no-op). A null preferred scheduling term matches no objects (i.e. is also a no-op).
                     properties:
-                      labelSelector:
-                        description: |-
-                          LabelSelector is used to find matching pods.
-                          Pods that match this label selector are counted to determine the number of pods
-                          in their corresponding topology domain.
+                      preference:
+                        description: A node selector term, associated with the corresponding
+                          weight.
                         properties:
                           matchExpressions:
-                            description: matchExpressions is a list of label selector
-                              requirements. The requirements are ANDed.
+                            description: A list of node selector requirements by node's
+                              labels.
+                            items:
+                              description: |-
+                                A node selector requirement is a selector that contains values, a key, and an operator
+                                that relates the key and values.
+                              properties:
+                                key:
+                                  description: The label key that the selector applies
+                                    to.
+                                  type: string
+                                operator:
+                                  description: |-
+                                    Represents a key's relationship to a set of values.
+                                    Valid operators are In, NotIn, Exists, DoesNotExist. Gt, and Lt.
+                                  type: string
+                                values:
+                                  description: |-
+                                    An array of string values. If the operator is In or NotIn,
+                                    the values array must be non-empty. If the operator is Exists or DoesNotExist,
+                                    the values array must be empty. If the operator is Gt or Lt, the values
+                                    array must have a single element, which will be interpreted as an integer.
+                                    This array is replaced during a strategic merge patch.
+                                  items:
+                                    type: string
+                                  type: array
+                                  x-kubernetes-list-type: atomic
+                              required:
+                              - key
+                              - operator
+                              type: object
+                            type: array
+                            x-kubernetes-list-type: atomic
+                          matchFields:
+                            description: A list of node selector requirements by node's
+                              fields.
                             items:
                               description: |-
-                                A label selector requirement is a selector that contains values, a key, and an operator that
-                                relates the key and values.
+                                A node selector requirement is a selector that contains values, a key, and an operator
+                                that relates the key and values.
                               properties:
                                 key:
-                                  description: key is the label key that the selector
-                                    applies to.
+                                  description: The label key that the selector applies
+                                    to.
                                   type: string
                                 operator:
                                   description: |-
-                                    operator represents a key's relationship to a set of values.
-                                    Valid operators are In, NotIn, Exists and DoesNotExist.
+                                    Represents a key's relationship to a set of values.
+                                    Valid operators are In, NotIn, Exists, DoesNotExist. Gt, and Lt.
                                   type: string
                                 values:
                                   description: |-
-                                    values is an array of string values. If the operator is In or NotIn,
+                                    An array of string values. If the operator is In or NotIn,
                                     the values array must be non-empty. If the operator is Exists or DoesNotExist,
-                                    the values array must be empty. This array is replaced during a strategic
-                                    merge patch.
+                                    the values array must be empty. If the operator is Gt or Lt, the values
+                                    array must have a single element, which will be interpreted as an integer.
+                                    This array is replaced during a strategic merge patch.
                                   items:
                                     type: string
                                   type: array
@@ -18315,680 +23274,826 @@ var CRDsValidation map[string]string = map[string]string{
                               type: object
                             type: array
                             x-kubernetes-list-type: atomic
-                          matchLabels:
-                            additionalProperties:
-                              type: string
-                            description: |-
-                              matchLabels is a map of {key,value} pairs. A single {key,value} in the matchLabels
-                              map is equivalent to an element of matchExpressions, whose key field is "key", the
-                              operator is "In", and the values array contains only "value". The requirements are ANDed.
-                            type: object
                         type: object
                         x-kubernetes-map-type: atomic
-                      matchLabelKeys:
-                        description: |-
-                          MatchLabelKeys is a set of pod label keys to select the pods over which
-                          spreading will be calculated. The keys are used to lookup values from the
-                          incoming pod labels, those key-value labels are ANDed with labelSelector
-                          to select the group of existing pods over which spreading will be calculated
-                          for the incoming pod. The same key is forbidden to exist in both MatchLabelKeys and LabelSelector.
-                          MatchLabelKeys cannot be set when LabelSelector isn't set.
-                          Keys that don't exist in the incoming pod labels will
-                          be ignored. A null or empty list means only match against labelSelector.
-
-                          This is a beta field and requires the MatchLabelKeysInPodTopologySpread feature gate to be enabled (enabled by default).
-                        items:
-                          type: string
-                        type: array
-                        x-kubernetes-list-type: atomic
-                      maxSkew:
-                        description: |-
-                          MaxSkew describes the degree to which pods may be unevenly distributed.
-                          When 'whenUnsatisfiable=DoNotSchedule', it is the maximum permitted difference
-                          between the number of matching pods in the target topology and the global minimum.
-                          The global minimum is the minimum number of matching pods in an eligible domain
-                          or zero if the number of eligible domains is less than MinDomains.
-                          For example, in a 3-zone cluster, MaxSkew is set to 1, and pods with the same
-                          labelSelector spread as 2/2/1:
-                          In this case, the global minimum is 1.
-                          | zone1 | zone2 | zone3 |
-                          |  P P  |  P P  |   P   |
-                          - if MaxSkew is 1, incoming pod can only be scheduled to zone3 to become 2/2/2;
-                          scheduling it onto zone1(zone2) would make the ActualSkew(3-1) on zone1(zone2)
-                          violate MaxSkew(1).
-                          - if MaxSkew is 2, incoming pod can be scheduled onto any zone.
-                          When 'whenUnsatisfiable=ScheduleAnyway', it is used to give higher precedence
-                          to topologies that satisfy it.
-                          It's a required field. Default value is 1 and 0 is not allowed.
-                        format: int32
-                        type: integer
-                      minDomains:
-                        description: |-
-                          MinDomains indicates a minimum number of eligible domains.
-                          When the number of eligible domains with matching topology keys is less than minDomains,
-                          Pod Topology Spread treats "global minimum" as 0, and then the calculation of Skew is performed.
-                          And when the number of eligible domains with matching topology keys equals or greater than minDomains,
-                          this value has no effect on scheduling.
-                          As a result, when the number of eligible domains is less than minDomains,
-                          scheduler won't schedule more than maxSkew Pods to those domains.
-                          If value is nil, the constraint behaves as if MinDomains is equal to 1.
-                          Valid values are integers greater than 0.
-                          When value is not nil, WhenUnsatisfiable must be DoNotSchedule.
-
-                          For example, in a 3-zone cluster, MaxSkew is set to 2, MinDomains is set to 5 and pods with the same
-                          labelSelector spread as 2/2/2:
-                          | zone1 | zone2 | zone3 |
-                          |  P P  |  P P  |  P P  |
-                          The number of domains is less than 5(MinDomains), so "global minimum" is treated as 0.
-                          In this situation, new pod with the same labelSelector cannot be scheduled,
-                          because computed skew will be 3(3 - 0) if new Pod is scheduled to any of the three zones,
-                          it will violate MaxSkew.
+                      weight:
+                        description: Weight associated with matching the corresponding
+                          nodeSelectorTerm, in the range 1-100.
                         format: int32
                         type: integer
-                      nodeAffinityPolicy:
-                        description: |-
-                          NodeAffinityPolicy indicates how we will treat Pod's nodeAffinity/nodeSelector
-                          when calculating pod topology spread skew. Options are:
-                          - Honor: only nodes matching nodeAffinity/nodeSelector are included in the calculations.
-                          - Ignore: nodeAffinity/nodeSelector are ignored. All nodes are included in the calculations.
-
-                          If this value is nil, the behavior is equivalent to the Honor policy.
-                          This is a beta-level feature default enabled by the NodeInclusionPolicyInPodTopologySpread feature flag.
-                        type: string
-                      nodeTaintsPolicy:
-                        description: |-
-                          NodeTaintsPolicy indicates how we will treat node taints when calculating
-                          pod topology spread skew. Options are:
-                          - Honor: nodes without taints, along with tainted nodes for which the incoming pod
-                          has a toleration, are included.
-                          - Ignore: node taints are ignored. All nodes are included.
-
-                          If this value is nil, the behavior is equivalent to the Ignore policy.
-                          This is a beta-level feature default enabled by the NodeInclusionPolicyInPodTopologySpread feature flag.
-                        type: string
-                      topologyKey:
-                        description: |-
-                          TopologyKey is the key of node labels. Nodes that have a label with this key
-                          and identical values are considered to be in the same topology.
-                          We consider each <key, value> as a "bucket", and try to put balanced number
-                          of pods into each bucket.
-                          We define a domain as a particular instance of a topology.
-                          Also, we define an eligible domain as a domain whose nodes meet the requirements of
-                          nodeAffinityPolicy and nodeTaintsPolicy.
-                          e.g. If TopologyKey is "kubernetes.io/hostname", each Node is a domain of that topology.
-                          And, if TopologyKey is "topology.kubernetes.io/zone", each zone is a domain of that topology.
-                          It's a required field.
-                        type: string
-                      whenUnsatisfiable:
-                        description: |-
-                          WhenUnsatisfiable indicates how to deal with a pod if it doesn't satisfy
-                          the spread constraint.
-                          - DoNotSchedule (default) tells the scheduler not to schedule it.
-                          - ScheduleAnyway tells the scheduler to schedule the pod in any location,
-                            but giving higher precedence to topologies that would help reduce the
-                            skew.
-                          A constraint is considered "Unsatisfiable" for an incoming pod
-                          if and only if every possible node assignment for that pod would violate
-                          "MaxSkew" on some topology.
-                          For example, in a 3-zone cluster, MaxSkew is set to 1, and pods with the same
-                          labelSelector spread as 3/1/1:
-                          | zone1 | zone2 | zone3 |
-                          | P P P |   P   |   P   |
-                          If WhenUnsatisfiable is set to DoNotSchedule, incoming pod can only be scheduled
-                          to zone2(zone3) to become 3/2/1(3/1/2) as ActualSkew(2-1) on zone2(zone3) satisfies
-                          MaxSkew(1). In other words, the cluster can still be imbalanced, but scheduler
-                          won't make it *more* imbalanced.
-                          It's a required field.
-                        type: string
                     required:
-                    - maxSkew
-                    - topologyKey
-                    - whenUnsatisfiable
+                    - preference
+                    - weight
                     type: object
                   type: array
-                  x-kubernetes-list-map-keys:
-                  - topologyKey
-                  - whenUnsatisfiable
-                  x-kubernetes-list-type: map
-                volumes:
-                  description: List of volumes that can be mounted by disks belonging
-                    to the vmi.
+                  x-kubernetes-list-type: atomic
+                requiredDuringSchedulingIgnoredDuringExecution:
+                  description: |-
+                    If the affinity requirements specified by this field are not met at
+                    scheduling time, the pod will not be scheduled onto the node.
+                    If the affinity requirements specified by this field cease to be met
+                    at some point during pod execution (e.g. due to an update), the system
+                    may or may not try to eventually evict the pod from its node.
+                  properties:
+                    nodeSelectorTerms:
+                      description: Required. A list of node selector terms. The terms
+                        are ORed.
+                      items:
+                        description: |-
+                          A null or empty node selector term matches no objects. The requirements of
+                          them are ANDed.
+                          The TopologySelectorTerm type implements a subset of the NodeSelectorTerm.
+                        properties:
+                          matchExpressions:
+                            description: A list of node selector requirements by node's
+                              labels.
+                            items:
+                              description: |-
+                                A node selector requirement is a selector that contains values, a key, and an operator
+                                that relates the key and values.
+                              properties:
+                                key:
+                                  description: The label key that the selector applies
+                                    to.
+                                  type: string
+                                operator:
+                                  description: |-
+                                    Represents a key's relationship to a set of values.
+                                    Valid operators are In, NotIn, Exists, DoesNotExist. Gt, and Lt.
+                                  type: string
+                                values:
+                                  description: |-
+                                    An array of string values. If the operator is In or NotIn,
+                                    the values array must be non-empty. If the operator is Exists or DoesNotExist,
+                                    the values array must be empty. If the operator is Gt or Lt, the values
+                                    array must have a single element, which will be interpreted as an integer.
+                                    This array is replaced during a strategic merge patch.
+                                  items:
+                                    type: string
+                                  type: array
+                                  x-kubernetes-list-type: atomic
+                              required:
+                              - key
+                              - operator
+                              type: object
+                            type: array
+                            x-kubernetes-list-type: atomic
+                          matchFields:
+                            description: A list of node selector requirements by node's
+                              fields.
+                            items:
+                              description: |-
+                                A node selector requirement is a selector that contains values, a key, and an operator
+                                that relates the key and values.
+                              properties:
+                                key:
+                                  description: The label key that the selector applies
+                                    to.
+                                  type: string
+                                operator:
+                                  description: |-
+                                    Represents a key's relationship to a set of values.
+                                    Valid operators are In, NotIn, Exists, DoesNotExist. Gt, and Lt.
+                                  type: string
+                                values:
+                                  description: |-
+                                    An array of string values. If the operator is In or NotIn,
+                                    the values array must be non-empty. If the operator is Exists or DoesNotExist,
+                                    the values array must be empty. If the operator is Gt or Lt, the values
+                                    array must have a single element, which will be interpreted as an integer.
+                                    This array is replaced during a strategic merge patch.
+                                  items:
+                                    type: string
+                                  type: array
+                                  x-kubernetes-list-type: atomic
+                              required:
+                              - key
+                              - operator
+                              type: object
+                            type: array
+                            x-kubernetes-list-type: atomic
+                        type: object
+                        x-kubernetes-map-type: atomic
+                      type: array
+                      x-kubernetes-list-type: atomic
+                  required:
+                  - nodeSelectorTerms
+                  type: object
+                  x-kubernetes-map-type: atomic
+              type: object
+            podAffinity:
+              description: Describes pod affinity scheduling rules (e.g. co-locate
+                this pod in the same node, zone, etc. as some other pod(s)).
+              properties:
+                preferredDuringSchedulingIgnoredDuringExecution:
+                  description: |-
+                    The scheduler will prefer to schedule pods to nodes that satisfy
+                    the affinity expressions specified by this field, but it may choose
+                    a node that violates one or more of the expressions. The node that is
+                    most preferred is the one with the greatest sum of weights, i.e.
+                    for each node that meets all of the scheduling requirements (resource
+                    request, requiredDuringScheduling affinity expressions, etc.),
+                    compute a sum by iterating through the elements of this field and adding
+                    "weight" to the sum if the node has pods which matches the corresponding podAffinityTerm; the
+                    node(s) with the highest sum are the most preferred.
                   items:
-                    description: Volume represents a named volume in a vmi.
+                    description: The weights of all of the matched WeightedPodAffinityTerm
+                      fields are added per-node to find the most preferred node(s)
                     properties:
-                      cloudInitConfigDrive:
-                        description: |-
-                          CloudInitConfigDrive represents a cloud-init Config Drive user-data source.
-                          The Config Drive data will be added as a disk to the vmi. A proper cloud-init installation is required inside the guest.
-                          More info: https://cloudinit.readthedocs.io/en/latest/topics/datasources/configdrive.html
-                        properties:
-                          networkData:
-                            description: NetworkData contains config drive inline
-                              cloud-init networkdata.
-                            type: string
-                          networkDataBase64:
-                            description: NetworkDataBase64 contains config drive cloud-init
-                              networkdata as a base64 encoded string.
-                            type: string
-                          networkDataSecretRef:
-                            description: NetworkDataSecretRef references a k8s secret
-                              that contains config drive networkdata.
-                            properties:
-                              name:
-                                default: ""
-                                description: |-
-                                  Name of the referent.
-                                  This field is effectively required, but due to backwards compatibility is
-                                  allowed to be empty. Instances of this type with an empty value here are
-                                  almost certainly wrong.
-                                  More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
-                                type: string
-                            type: object
-                            x-kubernetes-map-type: atomic
-                          secretRef:
-                            description: UserDataSecretRef references a k8s secret
-                              that contains config drive userdata.
-                            properties:
-                              name:
-                                default: ""
-                                description: |-
-                                  Name of the referent.
-                                  This field is effectively required, but due to backwards compatibility is
-                                  allowed to be empty. Instances of this type with an empty value here are
-                                  almost certainly wrong.
-                                  More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
-                                type: string
-                            type: object
-                            x-kubernetes-map-type: atomic
-                          userData:
-                            description: UserData contains config drive inline cloud-init
-                              userdata.
-                            type: string
-                          userDataBase64:
-                            description: UserDataBase64 contains config drive cloud-init
-                              userdata as a base64 encoded string.
-                            type: string
-                        type: object
-                      cloudInitNoCloud:
-                        description: |-
-                          CloudInitNoCloud represents a cloud-init NoCloud user-data source.
-                          The NoCloud data will be added as a disk to the vmi. A proper cloud-init installation is required inside the guest.
-                          More info: http://cloudinit.readthedocs.io/en/latest/topics/datasources/nocloud.html
+                      podAffinityTerm:
+                        description: Required. A pod affinity term, associated with
+                          the corresponding weight.
                         properties:
-                          networkData:
-                            description: NetworkData contains NoCloud inline cloud-init
-                              networkdata.
-                            type: string
-                          networkDataBase64:
-                            description: NetworkDataBase64 contains NoCloud cloud-init
-                              networkdata as a base64 encoded string.
-                            type: string
-                          networkDataSecretRef:
-                            description: NetworkDataSecretRef references a k8s secret
-                              that contains NoCloud networkdata.
+                          labelSelector:
+                            description: |-
+                              A label query over a set of resources, in this case pods.
+                              If it's null, this PodAffinityTerm matches with no Pods.
                             properties:
-                              name:
-                                default: ""
+                              matchExpressions:
+                                description: matchExpressions is a list of label selector
+                                  requirements. The requirements are ANDed.
+                                items:
+                                  description: |-
+                                    A label selector requirement is a selector that contains values, a key, and an operator that
+                                    relates the key and values.
+                                  properties:
+                                    key:
+                                      description: key is the label key that the selector
+                                        applies to.
+                                      type: string
+                                    operator:
+                                      description: |-
+                                        operator represents a key's relationship to a set of values.
+                                        Valid operators are In, NotIn, Exists and DoesNotExist.
+                                      type: string
+                                    values:
+                                      description: |-
+                                        values is an array of string values. If the operator is In or NotIn,
+                                        the values array must be non-empty. If the operator is Exists or DoesNotExist,
+                                        the values array must be empty. This array is replaced during a strategic
+                                        merge patch.
+                                      items:
+                                        type: string
+                                      type: array
+                                      x-kubernetes-list-type: atomic
+                                  required:
+                                  - key
+                                  - operator
+                                  type: object
+                                type: array
+                                x-kubernetes-list-type: atomic
+                              matchLabels:
+                                additionalProperties:
+                                  type: string
                                 description: |-
-                                  Name of the referent.
-                                  This field is effectively required, but due to backwards compatibility is
-                                  allowed to be empty. Instances of this type with an empty value here are
-                                  almost certainly wrong.
-                                  More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
-                                type: string
+                                  matchLabels is a map of {key,value} pairs. A single {key,value} in the matchLabels
+                                  map is equivalent to an element of matchExpressions, whose key field is "key", the
+                                  operator is "In", and the values array contains only "value". The requirements are ANDed.
+                                type: object
                             type: object
                             x-kubernetes-map-type: atomic
-                          secretRef:
-                            description: UserDataSecretRef references a k8s secret
-                              that contains NoCloud userdata.
+                          matchLabelKeys:
+                            description: |-
+                              MatchLabelKeys is a set of pod label keys to select which pods will
+                              be taken into consideration. The keys are used to lookup values from the
+                              incoming pod labels, those key-value labels are merged with 'labelSelector' as 'key in (value)'
+                              to select the group of existing pods which pods will be taken into consideration
+                              for the incoming pod's pod (anti) affinity. Keys that don't exist in the incoming
+                              pod labels will be ignored. The default value is empty.
+                              The same key is forbidden to exist in both matchLabelKeys and labelSelector.
+                              Also, matchLabelKeys cannot be set when labelSelector isn't set.
+                              This is a beta field and requires enabling MatchLabelKeysInPodAffinity feature gate (enabled by default).
+                            items:
+                              type: string
+                            type: array
+                            x-kubernetes-list-type: atomic
+                          mismatchLabelKeys:
+                            description: |-
+                              MismatchLabelKeys is a set of pod label keys to select which pods will
+                              be taken into consideration. The keys are used to lookup values from the
+                              incoming pod labels, those key-value labels are merged with 'labelSelector' as 'key notin (value)'
+                              to select the group of existing pods which pods will be taken into consideration
+                              for the incoming pod's pod (anti) affinity. Keys that don't exist in the incoming
+                              pod labels will be ignored. The default value is empty.
+                              The same key is forbidden to exist in both mismatchLabelKeys and labelSelector.
+                              Also, mismatchLabelKeys cannot be set when labelSelector isn't set.
+                              This is a beta field and requires enabling MatchLabelKeysInPodAffinity feature gate (enabled by default).
+                            items:
+                              type: string
+                            type: array
+                            x-kubernetes-list-type: atomic
+                          namespaceSelector:
+                            description: |-
+                              A label query over the set of namespaces that the term applies to.
+                              The term is applied to the union of the namespaces selected by this field
+                              and the ones listed in the namespaces field.
+                              null selector and null or empty namespaces list means "this pod's namespace".
+                              An empty selector ({}) matches all namespaces.
                             properties:
-                              name:
-                                default: ""
+                              matchExpressions:
+                                description: matchExpressions is a list of label selector
+                                  requirements. The requirements are ANDed.
+                                items:
+                                  description: |-
+                                    A label selector requirement is a selector that contains values, a key, and an operator that
+                                    relates the key and values.
+                                  properties:
+                                    key:
+                                      description: key is the label key that the selector
+                                        applies to.
+                                      type: string
+                                    operator:
+                                      description: |-
+                                        operator represents a key's relationship to a set of values.
+                                        Valid operators are In, NotIn, Exists and DoesNotExist.
+                                      type: string
+                                    values:
+                                      description: |-
+                                        values is an array of string values. If the operator is In or NotIn,
+                                        the values array must be non-empty. If the operator is Exists or DoesNotExist,
+                                        the values array must be empty. This array is replaced during a strategic
+                                        merge patch.
+                                      items:
+                                        type: string
+                                      type: array
+                                      x-kubernetes-list-type: atomic
+                                  required:
+                                  - key
+                                  - operator
+                                  type: object
+                                type: array
+                                x-kubernetes-list-type: atomic
+                              matchLabels:
+                                additionalProperties:
+                                  type: string
                                 description: |-
-                                  Name of the referent.
-                                  This field is effectively required, but due to backwards compatibility is
-                                  allowed to be empty. Instances of this type with an empty value here are
-                                  almost certainly wrong.
-                                  More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
-                                type: string
+                                  matchLabels is a map of {key,value} pairs. A single {key,value} in the matchLabels
+                                  map is equivalent to an element of matchExpressions, whose key field is "key", the
+                                  operator is "In", and the values array contains only "value". The requirements are ANDed.
+                                type: object
                             type: object
                             x-kubernetes-map-type: atomic
-                          userData:
-                            description: UserData contains NoCloud inline cloud-init
-                              userdata.
-                            type: string
-                          userDataBase64:
-                            description: UserDataBase64 contains NoCloud cloud-init
-                              userdata as a base64 encoded string.
+                          namespaces:
+                            description: |-
+                              namespaces specifies a static list of namespace names that the term applies to.
+                              The term is applied to the union of the namespaces listed in this field
+                              and the ones selected by namespaceSelector.
+                              null or empty namespaces list and null namespaceSelector means "this pod's namespace".
+                            items:
+                              type: string
+                            type: array
+                            x-kubernetes-list-type: atomic
+                          topologyKey:
+                            description: |-
+                              This pod should be co-located (affinity) or not co-located (anti-affinity) with the pods matching
+                              the labelSelector in the specified namespaces, where co-located is defined as running on a node
+                              whose value of the label with key topologyKey matches that of any node on which any of the
+                              selected pods is running.
+                              Empty topologyKey is not allowed.
                             type: string
+                        required:
+                        - topologyKey
                         type: object
-                      configMap:
+                      weight:
                         description: |-
-                          ConfigMapSource represents a reference to a ConfigMap in the same namespace.
-                          More info: https://kubernetes.io/docs/tasks/configure-pod-container/configure-pod-configmap/
+                          weight associated with matching the corresponding podAffinityTerm,
+                          in the range 1-100.
+                        format: int32
+                        type: integer
+                    required:
+                    - podAffinityTerm
+                    - weight
+                    type: object
+                  type: array
+                  x-kubernetes-list-type: atomic
+                requiredDuringSchedulingIgnoredDuringExecution:
+                  description: |-
+                    If the affinity requirements specified by this field are not met at
+                    scheduling time, the pod will not be scheduled onto the node.
+                    If the affinity requirements specified by this field cease to be met
+                    at some point during pod execution (e.g. due to a pod label update), the
+                    system may or may not try to eventually evict the pod from its node.
+                    When there are multiple elements, the lists of nodes corresponding to each
+                    podAffinityTerm are intersected, i.e. all terms must be satisfied.
+                  items:
+                    description: |-
+                      Defines a set of pods (namely those matching the labelSelector
+                      relative to the given namespace(s)) that this pod should be
+                      co-located (affinity) or not co-located (anti-affinity) with,
+                      where co-located is defined as running on a node whose value of
+                      the label with key <topologyKey> matches that of any node on which
+                      a pod of the set of pods is running
+                    properties:
+                      labelSelector:
+                        description: |-
+                          A label query over a set of resources, in this case pods.
+                          If it's null, this PodAffinityTerm matches with no Pods.
                         properties:
-                          name:
-                            default: ""
-                            description: |-
-                              Name of the referent.
-                              This field is effectively required, but due to backwards compatibility is
-                              allowed to be empty. Instances of this type with an empty value here are
-                              almost certainly wrong.
-                              More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
-                            type: string
-                          optional:
-                            description: Specify whether the ConfigMap or it's keys
-                              must be defined
-                            type: boolean
-                          volumeLabel:
+                          matchExpressions:
+                            description: matchExpressions is a list of label selector
+                              requirements. The requirements are ANDed.
+                            items:
+                              description: |-
+                                A label selector requirement is a selector that contains values, a key, and an operator that
+                                relates the key and values.
+                              properties:
+                                key:
+                                  description: key is the label key that the selector
+                                    applies to.
+                                  type: string
+                                operator:
+                                  description: |-
+                                    operator represents a key's relationship to a set of values.
+                                    Valid operators are In, NotIn, Exists and DoesNotExist.
+                                  type: string
+                                values:
+                                  description: |-
+                                    values is an array of string values. If the operator is In or NotIn,
+                                    the values array must be non-empty. If the operator is Exists or DoesNotExist,
+                                    the values array must be empty. This array is replaced during a strategic
+                                    merge patch.
+                                  items:
+                                    type: string
+                                  type: array
+                                  x-kubernetes-list-type: atomic
+                              required:
+                              - key
+                              - operator
+                              type: object
+                            type: array
+                            x-kubernetes-list-type: atomic
+                          matchLabels:
+                            additionalProperties:
+                              type: string
                             description: |-
-                              The volume label of the resulting disk inside the VMI.
-                              Different bootstrapping mechanisms require different values.
-                              Typical values are "cidata" (cloud-init), "config-2" (cloud-init) or "OEMDRV" (kickstart).
-                            type: string
+                              matchLabels is a map of {key,value} pairs. A single {key,value} in the matchLabels
+                              map is equivalent to an element of matchExpressions, whose key field is "key", the
+                              operator is "In", and the values array contains only "value". The requirements are ANDed.
+                            type: object
                         type: object
                         x-kubernetes-map-type: atomic
-                      containerDisk:
+                      matchLabelKeys:
                         description: |-
-                          ContainerDisk references a docker image, embedding a qcow or raw disk.
-                          More info: https://kubevirt.gitbooks.io/user-guide/registry-disk.html
+                          MatchLabelKeys is a set of pod label keys to select which pods will
+                          be taken into consideration. The keys are used to lookup values from the
+                          incoming pod labels, those key-value labels are merged with 'labelSelector' as 'key in (value)'
+                          to select the group of existing pods which pods will be taken into consideration
+                          for the incoming pod's pod (anti) affinity. Keys that don't exist in the incoming
+                          pod labels will be ignored. The default value is empty.
+                          The same key is forbidden to exist in both matchLabelKeys and labelSelector.
+                          Also, matchLabelKeys cannot be set when labelSelector isn't set.
+                          This is a beta field and requires enabling MatchLabelKeysInPodAffinity feature gate (enabled by default).
+                        items:
+                          type: string
+                        type: array
+                        x-kubernetes-list-type: atomic
+                      mismatchLabelKeys:
+                        description: |-
+                          MismatchLabelKeys is a set of pod label keys to select which pods will
+                          be taken into consideration. The keys are used to lookup values from the
+                          incoming pod labels, those key-value labels are merged with 'labelSelector' as 'key notin (value)'
+                          to select the group of existing pods which pods will be taken into consideration
+                          for the incoming pod's pod (anti) affinity. Keys that don't exist in the incoming
+                          pod labels will be ignored. The default value is empty.
+                          The same key is forbidden to exist in both mismatchLabelKeys and labelSelector.
+                          Also, mismatchLabelKeys cannot be set when labelSelector isn't set.
+                          This is a beta field and requires enabling MatchLabelKeysInPodAffinity feature gate (enabled by default).
+                        items:
+                          type: string
+                        type: array
+                        x-kubernetes-list-type: atomic
+                      namespaceSelector:
+                        description: |-
+                          A label query over the set of namespaces that the term applies to.
+                          The term is applied to the union of the namespaces selected by this field
+                          and the ones listed in the namespaces field.
+                          null selector and null or empty namespaces list means "this pod's namespace".
+                          An empty selector ({}) matches all namespaces.
                         properties:
-                          image:
-                            description: Image is the name of the image with the embedded
-                              disk.
-                            type: string
-                          imagePullPolicy:
+                          matchExpressions:
+                            description: matchExpressions is a list of label selector
+                              requirements. The requirements are ANDed.
+                            items:
+                              description: |-
+                                A label selector requirement is a selector that contains values, a key, and an operator that
+                                relates the key and values.
+                              properties:
+                                key:
+                                  description: key is the label key that the selector
+                                    applies to.
+                                  type: string
+                                operator:
+                                  description: |-
+                                    operator represents a key's relationship to a set of values.
+                                    Valid operators are In, NotIn, Exists and DoesNotExist.
+                                  type: string
+                                values:
+                                  description: |-
+                                    values is an array of string values. If the operator is In or NotIn,
+                                    the values array must be non-empty. If the operator is Exists or DoesNotExist,
+                                    the values array must be empty. This array is replaced during a strategic
+                                    merge patch.
+                                  items:
+                                    type: string
+                                  type: array
+                                  x-kubernetes-list-type: atomic
+                              required:
+                              - key
+                              - operator
+                              type: object
+                            type: array
+                            x-kubernetes-list-type: atomic
+                          matchLabels:
+                            additionalProperties:
+                              type: string
                             description: |-
-                              Image pull policy.
-                              One of Always, Never, IfNotPresent.
-                              Defaults to Always if :latest tag is specified, or IfNotPresent otherwise.
-                              Cannot be updated.
-                              More info: https://kubernetes.io/docs/concepts/containers/images#updating-images
-                            type: string
-                          imagePullSecret:
-                            description: ImagePullSecret is the name of the Docker
-                              registry secret required to pull the image. The secret
-                              must already exist.
-                            type: string
-                          path:
-                            description: Path defines the path to disk file in the
-                              container
-                            type: string
-                        required:
-                        - image
+                              matchLabels is a map of {key,value} pairs. A single {key,value} in the matchLabels
+                              map is equivalent to an element of matchExpressions, whose key field is "key", the
+                              operator is "In", and the values array contains only "value". The requirements are ANDed.
+                            type: object
                         type: object
-                      dataVolume:
+                        x-kubernetes-map-type: atomic
+                      namespaces:
                         description: |-
-                          DataVolume represents the dynamic creation a PVC for this volume as well as
-                          the process of populating that PVC with a disk image.
-                        properties:
-                          hotpluggable:
-                            description: Hotpluggable indicates whether the volume
-                              can be hotplugged and hotunplugged.
-                            type: boolean
-                          name:
-                            description: Name of both the DataVolume and the PVC in
-                              the same namespace.
-                            type: string
-                        required:
-                        - name
-                        type: object
-                      downwardAPI:
-                        description: DownwardAPI represents downward API about the
-                          pod that should populate this volume
+                          namespaces specifies a static list of namespace names that the term applies to.
+                          The term is applied to the union of the namespaces listed in this field
+                          and the ones selected by namespaceSelector.
+                          null or empty namespaces list and null namespaceSelector means "this pod's namespace".
+                        items:
+                          type: string
+                        type: array
+                        x-kubernetes-list-type: atomic
+                      topologyKey:
+                        description: |-
+                          This pod should be co-located (affinity) or not co-located (anti-affinity) with the pods matching
+                          the labelSelector in the specified namespaces, where co-located is defined as running on a node
+                          whose value of the label with key topologyKey matches that of any node on which any of the
+                          selected pods is running.
+                          Empty topologyKey is not allowed.
+                        type: string
+                    required:
+                    - topologyKey
+                    type: object
+                  type: array
+                  x-kubernetes-list-type: atomic
+              type: object
+            podAntiAffinity:
+              description: Describes pod anti-affinity scheduling rules (e.g. avoid
+                putting this pod in the same node, zone, etc. as some other pod(s)).
+              properties:
+                preferredDuringSchedulingIgnoredDuringExecution:
+                  description: |-
+                    The scheduler will prefer to schedule pods to nodes that satisfy
+                    the anti-affinity expressions specified by this field, but it may choose
+                    a node that violates one or more of the expressions. The node that is
+                    most preferred is the one with the greatest sum of weights, i.e.
+                    for each node that meets all of the scheduling requirements (resource
+                    request, requiredDuringScheduling anti-affinity expressions, etc.),
+                    compute a sum by iterating through the elements of this field and adding
+                    "weight" to the sum if the node has pods which matches the corresponding podAffinityTerm; the
+                    node(s) with the highest sum are the most preferred.
+                  items:
+                    description: The weights of all of the matched WeightedPodAffinityTerm
+                      fields are added per-node to find the most preferred node(s)
+                    properties:
+                      podAffinityTerm:
+                        description: Required. A pod affinity term, associated with
+                          the corresponding weight.
                         properties:
-                          fields:
-                            description: Fields is a list of downward API volume file
-                            items:
-                              description: DownwardAPIVolumeFile represents information
-                                to create the file containing the pod field
-                              properties:
-                                fieldRef:
-                                  description: 'Required: Selects a field of the pod:
-                                    only annotations, labels, name, namespace and
-                                    uid are supported.'
+                          labelSelector:
+                            description: |-
+                              A label query over a set of resources, in this case pods.
+                              If it's null, this PodAffinityTerm matches with no Pods.
+                            properties:
+                              matchExpressions:
+                                description: matchExpressions is a list of label selector
+                                  requirements. The requirements are ANDed.
+                                items:
+                                  description: |-
+                                    A label selector requirement is a selector that contains values, a key, and an operator that
+                                    relates the key and values.
                                   properties:
-                                    apiVersion:
-                                      description: Version of the schema the FieldPath
-                                        is written in terms of, defaults to "v1".
+                                    key:
+                                      description: key is the label key that the selector
+                                        applies to.
                                       type: string
-                                    fieldPath:
-                                      description: Path of the field to select in
-                                        the specified API version.
+                                    operator:
+                                      description: |-
+                                        operator represents a key's relationship to a set of values.
+                                        Valid operators are In, NotIn, Exists and DoesNotExist.
                                       type: string
+                                    values:
+                                      description: |-
+                                        values is an array of string values. If the operator is In or NotIn,
+                                        the values array must be non-empty. If the operator is Exists or DoesNotExist,
+                                        the values array must be empty. This array is replaced during a strategic
+                                        merge patch.
+                                      items:
+                                        type: string
+                                      type: array
+                                      x-kubernetes-list-type: atomic
                                   required:
-                                  - fieldPath
+                                  - key
+                                  - operator
                                   type: object
-                                  x-kubernetes-map-type: atomic
-                                mode:
-                                  description: |-
-                                    Optional: mode bits used to set permissions on this file, must be an octal value
-                                    between 0000 and 0777 or a decimal value between 0 and 511.
-                                    YAML accepts both octal and decimal values, JSON requires decimal values for mode bits.
-                                    If not specified, the volume defaultMode will be used.
-                                    This might be in conflict with other options that affect the file
-                                    mode, like fsGroup, and the result can be other mode bits set.
-                                  format: int32
-                                  type: integer
-                                path:
-                                  description: 'Required: Path is  the relative path
-                                    name of the file to be created. Must not be absolute
-                                    or contain the ''..'' path. Must be utf-8 encoded.
-                                    The first item of the relative path must not start
-                                    with ''..'''
+                                type: array
+                                x-kubernetes-list-type: atomic
+                              matchLabels:
+                                additionalProperties:
                                   type: string
-                                resourceFieldRef:
+                                description: |-
+                                  matchLabels is a map of {key,value} pairs. A single {key,value} in the matchLabels
+                                  map is equivalent to an element of matchExpressions, whose key field is "key", the
+                                  operator is "In", and the values array contains only "value". The requirements are ANDed.
+                                type: object
+                            type: object
+                            x-kubernetes-map-type: atomic
+                          matchLabelKeys:
+                            description: |-
+                              MatchLabelKeys is a set of pod label keys to select which pods will
+                              be taken into consideration. The keys are used to lookup values from the
+                              incoming pod labels, those key-value labels are merged with 'labelSelector' as 'key in (value)'
+                              to select the group of existing pods which pods will be taken into consideration
+                              for the incoming pod's pod (anti) affinity. Keys that don't exist in the incoming
+                              pod labels will be ignored. The default value is empty.
+                              The same key is forbidden to exist in both matchLabelKeys and labelSelector.
+                              Also, matchLabelKeys cannot be set when labelSelector isn't set.
+                              This is a beta field and requires enabling MatchLabelKeysInPodAffinity feature gate (enabled by default).
+                            items:
+                              type: string
+                            type: array
+                            x-kubernetes-list-type: atomic
+                          mismatchLabelKeys:
+                            description: |-
+                              MismatchLabelKeys is a set of pod label keys to select which pods will
+                              be taken into consideration. The keys are used to lookup values from the
+                              incoming pod labels, those key-value labels are merged with 'labelSelector' as 'key notin (value)'
+                              to select the group of existing pods which pods will be taken into consideration
+                              for the incoming pod's pod (anti) affinity. Keys that don't exist in the incoming
+                              pod labels will be ignored. The default value is empty.
+                              The same key is forbidden to exist in both mismatchLabelKeys and labelSelector.
+                              Also, mismatchLabelKeys cannot be set when labelSelector isn't set.
+                              This is a beta field and requires enabling MatchLabelKeysInPodAffinity feature gate (enabled by default).
+                            items:
+                              type: string
+                            type: array
+                            x-kubernetes-list-type: atomic
+                          namespaceSelector:
+                            description: |-
+                              A label query over the set of namespaces that the term applies to.
+                              The term is applied to the union of the namespaces selected by this field
+                              and the ones listed in the namespaces field.
+                              null selector and null or empty namespaces list means "this pod's namespace".
+                              An empty selector ({}) matches all namespaces.
+                            properties:
+                              matchExpressions:
+                                description: matchExpressions is a list of label selector
+                                  requirements. The requirements are ANDed.
+                                items:
                                   description: |-
-                                    Selects a resource of the container: only resources limits and requests
-                                    (limits.cpu, limits.memory, requests.cpu and requests.memory) are currently supported.
+                                    A label selector requirement is a selector that contains values, a key, and an operator that
+                                    relates the key and values.
                                   properties:
-                                    containerName:
-                                      description: 'Container name: required for volumes,
-                                        optional for env vars'
+                                    key:
+                                      description: key is the label key that the selector
+                                        applies to.
                                       type: string
-                                    divisor:
-                                      anyOf:
-                                      - type: integer
-                                      - type: string
-                                      description: Specifies the output format of
-                                        the exposed resources, defaults to "1"
-                                      pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
-                                      x-kubernetes-int-or-string: true
-                                    resource:
-                                      description: 'Required: resource to select'
+                                    operator:
+                                      description: |-
+                                        operator represents a key's relationship to a set of values.
+                                        Valid operators are In, NotIn, Exists and DoesNotExist.
                                       type: string
+                                    values:
+                                      description: |-
+                                        values is an array of string values. If the operator is In or NotIn,
+                                        the values array must be non-empty. If the operator is Exists or DoesNotExist,
+                                        the values array must be empty. This array is replaced during a strategic
+                                        merge patch.
+                                      items:
+                                        type: string
+                                      type: array
+                                      x-kubernetes-list-type: atomic
                                   required:
-                                  - resource
+                                  - key
+                                  - operator
                                   type: object
-                                  x-kubernetes-map-type: atomic
-                              required:
-                              - path
-                              type: object
-                            type: array
-                          volumeLabel:
-                            description: |-
-                              The volume label of the resulting disk inside the VMI.
-                              Different bootstrapping mechanisms require different values.
-                              Typical values are "cidata" (cloud-init), "config-2" (cloud-init) or "OEMDRV" (kickstart).
-                            type: string
-                        type: object
-                      downwardMetrics:
-                        description: |-
-                          DownwardMetrics adds a very small disk to VMIs which contains a limited view of host and guest
-                          metrics. The disk content is compatible with vhostmd (https://github.com/vhostmd/vhostmd) and vm-dump-metrics.
-                        type: object
-                      emptyDisk:
-                        description: |-
-                          EmptyDisk represents a temporary disk which shares the vmis lifecycle.
-                          More info: https://kubevirt.gitbooks.io/user-guide/disks-and-volumes.html
-                        properties:
-                          capacity:
-                            anyOf:
-                            - type: integer
-                            - type: string
-                            description: Capacity of the sparse disk.
-                            pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
-                            x-kubernetes-int-or-string: true
-                        required:
-                        - capacity
-                        type: object
-                      ephemeral:
-                        description: Ephemeral is a special volume source that "wraps"
-                          specified source and provides copy-on-write image on top
-                          of it.
-                        properties:
-                          persistentVolumeClaim:
-                            description: |-
-                              PersistentVolumeClaimVolumeSource represents a reference to a PersistentVolumeClaim in the same namespace.
-                              Directly attached to the vmi via qemu.
-                              More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#persistentvolumeclaims
-                            properties:
-                              claimName:
-                                description: |-
-                                  claimName is the name of a PersistentVolumeClaim in the same namespace as the pod using this volume.
-                                  More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#persistentvolumeclaims
-                                type: string
-                              readOnly:
+                                type: array
+                                x-kubernetes-list-type: atomic
+                              matchLabels:
+                                additionalProperties:
+                                  type: string
                                 description: |-
-                                  readOnly Will force the ReadOnly setting in VolumeMounts.
-                                  Default false.
-                                type: boolean
-                            required:
-                            - claimName
+                                  matchLabels is a map of {key,value} pairs. A single {key,value} in the matchLabels
+                                  map is equivalent to an element of matchExpressions, whose key field is "key", the
+                                  operator is "In", and the values array contains only "value". The requirements are ANDed.
+                                type: object
                             type: object
-                        type: object
-                      hostDisk:
-                        description: HostDisk represents a disk created on the cluster
-                          level
-                        properties:
-                          capacity:
-                            anyOf:
-                            - type: integer
-                            - type: string
-                            description: Capacity of the sparse disk
-                            pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
-                            x-kubernetes-int-or-string: true
-                          path:
-                            description: The path to HostDisk image located on the
-                              cluster
-                            type: string
-                          shared:
-                            description: Shared indicate whether the path is shared
-                              between nodes
-                            type: boolean
-                          type:
+                            x-kubernetes-map-type: atomic
+                          namespaces:
                             description: |-
-                              Contains information if disk.img exists or should be created
-                              allowed options are 'Disk' and 'DiskOrCreate'
-                            type: string
-                        required:
-                        - path
-                        - type
-                        type: object
-                      memoryDump:
-                        description: MemoryDump is attached to the virt launcher and
-                          is populated with a memory dump of the vmi
-                        properties:
-                          claimName:
+                              namespaces specifies a static list of namespace names that the term applies to.
+                              The term is applied to the union of the namespaces listed in this field
+                              and the ones selected by namespaceSelector.
+                              null or empty namespaces list and null namespaceSelector means "this pod's namespace".
+                            items:
+                              type: string
+                            type: array
+                            x-kubernetes-list-type: atomic
+                          topologyKey:
                             description: |-
-                              claimName is the name of a PersistentVolumeClaim in the same namespace as the pod using this volume.
-                              More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#persistentvolumeclaims
+                              This pod should be co-located (affinity) or not co-located (anti-affinity) with the pods matching
+                              the labelSelector in the specified namespaces, where co-located is defined as running on a node
+                              whose value of the label with key topologyKey matches that of any node on which any of the
+                              selected pods is running.
+                              Empty topologyKey is not allowed.
                             type: string
-                          hotpluggable:
-                            description: Hotpluggable indicates whether the volume
-                              can be hotplugged and hotunplugged.
-                            type: boolean
-                          readOnly:
-                            description: |-
-                              readOnly Will force the ReadOnly setting in VolumeMounts.
-                              Default false.
-                            type: boolean
                         required:
-                        - claimName
+                        - topologyKey
                         type: object
-                      name:
+                      weight:
                         description: |-
-                          Volume's name.
-                          Must be a DNS_LABEL and unique within the vmi.
-                          More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
-                        type: string
-                      persistentVolumeClaim:
+                          weight associated with matching the corresponding podAffinityTerm,
+                          in the range 1-100.
+                        format: int32
+                        type: integer
+                    required:
+                    - podAffinityTerm
+                    - weight
+                    type: object
+                  type: array
+                  x-kubernetes-list-type: atomic
+                requiredDuringSchedulingIgnoredDuringExecution:
+                  description: |-
+                    If the anti-affinity requirements specified by this field are not met at
+                    scheduling time, the pod will not be scheduled onto the node.
+                    If the anti-affinity requirements specified by this field cease to be met
+                    at some point during pod execution (e.g. due to a pod label update), the
+                    system may or may not try to eventually evict the pod from its node.
+                    When there are multiple elements, the lists of nodes corresponding to each
+                    podAffinityTerm are intersected, i.e. all terms must be satisfied.
+                  items:
+                    description: |-
+                      Defines a set of pods (namely those matching the labelSelector
+                      relative to the given namespace(s)) that this pod should be
+                      co-located (affinity) or not co-located (anti-affinity) with,
+                      where co-located is defined as running on a node whose value of
+                      the label with key <topologyKey> matches that of any node on which
+                      a pod of the set of pods is running
+                    properties:
+                      labelSelector:
                         description: |-
-                          PersistentVolumeClaimVolumeSource represents a reference to a PersistentVolumeClaim in the same namespace.
-                          Directly attached to the vmi via qemu.
-                          More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#persistentvolumeclaims
+                          A label query over a set of resources, in this case pods.
+                          If it's null, this PodAffinityTerm matches with no Pods.
                         properties:
-                          claimName:
-                            description: |-
-                              claimName is the name of a PersistentVolumeClaim in the same namespace as the pod using this volume.
-                              More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#persistentvolumeclaims
-                            type: string
-                          hotpluggable:
-                            description: Hotpluggable indicates whether the volume
-                              can be hotplugged and hotunplugged.
-                            type: boolean
-                          readOnly:
+                          matchExpressions:
+                            description: matchExpressions is a list of label selector
+                              requirements. The requirements are ANDed.
+                            items:
+                              description: |-
+                                A label selector requirement is a selector that contains values, a key, and an operator that
+                                relates the key and values.
+                              properties:
+                                key:
+                                  description: key is the label key that the selector
+                                    applies to.
+                                  type: string
+                                operator:
+                                  description: |-
+                                    operator represents a key's relationship to a set of values.
+                                    Valid operators are In, NotIn, Exists and DoesNotExist.
+                                  type: string
+                                values:
+                                  description: |-
+                                    values is an array of string values. If the operator is In or NotIn,
+                                    the values array must be non-empty. If the operator is Exists or DoesNotExist,
+                                    the values array must be empty. This array is replaced during a strategic
+                                    merge patch.
+                                  items:
+                                    type: string
+                                  type: array
+                                  x-kubernetes-list-type: atomic
+                              required:
+                              - key
+                              - operator
+                              type: object
+                            type: array
+                            x-kubernetes-list-type: atomic
+                          matchLabels:
+                            additionalProperties:
+                              type: string
                             description: |-
-                              readOnly Will force the ReadOnly setting in VolumeMounts.
-                              Default false.
-                            type: boolean
-                        required:
-                        - claimName
+                              matchLabels is a map of {key,value} pairs. A single {key,value} in the matchLabels
+                              map is equivalent to an element of matchExpressions, whose key field is "key", the
+                              operator is "In", and the values array contains only "value". The requirements are ANDed.
+                            type: object
                         type: object
-                      secret:
+                        x-kubernetes-map-type: atomic
+                      matchLabelKeys:
                         description: |-
-                          SecretVolumeSource represents a reference to a secret data in the same namespace.
-                          More info: https://kubernetes.io/docs/concepts/configuration/secret/
-                        properties:
-                          optional:
-                            description: Specify whether the Secret or it's keys must
-                              be defined
-                            type: boolean
-                          secretName:
-                            description: |-
-                              Name of the secret in the pod's namespace to use.
-                              More info: https://kubernetes.io/docs/concepts/storage/volumes#secret
-                            type: string
-                          volumeLabel:
-                            description: |-
-                              The volume label of the resulting disk inside the VMI.
-                              Different bootstrapping mechanisms require different values.
-                              Typical values are "cidata" (cloud-init), "config-2" (cloud-init) or "OEMDRV" (kickstart).
-                            type: string
-                        type: object
-                      serviceAccount:
+                          MatchLabelKeys is a set of pod label keys to select which pods will
+                          be taken into consideration. The keys are used to lookup values from the
+                          incoming pod labels, those key-value labels are merged with 'labelSelector' as 'key in (value)'
+                          to select the group of existing pods which pods will be taken into consideration
+                          for the incoming pod's pod (anti) affinity. Keys that don't exist in the incoming
+                          pod labels will be ignored. The default value is empty.
+                          The same key is forbidden to exist in both matchLabelKeys and labelSelector.
+                          Also, matchLabelKeys cannot be set when labelSelector isn't set.
+                          This is a beta field and requires enabling MatchLabelKeysInPodAffinity feature gate (enabled by default).
+                        items:
+                          type: string
+                        type: array
+                        x-kubernetes-list-type: atomic
+                      mismatchLabelKeys:
                         description: |-
-                          ServiceAccountVolumeSource represents a reference to a service account.
-                          There can only be one volume of this type!
-                          More info: https://kubernetes.io/docs/tasks/configure-pod-container/configure-service-account/
-                        properties:
-                          serviceAccountName:
-                            description: |-
-                              Name of the service account in the pod's namespace to use.
-                              More info: https://kubernetes.io/docs/tasks/configure-pod-container/configure-service-account/
-                            type: string
-                        type: object
-                      sysprep:
-                        description: Represents a Sysprep volume source.
+                          MismatchLabelKeys is a set of pod label keys to select which pods will
+                          be taken into consideration. The keys are used to lookup values from the
+                          incoming pod labels, those key-value labels are merged with 'labelSelector' as 'key notin (value)'
+                          to select the group of existing pods which pods will be taken into consideration
+                          for the incoming pod's pod (anti) affinity. Keys that don't exist in the incoming
+                          pod labels will be ignored. The default value is empty.
+                          The same key is forbidden to exist in both mismatchLabelKeys and labelSelector.
+                          Also, mismatchLabelKeys cannot be set when labelSelector isn't set.
+                          This is a beta field and requires enabling MatchLabelKeysInPodAffinity feature gate (enabled by default).
+                        items:
+                          type: string
+                        type: array
+                        x-kubernetes-list-type: atomic
+                      namespaceSelector:
+                        description: |-
+                          A label query over the set of namespaces that the term applies to.
+                          The term is applied to the union of the namespaces selected by this field
+                          and the ones listed in the namespaces field.
+                          null selector and null or empty namespaces list means "this pod's namespace".
+                          An empty selector ({}) matches all namespaces.
                         properties:
-                          configMap:
-                            description: ConfigMap references a ConfigMap that contains
-                              Sysprep answer file named autounattend.xml that should
-                              be attached as disk of CDROM type.
-                            properties:
-                              name:
-                                default: ""
-                                description: |-
-                                  Name of the referent.
-                                  This field is effectively required, but due to backwards compatibility is
-                                  allowed to be empty. Instances of this type with an empty value here are
-                                  almost certainly wrong.
-                                  More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
-                                type: string
-                            type: object
-                            x-kubernetes-map-type: atomic
-                          secret:
-                            description: Secret references a k8s Secret that contains
-                              Sysprep answer file named autounattend.xml that should
-                              be attached as disk of CDROM type.
-                            properties:
-                              name:
-                                default: ""
-                                description: |-
-                                  Name of the referent.
-                                  This field is effectively required, but due to backwards compatibility is
-                                  allowed to be empty. Instances of this type with an empty value here are
-                                  almost certainly wrong.
-                                  More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
-                                type: string
+                          matchExpressions:
+                            description: matchExpressions is a list of label selector
+                              requirements. The requirements are ANDed.
+                            items:
+                              description: |-
+                                A label selector requirement is a selector that contains values, a key, and an operator that
+                                relates the key and values.
+                              properties:
+                                key:
+                                  description: key is the label key that the selector
+                                    applies to.
+                                  type: string
+                                operator:
+                                  description: |-
+                                    operator represents a key's relationship to a set of values.
+                                    Valid operators are In, NotIn, Exists and DoesNotExist.
+                                  type: string
+                                values:
+                                  description: |-
+                                    values is an array of string values. If the operator is In or NotIn,
+                                    the values array must be non-empty. If the operator is Exists or DoesNotExist,
+                                    the values array must be empty. This array is replaced during a strategic
+                                    merge patch.
+                                  items:
+                                    type: string
+                                  type: array
+                                  x-kubernetes-list-type: atomic
+                              required:
+                              - key
+                              - operator
+                              type: object
+                            type: array
+                            x-kubernetes-list-type: atomic
+                          matchLabels:
+                            additionalProperties:
+                              type: string
+                            description: |-
+                              matchLabels is a map of {key,value} pairs. A single {key,value} in the matchLabels
+                              map is equivalent to an element of matchExpressions, whose key field is "key", the
+                              operator is "In", and the values array contains only "value". The requirements are ANDed.
                             type: object
-                            x-kubernetes-map-type: atomic
                         type: object
+                        x-kubernetes-map-type: atomic
+                      namespaces:
+                        description: |-
+                          namespaces specifies a static list of namespace names that the term applies to.
+                          The term is applied to the union of the namespaces listed in this field
+                          and the ones selected by namespaceSelector.
+                          null or empty namespaces list and null namespaceSelector means "this pod's namespace".
+                        items:
+                          type: string
+                        type: array
+                        x-kubernetes-list-type: atomic
+                      topologyKey:
+                        description: |-
+                          This pod should be co-located (affinity) or not co-located (anti-affinity) with the pods matching
+                          the labelSelector in the specified namespaces, where co-located is defined as running on a node
+                          whose value of the label with key topologyKey matches that of any node on which any of the
+                          selected pods is running.
+                          Empty topologyKey is not allowed.
+                        type: string
                     required:
-                    - name
+                    - topologyKey
                     type: object
-                  maxItems: 256
                   type: array
-              required:
-              - domain
+                  x-kubernetes-list-type: atomic
               type: object
           type: object
-      required:
-      - selector
-      - template
-      type: object
-    status:
-      description: Status is the high level overview of how the VirtualMachineInstance
-        is doing. It contains information available to controllers and users.
-      nullable: true
-      properties:
-        conditions:
-          items:
-            properties:
-              lastProbeTime:
-                format: date-time
-                nullable: true
-                type: string
-              lastTransitionTime:
-                format: date-time
-                nullable: true
-                type: string
-              message:
-                type: string
-              reason:
-                type: string
-              status:
-                type: string
-              type:
-                type: string
-            required:
-            - status
-            - type
-            type: object
-          type: array
-        labelSelector:
-          description: Canonical form of the label selector for HPA which consumes
-            it through the scale subresource.
-          type: string
-        readyReplicas:
-          description: The number of ready replicas for this replica set.
-          format: int32
-          type: integer
-        replicas:
-          description: Total number of non-terminated pods targeted by this deployment
-            (their labels match the selector).
-          format: int32
-          type: integer
-      type: object
-  required:
-  - spec
-  type: object
-`,
-	"virtualmachineinstancetype": `openAPIV3Schema:
-  description: |-
-    VirtualMachineInstancetype resource contains quantitative and resource related VirtualMachine configuration
-    that can be used by multiple VirtualMachine resources.
-  properties:
-    apiVersion:
-      description: |-
-        APIVersion defines the versioned schema of this representation of an object.
-        Servers should convert recognized schemas to the latest internal value, and
-        may reject unrecognized values.
-        More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources
-      type: string
-    kind:
-      description: |-
-        Kind is a string value representing the REST resource this object represents.
-        Servers may infer this from the endpoint the client submits requests to.
-        Cannot be updated.
-        In CamelCase.
-        More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
-      type: string
-    metadata:
-      type: object
-    spec:
-      description: Required spec describing the instancetype
-      properties:
         annotations:
           additionalProperties:
             type: string
@@ -19020,12 +24125,21 @@ var CRDsValidation map[string]string = map[string]string{
                 can be hotplugged
               format: int32
               type: integer
+            mitigatedVulnerabilities:
+              description: |-
+                MitigatedVulnerabilities lists the CPU vulnerabilities, as named by the kernel in /sys/devices/system/cpu/vulnerabilities,
+                e.g. retbleed or mds, the node of the VMI has to be mitigated against or not affected by.
+              items:
+                type: string
+              type: array
+              x-kubernetes-list-type: set
             model:
               description: |-
                 Model specifies the CPU model inside the VMI.
                 List of available models https://github.com/libvirt/libvirt/tree/master/src/cpu_map.
                 It is possible to specify special cases like "host-passthrough" to get the same CPU as the node
                 and "host-model" to get CPU closest to the node one.
+                "cluster-common" selects the CPU model and features common to the nodes matching the node selector of the VMI.
                 Defaults to host-model.
               type: string
             numa:
@@ -19199,8 +24313,192 @@ var CRDsValidation map[string]string = map[string]string{
             If specified, the VMI will be dispatched by specified scheduler.
             If not specified, the VMI will be dispatched by default scheduler.
 
-            SchedulerName is the name of the custom K8s scheduler for the instancetype.
-          type: string
+            SchedulerName is the name of the custom K8s scheduler for the instancetype.
+          type: string
+        topologySpreadConstraints:
+          description: |-
+            TopologySpreadConstraints describes how a group of VMIs will be spread across a given topology
+            domains. K8s scheduler will schedule VMI pods in a way which abides by the constraints.
+
+            TopologySpreadConstraints are the topology spread constraints required by the instancetype.
+          items:
+            description: TopologySpreadConstraint specifies how to spread matching
+              pods among the given topology.
+            properties:
+              labelSelector:
+                description: |-
+                  LabelSelector is used to find matching pods.
+                  Pods that match this label selector are counted to determine the number of pods
+                  in their corresponding topology domain.
+                properties:
+                  matchExpressions:
+                    description: matchExpressions is a list of label selector requirements.
+                      The requirements are ANDed.
+                    items:
+                      description: |-
+                        A label selector requirement is a selector that contains values, a key, and an operator that
+                        relates the key and values.
+                      properties:
+                        key:
+                          description: key is the label key that the selector applies
+                            to.
+                          type: string
+                        operator:
+                          description: |-
+                            operator represents a key's relationship to a set of values.
+                            Valid operators are In, NotIn, Exists and DoesNotExist.
+                          type: string
+                        values:
+                          description: |-
+                            values is an array of string values. If the operator is In or NotIn,
+                            the values array must be non-empty. If the operator is Exists or DoesNotExist,
+                            the values array must be empty. This array is replaced during a strategic
+                            merge patch.
+                          items:
+                            type: string
+                          type: array
+                          x-kubernetes-list-type: atomic
+                      required:
+                      - key
+                      - operator
+                      type: object
+                    type: array
+                    x-kubernetes-list-type: atomic
+                  matchLabels:
+                    additionalProperties:
+                      type: string
+                    description: |-
+                      matchLabels is a map of {key,value} pairs. A single {key,value} in the matchLabels
+                      map is equivalent to an element of matchExpressions, whose key field is "key", the
+                      operator is "In", and the values array contains only "value". The requirements are ANDed.
+                    type: object
+                type: object
+                x-kubernetes-map-type: atomic
+              matchLabelKeys:
+                description: |-
+                  MatchLabelKeys is a set of pod label keys to select the pods over which
+                  spreading will be calculated. The keys are used to lookup values from the
+                  incoming pod labels, those key-value labels are ANDed with labelSelector
+                  to select the group of existing pods over which spreading will be calculated
+                  for the incoming pod. The same key is forbidden to exist in both MatchLabelKeys and LabelSelector.
+                  MatchLabelKeys cannot be set when LabelSelector isn't set.
+                  Keys that don't exist in the incoming pod labels will
+                  be ignored. A null or empty list means only match against labelSelector.
+
+                  This is a beta field and requires the MatchLabelKeysInPodTopologySpread feature gate to be enabled (enabled by default).
+                items:
+                  type: string
+                type: array
+                x-kubernetes-list-type: atomic
+              maxSkew:
+                description: |-
+                  MaxSkew describes the degree to which pods may be unevenly distributed.
+                  When 'whenUnsatisfiable=DoNotSchedule', it is the maximum permitted difference
+                  between the number of matching pods in the target topology and the global minimum.
+                  The global minimum is the minimum number of matching pods in an eligible domain
+                  or zero if the number of eligible domains is less than MinDomains.
+                  For example, in a 3-zone cluster, MaxSkew is set to 1, and pods with the same
+                  labelSelector spread as 2/2/1:
+                  In this case, the global minimum is 1.
+                  | zone1 | zone2 | zone3 |
+                  |  P P  |  P P  |   P   |
+                  - if MaxSkew is 1, incoming pod can only be scheduled to zone3 to become 2/2/2;
+                  scheduling it onto zone1(zone2) would make the ActualSkew(3-1) on zone1(zone2)
+                  violate MaxSkew(1).
+                  - if MaxSkew is 2, incoming pod can be scheduled onto any zone.
+                  When 'whenUnsatisfiable=ScheduleAnyway', it is used to give higher precedence
+                  to topologies that satisfy it.
+                  It's a required field. Default value is 1 and 0 is not allowed.
+                format: int32
+                type: integer
+              minDomains:
+                description: |-
+                  MinDomains indicates a minimum number of eligible domains.
+                  When the number of eligible domains with matching topology keys is less than minDomains,
+                  Pod Topology Spread treats "global minimum" as 0, and then the calculation of Skew is performed.
+                  And when the number of eligible domains with matching topology keys equals or greater than minDomains,
+                  this value has no effect on scheduling.
+                  As a result, when the number of eligible domains is less than minDomains,
+                  scheduler won't schedule more than maxSkew Pods to those domains.
+                  If value is nil, the constraint behaves as if MinDomains is equal to 1.
+                  Valid values are integers greater than 0.
+                  When value is not nil, WhenUnsatisfiable must be DoNotSchedule.
+
+                  For example, in a 3-zone cluster, MaxSkew is set to 2, MinDomains is set to 5 and pods with the same
+                  labelSelector spread as 2/2/2:
+                  | zone1 | zone2 | zone3 |
+                  |  P P  |  P P  |  P P  |
+                  The number of domains is less than 5(MinDomains), so "global minimum" is treated as 0.
+                  In this situation, new pod with the same labelSelector cannot be scheduled,
+                  because computed skew will be 3(3 - 0) if new Pod is scheduled to any of the three zones,
+                  it will violate MaxSkew.
+                format: int32
+                type: integer
+              nodeAffinityPolicy:
+                description: |-
+                  NodeAffinityPolicy indicates how we will treat Pod's nodeAffinity/nodeSelector
+                  when calculating pod topology spread skew. Options are:
+                  - Honor: only nodes matching nodeAffinity/nodeSelector are included in the calculations.
+                  - Ignore: nodeAffinity/nodeSelector are ignored. All nodes are included in the calculations.
+
+                  If this value is nil, the behavior is equivalent to the Honor policy.
+                  This is a beta-level feature default enabled by the NodeInclusionPolicyInPodTopologySpread feature flag.
+                type: string
+              nodeTaintsPolicy:
+                description: |-
+                  NodeTaintsPolicy indicates how we will treat node taints when calculating
+                  pod topology spread skew. Options are:
+                  - Honor: nodes without taints, along with tainted nodes for which the incoming pod
+                  has a toleration, are included.
+                  - Ignore: node taints are ignored. All nodes are included.
+
+                  If this value is nil, the behavior is equivalent to the Ignore policy.
+                  This is a beta-level feature default enabled by the NodeInclusionPolicyInPodTopologySpread feature flag.
+                type: string
+              topologyKey:
+                description: |-
+                  TopologyKey is the key of node labels. Nodes that have a label with this key
+                  and identical values are considered to be in the same topology.
+                  We consider each <key, value> as a "bucket", and try to put balanced number
+                  of pods into each bucket.
+                  We define a domain as a particular instance of a topology.
+                  Also, we define an eligible domain as a domain whose nodes meet the requirements of
+                  nodeAffinityPolicy and nodeTaintsPolicy.
+                  e.g. If TopologyKey is "kubernetes.io/hostname", each Node is a domain of that topology.
+                  And, if TopologyKey is "topology.kubernetes.io/zone", each zone is a domain of that topology.
+                  It's a required field.
+                type: string
+              whenUnsatisfiable:
+                description: |-
+                  WhenUnsatisfiable indicates how to deal with a pod if it doesn't satisfy
+                  the spread constraint.
+                  - DoNotSchedule (default) tells the scheduler not to schedule it.
+                  - ScheduleAnyway tells the scheduler to schedule the pod in any location,
+                    but giving higher precedence to topologies that would help reduce the
+                    skew.
+                  A constraint is considered "Unsatisfiable" for an incoming pod
+                  if and only if every possible node assignment for that pod would violate
+                  "MaxSkew" on some topology.
+                  For example, in a 3-zone cluster, MaxSkew is set to 1, and pods with the same
+                  labelSelector spread as 3/1/1:
+                  | zone1 | zone2 | zone3 |
+                  | P P P |   P   |   P   |
+                  If WhenUnsatisfiable is set to DoNotSchedule, incoming pod can only be scheduled
+                  to zone2(zone3) to become 3/2/1(3/1/2) as ActualSkew(2-1) on zone2(zone3) satisfies
+                  MaxSkew(1). In other words, the cluster can still be imbalanced, but scheduler
+                  won't make it *more* imbalanced.
+                  It's a required field.
+                type: string
+            required:
+            - maxSkew
+            - topologyKey
+            - whenUnsatisfiable
+            type: object
+          type: array
+          x-kubernetes-list-map-keys:
+          - topologyKey
+          - whenUnsatisfiable
+          x-kubernetes-list-type: map
       required:
       - cpu
       - memory
@@ -20038,6 +25336,35 @@ var CRDsValidation map[string]string = map[string]string{
                     Mutually exclusive with RunStrategy
                     Deprecated: VirtualMachineInstance field "Running" is now deprecated, please use RunStrategy instead.
                   type: boolean
+                storageMigration:
+                  description: |-
+                    StorageMigration moves the PVC and DataVolume volumes of the VirtualMachine to another StorageClass.
+                    The volumes are copied to new DataVolumes, which replace the original volumes once the copy is done.
+                    The original claims are retained.
+                  properties:
+                    method:
+                      description: |-
+                        Method is the way the volumes are copied. BlockCopy requires the VirtualMachine to run,
+                        CDI requires it to be stopped. Defaults to BlockCopy if the VirtualMachine runs and to CDI otherwise.
+                      enum:
+                      - BlockCopy
+                      - CDI
+                      type: string
+                    storageClassName:
+                      description: StorageClassName is the StorageClass the volumes
+                        are migrated to
+                      type: string
+                    volumes:
+                      description: |-
+                        Volumes restricts the migration to the named volumes. By default all the PVC and DataVolume
+                        volumes whose claims are not in the target StorageClass are migrated.
+                      items:
+                        type: string
+                      type: array
+                      x-kubernetes-list-type: atomic
+                  required:
+                  - storageClassName
+                  type: object
                 template:
                   description: Template is the direct specification of VirtualMachineInstance
                   properties:
@@ -21169,6 +26496,28 @@ var CRDsValidation map[string]string = map[string]string{
                               description: Clock sets the clock and timers of the
                                 vmi.
                               properties:
+                                guestTimeSync:
+                                  description: GuestTimeSync configures when the guest
+                                    clock is set to the host time through the guest
+                                    agent.
+                                  properties:
+                                    driftThresholdSeconds:
+                                      description: |-
+                                        DriftThresholdSeconds is the drift of the guest clock above which it is set to the host time
+                                        with the "OnDrift" policy.
+                                        Defaults to 2.
+                                      format: int32
+                                      type: integer
+                                    policy:
+                                      description: |-
+                                        Policy selects when the guest clock is set to the host time.
+                                        "OnResume" sets it after a live migration and when the vmi is unpaused.
+                                        "OnDrift" additionally sets it whenever the guest clock drifts away from the host clock by more than
+                                        the drift threshold, e.g. after the node resumed from suspend.
+                                        "Disabled" never sets it, leaving the guest clock to the time synchronization of the guest.
+                                        Defaults to "OnResume".
+                                      type: string
+                                  type: object
                                 timer:
                                   description: Timer specifies whih timers are attached
                                     to the vmi.
@@ -21302,6 +26651,24 @@ var CRDsValidation map[string]string = map[string]string{
                                     - name
                                     type: object
                                   type: array
+                                housekeeping:
+                                  description: |-
+                                    Housekeeping gives the QEMU threads which do not run vCPUs, like the emulator and IOThreads,
+                                    their own CPU budget in a child cgroup of the compute container, so they don't take CPU time
+                                    from the vCPUs. Can not be combined with DedicatedCPUPlacement, use IsolateEmulatorThread instead.
+                                  properties:
+                                    cpu:
+                                      anyOf:
+                                      - type: integer
+                                      - type: string
+                                      description: |-
+                                        CPU is added to the CPU requests of the compute container, and to its CPU limits if they are set.
+                                        The housekeeping threads are limited to this amount of CPU, the rest is left to the vCPUs.
+                                      pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                      x-kubernetes-int-or-string: true
+                                  required:
+                                  - cpu
+                                  type: object
                                 isolateEmulatorThread:
                                   description: |-
                                     IsolateEmulatorThread requests one more dedicated pCPU to be allocated for the VMI to place
@@ -21313,12 +26680,21 @@ var CRDsValidation map[string]string = map[string]string{
                                     be hotplugged
                                   format: int32
                                   type: integer
+                                mitigatedVulnerabilities:
+                                  description: |-
+                                    MitigatedVulnerabilities lists the CPU vulnerabilities, as named by the kernel in /sys/devices/system/cpu/vulnerabilities,
+                                    e.g. retbleed or mds, the node of the VMI has to be mitigated against or not affected by.
+                                  items:
+                                    type: string
+                                  type: array
+                                  x-kubernetes-list-type: set
                                 model:
                                   description: |-
                                     Model specifies the CPU model inside the VMI.
                                     List of available models https://github.com/libvirt/libvirt/tree/master/src/cpu_map.
                                     It is possible to specify special cases like "host-passthrough" to get the same CPU as the node
                                     and "host-model" to get CPU closest to the node one.
+                                    "cluster-common" selects the CPU model and features common to the nodes matching the node selector of the VMI.
                                     Defaults to host-model.
                                   type: string
                                 numa:
@@ -21348,6 +26724,24 @@ var CRDsValidation map[string]string = map[string]string{
                                     Must be a value greater or equal 1.
                                   format: int32
                                   type: integer
+                                threadPlacement:
+                                  description: |-
+                                    ThreadPlacement defines on which pCPUs the emulator thread and the IOThreads run.
+                                    Requires DedicatedCPUPlacement.
+                                  properties:
+                                    emulatorThread:
+                                      description: |-
+                                        EmulatorThread defines where the emulator thread runs. With shared it runs on the pCPUs of the vCPUs,
+                                        dedicated and sameNUMANode isolate it on an additional pCPU, like IsolateEmulatorThread does.
+                                        Defaults to dedicated if IsolateEmulatorThread is set and to shared otherwise.
+                                      type: string
+                                    ioThreads:
+                                      description: |-
+                                        IOThreads defines where the IOThreads run. With shared they run on the pCPU of the isolated emulator
+                                        thread, or on the pCPUs of the vCPUs if the emulator thread is not isolated. With dedicated and
+                                        sameNUMANode all IOThreads run together on an additional pCPU. Defaults to shared.
+                                      type: string
+                                  type: object
                                 threads:
                                   description: |-
                                     Threads specifies the number of threads inside the vmi.
@@ -21504,6 +26898,53 @@ var CRDsValidation map[string]string = map[string]string{
                                           IO specifies which QEMU disk IO mode should be used.
                                           Supported values are: native, default, threads.
                                         type: string
+                                      ioTune:
+                                        description: |-
+                                          IOTune limits the IO operations and the throughput of the disk.
+                                          It can be set when hotplugging the disk.
+                                        properties:
+                                          readBytesSec:
+                                            anyOf:
+                                            - type: integer
+                                            - type: string
+                                            description: ReadBytesSec limits the read
+                                              throughput in bytes per second.
+                                            pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                            x-kubernetes-int-or-string: true
+                                          readIOPSSec:
+                                            description: ReadIOPSSec limits the number
+                                              of read operations per second.
+                                            format: int64
+                                            type: integer
+                                          totalBytesSec:
+                                            anyOf:
+                                            - type: integer
+                                            - type: string
+                                            description: TotalBytesSec limits the
+                                              total throughput of reads and writes
+                                              in bytes per second.
+                                            pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                            x-kubernetes-int-or-string: true
+                                          totalIOPSSec:
+                                            description: TotalIOPSSec limits the total
+                                              number of read and write operations
+                                              per second.
+                                            format: int64
+                                            type: integer
+                                          writeBytesSec:
+                                            anyOf:
+                                            - type: integer
+                                            - type: string
+                                            description: WriteBytesSec limits the
+                                              write throughput in bytes per second.
+                                            pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                            x-kubernetes-int-or-string: true
+                                          writeIOPSSec:
+                                            description: WriteIOPSSec limits the number
+                                              of write operations per second.
+                                            format: int64
+                                            type: integer
+                                        type: object
                                       lun:
                                         description: Attach a volume as a LUN to the
                                           vmi.
@@ -21560,6 +27001,93 @@ var CRDsValidation map[string]string = map[string]string{
                                         type: string
                                       virtiofs:
                                         description: Virtiofs is supported
+                                        properties:
+                                          cache:
+                                            description: |-
+                                              Cache selects the caching policy of the virtiofs daemon serving the
+                                              filesystem. Use never when other pods write to the same volume
+                                              concurrently, so that the guest always sees their changes.
+                                              Supported values: auto, always, never. Defaults to auto.
+                                            enum:
+                                            - auto
+                                            - always
+                                            - never
+                                            type: string
+                                          idMapping:
+                                            description: |-
+                                              IDMapping translates the user and group IDs seen by the guest into
+                                              the IDs stored on the shared volume. Use it to let the guest and
+                                              other pods sharing the volume agree on file ownership.
+                                            properties:
+                                              groupIDs:
+                                                description: GroupIDs maps ranges
+                                                  of guest group IDs to group IDs
+                                                  on the volume.
+                                                items:
+                                                  properties:
+                                                    count:
+                                                      description: Count is the number
+                                                        of consecutive IDs in the
+                                                        range.
+                                                      format: int64
+                                                      minimum: 1
+                                                      type: integer
+                                                    guestID:
+                                                      description: GuestID is the
+                                                        first ID of the range as seen
+                                                        by the guest.
+                                                      format: int64
+                                                      minimum: 0
+                                                      type: integer
+                                                    hostID:
+                                                      description: HostID is the first
+                                                        ID of the range as stored
+                                                        on the volume.
+                                                      format: int64
+                                                      minimum: 0
+                                                      type: integer
+                                                  required:
+                                                  - count
+                                                  - guestID
+                                                  - hostID
+                                                  type: object
+                                                type: array
+                                                x-kubernetes-list-type: atomic
+                                              userIDs:
+                                                description: UserIDs maps ranges of
+                                                  guest user IDs to user IDs on the
+                                                  volume.
+                                                items:
+                                                  properties:
+                                                    count:
+                                                      description: Count is the number
+                                                        of consecutive IDs in the
+                                                        range.
+                                                      format: int64
+                                                      minimum: 1
+                                                      type: integer
+                                                    guestID:
+                                                      description: GuestID is the
+                                                        first ID of the range as seen
+                                                        by the guest.
+                                                      format: int64
+                                                      minimum: 0
+                                                      type: integer
+                                                    hostID:
+                                                      description: HostID is the first
+                                                        ID of the range as stored
+                                                        on the volume.
+                                                      format: int64
+                                                      minimum: 0
+                                                      type: integer
+                                                  required:
+                                                  - count
+                                                  - guestID
+                                                  - hostID
+                                                  type: object
+                                                type: array
+                                                x-kubernetes-list-type: atomic
+                                            type: object
                                         type: object
                                     required:
                                     - name
@@ -21666,6 +27194,80 @@ var CRDsValidation map[string]string = map[string]string{
                                           in PCI addresses assigned to the device.
                                           This value is required to be unique across all devices and be between 1 and (16*1024-1).
                                         type: integer
+                                      bandwidth:
+                                        description: |-
+                                          Bandwidth limits the traffic of the interface.
+                                          It is only supported on bridge and masquerade bindings.
+                                        properties:
+                                          inbound:
+                                            description: Inbound limits the traffic
+                                              received by the guest.
+                                            properties:
+                                              average:
+                                                anyOf:
+                                                - type: integer
+                                                - type: string
+                                                description: Average is the average
+                                                  rate the traffic is shaped to, in
+                                                  bytes per second.
+                                                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                                x-kubernetes-int-or-string: true
+                                              burst:
+                                                anyOf:
+                                                - type: integer
+                                                - type: string
+                                                description: Burst is the amount of
+                                                  bytes which may be transferred at
+                                                  the peak rate.
+                                                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                                x-kubernetes-int-or-string: true
+                                              peak:
+                                                anyOf:
+                                                - type: integer
+                                                - type: string
+                                                description: Peak is the rate the
+                                                  traffic may reach while bursting,
+                                                  in bytes per second.
+                                                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                                x-kubernetes-int-or-string: true
+                                            required:
+                                            - average
+                                            type: object
+                                          outbound:
+                                            description: Outbound limits the traffic
+                                              transmitted by the guest.
+                                            properties:
+                                              average:
+                                                anyOf:
+                                                - type: integer
+                                                - type: string
+                                                description: Average is the average
+                                                  rate the traffic is shaped to, in
+                                                  bytes per second.
+                                                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                                x-kubernetes-int-or-string: true
+                                              burst:
+                                                anyOf:
+                                                - type: integer
+                                                - type: string
+                                                description: Burst is the amount of
+                                                  bytes which may be transferred at
+                                                  the peak rate.
+                                                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                                x-kubernetes-int-or-string: true
+                                              peak:
+                                                anyOf:
+                                                - type: integer
+                                                - type: string
+                                                description: Peak is the rate the
+                                                  traffic may reach while bursting,
+                                                  in bytes per second.
+                                                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                                x-kubernetes-int-or-string: true
+                                            required:
+                                            - average
+                                            type: object
+                                        type: object
                                       binding:
                                         description: |-
                                           Binding specifies the binding plugin that will be used to connect the interface to the guest.
@@ -21700,6 +27302,12 @@ var CRDsValidation map[string]string = map[string]string{
                                             description: If specified will pass option
                                               67 to interface's DHCP server
                                             type: string
+                                          mtu:
+                                            description: If specified will pass the
+                                              MTU to the VM via DHCP option 026 instead
+                                              of the MTU of the pod interface.
+                                            format: int32
+                                            type: integer
                                           ntpServers:
                                             description: If specified will pass the
                                               configured NTP server to the VM via
@@ -21730,6 +27338,31 @@ var CRDsValidation map[string]string = map[string]string{
                                               - value
                                               type: object
                                             type: array
+                                          routes:
+                                            description: If specified will pass the
+                                              routes to the VM via DHCP option 121,
+                                              in addition to the routes of the pod
+                                              interface.
+                                            items:
+                                              description: DHCPRoute defines a classless
+                                                static route passed to the VM via
+                                                DHCP.
+                                              properties:
+                                                destination:
+                                                  description: |-
+                                                    Destination is the IPv4 CIDR of the route
+                                                    Required.
+                                                  type: string
+                                                gateway:
+                                                  description: Gateway is the IPv4
+                                                    address of the next hop, the route
+                                                    is on-link if omitted
+                                                  type: string
+                                              required:
+                                              - destination
+                                              type: object
+                                            type: array
+                                            x-kubernetes-list-type: atomic
                                           tftpServerName:
                                             description: If specified will pass option
                                               66 to interface's DHCP server
@@ -21876,6 +27509,34 @@ var CRDsValidation map[string]string = map[string]string{
                                         Defaults to false
                                       type: boolean
                                   type: object
+                                usbDevices:
+                                  description: |-
+                                    USBDevices are host USB devices passed through to the vmi. They are allocated by virt-handler
+                                    among the devices plugged into the node, and attached to the vmi as they get plugged.
+                                  items:
+                                    description: |-
+                                      USBDevice selects a USB device plugged into the node of the vmi, either by its vendor and product
+                                      identifiers or by the port it is plugged into. Exactly one of the selectors has to be set.
+                                    properties:
+                                      busPort:
+                                        description: |-
+                                          BusPort selects the device plugged into a port, by the bus number and port path the kernel
+                                          names the device after, e.g. 1-2.3
+                                        type: string
+                                      name:
+                                        description: Name of the USB device, unique
+                                          within the vmi
+                                        type: string
+                                      vendorProduct:
+                                        description: VendorProduct selects a free
+                                          device by its vendor_id:product_id tuple,
+                                          e.g. 1050:0407
+                                        type: string
+                                    required:
+                                    - name
+                                    type: object
+                                  type: array
+                                  x-kubernetes-list-type: atomic
                                 useVirtioTransitional:
                                   description: |-
                                     Fall back to legacy virtio 0.9 support if virtio bus is selected on devices.
@@ -22379,6 +28040,26 @@ var CRDsValidation map[string]string = map[string]string{
                             - "LiveMigrateIfPossible": the same as "LiveMigrate" but only if the VirtualMachine is Live-Migratable, otherwise it will behave as "None".
                             - "External": the VirtualMachineInstance will be protected by a PDB and 'vmi.Status.EvacuationNodeName' will be set on eviction. This is mainly useful for cluster-api-provider-kubevirt (capk) which needs a way for VMI's to be blocked from eviction, yet signal capk that eviction has been called on the VMI so the capk controller can handle tearing the VMI down. Details can be found in the commit description https://github.com/kubevirt/kubevirt/commit/c1d77face705c8b126696bac9a3ee3825f27f1fa.
                           type: string
+                        guestLicense:
+                          description: |-
+                            GuestLicense describes the licensing of the guest operating system.
+                            It is exposed in the kubevirt_vmi_guest_license_info metric.
+                          properties:
+                            byol:
+                              description: |-
+                                BYOL tells if the license of the guest operating system is brought by the owner of the VirtualMachineInstance
+                                instead of being provided with the cluster.
+                              type: boolean
+                            licenseModel:
+                              description: LicenseModel is the model the guest operating
+                                system is licensed under, like subscription or perCore.
+                              type: string
+                            osFamily:
+                              description: |-
+                                OSFamily is the family of the guest operating system, one of linux, windows or other.
+                                If not set, it is detected from the operating system reported by the guest agent.
+                              type: string
+                          type: object
                         hostname:
                           description: |-
                             Specifies the hostname of the vmi
@@ -22722,6 +28403,26 @@ var CRDsValidation map[string]string = map[string]string{
                             If specified, the VMI will be dispatched by specified scheduler.
                             If not specified, the VMI will be dispatched by default scheduler.
                           type: string
+                        shutdownPolicy:
+                          description: |-
+                            ShutdownPolicy escalates the graceful shutdown of the guest in stages, from an ACPI power button press,
+                            to a shutdown command sent through the guest agent, to forcefully powering off the guest.
+                            If not set, the guest is asked to shut down until the termination grace period expires.
+                          properties:
+                            acpiTimeoutSeconds:
+                              description: |-
+                                ACPITimeoutSeconds is the time the guest is given to power off after the ACPI power button was pressed.
+                                Defaults to 30.
+                              format: int64
+                              type: integer
+                            guestAgentTimeoutSeconds:
+                              description: |-
+                                GuestAgentTimeoutSeconds is the time the guest is given to power off after the shutdown command was sent
+                                through the guest agent, before it is forcefully powered off. The stage is skipped if no guest agent is connected.
+                                Defaults to 30.
+                              format: int64
+                              type: integer
+                          type: object
                         startStrategy:
                           description: StartStrategy can be set to "Paused" if Virtual
                             Machine should be started in paused state.
@@ -23255,6 +28956,27 @@ var CRDsValidation map[string]string = map[string]string{
                                   that "wraps" specified source and provides copy-on-write
                                   image on top of it.
                                 properties:
+                                  overlay:
+                                    description: |-
+                                      Overlay places the copy-on-write overlay holding the writes of the guest
+                                      on a dedicated volume instead of the ephemeral storage of the pod.
+                                    properties:
+                                      capacity:
+                                        anyOf:
+                                        - type: integer
+                                        - type: string
+                                        description: Capacity of the overlay volume,
+                                          limiting how much the guest can write.
+                                        pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                        x-kubernetes-int-or-string: true
+                                      storageClassName:
+                                        description: StorageClassName is the storage
+                                          class of the overlay volume.
+                                        type: string
+                                    required:
+                                    - capacity
+                                    - storageClassName
+                                    type: object
                                   persistentVolumeClaim:
                                     description: |-
                                       PersistentVolumeClaimVolumeSource represents a reference to a PersistentVolumeClaim in the same namespace.
@@ -23307,11 +29029,27 @@ var CRDsValidation map[string]string = map[string]string{
                                 description: MemoryDump is attached to the virt launcher
                                   and is populated with a memory dump of the vmi
                                 properties:
+                                  chunkSize:
+                                    anyOf:
+                                    - type: integer
+                                    - type: string
+                                    description: ChunkSize is the amount of the dump
+                                      which is written to the volume before it is
+                                      flushed to disk
+                                    pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                    x-kubernetes-int-or-string: true
                                   claimName:
                                     description: |-
                                       claimName is the name of a PersistentVolumeClaim in the same namespace as the pod using this volume.
                                       More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#persistentvolumeclaims
                                     type: string
+                                  compression:
+                                    description: Compression compresses the memory
+                                      dump while it is written to the volume
+                                    enum:
+                                    - gzip
+                                    - zstd
+                                    type: string
                                   hotpluggable:
                                     description: Hotpluggable indicates whether the
                                       volume can be hotplugged and hotunplugged.
@@ -24088,6 +29826,30 @@ var CRDsValidation map[string]string = map[string]string{
                 Deprecated: Will be removed with v1beta2 or v1
               type: boolean
           type: object
+        inheritFrom:
+          description: |-
+            InheritFrom optionally references base preferences whose attributes are inherited.
+            The base preferences are merged in order, later ones taking precedence over earlier ones, and the attributes
+            set by this preference take precedence over all of them. Maps are merged, lists are replaced.
+            VirtualMachineClusterPreferences can only inherit from VirtualMachineClusterPreferences.
+          items:
+            description: PreferenceReference references a preference to inherit from
+            properties:
+              kind:
+                description: |-
+                  Kind specifies which preference resource is referenced.
+                  Allowed values are: "VirtualMachinePreference" and "VirtualMachineClusterPreference".
+                  If not specified, "VirtualMachineClusterPreference" is used by default.
+                  VirtualMachinePreferences are looked up in the namespace of the inheriting preference.
+                type: string
+              name:
+                description: Name is the name of the referenced preference
+                type: string
+            required:
+            - name
+            type: object
+          type: array
+          x-kubernetes-list-type: atomic
         machine:
           description: Machine optionally defines preferences associated with the
             Machine attribute of a VirtualMachineInstance DomainSpec
@@ -24175,6 +29937,13 @@ var CRDsValidation map[string]string = map[string]string{
     spec:
       description: VirtualMachineRestoreSpec is the spec for a VirtualMachineRestoreresource
       properties:
+        newMacAddresses:
+          additionalProperties:
+            type: string
+          description: |-
+            NewMacAddresses sets the MAC addresses of the interfaces of a target created with the Regenerate identity policy.
+            The key is the interface name. The MAC addresses of the other interfaces are cleared so that new ones are assigned
+          type: object
         patches:
           description: |-
             If the target for the restore does not exist, it will be created. Patches holds JSON patches that would be
@@ -24205,6 +29974,14 @@ var CRDsValidation map[string]string = map[string]string{
           - name
           type: object
           x-kubernetes-map-type: atomic
+        targetIdentityPolicy:
+          description: |-
+            TargetIdentityPolicy defines whether a target created by the restore keeps the identity of the snapshotted VM.
+            It can only be set if the target does not exist. Defaults to Keep
+          enum:
+          - Keep
+          - Regenerate
+          type: string
         targetReadinessPolicy:
           description: |-
             TargetReadinessPolicy defines how to handle the restore in case
@@ -25204,6 +30981,35 @@ var CRDsValidation map[string]string = map[string]string{
                         Mutually exclusive with RunStrategy
                         Deprecated: VirtualMachineInstance field "Running" is now deprecated, please use RunStrategy instead.
                       type: boolean
+                    storageMigration:
+                      description: |-
+                        StorageMigration moves the PVC and DataVolume volumes of the VirtualMachine to another StorageClass.
+                        The volumes are copied to new DataVolumes, which replace the original volumes once the copy is done.
+                        The original claims are retained.
+                      properties:
+                        method:
+                          description: |-
+                            Method is the way the volumes are copied. BlockCopy requires the VirtualMachine to run,
+                            CDI requires it to be stopped. Defaults to BlockCopy if the VirtualMachine runs and to CDI otherwise.
+                          enum:
+                          - BlockCopy
+                          - CDI
+                          type: string
+                        storageClassName:
+                          description: StorageClassName is the StorageClass the volumes
+                            are migrated to
+                          type: string
+                        volumes:
+                          description: |-
+                            Volumes restricts the migration to the named volumes. By default all the PVC and DataVolume
+                            volumes whose claims are not in the target StorageClass are migrated.
+                          items:
+                            type: string
+                          type: array
+                          x-kubernetes-list-type: atomic
+                      required:
+                      - storageClassName
+                      type: object
                     template:
                       description: Template is the direct specification of VirtualMachineInstance
                       properties:
@@ -26350,6 +32156,28 @@ var CRDsValidation map[string]string = map[string]string{
                                   description: Clock sets the clock and timers of
                                     the vmi.
                                   properties:
+                                    guestTimeSync:
+                                      description: GuestTimeSync configures when the
+                                        guest clock is set to the host time through
+                                        the guest agent.
+                                      properties:
+                                        driftThresholdSeconds:
+                                          description: |-
+                                            DriftThresholdSeconds is the drift of the guest clock above which it is set to the host time
+                                            with the "OnDrift" policy.
+                                            Defaults to 2.
+                                          format: int32
+                                          type: integer
+                                        policy:
+                                          description: |-
+                                            Policy selects when the guest clock is set to the host time.
+                                            "OnResume" sets it after a live migration and when the vmi is unpaused.
+                                            "OnDrift" additionally sets it whenever the guest clock drifts away from the host clock by more than
+                                            the drift threshold, e.g. after the node resumed from suspend.
+                                            "Disabled" never sets it, leaving the guest clock to the time synchronization of the guest.
+                                            Defaults to "OnResume".
+                                          type: string
+                                      type: object
                                     timer:
                                       description: Timer specifies whih timers are
                                         attached to the vmi.
@@ -26484,6 +32312,24 @@ var CRDsValidation map[string]string = map[string]string{
                                         - name
                                         type: object
                                       type: array
+                                    housekeeping:
+                                      description: |-
+                                        Housekeeping gives the QEMU threads which do not run vCPUs, like the emulator and IOThreads,
+                                        their own CPU budget in a child cgroup of the compute container, so they don't take CPU time
+                                        from the vCPUs. Can not be combined with DedicatedCPUPlacement, use IsolateEmulatorThread instead.
+                                      properties:
+                                        cpu:
+                                          anyOf:
+                                          - type: integer
+                                          - type: string
+                                          description: |-
+                                            CPU is added to the CPU requests of the compute container, and to its CPU limits if they are set.
+                                            The housekeeping threads are limited to this amount of CPU, the rest is left to the vCPUs.
+                                          pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                          x-kubernetes-int-or-string: true
+                                      required:
+                                      - cpu
+                                      type: object
                                     isolateEmulatorThread:
                                       description: |-
                                         IsolateEmulatorThread requests one more dedicated pCPU to be allocated for the VMI to place
@@ -26495,12 +32341,21 @@ var CRDsValidation map[string]string = map[string]string{
                                         be hotplugged
                                       format: int32
                                       type: integer
+                                    mitigatedVulnerabilities:
+                                      description: |-
+                                        MitigatedVulnerabilities lists the CPU vulnerabilities, as named by the kernel in /sys/devices/system/cpu/vulnerabilities,
+                                        e.g. retbleed or mds, the node of the VMI has to be mitigated against or not affected by.
+                                      items:
+                                        type: string
+                                      type: array
+                                      x-kubernetes-list-type: set
                                     model:
                                       description: |-
                                         Model specifies the CPU model inside the VMI.
                                         List of available models https://github.com/libvirt/libvirt/tree/master/src/cpu_map.
                                         It is possible to specify special cases like "host-passthrough" to get the same CPU as the node
                                         and "host-model" to get CPU closest to the node one.
+                                        "cluster-common" selects the CPU model and features common to the nodes matching the node selector of the VMI.
                                         Defaults to host-model.
                                       type: string
                                     numa:
@@ -26530,6 +32385,24 @@ var CRDsValidation map[string]string = map[string]string{
                                         Must be a value greater or equal 1.
                                       format: int32
                                       type: integer
+                                    threadPlacement:
+                                      description: |-
+                                        ThreadPlacement defines on which pCPUs the emulator thread and the IOThreads run.
+                                        Requires DedicatedCPUPlacement.
+                                      properties:
+                                        emulatorThread:
+                                          description: |-
+                                            EmulatorThread defines where the emulator thread runs. With shared it runs on the pCPUs of the vCPUs,
+                                            dedicated and sameNUMANode isolate it on an additional pCPU, like IsolateEmulatorThread does.
+                                            Defaults to dedicated if IsolateEmulatorThread is set and to shared otherwise.
+                                          type: string
+                                        ioThreads:
+                                          description: |-
+                                            IOThreads defines where the IOThreads run. With shared they run on the pCPU of the isolated emulator
+                                            thread, or on the pCPUs of the vCPUs if the emulator thread is not isolated. With dedicated and
+                                            sameNUMANode all IOThreads run together on an additional pCPU. Defaults to shared.
+                                          type: string
+                                      type: object
                                     threads:
                                       description: |-
                                         Threads specifies the number of threads inside the vmi.
@@ -26688,6 +32561,54 @@ var CRDsValidation map[string]string = map[string]string{
                                               IO specifies which QEMU disk IO mode should be used.
                                               Supported values are: native, default, threads.
                                             type: string
+                                          ioTune:
+                                            description: |-
+                                              IOTune limits the IO operations and the throughput of the disk.
+                                              It can be set when hotplugging the disk.
+                                            properties:
+                                              readBytesSec:
+                                                anyOf:
+                                                - type: integer
+                                                - type: string
+                                                description: ReadBytesSec limits the
+                                                  read throughput in bytes per second.
+                                                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                                x-kubernetes-int-or-string: true
+                                              readIOPSSec:
+                                                description: ReadIOPSSec limits the
+                                                  number of read operations per second.
+                                                format: int64
+                                                type: integer
+                                              totalBytesSec:
+                                                anyOf:
+                                                - type: integer
+                                                - type: string
+                                                description: TotalBytesSec limits
+                                                  the total throughput of reads and
+                                                  writes in bytes per second.
+                                                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                                x-kubernetes-int-or-string: true
+                                              totalIOPSSec:
+                                                description: TotalIOPSSec limits the
+                                                  total number of read and write operations
+                                                  per second.
+                                                format: int64
+                                                type: integer
+                                              writeBytesSec:
+                                                anyOf:
+                                                - type: integer
+                                                - type: string
+                                                description: WriteBytesSec limits
+                                                  the write throughput in bytes per
+                                                  second.
+                                                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                                x-kubernetes-int-or-string: true
+                                              writeIOPSSec:
+                                                description: WriteIOPSSec limits the
+                                                  number of write operations per second.
+                                                format: int64
+                                                type: integer
+                                            type: object
                                           lun:
                                             description: Attach a volume as a LUN
                                               to the vmi.
@@ -26747,6 +32668,93 @@ var CRDsValidation map[string]string = map[string]string{
                                             type: string
                                           virtiofs:
                                             description: Virtiofs is supported
+                                            properties:
+                                              cache:
+                                                description: |-
+                                                  Cache selects the caching policy of the virtiofs daemon serving the
+                                                  filesystem. Use never when other pods write to the same volume
+                                                  concurrently, so that the guest always sees their changes.
+                                                  Supported values: auto, always, never. Defaults to auto.
+                                                enum:
+                                                - auto
+                                                - always
+                                                - never
+                                                type: string
+                                              idMapping:
+                                                description: |-
+                                                  IDMapping translates the user and group IDs seen by the guest into
+                                                  the IDs stored on the shared volume. Use it to let the guest and
+                                                  other pods sharing the volume agree on file ownership.
+                                                properties:
+                                                  groupIDs:
+                                                    description: GroupIDs maps ranges
+                                                      of guest group IDs to group
+                                                      IDs on the volume.
+                                                    items:
+                                                      properties:
+                                                        count:
+                                                          description: Count is the
+                                                            number of consecutive
+                                                            IDs in the range.
+                                                          format: int64
+                                                          minimum: 1
+                                                          type: integer
+                                                        guestID:
+                                                          description: GuestID is
+                                                            the first ID of the range
+                                                            as seen by the guest.
+                                                          format: int64
+                                                          minimum: 0
+                                                          type: integer
+                                                        hostID:
+                                                          description: HostID is the
+                                                            first ID of the range
+                                                            as stored on the volume.
+                                                          format: int64
+                                                          minimum: 0
+                                                          type: integer
+                                                      required:
+                                                      - count
+                                                      - guestID
+                                                      - hostID
+                                                      type: object
+                                                    type: array
+                                                    x-kubernetes-list-type: atomic
+                                                  userIDs:
+                                                    description: UserIDs maps ranges
+                                                      of guest user IDs to user IDs
+                                                      on the volume.
+                                                    items:
+                                                      properties:
+                                                        count:
+                                                          description: Count is the
+                                                            number of consecutive
+                                                            IDs in the range.
+                                                          format: int64
+                                                          minimum: 1
+                                                          type: integer
+                                                        guestID:
+                                                          description: GuestID is
+                                                            the first ID of the range
+                                                            as seen by the guest.
+                                                          format: int64
+                                                          minimum: 0
+                                                          type: integer
+                                                        hostID:
+                                                          description: HostID is the
+                                                            first ID of the range
+                                                            as stored on the volume.
+                                                          format: int64
+                                                          minimum: 0
+                                                          type: integer
+                                                      required:
+                                                      - count
+                                                      - guestID
+                                                      - hostID
+                                                      type: object
+                                                    type: array
+                                                    x-kubernetes-list-type: atomic
+                                                type: object
                                             type: object
                                         required:
                                         - name
@@ -26856,6 +32864,80 @@ var CRDsValidation map[string]string = map[string]string{
                                               in PCI addresses assigned to the device.
                                               This value is required to be unique across all devices and be between 1 and (16*1024-1).
                                             type: integer
+                                          bandwidth:
+                                            description: |-
+                                              Bandwidth limits the traffic of the interface.
+                                              It is only supported on bridge and masquerade bindings.
+                                            properties:
+                                              inbound:
+                                                description: Inbound limits the traffic
+                                                  received by the guest.
+                                                properties:
+                                                  average:
+                                                    anyOf:
+                                                    - type: integer
+                                                    - type: string
+                                                    description: Average is the average
+                                                      rate the traffic is shaped to,
+                                                      in bytes per second.
+                                                    pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                                    x-kubernetes-int-or-string: true
+                                                  burst:
+                                                    anyOf:
+                                                    - type: integer
+                                                    - type: string
+                                                    description: Burst is the amount
+                                                      of bytes which may be transferred
+                                                      at the peak rate.
+                                                    pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                                    x-kubernetes-int-or-string: true
+                                                  peak:
+                                                    anyOf:
+                                                    - type: integer
+                                                    - type: string
+                                                    description: Peak is the rate
+                                                      the traffic may reach while
+                                                      bursting, in bytes per second.
+                                                    pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                                    x-kubernetes-int-or-string: true
+                                                required:
+                                                - average
+                                                type: object
+                                              outbound:
+                                                description: Outbound limits the traffic
+                                                  transmitted by the guest.
+                                                properties:
+                                                  average:
+                                                    anyOf:
+                                                    - type: integer
+                                                    - type: string
+                                                    description: Average is the average
+                                                      rate the traffic is shaped to,
+                                                      in bytes per second.
+                                                    pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                                    x-kubernetes-int-or-string: true
+                                                  burst:
+                                                    anyOf:
+                                                    - type: integer
+                                                    - type: string
+                                                    description: Burst is the amount
+                                                      of bytes which may be transferred
+                                                      at the peak rate.
+                                                    pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                                    x-kubernetes-int-or-string: true
+                                                  peak:
+                                                    anyOf:
+                                                    - type: integer
+                                                    - type: string
+                                                    description: Peak is the rate
+                                                      the traffic may reach while
+                                                      bursting, in bytes per second.
+                                                    pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                                    x-kubernetes-int-or-string: true
+                                                required:
+                                                - average
+                                                type: object
+                                            type: object
                                           binding:
                                             description: |-
                                               Binding specifies the binding plugin that will be used to connect the interface to the guest.
@@ -26890,6 +32972,13 @@ var CRDsValidation map[string]string = map[string]string{
                                                 description: If specified will pass
                                                   option 67 to interface's DHCP server
                                                 type: string
+                                              mtu:
+                                                description: If specified will pass
+                                                  the MTU to the VM via DHCP option
+                                                  026 instead of the MTU of the pod
+                                                  interface.
+                                                format: int32
+                                                type: integer
                                               ntpServers:
                                                 description: If specified will pass
                                                   the configured NTP server to the
@@ -26920,6 +33009,31 @@ var CRDsValidation map[string]string = map[string]string{
                                                   - value
                                                   type: object
                                                 type: array
+                                              routes:
+                                                description: If specified will pass
+                                                  the routes to the VM via DHCP option
+                                                  121, in addition to the routes of
+                                                  the pod interface.
+                                                items:
+                                                  description: DHCPRoute defines a
+                                                    classless static route passed
+                                                    to the VM via DHCP.
+                                                  properties:
+                                                    destination:
+                                                      description: |-
+                                                        Destination is the IPv4 CIDR of the route
+                                                        Required.
+                                                      type: string
+                                                    gateway:
+                                                      description: Gateway is the
+                                                        IPv4 address of the next hop,
+                                                        the route is on-link if omitted
+                                                      type: string
+                                                  required:
+                                                  - destination
+                                                  type: object
+                                                type: array
+                                                x-kubernetes-list-type: atomic
                                               tftpServerName:
                                                 description: If specified will pass
                                                   option 66 to interface's DHCP server
@@ -27067,6 +33181,34 @@ var CRDsValidation map[string]string = map[string]string{
                                             Defaults to false
                                           type: boolean
                                       type: object
+                                    usbDevices:
+                                      description: |-
+                                        USBDevices are host USB devices passed through to the vmi. They are allocated by virt-handler
+                                        among the devices plugged into the node, and attached to the vmi as they get plugged.
+                                      items:
+                                        description: |-
+                                          USBDevice selects a USB device plugged into the node of the vmi, either by its vendor and product
+                                          identifiers or by the port it is plugged into. Exactly one of the selectors has to be set.
+                                        properties:
+                                          busPort:
+                                            description: |-
+                                              BusPort selects the device plugged into a port, by the bus number and port path the kernel
+                                              names the device after, e.g. 1-2.3
+                                            type: string
+                                          name:
+                                            description: Name of the USB device, unique
+                                              within the vmi
+                                            type: string
+                                          vendorProduct:
+                                            description: VendorProduct selects a free
+                                              device by its vendor_id:product_id tuple,
+                                              e.g. 1050:0407
+                                            type: string
+                                        required:
+                                        - name
+                                        type: object
+                                      type: array
+                                      x-kubernetes-list-type: atomic
                                     useVirtioTransitional:
                                       description: |-
                                         Fall back to legacy virtio 0.9 support if virtio bus is selected on devices.
@@ -27571,6 +33713,27 @@ var CRDsValidation map[string]string = map[string]string{
                                 - "LiveMigrateIfPossible": the same as "LiveMigrate" but only if the VirtualMachine is Live-Migratable, otherwise it will behave as "None".
                                 - "External": the VirtualMachineInstance will be protected by a PDB and 'vmi.Status.EvacuationNodeName' will be set on eviction. This is mainly useful for cluster-api-provider-kubevirt (capk) which needs a way for VMI's to be blocked from eviction, yet signal capk that eviction has been called on the VMI so the capk controller can handle tearing the VMI down. Details can be found in the commit description https://github.com/kubevirt/kubevirt/commit/c1d77face705c8b126696bac9a3ee3825f27f1fa.
                               type: string
+                            guestLicense:
+                              description: |-
+                                GuestLicense describes the licensing of the guest operating system.
+                                It is exposed in the kubevirt_vmi_guest_license_info metric.
+                              properties:
+                                byol:
+                                  description: |-
+                                    BYOL tells if the license of the guest operating system is brought by the owner of the VirtualMachineInstance
+                                    instead of being provided with the cluster.
+                                  type: boolean
+                                licenseModel:
+                                  description: LicenseModel is the model the guest
+                                    operating system is licensed under, like subscription
+                                    or perCore.
+                                  type: string
+                                osFamily:
+                                  description: |-
+                                    OSFamily is the family of the guest operating system, one of linux, windows or other.
+                                    If not set, it is detected from the operating system reported by the guest agent.
+                                  type: string
+                              type: object
                             hostname:
                               description: |-
                                 Specifies the hostname of the vmi
@@ -27917,6 +34080,26 @@ var CRDsValidation map[string]string = map[string]string{
                                 If specified, the VMI will be dispatched by specified scheduler.
                                 If not specified, the VMI will be dispatched by default scheduler.
                               type: string
+                            shutdownPolicy:
+                              description: |-
+                                ShutdownPolicy escalates the graceful shutdown of the guest in stages, from an ACPI power button press,
+                                to a shutdown command sent through the guest agent, to forcefully powering off the guest.
+                                If not set, the guest is asked to shut down until the termination grace period expires.
+                              properties:
+                                acpiTimeoutSeconds:
+                                  description: |-
+                                    ACPITimeoutSeconds is the time the guest is given to power off after the ACPI power button was pressed.
+                                    Defaults to 30.
+                                  format: int64
+                                  type: integer
+                                guestAgentTimeoutSeconds:
+                                  description: |-
+                                    GuestAgentTimeoutSeconds is the time the guest is given to power off after the shutdown command was sent
+                                    through the guest agent, before it is forcefully powered off. The stage is skipped if no guest agent is connected.
+                                    Defaults to 30.
+                                  format: int64
+                                  type: integer
+                              type: object
                             startStrategy:
                               description: StartStrategy can be set to "Paused" if
                                 Virtual Machine should be started in paused state.
@@ -28457,6 +34640,27 @@ var CRDsValidation map[string]string = map[string]string{
                                       that "wraps" specified source and provides copy-on-write
                                       image on top of it.
                                     properties:
+                                      overlay:
+                                        description: |-
+                                          Overlay places the copy-on-write overlay holding the writes of the guest
+                                          on a dedicated volume instead of the ephemeral storage of the pod.
+                                        properties:
+                                          capacity:
+                                            anyOf:
+                                            - type: integer
+                                            - type: string
+                                            description: Capacity of the overlay volume,
+                                              limiting how much the guest can write.
+                                            pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                            x-kubernetes-int-or-string: true
+                                          storageClassName:
+                                            description: StorageClassName is the storage
+                                              class of the overlay volume.
+                                            type: string
+                                        required:
+                                        - capacity
+                                        - storageClassName
+                                        type: object
                                       persistentVolumeClaim:
                                         description: |-
                                           PersistentVolumeClaimVolumeSource represents a reference to a PersistentVolumeClaim in the same namespace.
@@ -28510,11 +34714,27 @@ var CRDsValidation map[string]string = map[string]string{
                                       launcher and is populated with a memory dump
                                       of the vmi
                                     properties:
+                                      chunkSize:
+                                        anyOf:
+                                        - type: integer
+                                        - type: string
+                                        description: ChunkSize is the amount of the
+                                          dump which is written to the volume before
+                                          it is flushed to disk
+                                        pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                        x-kubernetes-int-or-string: true
                                       claimName:
                                         description: |-
                                           claimName is the name of a PersistentVolumeClaim in the same namespace as the pod using this volume.
                                           More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#persistentvolumeclaims
                                         type: string
+                                      compression:
+                                        description: Compression compresses the memory
+                                          dump while it is written to the volume
+                                        enum:
+                                        - gzip
+                                        - zstd
+                                        type: string
                                       hotpluggable:
                                         description: Hotpluggable indicates whether
                                           the volume can be hotplugged and hotunplugged.
@@ -28724,10 +34944,28 @@ var CRDsValidation map[string]string = map[string]string{
                         dump to the given pvc
                       nullable: true
                       properties:
+                        chunkSize:
+                          anyOf:
+                          - type: integer
+                          - type: string
+                          description: |-
+                            ChunkSize is the amount of the dump which is written to the pvc before it is flushed and dropped from the
+                            page cache of the virt-launcher pod. Defaults to 64Mi if the dump is compressed, otherwise the dump is
+                            written by the hypervisor directly
+                          pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                          x-kubernetes-int-or-string: true
                         claimName:
                           description: ClaimName is the name of the pvc that will
                             contain the memory dump
                           type: string
+                        compression:
+                          description: |-
+                            Compression compresses the memory dump while it is written to the pvc. The compressed dump does not
+                            have to fit the memory of the vm, the pvc size is therefore not validated. Defaults to no compression
+                          enum:
+                          - gzip
+                          - zstd
+                          type: string
                         endTimestamp:
                           description: EndTimestamp represents the time the memory
                             dump was completed
@@ -28856,6 +35094,63 @@ var CRDsValidation map[string]string = map[string]string{
                         - action
                         type: object
                       type: array
+                    storageMigration:
+                      description: StorageMigration tracks the migration of the volumes
+                        to the StorageClass requested in the spec
+                      nullable: true
+                      properties:
+                        endTimestamp:
+                          format: date-time
+                          type: string
+                        message:
+                          description: Message explains why the storage migration
+                            is pending or failed
+                          type: string
+                        method:
+                          description: Method is the way the volumes are copied
+                          type: string
+                        phase:
+                          description: Phase of the storage migration
+                          type: string
+                        startTimestamp:
+                          format: date-time
+                          type: string
+                        storageClassName:
+                          description: StorageClassName is the StorageClass the volumes
+                            are migrated to
+                          type: string
+                        volumes:
+                          description: Volumes lists the migrated volumes and their
+                            progress
+                          items:
+                            properties:
+                              percentComplete:
+                                description: PercentComplete is the progress of the
+                                  copy of the volume
+                                format: int32
+                                type: integer
+                              sourceClaim:
+                                description: SourceClaim is the claim the volume is
+                                  migrated from
+                                type: string
+                              targetClaim:
+                                description: TargetClaim is the DataVolume the volume
+                                  is migrated to
+                                type: string
+                              volumeName:
+                                description: VolumeName is the name of the volume
+                                  in the VirtualMachine
+                                type: string
+                            required:
+                            - sourceClaim
+                            - targetClaim
+                            - volumeName
+                            type: object
+                          type: array
+                          x-kubernetes-list-type: atomic
+                      required:
+                      - storageClassName
+                      type: object
                     volumeRequests:
                       description: |-
                         VolumeRequests indicates a list of volumes add or remove from the VMI template and
@@ -28968,6 +35263,53 @@ var CRDsValidation map[string]string = map[string]string{
                                       IO specifies which QEMU disk IO mode should be used.
                                       Supported values are: native, default, threads.
                                     type: string
+                                  ioTune:
+                                    description: |-
+                                      IOTune limits the IO operations and the throughput of the disk.
+                                      It can be set when hotplugging the disk.
+                                    properties:
+                                      readBytesSec:
+                                        anyOf:
+                                        - type: integer
+                                        - type: string
+                                        description: ReadBytesSec limits the read
+                                          throughput in bytes per second.
+                                        pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                        x-kubernetes-int-or-string: true
+                                      readIOPSSec:
+                                        description: ReadIOPSSec limits the number
+                                          of read operations per second.
+                                        format: int64
+                                        type: integer
+                                      totalBytesSec:
+                                        anyOf:
+                                        - type: integer
+                                        - type: string
+                                        description: TotalBytesSec limits the total
+                                          throughput of reads and writes in bytes
+                                          per second.
+                                        pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                        x-kubernetes-int-or-string: true
+                                      totalIOPSSec:
+                                        description: TotalIOPSSec limits the total
+                                          number of read and write operations per
+                                          second.
+                                        format: int64
+                                        type: integer
+                                      writeBytesSec:
+                                        anyOf:
+                                        - type: integer
+                                        - type: string
+                                        description: WriteBytesSec limits the write
+                                          throughput in bytes per second.
+                                        pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                        x-kubernetes-int-or-string: true
+                                      writeIOPSSec:
+                                        description: WriteIOPSSec limits the number
+                                          of write operations per second.
+                                        format: int64
+                                        type: integer
+                                    type: object
                                   lun:
                                     description: Attach a volume as a LUN to the vmi.
                                     properties:
@@ -29529,5 +35871,143 @@ var CRDsValidation map[string]string = map[string]string{
   required:
   - spec
   type: object
+`,
+	"virtualmachinesnapshotschedule": `openAPIV3Schema:
+  description: |-
+    VirtualMachineSnapshotSchedule creates VirtualMachineSnapshots of the selected VirtualMachines on a cron schedule
+    and deletes the oldest of them
+  properties:
+    apiVersion:
+      description: |-
+        APIVersion defines the versioned schema of this representation of an object.
+        Servers should convert recognized schemas to the latest internal value, and
+        may reject unrecognized values.
+        More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources
+      type: string
+    kind:
+      description: |-
+        Kind is a string value representing the REST resource this object represents.
+        Servers may infer this from the endpoint the client submits requests to.
+        Cannot be updated.
+        In CamelCase.
+        More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
+      type: string
+    metadata:
+      type: object
+    spec:
+      description: VirtualMachineSnapshotScheduleSpec is the spec for a VirtualMachineSnapshotSchedule
+        resource
+      properties:
+        failureDeadline:
+          description: FailureDeadline of the created snapshots
+          type: string
+        quiescePolicy:
+          description: |-
+            QuiescePolicy defines whether the guest file systems have to be frozen for the snapshots.
+            Defaults to BestEffort
+          enum:
+          - BestEffort
+          - Required
+          type: string
+        retentionCount:
+          description: |-
+            RetentionCount is the number of succeeded snapshots kept per VirtualMachine.
+            Defaults to 7
+          format: int32
+          minimum: 1
+          type: integer
+        schedule:
+          description: Schedule is a five field cron expression evaluated in UTC,
+            like "0 2 * * *"
+          type: string
+        selector:
+          description: |-
+            Selector selects the VirtualMachines in the namespace of the schedule which are snapshotted,
+            an empty selector selects all of them
+          properties:
+            matchExpressions:
+              description: matchExpressions is a list of label selector requirements.
+                The requirements are ANDed.
+              items:
+                description: |-
+                  A label selector requirement is a selector that contains values, a key, and an operator that
+                  relates the key and values.
+                properties:
+                  key:
+                    description: key is the label key that the selector applies to.
+                    type: string
+                  operator:
+                    description: |-
+                      operator represents a key's relationship to a set of values.
+                      Valid operators are In, NotIn, Exists and DoesNotExist.
+                    type: string
+                  values:
+                    description: |-
+                      values is an array of string values. If the operator is In or NotIn,
+                      the values array must be non-empty. If the operator is Exists or DoesNotExist,
+                      the values array must be empty. This array is replaced during a strategic
+                      merge patch.
+                    items:
+                      type: string
+                    type: array
+                    x-kubernetes-list-type: atomic
+                required:
+                - key
+                - operator
+                type: object
+              type: array
+              x-kubernetes-list-type: atomic
+            matchLabels:
+              additionalProperties:
+                type: string
+              description: |-
+                matchLabels is a map of {key,value} pairs. A single {key,value} in the matchLabels
+                map is equivalent to an element of matchExpressions, whose key field is "key", the
+                operator is "In", and the values array contains only "value". The requirements are ANDed.
+              type: object
+          type: object
+          x-kubernetes-map-type: atomic
+        suspend:
+          description: Suspend stops the schedule from creating snapshots, the existing
+            snapshots are kept
+          type: boolean
+      required:
+      - schedule
+      - selector
+      type: object
+    status:
+      description: VirtualMachineSnapshotScheduleStatus is the status for a VirtualMachineSnapshotSchedule
+        resource
+      properties:
+        error:
+          description: Error is the last error of the schedule, like a skipped VirtualMachine
+            or a failed snapshot
+          properties:
+            message:
+              type: string
+            time:
+              format: date-time
+              type: string
+          type: object
+        lastScheduleTime:
+          description: LastScheduleTime is the last time snapshots were created
+          format: date-time
+          nullable: true
+          type: string
+        lastSuccessTime:
+          description: LastSuccessTime is the time all the snapshots of the last successful
+            run were ready
+          format: date-time
+          nullable: true
+          type: string
+        nextScheduleTime:
+          description: NextScheduleTime is the next time snapshots will be created
+          format: date-time
+          nullable: true
+          type: string
+      type: object
+  required:
+  - spec
+  type: object
 `,
 }
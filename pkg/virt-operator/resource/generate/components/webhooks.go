@@ -301,6 +301,7 @@ func NewVirtAPIValidatingWebhookConfiguration(installNamespace string) *admissio
 	migrationCreatePath := MigrationCreateValidatePath
 	migrationUpdatePath := MigrationUpdateValidatePath
 	vmSnapshotValidatePath := VMSnapshotValidatePath
+	vmSnapshotScheduleValidatePath := VMSnapshotScheduleValidatePath
 	vmRestoreValidatePath := VMRestoreValidatePath
 	vmExportValidatePath := VMExportValidatePath
 	VmInstancetypeValidatePath := VMInstancetypeValidatePath
@@ -582,6 +583,31 @@ func NewVirtAPIValidatingWebhookConfiguration(installNamespace string) *admissio
 					},
 				},
 			},
+			{
+				Name:                    "virtualmachinesnapshotschedule-validator.snapshot.kubevirt.io",
+				AdmissionReviewVersions: []string{"v1", "v1beta1"},
+				FailurePolicy:           &failurePolicy,
+				TimeoutSeconds:          &defaultTimeoutSeconds,
+				SideEffects:             &sideEffectNone,
+				Rules: []admissionregistrationv1.RuleWithOperations{{
+					Operations: []admissionregistrationv1.OperationType{
+						admissionregistrationv1.Create,
+						admissionregistrationv1.Update,
+					},
+					Rule: admissionregistrationv1.Rule{
+						APIGroups:   []string{snapshotv1.SchemeGroupVersion.Group},
+						APIVersions: []string{snapshotv1.SchemeGroupVersion.Version},
+						Resources:   []string{"virtualmachinesnapshotschedules"},
+					},
+				}},
+				ClientConfig: admissionregistrationv1.WebhookClientConfig{
+					Service: &admissionregistrationv1.ServiceReference{
+						Namespace: installNamespace,
+						Name:      VirtApiServiceName,
+						Path:      &vmSnapshotScheduleValidatePath,
+					},
+				},
+			},
 			{
 				Name:                    "virtualmachinerestore-validator.snapshot.kubevirt.io",
 				AdmissionReviewVersions: []string{"v1", "v1beta1"},
@@ -877,6 +903,8 @@ const KubeVirtOperatorValidatingWebhookName = "virt-operator-validator"
 
 const VMSnapshotValidatePath = "/virtualmachinesnapshots-validate"
 
+const VMSnapshotScheduleValidatePath = "/virtualmachinesnapshotschedules-validate"
+
 const VMRestoreValidatePath = "/virtualmachinerestores-validate"
 
 const VMExportValidatePath = "/virtualmachineexports-validate"
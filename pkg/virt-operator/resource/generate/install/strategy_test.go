@@ -39,7 +39,9 @@ import (
 
 	v1 "kubevirt.io/api/core/v1"
 
+	"kubevirt.io/kubevirt/pkg/virt-config/featuregate"
 	//"kubevirt.io/kubevirt/pkg/virt-operator/resource/apply"
+	"kubevirt.io/kubevirt/pkg/virt-operator/resource/generate/components"
 	"kubevirt.io/kubevirt/pkg/virt-operator/util"
 )
 
@@ -94,6 +96,34 @@ var _ = Describe("Install Strategy", func() {
 		)
 	})
 
+	Context("emergency API", func() {
+		handlerArgs := func(featureGates ...string) []string {
+			config := util.GetTargetConfigFromKV(&v1.KubeVirt{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: namespace,
+				},
+				Spec: v1.KubeVirtSpec{
+					Configuration: v1.KubeVirtConfiguration{
+						DeveloperConfiguration: &v1.DeveloperConfiguration{FeatureGates: featureGates},
+					},
+				},
+			})
+			strategy, err := GenerateCurrentInstallStrategy(config, "openshift-monitoring", namespace)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(strategy.daemonSets).To(HaveLen(1))
+			return strategy.daemonSets[0].Spec.Template.Spec.Containers[0].Args
+		}
+
+		It("should be disabled by default", func() {
+			Expect(handlerArgs()).ToNot(ContainElement("--emergency-api-socket"))
+		})
+
+		It("should be served by virt-handler with the feature gate", func() {
+			Expect(strings.Join(handlerArgs(featuregate.EmergencyAPIGate), " ")).To(
+				ContainSubstring("--emergency-api-socket " + components.EmergencyAPISocket))
+		})
+	})
+
 	Context("should generate", func() {
 		It("install strategy convertable back to objects", func() {
 			strategy, err := GenerateCurrentInstallStrategy(config, "openshift-monitoring", namespace)
@@ -134,6 +134,17 @@ func newApiServerClusterRole() *rbacv1.ClusterRole {
 					"create", "get", "list", "watch", "patch",
 				},
 			},
+			{
+				APIGroups: []string{
+					GroupName,
+				},
+				Resources: []string{
+					"virtualmachineinstancemigrations/status",
+				},
+				Verbs: []string{
+					"patch",
+				},
+			},
 			{
 				APIGroups: []string{
 					GroupName,
@@ -231,6 +242,17 @@ func newApiServerClusterRole() *rbacv1.ClusterRole {
 					"watch",
 				},
 			},
+			{
+				APIGroups: []string{
+					"",
+				},
+				Resources: []string{
+					"nodes",
+				},
+				Verbs: []string{
+					"get", "list", "watch",
+				},
+			},
 			{
 				APIGroups: []string{
 					"instancetype.kubevirt.io",
@@ -349,7 +371,7 @@ func newApiServerRole(namespace string) *rbacv1.Role {
 					"configmaps",
 				},
 				Verbs: []string{
-					"get", "list", "watch",
+					"get", "list", "watch", "create", "update",
 				},
 			},
 		},
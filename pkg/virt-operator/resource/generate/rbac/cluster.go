@@ -26,6 +26,7 @@ import (
 	"kubevirt.io/api/clone"
 	"kubevirt.io/api/export"
 	"kubevirt.io/api/pool"
+	"kubevirt.io/api/prefetch"
 	"kubevirt.io/api/snapshot"
 
 	"kubevirt.io/api/instancetype"
@@ -39,21 +40,23 @@ const (
 	defaultClusterRoleName          = "kubevirt.io:default"
 	instancetypeViewClusterRoleName = "instancetype.kubevirt.io:view"
 
-	apiVersion            = "version"
-	apiGuestFs            = "guestfs"
-	apiExpandVmSpec       = "expand-vm-spec"
-	apiKubevirts          = "kubevirts"
-	apiVM                 = "virtualmachines"
-	apiVMInstances        = "virtualmachineinstances"
-	apiVMIPresets         = "virtualmachineinstancepresets"
-	apiVMIReplicasets     = "virtualmachineinstancereplicasets"
-	apiVMIMigrations      = "virtualmachineinstancemigrations"
-	apiVMSnapshots        = "virtualmachinesnapshots"
-	apiVMSnapshotContents = "virtualmachinesnapshotcontents"
-	apiVMRestores         = "virtualmachinerestores"
-	apiVMExports          = "virtualmachineexports"
-	apiVMClones           = "virtualmachineclones"
-	apiVMPools            = "virtualmachinepools"
+	apiVersion             = "version"
+	apiGuestFs             = "guestfs"
+	apiExpandVmSpec        = "expand-vm-spec"
+	apiKubevirts           = "kubevirts"
+	apiVM                  = "virtualmachines"
+	apiVMInstances         = "virtualmachineinstances"
+	apiVMIPresets          = "virtualmachineinstancepresets"
+	apiVMIReplicasets      = "virtualmachineinstancereplicasets"
+	apiVMIMigrations       = "virtualmachineinstancemigrations"
+	apiVMSnapshots         = "virtualmachinesnapshots"
+	apiVMSnapshotContents  = "virtualmachinesnapshotcontents"
+	apiVMRestores          = "virtualmachinerestores"
+	apiVMSnapshotSchedules = "virtualmachinesnapshotschedules"
+	apiVMExports           = "virtualmachineexports"
+	apiVMClones            = "virtualmachineclones"
+	apiVMPools             = "virtualmachinepools"
+	apiImagePrefetches     = "imageprefetches"
 
 	apiVMExpandSpec   = "virtualmachines/expand-spec"
 	apiVMPortForward  = "virtualmachines/portforward"
@@ -73,6 +76,7 @@ const (
 	apiVMInstancesUnpause                   = "virtualmachineinstances/unpause"
 	apiVMInstancesAddVolume                 = "virtualmachineinstances/addvolume"
 	apiVMInstancesRemoveVolume              = "virtualmachineinstances/removevolume"
+	apiVMInstancesSetLinkState              = "virtualmachineinstances/setlinkstate"
 	apiVMInstancesFreeze                    = "virtualmachineinstances/freeze"
 	apiVMInstancesUnfreeze                  = "virtualmachineinstances/unfreeze"
 	apiVMInstancesSoftReboot                = "virtualmachineinstances/softreboot"
@@ -80,11 +84,14 @@ const (
 	apiVMInstancesGuestOSInfo               = "virtualmachineinstances/guestosinfo"
 	apiVMInstancesFileSysList               = "virtualmachineinstances/filesystemlist"
 	apiVMInstancesUserList                  = "virtualmachineinstances/userlist"
+	apiVMInstancesMigrationFeasibility      = "virtualmachineinstances/migrationfeasibility"
 	apiVMInstancesSEVFetchCertChain         = "virtualmachineinstances/sev/fetchcertchain"
 	apiVMInstancesSEVQueryLaunchMeasurement = "virtualmachineinstances/sev/querylaunchmeasurement"
 	apiVMInstancesSEVSetupSession           = "virtualmachineinstances/sev/setupsession"
 	apiVMInstancesSEVInjectLaunchSecret     = "virtualmachineinstances/sev/injectlaunchsecret"
 	apiVMInstancesUSBRedir                  = "virtualmachineinstances/usbredir"
+	apiVMInstancesPacketCapture             = "virtualmachineinstances/pcap"
+	apiVMIMigrationsCancel                  = "virtualmachineinstancemigrations/cancel"
 )
 
 func GetAllCluster() []runtime.Object {
@@ -200,9 +207,11 @@ func newAdminClusterRole() *rbacv1.ClusterRole {
 					apiVMInstancesGuestOSInfo,
 					apiVMInstancesFileSysList,
 					apiVMInstancesUserList,
+					apiVMInstancesMigrationFeasibility,
 					apiVMInstancesSEVFetchCertChain,
 					apiVMInstancesSEVQueryLaunchMeasurement,
 					apiVMInstancesUSBRedir,
+					apiVMInstancesPacketCapture,
 				},
 				Verbs: []string{
 					"get",
@@ -217,12 +226,14 @@ func newAdminClusterRole() *rbacv1.ClusterRole {
 					apiVMInstancesUnpause,
 					apiVMInstancesAddVolume,
 					apiVMInstancesRemoveVolume,
+					apiVMInstancesSetLinkState,
 					apiVMInstancesFreeze,
 					apiVMInstancesUnfreeze,
 					apiVMInstancesSoftReboot,
 					apiVMInstancesReset,
 					apiVMInstancesSEVSetupSession,
 					apiVMInstancesSEVInjectLaunchSecret,
+					apiVMIMigrationsCancel,
 				},
 				Verbs: []string{
 					"update",
@@ -300,6 +311,7 @@ func newAdminClusterRole() *rbacv1.ClusterRole {
 					apiVMSnapshots,
 					apiVMSnapshotContents,
 					apiVMRestores,
+					apiVMSnapshotSchedules,
 				},
 				Verbs: []string{
 					"get", "delete", "create", "update", "patch", "list", "watch", "deletecollection",
@@ -327,6 +339,17 @@ func newAdminClusterRole() *rbacv1.ClusterRole {
 					"get", "delete", "create", "update", "patch", "list", "watch", "deletecollection",
 				},
 			},
+			{
+				APIGroups: []string{
+					prefetch.GroupName,
+				},
+				Resources: []string{
+					apiImagePrefetches,
+				},
+				Verbs: []string{
+					"get", "delete", "create", "update", "patch", "list", "watch", "deletecollection",
+				},
+			},
 			{
 				APIGroups: []string{
 					instancetype.GroupName,
@@ -393,9 +416,11 @@ func newEditClusterRole() *rbacv1.ClusterRole {
 					apiVMInstancesGuestOSInfo,
 					apiVMInstancesFileSysList,
 					apiVMInstancesUserList,
+					apiVMInstancesMigrationFeasibility,
 					apiVMInstancesSEVFetchCertChain,
 					apiVMInstancesSEVQueryLaunchMeasurement,
 					apiVMInstancesUSBRedir,
+					apiVMInstancesPacketCapture,
 				},
 				Verbs: []string{
 					"get",
@@ -410,12 +435,14 @@ func newEditClusterRole() *rbacv1.ClusterRole {
 					apiVMInstancesUnpause,
 					apiVMInstancesAddVolume,
 					apiVMInstancesRemoveVolume,
+					apiVMInstancesSetLinkState,
 					apiVMInstancesFreeze,
 					apiVMInstancesUnfreeze,
 					apiVMInstancesSoftReboot,
 					apiVMInstancesReset,
 					apiVMInstancesSEVSetupSession,
 					apiVMInstancesSEVInjectLaunchSecret,
+					apiVMIMigrationsCancel,
 				},
 				Verbs: []string{
 					"update",
@@ -493,6 +520,7 @@ func newEditClusterRole() *rbacv1.ClusterRole {
 					apiVMSnapshots,
 					apiVMSnapshotContents,
 					apiVMRestores,
+					apiVMSnapshotSchedules,
 				},
 				Verbs: []string{
 					"get", "delete", "create", "update", "patch", "list", "watch",
@@ -520,6 +548,17 @@ func newEditClusterRole() *rbacv1.ClusterRole {
 					"get", "delete", "create", "update", "patch", "list", "watch",
 				},
 			},
+			{
+				APIGroups: []string{
+					prefetch.GroupName,
+				},
+				Resources: []string{
+					apiImagePrefetches,
+				},
+				Verbs: []string{
+					"get", "delete", "create", "update", "patch", "list", "watch",
+				},
+			},
 			{
 				APIGroups: []string{
 					instancetype.GroupName,
@@ -590,11 +629,23 @@ func newMigrateClusterRole() *rbacv1.ClusterRole {
 				},
 				Resources: []string{
 					apiVMMigrate,
+					apiVMIMigrationsCancel,
 				},
 				Verbs: []string{
 					"update",
 				},
 			},
+			{
+				APIGroups: []string{
+					virtv1.SubresourceGroupName,
+				},
+				Resources: []string{
+					apiVMInstancesMigrationFeasibility,
+				},
+				Verbs: []string{
+					"get",
+				},
+			},
 			{
 				APIGroups: []string{
 					GroupName,
@@ -685,6 +736,7 @@ func newViewClusterRole() *rbacv1.ClusterRole {
 					apiVMSnapshots,
 					apiVMSnapshotContents,
 					apiVMRestores,
+					apiVMSnapshotSchedules,
 				},
 				Verbs: []string{
 					"get", "list", "watch",
@@ -712,6 +764,17 @@ func newViewClusterRole() *rbacv1.ClusterRole {
 					"get", "list", "watch",
 				},
 			},
+			{
+				APIGroups: []string{
+					prefetch.GroupName,
+				},
+				Resources: []string{
+					apiImagePrefetches,
+				},
+				Verbs: []string{
+					"get", "list", "watch",
+				},
+			},
 			{
 				APIGroups: []string{
 					instancetype.GroupName,
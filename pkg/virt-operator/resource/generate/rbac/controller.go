@@ -26,6 +26,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 
 	"kubevirt.io/api/clone"
+	"kubevirt.io/api/prefetch"
 
 	"kubevirt.io/kubevirt/pkg/virt-operator/resource/generate/components"
 
@@ -206,6 +207,28 @@ func newControllerClusterRole() *rbacv1.ClusterRole {
 					"get", "list", "watch", "delete", "update", "create", "patch",
 				},
 			},
+			{
+				APIGroups: []string{
+					"discovery.k8s.io",
+				},
+				Resources: []string{
+					"endpointslices",
+				},
+				Verbs: []string{
+					"get", "list", "watch", "delete", "update", "create",
+				},
+			},
+			{
+				APIGroups: []string{
+					"networking.k8s.io",
+				},
+				Resources: []string{
+					"networkpolicies",
+				},
+				Verbs: []string{
+					"get", "list", "watch", "delete", "update", "create",
+				},
+			},
 			{
 				APIGroups: []string{
 					"",
@@ -234,6 +257,7 @@ func newControllerClusterRole() *rbacv1.ClusterRole {
 				},
 				Resources: []string{
 					"pods/finalizers",
+					"services/finalizers",
 				},
 				Verbs: []string{
 					"update",
@@ -323,6 +347,8 @@ func newControllerClusterRole() *rbacv1.ClusterRole {
 					"virtualmachinesnapshotcontents/finalizers",
 					"virtualmachinerestores",
 					"virtualmachinerestores/status",
+					"virtualmachinesnapshotschedules",
+					"virtualmachinesnapshotschedules/status",
 				},
 				Verbs: []string{
 					"get", "list", "watch", "create", "update", "delete", "patch",
@@ -529,6 +555,19 @@ func newControllerClusterRole() *rbacv1.ClusterRole {
 					"get", "list", "watch", "update", "patch", "delete",
 				},
 			},
+			{
+				APIGroups: []string{
+					prefetch.GroupName,
+				},
+				Resources: []string{
+					"imageprefetches",
+					"imageprefetches/status",
+					"imageprefetches/finalizers",
+				},
+				Verbs: []string{
+					"get", "list", "watch", "update", "patch",
+				},
+			},
 			{
 				APIGroups: []string{
 					"",
@@ -100,6 +100,9 @@ const (
 	// lookup key in AdditionalProperties
 	AdditionalPropertiesPersistentReservationEnabled = "PersistentReservationEnabled"
 
+	// lookup key in AdditionalProperties
+	AdditionalPropertiesEmergencyAPIEnabled = "EmergencyAPIEnabled"
+
 	// account to use if one is not explicitly named
 	DefaultMonitorAccount = "prometheus-k8s"
 
@@ -215,6 +218,9 @@ func GetTargetConfigFromKVWithEnvVarManager(kv *v1.KubeVirt, envVarManager EnvVa
 			if v == featuregate.PersistentReservation {
 				additionalProperties[AdditionalPropertiesPersistentReservationEnabled] = ""
 			}
+			if v == featuregate.EmergencyAPIGate {
+				additionalProperties[AdditionalPropertiesEmergencyAPIEnabled] = ""
+			}
 		}
 	}
 	// don't use status.target* here, as that is always set, but we need to know if it was set by the spec and with that
@@ -621,6 +627,11 @@ func (c *KubeVirtDeploymentConfig) PersistentReservationEnabled() bool {
 	return enabled
 }
 
+func (c *KubeVirtDeploymentConfig) EmergencyAPIEnabled() bool {
+	_, enabled := c.AdditionalProperties[AdditionalPropertiesEmergencyAPIEnabled]
+	return enabled
+}
+
 func (c *KubeVirtDeploymentConfig) GetMigrationNetwork() *string {
 	value, enabled := c.AdditionalProperties[AdditionalPropertiesMigrationNetwork]
 	if enabled {
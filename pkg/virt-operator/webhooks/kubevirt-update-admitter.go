@@ -23,6 +23,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"regexp"
 	"strconv"
 
 	kvtls "kubevirt.io/kubevirt/pkg/util/tls"
@@ -37,13 +38,17 @@ import (
 
 	admissionv1 "k8s.io/api/admission/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 
 	v1 "kubevirt.io/api/core/v1"
 	"kubevirt.io/client-go/kubecli"
 
+	"kubevirt.io/kubevirt/pkg/network/macpool"
 	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/util/hardware"
+	"kubevirt.io/kubevirt/pkg/util/migrations"
 	webhookutils "kubevirt.io/kubevirt/pkg/util/webhooks"
 	validating_webhooks "kubevirt.io/kubevirt/pkg/util/webhooks/validating-webhooks"
 	"kubevirt.io/kubevirt/pkg/virt-operator/resource/apply"
@@ -107,10 +112,29 @@ func (admitter *KubeVirtUpdateAdmitter) Admit(ctx context.Context, ar *admission
 
 	}
 
+	if migrationConf := newKV.Spec.Configuration.MigrationConfiguration; migrationConf != nil {
+		results = append(results, migrations.ValidateParallelMigration(
+			field.NewPath("spec").Child("configuration", "migrations"), migrationConf.ParallelMigrationChannels, migrationConf.Compression)...)
+	}
+
+	if networkConf := newKV.Spec.Configuration.NetworkConfiguration; networkConf != nil {
+		results = append(results, macpool.ValidatePoolConfiguration(
+			field.NewPath("spec").Child("configuration", "network", "macAddressPool"), networkConf.MacAddressPool)...)
+	}
+
 	if newKV.Spec.Infra != nil {
 		results = append(results, validateInfraReplicas(newKV.Spec.Infra.Replicas)...)
 	}
 
+	results = append(results, validateDedicatedCPUPools(
+		field.NewPath("spec").Child("configuration", "dedicatedCPUPools"), newKV.Spec.Configuration.DedicatedCPUPools)...)
+	results = append(results, validateHugepagesPools(
+		field.NewPath("spec").Child("configuration", "hugepagesPools"), newKV.Spec.Configuration.HugepagesPools)...)
+	if permittedHostDevices := newKV.Spec.Configuration.PermittedHostDevices; permittedHostDevices != nil {
+		results = append(results, validateUSBPassthroughHostDevices(
+			field.NewPath("spec").Child("configuration", "permittedHostDevices", "usbPassthrough"), permittedHostDevices.USBPassthrough)...)
+	}
+
 	response := validating_webhooks.NewAdmissionResponse(results)
 
 	if featureGatesChanged(&currKV.Spec, &newKV.Spec) {
@@ -460,6 +484,73 @@ func warnDeprecatedFeatureGates(featureGates []string) (warnings []string) {
 	return warnings
 }
 
+func validateDedicatedCPUPools(field *field.Path, pools []v1.DedicatedCPUPool) (causes []metav1.StatusCause) {
+	for i, pool := range pools {
+		cpus, err := hardware.ParseCPUSetLine(pool.CPUs, 50000)
+		if err != nil || len(cpus) == 0 {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("the CPUs %q of the dedicated CPU pool %s are not a valid CPU list", pool.CPUs, pool.Name),
+				Field:   field.Index(i).Child("cpus").String(),
+			})
+		}
+	}
+	return causes
+}
+
+func validateHugepagesPools(field *field.Path, pools []v1.HugepagesPool) (causes []metav1.StatusCause) {
+	for i, pool := range pools {
+		// the kernel exposes the hugepages of each size in kilobytes
+		pageSize, err := resource.ParseQuantity(pool.PageSize)
+		if err != nil || pageSize.Value() <= 0 || pageSize.Value()%1024 != 0 {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("the page size %q of the hugepages pool %s is not a positive multiple of 1Ki", pool.PageSize, pool.Name),
+				Field:   field.Index(i).Child("pageSize").String(),
+			})
+		}
+		if pool.Count < 0 {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("the count of the hugepages pool %s must not be negative", pool.Name),
+				Field:   field.Index(i).Child("count").String(),
+			})
+		}
+	}
+	return causes
+}
+
+var (
+	isValidUSBVendorProduct = regexp.MustCompile(`^[0-9a-fA-F]{4}:[0-9a-fA-F]{4}$`).MatchString
+	isValidUSBBusPort       = regexp.MustCompile(`^[0-9]+-[0-9]+(\.[0-9]+)*$`).MatchString
+)
+
+func validateUSBPassthroughHostDevices(field *field.Path, devices []v1.USBPassthroughHostDevice) (causes []metav1.StatusCause) {
+	for i, device := range devices {
+		switch {
+		case device.VendorProduct == "" && device.BusPort == "":
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueRequired,
+				Message: fmt.Sprintf("%s must set at least one of vendorProduct or busPort", field.Index(i).String()),
+				Field:   field.Index(i).String(),
+			})
+		case device.VendorProduct != "" && !isValidUSBVendorProduct(device.VendorProduct):
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("the vendorProduct %q must be of the form vendor_id:product_id, e.g. 1050:0407", device.VendorProduct),
+				Field:   field.Index(i).Child("vendorProduct").String(),
+			})
+		case device.BusPort != "" && !isValidUSBBusPort(device.BusPort):
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("the busPort %q must be of the form bus-port[.port...], e.g. 1-2.3", device.BusPort),
+				Field:   field.Index(i).Child("busPort").String(),
+			})
+		}
+	}
+	return causes
+}
+
 func validateGuestToRequestHeadroom(ratioStrPtr *string) (causes []metav1.StatusCause) {
 	if ratioStrPtr == nil {
 		return
@@ -164,6 +164,142 @@ var _ = Describe("Validating KubeVirtUpdate Admitter", func() {
 		)
 	})
 
+	Context("with parallel migrations", func() {
+		admit := func(migrationConf *v1.MigrationConfiguration) *admissionv1.AdmissionResponse {
+			clusterConfig, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{})
+			admitter := NewKubeVirtUpdateAdmitter(nil, clusterConfig)
+
+			kv := v1.KubeVirt{}
+			kvBytes, err := json.Marshal(kv)
+			Expect(err).ToNot(HaveOccurred())
+
+			kv.Spec.Configuration.MigrationConfiguration = migrationConf
+			kvUpdatedBytes, err := json.Marshal(kv)
+			Expect(err).ToNot(HaveOccurred())
+
+			return admitter.Admit(context.Background(), &admissionv1.AdmissionReview{
+				Request: &admissionv1.AdmissionRequest{
+					Resource:  KubeVirtGroupVersionResource,
+					Operation: admissionv1.Update,
+					OldObject: runtime.RawExtension{Raw: kvBytes},
+					Object:    runtime.RawExtension{Raw: kvUpdatedBytes},
+				},
+			})
+		}
+
+		DescribeTable("should reject", func(migrationConf *v1.MigrationConfiguration, expectedField string) {
+			response := admit(migrationConf)
+			Expect(response.Allowed).To(BeFalse())
+			Expect(response.Result.Details.Causes).To(HaveLen(1))
+			Expect(response.Result.Details.Causes[0].Field).To(Equal(expectedField))
+		},
+			Entry("zero channels", &v1.MigrationConfiguration{ParallelMigrationChannels: pointer.P(uint32(0))},
+				"spec.configuration.migrations.parallelMigrationChannels"),
+			Entry("too many channels", &v1.MigrationConfiguration{ParallelMigrationChannels: pointer.P(uint32(256))},
+				"spec.configuration.migrations.parallelMigrationChannels"),
+			Entry("an unknown compression method", &v1.MigrationConfiguration{
+				Compression: &v1.MigrationCompression{Method: "lz4"},
+			}, "spec.configuration.migrations.compression.method"),
+			Entry("a zlib level above 9", &v1.MigrationConfiguration{
+				Compression: &v1.MigrationCompression{Method: v1.MigrationCompressionZlib, Level: pointer.P(int32(10))},
+			}, "spec.configuration.migrations.compression.level"),
+		)
+
+		DescribeTable("should accept", func(migrationConf *v1.MigrationConfiguration) {
+			Expect(admit(migrationConf).Allowed).To(BeTrue())
+		},
+			Entry("a single channel", &v1.MigrationConfiguration{ParallelMigrationChannels: pointer.P(uint32(1))}),
+			Entry("zstd compression with a level above 9", &v1.MigrationConfiguration{
+				ParallelMigrationChannels: pointer.P(uint32(16)),
+				Compression:               &v1.MigrationCompression{Method: v1.MigrationCompressionZstd, Level: pointer.P(int32(15))},
+			}),
+			Entry("zlib compression with the default level", &v1.MigrationConfiguration{
+				Compression: &v1.MigrationCompression{Method: v1.MigrationCompressionZlib},
+			}),
+		)
+	})
+
+	Context("with a MAC address pool", func() {
+		admit := func(poolConf *v1.MacAddressPoolConfiguration) *admissionv1.AdmissionResponse {
+			clusterConfig, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{})
+			admitter := NewKubeVirtUpdateAdmitter(nil, clusterConfig)
+
+			kv := v1.KubeVirt{}
+			kvBytes, err := json.Marshal(kv)
+			Expect(err).ToNot(HaveOccurred())
+
+			kv.Spec.Configuration.NetworkConfiguration = &v1.NetworkConfiguration{MacAddressPool: poolConf}
+			kvUpdatedBytes, err := json.Marshal(kv)
+			Expect(err).ToNot(HaveOccurred())
+
+			return admitter.Admit(context.Background(), &admissionv1.AdmissionReview{
+				Request: &admissionv1.AdmissionRequest{
+					Resource:  KubeVirtGroupVersionResource,
+					Operation: admissionv1.Update,
+					OldObject: runtime.RawExtension{Raw: kvBytes},
+					Object:    runtime.RawExtension{Raw: kvUpdatedBytes},
+				},
+			})
+		}
+
+		It("should reject an invalid range", func() {
+			response := admit(&v1.MacAddressPoolConfiguration{
+				Ranges: []v1.MacAddressRange{{Start: "02:00:00:00:00:ff", End: "02:00:00:00:00:00"}},
+			})
+			Expect(response.Allowed).To(BeFalse())
+			Expect(response.Result.Details.Causes).To(HaveLen(1))
+			Expect(response.Result.Details.Causes[0].Field).To(Equal("spec.configuration.network.macAddressPool.ranges[0]"))
+		})
+
+		It("should accept valid ranges", func() {
+			Expect(admit(&v1.MacAddressPoolConfiguration{
+				Ranges: []v1.MacAddressRange{{Start: "02:00:00:00:00:00", End: "02:00:00:ff:ff:ff"}},
+			}).Allowed).To(BeTrue())
+		})
+	})
+
+	DescribeTable("validateDedicatedCPUPools", func(cpus string, valid bool) {
+		causes := validateDedicatedCPUPools(field.NewPath("pools"), []v1.DedicatedCPUPool{{Name: "vms", CPUs: cpus}})
+		if valid {
+			Expect(causes).To(BeEmpty())
+		} else {
+			Expect(causes).To(ConsistOf(HaveField("Field", "pools[0].cpus")))
+		}
+	},
+		Entry("should accept ranges and single CPUs", "4-15,20,22-23", true),
+		Entry("should reject an empty list", "", false),
+		Entry("should reject an invalid list", "4-a", false),
+	)
+
+	DescribeTable("validateHugepagesPools", func(pool v1.HugepagesPool, invalidFields ...string) {
+		causes := validateHugepagesPools(field.NewPath("pools"), []v1.HugepagesPool{pool})
+		Expect(causes).To(HaveLen(len(invalidFields)))
+		for i, invalidField := range invalidFields {
+			Expect(causes[i].Field).To(Equal(invalidField))
+		}
+	},
+		Entry("should accept 2Mi pages", v1.HugepagesPool{Name: "vms", PageSize: "2Mi", Count: 1024}),
+		Entry("should accept 1Gi pages", v1.HugepagesPool{Name: "vms", PageSize: "1Gi", Count: 4}),
+		Entry("should reject an invalid page size", v1.HugepagesPool{Name: "vms", PageSize: "huge", Count: 4}, "pools[0].pageSize"),
+		Entry("should reject a page size which is not a multiple of 1Ki", v1.HugepagesPool{Name: "vms", PageSize: "1500", Count: 4}, "pools[0].pageSize"),
+		Entry("should reject a negative count", v1.HugepagesPool{Name: "vms", PageSize: "2Mi", Count: -1}, "pools[0].count"),
+	)
+
+	DescribeTable("validateUSBPassthroughHostDevices", func(device v1.USBPassthroughHostDevice, invalidFields ...string) {
+		causes := validateUSBPassthroughHostDevices(field.NewPath("usbPassthrough"), []v1.USBPassthroughHostDevice{device})
+		Expect(causes).To(HaveLen(len(invalidFields)))
+		for i, invalidField := range invalidFields {
+			Expect(causes[i].Field).To(Equal(invalidField))
+		}
+	},
+		Entry("should accept a vendor and product", v1.USBPassthroughHostDevice{VendorProduct: "1050:0407"}),
+		Entry("should accept a bus and port path", v1.USBPassthroughHostDevice{BusPort: "1-2.3"}),
+		Entry("should accept both selectors", v1.USBPassthroughHostDevice{VendorProduct: "1050:0407", BusPort: "1-2.3"}),
+		Entry("should reject a device without selector", v1.USBPassthroughHostDevice{}, "usbPassthrough[0]"),
+		Entry("should reject an invalid vendor and product", v1.USBPassthroughHostDevice{VendorProduct: "1050"}, "usbPassthrough[0].vendorProduct"),
+		Entry("should reject an invalid bus and port path", v1.USBPassthroughHostDevice{BusPort: "usb1"}, "usbPassthrough[0].busPort"),
+	)
+
 	Context("deprecations", func() {
 		var admitter *KubeVirtUpdateAdmitter
 
@@ -0,0 +1,167 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package batch
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"kubevirt.io/client-go/kubecli"
+)
+
+const (
+	selectorArg      = "selector"
+	allNamespacesArg = "all-namespaces"
+	concurrencyArg   = "concurrency"
+
+	defaultConcurrency = 5
+)
+
+// Options allow applying a command to all objects matching a label selector instead of a single named one
+type Options struct {
+	Selector      string
+	AllNamespaces bool
+	Concurrency   int
+}
+
+// Target is an object the command is applied to
+type Target struct {
+	Namespace string
+	Name      string
+}
+
+func (t Target) String() string {
+	return t.Namespace + "/" + t.Name
+}
+
+func (o *Options) AddFlags(flags *pflag.FlagSet) {
+	flags.StringVarP(&o.Selector, selectorArg, "l", "", "Selector (label query) to filter on. If set, the command is applied to all matching objects instead of a single named one.")
+	flags.BoolVarP(&o.AllNamespaces, allNamespacesArg, "A", false, "If set, the objects matching --selector are looked up in all namespaces.")
+	flags.IntVar(&o.Concurrency, concurrencyArg, defaultConcurrency, "The maximum number of objects the command is applied to in parallel when --selector is set.")
+}
+
+// Enabled returns true if the command has to be applied to the objects matching the selector
+func (o *Options) Enabled() bool {
+	return o.Selector != ""
+}
+
+// Args expects nameArgs positional arguments, of which the last one is the name of the object.
+// The name is replaced by the selector if one is set.
+func (o *Options) Args(nameArgs int) cobra.PositionalArgs {
+	return func(cmd *cobra.Command, args []string) error {
+		if !o.Enabled() {
+			if o.AllNamespaces {
+				return fmt.Errorf("--%s can only be used together with --%s", allNamespacesArg, selectorArg)
+			}
+			return cobra.ExactArgs(nameArgs)(cmd, args)
+		}
+		if len(args) >= nameArgs {
+			return fmt.Errorf("a name can't be given together with --%s", selectorArg)
+		}
+		if o.Concurrency < 1 {
+			return fmt.Errorf("--%s must be at least 1", concurrencyArg)
+		}
+		return cobra.ExactArgs(nameArgs-1)(cmd, args)
+	}
+}
+
+func (o *Options) namespace(namespace string) string {
+	if o.AllNamespaces {
+		return metav1.NamespaceAll
+	}
+	return namespace
+}
+
+// VMs returns the VirtualMachines matching the selector
+func (o *Options) VMs(ctx context.Context, client kubecli.KubevirtClient, namespace string) ([]Target, error) {
+	list, err := client.VirtualMachine(o.namespace(namespace)).List(ctx, metav1.ListOptions{LabelSelector: o.Selector})
+	if err != nil {
+		return nil, fmt.Errorf("error listing VirtualMachines: %v", err)
+	}
+	targets := make([]Target, 0, len(list.Items))
+	for _, vm := range list.Items {
+		targets = append(targets, Target{Namespace: vm.Namespace, Name: vm.Name})
+	}
+	return targets, nil
+}
+
+// VMIs returns the VirtualMachineInstances matching the selector
+func (o *Options) VMIs(ctx context.Context, client kubecli.KubevirtClient, namespace string) ([]Target, error) {
+	list, err := client.VirtualMachineInstance(o.namespace(namespace)).List(ctx, metav1.ListOptions{LabelSelector: o.Selector})
+	if err != nil {
+		return nil, fmt.Errorf("error listing VirtualMachineInstances: %v", err)
+	}
+	targets := make([]Target, 0, len(list.Items))
+	for _, vmi := range list.Items {
+		targets = append(targets, Target{Namespace: vmi.Namespace, Name: vmi.Name})
+	}
+	return targets, nil
+}
+
+// Run applies op to all targets with at most Concurrency operations in flight.
+// The result of every operation is printed as soon as it is known, followed by a summary.
+// An error listing all failed targets is returned if any of the operations failed.
+func (o *Options) Run(cmd *cobra.Command, kind, verb string, targets []Target, op func(namespace, name string) error) error {
+	if len(targets) == 0 {
+		cmd.Printf("No %ss match the selector %q\n", kind, o.Selector)
+		return nil
+	}
+
+	var (
+		wg     sync.WaitGroup
+		mutex  sync.Mutex
+		failed []string
+	)
+	tokens := make(chan struct{}, o.Concurrency)
+	for _, target := range targets {
+		tokens <- struct{}{}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer func() { <-tokens }()
+
+			err := op(target.Namespace, target.Name)
+
+			mutex.Lock()
+			defer mutex.Unlock()
+			if err != nil {
+				failed = append(failed, target.String())
+				cmd.PrintErrf("%s %s: %v\n", kind, target, err)
+				return
+			}
+			cmd.Printf("%s %s was scheduled to %s\n", kind, target, verb)
+		}()
+	}
+	wg.Wait()
+
+	cmd.Printf("%d of %d %ss were scheduled to %s\n", len(targets)-len(failed), len(targets), kind, verb)
+	if len(failed) > 0 {
+		sort.Strings(failed)
+		return fmt.Errorf("failed to %s %d of %d %ss: %s", verb, len(failed), len(targets), kind, strings.Join(failed, ", "))
+	}
+	return nil
+}
@@ -0,0 +1,193 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package clone
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	k8sv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	clonev1beta1 "kubevirt.io/api/clone/v1beta1"
+	"kubevirt.io/client-go/kubecli"
+
+	virtwait "kubevirt.io/kubevirt/pkg/apimachinery/wait"
+	"kubevirt.io/kubevirt/pkg/virtctl/clientconfig"
+	createclone "kubevirt.io/kubevirt/pkg/virtctl/create/clone"
+	"kubevirt.io/kubevirt/pkg/virtctl/templates"
+)
+
+const (
+	COMMAND_CLONE = "clone"
+
+	NameFlag           = "name"
+	SnapshotSourceFlag = "snapshot-source"
+	WaitFlag           = "wait"
+	TimeoutFlag        = "timeout"
+
+	defaultTimeout = 10 * time.Minute
+	pollInterval   = 2 * time.Second
+)
+
+// WaitInterval allows overriding the interval used while waiting (useful for unit testing)
+var WaitInterval = pollInterval
+
+type command struct {
+	createclone.SpecFlags
+	name           string
+	snapshotSource bool
+	wait           bool
+	timeout        time.Duration
+}
+
+func NewCommand() *cobra.Command {
+	c := command{}
+	cmd := &cobra.Command{
+		Use:   "clone vm (SOURCE) (TARGET)",
+		Short: "Clone a virtual machine or a virtual machine snapshot into a new virtual machine.",
+		Long: `Creates a VirtualMachineClone which copies the source virtual machine, or the virtual machine snapshot with --snapshot-source, into a new virtual machine.
+The MAC addresses of the interfaces and the SMBIOS serial of the new virtual machine are regenerated unless they are set with --new-mac-address and --new-smbios-serial.
+Labels and annotations are copied unless they are excluded by filters like '!some/key*'.`,
+		Args:    cobra.ExactArgs(3),
+		Example: usage(),
+		RunE:    c.run,
+	}
+
+	cmd.Flags().StringVar(&c.name, NameFlag, "", "Name of the VirtualMachineClone. Defaults to a name generated from the source name.")
+	cmd.Flags().BoolVar(&c.snapshotSource, SnapshotSourceFlag, false, "Clone from the VirtualMachineSnapshot called SOURCE instead of the virtual machine.")
+	c.SpecFlags.AddFlags(cmd)
+	cmd.Flags().BoolVar(&c.wait, WaitFlag, false, "Wait until the clone is complete.")
+	cmd.Flags().DurationVar(&c.timeout, TimeoutFlag, defaultTimeout, "The time to wait for the clone to complete.")
+	cmd.SetUsageTemplate(templates.UsageTemplate())
+
+	return cmd
+}
+
+func usage() string {
+	return `  # Clone the virtual machine 'myvm' into the new virtual machine 'myclone' and wait until the clone is complete:
+  {{ProgramName}} clone vm myvm myclone --wait
+
+  # Create the virtual machine 'myclone' from the snapshot 'mysnap':
+  {{ProgramName}} clone vm mysnap myclone --snapshot-source
+
+  # Clone the virtual machine 'myvm' without the label 'some/key' and with a fixed MAC address for the interface 'default':
+  {{ProgramName}} clone vm myvm myclone --label-filter '*' --label-filter '!some/key' --new-mac-address default:02-00-00-00-00-01`
+}
+
+func (c *command) run(cmd *cobra.Command, args []string) error {
+	switch strings.ToLower(args[0]) {
+	case "vm", "vms", "virtualmachine", "virtualmachines":
+	default:
+		return fmt.Errorf("unsupported resource type: %s", args[0])
+	}
+	sourceName, targetName := args[1], args[2]
+
+	vmClone, err := c.newClone(sourceName, targetName)
+	if err != nil {
+		return err
+	}
+
+	virtClient, namespace, _, err := clientconfig.ClientAndNamespaceFromContext(cmd.Context())
+	if err != nil {
+		return fmt.Errorf("cannot obtain KubeVirt client: %v", err)
+	}
+
+	vmClone, err = virtClient.VirtualMachineClone(namespace).Create(cmd.Context(), vmClone, metav1.CreateOptions{})
+	if err != nil {
+		return fmt.Errorf("error creating VirtualMachineClone of %s: %v", sourceName, err)
+	}
+	cmd.Printf("VirtualMachineClone %s/%s created\n", namespace, vmClone.Name)
+
+	if !c.wait {
+		return nil
+	}
+	return waitForClone(cmd, virtClient, namespace, vmClone.Name, c.timeout)
+}
+
+func (c *command) newClone(sourceName, targetName string) (*clonev1beta1.VirtualMachineClone, error) {
+	sourceType := "vm"
+	if c.snapshotSource {
+		sourceType = "snapshot"
+	}
+	source, err := createclone.TypeToTypedLocalObjectReference(sourceType, sourceName, true)
+	if err != nil {
+		return nil, err
+	}
+	target, err := createclone.TypeToTypedLocalObjectReference("vm", targetName, false)
+	if err != nil {
+		return nil, err
+	}
+
+	vmClone := &clonev1beta1.VirtualMachineClone{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: c.name,
+		},
+		Spec: clonev1beta1.VirtualMachineCloneSpec{
+			Source: source,
+			Target: target,
+		},
+	}
+	if c.name == "" {
+		vmClone.GenerateName = fmt.Sprintf("%s-clone-", sourceName)
+	}
+	if err := c.SpecFlags.ApplyTo(&vmClone.Spec); err != nil {
+		return nil, err
+	}
+
+	return vmClone, nil
+}
+
+func waitForClone(cmd *cobra.Command, virtClient kubecli.KubevirtClient, namespace, name string, timeout time.Duration) error {
+	return virtwait.PollImmediately(WaitInterval, timeout, func(ctx context.Context) (bool, error) {
+		vmClone, err := virtClient.VirtualMachineClone(namespace).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return false, err
+		}
+		switch vmClone.Status.Phase {
+		case clonev1beta1.Succeeded:
+			cmd.Printf("VirtualMachineClone %s is complete, created VirtualMachine %s\n", name, targetName(vmClone))
+			return true, nil
+		case clonev1beta1.Failed:
+			return false, fmt.Errorf("VirtualMachineClone %s failed: %s", name, failureMessage(vmClone))
+		}
+		cmd.Printf("Waiting for VirtualMachineClone %s to complete, current phase: %s\n", name, vmClone.Status.Phase)
+		return false, nil
+	})
+}
+
+func targetName(vmClone *clonev1beta1.VirtualMachineClone) string {
+	if vmClone.Status.TargetName != nil {
+		return *vmClone.Status.TargetName
+	}
+	return vmClone.Spec.Target.Name
+}
+
+func failureMessage(vmClone *clonev1beta1.VirtualMachineClone) string {
+	for _, condition := range vmClone.Status.Conditions {
+		if condition.Message != "" && condition.Status == k8sv1.ConditionFalse {
+			return condition.Message
+		}
+	}
+	return "unknown error"
+}
@@ -0,0 +1,165 @@
+package clone_test
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	k8sv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	k8stesting "k8s.io/client-go/testing"
+
+	clonev1beta1 "kubevirt.io/api/clone/v1beta1"
+	"kubevirt.io/client-go/kubecli"
+	kubevirtfake "kubevirt.io/client-go/kubevirt/fake"
+
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/virtctl/clone"
+	createclone "kubevirt.io/kubevirt/pkg/virtctl/create/clone"
+	"kubevirt.io/kubevirt/pkg/virtctl/testing"
+)
+
+var _ = Describe("Clone", func() {
+	const (
+		sourceName = "source-vm"
+		targetName = "target-vm"
+		cloneName  = "test-clone"
+	)
+
+	var virtClient *kubevirtfake.Clientset
+
+	BeforeEach(func() {
+		virtClient = kubevirtfake.NewSimpleClientset()
+
+		ctrl := gomock.NewController(GinkgoT())
+		kubecli.GetKubevirtClientFromClientConfig = kubecli.GetMockKubevirtClientFromClientConfig
+		kubecli.MockKubevirtClientInstance = kubecli.NewMockKubevirtClient(ctrl)
+		kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachineClone(metav1.NamespaceDefault).
+			Return(virtClient.CloneV1beta1().VirtualMachineClones(metav1.NamespaceDefault)).AnyTimes()
+
+		waitInterval := clone.WaitInterval
+		clone.WaitInterval = 10 * time.Millisecond
+		DeferCleanup(func() {
+			clone.WaitInterval = waitInterval
+		})
+	})
+
+	getClone := func() *clonev1beta1.VirtualMachineClone {
+		vmClone, err := virtClient.CloneV1beta1().VirtualMachineClones(metav1.NamespaceDefault).Get(context.Background(), cloneName, metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		return vmClone
+	}
+
+	setCloneStatusOnGet := func(status clonev1beta1.VirtualMachineCloneStatus) {
+		virtClient.PrependReactor("get", "virtualmachineclones", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			return true, &clonev1beta1.VirtualMachineClone{
+				ObjectMeta: metav1.ObjectMeta{Name: action.(k8stesting.GetAction).GetName(), Namespace: metav1.NamespaceDefault},
+				Status:     status,
+			}, nil
+		})
+	}
+
+	DescribeTable("should fail", func(errorString string, args ...string) {
+		err := testing.NewRepeatableVirtctlCommand(append([]string{clone.COMMAND_CLONE}, args...)...)()
+		Expect(err).To(MatchError(ContainSubstring(errorString)))
+	},
+		Entry("without target", "accepts 3 arg(s), received 2", "vm", sourceName),
+		Entry("with unsupported resource type", "unsupported resource type: vmi", "vmi", sourceName, targetName),
+		Entry("with a MAC address without interface name", "empty interface name", "vm", sourceName, targetName, setFlag(createclone.NewMacAddressesFlag, ":02-00-00-00-00-01")),
+		Entry("with a MAC address without address", "exactly one ':' is expected", "vm", sourceName, targetName, setFlag(createclone.NewMacAddressesFlag, "default")),
+	)
+
+	It("should create a clone of the VM", func() {
+		Expect(testing.NewRepeatableVirtctlCommand(clone.COMMAND_CLONE, "vm", sourceName, targetName, setFlag(clone.NameFlag, cloneName),
+			setFlag(createclone.LabelFilterFlag, "*"), setFlag(createclone.LabelFilterFlag, "!some/key"),
+			setFlag(createclone.AnnotationFilterFlag, "!other/key"),
+			setFlag(createclone.TemplateLabelFilterFlag, "*"),
+			setFlag(createclone.TemplateAnnotationFilterFlag, "!template/key"),
+			setFlag(createclone.NewMacAddressesFlag, "default:02-00-00-00-00-01"),
+			setFlag(createclone.NewSMBiosSerialFlag, "serial"),
+		)()).To(Succeed())
+
+		vmClone := getClone()
+		Expect(vmClone.Spec.Source).To(Equal(&k8sv1.TypedLocalObjectReference{
+			APIGroup: pointer.P("kubevirt.io"),
+			Kind:     "VirtualMachine",
+			Name:     sourceName,
+		}))
+		Expect(vmClone.Spec.Target).To(Equal(&k8sv1.TypedLocalObjectReference{
+			APIGroup: pointer.P("kubevirt.io"),
+			Kind:     "VirtualMachine",
+			Name:     targetName,
+		}))
+		Expect(vmClone.Spec.LabelFilters).To(Equal([]string{"*", "!some/key"}))
+		Expect(vmClone.Spec.AnnotationFilters).To(Equal([]string{"!other/key"}))
+		Expect(vmClone.Spec.Template.LabelFilters).To(Equal([]string{"*"}))
+		Expect(vmClone.Spec.Template.AnnotationFilters).To(Equal([]string{"!template/key"}))
+		Expect(vmClone.Spec.NewMacAddresses).To(Equal(map[string]string{"default": "02-00-00-00-00-01"}))
+		Expect(vmClone.Spec.NewSMBiosSerial).To(HaveValue(Equal("serial")))
+	})
+
+	It("should create a clone of a VM snapshot with --snapshot-source", func() {
+		Expect(testing.NewRepeatableVirtctlCommand(clone.COMMAND_CLONE, "vm", "source-snapshot", targetName,
+			setFlag(clone.NameFlag, cloneName), "--"+clone.SnapshotSourceFlag)()).To(Succeed())
+
+		vmClone := getClone()
+		Expect(vmClone.Spec.Source).To(Equal(&k8sv1.TypedLocalObjectReference{
+			APIGroup: pointer.P("snapshot.kubevirt.io"),
+			Kind:     "VirtualMachineSnapshot",
+			Name:     "source-snapshot",
+		}))
+		Expect(vmClone.Spec.NewMacAddresses).To(BeEmpty())
+		Expect(vmClone.Spec.NewSMBiosSerial).To(BeNil())
+	})
+
+	It("should use a generated name if none is given", func() {
+		virtClient.PrependReactor("create", "virtualmachineclones", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			vmClone := action.(k8stesting.CreateAction).GetObject().(*clonev1beta1.VirtualMachineClone)
+			Expect(vmClone.Name).To(BeEmpty())
+			Expect(vmClone.GenerateName).To(Equal(sourceName + "-clone-"))
+			return true, vmClone, nil
+		})
+		Expect(testing.NewRepeatableVirtctlCommand(clone.COMMAND_CLONE, "vm", sourceName, targetName)()).To(Succeed())
+	})
+
+	It("should wait until the clone is complete", func() {
+		setCloneStatusOnGet(clonev1beta1.VirtualMachineCloneStatus{
+			Phase:      clonev1beta1.Succeeded,
+			TargetName: pointer.P(targetName),
+		})
+		out, err := testing.NewRepeatableVirtctlCommandWithOut(clone.COMMAND_CLONE, "vm", sourceName, targetName,
+			setFlag(clone.NameFlag, cloneName), "--"+clone.WaitFlag)()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(out)).To(ContainSubstring("created VirtualMachine " + targetName))
+	})
+
+	It("should fail waiting if the clone failed", func() {
+		setCloneStatusOnGet(clonev1beta1.VirtualMachineCloneStatus{
+			Phase: clonev1beta1.Failed,
+			Conditions: []clonev1beta1.Condition{{
+				Type:    clonev1beta1.ConditionReady,
+				Status:  k8sv1.ConditionFalse,
+				Message: "source VM does not exist",
+			}},
+		})
+		err := testing.NewRepeatableVirtctlCommand(clone.COMMAND_CLONE, "vm", sourceName, targetName,
+			setFlag(clone.NameFlag, cloneName), "--"+clone.WaitFlag)()
+		Expect(err).To(MatchError(ContainSubstring("source VM does not exist")))
+	})
+
+	It("should time out waiting if the clone does not complete", func() {
+		setCloneStatusOnGet(clonev1beta1.VirtualMachineCloneStatus{Phase: clonev1beta1.SnapshotInProgress})
+		err := testing.NewRepeatableVirtctlCommand(clone.COMMAND_CLONE, "vm", sourceName, targetName,
+			setFlag(clone.NameFlag, cloneName), "--"+clone.WaitFlag, setFlag(clone.TimeoutFlag, "50ms"))()
+		Expect(err).To(HaveOccurred())
+	})
+})
+
+func setFlag(flag, parameter string) string {
+	return fmt.Sprintf("--%s=%s", flag, parameter)
+}
@@ -37,8 +37,14 @@ import (
 	"kubevirt.io/kubevirt/pkg/virtctl/templates"
 )
 
+const (
+	defaultRecordingWidth  = 80
+	defaultRecordingHeight = 24
+)
+
 type consoleCommand struct {
 	timeout int
+	record  string
 }
 
 func NewCommand() *cobra.Command {
@@ -51,6 +57,7 @@ func NewCommand() *cobra.Command {
 		RunE:    c.run,
 	}
 	cmd.Flags().IntVar(&c.timeout, "timeout", 5, "The number of minutes to wait for the virtual machine instance to be ready.")
+	cmd.Flags().StringVar(&c.record, "record", "", "Record the console output with timestamps to the given file in the asciicast v2 format, which can be replayed with asciinema.")
 	cmd.SetUsageTemplate(templates.UsageTemplate())
 	return cmd
 }
@@ -59,7 +66,9 @@ func usage() string {
 	usage := `  # Connect to the console on VirtualMachineInstance 'myvmi':
   {{ProgramName}} console myvmi
   # Configure one minute timeout (default 5 minutes)
-  {{ProgramName}} console --timeout=1 myvmi`
+  {{ProgramName}} console --timeout=1 myvmi
+  # Record the console session of VirtualMachineInstance 'myvmi' to a file which can be replayed with 'asciinema play':
+  {{ProgramName}} console --record=myvmi.cast myvmi`
 
 	return usage
 }
@@ -72,16 +81,44 @@ func (c *consoleCommand) run(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("cannot obtain KubeVirt client: %v", err)
 	}
 
-	return c.handleConsoleConnection(client, namespace, vmi)
+	if c.record == "" {
+		return c.handleConsoleConnection(client, namespace, vmi, nil)
+	}
+
+	file, err := os.Create(c.record)
+	if err != nil {
+		return fmt.Errorf("cannot create console recording: %v", err)
+	}
+	defer file.Close()
+
+	width, height, err := term.GetSize(int(os.Stdout.Fd()))
+	if err != nil {
+		width, height = defaultRecordingWidth, defaultRecordingHeight
+	}
+	recorder, err := newAsciicastRecorder(file, width, height, fmt.Sprintf("%s/%s console", namespace, vmi), time.Now)
+	if err != nil {
+		return fmt.Errorf("cannot write console recording: %v", err)
+	}
+
+	err = c.handleConsoleConnection(client, namespace, vmi, recorder)
+	if recordErr := recorder.Flush(); recordErr != nil {
+		fmt.Fprintf(os.Stderr, "Console recording %s is incomplete: %v\n", c.record, recordErr)
+	}
+	return err
 }
 
-func (c *consoleCommand) handleConsoleConnection(client kubecli.KubevirtClient, namespace, vmi string) error {
+func (c *consoleCommand) handleConsoleConnection(client kubecli.KubevirtClient, namespace, vmi string, recorder io.Writer) error {
 	// in -> stdinWriter | stdinReader -> console
 	// out <- stdoutReader | stdoutWriter <- console
 	// Wait until the virtual machine is in running phase, user interrupt or timeout
 	stdinReader, stdinWriter := io.Pipe()
 	stdoutReader, stdoutWriter := io.Pipe()
 
+	var out io.Writer = stdoutWriter
+	if recorder != nil {
+		out = io.MultiWriter(stdoutWriter, recorder)
+	}
+
 	resChan := make(chan error)
 	runningChan := make(chan error)
 	waitInterrupt := make(chan os.Signal, 1)
@@ -97,7 +134,7 @@ func (c *consoleCommand) handleConsoleConnection(client kubecli.KubevirtClient,
 
 		resChan <- con.Stream(kvcorev1.StreamOptions{
 			In:  stdinReader,
-			Out: stdoutWriter,
+			Out: out,
 		})
 	}()
 
@@ -0,0 +1,123 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package console
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+	"unicode/utf8"
+)
+
+type asciicastHeader struct {
+	Version   int               `json:"version"`
+	Width     int               `json:"width"`
+	Height    int               `json:"height"`
+	Timestamp int64             `json:"timestamp"`
+	Title     string            `json:"title,omitempty"`
+	Env       map[string]string `json:"env,omitempty"`
+}
+
+// asciicastRecorder writes the console output it receives as an asciicast v2 recording,
+// see https://docs.asciinema.org/manual/asciicast/v2/
+type asciicastRecorder struct {
+	lock  sync.Mutex
+	out   io.Writer
+	start time.Time
+	now   func() time.Time
+	// pending holds the bytes of an incomplete UTF-8 sequence at the end of the last write
+	pending []byte
+	err     error
+}
+
+func newAsciicastRecorder(out io.Writer, width, height int, title string, now func() time.Time) (*asciicastRecorder, error) {
+	r := &asciicastRecorder{
+		out:   out,
+		start: now(),
+		now:   now,
+	}
+	header, err := json.Marshal(asciicastHeader{
+		Version:   2,
+		Width:     width,
+		Height:    height,
+		Timestamp: r.start.Unix(),
+		Title:     title,
+		Env:       map[string]string{"TERM": "xterm"},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if _, err := fmt.Fprintf(out, "%s\n", header); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// Write records p as an output event. It never fails so that a broken recording does not
+// interrupt the console session, the first error is returned by Flush instead.
+func (r *asciicastRecorder) Write(p []byte) (int, error) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	data := append(r.pending, p...)
+	complete := len(data)
+	// hold back a trailing partial rune until the rest of it arrives
+	for i := 1; i < utf8.UTFMax && i <= len(data); i++ {
+		if utf8.RuneStart(data[len(data)-i]) {
+			if !utf8.FullRune(data[len(data)-i:]) {
+				complete = len(data) - i
+			}
+			break
+		}
+	}
+	r.pending = append([]byte(nil), data[complete:]...)
+	if err := r.writeEvent(data[:complete]); err != nil && r.err == nil {
+		r.err = err
+	}
+	return len(p), nil
+}
+
+// Flush records any bytes held back from the previous write and
+// returns the first error which occurred while recording
+func (r *asciicastRecorder) Flush() error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	data := r.pending
+	r.pending = nil
+	if err := r.writeEvent(data); err != nil && r.err == nil {
+		r.err = err
+	}
+	return r.err
+}
+
+func (r *asciicastRecorder) writeEvent(data []byte) error {
+	if len(data) == 0 {
+		return nil
+	}
+	event, err := json.Marshal([]interface{}{r.now().Sub(r.start).Seconds(), "o", string(data)})
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(r.out, "%s\n", event)
+	return err
+}
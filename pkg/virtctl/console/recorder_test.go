@@ -0,0 +1,138 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package console
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+type failingWriter struct {
+	writes int
+}
+
+func (w *failingWriter) Write(p []byte) (int, error) {
+	w.writes++
+	if w.writes > 1 {
+		return 0, errors.New("disk full")
+	}
+	return len(p), nil
+}
+
+var _ = Describe("asciicast recorder", func() {
+	var (
+		out *bytes.Buffer
+		now time.Time
+	)
+
+	clock := func() time.Time {
+		return now
+	}
+
+	lines := func() []string {
+		return strings.Split(strings.TrimSuffix(out.String(), "\n"), "\n")
+	}
+
+	event := func(line string) []interface{} {
+		var e []interface{}
+		ExpectWithOffset(1, json.Unmarshal([]byte(line), &e)).To(Succeed())
+		return e
+	}
+
+	BeforeEach(func() {
+		out = &bytes.Buffer{}
+		now = time.Unix(1700000000, 0)
+	})
+
+	It("should write a v2 header", func() {
+		_, err := newAsciicastRecorder(out, 120, 40, "default/testvmi console", clock)
+		Expect(err).ToNot(HaveOccurred())
+
+		header := asciicastHeader{}
+		Expect(json.Unmarshal([]byte(lines()[0]), &header)).To(Succeed())
+		Expect(header.Version).To(Equal(2))
+		Expect(header.Width).To(Equal(120))
+		Expect(header.Height).To(Equal(40))
+		Expect(header.Timestamp).To(Equal(int64(1700000000)))
+		Expect(header.Title).To(Equal("default/testvmi console"))
+	})
+
+	It("should record output events relative to the start of the recording", func() {
+		r, err := newAsciicastRecorder(out, 80, 24, "", clock)
+		Expect(err).ToNot(HaveOccurred())
+
+		now = now.Add(500 * time.Millisecond)
+		n, err := r.Write([]byte("Booting...\r\n"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(n).To(Equal(12))
+		now = now.Add(2 * time.Second)
+		_, err = r.Write([]byte("login: "))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(r.Flush()).To(Succeed())
+
+		Expect(lines()).To(HaveLen(3))
+		Expect(event(lines()[1])).To(Equal([]interface{}{0.5, "o", "Booting...\r\n"}))
+		Expect(event(lines()[2])).To(Equal([]interface{}{2.5, "o", "login: "}))
+	})
+
+	It("should not split multi-byte characters across events", func() {
+		r, err := newAsciicastRecorder(out, 80, 24, "", clock)
+		Expect(err).ToNot(HaveOccurred())
+
+		euro := []byte("€")
+		_, err = r.Write(append([]byte("price: "), euro[:2]...))
+		Expect(err).ToNot(HaveOccurred())
+		_, err = r.Write(euro[2:])
+		Expect(err).ToNot(HaveOccurred())
+		Expect(r.Flush()).To(Succeed())
+
+		Expect(lines()).To(HaveLen(3))
+		Expect(event(lines()[1])[2]).To(Equal("price: "))
+		Expect(event(lines()[2])[2]).To(Equal("€"))
+	})
+
+	It("should record held back bytes on flush", func() {
+		r, err := newAsciicastRecorder(out, 80, 24, "", clock)
+		Expect(err).ToNot(HaveOccurred())
+
+		_, err = r.Write([]byte("€")[:1])
+		Expect(err).ToNot(HaveOccurred())
+		Expect(lines()).To(HaveLen(1))
+
+		Expect(r.Flush()).To(Succeed())
+		Expect(lines()).To(HaveLen(2))
+	})
+
+	It("should not fail writes but report the error on flush", func() {
+		r, err := newAsciicastRecorder(&failingWriter{}, 80, 24, "", clock)
+		Expect(err).ToNot(HaveOccurred())
+
+		n, err := r.Write([]byte("output"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(n).To(Equal(6))
+		Expect(r.Flush()).To(MatchError("disk full"))
+	})
+})
@@ -55,12 +55,17 @@ const (
 )
 
 type createClone struct {
-	namespace                 string
-	name                      string
-	sourceName                string
-	targetName                string
-	sourceType                string
-	targetType                string
+	SpecFlags
+	namespace  string
+	name       string
+	sourceName string
+	targetName string
+	sourceType string
+	targetType string
+}
+
+// SpecFlags are the flags for the filters and the new identity of a clone, which are shared with the clone command
+type SpecFlags struct {
 	labelFilters              []string
 	annotationFilters         []string
 	templateLabelFilters      []string
@@ -69,13 +74,6 @@ type createClone struct {
 	newSmbiosSerial           string
 }
 
-type cloneSpec clone.VirtualMachineCloneSpec
-type optionFn func(*createClone, *cloneSpec) error
-
-var optFns = map[string]optionFn{
-	NewMacAddressesFlag: withNewMacAddresses,
-}
-
 func NewCommand() *cobra.Command {
 	c := createClone{}
 	cmd := &cobra.Command{
@@ -86,19 +84,13 @@ func NewCommand() *cobra.Command {
 	}
 
 	const emptyValue = ""
-	const supportsMultipleFlags = "Can be provided multiple times."
 
 	cmd.Flags().StringVar(&c.name, NameFlag, emptyValue, "Specify the name of the clone. If not specified, name would be randomized.")
 	cmd.Flags().StringVar(&c.sourceName, SourceNameFlag, emptyValue, "Specify the clone's source name.")
 	cmd.Flags().StringVar(&c.targetName, TargetNameFlag, emptyValue, "Specify the clone's target name.")
 	cmd.Flags().StringVar(&c.sourceType, SourceTypeFlag, emptyValue, "Specify the clone's source type. Default type is VM. Supported types: "+supportedSourceTypes)
 	cmd.Flags().StringVar(&c.targetType, TargetTypeFlag, emptyValue, "Specify the clone's target type. Default type is VM. Supported types: "+supportedTargetTypes)
-	cmd.Flags().StringArrayVar(&c.labelFilters, LabelFilterFlag, nil, "Specify clone's label filters. "+supportsMultipleFlags)
-	cmd.Flags().StringArrayVar(&c.annotationFilters, AnnotationFilterFlag, nil, "Specify clone's annotation filters. "+supportsMultipleFlags)
-	cmd.Flags().StringArrayVar(&c.templateLabelFilters, TemplateLabelFilterFlag, nil, "Specify clone's template label filters. "+supportsMultipleFlags)
-	cmd.Flags().StringArrayVar(&c.templateAnnotationFilters, TemplateAnnotationFilterFlag, nil, "Specify clone's template annotation filters. "+supportsMultipleFlags)
-	cmd.Flags().StringArrayVar(&c.newMacAddresses, NewMacAddressesFlag, nil, "Specify clone's new mac addresses. For example: 'interfaceName0:newAddress0'")
-	cmd.Flags().StringVar(&c.newSmbiosSerial, NewSMBiosSerialFlag, emptyValue, "Specify the clone's new smbios serial")
+	c.SpecFlags.AddFlags(cmd)
 
 	if err := cmd.MarkFlagRequired(SourceNameFlag); err != nil {
 		panic(err)
@@ -107,8 +99,36 @@ func NewCommand() *cobra.Command {
 	return cmd
 }
 
-func withNewMacAddresses(c *createClone, cloneSpec *cloneSpec) error {
-	for _, param := range c.newMacAddresses {
+// AddFlags adds the filter and new identity flags to the command
+func (f *SpecFlags) AddFlags(cmd *cobra.Command) {
+	const supportsMultipleFlags = "Can be provided multiple times."
+
+	cmd.Flags().StringArrayVar(&f.labelFilters, LabelFilterFlag, nil, "Specify clone's label filters. "+supportsMultipleFlags)
+	cmd.Flags().StringArrayVar(&f.annotationFilters, AnnotationFilterFlag, nil, "Specify clone's annotation filters. "+supportsMultipleFlags)
+	cmd.Flags().StringArrayVar(&f.templateLabelFilters, TemplateLabelFilterFlag, nil, "Specify clone's template label filters. "+supportsMultipleFlags)
+	cmd.Flags().StringArrayVar(&f.templateAnnotationFilters, TemplateAnnotationFilterFlag, nil, "Specify clone's template annotation filters. "+supportsMultipleFlags)
+	cmd.Flags().StringArrayVar(&f.newMacAddresses, NewMacAddressesFlag, nil, "Specify clone's new mac addresses. For example: 'interfaceName0:newAddress0'")
+	cmd.Flags().StringVar(&f.newSmbiosSerial, NewSMBiosSerialFlag, "", "Specify the clone's new smbios serial")
+}
+
+// ApplyTo sets the filters and the new identity of the flags in the spec of a clone
+func (f *SpecFlags) ApplyTo(spec *clone.VirtualMachineCloneSpec) error {
+	spec.AnnotationFilters = f.annotationFilters
+	spec.LabelFilters = f.labelFilters
+	spec.Template = clone.VirtualMachineCloneTemplateFilters{
+		AnnotationFilters: f.templateAnnotationFilters,
+		LabelFilters:      f.templateLabelFilters,
+	}
+
+	if f.newSmbiosSerial != "" {
+		spec.NewSMBiosSerial = pointer.P(f.newSmbiosSerial)
+	}
+
+	return f.applyNewMacAddresses(spec)
+}
+
+func (f *SpecFlags) applyNewMacAddresses(cloneSpec *clone.VirtualMachineCloneSpec) error {
+	for _, param := range f.newMacAddresses {
 		splitParam := strings.Split(param, ":")
 		if len(splitParam) != 2 {
 			return fmt.Errorf("newMacAddress parameter %s is invalid: exactly one ':' is expected. For example: 'interface0:address0'", param)
@@ -179,46 +199,28 @@ func (c *createClone) newClone() (*clone.VirtualMachineClone, error) {
 		vmClone.Namespace = c.namespace
 	}
 
-	source, err := c.typeToTypedLocalObjectReference(c.sourceType, c.sourceName, true)
+	source, err := TypeToTypedLocalObjectReference(c.sourceType, c.sourceName, true)
 	if err != nil {
 		return nil, err
 	}
 
-	target, err := c.typeToTypedLocalObjectReference(c.targetType, c.targetName, false)
+	target, err := TypeToTypedLocalObjectReference(c.targetType, c.targetName, false)
 	if err != nil {
 		return nil, err
 	}
 
 	vmClone.Spec = clone.VirtualMachineCloneSpec{
-		Source:            source,
-		Target:            target,
-		AnnotationFilters: c.annotationFilters,
-		LabelFilters:      c.labelFilters,
-		Template: clone.VirtualMachineCloneTemplateFilters{
-			AnnotationFilters: c.templateAnnotationFilters,
-			LabelFilters:      c.templateLabelFilters,
-		},
+		Source: source,
+		Target: target,
 	}
 
-	if c.newSmbiosSerial != "" {
-		vmClone.Spec.NewSMBiosSerial = pointer.P(c.newSmbiosSerial)
+	if err := c.SpecFlags.ApplyTo(&vmClone.Spec); err != nil {
+		return nil, err
 	}
 
 	return vmClone, nil
 }
 
-func (c *createClone) applyFlags(cmd *cobra.Command, spec *clone.VirtualMachineCloneSpec) error {
-	for flag := range optFns {
-		if cmd.Flags().Changed(flag) {
-			if err := optFns[flag](c, (*cloneSpec)(spec)); err != nil {
-				return err
-			}
-		}
-	}
-
-	return nil
-}
-
 func (c *createClone) run(cmd *cobra.Command, _ []string) error {
 	if err := c.setDefaults(cmd.Context()); err != nil {
 		return err
@@ -234,11 +236,6 @@ func (c *createClone) run(cmd *cobra.Command, _ []string) error {
 		return err
 	}
 
-	err = c.applyFlags(cmd, &clone.Spec)
-	if err != nil {
-		return err
-	}
-
 	if clone.Name == "" {
 		clone.Name = "clone-" + rand.String(5)
 	}
@@ -252,7 +249,8 @@ func (c *createClone) run(cmd *cobra.Command, _ []string) error {
 	return nil
 }
 
-func (c *createClone) typeToTypedLocalObjectReference(sourceOrTargetType, sourceOrTargetName string, isSource bool) (*v1.TypedLocalObjectReference, error) {
+// TypeToTypedLocalObjectReference returns the reference to the source or target of a clone of the given type
+func TypeToTypedLocalObjectReference(sourceOrTargetType, sourceOrTargetName string, isSource bool) (*v1.TypedLocalObjectReference, error) {
 	var kind, apiGroup string
 
 	generateErr := func() error {
@@ -0,0 +1,250 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package vm
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/api/instancetype"
+	"kubevirt.io/client-go/kubecli"
+
+	"kubevirt.io/kubevirt/pkg/virtctl/clientconfig"
+)
+
+const noneChoice = "none"
+
+// wizard asks for the settings of the VM on the command line and offers the
+// instancetypes, preferences, DataSources and networks which exist in the cluster
+type wizard struct {
+	client    kubecli.KubevirtClient
+	namespace string
+	in        *bufio.Reader
+	out       io.Writer
+}
+
+// runWizard sets the flags which were not passed on the command line from the choices of the user.
+// Choices are applied through the flags, so they are validated and processed like any other flag.
+func (c *createVM) runWizard(cmd *cobra.Command) error {
+	client, namespace, _, err := clientconfig.ClientAndNamespaceFromContext(cmd.Context())
+	if err != nil {
+		return err
+	}
+	if c.namespace != "" {
+		namespace = c.namespace
+	}
+
+	w := &wizard{
+		client:    client,
+		namespace: namespace,
+		in:        bufio.NewReader(cmd.InOrStdin()),
+		out:       cmd.ErrOrStderr(),
+	}
+
+	if !anyFlagChanged(cmd, MemoryFlag, InstancetypeFlag, InferInstancetypeFlag, InferInstancetypeFromFlag) {
+		choice, err := w.chooseInstancetype(cmd.Context())
+		if err != nil {
+			return err
+		}
+		if choice != noneChoice {
+			if err := cmd.Flags().Set(InstancetypeFlag, choice); err != nil {
+				return err
+			}
+		}
+	}
+
+	if !anyFlagChanged(cmd, PreferenceFlag, InferPreferenceFlag, InferPreferenceFromFlag) {
+		choice, err := w.choosePreference(cmd.Context())
+		if err != nil {
+			return err
+		}
+		if choice != noneChoice {
+			if err := cmd.Flags().Set(PreferenceFlag, choice); err != nil {
+				return err
+			}
+		}
+	}
+
+	if !anyFlagChanged(cmd, ContainerdiskVolumeFlag, PvcVolumeFlag, VolumeImportFlag,
+		DataSourceVolumeFlag, ClonePvcVolumeFlag, BlankVolumeFlag) {
+		choice, err := w.chooseBootSource(cmd.Context())
+		if err != nil {
+			return err
+		}
+		if choice != noneChoice {
+			if err := cmd.Flags().Set(VolumeImportFlag, fmt.Sprintf("type:%s,src:%s", ds, choice)); err != nil {
+				return err
+			}
+		}
+	}
+
+	choice, err := w.chooseNetwork(cmd.Context())
+	if err != nil {
+		return err
+	}
+	if choice != noneChoice {
+		c.network = choice
+	}
+
+	return nil
+}
+
+func anyFlagChanged(cmd *cobra.Command, names ...string) bool {
+	for _, name := range names {
+		if cmd.Flags().Changed(name) {
+			return true
+		}
+	}
+	return false
+}
+
+func (w *wizard) chooseInstancetype(ctx context.Context) (string, error) {
+	options := []string{noneChoice}
+	clusterInstancetypes, err := w.client.VirtualMachineClusterInstancetype().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return "", fmt.Errorf("failed to list cluster instancetypes: %w", err)
+	}
+	for _, it := range clusterInstancetypes.Items {
+		options = append(options, instancetype.ClusterSingularResourceName+"/"+it.Name)
+	}
+	instancetypes, err := w.client.VirtualMachineInstancetype(w.namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return "", fmt.Errorf("failed to list instancetypes: %w", err)
+	}
+	for _, it := range instancetypes.Items {
+		options = append(options, instancetype.SingularResourceName+"/"+it.Name)
+	}
+	return w.choose("Instancetype (none keeps the default memory)", options)
+}
+
+func (w *wizard) choosePreference(ctx context.Context) (string, error) {
+	options := []string{noneChoice}
+	clusterPreferences, err := w.client.VirtualMachineClusterPreference().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return "", fmt.Errorf("failed to list cluster preferences: %w", err)
+	}
+	for _, preference := range clusterPreferences.Items {
+		options = append(options, instancetype.ClusterSingularPreferenceResourceName+"/"+preference.Name)
+	}
+	preferences, err := w.client.VirtualMachinePreference(w.namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return "", fmt.Errorf("failed to list preferences: %w", err)
+	}
+	for _, preference := range preferences.Items {
+		options = append(options, instancetype.SingularPreferenceResourceName+"/"+preference.Name)
+	}
+	return w.choose("Preference", options)
+}
+
+// chooseBootSource offers the DataSources of all namespaces. If the user is not allowed to list
+// them cluster wide only the DataSources of the namespace of the VM are offered.
+func (w *wizard) chooseBootSource(ctx context.Context) (string, error) {
+	dataSources, err := w.client.CdiClient().CdiV1beta1().DataSources(metav1.NamespaceAll).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		dataSources, err = w.client.CdiClient().CdiV1beta1().DataSources(w.namespace).List(ctx, metav1.ListOptions{})
+		if err != nil {
+			return "", fmt.Errorf("failed to list DataSources: %w", err)
+		}
+	}
+	options := []string{noneChoice}
+	for _, dataSource := range dataSources.Items {
+		options = append(options, dataSource.Namespace+"/"+dataSource.Name)
+	}
+	return w.choose("Boot source (DataSource to clone)", options)
+}
+
+// chooseNetwork offers the NetworkAttachmentDefinitions of the namespace of the VM
+// which are added as secondary network next to the pod network
+func (w *wizard) chooseNetwork(ctx context.Context) (string, error) {
+	nads, err := w.client.NetworkClient().K8sCniCncfIoV1().NetworkAttachmentDefinitions(w.namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return "", fmt.Errorf("failed to list NetworkAttachmentDefinitions: %w", err)
+	}
+	if len(nads.Items) == 0 {
+		return noneChoice, nil
+	}
+	options := []string{noneChoice}
+	for _, nad := range nads.Items {
+		options = append(options, nad.Namespace+"/"+nad.Name)
+	}
+	return w.choose("Secondary network (the pod network is always attached)", options)
+}
+
+// choose prints the numbered options and reads the choice of the user, an empty answer selects the first option
+func (w *wizard) choose(title string, options []string) (string, error) {
+	fmt.Fprintf(w.out, "%s:\n", title)
+	for i, option := range options {
+		fmt.Fprintf(w.out, "  %d) %s\n", i+1, option)
+	}
+
+	for {
+		fmt.Fprint(w.out, "Choice [1]: ")
+		line, err := w.in.ReadString('\n')
+		if err != nil && (!errors.Is(err, io.EOF) || line == "") {
+			return "", fmt.Errorf("failed to read the choice: %w", err)
+		}
+		line = strings.TrimSpace(line)
+		if line == "" {
+			return options[0], nil
+		}
+		if i, convErr := strconv.Atoi(line); convErr == nil && i >= 1 && i <= len(options) {
+			return options[i-1], nil
+		}
+		if err != nil {
+			return "", fmt.Errorf("invalid choice \"%s\"", line)
+		}
+		fmt.Fprintf(w.out, "Invalid choice \"%s\", enter a number between 1 and %d.\n", line, len(options))
+	}
+}
+
+// withNetwork attaches the VM to the pod network and the chosen NetworkAttachmentDefinition
+func (c *createVM) withNetwork(vm *v1.VirtualMachine) {
+	if c.network == "" {
+		return
+	}
+
+	_, name, _ := strings.Cut(c.network, "/")
+	spec := &vm.Spec.Template.Spec
+	spec.Networks = []v1.Network{
+		*v1.DefaultPodNetwork(),
+		{
+			Name: name,
+			NetworkSource: v1.NetworkSource{
+				Multus: &v1.MultusNetwork{NetworkName: c.network},
+			},
+		},
+	}
+	spec.Domain.Devices.Interfaces = []v1.Interface{
+		*v1.DefaultMasqueradeNetworkInterface(),
+		{
+			Name:                   name,
+			InterfaceBindingMethod: v1.InterfaceBindingMethod{Bridge: &v1.InterfaceBridge{}},
+		},
+	}
+}
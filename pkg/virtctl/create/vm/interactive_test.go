@@ -0,0 +1,208 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package vm_test
+
+import (
+	"context"
+	"strings"
+
+	"github.com/golang/mock/gomock"
+	networkv1 "github.com/k8snetworkplumbingwg/network-attachment-definition-client/pkg/apis/k8s.cni.cncf.io/v1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	k8stesting "k8s.io/client-go/testing"
+
+	v1 "kubevirt.io/api/core/v1"
+	instancetypeapi "kubevirt.io/api/instancetype"
+	instancetypev1beta1 "kubevirt.io/api/instancetype/v1beta1"
+	fakecdiclient "kubevirt.io/client-go/containerizeddataimporter/fake"
+	"kubevirt.io/client-go/kubecli"
+	kubevirtfake "kubevirt.io/client-go/kubevirt/fake"
+	fakenetworkclient "kubevirt.io/client-go/networkattachmentdefinitionclient/fake"
+	cdiv1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
+
+	"kubevirt.io/kubevirt/pkg/virtctl/create"
+	. "kubevirt.io/kubevirt/pkg/virtctl/create/vm"
+	"kubevirt.io/kubevirt/pkg/virtctl/testing"
+)
+
+var _ = Describe("create vm --interactive", func() {
+	const (
+		dataSourceNamespace = "os-images"
+		dataSourceName      = "fedora"
+		nadName             = "my-nad"
+	)
+
+	var (
+		virtClient    *kubevirtfake.Clientset
+		cdiClient     *fakecdiclient.Clientset
+		networkClient *fakenetworkclient.Clientset
+	)
+
+	BeforeEach(func() {
+		virtClient = kubevirtfake.NewSimpleClientset(
+			&instancetypev1beta1.VirtualMachineClusterInstancetype{
+				ObjectMeta: metav1.ObjectMeta{Name: "u1.medium"},
+			},
+			&instancetypev1beta1.VirtualMachineClusterPreference{
+				ObjectMeta: metav1.ObjectMeta{Name: "fedora"},
+			},
+			&instancetypev1beta1.VirtualMachinePreference{
+				ObjectMeta: metav1.ObjectMeta{Name: "my-preference", Namespace: metav1.NamespaceDefault},
+			},
+		)
+		cdiClient = fakecdiclient.NewSimpleClientset(&cdiv1.DataSource{
+			ObjectMeta: metav1.ObjectMeta{Name: dataSourceName, Namespace: dataSourceNamespace},
+		})
+		// The tracker of the fake clientset guesses a different resource name than the client uses
+		networkClient = fakenetworkclient.NewSimpleClientset()
+		_, err := networkClient.K8sCniCncfIoV1().NetworkAttachmentDefinitions(metav1.NamespaceDefault).Create(context.Background(),
+			&networkv1.NetworkAttachmentDefinition{
+				ObjectMeta: metav1.ObjectMeta{Name: nadName, Namespace: metav1.NamespaceDefault},
+			}, metav1.CreateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+
+		ctrl := gomock.NewController(GinkgoT())
+		kubecli.GetKubevirtClientFromClientConfig = kubecli.GetMockKubevirtClientFromClientConfig
+		kubecli.MockKubevirtClientInstance = kubecli.NewMockKubevirtClient(ctrl)
+		kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachineClusterInstancetype().
+			Return(virtClient.InstancetypeV1beta1().VirtualMachineClusterInstancetypes()).AnyTimes()
+		kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachineInstancetype(metav1.NamespaceDefault).
+			Return(virtClient.InstancetypeV1beta1().VirtualMachineInstancetypes(metav1.NamespaceDefault)).AnyTimes()
+		kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachineClusterPreference().
+			Return(virtClient.InstancetypeV1beta1().VirtualMachineClusterPreferences()).AnyTimes()
+		kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachinePreference(metav1.NamespaceDefault).
+			Return(virtClient.InstancetypeV1beta1().VirtualMachinePreferences(metav1.NamespaceDefault)).AnyTimes()
+		kubecli.MockKubevirtClientInstance.EXPECT().CdiClient().Return(cdiClient).AnyTimes()
+		kubecli.MockKubevirtClientInstance.EXPECT().NetworkClient().Return(networkClient).AnyTimes()
+	})
+
+	It("should create the VM from the choices", func() {
+		out, err := runInteractiveCmd("2\n3\n2\n2\n")
+		Expect(err).ToNot(HaveOccurred())
+		vm, err := decodeVM(out)
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(vm.Spec.Template.Spec.Domain.Memory).To(BeNil())
+		Expect(vm.Spec.Instancetype).To(PointTo(MatchFields(IgnoreExtras, Fields{
+			"Name": Equal("u1.medium"),
+			"Kind": Equal(instancetypeapi.ClusterSingularResourceName),
+		})))
+		Expect(vm.Spec.Preference).To(PointTo(MatchFields(IgnoreExtras, Fields{
+			"Name": Equal("my-preference"),
+			"Kind": Equal(instancetypeapi.SingularPreferenceResourceName),
+		})))
+
+		Expect(vm.Spec.DataVolumeTemplates).To(HaveLen(1))
+		sourceRef := vm.Spec.DataVolumeTemplates[0].Spec.SourceRef
+		Expect(sourceRef).ToNot(BeNil())
+		Expect(sourceRef.Name).To(Equal(dataSourceName))
+		Expect(sourceRef.Namespace).To(PointTo(Equal(dataSourceNamespace)))
+
+		Expect(vm.Spec.Template.Spec.Networks).To(ConsistOf(
+			*v1.DefaultPodNetwork(),
+			v1.Network{
+				Name: nadName,
+				NetworkSource: v1.NetworkSource{
+					Multus: &v1.MultusNetwork{NetworkName: metav1.NamespaceDefault + "/" + nadName},
+				},
+			},
+		))
+		Expect(vm.Spec.Template.Spec.Domain.Devices.Interfaces).To(HaveLen(2))
+		Expect(vm.Spec.Template.Spec.Domain.Devices.Interfaces[1].Name).To(Equal(nadName))
+		Expect(vm.Spec.Template.Spec.Domain.Devices.Interfaces[1].Bridge).ToNot(BeNil())
+	})
+
+	It("should keep the defaults on empty answers", func() {
+		out, err := runInteractiveCmd("\n\n\n\n")
+		Expect(err).ToNot(HaveOccurred())
+		vm, err := decodeVM(out)
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(vm.Spec.Template.Spec.Domain.Memory).ToNot(BeNil())
+		Expect(vm.Spec.Instancetype).To(BeNil())
+		Expect(vm.Spec.Preference).To(BeNil())
+		Expect(vm.Spec.DataVolumeTemplates).To(BeEmpty())
+		Expect(vm.Spec.Template.Spec.Networks).To(BeEmpty())
+	})
+
+	It("should not ask for settings passed as flags", func() {
+		out, err := runInteractiveCmd("2\n",
+			setFlag(InstancetypeFlag, "my-instancetype"),
+			setFlag(PreferenceFlag, "my-preference"),
+			setFlag(ContainerdiskVolumeFlag, "src:my.registry/my-image:my-tag"),
+		)
+		Expect(err).ToNot(HaveOccurred())
+		vm, err := decodeVM(out)
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(vm.Spec.Instancetype.Name).To(Equal("my-instancetype"))
+		Expect(vm.Spec.Preference.Name).To(Equal("my-preference"))
+		Expect(vm.Spec.DataVolumeTemplates).To(BeEmpty())
+		Expect(vm.Spec.Template.Spec.Networks).To(HaveLen(2))
+	})
+
+	It("should ask again after an invalid choice", func() {
+		out, err := runInteractiveCmd("5\nfoo\n2\n\n\n\n")
+		Expect(err).ToNot(HaveOccurred())
+		vm, err := decodeVM(out)
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(vm.Spec.Instancetype.Name).To(Equal("u1.medium"))
+	})
+
+	It("should fail if the input ends before all choices are made", func() {
+		_, err := runInteractiveCmd("2\n")
+		Expect(err).To(MatchError(ContainSubstring("failed to read the choice")))
+	})
+
+	It("should offer the DataSources of the namespace if listing all namespaces is forbidden", func() {
+		_, err := cdiClient.CdiV1beta1().DataSources(metav1.NamespaceDefault).Create(context.Background(), &cdiv1.DataSource{
+			ObjectMeta: metav1.ObjectMeta{Name: "my-ds", Namespace: metav1.NamespaceDefault},
+		}, metav1.CreateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		cdiClient.Fake.PrependReactor("list", "datasources", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			if action.GetNamespace() != metav1.NamespaceAll {
+				return false, nil, nil
+			}
+			return true, nil, errors.NewForbidden(schema.GroupResource{Resource: "datasources"}, "", nil)
+		})
+
+		out, err := runInteractiveCmd("\n\n2\n\n")
+		Expect(err).ToNot(HaveOccurred())
+		vm, err := decodeVM(out)
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(vm.Spec.DataVolumeTemplates).To(HaveLen(1))
+		Expect(vm.Spec.DataVolumeTemplates[0].Spec.SourceRef.Name).To(Equal("my-ds"))
+		Expect(vm.Spec.DataVolumeTemplates[0].Spec.SourceRef.Namespace).To(PointTo(Equal(metav1.NamespaceDefault)))
+	})
+})
+
+func runInteractiveCmd(input string, extraArgs ...string) ([]byte, error) {
+	args := append([]string{create.CREATE, "vm", "--" + InteractiveFlag}, extraArgs...)
+	return testing.NewRepeatableVirtctlCommandWithInAndOut(strings.NewReader(input), args...)()
+}
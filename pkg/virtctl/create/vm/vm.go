@@ -73,6 +73,8 @@ const (
 	CloudInitUserDataFlag    = "cloud-init-user-data"
 	CloudInitNetworkDataFlag = "cloud-init-network-data"
 
+	InteractiveFlag = "interactive"
+
 	// Deprecated flags
 	DataSourceVolumeFlag = "volume-datasource"
 	ClonePvcVolumeFlag   = "volume-clone-pvc"
@@ -137,6 +139,9 @@ type createVM struct {
 	cloudInitUserData    string
 	cloudInitNetworkData string
 
+	interactive bool
+	network     string
+
 	// Deprecated fields
 	dataSourceVolumes []string
 	clonePvcVolumes   []string
@@ -247,6 +252,8 @@ func NewCommand() *cobra.Command {
 	cmd.MarkFlagsMutuallyExclusive(CloudInitUserDataFlag, SSHKeyFlag)
 	cmd.MarkFlagsMutuallyExclusive(CloudInitUserDataFlag, GAManageSSHFlag)
 
+	cmd.Flags().BoolVar(&c.interactive, InteractiveFlag, c.interactive, "Ask for the instancetype, preference, boot source and network of the VM and offer the ones available in the cluster.\nSettings passed as flags are not asked for.")
+
 	// Deprecated flags
 	cmd.Flags().StringArrayVar(&c.dataSourceVolumes, DataSourceVolumeFlag, c.dataSourceVolumes, "Specify a DataSource to be cloned by the VM. Can be provided multiple times.\nSupported parameters: name:string,src:string,bootorder:uint,size:resource.Quantity\nDEPRECATED: Use --volume-import with type:ds and same params instead.")
 	cmd.Flags().StringArrayVar(&c.clonePvcVolumes, ClonePvcVolumeFlag, c.clonePvcVolumes, "Specify a PVC to be cloned by the VM. Can be provided multiple times.\nSupported parameters: name:string,src:string,bootorder:uint,size:resource.Quantity\nDEPRECATED: Use --volume-import with type:pvc and same params instead.")
@@ -334,6 +341,12 @@ func (c *createVM) run(cmd *cobra.Command, _ []string) error {
 		return err
 	}
 
+	if c.interactive {
+		if err := c.runWizard(cmd); err != nil {
+			return err
+		}
+	}
+
 	vm, err := c.newVM()
 	if err != nil {
 		return err
@@ -347,6 +360,8 @@ func (c *createVM) run(cmd *cobra.Command, _ []string) error {
 		}
 	}
 
+	c.withNetwork(vm)
+
 	if err := c.cloudInitConfig(vm); err != nil {
 		return err
 	}
@@ -478,7 +493,10 @@ func (c *createVM) usage() string {
   {{ProgramName}} create vm --access-cred=type:password,src:my-pws
 
   # Create a manifest for a VirtualMachine with a Containerdisk and a Sysprep volume (source ConfigMap needs to exist)
-  {{ProgramName}} create vm --memory=1Gi --volume-containerdisk=src:my.registry/my-image:my-tag --volume-sysprep=src:my-cm`
+  {{ProgramName}} create vm --memory=1Gi --volume-containerdisk=src:my.registry/my-image:my-tag --volume-sysprep=src:my-cm
+
+  # Create a manifest for a VirtualMachine by choosing the instancetype, preference, boot source and network from the ones available in the cluster
+  {{ProgramName}} create vm --interactive`
 }
 
 func (c *createVM) newVM() (*v1.VirtualMachine, error) {
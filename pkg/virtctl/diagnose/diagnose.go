@@ -0,0 +1,355 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package diagnose
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+	k8sv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/types"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+
+	"kubevirt.io/kubevirt/pkg/virtctl/clientconfig"
+	"kubevirt.io/kubevirt/pkg/virtctl/templates"
+)
+
+const (
+	COMMAND_DIAGNOSE = "diagnose"
+
+	outputArg = "output"
+	eventsArg = "events"
+
+	outputText = "text"
+	outputJSON = "json"
+
+	virtHandlerSelector = v1.AppLabel + "=virt-handler"
+)
+
+type command struct {
+	output string
+	events int
+}
+
+func NewCommand() *cobra.Command {
+	c := command{}
+	cmd := &cobra.Command{
+		Use:   "diagnose vmi (VMI)",
+		Short: "Gather the state of a virtual machine instance and its surroundings into a single report.",
+		Long: `Reports the phase and conditions of a virtual machine instance, the status of its virt-launcher pods,
+the health of the node and the virt-handler it runs on, its migrations and the recent events of the virtual machine instance and its pods.
+Parts of the report which can not be gathered, for example because of missing permissions to read nodes, are listed as errors at the end of the report.`,
+		Args:    cobra.ExactArgs(2),
+		Example: usage(),
+		RunE:    c.run,
+	}
+
+	cmd.Flags().StringVarP(&c.output, outputArg, "o", outputText, "Output format of the report, one of text or json.")
+	cmd.Flags().IntVar(&c.events, eventsArg, 20, "Number of most recent events to report.")
+	cmd.SetUsageTemplate(templates.UsageTemplate())
+
+	return cmd
+}
+
+func usage() string {
+	return `  # Diagnose a virtual machine instance called 'myvmi':
+  {{ProgramName}} diagnose vmi myvmi
+
+  # Diagnose a virtual machine instance called 'myvmi' and attach the report to a support case:
+  {{ProgramName}} diagnose vmi myvmi --output json > myvmi-report.json`
+}
+
+// Report is the diagnosis of a VirtualMachineInstance
+type Report struct {
+	VMI        VMIReport         `json:"vmi"`
+	Pods       []PodReport       `json:"pods"`
+	Node       *NodeReport       `json:"node,omitempty"`
+	Migrations []MigrationReport `json:"migrations"`
+	Events     []EventReport     `json:"events"`
+	// Errors are the parts of the report which could not be gathered
+	Errors []string `json:"errors,omitempty"`
+}
+
+type VMIReport struct {
+	Name           string                                   `json:"name"`
+	Namespace      string                                   `json:"namespace"`
+	Phase          v1.VirtualMachineInstancePhase           `json:"phase"`
+	Reason         string                                   `json:"reason,omitempty"`
+	NodeName       string                                   `json:"nodeName,omitempty"`
+	Conditions     []v1.VirtualMachineInstanceCondition     `json:"conditions,omitempty"`
+	MigrationState *v1.VirtualMachineInstanceMigrationState `json:"migrationState,omitempty"`
+}
+
+type PodReport struct {
+	Name       string               `json:"name"`
+	Phase      k8sv1.PodPhase       `json:"phase"`
+	Reason     string               `json:"reason,omitempty"`
+	Message    string               `json:"message,omitempty"`
+	NodeName   string               `json:"nodeName,omitempty"`
+	Conditions []k8sv1.PodCondition `json:"conditions,omitempty"`
+	Containers []ContainerReport    `json:"containers,omitempty"`
+}
+
+type ContainerReport struct {
+	Name         string `json:"name"`
+	Ready        bool   `json:"ready"`
+	RestartCount int32  `json:"restartCount"`
+	State        string `json:"state"`
+	Reason       string `json:"reason,omitempty"`
+	Message      string `json:"message,omitempty"`
+}
+
+type NodeReport struct {
+	Name        string                `json:"name"`
+	Ready       k8sv1.ConditionStatus `json:"ready,omitempty"`
+	Schedulable string                `json:"schedulable,omitempty"`
+	Heartbeat   string                `json:"heartbeat,omitempty"`
+	VirtHandler *VirtHandlerReport    `json:"virtHandler,omitempty"`
+}
+
+type VirtHandlerReport struct {
+	Name         string         `json:"name"`
+	Phase        k8sv1.PodPhase `json:"phase"`
+	Ready        bool           `json:"ready"`
+	RestartCount int32          `json:"restartCount"`
+}
+
+type MigrationReport struct {
+	Name    string                                  `json:"name"`
+	Phase   v1.VirtualMachineInstanceMigrationPhase `json:"phase"`
+	Created metav1.Time                             `json:"created"`
+}
+
+type EventReport struct {
+	Time    metav1.Time `json:"time"`
+	Type    string      `json:"type"`
+	Reason  string      `json:"reason"`
+	Object  string      `json:"object"`
+	Message string      `json:"message"`
+	Count   int32       `json:"count,omitempty"`
+}
+
+func (c *command) run(cmd *cobra.Command, args []string) error {
+	switch strings.ToLower(args[0]) {
+	case "vmi", "vmis", "virtualmachineinstance", "virtualmachineinstances":
+	default:
+		return fmt.Errorf("unsupported resource type: %s", args[0])
+	}
+	if c.output != outputText && c.output != outputJSON {
+		return fmt.Errorf("unsupported output format: %s", c.output)
+	}
+
+	client, namespace, _, err := clientconfig.ClientAndNamespaceFromContext(cmd.Context())
+	if err != nil {
+		return fmt.Errorf("cannot obtain KubeVirt client: %v", err)
+	}
+
+	vmi, err := client.VirtualMachineInstance(namespace).Get(cmd.Context(), args[1], metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("error fetching VirtualMachineInstance: %v", err)
+	}
+
+	report := c.diagnose(cmd.Context(), client, vmi)
+
+	if c.output == outputJSON {
+		out, err := json.MarshalIndent(report, "", "  ")
+		if err != nil {
+			return err
+		}
+		cmd.Println(string(out))
+		return nil
+	}
+	printReport(cmd, report)
+	return nil
+}
+
+func (c *command) diagnose(ctx context.Context, client kubecli.KubevirtClient, vmi *v1.VirtualMachineInstance) *Report {
+	report := &Report{
+		VMI: VMIReport{
+			Name:           vmi.Name,
+			Namespace:      vmi.Namespace,
+			Phase:          vmi.Status.Phase,
+			Reason:         vmi.Status.Reason,
+			NodeName:       vmi.Status.NodeName,
+			Conditions:     vmi.Status.Conditions,
+			MigrationState: vmi.Status.MigrationState,
+		},
+	}
+	objects := map[types.UID]string{vmi.UID: "VirtualMachineInstance/" + vmi.Name}
+
+	// The VMI has no node until it is running, the pod shows where it is stuck being started
+	nodeName := vmi.Status.NodeName
+	pods, err := client.CoreV1().Pods(vmi.Namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: fmt.Sprintf("%s=%s", v1.CreatedByLabel, vmi.UID),
+	})
+	if err != nil {
+		report.Errors = append(report.Errors, fmt.Sprintf("unable to list the virt-launcher pods: %v", err))
+	} else {
+		for i := range pods.Items {
+			pod := &pods.Items[i]
+			report.Pods = append(report.Pods, newPodReport(pod))
+			objects[pod.UID] = "Pod/" + pod.Name
+			if nodeName == "" {
+				nodeName = pod.Spec.NodeName
+			}
+		}
+	}
+	if nodeName != "" {
+		report.Node = diagnoseNode(ctx, client, nodeName, report)
+	}
+
+	migrations, err := client.VirtualMachineInstanceMigration(vmi.Namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: fmt.Sprintf("%s=%s", v1.MigrationSelectorLabel, vmi.Name),
+	})
+	if err != nil {
+		report.Errors = append(report.Errors, fmt.Sprintf("unable to list the migrations: %v", err))
+	} else {
+		for _, migration := range migrations.Items {
+			report.Migrations = append(report.Migrations, MigrationReport{
+				Name:    migration.Name,
+				Phase:   migration.Status.Phase,
+				Created: migration.CreationTimestamp,
+			})
+		}
+		sort.Slice(report.Migrations, func(i, j int) bool {
+			return report.Migrations[i].Created.Before(&report.Migrations[j].Created)
+		})
+	}
+
+	events, err := client.CoreV1().Events(vmi.Namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		report.Errors = append(report.Errors, fmt.Sprintf("unable to list the events: %v", err))
+	} else {
+		report.Events = recentEvents(events.Items, objects, c.events)
+	}
+
+	return report
+}
+
+func diagnoseNode(ctx context.Context, client kubecli.KubevirtClient, nodeName string, report *Report) *NodeReport {
+	nodeReport := &NodeReport{Name: nodeName}
+
+	node, err := client.CoreV1().Nodes().Get(ctx, nodeName, metav1.GetOptions{})
+	if err != nil {
+		report.Errors = append(report.Errors, fmt.Sprintf("unable to get the node %s: %v", nodeName, err))
+	} else {
+		for _, condition := range node.Status.Conditions {
+			if condition.Type == k8sv1.NodeReady {
+				nodeReport.Ready = condition.Status
+			}
+		}
+		nodeReport.Schedulable = node.Labels[v1.NodeSchedulable]
+		nodeReport.Heartbeat = node.Annotations[v1.VirtHandlerHeartbeat]
+	}
+
+	handlers, err := client.CoreV1().Pods(metav1.NamespaceAll).List(ctx, metav1.ListOptions{
+		LabelSelector: virtHandlerSelector,
+		FieldSelector: fields.OneTermEqualSelector("spec.nodeName", nodeName).String(),
+	})
+	if err != nil {
+		report.Errors = append(report.Errors, fmt.Sprintf("unable to list the virt-handler pods: %v", err))
+	} else if len(handlers.Items) > 0 {
+		handler := handlers.Items[0]
+		nodeReport.VirtHandler = &VirtHandlerReport{
+			Name:  handler.Name,
+			Phase: handler.Status.Phase,
+		}
+		for _, status := range handler.Status.ContainerStatuses {
+			nodeReport.VirtHandler.RestartCount += status.RestartCount
+		}
+		for _, condition := range handler.Status.Conditions {
+			if condition.Type == k8sv1.PodReady {
+				nodeReport.VirtHandler.Ready = condition.Status == k8sv1.ConditionTrue
+			}
+		}
+	}
+
+	return nodeReport
+}
+
+func newPodReport(pod *k8sv1.Pod) PodReport {
+	podReport := PodReport{
+		Name:       pod.Name,
+		Phase:      pod.Status.Phase,
+		Reason:     pod.Status.Reason,
+		Message:    pod.Status.Message,
+		NodeName:   pod.Spec.NodeName,
+		Conditions: pod.Status.Conditions,
+	}
+	for _, status := range pod.Status.ContainerStatuses {
+		containerReport := ContainerReport{
+			Name:         status.Name,
+			Ready:        status.Ready,
+			RestartCount: status.RestartCount,
+		}
+		switch {
+		case status.State.Running != nil:
+			containerReport.State = "Running"
+		case status.State.Waiting != nil:
+			containerReport.State = "Waiting"
+			containerReport.Reason = status.State.Waiting.Reason
+			containerReport.Message = status.State.Waiting.Message
+		case status.State.Terminated != nil:
+			containerReport.State = "Terminated"
+			containerReport.Reason = status.State.Terminated.Reason
+			containerReport.Message = status.State.Terminated.Message
+		}
+		podReport.Containers = append(podReport.Containers, containerReport)
+	}
+	return podReport
+}
+
+// recentEvents returns the most recent events of the given objects, oldest first
+func recentEvents(events []k8sv1.Event, objects map[types.UID]string, limit int) []EventReport {
+	var reports []EventReport
+	for _, event := range events {
+		object, exists := objects[event.InvolvedObject.UID]
+		if !exists {
+			continue
+		}
+		eventTime := event.LastTimestamp
+		if eventTime.IsZero() {
+			eventTime = metav1.NewTime(event.EventTime.Time)
+		}
+		reports = append(reports, EventReport{
+			Time:    eventTime,
+			Type:    event.Type,
+			Reason:  event.Reason,
+			Object:  object,
+			Message: event.Message,
+			Count:   event.Count,
+		})
+	}
+	sort.SliceStable(reports, func(i, j int) bool {
+		return reports[i].Time.Before(&reports[j].Time)
+	})
+	if limit >= 0 && len(reports) > limit {
+		reports = reports[len(reports)-limit:]
+	}
+	return reports
+}
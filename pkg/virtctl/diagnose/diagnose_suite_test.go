@@ -0,0 +1,11 @@
+package diagnose_test
+
+import (
+	"testing"
+
+	"kubevirt.io/client-go/testutils"
+)
+
+func TestDiagnose(t *testing.T) {
+	testutils.KubeVirtTestSuiteSetup(t)
+}
@@ -0,0 +1,223 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package diagnose_test
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	k8sv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+	kubevirtfake "kubevirt.io/client-go/kubevirt/fake"
+
+	"kubevirt.io/kubevirt/pkg/libvmi"
+	"kubevirt.io/kubevirt/pkg/virtctl/diagnose"
+	"kubevirt.io/kubevirt/pkg/virtctl/testing"
+)
+
+var _ = Describe("Diagnose", func() {
+	const (
+		nodeName     = "node01"
+		vmiUID       = "vmi-uid"
+		podUID       = "pod-uid"
+		launcherName = "virt-launcher-testvmi-abcde"
+	)
+
+	var (
+		virtClient *kubevirtfake.Clientset
+		kubeClient *fake.Clientset
+		now        time.Time
+	)
+
+	newEvent := func(name, uid, reason string, age time.Duration) *k8sv1.Event {
+		return &k8sv1.Event{
+			ObjectMeta:     metav1.ObjectMeta{Name: name, Namespace: metav1.NamespaceDefault},
+			InvolvedObject: k8sv1.ObjectReference{UID: types.UID(uid)},
+			Type:           k8sv1.EventTypeWarning,
+			Reason:         reason,
+			Message:        reason + " happened",
+			LastTimestamp:  metav1.NewTime(now.Add(-age)),
+		}
+	}
+
+	BeforeEach(func() {
+		now = time.Now().Truncate(time.Second)
+
+		vmi := libvmi.New(libvmi.WithName("testvmi"), libvmi.WithNamespace(metav1.NamespaceDefault))
+		vmi.UID = vmiUID
+		vmi.Status.Phase = v1.Scheduling
+		vmi.Status.Conditions = []v1.VirtualMachineInstanceCondition{{
+			Type:   v1.VirtualMachineInstanceReady,
+			Status: k8sv1.ConditionFalse,
+			Reason: v1.PodNotExistsReason,
+		}}
+		virtClient = kubevirtfake.NewSimpleClientset(vmi, &v1.VirtualMachineInstanceMigration{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "testmigration",
+				Namespace: metav1.NamespaceDefault,
+				Labels:    map[string]string{v1.MigrationSelectorLabel: "testvmi"},
+			},
+			Status: v1.VirtualMachineInstanceMigrationStatus{Phase: v1.MigrationFailed},
+		})
+
+		kubeClient = fake.NewSimpleClientset(
+			&k8sv1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      launcherName,
+					Namespace: metav1.NamespaceDefault,
+					UID:       podUID,
+					Labels:    map[string]string{v1.CreatedByLabel: vmiUID},
+				},
+				Spec: k8sv1.PodSpec{NodeName: nodeName},
+				Status: k8sv1.PodStatus{
+					Phase: k8sv1.PodPending,
+					Conditions: []k8sv1.PodCondition{{
+						Type:   k8sv1.PodInitialized,
+						Status: k8sv1.ConditionTrue,
+					}},
+					ContainerStatuses: []k8sv1.ContainerStatus{{
+						Name: "compute",
+						State: k8sv1.ContainerState{Waiting: &k8sv1.ContainerStateWaiting{
+							Reason:  "ImagePullBackOff",
+							Message: "Back-off pulling image",
+						}},
+					}},
+				},
+			},
+			&k8sv1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "virt-handler-xyz",
+					Namespace: "kubevirt",
+					Labels:    map[string]string{v1.AppLabel: "virt-handler"},
+				},
+				Spec: k8sv1.PodSpec{NodeName: nodeName},
+				Status: k8sv1.PodStatus{
+					Phase: k8sv1.PodRunning,
+					Conditions: []k8sv1.PodCondition{{
+						Type:   k8sv1.PodReady,
+						Status: k8sv1.ConditionTrue,
+					}},
+					ContainerStatuses: []k8sv1.ContainerStatus{{Name: "virt-handler", RestartCount: 3}},
+				},
+			},
+			&k8sv1.Node{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        nodeName,
+					Labels:      map[string]string{v1.NodeSchedulable: "false"},
+					Annotations: map[string]string{v1.VirtHandlerHeartbeat: "2024-01-01T00:00:00Z"},
+				},
+				Status: k8sv1.NodeStatus{
+					Conditions: []k8sv1.NodeCondition{{Type: k8sv1.NodeReady, Status: k8sv1.ConditionTrue}},
+				},
+			},
+			newEvent("vmi-event", vmiUID, "SuccessfulCreate", 2*time.Minute),
+			newEvent("pod-event", podUID, "Failed", time.Minute),
+			newEvent("other-event", "other-uid", "Unrelated", 0),
+		)
+
+		ctrl := gomock.NewController(GinkgoT())
+		kubecli.GetKubevirtClientFromClientConfig = kubecli.GetMockKubevirtClientFromClientConfig
+		kubecli.MockKubevirtClientInstance = kubecli.NewMockKubevirtClient(ctrl)
+		kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachineInstance(metav1.NamespaceDefault).
+			Return(virtClient.KubevirtV1().VirtualMachineInstances(metav1.NamespaceDefault)).AnyTimes()
+		kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachineInstanceMigration(metav1.NamespaceDefault).
+			Return(virtClient.KubevirtV1().VirtualMachineInstanceMigrations(metav1.NamespaceDefault)).AnyTimes()
+		kubecli.MockKubevirtClientInstance.EXPECT().CoreV1().Return(kubeClient.CoreV1()).AnyTimes()
+	})
+
+	DescribeTable("should fail", func(errMsg string, args ...string) {
+		err := testing.NewRepeatableVirtctlCommand(append([]string{diagnose.COMMAND_DIAGNOSE}, args...)...)()
+		Expect(err).To(MatchError(ContainSubstring(errMsg)))
+	},
+		Entry("with unsupported resource type", "unsupported resource type: vm", "vm", "testvmi"),
+		Entry("with unsupported output format", "unsupported output format: yaml", "vmi", "testvmi", "--output", "yaml"),
+		Entry("with missing VMI", "virtualmachineinstances.kubevirt.io \"unknown\" not found", "vmi", "unknown"),
+	)
+
+	It("should print a human readable report", func() {
+		out, err := testing.NewRepeatableVirtctlCommandWithOut(diagnose.COMMAND_DIAGNOSE, "vmi", "testvmi")()
+		Expect(err).ToNot(HaveOccurred())
+
+		report := string(out)
+		Expect(report).To(ContainSubstring("VirtualMachineInstance default/testvmi"))
+		Expect(report).To(ContainSubstring("Ready=False (PodNotExists)"))
+		Expect(report).To(ContainSubstring("ImagePullBackOff: Back-off pulling image"))
+		Expect(report).To(ContainSubstring("Node node01"))
+		Expect(report).To(ContainSubstring("virt-handler-xyz Running, ready: true, restarts: 3"))
+		Expect(report).To(ContainSubstring("testmigration"))
+		Expect(report).To(MatchRegexp(`(?s)SuccessfulCreate.*Failed`))
+		Expect(report).ToNot(ContainSubstring("Unrelated"))
+		Expect(report).ToNot(ContainSubstring("Errors:"))
+	})
+
+	It("should print the report as json", func() {
+		out, err := testing.NewRepeatableVirtctlCommandWithOut(diagnose.COMMAND_DIAGNOSE, "vmi", "testvmi", "--output", "json", "--events", "1")()
+		Expect(err).ToNot(HaveOccurred())
+
+		report := &diagnose.Report{}
+		Expect(json.Unmarshal(out, report)).To(Succeed())
+		Expect(report.VMI.Phase).To(Equal(v1.Scheduling))
+		Expect(report.Pods).To(HaveLen(1))
+		Expect(report.Pods[0].Containers).To(ConsistOf(diagnose.ContainerReport{
+			Name:    "compute",
+			State:   "Waiting",
+			Reason:  "ImagePullBackOff",
+			Message: "Back-off pulling image",
+		}))
+		Expect(report.Node).To(Equal(&diagnose.NodeReport{
+			Name:        nodeName,
+			Ready:       k8sv1.ConditionTrue,
+			Schedulable: "false",
+			Heartbeat:   "2024-01-01T00:00:00Z",
+			VirtHandler: &diagnose.VirtHandlerReport{
+				Name:         "virt-handler-xyz",
+				Phase:        k8sv1.PodRunning,
+				Ready:        true,
+				RestartCount: 3,
+			},
+		}))
+		Expect(report.Migrations).To(ConsistOf(HaveField("Name", "testmigration")))
+		Expect(report.Events).To(ConsistOf(HaveField("Object", "Pod/"+launcherName)))
+		Expect(report.Errors).To(BeEmpty())
+	})
+
+	It("should report the parts which could not be gathered", func() {
+		kubeClient.Fake.PrependReactor("get", "nodes", func(_ k8stesting.Action) (bool, runtime.Object, error) {
+			return true, nil, context.DeadlineExceeded
+		})
+
+		out, err := testing.NewRepeatableVirtctlCommandWithOut(diagnose.COMMAND_DIAGNOSE, "vmi", "testvmi")()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(out)).To(ContainSubstring("unable to get the node node01: context deadline exceeded"))
+		Expect(string(out)).To(ContainSubstring("virt-handler-xyz"))
+	})
+})
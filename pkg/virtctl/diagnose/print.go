@@ -0,0 +1,132 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package diagnose
+
+import (
+	"fmt"
+	"io"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+const none = "<none>"
+
+func printReport(cmd *cobra.Command, report *Report) {
+	w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 0, 2, ' ', 0)
+	defer w.Flush()
+
+	vmi := report.VMI
+	fmt.Fprintf(w, "VirtualMachineInstance %s/%s\n", vmi.Namespace, vmi.Name)
+	fmt.Fprintf(w, "  Phase:\t%s\n", vmi.Phase)
+	if vmi.Reason != "" {
+		fmt.Fprintf(w, "  Reason:\t%s\n", vmi.Reason)
+	}
+	fmt.Fprintf(w, "  Node:\t%s\n", orNone(vmi.NodeName))
+	fmt.Fprintln(w, "  Conditions:")
+	if len(vmi.Conditions) == 0 {
+		fmt.Fprintf(w, "    %s\n", none)
+	}
+	for _, condition := range vmi.Conditions {
+		printCondition(w, string(condition.Type), string(condition.Status), condition.Reason, condition.Message)
+	}
+	if state := vmi.MigrationState; state != nil {
+		fmt.Fprintln(w, "  Migration:")
+		fmt.Fprintf(w, "    Migration:\t%s\n", state.MigrationUID)
+		fmt.Fprintf(w, "    Source node:\t%s\n", state.SourceNode)
+		fmt.Fprintf(w, "    Target node:\t%s\n", orNone(state.TargetNode))
+		fmt.Fprintf(w, "    Completed:\t%t\n", state.Completed)
+		fmt.Fprintf(w, "    Failed:\t%t\n", state.Failed)
+	}
+
+	fmt.Fprintln(w, "\nvirt-launcher pods:")
+	if len(report.Pods) == 0 {
+		fmt.Fprintf(w, "  %s\n", none)
+	}
+	for _, pod := range report.Pods {
+		fmt.Fprintf(w, "  %s\t%s\tnode: %s\n", pod.Name, pod.Phase, orNone(pod.NodeName))
+		if pod.Reason != "" || pod.Message != "" {
+			fmt.Fprintf(w, "    %s: %s\n", pod.Reason, pod.Message)
+		}
+		for _, condition := range pod.Conditions {
+			printCondition(w, string(condition.Type), string(condition.Status), condition.Reason, condition.Message)
+		}
+		for _, container := range pod.Containers {
+			fmt.Fprintf(w, "    container %s\t%s\tready: %t, restarts: %d\n", container.Name, container.State, container.Ready, container.RestartCount)
+			if container.Reason != "" || container.Message != "" {
+				fmt.Fprintf(w, "      %s: %s\n", container.Reason, container.Message)
+			}
+		}
+	}
+
+	if node := report.Node; node != nil {
+		fmt.Fprintf(w, "\nNode %s\n", node.Name)
+		fmt.Fprintf(w, "  Ready:\t%s\n", orNone(string(node.Ready)))
+		fmt.Fprintf(w, "  Schedulable:\t%s\n", orNone(node.Schedulable))
+		fmt.Fprintf(w, "  virt-handler heartbeat:\t%s\n", orNone(node.Heartbeat))
+		if handler := node.VirtHandler; handler != nil {
+			fmt.Fprintf(w, "  virt-handler:\t%s %s, ready: %t, restarts: %d\n", handler.Name, handler.Phase, handler.Ready, handler.RestartCount)
+		} else {
+			fmt.Fprintf(w, "  virt-handler:\t%s\n", none)
+		}
+	}
+
+	fmt.Fprintln(w, "\nMigrations:")
+	if len(report.Migrations) == 0 {
+		fmt.Fprintf(w, "  %s\n", none)
+	}
+	for _, migration := range report.Migrations {
+		fmt.Fprintf(w, "  %s\t%s\t%s\n", migration.Name, orNone(string(migration.Phase)), migration.Created.Format(time.RFC3339))
+	}
+
+	fmt.Fprintln(w, "\nEvents:")
+	if len(report.Events) == 0 {
+		fmt.Fprintf(w, "  %s\n", none)
+	}
+	for _, event := range report.Events {
+		fmt.Fprintf(w, "  %s\t%s\t%s\t%s\t%s\n", event.Time.Format(time.RFC3339), event.Type, event.Reason, event.Object, event.Message)
+	}
+
+	if len(report.Errors) > 0 {
+		fmt.Fprintln(w, "\nErrors:")
+		for _, err := range report.Errors {
+			fmt.Fprintf(w, "  %s\n", err)
+		}
+	}
+}
+
+func printCondition(w io.Writer, conditionType, status, reason, message string) {
+	fmt.Fprintf(w, "    %s=%s", conditionType, status)
+	if reason != "" {
+		fmt.Fprintf(w, " (%s)", reason)
+	}
+	if message != "" {
+		fmt.Fprintf(w, ": %s", message)
+	}
+	fmt.Fprintln(w)
+}
+
+func orNone(value string) string {
+	if value == "" {
+		return none
+	}
+	return value
+}
@@ -6,8 +6,10 @@ import (
 	"fmt"
 	"strings"
 
+	routev1 "github.com/openshift/api/route/v1"
 	"github.com/spf13/cobra"
 	k8sv1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
 
@@ -20,6 +22,12 @@ import (
 
 const (
 	COMMAND_EXPOSE = "expose"
+
+	ingressTypeIngress = "Ingress"
+	ingressTypeRoute   = "Route"
+
+	// sslPassthroughAnnotation makes ingress-nginx pass the TLS connection through to the VM
+	sslPassthroughAnnotation = "nginx.ingress.kubernetes.io/ssl-passthrough"
 )
 
 type command struct {
@@ -34,12 +42,16 @@ type command struct {
 	portName          string
 	strIPFamily       string
 	strIPFamilyPolicy string
+	httpHost          string
+	strIngressType    string
+	ingressClass      string
 
 	targetPort     intstr.IntOrString
 	protocol       k8sv1.Protocol
 	serviceType    k8sv1.ServiceType
 	ipFamilies     []k8sv1.IPFamily
 	ipFamilyPolicy k8sv1.IPFamilyPolicy
+	ingressType    string
 
 	namespace string
 	client    kubecli.KubevirtClient
@@ -77,6 +89,10 @@ virtualmachineinstance (vmi), virtualmachine (vm), virtualmachineinstancereplica
 	cmd.Flags().StringVar(&c.strIPFamily, "ip-family", "", "IP family over which the service will be exposed. Valid values are 'IPv4', 'IPv6', 'IPv4,IPv6' or 'IPv6,IPv4'")
 	cmd.Flags().StringVar(&c.strIPFamilyPolicy, "ip-family-policy", "", "IP family policy defines whether the service can use IPv4, IPv6, or both. Valid values are 'SingleStack', 'PreferDualStack' or 'RequireDualStack'")
 
+	cmd.Flags().StringVar(&c.httpHost, "http-host", "", "Host name on which the service is exposed outside of the cluster through an Ingress or Route with TLS passthrough to the VM. Optional.")
+	cmd.Flags().StringVar(&c.strIngressType, "ingress-type", ingressTypeIngress, "Type of the object exposing the service on --http-host: Ingress or Route.")
+	cmd.Flags().StringVar(&c.ingressClass, "ingress-class", "", "Name of the IngressClass of the Ingress created for --http-host. Optional.")
+
 	cmd.SetUsageTemplate(templates.UsageTemplate())
 
 	return cmd
@@ -90,7 +106,10 @@ func usage() string {
   {{ProgramName}} expose vmirs myvmirs --name=vmirs-service
 
   # Expose port 8080 as port 80 from a virtual machine instance replicaset on a service:
-  {{ProgramName}} expose vmirs myvmirs --port=80 --target-port=8080 --name=vmirs-service`
+  {{ProgramName}} expose vmirs myvmirs --port=80 --target-port=8080 --name=vmirs-service
+
+  # Expose HTTPS of a virtual machine called 'myvm' on myvm.example.com through an OpenShift Route:
+  {{ProgramName}} expose vm myvm --port=443 --name=myvm-https --http-host=myvm.example.com --ingress-type=Route`
 }
 
 func (c *command) run(cmd *cobra.Command, args []string) error {
@@ -113,11 +132,31 @@ func (c *command) run(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
+	if c.httpHost != "" && len(ports) != 1 {
+		return errors.New("--http-host requires a single port, use --port to select it")
+	}
+
 	if err := c.createService(serviceSelector, ports); err != nil {
 		return err
 	}
 
 	cmd.Printf("Service %s successfully created for %s %s\n", c.serviceName, vmType, vmName)
+
+	if c.httpHost == "" {
+		return nil
+	}
+
+	var createErr error
+	if c.ingressType == ingressTypeRoute {
+		createErr = c.createRoute(ports[0])
+	} else {
+		createErr = c.createIngress(ports[0])
+	}
+	if createErr != nil {
+		return createErr
+	}
+
+	cmd.Printf("%s %s successfully created for host %s\n", c.ingressType, c.serviceName, c.httpHost)
 	return nil
 }
 
@@ -137,6 +176,19 @@ func (c *command) parseFlags() error {
 	if c.ipFamilyPolicy, err = convertIPFamilyPolicy(c.strIPFamilyPolicy, c.ipFamilies); err != nil {
 		return err
 	}
+	if c.ingressType, err = convertIngressType(c.strIngressType); err != nil {
+		return err
+	}
+
+	if c.httpHost == "" {
+		return nil
+	}
+	if c.protocol != k8sv1.ProtocolTCP {
+		return fmt.Errorf("--http-host requires protocol %s", k8sv1.ProtocolTCP)
+	}
+	if c.ingressClass != "" && c.ingressType != ingressTypeIngress {
+		return fmt.Errorf("--ingress-class is only supported with --ingress-type %s", ingressTypeIngress)
+	}
 
 	return nil
 }
@@ -223,6 +275,89 @@ func (c *command) createService(serviceSelector map[string]string, ports []k8sv1
 	return nil
 }
 
+// createIngress creates an Ingress forwarding TLS connections for the host to the service port.
+// Passthrough is not part of the Ingress API, it is requested with the annotation of ingress-nginx.
+func (c *command) createIngress(port k8sv1.ServicePort) error {
+	pathType := networkingv1.PathTypePrefix
+	ingress := &networkingv1.Ingress{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      c.serviceName,
+			Namespace: c.namespace,
+			Annotations: map[string]string{
+				sslPassthroughAnnotation: "true",
+			},
+		},
+		Spec: networkingv1.IngressSpec{
+			Rules: []networkingv1.IngressRule{{
+				Host: c.httpHost,
+				IngressRuleValue: networkingv1.IngressRuleValue{
+					HTTP: &networkingv1.HTTPIngressRuleValue{
+						Paths: []networkingv1.HTTPIngressPath{{
+							Path:     "/",
+							PathType: &pathType,
+							Backend: networkingv1.IngressBackend{
+								Service: &networkingv1.IngressServiceBackend{
+									Name: c.serviceName,
+									Port: networkingv1.ServiceBackendPort{Number: port.Port},
+								},
+							},
+						}},
+					},
+				},
+			}},
+		},
+	}
+	if c.ingressClass != "" {
+		ingress.Spec.IngressClassName = &c.ingressClass
+	}
+	if _, err := c.client.NetworkingV1().Ingresses(c.namespace).Create(context.Background(), ingress, metav1.CreateOptions{}); err != nil {
+		return fmt.Errorf("ingress creation failed: %v", err)
+	}
+
+	return nil
+}
+
+// createRoute creates a passthrough Route for the host. The route only needs to name the port if the
+// service has one, otherwise the router uses the single port of the service.
+func (c *command) createRoute(port k8sv1.ServicePort) error {
+	route := &routev1.Route{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      c.serviceName,
+			Namespace: c.namespace,
+		},
+		Spec: routev1.RouteSpec{
+			Host: c.httpHost,
+			To: routev1.RouteTargetReference{
+				Kind: "Service",
+				Name: c.serviceName,
+			},
+			TLS: &routev1.TLSConfig{
+				Termination:                   routev1.TLSTerminationPassthrough,
+				InsecureEdgeTerminationPolicy: routev1.InsecureEdgeTerminationPolicyRedirect,
+			},
+		},
+	}
+	if port.Name != "" {
+		route.Spec.Port = &routev1.RoutePort{TargetPort: intstr.FromString(port.Name)}
+	}
+	if _, err := c.client.RouteClient().Routes(c.namespace).Create(context.Background(), route, metav1.CreateOptions{}); err != nil {
+		return fmt.Errorf("route creation failed: %v", err)
+	}
+
+	return nil
+}
+
+func convertIngressType(strIngressType string) (string, error) {
+	switch strings.ToLower(strIngressType) {
+	case strings.ToLower(ingressTypeIngress):
+		return ingressTypeIngress, nil
+	case strings.ToLower(ingressTypeRoute):
+		return ingressTypeRoute, nil
+	default:
+		return "", fmt.Errorf("unknown ingress type: %s", strIngressType)
+	}
+}
+
 func convertProtocol(strProtocol string) (k8sv1.Protocol, error) {
 	switch strings.ToLower(strProtocol) {
 	case strings.ToLower(string(k8sv1.ProtocolTCP)):
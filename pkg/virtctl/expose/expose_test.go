@@ -6,8 +6,11 @@ import (
 	"github.com/golang/mock/gomock"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	routev1 "github.com/openshift/api/route/v1"
+	routev1fake "github.com/openshift/client-go/route/clientset/versioned/typed/route/v1/fake"
 
 	k8sv1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/client-go/kubernetes/fake"
@@ -29,13 +32,15 @@ const (
 
 var _ = Describe("Expose", func() {
 	var (
-		kubeClient *fake.Clientset
-		virtClient *kubevirtfake.Clientset
+		kubeClient  *fake.Clientset
+		virtClient  *kubevirtfake.Clientset
+		routeClient *routev1fake.FakeRouteV1
 	)
 
 	BeforeEach(func() {
 		kubeClient = fake.NewSimpleClientset()
 		virtClient = kubevirtfake.NewSimpleClientset()
+		routeClient = &routev1fake.FakeRouteV1{Fake: &fake.NewSimpleClientset().Fake}
 
 		ctrl := gomock.NewController(GinkgoT())
 		kubecli.GetKubevirtClientFromClientConfig = kubecli.GetMockKubevirtClientFromClientConfig
@@ -48,6 +53,8 @@ var _ = Describe("Expose", func() {
 		kubecli.MockKubevirtClientInstance.EXPECT().ReplicaSet(metav1.NamespaceDefault).
 			Return(virtClient.KubevirtV1().VirtualMachineInstanceReplicaSets(metav1.NamespaceDefault)).AnyTimes()
 		kubecli.MockKubevirtClientInstance.EXPECT().CoreV1().Return(kubeClient.CoreV1()).AnyTimes()
+		kubecli.MockKubevirtClientInstance.EXPECT().NetworkingV1().Return(kubeClient.NetworkingV1()).AnyTimes()
+		kubecli.MockKubevirtClientInstance.EXPECT().RouteClient().Return(routeClient).AnyTimes()
 	})
 
 	Context("should fail", func() {
@@ -84,6 +91,15 @@ var _ = Describe("Expose", func() {
 			Entry("service type externalname", "--type=externalname", "type: externalname not supported"),
 			Entry("invalid ip family", "--ip-family=madeup", "unknown IPFamily/s: madeup"),
 			Entry("invalid ip family policy", "--ip-family-policy=madeup", "unknown IPFamilyPolicy/s: madeup"),
+			Entry("invalid ingress type", "--ingress-type=madeup", "unknown ingress type: madeup"),
+		)
+
+		DescribeTable("invalid flag combination with --http-host", func(errMsg string, args ...string) {
+			err := runCommand(append([]string{"vmi", "my-vm", "--name", "my-service", "--http-host", "my-vm.example.com"}, args...)...)
+			Expect(err).To(MatchError(errMsg))
+		},
+			Entry("protocol UDP", "--http-host requires protocol TCP", "--protocol=UDP"),
+			Entry("ingress class with route", "--ingress-class is only supported with --ingress-type Ingress", "--ingress-type=route", "--ingress-class=nginx"),
 		)
 
 		It("when client has an error", func() {
@@ -420,6 +436,67 @@ var _ = Describe("Expose", func() {
 			Entry("with VirtualMachineInstanceReplicaSet and IPFamilyPolicy PreferDualStack", "vmirs", k8sv1.IPFamilyPolicyPreferDualStack),
 			Entry("with VirtualMachineInstanceReplicaSet and IPFamilyPolicy RequireDualStack", "vmirs", k8sv1.IPFamilyPolicyRequireDualStack),
 		)
+
+		Context("with --http-host", func() {
+			const httpHost = "my-vm.example.com"
+
+			It("should fail with multiple ports", func() {
+				vmi.Spec.Networks = []v1.Network{*v1.DefaultPodNetwork()}
+				vmi.Spec.Domain.Devices.Interfaces = []v1.Interface{{
+					Name:  "default",
+					Ports: []v1.Port{{Protocol: "TCP", Port: 80}, {Protocol: "TCP", Port: 443}},
+				}}
+				_, err := virtClient.KubevirtV1().VirtualMachineInstances(metav1.NamespaceDefault).Update(context.Background(), vmi, metav1.UpdateOptions{})
+				Expect(err).ToNot(HaveOccurred())
+
+				err = runCommand("vmi", vmi.Name, "--name", serviceName, "--http-host", httpHost)
+				Expect(err).To(MatchError("--http-host requires a single port, use --port to select it"))
+			})
+
+			DescribeTable("should create an Ingress with TLS passthrough", func(resType string) {
+				resName := getResName(resType)
+				err := runCommand(resType, resName, "--name", serviceName, "--port", servicePortStr, "--http-host", httpHost, "--ingress-class", "nginx")
+				Expect(err).ToNot(HaveOccurred())
+
+				_, err = kubeClient.CoreV1().Services(metav1.NamespaceDefault).Get(context.Background(), serviceName, metav1.GetOptions{})
+				Expect(err).ToNot(HaveOccurred())
+				ingress, err := kubeClient.NetworkingV1().Ingresses(metav1.NamespaceDefault).Get(context.Background(), serviceName, metav1.GetOptions{})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(ingress.Annotations).To(HaveKeyWithValue("nginx.ingress.kubernetes.io/ssl-passthrough", "true"))
+				Expect(ingress.Spec.IngressClassName).To(HaveValue(Equal("nginx")))
+				Expect(ingress.Spec.Rules).To(HaveLen(1))
+				Expect(ingress.Spec.Rules[0].Host).To(Equal(httpHost))
+				Expect(ingress.Spec.Rules[0].HTTP.Paths).To(HaveLen(1))
+				Expect(ingress.Spec.Rules[0].HTTP.Paths[0].Backend.Service).To(Equal(&networkingv1.IngressServiceBackend{
+					Name: serviceName,
+					Port: networkingv1.ServiceBackendPort{Number: servicePort},
+				}))
+			},
+				Entry("with VirtualMachineInstance", "vmi"),
+				Entry("with VirtualMachine", "vm"),
+				Entry("with VirtualMachineInstanceReplicaSet", "vmirs"),
+			)
+
+			DescribeTable("should create a passthrough Route", func(portName string, expectedPort *routev1.RoutePort) {
+				err := runCommand("vm", vm.Name, "--name", serviceName, "--port", servicePortStr, "--port-name", portName, "--http-host", httpHost, "--ingress-type", "route")
+				Expect(err).ToNot(HaveOccurred())
+
+				_, err = kubeClient.NetworkingV1().Ingresses(metav1.NamespaceDefault).Get(context.Background(), serviceName, metav1.GetOptions{})
+				Expect(err).To(HaveOccurred())
+				route, err := routeClient.Routes(metav1.NamespaceDefault).Get(context.Background(), serviceName, metav1.GetOptions{})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(route.Spec.Host).To(Equal(httpHost))
+				Expect(route.Spec.To).To(Equal(routev1.RouteTargetReference{Kind: "Service", Name: serviceName}))
+				Expect(route.Spec.Port).To(Equal(expectedPort))
+				Expect(route.Spec.TLS).To(Equal(&routev1.TLSConfig{
+					Termination:                   routev1.TLSTerminationPassthrough,
+					InsecureEdgeTerminationPolicy: routev1.InsecureEdgeTerminationPolicyRedirect,
+				}))
+			},
+				Entry("with unnamed port", "", nil),
+				Entry("with named port", "https", &routev1.RoutePort{TargetPort: intstr.FromString("https")}),
+			)
+		})
 	})
 })
 
@@ -57,6 +57,8 @@ type guestfsCommand struct {
 	gid        string
 	pullPolicy string
 	vm         string
+	// readOnly mounts the pvc read-only and runs the command without a terminal
+	readOnly bool
 }
 
 // Following variables allow overriding the default functions (useful for unit testing)
@@ -85,58 +87,70 @@ func NewGuestfsShellCommand() *cobra.Command {
 	cmd.SetUsageTemplate(templates.UsageTemplate())
 	cmd.PersistentFlags().StringVar(&c.fsGroup, "fsGroup", "", "Set the fsgroup for the libguestfs-tool container")
 	cmd.PersistentFlags().StringVar(&c.vm, "vm", "", "Provide a VM to apply its scheduling constraints to the libguestfs-tool pod")
+	cmd.AddCommand(newInspectCommand(&c))
 
 	return cmd
 }
 
 func usage() string {
 	usage := `  # Create a pod with libguestfs-tools, mount the pvc and attach a shell to it:
-  {{ProgramName}} guestfs <pvc-name>`
+  {{ProgramName}} guestfs <pvc-name>
+
+  # Print the partition layout, OS release and fstab of the disk on the pvc without opening a shell:
+  {{ProgramName}} guestfs inspect <pvc-name>`
 	return usage
 }
 
 func (c *guestfsCommand) run(cmd *cobra.Command, args []string) error {
-	c.pvc = args[0]
+	client, namespace, isBlock, err := c.prepare(cmd, args[0])
+	if err != nil {
+		return err
+	}
+	defer client.removePod(namespace, genPodName(c.pvc))
+	return c.createInteractivePodWithPVC(client, namespace, "/entrypoint.sh", []string{}, isBlock)
+}
+
+// prepare validates the flags, sets the image and checks that the pvc exists and is not in use
+func (c *guestfsCommand) prepare(cmd *cobra.Command, pvc string) (*K8sClient, string, bool, error) {
+	c.pvc = pvc
 
 	virtClient, namespace, _, err := clientconfig.ClientAndNamespaceFromContext(cmd.Context())
 	if err != nil {
-		return err
+		return nil, "", false, err
 	}
 
 	if c.pullPolicy != string(corev1.PullAlways) &&
 		c.pullPolicy != string(corev1.PullNever) &&
 		c.pullPolicy != string(corev1.PullIfNotPresent) {
-		return fmt.Errorf("Invalid pull policy: %s", c.pullPolicy)
+		return nil, "", false, fmt.Errorf("Invalid pull policy: %s", c.pullPolicy)
 	}
-	var inUse bool
 	client, err := CreateClientFunc(virtClient)
 	if err != nil {
-		return err
+		return nil, "", false, err
 	}
 	if c.image == "" {
 		c.image, err = ImageSetFunc(client.VirtClient)
 		if err != nil {
-			return err
+			return nil, "", false, err
 		}
 	}
-	fmt.Printf("Use image: %s \n", c.image)
+	fmt.Fprintf(cmd.ErrOrStderr(), "Use image: %s \n", c.image)
 	exist, _ := client.existsPVC(c.pvc, namespace)
 	if !exist {
-		return fmt.Errorf("The PVC %s doesn't exist", c.pvc)
+		return nil, "", false, fmt.Errorf("The PVC %s doesn't exist", c.pvc)
 	}
-	inUse, err = client.isPVCinUse(c.pvc, namespace)
+	inUse, err := client.isPVCinUse(c.pvc, namespace)
 	if err != nil {
-		return err
+		return nil, "", false, err
 	}
 	if inUse {
-		return fmt.Errorf("PVC %s is used by another pod", c.pvc)
+		return nil, "", false, fmt.Errorf("PVC %s is used by another pod", c.pvc)
 	}
 	isBlock, err := client.isPVCVolumeBlock(c.pvc, namespace)
 	if err != nil {
-		return err
+		return nil, "", false, err
 	}
-	defer client.removePod(namespace, genPodName(c.pvc))
-	return c.createInteractivePodWithPVC(client, namespace, "/entrypoint.sh", []string{}, isBlock)
+	return client, namespace, isBlock, nil
 }
 
 // K8sClient holds the information of the Kubernetes client
@@ -431,7 +445,7 @@ func (c *guestfsCommand) createLibguestfsPod(client *K8sClient, ns, cmd string,
 					VolumeSource: corev1.VolumeSource{
 						PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
 							ClaimName: c.pvc,
-							ReadOnly:  false,
+							ReadOnly:  c.readOnly,
 						},
 					},
 				},
@@ -491,8 +505,8 @@ func (c *guestfsCommand) createLibguestfsPod(client *K8sClient, ns, cmd string,
 						},
 					},
 					ImagePullPolicy: corev1.PullPolicy(c.pullPolicy),
-					Stdin:           true,
-					TTY:             true,
+					Stdin:           !c.readOnly,
+					TTY:             !c.readOnly,
 					Resources:       resources,
 				},
 			},
@@ -507,17 +521,17 @@ func (c *guestfsCommand) createLibguestfsPod(client *K8sClient, ns, cmd string,
 			Name:       volume,
 			DevicePath: diskPath,
 		})
-		fmt.Printf("The PVC has been mounted at %s \n", diskPath)
+		fmt.Fprintf(os.Stderr, "The PVC has been mounted at %s \n", diskPath)
 	} else {
 		// PVC volume mode is filesystem
 		pod.Spec.Containers[0].VolumeMounts = append(pod.Spec.Containers[0].VolumeMounts, corev1.VolumeMount{
 			Name:      volume,
-			ReadOnly:  false,
+			ReadOnly:  c.readOnly,
 			MountPath: diskDir,
 		})
 
 		pod.Spec.Containers[0].WorkingDir = diskDir
-		fmt.Printf("The PVC has been mounted at %s \n", diskDir)
+		fmt.Fprintf(os.Stderr, "The PVC has been mounted at %s \n", diskDir)
 	}
 
 	p, err := client.Client.CoreV1().Pods(ns).Create(context.TODO(), pod, metav1.CreateOptions{})
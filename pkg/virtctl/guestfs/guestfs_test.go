@@ -187,6 +187,65 @@ var _ = Describe("Guestfs shell", func() {
 		})
 	})
 
+	Context("inspect PVC", func() {
+		fakeCreateClientCompletingPod := func(phase v1.PodPhase) func(kubecli.KubevirtClient) (*guestfs.K8sClient, error) {
+			return func(_ kubecli.KubevirtClient) (*guestfs.K8sClient, error) {
+				kubeClient = fake.NewSimpleClientset(pvc)
+				kubeClient.Fake.PrependReactor("create", "pods", func(action k8stesting.Action) (bool, runtime.Object, error) {
+					libguestfsPod = action.(k8stesting.CreateAction).GetObject().(*v1.Pod)
+					return false, nil, nil
+				})
+				kubeClient.Fake.PrependReactor("get", "pods", func(action k8stesting.Action) (bool, runtime.Object, error) {
+					pod := libguestfsPod.DeepCopy()
+					pod.Status.Phase = phase
+					return true, pod, nil
+				})
+				return &guestfs.K8sClient{Client: kubeClient, VirtClient: kubevirtClient}, nil
+			}
+		}
+
+		BeforeEach(func() {
+			guestfs.ImageSetFunc = fakeSetImage
+			libguestfsPod = nil
+		})
+
+		AfterEach(func() {
+			guestfs.ImageSetFunc = guestfs.SetImage
+			guestfs.CreateClientFunc = guestfs.CreateClient
+		})
+
+		It("should print the inspection results of a read-only mounted PVC", func() {
+			guestfs.CreateClientFunc = fakeCreateClientCompletingPod(v1.PodSucceeded)
+			out, err := testing.NewRepeatableVirtctlCommandWithOut(commandName, "inspect", pvcName)()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(out)).To(Equal("fake logs"))
+
+			Expect(libguestfsPod).ToNot(BeNil())
+			Expect(libguestfsPod.Spec.Volumes[0].PersistentVolumeClaim.ReadOnly).To(BeTrue())
+			container := libguestfsPod.Spec.Containers[0]
+			Expect(container.TTY).To(BeFalse())
+			Expect(container.Stdin).To(BeFalse())
+			Expect(container.Args).To(HaveExactElements(Equal("-c"), ContainSubstring("virt-filesystems"), Equal("inspect"), Equal("/disk/disk.img")))
+			Expect(container.VolumeMounts).To(ContainElement(v1.VolumeMount{Name: "volume", ReadOnly: true, MountPath: "/disk"}))
+
+			Expect(kubeClient.Actions()).To(ContainElement(WithTransform(func(action k8stesting.Action) string {
+				return action.GetVerb() + "/" + action.GetResource().Resource
+			}, Equal("delete/pods"))))
+		})
+
+		It("should fail when the inspection failed", func() {
+			guestfs.CreateClientFunc = fakeCreateClientCompletingPod(v1.PodFailed)
+			_, err := testing.NewRepeatableVirtctlCommandWithOut(commandName, "inspect", pvcName)()
+			Expect(err).To(MatchError(fmt.Sprintf("inspection of PVC %s failed: fake logs", pvcName)))
+		})
+
+		It("should refuse to inspect a PVC in use", func() {
+			guestfs.CreateClientFunc = fakeCreateClientPVCinUse
+			err := testing.NewRepeatableVirtctlCommand(commandName, "inspect", pvcName)()
+			Expect(err).To(MatchError(fmt.Sprintf("PVC %s is used by another pod", pvcName)))
+		})
+	})
+
 	Context("URL authenticity", func() {
 		fakeGetImageInfoNoCustomURL := func(virtClient kubecli.KubevirtClient) (*kubecli.GuestfsInfo, error) {
 			info := &kubecli.GuestfsInfo{
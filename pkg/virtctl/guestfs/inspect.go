@@ -0,0 +1,115 @@
+package guestfs
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"syscall"
+	"time"
+
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+
+	"kubevirt.io/kubevirt/pkg/virtctl/templates"
+)
+
+const (
+	// diskImageName is the name of the disk image on filesystem-based pvcs as created by CDI
+	diskImageName = "disk.img"
+
+	// inspectScript prints the partition layout, OS release and fstab of the disk passed as first argument.
+	// A missing file is reported rather than failing, e.g. Windows guests have neither of them.
+	inspectScript = `disk="$1"
+echo "== Partition layout =="
+virt-filesystems --add "$disk" --format=raw --all --long --human-readable || exit 1
+echo
+echo "== OS release =="
+virt-cat --add "$disk" --format=raw /etc/os-release 2>/dev/null || echo "/etc/os-release not found"
+echo
+echo "== fstab =="
+virt-cat --add "$disk" --format=raw /etc/fstab 2>/dev/null || echo "/etc/fstab not found"
+`
+)
+
+// InspectPollInterval is the interval for checking if the inspection finished, it can be overridden in unit tests
+var InspectPollInterval = 2 * time.Second
+
+func newInspectCommand(c *guestfsCommand) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "inspect (PVC)",
+		Short: "Print the partition layout, OS release and fstab of the disk on a pvc",
+		Long: `Create a pod with libguestfs-tools which mounts the pvc read-only, prints the partition layout, the OS release and the fstab of the disk and exits.
+The disk is expected as disk.img on filesystem-based pvcs. No interactive shell is opened, which makes the command suitable for automated disk checks.`,
+		Args:    cobra.ExactArgs(1),
+		Example: inspectUsage(),
+		RunE:    c.runInspect,
+	}
+	cmd.SetUsageTemplate(templates.UsageTemplate())
+	return cmd
+}
+
+func inspectUsage() string {
+	return `  # Print the partition layout, OS release and fstab of the disk on the pvc:
+  {{ProgramName}} guestfs inspect <pvc-name>`
+}
+
+func (c *guestfsCommand) runInspect(cmd *cobra.Command, args []string) error {
+	c.readOnly = true
+	client, namespace, isBlock, err := c.prepare(cmd, args[0])
+	if err != nil {
+		return err
+	}
+
+	disk := diskPath
+	if !isBlock {
+		disk = filepath.Join(diskDir, diskImageName)
+	}
+
+	ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	podName := genPodName(c.pvc)
+	if _, err := c.createLibguestfsPod(client, namespace, "/bin/sh", []string{"-c", inspectScript, "inspect", disk}, isBlock); err != nil {
+		return err
+	}
+	defer client.removePod(namespace, podName)
+
+	phase, err := client.waitForPodCompleted(ctx, podName, namespace, timeout)
+	if err != nil {
+		return err
+	}
+
+	logs, err := client.Client.CoreV1().Pods(namespace).GetLogs(podName, &corev1.PodLogOptions{Container: contName}).DoRaw(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get the inspection results: %v", err)
+	}
+	if phase != corev1.PodSucceeded {
+		return fmt.Errorf("inspection of PVC %s failed: %s", c.pvc, logs)
+	}
+	fmt.Fprint(cmd.OutOrStdout(), string(logs))
+	return nil
+}
+
+// waitForPodCompleted waits until the pod terminated and returns its final phase
+func (client *K8sClient) waitForPodCompleted(ctx context.Context, podName, ns string, timeout time.Duration) (corev1.PodPhase, error) {
+	var phase corev1.PodPhase
+	err := wait.PollUntilContextTimeout(ctx, InspectPollInterval, timeout, true, func(ctx context.Context) (bool, error) {
+		pod, err := client.Client.CoreV1().Pods(ns).Get(ctx, podName, metav1.GetOptions{})
+		if err != nil {
+			return false, err
+		}
+		phase = pod.Status.Phase
+		return phase == corev1.PodSucceeded || phase == corev1.PodFailed, nil
+	})
+	if ctx.Err() == context.Canceled {
+		return "", fmt.Errorf("interrupted while waiting for the inspection in pod %s to finish", podName)
+	}
+	if wait.Interrupted(err) {
+		return "", fmt.Errorf("timeout in waiting for the inspection in pod %s to finish", podName)
+	}
+	return phase, err
+}
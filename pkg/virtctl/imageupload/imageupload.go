@@ -22,6 +22,7 @@ package imageupload
 import (
 	"context"
 	"crypto/tls"
+	"encoding/json"
 	"fmt"
 	"io"
 	"math/rand/v2"
@@ -70,6 +71,14 @@ const (
 	UsePopulatorAnnotation          = "cdi.kubevirt.io/storage.usePopulator"
 	PVCPrimeNameAnnotation          = "cdi.kubevirt.io/storage.populator.pvcPrime"
 
+	// UploadStateAnnotation is the annotation on the upload target DataVolume/PVC recording the progress of a resumable upload
+	UploadStateAnnotation = "kubevirt.io/image-upload.state"
+
+	// ResumableUploadHeader is set by upload servers which accept the image in Content-Range chunks and
+	// continue a partial upload. Accept-Ranges is not enough, it only advertises ranged downloads.
+	ResumableUploadHeader = "X-Resumable-Upload"
+	resumableUploadRanges = "content-range"
+
 	uploadReadyWaitInterval = 2 * time.Second
 
 	processingWaitInterval = 2 * time.Second
@@ -101,6 +110,9 @@ var UploadProcessingCompleteFunc processingCompleteFunc = waitUploadProcessingCo
 // GetHTTPClientFn allows overriding the default http client (useful for unit testing)
 var GetHTTPClientFn = GetHTTPClient
 
+// UploadChunkSize is the size of the chunks a resumable upload is split into, it can be overridden in unit tests
+var UploadChunkSize int64 = 256 * 1024 * 1024
+
 // NewImageUploadCommand returns a cobra.Command for handling the uploading of VM images
 func NewImageUploadCommand() *cobra.Command {
 	c := command{}
@@ -135,6 +147,7 @@ func NewImageUploadCommand() *cobra.Command {
 	cmd.Flags().BoolVar(&c.noCreate, "no-create", false, "Don't attempt to create a new DataVolume/PVC.")
 	cmd.Flags().UintVar(&c.uploadPodWaitSecs, "wait-secs", 300, "Seconds to wait for upload pod to start.")
 	cmd.Flags().UintVar(&c.uploadRetries, "retry", 5, "When upload server returns a transient error, we retry this number of times before giving up")
+	cmd.Flags().BoolVar(&c.resume, "resume", false, "Continue an interrupted upload at the offset recorded on the DataVolume/PVC. Requires an upload server which supports resumable uploads.")
 	cmd.Flags().BoolVar(&c.forceBind, "force-bind", false, "Force bind the PVC, ignoring the WaitForFirstConsumer logic.")
 	cmd.Flags().BoolVar(&c.dataSource, "datasource", false, "Create a DataSource pointing to the created DataVolume/PVC.")
 	cmd.Flags().StringVar(&c.defaultInstancetype, "default-instancetype", "", "The default instance type to associate with the image.")
@@ -168,7 +181,10 @@ func usage() string {
   {{ProgramName}} image-upload dv fedora-dv --uploadproxy-url=https://cdi-uploadproxy.mycluster.com --image-path=/images/fedora30.qcow2
 
   # Upload a local disk archive to a newly created DataVolume:
-  {{ProgramName}} image-upload dv fedora-dv --size=10Gi --archive-path=/images/fedora30.tar`
+  {{ProgramName}} image-upload dv fedora-dv --size=10Gi --archive-path=/images/fedora30.tar
+
+  # Continue an interrupted upload to a DataVolume:
+  {{ProgramName}} image-upload dv fedora-dv --size=10Gi --image-path=/images/fedora30.qcow2 --resume`
 	return usage
 }
 
@@ -198,6 +214,14 @@ type command struct {
 	forceBind               bool
 	dataSource              bool
 	archiveUpload           bool
+	resume                  bool
+}
+
+// uploadState is recorded in the UploadStateAnnotation after every chunk of a resumable upload
+type uploadState struct {
+	ImageSize    int64     `json:"imageSize"`
+	ImageModTime time.Time `json:"imageModTime"`
+	Offset       int64     `json:"offset"`
 }
 
 func (c *command) parseArgs(args []string) error {
@@ -299,6 +323,10 @@ func (c *command) run(args []string) error {
 			return err
 		}
 
+		if c.resume {
+			return fmt.Errorf("cannot resume the upload, PVC %s/%s does not exist", c.namespace, c.name)
+		}
+
 		if !c.noCreate && len(c.size) == 0 {
 			return fmt.Errorf("when creating a resource, the size must be specified")
 		}
@@ -412,10 +440,17 @@ func ConstructUploadProxyPath(uploadProxyURL string) (string, error) {
 
 // ConstructUploadProxyPathAsync - receives uploadproxy address and concatenates to it URI
 func ConstructUploadProxyPathAsync(uploadProxyURL, token string, insecure bool) (string, error) {
+	uploadURL, _, err := discoverUploadProxyPath(uploadProxyURL, token, insecure)
+	return uploadURL, err
+}
+
+// discoverUploadProxyPath returns the upload URL and whether the endpoint explicitly
+// advertises resumable uploads of the image in byte ranges
+func discoverUploadProxyPath(uploadProxyURL, token string, insecure bool) (string, bool, error) {
 	u, err := url.Parse(uploadProxyURL)
 
 	if err != nil {
-		return "", err
+		return "", false, err
 	}
 
 	if !strings.Contains(uploadProxyURL, UploadProxyURIAsync) {
@@ -429,14 +464,15 @@ func ConstructUploadProxyPathAsync(uploadProxyURL, token string, insecure bool)
 	resp, err := client.Do(req)
 	if err != nil || resp.StatusCode != http.StatusOK {
 		// Async not available, use regular upload url.
-		return ConstructUploadProxyPath(uploadProxyURL)
+		uploadURL, err := ConstructUploadProxyPath(uploadProxyURL)
+		return uploadURL, false, err
 	}
 
-	return u.String(), nil
+	return u.String(), resp.Header.Get(ResumableUploadHeader) == resumableUploadRanges, nil
 }
 
 func (c *command) uploadData(token string, file *os.File) error {
-	uploadURL, err := ConstructUploadProxyPathAsync(c.uploadProxyURL, token, c.insecure)
+	uploadURL, resumable, err := discoverUploadProxyPath(c.uploadProxyURL, token, c.insecure)
 	if err != nil {
 		return err
 	}
@@ -446,6 +482,13 @@ func (c *command) uploadData(token string, file *os.File) error {
 		return err
 	}
 
+	if resumable {
+		return c.uploadDataInChunks(uploadURL, token, file, fi)
+	}
+	if c.resume {
+		return fmt.Errorf("the upload server does not support resumable uploads, upload the image without --resume")
+	}
+
 	bar := pb.Full.Start64(fi.Size())
 	bar.SetWriter(os.Stdout)
 	bar.Set(pb.Bytes, true)
@@ -480,24 +523,169 @@ func (c *command) uploadData(token string, file *os.File) error {
 	c.cmd.Println()
 	bar.Start()
 
+	err = c.withRetries(clientDo)
+
+	bar.Finish()
+	c.cmd.Println()
+
+	if err != nil {
+		return fmt.Errorf("error uploading image after %d retries: %w", c.uploadRetries, err)
+	}
+
+	return nil
+}
+
+// uploadDataInChunks uploads the image in byte ranges and records the uploaded offset on the
+// upload target after every chunk, so that an interrupted upload can be continued with --resume
+func (c *command) uploadDataInChunks(uploadURL, token string, file *os.File, fi os.FileInfo) error {
+	state := &uploadState{
+		ImageSize:    fi.Size(),
+		ImageModTime: fi.ModTime(),
+	}
+
+	if c.resume {
+		recorded, err := c.getUploadState()
+		if err != nil {
+			return err
+		}
+		if recorded.ImageSize != state.ImageSize || !recorded.ImageModTime.Equal(state.ImageModTime) {
+			return fmt.Errorf("%s is not the image of the interrupted upload to %s/%s", c.imagePath, c.namespace, c.name)
+		}
+		state.Offset = recorded.Offset
+		c.cmd.Printf("Resuming upload at byte %d of %d\n", state.Offset, state.ImageSize)
+	}
+
+	bar := pb.Full.Start64(fi.Size())
+	bar.SetWriter(os.Stdout)
+	bar.Set(pb.Bytes, true)
+
+	client := GetHTTPClientFn(c.insecure)
+
+	c.cmd.Println()
+	bar.Start()
+
+	for state.Offset < state.ImageSize {
+		length := min(UploadChunkSize, state.ImageSize-state.Offset)
+		err := c.withRetries(func() error {
+			bar.SetCurrent(state.Offset)
+			return uploadChunk(client, uploadURL, token, bar.NewProxyReader(io.NewSectionReader(file, state.Offset, length)), state.Offset, length, state.ImageSize)
+		})
+		if err != nil {
+			bar.Finish()
+			c.cmd.Println()
+			return fmt.Errorf("error uploading image after %d retries, continue the upload with --resume: %w", c.uploadRetries, err)
+		}
+		state.Offset += length
+		if err := c.setUploadState(state); err != nil {
+			return err
+		}
+	}
+
+	bar.Finish()
+	c.cmd.Println()
+
+	return c.setUploadState(nil)
+}
+
+func uploadChunk(client *http.Client, uploadURL, token string, chunk io.Reader, offset, length, size int64) error {
+	req, err := http.NewRequest("POST", uploadURL, io.NopCloser(chunk))
+	if err != nil {
+		return err
+	}
+
+	req.Header.Add("Authorization", "Bearer "+token)
+	req.Header.Add("Content-Type", "application/octet-stream")
+	req.Header.Add("Content-Range", fmt.Sprintf("bytes %d-%d/%d", offset, offset+length-1, size))
+	req.ContentLength = length
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		body, err := io.ReadAll(resp.Body)
+		if err != nil {
+			return err
+		}
+		return fmt.Errorf("unexpected return value %d, %s", resp.StatusCode, string(body))
+	}
+	return nil
+}
+
+// withRetries calls upload until it succeeds or the retries are used up
+func (c *command) withRetries(upload func() error) error {
+	var err error
 	retry := uint(0)
 	for retry < c.uploadRetries {
-		if err = clientDo(); err == nil {
-			break
+		if err = upload(); err == nil {
+			return nil
 		}
 		retry++
 		if retry < c.uploadRetries {
 			time.Sleep(time.Duration(retry*rand.UintN(50)) * time.Millisecond)
 		}
 	}
+	return err
+}
 
-	bar.Finish()
-	c.cmd.Println()
+// getUploadState returns the progress of the interrupted upload recorded on the upload target
+func (c *command) getUploadState() (*uploadState, error) {
+	var annotations map[string]string
+	if c.createPVC {
+		pvc, err := c.client.CoreV1().PersistentVolumeClaims(c.namespace).Get(context.Background(), c.name, metav1.GetOptions{})
+		if err != nil {
+			return nil, err
+		}
+		annotations = pvc.Annotations
+	} else {
+		dv, err := c.client.CdiClient().CdiV1beta1().DataVolumes(c.namespace).Get(context.Background(), c.name, metav1.GetOptions{})
+		if err != nil {
+			return nil, err
+		}
+		annotations = dv.Annotations
+	}
 
-	if err != nil && retry == c.uploadRetries {
-		return fmt.Errorf("error uploading image after %d retries: %w", c.uploadRetries, err)
+	value, ok := annotations[UploadStateAnnotation]
+	if !ok {
+		return nil, fmt.Errorf("no interrupted upload recorded on %s/%s, upload the image without --resume", c.namespace, c.name)
+	}
+	state := &uploadState{}
+	if err := json.Unmarshal([]byte(value), state); err != nil {
+		return nil, fmt.Errorf("invalid %s annotation on %s/%s: %v", UploadStateAnnotation, c.namespace, c.name, err)
+	}
+	return state, nil
+}
+
+// setUploadState records the progress of the upload on the upload target, a nil state removes it
+func (c *command) setUploadState(state *uploadState) error {
+	var value interface{}
+	if state != nil {
+		stateBytes, err := json.Marshal(state)
+		if err != nil {
+			return err
+		}
+		value = string(stateBytes)
+	}
+	patchBytes, err := json.Marshal(map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"annotations": map[string]interface{}{
+				UploadStateAnnotation: value,
+			},
+		},
+	})
+	if err != nil {
+		return err
 	}
 
+	if c.createPVC {
+		_, err = c.client.CoreV1().PersistentVolumeClaims(c.namespace).Patch(context.Background(), c.name, types.MergePatchType, patchBytes, metav1.PatchOptions{})
+	} else {
+		_, err = c.client.CdiClient().CdiV1beta1().DataVolumes(c.namespace).Patch(context.Background(), c.name, types.MergePatchType, patchBytes, metav1.PatchOptions{})
+	}
+	if err != nil {
+		return fmt.Errorf("failed to record the upload progress on %s/%s: %v", c.namespace, c.name, err)
+	}
 	return nil
 }
 
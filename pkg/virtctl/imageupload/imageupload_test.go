@@ -3,6 +3,7 @@ package imageupload_test
 import (
 	"context"
 	"fmt"
+	"io"
 	"net/http"
 	"net/http/httptest"
 	"os"
@@ -1015,6 +1016,138 @@ var _ = Describe("ImageUpload", func() {
 		})
 	})
 
+	Context("Resumable upload", func() {
+		var (
+			contentRanges []string
+			uploaded      []byte
+			failingRange  string
+		)
+
+		startResumableServer := func(resumable bool) {
+			contentRanges = nil
+			uploaded = nil
+			failingRange = ""
+			server.Close()
+			server = httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method == "HEAD" {
+					w.Header().Set("Accept-Ranges", "bytes")
+					if resumable {
+						w.Header().Set(imageupload.ResumableUploadHeader, "content-range")
+					}
+					w.WriteHeader(http.StatusOK)
+					return
+				}
+				contentRange := r.Header.Get("Content-Range")
+				if failingRange != "" && contentRange == failingRange {
+					w.WriteHeader(http.StatusBadGateway)
+					return
+				}
+				body, err := io.ReadAll(r.Body)
+				Expect(err).ToNot(HaveOccurred())
+				contentRanges = append(contentRanges, contentRange)
+				uploaded = append(uploaded, body...)
+				w.WriteHeader(http.StatusOK)
+			}))
+		}
+
+		getUploadStateAnnotation := func() (string, bool) {
+			dv, err := cdiClient.CdiV1beta1().DataVolumes(targetNamespace).Get(context.Background(), targetName, metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			value, ok := dv.Annotations[imageupload.UploadStateAnnotation]
+			return value, ok
+		}
+
+		BeforeEach(func() {
+			imageupload.UploadChunkSize = 4
+			testInitAsyncWithCdiObjects(
+				http.StatusOK,
+				true,
+				[]runtime.Object{pvcSpecWithUploadAnnotation()},
+				[]runtime.Object{dvSpecWithPhase(cdiv1.UploadReady)},
+			)
+			// token requests are not persisted by the apiserver, allow to request a token for every upload attempt
+			cdiClient.Fake.PrependReactor("create", "uploadtokenrequests", func(action k8stesting.Action) (bool, runtime.Object, error) {
+				return true, action.(k8stesting.CreateAction).GetObject(), nil
+			})
+		})
+
+		AfterEach(func() {
+			imageupload.UploadChunkSize = 256 * 1024 * 1024
+			testDone()
+		})
+
+		It("should upload the image in chunks and clear the upload state", func() {
+			startResumableServer(true)
+			cmd := testing.NewRepeatableVirtctlCommand(commandName, "dv", targetName, "--no-create",
+				"--uploadproxy-url", server.URL, "--insecure", "--image-path", imagePath)
+			Expect(cmd()).To(Succeed())
+			Expect(contentRanges).To(Equal([]string{"bytes 0-3/11", "bytes 4-7/11", "bytes 8-10/11"}))
+			Expect(string(uploaded)).To(Equal("hello world"))
+			_, recorded := getUploadStateAnnotation()
+			Expect(recorded).To(BeFalse())
+		})
+
+		It("should upload the image in one stream when the server only accepts ranged downloads", func() {
+			startResumableServer(false)
+			cmd := testing.NewRepeatableVirtctlCommand(commandName, "dv", targetName, "--no-create",
+				"--uploadproxy-url", server.URL, "--insecure", "--image-path", imagePath)
+			Expect(cmd()).To(Succeed())
+			Expect(contentRanges).To(Equal([]string{""}))
+			Expect(string(uploaded)).To(Equal("hello world"))
+		})
+
+		It("should continue an interrupted upload with --resume", func() {
+			startResumableServer(true)
+			failingRange = "bytes 4-7/11"
+			cmd := testing.NewRepeatableVirtctlCommand(commandName, "dv", targetName, "--no-create",
+				"--uploadproxy-url", server.URL, "--insecure", "--image-path", imagePath, "--retry", "1")
+			Expect(cmd()).To(MatchError(ContainSubstring("continue the upload with --resume")))
+			Expect(contentRanges).To(Equal([]string{"bytes 0-3/11"}))
+			state, recorded := getUploadStateAnnotation()
+			Expect(recorded).To(BeTrue())
+			Expect(state).To(ContainSubstring(`"offset":4`))
+
+			failingRange = ""
+			contentRanges = nil
+			cmd = testing.NewRepeatableVirtctlCommand(commandName, "dv", targetName, "--no-create",
+				"--uploadproxy-url", server.URL, "--insecure", "--image-path", imagePath, "--resume")
+			Expect(cmd()).To(Succeed())
+			Expect(contentRanges).To(Equal([]string{"bytes 4-7/11", "bytes 8-10/11"}))
+			Expect(string(uploaded)).To(Equal("hello world"))
+			_, recorded = getUploadStateAnnotation()
+			Expect(recorded).To(BeFalse())
+		})
+
+		It("should refuse to resume an upload of a different image", func() {
+			startResumableServer(true)
+			failingRange = "bytes 4-7/11"
+			cmd := testing.NewRepeatableVirtctlCommand(commandName, "dv", targetName, "--no-create",
+				"--uploadproxy-url", server.URL, "--insecure", "--image-path", imagePath, "--retry", "1")
+			Expect(cmd()).ToNot(Succeed())
+
+			Expect(os.WriteFile(imagePath, []byte("another image"), 0600)).To(Succeed())
+			cmd = testing.NewRepeatableVirtctlCommand(commandName, "dv", targetName, "--no-create",
+				"--uploadproxy-url", server.URL, "--insecure", "--image-path", imagePath, "--resume")
+			Expect(cmd()).To(MatchError(ContainSubstring("is not the image of the interrupted upload")))
+		})
+
+		It("should fail to resume without a recorded upload state", func() {
+			startResumableServer(true)
+			cmd := testing.NewRepeatableVirtctlCommand(commandName, "dv", targetName, "--no-create",
+				"--uploadproxy-url", server.URL, "--insecure", "--image-path", imagePath, "--resume")
+			Expect(cmd()).To(MatchError(ContainSubstring("no interrupted upload recorded")))
+			Expect(contentRanges).To(BeEmpty())
+		})
+
+		It("should fail to resume when the upload server does not advertise resumable uploads", func() {
+			startResumableServer(false)
+			cmd := testing.NewRepeatableVirtctlCommand(commandName, "dv", targetName, "--no-create",
+				"--uploadproxy-url", server.URL, "--insecure", "--image-path", imagePath, "--resume")
+			Expect(cmd()).To(MatchError(ContainSubstring("the upload server does not support resumable uploads")))
+			Expect(contentRanges).To(BeEmpty())
+		})
+	})
+
 	Context("URL validation", func() {
 		serverURL := "http://localhost:12345"
 		DescribeTable("Server URL validations", func(serverUrl string, expected string) {
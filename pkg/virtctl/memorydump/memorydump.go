@@ -51,6 +51,8 @@ const (
 	FormatFlag       = "format"
 	LocalPortFlag    = "local-port"
 	OutputFileFlag   = "output"
+	CompressionFlag  = "compression"
+	ChunkSizeFlag    = "chunk-size"
 
 	configName         = "config"
 	filesystemOverhead = v1.Percent("0.055")
@@ -69,6 +71,8 @@ var (
 	storageClass string
 	accessMode   string
 	outputFile   string
+	compression  string
+	chunkSize    string
 )
 
 type command struct{}
@@ -86,6 +90,9 @@ func usageMemoryDump() string {
   #Create and download memory dump to the given output file.
   {{ProgramName}} memory-dump get myvm --claim-name=memoryvolume --create-claim --output=memoryDump.dump.gz
 
+  #Dump the memory compressed with zstd, and flushed to the pvc every 128Mi.
+  {{ProgramName}} memory-dump get myvm --claim-name=memoryvolume --compression=zstd --chunk-size=128Mi
+
   #Dump memory again to the same virtual machine with an already associated pvc(existing memory dump on vm status).
   {{ProgramName}} memory-dump get myvm
 
@@ -117,6 +124,8 @@ func NewMemoryDumpCommand() *cobra.Command {
 	cmd.Flags().StringVar(&storageClass, StorageClassFlag, "", "The storage class for the PVC.")
 	cmd.Flags().StringVar(&accessMode, AccessModeFlag, "", "The access mode for the PVC.")
 	cmd.Flags().StringVar(&outputFile, OutputFileFlag, "", "Specifies the output path of the memory dump to be downloaded.")
+	cmd.Flags().StringVar(&compression, CompressionFlag, "", "Compresses the memory dump on the pvc (gzip or zstd).")
+	cmd.Flags().StringVar(&chunkSize, ChunkSizeFlag, "", "The amount of the memory dump written to the pvc before it is flushed.")
 
 	return cmd
 }
@@ -264,7 +273,15 @@ func createPVCforMemoryDump(namespace, vmName, claimName string, virtClient kube
 
 func createMemoryDump(namespace, vmName, claimName string, virtClient kubecli.KubevirtClient) error {
 	memoryDumpRequest := &v1.VirtualMachineMemoryDumpRequest{
-		ClaimName: claimName,
+		ClaimName:   claimName,
+		Compression: v1.MemoryDumpCompression(compression),
+	}
+	if chunkSize != "" {
+		quantity, err := resource.ParseQuantity(chunkSize)
+		if err != nil {
+			return fmt.Errorf("invalid chunk size %s: %v", chunkSize, err)
+		}
+		memoryDumpRequest.ChunkSize = &quantity
 	}
 
 	err := virtClient.VirtualMachine(namespace).MemoryDump(context.Background(), vmName, memoryDumpRequest)
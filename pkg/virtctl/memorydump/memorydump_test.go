@@ -147,6 +147,28 @@ var _ = Describe("MemoryDump", func() {
 		Expect(kvtesting.FilterActions(&virtClient.Fake, "put", "virtualmachines", "memorydump")).To(HaveLen(1))
 	})
 
+	It("should call memory dump subresource with compression and chunk size", func() {
+		virtClient.PrependReactor("put", "virtualmachines/memorydump", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			request := action.(kvtesting.PutAction[*v1.VirtualMachineMemoryDumpRequest]).GetOptions()
+			Expect(request.Compression).To(Equal(v1.MemoryDumpCompressionZstd))
+			Expect(request.ChunkSize.String()).To(Equal("128Mi"))
+			return true, nil, nil
+		})
+		Expect(runGetCmd(
+			setFlag(memorydump.ClaimNameFlag, pvcName),
+			setFlag(memorydump.CompressionFlag, "zstd"),
+			setFlag(memorydump.ChunkSizeFlag, "128Mi"),
+		)).To(Succeed())
+		Expect(kvtesting.FilterActions(&virtClient.Fake, "put", "virtualmachines", "memorydump")).To(HaveLen(1))
+	})
+
+	It("should fail with an invalid chunk size", func() {
+		Expect(runGetCmd(
+			setFlag(memorydump.ClaimNameFlag, pvcName),
+			setFlag(memorydump.ChunkSizeFlag, "big"),
+		)).To(MatchError(ContainSubstring("invalid chunk size")))
+	})
+
 	It("should call memory dump subresource without claim-name no create", func() {
 		expectVMEndpointMemoryDump("")
 		Expect(runGetCmd()).To(Succeed())
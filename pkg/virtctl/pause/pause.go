@@ -31,12 +31,14 @@ import (
 	kubevirtV1 "kubevirt.io/api/core/v1"
 	"kubevirt.io/client-go/kubecli"
 
+	"kubevirt.io/kubevirt/pkg/virtctl/batch"
 	"kubevirt.io/kubevirt/pkg/virtctl/clientconfig"
 	"kubevirt.io/kubevirt/pkg/virtctl/templates"
 )
 
 type virtCommand struct {
 	dryRun bool
+	batch  batch.Options
 }
 
 func NewCommand() *cobra.Command {
@@ -46,11 +48,12 @@ func NewCommand() *cobra.Command {
 		Short: "Pause a virtual machine",
 		Long: `Pauses a virtual machine by freezing it. Machine state is kept in memory.
 First argument is the resource type, possible types are (case insensitive, both singular and plural forms) virtualmachineinstance (vmi) or virtualmachine (vm).
-Second argument is the name of the resource.`,
-		Args:    cobra.ExactArgs(2),
+Second argument is the name of the resource, it can be replaced by --selector to pause all matching resources.`,
+		Args:    c.batch.Args(2),
 		Example: usage(),
 		RunE:    c.Run,
 	}
+	c.batch.AddFlags(cmd.Flags())
 
 	cmd.Flags().BoolVar(&c.dryRun, "dry-run", false, "--dry-run=false: Flag used to set whether to perform a dry run or not. If true the command will be executed without performing any changes.")
 
@@ -59,12 +62,15 @@ Second argument is the name of the resource.`,
 }
 
 func usage() string {
-	return "  # Pause a virtualmachine called 'myvm':\n  {{ProgramName}} pause vm myvm"
+	return `  # Pause a virtualmachine called 'myvm':
+  {{ProgramName}} pause vm myvm
+
+  # Pause all virtualmachines labeled 'app=web' in all namespaces, at most 10 at a time:
+  {{ProgramName}} pause vm -l app=web -A --concurrency 10`
 }
 
 func (vc *virtCommand) Run(cmd *cobra.Command, args []string) error {
 	resourceType := strings.ToLower(args[0])
-	resourceName := args[1]
 
 	virtClient, namespace, _, err := clientconfig.ClientAndNamespaceFromContext(cmd.Context())
 	if err != nil {
@@ -77,7 +83,42 @@ func (vc *virtCommand) Run(cmd *cobra.Command, args []string) error {
 		dryRunOption = []string{v1.DryRunAll}
 	}
 
-	return executePauseCMD(virtClient, namespace, resourceType, resourceName, dryRunOption)
+	if vc.batch.Enabled() {
+		return vc.runBatch(cmd, virtClient, namespace, resourceType, dryRunOption)
+	}
+
+	resourceName := args[1]
+	if err := executePauseCMD(virtClient, namespace, resourceType, resourceName, dryRunOption); err != nil {
+		return err
+	}
+	fmt.Printf("VMI %s was scheduled to pause\n", resourceName)
+
+	return nil
+}
+
+func (vc *virtCommand) runBatch(cmd *cobra.Command, client kubecli.KubevirtClient, namespace, resourceType string, dryRunOption []string) error {
+	var (
+		kind    string
+		targets []batch.Target
+		err     error
+	)
+	switch resourceType {
+	case "virtualmachine", "vm":
+		kind = "VM"
+		targets, err = vc.batch.VMs(cmd.Context(), client, namespace)
+	case "virtualmachineinstance", "vmi":
+		kind = "VMI"
+		targets, err = vc.batch.VMIs(cmd.Context(), client, namespace)
+	default:
+		return fmt.Errorf("unsupported resource type %s", resourceType)
+	}
+	if err != nil {
+		return err
+	}
+
+	return vc.batch.Run(cmd, kind, "pause", targets, func(namespace, name string) error {
+		return executePauseCMD(client, namespace, resourceType, name, dryRunOption)
+	})
 }
 
 func executePauseCMD(client kubecli.KubevirtClient, namespace, resourceType, resourceName string, dryRunOption []string) error {
@@ -102,7 +143,6 @@ func executePauseCMD(client kubecli.KubevirtClient, namespace, resourceType, res
 			return fmt.Errorf("Error pausing VirtualMachineInstance %s: %v", resourceName, err)
 		}
 	}
-	fmt.Printf("VMI %s was scheduled to pause\n", resourceName)
 
 	return nil
 }
@@ -80,4 +80,18 @@ var _ = Describe("Pausing", func() {
 		Entry("", &v1.PauseOptions{}),
 		Entry("with dry-run option", &v1.PauseOptions{DryRun: []string{k8smetav1.DryRunAll}}),
 	)
+
+	It("should pause all VMIs matching the selector", func() {
+		vmis := []v1.VirtualMachineInstance{*api.NewMinimalVMI("vmi1"), *api.NewMinimalVMI("vmi2")}
+
+		kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachineInstance(k8smetav1.NamespaceDefault).Return(vmiInterface).Times(3)
+		vmiInterface.EXPECT().List(gomock.Any(), k8smetav1.ListOptions{LabelSelector: "app=web"}).
+			Return(&v1.VirtualMachineInstanceList{Items: vmis}, nil).Times(1)
+		vmiInterface.EXPECT().Pause(context.Background(), "vmi1", &v1.PauseOptions{}).Return(nil).Times(1)
+		vmiInterface.EXPECT().Pause(context.Background(), "vmi2", &v1.PauseOptions{}).Return(nil).Times(1)
+
+		out, err := testing.NewRepeatableVirtctlCommandWithOut(COMMAND_PAUSE, "vmi", "-l", "app=web")()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(out)).To(ContainSubstring("2 of 2 VMIs were scheduled to pause"))
+	})
 })
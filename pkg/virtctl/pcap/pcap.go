@@ -0,0 +1,141 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package pcap
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "kubevirt.io/api/core/v1"
+	kvcorev1 "kubevirt.io/client-go/kubevirt/typed/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/virtctl/clientconfig"
+	"kubevirt.io/kubevirt/pkg/virtctl/templates"
+)
+
+const (
+	interfaceArg = "interface"
+	durationArg  = "duration"
+	outputArg    = "output"
+)
+
+type command struct {
+	iface    string
+	duration time.Duration
+	output   string
+}
+
+func NewCommand() *cobra.Command {
+	c := command{}
+	cmd := &cobra.Command{
+		Use:   "pcap vmi (VMI)",
+		Short: "Capture the network traffic of a virtual machine instance interface.",
+		Long: `Captures the traffic of an interface of a virtual machine instance in the pcap format, which can be read by tcpdump and wireshark.
+The traffic is captured on the node, on the tap device of the interface, only interfaces with the bridge or masquerade binding can be captured.`,
+		Args:    cobra.ExactArgs(2),
+		Example: usage(),
+		RunE:    c.run,
+	}
+
+	cmd.Flags().StringVar(&c.iface, interfaceArg, "default", "Name of the interface, as defined in the virtual machine instance spec, to capture the traffic of.")
+	cmd.Flags().DurationVar(&c.duration, durationArg, time.Minute, "Duration of the capture. Zero captures until interrupted.")
+	cmd.Flags().StringVarP(&c.output, outputArg, "o", "", "File to write the capture to. The capture is written to the standard output if omitted.")
+	cmd.SetUsageTemplate(templates.UsageTemplate())
+
+	return cmd
+}
+
+func usage() string {
+	return `  # Capture the traffic of the default interface of a virtual machine instance called 'myvmi' for one minute:
+  {{ProgramName}} pcap vmi myvmi --output myvmi.pcap
+
+  # Capture the traffic of the interface 'blue' for 10 seconds and read it with tcpdump:
+  {{ProgramName}} pcap vmi myvmi --interface blue --duration 10s | tcpdump -nr -
+
+  # Watch the traffic with wireshark until interrupted:
+  {{ProgramName}} pcap vmi myvmi --duration 0 | wireshark -k -i -`
+}
+
+func (c *command) run(cmd *cobra.Command, args []string) error {
+	switch strings.ToLower(args[0]) {
+	case "vmi", "vmis", "virtualmachineinstance", "virtualmachineinstances":
+	default:
+		return fmt.Errorf("unsupported resource type: %s", args[0])
+	}
+	if c.duration < 0 {
+		return fmt.Errorf("invalid capture duration: %s", c.duration)
+	}
+
+	client, namespace, _, err := clientconfig.ClientAndNamespaceFromContext(cmd.Context())
+	if err != nil {
+		return fmt.Errorf("cannot obtain KubeVirt client: %v", err)
+	}
+
+	options := &v1.PacketCaptureOptions{InterfaceName: c.iface}
+	if c.duration > 0 {
+		options.Duration = &metav1.Duration{Duration: c.duration}
+	}
+	stream, err := client.VirtualMachineInstance(namespace).PacketCapture(args[1], options)
+	if err != nil {
+		return fmt.Errorf("can't capture the traffic of interface %s: %v", c.iface, err)
+	}
+
+	out := cmd.OutOrStdout()
+	if c.output != "" {
+		file, err := os.Create(c.output)
+		if err != nil {
+			return fmt.Errorf("cannot create capture file: %v", err)
+		}
+		defer file.Close()
+		out = file
+	}
+
+	return capture(stream, out)
+}
+
+// capture writes the stream to out until it ends or the user interrupts it, keeping what was captured so far.
+func capture(stream kvcorev1.StreamInterface, out io.Writer) error {
+	// Nothing is sent to the capture, the input is only closed when returning
+	inReader, inWriter := io.Pipe()
+	defer inWriter.Close()
+
+	done := make(chan error, 1)
+	go func() {
+		done <- stream.Stream(kvcorev1.StreamOptions{In: inReader, Out: out})
+	}()
+
+	interrupt := make(chan os.Signal, 1)
+	signal.Notify(interrupt, os.Interrupt)
+	defer signal.Stop(interrupt)
+
+	select {
+	case <-interrupt:
+		return nil
+	case err := <-done:
+		return err
+	}
+}
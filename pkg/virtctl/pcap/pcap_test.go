@@ -0,0 +1,124 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package pcap_test
+
+import (
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+	kvcorev1 "kubevirt.io/client-go/kubevirt/typed/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/virtctl/testing"
+)
+
+type fakeStream struct {
+	capture []byte
+	err     error
+}
+
+func (s fakeStream) Stream(options kvcorev1.StreamOptions) error {
+	if _, err := options.Out.Write(s.capture); err != nil {
+		return err
+	}
+	return s.err
+}
+
+func (s fakeStream) AsConn() net.Conn {
+	return nil
+}
+
+var _ = Describe("Packet capture", func() {
+	const vmiName = "testvmi"
+
+	var vmiInterface *kubecli.MockVirtualMachineInstanceInterface
+
+	BeforeEach(func() {
+		ctrl := gomock.NewController(GinkgoT())
+		kubecli.GetKubevirtClientFromClientConfig = kubecli.GetMockKubevirtClientFromClientConfig
+		kubecli.MockKubevirtClientInstance = kubecli.NewMockKubevirtClient(ctrl)
+		vmiInterface = kubecli.NewMockVirtualMachineInstanceInterface(ctrl)
+		kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachineInstance(metav1.NamespaceDefault).Return(vmiInterface).AnyTimes()
+	})
+
+	It("should write the capture of the default interface to the standard output", func() {
+		vmiInterface.EXPECT().PacketCapture(vmiName, &v1.PacketCaptureOptions{
+			InterfaceName: "default",
+			Duration:      &metav1.Duration{Duration: time.Minute},
+		}).Return(fakeStream{capture: []byte("pcap")}, nil)
+
+		out, err := testing.NewRepeatableVirtctlCommandWithOut("pcap", "vmi", vmiName)()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(out)).To(Equal("pcap"))
+	})
+
+	It("should write the capture of the requested interface to a file", func() {
+		output := filepath.Join(GinkgoT().TempDir(), "capture.pcap")
+		vmiInterface.EXPECT().PacketCapture(vmiName, &v1.PacketCaptureOptions{
+			InterfaceName: "blue",
+			Duration:      &metav1.Duration{Duration: 10 * time.Second},
+		}).Return(fakeStream{capture: []byte("pcap")}, nil)
+
+		out, err := testing.NewRepeatableVirtctlCommandWithOut(
+			"pcap", "vmi", vmiName, "--interface", "blue", "--duration", "10s", "--output", output)()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(out).To(BeEmpty())
+		Expect(os.ReadFile(output)).To(Equal([]byte("pcap")))
+	})
+
+	It("should capture without duration until interrupted", func() {
+		vmiInterface.EXPECT().PacketCapture(vmiName, &v1.PacketCaptureOptions{InterfaceName: "default"}).
+			Return(fakeStream{}, nil)
+
+		Expect(testing.NewRepeatableVirtctlCommand("pcap", "vmi", vmiName, "--duration", "0")()).To(Succeed())
+	})
+
+	It("should fail when the capture can not be started", func() {
+		vmiInterface.EXPECT().PacketCapture(vmiName, gomock.Any()).Return(nil, fmt.Errorf("interface default not found"))
+
+		err := testing.NewRepeatableVirtctlCommand("pcap", "vmi", vmiName)()
+		Expect(err).To(MatchError(ContainSubstring("interface default not found")))
+	})
+
+	It("should fail when the connection breaks", func() {
+		vmiInterface.EXPECT().PacketCapture(vmiName, gomock.Any()).Return(fakeStream{err: fmt.Errorf("connection reset")}, nil)
+
+		err := testing.NewRepeatableVirtctlCommand("pcap", "vmi", vmiName)()
+		Expect(err).To(MatchError("connection reset"))
+	})
+
+	DescribeTable("should reject", func(args ...string) {
+		err := testing.NewRepeatableVirtctlCommand(append([]string{"pcap"}, args...)...)()
+		Expect(err).To(HaveOccurred())
+	},
+		Entry("an unsupported resource type", "vm", vmiName),
+		Entry("a negative duration", "vmi", vmiName, "--duration", "-1s"),
+		Entry("a missing VMI name", "vmi"),
+	)
+})
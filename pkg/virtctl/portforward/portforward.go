@@ -26,6 +26,7 @@ import (
 	"os"
 	"os/signal"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 	"kubevirt.io/client-go/kubecli"
@@ -39,6 +40,7 @@ import (
 const (
 	forwardToStdioFlag = "stdio"
 	addressFlag        = "address"
+	udpIdleTimeoutFlag = "udp-idle-timeout"
 
 	vm  = "vm"
 	vmi = "vmi"
@@ -47,6 +49,7 @@ const (
 var (
 	forwardToStdio bool
 	address        string = "127.0.0.1"
+	udpIdleTimeout        = time.Minute
 )
 
 func NewCommand() *cobra.Command {
@@ -73,6 +76,8 @@ func NewCommand() *cobra.Command {
 		fmt.Sprintf("--%s=true: Set this to true to forward the tunnel to stdout/stdin; Only works with a single port", forwardToStdioFlag))
 	cmd.Flags().StringVar(&address, addressFlag, address,
 		fmt.Sprintf("--%s=: Set this to the address the local ports should be opened on", addressFlag))
+	cmd.Flags().DurationVar(&udpIdleTimeout, udpIdleTimeoutFlag, udpIdleTimeout,
+		fmt.Sprintf("--%s=1m: Close the tunnel of a UDP client after it was idle for this long; 0 keeps tunnels open", udpIdleTimeoutFlag))
 	cmd.SetUsageTemplate(templates.UsageTemplate())
 	return cmd
 }
@@ -171,10 +176,11 @@ func (o *PortForward) startStdoutStream(namespace, name string, port forwardedPo
 func (o *PortForward) startPortForwards(kind, namespace, name string, ports []forwardedPort) error {
 	for _, port := range ports {
 		forwarder := portForwarder{
-			kind:      kind,
-			namespace: namespace,
-			name:      name,
-			resource:  o.resource,
+			kind:           kind,
+			namespace:      namespace,
+			name:           name,
+			resource:       o.resource,
+			udpIdleTimeout: udpIdleTimeout,
 		}
 		if err := forwarder.startForwarding(o.address, port); err != nil {
 			return err
@@ -206,6 +212,9 @@ A warning will be emitted instead. This behavior will be removed in the next rel
 The port argument supports the syntax protocol/localPort:targetPort with protocol/ and :targetPort as optional fields.
 Protocol supports TCP (default) and UDP.
 
+Every UDP client, identified by its local address and port, gets its own tunnel to the target port.
+Tunnels of clients which did not send or receive a datagram for --udp-idle-timeout are closed.
+
 Portforwards get established over the Kubernetes control-plane using websocket streams.
 Usage can be restricted by the cluster administrator through the /portforward subresource.
 `
@@ -221,6 +230,9 @@ func examples() string {
   # Forward the local port 8080 to the vmi port 9090 as a UDP connection:
   {{ProgramName}} port-forward vmi/testvmi/mynamespace udp/8080:9090
 
+  # Forward the local port 5353 to the DNS server of the vmi and close idle UDP tunnels after 10 seconds:
+  {{ProgramName}} port-forward vmi/testvmi udp/5353:53 --udp-idle-timeout=10s
+
   # Forward the local port 8080 to the vm port
   {{ProgramName}} port-forward vm/testvm 8080
 
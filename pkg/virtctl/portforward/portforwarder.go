@@ -4,6 +4,7 @@ import (
 	"errors"
 	"net"
 	"strings"
+	"time"
 
 	kvcorev1 "kubevirt.io/client-go/kubevirt/typed/core/v1"
 	"kubevirt.io/client-go/log"
@@ -12,6 +13,7 @@ import (
 type portForwarder struct {
 	kind, namespace, name string
 	resource              portforwardableResource
+	udpIdleTimeout        time.Duration
 }
 
 type portforwardableResource interface {
@@ -1,8 +1,10 @@
 package portforward
 
 import (
+	"errors"
 	"net"
 	"sync"
+	"time"
 
 	"kubevirt.io/client-go/log"
 )
@@ -33,7 +35,8 @@ func (p *portForwarder) startForwardingUDP(address *net.IPAddr, port forwardedPo
 			}
 			return stream.AsConn(), nil
 		},
-		clients: make(map[string]*udpProxyConn),
+		idleTimeout: p.udpIdleTimeout,
+		clients:     make(map[string]*udpProxyConn),
 	}
 
 	go proxy.Run()
@@ -45,6 +48,10 @@ type udpProxy struct {
 
 	remoteDialer func() (net.Conn, error)
 
+	// idleTimeout closes the tunnel of a client after it did not send or receive a datagram
+	// for the given duration; 0 keeps tunnels open until the remote side closes them
+	idleTimeout time.Duration
+
 	sync.Mutex
 	clients map[string]*udpProxyConn
 }
@@ -53,6 +60,9 @@ func (p *udpProxy) Run() {
 	buf := make([]byte, bufSize)
 	for {
 		if err := p.handleRead(buf); err != nil {
+			if errors.Is(err, net.ErrClosed) {
+				return
+			}
 			log.Log.Errorf("%v", err)
 		}
 	}
@@ -76,16 +86,19 @@ func (p *udpProxy) handleRead(buf []byte) error {
 			return err
 		}
 		client = &udpProxyConn{
-			localConn:  p.listener,
-			clientAddr: clientAddr,
-			remoteConn: remoteConn,
-			close:      make(chan struct{}),
+			localConn:   p.listener,
+			clientAddr:  clientAddr,
+			remoteConn:  remoteConn,
+			idleTimeout: p.idleTimeout,
+			close:       make(chan struct{}),
 		}
+		client.startIdleTimer()
 		p.clients[clientID] = client
 		go client.handleRemoteReads()
 		go p.cleanupClient(clientID, client)
 	}
 
+	client.resetIdleTimer()
 	_, err = client.remoteConn.Write(buf[0:n])
 	return err
 }
@@ -102,11 +115,31 @@ type udpProxyConn struct {
 	clientAddr *net.UDPAddr
 	remoteConn net.Conn
 
+	idleTimeout time.Duration
+	idleTimer   *time.Timer
+
 	close chan struct{}
 }
 
+func (c *udpProxyConn) startIdleTimer() {
+	if c.idleTimeout <= 0 {
+		return
+	}
+	c.idleTimer = time.AfterFunc(c.idleTimeout, func() {
+		log.Log.Infof("closing idle udp tunnel for %s", c.clientAddr)
+		c.remoteConn.Close()
+	})
+}
+
+func (c *udpProxyConn) resetIdleTimer() {
+	if c.idleTimer != nil {
+		c.idleTimer.Reset(c.idleTimeout)
+	}
+}
+
 func (c *udpProxyConn) handleRemoteReads() {
 	defer close(c.close)
+	defer c.remoteConn.Close()
 	buf := make([]byte, bufSize)
 	for {
 		if err := c.handleRemoteRead(buf); err != nil {
@@ -121,6 +154,7 @@ func (c *udpProxyConn) handleRemoteRead(buf []byte) error {
 	if err != nil {
 		return err
 	}
+	c.resetIdleTimer()
 	_, err = c.localConn.WriteToUDP(buf[0:n], c.clientAddr)
 	if err != nil {
 		return err
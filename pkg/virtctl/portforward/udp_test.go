@@ -0,0 +1,121 @@
+package portforward
+
+import (
+	"net"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("UDP proxy", func() {
+	var (
+		listener *net.UDPConn
+		client   *net.UDPConn
+		remotes  chan net.Conn
+		proxy    *udpProxy
+	)
+
+	// echo answers every datagram received over the tunnel with the datagram in upper case
+	echo := func(conn net.Conn) {
+		buf := make([]byte, bufSize)
+		for {
+			n, err := conn.Read(buf)
+			if err != nil {
+				return
+			}
+			for i := range buf[:n] {
+				if buf[i] >= 'a' && buf[i] <= 'z' {
+					buf[i] -= 'a' - 'A'
+				}
+			}
+			if _, err := conn.Write(buf[:n]); err != nil {
+				return
+			}
+		}
+	}
+
+	receive := func() string {
+		buf := make([]byte, bufSize)
+		Expect(client.SetReadDeadline(time.Now().Add(5 * time.Second))).To(Succeed())
+		n, err := client.Read(buf)
+		Expect(err).ToNot(HaveOccurred())
+		return string(buf[:n])
+	}
+
+	start := func(idleTimeout time.Duration) {
+		proxy = &udpProxy{
+			listener: listener,
+			remoteDialer: func() (net.Conn, error) {
+				local, remote := net.Pipe()
+				go echo(remote)
+				remotes <- remote
+				return local, nil
+			},
+			idleTimeout: idleTimeout,
+			clients:     make(map[string]*udpProxyConn),
+		}
+		go proxy.Run()
+	}
+
+	numClients := func() int {
+		proxy.Lock()
+		defer proxy.Unlock()
+		return len(proxy.clients)
+	}
+
+	BeforeEach(func() {
+		var err error
+		listener, err = net.ListenUDP("udp", &net.UDPAddr{IP: net.IPv4(127, 0, 0, 1)})
+		Expect(err).ToNot(HaveOccurred())
+		client, err = net.DialUDP("udp", nil, listener.LocalAddr().(*net.UDPAddr))
+		Expect(err).ToNot(HaveOccurred())
+		remotes = make(chan net.Conn, 10)
+		DeferCleanup(func() {
+			client.Close()
+			listener.Close()
+		})
+	})
+
+	It("should forward datagrams of a client over a single tunnel", func() {
+		start(0)
+
+		_, err := client.Write([]byte("hello"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(receive()).To(Equal("HELLO"))
+		_, err = client.Write([]byte("again"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(receive()).To(Equal("AGAIN"))
+
+		Expect(remotes).To(HaveLen(1))
+		Expect(numClients()).To(Equal(1))
+	})
+
+	It("should close the tunnel of an idle client", func() {
+		start(200 * time.Millisecond)
+
+		_, err := client.Write([]byte("hello"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(receive()).To(Equal("HELLO"))
+		Expect(numClients()).To(Equal(1))
+
+		Eventually(numClients).WithTimeout(5 * time.Second).Should(BeZero())
+
+		_, err = client.Write([]byte("again"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(receive()).To(Equal("AGAIN"))
+		Expect(remotes).To(HaveLen(2))
+	})
+
+	It("should stop when the listener is closed", func() {
+		done := make(chan struct{})
+		proxy = &udpProxy{listener: listener, clients: make(map[string]*udpProxyConn)}
+		go func() {
+			defer close(done)
+			proxy.Run()
+		}()
+
+		Expect(listener.Close()).To(Succeed())
+		Eventually(done).WithTimeout(5 * time.Second).Should(BeClosed())
+	})
+})
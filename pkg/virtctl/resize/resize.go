@@ -0,0 +1,244 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package resize
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	k8sv1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+
+	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
+	"kubevirt.io/kubevirt/pkg/apimachinery/wait"
+	"kubevirt.io/kubevirt/pkg/virtctl/clientconfig"
+	"kubevirt.io/kubevirt/pkg/virtctl/templates"
+)
+
+const (
+	COMMAND_RESIZE = "resize"
+
+	cpuArg     = "cpu"
+	memoryArg  = "memory"
+	liveArg    = "live"
+	timeoutArg = "timeout"
+
+	domainPath = "/spec/template/spec/domain"
+)
+
+// WaitInterval is the interval in which the VMI is checked for the new resources
+var WaitInterval = 2 * time.Second
+
+type command struct {
+	cpu       uint32
+	strMemory string
+	live      bool
+	timeout   time.Duration
+
+	memory *resource.Quantity
+}
+
+func NewCommand() *cobra.Command {
+	c := command{}
+	cmd := &cobra.Command{
+		Use:   "resize vm (VM)",
+		Short: "Change the number of vCPUs and the guest memory of a virtual machine.",
+		Long: `Patches the vCPUs and the guest memory of a virtual machine.
+The number of vCPUs is changed by changing the number of sockets, the cores and threads per socket are kept.
+Depending on the rollout strategy of the cluster, the change is applied to the running virtual machine instance with CPU and memory hotplug, or on the next restart.
+With --live the command waits until the running virtual machine instance reflects the new resources and fails if the change requires a restart.`,
+		Args:    cobra.ExactArgs(2),
+		Example: usage(),
+		RunE:    c.run,
+	}
+
+	cmd.Flags().Uint32Var(&c.cpu, cpuArg, 0, "Number of vCPUs of the VM, must be a multiple of the cores and threads per socket.")
+	cmd.Flags().StringVar(&c.strMemory, memoryArg, "", "Guest memory of the VM, e.g. 16Gi.")
+	cmd.Flags().BoolVar(&c.live, liveArg, false, "Wait until the running VMI reflects the new resources.")
+	cmd.Flags().DurationVar(&c.timeout, timeoutArg, 5*time.Minute, "How long to wait for the VMI to reflect the new resources with --live.")
+	cmd.SetUsageTemplate(templates.UsageTemplate())
+
+	return cmd
+}
+
+func usage() string {
+	return `  # Give a virtual machine called 'myvm' 8 vCPUs and 16Gi of memory:
+  {{ProgramName}} resize vm myvm --cpu 8 --memory 16Gi
+
+  # Hotplug 4 vCPUs to a running virtual machine called 'myvm' and wait for the guest to get them:
+  {{ProgramName}} resize vm myvm --cpu 4 --live`
+}
+
+func (c *command) run(cmd *cobra.Command, args []string) error {
+	switch strings.ToLower(args[0]) {
+	case "vm", "vms", "virtualmachine", "virtualmachines":
+	default:
+		return fmt.Errorf("unsupported resource type: %s", args[0])
+	}
+	vmName := args[1]
+
+	if err := c.parseFlags(); err != nil {
+		return err
+	}
+
+	client, namespace, _, err := clientconfig.ClientAndNamespaceFromContext(cmd.Context())
+	if err != nil {
+		return fmt.Errorf("cannot obtain KubeVirt client: %v", err)
+	}
+
+	vm, err := client.VirtualMachine(namespace).Get(cmd.Context(), vmName, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("error fetching VirtualMachine: %v", err)
+	}
+
+	sockets, err := c.patchVM(cmd.Context(), client, vm)
+	if err != nil {
+		return err
+	}
+	cmd.Printf("VM %s was resized\n", vmName)
+
+	if !c.live {
+		return nil
+	}
+	if err := c.waitForVMI(cmd.Context(), client, vm, sockets); err != nil {
+		return err
+	}
+	cmd.Printf("VMI %s reflects the new resources\n", vmName)
+	return nil
+}
+
+func (c *command) parseFlags() error {
+	if c.cpu == 0 && c.strMemory == "" {
+		return fmt.Errorf("at least one of --%s or --%s is required", cpuArg, memoryArg)
+	}
+	if c.strMemory != "" {
+		memory, err := resource.ParseQuantity(c.strMemory)
+		if err != nil {
+			return fmt.Errorf("invalid memory %s: %v", c.strMemory, err)
+		}
+		if memory.Sign() <= 0 {
+			return fmt.Errorf("invalid memory %s: must be greater than zero", c.strMemory)
+		}
+		c.memory = &memory
+	}
+	return nil
+}
+
+// patchVM changes the template of the VM and returns the new number of sockets, or zero
+// if the number of vCPUs is not changed
+func (c *command) patchVM(ctx context.Context, client kubecli.KubevirtClient, vm *v1.VirtualMachine) (uint32, error) {
+	if vm.Spec.Instancetype != nil {
+		return 0, errors.New("the resources of VMs with an instancetype are defined by the instancetype, change the instancetype instead")
+	}
+	if vm.Spec.Template == nil {
+		return 0, errors.New("the VM has no template")
+	}
+	domain := &vm.Spec.Template.Spec.Domain
+
+	var sockets uint32
+	patchSet := patch.New()
+	if c.cpu != 0 {
+		cpu := &v1.CPU{}
+		if domain.CPU != nil {
+			cpu = domain.CPU.DeepCopy()
+		}
+		vCPUsPerSocket := max(cpu.Cores, 1) * max(cpu.Threads, 1)
+		if c.cpu%vCPUsPerSocket != 0 {
+			return 0, fmt.Errorf("%d vCPUs can not be split in sockets of %d vCPUs", c.cpu, vCPUsPerSocket)
+		}
+		sockets = c.cpu / vCPUsPerSocket
+		cpu.Sockets = sockets
+		if domain.CPU == nil {
+			patchSet.AddOption(patch.WithAdd(domainPath+"/cpu", cpu))
+		} else {
+			patchSet.AddOption(patch.WithAdd(domainPath+"/cpu/sockets", sockets))
+		}
+	}
+	if c.memory != nil {
+		if domain.Memory == nil {
+			patchSet.AddOption(patch.WithAdd(domainPath+"/memory", &v1.Memory{Guest: c.memory}))
+		} else {
+			patchSet.AddOption(patch.WithAdd(domainPath+"/memory/guest", c.memory))
+		}
+	}
+
+	payload, err := patchSet.GeneratePayload()
+	if err != nil {
+		return 0, err
+	}
+	if _, err := client.VirtualMachine(vm.Namespace).Patch(ctx, vm.Name, types.JSONPatchType, payload, metav1.PatchOptions{}); err != nil {
+		return 0, fmt.Errorf("error patching VirtualMachine: %v", err)
+	}
+	return sockets, nil
+}
+
+// waitForVMI waits until the VMI of the VM runs with the new resources. It fails early if the
+// VM controller decided that the change requires a restart.
+func (c *command) waitForVMI(ctx context.Context, client kubecli.KubevirtClient, vm *v1.VirtualMachine, sockets uint32) error {
+	err := wait.PollImmediately(WaitInterval, c.timeout, func(ctx context.Context) (bool, error) {
+		current, err := client.VirtualMachine(vm.Namespace).Get(ctx, vm.Name, metav1.GetOptions{})
+		if err != nil {
+			return false, err
+		}
+		for _, condition := range current.Status.Conditions {
+			if condition.Type == v1.VirtualMachineRestartRequired && condition.Status == k8sv1.ConditionTrue {
+				return false, fmt.Errorf("the VM can not be resized live, it needs to be restarted: %s", condition.Message)
+			}
+		}
+
+		vmi, err := client.VirtualMachineInstance(vm.Namespace).Get(ctx, vm.Name, metav1.GetOptions{})
+		if k8serrors.IsNotFound(err) {
+			return false, errors.New("the VM is not running, the new resources are applied when it starts")
+		}
+		if err != nil {
+			return false, err
+		}
+		return c.vmiReflectsResources(vmi, sockets), nil
+	})
+	if err != nil {
+		return fmt.Errorf("error waiting for the VMI to reflect the new resources: %v", err)
+	}
+	return nil
+}
+
+func (c *command) vmiReflectsResources(vmi *v1.VirtualMachineInstance, sockets uint32) bool {
+	if sockets != 0 {
+		topology := vmi.Status.CurrentCPUTopology
+		if topology == nil || topology.Sockets != sockets {
+			return false
+		}
+	}
+	if c.memory != nil {
+		if vmi.Status.Memory == nil || vmi.Status.Memory.GuestCurrent == nil || !vmi.Status.Memory.GuestCurrent.Equal(*c.memory) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,11 @@
+package resize_test
+
+import (
+	"testing"
+
+	"kubevirt.io/client-go/testutils"
+)
+
+func TestResize(t *testing.T) {
+	testutils.KubeVirtTestSuiteSetup(t)
+}
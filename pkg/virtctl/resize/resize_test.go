@@ -0,0 +1,167 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package resize_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+	kubevirtfake "kubevirt.io/client-go/kubevirt/fake"
+
+	"kubevirt.io/kubevirt/pkg/libvmi"
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/virtctl/resize"
+	"kubevirt.io/kubevirt/pkg/virtctl/testing"
+)
+
+var _ = Describe("Resize", func() {
+	var virtClient *kubevirtfake.Clientset
+
+	BeforeEach(func() {
+		virtClient = kubevirtfake.NewSimpleClientset()
+
+		ctrl := gomock.NewController(GinkgoT())
+		kubecli.GetKubevirtClientFromClientConfig = kubecli.GetMockKubevirtClientFromClientConfig
+		kubecli.MockKubevirtClientInstance = kubecli.NewMockKubevirtClient(ctrl)
+		kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachine(metav1.NamespaceDefault).
+			Return(virtClient.KubevirtV1().VirtualMachines(metav1.NamespaceDefault)).AnyTimes()
+		kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachineInstance(metav1.NamespaceDefault).
+			Return(virtClient.KubevirtV1().VirtualMachineInstances(metav1.NamespaceDefault)).AnyTimes()
+
+		waitInterval := resize.WaitInterval
+		resize.WaitInterval = 10 * time.Millisecond
+		DeferCleanup(func() { resize.WaitInterval = waitInterval })
+	})
+
+	createVM := func(vm *v1.VirtualMachine) *v1.VirtualMachine {
+		vm, err := virtClient.KubevirtV1().VirtualMachines(metav1.NamespaceDefault).Create(context.Background(), vm, metav1.CreateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		return vm
+	}
+
+	getVM := func(name string) *v1.VirtualMachine {
+		vm, err := virtClient.KubevirtV1().VirtualMachines(metav1.NamespaceDefault).Get(context.Background(), name, metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		return vm
+	}
+
+	createVMI := func(sockets uint32, memory string) {
+		vmi := libvmi.New(libvmi.WithName("testvm"))
+		vmi.Status.CurrentCPUTopology = &v1.CPUTopology{Sockets: sockets, Cores: 2, Threads: 1}
+		vmi.Status.Memory = &v1.MemoryStatus{GuestCurrent: pointer.P(resource.MustParse(memory))}
+		_, err := virtClient.KubevirtV1().VirtualMachineInstances(metav1.NamespaceDefault).Create(context.Background(), vmi, metav1.CreateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	newVM := func(opts ...libvmi.Option) *v1.VirtualMachine {
+		return libvmi.NewVirtualMachine(libvmi.New(append([]libvmi.Option{libvmi.WithName("testvm")}, opts...)...))
+	}
+
+	DescribeTable("should fail", func(errMsg string, args ...string) {
+		createVM(newVM(libvmi.WithCPUCount(2, 1, 1)))
+		err := testing.NewRepeatableVirtctlCommand(append([]string{resize.COMMAND_RESIZE}, args...)...)()
+		Expect(err).To(MatchError(ContainSubstring(errMsg)))
+	},
+		Entry("with unsupported resource type", "unsupported resource type: vmi", "vmi", "testvm", "--cpu", "2"),
+		Entry("without resources", "at least one of --cpu or --memory is required", "vm", "testvm"),
+		Entry("with invalid memory", "invalid memory kaboom", "vm", "testvm", "--memory", "kaboom"),
+		Entry("with zero memory", "must be greater than zero", "vm", "testvm", "--memory", "0"),
+		Entry("with missing VM", "virtualmachines.kubevirt.io \"unknown\" not found", "vm", "unknown", "--cpu", "2"),
+		Entry("with vCPUs not fitting into sockets", "3 vCPUs can not be split in sockets of 2 vCPUs", "vm", "testvm", "--cpu", "3"),
+	)
+
+	It("should fail with VMs which have an instancetype", func() {
+		vm := newVM()
+		vm.Spec.Instancetype = &v1.InstancetypeMatcher{Name: "u1.small"}
+		createVM(vm)
+
+		err := testing.NewRepeatableVirtctlCommand(resize.COMMAND_RESIZE, "vm", "testvm", "--cpu", "2")()
+		Expect(err).To(MatchError(ContainSubstring("change the instancetype instead")))
+	})
+
+	It("should change the sockets and the guest memory", func() {
+		createVM(newVM(libvmi.WithCPUCount(2, 1, 1), libvmi.WithGuestMemory("1Gi")))
+
+		Expect(testing.NewRepeatableVirtctlCommand(resize.COMMAND_RESIZE, "vm", "testvm", "--cpu", "8", "--memory", "4Gi")()).To(Succeed())
+
+		domain := getVM("testvm").Spec.Template.Spec.Domain
+		Expect(domain.CPU.Sockets).To(Equal(uint32(4)))
+		Expect(domain.CPU.Cores).To(Equal(uint32(2)))
+		Expect(domain.Memory.Guest).To(HaveValue(Equal(resource.MustParse("4Gi"))))
+	})
+
+	It("should add the CPU and memory to VMs without them", func() {
+		createVM(newVM())
+
+		Expect(testing.NewRepeatableVirtctlCommand(resize.COMMAND_RESIZE, "vm", "testvm", "--cpu", "2", "--memory", "2Gi")()).To(Succeed())
+
+		domain := getVM("testvm").Spec.Template.Spec.Domain
+		Expect(domain.CPU).To(Equal(&v1.CPU{Sockets: 2}))
+		Expect(domain.Memory.Guest).To(HaveValue(Equal(resource.MustParse("2Gi"))))
+	})
+
+	Context("with --live", func() {
+		It("should succeed once the VMI reflects the new resources", func() {
+			createVM(newVM(libvmi.WithCPUCount(2, 1, 1), libvmi.WithGuestMemory("1Gi")))
+			createVMI(2, "4Gi")
+
+			Expect(testing.NewRepeatableVirtctlCommand(resize.COMMAND_RESIZE, "vm", "testvm", "--cpu", "4", "--memory", "4Gi", "--live")()).To(Succeed())
+		})
+
+		It("should time out if the VMI does not reflect the new resources", func() {
+			createVM(newVM(libvmi.WithCPUCount(2, 1, 1)))
+			createVMI(1, "1Gi")
+
+			err := testing.NewRepeatableVirtctlCommand(resize.COMMAND_RESIZE, "vm", "testvm", "--cpu", "4", "--live", "--timeout", "50ms")()
+			Expect(err).To(MatchError(ContainSubstring("error waiting for the VMI to reflect the new resources")))
+		})
+
+		It("should fail if the VM requires a restart", func() {
+			vm := newVM(libvmi.WithCPUCount(2, 1, 1))
+			vm.Status.Conditions = []v1.VirtualMachineCondition{{
+				Type:    v1.VirtualMachineRestartRequired,
+				Status:  k8sv1.ConditionTrue,
+				Message: "Reduction of CPU socket count requires a restart",
+			}}
+			createVM(vm)
+			createVMI(2, "1Gi")
+
+			err := testing.NewRepeatableVirtctlCommand(resize.COMMAND_RESIZE, "vm", "testvm", "--cpu", "2", "--live")()
+			Expect(err).To(MatchError(ContainSubstring("it needs to be restarted: Reduction of CPU socket count requires a restart")))
+		})
+
+		It("should fail if the VM is not running", func() {
+			createVM(newVM(libvmi.WithCPUCount(2, 1, 1)))
+
+			err := testing.NewRepeatableVirtctlCommand(resize.COMMAND_RESIZE, "vm", "testvm", "--cpu", "4", "--live")()
+			Expect(err).To(MatchError(ContainSubstring("the VM is not running")))
+		})
+	})
+})
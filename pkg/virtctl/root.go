@@ -18,20 +18,26 @@ import (
 
 	"kubevirt.io/kubevirt/pkg/virtctl/adm"
 	"kubevirt.io/kubevirt/pkg/virtctl/clientconfig"
+	"kubevirt.io/kubevirt/pkg/virtctl/clone"
 	"kubevirt.io/kubevirt/pkg/virtctl/configuration"
 	"kubevirt.io/kubevirt/pkg/virtctl/console"
 	"kubevirt.io/kubevirt/pkg/virtctl/create"
 	"kubevirt.io/kubevirt/pkg/virtctl/credentials"
+	"kubevirt.io/kubevirt/pkg/virtctl/diagnose"
 	"kubevirt.io/kubevirt/pkg/virtctl/expose"
 	"kubevirt.io/kubevirt/pkg/virtctl/guestfs"
 	"kubevirt.io/kubevirt/pkg/virtctl/imageupload"
 	"kubevirt.io/kubevirt/pkg/virtctl/memorydump"
 	"kubevirt.io/kubevirt/pkg/virtctl/pause"
+	"kubevirt.io/kubevirt/pkg/virtctl/pcap"
 	"kubevirt.io/kubevirt/pkg/virtctl/portforward"
 	"kubevirt.io/kubevirt/pkg/virtctl/reset"
+	"kubevirt.io/kubevirt/pkg/virtctl/resize"
 	"kubevirt.io/kubevirt/pkg/virtctl/scp"
+	"kubevirt.io/kubevirt/pkg/virtctl/snapshot"
 	"kubevirt.io/kubevirt/pkg/virtctl/softreboot"
 	"kubevirt.io/kubevirt/pkg/virtctl/ssh"
+	"kubevirt.io/kubevirt/pkg/virtctl/status"
 	"kubevirt.io/kubevirt/pkg/virtctl/templates"
 	"kubevirt.io/kubevirt/pkg/virtctl/unpause"
 	"kubevirt.io/kubevirt/pkg/virtctl/usbredir"
@@ -120,11 +126,17 @@ func NewVirtctlCommandFn() *cobra.Command {
 		vm.NewRemoveVolumeCommand(),
 		vm.NewExpandCommand(),
 		memorydump.NewMemoryDumpCommand(),
+		snapshot.NewCommand(),
+		clone.NewCommand(),
 		pause.NewCommand(),
 		unpause.NewCommand(),
 		softreboot.NewSoftRebootCommand(),
 		reset.NewResetCommand(),
 		expose.NewCommand(),
+		resize.NewCommand(),
+		diagnose.NewCommand(),
+		pcap.NewCommand(),
+		status.NewCommand(),
 		version.VersionCommand(),
 		imageupload.NewImageUploadCommand(),
 		guestfs.NewGuestfsShellCommand(),
@@ -75,6 +75,11 @@ func (o *SCP) Run(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
+	o.options.HostKeyAlias, err = ssh.HostKeyAlias(client, remote.Namespace, remote.Name)
+	if err != nil {
+		return err
+	}
+
 	if o.options.WrapLocalSSH {
 		clientArgs := o.buildSCPTarget(local, remote, toRemote)
 		return ssh.RunLocalClient(remote.Kind, remote.Namespace, remote.Name, &o.options, clientArgs)
@@ -97,6 +102,7 @@ type RemoteArgument struct {
 
 func PrepareCommand(cmd *cobra.Command, fallbackNamespace string, opts *ssh.SSHOptions, args []string) (*LocalArgument, *RemoteArgument, bool, error) {
 	opts.IdentityFilePathProvided = cmd.Flags().Changed(ssh.IdentityFilePathFlag)
+	opts.KnownHostsFilePathProvided = cmd.Flags().Changed(ssh.KnownHostsFilePathFlag)
 
 	local, remote, toRemote, err := ParseTarget(args[0], args[1])
 	if err != nil {
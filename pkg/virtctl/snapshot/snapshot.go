@@ -0,0 +1,346 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package snapshot
+
+import (
+	"context"
+	"fmt"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+	k8sv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "kubevirt.io/api/core/v1"
+	snapshotv1 "kubevirt.io/api/snapshot/v1beta1"
+	"kubevirt.io/client-go/kubecli"
+
+	virtwait "kubevirt.io/kubevirt/pkg/apimachinery/wait"
+	"kubevirt.io/kubevirt/pkg/virtctl/clientconfig"
+	"kubevirt.io/kubevirt/pkg/virtctl/templates"
+)
+
+const (
+	NameFlag     = "name"
+	SnapshotFlag = "snapshot"
+	WaitFlag     = "wait"
+	TimeoutFlag  = "timeout"
+
+	defaultTimeout = 5 * time.Minute
+	pollInterval   = 2 * time.Second
+)
+
+// WaitInterval allows overriding the interval used while waiting (useful for unit testing)
+var WaitInterval = pollInterval
+
+type createCommand struct {
+	name    string
+	wait    bool
+	timeout time.Duration
+}
+
+type restoreCommand struct {
+	name     string
+	snapshot string
+	wait     bool
+	timeout  time.Duration
+}
+
+// NewCommand returns the snapshot command with its create, list, restore and delete subcommands
+func NewCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "snapshot",
+		Short: "Manage snapshots of virtual machines.",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return fmt.Errorf("please provide a valid subcommand")
+		},
+	}
+
+	cmd.AddCommand(
+		newCreateCommand(),
+		newListCommand(),
+		newRestoreCommand(),
+		newDeleteCommand(),
+	)
+
+	cmd.SetUsageTemplate(templates.UsageTemplate())
+	return cmd
+}
+
+func newCreateCommand() *cobra.Command {
+	c := createCommand{}
+	cmd := &cobra.Command{
+		Use:   "create (VM)",
+		Short: "Create a snapshot of a virtual machine.",
+		Example: `  # Create a snapshot of the virtual machine 'myvm':
+  {{ProgramName}} snapshot create myvm
+
+  # Create a snapshot called 'mysnap' and wait until it is ready to use:
+  {{ProgramName}} snapshot create myvm --name mysnap --wait`,
+		Args: cobra.ExactArgs(1),
+		RunE: c.run,
+	}
+
+	cmd.Flags().StringVar(&c.name, NameFlag, "", "Name of the snapshot. Defaults to a name generated from the VM name.")
+	cmd.Flags().BoolVar(&c.wait, WaitFlag, false, "Wait until the snapshot is ready to use.")
+	cmd.Flags().DurationVar(&c.timeout, TimeoutFlag, defaultTimeout, "The time to wait for the snapshot to become ready to use.")
+	cmd.SetUsageTemplate(templates.UsageTemplate())
+	return cmd
+}
+
+func newListCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list [VM]",
+		Short: "List the snapshots in a namespace, optionally filtered by virtual machine.",
+		Example: `  # List the snapshots of the virtual machine 'myvm':
+  {{ProgramName}} snapshot list myvm`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: runList,
+	}
+	cmd.SetUsageTemplate(templates.UsageTemplate())
+	return cmd
+}
+
+func newRestoreCommand() *cobra.Command {
+	c := restoreCommand{}
+	cmd := &cobra.Command{
+		Use:   "restore (VM)",
+		Short: "Restore a virtual machine from a snapshot.",
+		Example: `  # Restore the virtual machine 'myvm' from the snapshot 'mysnap' and wait until the restore is complete:
+  {{ProgramName}} snapshot restore myvm --snapshot mysnap --wait`,
+		Args: cobra.ExactArgs(1),
+		RunE: c.run,
+	}
+
+	cmd.Flags().StringVar(&c.snapshot, SnapshotFlag, "", "Name of the snapshot to restore from.")
+	cmd.Flags().StringVar(&c.name, NameFlag, "", "Name of the restore. Defaults to a name generated from the VM name.")
+	cmd.Flags().BoolVar(&c.wait, WaitFlag, false, "Wait until the restore is complete.")
+	cmd.Flags().DurationVar(&c.timeout, TimeoutFlag, defaultTimeout, "The time to wait for the restore to complete.")
+	cmd.SetUsageTemplate(templates.UsageTemplate())
+	return cmd
+}
+
+func newDeleteCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delete (SNAPSHOT)",
+		Short: "Delete a snapshot.",
+		Example: `  # Delete the snapshot 'mysnap':
+  {{ProgramName}} snapshot delete mysnap`,
+		Args: cobra.ExactArgs(1),
+		RunE: runDelete,
+	}
+	cmd.SetUsageTemplate(templates.UsageTemplate())
+	return cmd
+}
+
+func (c *createCommand) run(cmd *cobra.Command, args []string) error {
+	vmName := args[0]
+
+	virtClient, namespace, _, err := clientconfig.ClientAndNamespaceFromContext(cmd.Context())
+	if err != nil {
+		return fmt.Errorf("cannot obtain KubeVirt client: %v", err)
+	}
+
+	snapshot := &snapshotv1.VirtualMachineSnapshot{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: namespace,
+		},
+		Spec: snapshotv1.VirtualMachineSnapshotSpec{
+			Source: k8sv1.TypedLocalObjectReference{
+				APIGroup: &v1.SchemeGroupVersion.Group,
+				Kind:     v1.VirtualMachineGroupVersionKind.Kind,
+				Name:     vmName,
+			},
+		},
+	}
+	if c.name != "" {
+		snapshot.Name = c.name
+	} else {
+		snapshot.GenerateName = fmt.Sprintf("%s-snapshot-", vmName)
+	}
+
+	snapshot, err = virtClient.VirtualMachineSnapshot(namespace).Create(cmd.Context(), snapshot, metav1.CreateOptions{})
+	if err != nil {
+		return fmt.Errorf("error creating VirtualMachineSnapshot for VM %s: %v", vmName, err)
+	}
+	cmd.Printf("VirtualMachineSnapshot %s/%s created\n", namespace, snapshot.Name)
+
+	if !c.wait {
+		return nil
+	}
+	return waitForSnapshotReady(cmd, virtClient, namespace, snapshot.Name, c.timeout)
+}
+
+func waitForSnapshotReady(cmd *cobra.Command, virtClient kubecli.KubevirtClient, namespace, name string, timeout time.Duration) error {
+	return virtwait.PollImmediately(WaitInterval, timeout, func(ctx context.Context) (bool, error) {
+		snapshot, err := virtClient.VirtualMachineSnapshot(namespace).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return false, err
+		}
+		if snapshot.Status == nil {
+			return false, nil
+		}
+		if snapshot.Status.Phase == snapshotv1.Failed {
+			return false, fmt.Errorf("VirtualMachineSnapshot %s failed: %s", name, errorMessage(snapshot.Status.Error))
+		}
+		if snapshot.Status.ReadyToUse == nil || !*snapshot.Status.ReadyToUse {
+			cmd.Printf("Waiting for VirtualMachineSnapshot %s to be ready, current phase: %s\n", name, snapshot.Status.Phase)
+			return false, nil
+		}
+		cmd.Printf("VirtualMachineSnapshot %s is ready to use\n", name)
+		return true, nil
+	})
+}
+
+func runList(cmd *cobra.Command, args []string) error {
+	virtClient, namespace, _, err := clientconfig.ClientAndNamespaceFromContext(cmd.Context())
+	if err != nil {
+		return fmt.Errorf("cannot obtain KubeVirt client: %v", err)
+	}
+
+	list, err := virtClient.VirtualMachineSnapshot(namespace).List(cmd.Context(), metav1.ListOptions{})
+	if err != nil {
+		return fmt.Errorf("error listing VirtualMachineSnapshots: %v", err)
+	}
+
+	w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 0, 3, ' ', 0)
+	fmt.Fprintln(w, "NAME\tSOURCE\tPHASE\tREADYTOUSE\tCREATIONTIME")
+	for _, snapshot := range list.Items {
+		if len(args) == 1 && snapshot.Spec.Source.Name != args[0] {
+			continue
+		}
+		phase, readyToUse, creationTime := snapshotv1.PhaseUnset, false, ""
+		if snapshot.Status != nil {
+			phase = snapshot.Status.Phase
+			readyToUse = snapshot.Status.ReadyToUse != nil && *snapshot.Status.ReadyToUse
+			if snapshot.Status.CreationTime != nil {
+				creationTime = snapshot.Status.CreationTime.UTC().Format(time.RFC3339)
+			}
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%t\t%s\n", snapshot.Name, snapshot.Spec.Source.Name, phase, readyToUse, creationTime)
+	}
+	return w.Flush()
+}
+
+func (c *restoreCommand) run(cmd *cobra.Command, args []string) error {
+	vmName := args[0]
+	if c.snapshot == "" {
+		return fmt.Errorf("missing snapshot name, use the --%s flag", SnapshotFlag)
+	}
+
+	virtClient, namespace, _, err := clientconfig.ClientAndNamespaceFromContext(cmd.Context())
+	if err != nil {
+		return fmt.Errorf("cannot obtain KubeVirt client: %v", err)
+	}
+
+	restore := &snapshotv1.VirtualMachineRestore{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: namespace,
+		},
+		Spec: snapshotv1.VirtualMachineRestoreSpec{
+			Target: k8sv1.TypedLocalObjectReference{
+				APIGroup: &v1.SchemeGroupVersion.Group,
+				Kind:     v1.VirtualMachineGroupVersionKind.Kind,
+				Name:     vmName,
+			},
+			VirtualMachineSnapshotName: c.snapshot,
+		},
+	}
+	if c.name != "" {
+		restore.Name = c.name
+	} else {
+		restore.GenerateName = fmt.Sprintf("%s-restore-", vmName)
+	}
+
+	restore, err = virtClient.VirtualMachineRestore(namespace).Create(cmd.Context(), restore, metav1.CreateOptions{})
+	if err != nil {
+		return fmt.Errorf("error creating VirtualMachineRestore for VM %s: %v", vmName, err)
+	}
+	cmd.Printf("VirtualMachineRestore %s/%s created\n", namespace, restore.Name)
+
+	if !c.wait {
+		return nil
+	}
+	return waitForRestoreComplete(cmd, virtClient, namespace, restore.Name, c.timeout)
+}
+
+func waitForRestoreComplete(cmd *cobra.Command, virtClient kubecli.KubevirtClient, namespace, name string, timeout time.Duration) error {
+	return virtwait.PollImmediately(WaitInterval, timeout, func(ctx context.Context) (bool, error) {
+		restore, err := virtClient.VirtualMachineRestore(namespace).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return false, err
+		}
+		if failed, reason := restoreFailed(restore); failed {
+			return false, fmt.Errorf("VirtualMachineRestore %s failed: %s", name, reason)
+		}
+		if restore.Status == nil || restore.Status.Complete == nil || !*restore.Status.Complete {
+			cmd.Printf("Waiting for VirtualMachineRestore %s to complete\n", name)
+			return false, nil
+		}
+		cmd.Printf("VirtualMachineRestore %s is complete\n", name)
+		return true, nil
+	})
+}
+
+// restoreFailed reports whether the restore controller gave up on the restore,
+// together with the reason of the Failure condition or, lacking one, of the Ready condition
+func restoreFailed(restore *snapshotv1.VirtualMachineRestore) (bool, string) {
+	if restore.Status == nil {
+		return false, ""
+	}
+	failed, reason := false, ""
+	for _, condition := range restore.Status.Conditions {
+		switch {
+		case condition.Type == snapshotv1.ConditionFailure && condition.Status == k8sv1.ConditionTrue:
+			failed = true
+			if condition.Reason != "" {
+				reason = condition.Reason
+			}
+		case condition.Type == snapshotv1.ConditionReady && condition.Status == k8sv1.ConditionFalse:
+			if reason == "" {
+				reason = condition.Reason
+			}
+		}
+	}
+	return failed, reason
+}
+
+func runDelete(cmd *cobra.Command, args []string) error {
+	name := args[0]
+
+	virtClient, namespace, _, err := clientconfig.ClientAndNamespaceFromContext(cmd.Context())
+	if err != nil {
+		return fmt.Errorf("cannot obtain KubeVirt client: %v", err)
+	}
+
+	if err := virtClient.VirtualMachineSnapshot(namespace).Delete(cmd.Context(), name, metav1.DeleteOptions{}); err != nil {
+		return fmt.Errorf("error deleting VirtualMachineSnapshot %s: %v", name, err)
+	}
+	cmd.Printf("VirtualMachineSnapshot %s/%s deleted\n", namespace, name)
+	return nil
+}
+
+func errorMessage(snapshotErr *snapshotv1.Error) string {
+	if snapshotErr == nil || snapshotErr.Message == nil {
+		return "unknown error"
+	}
+	return *snapshotErr.Message
+}
@@ -0,0 +1,11 @@
+package snapshot_test
+
+import (
+	"testing"
+
+	"kubevirt.io/client-go/testutils"
+)
+
+func TestSnapshot(t *testing.T) {
+	testutils.KubeVirtTestSuiteSetup(t)
+}
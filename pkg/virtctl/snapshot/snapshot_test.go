@@ -0,0 +1,212 @@
+package snapshot_test
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	k8sv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	k8stesting "k8s.io/client-go/testing"
+
+	snapshotv1 "kubevirt.io/api/snapshot/v1beta1"
+	"kubevirt.io/client-go/kubecli"
+	kubevirtfake "kubevirt.io/client-go/kubevirt/fake"
+
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/virtctl/snapshot"
+	"kubevirt.io/kubevirt/pkg/virtctl/testing"
+)
+
+var _ = Describe("Snapshot", func() {
+	const (
+		vmName       = "test-vm"
+		snapshotName = "test-snapshot"
+		restoreName  = "test-restore"
+	)
+
+	var virtClient *kubevirtfake.Clientset
+
+	BeforeEach(func() {
+		virtClient = kubevirtfake.NewSimpleClientset()
+
+		ctrl := gomock.NewController(GinkgoT())
+		kubecli.GetKubevirtClientFromClientConfig = kubecli.GetMockKubevirtClientFromClientConfig
+		kubecli.MockKubevirtClientInstance = kubecli.NewMockKubevirtClient(ctrl)
+		kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachineSnapshot(metav1.NamespaceDefault).
+			Return(virtClient.SnapshotV1beta1().VirtualMachineSnapshots(metav1.NamespaceDefault)).AnyTimes()
+		kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachineRestore(metav1.NamespaceDefault).
+			Return(virtClient.SnapshotV1beta1().VirtualMachineRestores(metav1.NamespaceDefault)).AnyTimes()
+
+		snapshot.WaitInterval = 10 * time.Millisecond
+	})
+
+	newSnapshot := func(name, source string, status *snapshotv1.VirtualMachineSnapshotStatus) *snapshotv1.VirtualMachineSnapshot {
+		s := &snapshotv1.VirtualMachineSnapshot{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: metav1.NamespaceDefault,
+			},
+			Status: status,
+		}
+		s.Spec.Source.Name = source
+		return s
+	}
+
+	setSnapshotStatusOnGet := func(status *snapshotv1.VirtualMachineSnapshotStatus) {
+		virtClient.PrependReactor("get", "virtualmachinesnapshots", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			return true, newSnapshot(action.(k8stesting.GetAction).GetName(), vmName, status), nil
+		})
+	}
+
+	DescribeTable("should fail with missing or invalid arguments", func(errorString string, args ...string) {
+		Expect(testing.NewRepeatableVirtctlCommand(args...)()).To(MatchError(ContainSubstring(errorString)))
+	},
+		Entry("no subcommand", "please provide a valid subcommand", "snapshot"),
+		Entry("create without vm", "accepts 1 arg(s), received 0", "snapshot", "create"),
+		Entry("restore without vm", "accepts 1 arg(s), received 0", "snapshot", "restore"),
+		Entry("restore without snapshot", "missing snapshot name", "snapshot", "restore", vmName),
+		Entry("delete without snapshot", "accepts 1 arg(s), received 0", "snapshot", "delete"),
+		Entry("list with too many args", "accepts at most 1 arg(s), received 2", "snapshot", "list", vmName, vmName),
+	)
+
+	Context("create", func() {
+		It("should create a snapshot of the VM", func() {
+			Expect(testing.NewRepeatableVirtctlCommand("snapshot", "create", vmName, setFlag(snapshot.NameFlag, snapshotName))()).To(Succeed())
+
+			s, err := virtClient.SnapshotV1beta1().VirtualMachineSnapshots(metav1.NamespaceDefault).Get(context.Background(), snapshotName, metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(s.Spec.Source.Name).To(Equal(vmName))
+			Expect(s.Spec.Source.Kind).To(Equal("VirtualMachine"))
+			Expect(s.Spec.Source.APIGroup).To(HaveValue(Equal("kubevirt.io")))
+		})
+
+		It("should use a generated name if none is given", func() {
+			virtClient.PrependReactor("create", "virtualmachinesnapshots", func(action k8stesting.Action) (bool, runtime.Object, error) {
+				s := action.(k8stesting.CreateAction).GetObject().(*snapshotv1.VirtualMachineSnapshot)
+				Expect(s.Name).To(BeEmpty())
+				Expect(s.GenerateName).To(Equal(vmName + "-snapshot-"))
+				return true, s, nil
+			})
+			Expect(testing.NewRepeatableVirtctlCommand("snapshot", "create", vmName)()).To(Succeed())
+		})
+
+		It("should wait until the snapshot is ready to use", func() {
+			setSnapshotStatusOnGet(&snapshotv1.VirtualMachineSnapshotStatus{
+				Phase:      snapshotv1.Succeeded,
+				ReadyToUse: pointer.P(true),
+			})
+			Expect(testing.NewRepeatableVirtctlCommand("snapshot", "create", vmName, setFlag(snapshot.NameFlag, snapshotName), "--wait")()).To(Succeed())
+		})
+
+		It("should fail waiting if the snapshot failed", func() {
+			setSnapshotStatusOnGet(&snapshotv1.VirtualMachineSnapshotStatus{
+				Phase: snapshotv1.Failed,
+				Error: &snapshotv1.Error{Message: pointer.P("boom")},
+			})
+			err := testing.NewRepeatableVirtctlCommand("snapshot", "create", vmName, setFlag(snapshot.NameFlag, snapshotName), "--wait")()
+			Expect(err).To(MatchError(ContainSubstring("boom")))
+		})
+
+		It("should time out waiting if the snapshot does not become ready", func() {
+			setSnapshotStatusOnGet(&snapshotv1.VirtualMachineSnapshotStatus{
+				Phase: snapshotv1.InProgress,
+			})
+			err := testing.NewRepeatableVirtctlCommand("snapshot", "create", vmName,
+				setFlag(snapshot.NameFlag, snapshotName), "--wait", setFlag(snapshot.TimeoutFlag, "50ms"))()
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Context("list", func() {
+		BeforeEach(func() {
+			for _, s := range []*snapshotv1.VirtualMachineSnapshot{
+				newSnapshot("snap-a", vmName, &snapshotv1.VirtualMachineSnapshotStatus{Phase: snapshotv1.Succeeded, ReadyToUse: pointer.P(true)}),
+				newSnapshot("snap-b", "other-vm", nil),
+			} {
+				_, err := virtClient.SnapshotV1beta1().VirtualMachineSnapshots(metav1.NamespaceDefault).Create(context.Background(), s, metav1.CreateOptions{})
+				Expect(err).ToNot(HaveOccurred())
+			}
+		})
+
+		It("should list all snapshots in the namespace", func() {
+			out, err := testing.NewRepeatableVirtctlCommandWithOut("snapshot", "list")()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(out)).To(ContainSubstring("snap-a"))
+			Expect(string(out)).To(ContainSubstring("snap-b"))
+		})
+
+		It("should only list snapshots of the given VM", func() {
+			out, err := testing.NewRepeatableVirtctlCommandWithOut("snapshot", "list", vmName)()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(out)).To(MatchRegexp(`snap-a\s+test-vm\s+Succeeded\s+true`))
+			Expect(string(out)).ToNot(ContainSubstring("snap-b"))
+		})
+	})
+
+	Context("restore", func() {
+		It("should create a restore targeting the VM", func() {
+			Expect(testing.NewRepeatableVirtctlCommand("snapshot", "restore", vmName,
+				setFlag(snapshot.SnapshotFlag, snapshotName), setFlag(snapshot.NameFlag, restoreName))()).To(Succeed())
+
+			r, err := virtClient.SnapshotV1beta1().VirtualMachineRestores(metav1.NamespaceDefault).Get(context.Background(), restoreName, metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(r.Spec.Target.Name).To(Equal(vmName))
+			Expect(r.Spec.VirtualMachineSnapshotName).To(Equal(snapshotName))
+		})
+
+		It("should wait until the restore is complete", func() {
+			virtClient.PrependReactor("get", "virtualmachinerestores", func(action k8stesting.Action) (bool, runtime.Object, error) {
+				return true, &snapshotv1.VirtualMachineRestore{
+					ObjectMeta: metav1.ObjectMeta{Name: restoreName, Namespace: metav1.NamespaceDefault},
+					Status:     &snapshotv1.VirtualMachineRestoreStatus{Complete: pointer.P(true)},
+				}, nil
+			})
+			Expect(testing.NewRepeatableVirtctlCommand("snapshot", "restore", vmName,
+				setFlag(snapshot.SnapshotFlag, snapshotName), setFlag(snapshot.NameFlag, restoreName), "--wait")()).To(Succeed())
+		})
+
+		It("should fail when the restore failed", func() {
+			virtClient.PrependReactor("get", "virtualmachinerestores", func(action k8stesting.Action) (bool, runtime.Object, error) {
+				return true, &snapshotv1.VirtualMachineRestore{
+					ObjectMeta: metav1.ObjectMeta{Name: restoreName, Namespace: metav1.NamespaceDefault},
+					Status: &snapshotv1.VirtualMachineRestoreStatus{
+						Complete: pointer.P(false),
+						Conditions: []snapshotv1.Condition{
+							{Type: snapshotv1.ConditionReady, Status: k8sv1.ConditionFalse, Reason: "Operation failed"},
+							{Type: snapshotv1.ConditionFailure, Status: k8sv1.ConditionTrue, Reason: "Restore target failed to be ready within 5m0s"},
+						},
+					},
+				}, nil
+			})
+			Expect(testing.NewRepeatableVirtctlCommand("snapshot", "restore", vmName,
+				setFlag(snapshot.SnapshotFlag, snapshotName), setFlag(snapshot.NameFlag, restoreName), "--wait")()).To(
+				MatchError(ContainSubstring("VirtualMachineRestore test-restore failed: Restore target failed to be ready within 5m0s")))
+		})
+	})
+
+	Context("delete", func() {
+		It("should delete the snapshot", func() {
+			_, err := virtClient.SnapshotV1beta1().VirtualMachineSnapshots(metav1.NamespaceDefault).Create(context.Background(), newSnapshot(snapshotName, vmName, nil), metav1.CreateOptions{})
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(testing.NewRepeatableVirtctlCommand("snapshot", "delete", snapshotName)()).To(Succeed())
+
+			_, err = virtClient.SnapshotV1beta1().VirtualMachineSnapshots(metav1.NamespaceDefault).Get(context.Background(), snapshotName, metav1.GetOptions{})
+			Expect(err).To(MatchError(ContainSubstring("not found")))
+		})
+
+		It("should fail if the snapshot does not exist", func() {
+			Expect(testing.NewRepeatableVirtctlCommand("snapshot", "delete", snapshotName)()).To(MatchError(ContainSubstring("not found")))
+		})
+	})
+})
+
+func setFlag(flag, parameter string) string {
+	return fmt.Sprintf("--%s=%s", flag, parameter)
+}
@@ -58,6 +58,10 @@ func (o *NativeSSHConnection) PrepareSSHClient(kind, namespace, name string) (*s
 
 	conn := streamer.AsConn()
 	addr := fmt.Sprintf("%s/%s.%s:%d", kind, name, namespace, o.Options.SSHPort)
+	if o.Options.HostKeyAlias != "" {
+		// the port is left out like the local ssh client does for a HostKeyAlias
+		addr = net.JoinHostPort(o.Options.HostKeyAlias, "22")
+	}
 	authMethods := o.getAuthMethods(kind, namespace, name)
 
 	hostKeyCallback := ssh.InsecureIgnoreHostKey()
@@ -199,21 +203,5 @@ func (o *NativeSSHConnection) StartSession(client *ssh.Client, command string) e
 }
 
 func (o *NativeSSHConnection) prepareSSHTunnel(kind, namespace, name string) (kvcorev1.StreamInterface, error) {
-	var (
-		stream kvcorev1.StreamInterface
-		err    error
-	)
-	if kind == "vmi" {
-		stream, err = o.Client.VirtualMachineInstance(namespace).PortForward(name, o.Options.SSHPort, "tcp")
-		if err != nil {
-			return nil, fmt.Errorf("can't access VMI %s: %w", name, err)
-		}
-	} else if kind == "vm" {
-		stream, err = o.Client.VirtualMachine(namespace).PortForward(name, o.Options.SSHPort, "tcp")
-		if err != nil {
-			return nil, fmt.Errorf("can't access VM %s: %w", name, err)
-		}
-	}
-
-	return stream, nil
+	return portForwardSSH(o.Client, kind, namespace, name, o.Options.SSHPort)
 }
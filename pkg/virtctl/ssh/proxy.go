@@ -0,0 +1,103 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package ssh
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"kubevirt.io/client-go/kubecli"
+	kvcorev1 "kubevirt.io/client-go/kubevirt/typed/core/v1"
+)
+
+// HostKeyAlias returns the name the host key of the target VMI is stored under in the known_hosts file.
+// Keying the host key by the VMI UID avoids host key mismatches when a VMI is recreated with the same name.
+func HostKeyAlias(client kubecli.KubevirtClient, namespace, name string) (string, error) {
+	vmi, err := client.VirtualMachineInstance(namespace).Get(context.Background(), name, metav1.GetOptions{})
+	if err != nil {
+		return "", fmt.Errorf("can't access VMI %s: %w", name, err)
+	}
+	return fmt.Sprintf("vmi-%s", vmi.UID), nil
+}
+
+// ParseHostTarget parses the target as it is passed to a ProxyCommand by a local ssh client. Next to the
+// forms supported by ParseTarget it accepts the host form kind.name[.namespace] which is built by this command.
+func ParseHostTarget(arg string) (kind, namespace, name string, err error) {
+	if at := strings.LastIndex(arg, "@"); at > -1 {
+		arg = arg[at+1:]
+	}
+	if strings.Contains(arg, "/") {
+		kind, namespace, name, _, err = ParseTarget(arg)
+		return kind, namespace, name, err
+	}
+
+	parts := strings.SplitN(arg, ".", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", "", errors.New("target must be of the form kind.name[.namespace]")
+	}
+	kind, name = parts[0], parts[1]
+	if lastDot := strings.LastIndex(name, "."); lastDot > -1 {
+		name, namespace = name[:lastDot], name[lastDot+1:]
+	}
+	switch strings.ToLower(kind) {
+	case "vm", "vms", "virtualmachine", "virtualmachines":
+		kind = "vm"
+	case "vmi", "vmis", "virtualmachineinstance", "virtualmachineinstances":
+		kind = "vmi"
+	default:
+		return "", "", "", fmt.Errorf("unsupported resource type '%s'", kind)
+	}
+	return kind, namespace, name, nil
+}
+
+func portForwardSSH(client kubecli.KubevirtClient, kind, namespace, name string, port int) (kvcorev1.StreamInterface, error) {
+	switch kind {
+	case "vmi":
+		stream, err := client.VirtualMachineInstance(namespace).PortForward(name, port, "tcp")
+		if err != nil {
+			return nil, fmt.Errorf("can't access VMI %s: %w", name, err)
+		}
+		return stream, nil
+	case "vm":
+		stream, err := client.VirtualMachine(namespace).PortForward(name, port, "tcp")
+		if err != nil {
+			return nil, fmt.Errorf("can't access VM %s: %w", name, err)
+		}
+		return stream, nil
+	}
+	return nil, fmt.Errorf("unsupported resource type '%s'", kind)
+}
+
+// forwardStdio tunnels stdin and stdout to the SSH port of the target
+func (o *SSH) forwardStdio(client kubecli.KubevirtClient, kind, namespace, name string) error {
+	stream, err := portForwardSSH(client, kind, namespace, name, o.options.SSHPort)
+	if err != nil {
+		return err
+	}
+	return stream.Stream(kvcorev1.StreamOptions{
+		In:  os.Stdin,
+		Out: os.Stdout,
+	})
+}
@@ -40,9 +40,10 @@ const (
 	wrapLocalSSHFlag                                = "local-ssh"
 	usernameFlag, usernameFlagShort                 = "username", "l"
 	IdentityFilePathFlag, identityFilePathFlagShort = "identity-file", "i"
-	knownHostsFilePathFlag                          = "known-hosts"
+	KnownHostsFilePathFlag                          = "known-hosts"
 	commandToExecute, commandToExecuteShort         = "command", "c"
 	additionalOpts, additionalOptsShort             = "local-ssh-opts", "t"
+	stdioFlag                                       = "stdio"
 )
 
 func NewCommand() *cobra.Command {
@@ -62,6 +63,8 @@ func NewCommand() *cobra.Command {
 	AddCommandlineArgs(cmd.Flags(), &c.options)
 	cmd.Flags().StringVarP(&c.command, commandToExecute, commandToExecuteShort, c.command,
 		fmt.Sprintf(`--%s='ls /': Specify a command to execute in the VM`, commandToExecute))
+	cmd.Flags().BoolVar(&c.stdio, stdioFlag, c.stdio,
+		fmt.Sprintf("--%s=true: Forward the SSH port of the VM to stdin/stdout instead of connecting to it, to act as ProxyCommand of a local ssh, scp or rsync; The target may be given as kind.name.namespace", stdioFlag))
 	cmd.SetUsageTemplate(templates.UsageTemplate())
 	return cmd
 }
@@ -71,8 +74,8 @@ func AddCommandlineArgs(flagset *pflag.FlagSet, opts *SSHOptions) {
 		fmt.Sprintf("--%s=%s: Set this to the user you want to open the SSH connection as; If unassigned, this will be empty and the SSH default will apply", usernameFlag, opts.SSHUsername))
 	flagset.StringVarP(&opts.IdentityFilePath, IdentityFilePathFlag, identityFilePathFlagShort, opts.IdentityFilePath,
 		fmt.Sprintf("--%s=/home/jdoe/.ssh/id_rsa: Set the path to a private key used for authenticating to the server; If not provided, the client will try to use the local ssh-agent at $SSH_AUTH_SOCK", IdentityFilePathFlag))
-	flagset.StringVar(&opts.KnownHostsFilePath, knownHostsFilePathFlag, opts.KnownHostsFilePathDefault,
-		fmt.Sprintf("--%s=/home/jdoe/.ssh/kubevirt_known_hosts: Set the path to the known_hosts file.", knownHostsFilePathFlag))
+	flagset.StringVar(&opts.KnownHostsFilePath, KnownHostsFilePathFlag, opts.KnownHostsFilePathDefault,
+		fmt.Sprintf("--%s=/home/jdoe/.ssh/kubevirt_known_hosts: Set the path to the known_hosts file.", KnownHostsFilePathFlag))
 	flagset.IntVarP(&opts.SSHPort, portFlag, portFlagShort, opts.SSHPort,
 		fmt.Sprintf(`--%s=22: Specify a port on the VM to send SSH traffic to`, portFlag))
 
@@ -85,15 +88,16 @@ func DefaultSSHOptions() SSHOptions {
 		log.Log.Warningf("failed to determine user home directory: %v", err)
 	}
 	options := SSHOptions{
-		SSHPort:                   22,
-		SSHUsername:               defaultUsername(),
-		IdentityFilePath:          filepath.Join(homeDir, ".ssh", "id_rsa"),
-		IdentityFilePathProvided:  false,
-		KnownHostsFilePath:        "",
-		KnownHostsFilePathDefault: "",
-		AdditionalSSHLocalOptions: []string{},
-		WrapLocalSSH:              true,
-		LocalClientName:           "ssh",
+		SSHPort:                    22,
+		SSHUsername:                defaultUsername(),
+		IdentityFilePath:           filepath.Join(homeDir, ".ssh", "id_rsa"),
+		IdentityFilePathProvided:   false,
+		KnownHostsFilePath:         "",
+		KnownHostsFilePathDefault:  "",
+		KnownHostsFilePathProvided: false,
+		AdditionalSSHLocalOptions:  []string{},
+		WrapLocalSSH:               true,
+		LocalClientName:            "ssh",
 	}
 
 	if len(homeDir) > 0 {
@@ -105,18 +109,22 @@ func DefaultSSHOptions() SSHOptions {
 type SSH struct {
 	options SSHOptions
 	command string
+	stdio   bool
 }
 
 type SSHOptions struct {
-	SSHPort                   int
-	SSHUsername               string
-	IdentityFilePath          string
-	IdentityFilePathProvided  bool
-	KnownHostsFilePath        string
-	KnownHostsFilePathDefault string
-	AdditionalSSHLocalOptions []string
-	WrapLocalSSH              bool
-	LocalClientName           string
+	SSHPort                    int
+	SSHUsername                string
+	IdentityFilePath           string
+	IdentityFilePathProvided   bool
+	KnownHostsFilePath         string
+	KnownHostsFilePathDefault  string
+	KnownHostsFilePathProvided bool
+	AdditionalSSHLocalOptions  []string
+	WrapLocalSSH               bool
+	LocalClientName            string
+	// HostKeyAlias is the name the host key of the target is verified against in the known_hosts file
+	HostKeyAlias string
 }
 
 func (o *SSH) Run(cmd *cobra.Command, args []string) error {
@@ -125,11 +133,27 @@ func (o *SSH) Run(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
+	if o.stdio {
+		kind, targetNamespace, name, err := ParseHostTarget(args[0])
+		if err != nil {
+			return err
+		}
+		if targetNamespace != "" {
+			namespace = targetNamespace
+		}
+		return o.forwardStdio(client, kind, namespace, name)
+	}
+
 	kind, namespace, name, err := PrepareCommand(cmd, namespace, &o.options, args)
 	if err != nil {
 		return err
 	}
 
+	o.options.HostKeyAlias, err = HostKeyAlias(client, namespace, name)
+	if err != nil {
+		return err
+	}
+
 	if cmd.Flags().Changed(wrapLocalSSHFlag) {
 		cmd.PrintErrln("The --local-ssh flag is deprecated and now defaults to true.")
 	}
@@ -143,6 +167,7 @@ func (o *SSH) Run(cmd *cobra.Command, args []string) error {
 
 func PrepareCommand(cmd *cobra.Command, fallbackNamespace string, opts *SSHOptions, args []string) (kind, namespace, name string, err error) {
 	opts.IdentityFilePathProvided = cmd.Flags().Changed(IdentityFilePathFlag)
+	opts.KnownHostsFilePathProvided = cmd.Flags().Changed(KnownHostsFilePathFlag)
 	var targetUsername string
 	kind, namespace, name, targetUsername, err = ParseTarget(args[0])
 	if err != nil {
@@ -167,10 +192,18 @@ func usage() string {
   {{ProgramName}} ssh jdoe@vm/testvm/mynamespace [--%s]
 
   # Specify a username and namespace:
-  {{ProgramName}} ssh --namespace=mynamespace --%s=jdoe vmi/testvmi`,
+  {{ProgramName}} ssh --namespace=mynamespace --%s=jdoe vmi/testvmi
+
+  # Let ssh, scp and rsync reach VMs as vm.<name>.<namespace> by adding to ~/.ssh/config:
+  #   Host vm.* vmi.*
+  #     ProxyCommand {{ProgramName}} ssh --%s=true --%s=%%p %%h
+  ssh jdoe@vm.testvm.mynamespace
+  rsync -a ./dir/ jdoe@vm.testvm.mynamespace:/tmp/dir`,
 		IdentityFilePathFlag,
 		IdentityFilePathFlag,
 		usernameFlag,
+		stdioFlag,
+		portFlag,
 	) + additionalUsage()
 }
 
@@ -1,8 +1,16 @@
 package ssh_test
 
 import (
+	"context"
+
+	"github.com/golang/mock/gomock"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"kubevirt.io/client-go/api"
+	"kubevirt.io/client-go/kubecli"
+	kubevirtfake "kubevirt.io/client-go/kubevirt/fake"
 
 	"kubevirt.io/kubevirt/pkg/virtctl/ssh"
 )
@@ -62,4 +70,41 @@ var _ = Describe("SSH", func() {
 		Entry("kind vm with name and namespace (legacy)", "vm/testvm.default", "default", "testvm", "vm", "", ""),
 		Entry("kind vm with name and namespace and username (legacy)", "user@vm/testvm.default", "default", "testvm", "vm", "user", ""),
 	)
+
+	DescribeTable("ParseHostTarget", func(arg, targetNamespace, targetName, targetKind, expectedError string) {
+		kind, namespace, name, err := ssh.ParseHostTarget(arg)
+		if expectedError != "" {
+			Expect(err).To(MatchError(expectedError))
+			return
+		}
+		Expect(err).NotTo(HaveOccurred())
+		Expect(namespace).To(Equal(targetNamespace))
+		Expect(name).To(Equal(targetName))
+		Expect(kind).To(Equal(targetKind))
+	},
+		Entry("host form with namespace", "vm.testvm.default", "default", "testvm", "vm", ""),
+		Entry("host form without namespace", "vmi.testvmi", "", "testvmi", "vmi", ""),
+		Entry("host form with username", "user@vmi.testvmi.default", "default", "testvmi", "vmi", ""),
+		Entry("host form with long kind", "virtualmachine.testvm.default", "default", "testvm", "vm", ""),
+		Entry("target form", "vm/testvm/default", "default", "testvm", "vm", ""),
+		Entry("host form without name", "vm.", "", "", "", "target must be of the form kind.name[.namespace]"),
+		Entry("host form with invalid kind", "pod.testpod.default", "", "", "", "unsupported resource type 'pod'"),
+	)
+
+	It("HostKeyAlias should be keyed by the VMI UID", func() {
+		vmi := api.NewMinimalVMI("testvmi")
+		vmi.UID = "1234"
+		virtClient := kubecli.NewMockKubevirtClient(gomock.NewController(GinkgoT()))
+		kubevirtClient := kubevirtfake.NewSimpleClientset()
+		virtClient.EXPECT().VirtualMachineInstance(metav1.NamespaceDefault).Return(
+			kubevirtClient.KubevirtV1().VirtualMachineInstances(metav1.NamespaceDefault),
+		).AnyTimes()
+
+		_, err := ssh.HostKeyAlias(virtClient, metav1.NamespaceDefault, vmi.Name)
+		Expect(err).To(MatchError(ContainSubstring("can't access VMI testvmi")))
+
+		_, err = kubevirtClient.KubevirtV1().VirtualMachineInstances(metav1.NamespaceDefault).Create(context.Background(), vmi, metav1.CreateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(ssh.HostKeyAlias(virtClient, metav1.NamespaceDefault, vmi.Name)).To(Equal("vmi-1234"))
+	})
 })
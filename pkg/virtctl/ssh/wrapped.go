@@ -24,6 +24,13 @@ func RunLocalClient(kind, namespace, name string, options *SSHOptions, clientArg
 	if options.IdentityFilePathProvided {
 		args = append(args, "-i", options.IdentityFilePath)
 	}
+	if options.HostKeyAlias != "" {
+		args = append(args, "-o", "HostKeyAlias="+options.HostKeyAlias)
+	}
+	// The local client keeps using the known_hosts files of the user unless another one is requested
+	if options.KnownHostsFilePathProvided {
+		args = append(args, "-o", "UserKnownHostsFile="+options.KnownHostsFilePath)
+	}
 
 	args = append(args, clientArgs...)
 
@@ -60,4 +60,36 @@ var _ = Describe("Wrapped SSH", func() {
 		err := RunLocalClient(fakeKind, fakeNamespace, fakeName, &ssh.options, clientArgs)
 		Expect(err).ShouldNot(HaveOccurred())
 	})
+
+	It("RunLocalClient should verify the host key by its alias in the known_hosts file", func() {
+		runCommand = func(cmd *exec.Cmd) error {
+			Expect(cmd.Args).To(ContainElements(
+				"HostKeyAlias=vmi-1234",
+				"UserKnownHostsFile=/home/jdoe/.ssh/kubevirt_known_hosts",
+			))
+			Expect(cmd.Args[len(cmd.Args)-1]).To(Equal(ssh.buildSSHTarget(fakeKind, fakeNamespace, fakeName)[0]))
+			return nil
+		}
+
+		ssh.options = DefaultSSHOptions()
+		ssh.options.HostKeyAlias = "vmi-1234"
+		ssh.options.KnownHostsFilePath = "/home/jdoe/.ssh/kubevirt_known_hosts"
+		ssh.options.KnownHostsFilePathProvided = true
+		clientArgs := ssh.buildSSHTarget(fakeKind, fakeNamespace, fakeName)
+		Expect(RunLocalClient(fakeKind, fakeNamespace, fakeName, &ssh.options, clientArgs)).To(Succeed())
+	})
+
+	It("RunLocalClient should keep the known_hosts files of the user without --known-hosts", func() {
+		runCommand = func(cmd *exec.Cmd) error {
+			Expect(cmd.Args).To(ContainElement("HostKeyAlias=vmi-1234"))
+			Expect(cmd.Args).ToNot(ContainElement(HavePrefix("UserKnownHostsFile=")))
+			return nil
+		}
+
+		ssh.options = DefaultSSHOptions()
+		ssh.options.HostKeyAlias = "vmi-1234"
+		ssh.options.KnownHostsFilePath = ssh.options.KnownHostsFilePathDefault
+		clientArgs := ssh.buildSSHTarget(fakeKind, fakeNamespace, fakeName)
+		Expect(RunLocalClient(fakeKind, fakeNamespace, fakeName, &ssh.options, clientArgs)).To(Succeed())
+	})
 })
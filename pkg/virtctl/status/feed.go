@@ -0,0 +1,264 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package status
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"time"
+
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	v1 "kubevirt.io/api/core/v1"
+)
+
+const timeFormat = "15:04:05"
+
+type condition struct {
+	status  string
+	reason  string
+	message string
+}
+
+type vmState struct {
+	status     v1.VirtualMachinePrintableStatus
+	conditions map[string]condition
+}
+
+type vmiState struct {
+	phase      v1.VirtualMachineInstancePhase
+	nodeName   string
+	conditions map[string]condition
+}
+
+type migrationState struct {
+	phase           v1.VirtualMachineInstanceMigrationPhase
+	targetNode      string
+	percentComplete int32
+}
+
+// feed prints the changes of the VirtualMachine, its VirtualMachineInstance and migrations, and their events as they
+// are observed. Objects are compared with what was printed before, so observing the same state twice prints nothing.
+type feed struct {
+	out    io.Writer
+	name   string
+	vm     *vmState
+	vmi    *vmiState
+	events map[types.UID]int32
+
+	migrations map[string]*migrationState
+}
+
+func newFeed(out io.Writer, name string) *feed {
+	return &feed{
+		out:        out,
+		name:       name,
+		events:     map[types.UID]int32{},
+		migrations: map[string]*migrationState{},
+	}
+}
+
+func (f *feed) observeVM(vm *v1.VirtualMachine) {
+	if vm.Name != f.name {
+		return
+	}
+	object := v1.VirtualMachineGroupVersionKind.Kind + "/" + vm.Name
+	current := &vmState{status: vm.Status.PrintableStatus, conditions: map[string]condition{}}
+	for _, c := range vm.Status.Conditions {
+		current.conditions[string(c.Type)] = condition{status: string(c.Status), reason: c.Reason, message: c.Message}
+	}
+
+	previous := f.vm
+	if previous == nil {
+		previous = &vmState{}
+	}
+	if current.status != previous.status {
+		f.printChange(object, "Status", string(previous.status), string(current.status))
+	}
+	f.printConditions(object, previous.conditions, current.conditions)
+	f.vm = current
+}
+
+// deleteVM returns true if the followed VirtualMachine was deleted
+func (f *feed) deleteVM(vm *v1.VirtualMachine) bool {
+	if vm.Name != f.name {
+		return false
+	}
+	f.print(time.Now(), v1.VirtualMachineGroupVersionKind.Kind+"/"+vm.Name, "Deleted")
+	f.vm = nil
+	return true
+}
+
+func (f *feed) observeVMI(vmi *v1.VirtualMachineInstance) {
+	if vmi.Name != f.name {
+		return
+	}
+	object := v1.VirtualMachineInstanceGroupVersionKind.Kind + "/" + vmi.Name
+	current := &vmiState{phase: vmi.Status.Phase, nodeName: vmi.Status.NodeName, conditions: map[string]condition{}}
+	for _, c := range vmi.Status.Conditions {
+		current.conditions[string(c.Type)] = condition{status: string(c.Status), reason: c.Reason, message: c.Message}
+	}
+
+	previous := f.vmi
+	if previous == nil {
+		previous = &vmiState{}
+	}
+	if current.phase != previous.phase {
+		f.printChange(object, "Phase", string(previous.phase), string(current.phase))
+	}
+	if current.nodeName != previous.nodeName {
+		f.printChange(object, "Node", previous.nodeName, current.nodeName)
+	}
+	f.printConditions(object, previous.conditions, current.conditions)
+	f.vmi = current
+}
+
+func (f *feed) deleteVMI(vmi *v1.VirtualMachineInstance) {
+	if vmi.Name != f.name || f.vmi == nil {
+		return
+	}
+	f.print(time.Now(), v1.VirtualMachineInstanceGroupVersionKind.Kind+"/"+vmi.Name, "Deleted")
+	f.vmi = nil
+}
+
+func (f *feed) observeMigration(migration *v1.VirtualMachineInstanceMigration) {
+	if migration.Spec.VMIName != f.name {
+		return
+	}
+	object := v1.VirtualMachineInstanceMigrationGroupVersionKind.Kind + "/" + migration.Name
+	current := &migrationState{phase: migration.Status.Phase}
+	if state := migration.Status.MigrationState; state != nil {
+		current.targetNode = state.TargetNode
+		if state.Progress != nil {
+			current.percentComplete = state.Progress.PercentComplete
+		}
+	}
+
+	previous, exists := f.migrations[migration.Name]
+	if !exists {
+		previous = &migrationState{}
+	}
+	if current.phase != previous.phase {
+		f.printChange(object, "Phase", string(previous.phase), string(current.phase))
+	}
+	if current.targetNode != previous.targetNode {
+		f.printChange(object, "Target node", previous.targetNode, current.targetNode)
+	}
+	if current.percentComplete != previous.percentComplete {
+		f.print(time.Now(), object, fmt.Sprintf("Progress: %d%%", current.percentComplete))
+	}
+	f.migrations[migration.Name] = current
+}
+
+func (f *feed) deleteMigration(migration *v1.VirtualMachineInstanceMigration) {
+	if _, exists := f.migrations[migration.Name]; !exists {
+		return
+	}
+	f.print(time.Now(), v1.VirtualMachineInstanceMigrationGroupVersionKind.Kind+"/"+migration.Name, "Deleted")
+	delete(f.migrations, migration.Name)
+}
+
+// observeEvent prints the events of the VirtualMachine, its VirtualMachineInstance and the migrations seen so far.
+// Repeated events are printed again whenever their count increases.
+func (f *feed) observeEvent(event *k8sv1.Event) {
+	involved := event.InvolvedObject
+	switch involved.Kind {
+	case v1.VirtualMachineGroupVersionKind.Kind, v1.VirtualMachineInstanceGroupVersionKind.Kind:
+		if involved.Name != f.name {
+			return
+		}
+	case v1.VirtualMachineInstanceMigrationGroupVersionKind.Kind:
+		if _, exists := f.migrations[involved.Name]; !exists {
+			return
+		}
+	default:
+		return
+	}
+	if count, seen := f.events[event.UID]; seen && count >= event.Count {
+		return
+	}
+	f.events[event.UID] = event.Count
+
+	message := fmt.Sprintf("%s %s: %s", event.Type, event.Reason, event.Message)
+	if event.Count > 1 {
+		message = fmt.Sprintf("%s (x%d)", message, event.Count)
+	}
+	f.print(eventTime(event), involved.Kind+"/"+involved.Name, message)
+}
+
+func (f *feed) printConditions(object string, previous, current map[string]condition) {
+	for _, conditionType := range sortedTypes(current) {
+		c := current[conditionType]
+		if old, exists := previous[conditionType]; exists && old == c {
+			continue
+		}
+		message := fmt.Sprintf("Condition %s=%s", conditionType, c.status)
+		if c.reason != "" {
+			message = fmt.Sprintf("%s (%s)", message, c.reason)
+		}
+		if c.message != "" {
+			message = fmt.Sprintf("%s: %s", message, c.message)
+		}
+		f.print(time.Now(), object, message)
+	}
+	for _, conditionType := range sortedTypes(previous) {
+		if _, exists := current[conditionType]; !exists {
+			f.print(time.Now(), object, fmt.Sprintf("Condition %s removed", conditionType))
+		}
+	}
+}
+
+func sortedTypes(conditions map[string]condition) []string {
+	conditionTypes := make([]string, 0, len(conditions))
+	for conditionType := range conditions {
+		conditionTypes = append(conditionTypes, conditionType)
+	}
+	sort.Strings(conditionTypes)
+	return conditionTypes
+}
+
+func (f *feed) printChange(object, field, previous, current string) {
+	if previous == "" {
+		f.print(time.Now(), object, fmt.Sprintf("%s: %s", field, current))
+		return
+	}
+	f.print(time.Now(), object, fmt.Sprintf("%s: %s -> %s", field, previous, orNone(current)))
+}
+
+func (f *feed) print(t time.Time, object, message string) {
+	fmt.Fprintf(f.out, "%s  %s  %s\n", t.Format(timeFormat), object, message)
+}
+
+// eventTime returns the time the event was last seen, events of the events.k8s.io API only have the event time
+func eventTime(event *k8sv1.Event) time.Time {
+	if !event.LastTimestamp.IsZero() {
+		return event.LastTimestamp.Time
+	}
+	return event.EventTime.Time
+}
+
+func orNone(value string) string {
+	if value == "" {
+		return "<none>"
+	}
+	return value
+}
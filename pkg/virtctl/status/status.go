@@ -0,0 +1,281 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package status
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/spf13/cobra"
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/watch"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+
+	"kubevirt.io/kubevirt/pkg/virtctl/clientconfig"
+	"kubevirt.io/kubevirt/pkg/virtctl/templates"
+)
+
+const (
+	COMMAND_STATUS = "status"
+
+	watchArg = "watch"
+)
+
+type command struct {
+	watch bool
+}
+
+func NewCommand() *cobra.Command {
+	c := command{}
+	cmd := &cobra.Command{
+		Use:   "status (VM)",
+		Short: "Show the status of a virtual machine, its virtual machine instance and migrations in a single feed.",
+		Long: `Prints the status and conditions of a virtual machine, the phase and conditions of its virtual machine instance,
+its migrations and the events of all of them.
+With --watch the changes are streamed until the command is interrupted or the virtual machine is deleted.`,
+		Args:    cobra.ExactArgs(1),
+		Example: usage(),
+		RunE:    c.run,
+	}
+
+	cmd.Flags().BoolVarP(&c.watch, watchArg, "w", false, "Stream the changes of the status and the new events.")
+	cmd.SetUsageTemplate(templates.UsageTemplate())
+
+	return cmd
+}
+
+func usage() string {
+	return `  # Show the status of a virtual machine called 'myvm':
+  {{ProgramName}} status myvm
+
+  # Follow the status of a virtual machine called 'myvm' while it is started or migrated:
+  {{ProgramName}} status --watch myvm`
+}
+
+func (c *command) run(cmd *cobra.Command, args []string) error {
+	client, namespace, _, err := clientconfig.ClientAndNamespaceFromContext(cmd.Context())
+	if err != nil {
+		return fmt.Errorf("cannot obtain KubeVirt client: %v", err)
+	}
+	ctx, cancel := context.WithCancel(cmd.Context())
+	defer cancel()
+
+	f := newFeed(cmd.OutOrStdout(), args[0])
+
+	// The watches are opened before the current state is read so no change can be missed in between, the feed
+	// drops whatever it already printed
+	var streams []*stream
+	if c.watch {
+		streams = newStreams(client, namespace, args[0])
+		for _, s := range streams {
+			if err := s.open(ctx); err != nil {
+				return err
+			}
+			defer s.stop()
+		}
+	}
+
+	if err := snapshot(ctx, client, namespace, args[0], f); err != nil {
+		return err
+	}
+	if !c.watch {
+		return nil
+	}
+	return follow(ctx, streams, f)
+}
+
+// snapshot feeds the current state of the VirtualMachine, its VirtualMachineInstance, migrations and their events
+func snapshot(ctx context.Context, client kubecli.KubevirtClient, namespace, name string, f *feed) error {
+	vm, err := client.VirtualMachine(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("error fetching VirtualMachine: %v", err)
+	}
+	f.observeVM(vm)
+
+	vmi, err := client.VirtualMachineInstance(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err == nil {
+		f.observeVMI(vmi)
+	} else if !errors.IsNotFound(err) {
+		return fmt.Errorf("error fetching VirtualMachineInstance: %v", err)
+	}
+
+	migrations, err := client.VirtualMachineInstanceMigration(namespace).List(ctx, migrationSelector(name))
+	if err != nil {
+		return fmt.Errorf("error listing VirtualMachineInstanceMigrations: %v", err)
+	}
+	sort.Slice(migrations.Items, func(i, j int) bool {
+		return migrations.Items[i].CreationTimestamp.Before(&migrations.Items[j].CreationTimestamp)
+	})
+	for i := range migrations.Items {
+		f.observeMigration(&migrations.Items[i])
+	}
+
+	var events []k8sv1.Event
+	for _, selector := range eventSelectors(name) {
+		list, err := client.CoreV1().Events(namespace).List(ctx, selector)
+		if err != nil {
+			return fmt.Errorf("error listing events: %v", err)
+		}
+		events = append(events, list.Items...)
+	}
+	sort.SliceStable(events, func(i, j int) bool {
+		return eventTime(&events[i]).Before(eventTime(&events[j]))
+	})
+	for i := range events {
+		f.observeEvent(&events[i])
+	}
+	return nil
+}
+
+// migrationSelector selects the migrations of the VirtualMachineInstance, which virt-api labels with its name
+func migrationSelector(name string) metav1.ListOptions {
+	return metav1.ListOptions{LabelSelector: v1.MigrationSelectorLabel + "=" + name}
+}
+
+// eventSelectors select the events of the VirtualMachine and its VirtualMachineInstance, which share its name, and
+// the events of migrations, which are named independently of the VirtualMachineInstance and filtered by the feed
+func eventSelectors(name string) []metav1.ListOptions {
+	return []metav1.ListOptions{
+		{FieldSelector: "involvedObject.name=" + name},
+		{FieldSelector: "involvedObject.kind=" + v1.VirtualMachineInstanceMigrationGroupVersionKind.Kind},
+	}
+}
+
+// follow feeds the changes until the context is cancelled or the VirtualMachine is deleted
+func follow(ctx context.Context, streams []*stream, f *feed) error {
+	vms, vmis, migrations, events, migrationEvents := streams[0], streams[1], streams[2], streams[3], streams[4]
+	for {
+		var (
+			s     *stream
+			event watch.Event
+			ok    bool
+		)
+		select {
+		case <-ctx.Done():
+			return nil
+		case event, ok = <-vms.resultChan():
+			s = vms
+		case event, ok = <-vmis.resultChan():
+			s = vmis
+		case event, ok = <-migrations.resultChan():
+			s = migrations
+		case event, ok = <-events.resultChan():
+			s = events
+		case event, ok = <-migrationEvents.resultChan():
+			s = migrationEvents
+		}
+
+		// Watches are closed by the server from time to time, or fail with an error, reopening them replays the
+		// current state which the feed drops
+		if !ok || event.Type == watch.Error {
+			if err := s.reopen(ctx); err != nil {
+				return err
+			}
+			continue
+		}
+		if event.Type == watch.Bookmark {
+			continue
+		}
+
+		switch obj := event.Object.(type) {
+		case *v1.VirtualMachine:
+			if event.Type == watch.Deleted {
+				if f.deleteVM(obj) {
+					return nil
+				}
+				continue
+			}
+			f.observeVM(obj)
+		case *v1.VirtualMachineInstance:
+			if event.Type == watch.Deleted {
+				f.deleteVMI(obj)
+				continue
+			}
+			f.observeVMI(obj)
+		case *v1.VirtualMachineInstanceMigration:
+			if event.Type == watch.Deleted {
+				f.deleteMigration(obj)
+				continue
+			}
+			f.observeMigration(obj)
+		case *k8sv1.Event:
+			if event.Type != watch.Deleted {
+				f.observeEvent(obj)
+			}
+		}
+	}
+}
+
+// stream is a watch which can be reopened once the server closed it
+type stream struct {
+	watchFunc func(ctx context.Context) (watch.Interface, error)
+	watcher   watch.Interface
+}
+
+func newStreams(client kubecli.KubevirtClient, namespace, name string) []*stream {
+	nameSelector := metav1.ListOptions{FieldSelector: "metadata.name=" + name}
+	eventSelectors := eventSelectors(name)
+	return []*stream{
+		{watchFunc: func(ctx context.Context) (watch.Interface, error) {
+			return client.VirtualMachine(namespace).Watch(ctx, nameSelector)
+		}},
+		{watchFunc: func(ctx context.Context) (watch.Interface, error) {
+			return client.VirtualMachineInstance(namespace).Watch(ctx, nameSelector)
+		}},
+		{watchFunc: func(ctx context.Context) (watch.Interface, error) {
+			return client.VirtualMachineInstanceMigration(namespace).Watch(ctx, migrationSelector(name))
+		}},
+		{watchFunc: func(ctx context.Context) (watch.Interface, error) {
+			return client.CoreV1().Events(namespace).Watch(ctx, eventSelectors[0])
+		}},
+		{watchFunc: func(ctx context.Context) (watch.Interface, error) {
+			return client.CoreV1().Events(namespace).Watch(ctx, eventSelectors[1])
+		}},
+	}
+}
+
+func (s *stream) open(ctx context.Context) error {
+	watcher, err := s.watchFunc(ctx)
+	if err != nil {
+		return fmt.Errorf("unable to watch: %v", err)
+	}
+	s.watcher = watcher
+	return nil
+}
+
+func (s *stream) reopen(ctx context.Context) error {
+	s.stop()
+	return s.open(ctx)
+}
+
+func (s *stream) stop() {
+	if s.watcher != nil {
+		s.watcher.Stop()
+	}
+}
+
+func (s *stream) resultChan() <-chan watch.Event {
+	return s.watcher.ResultChan()
+}
@@ -0,0 +1,11 @@
+package status_test
+
+import (
+	"testing"
+
+	"kubevirt.io/client-go/testutils"
+)
+
+func TestStatus(t *testing.T) {
+	testutils.KubeVirtTestSuiteSetup(t)
+}
@@ -0,0 +1,223 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package status_test
+
+import (
+	"bytes"
+	"context"
+	"sync"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	k8sv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+	kubevirtfake "kubevirt.io/client-go/kubevirt/fake"
+
+	"kubevirt.io/kubevirt/pkg/libvmi"
+	"kubevirt.io/kubevirt/pkg/virtctl"
+	"kubevirt.io/kubevirt/pkg/virtctl/status"
+	"kubevirt.io/kubevirt/pkg/virtctl/testing"
+)
+
+// syncBuffer lets the output be read while the command is still writing to it
+type syncBuffer struct {
+	lock sync.Mutex
+	buf  bytes.Buffer
+}
+
+func (b *syncBuffer) Write(p []byte) (int, error) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	return b.buf.Write(p)
+}
+
+func (b *syncBuffer) String() string {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	return b.buf.String()
+}
+
+var _ = Describe("Status", func() {
+	const vmName = "testvm"
+
+	var (
+		virtClient *kubevirtfake.Clientset
+		kubeClient *fake.Clientset
+	)
+
+	newEvent := func(name, kind, objectName, reason string) *k8sv1.Event {
+		return &k8sv1.Event{
+			ObjectMeta:     metav1.ObjectMeta{Name: name, Namespace: metav1.NamespaceDefault, UID: types.UID("uid-" + name)},
+			InvolvedObject: k8sv1.ObjectReference{Kind: kind, Name: objectName},
+			Type:           k8sv1.EventTypeNormal,
+			Reason:         reason,
+			Message:        reason + " happened",
+			Count:          1,
+			LastTimestamp:  metav1.Now(),
+		}
+	}
+
+	newMigration := func(name, vmiName string, phase v1.VirtualMachineInstanceMigrationPhase) *v1.VirtualMachineInstanceMigration {
+		return &v1.VirtualMachineInstanceMigration{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: metav1.NamespaceDefault,
+				Labels:    map[string]string{v1.MigrationSelectorLabel: vmiName},
+			},
+			Spec:   v1.VirtualMachineInstanceMigrationSpec{VMIName: vmiName},
+			Status: v1.VirtualMachineInstanceMigrationStatus{Phase: phase},
+		}
+	}
+
+	BeforeEach(func() {
+		vm := libvmi.NewVirtualMachine(libvmi.New(libvmi.WithName(vmName), libvmi.WithNamespace(metav1.NamespaceDefault)))
+		vm.Status.PrintableStatus = v1.VirtualMachineStatusStarting
+		vm.Status.Conditions = []v1.VirtualMachineCondition{{
+			Type:   v1.VirtualMachineReady,
+			Status: k8sv1.ConditionFalse,
+			Reason: "GuestNotRunning",
+		}}
+
+		vmi := libvmi.New(libvmi.WithName(vmName), libvmi.WithNamespace(metav1.NamespaceDefault))
+		vmi.Status.Phase = v1.Scheduled
+		vmi.Status.NodeName = "node01"
+
+		virtClient = kubevirtfake.NewSimpleClientset(vm, vmi,
+			newMigration("testmigration", vmName, v1.MigrationFailed),
+			newMigration("othermigration", "othervm", v1.MigrationSucceeded),
+		)
+		kubeClient = fake.NewSimpleClientset(
+			newEvent("vm-event", v1.VirtualMachineGroupVersionKind.Kind, vmName, "SuccessfulCreate"),
+			newEvent("migration-event", v1.VirtualMachineInstanceMigrationGroupVersionKind.Kind, "testmigration", "FailedMigration"),
+			newEvent("other-event", v1.VirtualMachineGroupVersionKind.Kind, "othervm", "Unrelated"),
+		)
+
+		ctrl := gomock.NewController(GinkgoT())
+		kubecli.GetKubevirtClientFromClientConfig = kubecli.GetMockKubevirtClientFromClientConfig
+		kubecli.MockKubevirtClientInstance = kubecli.NewMockKubevirtClient(ctrl)
+		kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachine(metav1.NamespaceDefault).
+			Return(virtClient.KubevirtV1().VirtualMachines(metav1.NamespaceDefault)).AnyTimes()
+		kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachineInstance(metav1.NamespaceDefault).
+			Return(virtClient.KubevirtV1().VirtualMachineInstances(metav1.NamespaceDefault)).AnyTimes()
+		kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachineInstanceMigration(metav1.NamespaceDefault).
+			Return(virtClient.KubevirtV1().VirtualMachineInstanceMigrations(metav1.NamespaceDefault)).AnyTimes()
+		kubecli.MockKubevirtClientInstance.EXPECT().CoreV1().Return(kubeClient.CoreV1()).AnyTimes()
+	})
+
+	It("should fail with a missing VM", func() {
+		err := testing.NewRepeatableVirtctlCommand(status.COMMAND_STATUS, "unknown")()
+		Expect(err).To(MatchError(ContainSubstring("virtualmachines.kubevirt.io \"unknown\" not found")))
+	})
+
+	It("should print the current status of the VM, its VMI and migrations", func() {
+		out, err := testing.NewRepeatableVirtctlCommandWithOut(status.COMMAND_STATUS, vmName)()
+		Expect(err).ToNot(HaveOccurred())
+
+		feed := string(out)
+		Expect(feed).To(ContainSubstring("VirtualMachine/testvm  Status: Starting"))
+		Expect(feed).To(ContainSubstring("VirtualMachine/testvm  Condition Ready=False (GuestNotRunning)"))
+		Expect(feed).To(ContainSubstring("VirtualMachineInstance/testvm  Phase: Scheduled"))
+		Expect(feed).To(ContainSubstring("VirtualMachineInstance/testvm  Node: node01"))
+		Expect(feed).To(ContainSubstring("VirtualMachineInstanceMigration/testmigration  Phase: Failed"))
+		Expect(feed).To(ContainSubstring("VirtualMachine/testvm  Normal SuccessfulCreate: SuccessfulCreate happened"))
+		Expect(feed).To(ContainSubstring("VirtualMachineInstanceMigration/testmigration  Normal FailedMigration"))
+		Expect(feed).ToNot(ContainSubstring("othermigration"))
+		Expect(feed).ToNot(ContainSubstring("Unrelated"))
+	})
+
+	It("should only list the migrations and events of the VM", func() {
+		var migrationSelectors, eventSelectors []string
+		virtClient.PrependReactor("list", "virtualmachineinstancemigrations", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			migrationSelectors = append(migrationSelectors, action.(k8stesting.ListAction).GetListRestrictions().Labels.String())
+			return false, nil, nil
+		})
+		kubeClient.PrependReactor("list", "events", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			eventSelectors = append(eventSelectors, action.(k8stesting.ListAction).GetListRestrictions().Fields.String())
+			return false, nil, nil
+		})
+
+		Expect(testing.NewRepeatableVirtctlCommand(status.COMMAND_STATUS, vmName)()).To(Succeed())
+		Expect(migrationSelectors).To(ConsistOf(v1.MigrationSelectorLabel + "=" + vmName))
+		Expect(eventSelectors).To(ConsistOf(
+			"involvedObject.name="+vmName,
+			"involvedObject.kind="+v1.VirtualMachineInstanceMigrationGroupVersionKind.Kind,
+		))
+	})
+
+	It("should stream the changes until the VM is deleted", func() {
+		out := &syncBuffer{}
+		done := make(chan error, 1)
+		go func() {
+			defer GinkgoRecover()
+			cmd := virtctl.NewVirtctlCommand()
+			cmd.SetArgs([]string{status.COMMAND_STATUS, vmName, "--watch"})
+			cmd.SetOut(out)
+			done <- cmd.Execute()
+		}()
+		Eventually(out.String).Should(ContainSubstring("Phase: Scheduled"))
+
+		ctx := context.Background()
+		vmis := virtClient.KubevirtV1().VirtualMachineInstances(metav1.NamespaceDefault)
+		vmi, err := vmis.Get(ctx, vmName, metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		vmi.Status.Phase = v1.Running
+		vmi.Status.Conditions = []v1.VirtualMachineInstanceCondition{{
+			Type:   v1.VirtualMachineInstanceAgentConnected,
+			Status: k8sv1.ConditionTrue,
+		}}
+		_, err = vmis.Update(ctx, vmi, metav1.UpdateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		Eventually(out.String).Should(ContainSubstring("VirtualMachineInstance/testvm  Phase: Scheduled -> Running"))
+		Eventually(out.String).Should(ContainSubstring("VirtualMachineInstance/testvm  Condition AgentConnected=True"))
+
+		migration := newMigration("newmigration", vmName, v1.MigrationRunning)
+		migration.Status.MigrationState = &v1.VirtualMachineInstanceMigrationState{
+			TargetNode: "node02",
+			Progress:   &v1.MigrationProgress{PercentComplete: 40},
+		}
+		_, err = virtClient.KubevirtV1().VirtualMachineInstanceMigrations(metav1.NamespaceDefault).
+			Create(ctx, migration, metav1.CreateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		Eventually(out.String).Should(ContainSubstring("VirtualMachineInstanceMigration/newmigration  Phase: Running"))
+		Eventually(out.String).Should(ContainSubstring("VirtualMachineInstanceMigration/newmigration  Target node: node02"))
+		Eventually(out.String).Should(ContainSubstring("VirtualMachineInstanceMigration/newmigration  Progress: 40%"))
+
+		_, err = kubeClient.CoreV1().Events(metav1.NamespaceDefault).Create(ctx,
+			newEvent("vmi-event", v1.VirtualMachineInstanceGroupVersionKind.Kind, vmName, "Migrating"), metav1.CreateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		Eventually(out.String).Should(ContainSubstring("VirtualMachineInstance/testvm  Normal Migrating: Migrating happened"))
+
+		Expect(virtClient.KubevirtV1().VirtualMachines(metav1.NamespaceDefault).Delete(ctx, vmName, metav1.DeleteOptions{})).
+			To(Succeed())
+		Eventually(done).WithTimeout(5 * time.Second).Should(Receive(BeNil()))
+		Expect(out.String()).To(ContainSubstring("VirtualMachine/testvm  Deleted"))
+		Expect(out.String()).ToNot(ContainSubstring("Status: Starting -> "))
+	})
+})
@@ -21,6 +21,7 @@ package testing
 
 import (
 	"bytes"
+	"io"
 
 	"kubevirt.io/kubevirt/pkg/virtctl"
 )
@@ -43,3 +44,16 @@ func NewRepeatableVirtctlCommandWithOut(args ...string) func() ([]byte, error) {
 		return out.Bytes(), err
 	}
 }
+
+func NewRepeatableVirtctlCommandWithInAndOut(in io.Reader, args ...string) func() ([]byte, error) {
+	return func() ([]byte, error) {
+		out := &bytes.Buffer{}
+		cmd := virtctl.NewVirtctlCommand()
+		cmd.SetArgs(args)
+		cmd.SetIn(in)
+		cmd.SetOut(out)
+		cmd.SetErr(io.Discard)
+		err := cmd.Execute()
+		return out.Bytes(), err
+	}
+}
@@ -27,11 +27,13 @@ import (
 
 	"github.com/spf13/cobra"
 	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	v1 "kubevirt.io/api/core/v1"
 	"kubevirt.io/client-go/kubecli"
 
+	"kubevirt.io/kubevirt/pkg/pointer"
 	"kubevirt.io/kubevirt/pkg/virtctl/clientconfig"
 	"kubevirt.io/kubevirt/pkg/virtctl/templates"
 )
@@ -40,14 +42,28 @@ const (
 	serialArg       = "serial"
 	cacheArg        = "cache"
 	diskTypeArg     = "disk-type"
+	bootOrderArg    = "boot-order"
+	totalBytesArg   = "total-bytes-sec"
+	readBytesArg    = "read-bytes-sec"
+	writeBytesArg   = "write-bytes-sec"
+	totalIOPSArg    = "total-iops-sec"
+	readIOPSArg     = "read-iops-sec"
+	writeIOPSArg    = "write-iops-sec"
 	concurrentError = "the server rejected our request due to an error in our request"
 	maxRetries      = 15
 )
 
 var (
-	serial   string
-	cache    string
-	diskType string
+	serial     string
+	cache      string
+	diskType   string
+	bootOrder  uint
+	totalBytes string
+	readBytes  string
+	writeBytes string
+	totalIOPS  uint64
+	readIOPS   uint64
+	writeIOPS  uint64
 )
 
 func NewAddVolumeCommand() *cobra.Command {
@@ -65,7 +81,14 @@ func NewAddVolumeCommand() *cobra.Command {
 	cmd.Flags().StringVar(&cache, cacheArg, "", "caching options attribute control the cache mechanism")
 	cmd.Flags().BoolVar(&persist, persistArg, false, "if set, the added volume will be persisted in the VM spec (if it exists)")
 	cmd.Flags().BoolVar(&dryRun, dryRunArg, false, dryRunCommandUsage)
-	cmd.Flags().StringVar(&diskType, diskTypeArg, "disk", "specifies disk type to be hotplugged (disk/lun). Disk by default.")
+	cmd.Flags().StringVar(&diskType, diskTypeArg, "disk", "specifies disk type to be hotplugged (disk/lun/cdrom). Disk by default.")
+	cmd.Flags().UintVar(&bootOrder, bootOrderArg, 0, "boot order of the disk, takes effect at the next boot of the VM")
+	cmd.Flags().StringVar(&totalBytes, totalBytesArg, "", "limits the total throughput of the disk in bytes per second (e.g. 100Mi)")
+	cmd.Flags().StringVar(&readBytes, readBytesArg, "", "limits the read throughput of the disk in bytes per second (e.g. 100Mi)")
+	cmd.Flags().StringVar(&writeBytes, writeBytesArg, "", "limits the write throughput of the disk in bytes per second (e.g. 100Mi)")
+	cmd.Flags().Uint64Var(&totalIOPS, totalIOPSArg, 0, "limits the total number of operations per second of the disk")
+	cmd.Flags().Uint64Var(&readIOPS, readIOPSArg, 0, "limits the number of read operations per second of the disk")
+	cmd.Flags().Uint64Var(&writeIOPS, writeIOPSArg, 0, "limits the number of write operations per second of the disk")
 
 	return cmd
 }
@@ -82,6 +105,15 @@ func usageAddVolume() string {
 
   #Dynamically attach a volume with 'none' cache attribute to a running VM.
   {{ProgramName}} addvolume fedora-dv --volume-name=example-dv --cache=none
+
+  #Dynamically attach a volume to a running VM limiting its throughput and IO operations.
+  {{ProgramName}} addvolume fedora-dv --volume-name=example-dv --total-bytes-sec=100Mi --read-iops-sec=1000 --write-iops-sec=500
+
+  #Dynamically attach an ISO image as a CD-ROM to a running VM, e.g. to install drivers. Remove it with removevolume to eject it.
+  {{ProgramName}} addvolume win-vm --volume-name=virtio-win-iso --disk-type=cdrom
+
+  #Dynamically attach an ISO image as a CD-ROM which is booted from first at the next restart of the VM.
+  {{ProgramName}} addvolume win-vm --volume-name=install-iso --disk-type=cdrom --boot-order=1 --persist
   `
 }
 
@@ -123,6 +155,42 @@ func getVolumeSourceFromVolume(volumeName, namespace string, virtClient kubecli.
 	return nil, fmt.Errorf("Volume %s is not a DataVolume or PersistentVolumeClaim", volumeName)
 }
 
+// getIOTune returns the IO limits requested by the flags, or nil if none was set
+func getIOTune() (*v1.DiskIOTune, error) {
+	ioTune := &v1.DiskIOTune{}
+	for _, limit := range []struct {
+		arg   string
+		value string
+		dst   **resource.Quantity
+	}{
+		{totalBytesArg, totalBytes, &ioTune.TotalBytesSec},
+		{readBytesArg, readBytes, &ioTune.ReadBytesSec},
+		{writeBytesArg, writeBytes, &ioTune.WriteBytesSec},
+	} {
+		if limit.value == "" {
+			continue
+		}
+		q, err := resource.ParseQuantity(limit.value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s value %s", limit.arg, limit.value)
+		}
+		*limit.dst = &q
+	}
+	if totalIOPS > 0 {
+		ioTune.TotalIOPSSec = pointer.P(totalIOPS)
+	}
+	if readIOPS > 0 {
+		ioTune.ReadIOPSSec = pointer.P(readIOPS)
+	}
+	if writeIOPS > 0 {
+		ioTune.WriteIOPSSec = pointer.P(writeIOPS)
+	}
+	if *ioTune == (v1.DiskIOTune{}) {
+		return nil, nil
+	}
+	return ioTune, nil
+}
+
 func addVolume(vmiName, volumeName, namespace string, virtClient kubecli.KubevirtClient, dryRunOption *[]string) error {
 	volumeSource, err := getVolumeSourceFromVolume(volumeName, namespace, virtClient)
 	if err != nil {
@@ -146,8 +214,16 @@ func addVolume(vmiName, volumeName, namespace string, virtClient kubecli.Kubevir
 		hotplugRequest.Disk.DiskDevice.LUN = &v1.LunTarget{
 			Bus: "scsi",
 		}
+	case "cdrom":
+		hotplugRequest.Disk.DiskDevice.CDRom = &v1.CDRomTarget{
+			Bus: "scsi",
+		}
 	default:
-		return fmt.Errorf("Invalid disk type '%s'. Only LUN and Disk are supported.", diskType)
+		return fmt.Errorf("Invalid disk type '%s'. Only LUN, Disk and CDRom are supported.", diskType)
+	}
+
+	if bootOrder > 0 {
+		hotplugRequest.Disk.BootOrder = &bootOrder
 	}
 
 	if serial != "" {
@@ -164,6 +240,11 @@ func addVolume(vmiName, volumeName, namespace string, virtClient kubecli.Kubevir
 			return fmt.Errorf("error adding volume, invalid cache value %s", cache)
 		}
 	}
+	ioTune, err := getIOTune()
+	if err != nil {
+		return fmt.Errorf("error adding volume, %v", err)
+	}
+	hotplugRequest.Disk.IOTune = ioTune
 	retry := 0
 	for retry < maxRetries {
 		if !persist {
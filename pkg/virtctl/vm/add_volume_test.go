@@ -29,6 +29,8 @@ import (
 	. "github.com/onsi/gomega"
 
 	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	k8sfake "k8s.io/client-go/kubernetes/fake"
@@ -41,6 +43,7 @@ import (
 	kvtesting "kubevirt.io/client-go/testing"
 	"kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
 
+	"kubevirt.io/kubevirt/pkg/pointer"
 	"kubevirt.io/kubevirt/pkg/virtctl/testing"
 )
 
@@ -90,10 +93,25 @@ var _ = Describe("Add volume command", func() {
 		}, metav1.CreateOptions{})
 		Expect(err).ToNot(HaveOccurred())
 
-		cmd := testing.NewRepeatableVirtctlCommand("addvolume", vmiName, "--volume-name="+volumeName, "--disk-type=cdrom")
+		cmd := testing.NewRepeatableVirtctlCommand("addvolume", vmiName, "--volume-name="+volumeName, "--disk-type=floppy")
 		Expect(cmd()).To(MatchError(ContainSubstring("Invalid disk type")))
 	})
 
+	It("should fail when trying to add volume with invalid IO limits", func() {
+		kubecli.MockKubevirtClientInstance.EXPECT().CdiClient().Return(cdiClient)
+		kubecli.MockKubevirtClientInstance.EXPECT().CoreV1().Return(coreClient.CoreV1())
+
+		_, err := coreClient.CoreV1().PersistentVolumeClaims(metav1.NamespaceDefault).Create(context.Background(), &k8sv1.PersistentVolumeClaim{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: volumeName,
+			},
+		}, metav1.CreateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+
+		cmd := testing.NewRepeatableVirtctlCommand("addvolume", vmiName, "--volume-name="+volumeName, "--read-bytes-sec=fast")
+		Expect(cmd()).To(MatchError("error adding volume, invalid read-bytes-sec value fast"))
+	})
+
 	DescribeTable("should fail addvolume when no source is found according to option", func(dryRun bool) {
 		kubecli.MockKubevirtClientInstance.EXPECT().CdiClient().Return(cdiClient)
 		kubecli.MockKubevirtClientInstance.EXPECT().CoreV1().Return(coreClient.CoreV1())
@@ -201,10 +219,16 @@ var _ = Describe("Add volume command", func() {
 				Entry("dry-run", "--dry-run", verifyDiskSerial(volumeName), verifyDryRun),
 				Entry("disk-type disk", "--disk-type=disk", verifyDiskSerial(volumeName), verifyDiskTypeDisk),
 				Entry("disk-type lun", "--disk-type=lun", verifyDiskSerial(volumeName), verifyDiskTypeLun),
+				Entry("disk-type cdrom", "--disk-type=cdrom", verifyDiskSerial(volumeName), verifyDiskTypeCDRom),
+				Entry("boot-order", "--boot-order=2", verifyDiskSerial(volumeName), verifyBootOrder(2)),
 				Entry("serial", "--serial=test", verifyDiskSerial("test")),
 				Entry("cache none", "--cache=none", verifyDiskSerial(volumeName), verifyCache(v1.CacheNone)),
 				Entry("cache writethrough", "--cache=writethrough", verifyDiskSerial(volumeName), verifyCache(v1.CacheWriteThrough)),
 				Entry("cache writeback", "--cache=writeback", verifyDiskSerial(volumeName), verifyCache(v1.CacheWriteBack)),
+				Entry("total-bytes-sec", "--total-bytes-sec=100Mi", verifyDiskSerial(volumeName),
+					verifyIOTune(&v1.DiskIOTune{TotalBytesSec: pointer.P(resource.MustParse("100Mi"))})),
+				Entry("read-iops-sec", "--read-iops-sec=1000", verifyDiskSerial(volumeName),
+					verifyIOTune(&v1.DiskIOTune{ReadIOPSSec: pointer.P(uint64(1000))})),
 			)
 
 			DescribeTable("should call VM endpoint with persist and", func(arg string, verifyFns ...verifyFn) {
@@ -217,10 +241,16 @@ var _ = Describe("Add volume command", func() {
 				Entry("dry-run", "--dry-run", verifyDiskSerial(volumeName), verifyDryRun),
 				Entry("disk-type disk", "--disk-type=disk", verifyDiskSerial(volumeName), verifyDiskTypeDisk),
 				Entry("disk-type lun", "--disk-type=lun", verifyDiskSerial(volumeName), verifyDiskTypeLun),
+				Entry("disk-type cdrom", "--disk-type=cdrom", verifyDiskSerial(volumeName), verifyDiskTypeCDRom),
+				Entry("boot-order", "--boot-order=2", verifyDiskSerial(volumeName), verifyBootOrder(2)),
 				Entry("serial", "--serial=test", verifyDiskSerial("test")),
 				Entry("cache none", "--cache=none", verifyDiskSerial(volumeName), verifyCache(v1.CacheNone)),
 				Entry("cache writethrough", "--cache=writethrough", verifyDiskSerial(volumeName), verifyCache(v1.CacheWriteThrough)),
 				Entry("cache writeback", "--cache=writeback", verifyDiskSerial(volumeName), verifyCache(v1.CacheWriteBack)),
+				Entry("total-bytes-sec", "--total-bytes-sec=100Mi", verifyDiskSerial(volumeName),
+					verifyIOTune(&v1.DiskIOTune{TotalBytesSec: pointer.P(resource.MustParse("100Mi"))})),
+				Entry("read-iops-sec", "--read-iops-sec=1000", verifyDiskSerial(volumeName),
+					verifyIOTune(&v1.DiskIOTune{ReadIOPSSec: pointer.P(uint64(1000))})),
 			)
 
 			It("should fail immediately on non concurrent error", func() {
@@ -277,10 +307,16 @@ var _ = Describe("Add volume command", func() {
 				Entry("dry-run", "--dry-run", verifyDiskSerial(volumeName), verifyDryRun),
 				Entry("disk-type disk", "--disk-type=disk", verifyDiskSerial(volumeName), verifyDiskTypeDisk),
 				Entry("disk-type lun", "--disk-type=lun", verifyDiskSerial(volumeName), verifyDiskTypeLun),
+				Entry("disk-type cdrom", "--disk-type=cdrom", verifyDiskSerial(volumeName), verifyDiskTypeCDRom),
+				Entry("boot-order", "--boot-order=2", verifyDiskSerial(volumeName), verifyBootOrder(2)),
 				Entry("serial", "--serial=test", verifyDiskSerial("test")),
 				Entry("cache none", "--cache=none", verifyDiskSerial(volumeName), verifyCache(v1.CacheNone)),
 				Entry("cache writethrough", "--cache=writethrough", verifyDiskSerial(volumeName), verifyCache(v1.CacheWriteThrough)),
 				Entry("cache writeback", "--cache=writeback", verifyDiskSerial(volumeName), verifyCache(v1.CacheWriteBack)),
+				Entry("total-bytes-sec", "--total-bytes-sec=100Mi", verifyDiskSerial(volumeName),
+					verifyIOTune(&v1.DiskIOTune{TotalBytesSec: pointer.P(resource.MustParse("100Mi"))})),
+				Entry("read-iops-sec", "--read-iops-sec=1000", verifyDiskSerial(volumeName),
+					verifyIOTune(&v1.DiskIOTune{ReadIOPSSec: pointer.P(uint64(1000))})),
 			)
 
 			DescribeTable("should call VM endpoint with persist and", func(arg string, verifyFns ...verifyFn) {
@@ -293,10 +329,16 @@ var _ = Describe("Add volume command", func() {
 				Entry("dry-run", "--dry-run", verifyDiskSerial(volumeName), verifyDryRun),
 				Entry("disk-type disk", "--disk-type=disk", verifyDiskSerial(volumeName), verifyDiskTypeDisk),
 				Entry("disk-type lun", "--disk-type=lun", verifyDiskSerial(volumeName), verifyDiskTypeLun),
+				Entry("disk-type cdrom", "--disk-type=cdrom", verifyDiskSerial(volumeName), verifyDiskTypeCDRom),
+				Entry("boot-order", "--boot-order=2", verifyDiskSerial(volumeName), verifyBootOrder(2)),
 				Entry("serial", "--serial=test", verifyDiskSerial("test")),
 				Entry("cache none", "--cache=none", verifyDiskSerial(volumeName), verifyCache(v1.CacheNone)),
 				Entry("cache writethrough", "--cache=writethrough", verifyDiskSerial(volumeName), verifyCache(v1.CacheWriteThrough)),
 				Entry("cache writeback", "--cache=writeback", verifyDiskSerial(volumeName), verifyCache(v1.CacheWriteBack)),
+				Entry("total-bytes-sec", "--total-bytes-sec=100Mi", verifyDiskSerial(volumeName),
+					verifyIOTune(&v1.DiskIOTune{TotalBytesSec: pointer.P(resource.MustParse("100Mi"))})),
+				Entry("read-iops-sec", "--read-iops-sec=1000", verifyDiskSerial(volumeName),
+					verifyIOTune(&v1.DiskIOTune{ReadIOPSSec: pointer.P(uint64(1000))})),
 			)
 		})
 	})
@@ -328,6 +370,18 @@ func verifyDiskTypeLun(volumeOptions *v1.AddVolumeOptions) {
 	Expect(volumeOptions.Disk.DiskDevice.LUN.Bus).To(Equal(v1.DiskBusSCSI))
 }
 
+func verifyDiskTypeCDRom(volumeOptions *v1.AddVolumeOptions) {
+	Expect(volumeOptions.Disk.DiskDevice.Disk).To(BeNil())
+	Expect(volumeOptions.Disk.DiskDevice.CDRom).ToNot(BeNil())
+	Expect(volumeOptions.Disk.DiskDevice.CDRom.Bus).To(Equal(v1.DiskBusSCSI))
+}
+
+func verifyBootOrder(bootOrder uint) verifyFn {
+	return func(volumeOptions *v1.AddVolumeOptions) {
+		Expect(volumeOptions.Disk.BootOrder).To(HaveValue(Equal(bootOrder)))
+	}
+}
+
 func verifyDiskSerial(serial string) verifyFn {
 	return func(volumeOptions *v1.AddVolumeOptions) {
 		Expect(volumeOptions.Disk.Serial).To(Equal(serial))
@@ -339,3 +393,9 @@ func verifyCache(cache v1.DriverCache) verifyFn {
 		Expect(volumeOptions.Disk.Cache).To(Equal(cache))
 	}
 }
+
+func verifyIOTune(ioTune *v1.DiskIOTune) verifyFn {
+	return func(volumeOptions *v1.AddVolumeOptions) {
+		Expect(equality.Semantic.DeepEqual(volumeOptions.Disk.IOTune, ioTune)).To(BeTrue())
+	}
+}
@@ -0,0 +1,116 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package vm_test
+
+import (
+	"context"
+	"errors"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	k8smetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+
+	"kubevirt.io/kubevirt/pkg/virtctl/testing"
+	"kubevirt.io/kubevirt/pkg/virtctl/vm"
+)
+
+var _ = Describe("Lifecycle commands with a selector", func() {
+	const selector = "app=web"
+
+	var vmInterface *kubecli.MockVirtualMachineInterface
+
+	BeforeEach(func() {
+		ctrl := gomock.NewController(GinkgoT())
+		kubecli.GetKubevirtClientFromClientConfig = kubecli.GetMockKubevirtClientFromClientConfig
+		kubecli.MockKubevirtClientInstance = kubecli.NewMockKubevirtClient(ctrl)
+		vmInterface = kubecli.NewMockVirtualMachineInterface(ctrl)
+	})
+
+	newVM := func(namespace, name string) v1.VirtualMachine {
+		newVM := kubecli.NewMinimalVM(name)
+		newVM.Namespace = namespace
+		return *newVM
+	}
+
+	expectList := func(vms ...v1.VirtualMachine) {
+		vmInterface.EXPECT().List(gomock.Any(), k8smetav1.ListOptions{LabelSelector: selector}).
+			Return(&v1.VirtualMachineList{Items: vms}, nil).Times(1)
+	}
+
+	It("should stop all matching VMs in all namespaces", func() {
+		kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachine(k8smetav1.NamespaceAll).Return(vmInterface).Times(1)
+		kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachine("ns1").Return(vmInterface).Times(1)
+		kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachine("ns2").Return(vmInterface).Times(1)
+		expectList(newVM("ns1", "vm1"), newVM("ns2", "vm2"))
+		vmInterface.EXPECT().Stop(context.Background(), "vm1", &v1.StopOptions{}).Return(nil).Times(1)
+		vmInterface.EXPECT().Stop(context.Background(), "vm2", &v1.StopOptions{}).Return(nil).Times(1)
+
+		out, err := testing.NewRepeatableVirtctlCommandWithOut(vm.COMMAND_STOP, "-l", selector, "-A")()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(out)).To(ContainSubstring("VM ns1/vm1 was scheduled to stop"))
+		Expect(string(out)).To(ContainSubstring("VM ns2/vm2 was scheduled to stop"))
+		Expect(string(out)).To(ContainSubstring("2 of 2 VMs were scheduled to stop"))
+	})
+
+	It("should apply the command to all VMs and report the ones which failed", func() {
+		kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachine(k8smetav1.NamespaceDefault).Return(vmInterface).AnyTimes()
+		expectList(newVM(k8smetav1.NamespaceDefault, "vm1"), newVM(k8smetav1.NamespaceDefault, "vm2"), newVM(k8smetav1.NamespaceDefault, "vm3"))
+		vmInterface.EXPECT().Start(context.Background(), "vm1", gomock.Any()).Return(nil).Times(1)
+		vmInterface.EXPECT().Start(context.Background(), "vm2", gomock.Any()).Return(errors.New("conflict")).Times(1)
+		vmInterface.EXPECT().Start(context.Background(), "vm3", gomock.Any()).Return(nil).Times(1)
+
+		out, err := testing.NewRepeatableVirtctlCommandWithOut(vm.COMMAND_START, "-l", selector, "--concurrency", "1")()
+		Expect(err).To(MatchError("failed to start 1 of 3 VMs: default/vm2"))
+		Expect(string(out)).To(ContainSubstring("2 of 3 VMs were scheduled to start"))
+	})
+
+	It("should succeed if no VM matches", func() {
+		kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachine(k8smetav1.NamespaceDefault).Return(vmInterface).AnyTimes()
+		expectList()
+
+		out, err := testing.NewRepeatableVirtctlCommandWithOut(vm.COMMAND_RESTART, "-l", selector)()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(out)).To(ContainSubstring(`No VMs match the selector "app=web"`))
+	})
+
+	It("should migrate all matching VMs", func() {
+		kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachine(k8smetav1.NamespaceDefault).Return(vmInterface).AnyTimes()
+		expectList(newVM(k8smetav1.NamespaceDefault, "vm1"), newVM(k8smetav1.NamespaceDefault, "vm2"))
+		vmInterface.EXPECT().Migrate(context.Background(), gomock.Any(), &v1.MigrateOptions{}).Return(nil).Times(2)
+
+		out, err := testing.NewRepeatableVirtctlCommandWithOut(vm.COMMAND_MIGRATE, "--selector", selector)()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(out)).To(ContainSubstring("2 of 2 VMs were scheduled to migrate"))
+	})
+
+	DescribeTable("should reject", func(expectedErr string, args ...string) {
+		err := testing.NewRepeatableVirtctlCommand(args...)()
+		Expect(err).To(MatchError(expectedErr))
+	},
+		Entry("a name together with a selector", "a name can't be given together with --selector", vm.COMMAND_START, "myvm", "-l", selector),
+		Entry("all namespaces without a selector", "--all-namespaces can only be used together with --selector", vm.COMMAND_STOP, "myvm", "-A"),
+		Entry("a concurrency below 1", "--concurrency must be at least 1", vm.COMMAND_RESTART, "-l", selector, "--concurrency", "0"),
+	)
+})
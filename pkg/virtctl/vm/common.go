@@ -23,7 +23,12 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/spf13/cobra"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"kubevirt.io/client-go/kubecli"
+
+	"kubevirt.io/kubevirt/pkg/virtctl/batch"
 )
 
 const (
@@ -50,6 +55,7 @@ var (
 
 type Command struct {
 	command string
+	batch   batch.Options
 }
 
 func usage(cmd string) string {
@@ -60,6 +66,19 @@ func usage(cmd string) string {
 	return fmt.Sprintf("  # %s a virtual machine called 'myvm':\n  {{ProgramName}} %s myvm", strings.Title(cmd), cmd)
 }
 
+func batchUsage(cmd string) string {
+	return fmt.Sprintf("\n\n  # %s all virtual machines labeled 'app=web' in all namespaces, at most 10 at a time:\n  {{ProgramName}} %s -l app=web -A --concurrency 10", strings.Title(cmd), cmd)
+}
+
+// runBatch applies op to all VMs matching the selector of the command
+func runBatch(cmd *cobra.Command, opts *batch.Options, verb string, virtClient kubecli.KubevirtClient, namespace string, op func(namespace, name string) error) error {
+	targets, err := opts.VMs(cmd.Context(), virtClient, namespace)
+	if err != nil {
+		return err
+	}
+	return opts.Run(cmd, "VM", verb, targets, op)
+}
+
 func setDryRunOption(dryRun bool) []string {
 	if dryRun {
 		fmt.Printf("Dry Run execution\n")
@@ -22,41 +22,61 @@ package vm
 import (
 	"context"
 	"fmt"
+	"math"
+	"time"
 
 	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/watch"
 
 	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
 
+	"kubevirt.io/kubevirt/pkg/virtctl/batch"
 	"kubevirt.io/kubevirt/pkg/virtctl/clientconfig"
 	"kubevirt.io/kubevirt/pkg/virtctl/templates"
 )
 
-const COMMAND_MIGRATE = "migrate"
+const (
+	COMMAND_MIGRATE = "migrate"
+
+	waitArg    = "wait"
+	timeoutArg = "timeout"
+
+	defaultMigrationTimeout = 15 * time.Minute
+)
 
 type migrateCommand struct {
 	command           string
 	addedNodeSelector map[string]string
+	wait              bool
+	timeout           time.Duration
+	batch             batch.Options
 }
 
 func NewMigrateCommand() *cobra.Command {
 	c := migrateCommand{command: COMMAND_MIGRATE}
 	cmd := &cobra.Command{
-		Use:     "migrate (VM)",
-		Short:   "Migrate a virtual machine.",
-		Example: usage(COMMAND_MIGRATE),
-		Args:    cobra.ExactArgs(1),
-		RunE:    c.migrateRun,
+		Use:   "migrate (VM)",
+		Short: "Migrate a virtual machine.",
+		Example: usage(COMMAND_MIGRATE) + `
+
+  # Migrate a virtual machine called 'myvm' and follow the progress until the migration finished:
+  {{ProgramName}} migrate myvm --wait --timeout 10m` + batchUsage(COMMAND_MIGRATE),
+		Args: c.batch.Args(1),
+		RunE: c.migrateRun,
 	}
+	c.batch.AddFlags(cmd.Flags())
 
 	cmd.Flags().StringToStringVar(&c.addedNodeSelector, "addedNodeSelector", nil, "--addedNodeSelector=key=value1,key2=value2: configure an additional node selector for the one-off migration attempt. AddedNodeSelector can only restrict constraints already set on the VM. By default the scheduler is responsible for finding the best Node, which is the recommended way of migrating VMs.")
 	cmd.Flags().BoolVar(&dryRun, dryRunArg, false, dryRunCommandUsage)
+	cmd.Flags().BoolVar(&c.wait, waitArg, false, "Wait until the migration finished and report its progress. The command fails if the migration fails.")
+	cmd.Flags().DurationVar(&c.timeout, timeoutArg, defaultMigrationTimeout, "The time to wait for the migration to finish when --wait is set.")
 	cmd.SetUsageTemplate(templates.UsageTemplate())
 	return cmd
 }
 
 func (c *migrateCommand) migrateRun(cmd *cobra.Command, args []string) error {
-	vmiName := args[0]
-
 	virtClient, namespace, _, err := clientconfig.ClientAndNamespaceFromContext(cmd.Context())
 	if err != nil {
 		return err
@@ -64,17 +84,181 @@ func (c *migrateCommand) migrateRun(cmd *cobra.Command, args []string) error {
 
 	dryRunOption := setDryRunOption(dryRun)
 
-	options := &v1.MigrateOptions{
-		DryRun:            dryRunOption,
-		AddedNodeSelector: c.addedNodeSelector,
+	migrate := func(namespace, name string) error {
+		if c.wait && !dryRun {
+			return c.migrateAndWait(cmd, virtClient, namespace, name)
+		}
+
+		options := &v1.MigrateOptions{
+			DryRun:            dryRunOption,
+			AddedNodeSelector: c.addedNodeSelector,
+		}
+
+		err := virtClient.VirtualMachine(namespace).Migrate(context.Background(), name, options)
+		if err != nil {
+			return fmt.Errorf("Error migrating VirtualMachine %v", err)
+		}
+		return nil
 	}
 
-	err = virtClient.VirtualMachine(namespace).Migrate(context.Background(), vmiName, options)
-	if err != nil {
-		return fmt.Errorf("Error migrating VirtualMachine %v", err)
+	if c.batch.Enabled() {
+		return runBatch(cmd, &c.batch, c.command, virtClient, namespace, migrate)
+	}
+
+	vmiName := args[0]
+	if c.wait && !dryRun {
+		return migrate(namespace, vmiName)
+	}
+	if err := migrate(namespace, vmiName); err != nil {
+		return err
 	}
 
 	fmt.Printf("VM %s was scheduled to %s\n", vmiName, c.command)
 
 	return nil
 }
+
+// migrateAndWait migrates the VM through the migrate subresource and follows the migration it created. The watch of
+// the migrations starts before the migration is requested, so that the new migration is told apart from older ones.
+func (c *migrateCommand) migrateAndWait(cmd *cobra.Command, virtClient kubecli.KubevirtClient, namespace, vmiName string) error {
+	ctx, cancel := context.WithTimeout(cmd.Context(), c.timeout)
+	defer cancel()
+	timeoutSeconds := int64(math.Ceil(c.timeout.Seconds()))
+
+	migrationOptions := metav1.ListOptions{
+		LabelSelector:  v1.MigrationSelectorLabel + "=" + vmiName,
+		TimeoutSeconds: &timeoutSeconds,
+	}
+	existing, err := virtClient.VirtualMachineInstanceMigration(namespace).List(ctx, migrationOptions)
+	if err != nil {
+		return fmt.Errorf("Error migrating VirtualMachine %v", err)
+	}
+	knownMigrations := map[string]struct{}{}
+	for _, migration := range existing.Items {
+		knownMigrations[migration.Name] = struct{}{}
+	}
+	migrationOptions.ResourceVersion = existing.ResourceVersion
+	migrationWatcher, err := virtClient.VirtualMachineInstanceMigration(namespace).Watch(ctx, migrationOptions)
+	if err != nil {
+		return fmt.Errorf("Error migrating VirtualMachine %v", err)
+	}
+	defer migrationWatcher.Stop()
+	vmiWatcher, err := virtClient.VirtualMachineInstance(namespace).Watch(ctx, metav1.ListOptions{
+		FieldSelector:  "metadata.name=" + vmiName,
+		TimeoutSeconds: &timeoutSeconds,
+	})
+	if err != nil {
+		return fmt.Errorf("Error migrating VirtualMachine %v", err)
+	}
+	defer vmiWatcher.Stop()
+
+	err = virtClient.VirtualMachine(namespace).Migrate(ctx, vmiName, &v1.MigrateOptions{AddedNodeSelector: c.addedNodeSelector})
+	if err != nil {
+		return fmt.Errorf("Error migrating VirtualMachine %v", err)
+	}
+
+	var migration *v1.VirtualMachineInstanceMigration
+	var vmiState *v1.VirtualMachineInstanceMigrationState
+	lastReport := ""
+	for {
+		select {
+		case <-ctx.Done():
+			if migration == nil {
+				return fmt.Errorf("timed out after %s waiting for the migration of VM %s to start", c.timeout, vmiName)
+			}
+			return fmt.Errorf("timed out after %s waiting for migration %s of VM %s to finish", c.timeout, migration.Name, vmiName)
+		case event, ok := <-migrationWatcher.ResultChan():
+			if !ok {
+				return fmt.Errorf("the watch of the migrations of VM %s was closed", vmiName)
+			}
+			observed, isMigration := event.Object.(*v1.VirtualMachineInstanceMigration)
+			if !isMigration || event.Type == watch.Deleted {
+				continue
+			}
+			if migration == nil {
+				if _, known := knownMigrations[observed.Name]; known {
+					continue
+				}
+				cmd.Printf("VirtualMachineInstanceMigration %s/%s created\n", namespace, observed.Name)
+			} else if observed.Name != migration.Name {
+				continue
+			}
+			migration = observed
+		case event, ok := <-vmiWatcher.ResultChan():
+			if !ok {
+				return fmt.Errorf("the watch of VM %s was closed", vmiName)
+			}
+			if vmi, isVMI := event.Object.(*v1.VirtualMachineInstance); isVMI {
+				vmiState = vmi.Status.MigrationState
+			}
+		}
+		if migration == nil {
+			continue
+		}
+
+		state := vmiState
+		if state != nil && state.MigrationUID != migration.UID {
+			state = nil
+		}
+		switch migration.Status.Phase {
+		case v1.MigrationSucceeded:
+			cmd.Printf("VM %s was migrated%s\n", vmiName, migrationSummary(state))
+			return nil
+		case v1.MigrationFailed:
+			reason := "unknown reason"
+			if state != nil && state.FailureReason != "" {
+				reason = state.FailureReason
+			}
+			return fmt.Errorf("migration %s of VM %s failed: %s", migration.Name, vmiName, reason)
+		case v1.MigrationCancelled:
+			return fmt.Errorf("migration %s of VM %s was cancelled", migration.Name, vmiName)
+		}
+
+		if report := migrationReport(migration, state); report != lastReport {
+			cmd.Println(report)
+			lastReport = report
+		}
+	}
+}
+
+func migrationReport(migration *v1.VirtualMachineInstanceMigration, state *v1.VirtualMachineInstanceMigrationState) string {
+	phase := migration.Status.Phase
+	if phase == "" {
+		phase = v1.MigrationPending
+	}
+	report := fmt.Sprintf("Migration %s: phase %s", migration.Name, phase)
+	if state == nil {
+		return report
+	}
+	if state.TargetNode != "" {
+		report += fmt.Sprintf(", target node %s", state.TargetNode)
+	}
+	if progress := state.Progress; progress != nil && progress.DataTotalBytes > 0 {
+		report += fmt.Sprintf(", transferred %dMiB, remaining %dMiB of %dMiB, expected downtime %dms",
+			toMiB(progress.DataProcessedBytes), toMiB(progress.DataRemainingBytes), toMiB(progress.DataTotalBytes),
+			progress.DowntimeMilliseconds)
+	}
+	return report
+}
+
+func migrationSummary(state *v1.VirtualMachineInstanceMigrationState) string {
+	if state == nil {
+		return ""
+	}
+	summary := ""
+	if state.TargetNode != "" {
+		summary += fmt.Sprintf(" to node %s", state.TargetNode)
+	}
+	if state.StartTimestamp != nil && state.EndTimestamp != nil {
+		summary += fmt.Sprintf(" in %s", state.EndTimestamp.Sub(state.StartTimestamp.Time))
+	}
+	if state.Progress != nil {
+		summary += fmt.Sprintf(", transferred %dMiB with a downtime of %dms",
+			toMiB(state.Progress.DataProcessedBytes), state.Progress.DowntimeMilliseconds)
+	}
+	return summary
+}
+
+func toMiB(bytes int64) int64 {
+	return bytes / 1024 / 1024
+}
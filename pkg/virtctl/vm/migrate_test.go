@@ -27,9 +27,13 @@ import (
 	. "github.com/onsi/gomega"
 
 	k8smetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/watch"
+	k8stesting "k8s.io/client-go/testing"
 
 	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/api"
 	"kubevirt.io/client-go/kubecli"
+	kubevirtfake "kubevirt.io/client-go/kubevirt/fake"
 
 	"kubevirt.io/kubevirt/pkg/virtctl/testing"
 )
@@ -104,4 +108,143 @@ var _ = Describe("Migrate command", func() {
 			"--addedNodeSelector", "key1,key2"),
 	)
 
+	Context("with --wait", func() {
+		const migrationName = "kubevirt-migrate-vm-abcde"
+		const migrationUID = "migration-uid"
+
+		var (
+			virtClient       *kubevirtfake.Clientset
+			migrationWatcher *watch.FakeWatcher
+			vmiWatcher       *watch.FakeWatcher
+			migrated         chan struct{}
+		)
+
+		BeforeEach(func() {
+			virtClient = kubevirtfake.NewSimpleClientset()
+			kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachineInstanceMigration(k8smetav1.NamespaceDefault).
+				Return(virtClient.KubevirtV1().VirtualMachineInstanceMigrations(k8smetav1.NamespaceDefault)).AnyTimes()
+			kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachineInstance(k8smetav1.NamespaceDefault).
+				Return(virtClient.KubevirtV1().VirtualMachineInstances(k8smetav1.NamespaceDefault)).AnyTimes()
+
+			migrationWatcher = watch.NewFake()
+			vmiWatcher = watch.NewFake()
+			virtClient.PrependWatchReactor("virtualmachineinstancemigrations", k8stesting.DefaultWatchReactor(migrationWatcher, nil))
+			virtClient.PrependWatchReactor("virtualmachineinstances", k8stesting.DefaultWatchReactor(vmiWatcher, nil))
+
+			migrated = make(chan struct{})
+			kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachine(k8smetav1.NamespaceDefault).Return(vmInterface).AnyTimes()
+			vmInterface.EXPECT().Migrate(gomock.Any(), vmName, &v1.MigrateOptions{}).DoAndReturn(
+				func(_ context.Context, _ string, _ *v1.MigrateOptions) error {
+					close(migrated)
+					return nil
+				}).AnyTimes()
+		})
+
+		newMigration := func(phase v1.VirtualMachineInstanceMigrationPhase) *v1.VirtualMachineInstanceMigration {
+			return &v1.VirtualMachineInstanceMigration{
+				ObjectMeta: k8smetav1.ObjectMeta{
+					Name:   migrationName,
+					UID:    migrationUID,
+					Labels: map[string]string{v1.MigrationSelectorLabel: vmName},
+				},
+				Spec:   v1.VirtualMachineInstanceMigrationSpec{VMIName: vmName},
+				Status: v1.VirtualMachineInstanceMigrationStatus{Phase: phase},
+			}
+		}
+
+		newVMI := func(migrationState *v1.VirtualMachineInstanceMigrationState) *v1.VirtualMachineInstance {
+			vmi := api.NewMinimalVMI(vmName)
+			vmi.Status.MigrationState = migrationState
+			return vmi
+		}
+
+		runMigrate := func(args ...string) (chan struct{}, *[]byte, *error) {
+			done := make(chan struct{})
+			var out []byte
+			var err error
+			go func() {
+				defer GinkgoRecover()
+				defer close(done)
+				out, err = testing.NewRepeatableVirtctlCommandWithOut(append([]string{"migrate", vmName, "--wait"}, args...)...)()
+			}()
+			return done, &out, &err
+		}
+
+		It("should migrate through the subresource and report the progress on every update until it succeeded", func() {
+			done, out, err := runMigrate()
+			Eventually(migrated).Should(BeClosed())
+
+			migrationWatcher.Add(newMigration(v1.MigrationPending))
+			migrationWatcher.Modify(newMigration(v1.MigrationRunning))
+			vmiWatcher.Modify(newVMI(&v1.VirtualMachineInstanceMigrationState{
+				MigrationUID: migrationUID,
+				TargetNode:   "node02",
+				Progress: &v1.MigrationProgress{
+					DataProcessedBytes:   1024 * 1024 * 1024,
+					DataRemainingBytes:   3 * 1024 * 1024 * 1024,
+					DataTotalBytes:       4 * 1024 * 1024 * 1024,
+					DowntimeMilliseconds: 300,
+				},
+			}))
+			migrationWatcher.Modify(newMigration(v1.MigrationSucceeded))
+			Eventually(done).Should(BeClosed())
+
+			Expect(*err).ToNot(HaveOccurred())
+			Expect(string(*out)).To(ContainSubstring("VirtualMachineInstanceMigration default/kubevirt-migrate-vm-abcde created"))
+			Expect(string(*out)).To(ContainSubstring("Migration kubevirt-migrate-vm-abcde: phase Pending\n"))
+			Expect(string(*out)).To(ContainSubstring("Migration kubevirt-migrate-vm-abcde: phase Running\n"))
+			Expect(string(*out)).To(ContainSubstring("phase Running, target node node02, transferred 1024MiB, remaining 3072MiB of 4096MiB, expected downtime 300ms"))
+			Expect(string(*out)).To(ContainSubstring("VM testvm was migrated to node node02"))
+		})
+
+		It("should ignore the migrations which existed before", func() {
+			existing := newMigration(v1.MigrationFailed)
+			existing.Name = "kubevirt-migrate-vm-older"
+			_, createErr := virtClient.KubevirtV1().VirtualMachineInstanceMigrations(k8smetav1.NamespaceDefault).Create(context.Background(), existing, k8smetav1.CreateOptions{})
+			Expect(createErr).ToNot(HaveOccurred())
+
+			done, out, err := runMigrate()
+			Eventually(migrated).Should(BeClosed())
+
+			migrationWatcher.Modify(existing)
+			migrationWatcher.Add(newMigration(v1.MigrationSucceeded))
+			Eventually(done).Should(BeClosed())
+
+			Expect(*err).ToNot(HaveOccurred())
+			Expect(string(*out)).To(ContainSubstring("VirtualMachineInstanceMigration default/kubevirt-migrate-vm-abcde created"))
+		})
+
+		It("should fail when the migration failed", func() {
+			done, _, err := runMigrate()
+			Eventually(migrated).Should(BeClosed())
+
+			migrationWatcher.Add(newMigration(v1.MigrationRunning))
+			vmiWatcher.Modify(newVMI(&v1.VirtualMachineInstanceMigrationState{
+				MigrationUID:  migrationUID,
+				Failed:        true,
+				FailureReason: "target pod could not be scheduled",
+			}))
+			migrationWatcher.Modify(newMigration(v1.MigrationFailed))
+			Eventually(done).Should(BeClosed())
+
+			Expect(*err).To(MatchError("migration kubevirt-migrate-vm-abcde of VM testvm failed: target pod could not be scheduled"))
+		})
+
+		It("should fail when the migration did not finish in time", func() {
+			done, _, err := runMigrate("--timeout", "100ms")
+			Eventually(migrated).Should(BeClosed())
+
+			migrationWatcher.Add(newMigration(v1.MigrationScheduling))
+			Eventually(done).Should(BeClosed())
+
+			Expect(*err).To(MatchError(ContainSubstring("timed out after 100ms waiting for migration kubevirt-migrate-vm-abcde of VM testvm to finish")))
+		})
+
+		It("should use the migrate subresource and not wait on dry-run", func() {
+			vmInterface.EXPECT().Migrate(context.Background(), vmName, &v1.MigrateOptions{DryRun: []string{k8smetav1.DryRunAll}}).Return(nil).Times(1)
+
+			Expect(testing.NewRepeatableVirtctlCommand("migrate", vmName, "--wait", "--dry-run")()).To(Succeed())
+		})
+	})
+
 })
@@ -38,10 +38,11 @@ func NewRestartCommand() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:     "restart (VM)",
 		Short:   "Restart a virtual machine.",
-		Example: usage(COMMAND_RESTART),
-		Args:    cobra.ExactArgs(1),
+		Example: usage(COMMAND_RESTART) + batchUsage(COMMAND_RESTART),
+		Args:    c.batch.Args(1),
 		RunE:    c.restartRun,
 	}
+	c.batch.AddFlags(cmd.Flags())
 	cmd.Flags().BoolVar(&forceRestart, forceArg, false, "--force=false: Only used when grace-period=0. If true, immediately remove VMI pod from API and bypass graceful deletion. Note that immediate deletion of some resources may result in inconsistency or data loss and requires confirmation.")
 	cmd.Flags().Int64Var(&gracePeriod, gracePeriodArg, -1, "--grace-period=-1: Period of time in seconds given to the VMI to terminate gracefully. Can only be set to 0 when --force is true (force deletion). Currently only setting 0 is supported.")
 	cmd.Flags().BoolVar(&dryRun, dryRunArg, false, dryRunCommandUsage)
@@ -50,7 +51,6 @@ func NewRestartCommand() *cobra.Command {
 }
 
 func (o *Command) restartRun(cmd *cobra.Command, args []string) error {
-	vmiName := args[0]
 	errorFmt := "error restarting VirtualMachine: %v"
 
 	virtClient, namespace, _, err := clientconfig.ClientAndNamespaceFromContext(cmd.Context())
@@ -71,9 +71,21 @@ func (o *Command) restartRun(cmd *cobra.Command, args []string) error {
 		errorFmt = "error force restarting VirtualMachine: %v"
 	}
 
-	err = virtClient.VirtualMachine(namespace).Restart(context.Background(), vmiName, restartOpts)
-	if err != nil {
-		return fmt.Errorf(errorFmt, err)
+	restart := func(namespace, name string) error {
+		err := virtClient.VirtualMachine(namespace).Restart(context.Background(), name, restartOpts)
+		if err != nil {
+			return fmt.Errorf(errorFmt, err)
+		}
+		return nil
+	}
+
+	if o.batch.Enabled() {
+		return runBatch(cmd, &o.batch, o.command, virtClient, namespace, restart)
+	}
+
+	vmiName := args[0]
+	if err := restart(namespace, vmiName); err != nil {
+		return err
 	}
 
 	fmt.Printf("VM %s was scheduled to %s\n", vmiName, o.command)
@@ -45,10 +45,11 @@ func NewStartCommand() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:     "start (VM)",
 		Short:   "Start a virtual machine.",
-		Example: usage(COMMAND_START),
-		Args:    cobra.ExactArgs(1),
+		Example: usage(COMMAND_START) + batchUsage(COMMAND_START),
+		Args:    c.batch.Args(1),
 		RunE:    c.startRun,
 	}
+	c.batch.AddFlags(cmd.Flags())
 	cmd.Flags().BoolVar(&startPaused, pausedArg, false, "--paused=false: If set to true, start virtual machine in paused state")
 	cmd.Flags().BoolVar(&dryRun, dryRunArg, false, dryRunCommandUsage)
 	cmd.SetUsageTemplate(templates.UsageTemplate())
@@ -56,8 +57,6 @@ func NewStartCommand() *cobra.Command {
 }
 
 func (o *Command) startRun(cmd *cobra.Command, args []string) error {
-	vmiName := args[0]
-
 	virtClient, namespace, _, err := clientconfig.ClientAndNamespaceFromContext(cmd.Context())
 	if err != nil {
 		return err
@@ -65,9 +64,21 @@ func (o *Command) startRun(cmd *cobra.Command, args []string) error {
 
 	dryRunOption := setDryRunOption(dryRun)
 
-	err = virtClient.VirtualMachine(namespace).Start(context.Background(), vmiName, &v1.StartOptions{Paused: startPaused, DryRun: dryRunOption})
-	if err != nil {
-		return fmt.Errorf("Error starting VirtualMachine %v", err)
+	start := func(namespace, name string) error {
+		err := virtClient.VirtualMachine(namespace).Start(context.Background(), name, &v1.StartOptions{Paused: startPaused, DryRun: dryRunOption})
+		if err != nil {
+			return fmt.Errorf("Error starting VirtualMachine %v", err)
+		}
+		return nil
+	}
+
+	if o.batch.Enabled() {
+		return runBatch(cmd, &o.batch, o.command, virtClient, namespace, start)
+	}
+
+	vmiName := args[0]
+	if err := start(namespace, vmiName); err != nil {
+		return err
 	}
 
 	fmt.Printf("VM %s was scheduled to %s\n", vmiName, o.command)
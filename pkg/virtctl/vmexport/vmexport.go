@@ -30,6 +30,8 @@ import (
 	"net/http"
 	"net/url"
 	"os"
+	"os/exec"
+	"path/filepath"
 	"strconv"
 	"strings"
 	"time"
@@ -84,6 +86,8 @@ const (
 	LABELS_FLAG            = "--labels"
 	ANNOTATIONS_FLAG       = "--annotations"
 	READINESS_TIMEOUT_FLAG = "--readiness-timeout"
+	CONVERT_TO_FLAG        = "--convert-to"
+	OVA_FLAG               = "--ova"
 
 	// Possible output format for manifests
 	OUTPUT_FORMAT_JSON = "json"
@@ -93,6 +97,14 @@ const (
 	GZIP_FORMAT = "gzip"
 	RAW_FORMAT  = "raw"
 
+	// Possible formats to convert downloaded volumes to
+	QCOW2_FORMAT = "qcow2"
+	VMDK_FORMAT  = "vmdk"
+	VHDX_FORMAT  = "vhdx"
+
+	// qemuImg is the binary used to convert downloaded volumes
+	qemuImg = "qemu-img"
+
 	ACCEPT           = "Accept"
 	APPLICATION_YAML = "application/yaml"
 	APPLICATION_JSON = "application/json"
@@ -147,6 +159,8 @@ var (
 	resourceLabels       []string
 	resourceAnnotations  []string
 	readinessTimeout     string
+	convertTo            string
+	exportOVA            bool
 )
 
 type VMExportInfo struct {
@@ -156,6 +170,7 @@ type VMExportInfo struct {
 	DeleteVme        bool
 	IncludeSecret    bool
 	ExportManifest   bool
+	ExportOVA        bool
 	Decompress       bool
 	PortForward      bool
 	LocalPort        string
@@ -170,6 +185,7 @@ type VMExportInfo struct {
 	TTL              metav1.Duration
 	DownloadRetries  int
 	ReadinessTimeout time.Duration
+	ConvertTo        string
 	Labels           map[string]string
 	Annotations      map[string]string
 }
@@ -190,6 +206,9 @@ var HandleHTTPGetRequestFn = HandleHTTPGetRequest
 // RunPortForwardFn allows overriding the default port-forwarder (useful for unit testing)
 var RunPortForwardFn = RunPortForward
 
+// ConvertImageFn allows overriding the function converting downloaded volumes (useful for unit testing)
+var ConvertImageFn = ConvertImage
+
 var exportFunction func(client kubecli.KubevirtClient, vmeInfo *VMExportInfo) error
 
 // TODO Should use cmd.Printf and cmd.SetOut
@@ -212,6 +231,9 @@ func usage() string {
 	# Download a volume from an already existing VirtualMachineExport (--volume is optional when only one volume is available)
 	{{ProgramName}} vmexport download vm1-export --volume=volume1 --output=disk.img.gz
 
+	# Download a volume and convert it to qcow2, either on the server side if the export offers qcow2 or locally with qemu-img
+	{{ProgramName}} vmexport download vm1-export --volume=volume1 --output=disk.qcow2 --convert-to=qcow2
+
 	# Download a volume as before but through local port 5410
 	{{ProgramName}} vmexport download vm1-export --volume=volume1 --output=disk.img.gz --port-forward --local-port=5410
 
@@ -222,7 +244,10 @@ func usage() string {
 	{{ProgramName}} vmexport download vm1-export --vm=vm1 --manifest
 
 	# Get the VirtualMachine manifest in Yaml format from an existing VirtualMachineExport including CDI header secret
-	{{ProgramName}} vmexport download existing-export --include-secret --manifest`
+	{{ProgramName}} vmexport download existing-export --include-secret --manifest
+
+	# Create a VirtualMachineExport and download the VirtualMachine and its disks as an OVA
+	{{ProgramName}} vmexport download vm1-export --vm=vm1 --ova --output=vm1.ova`
 	return usage
 }
 
@@ -258,9 +283,11 @@ func NewVirtualMachineExportCommand() *cobra.Command {
 	cmd.Flags().IntVar(&downloadRetries, "retry", 0, "When export server returns a transient error, we retry this number of times before giving up")
 	cmd.Flags().BoolVar(&includeSecret, "include-secret", false, "When used with manifest and set to true include a secret that contains proper headers for CDI to import using the manifest")
 	cmd.Flags().BoolVar(&exportManifest, "manifest", false, "Instead of downloading a volume, retrieve the VM manifest")
+	cmd.Flags().BoolVar(&exportOVA, "ova", false, "Instead of downloading a volume, download the VM and its disks as an OVA that can be imported into VMware or VirtualBox")
 	cmd.Flags().StringSliceVar(&resourceLabels, "labels", nil, "Specify custom labels to VM export object and its associated pod")
 	cmd.Flags().StringSliceVar(&resourceAnnotations, "annotations", nil, "Specify custom annotations to VM export object and its associated pod")
 	cmd.Flags().StringVar(&readinessTimeout, "readiness-timeout", "", "Specify maximum wait for VM export object to be ready")
+	cmd.Flags().StringVar(&convertTo, "convert-to", "", "When used with the 'download' option, converts the downloaded volume to the specified format. Valid options are qcow2, vmdk and vhdx. The converted image is downloaded if the export offers it, otherwise the volume is converted locally using qemu-img.")
 	cmd.SetUsageTemplate(templates.UsageTemplate())
 
 	return cmd
@@ -334,7 +361,11 @@ func (c *command) parseExportArguments(args []string, vmeInfo *VMExportInfo) err
 func (c *command) initVMExportInfo(vmeInfo *VMExportInfo) error {
 	vmeInfo.ExportSource = getExportSource()
 	// User wants the output in a file, create
-	if outputFile != "" && outputFile != "-" {
+	if convertTo != "" {
+		// The converted image is written to the output file once the download finished
+		vmeInfo.OutputFile = outputFile
+		vmeInfo.ConvertTo = convertTo
+	} else if outputFile != "" && outputFile != "-" {
 		vmeInfo.OutputFile = outputFile
 		output, err := os.Create(vmeInfo.OutputFile)
 		if err != nil {
@@ -364,6 +395,7 @@ func (c *command) initVMExportInfo(vmeInfo *VMExportInfo) error {
 	vmeInfo.OutputFormat = manifestOutputFormat
 	vmeInfo.IncludeSecret = includeSecret
 	vmeInfo.ExportManifest = exportManifest
+	vmeInfo.ExportOVA = exportOVA
 	if portForward {
 		vmeInfo.PortForward = portForward
 		vmeInfo.Insecure = true
@@ -537,7 +569,15 @@ func downloadVirtualMachineExport(client kubecli.KubevirtClient, vmeInfo *VMExpo
 		return getVirtualMachineManifest(client, vmexport, vmeInfo)
 	}
 
+	// Download the VM and its disks packaged as an OVA
+	if vmeInfo.ExportOVA {
+		return downloadOVA(client, vmexport, vmeInfo)
+	}
+
 	// Download the exported volume
+	if vmeInfo.ConvertTo != "" {
+		return downloadAndConvertVolume(client, vmexport, vmeInfo)
+	}
 	return downloadVolume(client, vmexport, vmeInfo)
 }
 
@@ -581,6 +621,38 @@ func getVirtualMachineManifest(client kubecli.KubevirtClient, vmexport *exportv1
 	return true, nil
 }
 
+// downloadOVA handles the process of downloading the VM and its disks packaged as an OVA from a VirtualMachineExport
+func downloadOVA(client kubecli.KubevirtClient, vmexport *exportv1.VirtualMachineExport, vmeInfo *VMExportInfo) (bool, error) {
+	manifestMap, err := GetManifestUrlsFromVirtualMachineExport(vmexport, vmeInfo)
+	if err != nil {
+		return false, err
+	}
+	downloadUrl, ok := manifestMap[exportv1.OVA]
+	if !ok {
+		return false, fmt.Errorf("'%s/%s' VirtualMachineExport doesn't offer an OVA", vmexport.Namespace, vmexport.Name)
+	}
+
+	resp, err := HandleHTTPGetRequestFn(client, vmexport, downloadUrl, vmeInfo.Insecure, vmeInfo.ServiceURL, nil)
+	if err != nil {
+		return false, err
+	}
+	defer resp.Body.Close()
+
+	// Check server response
+	if resp.StatusCode != http.StatusOK {
+		printToOutput("Bad status: %s\n", resp.Status)
+		return false, nil
+	}
+
+	if err := copyFileWithProgressBar(vmeInfo.OutputWriter, resp, false); err != nil {
+		return false, err
+	}
+
+	printToOutput("Download finished succesfully\n")
+
+	return true, nil
+}
+
 // downloadVolume handles the process of downloading the requested volume from a VirtualMachineExport
 func downloadVolume(client kubecli.KubevirtClient, vmexport *exportv1.VirtualMachineExport, vmeInfo *VMExportInfo) (bool, error) {
 	// Extract the URL from the vmexport
@@ -611,6 +683,78 @@ func downloadVolume(client kubecli.KubevirtClient, vmexport *exportv1.VirtualMac
 	return true, nil
 }
 
+// downloadAndConvertVolume handles the process of downloading the requested volume from a VirtualMachineExport in the format
+// to convert to. If the export doesn't offer the volume in that format, the raw volume is downloaded and converted locally.
+func downloadAndConvertVolume(client kubecli.KubevirtClient, vmexport *exportv1.VirtualMachineExport, vmeInfo *VMExportInfo) (bool, error) {
+	downloadUrl, converted, err := getConversionUrlFromVirtualMachineExport(vmexport, vmeInfo)
+	if err != nil {
+		return false, err
+	}
+
+	resp, err := HandleHTTPGetRequestFn(client, vmexport, downloadUrl, vmeInfo.Insecure, vmeInfo.ServiceURL, nil)
+	if err != nil {
+		return false, err
+	}
+	defer resp.Body.Close()
+
+	// Check server response
+	if resp.StatusCode != http.StatusOK {
+		printToOutput("Bad status: %s\n", resp.Status)
+		return false, nil
+	}
+
+	if converted {
+		if err := copyFileWithProgressBarTo(vmeInfo.OutputFile, resp, false); err != nil {
+			return false, err
+		}
+		printToOutput("Download finished succesfully\n")
+		return true, nil
+	}
+
+	// Store the raw volume next to the output file to not run out of space on a different filesystem
+	rawFile, err := os.CreateTemp(filepath.Dir(vmeInfo.OutputFile), filepath.Base(vmeInfo.OutputFile)+".*.raw")
+	if err != nil {
+		return false, err
+	}
+	rawFile.Close()
+	defer os.Remove(rawFile.Name())
+
+	if err := copyFileWithProgressBarTo(rawFile.Name(), resp, vmeInfo.Decompress); err != nil {
+		return false, err
+	}
+	printToOutput("Download finished succesfully, converting image to %s\n", vmeInfo.ConvertTo)
+
+	if err := ConvertImageFn(rawFile.Name(), vmeInfo.OutputFile, vmeInfo.ConvertTo); err != nil {
+		return false, err
+	}
+	printToOutput("Conversion finished succesfully\n")
+
+	return true, nil
+}
+
+// copyFileWithProgressBarTo copies the file with a progress bar to the file at the given path
+func copyFileWithProgressBarTo(path string, resp *http.Response, decompress bool) (err error) {
+	output, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer util.CloseIOAndCheckErr(output, &err)
+
+	return copyFileWithProgressBar(output, resp, decompress)
+}
+
+// ConvertImage converts the raw image at src to an image of the given format at dst using qemu-img
+func ConvertImage(src, dst, format string) error {
+	if _, err := exec.LookPath(qemuImg); err != nil {
+		return fmt.Errorf("%s is required to convert the volume to %s: %v", qemuImg, format, err)
+	}
+	out, err := exec.Command(qemuImg, "convert", "-p", "-f", RAW_FORMAT, "-O", format, src, dst).CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("failed to convert the volume to %s: %v: %s", format, err, out)
+	}
+	return nil
+}
+
 // shouldDeleteVMExport decides wether we should retain or delete a VMExport after a download. If delete/retain are not explicitly specified,
 // the vmexport will be deleted when is created in the same instance as the download, retained otherwise.
 func shouldDeleteVMExport(vmeInfo *VMExportInfo) bool {
@@ -646,26 +790,23 @@ func GetUrlFromVirtualMachineExport(vmexport *exportv1.VirtualMachineExport, vme
 	if links == nil || len(links.Volumes) <= 0 {
 		return "", fmt.Errorf("unable to access the volume info from '%s/%s' VirtualMachineExport", vmexport.Namespace, vmexport.Name)
 	}
-	volumeNumber := len(links.Volumes)
-	if volumeNumber > 1 && vmeInfo.VolumeName == "" {
-		return "", fmt.Errorf("detected more than one downloadable volume in '%s/%s' VirtualMachineExport: Select the expected volume using the --volume flag", vmexport.Namespace, vmexport.Name)
+	exportVolume, err := getExportVolume(vmexport, vmeInfo, links)
+	if err != nil {
+		return "", err
 	}
-	for _, exportVolume := range links.Volumes {
-		// Access the requested volume
-		if volumeNumber == 1 || exportVolume.Name == vmeInfo.VolumeName {
-			for _, format = range exportVolume.Formats {
-				if format.Format == exportv1.KubeVirtGz || format.Format == exportv1.ArchiveGz || format.Format == exportv1.KubeVirtRaw {
-					downloadUrl, err = replaceUrlWithServiceUrl(format.Url, vmeInfo)
-					if err != nil {
-						return "", err
-					}
-				}
-				// By default, we always attempt to find and get the compressed file URL,
-				// so we only break the loop when one is found.
-				if format.Format == exportv1.KubeVirtGz || format.Format == exportv1.ArchiveGz {
-					break
+	if exportVolume != nil {
+		for _, format = range exportVolume.Formats {
+			if format.Format == exportv1.KubeVirtGz || format.Format == exportv1.ArchiveGz || format.Format == exportv1.KubeVirtRaw {
+				downloadUrl, err = replaceUrlWithServiceUrl(format.Url, vmeInfo)
+				if err != nil {
+					return "", err
 				}
 			}
+			// By default, we always attempt to find and get the compressed file URL,
+			// so we only break the loop when one is found.
+			if format.Format == exportv1.KubeVirtGz || format.Format == exportv1.ArchiveGz {
+				break
+			}
 		}
 	}
 
@@ -681,6 +822,69 @@ func GetUrlFromVirtualMachineExport(vmexport *exportv1.VirtualMachineExport, vme
 	return downloadUrl, nil
 }
 
+// getConversionUrlFromVirtualMachineExport inspects the VirtualMachineExport status to fetch the URL of the volume in the format
+// to convert to. If the volume isn't offered in that format, the URL of the raw or gzipped volume is returned instead.
+func getConversionUrlFromVirtualMachineExport(vmexport *exportv1.VirtualMachineExport, vmeInfo *VMExportInfo) (downloadUrl string, converted bool, err error) {
+	var links *exportv1.VirtualMachineExportLink
+	if vmeInfo.ServiceURL == "" && vmexport.Status.Links != nil && vmexport.Status.Links.External != nil {
+		links = vmexport.Status.Links.External
+	} else if vmexport.Status.Links != nil && vmexport.Status.Links.Internal != nil {
+		links = vmexport.Status.Links.Internal
+	}
+	if links == nil || len(links.Volumes) <= 0 {
+		return "", false, fmt.Errorf("unable to access the volume info from '%s/%s' VirtualMachineExport", vmexport.Namespace, vmexport.Name)
+	}
+	exportVolume, err := getExportVolume(vmexport, vmeInfo, links)
+	if err != nil {
+		return "", false, err
+	}
+	if exportVolume == nil {
+		return "", false, fmt.Errorf("unable to get a valid URL from '%s/%s' VirtualMachineExport", vmexport.Namespace, vmexport.Name)
+	}
+
+	var rawUrl, gzUrl string
+	for _, format := range exportVolume.Formats {
+		switch format.Format {
+		case exportv1.ExportVolumeFormat(vmeInfo.ConvertTo):
+			downloadUrl, err = replaceUrlWithServiceUrl(format.Url, vmeInfo)
+			return downloadUrl, true, err
+		case exportv1.KubeVirtRaw:
+			rawUrl = format.Url
+		case exportv1.KubeVirtGz:
+			gzUrl = format.Url
+		}
+	}
+
+	// Prefer the compressed volume, it's decompressed while downloading
+	switch {
+	case gzUrl != "":
+		vmeInfo.Decompress = true
+		downloadUrl = gzUrl
+	case rawUrl != "":
+		vmeInfo.Decompress = false
+		downloadUrl = rawUrl
+	default:
+		return "", false, fmt.Errorf("volume '%s' from '%s/%s' VirtualMachineExport is not a disk image and can't be converted to %s", exportVolume.Name, vmexport.Namespace, vmexport.Name, vmeInfo.ConvertTo)
+	}
+	downloadUrl, err = replaceUrlWithServiceUrl(downloadUrl, vmeInfo)
+	return downloadUrl, false, err
+}
+
+// getExportVolume returns the requested volume from the VirtualMachineExport links, or nil if it doesn't exist
+func getExportVolume(vmexport *exportv1.VirtualMachineExport, vmeInfo *VMExportInfo, links *exportv1.VirtualMachineExportLink) (*exportv1.VirtualMachineExportVolume, error) {
+	volumeNumber := len(links.Volumes)
+	if volumeNumber > 1 && vmeInfo.VolumeName == "" {
+		return nil, fmt.Errorf("detected more than one downloadable volume in '%s/%s' VirtualMachineExport: Select the expected volume using the --volume flag", vmexport.Namespace, vmexport.Name)
+	}
+	for i, exportVolume := range links.Volumes {
+		// Access the requested volume
+		if volumeNumber == 1 || exportVolume.Name == vmeInfo.VolumeName {
+			return &links.Volumes[i], nil
+		}
+	}
+	return nil, nil
+}
+
 // GetManifestUrlsFromVirtualMachineExport retrieves the manifest URLs from VirtualMachineExport status
 func GetManifestUrlsFromVirtualMachineExport(vmexport *exportv1.VirtualMachineExport, vmeInfo *VMExportInfo) (map[exportv1.ExportManifestType]string, error) {
 	res := make(map[exportv1.ExportManifestType]string, 0)
@@ -938,6 +1142,12 @@ func handleCreateFlags() error {
 	if downloadRetries != 0 {
 		return fmt.Errorf(ErrIncompatibleFlag, RETRY_FLAG, CREATE)
 	}
+	if convertTo != "" {
+		return fmt.Errorf(ErrIncompatibleFlag, CONVERT_TO_FLAG, CREATE)
+	}
+	if exportOVA {
+		return fmt.Errorf(ErrIncompatibleFlag, OVA_FLAG, CREATE)
+	}
 
 	return nil
 }
@@ -987,6 +1197,12 @@ func handleDeleteFlags() error {
 	if len(resourceAnnotations) > 0 {
 		return fmt.Errorf(ErrIncompatibleFlag, ANNOTATIONS_FLAG, DELETE)
 	}
+	if convertTo != "" {
+		return fmt.Errorf(ErrIncompatibleFlag, CONVERT_TO_FLAG, DELETE)
+	}
+	if exportOVA {
+		return fmt.Errorf(ErrIncompatibleFlag, OVA_FLAG, DELETE)
+	}
 
 	return nil
 }
@@ -1026,6 +1242,40 @@ func handleDownloadFlags() error {
 		if pvc != "" {
 			return fmt.Errorf(ErrIncompatibleFlag, PVC_FLAG, MANIFEST_FLAG)
 		}
+
+		if convertTo != "" {
+			return fmt.Errorf(ErrIncompatibleFlag, CONVERT_TO_FLAG, MANIFEST_FLAG)
+		}
+	}
+
+	if exportOVA {
+		if exportManifest {
+			return fmt.Errorf(ErrIncompatibleFlag, MANIFEST_FLAG, OVA_FLAG)
+		}
+		if volumeName != "" {
+			return fmt.Errorf(ErrIncompatibleFlag, VOLUME_FLAG, OVA_FLAG)
+		}
+		if pvc != "" {
+			return fmt.Errorf(ErrIncompatibleFlag, PVC_FLAG, OVA_FLAG)
+		}
+		if convertTo != "" {
+			return fmt.Errorf(ErrIncompatibleFlag, CONVERT_TO_FLAG, OVA_FLAG)
+		}
+		if format != "" {
+			return fmt.Errorf(ErrIncompatibleFlag, FORMAT_FLAG, OVA_FLAG)
+		}
+	}
+
+	if convertTo != "" {
+		if convertTo != QCOW2_FORMAT && convertTo != VMDK_FORMAT && convertTo != VHDX_FORMAT {
+			return fmt.Errorf(ErrInvalidValue, CONVERT_TO_FLAG, "qcow2/vmdk/vhdx")
+		}
+		if format == GZIP_FORMAT {
+			return fmt.Errorf(ErrIncompatibleFlag, FORMAT_FLAG+"="+GZIP_FORMAT, CONVERT_TO_FLAG)
+		}
+		if outputFile == "" || outputFile == "-" {
+			return fmt.Errorf(ErrRequiredFlag, OUTPUT_FLAG+" <FILE>", CONVERT_TO_FLAG)
+		}
 	}
 	if !exportManifest && outputFile == "" {
 		return fmt.Errorf("warning: Binary output can mess up your terminal. Use '%s -' to output into stdout anyway or consider '%s <FILE>' to save to a file", OUTPUT_FLAG, OUTPUT_FLAG)
@@ -119,6 +119,7 @@ var _ = Describe("vmexport", func() {
 		vmexport.GetHTTPClientFn = vmexport.GetHTTPClient
 		vmexport.HandleHTTPGetRequestFn = vmexport.HandleHTTPGetRequest
 		vmexport.RunPortForwardFn = vmexport.RunPortForward
+		vmexport.ConvertImageFn = vmexport.ConvertImage
 	})
 
 	Context("VMExport fails", func() {
@@ -365,6 +366,18 @@ var _ = Describe("vmexport", func() {
 			Entry("Using 'manifest' with invalid output_format_flag", fmt.Sprintf(vmexport.ErrInvalidValue, vmexport.OUTPUT_FORMAT_FLAG, "json/yaml"), runDownloadCmd, vmexport.MANIFEST_FLAG, setFlag(vmexport.OUTPUT_FORMAT_FLAG, "invalid")),
 			Entry("Using 'port-forward' with invalid port", fmt.Sprintf(vmexport.ErrInvalidValue, vmexport.LOCAL_PORT_FLAG, "valid port numbers"), runDownloadCmd, vmexport.PORT_FORWARD_FLAG, setFlag(vmexport.LOCAL_PORT_FLAG, "test")),
 			Entry("Using 'format' with invalid download format", fmt.Sprintf(vmexport.ErrInvalidValue, vmexport.FORMAT_FLAG, "gzip/raw"), runDownloadCmd, setFlag(vmexport.FORMAT_FLAG, "test")),
+			Entry("Using 'create' with convert-to", fmt.Sprintf(vmexport.ErrIncompatibleFlag, vmexport.CONVERT_TO_FLAG, vmexport.CREATE), runCreateCmd, setFlag(vmexport.PVC_FLAG, "test"), setFlag(vmexport.CONVERT_TO_FLAG, vmexport.QCOW2_FORMAT)),
+			Entry("Using 'manifest' with convert-to", fmt.Sprintf(vmexport.ErrIncompatibleFlag, vmexport.CONVERT_TO_FLAG, vmexport.MANIFEST_FLAG), runDownloadCmd, vmexport.MANIFEST_FLAG, setFlag(vmexport.CONVERT_TO_FLAG, vmexport.QCOW2_FORMAT)),
+			Entry("Using 'convert-to' with invalid format", fmt.Sprintf(vmexport.ErrInvalidValue, vmexport.CONVERT_TO_FLAG, "qcow2/vmdk/vhdx"), runDownloadCmd, setFlag(vmexport.CONVERT_TO_FLAG, "vdi"), setFlag(vmexport.OUTPUT_FLAG, "disk.vdi")),
+			Entry("Using 'convert-to' with gzip format", fmt.Sprintf(vmexport.ErrIncompatibleFlag, vmexport.FORMAT_FLAG+"="+vmexport.GZIP_FORMAT, vmexport.CONVERT_TO_FLAG), runDownloadCmd, setFlag(vmexport.CONVERT_TO_FLAG, vmexport.VMDK_FORMAT), setFlag(vmexport.FORMAT_FLAG, vmexport.GZIP_FORMAT), setFlag(vmexport.OUTPUT_FLAG, "disk.vmdk")),
+			Entry("Using 'convert-to' with output to stdout", fmt.Sprintf(vmexport.ErrRequiredFlag, vmexport.OUTPUT_FLAG+" <FILE>", vmexport.CONVERT_TO_FLAG), runDownloadCmd, setFlag(vmexport.CONVERT_TO_FLAG, vmexport.VHDX_FORMAT), setFlag(vmexport.OUTPUT_FLAG, "-")),
+			Entry("Using 'create' with ova", fmt.Sprintf(vmexport.ErrIncompatibleFlag, vmexport.OVA_FLAG, vmexport.CREATE), runCreateCmd, setFlag(vmexport.VM_FLAG, "test"), vmexport.OVA_FLAG),
+			Entry("Using 'ova' with manifest flag", fmt.Sprintf(vmexport.ErrIncompatibleFlag, vmexport.MANIFEST_FLAG, vmexport.OVA_FLAG), runDownloadCmd, vmexport.OVA_FLAG, vmexport.MANIFEST_FLAG),
+			Entry("Using 'ova' with pvc flag", fmt.Sprintf(vmexport.ErrIncompatibleFlag, vmexport.PVC_FLAG, vmexport.OVA_FLAG), runDownloadCmd, vmexport.OVA_FLAG, setFlag(vmexport.PVC_FLAG, "test")),
+			Entry("Using 'ova' with volume flag", fmt.Sprintf(vmexport.ErrIncompatibleFlag, vmexport.VOLUME_FLAG, vmexport.OVA_FLAG), runDownloadCmd, vmexport.OVA_FLAG, setFlag(vmexport.VOLUME_FLAG, "volume")),
+			Entry("Using 'ova' with convert-to", fmt.Sprintf(vmexport.ErrIncompatibleFlag, vmexport.CONVERT_TO_FLAG, vmexport.OVA_FLAG), runDownloadCmd, vmexport.OVA_FLAG, setFlag(vmexport.CONVERT_TO_FLAG, vmexport.VMDK_FORMAT)),
+			Entry("Using 'ova' with format flag", fmt.Sprintf(vmexport.ErrIncompatibleFlag, vmexport.FORMAT_FLAG, vmexport.OVA_FLAG), runDownloadCmd, vmexport.OVA_FLAG, setFlag(vmexport.FORMAT_FLAG, vmexport.RAW_FORMAT)),
+			Entry("Downloading an OVA without specifying output", fmt.Sprintf("warning: Binary output can mess up your terminal. Use '%s -' to output into stdout anyway or consider '%s <FILE>' to save to a file", vmexport.OUTPUT_FLAG, vmexport.OUTPUT_FLAG), runDownloadCmd, vmexport.OVA_FLAG),
 			Entry("Downloading volume without specifying output", fmt.Sprintf("warning: Binary output can mess up your terminal. Use '%s -' to output into stdout anyway or consider '%s <FILE>' to save to a file", vmexport.OUTPUT_FLAG, vmexport.OUTPUT_FLAG), runDownloadCmd),
 		)
 	})
@@ -562,6 +575,82 @@ var _ = Describe("vmexport", func() {
 			})
 		})
 
+		Context("with conversion", func() {
+			BeforeEach(func() {
+				server.Config.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					_, err := w.Write([]byte(r.URL.Path))
+					Expect(err).ToNot(HaveOccurred())
+				})
+				outputPath = filepath.Join(filepath.Dir(outputPath), "disk.qcow2")
+
+				_, err := kubeClient.CoreV1().Secrets(metav1.NamespaceDefault).Create(context.Background(), secret, metav1.CreateOptions{})
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			createVMEWithFormats := func(formats ...exportv1.ExportVolumeFormat) {
+				volume := exportv1.VirtualMachineExportVolume{Name: volumeName}
+				for _, format := range formats {
+					volume.Formats = append(volume.Formats, exportv1.VirtualMachineExportVolumeFormat{
+						Format: format,
+						Url:    server.URL + "/" + string(format),
+					})
+				}
+				vme.Status = vmeStatusReady([]exportv1.VirtualMachineExportVolume{volume})
+				_, err := virtClient.ExportV1beta1().VirtualMachineExports(metav1.NamespaceDefault).Create(context.Background(), vme, metav1.CreateOptions{})
+				Expect(err).ToNot(HaveOccurred())
+			}
+
+			It("should convert the raw volume locally", func() {
+				createVMEWithFormats(exportv1.KubeVirtRaw)
+				vmexport.ConvertImageFn = func(src, dst, format string) error {
+					Expect(filepath.Dir(src)).To(Equal(filepath.Dir(outputPath)))
+					Expect(os.ReadFile(src)).To(BeEquivalentTo("/raw"))
+					Expect(format).To(Equal(vmexport.QCOW2_FORMAT))
+					return os.WriteFile(dst, []byte("converted"), 0o600)
+				}
+
+				err := runDownloadCmd(
+					setFlag(vmexport.VOLUME_FLAG, volumeName),
+					setFlag(vmexport.OUTPUT_FLAG, outputPath),
+					setFlag(vmexport.CONVERT_TO_FLAG, vmexport.QCOW2_FORMAT),
+					vmexport.INSECURE_FLAG,
+				)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(os.ReadFile(outputPath)).To(BeEquivalentTo("converted"))
+				// The downloaded raw volume is removed after the conversion
+				Expect(os.ReadDir(filepath.Dir(outputPath))).To(HaveLen(1))
+			})
+
+			It("should download the volume converted by the server if available", func() {
+				createVMEWithFormats(exportv1.KubeVirtRaw, vmexport.QCOW2_FORMAT)
+				vmexport.ConvertImageFn = func(_, _, _ string) error {
+					Fail("the volume should not be converted locally")
+					return nil
+				}
+
+				err := runDownloadCmd(
+					setFlag(vmexport.VOLUME_FLAG, volumeName),
+					setFlag(vmexport.OUTPUT_FLAG, outputPath),
+					setFlag(vmexport.CONVERT_TO_FLAG, vmexport.QCOW2_FORMAT),
+					vmexport.INSECURE_FLAG,
+				)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(os.ReadFile(outputPath)).To(BeEquivalentTo("/qcow2"))
+			})
+
+			It("should fail to convert a volume which is not a disk image", func() {
+				createVMEWithFormats(exportv1.ArchiveGz, exportv1.Dir)
+
+				err := runDownloadCmd(
+					setFlag(vmexport.VOLUME_FLAG, volumeName),
+					setFlag(vmexport.OUTPUT_FLAG, outputPath),
+					setFlag(vmexport.CONVERT_TO_FLAG, vmexport.VMDK_FORMAT),
+					vmexport.INSECURE_FLAG,
+				)
+				Expect(err).To(MatchError(fmt.Sprintf("volume '%s' from '%s/%s' VirtualMachineExport is not a disk image and can't be converted to vmdk", volumeName, metav1.NamespaceDefault, vmeName)))
+			})
+		})
+
 		It("Succesfully download a VirtualMachineExport with just 'raw' links", func() {
 			vme.Status = vmeStatusReady([]exportv1.VirtualMachineExportVolume{{
 				Name: volumeName,
@@ -787,6 +876,66 @@ var _ = Describe("vmexport", func() {
 		})
 	})
 
+	Context("OVA", func() {
+		const ovaUrl = "/test/ova"
+
+		createVMEWithOVA := func(manifests ...exportv1.VirtualMachineExportManifest) {
+			vme.Status = vmeStatusReady([]exportv1.VirtualMachineExportVolume{{
+				Name: volumeName,
+				Formats: []exportv1.VirtualMachineExportVolumeFormat{{
+					Format: exportv1.KubeVirtGz,
+					Url:    server.URL,
+				}}},
+			})
+			vme.Status.Links.External.Manifests = append(vme.Status.Links.External.Manifests, manifests...)
+			_, err := virtClient.ExportV1beta1().VirtualMachineExports(metav1.NamespaceDefault).Create(context.Background(), vme, metav1.CreateOptions{})
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = kubeClient.CoreV1().Secrets(metav1.NamespaceDefault).Create(context.Background(), secret, metav1.CreateOptions{})
+			Expect(err).ToNot(HaveOccurred())
+		}
+
+		It("should download the OVA", func() {
+			server.Config.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				Expect(r.URL.String()).To(Equal(ovaUrl))
+				_, err := w.Write([]byte("ova"))
+				Expect(err).ToNot(HaveOccurred())
+			})
+			createVMEWithOVA(
+				exportv1.VirtualMachineExportManifest{
+					Type: exportv1.AllManifests,
+					Url:  server.URL + "/test/all",
+				},
+				exportv1.VirtualMachineExportManifest{
+					Type: exportv1.OVA,
+					Url:  server.URL + ovaUrl,
+				},
+			)
+
+			err := runDownloadCmd(
+				vmexport.OVA_FLAG,
+				setFlag(vmexport.VM_FLAG, "test"),
+				setFlag(vmexport.OUTPUT_FLAG, outputPath),
+			)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(os.ReadFile(outputPath)).To(BeEquivalentTo("ova"))
+		})
+
+		It("should fail if the VirtualMachineExport doesn't offer an OVA", func() {
+			createVMEWithOVA(exportv1.VirtualMachineExportManifest{
+				Type: exportv1.AllManifests,
+				Url:  server.URL + "/test/all",
+			})
+
+			err := runDownloadCmd(
+				vmexport.OVA_FLAG,
+				setFlag(vmexport.VM_FLAG, "test"),
+				setFlag(vmexport.OUTPUT_FLAG, outputPath),
+			)
+			Expect(err).To(MatchError(fmt.Sprintf("'%s/%s' VirtualMachineExport doesn't offer an OVA", metav1.NamespaceDefault, vmeName)))
+		})
+	})
+
 	Context("Port-forward", func() {
 		const (
 			localPort    = uint16(5432)
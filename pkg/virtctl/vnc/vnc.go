@@ -25,11 +25,13 @@ import (
 	"fmt"
 	"io"
 	"net"
+	"net/http"
 	"os"
 	"os/exec"
 	"os/signal"
 	"path/filepath"
 	"runtime"
+	"strconv"
 	"time"
 
 	"github.com/spf13/cobra"
@@ -66,6 +68,9 @@ var listenAddressFmt string
 var listenAddress = "127.0.0.1"
 var proxyOnly bool
 var customPort = 0
+var listen string
+var websocketProxy bool
+var allowedOrigins []string
 
 func NewCommand() *cobra.Command {
 	log.InitializeLogging("vnc")
@@ -81,6 +86,14 @@ func NewCommand() *cobra.Command {
 	cmd.Flags().BoolVar(&proxyOnly, "proxy-only", proxyOnly, "--proxy-only=false: Setting this true will run only the virtctl vnc proxy and show the port where VNC viewers can connect")
 	cmd.Flags().IntVar(&customPort, "port", customPort,
 		"--port=0: Assigning a port value to this will try to run the proxy on the given port if the port is accessible; If unassigned, the proxy will run on a random port")
+	cmd.Flags().StringVar(&listen, "listen", listen,
+		"--listen=127.0.0.1:5901: The address and port the proxy listens on, as an alternative to --address and --port")
+	cmd.Flags().BoolVar(&websocketProxy, "websocket", websocketProxy,
+		"--websocket=false: Serve a websocket endpoint which noVNC compatible browser consoles can connect to on the loopback interface. Requires --proxy-only")
+	cmd.Flags().StringSliceVar(&allowedOrigins, "allowed-origin", allowedOrigins,
+		"--allowed-origin=https://console.example.com: An origin of the web pages which may connect to the websocket endpoint, can be repeated; Browsers from other origins are refused")
+	cmd.MarkFlagsMutuallyExclusive("listen", "address")
+	cmd.MarkFlagsMutuallyExclusive("listen", "port")
 	cmd.SetUsageTemplate(templates.UsageTemplate())
 	cmd.AddCommand(screenshot.NewScreenshotCommand())
 	return cmd
@@ -96,6 +109,33 @@ func (o *VNC) Run(cmd *cobra.Command, args []string) error {
 
 	vmi := args[0]
 
+	if listen != "" {
+		host, port, err := net.SplitHostPort(listen)
+		if err != nil {
+			return fmt.Errorf("Invalid listen address %s: %s", listen, err.Error())
+		}
+		if customPort, err = strconv.Atoi(port); err != nil {
+			return fmt.Errorf("Invalid listen port %s: %s", port, err.Error())
+		}
+		listenAddress = host
+	}
+	if websocketProxy && !proxyOnly {
+		return errors.New("--websocket requires --proxy-only")
+	}
+
+	if !websocketProxy && len(allowedOrigins) > 0 {
+		return errors.New("--allowed-origin requires --websocket")
+	}
+
+	if websocketProxy {
+		if !isLoopbackAddress(listenAddress) {
+			return fmt.Errorf("the websocket endpoint only listens on the loopback interface, not on %q", listenAddress)
+		}
+		return o.runWebsocketProxy(cmd, vmi, func() (kvcorev1.StreamInterface, error) {
+			return virtCli.VirtualMachineInstance(namespace).VNC(vmi)
+		})
+	}
+
 	// setup connection with VM
 	vnc, err := virtCli.VirtualMachineInstance(namespace).VNC(vmi)
 	if err != nil {
@@ -107,16 +147,10 @@ func (o *VNC) Run(cmd *cobra.Command, args []string) error {
 		listenAddress = "127.0.0.1"
 		log.Log.V(2).Infof("--proxy-only is set to false, listening on %s\n", listenAddress)
 	}
-	listenAddressFmt = listenAddress + ":%d"
-	lnAddr, err := net.ResolveTCPAddr("tcp", fmt.Sprintf(listenAddressFmt, customPort))
-	if err != nil {
-		return fmt.Errorf("Can't resolve the address: %s", err.Error())
-	}
-
 	// The local tcp server is used to proxy the podExec websock connection to vnc client
-	ln, err := net.ListenTCP("tcp", lnAddr)
+	ln, err := listenTCP()
 	if err != nil {
-		return fmt.Errorf("Can't listen on unix socket: %s", err.Error())
+		return err
 	}
 	// End of pre-flight checks. Everything looks good, we can start
 	// the goroutines and let the data flow
@@ -183,24 +217,15 @@ func (o *VNC) Run(cmd *cobra.Command, args []string) error {
 
 	if proxyOnly {
 		defer close(doneChan)
-		optionString, err := json.Marshal(struct {
-			Port int `json:"port"`
-		}{port})
-		if err != nil {
-			return fmt.Errorf("Error encountered: %s", err.Error())
+		if err := printPort(cmd, port); err != nil {
+			return err
 		}
-		fmt.Fprintln(cmd.OutOrStdout(), string(optionString))
 	} else {
 		// execute VNC Viewer
 		go checkAndRunVNCViewer(doneChan, viewResChan, port)
 	}
 
-	go func() {
-		defer close(stopChan)
-		interrupt := make(chan os.Signal, 1)
-		signal.Notify(interrupt, os.Interrupt)
-		<-interrupt
-	}()
+	go waitForInterrupt(stopChan)
 
 	select {
 	case <-stopChan:
@@ -217,6 +242,76 @@ func (o *VNC) Run(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+// runWebsocketProxy serves a websocket endpoint for browser consoles instead of a plain VNC port.
+// Every websocket connection gets its own VNC stream to the VMI, so the console can reconnect.
+func (o *VNC) runWebsocketProxy(cmd *cobra.Command, vmi string, dial vncDialer) error {
+	// Fail early if the VMI can't be accessed
+	stream, err := dial()
+	if err != nil {
+		return fmt.Errorf("Can't access VMI %s: %s", vmi, err.Error())
+	}
+	stream.AsConn().Close()
+
+	ln, err := listenTCP()
+	if err != nil {
+		return err
+	}
+	if err := printPort(cmd, ln.Addr().(*net.TCPAddr).Port); err != nil {
+		ln.Close()
+		return err
+	}
+
+	stopChan := make(chan struct{})
+	go waitForInterrupt(stopChan)
+
+	if err := serveWebsocket(ln, dial, allowedOrigins, stopChan); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return fmt.Errorf("Error encountered: %s", err.Error())
+	}
+	return nil
+}
+
+// isLoopbackAddress returns true for the loopback IPs and localhost, the websocket endpoint has no
+// authentication of its own and must not be reachable from other hosts
+func isLoopbackAddress(address string) bool {
+	if address == "localhost" {
+		return true
+	}
+	ip := net.ParseIP(address)
+	return ip != nil && ip.IsLoopback()
+}
+
+func listenTCP() (*net.TCPListener, error) {
+	listenAddressFmt = listenAddress + ":%d"
+	lnAddr, err := net.ResolveTCPAddr("tcp", fmt.Sprintf(listenAddressFmt, customPort))
+	if err != nil {
+		return nil, fmt.Errorf("Can't resolve the address: %s", err.Error())
+	}
+
+	ln, err := net.ListenTCP("tcp", lnAddr)
+	if err != nil {
+		return nil, fmt.Errorf("Can't listen on unix socket: %s", err.Error())
+	}
+	return ln, nil
+}
+
+func printPort(cmd *cobra.Command, port int) error {
+	optionString, err := json.Marshal(struct {
+		Port int `json:"port"`
+	}{port})
+	if err != nil {
+		return fmt.Errorf("Error encountered: %s", err.Error())
+	}
+	fmt.Fprintln(cmd.OutOrStdout(), string(optionString))
+	return nil
+}
+
+func waitForInterrupt(stopChan chan struct{}) {
+	defer close(stopChan)
+	interrupt := make(chan os.Signal, 1)
+	signal.Notify(interrupt, os.Interrupt)
+	<-interrupt
+}
+
 func checkAndRunVNCViewer(doneChan chan struct{}, viewResChan chan error, port int) {
 	defer close(doneChan)
 	var err error
@@ -322,5 +417,8 @@ func remoteViewerArgs(port int) (args []string) {
 
 func usage() string {
 	return `  # Connect to 'testvmi' via remote-viewer:
-   {{ProgramName}} vnc testvmi`
+   {{ProgramName}} vnc testvmi
+
+  # Serve a websocket endpoint on port 5901 of the loopback interface for a noVNC compatible browser console:
+   {{ProgramName}} vnc testvmi --proxy-only --listen 127.0.0.1:5901 --websocket --allowed-origin https://console.example.com`
 }
@@ -0,0 +1,136 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package vnc
+
+import (
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gorilla/websocket"
+
+	kvcorev1 "kubevirt.io/client-go/kubevirt/typed/core/v1"
+	"kubevirt.io/client-go/log"
+)
+
+// noVNC asks for the binary subprotocol, older clients which ask for none are served as well
+const websocketBinaryProtocol = "binary"
+
+type vncDialer func() (kvcorev1.StreamInterface, error)
+
+// newWebsocketHandler returns a handler which opens a new VNC stream to the VMI for every
+// websocket connection and forwards the RFB protocol in binary messages, as noVNC expects it.
+func newWebsocketHandler(dial vncDialer, allowedOrigins []string) http.Handler {
+	checkOrigin := originChecker(allowedOrigins)
+	upgrader := &websocket.Upgrader{
+		ReadBufferSize:  kvcorev1.WebsocketMessageBufferSize,
+		WriteBufferSize: kvcorev1.WebsocketMessageBufferSize,
+		CheckOrigin:     checkOrigin,
+		Subprotocols:    []string{websocketBinaryProtocol},
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !websocket.IsWebSocketUpgrade(r) {
+			http.Error(w, "only websocket connections are supported", http.StatusBadRequest)
+			return
+		}
+		// Refuse foreign origins before a VNC stream to the VMI is opened for them
+		if !checkOrigin(r) {
+			http.Error(w, "origin not allowed", http.StatusForbidden)
+			return
+		}
+
+		stream, err := dial()
+		if err != nil {
+			log.Log.Reason(err).Error("Can't access the VNC of the VMI")
+			http.Error(w, err.Error(), http.StatusBadGateway)
+			return
+		}
+		remote := stream.AsConn()
+		defer remote.Close()
+
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			// The upgrader already replied to the client
+			log.Log.Reason(err).V(2).Info("Failed to upgrade the websocket connection")
+			return
+		}
+		defer conn.Close()
+
+		log.Log.V(2).Infof("VNC websocket client %s connected", r.RemoteAddr)
+		errs := make(chan error, 2)
+		go func() {
+			_, err := kvcorev1.CopyTo(conn, remote)
+			errs <- err
+		}()
+		go func() {
+			_, err := kvcorev1.CopyFrom(remote, conn)
+			errs <- err
+		}()
+
+		if err := <-errs; err != nil && !errors.Is(err, io.EOF) {
+			log.Log.Reason(err).V(2).Info("VNC websocket connection closed")
+		}
+		log.Log.V(2).Infof("VNC websocket client %s disconnected", r.RemoteAddr)
+	})
+}
+
+// originChecker only lets browsers connect from the allowed origins, otherwise any web page open
+// in the browser of the user could reach the console of the VMI with the credentials of the user.
+// Clients which send no Origin are not browsers and are always accepted. The Host of the request is
+// not trusted, a page on a domain resolving to the loopback address would pass a same origin check.
+func originChecker(allowedOrigins []string) func(r *http.Request) bool {
+	return func(r *http.Request) bool {
+		origin := r.Header.Get("Origin")
+		if origin == "" {
+			return true
+		}
+		for _, allowed := range allowedOrigins {
+			if strings.EqualFold(strings.TrimSuffix(allowed, "/"), origin) {
+				return true
+			}
+		}
+		log.Log.V(2).Infof("Refused VNC websocket client %s from origin %s", r.RemoteAddr, origin)
+		return false
+	}
+}
+
+// serveWebsocket serves the websocket endpoint on the listener until stopChan is closed
+func serveWebsocket(ln net.Listener, dial vncDialer, allowedOrigins []string, stopChan <-chan struct{}) error {
+	server := &http.Server{
+		Handler:           newWebsocketHandler(dial, allowedOrigins),
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	serveErr := make(chan error, 1)
+	go func() {
+		serveErr <- server.Serve(ln)
+	}()
+
+	select {
+	case <-stopChan:
+		return server.Close()
+	case err := <-serveErr:
+		return err
+	}
+}
@@ -0,0 +1,158 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package vnc
+
+import (
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	"github.com/gorilla/websocket"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	kvcorev1 "kubevirt.io/client-go/kubevirt/typed/core/v1"
+)
+
+type fakeStream struct {
+	conn net.Conn
+}
+
+func (s *fakeStream) Stream(_ kvcorev1.StreamOptions) error {
+	return errors.New("not implemented")
+}
+
+func (s *fakeStream) AsConn() net.Conn {
+	return s.conn
+}
+
+var _ = Describe("VNC websocket proxy", func() {
+	var (
+		server *httptest.Server
+		dials  int
+		vnc    net.Conn
+	)
+
+	BeforeEach(func() {
+		dials = 0
+		server = httptest.NewServer(newWebsocketHandler(func() (kvcorev1.StreamInterface, error) {
+			dials++
+			local, remote := net.Pipe()
+			vnc = remote
+			return &fakeStream{conn: local}, nil
+		}, []string{"https://console.example.com"}))
+		DeferCleanup(server.Close)
+	})
+
+	dialWithHeader := func(header http.Header) (*websocket.Conn, *http.Response, error) {
+		dialer := &websocket.Dialer{Subprotocols: []string{websocketBinaryProtocol}}
+		conn, resp, err := dialer.Dial("ws"+strings.TrimPrefix(server.URL, "http")+"/websockify", header)
+		if conn != nil {
+			DeferCleanup(conn.Close)
+		}
+		return conn, resp, err
+	}
+
+	dial := func() *websocket.Conn {
+		conn, _, err := dialWithHeader(nil)
+		Expect(err).ToNot(HaveOccurred())
+		return conn
+	}
+
+	It("should forward binary messages to and from the VNC stream", func() {
+		conn := dial()
+		Expect(conn.Subprotocol()).To(Equal(websocketBinaryProtocol))
+
+		Expect(conn.WriteMessage(websocket.BinaryMessage, []byte("client"))).To(Succeed())
+		buf := make([]byte, len("client"))
+		_, err := io.ReadFull(vnc, buf)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(buf)).To(Equal("client"))
+
+		go vnc.Write([]byte("RFB 003.008\n"))
+		msgType, msg, err := conn.ReadMessage()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(msgType).To(Equal(websocket.BinaryMessage))
+		Expect(string(msg)).To(Equal("RFB 003.008\n"))
+	})
+
+	It("should open a new VNC stream for every connection", func() {
+		dial()
+		dial()
+		Expect(dials).To(Equal(2))
+	})
+
+	DescribeTable("should accept browsers from allowed origins", func(origin string) {
+		_, _, err := dialWithHeader(http.Header{"Origin": []string{origin}})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(dials).To(Equal(1))
+	},
+		Entry("with the exact origin", "https://console.example.com"),
+		Entry("with a differently cased origin", "https://Console.Example.com"),
+	)
+
+	DescribeTable("should reject browsers from other origins without accessing the VMI", func(origin string) {
+		_, resp, err := dialWithHeader(http.Header{"Origin": []string{origin}})
+		Expect(err).To(MatchError(websocket.ErrBadHandshake))
+		Expect(resp.StatusCode).To(Equal(http.StatusForbidden))
+		Expect(dials).To(BeZero())
+	},
+		Entry("with a foreign origin", "https://evil.example.com"),
+		Entry("with a different scheme", "http://console.example.com"),
+		Entry("with the origin of the proxy itself", "http://127.0.0.1"),
+	)
+
+	It("should reject plain HTTP requests", func() {
+		resp, err := http.Get(server.URL)
+		Expect(err).ToNot(HaveOccurred())
+		defer resp.Body.Close()
+		Expect(resp.StatusCode).To(Equal(http.StatusBadRequest))
+		Expect(dials).To(BeZero())
+	})
+
+	It("should fail the handshake if the VMI can't be accessed", func() {
+		failing := httptest.NewServer(newWebsocketHandler(func() (kvcorev1.StreamInterface, error) {
+			return nil, errors.New("vmi not running")
+		}, nil))
+		defer failing.Close()
+
+		_, resp, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(failing.URL, "http"), nil)
+		Expect(err).To(MatchError(websocket.ErrBadHandshake))
+		Expect(resp.StatusCode).To(Equal(http.StatusBadGateway))
+	})
+})
+
+var _ = Describe("VNC websocket listen address", func() {
+	DescribeTable("should only accept loopback addresses", func(address string, expected bool) {
+		Expect(isLoopbackAddress(address)).To(Equal(expected))
+	},
+		Entry("IPv4 loopback", "127.0.0.1", true),
+		Entry("IPv6 loopback", "::1", true),
+		Entry("localhost", "localhost", true),
+		Entry("all interfaces", "", false),
+		Entry("IPv4 unspecified", "0.0.0.0", false),
+		Entry("IPv6 unspecified", "::", false),
+		Entry("a host address", "192.168.1.10", false),
+		Entry("a hostname", "example.com", false),
+	)
+})
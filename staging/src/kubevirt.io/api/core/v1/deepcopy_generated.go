@@ -297,6 +297,21 @@ func (in *CPU) DeepCopyInto(out *CPU) {
 		*out = new(Realtime)
 		**out = **in
 	}
+	if in.Housekeeping != nil {
+		in, out := &in.Housekeeping, &out.Housekeeping
+		*out = new(Housekeeping)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ThreadPlacement != nil {
+		in, out := &in.ThreadPlacement, &out.ThreadPlacement
+		*out = new(ThreadPlacement)
+		**out = **in
+	}
+	if in.MitigatedVulnerabilities != nil {
+		in, out := &in.MitigatedVulnerabilities, &out.MitigatedVulnerabilities
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -310,6 +325,50 @@ func (in *CPU) DeepCopy() *CPU {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CPUFairShareConfiguration) DeepCopyInto(out *CPUFairShareConfiguration) {
+	*out = *in
+	if in.Tiers != nil {
+		in, out := &in.Tiers, &out.Tiers
+		*out = make([]CPUFairShareTier, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CPUFairShareConfiguration.
+func (in *CPUFairShareConfiguration) DeepCopy() *CPUFairShareConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(CPUFairShareConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CPUFairShareTier) DeepCopyInto(out *CPUFairShareTier) {
+	*out = *in
+	if in.Namespaces != nil {
+		in, out := &in.Namespaces, &out.Namespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CPUFairShareTier.
+func (in *CPUFairShareTier) DeepCopy() *CPUFairShareTier {
+	if in == nil {
+		return nil
+	}
+	out := new(CPUFairShareTier)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CPUFeature) DeepCopyInto(out *CPUFeature) {
 	*out = *in
@@ -342,6 +401,49 @@ func (in *CPUTopology) DeepCopy() *CPUTopology {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CanaryConfiguration) DeepCopyInto(out *CanaryConfiguration) {
+	*out = *in
+	if in.Interval != nil {
+		in, out := &in.Interval, &out.Interval
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.Memory != nil {
+		in, out := &in.Memory, &out.Memory
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.NodeSelector != nil {
+		in, out := &in.NodeSelector, &out.NodeSelector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.ReadinessProbe != nil {
+		in, out := &in.ReadinessProbe, &out.ReadinessProbe
+		*out = new(Probe)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CanaryConfiguration.
+func (in *CanaryConfiguration) DeepCopy() *CanaryConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(CanaryConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CertConfig) DeepCopyInto(out *CertConfig) {
 	*out = *in
@@ -368,6 +470,27 @@ func (in *CertConfig) DeepCopy() *CertConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CgroupLayout) DeepCopyInto(out *CgroupLayout) {
+	*out = *in
+	if in.Housekeeping != nil {
+		in, out := &in.Housekeeping, &out.Housekeeping
+		*out = new(HousekeepingCgroup)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CgroupLayout.
+func (in *CgroupLayout) DeepCopy() *CgroupLayout {
+	if in == nil {
+		return nil
+	}
+	out := new(CgroupLayout)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Chassis) DeepCopyInto(out *Chassis) {
 	*out = *in
@@ -409,6 +532,11 @@ func (in *Clock) DeepCopyInto(out *Clock) {
 		*out = new(Timer)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.GuestTimeSync != nil {
+		in, out := &in.GuestTimeSync, &out.GuestTimeSync
+		*out = new(GuestTimeSync)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -785,6 +913,16 @@ func (in *DHCPOptions) DeepCopyInto(out *DHCPOptions) {
 		*out = make([]DHCPPrivateOptions, len(*in))
 		copy(*out, *in)
 	}
+	if in.MTU != nil {
+		in, out := &in.MTU, &out.MTU
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.Routes != nil {
+		in, out := &in.Routes, &out.Routes
+		*out = make([]DHCPRoute, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -814,6 +952,22 @@ func (in *DHCPPrivateOptions) DeepCopy() *DHCPPrivateOptions {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DHCPRoute) DeepCopyInto(out *DHCPRoute) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DHCPRoute.
+func (in *DHCPRoute) DeepCopy() *DHCPRoute {
+	if in == nil {
+		return nil
+	}
+	out := new(DHCPRoute)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DataVolumeSource) DeepCopyInto(out *DataVolumeSource) {
 	*out = *in
@@ -870,6 +1024,29 @@ func (in *DataVolumeTemplateSpec) DeepCopy() *DataVolumeTemplateSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DedicatedCPUPool) DeepCopyInto(out *DedicatedCPUPool) {
+	*out = *in
+	if in.NodeSelector != nil {
+		in, out := &in.NodeSelector, &out.NodeSelector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DedicatedCPUPool.
+func (in *DedicatedCPUPool) DeepCopy() *DedicatedCPUPool {
+	if in == nil {
+		return nil
+	}
+	out := new(DedicatedCPUPool)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DeprecatedInterfaceMacvtap) DeepCopyInto(out *DeprecatedInterfaceMacvtap) {
 	*out = *in
@@ -1067,6 +1244,11 @@ func (in *Devices) DeepCopyInto(out *Devices) {
 		*out = make([]HostDevice, len(*in))
 		copy(*out, *in)
 	}
+	if in.USBDevices != nil {
+		in, out := &in.USBDevices, &out.USBDevices
+		*out = make([]USBDevice, len(*in))
+		copy(*out, *in)
+	}
 	if in.ClientPassthrough != nil {
 		in, out := &in.ClientPassthrough, &out.ClientPassthrough
 		*out = new(ClientPassthroughDevices)
@@ -1156,6 +1338,11 @@ func (in *Disk) DeepCopyInto(out *Disk) {
 		*out = new(DiskErrorPolicy)
 		**out = **in
 	}
+	if in.IOTune != nil {
+		in, out := &in.IOTune, &out.IOTune
+		*out = new(DiskIOTune)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1221,6 +1408,52 @@ func (in *DiskIOThreads) DeepCopy() *DiskIOThreads {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DiskIOTune) DeepCopyInto(out *DiskIOTune) {
+	*out = *in
+	if in.TotalBytesSec != nil {
+		in, out := &in.TotalBytesSec, &out.TotalBytesSec
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.ReadBytesSec != nil {
+		in, out := &in.ReadBytesSec, &out.ReadBytesSec
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.WriteBytesSec != nil {
+		in, out := &in.WriteBytesSec, &out.WriteBytesSec
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.TotalIOPSSec != nil {
+		in, out := &in.TotalIOPSSec, &out.TotalIOPSSec
+		*out = new(uint64)
+		**out = **in
+	}
+	if in.ReadIOPSSec != nil {
+		in, out := &in.ReadIOPSSec, &out.ReadIOPSSec
+		*out = new(uint64)
+		**out = **in
+	}
+	if in.WriteIOPSSec != nil {
+		in, out := &in.WriteIOPSSec, &out.WriteIOPSSec
+		*out = new(uint64)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DiskIOTune.
+func (in *DiskIOTune) DeepCopy() *DiskIOTune {
+	if in == nil {
+		return nil
+	}
+	out := new(DiskIOTune)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DiskTarget) DeepCopyInto(out *DiskTarget) {
 	*out = *in
@@ -1269,6 +1502,11 @@ func (in *DomainMemoryDumpInfo) DeepCopyInto(out *DomainMemoryDumpInfo) {
 		in, out := &in.EndTimestamp, &out.EndTimestamp
 		*out = (*in).DeepCopy()
 	}
+	if in.Progress != nil {
+		in, out := &in.Progress, &out.Progress
+		*out = new(MemoryDumpProgress)
+		**out = **in
+	}
 	return
 }
 
@@ -1448,6 +1686,23 @@ func (in *EmptyDiskSource) DeepCopy() *EmptyDiskSource {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EphemeralOverlay) DeepCopyInto(out *EphemeralOverlay) {
+	*out = *in
+	out.Capacity = in.Capacity.DeepCopy()
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EphemeralOverlay.
+func (in *EphemeralOverlay) DeepCopy() *EphemeralOverlay {
+	if in == nil {
+		return nil
+	}
+	out := new(EphemeralOverlay)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *EphemeralVolumeSource) DeepCopyInto(out *EphemeralVolumeSource) {
 	*out = *in
@@ -1456,6 +1711,11 @@ func (in *EphemeralVolumeSource) DeepCopyInto(out *EphemeralVolumeSource) {
 		*out = new(corev1.PersistentVolumeClaimVolumeSource)
 		**out = **in
 	}
+	if in.Overlay != nil {
+		in, out := &in.Overlay, &out.Overlay
+		*out = new(EphemeralOverlay)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1469,6 +1729,31 @@ func (in *EphemeralVolumeSource) DeepCopy() *EphemeralVolumeSource {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FSFreezeInfo) DeepCopyInto(out *FSFreezeInfo) {
+	*out = *in
+	if in.Volumes != nil {
+		in, out := &in.Volumes, &out.Volumes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.FreezeTimestamp != nil {
+		in, out := &in.FreezeTimestamp, &out.FreezeTimestamp
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FSFreezeInfo.
+func (in *FSFreezeInfo) DeepCopy() *FSFreezeInfo {
+	if in == nil {
+		return nil
+	}
+	out := new(FSFreezeInfo)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *FeatureAPIC) DeepCopyInto(out *FeatureAPIC) {
 	*out = *in
@@ -1713,7 +1998,7 @@ func (in *Filesystem) DeepCopyInto(out *Filesystem) {
 	if in.Virtiofs != nil {
 		in, out := &in.Virtiofs, &out.Virtiofs
 		*out = new(FilesystemVirtiofs)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	return
 }
@@ -1731,6 +2016,11 @@ func (in *Filesystem) DeepCopy() *Filesystem {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *FilesystemVirtiofs) DeepCopyInto(out *FilesystemVirtiofs) {
 	*out = *in
+	if in.IDMapping != nil {
+		in, out := &in.IDMapping, &out.IDMapping
+		*out = new(VirtiofsIDMapping)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1820,6 +2110,11 @@ func (in *FreezeUnfreezeTimeout) DeepCopyInto(out *FreezeUnfreezeTimeout) {
 		*out = new(metav1.Duration)
 		**out = **in
 	}
+	if in.Volumes != nil {
+		in, out := &in.Volumes, &out.Volumes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -1902,6 +2197,64 @@ func (in *GuestAgentPing) DeepCopy() *GuestAgentPing {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GuestFilesystemUsage) DeepCopyInto(out *GuestFilesystemUsage) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GuestFilesystemUsage.
+func (in *GuestFilesystemUsage) DeepCopy() *GuestFilesystemUsage {
+	if in == nil {
+		return nil
+	}
+	out := new(GuestFilesystemUsage)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GuestLicense) DeepCopyInto(out *GuestLicense) {
+	*out = *in
+	if in.BYOL != nil {
+		in, out := &in.BYOL, &out.BYOL
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GuestLicense.
+func (in *GuestLicense) DeepCopy() *GuestLicense {
+	if in == nil {
+		return nil
+	}
+	out := new(GuestLicense)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GuestTimeSync) DeepCopyInto(out *GuestTimeSync) {
+	*out = *in
+	if in.DriftThresholdSeconds != nil {
+		in, out := &in.DriftThresholdSeconds, &out.DriftThresholdSeconds
+		*out = new(uint32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GuestTimeSync.
+func (in *GuestTimeSync) DeepCopy() *GuestTimeSync {
+	if in == nil {
+		return nil
+	}
+	out := new(GuestTimeSync)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HPETTimer) DeepCopyInto(out *HPETTimer) {
 	*out = *in
@@ -2040,34 +2393,95 @@ func (in *HotplugVolumeStatus) DeepCopy() *HotplugVolumeStatus {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Hugepages) DeepCopyInto(out *Hugepages) {
+func (in *Housekeeping) DeepCopyInto(out *Housekeeping) {
 	*out = *in
+	out.CPU = in.CPU.DeepCopy()
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Hugepages.
-func (in *Hugepages) DeepCopy() *Hugepages {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Housekeeping.
+func (in *Housekeeping) DeepCopy() *Housekeeping {
 	if in == nil {
 		return nil
 	}
-	out := new(Hugepages)
+	out := new(Housekeeping)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *HyperVPassthrough) DeepCopyInto(out *HyperVPassthrough) {
+func (in *HousekeepingCgroup) DeepCopyInto(out *HousekeepingCgroup) {
 	*out = *in
-	if in.Enabled != nil {
-		in, out := &in.Enabled, &out.Enabled
-		*out = new(bool)
-		**out = **in
+	if in.CPULimit != nil {
+		in, out := &in.CPULimit, &out.CPULimit
+		x := (*in).DeepCopy()
+		*out = &x
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HyperVPassthrough.
-func (in *HyperVPassthrough) DeepCopy() *HyperVPassthrough {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HousekeepingCgroup.
+func (in *HousekeepingCgroup) DeepCopy() *HousekeepingCgroup {
+	if in == nil {
+		return nil
+	}
+	out := new(HousekeepingCgroup)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Hugepages) DeepCopyInto(out *Hugepages) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Hugepages.
+func (in *Hugepages) DeepCopy() *Hugepages {
+	if in == nil {
+		return nil
+	}
+	out := new(Hugepages)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HugepagesPool) DeepCopyInto(out *HugepagesPool) {
+	*out = *in
+	if in.NodeSelector != nil {
+		in, out := &in.NodeSelector, &out.NodeSelector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HugepagesPool.
+func (in *HugepagesPool) DeepCopy() *HugepagesPool {
+	if in == nil {
+		return nil
+	}
+	out := new(HugepagesPool)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HyperVPassthrough) DeepCopyInto(out *HyperVPassthrough) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HyperVPassthrough.
+func (in *HyperVPassthrough) DeepCopy() *HyperVPassthrough {
 	if in == nil {
 		return nil
 	}
@@ -2113,6 +2527,22 @@ func (in *I6300ESBWatchdog) DeepCopy() *I6300ESBWatchdog {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IOThreadPlacement) DeepCopyInto(out *IOThreadPlacement) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IOThreadPlacement.
+func (in *IOThreadPlacement) DeepCopy() *IOThreadPlacement {
+	if in == nil {
+		return nil
+	}
+	out := new(IOThreadPlacement)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *InitrdInfo) DeepCopyInto(out *InitrdInfo) {
 	*out = *in
@@ -2153,6 +2583,28 @@ func (in *InstancetypeConfiguration) DeepCopyInto(out *InstancetypeConfiguration
 		*out = new(InstancetypeReferencePolicy)
 		**out = **in
 	}
+	if in.RevisionUpgradePolicy != nil {
+		in, out := &in.RevisionUpgradePolicy, &out.RevisionUpgradePolicy
+		*out = new(InstancetypeRevisionUpgradePolicy)
+		**out = **in
+	}
+	if in.NodeCompatibilityPolicy != nil {
+		in, out := &in.NodeCompatibilityPolicy, &out.NodeCompatibilityPolicy
+		*out = new(InstancetypeNodeCompatibilityPolicy)
+		**out = **in
+	}
+	if in.NamespacePolicies != nil {
+		in, out := &in.NamespacePolicies, &out.NamespacePolicies
+		*out = make([]InstancetypeNamespacePolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Rightsizing != nil {
+		in, out := &in.Rightsizing, &out.Rightsizing
+		*out = new(InstancetypeRightsizing)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -2187,6 +2639,54 @@ func (in *InstancetypeMatcher) DeepCopy() *InstancetypeMatcher {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstancetypeNamespacePolicy) DeepCopyInto(out *InstancetypeNamespacePolicy) {
+	*out = *in
+	in.NamespaceSelector.DeepCopyInto(&out.NamespaceSelector)
+	if in.ClusterInstancetypes != nil {
+		in, out := &in.ClusterInstancetypes, &out.ClusterInstancetypes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ClusterPreferences != nil {
+		in, out := &in.ClusterPreferences, &out.ClusterPreferences
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstancetypeNamespacePolicy.
+func (in *InstancetypeNamespacePolicy) DeepCopy() *InstancetypeNamespacePolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(InstancetypeNamespacePolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstancetypeRightsizing) DeepCopyInto(out *InstancetypeRightsizing) {
+	*out = *in
+	if in.Window != nil {
+		in, out := &in.Window, &out.Window
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstancetypeRightsizing.
+func (in *InstancetypeRightsizing) DeepCopy() *InstancetypeRightsizing {
+	if in == nil {
+		return nil
+	}
+	out := new(InstancetypeRightsizing)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *InstancetypeStatusRef) DeepCopyInto(out *InstancetypeStatusRef) {
 	*out = *in
@@ -2237,6 +2737,11 @@ func (in *Interface) DeepCopyInto(out *Interface) {
 		*out = new(DHCPOptions)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Bandwidth != nil {
+		in, out := &in.Bandwidth, &out.Bandwidth
+		*out = new(InterfaceBandwidth)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -2250,6 +2755,59 @@ func (in *Interface) DeepCopy() *Interface {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InterfaceBandwidth) DeepCopyInto(out *InterfaceBandwidth) {
+	*out = *in
+	if in.Inbound != nil {
+		in, out := &in.Inbound, &out.Inbound
+		*out = new(InterfaceBandwidthLimit)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Outbound != nil {
+		in, out := &in.Outbound, &out.Outbound
+		*out = new(InterfaceBandwidthLimit)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceBandwidth.
+func (in *InterfaceBandwidth) DeepCopy() *InterfaceBandwidth {
+	if in == nil {
+		return nil
+	}
+	out := new(InterfaceBandwidth)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InterfaceBandwidthLimit) DeepCopyInto(out *InterfaceBandwidthLimit) {
+	*out = *in
+	out.Average = in.Average.DeepCopy()
+	if in.Peak != nil {
+		in, out := &in.Peak, &out.Peak
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.Burst != nil {
+		in, out := &in.Burst, &out.Burst
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceBandwidthLimit.
+func (in *InterfaceBandwidthLimit) DeepCopy() *InterfaceBandwidthLimit {
+	if in == nil {
+		return nil
+	}
+	out := new(InterfaceBandwidthLimit)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *InterfaceBindingMethod) DeepCopyInto(out *InterfaceBindingMethod) {
 	*out = *in
@@ -2394,6 +2952,11 @@ func (in *KSMConfiguration) DeepCopyInto(out *KSMConfiguration) {
 		*out = new(metav1.LabelSelector)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Tuning != nil {
+		in, out := &in.Tuning, &out.Tuning
+		*out = new(KSMTuning)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -2407,6 +2970,57 @@ func (in *KSMConfiguration) DeepCopy() *KSMConfiguration {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KSMTuning) DeepCopyInto(out *KSMTuning) {
+	*out = *in
+	if in.PagesBoost != nil {
+		in, out := &in.PagesBoost, &out.PagesBoost
+		*out = new(int32)
+		**out = **in
+	}
+	if in.PagesDecay != nil {
+		in, out := &in.PagesDecay, &out.PagesDecay
+		*out = new(int32)
+		**out = **in
+	}
+	if in.PagesMin != nil {
+		in, out := &in.PagesMin, &out.PagesMin
+		*out = new(int32)
+		**out = **in
+	}
+	if in.PagesMax != nil {
+		in, out := &in.PagesMax, &out.PagesMax
+		*out = new(int32)
+		**out = **in
+	}
+	if in.PagesInit != nil {
+		in, out := &in.PagesInit, &out.PagesInit
+		*out = new(int32)
+		**out = **in
+	}
+	if in.SleepMsBaseline != nil {
+		in, out := &in.SleepMsBaseline, &out.SleepMsBaseline
+		*out = new(int32)
+		**out = **in
+	}
+	if in.FreePercent != nil {
+		in, out := &in.FreePercent, &out.FreePercent
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KSMTuning.
+func (in *KSMTuning) DeepCopy() *KSMTuning {
+	if in == nil {
+		return nil
+	}
+	out := new(KSMTuning)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KVMTimer) DeepCopyInto(out *KVMTimer) {
 	*out = *in
@@ -2726,6 +3340,45 @@ func (in *KubeVirtConfiguration) DeepCopyInto(out *KubeVirtConfiguration) {
 		*out = new(InstancetypeConfiguration)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.StartConfiguration != nil {
+		in, out := &in.StartConfiguration, &out.StartConfiguration
+		*out = new(StartConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CanaryConfiguration != nil {
+		in, out := &in.CanaryConfiguration, &out.CanaryConfiguration
+		*out = new(CanaryConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PlacementHints != nil {
+		in, out := &in.PlacementHints, &out.PlacementHints
+		*out = new(PlacementHintsConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CPUFairShare != nil {
+		in, out := &in.CPUFairShare, &out.CPUFairShare
+		*out = new(CPUFairShareConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DedicatedCPUPools != nil {
+		in, out := &in.DedicatedCPUPools, &out.DedicatedCPUPools
+		*out = make([]DedicatedCPUPool, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.SwapConfiguration != nil {
+		in, out := &in.SwapConfiguration, &out.SwapConfiguration
+		*out = new(SwapConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.HugepagesPools != nil {
+		in, out := &in.HugepagesPools, &out.HugepagesPools
+		*out = make([]HugepagesPool, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -2739,6 +3392,42 @@ func (in *KubeVirtConfiguration) DeepCopy() *KubeVirtConfiguration {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubeVirtFleetSummary) DeepCopyInto(out *KubeVirtFleetSummary) {
+	*out = *in
+	if in.VirtualMachines != nil {
+		in, out := &in.VirtualMachines, &out.VirtualMachines
+		*out = make(map[string]int32, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.VirtualMachineInstances != nil {
+		in, out := &in.VirtualMachineInstances, &out.VirtualMachineInstances
+		*out = make(map[string]int32, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.PendingActions != nil {
+		in, out := &in.PendingActions, &out.PendingActions
+		*out = make([]KubeVirtPendingAction, len(*in))
+		copy(*out, *in)
+	}
+	in.LastUpdateTime.DeepCopyInto(&out.LastUpdateTime)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeVirtFleetSummary.
+func (in *KubeVirtFleetSummary) DeepCopy() *KubeVirtFleetSummary {
+	if in == nil {
+		return nil
+	}
+	out := new(KubeVirtFleetSummary)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KubeVirtList) DeepCopyInto(out *KubeVirtList) {
 	*out = *in
@@ -2772,6 +3461,22 @@ func (in *KubeVirtList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubeVirtPendingAction) DeepCopyInto(out *KubeVirtPendingAction) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeVirtPendingAction.
+func (in *KubeVirtPendingAction) DeepCopy() *KubeVirtPendingAction {
+	if in == nil {
+		return nil
+	}
+	out := new(KubeVirtPendingAction)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KubeVirtSelfSignConfiguration) DeepCopyInto(out *KubeVirtSelfSignConfiguration) {
 	*out = *in
@@ -2873,6 +3578,18 @@ func (in *KubeVirtStatus) DeepCopyInto(out *KubeVirtStatus) {
 		*out = make([]GenerationStatus, len(*in))
 		copy(*out, *in)
 	}
+	if in.FleetSummary != nil {
+		in, out := &in.FleetSummary, &out.FleetSummary
+		*out = new(KubeVirtFleetSummary)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NodeEvacuations != nil {
+		in, out := &in.NodeEvacuations, &out.NodeEvacuations
+		*out = make([]NodeEvacuationStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -3003,6 +3720,50 @@ func (in *LunTarget) DeepCopy() *LunTarget {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MacAddressPoolConfiguration) DeepCopyInto(out *MacAddressPoolConfiguration) {
+	*out = *in
+	if in.Ranges != nil {
+		in, out := &in.Ranges, &out.Ranges
+		*out = make([]MacAddressRange, len(*in))
+		copy(*out, *in)
+	}
+	if in.Namespaces != nil {
+		in, out := &in.Namespaces, &out.Namespaces
+		*out = make([]NamespaceMacAddressRanges, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MacAddressPoolConfiguration.
+func (in *MacAddressPoolConfiguration) DeepCopy() *MacAddressPoolConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(MacAddressPoolConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MacAddressRange) DeepCopyInto(out *MacAddressRange) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MacAddressRange.
+func (in *MacAddressRange) DeepCopy() *MacAddressRange {
+	if in == nil {
+		return nil
+	}
+	out := new(MacAddressRange)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Machine) DeepCopyInto(out *Machine) {
 	*out = *in
@@ -3019,6 +3780,22 @@ func (in *Machine) DeepCopy() *Machine {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MediatedDeviceTypeCount) DeepCopyInto(out *MediatedDeviceTypeCount) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MediatedDeviceTypeCount.
+func (in *MediatedDeviceTypeCount) DeepCopy() *MediatedDeviceTypeCount {
+	if in == nil {
+		return nil
+	}
+	out := new(MediatedDeviceTypeCount)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MediatedDevicesConfiguration) DeepCopyInto(out *MediatedDevicesConfiguration) {
 	*out = *in
@@ -3099,10 +3876,31 @@ func (in *Memory) DeepCopy() *Memory {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MemoryDumpProgress) DeepCopyInto(out *MemoryDumpProgress) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MemoryDumpProgress.
+func (in *MemoryDumpProgress) DeepCopy() *MemoryDumpProgress {
+	if in == nil {
+		return nil
+	}
+	out := new(MemoryDumpProgress)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MemoryDumpVolumeSource) DeepCopyInto(out *MemoryDumpVolumeSource) {
 	*out = *in
 	out.PersistentVolumeClaimVolumeSource = in.PersistentVolumeClaimVolumeSource
+	if in.ChunkSize != nil {
+		in, out := &in.ChunkSize, &out.ChunkSize
+		x := (*in).DeepCopy()
+		*out = &x
+	}
 	return
 }
 
@@ -3134,44 +3932,129 @@ func (in *MemoryStatus) DeepCopyInto(out *MemoryStatus) {
 		x := (*in).DeepCopy()
 		*out = &x
 	}
+	if in.GuestUsable != nil {
+		in, out := &in.GuestUsable, &out.GuestUsable
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MemoryStatus.
+func (in *MemoryStatus) DeepCopy() *MemoryStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(MemoryStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MigrateOptions) DeepCopyInto(out *MigrateOptions) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	if in.DryRun != nil {
+		in, out := &in.DryRun, &out.DryRun
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AddedNodeSelector != nil {
+		in, out := &in.AddedNodeSelector, &out.AddedNodeSelector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MigrateOptions.
+func (in *MigrateOptions) DeepCopy() *MigrateOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(MigrateOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MigrationBandwidthAutoTuning) DeepCopyInto(out *MigrationBandwidthAutoTuning) {
+	*out = *in
+	if in.MinBandwidthPerMigration != nil {
+		in, out := &in.MinBandwidthPerMigration, &out.MinBandwidthPerMigration
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.TargetUtilizationPercent != nil {
+		in, out := &in.TargetUtilizationPercent, &out.TargetUtilizationPercent
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MigrationBandwidthAutoTuning.
+func (in *MigrationBandwidthAutoTuning) DeepCopy() *MigrationBandwidthAutoTuning {
+	if in == nil {
+		return nil
+	}
+	out := new(MigrationBandwidthAutoTuning)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MigrationBlocker) DeepCopyInto(out *MigrationBlocker) {
+	*out = *in
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MemoryStatus.
-func (in *MemoryStatus) DeepCopy() *MemoryStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MigrationBlocker.
+func (in *MigrationBlocker) DeepCopy() *MigrationBlocker {
 	if in == nil {
 		return nil
 	}
-	out := new(MemoryStatus)
+	out := new(MigrationBlocker)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MigrateOptions) DeepCopyInto(out *MigrateOptions) {
+func (in *MigrationCancelOptions) DeepCopyInto(out *MigrationCancelOptions) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
-	if in.DryRun != nil {
-		in, out := &in.DryRun, &out.DryRun
-		*out = make([]string, len(*in))
-		copy(*out, *in)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MigrationCancelOptions.
+func (in *MigrationCancelOptions) DeepCopy() *MigrationCancelOptions {
+	if in == nil {
+		return nil
 	}
-	if in.AddedNodeSelector != nil {
-		in, out := &in.AddedNodeSelector, &out.AddedNodeSelector
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
+	out := new(MigrationCancelOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MigrationCompression) DeepCopyInto(out *MigrationCompression) {
+	*out = *in
+	if in.Level != nil {
+		in, out := &in.Level, &out.Level
+		*out = new(int32)
+		**out = **in
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MigrateOptions.
-func (in *MigrateOptions) DeepCopy() *MigrateOptions {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MigrationCompression.
+func (in *MigrationCompression) DeepCopy() *MigrationCompression {
 	if in == nil {
 		return nil
 	}
-	out := new(MigrateOptions)
+	out := new(MigrationCompression)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -3204,6 +4087,11 @@ func (in *MigrationConfiguration) DeepCopyInto(out *MigrationConfiguration) {
 		x := (*in).DeepCopy()
 		*out = &x
 	}
+	if in.BandwidthAutoTuning != nil {
+		in, out := &in.BandwidthAutoTuning, &out.BandwidthAutoTuning
+		*out = new(MigrationBandwidthAutoTuning)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.CompletionTimeoutPerGiB != nil {
 		in, out := &in.CompletionTimeoutPerGiB, &out.CompletionTimeoutPerGiB
 		*out = new(int64)
@@ -3229,6 +4117,26 @@ func (in *MigrationConfiguration) DeepCopyInto(out *MigrationConfiguration) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.MaxDowntimeMilliseconds != nil {
+		in, out := &in.MaxDowntimeMilliseconds, &out.MaxDowntimeMilliseconds
+		*out = new(int64)
+		**out = **in
+	}
+	if in.PostCopyAfterIterations != nil {
+		in, out := &in.PostCopyAfterIterations, &out.PostCopyAfterIterations
+		*out = new(int64)
+		**out = **in
+	}
+	if in.ParallelMigrationChannels != nil {
+		in, out := &in.ParallelMigrationChannels, &out.ParallelMigrationChannels
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.Compression != nil {
+		in, out := &in.Compression, &out.Compression
+		*out = new(MigrationCompression)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.DisableTLS != nil {
 		in, out := &in.DisableTLS, &out.DisableTLS
 		*out = new(bool)
@@ -3257,6 +4165,22 @@ func (in *MigrationConfiguration) DeepCopy() *MigrationConfiguration {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MigrationProgress) DeepCopyInto(out *MigrationProgress) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MigrationProgress.
+func (in *MigrationProgress) DeepCopy() *MigrationProgress {
+	if in == nil {
+		return nil
+	}
+	out := new(MigrationProgress)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MultusNetwork) DeepCopyInto(out *MultusNetwork) {
 	*out = *in
@@ -3310,6 +4234,27 @@ func (in *NUMAGuestMappingPassthrough) DeepCopy() *NUMAGuestMappingPassthrough {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NamespaceMacAddressRanges) DeepCopyInto(out *NamespaceMacAddressRanges) {
+	*out = *in
+	if in.Ranges != nil {
+		in, out := &in.Ranges, &out.Ranges
+		*out = make([]MacAddressRange, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NamespaceMacAddressRanges.
+func (in *NamespaceMacAddressRanges) DeepCopy() *NamespaceMacAddressRanges {
+	if in == nil {
+		return nil
+	}
+	out := new(NamespaceMacAddressRanges)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Network) DeepCopyInto(out *Network) {
 	*out = *in
@@ -3347,6 +4292,11 @@ func (in *NetworkConfiguration) DeepCopyInto(out *NetworkConfiguration) {
 			(*out)[key] = *val.DeepCopy()
 		}
 	}
+	if in.MacAddressPool != nil {
+		in, out := &in.MacAddressPool, &out.MacAddressPool
+		*out = new(MacAddressPoolConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -3402,6 +4352,28 @@ func (in *NoCloudSSHPublicKeyAccessCredentialPropagation) DeepCopy() *NoCloudSSH
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeEvacuationStatus) DeepCopyInto(out *NodeEvacuationStatus) {
+	*out = *in
+	if in.RemainingMemory != nil {
+		in, out := &in.RemainingMemory, &out.RemainingMemory
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	in.LastUpdateTime.DeepCopyInto(&out.LastUpdateTime)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeEvacuationStatus.
+func (in *NodeEvacuationStatus) DeepCopy() *NodeEvacuationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeEvacuationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NodeMediatedDeviceTypesConfig) DeepCopyInto(out *NodeMediatedDeviceTypesConfig) {
 	*out = *in
@@ -3422,6 +4394,11 @@ func (in *NodeMediatedDeviceTypesConfig) DeepCopyInto(out *NodeMediatedDeviceTyp
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.MediatedDeviceCounts != nil {
+		in, out := &in.MediatedDeviceCounts, &out.MediatedDeviceCounts
+		*out = make([]MediatedDeviceTypeCount, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -3491,6 +4468,27 @@ func (in *PITTimer) DeepCopy() *PITTimer {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PacketCaptureOptions) DeepCopyInto(out *PacketCaptureOptions) {
+	*out = *in
+	if in.Duration != nil {
+		in, out := &in.Duration, &out.Duration
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PacketCaptureOptions.
+func (in *PacketCaptureOptions) DeepCopy() *PacketCaptureOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(PacketCaptureOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PauseOptions) DeepCopyInto(out *PauseOptions) {
 	*out = *in
@@ -3513,6 +4511,22 @@ func (in *PauseOptions) DeepCopy() *PauseOptions {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PauseStatus) DeepCopyInto(out *PauseStatus) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PauseStatus.
+func (in *PauseStatus) DeepCopy() *PauseStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PauseStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PciHostDevice) DeepCopyInto(out *PciHostDevice) {
 	*out = *in
@@ -3549,6 +4563,11 @@ func (in *PermittedHostDevices) DeepCopyInto(out *PermittedHostDevices) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.USBPassthrough != nil {
+		in, out := &in.USBPassthrough, &out.USBPassthrough
+		*out = make([]USBPassthroughHostDevice, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -3624,6 +4643,86 @@ func (in *PersistentVolumeClaimVolumeSource) DeepCopy() *PersistentVolumeClaimVo
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PlacementHintsConfiguration) DeepCopyInto(out *PlacementHintsConfiguration) {
+	*out = *in
+	if in.CABundle != nil {
+		in, out := &in.CABundle, &out.CABundle
+		*out = make([]byte, len(*in))
+		copy(*out, *in)
+	}
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.FailurePolicy != nil {
+		in, out := &in.FailurePolicy, &out.FailurePolicy
+		*out = new(PlacementHintsFailurePolicy)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlacementHintsConfiguration.
+func (in *PlacementHintsConfiguration) DeepCopy() *PlacementHintsConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(PlacementHintsConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PlacementHintsRequest) DeepCopyInto(out *PlacementHintsRequest) {
+	*out = *in
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlacementHintsRequest.
+func (in *PlacementHintsRequest) DeepCopy() *PlacementHintsRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(PlacementHintsRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PlacementHintsResponse) DeepCopyInto(out *PlacementHintsResponse) {
+	*out = *in
+	if in.PreferredNodes != nil {
+		in, out := &in.PreferredNodes, &out.PreferredNodes
+		*out = make([]PreferredNode, len(*in))
+		copy(*out, *in)
+	}
+	if in.ForbiddenNodes != nil {
+		in, out := &in.ForbiddenNodes, &out.ForbiddenNodes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlacementHintsResponse.
+func (in *PlacementHintsResponse) DeepCopy() *PlacementHintsResponse {
+	if in == nil {
+		return nil
+	}
+	out := new(PlacementHintsResponse)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PluginBinding) DeepCopyInto(out *PluginBinding) {
 	*out = *in
@@ -3693,6 +4792,22 @@ func (in *PreferenceMatcher) DeepCopy() *PreferenceMatcher {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PreferredNode) DeepCopyInto(out *PreferredNode) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PreferredNode.
+func (in *PreferredNode) DeepCopy() *PreferredNode {
+	if in == nil {
+		return nil
+	}
+	out := new(PreferredNode)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Probe) DeepCopyInto(out *Probe) {
 	*out = *in
@@ -4307,6 +5422,54 @@ func (in *ServiceAccountVolumeSource) DeepCopy() *ServiceAccountVolumeSource {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SetLinkStateOptions) DeepCopyInto(out *SetLinkStateOptions) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	if in.DryRun != nil {
+		in, out := &in.DryRun, &out.DryRun
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SetLinkStateOptions.
+func (in *SetLinkStateOptions) DeepCopy() *SetLinkStateOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(SetLinkStateOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShutdownPolicy) DeepCopyInto(out *ShutdownPolicy) {
+	*out = *in
+	if in.ACPITimeoutSeconds != nil {
+		in, out := &in.ACPITimeoutSeconds, &out.ACPITimeoutSeconds
+		*out = new(int64)
+		**out = **in
+	}
+	if in.GuestAgentTimeoutSeconds != nil {
+		in, out := &in.GuestAgentTimeoutSeconds, &out.GuestAgentTimeoutSeconds
+		*out = new(int64)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShutdownPolicy.
+func (in *ShutdownPolicy) DeepCopy() *ShutdownPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(ShutdownPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SoundDevice) DeepCopyInto(out *SoundDevice) {
 	*out = *in
@@ -4323,6 +5486,37 @@ func (in *SoundDevice) DeepCopy() *SoundDevice {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StartConfiguration) DeepCopyInto(out *StartConfiguration) {
+	*out = *in
+	if in.ParallelStartsPerCluster != nil {
+		in, out := &in.ParallelStartsPerCluster, &out.ParallelStartsPerCluster
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.ParallelStartsPerNamespace != nil {
+		in, out := &in.ParallelStartsPerNamespace, &out.ParallelStartsPerNamespace
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.VolumePreparationLimits != nil {
+		in, out := &in.VolumePreparationLimits, &out.VolumePreparationLimits
+		*out = make([]VolumePreparationLimit, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StartConfiguration.
+func (in *StartConfiguration) DeepCopy() *StartConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(StartConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *StartOptions) DeepCopyInto(out *StartOptions) {
 	*out = *in
@@ -4401,16 +5595,49 @@ func (in *StorageMigratedVolumeInfo) DeepCopy() *StorageMigratedVolumeInfo {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SupportContainerResources) DeepCopyInto(out *SupportContainerResources) {
 	*out = *in
-	in.Resources.DeepCopyInto(&out.Resources)
+	in.Resources.DeepCopyInto(&out.Resources)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SupportContainerResources.
+func (in *SupportContainerResources) DeepCopy() *SupportContainerResources {
+	if in == nil {
+		return nil
+	}
+	out := new(SupportContainerResources)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SwapConfiguration) DeepCopyInto(out *SwapConfiguration) {
+	*out = *in
+	if in.NodeSelector != nil {
+		in, out := &in.NodeSelector, &out.NodeSelector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.EvictionSwapUsedPercent != nil {
+		in, out := &in.EvictionSwapUsedPercent, &out.EvictionSwapUsedPercent
+		*out = new(int32)
+		**out = **in
+	}
+	if in.EvictionMemoryPressurePercent != nil {
+		in, out := &in.EvictionMemoryPressurePercent, &out.EvictionMemoryPressurePercent
+		*out = new(int32)
+		**out = **in
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SupportContainerResources.
-func (in *SupportContainerResources) DeepCopy() *SupportContainerResources {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SwapConfiguration.
+func (in *SwapConfiguration) DeepCopy() *SwapConfiguration {
 	if in == nil {
 		return nil
 	}
-	out := new(SupportContainerResources)
+	out := new(SwapConfiguration)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -4509,6 +5736,43 @@ func (in *TPMDevice) DeepCopy() *TPMDevice {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ThreadPlacement) DeepCopyInto(out *ThreadPlacement) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ThreadPlacement.
+func (in *ThreadPlacement) DeepCopy() *ThreadPlacement {
+	if in == nil {
+		return nil
+	}
+	out := new(ThreadPlacement)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ThreadPlacementStatus) DeepCopyInto(out *ThreadPlacementStatus) {
+	*out = *in
+	if in.IOThreads != nil {
+		in, out := &in.IOThreads, &out.IOThreads
+		*out = make([]IOThreadPlacement, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ThreadPlacementStatus.
+func (in *ThreadPlacementStatus) DeepCopy() *ThreadPlacementStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ThreadPlacementStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Timer) DeepCopyInto(out *Timer) {
 	*out = *in
@@ -4574,6 +5838,11 @@ func (in *TopologyHints) DeepCopyInto(out *TopologyHints) {
 		*out = new(int64)
 		**out = **in
 	}
+	if in.CPUFeatures != nil {
+		in, out := &in.CPUFeatures, &out.CPUFeatures
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -4587,6 +5856,38 @@ func (in *TopologyHints) DeepCopy() *TopologyHints {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *USBDevice) DeepCopyInto(out *USBDevice) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new USBDevice.
+func (in *USBDevice) DeepCopy() *USBDevice {
+	if in == nil {
+		return nil
+	}
+	out := new(USBDevice)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *USBDeviceStatus) DeepCopyInto(out *USBDeviceStatus) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new USBDeviceStatus.
+func (in *USBDeviceStatus) DeepCopy() *USBDeviceStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(USBDeviceStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *USBHostDevice) DeepCopyInto(out *USBHostDevice) {
 	*out = *in
@@ -4608,6 +5909,22 @@ func (in *USBHostDevice) DeepCopy() *USBHostDevice {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *USBPassthroughHostDevice) DeepCopyInto(out *USBPassthroughHostDevice) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new USBPassthroughHostDevice.
+func (in *USBPassthroughHostDevice) DeepCopy() *USBPassthroughHostDevice {
+	if in == nil {
+		return nil
+	}
+	out := new(USBPassthroughHostDevice)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *USBSelector) DeepCopyInto(out *USBSelector) {
 	*out = *in
@@ -4790,6 +6107,48 @@ func (in *VSOCKOptions) DeepCopy() *VSOCKOptions {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtiofsIDMapping) DeepCopyInto(out *VirtiofsIDMapping) {
+	*out = *in
+	if in.UserIDs != nil {
+		in, out := &in.UserIDs, &out.UserIDs
+		*out = make([]VirtiofsIDRange, len(*in))
+		copy(*out, *in)
+	}
+	if in.GroupIDs != nil {
+		in, out := &in.GroupIDs, &out.GroupIDs
+		*out = make([]VirtiofsIDRange, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtiofsIDMapping.
+func (in *VirtiofsIDMapping) DeepCopy() *VirtiofsIDMapping {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtiofsIDMapping)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtiofsIDRange) DeepCopyInto(out *VirtiofsIDRange) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtiofsIDRange.
+func (in *VirtiofsIDRange) DeepCopy() *VirtiofsIDRange {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtiofsIDRange)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualMachine) DeepCopyInto(out *VirtualMachine) {
 	*out = *in
@@ -5154,6 +6513,41 @@ func (in *VirtualMachineInstanceMigrationCondition) DeepCopy() *VirtualMachineIn
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineInstanceMigrationFeasibility) DeepCopyInto(out *VirtualMachineInstanceMigrationFeasibility) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	if in.Blockers != nil {
+		in, out := &in.Blockers, &out.Blockers
+		*out = make([]MigrationBlocker, len(*in))
+		copy(*out, *in)
+	}
+	if in.TargetNodes != nil {
+		in, out := &in.TargetNodes, &out.TargetNodes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineInstanceMigrationFeasibility.
+func (in *VirtualMachineInstanceMigrationFeasibility) DeepCopy() *VirtualMachineInstanceMigrationFeasibility {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineInstanceMigrationFeasibility)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VirtualMachineInstanceMigrationFeasibility) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualMachineInstanceMigrationList) DeepCopyInto(out *VirtualMachineInstanceMigrationList) {
 	*out = *in
@@ -5264,6 +6658,11 @@ func (in *VirtualMachineInstanceMigrationState) DeepCopyInto(out *VirtualMachine
 		*out = make([]int, len(*in))
 		copy(*out, *in)
 	}
+	if in.Progress != nil {
+		in, out := &in.Progress, &out.Progress
+		*out = new(MigrationProgress)
+		**out = **in
+	}
 	return
 }
 
@@ -5631,6 +7030,11 @@ func (in *VirtualMachineInstanceSpec) DeepCopyInto(out *VirtualMachineInstanceSp
 		*out = new(int64)
 		**out = **in
 	}
+	if in.ShutdownPolicy != nil {
+		in, out := &in.ShutdownPolicy, &out.ShutdownPolicy
+		*out = new(ShutdownPolicy)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Volumes != nil {
 		in, out := &in.Volumes, &out.Volumes
 		*out = make([]Volume, len(*in))
@@ -5667,6 +7071,11 @@ func (in *VirtualMachineInstanceSpec) DeepCopyInto(out *VirtualMachineInstanceSp
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.GuestLicense != nil {
+		in, out := &in.GuestLicense, &out.GuestLicense
+		*out = new(GuestLicense)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -5715,6 +7124,16 @@ func (in *VirtualMachineInstanceStatus) DeepCopyInto(out *VirtualMachineInstance
 		*out = new(corev1.PodQOSClass)
 		**out = **in
 	}
+	if in.CgroupLayout != nil {
+		in, out := &in.CgroupLayout, &out.CgroupLayout
+		*out = new(CgroupLayout)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ThreadPlacement != nil {
+		in, out := &in.ThreadPlacement, &out.ThreadPlacement
+		*out = new(ThreadPlacementStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.ActivePods != nil {
 		in, out := &in.ActivePods, &out.ActivePods
 		*out = make(map[types.UID]string, len(*in))
@@ -5734,6 +7153,16 @@ func (in *VirtualMachineInstanceStatus) DeepCopyInto(out *VirtualMachineInstance
 		*out = new(KernelBootStatus)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.FSFreezeInfo != nil {
+		in, out := &in.FSFreezeInfo, &out.FSFreezeInfo
+		*out = new(FSFreezeInfo)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PauseStatus != nil {
+		in, out := &in.PauseStatus, &out.PauseStatus
+		*out = new(PauseStatus)
+		**out = **in
+	}
 	if in.TopologyHints != nil {
 		in, out := &in.TopologyHints, &out.TopologyHints
 		*out = new(TopologyHints)
@@ -5766,6 +7195,11 @@ func (in *VirtualMachineInstanceStatus) DeepCopyInto(out *VirtualMachineInstance
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.USBDevices != nil {
+		in, out := &in.USBDevices, &out.USBDevices
+		*out = make([]USBDeviceStatus, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -5846,6 +7280,11 @@ func (in *VirtualMachineMemoryDumpRequest) DeepCopyInto(out *VirtualMachineMemor
 		*out = new(string)
 		**out = **in
 	}
+	if in.ChunkSize != nil {
+		in, out := &in.ChunkSize, &out.ChunkSize
+		x := (*in).DeepCopy()
+		*out = &x
+	}
 	return
 }
 
@@ -5925,6 +7364,16 @@ func (in *VirtualMachineSpec) DeepCopyInto(out *VirtualMachineSpec) {
 		*out = new(UpdateVolumesStrategy)
 		**out = **in
 	}
+	if in.StorageMigration != nil {
+		in, out := &in.StorageMigration, &out.StorageMigration
+		*out = new(VirtualMachineStorageMigration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.VolumeAutoExpansion != nil {
+		in, out := &in.VolumeAutoExpansion, &out.VolumeAutoExpansion
+		*out = new(VolumeAutoExpansion)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -6040,6 +7489,11 @@ func (in *VirtualMachineStatus) DeepCopyInto(out *VirtualMachineStatus) {
 		*out = new(VolumeUpdateState)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.StorageMigration != nil {
+		in, out := &in.StorageMigration, &out.StorageMigration
+		*out = new(VirtualMachineStorageMigrationStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.InstancetypeRef != nil {
 		in, out := &in.InstancetypeRef, &out.InstancetypeRef
 		*out = new(InstancetypeStatusRef)
@@ -6063,6 +7517,77 @@ func (in *VirtualMachineStatus) DeepCopy() *VirtualMachineStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineStorageMigration) DeepCopyInto(out *VirtualMachineStorageMigration) {
+	*out = *in
+	if in.Volumes != nil {
+		in, out := &in.Volumes, &out.Volumes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Method != nil {
+		in, out := &in.Method, &out.Method
+		*out = new(VirtualMachineStorageMigrationMethod)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineStorageMigration.
+func (in *VirtualMachineStorageMigration) DeepCopy() *VirtualMachineStorageMigration {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineStorageMigration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineStorageMigrationStatus) DeepCopyInto(out *VirtualMachineStorageMigrationStatus) {
+	*out = *in
+	if in.Volumes != nil {
+		in, out := &in.Volumes, &out.Volumes
+		*out = make([]VirtualMachineStorageMigrationVolumeStatus, len(*in))
+		copy(*out, *in)
+	}
+	if in.StartTimestamp != nil {
+		in, out := &in.StartTimestamp, &out.StartTimestamp
+		*out = (*in).DeepCopy()
+	}
+	if in.EndTimestamp != nil {
+		in, out := &in.EndTimestamp, &out.EndTimestamp
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineStorageMigrationStatus.
+func (in *VirtualMachineStorageMigrationStatus) DeepCopy() *VirtualMachineStorageMigrationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineStorageMigrationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineStorageMigrationVolumeStatus) DeepCopyInto(out *VirtualMachineStorageMigrationVolumeStatus) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineStorageMigrationVolumeStatus.
+func (in *VirtualMachineStorageMigrationVolumeStatus) DeepCopy() *VirtualMachineStorageMigrationVolumeStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineStorageMigrationVolumeStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualMachineVolumeRequest) DeepCopyInto(out *VirtualMachineVolumeRequest) {
 	*out = *in
@@ -6106,6 +7631,37 @@ func (in *Volume) DeepCopy() *Volume {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VolumeAutoExpansion) DeepCopyInto(out *VolumeAutoExpansion) {
+	*out = *in
+	if in.Volumes != nil {
+		in, out := &in.Volumes, &out.Volumes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.IncrementPercentage != nil {
+		in, out := &in.IncrementPercentage, &out.IncrementPercentage
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxSize != nil {
+		in, out := &in.MaxSize, &out.MaxSize
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeAutoExpansion.
+func (in *VolumeAutoExpansion) DeepCopy() *VolumeAutoExpansion {
+	if in == nil {
+		return nil
+	}
+	out := new(VolumeAutoExpansion)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VolumeMigrationState) DeepCopyInto(out *VolumeMigrationState) {
 	*out = *in
@@ -6129,6 +7685,22 @@ func (in *VolumeMigrationState) DeepCopy() *VolumeMigrationState {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VolumePreparationLimit) DeepCopyInto(out *VolumePreparationLimit) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumePreparationLimit.
+func (in *VolumePreparationLimit) DeepCopy() *VolumePreparationLimit {
+	if in == nil {
+		return nil
+	}
+	out := new(VolumePreparationLimit)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VolumeSnapshotStatus) DeepCopyInto(out *VolumeSnapshotStatus) {
 	*out = *in
@@ -6221,7 +7793,7 @@ func (in *VolumeSource) DeepCopyInto(out *VolumeSource) {
 	if in.MemoryDump != nil {
 		in, out := &in.MemoryDump, &out.MemoryDump
 		*out = new(MemoryDumpVolumeSource)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	return
 }
@@ -6259,6 +7831,11 @@ func (in *VolumeStatus) DeepCopyInto(out *VolumeStatus) {
 		*out = new(ContainerDiskInfo)
 		**out = **in
 	}
+	if in.GuestFilesystemUsage != nil {
+		in, out := &in.GuestFilesystemUsage, &out.GuestFilesystemUsage
+		*out = new(GuestFilesystemUsage)
+		**out = **in
+	}
 	return
 }
 
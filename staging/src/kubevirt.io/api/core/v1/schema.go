@@ -35,6 +35,7 @@ const (
 	IOThreadsPolicySupplementalPool IOThreadsPolicy = "supplementalPool"
 	CPUModeHostPassthrough                          = "host-passthrough"
 	CPUModeHostModel                                = "host-model"
+	CPUModeClusterCommon                            = "cluster-common"
 	DefaultCPUModel                                 = CPUModeHostModel
 )
 
@@ -320,6 +321,7 @@ type CPU struct {
 	// List of available models https://github.com/libvirt/libvirt/tree/master/src/cpu_map.
 	// It is possible to specify special cases like "host-passthrough" to get the same CPU as the node
 	// and "host-model" to get CPU closest to the node one.
+	// "cluster-common" selects the CPU model and features common to the nodes matching the node selector of the VMI.
 	// Defaults to host-model.
 	// +optional
 	Model string `json:"model,omitempty"`
@@ -342,6 +344,54 @@ type CPU struct {
 	// Realtime instructs the virt-launcher to tune the VMI for lower latency, optional for real time workloads
 	// +optional
 	Realtime *Realtime `json:"realtime,omitempty"`
+	// Housekeeping gives the QEMU threads which do not run vCPUs, like the emulator and IOThreads,
+	// their own CPU budget in a child cgroup of the compute container, so they don't take CPU time
+	// from the vCPUs. Can not be combined with DedicatedCPUPlacement, use IsolateEmulatorThread instead.
+	// +optional
+	Housekeeping *Housekeeping `json:"housekeeping,omitempty"`
+	// ThreadPlacement defines on which pCPUs the emulator thread and the IOThreads run.
+	// Requires DedicatedCPUPlacement.
+	// +optional
+	ThreadPlacement *ThreadPlacement `json:"threadPlacement,omitempty"`
+
+	// MitigatedVulnerabilities lists the CPU vulnerabilities, as named by the kernel in /sys/devices/system/cpu/vulnerabilities,
+	// e.g. retbleed or mds, the node of the VMI has to be mitigated against or not affected by.
+	// +optional
+	// +listType=set
+	MitigatedVulnerabilities []string `json:"mitigatedVulnerabilities,omitempty"`
+}
+
+type ThreadPlacementPolicy string
+
+const (
+	// ThreadPlacementShared runs the threads on the pCPUs they share with the other housekeeping threads.
+	ThreadPlacementShared ThreadPlacementPolicy = "shared"
+	// ThreadPlacementDedicated runs the threads on an additional pCPU which is dedicated to them.
+	ThreadPlacementDedicated ThreadPlacementPolicy = "dedicated"
+	// ThreadPlacementSameNUMANode runs the threads on an additional pCPU which is dedicated to them,
+	// on a host NUMA node the vCPUs run on.
+	ThreadPlacementSameNUMANode ThreadPlacementPolicy = "sameNUMANode"
+)
+
+// ThreadPlacement defines where the emulator thread and the IOThreads of a VMI with dedicated CPUs run.
+type ThreadPlacement struct {
+	// EmulatorThread defines where the emulator thread runs. With shared it runs on the pCPUs of the vCPUs,
+	// dedicated and sameNUMANode isolate it on an additional pCPU, like IsolateEmulatorThread does.
+	// Defaults to dedicated if IsolateEmulatorThread is set and to shared otherwise.
+	// +optional
+	EmulatorThread ThreadPlacementPolicy `json:"emulatorThread,omitempty"`
+	// IOThreads defines where the IOThreads run. With shared they run on the pCPU of the isolated emulator
+	// thread, or on the pCPUs of the vCPUs if the emulator thread is not isolated. With dedicated and
+	// sameNUMANode all IOThreads run together on an additional pCPU. Defaults to shared.
+	// +optional
+	IOThreads ThreadPlacementPolicy `json:"ioThreads,omitempty"`
+}
+
+// Housekeeping holds the CPU budget of the housekeeping threads of the VMI.
+type Housekeeping struct {
+	// CPU is added to the CPU requests of the compute container, and to its CPU limits if they are set.
+	// The housekeeping threads are limited to this amount of CPU, the rest is left to the vCPUs.
+	CPU resource.Quantity `json:"cpu"`
 }
 
 // Realtime holds the tuning knobs specific for realtime workloads.
@@ -405,6 +455,10 @@ type MemoryStatus struct {
 	// GuestRequested specifies how much memory was requested (hotplug) for the VirtualMachine.
 	// +optional
 	GuestRequested *resource.Quantity `json:"guestRequested,omitempty"`
+	// GuestUsable specifies how much memory is actually usable by the guest.
+	// It can stay above GuestRequested when the guest could not release all the memory being unplugged.
+	// +optional
+	GuestUsable *resource.Quantity `json:"guestUsable,omitempty"`
 }
 
 // Hugepages allow to use hugepages for the VirtualMachineInstance instead of regular memory.
@@ -509,6 +563,11 @@ type Devices struct {
 	// +optional
 	// +listType=atomic
 	HostDevices []HostDevice `json:"hostDevices,omitempty"`
+	// USBDevices are host USB devices passed through to the vmi. They are allocated by virt-handler
+	// among the devices plugged into the node, and attached to the vmi as they get plugged.
+	// +optional
+	// +listType=atomic
+	USBDevices []USBDevice `json:"usbDevices,omitempty"`
 	// To configure and access client devices such as redirecting USB
 	// +optional
 	ClientPassthrough *ClientPassthroughDevices `json:"clientPassthrough,omitempty"`
@@ -584,7 +643,51 @@ type Filesystem struct {
 	Virtiofs *FilesystemVirtiofs `json:"virtiofs"`
 }
 
-type FilesystemVirtiofs struct{}
+type FilesystemVirtiofs struct {
+	// Cache selects the caching policy of the virtiofs daemon serving the
+	// filesystem. Use never when other pods write to the same volume
+	// concurrently, so that the guest always sees their changes.
+	// Supported values: auto, always, never. Defaults to auto.
+	// +kubebuilder:validation:Enum=auto;always;never
+	// +optional
+	Cache VirtiofsCacheMode `json:"cache,omitempty"`
+	// IDMapping translates the user and group IDs seen by the guest into
+	// the IDs stored on the shared volume. Use it to let the guest and
+	// other pods sharing the volume agree on file ownership.
+	// +optional
+	IDMapping *VirtiofsIDMapping `json:"idMapping,omitempty"`
+}
+
+type VirtiofsCacheMode string
+
+const (
+	VirtiofsCacheAuto   VirtiofsCacheMode = "auto"
+	VirtiofsCacheAlways VirtiofsCacheMode = "always"
+	VirtiofsCacheNever  VirtiofsCacheMode = "never"
+)
+
+type VirtiofsIDMapping struct {
+	// UserIDs maps ranges of guest user IDs to user IDs on the volume.
+	// +listType=atomic
+	// +optional
+	UserIDs []VirtiofsIDRange `json:"userIDs,omitempty"`
+	// GroupIDs maps ranges of guest group IDs to group IDs on the volume.
+	// +listType=atomic
+	// +optional
+	GroupIDs []VirtiofsIDRange `json:"groupIDs,omitempty"`
+}
+
+type VirtiofsIDRange struct {
+	// GuestID is the first ID of the range as seen by the guest.
+	// +kubebuilder:validation:Minimum=0
+	GuestID int64 `json:"guestID"`
+	// HostID is the first ID of the range as stored on the volume.
+	// +kubebuilder:validation:Minimum=0
+	HostID int64 `json:"hostID"`
+	// Count is the number of consecutive IDs in the range.
+	// +kubebuilder:validation:Minimum=1
+	Count int64 `json:"count"`
+}
 
 type DownwardMetrics struct{}
 
@@ -622,6 +725,20 @@ type HostDevice struct {
 	Tag string `json:"tag,omitempty"`
 }
 
+// USBDevice selects a USB device plugged into the node of the vmi, either by its vendor and product
+// identifiers or by the port it is plugged into. Exactly one of the selectors has to be set.
+type USBDevice struct {
+	// Name of the USB device, unique within the vmi
+	Name string `json:"name"`
+	// VendorProduct selects a free device by its vendor_id:product_id tuple, e.g. 1050:0407
+	// +optional
+	VendorProduct string `json:"vendorProduct,omitempty"`
+	// BusPort selects the device plugged into a port, by the bus number and port path the kernel
+	// names the device after, e.g. 1-2.3
+	// +optional
+	BusPort string `json:"busPort,omitempty"`
+}
+
 type Disk struct {
 	// Name is the device name
 	Name string `json:"name"`
@@ -662,6 +779,33 @@ type Disk struct {
 	// If specified, it can change the default error policy (stop) for the disk
 	// +optional
 	ErrorPolicy *DiskErrorPolicy `json:"errorPolicy,omitempty"`
+	// IOTune limits the IO operations and the throughput of the disk.
+	// It can be set when hotplugging the disk.
+	// +optional
+	IOTune *DiskIOTune `json:"ioTune,omitempty"`
+}
+
+// DiskIOTune represents the IO limits of a disk.
+// A total limit can't be combined with the read or write limit of the same kind.
+type DiskIOTune struct {
+	// TotalBytesSec limits the total throughput of reads and writes in bytes per second.
+	// +optional
+	TotalBytesSec *resource.Quantity `json:"totalBytesSec,omitempty"`
+	// ReadBytesSec limits the read throughput in bytes per second.
+	// +optional
+	ReadBytesSec *resource.Quantity `json:"readBytesSec,omitempty"`
+	// WriteBytesSec limits the write throughput in bytes per second.
+	// +optional
+	WriteBytesSec *resource.Quantity `json:"writeBytesSec,omitempty"`
+	// TotalIOPSSec limits the total number of read and write operations per second.
+	// +optional
+	TotalIOPSSec *uint64 `json:"totalIOPSSec,omitempty"`
+	// ReadIOPSSec limits the number of read operations per second.
+	// +optional
+	ReadIOPSSec *uint64 `json:"readIOPSSec,omitempty"`
+	// WriteIOPSSec limits the number of write operations per second.
+	// +optional
+	WriteIOPSSec *uint64 `json:"writeIOPSSec,omitempty"`
 }
 
 // CustomBlockSize represents the desired logical and physical block size for a VM disk.
@@ -883,6 +1027,13 @@ type MemoryDumpVolumeSource struct {
 	// Directly attached to the virt launcher
 	// +optional
 	PersistentVolumeClaimVolumeSource `json:",inline"`
+	// Compression compresses the memory dump while it is written to the volume
+	// +kubebuilder:validation:Enum=gzip;zstd
+	// +optional
+	Compression MemoryDumpCompression `json:"compression,omitempty"`
+	// ChunkSize is the amount of the dump which is written to the volume before it is flushed to disk
+	// +optional
+	ChunkSize *resource.Quantity `json:"chunkSize,omitempty"`
 }
 
 type EphemeralVolumeSource struct {
@@ -891,6 +1042,20 @@ type EphemeralVolumeSource struct {
 	// More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#persistentvolumeclaims
 	// +optional
 	PersistentVolumeClaim *v1.PersistentVolumeClaimVolumeSource `json:"persistentVolumeClaim,omitempty"`
+	// Overlay places the copy-on-write overlay holding the writes of the guest
+	// on a dedicated volume instead of the ephemeral storage of the pod.
+	// +optional
+	Overlay *EphemeralOverlay `json:"overlay,omitempty"`
+}
+
+// EphemeralOverlay describes the volume holding the overlay of an ephemeral
+// disk. The volume is provisioned together with the virt-launcher pod and
+// deleted with it, so it fits node-local storage classes best.
+type EphemeralOverlay struct {
+	// StorageClassName is the storage class of the overlay volume.
+	StorageClassName string `json:"storageClassName"`
+	// Capacity of the overlay volume, limiting how much the guest can write.
+	Capacity resource.Quantity `json:"capacity"`
 }
 
 // EmptyDisk represents a temporary disk which shares the vmis lifecycle.
@@ -945,6 +1110,38 @@ type Clock struct {
 	// Timer specifies whih timers are attached to the vmi.
 	// +optional
 	Timer *Timer `json:"timer,omitempty"`
+	// GuestTimeSync configures when the guest clock is set to the host time through the guest agent.
+	// +optional
+	GuestTimeSync *GuestTimeSync `json:"guestTimeSync,omitempty"`
+}
+
+// GuestTimeSyncPolicy selects when the guest clock is set to the host time.
+type GuestTimeSyncPolicy string
+
+const (
+	// GuestTimeSyncOnResume sets the guest clock after a live migration and when the vmi is unpaused
+	GuestTimeSyncOnResume GuestTimeSyncPolicy = "OnResume"
+	// GuestTimeSyncOnDrift additionally sets the guest clock whenever it drifts away from the host clock
+	GuestTimeSyncOnDrift GuestTimeSyncPolicy = "OnDrift"
+	// GuestTimeSyncDisabled never sets the guest clock
+	GuestTimeSyncDisabled GuestTimeSyncPolicy = "Disabled"
+)
+
+// GuestTimeSync configures when the guest clock is set to the host time through the guest agent.
+type GuestTimeSync struct {
+	// Policy selects when the guest clock is set to the host time.
+	// "OnResume" sets it after a live migration and when the vmi is unpaused.
+	// "OnDrift" additionally sets it whenever the guest clock drifts away from the host clock by more than
+	// the drift threshold, e.g. after the node resumed from suspend.
+	// "Disabled" never sets it, leaving the guest clock to the time synchronization of the guest.
+	// Defaults to "OnResume".
+	// +optional
+	Policy GuestTimeSyncPolicy `json:"policy,omitempty"`
+	// DriftThresholdSeconds is the drift of the guest clock above which it is set to the host time
+	// with the "OnDrift" policy.
+	// Defaults to 2.
+	// +optional
+	DriftThresholdSeconds *uint32 `json:"driftThresholdSeconds,omitempty"`
 }
 
 // Represents all available timers in a vmi.
@@ -1287,6 +1484,10 @@ type Interface struct {
 	// Empty value functions as `up`.
 	// +optional
 	State InterfaceState `json:"state,omitempty"`
+	// Bandwidth limits the traffic of the interface.
+	// It is only supported on bridge and masquerade bindings.
+	// +optional
+	Bandwidth *InterfaceBandwidth `json:"bandwidth,omitempty"`
 }
 
 type InterfaceState string
@@ -1297,6 +1498,28 @@ const (
 	InterfaceStateLinkDown InterfaceState = "down"
 )
 
+// InterfaceBandwidth represents the traffic limits of an interface.
+type InterfaceBandwidth struct {
+	// Inbound limits the traffic received by the guest.
+	// +optional
+	Inbound *InterfaceBandwidthLimit `json:"inbound,omitempty"`
+	// Outbound limits the traffic transmitted by the guest.
+	// +optional
+	Outbound *InterfaceBandwidthLimit `json:"outbound,omitempty"`
+}
+
+// InterfaceBandwidthLimit represents the traffic limits of an interface in one direction.
+type InterfaceBandwidthLimit struct {
+	// Average is the average rate the traffic is shaped to, in bytes per second.
+	Average resource.Quantity `json:"average"`
+	// Peak is the rate the traffic may reach while bursting, in bytes per second.
+	// +optional
+	Peak *resource.Quantity `json:"peak,omitempty"`
+	// Burst is the amount of bytes which may be transferred at the peak rate.
+	// +optional
+	Burst *resource.Quantity `json:"burst,omitempty"`
+}
+
 // Extra DHCP options to use in the interface.
 type DHCPOptions struct {
 	// If specified will pass option 67 to interface's DHCP server
@@ -1311,6 +1534,13 @@ type DHCPOptions struct {
 	// If specified will pass extra DHCP options for private use, range: 224-254
 	// +optional
 	PrivateOptions []DHCPPrivateOptions `json:"privateOptions,omitempty"`
+	// If specified will pass the MTU to the VM via DHCP option 026 instead of the MTU of the pod interface.
+	// +optional
+	MTU *uint32 `json:"mtu,omitempty"`
+	// If specified will pass the routes to the VM via DHCP option 121, in addition to the routes of the pod interface.
+	// +optional
+	// +listType=atomic
+	Routes []DHCPRoute `json:"routes,omitempty"`
 }
 
 func (d *DHCPOptions) UnmarshalJSON(data []byte) error {
@@ -1341,6 +1571,16 @@ type DHCPPrivateOptions struct {
 	Value string `json:"value"`
 }
 
+// DHCPRoute defines a classless static route passed to the VM via DHCP.
+type DHCPRoute struct {
+	// Destination is the IPv4 CIDR of the route
+	// Required.
+	Destination string `json:"destination"`
+	// Gateway is the IPv4 address of the next hop, the route is on-link if omitted
+	// +optional
+	Gateway string `json:"gateway,omitempty"`
+}
+
 // Represents the method which will be used to connect the interface to the guest.
 // Only one of its members may be specified.
 type InterfaceBindingMethod struct {
@@ -157,17 +157,35 @@ func (ResourceRequirements) SwaggerDoc() map[string]string {
 
 func (CPU) SwaggerDoc() map[string]string {
 	return map[string]string{
-		"":                      "CPU allows specifying the CPU topology.",
-		"cores":                 "Cores specifies the number of cores inside the vmi.\nMust be a value greater or equal 1.",
-		"sockets":               "Sockets specifies the number of sockets inside the vmi.\nMust be a value greater or equal 1.",
-		"maxSockets":            "MaxSockets specifies the maximum amount of sockets that can\nbe hotplugged",
-		"threads":               "Threads specifies the number of threads inside the vmi.\nMust be a value greater or equal 1.",
-		"model":                 "Model specifies the CPU model inside the VMI.\nList of available models https://github.com/libvirt/libvirt/tree/master/src/cpu_map.\nIt is possible to specify special cases like \"host-passthrough\" to get the same CPU as the node\nand \"host-model\" to get CPU closest to the node one.\nDefaults to host-model.\n+optional",
-		"features":              "Features specifies the CPU features list inside the VMI.\n+optional",
-		"dedicatedCpuPlacement": "DedicatedCPUPlacement requests the scheduler to place the VirtualMachineInstance on a node\nwith enough dedicated pCPUs and pin the vCPUs to it.\n+optional",
-		"numa":                  "NUMA allows specifying settings for the guest NUMA topology\n+optional",
-		"isolateEmulatorThread": "IsolateEmulatorThread requests one more dedicated pCPU to be allocated for the VMI to place\nthe emulator thread on it.\n+optional",
-		"realtime":              "Realtime instructs the virt-launcher to tune the VMI for lower latency, optional for real time workloads\n+optional",
+		"":                         "CPU allows specifying the CPU topology.",
+		"cores":                    "Cores specifies the number of cores inside the vmi.\nMust be a value greater or equal 1.",
+		"sockets":                  "Sockets specifies the number of sockets inside the vmi.\nMust be a value greater or equal 1.",
+		"maxSockets":               "MaxSockets specifies the maximum amount of sockets that can\nbe hotplugged",
+		"threads":                  "Threads specifies the number of threads inside the vmi.\nMust be a value greater or equal 1.",
+		"model":                    "Model specifies the CPU model inside the VMI.\nList of available models https://github.com/libvirt/libvirt/tree/master/src/cpu_map.\nIt is possible to specify special cases like \"host-passthrough\" to get the same CPU as the node\nand \"host-model\" to get CPU closest to the node one.\n\"cluster-common\" selects the CPU model and features common to the nodes matching the node selector of the VMI.\nDefaults to host-model.\n+optional",
+		"features":                 "Features specifies the CPU features list inside the VMI.\n+optional",
+		"dedicatedCpuPlacement":    "DedicatedCPUPlacement requests the scheduler to place the VirtualMachineInstance on a node\nwith enough dedicated pCPUs and pin the vCPUs to it.\n+optional",
+		"numa":                     "NUMA allows specifying settings for the guest NUMA topology\n+optional",
+		"isolateEmulatorThread":    "IsolateEmulatorThread requests one more dedicated pCPU to be allocated for the VMI to place\nthe emulator thread on it.\n+optional",
+		"realtime":                 "Realtime instructs the virt-launcher to tune the VMI for lower latency, optional for real time workloads\n+optional",
+		"housekeeping":             "Housekeeping gives the QEMU threads which do not run vCPUs, like the emulator and IOThreads,\ntheir own CPU budget in a child cgroup of the compute container, so they don't take CPU time\nfrom the vCPUs. Can not be combined with DedicatedCPUPlacement, use IsolateEmulatorThread instead.\n+optional",
+		"threadPlacement":          "ThreadPlacement defines on which pCPUs the emulator thread and the IOThreads run.\nRequires DedicatedCPUPlacement.\n+optional",
+		"mitigatedVulnerabilities": "MitigatedVulnerabilities lists the CPU vulnerabilities, as named by the kernel in /sys/devices/system/cpu/vulnerabilities,\ne.g. retbleed or mds, the node of the VMI has to be mitigated against or not affected by.\n+optional\n+listType=set",
+	}
+}
+
+func (ThreadPlacement) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":               "ThreadPlacement defines where the emulator thread and the IOThreads of a VMI with dedicated CPUs run.",
+		"emulatorThread": "EmulatorThread defines where the emulator thread runs. With shared it runs on the pCPUs of the vCPUs,\ndedicated and sameNUMANode isolate it on an additional pCPU, like IsolateEmulatorThread does.\nDefaults to dedicated if IsolateEmulatorThread is set and to shared otherwise.\n+optional",
+		"ioThreads":      "IOThreads defines where the IOThreads run. With shared they run on the pCPU of the isolated emulator\nthread, or on the pCPUs of the vCPUs if the emulator thread is not isolated. With dedicated and\nsameNUMANode all IOThreads run together on an additional pCPU. Defaults to shared.\n+optional",
+	}
+}
+
+func (Housekeeping) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":    "Housekeeping holds the CPU budget of the housekeeping threads of the VMI.",
+		"cpu": "CPU is added to the CPU requests of the compute container, and to its CPU limits if they are set.\nThe housekeeping threads are limited to this amount of CPU, the rest is left to the vCPUs.",
 	}
 }
 
@@ -212,6 +230,7 @@ func (MemoryStatus) SwaggerDoc() map[string]string {
 		"guestAtBoot":    "GuestAtBoot specifies with how much memory the VirtualMachine intiallly booted with.\n+optional",
 		"guestCurrent":   "GuestCurrent specifies how much memory is currently available for the VirtualMachine.\n+optional",
 		"guestRequested": "GuestRequested specifies how much memory was requested (hotplug) for the VirtualMachine.\n+optional",
+		"guestUsable":    "GuestUsable specifies how much memory is actually usable by the guest.\nIt can stay above GuestRequested when the guest could not release all the memory being unplugged.\n+optional",
 	}
 }
 
@@ -266,6 +285,7 @@ func (Devices) SwaggerDoc() map[string]string {
 		"downwardMetrics":            "DownwardMetrics creates a virtio serials for exposing the downward metrics to the vmi.\n+optional",
 		"filesystems":                "Filesystems describes filesystem which is connected to the vmi.\n+optional\n+listType=atomic",
 		"hostDevices":                "Whether to attach a host device to the vmi.\n+optional\n+listType=atomic",
+		"usbDevices":                 "USBDevices are host USB devices passed through to the vmi. They are allocated by virt-handler\namong the devices plugged into the node, and attached to the vmi as they get plugged.\n+optional\n+listType=atomic",
 		"clientPassthrough":          "To configure and access client devices such as redirecting USB\n+optional",
 		"sound":                      "Whether to emulate a sound device.\n+optional",
 		"tpm":                        "Whether to emulate a TPM device.\n+optional",
@@ -308,7 +328,25 @@ func (Filesystem) SwaggerDoc() map[string]string {
 }
 
 func (FilesystemVirtiofs) SwaggerDoc() map[string]string {
-	return map[string]string{}
+	return map[string]string{
+		"cache":     "Cache selects the caching policy of the virtiofs daemon serving the\nfilesystem. Use never when other pods write to the same volume\nconcurrently, so that the guest always sees their changes.\nSupported values: auto, always, never. Defaults to auto.\n+kubebuilder:validation:Enum=auto;always;never\n+optional",
+		"idMapping": "IDMapping translates the user and group IDs seen by the guest into\nthe IDs stored on the shared volume. Use it to let the guest and\nother pods sharing the volume agree on file ownership.\n+optional",
+	}
+}
+
+func (VirtiofsIDMapping) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"userIDs":  "UserIDs maps ranges of guest user IDs to user IDs on the volume.\n+listType=atomic\n+optional",
+		"groupIDs": "GroupIDs maps ranges of guest group IDs to group IDs on the volume.\n+listType=atomic\n+optional",
+	}
+}
+
+func (VirtiofsIDRange) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"guestID": "GuestID is the first ID of the range as seen by the guest.\n+kubebuilder:validation:Minimum=0",
+		"hostID":  "HostID is the first ID of the range as stored on the volume.\n+kubebuilder:validation:Minimum=0",
+		"count":   "Count is the number of consecutive IDs in the range.\n+kubebuilder:validation:Minimum=1",
+	}
 }
 
 func (DownwardMetrics) SwaggerDoc() map[string]string {
@@ -340,6 +378,15 @@ func (HostDevice) SwaggerDoc() map[string]string {
 	}
 }
 
+func (USBDevice) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":              "USBDevice selects a USB device plugged into the node of the vmi, either by its vendor and product\nidentifiers or by the port it is plugged into. Exactly one of the selectors has to be set.",
+		"name":          "Name of the USB device, unique within the vmi",
+		"vendorProduct": "VendorProduct selects a free device by its vendor_id:product_id tuple, e.g. 1050:0407\n+optional",
+		"busPort":       "BusPort selects the device plugged into a port, by the bus number and port path the kernel\nnames the device after, e.g. 1-2.3\n+optional",
+	}
+}
+
 func (Disk) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"name":              "Name is the device name",
@@ -352,6 +399,19 @@ func (Disk) SwaggerDoc() map[string]string {
 		"blockSize":         "If specified, the virtual disk will be presented with the given block sizes.\n+optional",
 		"shareable":         "If specified the disk is made sharable and multiple write from different VMs are permitted\n+optional",
 		"errorPolicy":       "If specified, it can change the default error policy (stop) for the disk\n+optional",
+		"ioTune":            "IOTune limits the IO operations and the throughput of the disk.\nIt can be set when hotplugging the disk.\n+optional",
+	}
+}
+
+func (DiskIOTune) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":              "DiskIOTune represents the IO limits of a disk.\nA total limit can't be combined with the read or write limit of the same kind.",
+		"totalBytesSec": "TotalBytesSec limits the total throughput of reads and writes in bytes per second.\n+optional",
+		"readBytesSec":  "ReadBytesSec limits the read throughput in bytes per second.\n+optional",
+		"writeBytesSec": "WriteBytesSec limits the write throughput in bytes per second.\n+optional",
+		"totalIOPSSec":  "TotalIOPSSec limits the total number of read and write operations per second.\n+optional",
+		"readIOPSSec":   "ReadIOPSSec limits the number of read operations per second.\n+optional",
+		"writeIOPSSec":  "WriteIOPSSec limits the number of write operations per second.\n+optional",
 	}
 }
 
@@ -476,12 +536,24 @@ func (PersistentVolumeClaimVolumeSource) SwaggerDoc() map[string]string {
 }
 
 func (MemoryDumpVolumeSource) SwaggerDoc() map[string]string {
-	return map[string]string{}
+	return map[string]string{
+		"compression": "Compression compresses the memory dump while it is written to the volume\n+kubebuilder:validation:Enum=gzip;zstd\n+optional",
+		"chunkSize":   "ChunkSize is the amount of the dump which is written to the volume before it is flushed to disk\n+optional",
+	}
 }
 
 func (EphemeralVolumeSource) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"persistentVolumeClaim": "PersistentVolumeClaimVolumeSource represents a reference to a PersistentVolumeClaim in the same namespace.\nDirectly attached to the vmi via qemu.\nMore info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#persistentvolumeclaims\n+optional",
+		"overlay":               "Overlay places the copy-on-write overlay holding the writes of the guest\non a dedicated volume instead of the ephemeral storage of the pod.\n+optional",
+	}
+}
+
+func (EphemeralOverlay) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                 "EphemeralOverlay describes the volume holding the overlay of an ephemeral\ndisk. The volume is provisioned together with the virt-launcher pod and\ndeleted with it, so it fits node-local storage classes best.",
+		"storageClassName": "StorageClassName is the storage class of the overlay volume.",
+		"capacity":         "Capacity of the overlay volume, limiting how much the guest can write.",
 	}
 }
 
@@ -519,8 +591,17 @@ func (ClockOffsetUTC) SwaggerDoc() map[string]string {
 
 func (Clock) SwaggerDoc() map[string]string {
 	return map[string]string{
-		"":      "Represents the clock and timers of a vmi.\n+kubebuilder:pruning:PreserveUnknownFields",
-		"timer": "Timer specifies whih timers are attached to the vmi.\n+optional",
+		"":              "Represents the clock and timers of a vmi.\n+kubebuilder:pruning:PreserveUnknownFields",
+		"timer":         "Timer specifies whih timers are attached to the vmi.\n+optional",
+		"guestTimeSync": "GuestTimeSync configures when the guest clock is set to the host time through the guest agent.\n+optional",
+	}
+}
+
+func (GuestTimeSync) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                      "GuestTimeSync configures when the guest clock is set to the host time through the guest agent.",
+		"policy":                "Policy selects when the guest clock is set to the host time.\n\"OnResume\" sets it after a live migration and when the vmi is unpaused.\n\"OnDrift\" additionally sets it whenever the guest clock drifts away from the host clock by more than\nthe drift threshold, e.g. after the node resumed from suspend.\n\"Disabled\" never sets it, leaving the guest clock to the time synchronization of the guest.\nDefaults to \"OnResume\".\n+optional",
+		"driftThresholdSeconds": "DriftThresholdSeconds is the drift of the guest clock above which it is set to the host time\nwith the \"OnDrift\" policy.\nDefaults to 2.\n+optional",
 	}
 }
 
@@ -679,6 +760,24 @@ func (Interface) SwaggerDoc() map[string]string {
 		"tag":         "If specified, the virtual network interface address and its tag will be provided to the guest via config drive\n+optional",
 		"acpiIndex":   "If specified, the ACPI index is used to provide network interface device naming, that is stable across changes\nin PCI addresses assigned to the device.\nThis value is required to be unique across all devices and be between 1 and (16*1024-1).\n+optional",
 		"state":       "State represents the requested operational state of the interface.\nThe supported values are:\n`absent`, expressing a request to remove the interface.\n`down`, expressing a request to set the link down.\n`up`, expressing a request to set the link up.\nEmpty value functions as `up`.\n+optional",
+		"bandwidth":   "Bandwidth limits the traffic of the interface.\nIt is only supported on bridge and masquerade bindings.\n+optional",
+	}
+}
+
+func (InterfaceBandwidth) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":         "InterfaceBandwidth represents the traffic limits of an interface.",
+		"inbound":  "Inbound limits the traffic received by the guest.\n+optional",
+		"outbound": "Outbound limits the traffic transmitted by the guest.\n+optional",
+	}
+}
+
+func (InterfaceBandwidthLimit) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":        "InterfaceBandwidthLimit represents the traffic limits of an interface in one direction.",
+		"average": "Average is the average rate the traffic is shaped to, in bytes per second.",
+		"peak":    "Peak is the rate the traffic may reach while bursting, in bytes per second.\n+optional",
+		"burst":   "Burst is the amount of bytes which may be transferred at the peak rate.\n+optional",
 	}
 }
 
@@ -689,6 +788,8 @@ func (DHCPOptions) SwaggerDoc() map[string]string {
 		"tftpServerName": "If specified will pass option 66 to interface's DHCP server\n+optional",
 		"ntpServers":     "If specified will pass the configured NTP server to the VM via DHCP option 042.\n+optional",
 		"privateOptions": "If specified will pass extra DHCP options for private use, range: 224-254\n+optional",
+		"mtu":            "If specified will pass the MTU to the VM via DHCP option 026 instead of the MTU of the pod interface.\n+optional",
+		"routes":         "If specified will pass the routes to the VM via DHCP option 121, in addition to the routes of the pod interface.\n+optional\n+listType=atomic",
 	}
 }
 
@@ -700,6 +801,14 @@ func (DHCPPrivateOptions) SwaggerDoc() map[string]string {
 	}
 }
 
+func (DHCPRoute) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":            "DHCPRoute defines a classless static route passed to the VM via DHCP.",
+		"destination": "Destination is the IPv4 CIDR of the route\nRequired.",
+		"gateway":     "Gateway is the IPv4 address of the next hop, the route is on-link if omitted\n+optional",
+	}
+}
+
 func (InterfaceBindingMethod) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"":        "Represents the method which will be used to connect the interface to the guest.\nOnly one of its members may be specified.",
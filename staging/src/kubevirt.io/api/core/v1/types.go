@@ -76,6 +76,58 @@ const (
 	StartStrategyPaused StartStrategy = "Paused"
 )
 
+// ShutdownPolicy defines the time given to each stage of a graceful shutdown.
+// The shutdown never takes longer than the termination grace period.
+type ShutdownPolicy struct {
+	// ACPITimeoutSeconds is the time the guest is given to power off after the ACPI power button was pressed.
+	// Defaults to 30.
+	// +optional
+	ACPITimeoutSeconds *int64 `json:"acpiTimeoutSeconds,omitempty"`
+	// GuestAgentTimeoutSeconds is the time the guest is given to power off after the shutdown command was sent
+	// through the guest agent, before it is forcefully powered off. The stage is skipped if no guest agent is connected.
+	// Defaults to 30.
+	// +optional
+	GuestAgentTimeoutSeconds *int64 `json:"guestAgentTimeoutSeconds,omitempty"`
+}
+
+// ShutdownStage is a stage of the shutdown policy
+type ShutdownStage string
+
+const (
+	// ShutdownStageACPI means the ACPI power button was pressed
+	ShutdownStageACPI ShutdownStage = "ACPI"
+	// ShutdownStageGuestAgent means the shutdown command was sent through the guest agent
+	ShutdownStageGuestAgent ShutdownStage = "GuestAgent"
+	// ShutdownStageForcedOff means the guest was forcefully powered off
+	ShutdownStageForcedOff ShutdownStage = "ForcedOff"
+
+	DefaultShutdownStageTimeoutSeconds int64 = 30
+)
+
+// GuestLicense describes the licensing of the guest operating system, for license compliance reporting.
+type GuestLicense struct {
+	// OSFamily is the family of the guest operating system, one of linux, windows or other.
+	// If not set, it is detected from the operating system reported by the guest agent.
+	// +optional
+	OSFamily GuestOSFamily `json:"osFamily,omitempty"`
+	// LicenseModel is the model the guest operating system is licensed under, like subscription or perCore.
+	// +optional
+	LicenseModel string `json:"licenseModel,omitempty"`
+	// BYOL tells if the license of the guest operating system is brought by the owner of the VirtualMachineInstance
+	// instead of being provided with the cluster.
+	// +optional
+	BYOL *bool `json:"byol,omitempty"`
+}
+
+// GuestOSFamily is the family of a guest operating system
+type GuestOSFamily string
+
+const (
+	GuestOSFamilyLinux   GuestOSFamily = "linux"
+	GuestOSFamilyWindows GuestOSFamily = "windows"
+	GuestOSFamilyOther   GuestOSFamily = "other"
+)
+
 // VirtualMachineInstanceSpec is a description of a VirtualMachineInstance.
 type VirtualMachineInstanceSpec struct {
 
@@ -123,6 +175,11 @@ type VirtualMachineInstanceSpec struct {
 	StartStrategy *StartStrategy `json:"startStrategy,omitempty"`
 	// Grace period observed after signalling a VirtualMachineInstance to stop after which the VirtualMachineInstance is force terminated.
 	TerminationGracePeriodSeconds *int64 `json:"terminationGracePeriodSeconds,omitempty"`
+	// ShutdownPolicy escalates the graceful shutdown of the guest in stages, from an ACPI power button press,
+	// to a shutdown command sent through the guest agent, to forcefully powering off the guest.
+	// If not set, the guest is asked to shut down until the termination grace period expires.
+	// +optional
+	ShutdownPolicy *ShutdownPolicy `json:"shutdownPolicy,omitempty"`
 	// List of volumes that can be mounted by disks belonging to the vmi.
 	// +kubebuilder:validation:MaxItems:=256
 	Volumes []Volume `json:"volumes,omitempty"`
@@ -170,6 +227,10 @@ type VirtualMachineInstanceSpec struct {
 	AccessCredentials []AccessCredential `json:"accessCredentials,omitempty"`
 	// Specifies the architecture of the vm guest you are attempting to run. Defaults to the compiled architecture of the KubeVirt components
 	Architecture string `json:"architecture,omitempty"`
+	// GuestLicense describes the licensing of the guest operating system.
+	// It is exposed in the kubevirt_vmi_guest_license_info metric.
+	// +optional
+	GuestLicense *GuestLicense `json:"guestLicense,omitempty"`
 }
 
 func (vmiSpec *VirtualMachineInstanceSpec) UnmarshalJSON(data []byte) error {
@@ -202,6 +263,15 @@ type VirtualMachineInstancePhaseTransitionTimestamp struct {
 
 type TopologyHints struct {
 	TSCFrequency *int64 `json:"tscFrequency,omitempty"`
+	// CPUModel is the CPU model common to the nodes the VMI can be scheduled to,
+	// used when the VMI requests the cluster-common CPU model
+	// +optional
+	CPUModel string `json:"cpuModel,omitempty"`
+	// CPUFeatures are the CPU features common to the nodes the VMI can be scheduled to,
+	// required on top of CPUModel
+	// +optional
+	// +listType=set
+	CPUFeatures []string `json:"cpuFeatures,omitempty"`
 }
 
 // VirtualMachineInstanceStatus represents information about the status of a VirtualMachineInstance. Status may trail the actual
@@ -236,6 +306,15 @@ type VirtualMachineInstanceStatus struct {
 	// +optional
 	QOSClass *k8sv1.PodQOSClass `json:"qosClass,omitempty"`
 
+	// CgroupLayout describes how the threads of the virtual machine instance are placed in the cgroups
+	// of the compute container. It is only reported if the housekeeping threads are separated from the vCPUs.
+	// +optional
+	CgroupLayout *CgroupLayout `json:"cgroupLayout,omitempty"`
+
+	// ThreadPlacement reports the host CPUs the emulator thread and the IOThreads are pinned to
+	// +optional
+	ThreadPlacement *ThreadPlacementStatus `json:"threadPlacement,omitempty"`
+
 	// LauncherContainerImageVersion indicates what container image is currently active for the vmi.
 	LauncherContainerImageVersion string `json:"launcherContainerImageVersion,omitempty"`
 
@@ -262,6 +341,19 @@ type VirtualMachineInstanceStatus struct {
 	// +optional
 	FSFreezeStatus string `json:"fsFreezeStatus,omitempty"`
 
+	// FSFreezeInfo reports which guest filesystems are frozen and for how long they were frozen
+	// +optional
+	FSFreezeInfo *FSFreezeInfo `json:"fsFreezeInfo,omitempty"`
+
+	// PauseStatus reports for how long the VirtualMachineInstance was paused
+	// +optional
+	PauseStatus *PauseStatus `json:"pauseStatus,omitempty"`
+
+	// ShutdownStage is the stage of the shutdown policy the guest is shut down in,
+	// or was shut down in once the VirtualMachineInstance stopped
+	// +optional
+	ShutdownStage ShutdownStage `json:"shutdownStage,omitempty"`
+
 	// +optional
 	TopologyHints *TopologyHints `json:"topologyHints,omitempty"`
 
@@ -299,6 +391,40 @@ type VirtualMachineInstanceStatus struct {
 	// +listType=atomic
 	// +optional
 	MigratedVolumes []StorageMigratedVolumeInfo `json:"migratedVolumes,omitempty"`
+
+	// USBDevices reports the host USB devices allocated to the USB devices of the VirtualMachineInstance
+	// +listType=atomic
+	// +optional
+	USBDevices []USBDeviceStatus `json:"usbDevices,omitempty"`
+}
+
+// USBDevicePhase is the state of a USB device of the VirtualMachineInstance
+type USBDevicePhase string
+
+const (
+	// USBDevicePending means that no free host device matching the USB device is plugged into the node
+	USBDevicePending USBDevicePhase = "Pending"
+	// USBDeviceAllocated means that a host device is allocated to the USB device, but is not attached to the domain yet
+	USBDeviceAllocated USBDevicePhase = "Allocated"
+	// USBDeviceAttached means that the host device allocated to the USB device is attached to the domain
+	USBDeviceAttached USBDevicePhase = "Attached"
+)
+
+// USBDeviceStatus reports the host device allocated to a USB device of the VirtualMachineInstance
+type USBDeviceStatus struct {
+	// Name of the USB device in the VirtualMachineInstance spec
+	Name string `json:"name"`
+	// Phase of the USB device
+	Phase USBDevicePhase `json:"phase,omitempty"`
+	// BusPort is the bus and port path of the allocated host device, e.g. 1-2.3
+	// +optional
+	BusPort string `json:"busPort,omitempty"`
+	// Bus is the number of the bus of the allocated host device
+	// +optional
+	Bus int `json:"bus,omitempty"`
+	// DeviceNumber is the number of the allocated host device on its bus
+	// +optional
+	DeviceNumber int `json:"deviceNumber,omitempty"`
 }
 
 // StorageMigratedVolumeInfo tracks the information about the source and destination volumes during the volume migration
@@ -369,6 +495,18 @@ type VolumeStatus struct {
 	MemoryDumpVolume *DomainMemoryDumpInfo `json:"memoryDumpVolume,omitempty"`
 	// ContainerDiskVolume shows info about the containerdisk, if the volume is a containerdisk
 	ContainerDiskVolume *ContainerDiskInfo `json:"containerDiskVolume,omitempty"`
+	// GuestFilesystemUsage is the usage of the guest filesystems on the volume, as reported by the guest agent.
+	// It is reported with the VolumeAutoExpansion feature gate, for the volumes whose disks have a serial.
+	// +optional
+	GuestFilesystemUsage *GuestFilesystemUsage `json:"guestFilesystemUsage,omitempty"`
+}
+
+// GuestFilesystemUsage sums up the guest filesystems on a volume
+type GuestFilesystemUsage struct {
+	// UsedBytes is the space used on the filesystems
+	UsedBytes int64 `json:"usedBytes"`
+	// TotalBytes is the size of the filesystems
+	TotalBytes int64 `json:"totalBytes"`
 }
 
 // KernelInfo show info about the kernel image
@@ -391,6 +529,69 @@ type KernelBootStatus struct {
 	InitrdInfo *InitrdInfo `json:"initrdInfo,omitempty"`
 }
 
+// FSFreezeInfo reports which guest filesystems are frozen and for how long they were frozen
+type FSFreezeInfo struct {
+	// Volumes lists the volumes whose guest filesystems are frozen.
+	// All the guest filesystems are frozen if it's empty.
+	// +optional
+	// +listType=atomic
+	Volumes []string `json:"volumes,omitempty"`
+	// FreezeTimestamp is the time the guest filesystems were frozen at
+	// +optional
+	FreezeTimestamp *metav1.Time `json:"freezeTimestamp,omitempty"`
+	// LastFreezeDurationSeconds is the duration of the most recent freeze which ended
+	// +optional
+	LastFreezeDurationSeconds int64 `json:"lastFreezeDurationSeconds,omitempty"`
+}
+
+// PauseStatus reports for how long a VirtualMachineInstance was paused
+type PauseStatus struct {
+	// LastPauseDurationSeconds is the duration of the most recent pause which ended
+	LastPauseDurationSeconds int64 `json:"lastPauseDurationSeconds"`
+	// TotalPauseDurationSeconds is the sum of the durations of all pauses which ended
+	TotalPauseDurationSeconds int64 `json:"totalPauseDurationSeconds"`
+}
+
+// CgroupLayout describes the cgroups of the compute container of the virtual machine instance
+type CgroupLayout struct {
+	// Version is the version of the cgroup hierarchy on the node, v1 or v2
+	Version string `json:"version,omitempty"`
+	// Housekeeping is the child cgroup the housekeeping threads are moved to
+	// +optional
+	Housekeeping *HousekeepingCgroup `json:"housekeeping,omitempty"`
+}
+
+// HousekeepingCgroup describes the child cgroup of the housekeeping threads
+type HousekeepingCgroup struct {
+	// Name is the name of the child cgroup below the cgroup of the compute container
+	Name string `json:"name"`
+	// CPUSet are the CPUs the housekeeping threads are pinned to
+	// +optional
+	CPUSet string `json:"cpuSet,omitempty"`
+	// CPULimit is the CPU time the housekeeping threads are limited to
+	// +optional
+	CPULimit *resource.Quantity `json:"cpuLimit,omitempty"`
+}
+
+// ThreadPlacementStatus reports where the emulator thread and the IOThreads of the VMI run
+type ThreadPlacementStatus struct {
+	// EmulatorThreadCPUSet are the host CPUs the emulator thread is pinned to
+	// +optional
+	EmulatorThreadCPUSet string `json:"emulatorThreadCPUSet,omitempty"`
+	// IOThreads are the host CPUs the IOThreads are pinned to
+	// +optional
+	// +listType=atomic
+	IOThreads []IOThreadPlacement `json:"ioThreads,omitempty"`
+}
+
+// IOThreadPlacement reports the host CPUs an IOThread is pinned to
+type IOThreadPlacement struct {
+	// ID is the id of the IOThread
+	ID uint32 `json:"id"`
+	// CPUSet are the host CPUs the IOThread is pinned to
+	CPUSet string `json:"cpuSet"`
+}
+
 // DomainMemoryDumpInfo represents the memory dump information
 type DomainMemoryDumpInfo struct {
 	// StartTimestamp is the time when the memory dump started
@@ -401,6 +602,19 @@ type DomainMemoryDumpInfo struct {
 	ClaimName string `json:"claimName,omitempty"`
 	// TargetFileName is the name of the memory dump output
 	TargetFileName string `json:"targetFileName,omitempty"`
+	// Progress is the share of the guest memory dumped so far
+	// +optional
+	Progress *MemoryDumpProgress `json:"progress,omitempty"`
+}
+
+// MemoryDumpProgress holds periodically sampled statistics of a running memory dump
+type MemoryDumpProgress struct {
+	// The amount of guest memory dumped so far
+	DataProcessedBytes int64 `json:"dataProcessedBytes,omitempty"`
+	// The total amount of guest memory to be dumped
+	DataTotalBytes int64 `json:"dataTotalBytes,omitempty"`
+	// The share of the guest memory dumped so far, from 0 to 100
+	PercentComplete int32 `json:"percentComplete,omitempty"`
 }
 
 // HotplugVolumeStatus represents the hotplug status of the volume
@@ -572,6 +786,12 @@ const (
 	// Indicates that the VMI is hot(un)plugging memory
 	VirtualMachineInstanceMemoryChange VirtualMachineInstanceConditionType = "HotMemoryChange"
 
+	// Indicates that the VMI has SR-IOV interfaces whose VFs are not allocated to its pod yet
+	VirtualMachineInstanceSRIOVChange VirtualMachineInstanceConditionType = "HotSRIOVChange"
+
+	// Indicates that the VMI has GPUs or host devices which are not allocated to its pod yet
+	VirtualMachineInstanceHostDevicesChange VirtualMachineInstanceConditionType = "HotHostDevicesChange"
+
 	// Indicates that the VMI has an updates in its volume set
 	VirtualMachineInstanceVolumesChange VirtualMachineInstanceConditionType = "VolumesChange"
 
@@ -580,6 +800,12 @@ const (
 
 	// Indicates whether the VMI is live migratable
 	VirtualMachineInstanceIsStorageLiveMigratable VirtualMachineInstanceConditionType = "StorageLiveMigratable"
+
+	// Indicates that the start of the VMI is delayed because too many VMIs are starting at the same time
+	VirtualMachineInstanceStartThrottled VirtualMachineInstanceConditionType = "StartThrottled"
+
+	// Reflects the recovery of a post copy migration which lost the connection to the target
+	VirtualMachineInstancePostCopyRecovered VirtualMachineInstanceConditionType = "PostCopyRecovered"
 )
 
 // These are valid reasons for VMI conditions.
@@ -596,6 +822,8 @@ const (
 	VirtualMachineInstanceReasonVirtIOFSNotMigratable = "VirtIOFSNotLiveMigratable"
 	// Reason means that VMI is not live migratable because it uses PCI host devices
 	VirtualMachineInstanceReasonHostDeviceNotMigratable = "HostDeviceNotLiveMigratable"
+	// Reason means that VMI is not live migratable because it uses USB host devices
+	VirtualMachineInstanceReasonUSBDeviceNotMigratable = "USBDeviceNotLiveMigratable"
 	// Reason means that VMI is not live migratable because it uses Secure Encrypted Virtualization (SEV)
 	VirtualMachineInstanceReasonSEVNotMigratable = "SEVNotLiveMigratable"
 	// Reason means that VMI is not live migratable because it uses HyperV Reenlightenment while TSC Frequency is not available
@@ -604,6 +832,8 @@ const (
 	VirtualMachineInstanceReasonHypervPassthroughNotMigratable = "HypervPassthroughNotLiveMigratable"
 	// Reason means that VMI is not live migratable because it requested SCSI persitent reservation
 	VirtualMachineInstanceReasonPRNotMigratable = "PersistentReservationNotLiveMigratable"
+	// Reason means that no node other than the current one can run the VMI
+	VirtualMachineInstanceReasonNoMigrationTargetNode = "NoMigrationTargetNode"
 	// Reason means that not all of the VMI's DVs are ready
 	VirtualMachineInstanceReasonNotAllDVsReady = "NotAllDVsReady"
 	// Reason means that all of the VMI's DVs are bound and not running
@@ -612,6 +842,12 @@ const (
 	VirtualMachineInstanceReasonNotMigratable = "NotMigratable"
 	// Reason means that the volume update change was cancelled
 	VirtualMachineInstanceReasonVolumesChangeCancellation = "VolumesChangeCancellation"
+	// Reason means that virt-launcher is resuming a post copy migration which lost the connection to the target
+	VirtualMachineInstanceReasonPostCopyRecoveryInProgress = "PostCopyRecoveryInProgress"
+	// Reason means that a post copy migration was resumed after it lost the connection to the target
+	VirtualMachineInstanceReasonPostCopyRecoverySucceeded = "PostCopyRecoverySucceeded"
+	// Reason means that a post copy migration could not be resumed and the guest is lost
+	VirtualMachineInstanceReasonPostCopyRecoveryFailed = "PostCopyRecoveryFailed"
 )
 
 const (
@@ -626,6 +862,10 @@ const (
 
 	// GuestNotRunningReason indicates on the Ready condition on the VMI if the underlying guest VM is not running
 	GuestNotRunningReason = "GuestNotRunning"
+
+	// MeshProxyNotReadyReason indicates on the Ready condition on the VMI if the service mesh proxy sidecar of the
+	// underlying pod is not ready, e.g. while it restarts
+	MeshProxyNotReadyReason = "MeshProxyNotReady"
 )
 
 type VirtualMachineInstanceMigrationConditionType string
@@ -635,6 +875,12 @@ const (
 	// VirtualMachineInstanceMigrationAbortRequested indicates that live migration abort has been requested
 	VirtualMachineInstanceMigrationAbortRequested          VirtualMachineInstanceMigrationConditionType = "migrationAbortRequested"
 	VirtualMachineInstanceMigrationRejectedByResourceQuota VirtualMachineInstanceMigrationConditionType = "migrationRejectedByResourceQuota"
+	// VirtualMachineInstanceMigrationQueuedForMaintenanceWindow indicates that an automated migration waits for a
+	// maintenance window of its migration policy to open
+	VirtualMachineInstanceMigrationQueuedForMaintenanceWindow VirtualMachineInstanceMigrationConditionType = "migrationQueuedForMaintenanceWindow"
+	// VirtualMachineInstanceMigrationCancelRequested indicates that the migration was cancelled through the cancel
+	// subresource, its message holds the reason given by the user
+	VirtualMachineInstanceMigrationCancelRequested VirtualMachineInstanceMigrationConditionType = "migrationCancelRequested"
 )
 
 type VirtualMachineInstanceCondition struct {
@@ -661,7 +907,7 @@ type VirtualMachineInstanceMigrationCondition struct {
 
 // The migration phase indicates that the job has completed
 func (m *VirtualMachineInstanceMigration) IsFinal() bool {
-	return m.Status.Phase == MigrationFailed || m.Status.Phase == MigrationSucceeded
+	return m.Status.Phase == MigrationFailed || m.Status.Phase == MigrationSucceeded || m.Status.Phase == MigrationCancelled
 }
 
 func (m *VirtualMachineInstanceMigration) IsRunning() bool {
@@ -700,7 +946,7 @@ type VirtualMachineInstanceNetworkInterface struct {
 	PodInterfaceName string `json:"podInterfaceName,omitempty"`
 	// The interface name inside the Virtual Machine
 	InterfaceName string `json:"interfaceName,omitempty"`
-	// Specifies the origin of the interface data collected. values: domain, guest-agent, multus-status.
+	// Specifies the origin of the interface data collected. values: domain, guest-agent, multus-status, arp-snooping.
 	InfoSource string `json:"infoSource,omitempty"`
 	// Specifies how many queues are allocated by MultiQueue
 	QueueCount int32 `json:"queueCount,omitempty"`
@@ -725,6 +971,8 @@ type VirtualMachineInstanceGuestOSInfo struct {
 	Machine string `json:"machine,omitempty"`
 	// Guest OS Id
 	ID string `json:"id,omitempty"`
+	// Short hostname of the guest as reported by the guest agent, not its FQDN
+	Hostname string `json:"hostname,omitempty"`
 }
 
 // MigrationConfigSource indicates the source of migration configuration.
@@ -790,6 +1038,25 @@ type VirtualMachineInstanceMigrationState struct {
 	SourcePersistentStatePVCName string `json:"sourcePersistentStatePVCName,omitempty"`
 	// If the VMI being migrated uses persistent features (backend-storage), its target PVC name is saved here
 	TargetPersistentStatePVCName string `json:"targetPersistentStatePVCName,omitempty"`
+	// The data transfer statistics of the migration as reported by the source
+	// +optional
+	Progress *MigrationProgress `json:"progress,omitempty"`
+}
+
+// MigrationProgress holds periodically sampled data transfer statistics of a live migration
+//
+// +k8s:openapi-gen=true
+type MigrationProgress struct {
+	// The amount of data transferred to the target so far
+	DataProcessedBytes int64 `json:"dataProcessedBytes,omitempty"`
+	// The amount of data still to be transferred to the target
+	DataRemainingBytes int64 `json:"dataRemainingBytes,omitempty"`
+	// The total amount of data to be transferred
+	DataTotalBytes int64 `json:"dataTotalBytes,omitempty"`
+	// The expected downtime while the migration is running and the measured downtime once it completed
+	DowntimeMilliseconds int64 `json:"downtimeMilliseconds,omitempty"`
+	// The share of the data transferred to the target, from 0 to 100
+	PercentComplete int32 `json:"percentComplete,omitempty"`
 }
 
 type MigrationAbortStatus string
@@ -897,6 +1164,9 @@ const (
 	CreatedByLabel string = "kubevirt.io/created-by"
 	// This label is used to indicate that this pod is the target of a migration job.
 	MigrationJobLabel string = "kubevirt.io/migrationJobUID"
+	// This label marks migration target pods which are excluded from the user quota of their namespace, so that a quota
+	// webhook can leave them out. Set on target pods in namespaces with the MigrationQuotaExclusionAnnotation.
+	MigrationQuotaExcludedLabel string = "kubevirt.io/migration-quota-excluded"
 	// This label indicates the migration name that a PDB is protecting.
 	MigrationNameLabel string = "kubevirt.io/migrationName"
 	// This label describes which cluster node runs the virtual machine
@@ -923,6 +1193,16 @@ const (
 	// if a particular node is alive and hence should be available for new
 	// virtual machine instance scheduling. Used on Node.
 	VirtHandlerHeartbeat string = "kubevirt.io/heartbeat"
+	// This annotation is set by virt-handler to the ratio between the highest measured
+	// memory overhead of the virt-launcher pods on the node and the estimated one, as long as the
+	// MemoryOverheadCalibration feature gate is enabled. Used on Node.
+	MemoryOverheadRatioAnnotation string = "kubevirt.io/memory-overhead-ratio"
+	// This annotation is set by virt-handler to the capacity of the host NIC which carries the migrations of the
+	// node, in bytes per second. Used on Node.
+	MigrationNetworkCapacityAnnotation string = "kubevirt.io/migration-network-capacity"
+	// This annotation is set by virt-handler to the bytes per second sent or received, whichever is higher,
+	// on the host NIC which carries the migrations of the node. Used on Node.
+	MigrationNetworkUsageAnnotation string = "kubevirt.io/migration-network-usage"
 	// This label indicates what launcher image a VMI is currently running with.
 	OutdatedLauncherImageLabel string = "kubevirt.io/outdatedLauncherImage"
 	// Namespace recommended by Kubernetes for commonly recognized labels
@@ -1041,12 +1321,80 @@ const (
 	// SEVESLabel marks the node as capable of running workloads with SEV-ES
 	SEVESLabel string = "kubevirt.io/sev-es"
 
+	// NUMANodesLabel holds the number of host NUMA nodes of the node
+	NUMANodesLabel string = "kubevirt.io/numa-nodes"
+
+	// NUMANodeCPUsLabel holds the number of CPUs of the smallest host NUMA node of the node
+	NUMANodeCPUsLabel string = "kubevirt.io/numa-node-cpus"
+
+	// CPUVulnerabilityLabel prefixes the labels holding the mitigation status of the CPU vulnerabilities of the node,
+	// as named by the kernel, e.g. cpu-vulnerability.node.kubevirt.io/retbleed=mitigated
+	CPUVulnerabilityLabel string = "cpu-vulnerability.node.kubevirt.io/"
+	// CPUVulnerabilityMitigated is the status of a CPU vulnerability the node is mitigated against
+	CPUVulnerabilityMitigated string = "mitigated"
+	// CPUVulnerabilityNotAffected is the status of a CPU vulnerability the CPUs of the node are not affected by
+	CPUVulnerabilityNotAffected string = "not-affected"
+	// CPUVulnerabilityVulnerable is the status of a CPU vulnerability the node is not mitigated against
+	CPUVulnerabilityVulnerable string = "vulnerable"
+	// CPUVulnerabilityUnknown is the status of a CPU vulnerability the kernel reports in an unknown format
+	CPUVulnerabilityUnknown string = "unknown"
+
+	// MitigatedCPUVulnerabilityLabel prefixes the labels of the CPU vulnerabilities the node is mitigated against
+	// or not affected by, used to schedule the VMIs requiring mitigated vulnerabilities
+	MitigatedCPUVulnerabilityLabel string = "mitigated-cpu-vulnerability.node.kubevirt.io/"
+
+	// CPUMicrocodeLabel holds the microcode revision of the CPUs of the node
+	CPUMicrocodeLabel string = "cpu-microcode.node.kubevirt.io/revision"
+
 	// KSMEnabledLabel marks the node as KSM-handling enabled
 	KSMEnabledLabel string = "kubevirt.io/ksm-enabled"
 
+	// KSMPolicyLabel enables or disables the KSM handling on the node, whether the node matches the
+	// node label selector of the KSM configuration or not. Its value is either "enabled" or "disabled".
+	KSMPolicyLabel string = "kubevirt.io/ksm-policy"
+	// KSMPolicyEnabled is the value of the KSMPolicyLabel enabling the KSM handling on the node
+	KSMPolicyEnabled string = "enabled"
+	// KSMPolicyDisabled is the value of the KSMPolicyLabel disabling the KSM handling on the node
+	KSMPolicyDisabled string = "disabled"
+
+	// DedicatedCPUPoolLabel holds the name of the dedicated CPU pool virt-handler reserved on the node
+	DedicatedCPUPoolLabel string = "kubevirt.io/dedicated-cpu-pool"
+
+	// NodeMaintenanceAnnotation puts the node into maintenance, its value is free form, e.g. the reason of the maintenance.
+	// virt-handler stops the scheduling of VirtualMachineInstances to the node and evacuates its VirtualMachineInstances
+	// until the annotation is removed.
+	NodeMaintenanceAnnotation string = "kubevirt.io/maintenance"
+	// NodeMaintenanceStateLabel reports the progress of the maintenance of the node
+	NodeMaintenanceStateLabel string = "kubevirt.io/maintenance-state"
+	// NodeMaintenanceEvacuating is the state of a node whose VirtualMachineInstances are being migrated away
+	NodeMaintenanceEvacuating string = "evacuating"
+	// NodeMaintenanceBlocked is the state of a node on which only VirtualMachineInstances which can not be migrated
+	// away are left, they have to be stopped to complete the maintenance
+	NodeMaintenanceBlocked string = "blocked"
+	// NodeMaintenanceCompleted is the state of a node on which no VirtualMachineInstance is left
+	NodeMaintenanceCompleted string = "completed"
+	// NodeMaintenanceRemainingVMIsAnnotation reports the number of VirtualMachineInstances left on a node in maintenance
+	NodeMaintenanceRemainingVMIsAnnotation string = "kubevirt.io/maintenance-remaining-vmis"
+
+	// HugepagesAnnotationPrefix prefixes the annotations reporting the provisioning of the hugepages of the hugepages
+	// pools on a node, per page size: hugepages.kubevirt.io/<page size>-state holds the provisioning state and
+	// hugepages.kubevirt.io/<page size>-allocated the number of hugepages allocated, e.g. hugepages.kubevirt.io/2Mi-state
+	HugepagesAnnotationPrefix string = "hugepages.kubevirt.io/"
+	// HugepagesProvisioned is the state of the hugepages of a size whose count is allocated on the node
+	HugepagesProvisioned string = "provisioned"
+	// HugepagesPartiallyProvisioned is the state of the hugepages of a size of which the kernel could only allocate
+	// a part of the count, e.g. because the memory of the node is fragmented
+	HugepagesPartiallyProvisioned string = "partial"
+	// HugepagesProvisioningFailed is the state of the hugepages of a size which could not be allocated,
+	// e.g. because the node does not support the page size
+	HugepagesProvisioningFailed string = "failed"
+
 	// KSMHandlerManagedAnnotation is an annotation used to mark the nodes where the virt-handler has enabled the ksm
 	KSMHandlerManagedAnnotation string = "kubevirt.io/ksm-handler-managed"
 
+	// MediatedDevicesDriftAnnotation lists, in JSON, the mdev types the virt-handler could not configure on the node as desired
+	MediatedDevicesDriftAnnotation string = "kubevirt.io/mediated-devices-drift"
+
 	// KSM debug annotations to override default constants
 	KSMPagesBoostOverride      string = "kubevirt.io/ksm-pages-boost-override"
 	KSMPagesDecayOverride      string = "kubevirt.io/ksm-pages-decay-override"
@@ -1109,6 +1457,11 @@ const (
 	// happens.
 	MemoryHotplugOverheadRatioLabel string = "kubevirt.io/memory-hotplug-overhead-ratio"
 
+	// MigrationQuotaExclusionAnnotation on a namespace makes virt-controller label migration target pods with
+	// MigrationQuotaExcludedLabel and not wait for the ResourceQuotas of the namespace before creating them.
+	// Must be "true".
+	MigrationQuotaExclusionAnnotation string = "alpha.kubevirt.io/exclude-migration-target-pods-from-quota"
+
 	// AutoMemoryLimitsRatioLabel allows to use a custom ratio for auto memory limits calculation.
 	// Must be a float >= 1.
 	AutoMemoryLimitsRatioLabel string = "alpha.kubevirt.io/auto-memory-limits-ratio"
@@ -1126,6 +1479,30 @@ const (
 	// ImmediateDataVolumeCreation indicates that the data volumes should be created immediately
 	// Even if the VM is halted
 	ImmediateDataVolumeCreation string = "kubevirt.io/immediate-data-volume-creation"
+
+	// WaitForMeshProxyAnnotation on a VMI delays the start of the guest until the istio-proxy sidecar of the
+	// virt-launcher pod is ready instead of only running, so that the guest does not race the proxy at boot.
+	// While the proxy is not ready later on, e.g. when it restarts, the VMI is reported as not Ready.
+	// Must be "true".
+	WaitForMeshProxyAnnotation string = "kubevirt.io/wait-for-mesh-proxy"
+
+	// GuestMetricsPortAnnotation on a VM makes virt-controller expose the port of a metrics exporter running in the
+	// guest, like node_exporter, through a headless Service and an EndpointSlice which follow the VMI across migrations.
+	// Requires the GuestMetricsEndpoints feature gate.
+	GuestMetricsPortAnnotation string = "kubevirt.io/guest-metrics-port"
+	// GuestMetricsPathAnnotation sets the path the exporter in the guest serves its metrics on, "/metrics" by default.
+	GuestMetricsPathAnnotation string = "kubevirt.io/guest-metrics-path"
+	// GuestMetricsAppLabelValue is the value of the AppLabel on the Services and EndpointSlices exposing guest metrics
+	GuestMetricsAppLabelValue string = "guest-metrics"
+
+	// FirewallAnnotation on a VMI, or in the template of a VM, holds a JSON firewall spec which virt-controller
+	// translates into a NetworkPolicy selecting the virt-launcher pod, e.g.
+	// {"ingress": [{"ports": [{"protocol": "TCP", "port": 22}], "cidrs": ["10.0.0.0/8"]}], "egress": []}
+	// A direction which is present is restricted to its rules, an empty list denies all its traffic.
+	// Requires the VMFirewall feature gate.
+	FirewallAnnotation string = "kubevirt.io/firewall"
+	// FirewallAppLabelValue is the value of the AppLabel on the NetworkPolicies enforcing the firewall of VMIs
+	FirewallAppLabelValue string = "vmi-firewall"
 )
 
 func NewVMI(name string, uid types.UID) *VirtualMachineInstance {
@@ -1438,6 +1815,8 @@ const (
 	MigrationSucceeded VirtualMachineInstanceMigrationPhase = "Succeeded"
 	// The migration failed
 	MigrationFailed VirtualMachineInstanceMigrationPhase = "Failed"
+	// The migration was cancelled through the cancel subresource
+	MigrationCancelled VirtualMachineInstanceMigrationPhase = "Cancelled"
 )
 
 // Deprecated for removal in v2, please use VirtualMachineInstanceType and VirtualMachinePreference instead.
@@ -1590,6 +1969,104 @@ type VirtualMachineSpec struct {
 
 	// UpdateVolumesStrategy is the strategy to apply on volumes updates
 	UpdateVolumesStrategy *UpdateVolumesStrategy `json:"updateVolumesStrategy,omitempty"`
+
+	// StorageMigration moves the PVC and DataVolume volumes of the VirtualMachine to another StorageClass.
+	// The volumes are copied to new DataVolumes, which replace the original volumes once the copy is done.
+	// The original claims are retained.
+	// +optional
+	StorageMigration *VirtualMachineStorageMigration `json:"storageMigration,omitempty"`
+
+	// VolumeAutoExpansion expands the PVCs of the VirtualMachine when the guest filesystems on them are filling up.
+	// It requires the VolumeAutoExpansion feature gate and a guest agent.
+	// +optional
+	VolumeAutoExpansion *VolumeAutoExpansion `json:"volumeAutoExpansion,omitempty"`
+}
+
+// VolumeAutoExpansion configures the expansion of PVCs driven by the usage of the guest filesystems on them.
+// The guest filesystems are matched with the volumes through the serial of their disks.
+type VolumeAutoExpansion struct {
+	// Volumes are the names of the PVC and DataVolume volumes which are expanded. Their disks need a serial.
+	// +listType=set
+	Volumes []string `json:"volumes"`
+	// UsageThresholdPercentage is the usage of the guest filesystems on a volume, in percent of their size,
+	// above which the PVC of the volume is expanded
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=99
+	UsageThresholdPercentage int32 `json:"usageThresholdPercentage"`
+	// IncrementPercentage is by how much, in percent of its capacity, a PVC is expanded. Defaults to 20.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	IncrementPercentage *int32 `json:"incrementPercentage,omitempty"`
+	// MaxSize is the size PVCs are not expanded beyond
+	// +optional
+	MaxSize *resource.Quantity `json:"maxSize,omitempty"`
+}
+
+// VirtualMachineStorageMigrationMethod is the way the volumes are copied to the new StorageClass
+type VirtualMachineStorageMigrationMethod string
+
+const (
+	// StorageMigrationMethodBlockCopy copies the volumes of the running VirtualMachine with libvirt
+	// during a live migration
+	StorageMigrationMethodBlockCopy VirtualMachineStorageMigrationMethod = "BlockCopy"
+	// StorageMigrationMethodCDI clones the volumes of the stopped VirtualMachine with CDI
+	StorageMigrationMethodCDI VirtualMachineStorageMigrationMethod = "CDI"
+)
+
+type VirtualMachineStorageMigration struct {
+	// StorageClassName is the StorageClass the volumes are migrated to
+	StorageClassName string `json:"storageClassName"`
+	// Volumes restricts the migration to the named volumes. By default all the PVC and DataVolume
+	// volumes whose claims are not in the target StorageClass are migrated.
+	// +listType=atomic
+	// +optional
+	Volumes []string `json:"volumes,omitempty"`
+	// Method is the way the volumes are copied. BlockCopy requires the VirtualMachine to run,
+	// CDI requires it to be stopped. Defaults to BlockCopy if the VirtualMachine runs and to CDI otherwise.
+	// +kubebuilder:validation:Enum=BlockCopy;CDI
+	// +optional
+	Method *VirtualMachineStorageMigrationMethod `json:"method,omitempty"`
+}
+
+type VirtualMachineStorageMigrationPhase string
+
+const (
+	StorageMigrationPending   VirtualMachineStorageMigrationPhase = "Pending"
+	StorageMigrationRunning   VirtualMachineStorageMigrationPhase = "Running"
+	StorageMigrationSucceeded VirtualMachineStorageMigrationPhase = "Succeeded"
+	StorageMigrationFailed    VirtualMachineStorageMigrationPhase = "Failed"
+)
+
+type VirtualMachineStorageMigrationStatus struct {
+	// StorageClassName is the StorageClass the volumes are migrated to
+	StorageClassName string `json:"storageClassName"`
+	// Method is the way the volumes are copied
+	Method VirtualMachineStorageMigrationMethod `json:"method,omitempty"`
+	// Phase of the storage migration
+	Phase VirtualMachineStorageMigrationPhase `json:"phase,omitempty"`
+	// Message explains why the storage migration is pending or failed
+	// +optional
+	Message string `json:"message,omitempty"`
+	// Volumes lists the migrated volumes and their progress
+	// +listType=atomic
+	// +optional
+	Volumes []VirtualMachineStorageMigrationVolumeStatus `json:"volumes,omitempty"`
+	// +optional
+	StartTimestamp *metav1.Time `json:"startTimestamp,omitempty"`
+	// +optional
+	EndTimestamp *metav1.Time `json:"endTimestamp,omitempty"`
+}
+
+type VirtualMachineStorageMigrationVolumeStatus struct {
+	// VolumeName is the name of the volume in the VirtualMachine
+	VolumeName string `json:"volumeName"`
+	// SourceClaim is the claim the volume is migrated from
+	SourceClaim string `json:"sourceClaim"`
+	// TargetClaim is the DataVolume the volume is migrated to
+	TargetClaim string `json:"targetClaim"`
+	// PercentComplete is the progress of the copy of the volume
+	// +optional
+	PercentComplete int32 `json:"percentComplete,omitempty"`
 }
 
 // StateChangeRequestType represents the existing state change requests that are possible
@@ -1717,6 +2194,11 @@ type VirtualMachineStatus struct {
 	// updates related to the volumeUpdateStrategy
 	VolumeUpdateState *VolumeUpdateState `json:"volumeUpdateState,omitempty" optional:"true"`
 
+	// StorageMigration tracks the migration of the volumes to the StorageClass requested in the spec
+	// +nullable
+	// +optional
+	StorageMigration *VirtualMachineStorageMigrationStatus `json:"storageMigration,omitempty" optional:"true"`
+
 	// InstancetypeRef captures the state of any referenced instance type from the VirtualMachine
 	//+nullable
 	//+optional
@@ -1826,6 +2308,10 @@ const (
 
 	// VirtualMachineManualRecoveryRequired is added when the VM spec needs to be manually recovered by the user
 	VirtualMachineManualRecoveryRequired VirtualMachineConditionType = "ManualRecoveryRequired"
+
+	// VirtualMachineDataVolumeFailure is added when a DataVolume of the VM can't be populated,
+	// e.g. because the quota is exceeded, the import source or the storage class does not exist
+	VirtualMachineDataVolumeFailure VirtualMachineConditionType = "DataVolumeFailure"
 )
 
 type HostDiskType string
@@ -2167,6 +2653,71 @@ type KubeVirtStatus struct {
 	DefaultArchitecture                     string              `json:"defaultArchitecture,omitempty"`
 	// +listType=atomic
 	Generations []GenerationStatus `json:"generations,omitempty" optional:"true"`
+	// FleetSummary aggregates the state of the workloads and nodes managed by KubeVirt
+	// +optional
+	FleetSummary *KubeVirtFleetSummary `json:"fleetSummary,omitempty" optional:"true"`
+	// NodeEvacuations reports the progress of the nodes which are being evacuated
+	// +optional
+	// +listType=map
+	// +listMapKey=node
+	NodeEvacuations []NodeEvacuationStatus `json:"nodeEvacuations,omitempty" optional:"true"`
+}
+
+// KubeVirtFleetSummary aggregates the state of the workloads and nodes managed by KubeVirt, as observed by
+// virt-controller
+type KubeVirtFleetSummary struct {
+	// VirtualMachines is the number of VirtualMachines by run strategy
+	// +optional
+	VirtualMachines map[string]int32 `json:"virtualMachines,omitempty"`
+	// VirtualMachineInstances is the number of VirtualMachineInstances by phase
+	// +optional
+	VirtualMachineInstances map[string]int32 `json:"virtualMachineInstances,omitempty"`
+	// MigrationsInFlight is the number of VirtualMachineInstanceMigrations which did not finish yet
+	MigrationsInFlight int32 `json:"migrationsInFlight"`
+	// SchedulableNodes is the number of nodes VirtualMachineInstances can be scheduled on
+	SchedulableNodes int32 `json:"schedulableNodes"`
+	// PendingActions lists the actions which are outstanding before the fleet is up to date
+	// +optional
+	// +listType=map
+	// +listMapKey=reason
+	PendingActions []KubeVirtPendingAction `json:"pendingActions,omitempty"`
+	// LastUpdateTime is the time the summary was aggregated at
+	LastUpdateTime metav1.Time `json:"lastUpdateTime"`
+}
+
+// KubeVirtPendingAction counts the workloads waiting for the same action
+type KubeVirtPendingAction struct {
+	Reason KubeVirtPendingActionReason `json:"reason"`
+	Count  int32                       `json:"count"`
+}
+
+type KubeVirtPendingActionReason string
+
+const (
+	// The VirtualMachines need a restart to apply changes which can't be live-propagated
+	PendingActionRestartRequired KubeVirtPendingActionReason = "RestartRequired"
+	// The VirtualMachineInstances run with an outdated virt-launcher image and wait for the workload updater
+	PendingActionOutdatedLauncherImage KubeVirtPendingActionReason = "OutdatedLauncherImage"
+	// The VirtualMachineInstances run on a node which is drained and wait to be evacuated
+	PendingActionEvacuation KubeVirtPendingActionReason = "Evacuation"
+)
+
+// NodeEvacuationStatus reports the VirtualMachineInstances which still have to leave a node that is being evacuated,
+// as observed by virt-controller
+type NodeEvacuationStatus struct {
+	// Node is the name of the node which is being evacuated
+	Node string `json:"node"`
+	// Pending is the number of VirtualMachineInstances waiting for an evacuation migration
+	Pending int32 `json:"pending"`
+	// Migrating is the number of VirtualMachineInstances with an evacuation migration in flight
+	Migrating int32 `json:"migrating"`
+	// NotMigratable is the number of VirtualMachineInstances which can't be live migrated and block the evacuation
+	NotMigratable int32 `json:"notMigratable"`
+	// RemainingMemory is the guest memory of all the VirtualMachineInstances which still have to leave the node
+	// +optional
+	RemainingMemory *resource.Quantity `json:"remainingMemory,omitempty"`
+	// LastUpdateTime is the time the progress was observed at
+	LastUpdateTime metav1.Time `json:"lastUpdateTime"`
 }
 
 // KubeVirtPhase is a label for the phase of a KubeVirt deployment at the current time.
@@ -2214,6 +2765,9 @@ const (
 	KubeVirtConditionProgressing KubeVirtConditionType = "Progressing"
 	// Whether KubeVirt is not functioning completely
 	KubeVirtConditionDegraded KubeVirtConditionType = "Degraded"
+
+	// Whether virt-controller paused the reconciles of controllers which keep failing
+	KubeVirtConditionControllersDegraded KubeVirtConditionType = "ControllersDegraded"
 )
 
 const (
@@ -2290,6 +2844,23 @@ type UnpauseOptions struct {
 	DryRun []string `json:"dryRun,omitempty" protobuf:"bytes,1,rep,name=dryRun"`
 }
 
+// SetLinkStateOptions is provided when setting the link state of an interface of a running VMI.
+type SetLinkStateOptions struct {
+	metav1.TypeMeta `json:",inline"`
+	// Name of the interface, which is the name of the network it is connected to
+	Name string `json:"name"`
+	// State is the link state of the interface, up or down
+	State InterfaceState `json:"state"`
+	// When present, indicates that modifications should not be
+	// persisted. An invalid or unrecognized dryRun directive will
+	// result in an error response and no further processing of the
+	// request. Valid values are:
+	// - All: all dry run stages will be processed
+	// +optional
+	// +listType=atomic
+	DryRun []string `json:"dryRun,omitempty"`
+}
+
 const (
 	StartRequestDataPausedKey  string = "paused"
 	StartRequestDataPausedTrue string = "true"
@@ -2334,6 +2905,39 @@ type MigrateOptions struct {
 	AddedNodeSelector map[string]string `json:"addedNodeSelector,omitempty"`
 }
 
+// MigrationCancelOptions may be provided on cancel request.
+type MigrationCancelOptions struct {
+	metav1.TypeMeta `json:",inline"`
+	// Reason is recorded in the message of the migrationCancelRequested condition of the migration
+	// +optional
+	Reason string `json:"reason,omitempty"`
+}
+
+// VirtualMachineInstanceMigrationFeasibility reports whether a VirtualMachineInstance can currently be live migrated
+//
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type VirtualMachineInstanceMigrationFeasibility struct {
+	metav1.TypeMeta `json:",inline"`
+	// Migratable is true if nothing blocks the live migration of the VirtualMachineInstance
+	Migratable bool `json:"migratable"`
+	// Blockers are the reasons preventing the live migration
+	// +optional
+	// +listType=atomic
+	Blockers []MigrationBlocker `json:"blockers,omitempty"`
+	// TargetNodes are the nodes the VirtualMachineInstance can be migrated to
+	// +optional
+	// +listType=atomic
+	TargetNodes []string `json:"targetNodes,omitempty"`
+}
+
+// MigrationBlocker is a reason preventing the live migration of a VirtualMachineInstance
+type MigrationBlocker struct {
+	// Reason is the machine readable reason, e.g. the reason of the LiveMigratable condition
+	Reason string `json:"reason"`
+	// Message is the human readable description of the blocker
+	Message string `json:"message"`
+}
+
 // VirtualMachineInstanceGuestAgentInfo represents information from the installed guest agent
 //
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -2414,6 +3018,12 @@ type VirtualMachineInstanceFileSystem struct {
 // FreezeUnfreezeTimeout represent the time unfreeze will be triggered if guest was not unfrozen by unfreeze command
 type FreezeUnfreezeTimeout struct {
 	UnfreezeTimeout *metav1.Duration `json:"unfreezeTimeout"`
+	// Volumes lists the volumes whose guest filesystems should be frozen.
+	// The disks of the volumes need a serial to identify their filesystems in the guest.
+	// All the guest filesystems are frozen if it's empty.
+	// +optional
+	// +listType=atomic
+	Volumes []string `json:"volumes,omitempty"`
 }
 
 // VirtualMachineMemoryDumpRequest represent the memory dump request phase and info
@@ -2437,8 +3047,25 @@ type VirtualMachineMemoryDumpRequest struct {
 	// Message is a detailed message about failure of the memory dump
 	// +optional
 	Message string `json:"message,omitempty"`
+	// Compression compresses the memory dump while it is written to the pvc. The compressed dump does not
+	// have to fit the memory of the vm, the pvc size is therefore not validated. Defaults to no compression
+	// +kubebuilder:validation:Enum=gzip;zstd
+	// +optional
+	Compression MemoryDumpCompression `json:"compression,omitempty"`
+	// ChunkSize is the amount of the dump which is written to the pvc before it is flushed and dropped from the
+	// page cache of the virt-launcher pod. Defaults to 64Mi if the dump is compressed, otherwise the dump is
+	// written by the hypervisor directly
+	// +optional
+	ChunkSize *resource.Quantity `json:"chunkSize,omitempty"`
 }
 
+type MemoryDumpCompression string
+
+const (
+	MemoryDumpCompressionGzip MemoryDumpCompression = "gzip"
+	MemoryDumpCompressionZstd MemoryDumpCompression = "zstd"
+)
+
 type MemoryDumpPhase string
 
 const (
@@ -2485,6 +3112,15 @@ type VSOCKOptions struct {
 	UseTLS     *bool  `json:"useTLS,omitempty"`
 }
 
+// PacketCaptureOptions is provided when capturing the traffic of a VirtualMachineInstance interface
+type PacketCaptureOptions struct {
+	// InterfaceName is the name of the interface, as defined in the VMI spec, to capture the traffic of
+	InterfaceName string `json:"interfaceName"`
+	// Duration limits the capture, otherwise it lasts until the connection is closed
+	// +optional
+	Duration *metav1.Duration `json:"duration,omitempty"`
+}
+
 // RemoveVolumeOptions is provided when dynamically hot unplugging volume and disk
 type RemoveVolumeOptions struct {
 	// Name represents the name that maps to both the disk and volume that
@@ -2606,6 +3242,252 @@ type KubeVirtConfiguration struct {
 	// Instancetype configuration
 	// +nullable
 	Instancetype *InstancetypeConfiguration `json:"instancetype,omitempty"`
+
+	// StartConfiguration limits how many VirtualMachineInstances are allowed to start at the same time
+	// +nullable
+	StartConfiguration *StartConfiguration `json:"startConfiguration,omitempty"`
+
+	// CanaryConfiguration enables a canary which periodically creates, boots, probes and deletes a small
+	// VirtualMachineInstance and reports the duration and the result of each phase as metrics
+	// +nullable
+	CanaryConfiguration *CanaryConfiguration `json:"canaryConfiguration,omitempty"`
+
+	// PlacementHints configures an external placement service which virt-controller consults for preferred
+	// and forbidden nodes when it creates the pod of a starting VirtualMachineInstance or a migration target pod
+	// +nullable
+	PlacementHints *PlacementHintsConfiguration `json:"placementHints,omitempty"`
+
+	// CPUFairShare weights the CPU time of the virt-launcher pods by the tier of their namespace,
+	// so that VirtualMachineInstances of higher tiers get more CPU time when a node is CPU saturated
+	// +nullable
+	CPUFairShare *CPUFairShareConfiguration `json:"cpuFairShare,omitempty"`
+
+	// DedicatedCPUPools reserve host CPUs of the nodes for the VirtualMachineInstances with dedicated CPU placement,
+	// on nodes where the CPU manager policy of the kubelet is not static
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	DedicatedCPUPools []DedicatedCPUPool `json:"dedicatedCPUPools,omitempty"`
+
+	// SwapConfiguration lets virt-handler give the overcommitted VirtualMachineInstances access to the swap of the nodes
+	// +optional
+	SwapConfiguration *SwapConfiguration `json:"swapConfiguration,omitempty"`
+
+	// HugepagesPools let virt-handler allocate hugepages on the nodes at runtime, so that VirtualMachineInstances
+	// backed by hugepages can run without reserving the hugepages on the kernel command line
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	HugepagesPools []HugepagesPool `json:"hugepagesPools,omitempty"`
+}
+
+// PlacementHintsConfiguration holds the settings of the external placement service.
+// virt-controller POSTs a PlacementHintsRequest to the service and expects a PlacementHintsResponse.
+// The preferred nodes are added as preferred and the forbidden nodes as required node affinity to the pod.
+type PlacementHintsConfiguration struct {
+	// URL is the http or https endpoint of the placement service
+	URL string `json:"url"`
+	// CABundle is the PEM encoded CA bundle used to verify the certificate of the placement service.
+	// The system trust store is used if empty
+	// +optional
+	CABundle []byte `json:"caBundle,omitempty"`
+	// Timeout is the time the placement service has to answer. Defaults to 2s
+	// +optional
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+	// FailurePolicy defines how a failed request or an invalid response is handled.
+	// Ignore creates the pod without hints, Fail retries later without creating the pod. Defaults to Ignore
+	// +optional
+	// +kubebuilder:validation:Enum=Ignore;Fail
+	FailurePolicy *PlacementHintsFailurePolicy `json:"failurePolicy,omitempty"`
+}
+
+type PlacementHintsFailurePolicy string
+
+const (
+	PlacementHintsFailurePolicyIgnore PlacementHintsFailurePolicy = "Ignore"
+	PlacementHintsFailurePolicyFail   PlacementHintsFailurePolicy = "Fail"
+)
+
+type PlacementHintsOperation string
+
+const (
+	// PlacementHintsOperationStart asks for the hints of the pod of a starting VirtualMachineInstance
+	PlacementHintsOperationStart PlacementHintsOperation = "Start"
+	// PlacementHintsOperationMigration asks for the hints of a migration target pod
+	PlacementHintsOperationMigration PlacementHintsOperation = "Migration"
+)
+
+// PlacementHintsRequest is sent to the placement service
+type PlacementHintsRequest struct {
+	// Operation tells if the pod is created to start or to migrate the VirtualMachineInstance
+	Operation PlacementHintsOperation `json:"operation"`
+	// Namespace of the VirtualMachineInstance
+	Namespace string `json:"namespace"`
+	// Name of the VirtualMachineInstance
+	Name string `json:"name"`
+	// UID of the VirtualMachineInstance
+	UID types.UID `json:"uid,omitempty"`
+	// Labels of the VirtualMachineInstance
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+	// SourceNode is the node a migrating VirtualMachineInstance runs on
+	// +optional
+	SourceNode string `json:"sourceNode,omitempty"`
+	// MigrationName is the name of the migration the target pod is created for
+	// +optional
+	MigrationName string `json:"migrationName,omitempty"`
+}
+
+// PlacementHintsResponse is returned by the placement service
+type PlacementHintsResponse struct {
+	// PreferredNodes are the nodes the pod should be scheduled on, by weight
+	// +optional
+	// +listType=atomic
+	PreferredNodes []PreferredNode `json:"preferredNodes,omitempty"`
+	// ForbiddenNodes are the nodes the pod must not be scheduled on
+	// +optional
+	// +listType=atomic
+	ForbiddenNodes []string `json:"forbiddenNodes,omitempty"`
+}
+
+// PreferredNode is a node preferred by the placement service
+type PreferredNode struct {
+	// Node is the name of the node
+	Node string `json:"node"`
+	// Weight of the preference, in the range 1-100
+	Weight int32 `json:"weight"`
+}
+
+// DedicatedCPUPool is a set of host CPUs reserved on the nodes matching its node selector.
+// virt-handler pins the virt-launcher pods of the VirtualMachineInstances with dedicated CPU placement to exclusive
+// CPUs of the pool, and keeps the burstable and best-effort pods off the pool.
+type DedicatedCPUPool struct {
+	// Name of the pool, the nodes are labeled with the name of their pool
+	Name string `json:"name"`
+	// NodeSelector selects the nodes the pool is reserved on, the first pool matching a node is used.
+	// The pool is reserved on all the nodes if it is empty
+	// +optional
+	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
+	// CPUs of the pool, in the cpuset list format, e.g. 4-15,20-23
+	CPUs string `json:"cpus"`
+}
+
+// HugepagesPool is a number of hugepages of a size allocated on the nodes matching its node selector.
+// virt-handler allocates the hugepages at runtime, reports the provisioning in the annotations of the nodes,
+// and frees the hugepages of a size once no pool of that size selects the node anymore.
+type HugepagesPool struct {
+	// Name of the pool
+	Name string `json:"name"`
+	// NodeSelector selects the nodes the hugepages are allocated on, the first pool of a page size matching
+	// a node is used. The hugepages are allocated on all the nodes if it is empty
+	// +optional
+	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
+	// PageSize of the hugepages, e.g. 2Mi or 1Gi
+	PageSize string `json:"pageSize"`
+	// Count is the number of hugepages allocated on each node
+	Count int32 `json:"count"`
+}
+
+// SwapConfiguration lets virt-handler give the virt-launcher pods of the overcommitted VMIs access to the swap of
+// the nodes, so that the guest memory above their memory request is swapped out under memory pressure instead of
+// getting them OOM-killed. The nodes need swap enabled and cgroup v2.
+type SwapConfiguration struct {
+	// NodeSelector selects the nodes on which the swap of the VMIs is managed.
+	// All the nodes are selected if it is empty
+	// +optional
+	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
+	// EvictionSwapUsedPercent is the percentage of the swap of a node in use above which the overcommitted VMI
+	// using the most swap is evacuated from the node. The eviction on swap usage is disabled if it is unset
+	// +optional
+	EvictionSwapUsedPercent *int32 `json:"evictionSwapUsedPercent,omitempty"`
+	// EvictionMemoryPressurePercent is the percentage of the last 10 seconds during which all the tasks of a node were
+	// stalled on memory, above which the overcommitted VMI using the most swap is evacuated from the node.
+	// The eviction on memory pressure is disabled if it is unset
+	// +optional
+	EvictionMemoryPressurePercent *int32 `json:"evictionMemoryPressurePercent,omitempty"`
+}
+
+// CPUFairShareConfiguration holds the CPU tiers of the namespaces.
+// virt-handler sets the cgroup cpu.weight of the virt-launcher pods to the weight of the tier of their namespace,
+// pods in namespaces without a tier keep the weight assigned by the kubelet.
+type CPUFairShareConfiguration struct {
+	// Tiers are the CPU tiers, a namespace should only be part of one tier
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	Tiers []CPUFairShareTier `json:"tiers,omitempty"`
+}
+
+// CPUFairShareTier is a group of namespaces sharing the same CPU weight
+type CPUFairShareTier struct {
+	// Name of the tier
+	Name string `json:"name"`
+	// Weight is the cgroup v2 cpu.weight of the virt-launcher pods of the tier, in the range 1-10000.
+	// The kubelet derives a weight of about 40 per requested CPU
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=10000
+	Weight uint64 `json:"weight"`
+	// Namespaces of the tier
+	// +optional
+	// +listType=set
+	Namespaces []string `json:"namespaces,omitempty"`
+}
+
+// CanaryConfiguration holds the settings of the canary VirtualMachineInstance.
+// A single canary run happens at a time, a new run starts once the interval passed since the start of the last one.
+type CanaryConfiguration struct {
+	// Image is the containerDisk image booted by the canary VirtualMachineInstance
+	Image string `json:"image"`
+	// Namespace is the namespace the canary VirtualMachineInstance is created in.
+	// Defaults to the namespace KubeVirt is installed in
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+	// Interval is the time between the starts of two canary runs. Defaults to 10m
+	// +optional
+	Interval *metav1.Duration `json:"interval,omitempty"`
+	// Timeout is the time the canary VirtualMachineInstance has to become ready before the run fails. Defaults to 5m
+	// +optional
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+	// Memory is the guest memory of the canary VirtualMachineInstance. Defaults to 128Mi
+	// +optional
+	Memory *resource.Quantity `json:"memory,omitempty"`
+	// NodeSelector restricts the nodes the canary VirtualMachineInstance is scheduled on
+	// +optional
+	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
+	// ReadinessProbe has to succeed before the canary VirtualMachineInstance counts as ready.
+	// Without a probe the canary is ready once it is running
+	// +optional
+	ReadinessProbe *Probe `json:"readinessProbe,omitempty"`
+}
+
+// StartConfiguration holds the limits on concurrently starting VirtualMachineInstances.
+// A VirtualMachineInstance is starting from the moment its launcher pod is created until it is running,
+// except while the scheduler reports its launcher pod as unschedulable.
+// VirtualMachineInstances exceeding a limit wait in a first-in first-out queue.
+type StartConfiguration struct {
+	// ParallelStartsPerCluster is the maximum number of VirtualMachineInstances
+	// starting at the same time cluster-wide. Unlimited if not set
+	// +optional
+	ParallelStartsPerCluster *uint32 `json:"parallelStartsPerCluster,omitempty"`
+	// ParallelStartsPerNamespace is the maximum number of VirtualMachineInstances
+	// starting at the same time in a single namespace. Unlimited if not set
+	// +optional
+	ParallelStartsPerNamespace *uint32 `json:"parallelStartsPerNamespace,omitempty"`
+	// VolumePreparationLimits limits the number of DataVolumes of a storage class which are
+	// cloned, imported or resized at the same time before their VirtualMachines can start
+	// +optional
+	// +listType=atomic
+	VolumePreparationLimits []VolumePreparationLimit `json:"volumePreparationLimits,omitempty"`
+}
+
+// VolumePreparationLimit holds the limit on concurrent volume preparations for a storage class.
+// DataVolumes of VirtualMachines and PVC expansions exceeding the limit wait until a preparation finishes.
+type VolumePreparationLimit struct {
+	// StorageClassName is the name of the storage class the limit applies to
+	StorageClassName string `json:"storageClassName"`
+	// ParallelVolumePreparations is the maximum number of volumes of the storage class
+	// which are cloned, imported or resized at the same time
+	ParallelVolumePreparations uint32 `json:"parallelVolumePreparations"`
 }
 
 type InstancetypeConfiguration struct {
@@ -2616,6 +3498,60 @@ type InstancetypeConfiguration struct {
 	// +nullable
 	// +kubebuilder:validation:Enum=reference;expand;expandAll
 	ReferencePolicy *InstancetypeReferencePolicy `json:"referencePolicy,omitempty"`
+
+	// RevisionUpgradePolicy defines if VMs referencing an outdated ControllerRevision of an instance type are moved to the latest version, supported values are:
+	// manual (default) - Where the VM keeps its ControllerRevision until it is changed by the user.
+	// onRestart - Where the VM is moved to a ControllerRevision of the latest version of the instance type while it is not running, so it is used at the next start.
+	// The policy can be overridden per VM with the instancetype.kubevirt.io/revision-upgrade-policy annotation.
+	// +nullable
+	// +kubebuilder:validation:Enum=manual;onRestart
+	RevisionUpgradePolicy *InstancetypeRevisionUpgradePolicy `json:"revisionUpgradePolicy,omitempty"`
+
+	// NodeCompatibilityPolicy defines how VMs are admitted when no node provides the hugepages, dedicated CPUs, GPUs or host devices of their instance type, supported values are:
+	// ignore (default) - Where the VM is admitted without looking at the nodes.
+	// warn - Where the VM is admitted with a warning.
+	// reject - Where the VM is rejected.
+	// +nullable
+	// +kubebuilder:validation:Enum=ignore;warn;reject
+	NodeCompatibilityPolicy *InstancetypeNodeCompatibilityPolicy `json:"nodeCompatibilityPolicy,omitempty"`
+
+	// NamespacePolicies restrict which cluster instance types and cluster preferences the VMs of the selected namespaces may reference.
+	// If policies selecting a namespace list cluster instance types, VMs in the namespace may only reference the cluster instance types listed by any of them, the same applies to cluster preferences.
+	// Namespaced instance types and preferences are not restricted.
+	// +optional
+	// +listType=atomic
+	NamespacePolicies []InstancetypeNamespacePolicy `json:"namespacePolicies,omitempty"`
+
+	// Rightsizing enables the recommendation of cluster instance types from the observed usage of the VMs.
+	// The recommendation is set in the instancetype.kubevirt.io/recommended-instancetype annotation of the VMs.
+	// +optional
+	Rightsizing *InstancetypeRightsizing `json:"rightsizing,omitempty"`
+}
+
+// InstancetypeRightsizing configures where the usage of the VMs is read from and how long it is observed
+type InstancetypeRightsizing struct {
+	// PrometheusURL is the address of the Prometheus API the kubevirt_vmi_vcpu_seconds_total and kubevirt_vmi_memory_used_bytes metrics are queried from
+	PrometheusURL string `json:"prometheusURL"`
+
+	// Window is the period the usage of the VMs is observed over, defaults to 24h
+	// +optional
+	Window *metav1.Duration `json:"window,omitempty"`
+}
+
+// InstancetypeNamespacePolicy allows the VMs of the selected namespaces to reference the listed cluster instance types and cluster preferences
+type InstancetypeNamespacePolicy struct {
+	// NamespaceSelector selects the namespaces the policy applies to, an empty selector selects all namespaces
+	NamespaceSelector metav1.LabelSelector `json:"namespaceSelector"`
+
+	// ClusterInstancetypes lists the names of the VirtualMachineClusterInstancetypes the VMs may reference
+	// +optional
+	// +listType=set
+	ClusterInstancetypes []string `json:"clusterInstancetypes,omitempty"`
+
+	// ClusterPreferences lists the names of the VirtualMachineClusterPreferences the VMs may reference
+	// +optional
+	// +listType=set
+	ClusterPreferences []string `json:"clusterPreferences,omitempty"`
 }
 
 type InstancetypeReferencePolicy string
@@ -2629,6 +3565,26 @@ const (
 	ExpandAll InstancetypeReferencePolicy = "expandAll"
 )
 
+type InstancetypeRevisionUpgradePolicy string
+
+const (
+	// Keep the ControllerRevision referenced by the VirtualMachine until it is changed by the user
+	RevisionUpgradeManual InstancetypeRevisionUpgradePolicy = "manual"
+	// Move stopped VirtualMachines to a ControllerRevision of the latest version of their instance type
+	RevisionUpgradeOnRestart InstancetypeRevisionUpgradePolicy = "onRestart"
+)
+
+type InstancetypeNodeCompatibilityPolicy string
+
+const (
+	// Admit VirtualMachines without checking the resources advertised by the nodes
+	NodeCompatibilityIgnore InstancetypeNodeCompatibilityPolicy = "ignore"
+	// Admit VirtualMachines with a warning if no node provides the resources of their instance type
+	NodeCompatibilityWarn InstancetypeNodeCompatibilityPolicy = "warn"
+	// Reject VirtualMachines if no node provides the resources of their instance type
+	NodeCompatibilityReject InstancetypeNodeCompatibilityPolicy = "reject"
+)
+
 type CommonInstancetypesDeployment struct {
 	// Enabled controls the deployment of common-instancetypes resources, defaults to True.
 	// +nullable
@@ -2770,6 +3726,10 @@ type MigrationConfiguration struct {
 	// BandwidthPerMigration limits the amount of network bandwidth live migrations are allowed to use.
 	// The value is in quantity per second. Defaults to 0 (no limit)
 	BandwidthPerMigration *resource.Quantity `json:"bandwidthPerMigration,omitempty"`
+	// BandwidthAutoTuning lets virt-controller derive the bandwidth of each migration from the number of
+	// concurrent outbound migrations and the network utilization which virt-handler reports for the source node.
+	// BandwidthPerMigration, if set, remains the upper limit. Defaults to no auto-tuning
+	BandwidthAutoTuning *MigrationBandwidthAutoTuning `json:"bandwidthAutoTuning,omitempty"`
 	// CompletionTimeoutPerGiB is the maximum number of seconds per GiB a migration is allowed to take.
 	// If the timeout is reached, the migration will be either paused, switched
 	// to post-copy or cancelled depending on other settings. Defaults to 150
@@ -2791,6 +3751,21 @@ type MigrationConfiguration struct {
 	// permitted, migration will be switched to post-copy or the VMI will be
 	// paused to allow the migration to complete
 	AllowWorkloadDisruption *bool `json:"allowWorkloadDisruption,omitempty"`
+	// MaxDowntimeMilliseconds is the maximum number of milliseconds the guest may be paused to switch over to the
+	// target at the end of a pre-copy migration. Larger values let migrations of busy VMIs converge sooner.
+	// Defaults to the hypervisor default (300)
+	MaxDowntimeMilliseconds *int64 `json:"maxDowntimeMilliseconds,omitempty"`
+	// PostCopyAfterIterations switches a migration to post-copy once the guest memory has been copied that many
+	// times without the migration completing, instead of waiting for CompletionTimeoutPerGiB.
+	// Only applies if AllowPostCopy and AllowWorkloadDisruption are set. Defaults to 0 (disabled)
+	PostCopyAfterIterations *int64 `json:"postCopyAfterIterations,omitempty"`
+	// ParallelMigrationChannels is the number of multifd channels used to transfer the guest memory of a live
+	// migration. A value of 1 migrates over a single connection. Post-copy migrations and VMIs with a CPU limit
+	// always use a single connection. Defaults to 8
+	ParallelMigrationChannels *uint32 `json:"parallelMigrationChannels,omitempty"`
+	// Compression compresses the guest memory sent over the multifd channels of a live migration.
+	// It is ignored for migrations over a single connection. Defaults to no compression
+	Compression *MigrationCompression `json:"compression,omitempty"`
 	// When set to true, DisableTLS will disable the additional layer of live migration encryption
 	// provided by KubeVirt. This is usually a bad idea. Defaults to false
 	DisableTLS *bool `json:"disableTLS,omitempty"`
@@ -2804,6 +3779,38 @@ type MigrationConfiguration struct {
 	MatchSELinuxLevelOnMigration *bool `json:"matchSELinuxLevelOnMigration,omitempty"`
 }
 
+// MigrationBandwidthAutoTuning defines how the bandwidth of live migrations is tuned to the network of the source node
+type MigrationBandwidthAutoTuning struct {
+	// MinBandwidthPerMigration is the lowest bandwidth a migration is limited to, even if the network of the
+	// source node is saturated. The value is in quantity per second. Defaults to 0 (no lower limit)
+	// +optional
+	MinBandwidthPerMigration *resource.Quantity `json:"minBandwidthPerMigration,omitempty"`
+	// TargetUtilizationPercent is the share of the migration network capacity of the source node which may be
+	// used in total. Migrations use whatever is left by the other traffic. Defaults to 80
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100
+	// +optional
+	TargetUtilizationPercent *int32 `json:"targetUtilizationPercent,omitempty"`
+}
+
+type MigrationCompressionMethod string
+
+const (
+	MigrationCompressionZlib MigrationCompressionMethod = "zlib"
+	MigrationCompressionZstd MigrationCompressionMethod = "zstd"
+)
+
+// MigrationCompression defines the compression of the guest memory sent by multifd live migrations
+type MigrationCompression struct {
+	// Method is the compression method. One of zlib or zstd
+	// +kubebuilder:validation:Enum=zlib;zstd
+	Method MigrationCompressionMethod `json:"method"`
+	// Level is the compression level. Ranges from 1 to 9 for zlib and from 1 to 20 for zstd.
+	// Defaults to the hypervisor default of the method
+	// +optional
+	Level *int32 `json:"level,omitempty"`
+}
+
 // DiskVerification holds container disks verification limits
 type DiskVerification struct {
 	MemoryLimit *resource.Quantity `json:"memoryLimit"`
@@ -2875,6 +3882,22 @@ type PermittedHostDevices struct {
 	MediatedDevices []MediatedHostDevice `json:"mediatedDevices,omitempty"`
 	// +listType=atomic
 	USB []USBHostDevice `json:"usb,omitempty"`
+	// USBPassthrough lists the USB devices of the nodes which the usbDevices of VMIs may select.
+	// Hubs can not be passed through.
+	// +listType=atomic
+	USBPassthrough []USBPassthroughHostDevice `json:"usbPassthrough,omitempty"`
+}
+
+// USBPassthroughHostDevice permits the USB devices matching all of its set selectors to be passed through.
+// At least one of the selectors has to be set.
+type USBPassthroughHostDevice struct {
+	// VendorProduct permits the devices with the vendor_id:product_id tuple, e.g. 1050:0407
+	// +optional
+	VendorProduct string `json:"vendorProduct,omitempty"`
+	// BusPort permits the device plugged into the port, by the bus number and port path the kernel
+	// names the device after, e.g. 1-2.3
+	// +optional
+	BusPort string `json:"busPort,omitempty"`
 }
 
 type USBHostDevice struct {
@@ -2941,6 +3964,20 @@ type NodeMediatedDeviceTypesConfig struct {
 	// +optional
 	// +listType=atomic
 	MediatedDeviceTypes []string `json:"mediatedDeviceTypes"`
+	// MediatedDeviceCounts sets how many instances of some of the mediatedDeviceTypes are created on the node.
+	// Types without a count get all the instances their parent devices can provide.
+	// +optional
+	// +listType=atomic
+	MediatedDeviceCounts []MediatedDeviceTypeCount `json:"mediatedDeviceCounts,omitempty"`
+}
+
+// MediatedDeviceTypeCount sets the number of instances of an mdev type created on a node.
+// +k8s:openapi-gen=true
+type MediatedDeviceTypeCount struct {
+	// Type is the name or the ID of the mdev type, as listed in mediatedDeviceTypes.
+	Type string `json:"type"`
+	// Count is the number of instances of the type to create on the node.
+	Count int32 `json:"count"`
 }
 
 // KSMConfiguration holds information about KSM.
@@ -2948,8 +3985,44 @@ type NodeMediatedDeviceTypesConfig struct {
 type KSMConfiguration struct {
 	// NodeLabelSelector is a selector that filters in which nodes the KSM will be enabled.
 	// Empty NodeLabelSelector will enable ksm for every node.
+	// The kubevirt.io/ksm-policy label of a node, enabled or disabled, takes precedence over it.
 	// +optional
 	NodeLabelSelector *metav1.LabelSelector `json:"nodeLabelSelector,omitempty"`
+	// Tuning holds the parameters of the KSM scanning on the nodes.
+	// The KSM override annotations of a node take precedence over them.
+	// +optional
+	Tuning *KSMTuning `json:"tuning,omitempty"`
+}
+
+// KSMTuning holds the parameters virt-handler uses to adjust the KSM scanning to the memory pressure of the node.
+// While the node is under memory pressure, the pages to scan start at PagesInit and grow by PagesBoost on every
+// heartbeat up to PagesMax. Without pressure, they shrink by PagesDecay down to PagesMin, where KSM stops.
+// +k8s:openapi-gen=true
+type KSMTuning struct {
+	// PagesBoost is the number of pages to scan added on every heartbeat under memory pressure. Defaults to 300.
+	// +optional
+	PagesBoost *int32 `json:"pagesBoost,omitempty"`
+	// PagesDecay is the number of pages to scan added on every heartbeat without memory pressure,
+	// it can not be positive. Defaults to -50.
+	// +optional
+	PagesDecay *int32 `json:"pagesDecay,omitempty"`
+	// PagesMin is the minimum number of pages to scan. Defaults to 64.
+	// +optional
+	PagesMin *int32 `json:"pagesMin,omitempty"`
+	// PagesMax is the maximum number of pages to scan. Defaults to 1250.
+	// +optional
+	PagesMax *int32 `json:"pagesMax,omitempty"`
+	// PagesInit is the number of pages to scan when KSM starts. Defaults to 100.
+	// +optional
+	PagesInit *int32 `json:"pagesInit,omitempty"`
+	// SleepMsBaseline is the time KSM sleeps between scans on a node with 16GiB of used memory, in milliseconds.
+	// The sleep time is scaled down the more memory is used. Defaults to 100.
+	// +optional
+	SleepMsBaseline *int32 `json:"sleepMsBaseline,omitempty"`
+	// FreePercent is the percentage of available memory under which the node is under memory pressure.
+	// Defaults to 20.
+	// +optional
+	FreePercent *int32 `json:"freePercent,omitempty"`
 }
 
 // NetworkConfiguration holds network options
@@ -2960,6 +4033,40 @@ type NetworkConfiguration struct {
 	DeprecatedPermitSlirpInterface    *bool                             `json:"permitSlirpInterface,omitempty"`
 	PermitBridgeInterfaceOnPodNetwork *bool                             `json:"permitBridgeInterfaceOnPodNetwork,omitempty"`
 	Binding                           map[string]InterfaceBindingPlugin `json:"binding,omitempty"`
+	// MacAddressPool holds the ranges the MAC addresses of VirtualMachine interfaces are allocated from.
+	// It requires the MacAddressPool feature gate.
+	// +optional
+	MacAddressPool *MacAddressPoolConfiguration `json:"macAddressPool,omitempty"`
+}
+
+// MacAddressPoolConfiguration holds the ranges of the built-in MAC address pool.
+// VirtualMachine interfaces without a MAC address get one from the ranges of their namespace on creation.
+type MacAddressPoolConfiguration struct {
+	// Ranges are the MAC address ranges of the namespaces without dedicated ranges
+	// +listType=atomic
+	// +optional
+	Ranges []MacAddressRange `json:"ranges,omitempty"`
+	// Namespaces holds the MAC address ranges dedicated to namespaces
+	// +listType=atomic
+	// +optional
+	Namespaces []NamespaceMacAddressRanges `json:"namespaces,omitempty"`
+}
+
+// MacAddressRange is an inclusive range of MAC addresses
+type MacAddressRange struct {
+	// Start is the first MAC address of the range, e.g. 02:00:00:00:00:00
+	Start string `json:"start"`
+	// End is the last MAC address of the range, e.g. 02:00:00:ff:ff:ff
+	End string `json:"end"`
+}
+
+// NamespaceMacAddressRanges dedicates MAC address ranges to a namespace
+type NamespaceMacAddressRanges struct {
+	// Namespace is the name of the namespace
+	Namespace string `json:"namespace"`
+	// Ranges are the MAC address ranges of the namespace
+	// +listType=atomic
+	Ranges []MacAddressRange `json:"ranges"`
 }
 
 type InterfaceBindingPlugin struct {
@@ -2973,7 +4080,7 @@ type InterfaceBindingPlugin struct {
 	// version: 1alphav1
 	NetworkAttachmentDefinition string `json:"networkAttachmentDefinition,omitempty"`
 	// DomainAttachmentType is a standard domain network attachment method kubevirt supports.
-	// Supported values: "tap", "managedTap" (since v1.4).
+	// Supported values: "tap", "managedTap" (since v1.4), "vdpa" (since v1.6).
 	// The standard domain attachment can be used instead or in addition to the sidecarImage.
 	// version: 1alphav1
 	DomainAttachmentType DomainAttachmentType `json:"domainAttachmentType,omitempty"`
@@ -3016,6 +4123,10 @@ const (
 	// ManagedTap domain attachment type is binding an ethernet connection into guests using a tap device.
 	// The tap device is created (unless already present) on the network pod interface with a Linux bridge.
 	ManagedTap DomainAttachmentType = "managedTap"
+	// Vdpa domain attachment type is binding a vDPA device into guests, with a hardware offloaded virtio datapath.
+	// The vhost-vdpa character device is taken from the device info of the network, as reported by the device plugin.
+	// https://libvirt.org/formatdomain.html#vdpa-devices
+	Vdpa DomainAttachmentType = "vdpa"
 )
 
 type NetworkBindingDownwardAPIType string
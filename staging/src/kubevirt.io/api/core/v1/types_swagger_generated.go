@@ -16,6 +16,23 @@ func (VirtualMachineInstanceList) SwaggerDoc() map[string]string {
 	}
 }
 
+func (ShutdownPolicy) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                         "ShutdownPolicy defines the time given to each stage of a graceful shutdown.\nThe shutdown never takes longer than the termination grace period.",
+		"acpiTimeoutSeconds":       "ACPITimeoutSeconds is the time the guest is given to power off after the ACPI power button was pressed.\nDefaults to 30.\n+optional",
+		"guestAgentTimeoutSeconds": "GuestAgentTimeoutSeconds is the time the guest is given to power off after the shutdown command was sent\nthrough the guest agent, before it is forcefully powered off. The stage is skipped if no guest agent is connected.\nDefaults to 30.\n+optional",
+	}
+}
+
+func (GuestLicense) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":             "GuestLicense describes the licensing of the guest operating system, for license compliance reporting.",
+		"osFamily":     "OSFamily is the family of the guest operating system, one of linux, windows or other.\nIf not set, it is detected from the operating system reported by the guest agent.\n+optional",
+		"licenseModel": "LicenseModel is the model the guest operating system is licensed under, like subscription or perCore.\n+optional",
+		"byol":         "BYOL tells if the license of the guest operating system is brought by the owner of the VirtualMachineInstance\ninstead of being provided with the cluster.\n+optional",
+	}
+}
+
 func (VirtualMachineInstanceSpec) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"":                              "VirtualMachineInstanceSpec is a description of a VirtualMachineInstance.",
@@ -29,6 +46,7 @@ func (VirtualMachineInstanceSpec) SwaggerDoc() map[string]string {
 		"evictionStrategy":              "EvictionStrategy describes the strategy to follow when a node drain occurs.\nThe possible options are:\n- \"None\": No action will be taken, according to the specified 'RunStrategy' the VirtualMachine will be restarted or shutdown.\n- \"LiveMigrate\": the VirtualMachineInstance will be migrated instead of being shutdown.\n- \"LiveMigrateIfPossible\": the same as \"LiveMigrate\" but only if the VirtualMachine is Live-Migratable, otherwise it will behave as \"None\".\n- \"External\": the VirtualMachineInstance will be protected by a PDB and `vmi.Status.EvacuationNodeName` will be set on eviction. This is mainly useful for cluster-api-provider-kubevirt (capk) which needs a way for VMI's to be blocked from eviction, yet signal capk that eviction has been called on the VMI so the capk controller can handle tearing the VMI down. Details can be found in the commit description https://github.com/kubevirt/kubevirt/commit/c1d77face705c8b126696bac9a3ee3825f27f1fa.\n+optional",
 		"startStrategy":                 "StartStrategy can be set to \"Paused\" if Virtual Machine should be started in paused state.\n\n+optional",
 		"terminationGracePeriodSeconds": "Grace period observed after signalling a VirtualMachineInstance to stop after which the VirtualMachineInstance is force terminated.",
+		"shutdownPolicy":                "ShutdownPolicy escalates the graceful shutdown of the guest in stages, from an ACPI power button press,\nto a shutdown command sent through the guest agent, to forcefully powering off the guest.\nIf not set, the guest is asked to shut down until the termination grace period expires.\n+optional",
 		"volumes":                       "List of volumes that can be mounted by disks belonging to the vmi.\n+kubebuilder:validation:MaxItems:=256",
 		"livenessProbe":                 "Periodic probe of VirtualMachineInstance liveness.\nVirtualmachineInstances will be stopped if the probe fails.\nCannot be updated.\nMore info: https://kubernetes.io/docs/concepts/workloads/pods/pod-lifecycle#container-probes\n+optional",
 		"readinessProbe":                "Periodic probe of VirtualMachineInstance service readiness.\nVirtualmachineInstances will be removed from service endpoints if the probe fails.\nCannot be updated.\nMore info: https://kubernetes.io/docs/concepts/workloads/pods/pod-lifecycle#container-probes\n+optional",
@@ -39,6 +57,7 @@ func (VirtualMachineInstanceSpec) SwaggerDoc() map[string]string {
 		"dnsConfig":                     "Specifies the DNS parameters of a pod.\nParameters specified here will be merged to the generated DNS\nconfiguration based on DNSPolicy.\n+optional",
 		"accessCredentials":             "Specifies a set of public keys to inject into the vm guest\n+listType=atomic\n+optional\n+kubebuilder:validation:MaxItems:=256",
 		"architecture":                  "Specifies the architecture of the vm guest you are attempting to run. Defaults to the compiled architecture of the KubeVirt components",
+		"guestLicense":                  "GuestLicense describes the licensing of the guest operating system.\nIt is exposed in the kubevirt_vmi_guest_license_info metric.\n+optional",
 	}
 }
 
@@ -51,7 +70,10 @@ func (VirtualMachineInstancePhaseTransitionTimestamp) SwaggerDoc() map[string]st
 }
 
 func (TopologyHints) SwaggerDoc() map[string]string {
-	return map[string]string{}
+	return map[string]string{
+		"cpuModel":    "CPUModel is the CPU model common to the nodes the VMI can be scheduled to,\nused when the VMI requests the cluster-common CPU model\n+optional",
+		"cpuFeatures": "CPUFeatures are the CPU features common to the nodes the VMI can be scheduled to,\nrequired on top of CPUModel\n+optional\n+listType=set",
+	}
 }
 
 func (VirtualMachineInstanceStatus) SwaggerDoc() map[string]string {
@@ -68,12 +90,17 @@ func (VirtualMachineInstanceStatus) SwaggerDoc() map[string]string {
 		"migrationMethod":               "Represents the method using which the vmi can be migrated: live migration or block migration",
 		"migrationTransport":            "This represents the migration transport",
 		"qosClass":                      "The Quality of Service (QOS) classification assigned to the virtual machine instance based on resource requirements\nSee PodQOSClass type for available QOS classes\nMore info: https://git.k8s.io/community/contributors/design-proposals/node/resource-qos.md\n+optional",
+		"cgroupLayout":                  "CgroupLayout describes how the threads of the virtual machine instance are placed in the cgroups\nof the compute container. It is only reported if the housekeeping threads are separated from the vCPUs.\n+optional",
+		"threadPlacement":               "ThreadPlacement reports the host CPUs the emulator thread and the IOThreads are pinned to\n+optional",
 		"launcherContainerImageVersion": "LauncherContainerImageVersion indicates what container image is currently active for the vmi.",
 		"evacuationNodeName":            "EvacuationNodeName is used to track the eviction process of a VMI. It stores the name of the node that we want\nto evacuate. It is meant to be used by KubeVirt core components only and can't be set or modified by users.\n+optional",
 		"activePods":                    "ActivePods is a mapping of pod UID to node name.\nIt is possible for multiple pods to be running for a single VMI during migration.",
 		"volumeStatus":                  "VolumeStatus contains the statuses of all the volumes\n+optional\n+listType=atomic",
 		"kernelBootStatus":              "KernelBootStatus contains info about the kernelBootContainer\n+optional",
 		"fsFreezeStatus":                "FSFreezeStatus is the state of the fs of the guest\nit can be either frozen or thawed\n+optional",
+		"fsFreezeInfo":                  "FSFreezeInfo reports which guest filesystems are frozen and for how long they were frozen\n+optional",
+		"pauseStatus":                   "PauseStatus reports for how long the VirtualMachineInstance was paused\n+optional",
+		"shutdownStage":                 "ShutdownStage is the stage of the shutdown policy the guest is shut down in,\nor was shut down in once the VirtualMachineInstance stopped\n+optional",
 		"topologyHints":                 "+optional",
 		"virtualMachineRevisionName":    "VirtualMachineRevisionName is used to get the vm revision of the vmi when doing\nan online vm snapshot\n+optional",
 		"runtimeUser":                   "RuntimeUser is used to determine what user will be used in launcher\n+optional",
@@ -83,6 +110,18 @@ func (VirtualMachineInstanceStatus) SwaggerDoc() map[string]string {
 		"currentCPUTopology":            "CurrentCPUTopology specifies the current CPU topology used by the VM workload.\nCurrent topology may differ from the desired topology in the spec while CPU hotplug\ntakes place.",
 		"memory":                        "Memory shows various informations about the VirtualMachine memory.\n+optional",
 		"migratedVolumes":               "MigratedVolumes lists the source and destination volumes during the volume migration\n+listType=atomic\n+optional",
+		"usbDevices":                    "USBDevices reports the host USB devices allocated to the USB devices of the VirtualMachineInstance\n+listType=atomic\n+optional",
+	}
+}
+
+func (USBDeviceStatus) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":             "USBDeviceStatus reports the host device allocated to a USB device of the VirtualMachineInstance",
+		"name":         "Name of the USB device in the VirtualMachineInstance spec",
+		"phase":        "Phase of the USB device",
+		"busPort":      "BusPort is the bus and port path of the allocated host device, e.g. 1-2.3\n+optional",
+		"bus":          "Bus is the number of the bus of the allocated host device\n+optional",
+		"deviceNumber": "DeviceNumber is the number of the allocated host device on its bus\n+optional",
 	}
 }
 
@@ -121,6 +160,15 @@ func (VolumeStatus) SwaggerDoc() map[string]string {
 		"size":                      "Represents the size of the volume",
 		"memoryDumpVolume":          "If the volume is memorydump volume, this will contain the memorydump info.",
 		"containerDiskVolume":       "ContainerDiskVolume shows info about the containerdisk, if the volume is a containerdisk",
+		"guestFilesystemUsage":      "GuestFilesystemUsage is the usage of the guest filesystems on the volume, as reported by the guest agent.\nIt is reported with the VolumeAutoExpansion feature gate, for the volumes whose disks have a serial.\n+optional",
+	}
+}
+
+func (GuestFilesystemUsage) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":           "GuestFilesystemUsage sums up the guest filesystems on a volume",
+		"usedBytes":  "UsedBytes is the space used on the filesystems",
+		"totalBytes": "TotalBytes is the size of the filesystems",
 	}
 }
 
@@ -146,6 +194,56 @@ func (KernelBootStatus) SwaggerDoc() map[string]string {
 	}
 }
 
+func (FSFreezeInfo) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                          "FSFreezeInfo reports which guest filesystems are frozen and for how long they were frozen",
+		"volumes":                   "Volumes lists the volumes whose guest filesystems are frozen.\nAll the guest filesystems are frozen if it's empty.\n+optional\n+listType=atomic",
+		"freezeTimestamp":           "FreezeTimestamp is the time the guest filesystems were frozen at\n+optional",
+		"lastFreezeDurationSeconds": "LastFreezeDurationSeconds is the duration of the most recent freeze which ended\n+optional",
+	}
+}
+
+func (PauseStatus) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                          "PauseStatus reports for how long a VirtualMachineInstance was paused",
+		"lastPauseDurationSeconds":  "LastPauseDurationSeconds is the duration of the most recent pause which ended",
+		"totalPauseDurationSeconds": "TotalPauseDurationSeconds is the sum of the durations of all pauses which ended",
+	}
+}
+
+func (CgroupLayout) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":             "CgroupLayout describes the cgroups of the compute container of the virtual machine instance",
+		"version":      "Version is the version of the cgroup hierarchy on the node, v1 or v2",
+		"housekeeping": "Housekeeping is the child cgroup the housekeeping threads are moved to\n+optional",
+	}
+}
+
+func (HousekeepingCgroup) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":         "HousekeepingCgroup describes the child cgroup of the housekeeping threads",
+		"name":     "Name is the name of the child cgroup below the cgroup of the compute container",
+		"cpuSet":   "CPUSet are the CPUs the housekeeping threads are pinned to\n+optional",
+		"cpuLimit": "CPULimit is the CPU time the housekeeping threads are limited to\n+optional",
+	}
+}
+
+func (ThreadPlacementStatus) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                     "ThreadPlacementStatus reports where the emulator thread and the IOThreads of the VMI run",
+		"emulatorThreadCPUSet": "EmulatorThreadCPUSet are the host CPUs the emulator thread is pinned to\n+optional",
+		"ioThreads":            "IOThreads are the host CPUs the IOThreads are pinned to\n+optional\n+listType=atomic",
+	}
+}
+
+func (IOThreadPlacement) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":       "IOThreadPlacement reports the host CPUs an IOThread is pinned to",
+		"id":     "ID is the id of the IOThread",
+		"cpuSet": "CPUSet are the host CPUs the IOThread is pinned to",
+	}
+}
+
 func (DomainMemoryDumpInfo) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"":               "DomainMemoryDumpInfo represents the memory dump information",
@@ -153,6 +251,16 @@ func (DomainMemoryDumpInfo) SwaggerDoc() map[string]string {
 		"endTimestamp":   "EndTimestamp is the time when the memory dump completed",
 		"claimName":      "ClaimName is the name of the pvc the memory was dumped to",
 		"targetFileName": "TargetFileName is the name of the memory dump output",
+		"progress":       "Progress is the share of the guest memory dumped so far\n+optional",
+	}
+}
+
+func (MemoryDumpProgress) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                   "MemoryDumpProgress holds periodically sampled statistics of a running memory dump",
+		"dataProcessedBytes": "The amount of guest memory dumped so far",
+		"dataTotalBytes":     "The total amount of guest memory to be dumped",
+		"percentComplete":    "The share of the guest memory dumped so far, from 0 to 100",
 	}
 }
 
@@ -193,7 +301,7 @@ func (VirtualMachineInstanceNetworkInterface) SwaggerDoc() map[string]string {
 		"ipAddresses":      "List of all IP addresses of a Virtual Machine interface",
 		"podInterfaceName": "PodInterfaceName represents the name of the pod network interface",
 		"interfaceName":    "The interface name inside the Virtual Machine",
-		"infoSource":       "Specifies the origin of the interface data collected. values: domain, guest-agent, multus-status.",
+		"infoSource":       "Specifies the origin of the interface data collected. values: domain, guest-agent, multus-status, arp-snooping.",
 		"queueCount":       "Specifies how many queues are allocated by MultiQueue",
 		"linkState":        "LinkState Reports the current operational link state`. values: up, down.",
 	}
@@ -209,6 +317,7 @@ func (VirtualMachineInstanceGuestOSInfo) SwaggerDoc() map[string]string {
 		"kernelVersion": "Kernel version of the Guest OS",
 		"machine":       "Machine type of the Guest OS",
 		"id":            "Guest OS Id",
+		"hostname":      "Short hostname of the guest as reported by the guest agent, not its FQDN",
 	}
 }
 
@@ -238,6 +347,18 @@ func (VirtualMachineInstanceMigrationState) SwaggerDoc() map[string]string {
 		"targetNodeTopology":             "If the VMI requires dedicated CPUs, this field will\nhold the numa topology on the target node",
 		"sourcePersistentStatePVCName":   "If the VMI being migrated uses persistent features (backend-storage), its source PVC name is saved here",
 		"targetPersistentStatePVCName":   "If the VMI being migrated uses persistent features (backend-storage), its target PVC name is saved here",
+		"progress":                       "The data transfer statistics of the migration as reported by the source\n+optional",
+	}
+}
+
+func (MigrationProgress) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                     "MigrationProgress holds periodically sampled data transfer statistics of a live migration\n\n+k8s:openapi-gen=true",
+		"dataProcessedBytes":   "The amount of data transferred to the target so far",
+		"dataRemainingBytes":   "The amount of data still to be transferred to the target",
+		"dataTotalBytes":       "The total amount of data to be transferred",
+		"downtimeMilliseconds": "The expected downtime while the migration is running and the measured downtime once it completed",
+		"percentComplete":      "The share of the data transferred to the target, from 0 to 100",
 	}
 }
 
@@ -382,6 +503,47 @@ func (VirtualMachineSpec) SwaggerDoc() map[string]string {
 		"template":              "Template is the direct specification of VirtualMachineInstance",
 		"dataVolumeTemplates":   "dataVolumeTemplates is a list of dataVolumes that the VirtualMachineInstance template can reference.\nDataVolumes in this list are dynamically created for the VirtualMachine and are tied to the VirtualMachine's life-cycle.",
 		"updateVolumesStrategy": "UpdateVolumesStrategy is the strategy to apply on volumes updates",
+		"storageMigration":      "StorageMigration moves the PVC and DataVolume volumes of the VirtualMachine to another StorageClass.\nThe volumes are copied to new DataVolumes, which replace the original volumes once the copy is done.\nThe original claims are retained.\n+optional",
+		"volumeAutoExpansion":   "VolumeAutoExpansion expands the PVCs of the VirtualMachine when the guest filesystems on them are filling up.\nIt requires the VolumeAutoExpansion feature gate and a guest agent.\n+optional",
+	}
+}
+
+func (VolumeAutoExpansion) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                         "VolumeAutoExpansion configures the expansion of PVCs driven by the usage of the guest filesystems on them.\nThe guest filesystems are matched with the volumes through the serial of their disks.",
+		"volumes":                  "Volumes are the names of the PVC and DataVolume volumes which are expanded. Their disks need a serial.\n+listType=set",
+		"usageThresholdPercentage": "UsageThresholdPercentage is the usage of the guest filesystems on a volume, in percent of their size,\nabove which the PVC of the volume is expanded\n+kubebuilder:validation:Minimum=1\n+kubebuilder:validation:Maximum=99",
+		"incrementPercentage":      "IncrementPercentage is by how much, in percent of its capacity, a PVC is expanded. Defaults to 20.\n+kubebuilder:validation:Minimum=1\n+optional",
+		"maxSize":                  "MaxSize is the size PVCs are not expanded beyond\n+optional",
+	}
+}
+
+func (VirtualMachineStorageMigration) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"storageClassName": "StorageClassName is the StorageClass the volumes are migrated to",
+		"volumes":          "Volumes restricts the migration to the named volumes. By default all the PVC and DataVolume\nvolumes whose claims are not in the target StorageClass are migrated.\n+listType=atomic\n+optional",
+		"method":           "Method is the way the volumes are copied. BlockCopy requires the VirtualMachine to run,\nCDI requires it to be stopped. Defaults to BlockCopy if the VirtualMachine runs and to CDI otherwise.\n+kubebuilder:validation:Enum=BlockCopy;CDI\n+optional",
+	}
+}
+
+func (VirtualMachineStorageMigrationStatus) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"storageClassName": "StorageClassName is the StorageClass the volumes are migrated to",
+		"method":           "Method is the way the volumes are copied",
+		"phase":            "Phase of the storage migration",
+		"message":          "Message explains why the storage migration is pending or failed\n+optional",
+		"volumes":          "Volumes lists the migrated volumes and their progress\n+listType=atomic\n+optional",
+		"startTimestamp":   "+optional",
+		"endTimestamp":     "+optional",
+	}
+}
+
+func (VirtualMachineStorageMigrationVolumeStatus) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"volumeName":      "VolumeName is the name of the volume in the VirtualMachine",
+		"sourceClaim":     "SourceClaim is the claim the volume is migrated from",
+		"targetClaim":     "TargetClaim is the DataVolume the volume is migrated to",
+		"percentComplete": "PercentComplete is the progress of the copy of the volume\n+optional",
 	}
 }
 
@@ -409,6 +571,7 @@ func (VirtualMachineStatus) SwaggerDoc() map[string]string {
 		"desiredGeneration":      "DesiredGeneration is the generation which is desired for the VMI.\nThis will be used in comparisons with ObservedGeneration to understand when\nthe VMI is out of sync. This will be changed at the same time as\nObservedGeneration to remove errors which could occur if Generation is\nupdated through an Update() before ObservedGeneration in Status.\n+optional",
 		"runStrategy":            "RunStrategy tracks the last recorded RunStrategy used by the VM.\nThis is needed to correctly process the next strategy (for now only the RerunOnFailure)",
 		"volumeUpdateState":      "VolumeUpdateState contains the information about the volumes set\nupdates related to the volumeUpdateStrategy",
+		"storageMigration":       "StorageMigration tracks the migration of the volumes to the StorageClass requested in the spec\n+nullable\n+optional",
 		"instancetypeRef":        "InstancetypeRef captures the state of any referenced instance type from the VirtualMachine\n+nullable\n+optional",
 		"preferenceRef":          "PreferenceRef captures the state of any referenced preference from the VirtualMachine\n+nullable\n+optional",
 	}
@@ -591,8 +754,40 @@ func (GenerationStatus) SwaggerDoc() map[string]string {
 
 func (KubeVirtStatus) SwaggerDoc() map[string]string {
 	return map[string]string{
-		"":            "KubeVirtStatus represents information pertaining to a KubeVirt deployment.",
-		"generations": "+listType=atomic",
+		"":                "KubeVirtStatus represents information pertaining to a KubeVirt deployment.",
+		"generations":     "+listType=atomic",
+		"fleetSummary":    "FleetSummary aggregates the state of the workloads and nodes managed by KubeVirt\n+optional",
+		"nodeEvacuations": "NodeEvacuations reports the progress of the nodes which are being evacuated\n+optional\n+listType=map\n+listMapKey=node",
+	}
+}
+
+func (KubeVirtFleetSummary) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                        "KubeVirtFleetSummary aggregates the state of the workloads and nodes managed by KubeVirt, as observed by\nvirt-controller",
+		"virtualMachines":         "VirtualMachines is the number of VirtualMachines by run strategy\n+optional",
+		"virtualMachineInstances": "VirtualMachineInstances is the number of VirtualMachineInstances by phase\n+optional",
+		"migrationsInFlight":      "MigrationsInFlight is the number of VirtualMachineInstanceMigrations which did not finish yet",
+		"schedulableNodes":        "SchedulableNodes is the number of nodes VirtualMachineInstances can be scheduled on",
+		"pendingActions":          "PendingActions lists the actions which are outstanding before the fleet is up to date\n+optional\n+listType=map\n+listMapKey=reason",
+		"lastUpdateTime":          "LastUpdateTime is the time the summary was aggregated at",
+	}
+}
+
+func (KubeVirtPendingAction) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"": "KubeVirtPendingAction counts the workloads waiting for the same action",
+	}
+}
+
+func (NodeEvacuationStatus) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                "NodeEvacuationStatus reports the VirtualMachineInstances which still have to leave a node that is being evacuated,\nas observed by virt-controller",
+		"node":            "Node is the name of the node which is being evacuated",
+		"pending":         "Pending is the number of VirtualMachineInstances waiting for an evacuation migration",
+		"migrating":       "Migrating is the number of VirtualMachineInstances with an evacuation migration in flight",
+		"notMigratable":   "NotMigratable is the number of VirtualMachineInstances which can't be live migrated and block the evacuation",
+		"remainingMemory": "RemainingMemory is the guest memory of all the VirtualMachineInstances which still have to leave the node\n+optional",
+		"lastUpdateTime":  "LastUpdateTime is the time the progress was observed at",
 	}
 }
 
@@ -634,6 +829,15 @@ func (UnpauseOptions) SwaggerDoc() map[string]string {
 	}
 }
 
+func (SetLinkStateOptions) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":       "SetLinkStateOptions is provided when setting the link state of an interface of a running VMI.",
+		"name":   "Name of the interface, which is the name of the network it is connected to",
+		"state":  "State is the link state of the interface, up or down",
+		"dryRun": "When present, indicates that modifications should not be\npersisted. An invalid or unrecognized dryRun directive will\nresult in an error response and no further processing of the\nrequest. Valid values are:\n- All: all dry run stages will be processed\n+optional\n+listType=atomic",
+	}
+}
+
 func (StopOptions) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"":            "StopOptions may be provided when deleting an API object.",
@@ -650,6 +854,30 @@ func (MigrateOptions) SwaggerDoc() map[string]string {
 	}
 }
 
+func (MigrationCancelOptions) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":       "MigrationCancelOptions may be provided on cancel request.",
+		"reason": "Reason is recorded in the message of the migrationCancelRequested condition of the migration\n+optional",
+	}
+}
+
+func (VirtualMachineInstanceMigrationFeasibility) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":            "VirtualMachineInstanceMigrationFeasibility reports whether a VirtualMachineInstance can currently be live migrated\n\n+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object",
+		"migratable":  "Migratable is true if nothing blocks the live migration of the VirtualMachineInstance",
+		"blockers":    "Blockers are the reasons preventing the live migration\n+optional\n+listType=atomic",
+		"targetNodes": "TargetNodes are the nodes the VirtualMachineInstance can be migrated to\n+optional\n+listType=atomic",
+	}
+}
+
+func (MigrationBlocker) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":        "MigrationBlocker is a reason preventing the live migration of a VirtualMachineInstance",
+		"reason":  "Reason is the machine readable reason, e.g. the reason of the LiveMigratable condition",
+		"message": "Message is the human readable description of the blocker",
+	}
+}
+
 func (VirtualMachineInstanceGuestAgentInfo) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"":                  "VirtualMachineInstanceGuestAgentInfo represents information from the installed guest agent\n\n+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object",
@@ -708,7 +936,8 @@ func (VirtualMachineInstanceFileSystem) SwaggerDoc() map[string]string {
 
 func (FreezeUnfreezeTimeout) SwaggerDoc() map[string]string {
 	return map[string]string{
-		"": "FreezeUnfreezeTimeout represent the time unfreeze will be triggered if guest was not unfrozen by unfreeze command",
+		"":        "FreezeUnfreezeTimeout represent the time unfreeze will be triggered if guest was not unfrozen by unfreeze command",
+		"volumes": "Volumes lists the volumes whose guest filesystems should be frozen.\nThe disks of the volumes need a serial to identify their filesystems in the guest.\nAll the guest filesystems are frozen if it's empty.\n+optional\n+listType=atomic",
 	}
 }
 
@@ -722,6 +951,8 @@ func (VirtualMachineMemoryDumpRequest) SwaggerDoc() map[string]string {
 		"endTimestamp":   "EndTimestamp represents the time the memory dump was completed\n+optional",
 		"fileName":       "FileName represents the name of the output file\n+optional",
 		"message":        "Message is a detailed message about failure of the memory dump\n+optional",
+		"compression":    "Compression compresses the memory dump while it is written to the pvc. The compressed dump does not\nhave to fit the memory of the vm, the pvc size is therefore not validated. Defaults to no compression\n+kubebuilder:validation:Enum=gzip;zstd\n+optional",
+		"chunkSize":      "ChunkSize is the amount of the dump which is written to the pvc before it is flushed and dropped from the\npage cache of the virt-launcher pod. Defaults to 64Mi if the dump is compressed, otherwise the dump is\nwritten by the hypervisor directly\n+optional",
 	}
 }
 
@@ -743,6 +974,14 @@ func (VSOCKOptions) SwaggerDoc() map[string]string {
 	return map[string]string{}
 }
 
+func (PacketCaptureOptions) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":              "PacketCaptureOptions is provided when capturing the traffic of a VirtualMachineInstance interface",
+		"interfaceName": "InterfaceName is the name of the interface, as defined in the VMI spec, to capture the traffic of",
+		"duration":      "Duration limits the capture, otherwise it lasts until the connection is closed\n+optional",
+	}
+}
+
 func (RemoveVolumeOptions) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"":       "RemoveVolumeOptions is provided when dynamically hot unplugging volume and disk",
@@ -793,12 +1032,153 @@ func (KubeVirtConfiguration) SwaggerDoc() map[string]string {
 		"vmRolloutStrategy":                  "VMRolloutStrategy defines how live-updatable fields, like CPU sockets, memory,\ntolerations, and affinity, are propagated from a VM to its VMI.\n+nullable\n+kubebuilder:validation:Enum=Stage;LiveUpdate",
 		"commonInstancetypesDeployment":      "CommonInstancetypesDeployment controls the deployment of common-instancetypes resources\n+nullable",
 		"instancetype":                       "Instancetype configuration\n+nullable",
+		"startConfiguration":                 "StartConfiguration limits how many VirtualMachineInstances are allowed to start at the same time\n+nullable",
+		"canaryConfiguration":                "CanaryConfiguration enables a canary which periodically creates, boots, probes and deletes a small\nVirtualMachineInstance and reports the duration and the result of each phase as metrics\n+nullable",
+		"placementHints":                     "PlacementHints configures an external placement service which virt-controller consults for preferred\nand forbidden nodes when it creates the pod of a starting VirtualMachineInstance or a migration target pod\n+nullable",
+		"cpuFairShare":                       "CPUFairShare weights the CPU time of the virt-launcher pods by the tier of their namespace,\nso that VirtualMachineInstances of higher tiers get more CPU time when a node is CPU saturated\n+nullable",
+		"dedicatedCPUPools":                  "DedicatedCPUPools reserve host CPUs of the nodes for the VirtualMachineInstances with dedicated CPU placement,\non nodes where the CPU manager policy of the kubelet is not static\n+optional\n+listType=map\n+listMapKey=name",
+		"swapConfiguration":                  "SwapConfiguration lets virt-handler give the overcommitted VirtualMachineInstances access to the swap of the nodes\n+optional",
+		"hugepagesPools":                     "HugepagesPools let virt-handler allocate hugepages on the nodes at runtime, so that VirtualMachineInstances\nbacked by hugepages can run without reserving the hugepages on the kernel command line\n+optional\n+listType=map\n+listMapKey=name",
+	}
+}
+
+func (PlacementHintsConfiguration) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":              "PlacementHintsConfiguration holds the settings of the external placement service.\nvirt-controller POSTs a PlacementHintsRequest to the service and expects a PlacementHintsResponse.\nThe preferred nodes are added as preferred and the forbidden nodes as required node affinity to the pod.",
+		"url":           "URL is the http or https endpoint of the placement service",
+		"caBundle":      "CABundle is the PEM encoded CA bundle used to verify the certificate of the placement service.\nThe system trust store is used if empty\n+optional",
+		"timeout":       "Timeout is the time the placement service has to answer. Defaults to 2s\n+optional",
+		"failurePolicy": "FailurePolicy defines how a failed request or an invalid response is handled.\nIgnore creates the pod without hints, Fail retries later without creating the pod. Defaults to Ignore\n+optional\n+kubebuilder:validation:Enum=Ignore;Fail",
+	}
+}
+
+func (PlacementHintsRequest) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":              "PlacementHintsRequest is sent to the placement service",
+		"operation":     "Operation tells if the pod is created to start or to migrate the VirtualMachineInstance",
+		"namespace":     "Namespace of the VirtualMachineInstance",
+		"name":          "Name of the VirtualMachineInstance",
+		"uid":           "UID of the VirtualMachineInstance",
+		"labels":        "Labels of the VirtualMachineInstance\n+optional",
+		"sourceNode":    "SourceNode is the node a migrating VirtualMachineInstance runs on\n+optional",
+		"migrationName": "MigrationName is the name of the migration the target pod is created for\n+optional",
+	}
+}
+
+func (PlacementHintsResponse) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":               "PlacementHintsResponse is returned by the placement service",
+		"preferredNodes": "PreferredNodes are the nodes the pod should be scheduled on, by weight\n+optional\n+listType=atomic",
+		"forbiddenNodes": "ForbiddenNodes are the nodes the pod must not be scheduled on\n+optional\n+listType=atomic",
+	}
+}
+
+func (PreferredNode) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":       "PreferredNode is a node preferred by the placement service",
+		"node":   "Node is the name of the node",
+		"weight": "Weight of the preference, in the range 1-100",
+	}
+}
+
+func (DedicatedCPUPool) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":             "DedicatedCPUPool is a set of host CPUs reserved on the nodes matching its node selector.\nvirt-handler pins the virt-launcher pods of the VirtualMachineInstances with dedicated CPU placement to exclusive\nCPUs of the pool, and keeps the burstable and best-effort pods off the pool.",
+		"name":         "Name of the pool, the nodes are labeled with the name of their pool",
+		"nodeSelector": "NodeSelector selects the nodes the pool is reserved on, the first pool matching a node is used.\nThe pool is reserved on all the nodes if it is empty\n+optional",
+		"cpus":         "CPUs of the pool, in the cpuset list format, e.g. 4-15,20-23",
+	}
+}
+
+func (HugepagesPool) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":             "HugepagesPool is a number of hugepages of a size allocated on the nodes matching its node selector.\nvirt-handler allocates the hugepages at runtime, reports the provisioning in the annotations of the nodes,\nand frees the hugepages of a size once no pool of that size selects the node anymore.",
+		"name":         "Name of the pool",
+		"nodeSelector": "NodeSelector selects the nodes the hugepages are allocated on, the first pool of a page size matching\na node is used. The hugepages are allocated on all the nodes if it is empty\n+optional",
+		"pageSize":     "PageSize of the hugepages, e.g. 2Mi or 1Gi",
+		"count":        "Count is the number of hugepages allocated on each node",
+	}
+}
+
+func (SwapConfiguration) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                              "SwapConfiguration lets virt-handler give the virt-launcher pods of the overcommitted VMIs access to the swap of\nthe nodes, so that the guest memory above their memory request is swapped out under memory pressure instead of\ngetting them OOM-killed. The nodes need swap enabled and cgroup v2.",
+		"nodeSelector":                  "NodeSelector selects the nodes on which the swap of the VMIs is managed.\nAll the nodes are selected if it is empty\n+optional",
+		"evictionSwapUsedPercent":       "EvictionSwapUsedPercent is the percentage of the swap of a node in use above which the overcommitted VMI\nusing the most swap is evacuated from the node. The eviction on swap usage is disabled if it is unset\n+optional",
+		"evictionMemoryPressurePercent": "EvictionMemoryPressurePercent is the percentage of the last 10 seconds during which all the tasks of a node were\nstalled on memory, above which the overcommitted VMI using the most swap is evacuated from the node.\nThe eviction on memory pressure is disabled if it is unset\n+optional",
+	}
+}
+
+func (CPUFairShareConfiguration) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":      "CPUFairShareConfiguration holds the CPU tiers of the namespaces.\nvirt-handler sets the cgroup cpu.weight of the virt-launcher pods to the weight of the tier of their namespace,\npods in namespaces without a tier keep the weight assigned by the kubelet.",
+		"tiers": "Tiers are the CPU tiers, a namespace should only be part of one tier\n+optional\n+listType=map\n+listMapKey=name",
+	}
+}
+
+func (CPUFairShareTier) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":           "CPUFairShareTier is a group of namespaces sharing the same CPU weight",
+		"name":       "Name of the tier",
+		"weight":     "Weight is the cgroup v2 cpu.weight of the virt-launcher pods of the tier, in the range 1-10000.\nThe kubelet derives a weight of about 40 per requested CPU\n+kubebuilder:validation:Minimum=1\n+kubebuilder:validation:Maximum=10000",
+		"namespaces": "Namespaces of the tier\n+optional\n+listType=set",
+	}
+}
+
+func (CanaryConfiguration) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":               "CanaryConfiguration holds the settings of the canary VirtualMachineInstance.\nA single canary run happens at a time, a new run starts once the interval passed since the start of the last one.",
+		"image":          "Image is the containerDisk image booted by the canary VirtualMachineInstance",
+		"namespace":      "Namespace is the namespace the canary VirtualMachineInstance is created in.\nDefaults to the namespace KubeVirt is installed in\n+optional",
+		"interval":       "Interval is the time between the starts of two canary runs. Defaults to 10m\n+optional",
+		"timeout":        "Timeout is the time the canary VirtualMachineInstance has to become ready before the run fails. Defaults to 5m\n+optional",
+		"memory":         "Memory is the guest memory of the canary VirtualMachineInstance. Defaults to 128Mi\n+optional",
+		"nodeSelector":   "NodeSelector restricts the nodes the canary VirtualMachineInstance is scheduled on\n+optional",
+		"readinessProbe": "ReadinessProbe has to succeed before the canary VirtualMachineInstance counts as ready.\nWithout a probe the canary is ready once it is running\n+optional",
+	}
+}
+
+func (StartConfiguration) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                           "StartConfiguration holds the limits on concurrently starting VirtualMachineInstances.\nA VirtualMachineInstance is starting from the moment its launcher pod is created until it is running,\nexcept while the scheduler reports its launcher pod as unschedulable.\nVirtualMachineInstances exceeding a limit wait in a first-in first-out queue.",
+		"parallelStartsPerCluster":   "ParallelStartsPerCluster is the maximum number of VirtualMachineInstances\nstarting at the same time cluster-wide. Unlimited if not set\n+optional",
+		"parallelStartsPerNamespace": "ParallelStartsPerNamespace is the maximum number of VirtualMachineInstances\nstarting at the same time in a single namespace. Unlimited if not set\n+optional",
+		"volumePreparationLimits":    "VolumePreparationLimits limits the number of DataVolumes of a storage class which are\ncloned, imported or resized at the same time before their VirtualMachines can start\n+optional\n+listType=atomic",
+	}
+}
+
+func (VolumePreparationLimit) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                           "VolumePreparationLimit holds the limit on concurrent volume preparations for a storage class.\nDataVolumes of VirtualMachines and PVC expansions exceeding the limit wait until a preparation finishes.",
+		"storageClassName":           "StorageClassName is the name of the storage class the limit applies to",
+		"parallelVolumePreparations": "ParallelVolumePreparations is the maximum number of volumes of the storage class\nwhich are cloned, imported or resized at the same time",
 	}
 }
 
 func (InstancetypeConfiguration) SwaggerDoc() map[string]string {
 	return map[string]string{
-		"referencePolicy": "ReferencePolicy defines how an instance type or preference should be referenced by the VM after submission, supported values are:\nreference (default) - Where a copy of the original object is stashed in a ControllerRevision and referenced by the VM.\nexpand - Where the instance type or preference are expanded into the VM if no revisionNames have been populated.\nexpandAll - Where the instance type or preference are expanded into the VM regardless of revisionNames previously being populated.\n+nullable\n+kubebuilder:validation:Enum=reference;expand;expandAll",
+		"referencePolicy":         "ReferencePolicy defines how an instance type or preference should be referenced by the VM after submission, supported values are:\nreference (default) - Where a copy of the original object is stashed in a ControllerRevision and referenced by the VM.\nexpand - Where the instance type or preference are expanded into the VM if no revisionNames have been populated.\nexpandAll - Where the instance type or preference are expanded into the VM regardless of revisionNames previously being populated.\n+nullable\n+kubebuilder:validation:Enum=reference;expand;expandAll",
+		"revisionUpgradePolicy":   "RevisionUpgradePolicy defines if VMs referencing an outdated ControllerRevision of an instance type are moved to the latest version, supported values are:\nmanual (default) - Where the VM keeps its ControllerRevision until it is changed by the user.\nonRestart - Where the VM is moved to a ControllerRevision of the latest version of the instance type while it is not running, so it is used at the next start.\nThe policy can be overridden per VM with the instancetype.kubevirt.io/revision-upgrade-policy annotation.\n+nullable\n+kubebuilder:validation:Enum=manual;onRestart",
+		"nodeCompatibilityPolicy": "NodeCompatibilityPolicy defines how VMs are admitted when no node provides the hugepages, dedicated CPUs, GPUs or host devices of their instance type, supported values are:\nignore (default) - Where the VM is admitted without looking at the nodes.\nwarn - Where the VM is admitted with a warning.\nreject - Where the VM is rejected.\n+nullable\n+kubebuilder:validation:Enum=ignore;warn;reject",
+		"namespacePolicies":       "NamespacePolicies restrict which cluster instance types and cluster preferences the VMs of the selected namespaces may reference.\nIf policies selecting a namespace list cluster instance types, VMs in the namespace may only reference the cluster instance types listed by any of them, the same applies to cluster preferences.\nNamespaced instance types and preferences are not restricted.\n+optional\n+listType=atomic",
+		"rightsizing":             "Rightsizing enables the recommendation of cluster instance types from the observed usage of the VMs.\nThe recommendation is set in the instancetype.kubevirt.io/recommended-instancetype annotation of the VMs.\n+optional",
+	}
+}
+
+func (InstancetypeRightsizing) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":              "InstancetypeRightsizing configures where the usage of the VMs is read from and how long it is observed",
+		"prometheusURL": "PrometheusURL is the address of the Prometheus API the kubevirt_vmi_vcpu_seconds_total and kubevirt_vmi_memory_used_bytes metrics are queried from",
+		"window":        "Window is the period the usage of the VMs is observed over, defaults to 24h\n+optional",
+	}
+}
+
+func (InstancetypeNamespacePolicy) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                     "InstancetypeNamespacePolicy allows the VMs of the selected namespaces to reference the listed cluster instance types and cluster preferences",
+		"namespaceSelector":    "NamespaceSelector selects the namespaces the policy applies to, an empty selector selects all namespaces",
+		"clusterInstancetypes": "ClusterInstancetypes lists the names of the VirtualMachineClusterInstancetypes the VMs may reference\n+optional\n+listType=set",
+		"clusterPreferences":   "ClusterPreferences lists the names of the VirtualMachineClusterPreferences the VMs may reference\n+optional\n+listType=set",
 	}
 }
 
@@ -877,17 +1257,38 @@ func (MigrationConfiguration) SwaggerDoc() map[string]string {
 		"parallelMigrationsPerCluster":      "ParallelMigrationsPerCluster is the total number of concurrent live migrations\nallowed cluster-wide. Defaults to 5",
 		"allowAutoConverge":                 "AllowAutoConverge allows the platform to compromise performance/availability of VMIs to\nguarantee successful VMI live migrations. Defaults to false",
 		"bandwidthPerMigration":             "BandwidthPerMigration limits the amount of network bandwidth live migrations are allowed to use.\nThe value is in quantity per second. Defaults to 0 (no limit)",
+		"bandwidthAutoTuning":               "BandwidthAutoTuning lets virt-controller derive the bandwidth of each migration from the number of\nconcurrent outbound migrations and the network utilization which virt-handler reports for the source node.\nBandwidthPerMigration, if set, remains the upper limit. Defaults to no auto-tuning",
 		"completionTimeoutPerGiB":           "CompletionTimeoutPerGiB is the maximum number of seconds per GiB a migration is allowed to take.\nIf the timeout is reached, the migration will be either paused, switched\nto post-copy or cancelled depending on other settings. Defaults to 150",
 		"progressTimeout":                   "ProgressTimeout is the maximum number of seconds a live migration is allowed to make no progress.\nHitting this timeout means a migration transferred 0 data for that many seconds. The migration is\nthen considered stuck and therefore cancelled. Defaults to 150",
 		"unsafeMigrationOverride":           "UnsafeMigrationOverride allows live migrations to occur even if the compatibility check\nindicates the migration will be unsafe to the guest. Defaults to false",
 		"allowPostCopy":                     "AllowPostCopy enables post-copy live migrations. Such migrations allow even the busiest VMIs\nto successfully live-migrate. However, events like a network failure can cause a VMI crash.\nIf set to true, migrations will still start in pre-copy, but switch to post-copy when\nCompletionTimeoutPerGiB triggers. Defaults to false",
 		"allowWorkloadDisruption":           "AllowWorkloadDisruption indicates that the migration shouldn't be\ncanceled after acceptableCompletionTime is exceeded. Instead, if\npermitted, migration will be switched to post-copy or the VMI will be\npaused to allow the migration to complete",
+		"maxDowntimeMilliseconds":           "MaxDowntimeMilliseconds is the maximum number of milliseconds the guest may be paused to switch over to the\ntarget at the end of a pre-copy migration. Larger values let migrations of busy VMIs converge sooner.\nDefaults to the hypervisor default (300)",
+		"postCopyAfterIterations":           "PostCopyAfterIterations switches a migration to post-copy once the guest memory has been copied that many\ntimes without the migration completing, instead of waiting for CompletionTimeoutPerGiB.\nOnly applies if AllowPostCopy and AllowWorkloadDisruption are set. Defaults to 0 (disabled)",
+		"parallelMigrationChannels":         "ParallelMigrationChannels is the number of multifd channels used to transfer the guest memory of a live\nmigration. A value of 1 migrates over a single connection. Post-copy migrations and VMIs with a CPU limit\nalways use a single connection. Defaults to 8",
+		"compression":                       "Compression compresses the guest memory sent over the multifd channels of a live migration.\nIt is ignored for migrations over a single connection. Defaults to no compression",
 		"disableTLS":                        "When set to true, DisableTLS will disable the additional layer of live migration encryption\nprovided by KubeVirt. This is usually a bad idea. Defaults to false",
 		"network":                           "Network is the name of the CNI network to use for live migrations. By default, migrations go\nthrough the pod network.",
 		"matchSELinuxLevelOnMigration":      "By default, the SELinux level of target virt-launcher pods is forced to the level of the source virt-launcher.\nWhen set to true, MatchSELinuxLevelOnMigration lets the CRI auto-assign a random level to the target.\nThat will ensure the target virt-launcher doesn't share categories with another pod on the node.\nHowever, migrations will fail when using RWX volumes that don't automatically deal with SELinux levels.",
 	}
 }
 
+func (MigrationBandwidthAutoTuning) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                         "MigrationBandwidthAutoTuning defines how the bandwidth of live migrations is tuned to the network of the source node",
+		"minBandwidthPerMigration": "MinBandwidthPerMigration is the lowest bandwidth a migration is limited to, even if the network of the\nsource node is saturated. The value is in quantity per second. Defaults to 0 (no lower limit)\n+optional",
+		"targetUtilizationPercent": "TargetUtilizationPercent is the share of the migration network capacity of the source node which may be\nused in total. Migrations use whatever is left by the other traffic. Defaults to 80\n+kubebuilder:validation:Minimum=1\n+kubebuilder:validation:Maximum=100\n+optional",
+	}
+}
+
+func (MigrationCompression) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":       "MigrationCompression defines the compression of the guest memory sent by multifd live migrations",
+		"method": "Method is the compression method. One of zlib or zstd\n+kubebuilder:validation:Enum=zlib;zstd",
+		"level":  "Level is the compression level. Ranges from 1 to 9 for zlib and from 1 to 20 for zstd.\nDefaults to the hypervisor default of the method\n+optional",
+	}
+}
+
 func (DiskVerification) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"": "DiskVerification holds container disks verification limits",
@@ -922,6 +1323,15 @@ func (PermittedHostDevices) SwaggerDoc() map[string]string {
 		"pciHostDevices":  "+listType=atomic",
 		"mediatedDevices": "+listType=atomic",
 		"usb":             "+listType=atomic",
+		"usbPassthrough":  "USBPassthrough lists the USB devices of the nodes which the usbDevices of VMIs may select.\nHubs can not be passed through.\n+listType=atomic",
+	}
+}
+
+func (USBPassthroughHostDevice) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":              "USBPassthroughHostDevice permits the USB devices matching all of its set selectors to be passed through.\nAt least one of the selectors has to be set.",
+		"vendorProduct": "VendorProduct permits the devices with the vendor_id:product_id tuple, e.g. 1050:0407\n+optional",
+		"busPort":       "BusPort permits the device plugged into the port, by the bus number and port path the kernel\nnames the device after, e.g. 1-2.3\n+optional",
 	}
 }
 
@@ -967,13 +1377,36 @@ func (NodeMediatedDeviceTypesConfig) SwaggerDoc() map[string]string {
 		"nodeSelector":         "NodeSelector is a selector which must be true for the vmi to fit on a node.\nSelector which must match a node's labels for the vmi to be scheduled on that node.\nMore info: https://kubernetes.io/docs/concepts/configuration/assign-pod-node/",
 		"mediatedDevicesTypes": "Deprecated. Use mediatedDeviceTypes instead.\n+optional\n+listType=atomic",
 		"mediatedDeviceTypes":  "+optional\n+listType=atomic",
+		"mediatedDeviceCounts": "MediatedDeviceCounts sets how many instances of some of the mediatedDeviceTypes are created on the node.\nTypes without a count get all the instances their parent devices can provide.\n+optional\n+listType=atomic",
+	}
+}
+
+func (MediatedDeviceTypeCount) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":      "MediatedDeviceTypeCount sets the number of instances of an mdev type created on a node.\n+k8s:openapi-gen=true",
+		"type":  "Type is the name or the ID of the mdev type, as listed in mediatedDeviceTypes.",
+		"count": "Count is the number of instances of the type to create on the node.",
 	}
 }
 
 func (KSMConfiguration) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"":                  "KSMConfiguration holds information about KSM.\n+k8s:openapi-gen=true",
-		"nodeLabelSelector": "NodeLabelSelector is a selector that filters in which nodes the KSM will be enabled.\nEmpty NodeLabelSelector will enable ksm for every node.\n+optional",
+		"nodeLabelSelector": "NodeLabelSelector is a selector that filters in which nodes the KSM will be enabled.\nEmpty NodeLabelSelector will enable ksm for every node.\nThe kubevirt.io/ksm-policy label of a node, enabled or disabled, takes precedence over it.\n+optional",
+		"tuning":            "Tuning holds the parameters of the KSM scanning on the nodes.\nThe KSM override annotations of a node take precedence over them.\n+optional",
+	}
+}
+
+func (KSMTuning) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                "KSMTuning holds the parameters virt-handler uses to adjust the KSM scanning to the memory pressure of the node.\nWhile the node is under memory pressure, the pages to scan start at PagesInit and grow by PagesBoost on every\nheartbeat up to PagesMax. Without pressure, they shrink by PagesDecay down to PagesMin, where KSM stops.\n+k8s:openapi-gen=true",
+		"pagesBoost":      "PagesBoost is the number of pages to scan added on every heartbeat under memory pressure. Defaults to 300.\n+optional",
+		"pagesDecay":      "PagesDecay is the number of pages to scan added on every heartbeat without memory pressure,\nit can not be positive. Defaults to -50.\n+optional",
+		"pagesMin":        "PagesMin is the minimum number of pages to scan. Defaults to 64.\n+optional",
+		"pagesMax":        "PagesMax is the maximum number of pages to scan. Defaults to 1250.\n+optional",
+		"pagesInit":       "PagesInit is the number of pages to scan when KSM starts. Defaults to 100.\n+optional",
+		"sleepMsBaseline": "SleepMsBaseline is the time KSM sleeps between scans on a node with 16GiB of used memory, in milliseconds.\nThe sleep time is scaled down the more memory is used. Defaults to 100.\n+optional",
+		"freePercent":     "FreePercent is the percentage of available memory under which the node is under memory pressure.\nDefaults to 20.\n+optional",
 	}
 }
 
@@ -981,6 +1414,31 @@ func (NetworkConfiguration) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"":                     "NetworkConfiguration holds network options",
 		"permitSlirpInterface": "DeprecatedPermitSlirpInterface is an alias for the deprecated PermitSlirpInterface.\nDeprecated: Removed in v1.3.",
+		"macAddressPool":       "MacAddressPool holds the ranges the MAC addresses of VirtualMachine interfaces are allocated from.\nIt requires the MacAddressPool feature gate.\n+optional",
+	}
+}
+
+func (MacAddressPoolConfiguration) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":           "MacAddressPoolConfiguration holds the ranges of the built-in MAC address pool.\nVirtualMachine interfaces without a MAC address get one from the ranges of their namespace on creation.",
+		"ranges":     "Ranges are the MAC address ranges of the namespaces without dedicated ranges\n+listType=atomic\n+optional",
+		"namespaces": "Namespaces holds the MAC address ranges dedicated to namespaces\n+listType=atomic\n+optional",
+	}
+}
+
+func (MacAddressRange) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":      "MacAddressRange is an inclusive range of MAC addresses",
+		"start": "Start is the first MAC address of the range, e.g. 02:00:00:00:00:00",
+		"end":   "End is the last MAC address of the range, e.g. 02:00:00:ff:ff:ff",
+	}
+}
+
+func (NamespaceMacAddressRanges) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":          "NamespaceMacAddressRanges dedicates MAC address ranges to a namespace",
+		"namespace": "Namespace is the name of the namespace",
+		"ranges":    "Ranges are the MAC address ranges of the namespace\n+listType=atomic",
 	}
 }
 
@@ -988,7 +1446,7 @@ func (InterfaceBindingPlugin) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"sidecarImage":                "SidecarImage references a container image that runs in the virt-launcher pod.\nThe sidecar handles (libvirt) domain configuration and optional services.\nversion: 1alphav1",
 		"networkAttachmentDefinition": "NetworkAttachmentDefinition references to a NetworkAttachmentDefinition CR object.\nFormat: <name>, <namespace>/<name>.\nIf namespace is not specified, VMI namespace is assumed.\nversion: 1alphav1",
-		"domainAttachmentType":        "DomainAttachmentType is a standard domain network attachment method kubevirt supports.\nSupported values: \"tap\", \"managedTap\" (since v1.4).\nThe standard domain attachment can be used instead or in addition to the sidecarImage.\nversion: 1alphav1",
+		"domainAttachmentType":        "DomainAttachmentType is a standard domain network attachment method kubevirt supports.\nSupported values: \"tap\", \"managedTap\" (since v1.4), \"vdpa\" (since v1.6).\nThe standard domain attachment can be used instead or in addition to the sidecarImage.\nversion: 1alphav1",
 		"migration":                   "Migration means the VM using the plugin can be safely migrated\nversion: 1alphav1",
 		"downwardAPI":                 "DownwardAPI specifies what kind of data should be exposed to the binding plugin sidecar.\nSupported values: \"device-info\"\nversion: v1alphav1\n+optional",
 		"computeResourceOverhead":     "ComputeResourceOverhead specifies the resource overhead that should be added to the compute container when using the binding.\nversion: v1alphav1\n+optional",
@@ -53,3 +53,9 @@ const (
 	ControllerRevisionObjectUIDLabel        = "instancetype.kubevirt.io/object-uid"
 	ControllerRevisionObjectVersionLabel    = "instancetype.kubevirt.io/object-version"
 )
+
+// RevisionUpgradePolicyAnnotation overrides the cluster wide RevisionUpgradePolicy for a VirtualMachine
+const RevisionUpgradePolicyAnnotation = "instancetype.kubevirt.io/revision-upgrade-policy"
+
+// RecommendedInstancetypeAnnotation is set on a VirtualMachine to the VirtualMachineClusterInstancetype fitting its observed usage
+const RecommendedInstancetypeAnnotation = "instancetype.kubevirt.io/recommended-instancetype"
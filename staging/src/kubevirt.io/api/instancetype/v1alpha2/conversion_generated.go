@@ -245,6 +245,7 @@ func autoConvert_v1beta1_CPUInstancetype_To_v1alpha2_CPUInstancetype(in *v1beta1
 	}
 	out.Realtime = (*corev1.Realtime)(unsafe.Pointer(in.Realtime))
 	// WARNING: in.MaxSockets requires manual conversion: does not exist in peer-type
+	// WARNING: in.MitigatedVulnerabilities requires manual conversion: does not exist in peer-type
 	return nil
 }
 
@@ -656,6 +657,8 @@ func Convert_v1alpha2_VirtualMachineInstancetypeList_To_v1beta1_VirtualMachineIn
 func autoConvert_v1beta1_VirtualMachineInstancetypeSpec_To_v1alpha2_VirtualMachineInstancetypeSpec(in *v1beta1.VirtualMachineInstancetypeSpec, out *VirtualMachineInstancetypeSpec, s conversion.Scope) error {
 	// WARNING: in.NodeSelector requires manual conversion: does not exist in peer-type
 	// WARNING: in.SchedulerName requires manual conversion: does not exist in peer-type
+	// WARNING: in.Affinity requires manual conversion: does not exist in peer-type
+	// WARNING: in.TopologySpreadConstraints requires manual conversion: does not exist in peer-type
 	if err := Convert_v1beta1_CPUInstancetype_To_v1alpha2_CPUInstancetype(&in.CPU, &out.CPU, s); err != nil {
 		return err
 	}
@@ -794,6 +797,7 @@ func autoConvert_v1beta1_VirtualMachinePreferenceSpec_To_v1alpha2_VirtualMachine
 	// WARNING: in.Requirements requires manual conversion: does not exist in peer-type
 	// WARNING: in.Annotations requires manual conversion: does not exist in peer-type
 	// WARNING: in.PreferSpreadSocketToCoreRatio requires manual conversion: does not exist in peer-type
+	// WARNING: in.InheritFrom requires manual conversion: does not exist in peer-type
 	return nil
 }
 
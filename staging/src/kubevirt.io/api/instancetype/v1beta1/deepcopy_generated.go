@@ -22,6 +22,7 @@ limitations under the License.
 package v1beta1
 
 import (
+	corev1 "k8s.io/api/core/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 	v1 "kubevirt.io/api/core/v1"
 )
@@ -59,6 +60,11 @@ func (in *CPUInstancetype) DeepCopyInto(out *CPUInstancetype) {
 		*out = new(uint32)
 		**out = **in
 	}
+	if in.MitigatedVulnerabilities != nil {
+		in, out := &in.MitigatedVulnerabilities, &out.MitigatedVulnerabilities
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -383,6 +389,22 @@ func (in *MemoryPreferenceRequirement) DeepCopy() *MemoryPreferenceRequirement {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PreferenceReference) DeepCopyInto(out *PreferenceReference) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PreferenceReference.
+func (in *PreferenceReference) DeepCopy() *PreferenceReference {
+	if in == nil {
+		return nil
+	}
+	out := new(PreferenceReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PreferenceRequirements) DeepCopyInto(out *PreferenceRequirements) {
 	*out = *in
@@ -625,6 +647,18 @@ func (in *VirtualMachineInstancetypeSpec) DeepCopyInto(out *VirtualMachineInstan
 			(*out)[key] = val
 		}
 	}
+	if in.Affinity != nil {
+		in, out := &in.Affinity, &out.Affinity
+		*out = new(corev1.Affinity)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TopologySpreadConstraints != nil {
+		in, out := &in.TopologySpreadConstraints, &out.TopologySpreadConstraints
+		*out = make([]corev1.TopologySpreadConstraint, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	in.CPU.DeepCopyInto(&out.CPU)
 	in.Memory.DeepCopyInto(&out.Memory)
 	if in.GPUs != nil {
@@ -789,6 +823,11 @@ func (in *VirtualMachinePreferenceSpec) DeepCopyInto(out *VirtualMachinePreferen
 			(*out)[key] = val
 		}
 	}
+	if in.InheritFrom != nil {
+		in, out := &in.InheritFrom, &out.InheritFrom
+		*out = make([]PreferenceReference, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -20,6 +20,7 @@
 package v1beta1
 
 import (
+	k8sv1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
@@ -89,6 +90,22 @@ type VirtualMachineInstancetypeSpec struct {
 	// +optional
 	SchedulerName string `json:"schedulerName,omitempty"`
 
+	// If affinity is specified, obey all the affinity rules.
+	//
+	// Affinity is the affinity of the VirtualMachineInstance to nodes and other pods required by the instancetype.
+	// +optional
+	Affinity *k8sv1.Affinity `json:"affinity,omitempty"`
+
+	// TopologySpreadConstraints describes how a group of VMIs will be spread across a given topology
+	// domains. K8s scheduler will schedule VMI pods in a way which abides by the constraints.
+	//
+	// TopologySpreadConstraints are the topology spread constraints required by the instancetype.
+	// +optional
+	// +listType=map
+	// +listMapKey=topologyKey
+	// +listMapKey=whenUnsatisfiable
+	TopologySpreadConstraints []k8sv1.TopologySpreadConstraint `json:"topologySpreadConstraints,omitempty"`
+
 	// Required CPU related attributes of the instancetype.
 	CPU CPUInstancetype `json:"cpu"`
 
@@ -137,6 +154,7 @@ type CPUInstancetype struct {
 	// List of available models https://github.com/libvirt/libvirt/tree/master/src/cpu_map.
 	// It is possible to specify special cases like "host-passthrough" to get the same CPU as the node
 	// and "host-model" to get CPU closest to the node one.
+	// "cluster-common" selects the CPU model and features common to the nodes matching the node selector of the VMI.
 	// Defaults to host-model.
 	// +optional
 	Model *string `json:"model,omitempty"`
@@ -162,6 +180,12 @@ type CPUInstancetype struct {
 	// MaxSockets specifies the maximum amount of sockets that can be hotplugged
 	// +optional
 	MaxSockets *uint32 `json:"maxSockets,omitempty"`
+
+	// MitigatedVulnerabilities lists the CPU vulnerabilities, as named by the kernel in /sys/devices/system/cpu/vulnerabilities,
+	// e.g. retbleed or mds, the node of the VMI has to be mitigated against or not affected by.
+	// +optional
+	// +listType=set
+	MitigatedVulnerabilities []string `json:"mitigatedVulnerabilities,omitempty"`
 }
 
 // MemoryInstancetype contains the Memory related configuration of a given VirtualMachineInstancetypeSpec.
@@ -298,6 +322,29 @@ type VirtualMachinePreferenceSpec struct {
 	//
 	//+optional
 	PreferSpreadSocketToCoreRatio uint32 `json:"preferSpreadSocketToCoreRatio,omitempty"`
+
+	// InheritFrom optionally references base preferences whose attributes are inherited.
+	// The base preferences are merged in order, later ones taking precedence over earlier ones, and the attributes
+	// set by this preference take precedence over all of them. Maps are merged, lists are replaced.
+	// VirtualMachineClusterPreferences can only inherit from VirtualMachineClusterPreferences.
+	//
+	//+optional
+	//+listType=atomic
+	InheritFrom []PreferenceReference `json:"inheritFrom,omitempty"`
+}
+
+// PreferenceReference references a preference to inherit from
+type PreferenceReference struct {
+	// Name is the name of the referenced preference
+	Name string `json:"name"`
+
+	// Kind specifies which preference resource is referenced.
+	// Allowed values are: "VirtualMachinePreference" and "VirtualMachineClusterPreference".
+	// If not specified, "VirtualMachineClusterPreference" is used by default.
+	// VirtualMachinePreferences are looked up in the namespace of the inheriting preference.
+	//
+	//+optional
+	Kind string `json:"kind,omitempty"`
 }
 
 type VolumePreferences struct {
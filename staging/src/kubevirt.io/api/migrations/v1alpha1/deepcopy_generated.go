@@ -23,6 +23,7 @@ package v1alpha1
 
 import (
 	runtime "k8s.io/apimachinery/pkg/runtime"
+	v1 "kubevirt.io/api/core/v1"
 )
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
@@ -47,6 +48,23 @@ func (in LabelSelector) DeepCopy() LabelSelector {
 	return *out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MaintenanceWindow) DeepCopyInto(out *MaintenanceWindow) {
+	*out = *in
+	out.Duration = in.Duration
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenanceWindow.
+func (in *MaintenanceWindow) DeepCopy() *MaintenanceWindow {
+	if in == nil {
+		return nil
+	}
+	out := new(MaintenanceWindow)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MigrationPolicy) DeepCopyInto(out *MigrationPolicy) {
 	*out = *in
@@ -141,6 +159,31 @@ func (in *MigrationPolicySpec) DeepCopyInto(out *MigrationPolicySpec) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.MaxDowntimeMilliseconds != nil {
+		in, out := &in.MaxDowntimeMilliseconds, &out.MaxDowntimeMilliseconds
+		*out = new(int64)
+		**out = **in
+	}
+	if in.PostCopyAfterIterations != nil {
+		in, out := &in.PostCopyAfterIterations, &out.PostCopyAfterIterations
+		*out = new(int64)
+		**out = **in
+	}
+	if in.ParallelMigrationChannels != nil {
+		in, out := &in.ParallelMigrationChannels, &out.ParallelMigrationChannels
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.Compression != nil {
+		in, out := &in.Compression, &out.Compression
+		*out = new(v1.MigrationCompression)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MaintenanceWindows != nil {
+		in, out := &in.MaintenanceWindows, &out.MaintenanceWindows
+		*out = make([]MaintenanceWindow, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -53,6 +53,29 @@ type MigrationPolicySpec struct {
 	AllowPostCopy *bool `json:"allowPostCopy,omitempty"`
 	//+optional
 	AllowWorkloadDisruption *bool `json:"allowWorkloadDisruption,omitempty"`
+	//+optional
+	MaxDowntimeMilliseconds *int64 `json:"maxDowntimeMilliseconds,omitempty"`
+	//+optional
+	PostCopyAfterIterations *int64 `json:"postCopyAfterIterations,omitempty"`
+	//+optional
+	ParallelMigrationChannels *uint32 `json:"parallelMigrationChannels,omitempty"`
+	//+optional
+	Compression *k6tv1.MigrationCompression `json:"compression,omitempty"`
+	// MaintenanceWindows restrict the automated migrations of the matched VirtualMachineInstances, the ones
+	// created by the workload updater and for evacuations, to the given windows. Automated migrations requested
+	// outside of all windows stay pending until one opens. Migrations requested by users are never held back.
+	//+optional
+	//+listType=atomic
+	MaintenanceWindows []MaintenanceWindow `json:"maintenanceWindows,omitempty"`
+}
+
+// MaintenanceWindow is a recurring time window during which automated migrations are allowed
+type MaintenanceWindow struct {
+	// Schedule is a cron expression in the five field format "minute hour day-of-month month day-of-week",
+	// evaluated in UTC, at which the window opens. Fields support numbers, ranges, lists and steps.
+	Schedule string `json:"schedule"`
+	// Duration is how long the window stays open, at most 7 days.
+	Duration metav1.Duration `json:"duration"`
 }
 
 type LabelSelector map[string]string
@@ -109,6 +132,25 @@ func (m *MigrationPolicy) GetMigrationConfByPolicy(clusterMigrationConfiguration
 		// value of AllowPostCopy, if not explicitly set
 		*clusterMigrationConfigurations.AllowWorkloadDisruption = *policySpec.AllowPostCopy
 	}
+	if policySpec.MaxDowntimeMilliseconds != nil {
+		changed = true
+		maxDowntime := *policySpec.MaxDowntimeMilliseconds
+		clusterMigrationConfigurations.MaxDowntimeMilliseconds = &maxDowntime
+	}
+	if policySpec.PostCopyAfterIterations != nil {
+		changed = true
+		iterations := *policySpec.PostCopyAfterIterations
+		clusterMigrationConfigurations.PostCopyAfterIterations = &iterations
+	}
+	if policySpec.ParallelMigrationChannels != nil {
+		changed = true
+		channels := *policySpec.ParallelMigrationChannels
+		clusterMigrationConfigurations.ParallelMigrationChannels = &channels
+	}
+	if policySpec.Compression != nil {
+		changed = true
+		clusterMigrationConfigurations.Compression = policySpec.Compression.DeepCopy()
+	}
 
 	return changed, nil
 }
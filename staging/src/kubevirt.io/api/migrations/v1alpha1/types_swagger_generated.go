@@ -11,11 +11,24 @@ func (MigrationPolicy) SwaggerDoc() map[string]string {
 
 func (MigrationPolicySpec) SwaggerDoc() map[string]string {
 	return map[string]string{
-		"allowAutoConverge":       "+optional",
-		"bandwidthPerMigration":   "+optional",
-		"completionTimeoutPerGiB": "+optional",
-		"allowPostCopy":           "+optional",
-		"allowWorkloadDisruption": "+optional",
+		"allowAutoConverge":         "+optional",
+		"bandwidthPerMigration":     "+optional",
+		"completionTimeoutPerGiB":   "+optional",
+		"allowPostCopy":             "+optional",
+		"allowWorkloadDisruption":   "+optional",
+		"maxDowntimeMilliseconds":   "+optional",
+		"postCopyAfterIterations":   "+optional",
+		"parallelMigrationChannels": "+optional",
+		"compression":               "+optional",
+		"maintenanceWindows":        "MaintenanceWindows restrict the automated migrations of the matched VirtualMachineInstances, the ones\ncreated by the workload updater and for evacuations, to the given windows. Automated migrations requested\noutside of all windows stay pending until one opens. Migrations requested by users are never held back.\n+optional\n+listType=atomic",
+	}
+}
+
+func (MaintenanceWindow) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":         "MaintenanceWindow is a recurring time window during which automated migrations are allowed",
+		"schedule": "Schedule is a cron expression in the five field format \"minute hour day-of-month month day-of-week\",\nevaluated in UTC, at which the window opens. Fields support numbers, ranges, lists and steps.",
+		"duration": "Duration is how long the window stays open, at most 7 days.",
 	}
 }
 
@@ -0,0 +1,174 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package v1alpha1
+
+import (
+	k8sv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	ImagePrefetchKind = "ImagePrefetch"
+
+	// ImagePrefetchLabel is set on the pods pulling the images of an ImagePrefetch
+	ImagePrefetchLabel = "prefetch.kubevirt.io/name"
+)
+
+// ImagePrefetch stages containerDisk images and DataSources on a set of nodes
+// ahead of a planned mass start of VirtualMachines.
+//
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +k8s:openapi-gen=true
+// +genclient
+type ImagePrefetch struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec ImagePrefetchSpec `json:"spec" valid:"required"`
+	// +optional
+	Status *ImagePrefetchStatus `json:"status,omitempty"`
+}
+
+// ImagePrefetchList is a list of ImagePrefetch resources.
+//
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +k8s:openapi-gen=true
+type ImagePrefetchList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ImagePrefetch `json:"items"`
+}
+
+// +k8s:openapi-gen=true
+type ImagePrefetchSpec struct {
+	// ContainerDisks lists the containerDisk images which are pulled on every selected node.
+	// +optional
+	// +listType=atomic
+	ContainerDisks []ContainerDiskImage `json:"containerDisks,omitempty"`
+
+	// DataSources lists the DataSources whose volumes have to be ready.
+	// +optional
+	// +listType=atomic
+	DataSources []DataSourceReference `json:"dataSources,omitempty"`
+
+	// NodeSelector selects the nodes the containerDisk images are staged on.
+	// All schedulable nodes are selected if it is empty.
+	// +optional
+	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
+
+	// ReadyBy is the time of the planned event. A warning is emitted if the
+	// prefetch did not complete by then.
+	// +optional
+	// +nullable
+	ReadyBy *metav1.Time `json:"readyBy,omitempty"`
+}
+
+// +k8s:openapi-gen=true
+type ContainerDiskImage struct {
+	// Image is the name of the containerDisk image.
+	Image string `json:"image"`
+
+	// ImagePullSecret is the name of the Docker registry secret required to pull the image.
+	// +optional
+	ImagePullSecret string `json:"imagePullSecret,omitempty"`
+
+	// ImagePullPolicy of the image. Defaults to IfNotPresent.
+	// +optional
+	// +kubebuilder:validation:Enum=Always;Never;IfNotPresent
+	ImagePullPolicy k8sv1.PullPolicy `json:"imagePullPolicy,omitempty"`
+}
+
+// +k8s:openapi-gen=true
+type DataSourceReference struct {
+	// Name of the DataSource.
+	Name string `json:"name"`
+
+	// Namespace of the DataSource. Defaults to the namespace of the ImagePrefetch.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+}
+
+// +k8s:openapi-gen=true
+type ImagePrefetchPhase string
+
+const (
+	// ImagePrefetchPending means the prefetch has not started yet
+	ImagePrefetchPending ImagePrefetchPhase = "Pending"
+	// ImagePrefetchInProgress means images are still being staged on some nodes
+	ImagePrefetchInProgress ImagePrefetchPhase = "InProgress"
+	// ImagePrefetchReady means all images and DataSources are staged
+	ImagePrefetchReady ImagePrefetchPhase = "Ready"
+)
+
+// +k8s:openapi-gen=true
+type ImagePrefetchStatus struct {
+	// +optional
+	Phase ImagePrefetchPhase `json:"phase,omitempty"`
+
+	// TargetNodes is the number of nodes selected by the node selector.
+	// +optional
+	TargetNodes int32 `json:"targetNodes,omitempty"`
+
+	// ReadyNodes is the number of selected nodes which pulled all containerDisk images.
+	// +optional
+	ReadyNodes int32 `json:"readyNodes,omitempty"`
+
+	// ReadyDataSources is the number of DataSources which are ready.
+	// +optional
+	ReadyDataSources int32 `json:"readyDataSources,omitempty"`
+
+	// ReadinessPercentage is the share of staged items, nodes and DataSources, in percent.
+	// +optional
+	ReadinessPercentage int32 `json:"readinessPercentage,omitempty"`
+
+	// +optional
+	// +listType=atomic
+	Conditions []Condition `json:"conditions,omitempty"`
+}
+
+// ConditionType is the const type for Conditions
+type ConditionType string
+
+const (
+	// ConditionOverdue means the prefetch was not ready by the ReadyBy deadline
+	ConditionOverdue ConditionType = "Overdue"
+)
+
+// Condition defines conditions
+// +k8s:openapi-gen=true
+type Condition struct {
+	Type ConditionType `json:"type"`
+
+	Status k8sv1.ConditionStatus `json:"status"`
+
+	// +optional
+	// +nullable
+	LastProbeTime metav1.Time `json:"lastProbeTime,omitempty"`
+
+	// +optional
+	// +nullable
+	LastTransitionTime metav1.Time `json:"lastTransitionTime,omitempty"`
+
+	// +optional
+	Reason string `json:"reason,omitempty"`
+
+	// +optional
+	Message string `json:"message,omitempty"`
+}
@@ -0,0 +1,65 @@
+// Code generated by swagger-doc. DO NOT EDIT.
+
+package v1alpha1
+
+func (ImagePrefetch) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":       "ImagePrefetch stages containerDisk images and DataSources on a set of nodes\nahead of a planned mass start of VirtualMachines.\n\n+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object\n+k8s:openapi-gen=true\n+genclient",
+		"status": "+optional",
+	}
+}
+
+func (ImagePrefetchList) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"": "ImagePrefetchList is a list of ImagePrefetch resources.\n\n+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object\n+k8s:openapi-gen=true",
+	}
+}
+
+func (ImagePrefetchSpec) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":               "+k8s:openapi-gen=true",
+		"containerDisks": "ContainerDisks lists the containerDisk images which are pulled on every selected node.\n+optional\n+listType=atomic",
+		"dataSources":    "DataSources lists the DataSources whose volumes have to be ready.\n+optional\n+listType=atomic",
+		"nodeSelector":   "NodeSelector selects the nodes the containerDisk images are staged on.\nAll schedulable nodes are selected if it is empty.\n+optional",
+		"readyBy":        "ReadyBy is the time of the planned event. A warning is emitted if the\nprefetch did not complete by then.\n+optional\n+nullable",
+	}
+}
+
+func (ContainerDiskImage) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                "+k8s:openapi-gen=true",
+		"image":           "Image is the name of the containerDisk image.",
+		"imagePullSecret": "ImagePullSecret is the name of the Docker registry secret required to pull the image.\n+optional",
+		"imagePullPolicy": "ImagePullPolicy of the image. Defaults to IfNotPresent.\n+optional\n+kubebuilder:validation:Enum=Always;Never;IfNotPresent",
+	}
+}
+
+func (DataSourceReference) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":          "+k8s:openapi-gen=true",
+		"name":      "Name of the DataSource.",
+		"namespace": "Namespace of the DataSource. Defaults to the namespace of the ImagePrefetch.\n+optional",
+	}
+}
+
+func (ImagePrefetchStatus) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                    "+k8s:openapi-gen=true",
+		"phase":               "+optional",
+		"targetNodes":         "TargetNodes is the number of nodes selected by the node selector.\n+optional",
+		"readyNodes":          "ReadyNodes is the number of selected nodes which pulled all containerDisk images.\n+optional",
+		"readyDataSources":    "ReadyDataSources is the number of DataSources which are ready.\n+optional",
+		"readinessPercentage": "ReadinessPercentage is the share of staged items, nodes and DataSources, in percent.\n+optional",
+		"conditions":          "+optional\n+listType=atomic",
+	}
+}
+
+func (Condition) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                   "Condition defines conditions\n+k8s:openapi-gen=true",
+		"lastProbeTime":      "+optional\n+nullable",
+		"lastTransitionTime": "+optional\n+nullable",
+		"reason":             "+optional",
+		"message":            "+optional",
+	}
+}
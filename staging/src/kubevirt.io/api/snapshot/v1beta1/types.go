@@ -334,6 +334,18 @@ const (
 	VirtualMachineRestoreWaitEventually TargetReadinessPolicy = "WaitEventually"
 )
 
+// TargetIdentityPolicy defines whether a VM created by a restore keeps the identity of the snapshotted VM
+type TargetIdentityPolicy string
+
+const (
+	// TargetIdentityPolicyKeep keeps the MAC addresses, the firmware UUID and the SMBios serial of the snapshotted VM
+	TargetIdentityPolicyKeep TargetIdentityPolicy = "Keep"
+
+	// TargetIdentityPolicyRegenerate clears the MAC addresses, the firmware UUID and the SMBios serial
+	// of the snapshotted VM, so that the new VM can run next to it
+	TargetIdentityPolicyRegenerate TargetIdentityPolicy = "Regenerate"
+)
+
 // VirtualMachineRestoreSpec is the spec for a VirtualMachineRestoreresource
 type VirtualMachineRestoreSpec struct {
 	// initially only VirtualMachine type supported
@@ -352,6 +364,17 @@ type VirtualMachineRestoreSpec struct {
 	// +optional
 	// +listType=atomic
 	Patches []string `json:"patches,omitempty"`
+
+	// TargetIdentityPolicy defines whether a target created by the restore keeps the identity of the snapshotted VM.
+	// It can only be set if the target does not exist. Defaults to Keep
+	// +kubebuilder:validation:Enum=Keep;Regenerate
+	// +optional
+	TargetIdentityPolicy *TargetIdentityPolicy `json:"targetIdentityPolicy,omitempty"`
+
+	// NewMacAddresses sets the MAC addresses of the interfaces of a target created with the Regenerate identity policy.
+	// The key is the interface name. The MAC addresses of the other interfaces are cleared so that new ones are assigned
+	// +optional
+	NewMacAddresses map[string]string `json:"newMacAddresses,omitempty"`
 }
 
 // VirtualMachineRestoreStatus is the spec for a VirtualMachineRestoreresource
@@ -395,3 +418,96 @@ type VirtualMachineRestoreList struct {
 
 	Items []VirtualMachineRestore `json:"items"`
 }
+
+const (
+	DefaultSnapshotScheduleRetentionCount = 7
+
+	// SnapshotScheduleLabel labels the VirtualMachineSnapshots created by a VirtualMachineSnapshotSchedule with its name
+	SnapshotScheduleLabel = "snapshot.kubevirt.io/schedule"
+)
+
+// VirtualMachineSnapshotSchedule creates VirtualMachineSnapshots of the selected VirtualMachines on a cron schedule
+// and deletes the oldest of them
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type VirtualMachineSnapshotSchedule struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec VirtualMachineSnapshotScheduleSpec `json:"spec"`
+
+	// +optional
+	Status *VirtualMachineSnapshotScheduleStatus `json:"status,omitempty"`
+}
+
+// QuiescePolicy defines whether the guest file systems have to be frozen for a scheduled snapshot
+type QuiescePolicy string
+
+const (
+	// QuiescePolicyBestEffort freezes the guest file systems if the guest agent is connected
+	QuiescePolicyBestEffort QuiescePolicy = "BestEffort"
+
+	// QuiescePolicyRequired skips running VirtualMachines without a connected guest agent
+	QuiescePolicyRequired QuiescePolicy = "Required"
+)
+
+// VirtualMachineSnapshotScheduleSpec is the spec for a VirtualMachineSnapshotSchedule resource
+type VirtualMachineSnapshotScheduleSpec struct {
+	// Schedule is a five field cron expression evaluated in UTC, like "0 2 * * *"
+	Schedule string `json:"schedule"`
+
+	// Selector selects the VirtualMachines in the namespace of the schedule which are snapshotted,
+	// an empty selector selects all of them
+	Selector metav1.LabelSelector `json:"selector"`
+
+	// RetentionCount is the number of succeeded snapshots kept per VirtualMachine.
+	// Defaults to 7
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	RetentionCount *int32 `json:"retentionCount,omitempty"`
+
+	// QuiescePolicy defines whether the guest file systems have to be frozen for the snapshots.
+	// Defaults to BestEffort
+	// +kubebuilder:validation:Enum=BestEffort;Required
+	// +optional
+	QuiescePolicy *QuiescePolicy `json:"quiescePolicy,omitempty"`
+
+	// Suspend stops the schedule from creating snapshots, the existing snapshots are kept
+	// +optional
+	Suspend *bool `json:"suspend,omitempty"`
+
+	// FailureDeadline of the created snapshots
+	// +optional
+	FailureDeadline *metav1.Duration `json:"failureDeadline,omitempty"`
+}
+
+// VirtualMachineSnapshotScheduleStatus is the status for a VirtualMachineSnapshotSchedule resource
+type VirtualMachineSnapshotScheduleStatus struct {
+	// LastScheduleTime is the last time snapshots were created
+	// +optional
+	// +nullable
+	LastScheduleTime *metav1.Time `json:"lastScheduleTime,omitempty"`
+
+	// NextScheduleTime is the next time snapshots will be created
+	// +optional
+	// +nullable
+	NextScheduleTime *metav1.Time `json:"nextScheduleTime,omitempty"`
+
+	// LastSuccessTime is the time all the snapshots of the last successful run were ready
+	// +optional
+	// +nullable
+	LastSuccessTime *metav1.Time `json:"lastSuccessTime,omitempty"`
+
+	// Error is the last error of the schedule, like a skipped VirtualMachine or a failed snapshot
+	// +optional
+	Error *Error `json:"error,omitempty"`
+}
+
+// VirtualMachineSnapshotScheduleList is a list of VirtualMachineSnapshotSchedule resources
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type VirtualMachineSnapshotScheduleList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	Items []VirtualMachineSnapshotSchedule `json:"items"`
+}
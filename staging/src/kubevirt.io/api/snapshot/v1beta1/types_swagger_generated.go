@@ -143,6 +143,8 @@ func (VirtualMachineRestoreSpec) SwaggerDoc() map[string]string {
 		"target":                "initially only VirtualMachine type supported",
 		"targetReadinessPolicy": "+optional",
 		"patches":               "If the target for the restore does not exist, it will be created. Patches holds JSON patches that would be\napplied to the target manifest before it's created. Patches should fit the target's Kind.\n\nExample for a patch: {\"op\": \"replace\", \"path\": \"/metadata/name\", \"value\": \"new-vm-name\"}\n\n+optional\n+listType=atomic",
+		"targetIdentityPolicy":  "TargetIdentityPolicy defines whether a target created by the restore keeps the identity of the snapshotted VM.\nIt can only be set if the target does not exist. Defaults to Keep\n+kubebuilder:validation:Enum=Keep;Regenerate\n+optional",
+		"newMacAddresses":       "NewMacAddresses sets the MAC addresses of the interfaces of a target created with the Regenerate identity policy.\nThe key is the interface name. The MAC addresses of the other interfaces are cleared so that new ones are assigned\n+optional",
 	}
 }
 
@@ -169,3 +171,38 @@ func (VirtualMachineRestoreList) SwaggerDoc() map[string]string {
 		"": "VirtualMachineRestoreList is a list of VirtualMachineRestore resources\n+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object",
 	}
 }
+
+func (VirtualMachineSnapshotSchedule) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":       "VirtualMachineSnapshotSchedule creates VirtualMachineSnapshots of the selected VirtualMachines on a cron schedule\nand deletes the oldest of them\n+genclient\n+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object",
+		"status": "+optional",
+	}
+}
+
+func (VirtualMachineSnapshotScheduleSpec) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                "VirtualMachineSnapshotScheduleSpec is the spec for a VirtualMachineSnapshotSchedule resource",
+		"schedule":        "Schedule is a five field cron expression evaluated in UTC, like \"0 2 * * *\"",
+		"selector":        "Selector selects the VirtualMachines in the namespace of the schedule which are snapshotted,\nan empty selector selects all of them",
+		"retentionCount":  "RetentionCount is the number of succeeded snapshots kept per VirtualMachine.\nDefaults to 7\n+kubebuilder:validation:Minimum=1\n+optional",
+		"quiescePolicy":   "QuiescePolicy defines whether the guest file systems have to be frozen for the snapshots.\nDefaults to BestEffort\n+kubebuilder:validation:Enum=BestEffort;Required\n+optional",
+		"suspend":         "Suspend stops the schedule from creating snapshots, the existing snapshots are kept\n+optional",
+		"failureDeadline": "FailureDeadline of the created snapshots\n+optional",
+	}
+}
+
+func (VirtualMachineSnapshotScheduleStatus) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                 "VirtualMachineSnapshotScheduleStatus is the status for a VirtualMachineSnapshotSchedule resource",
+		"lastScheduleTime": "LastScheduleTime is the last time snapshots were created\n+optional\n+nullable",
+		"nextScheduleTime": "NextScheduleTime is the next time snapshots will be created\n+optional\n+nullable",
+		"lastSuccessTime":  "LastSuccessTime is the time all the snapshots of the last successful run were ready\n+optional\n+nullable",
+		"error":            "Error is the last error of the schedule, like a skipped VirtualMachine or a failed snapshot\n+optional",
+	}
+}
+
+func (VirtualMachineSnapshotScheduleList) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"": "VirtualMachineSnapshotScheduleList is a list of VirtualMachineSnapshotSchedule resources\n+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object",
+	}
+}
@@ -340,9 +340,13 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"kubevirt.io/api/core/v1.Bootloader":                                                         schema_kubevirtio_api_core_v1_Bootloader(ref),
 		"kubevirt.io/api/core/v1.CDRomTarget":                                                        schema_kubevirtio_api_core_v1_CDRomTarget(ref),
 		"kubevirt.io/api/core/v1.CPU":                                                                schema_kubevirtio_api_core_v1_CPU(ref),
+		"kubevirt.io/api/core/v1.CPUFairShareConfiguration":                                          schema_kubevirtio_api_core_v1_CPUFairShareConfiguration(ref),
+		"kubevirt.io/api/core/v1.CPUFairShareTier":                                                   schema_kubevirtio_api_core_v1_CPUFairShareTier(ref),
 		"kubevirt.io/api/core/v1.CPUFeature":                                                         schema_kubevirtio_api_core_v1_CPUFeature(ref),
 		"kubevirt.io/api/core/v1.CPUTopology":                                                        schema_kubevirtio_api_core_v1_CPUTopology(ref),
+		"kubevirt.io/api/core/v1.CanaryConfiguration":                                                schema_kubevirtio_api_core_v1_CanaryConfiguration(ref),
 		"kubevirt.io/api/core/v1.CertConfig":                                                         schema_kubevirtio_api_core_v1_CertConfig(ref),
+		"kubevirt.io/api/core/v1.CgroupLayout":                                                       schema_kubevirtio_api_core_v1_CgroupLayout(ref),
 		"kubevirt.io/api/core/v1.Chassis":                                                            schema_kubevirtio_api_core_v1_Chassis(ref),
 		"kubevirt.io/api/core/v1.ClientPassthroughDevices":                                           schema_kubevirtio_api_core_v1_ClientPassthroughDevices(ref),
 		"kubevirt.io/api/core/v1.Clock":                                                              schema_kubevirtio_api_core_v1_Clock(ref),
@@ -365,9 +369,11 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"kubevirt.io/api/core/v1.CustomizeComponentsPatch":                                           schema_kubevirtio_api_core_v1_CustomizeComponentsPatch(ref),
 		"kubevirt.io/api/core/v1.DHCPOptions":                                                        schema_kubevirtio_api_core_v1_DHCPOptions(ref),
 		"kubevirt.io/api/core/v1.DHCPPrivateOptions":                                                 schema_kubevirtio_api_core_v1_DHCPPrivateOptions(ref),
+		"kubevirt.io/api/core/v1.DHCPRoute":                                                          schema_kubevirtio_api_core_v1_DHCPRoute(ref),
 		"kubevirt.io/api/core/v1.DataVolumeSource":                                                   schema_kubevirtio_api_core_v1_DataVolumeSource(ref),
 		"kubevirt.io/api/core/v1.DataVolumeTemplateDummyStatus":                                      schema_kubevirtio_api_core_v1_DataVolumeTemplateDummyStatus(ref),
 		"kubevirt.io/api/core/v1.DataVolumeTemplateSpec":                                             schema_kubevirtio_api_core_v1_DataVolumeTemplateSpec(ref),
+		"kubevirt.io/api/core/v1.DedicatedCPUPool":                                                   schema_kubevirtio_api_core_v1_DedicatedCPUPool(ref),
 		"kubevirt.io/api/core/v1.DeprecatedInterfaceMacvtap":                                         schema_kubevirtio_api_core_v1_DeprecatedInterfaceMacvtap(ref),
 		"kubevirt.io/api/core/v1.DeprecatedInterfacePasst":                                           schema_kubevirtio_api_core_v1_DeprecatedInterfacePasst(ref),
 		"kubevirt.io/api/core/v1.DeprecatedInterfaceSlirp":                                           schema_kubevirtio_api_core_v1_DeprecatedInterfaceSlirp(ref),
@@ -378,6 +384,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"kubevirt.io/api/core/v1.Disk":                                                               schema_kubevirtio_api_core_v1_Disk(ref),
 		"kubevirt.io/api/core/v1.DiskDevice":                                                         schema_kubevirtio_api_core_v1_DiskDevice(ref),
 		"kubevirt.io/api/core/v1.DiskIOThreads":                                                      schema_kubevirtio_api_core_v1_DiskIOThreads(ref),
+		"kubevirt.io/api/core/v1.DiskIOTune":                                                         schema_kubevirtio_api_core_v1_DiskIOTune(ref),
 		"kubevirt.io/api/core/v1.DiskTarget":                                                         schema_kubevirtio_api_core_v1_DiskTarget(ref),
 		"kubevirt.io/api/core/v1.DiskVerification":                                                   schema_kubevirtio_api_core_v1_DiskVerification(ref),
 		"kubevirt.io/api/core/v1.DomainMemoryDumpInfo":                                               schema_kubevirtio_api_core_v1_DomainMemoryDumpInfo(ref),
@@ -387,7 +394,9 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"kubevirt.io/api/core/v1.DownwardMetricsVolumeSource":                                        schema_kubevirtio_api_core_v1_DownwardMetricsVolumeSource(ref),
 		"kubevirt.io/api/core/v1.EFI":                                                                schema_kubevirtio_api_core_v1_EFI(ref),
 		"kubevirt.io/api/core/v1.EmptyDiskSource":                                                    schema_kubevirtio_api_core_v1_EmptyDiskSource(ref),
+		"kubevirt.io/api/core/v1.EphemeralOverlay":                                                   schema_kubevirtio_api_core_v1_EphemeralOverlay(ref),
 		"kubevirt.io/api/core/v1.EphemeralVolumeSource":                                              schema_kubevirtio_api_core_v1_EphemeralVolumeSource(ref),
+		"kubevirt.io/api/core/v1.FSFreezeInfo":                                                       schema_kubevirtio_api_core_v1_FSFreezeInfo(ref),
 		"kubevirt.io/api/core/v1.FeatureAPIC":                                                        schema_kubevirtio_api_core_v1_FeatureAPIC(ref),
 		"kubevirt.io/api/core/v1.FeatureHyperv":                                                      schema_kubevirtio_api_core_v1_FeatureHyperv(ref),
 		"kubevirt.io/api/core/v1.FeatureKVM":                                                         schema_kubevirtio_api_core_v1_FeatureKVM(ref),
@@ -404,22 +413,33 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"kubevirt.io/api/core/v1.GenerationStatus":                                                   schema_kubevirtio_api_core_v1_GenerationStatus(ref),
 		"kubevirt.io/api/core/v1.GuestAgentCommandInfo":                                              schema_kubevirtio_api_core_v1_GuestAgentCommandInfo(ref),
 		"kubevirt.io/api/core/v1.GuestAgentPing":                                                     schema_kubevirtio_api_core_v1_GuestAgentPing(ref),
+		"kubevirt.io/api/core/v1.GuestFilesystemUsage":                                               schema_kubevirtio_api_core_v1_GuestFilesystemUsage(ref),
+		"kubevirt.io/api/core/v1.GuestLicense":                                                       schema_kubevirtio_api_core_v1_GuestLicense(ref),
+		"kubevirt.io/api/core/v1.GuestTimeSync":                                                      schema_kubevirtio_api_core_v1_GuestTimeSync(ref),
 		"kubevirt.io/api/core/v1.HPETTimer":                                                          schema_kubevirtio_api_core_v1_HPETTimer(ref),
 		"kubevirt.io/api/core/v1.Handler":                                                            schema_kubevirtio_api_core_v1_Handler(ref),
 		"kubevirt.io/api/core/v1.HostDevice":                                                         schema_kubevirtio_api_core_v1_HostDevice(ref),
 		"kubevirt.io/api/core/v1.HostDisk":                                                           schema_kubevirtio_api_core_v1_HostDisk(ref),
 		"kubevirt.io/api/core/v1.HotplugVolumeSource":                                                schema_kubevirtio_api_core_v1_HotplugVolumeSource(ref),
 		"kubevirt.io/api/core/v1.HotplugVolumeStatus":                                                schema_kubevirtio_api_core_v1_HotplugVolumeStatus(ref),
+		"kubevirt.io/api/core/v1.Housekeeping":                                                       schema_kubevirtio_api_core_v1_Housekeeping(ref),
+		"kubevirt.io/api/core/v1.HousekeepingCgroup":                                                 schema_kubevirtio_api_core_v1_HousekeepingCgroup(ref),
 		"kubevirt.io/api/core/v1.Hugepages":                                                          schema_kubevirtio_api_core_v1_Hugepages(ref),
+		"kubevirt.io/api/core/v1.HugepagesPool":                                                      schema_kubevirtio_api_core_v1_HugepagesPool(ref),
 		"kubevirt.io/api/core/v1.HyperVPassthrough":                                                  schema_kubevirtio_api_core_v1_HyperVPassthrough(ref),
 		"kubevirt.io/api/core/v1.HypervTimer":                                                        schema_kubevirtio_api_core_v1_HypervTimer(ref),
 		"kubevirt.io/api/core/v1.I6300ESBWatchdog":                                                   schema_kubevirtio_api_core_v1_I6300ESBWatchdog(ref),
+		"kubevirt.io/api/core/v1.IOThreadPlacement":                                                  schema_kubevirtio_api_core_v1_IOThreadPlacement(ref),
 		"kubevirt.io/api/core/v1.InitrdInfo":                                                         schema_kubevirtio_api_core_v1_InitrdInfo(ref),
 		"kubevirt.io/api/core/v1.Input":                                                              schema_kubevirtio_api_core_v1_Input(ref),
 		"kubevirt.io/api/core/v1.InstancetypeConfiguration":                                          schema_kubevirtio_api_core_v1_InstancetypeConfiguration(ref),
 		"kubevirt.io/api/core/v1.InstancetypeMatcher":                                                schema_kubevirtio_api_core_v1_InstancetypeMatcher(ref),
+		"kubevirt.io/api/core/v1.InstancetypeNamespacePolicy":                                        schema_kubevirtio_api_core_v1_InstancetypeNamespacePolicy(ref),
+		"kubevirt.io/api/core/v1.InstancetypeRightsizing":                                            schema_kubevirtio_api_core_v1_InstancetypeRightsizing(ref),
 		"kubevirt.io/api/core/v1.InstancetypeStatusRef":                                              schema_kubevirtio_api_core_v1_InstancetypeStatusRef(ref),
 		"kubevirt.io/api/core/v1.Interface":                                                          schema_kubevirtio_api_core_v1_Interface(ref),
+		"kubevirt.io/api/core/v1.InterfaceBandwidth":                                                 schema_kubevirtio_api_core_v1_InterfaceBandwidth(ref),
+		"kubevirt.io/api/core/v1.InterfaceBandwidthLimit":                                            schema_kubevirtio_api_core_v1_InterfaceBandwidthLimit(ref),
 		"kubevirt.io/api/core/v1.InterfaceBindingMethod":                                             schema_kubevirtio_api_core_v1_InterfaceBindingMethod(ref),
 		"kubevirt.io/api/core/v1.InterfaceBindingMigration":                                          schema_kubevirtio_api_core_v1_InterfaceBindingMigration(ref),
 		"kubevirt.io/api/core/v1.InterfaceBindingPlugin":                                             schema_kubevirtio_api_core_v1_InterfaceBindingPlugin(ref),
@@ -427,6 +447,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"kubevirt.io/api/core/v1.InterfaceMasquerade":                                                schema_kubevirtio_api_core_v1_InterfaceMasquerade(ref),
 		"kubevirt.io/api/core/v1.InterfaceSRIOV":                                                     schema_kubevirtio_api_core_v1_InterfaceSRIOV(ref),
 		"kubevirt.io/api/core/v1.KSMConfiguration":                                                   schema_kubevirtio_api_core_v1_KSMConfiguration(ref),
+		"kubevirt.io/api/core/v1.KSMTuning":                                                          schema_kubevirtio_api_core_v1_KSMTuning(ref),
 		"kubevirt.io/api/core/v1.KVMTimer":                                                           schema_kubevirtio_api_core_v1_KVMTimer(ref),
 		"kubevirt.io/api/core/v1.KernelBoot":                                                         schema_kubevirtio_api_core_v1_KernelBoot(ref),
 		"kubevirt.io/api/core/v1.KernelBootContainer":                                                schema_kubevirtio_api_core_v1_KernelBootContainer(ref),
@@ -436,7 +457,9 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"kubevirt.io/api/core/v1.KubeVirtCertificateRotateStrategy":                                  schema_kubevirtio_api_core_v1_KubeVirtCertificateRotateStrategy(ref),
 		"kubevirt.io/api/core/v1.KubeVirtCondition":                                                  schema_kubevirtio_api_core_v1_KubeVirtCondition(ref),
 		"kubevirt.io/api/core/v1.KubeVirtConfiguration":                                              schema_kubevirtio_api_core_v1_KubeVirtConfiguration(ref),
+		"kubevirt.io/api/core/v1.KubeVirtFleetSummary":                                               schema_kubevirtio_api_core_v1_KubeVirtFleetSummary(ref),
 		"kubevirt.io/api/core/v1.KubeVirtList":                                                       schema_kubevirtio_api_core_v1_KubeVirtList(ref),
+		"kubevirt.io/api/core/v1.KubeVirtPendingAction":                                              schema_kubevirtio_api_core_v1_KubeVirtPendingAction(ref),
 		"kubevirt.io/api/core/v1.KubeVirtSelfSignConfiguration":                                      schema_kubevirtio_api_core_v1_KubeVirtSelfSignConfiguration(ref),
 		"kubevirt.io/api/core/v1.KubeVirtSpec":                                                       schema_kubevirtio_api_core_v1_KubeVirtSpec(ref),
 		"kubevirt.io/api/core/v1.KubeVirtStatus":                                                     schema_kubevirtio_api_core_v1_KubeVirtStatus(ref),
@@ -445,33 +468,50 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"kubevirt.io/api/core/v1.LiveUpdateConfiguration":                                            schema_kubevirtio_api_core_v1_LiveUpdateConfiguration(ref),
 		"kubevirt.io/api/core/v1.LogVerbosity":                                                       schema_kubevirtio_api_core_v1_LogVerbosity(ref),
 		"kubevirt.io/api/core/v1.LunTarget":                                                          schema_kubevirtio_api_core_v1_LunTarget(ref),
+		"kubevirt.io/api/core/v1.MacAddressPoolConfiguration":                                        schema_kubevirtio_api_core_v1_MacAddressPoolConfiguration(ref),
+		"kubevirt.io/api/core/v1.MacAddressRange":                                                    schema_kubevirtio_api_core_v1_MacAddressRange(ref),
 		"kubevirt.io/api/core/v1.Machine":                                                            schema_kubevirtio_api_core_v1_Machine(ref),
+		"kubevirt.io/api/core/v1.MediatedDeviceTypeCount":                                            schema_kubevirtio_api_core_v1_MediatedDeviceTypeCount(ref),
 		"kubevirt.io/api/core/v1.MediatedDevicesConfiguration":                                       schema_kubevirtio_api_core_v1_MediatedDevicesConfiguration(ref),
 		"kubevirt.io/api/core/v1.MediatedHostDevice":                                                 schema_kubevirtio_api_core_v1_MediatedHostDevice(ref),
 		"kubevirt.io/api/core/v1.Memory":                                                             schema_kubevirtio_api_core_v1_Memory(ref),
+		"kubevirt.io/api/core/v1.MemoryDumpProgress":                                                 schema_kubevirtio_api_core_v1_MemoryDumpProgress(ref),
 		"kubevirt.io/api/core/v1.MemoryDumpVolumeSource":                                             schema_kubevirtio_api_core_v1_MemoryDumpVolumeSource(ref),
 		"kubevirt.io/api/core/v1.MemoryStatus":                                                       schema_kubevirtio_api_core_v1_MemoryStatus(ref),
 		"kubevirt.io/api/core/v1.MigrateOptions":                                                     schema_kubevirtio_api_core_v1_MigrateOptions(ref),
+		"kubevirt.io/api/core/v1.MigrationBandwidthAutoTuning":                                       schema_kubevirtio_api_core_v1_MigrationBandwidthAutoTuning(ref),
+		"kubevirt.io/api/core/v1.MigrationBlocker":                                                   schema_kubevirtio_api_core_v1_MigrationBlocker(ref),
+		"kubevirt.io/api/core/v1.MigrationCancelOptions":                                             schema_kubevirtio_api_core_v1_MigrationCancelOptions(ref),
+		"kubevirt.io/api/core/v1.MigrationCompression":                                               schema_kubevirtio_api_core_v1_MigrationCompression(ref),
 		"kubevirt.io/api/core/v1.MigrationConfiguration":                                             schema_kubevirtio_api_core_v1_MigrationConfiguration(ref),
+		"kubevirt.io/api/core/v1.MigrationProgress":                                                  schema_kubevirtio_api_core_v1_MigrationProgress(ref),
 		"kubevirt.io/api/core/v1.MultusNetwork":                                                      schema_kubevirtio_api_core_v1_MultusNetwork(ref),
 		"kubevirt.io/api/core/v1.NUMA":                                                               schema_kubevirtio_api_core_v1_NUMA(ref),
 		"kubevirt.io/api/core/v1.NUMAGuestMappingPassthrough":                                        schema_kubevirtio_api_core_v1_NUMAGuestMappingPassthrough(ref),
+		"kubevirt.io/api/core/v1.NamespaceMacAddressRanges":                                          schema_kubevirtio_api_core_v1_NamespaceMacAddressRanges(ref),
 		"kubevirt.io/api/core/v1.Network":                                                            schema_kubevirtio_api_core_v1_Network(ref),
 		"kubevirt.io/api/core/v1.NetworkConfiguration":                                               schema_kubevirtio_api_core_v1_NetworkConfiguration(ref),
 		"kubevirt.io/api/core/v1.NetworkSource":                                                      schema_kubevirtio_api_core_v1_NetworkSource(ref),
 		"kubevirt.io/api/core/v1.NoCloudSSHPublicKeyAccessCredentialPropagation":                     schema_kubevirtio_api_core_v1_NoCloudSSHPublicKeyAccessCredentialPropagation(ref),
+		"kubevirt.io/api/core/v1.NodeEvacuationStatus":                                               schema_kubevirtio_api_core_v1_NodeEvacuationStatus(ref),
 		"kubevirt.io/api/core/v1.NodeMediatedDeviceTypesConfig":                                      schema_kubevirtio_api_core_v1_NodeMediatedDeviceTypesConfig(ref),
 		"kubevirt.io/api/core/v1.NodePlacement":                                                      schema_kubevirtio_api_core_v1_NodePlacement(ref),
 		"kubevirt.io/api/core/v1.PITTimer":                                                           schema_kubevirtio_api_core_v1_PITTimer(ref),
+		"kubevirt.io/api/core/v1.PacketCaptureOptions":                                               schema_kubevirtio_api_core_v1_PacketCaptureOptions(ref),
 		"kubevirt.io/api/core/v1.PauseOptions":                                                       schema_kubevirtio_api_core_v1_PauseOptions(ref),
+		"kubevirt.io/api/core/v1.PauseStatus":                                                        schema_kubevirtio_api_core_v1_PauseStatus(ref),
 		"kubevirt.io/api/core/v1.PciHostDevice":                                                      schema_kubevirtio_api_core_v1_PciHostDevice(ref),
 		"kubevirt.io/api/core/v1.PermittedHostDevices":                                               schema_kubevirtio_api_core_v1_PermittedHostDevices(ref),
 		"kubevirt.io/api/core/v1.PersistentVolumeClaimInfo":                                          schema_kubevirtio_api_core_v1_PersistentVolumeClaimInfo(ref),
 		"kubevirt.io/api/core/v1.PersistentVolumeClaimVolumeSource":                                  schema_kubevirtio_api_core_v1_PersistentVolumeClaimVolumeSource(ref),
+		"kubevirt.io/api/core/v1.PlacementHintsConfiguration":                                        schema_kubevirtio_api_core_v1_PlacementHintsConfiguration(ref),
+		"kubevirt.io/api/core/v1.PlacementHintsRequest":                                              schema_kubevirtio_api_core_v1_PlacementHintsRequest(ref),
+		"kubevirt.io/api/core/v1.PlacementHintsResponse":                                             schema_kubevirtio_api_core_v1_PlacementHintsResponse(ref),
 		"kubevirt.io/api/core/v1.PluginBinding":                                                      schema_kubevirtio_api_core_v1_PluginBinding(ref),
 		"kubevirt.io/api/core/v1.PodNetwork":                                                         schema_kubevirtio_api_core_v1_PodNetwork(ref),
 		"kubevirt.io/api/core/v1.Port":                                                               schema_kubevirtio_api_core_v1_Port(ref),
 		"kubevirt.io/api/core/v1.PreferenceMatcher":                                                  schema_kubevirtio_api_core_v1_PreferenceMatcher(ref),
+		"kubevirt.io/api/core/v1.PreferredNode":                                                      schema_kubevirtio_api_core_v1_PreferredNode(ref),
 		"kubevirt.io/api/core/v1.Probe":                                                              schema_kubevirtio_api_core_v1_Probe(ref),
 		"kubevirt.io/api/core/v1.ProfilerResult":                                                     schema_kubevirtio_api_core_v1_ProfilerResult(ref),
 		"kubevirt.io/api/core/v1.QemuGuestAgentSSHPublicKeyAccessCredentialPropagation":              schema_kubevirtio_api_core_v1_QemuGuestAgentSSHPublicKeyAccessCredentialPropagation(ref),
@@ -501,19 +541,28 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"kubevirt.io/api/core/v1.SeccompConfiguration":                                               schema_kubevirtio_api_core_v1_SeccompConfiguration(ref),
 		"kubevirt.io/api/core/v1.SecretVolumeSource":                                                 schema_kubevirtio_api_core_v1_SecretVolumeSource(ref),
 		"kubevirt.io/api/core/v1.ServiceAccountVolumeSource":                                         schema_kubevirtio_api_core_v1_ServiceAccountVolumeSource(ref),
+		"kubevirt.io/api/core/v1.SetLinkStateOptions":                                                schema_kubevirtio_api_core_v1_SetLinkStateOptions(ref),
+		"kubevirt.io/api/core/v1.ShutdownPolicy":                                                     schema_kubevirtio_api_core_v1_ShutdownPolicy(ref),
 		"kubevirt.io/api/core/v1.SoundDevice":                                                        schema_kubevirtio_api_core_v1_SoundDevice(ref),
+		"kubevirt.io/api/core/v1.StartConfiguration":                                                 schema_kubevirtio_api_core_v1_StartConfiguration(ref),
 		"kubevirt.io/api/core/v1.StartOptions":                                                       schema_kubevirtio_api_core_v1_StartOptions(ref),
 		"kubevirt.io/api/core/v1.StopOptions":                                                        schema_kubevirtio_api_core_v1_StopOptions(ref),
 		"kubevirt.io/api/core/v1.StorageMigratedVolumeInfo":                                          schema_kubevirtio_api_core_v1_StorageMigratedVolumeInfo(ref),
 		"kubevirt.io/api/core/v1.SupportContainerResources":                                          schema_kubevirtio_api_core_v1_SupportContainerResources(ref),
+		"kubevirt.io/api/core/v1.SwapConfiguration":                                                  schema_kubevirtio_api_core_v1_SwapConfiguration(ref),
 		"kubevirt.io/api/core/v1.SyNICTimer":                                                         schema_kubevirtio_api_core_v1_SyNICTimer(ref),
 		"kubevirt.io/api/core/v1.SysprepSource":                                                      schema_kubevirtio_api_core_v1_SysprepSource(ref),
 		"kubevirt.io/api/core/v1.TLSConfiguration":                                                   schema_kubevirtio_api_core_v1_TLSConfiguration(ref),
 		"kubevirt.io/api/core/v1.TPMDevice":                                                          schema_kubevirtio_api_core_v1_TPMDevice(ref),
+		"kubevirt.io/api/core/v1.ThreadPlacement":                                                    schema_kubevirtio_api_core_v1_ThreadPlacement(ref),
+		"kubevirt.io/api/core/v1.ThreadPlacementStatus":                                              schema_kubevirtio_api_core_v1_ThreadPlacementStatus(ref),
 		"kubevirt.io/api/core/v1.Timer":                                                              schema_kubevirtio_api_core_v1_Timer(ref),
 		"kubevirt.io/api/core/v1.TokenBucketRateLimiter":                                             schema_kubevirtio_api_core_v1_TokenBucketRateLimiter(ref),
 		"kubevirt.io/api/core/v1.TopologyHints":                                                      schema_kubevirtio_api_core_v1_TopologyHints(ref),
+		"kubevirt.io/api/core/v1.USBDevice":                                                          schema_kubevirtio_api_core_v1_USBDevice(ref),
+		"kubevirt.io/api/core/v1.USBDeviceStatus":                                                    schema_kubevirtio_api_core_v1_USBDeviceStatus(ref),
 		"kubevirt.io/api/core/v1.USBHostDevice":                                                      schema_kubevirtio_api_core_v1_USBHostDevice(ref),
+		"kubevirt.io/api/core/v1.USBPassthroughHostDevice":                                           schema_kubevirtio_api_core_v1_USBPassthroughHostDevice(ref),
 		"kubevirt.io/api/core/v1.USBSelector":                                                        schema_kubevirtio_api_core_v1_USBSelector(ref),
 		"kubevirt.io/api/core/v1.UnpauseOptions":                                                     schema_kubevirtio_api_core_v1_UnpauseOptions(ref),
 		"kubevirt.io/api/core/v1.UserPasswordAccessCredential":                                       schema_kubevirtio_api_core_v1_UserPasswordAccessCredential(ref),
@@ -523,6 +572,8 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"kubevirt.io/api/core/v1.VGPUOptions":                                                        schema_kubevirtio_api_core_v1_VGPUOptions(ref),
 		"kubevirt.io/api/core/v1.VMISelector":                                                        schema_kubevirtio_api_core_v1_VMISelector(ref),
 		"kubevirt.io/api/core/v1.VSOCKOptions":                                                       schema_kubevirtio_api_core_v1_VSOCKOptions(ref),
+		"kubevirt.io/api/core/v1.VirtiofsIDMapping":                                                  schema_kubevirtio_api_core_v1_VirtiofsIDMapping(ref),
+		"kubevirt.io/api/core/v1.VirtiofsIDRange":                                                    schema_kubevirtio_api_core_v1_VirtiofsIDRange(ref),
 		"kubevirt.io/api/core/v1.VirtualMachine":                                                     schema_kubevirtio_api_core_v1_VirtualMachine(ref),
 		"kubevirt.io/api/core/v1.VirtualMachineCondition":                                            schema_kubevirtio_api_core_v1_VirtualMachineCondition(ref),
 		"kubevirt.io/api/core/v1.VirtualMachineInstance":                                             schema_kubevirtio_api_core_v1_VirtualMachineInstance(ref),
@@ -538,6 +589,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"kubevirt.io/api/core/v1.VirtualMachineInstanceList":                                         schema_kubevirtio_api_core_v1_VirtualMachineInstanceList(ref),
 		"kubevirt.io/api/core/v1.VirtualMachineInstanceMigration":                                    schema_kubevirtio_api_core_v1_VirtualMachineInstanceMigration(ref),
 		"kubevirt.io/api/core/v1.VirtualMachineInstanceMigrationCondition":                           schema_kubevirtio_api_core_v1_VirtualMachineInstanceMigrationCondition(ref),
+		"kubevirt.io/api/core/v1.VirtualMachineInstanceMigrationFeasibility":                         schema_kubevirtio_api_core_v1_VirtualMachineInstanceMigrationFeasibility(ref),
 		"kubevirt.io/api/core/v1.VirtualMachineInstanceMigrationList":                                schema_kubevirtio_api_core_v1_VirtualMachineInstanceMigrationList(ref),
 		"kubevirt.io/api/core/v1.VirtualMachineInstanceMigrationPhaseTransitionTimestamp":            schema_kubevirtio_api_core_v1_VirtualMachineInstanceMigrationPhaseTransitionTimestamp(ref),
 		"kubevirt.io/api/core/v1.VirtualMachineInstanceMigrationSpec":                                schema_kubevirtio_api_core_v1_VirtualMachineInstanceMigrationSpec(ref),
@@ -564,9 +616,14 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"kubevirt.io/api/core/v1.VirtualMachineStartFailure":                                         schema_kubevirtio_api_core_v1_VirtualMachineStartFailure(ref),
 		"kubevirt.io/api/core/v1.VirtualMachineStateChangeRequest":                                   schema_kubevirtio_api_core_v1_VirtualMachineStateChangeRequest(ref),
 		"kubevirt.io/api/core/v1.VirtualMachineStatus":                                               schema_kubevirtio_api_core_v1_VirtualMachineStatus(ref),
+		"kubevirt.io/api/core/v1.VirtualMachineStorageMigration":                                     schema_kubevirtio_api_core_v1_VirtualMachineStorageMigration(ref),
+		"kubevirt.io/api/core/v1.VirtualMachineStorageMigrationStatus":                               schema_kubevirtio_api_core_v1_VirtualMachineStorageMigrationStatus(ref),
+		"kubevirt.io/api/core/v1.VirtualMachineStorageMigrationVolumeStatus":                         schema_kubevirtio_api_core_v1_VirtualMachineStorageMigrationVolumeStatus(ref),
 		"kubevirt.io/api/core/v1.VirtualMachineVolumeRequest":                                        schema_kubevirtio_api_core_v1_VirtualMachineVolumeRequest(ref),
 		"kubevirt.io/api/core/v1.Volume":                                                             schema_kubevirtio_api_core_v1_Volume(ref),
+		"kubevirt.io/api/core/v1.VolumeAutoExpansion":                                                schema_kubevirtio_api_core_v1_VolumeAutoExpansion(ref),
 		"kubevirt.io/api/core/v1.VolumeMigrationState":                                               schema_kubevirtio_api_core_v1_VolumeMigrationState(ref),
+		"kubevirt.io/api/core/v1.VolumePreparationLimit":                                             schema_kubevirtio_api_core_v1_VolumePreparationLimit(ref),
 		"kubevirt.io/api/core/v1.VolumeSnapshotStatus":                                               schema_kubevirtio_api_core_v1_VolumeSnapshotStatus(ref),
 		"kubevirt.io/api/core/v1.VolumeSource":                                                       schema_kubevirtio_api_core_v1_VolumeSource(ref),
 		"kubevirt.io/api/core/v1.VolumeStatus":                                                       schema_kubevirtio_api_core_v1_VolumeStatus(ref),
@@ -642,6 +699,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"kubevirt.io/api/instancetype/v1beta1.MachinePreferences":                                    schema_kubevirtio_api_instancetype_v1beta1_MachinePreferences(ref),
 		"kubevirt.io/api/instancetype/v1beta1.MemoryInstancetype":                                    schema_kubevirtio_api_instancetype_v1beta1_MemoryInstancetype(ref),
 		"kubevirt.io/api/instancetype/v1beta1.MemoryPreferenceRequirement":                           schema_kubevirtio_api_instancetype_v1beta1_MemoryPreferenceRequirement(ref),
+		"kubevirt.io/api/instancetype/v1beta1.PreferenceReference":                                   schema_kubevirtio_api_instancetype_v1beta1_PreferenceReference(ref),
 		"kubevirt.io/api/instancetype/v1beta1.PreferenceRequirements":                                schema_kubevirtio_api_instancetype_v1beta1_PreferenceRequirements(ref),
 		"kubevirt.io/api/instancetype/v1beta1.SpreadOptions":                                         schema_kubevirtio_api_instancetype_v1beta1_SpreadOptions(ref),
 		"kubevirt.io/api/instancetype/v1beta1.VirtualMachineClusterInstancetype":                     schema_kubevirtio_api_instancetype_v1beta1_VirtualMachineClusterInstancetype(ref),
@@ -655,6 +713,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"kubevirt.io/api/instancetype/v1beta1.VirtualMachinePreferenceList":                          schema_kubevirtio_api_instancetype_v1beta1_VirtualMachinePreferenceList(ref),
 		"kubevirt.io/api/instancetype/v1beta1.VirtualMachinePreferenceSpec":                          schema_kubevirtio_api_instancetype_v1beta1_VirtualMachinePreferenceSpec(ref),
 		"kubevirt.io/api/instancetype/v1beta1.VolumePreferences":                                     schema_kubevirtio_api_instancetype_v1beta1_VolumePreferences(ref),
+		"kubevirt.io/api/migrations/v1alpha1.MaintenanceWindow":                                      schema_kubevirtio_api_migrations_v1alpha1_MaintenanceWindow(ref),
 		"kubevirt.io/api/migrations/v1alpha1.MigrationPolicy":                                        schema_kubevirtio_api_migrations_v1alpha1_MigrationPolicy(ref),
 		"kubevirt.io/api/migrations/v1alpha1.MigrationPolicyList":                                    schema_kubevirtio_api_migrations_v1alpha1_MigrationPolicyList(ref),
 		"kubevirt.io/api/migrations/v1alpha1.MigrationPolicySpec":                                    schema_kubevirtio_api_migrations_v1alpha1_MigrationPolicySpec(ref),
@@ -667,6 +726,13 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"kubevirt.io/api/pool/v1alpha1.VirtualMachinePoolSpec":                                       schema_kubevirtio_api_pool_v1alpha1_VirtualMachinePoolSpec(ref),
 		"kubevirt.io/api/pool/v1alpha1.VirtualMachinePoolStatus":                                     schema_kubevirtio_api_pool_v1alpha1_VirtualMachinePoolStatus(ref),
 		"kubevirt.io/api/pool/v1alpha1.VirtualMachineTemplateSpec":                                   schema_kubevirtio_api_pool_v1alpha1_VirtualMachineTemplateSpec(ref),
+		"kubevirt.io/api/prefetch/v1alpha1.Condition":                                                schema_kubevirtio_api_prefetch_v1alpha1_Condition(ref),
+		"kubevirt.io/api/prefetch/v1alpha1.ContainerDiskImage":                                       schema_kubevirtio_api_prefetch_v1alpha1_ContainerDiskImage(ref),
+		"kubevirt.io/api/prefetch/v1alpha1.DataSourceReference":                                      schema_kubevirtio_api_prefetch_v1alpha1_DataSourceReference(ref),
+		"kubevirt.io/api/prefetch/v1alpha1.ImagePrefetch":                                            schema_kubevirtio_api_prefetch_v1alpha1_ImagePrefetch(ref),
+		"kubevirt.io/api/prefetch/v1alpha1.ImagePrefetchList":                                        schema_kubevirtio_api_prefetch_v1alpha1_ImagePrefetchList(ref),
+		"kubevirt.io/api/prefetch/v1alpha1.ImagePrefetchSpec":                                        schema_kubevirtio_api_prefetch_v1alpha1_ImagePrefetchSpec(ref),
+		"kubevirt.io/api/prefetch/v1alpha1.ImagePrefetchStatus":                                      schema_kubevirtio_api_prefetch_v1alpha1_ImagePrefetchStatus(ref),
 		"kubevirt.io/api/snapshot/v1alpha1.Condition":                                                schema_kubevirtio_api_snapshot_v1alpha1_Condition(ref),
 		"kubevirt.io/api/snapshot/v1alpha1.Error":                                                    schema_kubevirtio_api_snapshot_v1alpha1_Error(ref),
 		"kubevirt.io/api/snapshot/v1alpha1.PersistentVolumeClaim":                                    schema_kubevirtio_api_snapshot_v1alpha1_PersistentVolumeClaim(ref),
@@ -704,6 +770,10 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"kubevirt.io/api/snapshot/v1beta1.VirtualMachineSnapshotContentSpec":                         schema_kubevirtio_api_snapshot_v1beta1_VirtualMachineSnapshotContentSpec(ref),
 		"kubevirt.io/api/snapshot/v1beta1.VirtualMachineSnapshotContentStatus":                       schema_kubevirtio_api_snapshot_v1beta1_VirtualMachineSnapshotContentStatus(ref),
 		"kubevirt.io/api/snapshot/v1beta1.VirtualMachineSnapshotList":                                schema_kubevirtio_api_snapshot_v1beta1_VirtualMachineSnapshotList(ref),
+		"kubevirt.io/api/snapshot/v1beta1.VirtualMachineSnapshotSchedule":                            schema_kubevirtio_api_snapshot_v1beta1_VirtualMachineSnapshotSchedule(ref),
+		"kubevirt.io/api/snapshot/v1beta1.VirtualMachineSnapshotScheduleList":                        schema_kubevirtio_api_snapshot_v1beta1_VirtualMachineSnapshotScheduleList(ref),
+		"kubevirt.io/api/snapshot/v1beta1.VirtualMachineSnapshotScheduleSpec":                        schema_kubevirtio_api_snapshot_v1beta1_VirtualMachineSnapshotScheduleSpec(ref),
+		"kubevirt.io/api/snapshot/v1beta1.VirtualMachineSnapshotScheduleStatus":                      schema_kubevirtio_api_snapshot_v1beta1_VirtualMachineSnapshotScheduleStatus(ref),
 		"kubevirt.io/api/snapshot/v1beta1.VirtualMachineSnapshotSpec":                                schema_kubevirtio_api_snapshot_v1beta1_VirtualMachineSnapshotSpec(ref),
 		"kubevirt.io/api/snapshot/v1beta1.VirtualMachineSnapshotStatus":                              schema_kubevirtio_api_snapshot_v1beta1_VirtualMachineSnapshotStatus(ref),
 		"kubevirt.io/api/snapshot/v1beta1.VolumeBackup":                                              schema_kubevirtio_api_snapshot_v1beta1_VolumeBackup(ref),
@@ -17859,7 +17929,7 @@ func schema_kubevirtio_api_core_v1_CPU(ref common.ReferenceCallback) common.Open
 					},
 					"model": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Model specifies the CPU model inside the VMI. List of available models https://github.com/libvirt/libvirt/tree/master/src/cpu_map. It is possible to specify special cases like \"host-passthrough\" to get the same CPU as the node and \"host-model\" to get CPU closest to the node one. Defaults to host-model.",
+							Description: "Model specifies the CPU model inside the VMI. List of available models https://github.com/libvirt/libvirt/tree/master/src/cpu_map. It is possible to specify special cases like \"host-passthrough\" to get the same CPU as the node and \"host-model\" to get CPU closest to the node one. \"cluster-common\" selects the CPU model and features common to the nodes matching the node selector of the VMI. Defaults to host-model.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
@@ -17904,11 +17974,130 @@ func schema_kubevirtio_api_core_v1_CPU(ref common.ReferenceCallback) common.Open
 							Ref:         ref("kubevirt.io/api/core/v1.Realtime"),
 						},
 					},
+					"housekeeping": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Housekeeping gives the QEMU threads which do not run vCPUs, like the emulator and IOThreads, their own CPU budget in a child cgroup of the compute container, so they don't take CPU time from the vCPUs. Can not be combined with DedicatedCPUPlacement, use IsolateEmulatorThread instead.",
+							Ref:         ref("kubevirt.io/api/core/v1.Housekeeping"),
+						},
+					},
+					"threadPlacement": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ThreadPlacement defines on which pCPUs the emulator thread and the IOThreads run. Requires DedicatedCPUPlacement.",
+							Ref:         ref("kubevirt.io/api/core/v1.ThreadPlacement"),
+						},
+					},
+					"mitigatedVulnerabilities": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "MitigatedVulnerabilities lists the CPU vulnerabilities, as named by the kernel in /sys/devices/system/cpu/vulnerabilities, e.g. retbleed or mds, the node of the VMI has to be mitigated against or not affected by.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"kubevirt.io/api/core/v1.CPUFeature", "kubevirt.io/api/core/v1.NUMA", "kubevirt.io/api/core/v1.Realtime"},
+			"kubevirt.io/api/core/v1.CPUFeature", "kubevirt.io/api/core/v1.Housekeeping", "kubevirt.io/api/core/v1.NUMA", "kubevirt.io/api/core/v1.Realtime", "kubevirt.io/api/core/v1.ThreadPlacement"},
+	}
+}
+
+func schema_kubevirtio_api_core_v1_CPUFairShareConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "CPUFairShareConfiguration holds the CPU tiers of the namespaces. virt-handler sets the cgroup cpu.weight of the virt-launcher pods to the weight of the tier of their namespace, pods in namespaces without a tier keep the weight assigned by the kubelet.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"tiers": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"name",
+								},
+								"x-kubernetes-list-type": "map",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Tiers are the CPU tiers, a namespace should only be part of one tier",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("kubevirt.io/api/core/v1.CPUFairShareTier"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"kubevirt.io/api/core/v1.CPUFairShareTier"},
+	}
+}
+
+func schema_kubevirtio_api_core_v1_CPUFairShareTier(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "CPUFairShareTier is a group of namespaces sharing the same CPU weight",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name of the tier",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"weight": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Weight is the cgroup v2 cpu.weight of the virt-launcher pods of the tier, in the range 1-10000. The kubelet derives a weight of about 40 per requested CPU",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"namespaces": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Namespaces of the tier",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"name", "weight"},
+			},
+		},
 	}
 }
 
@@ -17975,6 +18164,77 @@ func schema_kubevirtio_api_core_v1_CPUTopology(ref common.ReferenceCallback) com
 	}
 }
 
+func schema_kubevirtio_api_core_v1_CanaryConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "CanaryConfiguration holds the settings of the canary VirtualMachineInstance. A single canary run happens at a time, a new run starts once the interval passed since the start of the last one.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"image": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Image is the containerDisk image booted by the canary VirtualMachineInstance",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"namespace": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Namespace is the namespace the canary VirtualMachineInstance is created in. Defaults to the namespace KubeVirt is installed in",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"interval": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Interval is the time between the starts of two canary runs. Defaults to 10m",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"timeout": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Timeout is the time the canary VirtualMachineInstance has to become ready before the run fails. Defaults to 5m",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"memory": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Memory is the guest memory of the canary VirtualMachineInstance. Defaults to 128Mi",
+							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+						},
+					},
+					"nodeSelector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NodeSelector restricts the nodes the canary VirtualMachineInstance is scheduled on",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"readinessProbe": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ReadinessProbe has to succeed before the canary VirtualMachineInstance counts as ready. Without a probe the canary is ready once it is running",
+							Ref:         ref("kubevirt.io/api/core/v1.Probe"),
+						},
+					},
+				},
+				Required: []string{"image"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/api/resource.Quantity", "k8s.io/apimachinery/pkg/apis/meta/v1.Duration", "kubevirt.io/api/core/v1.Probe"},
+	}
+}
+
 func schema_kubevirtio_api_core_v1_CertConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -18002,6 +18262,34 @@ func schema_kubevirtio_api_core_v1_CertConfig(ref common.ReferenceCallback) comm
 	}
 }
 
+func schema_kubevirtio_api_core_v1_CgroupLayout(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "CgroupLayout describes the cgroups of the compute container of the virtual machine instance",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"version": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Version is the version of the cgroup hierarchy on the node, v1 or v2",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"housekeeping": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Housekeeping is the child cgroup the housekeeping threads are moved to",
+							Ref:         ref("kubevirt.io/api/core/v1.HousekeepingCgroup"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"kubevirt.io/api/core/v1.HousekeepingCgroup"},
+	}
+}
+
 func schema_kubevirtio_api_core_v1_Chassis(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -18082,11 +18370,17 @@ func schema_kubevirtio_api_core_v1_Clock(ref common.ReferenceCallback) common.Op
 							Ref:         ref("kubevirt.io/api/core/v1.Timer"),
 						},
 					},
+					"guestTimeSync": {
+						SchemaProps: spec.SchemaProps{
+							Description: "GuestTimeSync configures when the guest clock is set to the host time through the guest agent.",
+							Ref:         ref("kubevirt.io/api/core/v1.GuestTimeSync"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"kubevirt.io/api/core/v1.ClockOffsetUTC", "kubevirt.io/api/core/v1.Timer"},
+			"kubevirt.io/api/core/v1.ClockOffsetUTC", "kubevirt.io/api/core/v1.GuestTimeSync", "kubevirt.io/api/core/v1.Timer"},
 	}
 }
 
@@ -18670,11 +18964,37 @@ func schema_kubevirtio_api_core_v1_DHCPOptions(ref common.ReferenceCallback) com
 							},
 						},
 					},
+					"mtu": {
+						SchemaProps: spec.SchemaProps{
+							Description: "If specified will pass the MTU to the VM via DHCP option 026 instead of the MTU of the pod interface.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"routes": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "If specified will pass the routes to the VM via DHCP option 121, in addition to the routes of the pod interface.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("kubevirt.io/api/core/v1.DHCPRoute"),
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"kubevirt.io/api/core/v1.DHCPPrivateOptions"},
+			"kubevirt.io/api/core/v1.DHCPPrivateOptions", "kubevirt.io/api/core/v1.DHCPRoute"},
 	}
 }
 
@@ -18708,6 +19028,35 @@ func schema_kubevirtio_api_core_v1_DHCPPrivateOptions(ref common.ReferenceCallba
 	}
 }
 
+func schema_kubevirtio_api_core_v1_DHCPRoute(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DHCPRoute defines a classless static route passed to the VM via DHCP.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"destination": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Destination is the IPv4 CIDR of the route Required.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"gateway": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Gateway is the IPv4 address of the next hop, the route is on-link if omitted",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"destination"},
+			},
+		},
+	}
+}
+
 func schema_kubevirtio_api_core_v1_DataVolumeSource(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -18794,6 +19143,52 @@ func schema_kubevirtio_api_core_v1_DataVolumeTemplateSpec(ref common.ReferenceCa
 	}
 }
 
+func schema_kubevirtio_api_core_v1_DedicatedCPUPool(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DedicatedCPUPool is a set of host CPUs reserved on the nodes matching its node selector. virt-handler pins the virt-launcher pods of the VirtualMachineInstances with dedicated CPU placement to exclusive CPUs of the pool, and keeps the burstable and best-effort pods off the pool.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name of the pool, the nodes are labeled with the name of their pool",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"nodeSelector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NodeSelector selects the nodes the pool is reserved on, the first pool matching a node is used. The pool is reserved on all the nodes if it is empty",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"cpus": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CPUs of the pool, in the cpuset list format, e.g. 4-15,20-23",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name", "cpus"},
+			},
+		},
+	}
+}
+
 func schema_kubevirtio_api_core_v1_DeprecatedInterfaceMacvtap(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -19132,6 +19527,25 @@ func schema_kubevirtio_api_core_v1_Devices(ref common.ReferenceCallback) common.
 							},
 						},
 					},
+					"usbDevices": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "USBDevices are host USB devices passed through to the vmi. They are allocated by virt-handler among the devices plugged into the node, and attached to the vmi as they get plugged.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("kubevirt.io/api/core/v1.USBDevice"),
+									},
+								},
+							},
+						},
+					},
 					"clientPassthrough": {
 						SchemaProps: spec.SchemaProps{
 							Description: "To configure and access client devices such as redirecting USB",
@@ -19154,7 +19568,7 @@ func schema_kubevirtio_api_core_v1_Devices(ref common.ReferenceCallback) common.
 			},
 		},
 		Dependencies: []string{
-			"kubevirt.io/api/core/v1.ClientPassthroughDevices", "kubevirt.io/api/core/v1.Disk", "kubevirt.io/api/core/v1.DownwardMetrics", "kubevirt.io/api/core/v1.Filesystem", "kubevirt.io/api/core/v1.GPU", "kubevirt.io/api/core/v1.HostDevice", "kubevirt.io/api/core/v1.Input", "kubevirt.io/api/core/v1.Interface", "kubevirt.io/api/core/v1.Rng", "kubevirt.io/api/core/v1.SoundDevice", "kubevirt.io/api/core/v1.TPMDevice", "kubevirt.io/api/core/v1.Watchdog"},
+			"kubevirt.io/api/core/v1.ClientPassthroughDevices", "kubevirt.io/api/core/v1.Disk", "kubevirt.io/api/core/v1.DownwardMetrics", "kubevirt.io/api/core/v1.Filesystem", "kubevirt.io/api/core/v1.GPU", "kubevirt.io/api/core/v1.HostDevice", "kubevirt.io/api/core/v1.Input", "kubevirt.io/api/core/v1.Interface", "kubevirt.io/api/core/v1.Rng", "kubevirt.io/api/core/v1.SoundDevice", "kubevirt.io/api/core/v1.TPMDevice", "kubevirt.io/api/core/v1.USBDevice", "kubevirt.io/api/core/v1.Watchdog"},
 	}
 }
 
@@ -19272,12 +19686,18 @@ func schema_kubevirtio_api_core_v1_Disk(ref common.ReferenceCallback) common.Ope
 							Format:      "",
 						},
 					},
+					"ioTune": {
+						SchemaProps: spec.SchemaProps{
+							Description: "IOTune limits the IO operations and the throughput of the disk. It can be set when hotplugging the disk.",
+							Ref:         ref("kubevirt.io/api/core/v1.DiskIOTune"),
+						},
+					},
 				},
 				Required: []string{"name"},
 			},
 		},
 		Dependencies: []string{
-			"kubevirt.io/api/core/v1.BlockSize", "kubevirt.io/api/core/v1.CDRomTarget", "kubevirt.io/api/core/v1.DiskTarget", "kubevirt.io/api/core/v1.LunTarget"},
+			"kubevirt.io/api/core/v1.BlockSize", "kubevirt.io/api/core/v1.CDRomTarget", "kubevirt.io/api/core/v1.DiskIOTune", "kubevirt.io/api/core/v1.DiskTarget", "kubevirt.io/api/core/v1.LunTarget"},
 	}
 }
 
@@ -19333,6 +19753,60 @@ func schema_kubevirtio_api_core_v1_DiskIOThreads(ref common.ReferenceCallback) c
 	}
 }
 
+func schema_kubevirtio_api_core_v1_DiskIOTune(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DiskIOTune represents the IO limits of a disk. A total limit can't be combined with the read or write limit of the same kind.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"totalBytesSec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TotalBytesSec limits the total throughput of reads and writes in bytes per second.",
+							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+						},
+					},
+					"readBytesSec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ReadBytesSec limits the read throughput in bytes per second.",
+							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+						},
+					},
+					"writeBytesSec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "WriteBytesSec limits the write throughput in bytes per second.",
+							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+						},
+					},
+					"totalIOPSSec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TotalIOPSSec limits the total number of read and write operations per second.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"readIOPSSec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ReadIOPSSec limits the number of read operations per second.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"writeIOPSSec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "WriteIOPSSec limits the number of write operations per second.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/api/resource.Quantity"},
+	}
+}
+
 func schema_kubevirtio_api_core_v1_DiskTarget(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -19420,11 +19894,17 @@ func schema_kubevirtio_api_core_v1_DomainMemoryDumpInfo(ref common.ReferenceCall
 							Format:      "",
 						},
 					},
+					"progress": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Progress is the share of the guest memory dumped so far",
+							Ref:         ref("kubevirt.io/api/core/v1.MemoryDumpProgress"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time", "kubevirt.io/api/core/v1.MemoryDumpProgress"},
 	}
 }
 
@@ -19624,6 +20104,36 @@ func schema_kubevirtio_api_core_v1_EmptyDiskSource(ref common.ReferenceCallback)
 	}
 }
 
+func schema_kubevirtio_api_core_v1_EphemeralOverlay(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "EphemeralOverlay describes the volume holding the overlay of an ephemeral disk. The volume is provisioned together with the virt-launcher pod and deleted with it, so it fits node-local storage classes best.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"storageClassName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "StorageClassName is the storage class of the overlay volume.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"capacity": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Capacity of the overlay volume, limiting how much the guest can write.",
+							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+						},
+					},
+				},
+				Required: []string{"storageClassName", "capacity"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/api/resource.Quantity"},
+	}
+}
+
 func schema_kubevirtio_api_core_v1_EphemeralVolumeSource(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -19636,11 +20146,65 @@ func schema_kubevirtio_api_core_v1_EphemeralVolumeSource(ref common.ReferenceCal
 							Ref:         ref("k8s.io/api/core/v1.PersistentVolumeClaimVolumeSource"),
 						},
 					},
+					"overlay": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Overlay places the copy-on-write overlay holding the writes of the guest on a dedicated volume instead of the ephemeral storage of the pod.",
+							Ref:         ref("kubevirt.io/api/core/v1.EphemeralOverlay"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/api/core/v1.PersistentVolumeClaimVolumeSource"},
+			"k8s.io/api/core/v1.PersistentVolumeClaimVolumeSource", "kubevirt.io/api/core/v1.EphemeralOverlay"},
+	}
+}
+
+func schema_kubevirtio_api_core_v1_FSFreezeInfo(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "FSFreezeInfo reports which guest filesystems are frozen and for how long they were frozen",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"volumes": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Volumes lists the volumes whose guest filesystems are frozen. All the guest filesystems are frozen if it's empty.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"freezeTimestamp": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FreezeTimestamp is the time the guest filesystems were frozen at",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"lastFreezeDurationSeconds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastFreezeDurationSeconds is the duration of the most recent freeze which ended",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
@@ -19951,8 +20515,25 @@ func schema_kubevirtio_api_core_v1_FilesystemVirtiofs(ref common.ReferenceCallba
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"cache": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Cache selects the caching policy of the virtiofs daemon serving the filesystem. Use never when other pods write to the same volume concurrently, so that the guest always sees their changes. Supported values: auto, always, never. Defaults to auto.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"idMapping": {
+						SchemaProps: spec.SchemaProps{
+							Description: "IDMapping translates the user and group IDs seen by the guest into the IDs stored on the shared volume. Use it to let the guest and other pods sharing the volume agree on file ownership.",
+							Ref:         ref("kubevirt.io/api/core/v1.VirtiofsIDMapping"),
+						},
+					},
+				},
 			},
 		},
+		Dependencies: []string{
+			"kubevirt.io/api/core/v1.VirtiofsIDMapping"},
 	}
 }
 
@@ -20072,6 +20653,26 @@ func schema_kubevirtio_api_core_v1_FreezeUnfreezeTimeout(ref common.ReferenceCal
 							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
 						},
 					},
+					"volumes": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Volumes lists the volumes whose guest filesystems should be frozen. The disks of the volumes need a serial to identify their filesystems in the guest. All the guest filesystems are frozen if it's empty.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
 				},
 				Required: []string{"unfreezeTimeout"},
 			},
@@ -20221,6 +20822,97 @@ func schema_kubevirtio_api_core_v1_GuestAgentPing(ref common.ReferenceCallback)
 	}
 }
 
+func schema_kubevirtio_api_core_v1_GuestFilesystemUsage(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "GuestFilesystemUsage sums up the guest filesystems on a volume",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"usedBytes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "UsedBytes is the space used on the filesystems",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"totalBytes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TotalBytes is the size of the filesystems",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+				},
+				Required: []string{"usedBytes", "totalBytes"},
+			},
+		},
+	}
+}
+
+func schema_kubevirtio_api_core_v1_GuestLicense(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "GuestLicense describes the licensing of the guest operating system, for license compliance reporting.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"osFamily": {
+						SchemaProps: spec.SchemaProps{
+							Description: "OSFamily is the family of the guest operating system, one of linux, windows or other. If not set, it is detected from the operating system reported by the guest agent.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"licenseModel": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LicenseModel is the model the guest operating system is licensed under, like subscription or perCore.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"byol": {
+						SchemaProps: spec.SchemaProps{
+							Description: "BYOL tells if the license of the guest operating system is brought by the owner of the VirtualMachineInstance instead of being provided with the cluster.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_kubevirtio_api_core_v1_GuestTimeSync(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "GuestTimeSync configures when the guest clock is set to the host time through the guest agent.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"policy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Policy selects when the guest clock is set to the host time. \"OnResume\" sets it after a live migration and when the vmi is unpaused. \"OnDrift\" additionally sets it whenever the guest clock drifts away from the host clock by more than the drift threshold, e.g. after the node resumed from suspend. \"Disabled\" never sets it, leaving the guest clock to the time synchronization of the guest. Defaults to \"OnResume\".",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"driftThresholdSeconds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DriftThresholdSeconds is the drift of the guest clock above which it is set to the host time with the \"OnDrift\" policy. Defaults to 2.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_kubevirtio_api_core_v1_HPETTimer(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -20420,6 +21112,65 @@ func schema_kubevirtio_api_core_v1_HotplugVolumeStatus(ref common.ReferenceCallb
 	}
 }
 
+func schema_kubevirtio_api_core_v1_Housekeeping(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Housekeeping holds the CPU budget of the housekeeping threads of the VMI.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"cpu": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CPU is added to the CPU requests of the compute container, and to its CPU limits if they are set. The housekeeping threads are limited to this amount of CPU, the rest is left to the vCPUs.",
+							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+						},
+					},
+				},
+				Required: []string{"cpu"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/api/resource.Quantity"},
+	}
+}
+
+func schema_kubevirtio_api_core_v1_HousekeepingCgroup(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "HousekeepingCgroup describes the child cgroup of the housekeeping threads",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the child cgroup below the cgroup of the compute container",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"cpuSet": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CPUSet are the CPUs the housekeeping threads are pinned to",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"cpuLimit": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CPULimit is the CPU time the housekeeping threads are limited to",
+							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/api/resource.Quantity"},
+	}
+}
+
 func schema_kubevirtio_api_core_v1_Hugepages(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -20440,6 +21191,60 @@ func schema_kubevirtio_api_core_v1_Hugepages(ref common.ReferenceCallback) commo
 	}
 }
 
+func schema_kubevirtio_api_core_v1_HugepagesPool(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "HugepagesPool is a number of hugepages of a size allocated on the nodes matching its node selector. virt-handler allocates the hugepages at runtime, reports the provisioning in the annotations of the nodes, and frees the hugepages of a size once no pool of that size selects the node anymore.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name of the pool",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"nodeSelector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NodeSelector selects the nodes the hugepages are allocated on, the first pool of a page size matching a node is used. The hugepages are allocated on all the nodes if it is empty",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"pageSize": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PageSize of the hugepages, e.g. 2Mi or 1Gi",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"count": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Count is the number of hugepages allocated on each node",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+				Required: []string{"name", "pageSize", "count"},
+			},
+		},
+	}
+}
+
 func schema_kubevirtio_api_core_v1_HyperVPassthrough(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -20497,6 +21302,36 @@ func schema_kubevirtio_api_core_v1_I6300ESBWatchdog(ref common.ReferenceCallback
 	}
 }
 
+func schema_kubevirtio_api_core_v1_IOThreadPlacement(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "IOThreadPlacement reports the host CPUs an IOThread is pinned to",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"id": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ID is the id of the IOThread",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"cpuSet": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CPUSet are the host CPUs the IOThread is pinned to",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"id", "cpuSet"},
+			},
+		},
+	}
+}
+
 func schema_kubevirtio_api_core_v1_InitrdInfo(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -20566,9 +21401,50 @@ func schema_kubevirtio_api_core_v1_InstancetypeConfiguration(ref common.Referenc
 							Format:      "",
 						},
 					},
+					"revisionUpgradePolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RevisionUpgradePolicy defines if VMs referencing an outdated ControllerRevision of an instance type are moved to the latest version, supported values are: manual (default) - Where the VM keeps its ControllerRevision until it is changed by the user. onRestart - Where the VM is moved to a ControllerRevision of the latest version of the instance type while it is not running, so it is used at the next start. The policy can be overridden per VM with the instancetype.kubevirt.io/revision-upgrade-policy annotation.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"nodeCompatibilityPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NodeCompatibilityPolicy defines how VMs are admitted when no node provides the hugepages, dedicated CPUs, GPUs or host devices of their instance type, supported values are: ignore (default) - Where the VM is admitted without looking at the nodes. warn - Where the VM is admitted with a warning. reject - Where the VM is rejected.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"namespacePolicies": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "NamespacePolicies restrict which cluster instance types and cluster preferences the VMs of the selected namespaces may reference. If policies selecting a namespace list cluster instance types, VMs in the namespace may only reference the cluster instance types listed by any of them, the same applies to cluster preferences. Namespaced instance types and preferences are not restricted.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("kubevirt.io/api/core/v1.InstancetypeNamespacePolicy"),
+									},
+								},
+							},
+						},
+					},
+					"rightsizing": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Rightsizing enables the recommendation of cluster instance types from the observed usage of the VMs. The recommendation is set in the instancetype.kubevirt.io/recommended-instancetype annotation of the VMs.",
+							Ref:         ref("kubevirt.io/api/core/v1.InstancetypeRightsizing"),
+						},
+					},
 				},
 			},
 		},
+		Dependencies: []string{
+			"kubevirt.io/api/core/v1.InstancetypeNamespacePolicy", "kubevirt.io/api/core/v1.InstancetypeRightsizing"},
 	}
 }
 
@@ -20620,6 +21496,99 @@ func schema_kubevirtio_api_core_v1_InstancetypeMatcher(ref common.ReferenceCallb
 	}
 }
 
+func schema_kubevirtio_api_core_v1_InstancetypeNamespacePolicy(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "InstancetypeNamespacePolicy allows the VMs of the selected namespaces to reference the listed cluster instance types and cluster preferences",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"namespaceSelector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NamespaceSelector selects the namespaces the policy applies to, an empty selector selects all namespaces",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"),
+						},
+					},
+					"clusterInstancetypes": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "ClusterInstancetypes lists the names of the VirtualMachineClusterInstancetypes the VMs may reference",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"clusterPreferences": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "ClusterPreferences lists the names of the VirtualMachineClusterPreferences the VMs may reference",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"namespaceSelector"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"},
+	}
+}
+
+func schema_kubevirtio_api_core_v1_InstancetypeRightsizing(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "InstancetypeRightsizing configures where the usage of the VMs is read from and how long it is observed",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"prometheusURL": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PrometheusURL is the address of the Prometheus API the kubevirt_vmi_vcpu_seconds_total and kubevirt_vmi_memory_used_bytes metrics are queried from",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"window": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Window is the period the usage of the VMs is observed over, defaults to 24h",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+				},
+				Required: []string{"prometheusURL"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+	}
+}
+
 func schema_kubevirtio_api_core_v1_InstancetypeStatusRef(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -20790,12 +21759,79 @@ func schema_kubevirtio_api_core_v1_Interface(ref common.ReferenceCallback) commo
 							Format:      "",
 						},
 					},
+					"bandwidth": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Bandwidth limits the traffic of the interface. It is only supported on bridge and masquerade bindings.",
+							Ref:         ref("kubevirt.io/api/core/v1.InterfaceBandwidth"),
+						},
+					},
 				},
 				Required: []string{"name"},
 			},
 		},
 		Dependencies: []string{
-			"kubevirt.io/api/core/v1.DHCPOptions", "kubevirt.io/api/core/v1.DeprecatedInterfaceMacvtap", "kubevirt.io/api/core/v1.DeprecatedInterfacePasst", "kubevirt.io/api/core/v1.DeprecatedInterfaceSlirp", "kubevirt.io/api/core/v1.InterfaceBridge", "kubevirt.io/api/core/v1.InterfaceMasquerade", "kubevirt.io/api/core/v1.InterfaceSRIOV", "kubevirt.io/api/core/v1.PluginBinding", "kubevirt.io/api/core/v1.Port"},
+			"kubevirt.io/api/core/v1.DHCPOptions", "kubevirt.io/api/core/v1.DeprecatedInterfaceMacvtap", "kubevirt.io/api/core/v1.DeprecatedInterfacePasst", "kubevirt.io/api/core/v1.DeprecatedInterfaceSlirp", "kubevirt.io/api/core/v1.InterfaceBandwidth", "kubevirt.io/api/core/v1.InterfaceBridge", "kubevirt.io/api/core/v1.InterfaceMasquerade", "kubevirt.io/api/core/v1.InterfaceSRIOV", "kubevirt.io/api/core/v1.PluginBinding", "kubevirt.io/api/core/v1.Port"},
+	}
+}
+
+func schema_kubevirtio_api_core_v1_InterfaceBandwidth(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "InterfaceBandwidth represents the traffic limits of an interface.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"inbound": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Inbound limits the traffic received by the guest.",
+							Ref:         ref("kubevirt.io/api/core/v1.InterfaceBandwidthLimit"),
+						},
+					},
+					"outbound": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Outbound limits the traffic transmitted by the guest.",
+							Ref:         ref("kubevirt.io/api/core/v1.InterfaceBandwidthLimit"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"kubevirt.io/api/core/v1.InterfaceBandwidthLimit"},
+	}
+}
+
+func schema_kubevirtio_api_core_v1_InterfaceBandwidthLimit(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "InterfaceBandwidthLimit represents the traffic limits of an interface in one direction.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"average": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Average is the average rate the traffic is shaped to, in bytes per second.",
+							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+						},
+					},
+					"peak": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Peak is the rate the traffic may reach while bursting, in bytes per second.",
+							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+						},
+					},
+					"burst": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Burst is the amount of bytes which may be transferred at the peak rate.",
+							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+						},
+					},
+				},
+				Required: []string{"average"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/api/resource.Quantity"},
 	}
 }
 
@@ -20888,7 +21924,7 @@ func schema_kubevirtio_api_core_v1_InterfaceBindingPlugin(ref common.ReferenceCa
 					},
 					"domainAttachmentType": {
 						SchemaProps: spec.SchemaProps{
-							Description: "DomainAttachmentType is a standard domain network attachment method kubevirt supports. Supported values: \"tap\", \"managedTap\" (since v1.4). The standard domain attachment can be used instead or in addition to the sidecarImage. version: 1alphav1",
+							Description: "DomainAttachmentType is a standard domain network attachment method kubevirt supports. Supported values: \"tap\", \"managedTap\" (since v1.4), \"vdpa\" (since v1.6). The standard domain attachment can be used instead or in addition to the sidecarImage. version: 1alphav1",
 							Type:        []string{"string"},
 							Format:      "",
 						},
@@ -20962,15 +21998,83 @@ func schema_kubevirtio_api_core_v1_KSMConfiguration(ref common.ReferenceCallback
 				Properties: map[string]spec.Schema{
 					"nodeLabelSelector": {
 						SchemaProps: spec.SchemaProps{
-							Description: "NodeLabelSelector is a selector that filters in which nodes the KSM will be enabled. Empty NodeLabelSelector will enable ksm for every node.",
+							Description: "NodeLabelSelector is a selector that filters in which nodes the KSM will be enabled. Empty NodeLabelSelector will enable ksm for every node. The kubevirt.io/ksm-policy label of a node, enabled or disabled, takes precedence over it.",
 							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"),
 						},
 					},
+					"tuning": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Tuning holds the parameters of the KSM scanning on the nodes. The KSM override annotations of a node take precedence over them.",
+							Ref:         ref("kubevirt.io/api/core/v1.KSMTuning"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector", "kubevirt.io/api/core/v1.KSMTuning"},
+	}
+}
+
+func schema_kubevirtio_api_core_v1_KSMTuning(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "KSMTuning holds the parameters virt-handler uses to adjust the KSM scanning to the memory pressure of the node. While the node is under memory pressure, the pages to scan start at PagesInit and grow by PagesBoost on every heartbeat up to PagesMax. Without pressure, they shrink by PagesDecay down to PagesMin, where KSM stops.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"pagesBoost": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PagesBoost is the number of pages to scan added on every heartbeat under memory pressure. Defaults to 300.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"pagesDecay": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PagesDecay is the number of pages to scan added on every heartbeat without memory pressure, it can not be positive. Defaults to -50.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"pagesMin": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PagesMin is the minimum number of pages to scan. Defaults to 64.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"pagesMax": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PagesMax is the maximum number of pages to scan. Defaults to 1250.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"pagesInit": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PagesInit is the number of pages to scan when KSM starts. Defaults to 100.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"sleepMsBaseline": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SleepMsBaseline is the time KSM sleeps between scans on a node with 16GiB of used memory, in milliseconds. The sleep time is scaled down the more memory is used. Defaults to 100.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"freePercent": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FreePercent is the percentage of available memory under which the node is under memory pressure. Defaults to 20.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+			},
+		},
 	}
 }
 
@@ -21503,11 +22607,177 @@ func schema_kubevirtio_api_core_v1_KubeVirtConfiguration(ref common.ReferenceCal
 							Ref:         ref("kubevirt.io/api/core/v1.InstancetypeConfiguration"),
 						},
 					},
+					"startConfiguration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "StartConfiguration limits how many VirtualMachineInstances are allowed to start at the same time",
+							Ref:         ref("kubevirt.io/api/core/v1.StartConfiguration"),
+						},
+					},
+					"canaryConfiguration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CanaryConfiguration enables a canary which periodically creates, boots, probes and deletes a small VirtualMachineInstance and reports the duration and the result of each phase as metrics",
+							Ref:         ref("kubevirt.io/api/core/v1.CanaryConfiguration"),
+						},
+					},
+					"placementHints": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PlacementHints configures an external placement service which virt-controller consults for preferred and forbidden nodes when it creates the pod of a starting VirtualMachineInstance or a migration target pod",
+							Ref:         ref("kubevirt.io/api/core/v1.PlacementHintsConfiguration"),
+						},
+					},
+					"cpuFairShare": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CPUFairShare weights the CPU time of the virt-launcher pods by the tier of their namespace, so that VirtualMachineInstances of higher tiers get more CPU time when a node is CPU saturated",
+							Ref:         ref("kubevirt.io/api/core/v1.CPUFairShareConfiguration"),
+						},
+					},
+					"dedicatedCPUPools": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"name",
+								},
+								"x-kubernetes-list-type": "map",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "DedicatedCPUPools reserve host CPUs of the nodes for the VirtualMachineInstances with dedicated CPU placement, on nodes where the CPU manager policy of the kubelet is not static",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("kubevirt.io/api/core/v1.DedicatedCPUPool"),
+									},
+								},
+							},
+						},
+					},
+					"swapConfiguration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SwapConfiguration lets virt-handler give the overcommitted VirtualMachineInstances access to the swap of the nodes",
+							Ref:         ref("kubevirt.io/api/core/v1.SwapConfiguration"),
+						},
+					},
+					"hugepagesPools": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"name",
+								},
+								"x-kubernetes-list-type": "map",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "HugepagesPools let virt-handler allocate hugepages on the nodes at runtime, so that VirtualMachineInstances backed by hugepages can run without reserving the hugepages on the kernel command line",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("kubevirt.io/api/core/v1.HugepagesPool"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/api/resource.Quantity", "k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector", "kubevirt.io/api/core/v1.ArchConfiguration", "kubevirt.io/api/core/v1.CPUFairShareConfiguration", "kubevirt.io/api/core/v1.CanaryConfiguration", "kubevirt.io/api/core/v1.CommonInstancetypesDeployment", "kubevirt.io/api/core/v1.DedicatedCPUPool", "kubevirt.io/api/core/v1.DeveloperConfiguration", "kubevirt.io/api/core/v1.HugepagesPool", "kubevirt.io/api/core/v1.InstancetypeConfiguration", "kubevirt.io/api/core/v1.KSMConfiguration", "kubevirt.io/api/core/v1.LiveUpdateConfiguration", "kubevirt.io/api/core/v1.MediatedDevicesConfiguration", "kubevirt.io/api/core/v1.MigrationConfiguration", "kubevirt.io/api/core/v1.NetworkConfiguration", "kubevirt.io/api/core/v1.PermittedHostDevices", "kubevirt.io/api/core/v1.PlacementHintsConfiguration", "kubevirt.io/api/core/v1.ReloadableComponentConfiguration", "kubevirt.io/api/core/v1.SMBiosConfiguration", "kubevirt.io/api/core/v1.SeccompConfiguration", "kubevirt.io/api/core/v1.StartConfiguration", "kubevirt.io/api/core/v1.SupportContainerResources", "kubevirt.io/api/core/v1.SwapConfiguration", "kubevirt.io/api/core/v1.TLSConfiguration", "kubevirt.io/api/core/v1.VirtualMachineOptions"},
+	}
+}
+
+func schema_kubevirtio_api_core_v1_KubeVirtFleetSummary(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "KubeVirtFleetSummary aggregates the state of the workloads and nodes managed by KubeVirt, as observed by virt-controller",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"virtualMachines": {
+						SchemaProps: spec.SchemaProps{
+							Description: "VirtualMachines is the number of VirtualMachines by run strategy",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: 0,
+										Type:    []string{"integer"},
+										Format:  "int32",
+									},
+								},
+							},
+						},
+					},
+					"virtualMachineInstances": {
+						SchemaProps: spec.SchemaProps{
+							Description: "VirtualMachineInstances is the number of VirtualMachineInstances by phase",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: 0,
+										Type:    []string{"integer"},
+										Format:  "int32",
+									},
+								},
+							},
+						},
+					},
+					"migrationsInFlight": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MigrationsInFlight is the number of VirtualMachineInstanceMigrations which did not finish yet",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"schedulableNodes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SchedulableNodes is the number of nodes VirtualMachineInstances can be scheduled on",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"pendingActions": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"reason",
+								},
+								"x-kubernetes-list-type": "map",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "PendingActions lists the actions which are outstanding before the fleet is up to date",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("kubevirt.io/api/core/v1.KubeVirtPendingAction"),
+									},
+								},
+							},
+						},
+					},
+					"lastUpdateTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastUpdateTime is the time the summary was aggregated at",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
 				},
+				Required: []string{"migrationsInFlight", "schedulableNodes", "lastUpdateTime"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/api/resource.Quantity", "k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector", "kubevirt.io/api/core/v1.ArchConfiguration", "kubevirt.io/api/core/v1.CommonInstancetypesDeployment", "kubevirt.io/api/core/v1.DeveloperConfiguration", "kubevirt.io/api/core/v1.InstancetypeConfiguration", "kubevirt.io/api/core/v1.KSMConfiguration", "kubevirt.io/api/core/v1.LiveUpdateConfiguration", "kubevirt.io/api/core/v1.MediatedDevicesConfiguration", "kubevirt.io/api/core/v1.MigrationConfiguration", "kubevirt.io/api/core/v1.NetworkConfiguration", "kubevirt.io/api/core/v1.PermittedHostDevices", "kubevirt.io/api/core/v1.ReloadableComponentConfiguration", "kubevirt.io/api/core/v1.SMBiosConfiguration", "kubevirt.io/api/core/v1.SeccompConfiguration", "kubevirt.io/api/core/v1.SupportContainerResources", "kubevirt.io/api/core/v1.TLSConfiguration", "kubevirt.io/api/core/v1.VirtualMachineOptions"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time", "kubevirt.io/api/core/v1.KubeVirtPendingAction"},
 	}
 }
 
@@ -21560,6 +22830,34 @@ func schema_kubevirtio_api_core_v1_KubeVirtList(ref common.ReferenceCallback) co
 	}
 }
 
+func schema_kubevirtio_api_core_v1_KubeVirtPendingAction(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "KubeVirtPendingAction counts the workloads waiting for the same action",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"reason": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"count": {
+						SchemaProps: spec.SchemaProps{
+							Default: 0,
+							Type:    []string{"integer"},
+							Format:  "int32",
+						},
+					},
+				},
+				Required: []string{"reason", "count"},
+			},
+		},
+	}
+}
+
 func schema_kubevirtio_api_core_v1_KubeVirtSelfSignConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -21862,11 +23160,39 @@ func schema_kubevirtio_api_core_v1_KubeVirtStatus(ref common.ReferenceCallback)
 							},
 						},
 					},
+					"fleetSummary": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FleetSummary aggregates the state of the workloads and nodes managed by KubeVirt",
+							Ref:         ref("kubevirt.io/api/core/v1.KubeVirtFleetSummary"),
+						},
+					},
+					"nodeEvacuations": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"node",
+								},
+								"x-kubernetes-list-type": "map",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "NodeEvacuations reports the progress of the nodes which are being evacuated",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("kubevirt.io/api/core/v1.NodeEvacuationStatus"),
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"kubevirt.io/api/core/v1.GenerationStatus", "kubevirt.io/api/core/v1.KubeVirtCondition"},
+			"kubevirt.io/api/core/v1.GenerationStatus", "kubevirt.io/api/core/v1.KubeVirtCondition", "kubevirt.io/api/core/v1.KubeVirtFleetSummary", "kubevirt.io/api/core/v1.NodeEvacuationStatus"},
 	}
 }
 
@@ -22064,6 +23390,89 @@ func schema_kubevirtio_api_core_v1_LunTarget(ref common.ReferenceCallback) commo
 	}
 }
 
+func schema_kubevirtio_api_core_v1_MacAddressPoolConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MacAddressPoolConfiguration holds the ranges of the built-in MAC address pool. VirtualMachine interfaces without a MAC address get one from the ranges of their namespace on creation.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"ranges": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Ranges are the MAC address ranges of the namespaces without dedicated ranges",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("kubevirt.io/api/core/v1.MacAddressRange"),
+									},
+								},
+							},
+						},
+					},
+					"namespaces": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Namespaces holds the MAC address ranges dedicated to namespaces",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("kubevirt.io/api/core/v1.NamespaceMacAddressRanges"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"kubevirt.io/api/core/v1.MacAddressRange", "kubevirt.io/api/core/v1.NamespaceMacAddressRanges"},
+	}
+}
+
+func schema_kubevirtio_api_core_v1_MacAddressRange(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MacAddressRange is an inclusive range of MAC addresses",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"start": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Start is the first MAC address of the range, e.g. 02:00:00:00:00:00",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"end": {
+						SchemaProps: spec.SchemaProps{
+							Description: "End is the last MAC address of the range, e.g. 02:00:00:ff:ff:ff",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"start", "end"},
+			},
+		},
+	}
+}
+
 func schema_kubevirtio_api_core_v1_Machine(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -22084,6 +23493,36 @@ func schema_kubevirtio_api_core_v1_Machine(ref common.ReferenceCallback) common.
 	}
 }
 
+func schema_kubevirtio_api_core_v1_MediatedDeviceTypeCount(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MediatedDeviceTypeCount sets the number of instances of an mdev type created on a node.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Type is the name or the ID of the mdev type, as listed in mediatedDeviceTypes.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"count": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Count is the number of instances of the type to create on the node.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+				Required: []string{"type", "count"},
+			},
+		},
+	}
+}
+
 func schema_kubevirtio_api_core_v1_MediatedDevicesConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -22223,6 +23662,40 @@ func schema_kubevirtio_api_core_v1_Memory(ref common.ReferenceCallback) common.O
 	}
 }
 
+func schema_kubevirtio_api_core_v1_MemoryDumpProgress(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MemoryDumpProgress holds periodically sampled statistics of a running memory dump",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"dataProcessedBytes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The amount of guest memory dumped so far",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"dataTotalBytes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The total amount of guest memory to be dumped",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"percentComplete": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The share of the guest memory dumped so far, from 0 to 100",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_kubevirtio_api_core_v1_MemoryDumpVolumeSource(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -22251,10 +23724,25 @@ func schema_kubevirtio_api_core_v1_MemoryDumpVolumeSource(ref common.ReferenceCa
 							Format:      "",
 						},
 					},
+					"compression": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Compression compresses the memory dump while it is written to the volume",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"chunkSize": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ChunkSize is the amount of the dump which is written to the volume before it is flushed to disk",
+							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+						},
+					},
 				},
 				Required: []string{"claimName"},
 			},
 		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/api/resource.Quantity"},
 	}
 }
 
@@ -22282,6 +23770,12 @@ func schema_kubevirtio_api_core_v1_MemoryStatus(ref common.ReferenceCallback) co
 							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
 						},
 					},
+					"guestUsable": {
+						SchemaProps: spec.SchemaProps{
+							Description: "GuestUsable specifies how much memory is actually usable by the guest. It can stay above GuestRequested when the guest could not release all the memory being unplugged.",
+							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+						},
+					},
 				},
 			},
 		},
@@ -22353,6 +23847,127 @@ func schema_kubevirtio_api_core_v1_MigrateOptions(ref common.ReferenceCallback)
 	}
 }
 
+func schema_kubevirtio_api_core_v1_MigrationBandwidthAutoTuning(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MigrationBandwidthAutoTuning defines how the bandwidth of live migrations is tuned to the network of the source node",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"minBandwidthPerMigration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MinBandwidthPerMigration is the lowest bandwidth a migration is limited to, even if the network of the source node is saturated. The value is in quantity per second. Defaults to 0 (no lower limit)",
+							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+						},
+					},
+					"targetUtilizationPercent": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TargetUtilizationPercent is the share of the migration network capacity of the source node which may be used in total. Migrations use whatever is left by the other traffic. Defaults to 80",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/api/resource.Quantity"},
+	}
+}
+
+func schema_kubevirtio_api_core_v1_MigrationBlocker(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MigrationBlocker is a reason preventing the live migration of a VirtualMachineInstance",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"reason": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Reason is the machine readable reason, e.g. the reason of the LiveMigratable condition",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Message is the human readable description of the blocker",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"reason", "message"},
+			},
+		},
+	}
+}
+
+func schema_kubevirtio_api_core_v1_MigrationCancelOptions(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MigrationCancelOptions may be provided on cancel request.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"reason": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Reason is recorded in the message of the migrationCancelRequested condition of the migration",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_kubevirtio_api_core_v1_MigrationCompression(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MigrationCompression defines the compression of the guest memory sent by multifd live migrations",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"method": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Method is the compression method. One of zlib or zstd",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"level": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Level is the compression level. Ranges from 1 to 9 for zlib and from 1 to 20 for zstd. Defaults to the hypervisor default of the method",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+				Required: []string{"method"},
+			},
+		},
+	}
+}
+
 func schema_kubevirtio_api_core_v1_MigrationConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -22394,6 +24009,12 @@ func schema_kubevirtio_api_core_v1_MigrationConfiguration(ref common.ReferenceCa
 							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
 						},
 					},
+					"bandwidthAutoTuning": {
+						SchemaProps: spec.SchemaProps{
+							Description: "BandwidthAutoTuning lets virt-controller derive the bandwidth of each migration from the number of concurrent outbound migrations and the network utilization which virt-handler reports for the source node. BandwidthPerMigration, if set, remains the upper limit. Defaults to no auto-tuning",
+							Ref:         ref("kubevirt.io/api/core/v1.MigrationBandwidthAutoTuning"),
+						},
+					},
 					"completionTimeoutPerGiB": {
 						SchemaProps: spec.SchemaProps{
 							Description: "CompletionTimeoutPerGiB is the maximum number of seconds per GiB a migration is allowed to take. If the timeout is reached, the migration will be either paused, switched to post-copy or cancelled depending on other settings. Defaults to 150",
@@ -22429,6 +24050,33 @@ func schema_kubevirtio_api_core_v1_MigrationConfiguration(ref common.ReferenceCa
 							Format:      "",
 						},
 					},
+					"maxDowntimeMilliseconds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxDowntimeMilliseconds is the maximum number of milliseconds the guest may be paused to switch over to the target at the end of a pre-copy migration. Larger values let migrations of busy VMIs converge sooner. Defaults to the hypervisor default (300)",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"postCopyAfterIterations": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PostCopyAfterIterations switches a migration to post-copy once the guest memory has been copied that many times without the migration completing, instead of waiting for CompletionTimeoutPerGiB. Only applies if AllowPostCopy and AllowWorkloadDisruption are set. Defaults to 0 (disabled)",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"parallelMigrationChannels": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ParallelMigrationChannels is the number of multifd channels used to transfer the guest memory of a live migration. A value of 1 migrates over a single connection. Post-copy migrations and VMIs with a CPU limit always use a single connection. Defaults to 8",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"compression": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Compression compresses the guest memory sent over the multifd channels of a live migration. It is ignored for migrations over a single connection. Defaults to no compression",
+							Ref:         ref("kubevirt.io/api/core/v1.MigrationCompression"),
+						},
+					},
 					"disableTLS": {
 						SchemaProps: spec.SchemaProps{
 							Description: "When set to true, DisableTLS will disable the additional layer of live migration encryption provided by KubeVirt. This is usually a bad idea. Defaults to false",
@@ -22454,7 +24102,55 @@ func schema_kubevirtio_api_core_v1_MigrationConfiguration(ref common.ReferenceCa
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/api/resource.Quantity"},
+			"k8s.io/apimachinery/pkg/api/resource.Quantity", "kubevirt.io/api/core/v1.MigrationBandwidthAutoTuning", "kubevirt.io/api/core/v1.MigrationCompression"},
+	}
+}
+
+func schema_kubevirtio_api_core_v1_MigrationProgress(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MigrationProgress holds periodically sampled data transfer statistics of a live migration",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"dataProcessedBytes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The amount of data transferred to the target so far",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"dataRemainingBytes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The amount of data still to be transferred to the target",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"dataTotalBytes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The total amount of data to be transferred",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"downtimeMilliseconds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The expected downtime while the migration is running and the measured downtime once it completed",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"percentComplete": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The share of the data transferred to the target, from 0 to 100",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+			},
+		},
 	}
 }
 
@@ -22518,6 +24214,49 @@ func schema_kubevirtio_api_core_v1_NUMAGuestMappingPassthrough(ref common.Refere
 	}
 }
 
+func schema_kubevirtio_api_core_v1_NamespaceMacAddressRanges(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "NamespaceMacAddressRanges dedicates MAC address ranges to a namespace",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"namespace": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Namespace is the name of the namespace",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"ranges": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Ranges are the MAC address ranges of the namespace",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("kubevirt.io/api/core/v1.MacAddressRange"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"namespace", "ranges"},
+			},
+		},
+		Dependencies: []string{
+			"kubevirt.io/api/core/v1.MacAddressRange"},
+	}
+}
+
 func schema_kubevirtio_api_core_v1_Network(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -22592,11 +24331,17 @@ func schema_kubevirtio_api_core_v1_NetworkConfiguration(ref common.ReferenceCall
 							},
 						},
 					},
+					"macAddressPool": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MacAddressPool holds the ranges the MAC addresses of VirtualMachine interfaces are allocated from. It requires the MacAddressPool feature gate.",
+							Ref:         ref("kubevirt.io/api/core/v1.MacAddressPoolConfiguration"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"kubevirt.io/api/core/v1.InterfaceBindingPlugin"},
+			"kubevirt.io/api/core/v1.InterfaceBindingPlugin", "kubevirt.io/api/core/v1.MacAddressPoolConfiguration"},
 	}
 }
 
@@ -22635,6 +24380,66 @@ func schema_kubevirtio_api_core_v1_NoCloudSSHPublicKeyAccessCredentialPropagatio
 	}
 }
 
+func schema_kubevirtio_api_core_v1_NodeEvacuationStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "NodeEvacuationStatus reports the VirtualMachineInstances which still have to leave a node that is being evacuated, as observed by virt-controller",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"node": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Node is the name of the node which is being evacuated",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"pending": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Pending is the number of VirtualMachineInstances waiting for an evacuation migration",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"migrating": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Migrating is the number of VirtualMachineInstances with an evacuation migration in flight",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"notMigratable": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NotMigratable is the number of VirtualMachineInstances which can't be live migrated and block the evacuation",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"remainingMemory": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RemainingMemory is the guest memory of all the VirtualMachineInstances which still have to leave the node",
+							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+						},
+					},
+					"lastUpdateTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastUpdateTime is the time the progress was observed at",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+				Required: []string{"node", "pending", "migrating", "notMigratable", "lastUpdateTime"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/api/resource.Quantity", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
 func schema_kubevirtio_api_core_v1_NodeMediatedDeviceTypesConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -22697,10 +24502,31 @@ func schema_kubevirtio_api_core_v1_NodeMediatedDeviceTypesConfig(ref common.Refe
 							},
 						},
 					},
+					"mediatedDeviceCounts": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "MediatedDeviceCounts sets how many instances of some of the mediatedDeviceTypes are created on the node. Types without a count get all the instances their parent devices can provide.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("kubevirt.io/api/core/v1.MediatedDeviceTypeCount"),
+									},
+								},
+							},
+						},
+					},
 				},
 				Required: []string{"nodeSelector"},
 			},
 		},
+		Dependencies: []string{
+			"kubevirt.io/api/core/v1.MediatedDeviceTypeCount"},
 	}
 }
 
@@ -22781,6 +24607,36 @@ func schema_kubevirtio_api_core_v1_PITTimer(ref common.ReferenceCallback) common
 	}
 }
 
+func schema_kubevirtio_api_core_v1_PacketCaptureOptions(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "PacketCaptureOptions is provided when capturing the traffic of a VirtualMachineInstance interface",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"interfaceName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "InterfaceName is the name of the interface, as defined in the VMI spec, to capture the traffic of",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"duration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Duration limits the capture, otherwise it lasts until the connection is closed",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+				},
+				Required: []string{"interfaceName"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+	}
+}
+
 func schema_kubevirtio_api_core_v1_PauseOptions(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -22828,6 +24684,36 @@ func schema_kubevirtio_api_core_v1_PauseOptions(ref common.ReferenceCallback) co
 	}
 }
 
+func schema_kubevirtio_api_core_v1_PauseStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "PauseStatus reports for how long a VirtualMachineInstance was paused",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"lastPauseDurationSeconds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastPauseDurationSeconds is the duration of the most recent pause which ended",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"totalPauseDurationSeconds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TotalPauseDurationSeconds is the sum of the durations of all pauses which ended",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+				},
+				Required: []string{"lastPauseDurationSeconds", "totalPauseDurationSeconds"},
+			},
+		},
+	}
+}
+
 func schema_kubevirtio_api_core_v1_PciHostDevice(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -22926,11 +24812,30 @@ func schema_kubevirtio_api_core_v1_PermittedHostDevices(ref common.ReferenceCall
 							},
 						},
 					},
+					"usbPassthrough": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "USBPassthrough lists the USB devices of the nodes which the usbDevices of VMIs may select. Hubs can not be passed through.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("kubevirt.io/api/core/v1.USBPassthroughHostDevice"),
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"kubevirt.io/api/core/v1.MediatedHostDevice", "kubevirt.io/api/core/v1.PciHostDevice", "kubevirt.io/api/core/v1.USBHostDevice"},
+			"kubevirt.io/api/core/v1.MediatedHostDevice", "kubevirt.io/api/core/v1.PciHostDevice", "kubevirt.io/api/core/v1.USBHostDevice", "kubevirt.io/api/core/v1.USBPassthroughHostDevice"},
 	}
 }
 
@@ -23063,6 +24968,179 @@ func schema_kubevirtio_api_core_v1_PersistentVolumeClaimVolumeSource(ref common.
 	}
 }
 
+func schema_kubevirtio_api_core_v1_PlacementHintsConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "PlacementHintsConfiguration holds the settings of the external placement service. virt-controller POSTs a PlacementHintsRequest to the service and expects a PlacementHintsResponse. The preferred nodes are added as preferred and the forbidden nodes as required node affinity to the pod.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"url": {
+						SchemaProps: spec.SchemaProps{
+							Description: "URL is the http or https endpoint of the placement service",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"caBundle": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CABundle is the PEM encoded CA bundle used to verify the certificate of the placement service. The system trust store is used if empty",
+							Type:        []string{"string"},
+							Format:      "byte",
+						},
+					},
+					"timeout": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Timeout is the time the placement service has to answer. Defaults to 2s",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"failurePolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FailurePolicy defines how a failed request or an invalid response is handled. Ignore creates the pod without hints, Fail retries later without creating the pod. Defaults to Ignore",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"url"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+	}
+}
+
+func schema_kubevirtio_api_core_v1_PlacementHintsRequest(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "PlacementHintsRequest is sent to the placement service",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"operation": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Operation tells if the pod is created to start or to migrate the VirtualMachineInstance",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"namespace": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Namespace of the VirtualMachineInstance",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name of the VirtualMachineInstance",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"uid": {
+						SchemaProps: spec.SchemaProps{
+							Description: "UID of the VirtualMachineInstance",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"labels": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Labels of the VirtualMachineInstance",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"sourceNode": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SourceNode is the node a migrating VirtualMachineInstance runs on",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"migrationName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MigrationName is the name of the migration the target pod is created for",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"operation", "namespace", "name"},
+			},
+		},
+	}
+}
+
+func schema_kubevirtio_api_core_v1_PlacementHintsResponse(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "PlacementHintsResponse is returned by the placement service",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"preferredNodes": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "PreferredNodes are the nodes the pod should be scheduled on, by weight",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("kubevirt.io/api/core/v1.PreferredNode"),
+									},
+								},
+							},
+						},
+					},
+					"forbiddenNodes": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "ForbiddenNodes are the nodes the pod must not be scheduled on",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"kubevirt.io/api/core/v1.PreferredNode"},
+	}
+}
+
 func schema_kubevirtio_api_core_v1_PluginBinding(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -23196,6 +25274,36 @@ func schema_kubevirtio_api_core_v1_PreferenceMatcher(ref common.ReferenceCallbac
 	}
 }
 
+func schema_kubevirtio_api_core_v1_PreferredNode(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "PreferredNode is a node preferred by the placement service",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"node": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Node is the name of the node",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"weight": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Weight of the preference, in the range 1-100",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+				Required: []string{"node", "weight"},
+			},
+		},
+	}
+}
+
 func schema_kubevirtio_api_core_v1_Probe(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -24106,6 +26214,97 @@ func schema_kubevirtio_api_core_v1_ServiceAccountVolumeSource(ref common.Referen
 	}
 }
 
+func schema_kubevirtio_api_core_v1_SetLinkStateOptions(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SetLinkStateOptions is provided when setting the link state of an interface of a running VMI.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name of the interface, which is the name of the network it is connected to",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"state": {
+						SchemaProps: spec.SchemaProps{
+							Description: "State is the link state of the interface, up or down",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"dryRun": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "When present, indicates that modifications should not be persisted. An invalid or unrecognized dryRun directive will result in an error response and no further processing of the request. Valid values are: - All: all dry run stages will be processed",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"name", "state"},
+			},
+		},
+	}
+}
+
+func schema_kubevirtio_api_core_v1_ShutdownPolicy(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ShutdownPolicy defines the time given to each stage of a graceful shutdown. The shutdown never takes longer than the termination grace period.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"acpiTimeoutSeconds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ACPITimeoutSeconds is the time the guest is given to power off after the ACPI power button was pressed. Defaults to 30.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"guestAgentTimeoutSeconds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "GuestAgentTimeoutSeconds is the time the guest is given to power off after the shutdown command was sent through the guest agent, before it is forcefully powered off. The stage is skipped if no guest agent is connected. Defaults to 30.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_kubevirtio_api_core_v1_SoundDevice(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -24135,6 +26334,54 @@ func schema_kubevirtio_api_core_v1_SoundDevice(ref common.ReferenceCallback) com
 	}
 }
 
+func schema_kubevirtio_api_core_v1_StartConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "StartConfiguration holds the limits on concurrently starting VirtualMachineInstances. A VirtualMachineInstance is starting from the moment its launcher pod is created until it is running, except while the scheduler reports its launcher pod as unschedulable. VirtualMachineInstances exceeding a limit wait in a first-in first-out queue.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"parallelStartsPerCluster": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ParallelStartsPerCluster is the maximum number of VirtualMachineInstances starting at the same time cluster-wide. Unlimited if not set",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"parallelStartsPerNamespace": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ParallelStartsPerNamespace is the maximum number of VirtualMachineInstances starting at the same time in a single namespace. Unlimited if not set",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"volumePreparationLimits": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "VolumePreparationLimits limits the number of DataVolumes of a storage class which are cloned, imported or resized at the same time before their VirtualMachines can start",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("kubevirt.io/api/core/v1.VolumePreparationLimit"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"kubevirt.io/api/core/v1.VolumePreparationLimit"},
+	}
+}
+
 func schema_kubevirtio_api_core_v1_StartOptions(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -24308,6 +26555,49 @@ func schema_kubevirtio_api_core_v1_SupportContainerResources(ref common.Referenc
 	}
 }
 
+func schema_kubevirtio_api_core_v1_SwapConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SwapConfiguration lets virt-handler give the virt-launcher pods of the overcommitted VMIs access to the swap of the nodes, so that the guest memory above their memory request is swapped out under memory pressure instead of getting them OOM-killed. The nodes need swap enabled and cgroup v2.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"nodeSelector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NodeSelector selects the nodes on which the swap of the VMIs is managed. All the nodes are selected if it is empty",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"evictionSwapUsedPercent": {
+						SchemaProps: spec.SchemaProps{
+							Description: "EvictionSwapUsedPercent is the percentage of the swap of a node in use above which the overcommitted VMI using the most swap is evacuated from the node. The eviction on swap usage is disabled if it is unset",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"evictionMemoryPressurePercent": {
+						SchemaProps: spec.SchemaProps{
+							Description: "EvictionMemoryPressurePercent is the percentage of the last 10 seconds during which all the tasks of a node were stalled on memory, above which the overcommitted VMI using the most swap is evacuated from the node. The eviction on memory pressure is disabled if it is unset",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_kubevirtio_api_core_v1_SyNICTimer(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -24418,6 +26708,74 @@ func schema_kubevirtio_api_core_v1_TPMDevice(ref common.ReferenceCallback) commo
 	}
 }
 
+func schema_kubevirtio_api_core_v1_ThreadPlacement(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ThreadPlacement defines where the emulator thread and the IOThreads of a VMI with dedicated CPUs run.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"emulatorThread": {
+						SchemaProps: spec.SchemaProps{
+							Description: "EmulatorThread defines where the emulator thread runs. With shared it runs on the pCPUs of the vCPUs, dedicated and sameNUMANode isolate it on an additional pCPU, like IsolateEmulatorThread does. Defaults to dedicated if IsolateEmulatorThread is set and to shared otherwise.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"ioThreads": {
+						SchemaProps: spec.SchemaProps{
+							Description: "IOThreads defines where the IOThreads run. With shared they run on the pCPU of the isolated emulator thread, or on the pCPUs of the vCPUs if the emulator thread is not isolated. With dedicated and sameNUMANode all IOThreads run together on an additional pCPU. Defaults to shared.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_kubevirtio_api_core_v1_ThreadPlacementStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ThreadPlacementStatus reports where the emulator thread and the IOThreads of the VMI run",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"emulatorThreadCPUSet": {
+						SchemaProps: spec.SchemaProps{
+							Description: "EmulatorThreadCPUSet are the host CPUs the emulator thread is pinned to",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"ioThreads": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "IOThreads are the host CPUs the IOThreads are pinned to",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("kubevirt.io/api/core/v1.IOThreadPlacement"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"kubevirt.io/api/core/v1.IOThreadPlacement"},
+	}
+}
+
 func schema_kubevirtio_api_core_v1_Timer(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -24504,7 +26862,120 @@ func schema_kubevirtio_api_core_v1_TopologyHints(ref common.ReferenceCallback) c
 							Format: "int64",
 						},
 					},
+					"cpuModel": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CPUModel is the CPU model common to the nodes the VMI can be scheduled to, used when the VMI requests the cluster-common CPU model",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"cpuFeatures": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "CPUFeatures are the CPU features common to the nodes the VMI can be scheduled to, required on top of CPUModel",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_kubevirtio_api_core_v1_USBDevice(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "USBDevice selects a USB device plugged into the node of the vmi, either by its vendor and product identifiers or by the port it is plugged into. Exactly one of the selectors has to be set.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name of the USB device, unique within the vmi",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"vendorProduct": {
+						SchemaProps: spec.SchemaProps{
+							Description: "VendorProduct selects a free device by its vendor_id:product_id tuple, e.g. 1050:0407",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"busPort": {
+						SchemaProps: spec.SchemaProps{
+							Description: "BusPort selects the device plugged into a port, by the bus number and port path the kernel names the device after, e.g. 1-2.3",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
+	}
+}
+
+func schema_kubevirtio_api_core_v1_USBDeviceStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "USBDeviceStatus reports the host device allocated to a USB device of the VirtualMachineInstance",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name of the USB device in the VirtualMachineInstance spec",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"phase": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Phase of the USB device",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"busPort": {
+						SchemaProps: spec.SchemaProps{
+							Description: "BusPort is the bus and port path of the allocated host device, e.g. 1-2.3",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"bus": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Bus is the number of the bus of the allocated host device",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"deviceNumber": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DeviceNumber is the number of the allocated host device on its bus",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
 				},
+				Required: []string{"name"},
 			},
 		},
 	}
@@ -24558,6 +27029,33 @@ func schema_kubevirtio_api_core_v1_USBHostDevice(ref common.ReferenceCallback) c
 	}
 }
 
+func schema_kubevirtio_api_core_v1_USBPassthroughHostDevice(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "USBPassthroughHostDevice permits the USB devices matching all of its set selectors to be passed through. At least one of the selectors has to be set.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"vendorProduct": {
+						SchemaProps: spec.SchemaProps{
+							Description: "VendorProduct permits the devices with the vendor_id:product_id tuple, e.g. 1050:0407",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"busPort": {
+						SchemaProps: spec.SchemaProps{
+							Description: "BusPort permits the device plugged into the port, by the bus number and port path the kernel names the device after, e.g. 1-2.3",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_kubevirtio_api_core_v1_USBSelector(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -24797,6 +27295,95 @@ func schema_kubevirtio_api_core_v1_VSOCKOptions(ref common.ReferenceCallback) co
 	}
 }
 
+func schema_kubevirtio_api_core_v1_VirtiofsIDMapping(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"userIDs": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "UserIDs maps ranges of guest user IDs to user IDs on the volume.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("kubevirt.io/api/core/v1.VirtiofsIDRange"),
+									},
+								},
+							},
+						},
+					},
+					"groupIDs": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "GroupIDs maps ranges of guest group IDs to group IDs on the volume.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("kubevirt.io/api/core/v1.VirtiofsIDRange"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"kubevirt.io/api/core/v1.VirtiofsIDRange"},
+	}
+}
+
+func schema_kubevirtio_api_core_v1_VirtiofsIDRange(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"guestID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "GuestID is the first ID of the range as seen by the guest.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"hostID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "HostID is the first ID of the range as stored on the volume.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"count": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Count is the number of consecutive IDs in the range.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+				},
+				Required: []string{"guestID", "hostID", "count"},
+			},
+		},
+	}
+}
+
 func schema_kubevirtio_api_core_v1_VirtualMachine(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -25334,6 +27921,13 @@ func schema_kubevirtio_api_core_v1_VirtualMachineInstanceGuestOSInfo(ref common.
 							Format:      "",
 						},
 					},
+					"hostname": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Short hostname of the guest as reported by the guest agent, not its FQDN",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
@@ -25570,6 +28164,83 @@ func schema_kubevirtio_api_core_v1_VirtualMachineInstanceMigrationCondition(ref
 	}
 }
 
+func schema_kubevirtio_api_core_v1_VirtualMachineInstanceMigrationFeasibility(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VirtualMachineInstanceMigrationFeasibility reports whether a VirtualMachineInstance can currently be live migrated",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"migratable": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Migratable is true if nothing blocks the live migration of the VirtualMachineInstance",
+							Default:     false,
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"blockers": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Blockers are the reasons preventing the live migration",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("kubevirt.io/api/core/v1.MigrationBlocker"),
+									},
+								},
+							},
+						},
+					},
+					"targetNodes": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "TargetNodes are the nodes the VirtualMachineInstance can be migrated to",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"migratable"},
+			},
+		},
+		Dependencies: []string{
+			"kubevirt.io/api/core/v1.MigrationBlocker"},
+	}
+}
+
 func schema_kubevirtio_api_core_v1_VirtualMachineInstanceMigrationList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -25873,11 +28544,17 @@ func schema_kubevirtio_api_core_v1_VirtualMachineInstanceMigrationState(ref comm
 							Format:      "",
 						},
 					},
+					"progress": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The data transfer statistics of the migration as reported by the source",
+							Ref:         ref("kubevirt.io/api/core/v1.MigrationProgress"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.Time", "kubevirt.io/api/core/v1.MigrationConfiguration"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time", "kubevirt.io/api/core/v1.MigrationConfiguration", "kubevirt.io/api/core/v1.MigrationProgress"},
 	}
 }
 
@@ -25998,7 +28675,7 @@ func schema_kubevirtio_api_core_v1_VirtualMachineInstanceNetworkInterface(ref co
 					},
 					"infoSource": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Specifies the origin of the interface data collected. values: domain, guest-agent, multus-status.",
+							Description: "Specifies the origin of the interface data collected. values: domain, guest-agent, multus-status, arp-snooping.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
@@ -26539,6 +29216,12 @@ func schema_kubevirtio_api_core_v1_VirtualMachineInstanceSpec(ref common.Referen
 							Format:      "int64",
 						},
 					},
+					"shutdownPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ShutdownPolicy escalates the graceful shutdown of the guest in stages, from an ACPI power button press, to a shutdown command sent through the guest agent, to forcefully powering off the guest. If not set, the guest is asked to shut down until the termination grace period expires.",
+							Ref:         ref("kubevirt.io/api/core/v1.ShutdownPolicy"),
+						},
+					},
 					"volumes": {
 						SchemaProps: spec.SchemaProps{
 							Description: "List of volumes that can be mounted by disks belonging to the vmi.",
@@ -26633,12 +29316,18 @@ func schema_kubevirtio_api_core_v1_VirtualMachineInstanceSpec(ref common.Referen
 							Format:      "",
 						},
 					},
+					"guestLicense": {
+						SchemaProps: spec.SchemaProps{
+							Description: "GuestLicense describes the licensing of the guest operating system. It is exposed in the kubevirt_vmi_guest_license_info metric.",
+							Ref:         ref("kubevirt.io/api/core/v1.GuestLicense"),
+						},
+					},
 				},
 				Required: []string{"domain"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/api/core/v1.Affinity", "k8s.io/api/core/v1.PodDNSConfig", "k8s.io/api/core/v1.Toleration", "k8s.io/api/core/v1.TopologySpreadConstraint", "kubevirt.io/api/core/v1.AccessCredential", "kubevirt.io/api/core/v1.DomainSpec", "kubevirt.io/api/core/v1.Network", "kubevirt.io/api/core/v1.Probe", "kubevirt.io/api/core/v1.Volume"},
+			"k8s.io/api/core/v1.Affinity", "k8s.io/api/core/v1.PodDNSConfig", "k8s.io/api/core/v1.Toleration", "k8s.io/api/core/v1.TopologySpreadConstraint", "kubevirt.io/api/core/v1.AccessCredential", "kubevirt.io/api/core/v1.DomainSpec", "kubevirt.io/api/core/v1.GuestLicense", "kubevirt.io/api/core/v1.Network", "kubevirt.io/api/core/v1.Probe", "kubevirt.io/api/core/v1.ShutdownPolicy", "kubevirt.io/api/core/v1.Volume"},
 	}
 }
 
@@ -26752,6 +29441,18 @@ func schema_kubevirtio_api_core_v1_VirtualMachineInstanceStatus(ref common.Refer
 							Enum:        []interface{}{"BestEffort", "Burstable", "Guaranteed"},
 						},
 					},
+					"cgroupLayout": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CgroupLayout describes how the threads of the virtual machine instance are placed in the cgroups of the compute container. It is only reported if the housekeeping threads are separated from the vCPUs.",
+							Ref:         ref("kubevirt.io/api/core/v1.CgroupLayout"),
+						},
+					},
+					"threadPlacement": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ThreadPlacement reports the host CPUs the emulator thread and the IOThreads are pinned to",
+							Ref:         ref("kubevirt.io/api/core/v1.ThreadPlacementStatus"),
+						},
+					},
 					"launcherContainerImageVersion": {
 						SchemaProps: spec.SchemaProps{
 							Description: "LauncherContainerImageVersion indicates what container image is currently active for the vmi.",
@@ -26814,6 +29515,25 @@ func schema_kubevirtio_api_core_v1_VirtualMachineInstanceStatus(ref common.Refer
 							Format:      "",
 						},
 					},
+					"fsFreezeInfo": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FSFreezeInfo reports which guest filesystems are frozen and for how long they were frozen",
+							Ref:         ref("kubevirt.io/api/core/v1.FSFreezeInfo"),
+						},
+					},
+					"pauseStatus": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PauseStatus reports for how long the VirtualMachineInstance was paused",
+							Ref:         ref("kubevirt.io/api/core/v1.PauseStatus"),
+						},
+					},
+					"shutdownStage": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ShutdownStage is the stage of the shutdown policy the guest is shut down in, or was shut down in once the VirtualMachineInstance stopped",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 					"topologyHints": {
 						SchemaProps: spec.SchemaProps{
 							Ref: ref("kubevirt.io/api/core/v1.TopologyHints"),
@@ -26885,11 +29605,30 @@ func schema_kubevirtio_api_core_v1_VirtualMachineInstanceStatus(ref common.Refer
 							},
 						},
 					},
+					"usbDevices": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "USBDevices reports the host USB devices allocated to the USB devices of the VirtualMachineInstance",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("kubevirt.io/api/core/v1.USBDeviceStatus"),
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"kubevirt.io/api/core/v1.CPUTopology", "kubevirt.io/api/core/v1.KernelBootStatus", "kubevirt.io/api/core/v1.Machine", "kubevirt.io/api/core/v1.MemoryStatus", "kubevirt.io/api/core/v1.StorageMigratedVolumeInfo", "kubevirt.io/api/core/v1.TopologyHints", "kubevirt.io/api/core/v1.VirtualMachineInstanceCondition", "kubevirt.io/api/core/v1.VirtualMachineInstanceGuestOSInfo", "kubevirt.io/api/core/v1.VirtualMachineInstanceMigrationState", "kubevirt.io/api/core/v1.VirtualMachineInstanceNetworkInterface", "kubevirt.io/api/core/v1.VirtualMachineInstancePhaseTransitionTimestamp", "kubevirt.io/api/core/v1.VolumeStatus"},
+			"kubevirt.io/api/core/v1.CPUTopology", "kubevirt.io/api/core/v1.CgroupLayout", "kubevirt.io/api/core/v1.FSFreezeInfo", "kubevirt.io/api/core/v1.KernelBootStatus", "kubevirt.io/api/core/v1.Machine", "kubevirt.io/api/core/v1.MemoryStatus", "kubevirt.io/api/core/v1.PauseStatus", "kubevirt.io/api/core/v1.StorageMigratedVolumeInfo", "kubevirt.io/api/core/v1.ThreadPlacementStatus", "kubevirt.io/api/core/v1.TopologyHints", "kubevirt.io/api/core/v1.USBDeviceStatus", "kubevirt.io/api/core/v1.VirtualMachineInstanceCondition", "kubevirt.io/api/core/v1.VirtualMachineInstanceGuestOSInfo", "kubevirt.io/api/core/v1.VirtualMachineInstanceMigrationState", "kubevirt.io/api/core/v1.VirtualMachineInstanceNetworkInterface", "kubevirt.io/api/core/v1.VirtualMachineInstancePhaseTransitionTimestamp", "kubevirt.io/api/core/v1.VolumeStatus"},
 	}
 }
 
@@ -27024,12 +29763,25 @@ func schema_kubevirtio_api_core_v1_VirtualMachineMemoryDumpRequest(ref common.Re
 							Format:      "",
 						},
 					},
+					"compression": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Compression compresses the memory dump while it is written to the pvc. The compressed dump does not have to fit the memory of the vm, the pvc size is therefore not validated. Defaults to no compression",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"chunkSize": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ChunkSize is the amount of the dump which is written to the pvc before it is flushed and dropped from the page cache of the virt-launcher pod. Defaults to 64Mi if the dump is compressed, otherwise the dump is written by the hypervisor directly",
+							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+						},
+					},
 				},
 				Required: []string{"claimName", "phase"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+			"k8s.io/apimachinery/pkg/api/resource.Quantity", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
@@ -27120,12 +29872,24 @@ func schema_kubevirtio_api_core_v1_VirtualMachineSpec(ref common.ReferenceCallba
 							Format:      "",
 						},
 					},
+					"storageMigration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "StorageMigration moves the PVC and DataVolume volumes of the VirtualMachine to another StorageClass. The volumes are copied to new DataVolumes, which replace the original volumes once the copy is done. The original claims are retained.",
+							Ref:         ref("kubevirt.io/api/core/v1.VirtualMachineStorageMigration"),
+						},
+					},
+					"volumeAutoExpansion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "VolumeAutoExpansion expands the PVCs of the VirtualMachine when the guest filesystems on them are filling up. It requires the VolumeAutoExpansion feature gate and a guest agent.",
+							Ref:         ref("kubevirt.io/api/core/v1.VolumeAutoExpansion"),
+						},
+					},
 				},
 				Required: []string{"template"},
 			},
 		},
 		Dependencies: []string{
-			"kubevirt.io/api/core/v1.DataVolumeTemplateSpec", "kubevirt.io/api/core/v1.InstancetypeMatcher", "kubevirt.io/api/core/v1.PreferenceMatcher", "kubevirt.io/api/core/v1.VirtualMachineInstanceTemplateSpec"},
+			"kubevirt.io/api/core/v1.DataVolumeTemplateSpec", "kubevirt.io/api/core/v1.InstancetypeMatcher", "kubevirt.io/api/core/v1.PreferenceMatcher", "kubevirt.io/api/core/v1.VirtualMachineInstanceTemplateSpec", "kubevirt.io/api/core/v1.VirtualMachineStorageMigration", "kubevirt.io/api/core/v1.VolumeAutoExpansion"},
 	}
 }
 
@@ -27347,6 +30111,12 @@ func schema_kubevirtio_api_core_v1_VirtualMachineStatus(ref common.ReferenceCall
 							Ref:         ref("kubevirt.io/api/core/v1.VolumeUpdateState"),
 						},
 					},
+					"storageMigration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "StorageMigration tracks the migration of the volumes to the StorageClass requested in the spec",
+							Ref:         ref("kubevirt.io/api/core/v1.VirtualMachineStorageMigrationStatus"),
+						},
+					},
 					"instancetypeRef": {
 						SchemaProps: spec.SchemaProps{
 							Description: "InstancetypeRef captures the state of any referenced instance type from the VirtualMachine",
@@ -27363,7 +30133,172 @@ func schema_kubevirtio_api_core_v1_VirtualMachineStatus(ref common.ReferenceCall
 			},
 		},
 		Dependencies: []string{
-			"kubevirt.io/api/core/v1.InstancetypeStatusRef", "kubevirt.io/api/core/v1.VirtualMachineCondition", "kubevirt.io/api/core/v1.VirtualMachineMemoryDumpRequest", "kubevirt.io/api/core/v1.VirtualMachineStartFailure", "kubevirt.io/api/core/v1.VirtualMachineStateChangeRequest", "kubevirt.io/api/core/v1.VirtualMachineVolumeRequest", "kubevirt.io/api/core/v1.VolumeSnapshotStatus", "kubevirt.io/api/core/v1.VolumeUpdateState"},
+			"kubevirt.io/api/core/v1.InstancetypeStatusRef", "kubevirt.io/api/core/v1.VirtualMachineCondition", "kubevirt.io/api/core/v1.VirtualMachineMemoryDumpRequest", "kubevirt.io/api/core/v1.VirtualMachineStartFailure", "kubevirt.io/api/core/v1.VirtualMachineStateChangeRequest", "kubevirt.io/api/core/v1.VirtualMachineStorageMigrationStatus", "kubevirt.io/api/core/v1.VirtualMachineVolumeRequest", "kubevirt.io/api/core/v1.VolumeSnapshotStatus", "kubevirt.io/api/core/v1.VolumeUpdateState"},
+	}
+}
+
+func schema_kubevirtio_api_core_v1_VirtualMachineStorageMigration(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"storageClassName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "StorageClassName is the StorageClass the volumes are migrated to",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"volumes": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Volumes restricts the migration to the named volumes. By default all the PVC and DataVolume volumes whose claims are not in the target StorageClass are migrated.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"method": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Method is the way the volumes are copied. BlockCopy requires the VirtualMachine to run, CDI requires it to be stopped. Defaults to BlockCopy if the VirtualMachine runs and to CDI otherwise.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"storageClassName"},
+			},
+		},
+	}
+}
+
+func schema_kubevirtio_api_core_v1_VirtualMachineStorageMigrationStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"storageClassName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "StorageClassName is the StorageClass the volumes are migrated to",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"method": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Method is the way the volumes are copied",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"phase": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Phase of the storage migration",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Message explains why the storage migration is pending or failed",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"volumes": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Volumes lists the migrated volumes and their progress",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("kubevirt.io/api/core/v1.VirtualMachineStorageMigrationVolumeStatus"),
+									},
+								},
+							},
+						},
+					},
+					"startTimestamp": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"endTimestamp": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+				Required: []string{"storageClassName"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time", "kubevirt.io/api/core/v1.VirtualMachineStorageMigrationVolumeStatus"},
+	}
+}
+
+func schema_kubevirtio_api_core_v1_VirtualMachineStorageMigrationVolumeStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"volumeName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "VolumeName is the name of the volume in the VirtualMachine",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"sourceClaim": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SourceClaim is the claim the volume is migrated from",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"targetClaim": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TargetClaim is the DataVolume the volume is migrated to",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"percentComplete": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PercentComplete is the progress of the copy of the volume",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+				Required: []string{"volumeName", "sourceClaim", "targetClaim"},
+			},
+		},
 	}
 }
 
@@ -27507,6 +30442,63 @@ func schema_kubevirtio_api_core_v1_Volume(ref common.ReferenceCallback) common.O
 	}
 }
 
+func schema_kubevirtio_api_core_v1_VolumeAutoExpansion(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VolumeAutoExpansion configures the expansion of PVCs driven by the usage of the guest filesystems on them. The guest filesystems are matched with the volumes through the serial of their disks.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"volumes": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Volumes are the names of the PVC and DataVolume volumes which are expanded. Their disks need a serial.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"usageThresholdPercentage": {
+						SchemaProps: spec.SchemaProps{
+							Description: "UsageThresholdPercentage is the usage of the guest filesystems on a volume, in percent of their size, above which the PVC of the volume is expanded",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"incrementPercentage": {
+						SchemaProps: spec.SchemaProps{
+							Description: "IncrementPercentage is by how much, in percent of its capacity, a PVC is expanded. Defaults to 20.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"maxSize": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxSize is the size PVCs are not expanded beyond",
+							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+						},
+					},
+				},
+				Required: []string{"volumes", "usageThresholdPercentage"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/api/resource.Quantity"},
+	}
+}
+
 func schema_kubevirtio_api_core_v1_VolumeMigrationState(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -27540,6 +30532,36 @@ func schema_kubevirtio_api_core_v1_VolumeMigrationState(ref common.ReferenceCall
 	}
 }
 
+func schema_kubevirtio_api_core_v1_VolumePreparationLimit(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VolumePreparationLimit holds the limit on concurrent volume preparations for a storage class. DataVolumes of VirtualMachines and PVC expansions exceeding the limit wait until a preparation finishes.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"storageClassName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "StorageClassName is the name of the storage class the limit applies to",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"parallelVolumePreparations": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ParallelVolumePreparations is the maximum number of volumes of the storage class which are cloned, imported or resized at the same time",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+				},
+				Required: []string{"storageClassName", "parallelVolumePreparations"},
+			},
+		},
+	}
+}
+
 func schema_kubevirtio_api_core_v1_VolumeSnapshotStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -27756,12 +30778,18 @@ func schema_kubevirtio_api_core_v1_VolumeStatus(ref common.ReferenceCallback) co
 							Ref:         ref("kubevirt.io/api/core/v1.ContainerDiskInfo"),
 						},
 					},
+					"guestFilesystemUsage": {
+						SchemaProps: spec.SchemaProps{
+							Description: "GuestFilesystemUsage is the usage of the guest filesystems on the volume, as reported by the guest agent. It is reported with the VolumeAutoExpansion feature gate, for the volumes whose disks have a serial.",
+							Ref:         ref("kubevirt.io/api/core/v1.GuestFilesystemUsage"),
+						},
+					},
 				},
 				Required: []string{"name", "target"},
 			},
 		},
 		Dependencies: []string{
-			"kubevirt.io/api/core/v1.ContainerDiskInfo", "kubevirt.io/api/core/v1.DomainMemoryDumpInfo", "kubevirt.io/api/core/v1.HotplugVolumeStatus", "kubevirt.io/api/core/v1.PersistentVolumeClaimInfo"},
+			"kubevirt.io/api/core/v1.ContainerDiskInfo", "kubevirt.io/api/core/v1.DomainMemoryDumpInfo", "kubevirt.io/api/core/v1.GuestFilesystemUsage", "kubevirt.io/api/core/v1.HotplugVolumeStatus", "kubevirt.io/api/core/v1.PersistentVolumeClaimInfo"},
 	}
 }
 
@@ -30712,7 +33740,7 @@ func schema_kubevirtio_api_instancetype_v1beta1_CPUInstancetype(ref common.Refer
 					},
 					"model": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Model specifies the CPU model inside the VMI. List of available models https://github.com/libvirt/libvirt/tree/master/src/cpu_map. It is possible to specify special cases like \"host-passthrough\" to get the same CPU as the node and \"host-model\" to get CPU closest to the node one. Defaults to host-model.",
+							Description: "Model specifies the CPU model inside the VMI. List of available models https://github.com/libvirt/libvirt/tree/master/src/cpu_map. It is possible to specify special cases like \"host-passthrough\" to get the same CPU as the node and \"host-model\" to get CPU closest to the node one. \"cluster-common\" selects the CPU model and features common to the nodes matching the node selector of the VMI. Defaults to host-model.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
@@ -30750,6 +33778,26 @@ func schema_kubevirtio_api_instancetype_v1beta1_CPUInstancetype(ref common.Refer
 							Format:      "int64",
 						},
 					},
+					"mitigatedVulnerabilities": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "MitigatedVulnerabilities lists the CPU vulnerabilities, as named by the kernel in /sys/devices/system/cpu/vulnerabilities, e.g. retbleed or mds, the node of the VMI has to be mitigated against or not affected by.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
 				},
 				Required: []string{"guest"},
 			},
@@ -31208,6 +34256,35 @@ func schema_kubevirtio_api_instancetype_v1beta1_MemoryPreferenceRequirement(ref
 	}
 }
 
+func schema_kubevirtio_api_instancetype_v1beta1_PreferenceReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "PreferenceReference references a preference to inherit from",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the referenced preference",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind specifies which preference resource is referenced. Allowed values are: \"VirtualMachinePreference\" and \"VirtualMachineClusterPreference\". If not specified, \"VirtualMachineClusterPreference\" is used by default. VirtualMachinePreferences are looked up in the namespace of the inheriting preference.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
+	}
+}
+
 func schema_kubevirtio_api_instancetype_v1beta1_PreferenceRequirements(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -31571,6 +34648,35 @@ func schema_kubevirtio_api_instancetype_v1beta1_VirtualMachineInstancetypeSpec(r
 							Format:      "",
 						},
 					},
+					"affinity": {
+						SchemaProps: spec.SchemaProps{
+							Description: "If affinity is specified, obey all the affinity rules.\n\nAffinity is the affinity of the VirtualMachineInstance to nodes and other pods required by the instancetype.",
+							Ref:         ref("k8s.io/api/core/v1.Affinity"),
+						},
+					},
+					"topologySpreadConstraints": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"topologyKey",
+									"whenUnsatisfiable",
+								},
+								"x-kubernetes-list-type": "map",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "TopologySpreadConstraints describes how a group of VMIs will be spread across a given topology domains. K8s scheduler will schedule VMI pods in a way which abides by the constraints.\n\nTopologySpreadConstraints are the topology spread constraints required by the instancetype.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/api/core/v1.TopologySpreadConstraint"),
+									},
+								},
+							},
+						},
+					},
 					"cpu": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Required CPU related attributes of the instancetype.",
@@ -31657,7 +34763,7 @@ func schema_kubevirtio_api_instancetype_v1beta1_VirtualMachineInstancetypeSpec(r
 			},
 		},
 		Dependencies: []string{
-			"kubevirt.io/api/core/v1.GPU", "kubevirt.io/api/core/v1.HostDevice", "kubevirt.io/api/core/v1.LaunchSecurity", "kubevirt.io/api/instancetype/v1beta1.CPUInstancetype", "kubevirt.io/api/instancetype/v1beta1.MemoryInstancetype"},
+			"k8s.io/api/core/v1.Affinity", "k8s.io/api/core/v1.TopologySpreadConstraint", "kubevirt.io/api/core/v1.GPU", "kubevirt.io/api/core/v1.HostDevice", "kubevirt.io/api/core/v1.LaunchSecurity", "kubevirt.io/api/instancetype/v1beta1.CPUInstancetype", "kubevirt.io/api/instancetype/v1beta1.MemoryInstancetype"},
 	}
 }
 
@@ -31850,11 +34956,30 @@ func schema_kubevirtio_api_instancetype_v1beta1_VirtualMachinePreferenceSpec(ref
 							Format:      "int64",
 						},
 					},
+					"inheritFrom": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "InheritFrom optionally references base preferences whose attributes are inherited. The base preferences are merged in order, later ones taking precedence over earlier ones, and the attributes set by this preference take precedence over all of them. Maps are merged, lists are replaced. VirtualMachineClusterPreferences can only inherit from VirtualMachineClusterPreferences.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("kubevirt.io/api/instancetype/v1beta1.PreferenceReference"),
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"kubevirt.io/api/instancetype/v1beta1.CPUPreferences", "kubevirt.io/api/instancetype/v1beta1.ClockPreferences", "kubevirt.io/api/instancetype/v1beta1.DevicePreferences", "kubevirt.io/api/instancetype/v1beta1.FeaturePreferences", "kubevirt.io/api/instancetype/v1beta1.FirmwarePreferences", "kubevirt.io/api/instancetype/v1beta1.MachinePreferences", "kubevirt.io/api/instancetype/v1beta1.PreferenceRequirements", "kubevirt.io/api/instancetype/v1beta1.VolumePreferences"},
+			"kubevirt.io/api/instancetype/v1beta1.CPUPreferences", "kubevirt.io/api/instancetype/v1beta1.ClockPreferences", "kubevirt.io/api/instancetype/v1beta1.DevicePreferences", "kubevirt.io/api/instancetype/v1beta1.FeaturePreferences", "kubevirt.io/api/instancetype/v1beta1.FirmwarePreferences", "kubevirt.io/api/instancetype/v1beta1.MachinePreferences", "kubevirt.io/api/instancetype/v1beta1.PreferenceReference", "kubevirt.io/api/instancetype/v1beta1.PreferenceRequirements", "kubevirt.io/api/instancetype/v1beta1.VolumePreferences"},
 	}
 }
 
@@ -31877,6 +35002,36 @@ func schema_kubevirtio_api_instancetype_v1beta1_VolumePreferences(ref common.Ref
 	}
 }
 
+func schema_kubevirtio_api_migrations_v1alpha1_MaintenanceWindow(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MaintenanceWindow is a recurring time window during which automated migrations are allowed",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"schedule": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Schedule is a cron expression in the five field format \"minute hour day-of-month month day-of-week\", evaluated in UTC, at which the window opens. Fields support numbers, ranges, lists and steps.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"duration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Duration is how long the window stays open, at most 7 days.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+				},
+				Required: []string{"schedule", "duration"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+	}
+}
+
 func schema_kubevirtio_api_migrations_v1alpha1_MigrationPolicy(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -32019,12 +35174,54 @@ func schema_kubevirtio_api_migrations_v1alpha1_MigrationPolicySpec(ref common.Re
 							Format: "",
 						},
 					},
+					"maxDowntimeMilliseconds": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"integer"},
+							Format: "int64",
+						},
+					},
+					"postCopyAfterIterations": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"integer"},
+							Format: "int64",
+						},
+					},
+					"parallelMigrationChannels": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"integer"},
+							Format: "int64",
+						},
+					},
+					"compression": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("kubevirt.io/api/core/v1.MigrationCompression"),
+						},
+					},
+					"maintenanceWindows": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "MaintenanceWindows restrict the automated migrations of the matched VirtualMachineInstances, the ones created by the workload updater and for evacuations, to the given windows. Automated migrations requested outside of all windows stay pending until one opens. Migrations requested by users are never held back.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("kubevirt.io/api/migrations/v1alpha1.MaintenanceWindow"),
+									},
+								},
+							},
+						},
+					},
 				},
 				Required: []string{"selectors"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/api/resource.Quantity", "kubevirt.io/api/migrations/v1alpha1.Selectors"},
+			"k8s.io/apimachinery/pkg/api/resource.Quantity", "kubevirt.io/api/core/v1.MigrationCompression", "kubevirt.io/api/migrations/v1alpha1.MaintenanceWindow", "kubevirt.io/api/migrations/v1alpha1.Selectors"},
 	}
 }
 
@@ -32213,7 +35410,368 @@ func schema_kubevirtio_api_pool_v1alpha1_VirtualMachinePoolList(ref common.Refer
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("kubevirt.io/api/pool/v1alpha1.VirtualMachinePool"),
+										Ref:     ref("kubevirt.io/api/pool/v1alpha1.VirtualMachinePool"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "kubevirt.io/api/pool/v1alpha1.VirtualMachinePool"},
+	}
+}
+
+func schema_kubevirtio_api_pool_v1alpha1_VirtualMachinePoolNameGeneration(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"appendIndexToConfigMapRefs": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"boolean"},
+							Format: "",
+						},
+					},
+					"appendIndexToSecretRefs": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"boolean"},
+							Format: "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_kubevirtio_api_pool_v1alpha1_VirtualMachinePoolSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"replicas": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Number of desired pods. This is a pointer to distinguish between explicit zero and not specified. Defaults to 1.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"selector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Label selector for pods. Existing Poolss whose pods are selected by this will be the ones affected by this deployment.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"),
+						},
+					},
+					"virtualMachineTemplate": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Template describes the VM that will be created.",
+							Ref:         ref("kubevirt.io/api/pool/v1alpha1.VirtualMachineTemplateSpec"),
+						},
+					},
+					"paused": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Indicates that the pool is paused.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"nameGeneration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Options for the name generation in a pool.",
+							Ref:         ref("kubevirt.io/api/pool/v1alpha1.VirtualMachinePoolNameGeneration"),
+						},
+					},
+				},
+				Required: []string{"selector", "virtualMachineTemplate"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector", "kubevirt.io/api/pool/v1alpha1.VirtualMachinePoolNameGeneration", "kubevirt.io/api/pool/v1alpha1.VirtualMachineTemplateSpec"},
+	}
+}
+
+func schema_kubevirtio_api_pool_v1alpha1_VirtualMachinePoolStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"replicas": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"integer"},
+							Format: "int32",
+						},
+					},
+					"readyReplicas": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"integer"},
+							Format: "int32",
+						},
+					},
+					"conditions": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("kubevirt.io/api/pool/v1alpha1.VirtualMachinePoolCondition"),
+									},
+								},
+							},
+						},
+					},
+					"labelSelector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Canonical form of the label selector for HPA which consumes it through the scale subresource.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"kubevirt.io/api/pool/v1alpha1.VirtualMachinePoolCondition"},
+	}
+}
+
+func schema_kubevirtio_api_pool_v1alpha1_VirtualMachineTemplateSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "VirtualMachineSpec contains the VirtualMachine specification.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("kubevirt.io/api/core/v1.VirtualMachineSpec"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "kubevirt.io/api/core/v1.VirtualMachineSpec"},
+	}
+}
+
+func schema_kubevirtio_api_prefetch_v1alpha1_Condition(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Condition defines conditions",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"lastProbeTime": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"lastTransitionTime": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"reason": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+				},
+				Required: []string{"type", "status"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_kubevirtio_api_prefetch_v1alpha1_ContainerDiskImage(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"image": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Image is the name of the containerDisk image.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"imagePullSecret": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ImagePullSecret is the name of the Docker registry secret required to pull the image.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"imagePullPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ImagePullPolicy of the image. Defaults to IfNotPresent.\n\nPossible enum values:\n - `\"Always\"` means that kubelet always attempts to pull the latest image. Container will fail If the pull fails.\n - `\"IfNotPresent\"` means that kubelet pulls if the image isn't present on disk. Container will fail if the image isn't present and the pull fails.\n - `\"Never\"` means that kubelet never pulls an image, but only uses a local image. Container will fail if the image isn't present",
+							Type:        []string{"string"},
+							Format:      "",
+							Enum:        []interface{}{"Always", "IfNotPresent", "Never"},
+						},
+					},
+				},
+				Required: []string{"image"},
+			},
+		},
+	}
+}
+
+func schema_kubevirtio_api_prefetch_v1alpha1_DataSourceReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name of the DataSource.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"namespace": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Namespace of the DataSource. Defaults to the namespace of the ImagePrefetch.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
+	}
+}
+
+func schema_kubevirtio_api_prefetch_v1alpha1_ImagePrefetch(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ImagePrefetch stages containerDisk images and DataSources on a set of nodes ahead of a planned mass start of VirtualMachines.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("kubevirt.io/api/prefetch/v1alpha1.ImagePrefetchSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("kubevirt.io/api/prefetch/v1alpha1.ImagePrefetchStatus"),
+						},
+					},
+				},
+				Required: []string{"spec"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "kubevirt.io/api/prefetch/v1alpha1.ImagePrefetchSpec", "kubevirt.io/api/prefetch/v1alpha1.ImagePrefetchStatus"},
+	}
+}
+
+func schema_kubevirtio_api_prefetch_v1alpha1_ImagePrefetchList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ImagePrefetchList is a list of ImagePrefetch resources.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("kubevirt.io/api/prefetch/v1alpha1.ImagePrefetch"),
 									},
 								},
 							},
@@ -32224,97 +35782,122 @@ func schema_kubevirtio_api_pool_v1alpha1_VirtualMachinePoolList(ref common.Refer
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "kubevirt.io/api/pool/v1alpha1.VirtualMachinePool"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "kubevirt.io/api/prefetch/v1alpha1.ImagePrefetch"},
 	}
 }
 
-func schema_kubevirtio_api_pool_v1alpha1_VirtualMachinePoolNameGeneration(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_kubevirtio_api_prefetch_v1alpha1_ImagePrefetchSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"appendIndexToConfigMapRefs": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"boolean"},
-							Format: "",
+					"containerDisks": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
 						},
-					},
-					"appendIndexToSecretRefs": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"boolean"},
-							Format: "",
-						},
-					},
-				},
-			},
-		},
-	}
-}
-
-func schema_kubevirtio_api_pool_v1alpha1_VirtualMachinePoolSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
-				Properties: map[string]spec.Schema{
-					"replicas": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Number of desired pods. This is a pointer to distinguish between explicit zero and not specified. Defaults to 1.",
-							Type:        []string{"integer"},
-							Format:      "int32",
+							Description: "ContainerDisks lists the containerDisk images which are pulled on every selected node.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("kubevirt.io/api/prefetch/v1alpha1.ContainerDiskImage"),
+									},
+								},
+							},
 						},
 					},
-					"selector": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Label selector for pods. Existing Poolss whose pods are selected by this will be the ones affected by this deployment.",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"),
+					"dataSources": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
 						},
-					},
-					"virtualMachineTemplate": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Template describes the VM that will be created.",
-							Ref:         ref("kubevirt.io/api/pool/v1alpha1.VirtualMachineTemplateSpec"),
+							Description: "DataSources lists the DataSources whose volumes have to be ready.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("kubevirt.io/api/prefetch/v1alpha1.DataSourceReference"),
+									},
+								},
+							},
 						},
 					},
-					"paused": {
+					"nodeSelector": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Indicates that the pool is paused.",
-							Type:        []string{"boolean"},
-							Format:      "",
+							Description: "NodeSelector selects the nodes the containerDisk images are staged on. All schedulable nodes are selected if it is empty.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
 						},
 					},
-					"nameGeneration": {
+					"readyBy": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Options for the name generation in a pool.",
-							Ref:         ref("kubevirt.io/api/pool/v1alpha1.VirtualMachinePoolNameGeneration"),
+							Description: "ReadyBy is the time of the planned event. A warning is emitted if the prefetch did not complete by then.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
 				},
-				Required: []string{"selector", "virtualMachineTemplate"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector", "kubevirt.io/api/pool/v1alpha1.VirtualMachinePoolNameGeneration", "kubevirt.io/api/pool/v1alpha1.VirtualMachineTemplateSpec"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time", "kubevirt.io/api/prefetch/v1alpha1.ContainerDiskImage", "kubevirt.io/api/prefetch/v1alpha1.DataSourceReference"},
 	}
 }
 
-func schema_kubevirtio_api_pool_v1alpha1_VirtualMachinePoolStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_kubevirtio_api_prefetch_v1alpha1_ImagePrefetchStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"replicas": {
+					"phase": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"integer"},
-							Format: "int32",
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
-					"readyReplicas": {
+					"targetNodes": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"integer"},
-							Format: "int32",
+							Description: "TargetNodes is the number of nodes selected by the node selector.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"readyNodes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ReadyNodes is the number of selected nodes which pulled all containerDisk images.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"readyDataSources": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ReadyDataSources is the number of DataSources which are ready.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"readinessPercentage": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ReadinessPercentage is the share of staged items, nodes and DataSources, in percent.",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
 					"conditions": {
@@ -32329,51 +35912,17 @@ func schema_kubevirtio_api_pool_v1alpha1_VirtualMachinePoolStatus(ref common.Ref
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("kubevirt.io/api/pool/v1alpha1.VirtualMachinePoolCondition"),
+										Ref:     ref("kubevirt.io/api/prefetch/v1alpha1.Condition"),
 									},
 								},
 							},
 						},
 					},
-					"labelSelector": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Canonical form of the label selector for HPA which consumes it through the scale subresource.",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-				},
-			},
-		},
-		Dependencies: []string{
-			"kubevirt.io/api/pool/v1alpha1.VirtualMachinePoolCondition"},
-	}
-}
-
-func schema_kubevirtio_api_pool_v1alpha1_VirtualMachineTemplateSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
-				Properties: map[string]spec.Schema{
-					"metadata": {
-						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
-						},
-					},
-					"spec": {
-						SchemaProps: spec.SchemaProps{
-							Description: "VirtualMachineSpec contains the VirtualMachine specification.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("kubevirt.io/api/core/v1.VirtualMachineSpec"),
-						},
-					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "kubevirt.io/api/core/v1.VirtualMachineSpec"},
+			"kubevirt.io/api/prefetch/v1alpha1.Condition"},
 	}
 }
 
@@ -33666,6 +37215,29 @@ func schema_kubevirtio_api_snapshot_v1beta1_VirtualMachineRestoreSpec(ref common
 							},
 						},
 					},
+					"targetIdentityPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TargetIdentityPolicy defines whether a target created by the restore keeps the identity of the snapshotted VM. It can only be set if the target does not exist. Defaults to Keep",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"newMacAddresses": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NewMacAddresses sets the MAC addresses of the interfaces of a target created with the Regenerate identity policy. The key is the interface name. The MAC addresses of the other interfaces are cleared so that new ones are assigned",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
 				},
 				Required: []string{"target", "virtualMachineSnapshotName"},
 			},
@@ -34043,6 +37615,199 @@ func schema_kubevirtio_api_snapshot_v1beta1_VirtualMachineSnapshotList(ref commo
 	}
 }
 
+func schema_kubevirtio_api_snapshot_v1beta1_VirtualMachineSnapshotSchedule(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VirtualMachineSnapshotSchedule creates VirtualMachineSnapshots of the selected VirtualMachines on a cron schedule and deletes the oldest of them",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("kubevirt.io/api/snapshot/v1beta1.VirtualMachineSnapshotScheduleSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("kubevirt.io/api/snapshot/v1beta1.VirtualMachineSnapshotScheduleStatus"),
+						},
+					},
+				},
+				Required: []string{"spec"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "kubevirt.io/api/snapshot/v1beta1.VirtualMachineSnapshotScheduleSpec", "kubevirt.io/api/snapshot/v1beta1.VirtualMachineSnapshotScheduleStatus"},
+	}
+}
+
+func schema_kubevirtio_api_snapshot_v1beta1_VirtualMachineSnapshotScheduleList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VirtualMachineSnapshotScheduleList is a list of VirtualMachineSnapshotSchedule resources",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("kubevirt.io/api/snapshot/v1beta1.VirtualMachineSnapshotSchedule"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"metadata", "items"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "kubevirt.io/api/snapshot/v1beta1.VirtualMachineSnapshotSchedule"},
+	}
+}
+
+func schema_kubevirtio_api_snapshot_v1beta1_VirtualMachineSnapshotScheduleSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VirtualMachineSnapshotScheduleSpec is the spec for a VirtualMachineSnapshotSchedule resource",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"schedule": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Schedule is a five field cron expression evaluated in UTC, like \"0 2 * * *\"",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"selector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Selector selects the VirtualMachines in the namespace of the schedule which are snapshotted, an empty selector selects all of them",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"),
+						},
+					},
+					"retentionCount": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RetentionCount is the number of succeeded snapshots kept per VirtualMachine. Defaults to 7",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"quiescePolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "QuiescePolicy defines whether the guest file systems have to be frozen for the snapshots. Defaults to BestEffort",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"suspend": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Suspend stops the schedule from creating snapshots, the existing snapshots are kept",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"failureDeadline": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FailureDeadline of the created snapshots",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+				},
+				Required: []string{"schedule", "selector"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration", "k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"},
+	}
+}
+
+func schema_kubevirtio_api_snapshot_v1beta1_VirtualMachineSnapshotScheduleStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VirtualMachineSnapshotScheduleStatus is the status for a VirtualMachineSnapshotSchedule resource",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"lastScheduleTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastScheduleTime is the last time snapshots were created",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"nextScheduleTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NextScheduleTime is the next time snapshots will be created",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"lastSuccessTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastSuccessTime is the time all the snapshots of the last successful run were ready",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"error": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Error is the last error of the schedule, like a skipped VirtualMachine or a failed snapshot",
+							Ref:         ref("kubevirt.io/api/snapshot/v1beta1.Error"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time", "kubevirt.io/api/snapshot/v1beta1.Error"},
+	}
+}
+
 func schema_kubevirtio_api_snapshot_v1beta1_VirtualMachineSnapshotSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
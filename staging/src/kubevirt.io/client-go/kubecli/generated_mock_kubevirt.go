@@ -1207,6 +1207,16 @@ func (_mr *_MockVirtualMachineInstanceInterfaceRecorder) Freeze(arg0, arg1, arg2
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "Freeze", arg0, arg1, arg2)
 }
 
+func (_m *MockVirtualMachineInstanceInterface) FreezeVolumes(ctx context.Context, name string, unfreezeTimeout time.Duration, volumes []string) error {
+	ret := _m.ctrl.Call(_m, "FreezeVolumes", ctx, name, unfreezeTimeout, volumes)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockVirtualMachineInstanceInterfaceRecorder) FreezeVolumes(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "FreezeVolumes", arg0, arg1, arg2, arg3)
+}
+
 func (_m *MockVirtualMachineInstanceInterface) Unfreeze(ctx context.Context, name string) error {
 	ret := _m.ctrl.Call(_m, "Unfreeze", ctx, name)
 	ret0, _ := ret[0].(error)
@@ -1270,6 +1280,17 @@ func (_mr *_MockVirtualMachineInstanceInterfaceRecorder) FilesystemList(arg0, ar
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "FilesystemList", arg0, arg1)
 }
 
+func (_m *MockVirtualMachineInstanceInterface) MigrationFeasibility(ctx context.Context, name string) (v121.VirtualMachineInstanceMigrationFeasibility, error) {
+	ret := _m.ctrl.Call(_m, "MigrationFeasibility", ctx, name)
+	ret0, _ := ret[0].(v121.VirtualMachineInstanceMigrationFeasibility)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockVirtualMachineInstanceInterfaceRecorder) MigrationFeasibility(arg0, arg1 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "MigrationFeasibility", arg0, arg1)
+}
+
 func (_m *MockVirtualMachineInstanceInterface) AddVolume(ctx context.Context, name string, addVolumeOptions *v121.AddVolumeOptions) error {
 	ret := _m.ctrl.Call(_m, "AddVolume", ctx, name, addVolumeOptions)
 	ret0, _ := ret[0].(error)
@@ -1290,6 +1311,16 @@ func (_mr *_MockVirtualMachineInstanceInterfaceRecorder) RemoveVolume(arg0, arg1
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "RemoveVolume", arg0, arg1, arg2)
 }
 
+func (_m *MockVirtualMachineInstanceInterface) SetLinkState(ctx context.Context, name string, setLinkStateOptions *v121.SetLinkStateOptions) error {
+	ret := _m.ctrl.Call(_m, "SetLinkState", ctx, name, setLinkStateOptions)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockVirtualMachineInstanceInterfaceRecorder) SetLinkState(arg0, arg1, arg2 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "SetLinkState", arg0, arg1, arg2)
+}
+
 func (_m *MockVirtualMachineInstanceInterface) VSOCK(name string, options *v121.VSOCKOptions) (v122.StreamInterface, error) {
 	ret := _m.ctrl.Call(_m, "VSOCK", name, options)
 	ret0, _ := ret[0].(v122.StreamInterface)
@@ -1301,6 +1332,17 @@ func (_mr *_MockVirtualMachineInstanceInterfaceRecorder) VSOCK(arg0, arg1 interf
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "VSOCK", arg0, arg1)
 }
 
+func (_m *MockVirtualMachineInstanceInterface) PacketCapture(name string, options *v121.PacketCaptureOptions) (v122.StreamInterface, error) {
+	ret := _m.ctrl.Call(_m, "PacketCapture", name, options)
+	ret0, _ := ret[0].(v122.StreamInterface)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockVirtualMachineInstanceInterfaceRecorder) PacketCapture(arg0, arg1 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "PacketCapture", arg0, arg1)
+}
+
 func (_m *MockVirtualMachineInstanceInterface) SEVFetchCertChain(ctx context.Context, name string) (v121.SEVPlatformInfo, error) {
 	ret := _m.ctrl.Call(_m, "SEVFetchCertChain", ctx, name)
 	ret0, _ := ret[0].(v121.SEVPlatformInfo)
@@ -1981,6 +2023,16 @@ func (_mr *_MockVirtualMachineInstanceMigrationInterfaceRecorder) PatchStatus(ar
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "PatchStatus", arg0, arg1, arg2, arg3, arg4)
 }
 
+func (_m *MockVirtualMachineInstanceMigrationInterface) Cancel(ctx context.Context, name string, cancelOptions *v121.MigrationCancelOptions) error {
+	ret := _m.ctrl.Call(_m, "Cancel", ctx, name, cancelOptions)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockVirtualMachineInstanceMigrationInterfaceRecorder) Cancel(arg0, arg1, arg2 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "Cancel", arg0, arg1, arg2)
+}
+
 // Mock of KubeVirtInterface interface
 type MockKubeVirtInterface struct {
 	ctrl     *gomock.Controller
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 
 	v1 "k8s.io/api/core/v1"
 	k8smetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -22,6 +23,7 @@ const (
 	usbredirTemplateURI       = "wss://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/usbredir"
 	vncTemplateURI            = "wss://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/vnc"
 	vsockTemplateURI          = "wss://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/vsock"
+	pcapTemplateURI           = "wss://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/pcap"
 	pauseTemplateURI          = "https://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/pause"
 	unpauseTemplateURI        = "https://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/unpause"
 	freezeTemplateURI         = "https://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/freeze"
@@ -58,6 +60,7 @@ type VirtHandlerConn interface {
 	USBRedirURI(vmi *virtv1.VirtualMachineInstance) (string, error)
 	VNCURI(vmi *virtv1.VirtualMachineInstance) (string, error)
 	VSOCKURI(vmi *virtv1.VirtualMachineInstance, port string, tls string) (string, error)
+	PcapURI(vmi *virtv1.VirtualMachineInstance, iface string, duration string) (string, error)
 	PauseURI(vmi *virtv1.VirtualMachineInstance) (string, error)
 	UnpauseURI(vmi *virtv1.VirtualMachineInstance) (string, error)
 	FreezeURI(vmi *virtv1.VirtualMachineInstance) (string, error)
@@ -195,6 +198,19 @@ func (v *virtHandlerConn) VSOCKURI(vmi *virtv1.VirtualMachineInstance, port stri
 	return fmt.Sprintf("%s?port=%s&tls=%s", baseURI, port, tls), nil
 }
 
+func (v *virtHandlerConn) PcapURI(vmi *virtv1.VirtualMachineInstance, iface string, duration string) (string, error) {
+	baseURI, err := v.formatURI(pcapTemplateURI, vmi)
+	if err != nil {
+		return "", err
+	}
+	queryParams := url.Values{}
+	queryParams.Add("interface", iface)
+	if duration != "" {
+		queryParams.Add("duration", duration)
+	}
+	return fmt.Sprintf("%s?%s", baseURI, queryParams.Encode()), nil
+}
+
 func (v *virtHandlerConn) FreezeURI(vmi *virtv1.VirtualMachineInstance) (string, error) {
 	return v.formatURI(freezeTemplateURI, vmi)
 }
@@ -179,3 +179,15 @@ func (v *vmis) VSOCK(name string, options *v1.VSOCKOptions) (kvcorev1.StreamInte
 	queryParams.Add("tls", strconv.FormatBool(useTLS))
 	return kvcorev1.AsyncSubresourceHelper(v.config, v.resource, v.namespace, name, "vsock", queryParams)
 }
+
+func (v *vmis) PacketCapture(name string, options *v1.PacketCaptureOptions) (kvcorev1.StreamInterface, error) {
+	if options == nil || options.InterfaceName == "" {
+		return nil, fmt.Errorf("interface name is required but not provided")
+	}
+	queryParams := url.Values{}
+	queryParams.Add("interface", options.InterfaceName)
+	if options.Duration != nil {
+		queryParams.Add("duration", options.Duration.Duration.String())
+	}
+	return kvcorev1.AsyncSubresourceHelper(v.config, v.resource, v.namespace, name, "pcap", queryParams)
+}
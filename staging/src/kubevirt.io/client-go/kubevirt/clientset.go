@@ -35,6 +35,7 @@ import (
 	instancetypev1beta1 "kubevirt.io/client-go/kubevirt/typed/instancetype/v1beta1"
 	migrationsv1alpha1 "kubevirt.io/client-go/kubevirt/typed/migrations/v1alpha1"
 	poolv1alpha1 "kubevirt.io/client-go/kubevirt/typed/pool/v1alpha1"
+	prefetchv1alpha1 "kubevirt.io/client-go/kubevirt/typed/prefetch/v1alpha1"
 	snapshotv1alpha1 "kubevirt.io/client-go/kubevirt/typed/snapshot/v1alpha1"
 	snapshotv1beta1 "kubevirt.io/client-go/kubevirt/typed/snapshot/v1beta1"
 )
@@ -51,6 +52,7 @@ type Interface interface {
 	InstancetypeV1beta1() instancetypev1beta1.InstancetypeV1beta1Interface
 	MigrationsV1alpha1() migrationsv1alpha1.MigrationsV1alpha1Interface
 	PoolV1alpha1() poolv1alpha1.PoolV1alpha1Interface
+	PrefetchV1alpha1() prefetchv1alpha1.PrefetchV1alpha1Interface
 	SnapshotV1alpha1() snapshotv1alpha1.SnapshotV1alpha1Interface
 	SnapshotV1beta1() snapshotv1beta1.SnapshotV1beta1Interface
 }
@@ -68,6 +70,7 @@ type Clientset struct {
 	instancetypeV1beta1  *instancetypev1beta1.InstancetypeV1beta1Client
 	migrationsV1alpha1   *migrationsv1alpha1.MigrationsV1alpha1Client
 	poolV1alpha1         *poolv1alpha1.PoolV1alpha1Client
+	prefetchV1alpha1     *prefetchv1alpha1.PrefetchV1alpha1Client
 	snapshotV1alpha1     *snapshotv1alpha1.SnapshotV1alpha1Client
 	snapshotV1beta1      *snapshotv1beta1.SnapshotV1beta1Client
 }
@@ -122,6 +125,11 @@ func (c *Clientset) PoolV1alpha1() poolv1alpha1.PoolV1alpha1Interface {
 	return c.poolV1alpha1
 }
 
+// PrefetchV1alpha1 retrieves the PrefetchV1alpha1Client
+func (c *Clientset) PrefetchV1alpha1() prefetchv1alpha1.PrefetchV1alpha1Interface {
+	return c.prefetchV1alpha1
+}
+
 // SnapshotV1alpha1 retrieves the SnapshotV1alpha1Client
 func (c *Clientset) SnapshotV1alpha1() snapshotv1alpha1.SnapshotV1alpha1Interface {
 	return c.snapshotV1alpha1
@@ -216,6 +224,10 @@ func NewForConfigAndClient(c *rest.Config, httpClient *http.Client) (*Clientset,
 	if err != nil {
 		return nil, err
 	}
+	cs.prefetchV1alpha1, err = prefetchv1alpha1.NewForConfigAndClient(&configShallowCopy, httpClient)
+	if err != nil {
+		return nil, err
+	}
 	cs.snapshotV1alpha1, err = snapshotv1alpha1.NewForConfigAndClient(&configShallowCopy, httpClient)
 	if err != nil {
 		return nil, err
@@ -255,6 +267,7 @@ func New(c rest.Interface) *Clientset {
 	cs.instancetypeV1beta1 = instancetypev1beta1.New(c)
 	cs.migrationsV1alpha1 = migrationsv1alpha1.New(c)
 	cs.poolV1alpha1 = poolv1alpha1.New(c)
+	cs.prefetchV1alpha1 = prefetchv1alpha1.New(c)
 	cs.snapshotV1alpha1 = snapshotv1alpha1.New(c)
 	cs.snapshotV1beta1 = snapshotv1beta1.New(c)
 
@@ -45,6 +45,8 @@ import (
 	fakemigrationsv1alpha1 "kubevirt.io/client-go/kubevirt/typed/migrations/v1alpha1/fake"
 	poolv1alpha1 "kubevirt.io/client-go/kubevirt/typed/pool/v1alpha1"
 	fakepoolv1alpha1 "kubevirt.io/client-go/kubevirt/typed/pool/v1alpha1/fake"
+	prefetchv1alpha1 "kubevirt.io/client-go/kubevirt/typed/prefetch/v1alpha1"
+	fakeprefetchv1alpha1 "kubevirt.io/client-go/kubevirt/typed/prefetch/v1alpha1/fake"
 	snapshotv1alpha1 "kubevirt.io/client-go/kubevirt/typed/snapshot/v1alpha1"
 	fakesnapshotv1alpha1 "kubevirt.io/client-go/kubevirt/typed/snapshot/v1alpha1/fake"
 	snapshotv1beta1 "kubevirt.io/client-go/kubevirt/typed/snapshot/v1beta1"
@@ -155,6 +157,11 @@ func (c *Clientset) PoolV1alpha1() poolv1alpha1.PoolV1alpha1Interface {
 	return &fakepoolv1alpha1.FakePoolV1alpha1{Fake: &c.Fake}
 }
 
+// PrefetchV1alpha1 retrieves the PrefetchV1alpha1Client
+func (c *Clientset) PrefetchV1alpha1() prefetchv1alpha1.PrefetchV1alpha1Interface {
+	return &fakeprefetchv1alpha1.FakePrefetchV1alpha1{Fake: &c.Fake}
+}
+
 // SnapshotV1alpha1 retrieves the SnapshotV1alpha1Client
 func (c *Clientset) SnapshotV1alpha1() snapshotv1alpha1.SnapshotV1alpha1Interface {
 	return &fakesnapshotv1alpha1.FakeSnapshotV1alpha1{Fake: &c.Fake}
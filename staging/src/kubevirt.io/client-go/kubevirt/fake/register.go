@@ -34,6 +34,7 @@ import (
 	instancetypev1beta1 "kubevirt.io/api/instancetype/v1beta1"
 	migrationsv1alpha1 "kubevirt.io/api/migrations/v1alpha1"
 	poolv1alpha1 "kubevirt.io/api/pool/v1alpha1"
+	prefetchv1alpha1 "kubevirt.io/api/prefetch/v1alpha1"
 	snapshotv1alpha1 "kubevirt.io/api/snapshot/v1alpha1"
 	snapshotv1beta1 "kubevirt.io/api/snapshot/v1beta1"
 )
@@ -52,6 +53,7 @@ var localSchemeBuilder = runtime.SchemeBuilder{
 	instancetypev1beta1.AddToScheme,
 	migrationsv1alpha1.AddToScheme,
 	poolv1alpha1.AddToScheme,
+	prefetchv1alpha1.AddToScheme,
 	snapshotv1alpha1.AddToScheme,
 	snapshotv1beta1.AddToScheme,
 }
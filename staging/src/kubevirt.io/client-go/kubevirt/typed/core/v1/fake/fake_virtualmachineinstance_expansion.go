@@ -23,6 +23,7 @@ import (
 	"context"
 	"time"
 
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/testing"
 
 	v1 "kubevirt.io/api/core/v1"
@@ -71,6 +72,16 @@ func (c *FakeVirtualMachineInstances) Freeze(ctx context.Context, name string, u
 	return err
 }
 
+func (c *FakeVirtualMachineInstances) FreezeVolumes(ctx context.Context, name string, unfreezeTimeout time.Duration, volumes []string) error {
+	_, err := c.Fake.
+		Invokes(fake2.NewPutSubresourceAction(virtualmachineinstancesResource, c.ns, "freeze", name, &v1.FreezeUnfreezeTimeout{
+			UnfreezeTimeout: &metav1.Duration{Duration: unfreezeTimeout},
+			Volumes:         volumes,
+		}), nil)
+
+	return err
+}
+
 func (c *FakeVirtualMachineInstances) Unfreeze(ctx context.Context, name string) error {
 	_, err := c.Fake.
 		Invokes(fake2.NewPutSubresourceAction(virtualmachineinstancesResource, c.ns, "unfreeze", name, struct{}{}), nil)
@@ -114,6 +125,21 @@ func (c *FakeVirtualMachineInstances) FilesystemList(ctx context.Context, name s
 	return v1.VirtualMachineInstanceFileSystemList{}, err
 }
 
+func (c *FakeVirtualMachineInstances) MigrationFeasibility(
+	ctx context.Context, name string,
+) (v1.VirtualMachineInstanceMigrationFeasibility, error) {
+	obj, err := c.Fake.
+		Invokes(
+			testing.NewGetSubresourceAction(virtualmachineinstancesResource, c.ns, "migrationfeasibility", name),
+			&v1.VirtualMachineInstanceMigrationFeasibility{},
+		)
+
+	if obj == nil {
+		return v1.VirtualMachineInstanceMigrationFeasibility{}, err
+	}
+	return *obj.(*v1.VirtualMachineInstanceMigrationFeasibility), err
+}
+
 func (c *FakeVirtualMachineInstances) AddVolume(ctx context.Context, name string, addVolumeOptions *v1.AddVolumeOptions) error {
 	_, err := c.Fake.
 		Invokes(fake2.NewPutSubresourceAction(virtualmachineinstancesResource, c.ns, "addvolume", name, addVolumeOptions), nil)
@@ -128,10 +154,21 @@ func (c *FakeVirtualMachineInstances) RemoveVolume(ctx context.Context, name str
 	return err
 }
 
+func (c *FakeVirtualMachineInstances) SetLinkState(ctx context.Context, name string, setLinkStateOptions *v1.SetLinkStateOptions) error {
+	_, err := c.Fake.
+		Invokes(fake2.NewPutSubresourceAction(virtualmachineinstancesResource, c.ns, "setlinkstate", name, setLinkStateOptions), nil)
+
+	return err
+}
+
 func (c *FakeVirtualMachineInstances) VSOCK(name string, options *v1.VSOCKOptions) (kvcorev1.StreamInterface, error) {
 	return nil, nil
 }
 
+func (c *FakeVirtualMachineInstances) PacketCapture(name string, options *v1.PacketCaptureOptions) (kvcorev1.StreamInterface, error) {
+	return nil, nil
+}
+
 func (c *FakeVirtualMachineInstances) SEVFetchCertChain(ctx context.Context, name string) (v1.SEVPlatformInfo, error) {
 	_, err := c.Fake.
 		Invokes(testing.NewGetSubresourceAction(virtualmachineinstancesResource, c.ns, "sev/fetchcertchain", name), &v1.SEVPlatformInfo{})
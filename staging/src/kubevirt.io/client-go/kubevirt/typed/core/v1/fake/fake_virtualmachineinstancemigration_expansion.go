@@ -26,8 +26,16 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 
 	v1 "kubevirt.io/api/core/v1"
+	fake2 "kubevirt.io/client-go/testing"
 )
 
 func (c *FakeVirtualMachineInstanceMigrations) PatchStatus(ctx context.Context, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions) (*v1.VirtualMachineInstanceMigration, error) {
 	return c.Patch(ctx, name, pt, data, opts, "status")
 }
+
+func (c *FakeVirtualMachineInstanceMigrations) Cancel(ctx context.Context, name string, cancelOptions *v1.MigrationCancelOptions) error {
+	_, err := c.Fake.
+		Invokes(fake2.NewPutSubresourceAction(virtualmachineinstancemigrationsResource, c.ns, "cancel", name, cancelOptions), nil)
+
+	return err
+}
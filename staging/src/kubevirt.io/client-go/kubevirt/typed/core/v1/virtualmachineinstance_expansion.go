@@ -46,15 +46,19 @@ type VirtualMachineInstanceExpansion interface {
 	Pause(ctx context.Context, name string, pauseOptions *v1.PauseOptions) error
 	Unpause(ctx context.Context, name string, unpauseOptions *v1.UnpauseOptions) error
 	Freeze(ctx context.Context, name string, unfreezeTimeout time.Duration) error
+	FreezeVolumes(ctx context.Context, name string, unfreezeTimeout time.Duration, volumes []string) error
 	Unfreeze(ctx context.Context, name string) error
 	Reset(ctx context.Context, name string) error
 	SoftReboot(ctx context.Context, name string) error
 	GuestOsInfo(ctx context.Context, name string) (v1.VirtualMachineInstanceGuestAgentInfo, error)
 	UserList(ctx context.Context, name string) (v1.VirtualMachineInstanceGuestOSUserList, error)
 	FilesystemList(ctx context.Context, name string) (v1.VirtualMachineInstanceFileSystemList, error)
+	MigrationFeasibility(ctx context.Context, name string) (v1.VirtualMachineInstanceMigrationFeasibility, error)
 	AddVolume(ctx context.Context, name string, addVolumeOptions *v1.AddVolumeOptions) error
 	RemoveVolume(ctx context.Context, name string, removeVolumeOptions *v1.RemoveVolumeOptions) error
+	SetLinkState(ctx context.Context, name string, setLinkStateOptions *v1.SetLinkStateOptions) error
 	VSOCK(name string, options *v1.VSOCKOptions) (StreamInterface, error)
+	PacketCapture(name string, options *v1.PacketCaptureOptions) (StreamInterface, error)
 	SEVFetchCertChain(ctx context.Context, name string) (v1.SEVPlatformInfo, error)
 	SEVQueryLaunchMeasurement(ctx context.Context, name string) (v1.SEVMeasurementInfo, error)
 	SEVSetupSession(ctx context.Context, name string, sevSessionOptions *v1.SEVSessionOptions) error
@@ -142,11 +146,16 @@ func (c *virtualMachineInstances) Unpause(ctx context.Context, name string, unpa
 }
 
 func (c *virtualMachineInstances) Freeze(ctx context.Context, name string, unfreezeTimeout time.Duration) error {
+	return c.FreezeVolumes(ctx, name, unfreezeTimeout, nil)
+}
+
+func (c *virtualMachineInstances) FreezeVolumes(ctx context.Context, name string, unfreezeTimeout time.Duration, volumes []string) error {
 	log.Log.Infof("Freeze VMI %s", name)
 	freezeUnfreezeTimeout := &v1.FreezeUnfreezeTimeout{
 		UnfreezeTimeout: &metav1.Duration{
 			Duration: unfreezeTimeout,
 		},
+		Volumes: volumes,
 	}
 
 	body, err := json.Marshal(freezeUnfreezeTimeout)
@@ -275,6 +284,21 @@ func (c *virtualMachineInstances) FilesystemList(ctx context.Context, name strin
 	return fsList, err
 }
 
+func (c *virtualMachineInstances) MigrationFeasibility(
+	ctx context.Context, name string,
+) (v1.VirtualMachineInstanceMigrationFeasibility, error) {
+	feasibility := v1.VirtualMachineInstanceMigrationFeasibility{}
+	err := c.GetClient().Get().
+		AbsPath(fmt.Sprintf(vmiSubresourceURL, v1.ApiStorageVersion)).
+		Namespace(c.GetNamespace()).
+		Resource("virtualmachineinstances").
+		Name(name).
+		SubResource("migrationfeasibility").
+		Do(ctx).
+		Into(&feasibility)
+	return feasibility, err
+}
+
 func (c *virtualMachineInstances) AddVolume(ctx context.Context, name string, addVolumeOptions *v1.AddVolumeOptions) error {
 	body, err := json.Marshal(addVolumeOptions)
 	if err != nil {
@@ -309,12 +333,35 @@ func (c *virtualMachineInstances) RemoveVolume(ctx context.Context, name string,
 		Error()
 }
 
+func (c *virtualMachineInstances) SetLinkState(ctx context.Context, name string, setLinkStateOptions *v1.SetLinkStateOptions) error {
+	body, err := json.Marshal(setLinkStateOptions)
+	if err != nil {
+		return err
+	}
+
+	return c.GetClient().Put().
+		AbsPath(fmt.Sprintf(vmiSubresourceURL, v1.ApiStorageVersion)).
+		Namespace(c.GetNamespace()).
+		Resource("virtualmachineinstances").
+		Name(name).
+		SubResource("setlinkstate").
+		Body(body).
+		Do(ctx).
+		Error()
+}
+
 func (c *virtualMachineInstances) VSOCK(name string, options *v1.VSOCKOptions) (StreamInterface, error) {
 	// TODO not implemented yet
 	//  requires clientConfig
 	return nil, fmt.Errorf("VSOCK is not implemented yet in generated client")
 }
 
+func (c *virtualMachineInstances) PacketCapture(name string, options *v1.PacketCaptureOptions) (StreamInterface, error) {
+	// TODO not implemented yet
+	//  requires clientConfig
+	return nil, fmt.Errorf("PacketCapture is not implemented yet in generated client")
+}
+
 func (c *virtualMachineInstances) SEVFetchCertChain(ctx context.Context, name string) (v1.SEVPlatformInfo, error) {
 	sevPlatformInfo := v1.SEVPlatformInfo{}
 	err := c.GetClient().Get().
@@ -21,6 +21,8 @@ package v1
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
@@ -30,9 +32,26 @@ import (
 
 type VirtualMachineInstanceMigrationExpansion interface {
 	PatchStatus(ctx context.Context, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions) (*v1.VirtualMachineInstanceMigration, error)
+	Cancel(ctx context.Context, name string, cancelOptions *v1.MigrationCancelOptions) error
 }
 
 func (c *virtualMachineInstanceMigrations) PatchStatus(ctx context.Context, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions) (*v1.VirtualMachineInstanceMigration, error) {
 	// TODO not implemented yet
 	return nil, nil
 }
+
+func (c *virtualMachineInstanceMigrations) Cancel(ctx context.Context, name string, cancelOptions *v1.MigrationCancelOptions) error {
+	body, err := json.Marshal(cancelOptions)
+	if err != nil {
+		return err
+	}
+	return c.GetClient().Put().
+		AbsPath(fmt.Sprintf(vmiSubresourceURL, v1.ApiStorageVersion)).
+		Namespace(c.GetNamespace()).
+		Resource("virtualmachineinstancemigrations").
+		Name(name).
+		SubResource("cancel").
+		Body(body).
+		Do(ctx).
+		Error()
+}
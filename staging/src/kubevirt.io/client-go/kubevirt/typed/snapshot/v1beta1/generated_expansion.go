@@ -23,3 +23,5 @@ type VirtualMachineRestoreExpansion interface{}
 type VirtualMachineSnapshotExpansion interface{}
 
 type VirtualMachineSnapshotContentExpansion interface{}
+
+type VirtualMachineSnapshotScheduleExpansion interface{}